@@ -10,6 +10,7 @@ import (
 	"os"
 	"path/filepath"
 	"strings"
+	"sync"
 	"time"
 
 	"ramble-ai/ent"
@@ -20,9 +21,13 @@ import (
 	"ramble-ai/goapp/config"
 	"ramble-ai/goapp/exports"
 	"ramble-ai/goapp/highlights"
+	"ramble-ai/goapp/mcp"
 	"ramble-ai/goapp/projects"
+	"ramble-ai/goapp/prompts"
 	"ramble-ai/goapp/realtime"
+	"ramble-ai/goapp/search"
 	"ramble-ai/goapp/settings"
+	"ramble-ai/goapp/usage"
 	"ramble-ai/goapp/version"
 
 	"entgo.io/ent/dialect"
@@ -36,6 +41,9 @@ import (
 type App struct {
 	ctx    context.Context
 	client *ent.Client
+
+	mcpMu   sync.Mutex
+	mcpHTTP *mcp.HTTPServer // running MCP HTTP transport, nil when stopped
 }
 
 // getUserDataDir returns the user data directory for the application
@@ -115,6 +123,9 @@ func (a *App) startup(ctx context.Context) {
 
 	log.Println("Database initialized and migrations applied")
 
+	// Refresh model context lengths and prices in the background; the cached list is used meanwhile
+	go ai.NewModelService(a.client, ctx).RefreshModelsIfStale()
+
 	// Create event emitter function
 	emitEvent := func(eventName string, data ...interface{}) {
 		// For single string data, emit it directly instead of as an array
@@ -136,6 +147,16 @@ func (a *App) startup(ctx context.Context) {
 	if err := a.RecoverActiveExportJobs(); err != nil {
 		log.Printf("Failed to recover active export jobs: %v", err)
 	}
+
+	// Start the MCP HTTP server if enabled
+	if status := a.applyMCPSettings(); status.Error != "" {
+		log.Printf("Failed to start MCP server: %s", status.Error)
+	}
+
+	// Permanently delete trashed items past the retention period
+	if _, err := a.PurgeExpiredTrash(); err != nil {
+		log.Printf("Failed to purge expired trash: %v", err)
+	}
 }
 
 // shutdown is called when the app shuts down
@@ -144,6 +165,9 @@ func (a *App) shutdown(ctx context.Context) {
 	manager := realtime.GetManager()
 	manager.Shutdown()
 
+	// Stop the MCP HTTP server
+	a.stopMCPServer()
+
 	// Close the database connection
 	if err := a.client.Close(); err != nil {
 		log.Printf("failed to close database connection: %v", err)
@@ -209,12 +233,309 @@ func (a *App) UpdateProjectActiveTab(projectID int, activeTab string) error {
 	return service.UpdateProjectActiveTab(projectID, activeTab)
 }
 
-// DeleteProject deletes a project by its ID
+// DeleteProject moves a project to the trash. It refuses while exports are running for the project.
 func (a *App) DeleteProject(id int) error {
+	exportService := exports.NewExportService(a.client, a.ctx)
+	running, err := exportService.RunningProjectExports(id)
+	if err != nil {
+		return err
+	}
+	if len(running) > 0 {
+		return fmt.Errorf("project has running exports (%s); cancel them or wait for them to finish", strings.Join(running, ", "))
+	}
+
+	service := projects.NewProjectService(a.client, a.ctx)
+	return service.TrashProject(id)
+}
+
+// RestoreProject brings a project and the clips trashed with it back from the trash
+func (a *App) RestoreProject(id int) error {
+	service := projects.NewProjectService(a.client, a.ctx)
+	return service.RestoreProject(id)
+}
+
+// PermanentlyDeleteProject deletes a project and all its related entities immediately
+func (a *App) PermanentlyDeleteProject(id int) error {
 	service := projects.NewProjectService(a.client, a.ctx)
 	return service.DeleteProject(id)
 }
 
+// DuplicateProject copies a project's settings and, optionally, its clips and highlights
+func (a *App) DuplicateProject(id int, name string, includeClips bool) (*projects.ProjectResponse, error) {
+	service := projects.NewProjectService(a.client, a.ctx)
+	return service.DuplicateProject(id, name, includeClips)
+}
+
+// CreateProjectFromTemplate creates a new project pre-filled from a template (templateID 0 creates a blank project)
+func (a *App) CreateProjectFromTemplate(name, description string, templateID int) (*projects.ProjectResponse, error) {
+	service := projects.NewProjectService(a.client, a.ctx)
+	return service.CreateProjectFromTemplate(name, description, templateID)
+}
+
+// GetProjectTemplates returns all project templates
+func (a *App) GetProjectTemplates() ([]*projects.ProjectTemplate, error) {
+	service := projects.NewProjectService(a.client, a.ctx)
+	return service.GetProjectTemplates()
+}
+
+// CreateProjectTemplate creates a new project template
+func (a *App) CreateProjectTemplate(template projects.ProjectTemplate) (*projects.ProjectTemplate, error) {
+	service := projects.NewProjectService(a.client, a.ctx)
+	return service.CreateProjectTemplate(template)
+}
+
+// UpdateProjectTemplate updates an existing project template
+func (a *App) UpdateProjectTemplate(template projects.ProjectTemplate) (*projects.ProjectTemplate, error) {
+	service := projects.NewProjectService(a.client, a.ctx)
+	return service.UpdateProjectTemplate(template)
+}
+
+// CreateTemplateFromProject saves a project's settings and section structure as a new template
+func (a *App) CreateTemplateFromProject(projectID int, name, description string) (*projects.ProjectTemplate, error) {
+	service := projects.NewProjectService(a.client, a.ctx)
+	return service.CreateTemplateFromProject(projectID, name, description)
+}
+
+// DeleteProjectTemplate deletes a project template
+func (a *App) DeleteProjectTemplate(id int) error {
+	service := projects.NewProjectService(a.client, a.ctx)
+	return service.DeleteProjectTemplate(id)
+}
+
+// SavePrompt stores a new version of a library prompt
+func (a *App) SavePrompt(input prompts.PromptInput) (*prompts.PromptResponse, error) {
+	service := prompts.NewPromptService(a.client, a.ctx)
+	return service.SavePrompt(input)
+}
+
+// GetPrompts returns the latest version of every library prompt available to a project, optionally for one task type
+func (a *App) GetPrompts(projectID int, taskType string) ([]*prompts.PromptResponse, error) {
+	service := prompts.NewPromptService(a.client, a.ctx)
+	return service.GetPrompts(projectID, taskType)
+}
+
+// GetPromptVersions returns every version of a project's (or, with projectID 0, a shared) library prompt, newest first
+func (a *App) GetPromptVersions(projectID int, name string) ([]*prompts.PromptResponse, error) {
+	service := prompts.NewPromptService(a.client, a.ctx)
+	return service.GetPromptVersions(projectID, name)
+}
+
+// GetPrompt returns a library prompt version by its ID
+func (a *App) GetPrompt(id int) (*prompts.PromptResponse, error) {
+	service := prompts.NewPromptService(a.client, a.ctx)
+	return service.GetPrompt(id)
+}
+
+// SharePrompt makes a project's library prompt available to all projects
+func (a *App) SharePrompt(projectID int, name string) error {
+	service := prompts.NewPromptService(a.client, a.ctx)
+	return service.SharePrompt(projectID, name)
+}
+
+// DeletePrompt deletes every version of a project's (or, with projectID 0, a shared) library prompt
+func (a *App) DeletePrompt(projectID int, name string) error {
+	service := prompts.NewPromptService(a.client, a.ctx)
+	return service.DeletePrompt(projectID, name)
+}
+
+// GetProjectUsageSummary returns the AI usage and cost of a project, broken down by task type and model
+func (a *App) GetProjectUsageSummary(projectID int) (*usage.UsageSummary, error) {
+	service := usage.NewUsageService(a.client, a.ctx)
+	return service.GetProjectUsageSummary(projectID)
+}
+
+// GetMonthlyUsageSummaries returns the AI usage and cost of recent months, newest first
+func (a *App) GetMonthlyUsageSummaries(months int) ([]*usage.UsageSummary, error) {
+	service := usage.NewUsageService(a.client, a.ctx)
+	return service.GetMonthlyUsageSummaries(months)
+}
+
+// GetAIBudgetStatus returns the monthly AI budget cap and this month's spend
+func (a *App) GetAIBudgetStatus() (*usage.BudgetStatus, error) {
+	service := usage.NewUsageService(a.client, a.ctx)
+	return service.GetBudgetStatus()
+}
+
+// SetAIMonthlyBudget sets the monthly AI budget cap in US dollars (0 removes the cap)
+func (a *App) SetAIMonthlyBudget(capUSD float64) error {
+	service := usage.NewUsageService(a.client, a.ctx)
+	return service.SetMonthlyBudget(capUSD)
+}
+
+// GetAICacheStats returns the AI response cache hit/miss counters and entry count
+func (a *App) GetAICacheStats() (*ai.CacheStats, error) {
+	cache := ai.NewResponseCache(a.client, a.ctx)
+	return cache.Stats()
+}
+
+// GetAICacheSettings returns the AI response cache settings
+func (a *App) GetAICacheSettings() (*ai.CacheSettings, error) {
+	cache := ai.NewResponseCache(a.client, a.ctx)
+	return cache.GetSettings()
+}
+
+// SaveAICacheSettings saves the AI response cache settings
+func (a *App) SaveAICacheSettings(cacheSettings ai.CacheSettings) error {
+	cache := ai.NewResponseCache(a.client, a.ctx)
+	return cache.SaveSettings(cacheSettings)
+}
+
+// InvalidateAICache removes cached AI responses of a task type, or all of them when taskType is empty
+func (a *App) InvalidateAICache(taskType string) (int, error) {
+	cache := ai.NewResponseCache(a.client, a.ctx)
+	return cache.Invalidate(taskType)
+}
+
+// GetProjectExportPresets returns the export presets saved for a project
+func (a *App) GetProjectExportPresets(projectID int) ([]projects.ExportPreset, error) {
+	service := projects.NewProjectService(a.client, a.ctx)
+	return service.GetProjectExportPresets(projectID)
+}
+
+// SaveProjectExportPresets replaces the export presets saved for a project
+func (a *App) SaveProjectExportPresets(projectID int, presets []projects.ExportPreset) error {
+	service := projects.NewProjectService(a.client, a.ctx)
+	return service.SaveProjectExportPresets(projectID, presets)
+}
+
+// GetCuts returns the named cuts of a project
+func (a *App) GetCuts(projectID int) ([]*projects.CutResponse, error) {
+	service := projects.NewProjectService(a.client, a.ctx)
+	return service.GetCuts(projectID)
+}
+
+// CreateCut adds an empty cut to a project
+func (a *App) CreateCut(projectID int, name string) (*projects.CutResponse, error) {
+	service := projects.NewProjectService(a.client, a.ctx)
+	return service.CreateCut(projectID, name)
+}
+
+// CloneCut copies a cut into a new cut
+func (a *App) CloneCut(projectID int, cutID int, name string) (*projects.CutResponse, error) {
+	service := projects.NewProjectService(a.client, a.ctx)
+	return service.CloneCut(projectID, cutID, name)
+}
+
+// SwitchCut makes a cut the one being edited
+func (a *App) SwitchCut(projectID int, cutID int) (*projects.CutResponse, error) {
+	service := projects.NewProjectService(a.client, a.ctx)
+	return service.SwitchCut(projectID, cutID)
+}
+
+// RenameCut changes the name of a cut
+func (a *App) RenameCut(projectID int, cutID int, name string) (*projects.CutResponse, error) {
+	service := projects.NewProjectService(a.client, a.ctx)
+	return service.RenameCut(projectID, cutID, name)
+}
+
+// DeleteCut removes a cut that is not active
+func (a *App) DeleteCut(projectID int, cutID int) error {
+	service := projects.NewProjectService(a.client, a.ctx)
+	return service.DeleteCut(projectID, cutID)
+}
+
+// FilterProjects returns the projects matching a filter in the requested order
+func (a *App) FilterProjects(filter projects.ProjectFilter) ([]*projects.ProjectSummary, error) {
+	service := projects.NewProjectService(a.client, a.ctx)
+	return service.FilterProjects(filter)
+}
+
+// FilterVideoClips returns the video clips matching a filter in the requested order
+func (a *App) FilterVideoClips(filter projects.VideoClipFilter) ([]*projects.VideoClipSummary, error) {
+	service := projects.NewProjectService(a.client, a.ctx)
+	return service.FilterVideoClips(filter)
+}
+
+// GetTags returns all tags
+func (a *App) GetTags() ([]*projects.TagResponse, error) {
+	service := projects.NewProjectService(a.client, a.ctx)
+	return service.GetTags()
+}
+
+// UpdateTag renames or recolors a tag
+func (a *App) UpdateTag(id int, name, color string) (*projects.TagResponse, error) {
+	service := projects.NewProjectService(a.client, a.ctx)
+	return service.UpdateTag(id, name, color)
+}
+
+// DeleteTag deletes a tag and removes it from every project and clip
+func (a *App) DeleteTag(id int) error {
+	service := projects.NewProjectService(a.client, a.ctx)
+	return service.DeleteTag(id)
+}
+
+// AddProjectTag tags a project, creating the tag if needed
+func (a *App) AddProjectTag(projectID int, tagName string) (*projects.TagResponse, error) {
+	service := projects.NewProjectService(a.client, a.ctx)
+	return service.AddProjectTag(projectID, tagName)
+}
+
+// RemoveProjectTag removes a tag from a project
+func (a *App) RemoveProjectTag(projectID, tagID int) error {
+	service := projects.NewProjectService(a.client, a.ctx)
+	return service.RemoveProjectTag(projectID, tagID)
+}
+
+// GetProjectTags returns the tags on a project
+func (a *App) GetProjectTags(projectID int) ([]*projects.TagResponse, error) {
+	service := projects.NewProjectService(a.client, a.ctx)
+	return service.GetProjectTags(projectID)
+}
+
+// AddVideoClipTag tags a video clip, creating the tag if needed
+func (a *App) AddVideoClipTag(clipID int, tagName string) (*projects.TagResponse, error) {
+	service := projects.NewProjectService(a.client, a.ctx)
+	return service.AddVideoClipTag(clipID, tagName)
+}
+
+// RemoveVideoClipTag removes a tag from a video clip
+func (a *App) RemoveVideoClipTag(clipID, tagID int) error {
+	service := projects.NewProjectService(a.client, a.ctx)
+	return service.RemoveVideoClipTag(clipID, tagID)
+}
+
+// GetVideoClipTags returns the tags on a video clip
+func (a *App) GetVideoClipTags(clipID int) ([]*projects.TagResponse, error) {
+	service := projects.NewProjectService(a.client, a.ctx)
+	return service.GetVideoClipTags(clipID)
+}
+
+// CreateCollection creates a new, empty collection
+func (a *App) CreateCollection(name, description string) (*projects.CollectionResponse, error) {
+	service := projects.NewProjectService(a.client, a.ctx)
+	return service.CreateCollection(name, description)
+}
+
+// GetCollections returns all collections with their project IDs
+func (a *App) GetCollections() ([]*projects.CollectionResponse, error) {
+	service := projects.NewProjectService(a.client, a.ctx)
+	return service.GetCollections()
+}
+
+// UpdateCollection renames a collection or changes its description
+func (a *App) UpdateCollection(id int, name, description string) (*projects.CollectionResponse, error) {
+	service := projects.NewProjectService(a.client, a.ctx)
+	return service.UpdateCollection(id, name, description)
+}
+
+// DeleteCollection deletes a collection without deleting its projects
+func (a *App) DeleteCollection(id int) error {
+	service := projects.NewProjectService(a.client, a.ctx)
+	return service.DeleteCollection(id)
+}
+
+// AddProjectToCollection adds a project to a collection
+func (a *App) AddProjectToCollection(collectionID, projectID int) (*projects.CollectionResponse, error) {
+	service := projects.NewProjectService(a.client, a.ctx)
+	return service.AddProjectToCollection(collectionID, projectID)
+}
+
+// RemoveProjectFromCollection removes a project from a collection
+func (a *App) RemoveProjectFromCollection(collectionID, projectID int) (*projects.CollectionResponse, error) {
+	service := projects.NewProjectService(a.client, a.ctx)
+	return service.RemoveProjectFromCollection(collectionID, projectID)
+}
+
 // CreateVideoClip creates a new video clip with file validation
 func (a *App) CreateVideoClip(projectID int, filePath string) (*projects.VideoClipResponse, error) {
 	service := projects.NewProjectService(a.client, a.ctx)
@@ -233,12 +554,42 @@ func (a *App) UpdateVideoClip(id int, name, description string) (*projects.Video
 	return service.UpdateVideoClip(id, name, description)
 }
 
-// DeleteVideoClip deletes a video clip
+// DeleteVideoClip moves a video clip to the trash
 func (a *App) DeleteVideoClip(id int) error {
+	service := projects.NewProjectService(a.client, a.ctx)
+	return service.TrashVideoClip(id)
+}
+
+// RestoreVideoClip brings a video clip back from the trash
+func (a *App) RestoreVideoClip(id int) error {
+	service := projects.NewProjectService(a.client, a.ctx)
+	return service.RestoreVideoClip(id)
+}
+
+// PermanentlyDeleteVideoClip deletes a video clip immediately
+func (a *App) PermanentlyDeleteVideoClip(id int) error {
 	service := projects.NewProjectService(a.client, a.ctx)
 	return service.DeleteVideoClip(id)
 }
 
+// GetTrash lists trashed projects and video clips
+func (a *App) GetTrash() (*projects.TrashResponse, error) {
+	service := projects.NewProjectService(a.client, a.ctx)
+	return service.GetTrash()
+}
+
+// EmptyTrash permanently deletes everything in the trash
+func (a *App) EmptyTrash() (int, error) {
+	service := projects.NewProjectService(a.client, a.ctx)
+	return service.EmptyTrash()
+}
+
+// PurgeExpiredTrash permanently deletes trashed items older than the retention setting
+func (a *App) PurgeExpiredTrash() (int, error) {
+	service := projects.NewProjectService(a.client, a.ctx)
+	return service.PurgeExpiredTrash()
+}
+
 // SelectVideoFiles opens a file dialog to select video files
 func (a *App) SelectVideoFiles() ([]*projects.LocalVideoFile, error) {
 	service := projects.NewProjectService(a.client, a.ctx)
@@ -310,6 +661,60 @@ func (a *App) DeleteOpenRouterApiKey() error {
 	return a.DeleteSetting("openrouter_api_key")
 }
 
+// SaveAnthropicApiKey saves the Anthropic API key securely
+func (a *App) SaveAnthropicApiKey(apiKey string) error {
+	return a.SaveSetting("anthropic_api_key", apiKey)
+}
+
+// GetAnthropicApiKey retrieves the Anthropic API key
+func (a *App) GetAnthropicApiKey() (string, error) {
+	return a.GetSetting("anthropic_api_key")
+}
+
+// DeleteAnthropicApiKey removes the Anthropic API key
+func (a *App) DeleteAnthropicApiKey() error {
+	return a.DeleteSetting("anthropic_api_key")
+}
+
+// SaveOpenAICompatibleApiKey saves the API key of the OpenAI-compatible endpoint (most local servers need none)
+func (a *App) SaveOpenAICompatibleApiKey(apiKey string) error {
+	return a.SaveSetting("openai_compatible_api_key", apiKey)
+}
+
+// GetOpenAICompatibleApiKey retrieves the API key of the OpenAI-compatible endpoint
+func (a *App) GetOpenAICompatibleApiKey() (string, error) {
+	return a.GetSetting("openai_compatible_api_key")
+}
+
+// DeleteOpenAICompatibleApiKey removes the API key of the OpenAI-compatible endpoint
+func (a *App) DeleteOpenAICompatibleApiKey() error {
+	return a.DeleteSetting("openai_compatible_api_key")
+}
+
+// GetAIProviderSettings returns the text provider selected for each AI task
+func (a *App) GetAIProviderSettings() (*ai.ProviderSettings, error) {
+	service := ai.NewProviderService(a.client, a.ctx)
+	return service.GetSettings()
+}
+
+// SaveAIProviderSettings saves the text provider selected for each AI task
+func (a *App) SaveAIProviderSettings(providerSettings ai.ProviderSettings) error {
+	service := ai.NewProviderService(a.client, a.ctx)
+	return service.SaveSettings(providerSettings)
+}
+
+// GetAIModels returns the known models with their context lengths and prices
+func (a *App) GetAIModels() (*ai.ModelCatalog, error) {
+	service := ai.NewModelService(a.client, a.ctx)
+	return service.GetModels()
+}
+
+// RefreshAIModels fetches the current model list from OpenRouter
+func (a *App) RefreshAIModels() (*ai.ModelCatalog, error) {
+	service := ai.NewModelService(a.client, a.ctx)
+	return service.RefreshModels()
+}
+
 // SaveThemePreference saves the user's preferred theme (light or dark)
 func (a *App) SaveThemePreference(theme string) error {
 	service := settings.NewSettingsService(a.client, a.ctx)
@@ -322,6 +727,18 @@ func (a *App) GetThemePreference() (string, error) {
 	return service.GetThemePreference()
 }
 
+// SaveTrashRetentionDays sets how many days trashed items are kept (0 keeps them forever)
+func (a *App) SaveTrashRetentionDays(days int) error {
+	service := settings.NewSettingsService(a.client, a.ctx)
+	return service.SaveTrashRetentionDays(days)
+}
+
+// GetTrashRetentionDays retrieves how many days trashed items are kept
+func (a *App) GetTrashRetentionDays() (int, error) {
+	service := settings.NewSettingsService(a.client, a.ctx)
+	return service.GetTrashRetentionDays()
+}
+
 // SaveUseRemoteAIBackend saves the remote AI backend toggle setting
 func (a *App) SaveUseRemoteAIBackend(useRemote bool) error {
 	value := "false"
@@ -1003,6 +1420,10 @@ type ProjectHighlight = highlights.ProjectHighlight
 type ProjectHighlightAISettings = highlights.ProjectHighlightAISettings
 type HighlightSuggestion = highlights.HighlightSuggestion
 type AIActionOptions = highlights.AIActionOptions
+type SuggestionOptions = highlights.SuggestionOptions
+type ProjectSuggestionOptions = highlights.ProjectSuggestionOptions
+type ClipSuggestions = highlights.ClipSuggestions
+type HighlightFilter = highlights.HighlightFilter
 
 // ProjectAISilenceResult represents AI silence improvement result for Wails compatibility
 type ProjectAISilenceResult struct {
@@ -1023,6 +1444,12 @@ func (a *App) GetProjectHighlights(projectID int) ([]ProjectHighlight, error) {
 	return service.GetProjectHighlights(projectID)
 }
 
+// GetProjectHighlightsFiltered returns the highlights of a project filtered and sorted by score or category
+func (a *App) GetProjectHighlightsFiltered(projectID int, filter HighlightFilter) ([]ProjectHighlight, error) {
+	service := highlights.NewHighlightService(a.client, a.ctx)
+	return service.GetProjectHighlightsFiltered(projectID, filter)
+}
+
 // UpdateProjectHighlightOrder updates the custom order of highlights for a project
 func (a *App) UpdateProjectHighlightOrder(projectID int, highlightOrder []string) error {
 	service := projects.NewProjectService(a.client, a.ctx)
@@ -1116,6 +1543,27 @@ func (a *App) ReorderHighlightsWithAIOptions(projectID int, customPrompt string,
 	return service.ReorderHighlightsWithAIOptions(projectID, customPrompt, options, getAPIKey, getProjectHighlights)
 }
 
+// SaveCutHighlightOrder replaces the highlight order of a cut, active or not
+func (a *App) SaveCutHighlightOrder(projectID int, cutID int, highlightOrder []interface{}) error {
+	service := highlights.NewHighlightService(a.client, a.ctx)
+	return service.SaveCutHighlightOrder(projectID, cutID, highlightOrder)
+}
+
+// ReorderCutHighlightsWithAIOptions reorders the highlights of a specific cut with AI and saves the result to that cut
+func (a *App) ReorderCutHighlightsWithAIOptions(projectID int, cutID int, customPrompt string, options highlights.AIActionOptions) ([]interface{}, error) {
+	service := highlights.NewAIService(a.client, a.ctx)
+
+	getAPIKey := func() (string, error) {
+		return a.GetOpenRouterApiKey()
+	}
+
+	getProjectHighlights := func(id int) ([]highlights.ProjectHighlight, error) {
+		return a.GetProjectHighlights(id)
+	}
+
+	return service.ReorderCutHighlightsWithAIOptions(projectID, cutID, customPrompt, options, getAPIKey, getProjectHighlights)
+}
+
 // Export-related type aliases
 type ExportProgress = exports.ExportProgress
 type HighlightSegment = highlights.HighlightSegment
@@ -1138,6 +1586,18 @@ func (a *App) ExportIndividualHighlights(projectID int, outputFolder string, pad
 	return service.ExportIndividualHighlights(projectID, outputFolder, paddingSeconds)
 }
 
+// ExportStitchedCut exports a cut's visible highlights, in order, as a single stitched video
+func (a *App) ExportStitchedCut(projectID int, cutID int, outputFolder string, paddingSeconds float64) (string, error) {
+	service := exports.NewExportService(a.client, a.ctx)
+	return service.ExportStitchedCut(projectID, cutID, outputFolder, paddingSeconds)
+}
+
+// ExportIndividualCut exports each visible highlight of a cut as a separate file
+func (a *App) ExportIndividualCut(projectID int, cutID int, outputFolder string, paddingSeconds float64) (string, error) {
+	service := exports.NewExportService(a.client, a.ctx)
+	return service.ExportIndividualCut(projectID, cutID, outputFolder, paddingSeconds)
+}
+
 // GetExportProgress returns the current progress of an export job
 func (a *App) GetExportProgress(jobID string) (*ExportProgress, error) {
 	service := exports.NewExportService(a.client, a.ctx)
@@ -1211,12 +1671,50 @@ func (a *App) SuggestHighlightsWithAI(projectID int, videoID int, customPrompt s
 	return nil, fmt.Errorf("highlight suggestions not yet supported with remote AI backend")
 }
 
+// SuggestHighlightsWithAIOptions generates AI-powered highlight suggestions for a video with windowing and ranking options
+func (a *App) SuggestHighlightsWithAIOptions(projectID int, videoID int, customPrompt string, options SuggestionOptions) ([]HighlightSuggestion, error) {
+	factory := ai.NewAIServiceFactory(a.client, a.ctx)
+	aiService, err := factory.CreateService()
+	if err != nil {
+		return nil, fmt.Errorf("failed to create AI service: %w", err)
+	}
+
+	if _, ok := aiService.(*ai.LocalAIService); !ok {
+		return nil, fmt.Errorf("highlight suggestions not yet supported with remote AI backend")
+	}
+
+	highlightService := highlights.NewAIService(a.client, a.ctx)
+	return highlightService.SuggestHighlightsWithAIOptions(projectID, videoID, customPrompt, options)
+}
+
+// SuggestProjectHighlightsWithAI generates AI-powered highlight suggestions across all clips of a project
+func (a *App) SuggestProjectHighlightsWithAI(projectID int, customPrompt string, options ProjectSuggestionOptions) ([]ClipSuggestions, error) {
+	factory := ai.NewAIServiceFactory(a.client, a.ctx)
+	aiService, err := factory.CreateService()
+	if err != nil {
+		return nil, fmt.Errorf("failed to create AI service: %w", err)
+	}
+
+	if _, ok := aiService.(*ai.LocalAIService); !ok {
+		return nil, fmt.Errorf("highlight suggestions not yet supported with remote AI backend")
+	}
+
+	highlightService := highlights.NewAIService(a.client, a.ctx)
+	return highlightService.SuggestProjectHighlightsWithAI(projectID, customPrompt, options)
+}
+
 // GetSuggestedHighlights retrieves saved suggested highlights for a video
 func (a *App) GetSuggestedHighlights(videoID int) ([]HighlightSuggestion, error) {
 	service := highlights.NewHighlightService(a.client, a.ctx)
 	return service.GetSuggestedHighlights(videoID)
 }
 
+// GetSuggestedHighlightsFiltered retrieves saved suggested highlights for a video filtered and sorted by score or category
+func (a *App) GetSuggestedHighlightsFiltered(videoID int, filter HighlightFilter) ([]HighlightSuggestion, error) {
+	service := highlights.NewHighlightService(a.client, a.ctx)
+	return service.GetSuggestedHighlightsFiltered(videoID, filter)
+}
+
 // ClearSuggestedHighlights removes all suggested highlights for a video
 func (a *App) ClearSuggestedHighlights(videoID int) error {
 	service := highlights.NewHighlightService(a.client, a.ctx)
@@ -1231,6 +1729,11 @@ func (a *App) DeleteSuggestedHighlight(videoID int, suggestionID string) error {
 
 // ImproveHighlightSilencesWithAI uses AI to suggest improved timings for highlights with natural silence buffers
 func (a *App) ImproveHighlightSilencesWithAI(projectID int) ([]ProjectHighlight, error) {
+	return a.ImproveHighlightSilencesWithAIOptions(projectID, prompts.Selection{})
+}
+
+// ImproveHighlightSilencesWithAIOptions improves highlight timings using a library prompt as additional instructions
+func (a *App) ImproveHighlightSilencesWithAIOptions(projectID int, selection prompts.Selection) ([]ProjectHighlight, error) {
 	factory := ai.NewAIServiceFactory(a.client, a.ctx)
 	aiService, err := factory.CreateService()
 	if err != nil {
@@ -1240,7 +1743,7 @@ func (a *App) ImproveHighlightSilencesWithAI(projectID int) ([]ProjectHighlight,
 	// For local service, delegate to highlights service
 	if _, ok := aiService.(*ai.LocalAIService); ok {
 		highlightService := highlights.NewAIService(a.client, a.ctx)
-		return highlightService.ImproveHighlightSilencesWithAI(projectID, a.GetOpenRouterApiKey)
+		return highlightService.ImproveHighlightSilencesWithAIOptions(projectID, selection, a.GetOpenRouterApiKey)
 	}
 	
 	// For remote service, we need to build the request manually
@@ -1327,6 +1830,131 @@ func (a *App) GetHighlightsHistoryStatus(clipID int) (*HistoryStatus, error) {
 	}, nil
 }
 
+// Search Methods
+
+// Type aliases for search types
+type SearchOptions = search.SearchOptions
+type SearchResponse = search.SearchResponse
+
+// SearchTranscripts searches clip transcripts across projects (or a single project when ProjectID is set).
+// Text mode supports quoted phrases; semantic mode uses embeddings through the configured AI service.
+func (a *App) SearchTranscripts(opts SearchOptions) (*SearchResponse, error) {
+	service := search.NewSearchService(a.client, a.ctx)
+	if opts.Mode != search.ModeSemantic {
+		return service.SearchTranscripts(opts)
+	}
+
+	factory := ai.NewAIServiceFactory(a.client, a.ctx)
+	aiService, err := factory.CreateService()
+	if err != nil {
+		return nil, fmt.Errorf("failed to create AI service: %w", err)
+	}
+	return service.SearchTranscriptsWithAIService(opts, aiService)
+}
+
+// RebuildSearchIndex rebuilds the in-memory transcript search index
+func (a *App) RebuildSearchIndex() error {
+	service := search.NewSearchService(a.client, a.ctx)
+	return service.RebuildIndex()
+}
+
+// BuildSemanticSearchIndex embeds transcripts for a project (0 for all projects) ahead of semantic searches
+func (a *App) BuildSemanticSearchIndex(projectID int) (int, error) {
+	factory := ai.NewAIServiceFactory(a.client, a.ctx)
+	aiService, err := factory.CreateService()
+	if err != nil {
+		return 0, fmt.Errorf("failed to create AI service: %w", err)
+	}
+
+	service := search.NewSearchService(a.client, a.ctx)
+	return service.BuildSemanticIndex(projectID, aiService)
+}
+
+// MCP Server Methods
+
+// GetMCPServerStatus returns the MCP HTTP server settings and whether it is running
+func (a *App) GetMCPServerStatus() (*mcp.Status, error) {
+	mcpSettings, err := mcp.GetSettings(a.client, a.ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	a.mcpMu.Lock()
+	defer a.mcpMu.Unlock()
+	status := &mcp.Status{Settings: *mcpSettings, Running: a.mcpHTTP != nil}
+	if a.mcpHTTP != nil {
+		status.URL = a.mcpHTTP.URL()
+	}
+	return status, nil
+}
+
+// SaveMCPServerSettings saves the MCP HTTP server settings and starts or stops the server to match
+func (a *App) SaveMCPServerSettings(mcpSettings mcp.Settings) (*mcp.Status, error) {
+	if err := mcp.SaveSettings(a.client, a.ctx, mcpSettings); err != nil {
+		return nil, err
+	}
+	return a.applyMCPSettings(), nil
+}
+
+// applyMCPSettings restarts the MCP HTTP server with the saved settings, or stops it when disabled
+func (a *App) applyMCPSettings() *mcp.Status {
+	a.stopMCPServer()
+
+	mcpSettings, err := mcp.GetSettings(a.client, a.ctx)
+	if err != nil {
+		return &mcp.Status{Error: err.Error()}
+	}
+	status := &mcp.Status{Settings: *mcpSettings}
+	if !mcpSettings.HTTPEnabled {
+		return status
+	}
+
+	server := mcp.NewServer(a.client, a.ctx, a.UpdateProjectHighlightOrderWithTitles)
+	httpServer, err := server.ListenHTTP(mcpSettings.HTTPPort)
+	if err != nil {
+		status.Error = err.Error()
+		return status
+	}
+
+	a.mcpMu.Lock()
+	a.mcpHTTP = httpServer
+	a.mcpMu.Unlock()
+
+	status.Running = true
+	status.URL = httpServer.URL()
+	return status
+}
+
+// stopMCPServer stops the MCP HTTP server if it is running
+func (a *App) stopMCPServer() {
+	a.mcpMu.Lock()
+	defer a.mcpMu.Unlock()
+	if a.mcpHTTP == nil {
+		return
+	}
+	if err := a.mcpHTTP.Close(); err != nil {
+		log.Printf("Failed to stop MCP server: %v", err)
+	}
+	a.mcpHTTP = nil
+}
+
+// serveMCPStdio runs the MCP server on stdin/stdout without the desktop UI
+func (a *App) serveMCPStdio() error {
+	a.ctx = context.Background()
+	defer a.client.Close()
+
+	if err := a.client.Schema.Create(a.ctx); err != nil {
+		return fmt.Errorf("failed creating schema resources: %w", err)
+	}
+
+	// Keep stdout for the protocol; stray prints from services go to stderr
+	protocolOut := os.Stdout
+	os.Stdout = os.Stderr
+
+	server := mcp.NewServer(a.client, a.ctx, a.UpdateProjectHighlightOrderWithTitles)
+	return server.ServeStdio(os.Stdin, protocolOut)
+}
+
 // Chatbot Methods
 
 // SendChatMessage sends a message to the AI chatbot and returns the response
@@ -1335,22 +1963,107 @@ func (a *App) SendChatMessage(request chatbot.ChatRequest) (*chatbot.ChatRespons
 	return service.SendMessage(request, a.GetOpenRouterApiKey)
 }
 
-// GetChatHistory retrieves the chat history for a project and endpoint
-func (a *App) GetChatHistory(projectID int, endpointID string) (*chatbot.ChatHistoryResponse, error) {
+// CancelChatMessage stops the reply being streamed for a chat session, keeping the text received so far.
+// An empty sessionID stops the replies of every session of the endpoint.
+func (a *App) CancelChatMessage(projectID int, endpointID string, sessionID string) bool {
 	service := chatbot.NewChatbotService(a.client, a.ctx, a.UpdateProjectHighlightOrderWithTitles)
-	return service.GetChatHistory(projectID, endpointID)
+	return service.CancelResponse(projectID, endpointID, sessionID)
 }
 
-// ClearChatHistory clears the chat history for a project and endpoint
-func (a *App) ClearChatHistory(projectID int, endpointID string) error {
+// GetChatHistory retrieves the chat history of a session, or of the endpoint's latest session when sessionID is empty
+func (a *App) GetChatHistory(projectID int, endpointID string, sessionID string) (*chatbot.ChatHistoryResponse, error) {
 	service := chatbot.NewChatbotService(a.client, a.ctx, a.UpdateProjectHighlightOrderWithTitles)
-	return service.ClearChatHistory(projectID, endpointID)
+	return service.GetChatHistory(projectID, endpointID, sessionID)
+}
+
+// ClearChatHistory clears the messages of a chat session, or of the endpoint's latest session when sessionID is empty
+func (a *App) ClearChatHistory(projectID int, endpointID string, sessionID string) error {
+	service := chatbot.NewChatbotService(a.client, a.ctx, a.UpdateProjectHighlightOrderWithTitles)
+	return service.ClearChatHistory(projectID, endpointID, sessionID)
 }
 
 // SaveChatModelSelection saves the selected model for a chat session
-func (a *App) SaveChatModelSelection(projectID int, endpointID string, model string) error {
+func (a *App) SaveChatModelSelection(projectID int, endpointID string, sessionID string, model string) error {
+	service := chatbot.NewChatbotService(a.client, a.ctx, a.UpdateProjectHighlightOrderWithTitles)
+	return service.SaveModelSelection(projectID, endpointID, sessionID, model)
+}
+
+// ListChatSessions lists the chat sessions of a project endpoint, most recently active first
+func (a *App) ListChatSessions(projectID int, endpointID string, includeArchived bool) ([]*chatbot.ChatSession, error) {
+	service := chatbot.NewChatbotService(a.client, a.ctx, a.UpdateProjectHighlightOrderWithTitles)
+	return service.ListSessions(projectID, endpointID, includeArchived)
+}
+
+// CreateChatSession starts a new chat session for a project endpoint
+func (a *App) CreateChatSession(projectID int, endpointID string, title string) (*chatbot.ChatSession, error) {
+	service := chatbot.NewChatbotService(a.client, a.ctx, a.UpdateProjectHighlightOrderWithTitles)
+	return service.CreateSession(projectID, endpointID, title)
+}
+
+// RenameChatSession sets the title of a chat session
+func (a *App) RenameChatSession(sessionID string, title string) (*chatbot.ChatSession, error) {
+	service := chatbot.NewChatbotService(a.client, a.ctx, a.UpdateProjectHighlightOrderWithTitles)
+	return service.RenameSession(sessionID, title)
+}
+
+// ForkChatSession starts a new chat session from a session's messages up to and including messageID
+func (a *App) ForkChatSession(sessionID string, messageID string) (*chatbot.ChatSession, error) {
+	service := chatbot.NewChatbotService(a.client, a.ctx, a.UpdateProjectHighlightOrderWithTitles)
+	return service.ForkSession(sessionID, messageID)
+}
+
+// ArchiveChatSession archives or restores a chat session
+func (a *App) ArchiveChatSession(sessionID string, archived bool) (*chatbot.ChatSession, error) {
+	service := chatbot.NewChatbotService(a.client, a.ctx, a.UpdateProjectHighlightOrderWithTitles)
+	return service.ArchiveSession(sessionID, archived)
+}
+
+// GetChatProposals lists the highlight order proposals of a chat session
+func (a *App) GetChatProposals(projectID int, endpointID string, sessionID string, pendingOnly bool) ([]*chatbot.OrderProposal, error) {
+	service := chatbot.NewChatbotService(a.client, a.ctx, a.UpdateProjectHighlightOrderWithTitles)
+	return service.ListProposals(projectID, endpointID, sessionID, pendingOnly)
+}
+
+// ApplyChatProposal applies a pending highlight order proposal
+func (a *App) ApplyChatProposal(proposalID string) (*chatbot.OrderProposal, error) {
+	service := chatbot.NewChatbotService(a.client, a.ctx, a.UpdateProjectHighlightOrderWithTitles)
+	return service.ApplyProposal(proposalID)
+}
+
+// RejectChatProposal discards a pending highlight order proposal
+func (a *App) RejectChatProposal(proposalID string) (*chatbot.OrderProposal, error) {
+	service := chatbot.NewChatbotService(a.client, a.ctx, a.UpdateProjectHighlightOrderWithTitles)
+	return service.RejectProposal(proposalID)
+}
+
+// ConfirmChatExport starts an export the assistant prepared, after the user confirmed its plan
+func (a *App) ConfirmChatExport(confirmationID string) (string, error) {
+	service := chatbot.NewChatbotService(a.client, a.ctx, a.UpdateProjectHighlightOrderWithTitles)
+	return service.ConfirmExport(confirmationID)
+}
+
+// ExportChatSession renders a chat session as a "markdown" or "json" transcript
+func (a *App) ExportChatSession(sessionID string, format string) (string, error) {
+	service := chatbot.NewChatbotService(a.client, a.ctx, a.UpdateProjectHighlightOrderWithTitles)
+	return service.ExportSession(sessionID, format)
+}
+
+// ImportChatSession creates a chat session in a project from a JSON transcript
+func (a *App) ImportChatSession(projectID int, transcript string) (*chatbot.ChatSession, error) {
+	service := chatbot.NewChatbotService(a.client, a.ctx, a.UpdateProjectHighlightOrderWithTitles)
+	return service.ImportSession(projectID, transcript)
+}
+
+// GetChatCustomTools returns the user-defined chat tools
+func (a *App) GetChatCustomTools() ([]chatbot.CustomTool, error) {
+	service := chatbot.NewChatbotService(a.client, a.ctx, a.UpdateProjectHighlightOrderWithTitles)
+	return service.GetCustomTools()
+}
+
+// SaveChatCustomTools validates and saves the user-defined chat tools
+func (a *App) SaveChatCustomTools(tools []chatbot.CustomTool) error {
 	service := chatbot.NewChatbotService(a.client, a.ctx, a.UpdateProjectHighlightOrderWithTitles)
-	return service.SaveModelSelection(projectID, endpointID, model)
+	return service.SaveCustomTools(tools)
 }
 
 // GetAppVersion returns the current application version information
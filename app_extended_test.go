@@ -131,6 +131,28 @@ func TestAppProjectMethods(t *testing.T) {
 		assert.Error(t, err)
 	})
 
+	t.Run("DeleteProject_RunningExport", func(t *testing.T) {
+		created, err := app.CreateProject("Exporting Project", "Has a running export")
+		require.NoError(t, err)
+		job, err := app.client.ExportJob.
+			Create().
+			SetJobID("running_export").
+			SetExportType("stitched").
+			SetOutputPath("/test/path").
+			SetStage("processing").
+			SetProjectID(created.ID).
+			Save(app.ctx)
+		require.NoError(t, err)
+
+		err = app.DeleteProject(created.ID)
+		assert.ErrorContains(t, err, "running_export")
+		_, err = app.GetProjectByID(created.ID)
+		assert.NoError(t, err, "the project stays out of the trash")
+
+		require.NoError(t, job.Update().SetIsComplete(true).Exec(app.ctx))
+		assert.NoError(t, app.DeleteProject(created.ID))
+	})
+
 	t.Run("DeleteProject_NotFound", func(t *testing.T) {
 		err := app.DeleteProject(99999)
 		assert.Error(t, err)
@@ -3,9 +3,11 @@
 package ent
 
 import (
+	"encoding/json"
+	"fmt"
 	"ramble-ai/ent/chatmessage"
 	"ramble-ai/ent/chatsession"
-	"fmt"
+	"ramble-ai/ent/schema"
 	"strings"
 	"time"
 
@@ -32,6 +34,10 @@ type ChatMessage struct {
 	Timestamp time.Time `json:"timestamp,omitempty"`
 	// LLM model used for assistant messages
 	Model string `json:"model,omitempty"`
+	// Functions called for an assistant message and their results
+	FunctionResults []schema.ChatFunctionResult `json:"function_results,omitempty"`
+	// Project highlight order after an assistant message's actions
+	OrderSnapshot []interface{} `json:"order_snapshot,omitempty"`
 	// Edges holds the relations/edges for other nodes in the graph.
 	// The values are being populated by the ChatMessageQuery when eager-loading is set.
 	Edges        ChatMessageEdges `json:"edges"`
@@ -63,6 +69,8 @@ func (*ChatMessage) scanValues(columns []string) ([]any, error) {
 	values := make([]any, len(columns))
 	for i := range columns {
 		switch columns[i] {
+		case chatmessage.FieldFunctionResults, chatmessage.FieldOrderSnapshot:
+			values[i] = new([]byte)
 		case chatmessage.FieldID, chatmessage.FieldSessionID:
 			values[i] = new(sql.NullInt64)
 		case chatmessage.FieldMessageID, chatmessage.FieldRole, chatmessage.FieldContent, chatmessage.FieldHiddenContext, chatmessage.FieldModel:
@@ -132,6 +140,22 @@ func (cm *ChatMessage) assignValues(columns []string, values []any) error {
 			} else if value.Valid {
 				cm.Model = value.String
 			}
+		case chatmessage.FieldFunctionResults:
+			if value, ok := values[i].(*[]byte); !ok {
+				return fmt.Errorf("unexpected type %T for field function_results", values[i])
+			} else if value != nil && len(*value) > 0 {
+				if err := json.Unmarshal(*value, &cm.FunctionResults); err != nil {
+					return fmt.Errorf("unmarshal field function_results: %w", err)
+				}
+			}
+		case chatmessage.FieldOrderSnapshot:
+			if value, ok := values[i].(*[]byte); !ok {
+				return fmt.Errorf("unexpected type %T for field order_snapshot", values[i])
+			} else if value != nil && len(*value) > 0 {
+				if err := json.Unmarshal(*value, &cm.OrderSnapshot); err != nil {
+					return fmt.Errorf("unmarshal field order_snapshot: %w", err)
+				}
+			}
 		default:
 			cm.selectValues.Set(columns[i], values[i])
 		}
@@ -193,6 +217,12 @@ func (cm *ChatMessage) String() string {
 	builder.WriteString(", ")
 	builder.WriteString("model=")
 	builder.WriteString(cm.Model)
+	builder.WriteString(", ")
+	builder.WriteString("function_results=")
+	builder.WriteString(fmt.Sprintf("%v", cm.FunctionResults))
+	builder.WriteString(", ")
+	builder.WriteString("order_snapshot=")
+	builder.WriteString(fmt.Sprintf("%v", cm.OrderSnapshot))
 	builder.WriteByte(')')
 	return builder.String()
 }
@@ -29,6 +29,10 @@ const (
 	FieldTimestamp = "timestamp"
 	// FieldModel holds the string denoting the model field in the database.
 	FieldModel = "model"
+	// FieldFunctionResults holds the string denoting the function_results field in the database.
+	FieldFunctionResults = "function_results"
+	// FieldOrderSnapshot holds the string denoting the order_snapshot field in the database.
+	FieldOrderSnapshot = "order_snapshot"
 	// EdgeSession holds the string denoting the session edge name in mutations.
 	EdgeSession = "session"
 	// Table holds the table name of the chatmessage in the database.
@@ -52,6 +56,8 @@ var Columns = []string{
 	FieldHiddenContext,
 	FieldTimestamp,
 	FieldModel,
+	FieldFunctionResults,
+	FieldOrderSnapshot,
 }
 
 // ValidColumn reports if the column name is valid (part of the table columns).
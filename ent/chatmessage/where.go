@@ -445,6 +445,26 @@ func ModelContainsFold(v string) predicate.ChatMessage {
 	return predicate.ChatMessage(sql.FieldContainsFold(FieldModel, v))
 }
 
+// FunctionResultsIsNil applies the IsNil predicate on the "function_results" field.
+func FunctionResultsIsNil() predicate.ChatMessage {
+	return predicate.ChatMessage(sql.FieldIsNull(FieldFunctionResults))
+}
+
+// FunctionResultsNotNil applies the NotNil predicate on the "function_results" field.
+func FunctionResultsNotNil() predicate.ChatMessage {
+	return predicate.ChatMessage(sql.FieldNotNull(FieldFunctionResults))
+}
+
+// OrderSnapshotIsNil applies the IsNil predicate on the "order_snapshot" field.
+func OrderSnapshotIsNil() predicate.ChatMessage {
+	return predicate.ChatMessage(sql.FieldIsNull(FieldOrderSnapshot))
+}
+
+// OrderSnapshotNotNil applies the NotNil predicate on the "order_snapshot" field.
+func OrderSnapshotNotNil() predicate.ChatMessage {
+	return predicate.ChatMessage(sql.FieldNotNull(FieldOrderSnapshot))
+}
+
 // HasSession applies the HasEdge predicate on the "session" edge.
 func HasSession() predicate.ChatMessage {
 	return predicate.ChatMessage(func(s *sql.Selector) {
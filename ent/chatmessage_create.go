@@ -3,11 +3,12 @@
 package ent
 
 import (
-	"ramble-ai/ent/chatmessage"
-	"ramble-ai/ent/chatsession"
 	"context"
 	"errors"
 	"fmt"
+	"ramble-ai/ent/chatmessage"
+	"ramble-ai/ent/chatsession"
+	"ramble-ai/ent/schema"
 	"time"
 
 	"entgo.io/ent/dialect/sql/sqlgraph"
@@ -87,6 +88,18 @@ func (cmc *ChatMessageCreate) SetNillableModel(s *string) *ChatMessageCreate {
 	return cmc
 }
 
+// SetFunctionResults sets the "function_results" field.
+func (cmc *ChatMessageCreate) SetFunctionResults(sfr []schema.ChatFunctionResult) *ChatMessageCreate {
+	cmc.mutation.SetFunctionResults(sfr)
+	return cmc
+}
+
+// SetOrderSnapshot sets the "order_snapshot" field.
+func (cmc *ChatMessageCreate) SetOrderSnapshot(i []interface{}) *ChatMessageCreate {
+	cmc.mutation.SetOrderSnapshot(i)
+	return cmc
+}
+
 // SetSession sets the "session" edge to the ChatSession entity.
 func (cmc *ChatMessageCreate) SetSession(c *ChatSession) *ChatMessageCreate {
 	return cmc.SetSessionID(c.ID)
@@ -218,6 +231,14 @@ func (cmc *ChatMessageCreate) createSpec() (*ChatMessage, *sqlgraph.CreateSpec)
 		_spec.SetField(chatmessage.FieldModel, field.TypeString, value)
 		_node.Model = value
 	}
+	if value, ok := cmc.mutation.FunctionResults(); ok {
+		_spec.SetField(chatmessage.FieldFunctionResults, field.TypeJSON, value)
+		_node.FunctionResults = value
+	}
+	if value, ok := cmc.mutation.OrderSnapshot(); ok {
+		_spec.SetField(chatmessage.FieldOrderSnapshot, field.TypeJSON, value)
+		_node.OrderSnapshot = value
+	}
 	if nodes := cmc.mutation.SessionIDs(); len(nodes) > 0 {
 		edge := &sqlgraph.EdgeSpec{
 			Rel:     sqlgraph.M2O,
@@ -3,16 +3,18 @@
 package ent
 
 import (
-	"ramble-ai/ent/chatmessage"
-	"ramble-ai/ent/chatsession"
-	"ramble-ai/ent/predicate"
 	"context"
 	"errors"
 	"fmt"
+	"ramble-ai/ent/chatmessage"
+	"ramble-ai/ent/chatsession"
+	"ramble-ai/ent/predicate"
+	"ramble-ai/ent/schema"
 	"time"
 
 	"entgo.io/ent/dialect/sql"
 	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/dialect/sql/sqljson"
 	"entgo.io/ent/schema/field"
 )
 
@@ -139,6 +141,42 @@ func (cmu *ChatMessageUpdate) ClearModel() *ChatMessageUpdate {
 	return cmu
 }
 
+// SetFunctionResults sets the "function_results" field.
+func (cmu *ChatMessageUpdate) SetFunctionResults(sfr []schema.ChatFunctionResult) *ChatMessageUpdate {
+	cmu.mutation.SetFunctionResults(sfr)
+	return cmu
+}
+
+// AppendFunctionResults appends sfr to the "function_results" field.
+func (cmu *ChatMessageUpdate) AppendFunctionResults(sfr []schema.ChatFunctionResult) *ChatMessageUpdate {
+	cmu.mutation.AppendFunctionResults(sfr)
+	return cmu
+}
+
+// ClearFunctionResults clears the value of the "function_results" field.
+func (cmu *ChatMessageUpdate) ClearFunctionResults() *ChatMessageUpdate {
+	cmu.mutation.ClearFunctionResults()
+	return cmu
+}
+
+// SetOrderSnapshot sets the "order_snapshot" field.
+func (cmu *ChatMessageUpdate) SetOrderSnapshot(i []interface{}) *ChatMessageUpdate {
+	cmu.mutation.SetOrderSnapshot(i)
+	return cmu
+}
+
+// AppendOrderSnapshot appends i to the "order_snapshot" field.
+func (cmu *ChatMessageUpdate) AppendOrderSnapshot(i []interface{}) *ChatMessageUpdate {
+	cmu.mutation.AppendOrderSnapshot(i)
+	return cmu
+}
+
+// ClearOrderSnapshot clears the value of the "order_snapshot" field.
+func (cmu *ChatMessageUpdate) ClearOrderSnapshot() *ChatMessageUpdate {
+	cmu.mutation.ClearOrderSnapshot()
+	return cmu
+}
+
 // SetSession sets the "session" edge to the ChatSession entity.
 func (cmu *ChatMessageUpdate) SetSession(c *ChatSession) *ChatMessageUpdate {
 	return cmu.SetSessionID(c.ID)
@@ -241,6 +279,28 @@ func (cmu *ChatMessageUpdate) sqlSave(ctx context.Context) (n int, err error) {
 	if cmu.mutation.ModelCleared() {
 		_spec.ClearField(chatmessage.FieldModel, field.TypeString)
 	}
+	if value, ok := cmu.mutation.FunctionResults(); ok {
+		_spec.SetField(chatmessage.FieldFunctionResults, field.TypeJSON, value)
+	}
+	if value, ok := cmu.mutation.AppendedFunctionResults(); ok {
+		_spec.AddModifier(func(u *sql.UpdateBuilder) {
+			sqljson.Append(u, chatmessage.FieldFunctionResults, value)
+		})
+	}
+	if cmu.mutation.FunctionResultsCleared() {
+		_spec.ClearField(chatmessage.FieldFunctionResults, field.TypeJSON)
+	}
+	if value, ok := cmu.mutation.OrderSnapshot(); ok {
+		_spec.SetField(chatmessage.FieldOrderSnapshot, field.TypeJSON, value)
+	}
+	if value, ok := cmu.mutation.AppendedOrderSnapshot(); ok {
+		_spec.AddModifier(func(u *sql.UpdateBuilder) {
+			sqljson.Append(u, chatmessage.FieldOrderSnapshot, value)
+		})
+	}
+	if cmu.mutation.OrderSnapshotCleared() {
+		_spec.ClearField(chatmessage.FieldOrderSnapshot, field.TypeJSON)
+	}
 	if cmu.mutation.SessionCleared() {
 		edge := &sqlgraph.EdgeSpec{
 			Rel:     sqlgraph.M2O,
@@ -400,6 +460,42 @@ func (cmuo *ChatMessageUpdateOne) ClearModel() *ChatMessageUpdateOne {
 	return cmuo
 }
 
+// SetFunctionResults sets the "function_results" field.
+func (cmuo *ChatMessageUpdateOne) SetFunctionResults(sfr []schema.ChatFunctionResult) *ChatMessageUpdateOne {
+	cmuo.mutation.SetFunctionResults(sfr)
+	return cmuo
+}
+
+// AppendFunctionResults appends sfr to the "function_results" field.
+func (cmuo *ChatMessageUpdateOne) AppendFunctionResults(sfr []schema.ChatFunctionResult) *ChatMessageUpdateOne {
+	cmuo.mutation.AppendFunctionResults(sfr)
+	return cmuo
+}
+
+// ClearFunctionResults clears the value of the "function_results" field.
+func (cmuo *ChatMessageUpdateOne) ClearFunctionResults() *ChatMessageUpdateOne {
+	cmuo.mutation.ClearFunctionResults()
+	return cmuo
+}
+
+// SetOrderSnapshot sets the "order_snapshot" field.
+func (cmuo *ChatMessageUpdateOne) SetOrderSnapshot(i []interface{}) *ChatMessageUpdateOne {
+	cmuo.mutation.SetOrderSnapshot(i)
+	return cmuo
+}
+
+// AppendOrderSnapshot appends i to the "order_snapshot" field.
+func (cmuo *ChatMessageUpdateOne) AppendOrderSnapshot(i []interface{}) *ChatMessageUpdateOne {
+	cmuo.mutation.AppendOrderSnapshot(i)
+	return cmuo
+}
+
+// ClearOrderSnapshot clears the value of the "order_snapshot" field.
+func (cmuo *ChatMessageUpdateOne) ClearOrderSnapshot() *ChatMessageUpdateOne {
+	cmuo.mutation.ClearOrderSnapshot()
+	return cmuo
+}
+
 // SetSession sets the "session" edge to the ChatSession entity.
 func (cmuo *ChatMessageUpdateOne) SetSession(c *ChatSession) *ChatMessageUpdateOne {
 	return cmuo.SetSessionID(c.ID)
@@ -532,6 +628,28 @@ func (cmuo *ChatMessageUpdateOne) sqlSave(ctx context.Context) (_node *ChatMessa
 	if cmuo.mutation.ModelCleared() {
 		_spec.ClearField(chatmessage.FieldModel, field.TypeString)
 	}
+	if value, ok := cmuo.mutation.FunctionResults(); ok {
+		_spec.SetField(chatmessage.FieldFunctionResults, field.TypeJSON, value)
+	}
+	if value, ok := cmuo.mutation.AppendedFunctionResults(); ok {
+		_spec.AddModifier(func(u *sql.UpdateBuilder) {
+			sqljson.Append(u, chatmessage.FieldFunctionResults, value)
+		})
+	}
+	if cmuo.mutation.FunctionResultsCleared() {
+		_spec.ClearField(chatmessage.FieldFunctionResults, field.TypeJSON)
+	}
+	if value, ok := cmuo.mutation.OrderSnapshot(); ok {
+		_spec.SetField(chatmessage.FieldOrderSnapshot, field.TypeJSON, value)
+	}
+	if value, ok := cmuo.mutation.AppendedOrderSnapshot(); ok {
+		_spec.AddModifier(func(u *sql.UpdateBuilder) {
+			sqljson.Append(u, chatmessage.FieldOrderSnapshot, value)
+		})
+	}
+	if cmuo.mutation.OrderSnapshotCleared() {
+		_spec.ClearField(chatmessage.FieldOrderSnapshot, field.TypeJSON)
+	}
 	if cmuo.mutation.SessionCleared() {
 		edge := &sqlgraph.EdgeSpec{
 			Rel:     sqlgraph.M2O,
@@ -3,9 +3,9 @@
 package ent
 
 import (
+	"fmt"
 	"ramble-ai/ent/chatsession"
 	"ramble-ai/ent/project"
-	"fmt"
 	"strings"
 	"time"
 
@@ -30,6 +30,10 @@ type ChatSession struct {
 	UpdatedAt time.Time `json:"updated_at,omitempty"`
 	// The AI model selected for this chat session
 	SelectedModel string `json:"selected_model,omitempty"`
+	// User-facing session title
+	Title string `json:"title,omitempty"`
+	// Whether the session is hidden from the session list
+	Archived bool `json:"archived,omitempty"`
 	// Edges holds the relations/edges for other nodes in the graph.
 	// The values are being populated by the ChatSessionQuery when eager-loading is set.
 	Edges        ChatSessionEdges `json:"edges"`
@@ -72,9 +76,11 @@ func (*ChatSession) scanValues(columns []string) ([]any, error) {
 	values := make([]any, len(columns))
 	for i := range columns {
 		switch columns[i] {
+		case chatsession.FieldArchived:
+			values[i] = new(sql.NullBool)
 		case chatsession.FieldID, chatsession.FieldProjectID:
 			values[i] = new(sql.NullInt64)
-		case chatsession.FieldSessionID, chatsession.FieldEndpointID, chatsession.FieldSelectedModel:
+		case chatsession.FieldSessionID, chatsession.FieldEndpointID, chatsession.FieldSelectedModel, chatsession.FieldTitle:
 			values[i] = new(sql.NullString)
 		case chatsession.FieldCreatedAt, chatsession.FieldUpdatedAt:
 			values[i] = new(sql.NullTime)
@@ -135,6 +141,18 @@ func (cs *ChatSession) assignValues(columns []string, values []any) error {
 			} else if value.Valid {
 				cs.SelectedModel = value.String
 			}
+		case chatsession.FieldTitle:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field title", values[i])
+			} else if value.Valid {
+				cs.Title = value.String
+			}
+		case chatsession.FieldArchived:
+			if value, ok := values[i].(*sql.NullBool); !ok {
+				return fmt.Errorf("unexpected type %T for field archived", values[i])
+			} else if value.Valid {
+				cs.Archived = value.Bool
+			}
 		default:
 			cs.selectValues.Set(columns[i], values[i])
 		}
@@ -198,6 +216,12 @@ func (cs *ChatSession) String() string {
 	builder.WriteString(", ")
 	builder.WriteString("selected_model=")
 	builder.WriteString(cs.SelectedModel)
+	builder.WriteString(", ")
+	builder.WriteString("title=")
+	builder.WriteString(cs.Title)
+	builder.WriteString(", ")
+	builder.WriteString("archived=")
+	builder.WriteString(fmt.Sprintf("%v", cs.Archived))
 	builder.WriteByte(')')
 	return builder.String()
 }
@@ -3,12 +3,12 @@
 package ent
 
 import (
-	"ramble-ai/ent/chatmessage"
-	"ramble-ai/ent/chatsession"
-	"ramble-ai/ent/project"
 	"context"
 	"errors"
 	"fmt"
+	"ramble-ai/ent/chatmessage"
+	"ramble-ai/ent/chatsession"
+	"ramble-ai/ent/project"
 	"time"
 
 	"entgo.io/ent/dialect/sql/sqlgraph"
@@ -82,6 +82,34 @@ func (csc *ChatSessionCreate) SetNillableSelectedModel(s *string) *ChatSessionCr
 	return csc
 }
 
+// SetTitle sets the "title" field.
+func (csc *ChatSessionCreate) SetTitle(s string) *ChatSessionCreate {
+	csc.mutation.SetTitle(s)
+	return csc
+}
+
+// SetNillableTitle sets the "title" field if the given value is not nil.
+func (csc *ChatSessionCreate) SetNillableTitle(s *string) *ChatSessionCreate {
+	if s != nil {
+		csc.SetTitle(*s)
+	}
+	return csc
+}
+
+// SetArchived sets the "archived" field.
+func (csc *ChatSessionCreate) SetArchived(b bool) *ChatSessionCreate {
+	csc.mutation.SetArchived(b)
+	return csc
+}
+
+// SetNillableArchived sets the "archived" field if the given value is not nil.
+func (csc *ChatSessionCreate) SetNillableArchived(b *bool) *ChatSessionCreate {
+	if b != nil {
+		csc.SetArchived(*b)
+	}
+	return csc
+}
+
 // SetProject sets the "project" edge to the Project entity.
 func (csc *ChatSessionCreate) SetProject(p *Project) *ChatSessionCreate {
 	return csc.SetProjectID(p.ID)
@@ -145,6 +173,10 @@ func (csc *ChatSessionCreate) defaults() {
 		v := chatsession.DefaultUpdatedAt()
 		csc.mutation.SetUpdatedAt(v)
 	}
+	if _, ok := csc.mutation.Archived(); !ok {
+		v := chatsession.DefaultArchived
+		csc.mutation.SetArchived(v)
+	}
 }
 
 // check runs all checks and user-defined validators on the builder.
@@ -174,6 +206,9 @@ func (csc *ChatSessionCreate) check() error {
 	if _, ok := csc.mutation.UpdatedAt(); !ok {
 		return &ValidationError{Name: "updated_at", err: errors.New(`ent: missing required field "ChatSession.updated_at"`)}
 	}
+	if _, ok := csc.mutation.Archived(); !ok {
+		return &ValidationError{Name: "archived", err: errors.New(`ent: missing required field "ChatSession.archived"`)}
+	}
 	if len(csc.mutation.ProjectIDs()) == 0 {
 		return &ValidationError{Name: "project", err: errors.New(`ent: missing required edge "ChatSession.project"`)}
 	}
@@ -223,6 +258,14 @@ func (csc *ChatSessionCreate) createSpec() (*ChatSession, *sqlgraph.CreateSpec)
 		_spec.SetField(chatsession.FieldSelectedModel, field.TypeString, value)
 		_node.SelectedModel = value
 	}
+	if value, ok := csc.mutation.Title(); ok {
+		_spec.SetField(chatsession.FieldTitle, field.TypeString, value)
+		_node.Title = value
+	}
+	if value, ok := csc.mutation.Archived(); ok {
+		_spec.SetField(chatsession.FieldArchived, field.TypeBool, value)
+		_node.Archived = value
+	}
 	if nodes := csc.mutation.ProjectIDs(); len(nodes) > 0 {
 		edge := &sqlgraph.EdgeSpec{
 			Rel:     sqlgraph.M2O,
@@ -3,14 +3,14 @@
 package ent
 
 import (
-	"ramble-ai/ent/chatmessage"
-	"ramble-ai/ent/chatsession"
-	"ramble-ai/ent/predicate"
-	"ramble-ai/ent/project"
 	"context"
 	"database/sql/driver"
 	"fmt"
 	"math"
+	"ramble-ai/ent/chatmessage"
+	"ramble-ai/ent/chatsession"
+	"ramble-ai/ent/predicate"
+	"ramble-ai/ent/project"
 
 	"entgo.io/ent"
 	"entgo.io/ent/dialect/sql"
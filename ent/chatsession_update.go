@@ -3,13 +3,13 @@
 package ent
 
 import (
+	"context"
+	"errors"
+	"fmt"
 	"ramble-ai/ent/chatmessage"
 	"ramble-ai/ent/chatsession"
 	"ramble-ai/ent/predicate"
 	"ramble-ai/ent/project"
-	"context"
-	"errors"
-	"fmt"
 	"time"
 
 	"entgo.io/ent/dialect/sql"
@@ -112,6 +112,40 @@ func (csu *ChatSessionUpdate) ClearSelectedModel() *ChatSessionUpdate {
 	return csu
 }
 
+// SetTitle sets the "title" field.
+func (csu *ChatSessionUpdate) SetTitle(s string) *ChatSessionUpdate {
+	csu.mutation.SetTitle(s)
+	return csu
+}
+
+// SetNillableTitle sets the "title" field if the given value is not nil.
+func (csu *ChatSessionUpdate) SetNillableTitle(s *string) *ChatSessionUpdate {
+	if s != nil {
+		csu.SetTitle(*s)
+	}
+	return csu
+}
+
+// ClearTitle clears the value of the "title" field.
+func (csu *ChatSessionUpdate) ClearTitle() *ChatSessionUpdate {
+	csu.mutation.ClearTitle()
+	return csu
+}
+
+// SetArchived sets the "archived" field.
+func (csu *ChatSessionUpdate) SetArchived(b bool) *ChatSessionUpdate {
+	csu.mutation.SetArchived(b)
+	return csu
+}
+
+// SetNillableArchived sets the "archived" field if the given value is not nil.
+func (csu *ChatSessionUpdate) SetNillableArchived(b *bool) *ChatSessionUpdate {
+	if b != nil {
+		csu.SetArchived(*b)
+	}
+	return csu
+}
+
 // SetProject sets the "project" edge to the Project entity.
 func (csu *ChatSessionUpdate) SetProject(p *Project) *ChatSessionUpdate {
 	return csu.SetProjectID(p.ID)
@@ -248,6 +282,15 @@ func (csu *ChatSessionUpdate) sqlSave(ctx context.Context) (n int, err error) {
 	if csu.mutation.SelectedModelCleared() {
 		_spec.ClearField(chatsession.FieldSelectedModel, field.TypeString)
 	}
+	if value, ok := csu.mutation.Title(); ok {
+		_spec.SetField(chatsession.FieldTitle, field.TypeString, value)
+	}
+	if csu.mutation.TitleCleared() {
+		_spec.ClearField(chatsession.FieldTitle, field.TypeString)
+	}
+	if value, ok := csu.mutation.Archived(); ok {
+		_spec.SetField(chatsession.FieldArchived, field.TypeBool, value)
+	}
 	if csu.mutation.ProjectCleared() {
 		edge := &sqlgraph.EdgeSpec{
 			Rel:     sqlgraph.M2O,
@@ -424,6 +467,40 @@ func (csuo *ChatSessionUpdateOne) ClearSelectedModel() *ChatSessionUpdateOne {
 	return csuo
 }
 
+// SetTitle sets the "title" field.
+func (csuo *ChatSessionUpdateOne) SetTitle(s string) *ChatSessionUpdateOne {
+	csuo.mutation.SetTitle(s)
+	return csuo
+}
+
+// SetNillableTitle sets the "title" field if the given value is not nil.
+func (csuo *ChatSessionUpdateOne) SetNillableTitle(s *string) *ChatSessionUpdateOne {
+	if s != nil {
+		csuo.SetTitle(*s)
+	}
+	return csuo
+}
+
+// ClearTitle clears the value of the "title" field.
+func (csuo *ChatSessionUpdateOne) ClearTitle() *ChatSessionUpdateOne {
+	csuo.mutation.ClearTitle()
+	return csuo
+}
+
+// SetArchived sets the "archived" field.
+func (csuo *ChatSessionUpdateOne) SetArchived(b bool) *ChatSessionUpdateOne {
+	csuo.mutation.SetArchived(b)
+	return csuo
+}
+
+// SetNillableArchived sets the "archived" field if the given value is not nil.
+func (csuo *ChatSessionUpdateOne) SetNillableArchived(b *bool) *ChatSessionUpdateOne {
+	if b != nil {
+		csuo.SetArchived(*b)
+	}
+	return csuo
+}
+
 // SetProject sets the "project" edge to the Project entity.
 func (csuo *ChatSessionUpdateOne) SetProject(p *Project) *ChatSessionUpdateOne {
 	return csuo.SetProjectID(p.ID)
@@ -590,6 +667,15 @@ func (csuo *ChatSessionUpdateOne) sqlSave(ctx context.Context) (_node *ChatSessi
 	if csuo.mutation.SelectedModelCleared() {
 		_spec.ClearField(chatsession.FieldSelectedModel, field.TypeString)
 	}
+	if value, ok := csuo.mutation.Title(); ok {
+		_spec.SetField(chatsession.FieldTitle, field.TypeString, value)
+	}
+	if csuo.mutation.TitleCleared() {
+		_spec.ClearField(chatsession.FieldTitle, field.TypeString)
+	}
+	if value, ok := csuo.mutation.Archived(); ok {
+		_spec.SetField(chatsession.FieldArchived, field.TypeBool, value)
+	}
 	if csuo.mutation.ProjectCleared() {
 		edge := &sqlgraph.EdgeSpec{
 			Rel:     sqlgraph.M2O,
@@ -13,9 +13,17 @@ import (
 
 	"ramble-ai/ent/chatmessage"
 	"ramble-ai/ent/chatsession"
+	"ramble-ai/ent/collection"
+	"ramble-ai/ent/cut"
 	"ramble-ai/ent/exportjob"
 	"ramble-ai/ent/project"
+	"ramble-ai/ent/projecttemplate"
+	"ramble-ai/ent/prompt"
+	"ramble-ai/ent/responsecacheentry"
 	"ramble-ai/ent/settings"
+	"ramble-ai/ent/tag"
+	"ramble-ai/ent/transcriptembedding"
+	"ramble-ai/ent/usagerecord"
 	"ramble-ai/ent/videoclip"
 
 	"entgo.io/ent"
@@ -33,12 +41,28 @@ type Client struct {
 	ChatMessage *ChatMessageClient
 	// ChatSession is the client for interacting with the ChatSession builders.
 	ChatSession *ChatSessionClient
+	// Collection is the client for interacting with the Collection builders.
+	Collection *CollectionClient
+	// Cut is the client for interacting with the Cut builders.
+	Cut *CutClient
 	// ExportJob is the client for interacting with the ExportJob builders.
 	ExportJob *ExportJobClient
 	// Project is the client for interacting with the Project builders.
 	Project *ProjectClient
+	// ProjectTemplate is the client for interacting with the ProjectTemplate builders.
+	ProjectTemplate *ProjectTemplateClient
+	// Prompt is the client for interacting with the Prompt builders.
+	Prompt *PromptClient
+	// ResponseCacheEntry is the client for interacting with the ResponseCacheEntry builders.
+	ResponseCacheEntry *ResponseCacheEntryClient
 	// Settings is the client for interacting with the Settings builders.
 	Settings *SettingsClient
+	// Tag is the client for interacting with the Tag builders.
+	Tag *TagClient
+	// TranscriptEmbedding is the client for interacting with the TranscriptEmbedding builders.
+	TranscriptEmbedding *TranscriptEmbeddingClient
+	// UsageRecord is the client for interacting with the UsageRecord builders.
+	UsageRecord *UsageRecordClient
 	// VideoClip is the client for interacting with the VideoClip builders.
 	VideoClip *VideoClipClient
 }
@@ -54,9 +78,17 @@ func (c *Client) init() {
 	c.Schema = migrate.NewSchema(c.driver)
 	c.ChatMessage = NewChatMessageClient(c.config)
 	c.ChatSession = NewChatSessionClient(c.config)
+	c.Collection = NewCollectionClient(c.config)
+	c.Cut = NewCutClient(c.config)
 	c.ExportJob = NewExportJobClient(c.config)
 	c.Project = NewProjectClient(c.config)
+	c.ProjectTemplate = NewProjectTemplateClient(c.config)
+	c.Prompt = NewPromptClient(c.config)
+	c.ResponseCacheEntry = NewResponseCacheEntryClient(c.config)
 	c.Settings = NewSettingsClient(c.config)
+	c.Tag = NewTagClient(c.config)
+	c.TranscriptEmbedding = NewTranscriptEmbeddingClient(c.config)
+	c.UsageRecord = NewUsageRecordClient(c.config)
 	c.VideoClip = NewVideoClipClient(c.config)
 }
 
@@ -148,14 +180,22 @@ func (c *Client) Tx(ctx context.Context) (*Tx, error) {
 	cfg := c.config
 	cfg.driver = tx
 	return &Tx{
-		ctx:         ctx,
-		config:      cfg,
-		ChatMessage: NewChatMessageClient(cfg),
-		ChatSession: NewChatSessionClient(cfg),
-		ExportJob:   NewExportJobClient(cfg),
-		Project:     NewProjectClient(cfg),
-		Settings:    NewSettingsClient(cfg),
-		VideoClip:   NewVideoClipClient(cfg),
+		ctx:                 ctx,
+		config:              cfg,
+		ChatMessage:         NewChatMessageClient(cfg),
+		ChatSession:         NewChatSessionClient(cfg),
+		Collection:          NewCollectionClient(cfg),
+		Cut:                 NewCutClient(cfg),
+		ExportJob:           NewExportJobClient(cfg),
+		Project:             NewProjectClient(cfg),
+		ProjectTemplate:     NewProjectTemplateClient(cfg),
+		Prompt:              NewPromptClient(cfg),
+		ResponseCacheEntry:  NewResponseCacheEntryClient(cfg),
+		Settings:            NewSettingsClient(cfg),
+		Tag:                 NewTagClient(cfg),
+		TranscriptEmbedding: NewTranscriptEmbeddingClient(cfg),
+		UsageRecord:         NewUsageRecordClient(cfg),
+		VideoClip:           NewVideoClipClient(cfg),
 	}, nil
 }
 
@@ -173,14 +213,22 @@ func (c *Client) BeginTx(ctx context.Context, opts *sql.TxOptions) (*Tx, error)
 	cfg := c.config
 	cfg.driver = &txDriver{tx: tx, drv: c.driver}
 	return &Tx{
-		ctx:         ctx,
-		config:      cfg,
-		ChatMessage: NewChatMessageClient(cfg),
-		ChatSession: NewChatSessionClient(cfg),
-		ExportJob:   NewExportJobClient(cfg),
-		Project:     NewProjectClient(cfg),
-		Settings:    NewSettingsClient(cfg),
-		VideoClip:   NewVideoClipClient(cfg),
+		ctx:                 ctx,
+		config:              cfg,
+		ChatMessage:         NewChatMessageClient(cfg),
+		ChatSession:         NewChatSessionClient(cfg),
+		Collection:          NewCollectionClient(cfg),
+		Cut:                 NewCutClient(cfg),
+		ExportJob:           NewExportJobClient(cfg),
+		Project:             NewProjectClient(cfg),
+		ProjectTemplate:     NewProjectTemplateClient(cfg),
+		Prompt:              NewPromptClient(cfg),
+		ResponseCacheEntry:  NewResponseCacheEntryClient(cfg),
+		Settings:            NewSettingsClient(cfg),
+		Tag:                 NewTagClient(cfg),
+		TranscriptEmbedding: NewTranscriptEmbeddingClient(cfg),
+		UsageRecord:         NewUsageRecordClient(cfg),
+		VideoClip:           NewVideoClipClient(cfg),
 	}, nil
 }
 
@@ -210,7 +258,9 @@ func (c *Client) Close() error {
 // In order to add hooks to a specific client, call: `client.Node.Use(...)`.
 func (c *Client) Use(hooks ...Hook) {
 	for _, n := range []interface{ Use(...Hook) }{
-		c.ChatMessage, c.ChatSession, c.ExportJob, c.Project, c.Settings, c.VideoClip,
+		c.ChatMessage, c.ChatSession, c.Collection, c.Cut, c.ExportJob, c.Project,
+		c.ProjectTemplate, c.Prompt, c.ResponseCacheEntry, c.Settings, c.Tag,
+		c.TranscriptEmbedding, c.UsageRecord, c.VideoClip,
 	} {
 		n.Use(hooks...)
 	}
@@ -220,7 +270,9 @@ func (c *Client) Use(hooks ...Hook) {
 // In order to add interceptors to a specific client, call: `client.Node.Intercept(...)`.
 func (c *Client) Intercept(interceptors ...Interceptor) {
 	for _, n := range []interface{ Intercept(...Interceptor) }{
-		c.ChatMessage, c.ChatSession, c.ExportJob, c.Project, c.Settings, c.VideoClip,
+		c.ChatMessage, c.ChatSession, c.Collection, c.Cut, c.ExportJob, c.Project,
+		c.ProjectTemplate, c.Prompt, c.ResponseCacheEntry, c.Settings, c.Tag,
+		c.TranscriptEmbedding, c.UsageRecord, c.VideoClip,
 	} {
 		n.Intercept(interceptors...)
 	}
@@ -233,12 +285,28 @@ func (c *Client) Mutate(ctx context.Context, m Mutation) (Value, error) {
 		return c.ChatMessage.mutate(ctx, m)
 	case *ChatSessionMutation:
 		return c.ChatSession.mutate(ctx, m)
+	case *CollectionMutation:
+		return c.Collection.mutate(ctx, m)
+	case *CutMutation:
+		return c.Cut.mutate(ctx, m)
 	case *ExportJobMutation:
 		return c.ExportJob.mutate(ctx, m)
 	case *ProjectMutation:
 		return c.Project.mutate(ctx, m)
+	case *ProjectTemplateMutation:
+		return c.ProjectTemplate.mutate(ctx, m)
+	case *PromptMutation:
+		return c.Prompt.mutate(ctx, m)
+	case *ResponseCacheEntryMutation:
+		return c.ResponseCacheEntry.mutate(ctx, m)
 	case *SettingsMutation:
 		return c.Settings.mutate(ctx, m)
+	case *TagMutation:
+		return c.Tag.mutate(ctx, m)
+	case *TranscriptEmbeddingMutation:
+		return c.TranscriptEmbedding.mutate(ctx, m)
+	case *UsageRecordMutation:
+		return c.UsageRecord.mutate(ctx, m)
 	case *VideoClipMutation:
 		return c.VideoClip.mutate(ctx, m)
 	default:
@@ -560,6 +628,304 @@ func (c *ChatSessionClient) mutate(ctx context.Context, m *ChatSessionMutation)
 	}
 }
 
+// CollectionClient is a client for the Collection schema.
+type CollectionClient struct {
+	config
+}
+
+// NewCollectionClient returns a client for the Collection from the given config.
+func NewCollectionClient(c config) *CollectionClient {
+	return &CollectionClient{config: c}
+}
+
+// Use adds a list of mutation hooks to the hooks stack.
+// A call to `Use(f, g, h)` equals to `collection.Hooks(f(g(h())))`.
+func (c *CollectionClient) Use(hooks ...Hook) {
+	c.hooks.Collection = append(c.hooks.Collection, hooks...)
+}
+
+// Intercept adds a list of query interceptors to the interceptors stack.
+// A call to `Intercept(f, g, h)` equals to `collection.Intercept(f(g(h())))`.
+func (c *CollectionClient) Intercept(interceptors ...Interceptor) {
+	c.inters.Collection = append(c.inters.Collection, interceptors...)
+}
+
+// Create returns a builder for creating a Collection entity.
+func (c *CollectionClient) Create() *CollectionCreate {
+	mutation := newCollectionMutation(c.config, OpCreate)
+	return &CollectionCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// CreateBulk returns a builder for creating a bulk of Collection entities.
+func (c *CollectionClient) CreateBulk(builders ...*CollectionCreate) *CollectionCreateBulk {
+	return &CollectionCreateBulk{config: c.config, builders: builders}
+}
+
+// MapCreateBulk creates a bulk creation builder from the given slice. For each item in the slice, the function creates
+// a builder and applies setFunc on it.
+func (c *CollectionClient) MapCreateBulk(slice any, setFunc func(*CollectionCreate, int)) *CollectionCreateBulk {
+	rv := reflect.ValueOf(slice)
+	if rv.Kind() != reflect.Slice {
+		return &CollectionCreateBulk{err: fmt.Errorf("calling to CollectionClient.MapCreateBulk with wrong type %T, need slice", slice)}
+	}
+	builders := make([]*CollectionCreate, rv.Len())
+	for i := 0; i < rv.Len(); i++ {
+		builders[i] = c.Create()
+		setFunc(builders[i], i)
+	}
+	return &CollectionCreateBulk{config: c.config, builders: builders}
+}
+
+// Update returns an update builder for Collection.
+func (c *CollectionClient) Update() *CollectionUpdate {
+	mutation := newCollectionMutation(c.config, OpUpdate)
+	return &CollectionUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOne returns an update builder for the given entity.
+func (c *CollectionClient) UpdateOne(co *Collection) *CollectionUpdateOne {
+	mutation := newCollectionMutation(c.config, OpUpdateOne, withCollection(co))
+	return &CollectionUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOneID returns an update builder for the given id.
+func (c *CollectionClient) UpdateOneID(id int) *CollectionUpdateOne {
+	mutation := newCollectionMutation(c.config, OpUpdateOne, withCollectionID(id))
+	return &CollectionUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// Delete returns a delete builder for Collection.
+func (c *CollectionClient) Delete() *CollectionDelete {
+	mutation := newCollectionMutation(c.config, OpDelete)
+	return &CollectionDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// DeleteOne returns a builder for deleting the given entity.
+func (c *CollectionClient) DeleteOne(co *Collection) *CollectionDeleteOne {
+	return c.DeleteOneID(co.ID)
+}
+
+// DeleteOneID returns a builder for deleting the given entity by its id.
+func (c *CollectionClient) DeleteOneID(id int) *CollectionDeleteOne {
+	builder := c.Delete().Where(collection.ID(id))
+	builder.mutation.id = &id
+	builder.mutation.op = OpDeleteOne
+	return &CollectionDeleteOne{builder}
+}
+
+// Query returns a query builder for Collection.
+func (c *CollectionClient) Query() *CollectionQuery {
+	return &CollectionQuery{
+		config: c.config,
+		ctx:    &QueryContext{Type: TypeCollection},
+		inters: c.Interceptors(),
+	}
+}
+
+// Get returns a Collection entity by its id.
+func (c *CollectionClient) Get(ctx context.Context, id int) (*Collection, error) {
+	return c.Query().Where(collection.ID(id)).Only(ctx)
+}
+
+// GetX is like Get, but panics if an error occurs.
+func (c *CollectionClient) GetX(ctx context.Context, id int) *Collection {
+	obj, err := c.Get(ctx, id)
+	if err != nil {
+		panic(err)
+	}
+	return obj
+}
+
+// QueryProjects queries the projects edge of a Collection.
+func (c *CollectionClient) QueryProjects(co *Collection) *ProjectQuery {
+	query := (&ProjectClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := co.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(collection.Table, collection.FieldID, id),
+			sqlgraph.To(project.Table, project.FieldID),
+			sqlgraph.Edge(sqlgraph.M2M, false, collection.ProjectsTable, collection.ProjectsPrimaryKey...),
+		)
+		fromV = sqlgraph.Neighbors(co.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// Hooks returns the client hooks.
+func (c *CollectionClient) Hooks() []Hook {
+	return c.hooks.Collection
+}
+
+// Interceptors returns the client interceptors.
+func (c *CollectionClient) Interceptors() []Interceptor {
+	return c.inters.Collection
+}
+
+func (c *CollectionClient) mutate(ctx context.Context, m *CollectionMutation) (Value, error) {
+	switch m.Op() {
+	case OpCreate:
+		return (&CollectionCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdate:
+		return (&CollectionUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdateOne:
+		return (&CollectionUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpDelete, OpDeleteOne:
+		return (&CollectionDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
+	default:
+		return nil, fmt.Errorf("ent: unknown Collection mutation op: %q", m.Op())
+	}
+}
+
+// CutClient is a client for the Cut schema.
+type CutClient struct {
+	config
+}
+
+// NewCutClient returns a client for the Cut from the given config.
+func NewCutClient(c config) *CutClient {
+	return &CutClient{config: c}
+}
+
+// Use adds a list of mutation hooks to the hooks stack.
+// A call to `Use(f, g, h)` equals to `cut.Hooks(f(g(h())))`.
+func (c *CutClient) Use(hooks ...Hook) {
+	c.hooks.Cut = append(c.hooks.Cut, hooks...)
+}
+
+// Intercept adds a list of query interceptors to the interceptors stack.
+// A call to `Intercept(f, g, h)` equals to `cut.Intercept(f(g(h())))`.
+func (c *CutClient) Intercept(interceptors ...Interceptor) {
+	c.inters.Cut = append(c.inters.Cut, interceptors...)
+}
+
+// Create returns a builder for creating a Cut entity.
+func (c *CutClient) Create() *CutCreate {
+	mutation := newCutMutation(c.config, OpCreate)
+	return &CutCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// CreateBulk returns a builder for creating a bulk of Cut entities.
+func (c *CutClient) CreateBulk(builders ...*CutCreate) *CutCreateBulk {
+	return &CutCreateBulk{config: c.config, builders: builders}
+}
+
+// MapCreateBulk creates a bulk creation builder from the given slice. For each item in the slice, the function creates
+// a builder and applies setFunc on it.
+func (c *CutClient) MapCreateBulk(slice any, setFunc func(*CutCreate, int)) *CutCreateBulk {
+	rv := reflect.ValueOf(slice)
+	if rv.Kind() != reflect.Slice {
+		return &CutCreateBulk{err: fmt.Errorf("calling to CutClient.MapCreateBulk with wrong type %T, need slice", slice)}
+	}
+	builders := make([]*CutCreate, rv.Len())
+	for i := 0; i < rv.Len(); i++ {
+		builders[i] = c.Create()
+		setFunc(builders[i], i)
+	}
+	return &CutCreateBulk{config: c.config, builders: builders}
+}
+
+// Update returns an update builder for Cut.
+func (c *CutClient) Update() *CutUpdate {
+	mutation := newCutMutation(c.config, OpUpdate)
+	return &CutUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOne returns an update builder for the given entity.
+func (c *CutClient) UpdateOne(cu *Cut) *CutUpdateOne {
+	mutation := newCutMutation(c.config, OpUpdateOne, withCut(cu))
+	return &CutUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOneID returns an update builder for the given id.
+func (c *CutClient) UpdateOneID(id int) *CutUpdateOne {
+	mutation := newCutMutation(c.config, OpUpdateOne, withCutID(id))
+	return &CutUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// Delete returns a delete builder for Cut.
+func (c *CutClient) Delete() *CutDelete {
+	mutation := newCutMutation(c.config, OpDelete)
+	return &CutDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// DeleteOne returns a builder for deleting the given entity.
+func (c *CutClient) DeleteOne(cu *Cut) *CutDeleteOne {
+	return c.DeleteOneID(cu.ID)
+}
+
+// DeleteOneID returns a builder for deleting the given entity by its id.
+func (c *CutClient) DeleteOneID(id int) *CutDeleteOne {
+	builder := c.Delete().Where(cut.ID(id))
+	builder.mutation.id = &id
+	builder.mutation.op = OpDeleteOne
+	return &CutDeleteOne{builder}
+}
+
+// Query returns a query builder for Cut.
+func (c *CutClient) Query() *CutQuery {
+	return &CutQuery{
+		config: c.config,
+		ctx:    &QueryContext{Type: TypeCut},
+		inters: c.Interceptors(),
+	}
+}
+
+// Get returns a Cut entity by its id.
+func (c *CutClient) Get(ctx context.Context, id int) (*Cut, error) {
+	return c.Query().Where(cut.ID(id)).Only(ctx)
+}
+
+// GetX is like Get, but panics if an error occurs.
+func (c *CutClient) GetX(ctx context.Context, id int) *Cut {
+	obj, err := c.Get(ctx, id)
+	if err != nil {
+		panic(err)
+	}
+	return obj
+}
+
+// QueryProject queries the project edge of a Cut.
+func (c *CutClient) QueryProject(cu *Cut) *ProjectQuery {
+	query := (&ProjectClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := cu.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(cut.Table, cut.FieldID, id),
+			sqlgraph.To(project.Table, project.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, cut.ProjectTable, cut.ProjectColumn),
+		)
+		fromV = sqlgraph.Neighbors(cu.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// Hooks returns the client hooks.
+func (c *CutClient) Hooks() []Hook {
+	return c.hooks.Cut
+}
+
+// Interceptors returns the client interceptors.
+func (c *CutClient) Interceptors() []Interceptor {
+	return c.inters.Cut
+}
+
+func (c *CutClient) mutate(ctx context.Context, m *CutMutation) (Value, error) {
+	switch m.Op() {
+	case OpCreate:
+		return (&CutCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdate:
+		return (&CutUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdateOne:
+		return (&CutUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpDelete, OpDeleteOne:
+		return (&CutDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
+	default:
+		return nil, fmt.Errorf("ent: unknown Cut mutation op: %q", m.Op())
+	}
+}
+
 // ExportJobClient is a client for the ExportJob schema.
 type ExportJobClient struct {
 	config
@@ -865,6 +1231,70 @@ func (c *ProjectClient) QueryChatSessions(pr *Project) *ChatSessionQuery {
 	return query
 }
 
+// QueryCuts queries the cuts edge of a Project.
+func (c *ProjectClient) QueryCuts(pr *Project) *CutQuery {
+	query := (&CutClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := pr.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(project.Table, project.FieldID, id),
+			sqlgraph.To(cut.Table, cut.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, project.CutsTable, project.CutsColumn),
+		)
+		fromV = sqlgraph.Neighbors(pr.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// QueryPrompts queries the prompts edge of a Project.
+func (c *ProjectClient) QueryPrompts(pr *Project) *PromptQuery {
+	query := (&PromptClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := pr.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(project.Table, project.FieldID, id),
+			sqlgraph.To(prompt.Table, prompt.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, project.PromptsTable, project.PromptsColumn),
+		)
+		fromV = sqlgraph.Neighbors(pr.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// QueryTags queries the tags edge of a Project.
+func (c *ProjectClient) QueryTags(pr *Project) *TagQuery {
+	query := (&TagClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := pr.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(project.Table, project.FieldID, id),
+			sqlgraph.To(tag.Table, tag.FieldID),
+			sqlgraph.Edge(sqlgraph.M2M, true, project.TagsTable, project.TagsPrimaryKey...),
+		)
+		fromV = sqlgraph.Neighbors(pr.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// QueryCollections queries the collections edge of a Project.
+func (c *ProjectClient) QueryCollections(pr *Project) *CollectionQuery {
+	query := (&CollectionClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := pr.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(project.Table, project.FieldID, id),
+			sqlgraph.To(collection.Table, collection.FieldID),
+			sqlgraph.Edge(sqlgraph.M2M, true, project.CollectionsTable, project.CollectionsPrimaryKey...),
+		)
+		fromV = sqlgraph.Neighbors(pr.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
 // Hooks returns the client hooks.
 func (c *ProjectClient) Hooks() []Hook {
 	return c.hooks.Project
@@ -890,107 +1320,655 @@ func (c *ProjectClient) mutate(ctx context.Context, m *ProjectMutation) (Value,
 	}
 }
 
-// SettingsClient is a client for the Settings schema.
-type SettingsClient struct {
+// ProjectTemplateClient is a client for the ProjectTemplate schema.
+type ProjectTemplateClient struct {
 	config
 }
 
-// NewSettingsClient returns a client for the Settings from the given config.
-func NewSettingsClient(c config) *SettingsClient {
-	return &SettingsClient{config: c}
+// NewProjectTemplateClient returns a client for the ProjectTemplate from the given config.
+func NewProjectTemplateClient(c config) *ProjectTemplateClient {
+	return &ProjectTemplateClient{config: c}
 }
 
 // Use adds a list of mutation hooks to the hooks stack.
-// A call to `Use(f, g, h)` equals to `settings.Hooks(f(g(h())))`.
-func (c *SettingsClient) Use(hooks ...Hook) {
-	c.hooks.Settings = append(c.hooks.Settings, hooks...)
+// A call to `Use(f, g, h)` equals to `projecttemplate.Hooks(f(g(h())))`.
+func (c *ProjectTemplateClient) Use(hooks ...Hook) {
+	c.hooks.ProjectTemplate = append(c.hooks.ProjectTemplate, hooks...)
 }
 
 // Intercept adds a list of query interceptors to the interceptors stack.
-// A call to `Intercept(f, g, h)` equals to `settings.Intercept(f(g(h())))`.
-func (c *SettingsClient) Intercept(interceptors ...Interceptor) {
-	c.inters.Settings = append(c.inters.Settings, interceptors...)
+// A call to `Intercept(f, g, h)` equals to `projecttemplate.Intercept(f(g(h())))`.
+func (c *ProjectTemplateClient) Intercept(interceptors ...Interceptor) {
+	c.inters.ProjectTemplate = append(c.inters.ProjectTemplate, interceptors...)
 }
 
-// Create returns a builder for creating a Settings entity.
-func (c *SettingsClient) Create() *SettingsCreate {
-	mutation := newSettingsMutation(c.config, OpCreate)
-	return &SettingsCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+// Create returns a builder for creating a ProjectTemplate entity.
+func (c *ProjectTemplateClient) Create() *ProjectTemplateCreate {
+	mutation := newProjectTemplateMutation(c.config, OpCreate)
+	return &ProjectTemplateCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
-// CreateBulk returns a builder for creating a bulk of Settings entities.
-func (c *SettingsClient) CreateBulk(builders ...*SettingsCreate) *SettingsCreateBulk {
-	return &SettingsCreateBulk{config: c.config, builders: builders}
+// CreateBulk returns a builder for creating a bulk of ProjectTemplate entities.
+func (c *ProjectTemplateClient) CreateBulk(builders ...*ProjectTemplateCreate) *ProjectTemplateCreateBulk {
+	return &ProjectTemplateCreateBulk{config: c.config, builders: builders}
 }
 
 // MapCreateBulk creates a bulk creation builder from the given slice. For each item in the slice, the function creates
 // a builder and applies setFunc on it.
-func (c *SettingsClient) MapCreateBulk(slice any, setFunc func(*SettingsCreate, int)) *SettingsCreateBulk {
+func (c *ProjectTemplateClient) MapCreateBulk(slice any, setFunc func(*ProjectTemplateCreate, int)) *ProjectTemplateCreateBulk {
 	rv := reflect.ValueOf(slice)
 	if rv.Kind() != reflect.Slice {
-		return &SettingsCreateBulk{err: fmt.Errorf("calling to SettingsClient.MapCreateBulk with wrong type %T, need slice", slice)}
+		return &ProjectTemplateCreateBulk{err: fmt.Errorf("calling to ProjectTemplateClient.MapCreateBulk with wrong type %T, need slice", slice)}
 	}
-	builders := make([]*SettingsCreate, rv.Len())
+	builders := make([]*ProjectTemplateCreate, rv.Len())
 	for i := 0; i < rv.Len(); i++ {
 		builders[i] = c.Create()
 		setFunc(builders[i], i)
 	}
-	return &SettingsCreateBulk{config: c.config, builders: builders}
+	return &ProjectTemplateCreateBulk{config: c.config, builders: builders}
 }
 
-// Update returns an update builder for Settings.
-func (c *SettingsClient) Update() *SettingsUpdate {
-	mutation := newSettingsMutation(c.config, OpUpdate)
-	return &SettingsUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+// Update returns an update builder for ProjectTemplate.
+func (c *ProjectTemplateClient) Update() *ProjectTemplateUpdate {
+	mutation := newProjectTemplateMutation(c.config, OpUpdate)
+	return &ProjectTemplateUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
 // UpdateOne returns an update builder for the given entity.
-func (c *SettingsClient) UpdateOne(s *Settings) *SettingsUpdateOne {
-	mutation := newSettingsMutation(c.config, OpUpdateOne, withSettings(s))
-	return &SettingsUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+func (c *ProjectTemplateClient) UpdateOne(pt *ProjectTemplate) *ProjectTemplateUpdateOne {
+	mutation := newProjectTemplateMutation(c.config, OpUpdateOne, withProjectTemplate(pt))
+	return &ProjectTemplateUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
 // UpdateOneID returns an update builder for the given id.
-func (c *SettingsClient) UpdateOneID(id int) *SettingsUpdateOne {
-	mutation := newSettingsMutation(c.config, OpUpdateOne, withSettingsID(id))
-	return &SettingsUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+func (c *ProjectTemplateClient) UpdateOneID(id int) *ProjectTemplateUpdateOne {
+	mutation := newProjectTemplateMutation(c.config, OpUpdateOne, withProjectTemplateID(id))
+	return &ProjectTemplateUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
-// Delete returns a delete builder for Settings.
-func (c *SettingsClient) Delete() *SettingsDelete {
-	mutation := newSettingsMutation(c.config, OpDelete)
-	return &SettingsDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+// Delete returns a delete builder for ProjectTemplate.
+func (c *ProjectTemplateClient) Delete() *ProjectTemplateDelete {
+	mutation := newProjectTemplateMutation(c.config, OpDelete)
+	return &ProjectTemplateDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
 // DeleteOne returns a builder for deleting the given entity.
-func (c *SettingsClient) DeleteOne(s *Settings) *SettingsDeleteOne {
-	return c.DeleteOneID(s.ID)
+func (c *ProjectTemplateClient) DeleteOne(pt *ProjectTemplate) *ProjectTemplateDeleteOne {
+	return c.DeleteOneID(pt.ID)
 }
 
 // DeleteOneID returns a builder for deleting the given entity by its id.
-func (c *SettingsClient) DeleteOneID(id int) *SettingsDeleteOne {
-	builder := c.Delete().Where(settings.ID(id))
+func (c *ProjectTemplateClient) DeleteOneID(id int) *ProjectTemplateDeleteOne {
+	builder := c.Delete().Where(projecttemplate.ID(id))
 	builder.mutation.id = &id
 	builder.mutation.op = OpDeleteOne
-	return &SettingsDeleteOne{builder}
+	return &ProjectTemplateDeleteOne{builder}
 }
 
-// Query returns a query builder for Settings.
-func (c *SettingsClient) Query() *SettingsQuery {
-	return &SettingsQuery{
+// Query returns a query builder for ProjectTemplate.
+func (c *ProjectTemplateClient) Query() *ProjectTemplateQuery {
+	return &ProjectTemplateQuery{
+		config: c.config,
+		ctx:    &QueryContext{Type: TypeProjectTemplate},
+		inters: c.Interceptors(),
+	}
+}
+
+// Get returns a ProjectTemplate entity by its id.
+func (c *ProjectTemplateClient) Get(ctx context.Context, id int) (*ProjectTemplate, error) {
+	return c.Query().Where(projecttemplate.ID(id)).Only(ctx)
+}
+
+// GetX is like Get, but panics if an error occurs.
+func (c *ProjectTemplateClient) GetX(ctx context.Context, id int) *ProjectTemplate {
+	obj, err := c.Get(ctx, id)
+	if err != nil {
+		panic(err)
+	}
+	return obj
+}
+
+// Hooks returns the client hooks.
+func (c *ProjectTemplateClient) Hooks() []Hook {
+	return c.hooks.ProjectTemplate
+}
+
+// Interceptors returns the client interceptors.
+func (c *ProjectTemplateClient) Interceptors() []Interceptor {
+	return c.inters.ProjectTemplate
+}
+
+func (c *ProjectTemplateClient) mutate(ctx context.Context, m *ProjectTemplateMutation) (Value, error) {
+	switch m.Op() {
+	case OpCreate:
+		return (&ProjectTemplateCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdate:
+		return (&ProjectTemplateUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdateOne:
+		return (&ProjectTemplateUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpDelete, OpDeleteOne:
+		return (&ProjectTemplateDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
+	default:
+		return nil, fmt.Errorf("ent: unknown ProjectTemplate mutation op: %q", m.Op())
+	}
+}
+
+// PromptClient is a client for the Prompt schema.
+type PromptClient struct {
+	config
+}
+
+// NewPromptClient returns a client for the Prompt from the given config.
+func NewPromptClient(c config) *PromptClient {
+	return &PromptClient{config: c}
+}
+
+// Use adds a list of mutation hooks to the hooks stack.
+// A call to `Use(f, g, h)` equals to `prompt.Hooks(f(g(h())))`.
+func (c *PromptClient) Use(hooks ...Hook) {
+	c.hooks.Prompt = append(c.hooks.Prompt, hooks...)
+}
+
+// Intercept adds a list of query interceptors to the interceptors stack.
+// A call to `Intercept(f, g, h)` equals to `prompt.Intercept(f(g(h())))`.
+func (c *PromptClient) Intercept(interceptors ...Interceptor) {
+	c.inters.Prompt = append(c.inters.Prompt, interceptors...)
+}
+
+// Create returns a builder for creating a Prompt entity.
+func (c *PromptClient) Create() *PromptCreate {
+	mutation := newPromptMutation(c.config, OpCreate)
+	return &PromptCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// CreateBulk returns a builder for creating a bulk of Prompt entities.
+func (c *PromptClient) CreateBulk(builders ...*PromptCreate) *PromptCreateBulk {
+	return &PromptCreateBulk{config: c.config, builders: builders}
+}
+
+// MapCreateBulk creates a bulk creation builder from the given slice. For each item in the slice, the function creates
+// a builder and applies setFunc on it.
+func (c *PromptClient) MapCreateBulk(slice any, setFunc func(*PromptCreate, int)) *PromptCreateBulk {
+	rv := reflect.ValueOf(slice)
+	if rv.Kind() != reflect.Slice {
+		return &PromptCreateBulk{err: fmt.Errorf("calling to PromptClient.MapCreateBulk with wrong type %T, need slice", slice)}
+	}
+	builders := make([]*PromptCreate, rv.Len())
+	for i := 0; i < rv.Len(); i++ {
+		builders[i] = c.Create()
+		setFunc(builders[i], i)
+	}
+	return &PromptCreateBulk{config: c.config, builders: builders}
+}
+
+// Update returns an update builder for Prompt.
+func (c *PromptClient) Update() *PromptUpdate {
+	mutation := newPromptMutation(c.config, OpUpdate)
+	return &PromptUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOne returns an update builder for the given entity.
+func (c *PromptClient) UpdateOne(pr *Prompt) *PromptUpdateOne {
+	mutation := newPromptMutation(c.config, OpUpdateOne, withPrompt(pr))
+	return &PromptUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOneID returns an update builder for the given id.
+func (c *PromptClient) UpdateOneID(id int) *PromptUpdateOne {
+	mutation := newPromptMutation(c.config, OpUpdateOne, withPromptID(id))
+	return &PromptUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// Delete returns a delete builder for Prompt.
+func (c *PromptClient) Delete() *PromptDelete {
+	mutation := newPromptMutation(c.config, OpDelete)
+	return &PromptDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// DeleteOne returns a builder for deleting the given entity.
+func (c *PromptClient) DeleteOne(pr *Prompt) *PromptDeleteOne {
+	return c.DeleteOneID(pr.ID)
+}
+
+// DeleteOneID returns a builder for deleting the given entity by its id.
+func (c *PromptClient) DeleteOneID(id int) *PromptDeleteOne {
+	builder := c.Delete().Where(prompt.ID(id))
+	builder.mutation.id = &id
+	builder.mutation.op = OpDeleteOne
+	return &PromptDeleteOne{builder}
+}
+
+// Query returns a query builder for Prompt.
+func (c *PromptClient) Query() *PromptQuery {
+	return &PromptQuery{
+		config: c.config,
+		ctx:    &QueryContext{Type: TypePrompt},
+		inters: c.Interceptors(),
+	}
+}
+
+// Get returns a Prompt entity by its id.
+func (c *PromptClient) Get(ctx context.Context, id int) (*Prompt, error) {
+	return c.Query().Where(prompt.ID(id)).Only(ctx)
+}
+
+// GetX is like Get, but panics if an error occurs.
+func (c *PromptClient) GetX(ctx context.Context, id int) *Prompt {
+	obj, err := c.Get(ctx, id)
+	if err != nil {
+		panic(err)
+	}
+	return obj
+}
+
+// QueryProject queries the project edge of a Prompt.
+func (c *PromptClient) QueryProject(pr *Prompt) *ProjectQuery {
+	query := (&ProjectClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := pr.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(prompt.Table, prompt.FieldID, id),
+			sqlgraph.To(project.Table, project.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, prompt.ProjectTable, prompt.ProjectColumn),
+		)
+		fromV = sqlgraph.Neighbors(pr.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// Hooks returns the client hooks.
+func (c *PromptClient) Hooks() []Hook {
+	return c.hooks.Prompt
+}
+
+// Interceptors returns the client interceptors.
+func (c *PromptClient) Interceptors() []Interceptor {
+	return c.inters.Prompt
+}
+
+func (c *PromptClient) mutate(ctx context.Context, m *PromptMutation) (Value, error) {
+	switch m.Op() {
+	case OpCreate:
+		return (&PromptCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdate:
+		return (&PromptUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdateOne:
+		return (&PromptUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpDelete, OpDeleteOne:
+		return (&PromptDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
+	default:
+		return nil, fmt.Errorf("ent: unknown Prompt mutation op: %q", m.Op())
+	}
+}
+
+// ResponseCacheEntryClient is a client for the ResponseCacheEntry schema.
+type ResponseCacheEntryClient struct {
+	config
+}
+
+// NewResponseCacheEntryClient returns a client for the ResponseCacheEntry from the given config.
+func NewResponseCacheEntryClient(c config) *ResponseCacheEntryClient {
+	return &ResponseCacheEntryClient{config: c}
+}
+
+// Use adds a list of mutation hooks to the hooks stack.
+// A call to `Use(f, g, h)` equals to `responsecacheentry.Hooks(f(g(h())))`.
+func (c *ResponseCacheEntryClient) Use(hooks ...Hook) {
+	c.hooks.ResponseCacheEntry = append(c.hooks.ResponseCacheEntry, hooks...)
+}
+
+// Intercept adds a list of query interceptors to the interceptors stack.
+// A call to `Intercept(f, g, h)` equals to `responsecacheentry.Intercept(f(g(h())))`.
+func (c *ResponseCacheEntryClient) Intercept(interceptors ...Interceptor) {
+	c.inters.ResponseCacheEntry = append(c.inters.ResponseCacheEntry, interceptors...)
+}
+
+// Create returns a builder for creating a ResponseCacheEntry entity.
+func (c *ResponseCacheEntryClient) Create() *ResponseCacheEntryCreate {
+	mutation := newResponseCacheEntryMutation(c.config, OpCreate)
+	return &ResponseCacheEntryCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// CreateBulk returns a builder for creating a bulk of ResponseCacheEntry entities.
+func (c *ResponseCacheEntryClient) CreateBulk(builders ...*ResponseCacheEntryCreate) *ResponseCacheEntryCreateBulk {
+	return &ResponseCacheEntryCreateBulk{config: c.config, builders: builders}
+}
+
+// MapCreateBulk creates a bulk creation builder from the given slice. For each item in the slice, the function creates
+// a builder and applies setFunc on it.
+func (c *ResponseCacheEntryClient) MapCreateBulk(slice any, setFunc func(*ResponseCacheEntryCreate, int)) *ResponseCacheEntryCreateBulk {
+	rv := reflect.ValueOf(slice)
+	if rv.Kind() != reflect.Slice {
+		return &ResponseCacheEntryCreateBulk{err: fmt.Errorf("calling to ResponseCacheEntryClient.MapCreateBulk with wrong type %T, need slice", slice)}
+	}
+	builders := make([]*ResponseCacheEntryCreate, rv.Len())
+	for i := 0; i < rv.Len(); i++ {
+		builders[i] = c.Create()
+		setFunc(builders[i], i)
+	}
+	return &ResponseCacheEntryCreateBulk{config: c.config, builders: builders}
+}
+
+// Update returns an update builder for ResponseCacheEntry.
+func (c *ResponseCacheEntryClient) Update() *ResponseCacheEntryUpdate {
+	mutation := newResponseCacheEntryMutation(c.config, OpUpdate)
+	return &ResponseCacheEntryUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOne returns an update builder for the given entity.
+func (c *ResponseCacheEntryClient) UpdateOne(rce *ResponseCacheEntry) *ResponseCacheEntryUpdateOne {
+	mutation := newResponseCacheEntryMutation(c.config, OpUpdateOne, withResponseCacheEntry(rce))
+	return &ResponseCacheEntryUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOneID returns an update builder for the given id.
+func (c *ResponseCacheEntryClient) UpdateOneID(id int) *ResponseCacheEntryUpdateOne {
+	mutation := newResponseCacheEntryMutation(c.config, OpUpdateOne, withResponseCacheEntryID(id))
+	return &ResponseCacheEntryUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// Delete returns a delete builder for ResponseCacheEntry.
+func (c *ResponseCacheEntryClient) Delete() *ResponseCacheEntryDelete {
+	mutation := newResponseCacheEntryMutation(c.config, OpDelete)
+	return &ResponseCacheEntryDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// DeleteOne returns a builder for deleting the given entity.
+func (c *ResponseCacheEntryClient) DeleteOne(rce *ResponseCacheEntry) *ResponseCacheEntryDeleteOne {
+	return c.DeleteOneID(rce.ID)
+}
+
+// DeleteOneID returns a builder for deleting the given entity by its id.
+func (c *ResponseCacheEntryClient) DeleteOneID(id int) *ResponseCacheEntryDeleteOne {
+	builder := c.Delete().Where(responsecacheentry.ID(id))
+	builder.mutation.id = &id
+	builder.mutation.op = OpDeleteOne
+	return &ResponseCacheEntryDeleteOne{builder}
+}
+
+// Query returns a query builder for ResponseCacheEntry.
+func (c *ResponseCacheEntryClient) Query() *ResponseCacheEntryQuery {
+	return &ResponseCacheEntryQuery{
+		config: c.config,
+		ctx:    &QueryContext{Type: TypeResponseCacheEntry},
+		inters: c.Interceptors(),
+	}
+}
+
+// Get returns a ResponseCacheEntry entity by its id.
+func (c *ResponseCacheEntryClient) Get(ctx context.Context, id int) (*ResponseCacheEntry, error) {
+	return c.Query().Where(responsecacheentry.ID(id)).Only(ctx)
+}
+
+// GetX is like Get, but panics if an error occurs.
+func (c *ResponseCacheEntryClient) GetX(ctx context.Context, id int) *ResponseCacheEntry {
+	obj, err := c.Get(ctx, id)
+	if err != nil {
+		panic(err)
+	}
+	return obj
+}
+
+// Hooks returns the client hooks.
+func (c *ResponseCacheEntryClient) Hooks() []Hook {
+	return c.hooks.ResponseCacheEntry
+}
+
+// Interceptors returns the client interceptors.
+func (c *ResponseCacheEntryClient) Interceptors() []Interceptor {
+	return c.inters.ResponseCacheEntry
+}
+
+func (c *ResponseCacheEntryClient) mutate(ctx context.Context, m *ResponseCacheEntryMutation) (Value, error) {
+	switch m.Op() {
+	case OpCreate:
+		return (&ResponseCacheEntryCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdate:
+		return (&ResponseCacheEntryUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdateOne:
+		return (&ResponseCacheEntryUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpDelete, OpDeleteOne:
+		return (&ResponseCacheEntryDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
+	default:
+		return nil, fmt.Errorf("ent: unknown ResponseCacheEntry mutation op: %q", m.Op())
+	}
+}
+
+// SettingsClient is a client for the Settings schema.
+type SettingsClient struct {
+	config
+}
+
+// NewSettingsClient returns a client for the Settings from the given config.
+func NewSettingsClient(c config) *SettingsClient {
+	return &SettingsClient{config: c}
+}
+
+// Use adds a list of mutation hooks to the hooks stack.
+// A call to `Use(f, g, h)` equals to `settings.Hooks(f(g(h())))`.
+func (c *SettingsClient) Use(hooks ...Hook) {
+	c.hooks.Settings = append(c.hooks.Settings, hooks...)
+}
+
+// Intercept adds a list of query interceptors to the interceptors stack.
+// A call to `Intercept(f, g, h)` equals to `settings.Intercept(f(g(h())))`.
+func (c *SettingsClient) Intercept(interceptors ...Interceptor) {
+	c.inters.Settings = append(c.inters.Settings, interceptors...)
+}
+
+// Create returns a builder for creating a Settings entity.
+func (c *SettingsClient) Create() *SettingsCreate {
+	mutation := newSettingsMutation(c.config, OpCreate)
+	return &SettingsCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// CreateBulk returns a builder for creating a bulk of Settings entities.
+func (c *SettingsClient) CreateBulk(builders ...*SettingsCreate) *SettingsCreateBulk {
+	return &SettingsCreateBulk{config: c.config, builders: builders}
+}
+
+// MapCreateBulk creates a bulk creation builder from the given slice. For each item in the slice, the function creates
+// a builder and applies setFunc on it.
+func (c *SettingsClient) MapCreateBulk(slice any, setFunc func(*SettingsCreate, int)) *SettingsCreateBulk {
+	rv := reflect.ValueOf(slice)
+	if rv.Kind() != reflect.Slice {
+		return &SettingsCreateBulk{err: fmt.Errorf("calling to SettingsClient.MapCreateBulk with wrong type %T, need slice", slice)}
+	}
+	builders := make([]*SettingsCreate, rv.Len())
+	for i := 0; i < rv.Len(); i++ {
+		builders[i] = c.Create()
+		setFunc(builders[i], i)
+	}
+	return &SettingsCreateBulk{config: c.config, builders: builders}
+}
+
+// Update returns an update builder for Settings.
+func (c *SettingsClient) Update() *SettingsUpdate {
+	mutation := newSettingsMutation(c.config, OpUpdate)
+	return &SettingsUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOne returns an update builder for the given entity.
+func (c *SettingsClient) UpdateOne(s *Settings) *SettingsUpdateOne {
+	mutation := newSettingsMutation(c.config, OpUpdateOne, withSettings(s))
+	return &SettingsUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOneID returns an update builder for the given id.
+func (c *SettingsClient) UpdateOneID(id int) *SettingsUpdateOne {
+	mutation := newSettingsMutation(c.config, OpUpdateOne, withSettingsID(id))
+	return &SettingsUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// Delete returns a delete builder for Settings.
+func (c *SettingsClient) Delete() *SettingsDelete {
+	mutation := newSettingsMutation(c.config, OpDelete)
+	return &SettingsDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// DeleteOne returns a builder for deleting the given entity.
+func (c *SettingsClient) DeleteOne(s *Settings) *SettingsDeleteOne {
+	return c.DeleteOneID(s.ID)
+}
+
+// DeleteOneID returns a builder for deleting the given entity by its id.
+func (c *SettingsClient) DeleteOneID(id int) *SettingsDeleteOne {
+	builder := c.Delete().Where(settings.ID(id))
+	builder.mutation.id = &id
+	builder.mutation.op = OpDeleteOne
+	return &SettingsDeleteOne{builder}
+}
+
+// Query returns a query builder for Settings.
+func (c *SettingsClient) Query() *SettingsQuery {
+	return &SettingsQuery{
 		config: c.config,
 		ctx:    &QueryContext{Type: TypeSettings},
 		inters: c.Interceptors(),
 	}
 }
 
-// Get returns a Settings entity by its id.
-func (c *SettingsClient) Get(ctx context.Context, id int) (*Settings, error) {
-	return c.Query().Where(settings.ID(id)).Only(ctx)
+// Get returns a Settings entity by its id.
+func (c *SettingsClient) Get(ctx context.Context, id int) (*Settings, error) {
+	return c.Query().Where(settings.ID(id)).Only(ctx)
+}
+
+// GetX is like Get, but panics if an error occurs.
+func (c *SettingsClient) GetX(ctx context.Context, id int) *Settings {
+	obj, err := c.Get(ctx, id)
+	if err != nil {
+		panic(err)
+	}
+	return obj
+}
+
+// Hooks returns the client hooks.
+func (c *SettingsClient) Hooks() []Hook {
+	return c.hooks.Settings
+}
+
+// Interceptors returns the client interceptors.
+func (c *SettingsClient) Interceptors() []Interceptor {
+	return c.inters.Settings
+}
+
+func (c *SettingsClient) mutate(ctx context.Context, m *SettingsMutation) (Value, error) {
+	switch m.Op() {
+	case OpCreate:
+		return (&SettingsCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdate:
+		return (&SettingsUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdateOne:
+		return (&SettingsUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpDelete, OpDeleteOne:
+		return (&SettingsDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
+	default:
+		return nil, fmt.Errorf("ent: unknown Settings mutation op: %q", m.Op())
+	}
+}
+
+// TagClient is a client for the Tag schema.
+type TagClient struct {
+	config
+}
+
+// NewTagClient returns a client for the Tag from the given config.
+func NewTagClient(c config) *TagClient {
+	return &TagClient{config: c}
+}
+
+// Use adds a list of mutation hooks to the hooks stack.
+// A call to `Use(f, g, h)` equals to `tag.Hooks(f(g(h())))`.
+func (c *TagClient) Use(hooks ...Hook) {
+	c.hooks.Tag = append(c.hooks.Tag, hooks...)
+}
+
+// Intercept adds a list of query interceptors to the interceptors stack.
+// A call to `Intercept(f, g, h)` equals to `tag.Intercept(f(g(h())))`.
+func (c *TagClient) Intercept(interceptors ...Interceptor) {
+	c.inters.Tag = append(c.inters.Tag, interceptors...)
+}
+
+// Create returns a builder for creating a Tag entity.
+func (c *TagClient) Create() *TagCreate {
+	mutation := newTagMutation(c.config, OpCreate)
+	return &TagCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// CreateBulk returns a builder for creating a bulk of Tag entities.
+func (c *TagClient) CreateBulk(builders ...*TagCreate) *TagCreateBulk {
+	return &TagCreateBulk{config: c.config, builders: builders}
+}
+
+// MapCreateBulk creates a bulk creation builder from the given slice. For each item in the slice, the function creates
+// a builder and applies setFunc on it.
+func (c *TagClient) MapCreateBulk(slice any, setFunc func(*TagCreate, int)) *TagCreateBulk {
+	rv := reflect.ValueOf(slice)
+	if rv.Kind() != reflect.Slice {
+		return &TagCreateBulk{err: fmt.Errorf("calling to TagClient.MapCreateBulk with wrong type %T, need slice", slice)}
+	}
+	builders := make([]*TagCreate, rv.Len())
+	for i := 0; i < rv.Len(); i++ {
+		builders[i] = c.Create()
+		setFunc(builders[i], i)
+	}
+	return &TagCreateBulk{config: c.config, builders: builders}
+}
+
+// Update returns an update builder for Tag.
+func (c *TagClient) Update() *TagUpdate {
+	mutation := newTagMutation(c.config, OpUpdate)
+	return &TagUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOne returns an update builder for the given entity.
+func (c *TagClient) UpdateOne(t *Tag) *TagUpdateOne {
+	mutation := newTagMutation(c.config, OpUpdateOne, withTag(t))
+	return &TagUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOneID returns an update builder for the given id.
+func (c *TagClient) UpdateOneID(id int) *TagUpdateOne {
+	mutation := newTagMutation(c.config, OpUpdateOne, withTagID(id))
+	return &TagUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// Delete returns a delete builder for Tag.
+func (c *TagClient) Delete() *TagDelete {
+	mutation := newTagMutation(c.config, OpDelete)
+	return &TagDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// DeleteOne returns a builder for deleting the given entity.
+func (c *TagClient) DeleteOne(t *Tag) *TagDeleteOne {
+	return c.DeleteOneID(t.ID)
+}
+
+// DeleteOneID returns a builder for deleting the given entity by its id.
+func (c *TagClient) DeleteOneID(id int) *TagDeleteOne {
+	builder := c.Delete().Where(tag.ID(id))
+	builder.mutation.id = &id
+	builder.mutation.op = OpDeleteOne
+	return &TagDeleteOne{builder}
+}
+
+// Query returns a query builder for Tag.
+func (c *TagClient) Query() *TagQuery {
+	return &TagQuery{
+		config: c.config,
+		ctx:    &QueryContext{Type: TypeTag},
+		inters: c.Interceptors(),
+	}
+}
+
+// Get returns a Tag entity by its id.
+func (c *TagClient) Get(ctx context.Context, id int) (*Tag, error) {
+	return c.Query().Where(tag.ID(id)).Only(ctx)
 }
 
 // GetX is like Get, but panics if an error occurs.
-func (c *SettingsClient) GetX(ctx context.Context, id int) *Settings {
+func (c *TagClient) GetX(ctx context.Context, id int) *Tag {
 	obj, err := c.Get(ctx, id)
 	if err != nil {
 		panic(err)
@@ -998,28 +1976,342 @@ func (c *SettingsClient) GetX(ctx context.Context, id int) *Settings {
 	return obj
 }
 
+// QueryProjects queries the projects edge of a Tag.
+func (c *TagClient) QueryProjects(t *Tag) *ProjectQuery {
+	query := (&ProjectClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := t.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(tag.Table, tag.FieldID, id),
+			sqlgraph.To(project.Table, project.FieldID),
+			sqlgraph.Edge(sqlgraph.M2M, false, tag.ProjectsTable, tag.ProjectsPrimaryKey...),
+		)
+		fromV = sqlgraph.Neighbors(t.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// QueryVideoClips queries the video_clips edge of a Tag.
+func (c *TagClient) QueryVideoClips(t *Tag) *VideoClipQuery {
+	query := (&VideoClipClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := t.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(tag.Table, tag.FieldID, id),
+			sqlgraph.To(videoclip.Table, videoclip.FieldID),
+			sqlgraph.Edge(sqlgraph.M2M, false, tag.VideoClipsTable, tag.VideoClipsPrimaryKey...),
+		)
+		fromV = sqlgraph.Neighbors(t.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
 // Hooks returns the client hooks.
-func (c *SettingsClient) Hooks() []Hook {
-	return c.hooks.Settings
+func (c *TagClient) Hooks() []Hook {
+	return c.hooks.Tag
 }
 
 // Interceptors returns the client interceptors.
-func (c *SettingsClient) Interceptors() []Interceptor {
-	return c.inters.Settings
+func (c *TagClient) Interceptors() []Interceptor {
+	return c.inters.Tag
 }
 
-func (c *SettingsClient) mutate(ctx context.Context, m *SettingsMutation) (Value, error) {
+func (c *TagClient) mutate(ctx context.Context, m *TagMutation) (Value, error) {
 	switch m.Op() {
 	case OpCreate:
-		return (&SettingsCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+		return (&TagCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
 	case OpUpdate:
-		return (&SettingsUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+		return (&TagUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
 	case OpUpdateOne:
-		return (&SettingsUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+		return (&TagUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
 	case OpDelete, OpDeleteOne:
-		return (&SettingsDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
+		return (&TagDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
 	default:
-		return nil, fmt.Errorf("ent: unknown Settings mutation op: %q", m.Op())
+		return nil, fmt.Errorf("ent: unknown Tag mutation op: %q", m.Op())
+	}
+}
+
+// TranscriptEmbeddingClient is a client for the TranscriptEmbedding schema.
+type TranscriptEmbeddingClient struct {
+	config
+}
+
+// NewTranscriptEmbeddingClient returns a client for the TranscriptEmbedding from the given config.
+func NewTranscriptEmbeddingClient(c config) *TranscriptEmbeddingClient {
+	return &TranscriptEmbeddingClient{config: c}
+}
+
+// Use adds a list of mutation hooks to the hooks stack.
+// A call to `Use(f, g, h)` equals to `transcriptembedding.Hooks(f(g(h())))`.
+func (c *TranscriptEmbeddingClient) Use(hooks ...Hook) {
+	c.hooks.TranscriptEmbedding = append(c.hooks.TranscriptEmbedding, hooks...)
+}
+
+// Intercept adds a list of query interceptors to the interceptors stack.
+// A call to `Intercept(f, g, h)` equals to `transcriptembedding.Intercept(f(g(h())))`.
+func (c *TranscriptEmbeddingClient) Intercept(interceptors ...Interceptor) {
+	c.inters.TranscriptEmbedding = append(c.inters.TranscriptEmbedding, interceptors...)
+}
+
+// Create returns a builder for creating a TranscriptEmbedding entity.
+func (c *TranscriptEmbeddingClient) Create() *TranscriptEmbeddingCreate {
+	mutation := newTranscriptEmbeddingMutation(c.config, OpCreate)
+	return &TranscriptEmbeddingCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// CreateBulk returns a builder for creating a bulk of TranscriptEmbedding entities.
+func (c *TranscriptEmbeddingClient) CreateBulk(builders ...*TranscriptEmbeddingCreate) *TranscriptEmbeddingCreateBulk {
+	return &TranscriptEmbeddingCreateBulk{config: c.config, builders: builders}
+}
+
+// MapCreateBulk creates a bulk creation builder from the given slice. For each item in the slice, the function creates
+// a builder and applies setFunc on it.
+func (c *TranscriptEmbeddingClient) MapCreateBulk(slice any, setFunc func(*TranscriptEmbeddingCreate, int)) *TranscriptEmbeddingCreateBulk {
+	rv := reflect.ValueOf(slice)
+	if rv.Kind() != reflect.Slice {
+		return &TranscriptEmbeddingCreateBulk{err: fmt.Errorf("calling to TranscriptEmbeddingClient.MapCreateBulk with wrong type %T, need slice", slice)}
+	}
+	builders := make([]*TranscriptEmbeddingCreate, rv.Len())
+	for i := 0; i < rv.Len(); i++ {
+		builders[i] = c.Create()
+		setFunc(builders[i], i)
+	}
+	return &TranscriptEmbeddingCreateBulk{config: c.config, builders: builders}
+}
+
+// Update returns an update builder for TranscriptEmbedding.
+func (c *TranscriptEmbeddingClient) Update() *TranscriptEmbeddingUpdate {
+	mutation := newTranscriptEmbeddingMutation(c.config, OpUpdate)
+	return &TranscriptEmbeddingUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOne returns an update builder for the given entity.
+func (c *TranscriptEmbeddingClient) UpdateOne(te *TranscriptEmbedding) *TranscriptEmbeddingUpdateOne {
+	mutation := newTranscriptEmbeddingMutation(c.config, OpUpdateOne, withTranscriptEmbedding(te))
+	return &TranscriptEmbeddingUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOneID returns an update builder for the given id.
+func (c *TranscriptEmbeddingClient) UpdateOneID(id int) *TranscriptEmbeddingUpdateOne {
+	mutation := newTranscriptEmbeddingMutation(c.config, OpUpdateOne, withTranscriptEmbeddingID(id))
+	return &TranscriptEmbeddingUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// Delete returns a delete builder for TranscriptEmbedding.
+func (c *TranscriptEmbeddingClient) Delete() *TranscriptEmbeddingDelete {
+	mutation := newTranscriptEmbeddingMutation(c.config, OpDelete)
+	return &TranscriptEmbeddingDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// DeleteOne returns a builder for deleting the given entity.
+func (c *TranscriptEmbeddingClient) DeleteOne(te *TranscriptEmbedding) *TranscriptEmbeddingDeleteOne {
+	return c.DeleteOneID(te.ID)
+}
+
+// DeleteOneID returns a builder for deleting the given entity by its id.
+func (c *TranscriptEmbeddingClient) DeleteOneID(id int) *TranscriptEmbeddingDeleteOne {
+	builder := c.Delete().Where(transcriptembedding.ID(id))
+	builder.mutation.id = &id
+	builder.mutation.op = OpDeleteOne
+	return &TranscriptEmbeddingDeleteOne{builder}
+}
+
+// Query returns a query builder for TranscriptEmbedding.
+func (c *TranscriptEmbeddingClient) Query() *TranscriptEmbeddingQuery {
+	return &TranscriptEmbeddingQuery{
+		config: c.config,
+		ctx:    &QueryContext{Type: TypeTranscriptEmbedding},
+		inters: c.Interceptors(),
+	}
+}
+
+// Get returns a TranscriptEmbedding entity by its id.
+func (c *TranscriptEmbeddingClient) Get(ctx context.Context, id int) (*TranscriptEmbedding, error) {
+	return c.Query().Where(transcriptembedding.ID(id)).Only(ctx)
+}
+
+// GetX is like Get, but panics if an error occurs.
+func (c *TranscriptEmbeddingClient) GetX(ctx context.Context, id int) *TranscriptEmbedding {
+	obj, err := c.Get(ctx, id)
+	if err != nil {
+		panic(err)
+	}
+	return obj
+}
+
+// QueryVideoClip queries the video_clip edge of a TranscriptEmbedding.
+func (c *TranscriptEmbeddingClient) QueryVideoClip(te *TranscriptEmbedding) *VideoClipQuery {
+	query := (&VideoClipClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := te.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(transcriptembedding.Table, transcriptembedding.FieldID, id),
+			sqlgraph.To(videoclip.Table, videoclip.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, transcriptembedding.VideoClipTable, transcriptembedding.VideoClipColumn),
+		)
+		fromV = sqlgraph.Neighbors(te.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// Hooks returns the client hooks.
+func (c *TranscriptEmbeddingClient) Hooks() []Hook {
+	return c.hooks.TranscriptEmbedding
+}
+
+// Interceptors returns the client interceptors.
+func (c *TranscriptEmbeddingClient) Interceptors() []Interceptor {
+	return c.inters.TranscriptEmbedding
+}
+
+func (c *TranscriptEmbeddingClient) mutate(ctx context.Context, m *TranscriptEmbeddingMutation) (Value, error) {
+	switch m.Op() {
+	case OpCreate:
+		return (&TranscriptEmbeddingCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdate:
+		return (&TranscriptEmbeddingUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdateOne:
+		return (&TranscriptEmbeddingUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpDelete, OpDeleteOne:
+		return (&TranscriptEmbeddingDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
+	default:
+		return nil, fmt.Errorf("ent: unknown TranscriptEmbedding mutation op: %q", m.Op())
+	}
+}
+
+// UsageRecordClient is a client for the UsageRecord schema.
+type UsageRecordClient struct {
+	config
+}
+
+// NewUsageRecordClient returns a client for the UsageRecord from the given config.
+func NewUsageRecordClient(c config) *UsageRecordClient {
+	return &UsageRecordClient{config: c}
+}
+
+// Use adds a list of mutation hooks to the hooks stack.
+// A call to `Use(f, g, h)` equals to `usagerecord.Hooks(f(g(h())))`.
+func (c *UsageRecordClient) Use(hooks ...Hook) {
+	c.hooks.UsageRecord = append(c.hooks.UsageRecord, hooks...)
+}
+
+// Intercept adds a list of query interceptors to the interceptors stack.
+// A call to `Intercept(f, g, h)` equals to `usagerecord.Intercept(f(g(h())))`.
+func (c *UsageRecordClient) Intercept(interceptors ...Interceptor) {
+	c.inters.UsageRecord = append(c.inters.UsageRecord, interceptors...)
+}
+
+// Create returns a builder for creating a UsageRecord entity.
+func (c *UsageRecordClient) Create() *UsageRecordCreate {
+	mutation := newUsageRecordMutation(c.config, OpCreate)
+	return &UsageRecordCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// CreateBulk returns a builder for creating a bulk of UsageRecord entities.
+func (c *UsageRecordClient) CreateBulk(builders ...*UsageRecordCreate) *UsageRecordCreateBulk {
+	return &UsageRecordCreateBulk{config: c.config, builders: builders}
+}
+
+// MapCreateBulk creates a bulk creation builder from the given slice. For each item in the slice, the function creates
+// a builder and applies setFunc on it.
+func (c *UsageRecordClient) MapCreateBulk(slice any, setFunc func(*UsageRecordCreate, int)) *UsageRecordCreateBulk {
+	rv := reflect.ValueOf(slice)
+	if rv.Kind() != reflect.Slice {
+		return &UsageRecordCreateBulk{err: fmt.Errorf("calling to UsageRecordClient.MapCreateBulk with wrong type %T, need slice", slice)}
+	}
+	builders := make([]*UsageRecordCreate, rv.Len())
+	for i := 0; i < rv.Len(); i++ {
+		builders[i] = c.Create()
+		setFunc(builders[i], i)
+	}
+	return &UsageRecordCreateBulk{config: c.config, builders: builders}
+}
+
+// Update returns an update builder for UsageRecord.
+func (c *UsageRecordClient) Update() *UsageRecordUpdate {
+	mutation := newUsageRecordMutation(c.config, OpUpdate)
+	return &UsageRecordUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOne returns an update builder for the given entity.
+func (c *UsageRecordClient) UpdateOne(ur *UsageRecord) *UsageRecordUpdateOne {
+	mutation := newUsageRecordMutation(c.config, OpUpdateOne, withUsageRecord(ur))
+	return &UsageRecordUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOneID returns an update builder for the given id.
+func (c *UsageRecordClient) UpdateOneID(id int) *UsageRecordUpdateOne {
+	mutation := newUsageRecordMutation(c.config, OpUpdateOne, withUsageRecordID(id))
+	return &UsageRecordUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// Delete returns a delete builder for UsageRecord.
+func (c *UsageRecordClient) Delete() *UsageRecordDelete {
+	mutation := newUsageRecordMutation(c.config, OpDelete)
+	return &UsageRecordDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// DeleteOne returns a builder for deleting the given entity.
+func (c *UsageRecordClient) DeleteOne(ur *UsageRecord) *UsageRecordDeleteOne {
+	return c.DeleteOneID(ur.ID)
+}
+
+// DeleteOneID returns a builder for deleting the given entity by its id.
+func (c *UsageRecordClient) DeleteOneID(id int) *UsageRecordDeleteOne {
+	builder := c.Delete().Where(usagerecord.ID(id))
+	builder.mutation.id = &id
+	builder.mutation.op = OpDeleteOne
+	return &UsageRecordDeleteOne{builder}
+}
+
+// Query returns a query builder for UsageRecord.
+func (c *UsageRecordClient) Query() *UsageRecordQuery {
+	return &UsageRecordQuery{
+		config: c.config,
+		ctx:    &QueryContext{Type: TypeUsageRecord},
+		inters: c.Interceptors(),
+	}
+}
+
+// Get returns a UsageRecord entity by its id.
+func (c *UsageRecordClient) Get(ctx context.Context, id int) (*UsageRecord, error) {
+	return c.Query().Where(usagerecord.ID(id)).Only(ctx)
+}
+
+// GetX is like Get, but panics if an error occurs.
+func (c *UsageRecordClient) GetX(ctx context.Context, id int) *UsageRecord {
+	obj, err := c.Get(ctx, id)
+	if err != nil {
+		panic(err)
+	}
+	return obj
+}
+
+// Hooks returns the client hooks.
+func (c *UsageRecordClient) Hooks() []Hook {
+	return c.hooks.UsageRecord
+}
+
+// Interceptors returns the client interceptors.
+func (c *UsageRecordClient) Interceptors() []Interceptor {
+	return c.inters.UsageRecord
+}
+
+func (c *UsageRecordClient) mutate(ctx context.Context, m *UsageRecordMutation) (Value, error) {
+	switch m.Op() {
+	case OpCreate:
+		return (&UsageRecordCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdate:
+		return (&UsageRecordUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdateOne:
+		return (&UsageRecordUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpDelete, OpDeleteOne:
+		return (&UsageRecordDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
+	default:
+		return nil, fmt.Errorf("ent: unknown UsageRecord mutation op: %q", m.Op())
 	}
 }
 
@@ -1147,6 +2439,38 @@ func (c *VideoClipClient) QueryProject(vc *VideoClip) *ProjectQuery {
 	return query
 }
 
+// QueryTranscriptEmbeddings queries the transcript_embeddings edge of a VideoClip.
+func (c *VideoClipClient) QueryTranscriptEmbeddings(vc *VideoClip) *TranscriptEmbeddingQuery {
+	query := (&TranscriptEmbeddingClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := vc.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(videoclip.Table, videoclip.FieldID, id),
+			sqlgraph.To(transcriptembedding.Table, transcriptembedding.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, videoclip.TranscriptEmbeddingsTable, videoclip.TranscriptEmbeddingsColumn),
+		)
+		fromV = sqlgraph.Neighbors(vc.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// QueryTags queries the tags edge of a VideoClip.
+func (c *VideoClipClient) QueryTags(vc *VideoClip) *TagQuery {
+	query := (&TagClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := vc.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(videoclip.Table, videoclip.FieldID, id),
+			sqlgraph.To(tag.Table, tag.FieldID),
+			sqlgraph.Edge(sqlgraph.M2M, true, videoclip.TagsTable, videoclip.TagsPrimaryKey...),
+		)
+		fromV = sqlgraph.Neighbors(vc.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
 // Hooks returns the client hooks.
 func (c *VideoClipClient) Hooks() []Hook {
 	return c.hooks.VideoClip
@@ -1175,10 +2499,13 @@ func (c *VideoClipClient) mutate(ctx context.Context, m *VideoClipMutation) (Val
 // hooks and interceptors per client, for fast access.
 type (
 	hooks struct {
-		ChatMessage, ChatSession, ExportJob, Project, Settings, VideoClip []ent.Hook
+		ChatMessage, ChatSession, Collection, Cut, ExportJob, Project, ProjectTemplate,
+		Prompt, ResponseCacheEntry, Settings, Tag, TranscriptEmbedding, UsageRecord,
+		VideoClip []ent.Hook
 	}
 	inters struct {
-		ChatMessage, ChatSession, ExportJob, Project, Settings,
+		ChatMessage, ChatSession, Collection, Cut, ExportJob, Project, ProjectTemplate,
+		Prompt, ResponseCacheEntry, Settings, Tag, TranscriptEmbedding, UsageRecord,
 		VideoClip []ent.Interceptor
 	}
 )
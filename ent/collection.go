@@ -0,0 +1,165 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"fmt"
+	"ramble-ai/ent/collection"
+	"strings"
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/sql"
+)
+
+// Collection is the model entity for the Collection schema.
+type Collection struct {
+	config `json:"-"`
+	// ID of the ent.
+	ID int `json:"id,omitempty"`
+	// Collection name
+	Name string `json:"name,omitempty"`
+	// Collection description
+	Description string `json:"description,omitempty"`
+	// Creation timestamp
+	CreatedAt time.Time `json:"created_at,omitempty"`
+	// Last update timestamp
+	UpdatedAt time.Time `json:"updated_at,omitempty"`
+	// Edges holds the relations/edges for other nodes in the graph.
+	// The values are being populated by the CollectionQuery when eager-loading is set.
+	Edges        CollectionEdges `json:"edges"`
+	selectValues sql.SelectValues
+}
+
+// CollectionEdges holds the relations/edges for other nodes in the graph.
+type CollectionEdges struct {
+	// Projects in this collection
+	Projects []*Project `json:"projects,omitempty"`
+	// loadedTypes holds the information for reporting if a
+	// type was loaded (or requested) in eager-loading or not.
+	loadedTypes [1]bool
+}
+
+// ProjectsOrErr returns the Projects value or an error if the edge
+// was not loaded in eager-loading.
+func (e CollectionEdges) ProjectsOrErr() ([]*Project, error) {
+	if e.loadedTypes[0] {
+		return e.Projects, nil
+	}
+	return nil, &NotLoadedError{edge: "projects"}
+}
+
+// scanValues returns the types for scanning values from sql.Rows.
+func (*Collection) scanValues(columns []string) ([]any, error) {
+	values := make([]any, len(columns))
+	for i := range columns {
+		switch columns[i] {
+		case collection.FieldID:
+			values[i] = new(sql.NullInt64)
+		case collection.FieldName, collection.FieldDescription:
+			values[i] = new(sql.NullString)
+		case collection.FieldCreatedAt, collection.FieldUpdatedAt:
+			values[i] = new(sql.NullTime)
+		default:
+			values[i] = new(sql.UnknownType)
+		}
+	}
+	return values, nil
+}
+
+// assignValues assigns the values that were returned from sql.Rows (after scanning)
+// to the Collection fields.
+func (c *Collection) assignValues(columns []string, values []any) error {
+	if m, n := len(values), len(columns); m < n {
+		return fmt.Errorf("mismatch number of scan values: %d != %d", m, n)
+	}
+	for i := range columns {
+		switch columns[i] {
+		case collection.FieldID:
+			value, ok := values[i].(*sql.NullInt64)
+			if !ok {
+				return fmt.Errorf("unexpected type %T for field id", value)
+			}
+			c.ID = int(value.Int64)
+		case collection.FieldName:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field name", values[i])
+			} else if value.Valid {
+				c.Name = value.String
+			}
+		case collection.FieldDescription:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field description", values[i])
+			} else if value.Valid {
+				c.Description = value.String
+			}
+		case collection.FieldCreatedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field created_at", values[i])
+			} else if value.Valid {
+				c.CreatedAt = value.Time
+			}
+		case collection.FieldUpdatedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field updated_at", values[i])
+			} else if value.Valid {
+				c.UpdatedAt = value.Time
+			}
+		default:
+			c.selectValues.Set(columns[i], values[i])
+		}
+	}
+	return nil
+}
+
+// Value returns the ent.Value that was dynamically selected and assigned to the Collection.
+// This includes values selected through modifiers, order, etc.
+func (c *Collection) Value(name string) (ent.Value, error) {
+	return c.selectValues.Get(name)
+}
+
+// QueryProjects queries the "projects" edge of the Collection entity.
+func (c *Collection) QueryProjects() *ProjectQuery {
+	return NewCollectionClient(c.config).QueryProjects(c)
+}
+
+// Update returns a builder for updating this Collection.
+// Note that you need to call Collection.Unwrap() before calling this method if this Collection
+// was returned from a transaction, and the transaction was committed or rolled back.
+func (c *Collection) Update() *CollectionUpdateOne {
+	return NewCollectionClient(c.config).UpdateOne(c)
+}
+
+// Unwrap unwraps the Collection entity that was returned from a transaction after it was closed,
+// so that all future queries will be executed through the driver which created the transaction.
+func (c *Collection) Unwrap() *Collection {
+	_tx, ok := c.config.driver.(*txDriver)
+	if !ok {
+		panic("ent: Collection is not a transactional entity")
+	}
+	c.config.driver = _tx.drv
+	return c
+}
+
+// String implements the fmt.Stringer.
+func (c *Collection) String() string {
+	var builder strings.Builder
+	builder.WriteString("Collection(")
+	builder.WriteString(fmt.Sprintf("id=%v, ", c.ID))
+	builder.WriteString("name=")
+	builder.WriteString(c.Name)
+	builder.WriteString(", ")
+	builder.WriteString("description=")
+	builder.WriteString(c.Description)
+	builder.WriteString(", ")
+	builder.WriteString("created_at=")
+	builder.WriteString(c.CreatedAt.Format(time.ANSIC))
+	builder.WriteString(", ")
+	builder.WriteString("updated_at=")
+	builder.WriteString(c.UpdatedAt.Format(time.ANSIC))
+	builder.WriteByte(')')
+	return builder.String()
+}
+
+// Collections is a parsable slice of Collection.
+type Collections []*Collection
@@ -0,0 +1,521 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"ramble-ai/ent/collection"
+	"ramble-ai/ent/predicate"
+	"ramble-ai/ent/project"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+)
+
+// CollectionUpdate is the builder for updating Collection entities.
+type CollectionUpdate struct {
+	config
+	hooks    []Hook
+	mutation *CollectionMutation
+}
+
+// Where appends a list predicates to the CollectionUpdate builder.
+func (cu *CollectionUpdate) Where(ps ...predicate.Collection) *CollectionUpdate {
+	cu.mutation.Where(ps...)
+	return cu
+}
+
+// SetName sets the "name" field.
+func (cu *CollectionUpdate) SetName(s string) *CollectionUpdate {
+	cu.mutation.SetName(s)
+	return cu
+}
+
+// SetNillableName sets the "name" field if the given value is not nil.
+func (cu *CollectionUpdate) SetNillableName(s *string) *CollectionUpdate {
+	if s != nil {
+		cu.SetName(*s)
+	}
+	return cu
+}
+
+// SetDescription sets the "description" field.
+func (cu *CollectionUpdate) SetDescription(s string) *CollectionUpdate {
+	cu.mutation.SetDescription(s)
+	return cu
+}
+
+// SetNillableDescription sets the "description" field if the given value is not nil.
+func (cu *CollectionUpdate) SetNillableDescription(s *string) *CollectionUpdate {
+	if s != nil {
+		cu.SetDescription(*s)
+	}
+	return cu
+}
+
+// ClearDescription clears the value of the "description" field.
+func (cu *CollectionUpdate) ClearDescription() *CollectionUpdate {
+	cu.mutation.ClearDescription()
+	return cu
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (cu *CollectionUpdate) SetCreatedAt(t time.Time) *CollectionUpdate {
+	cu.mutation.SetCreatedAt(t)
+	return cu
+}
+
+// SetNillableCreatedAt sets the "created_at" field if the given value is not nil.
+func (cu *CollectionUpdate) SetNillableCreatedAt(t *time.Time) *CollectionUpdate {
+	if t != nil {
+		cu.SetCreatedAt(*t)
+	}
+	return cu
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (cu *CollectionUpdate) SetUpdatedAt(t time.Time) *CollectionUpdate {
+	cu.mutation.SetUpdatedAt(t)
+	return cu
+}
+
+// AddProjectIDs adds the "projects" edge to the Project entity by IDs.
+func (cu *CollectionUpdate) AddProjectIDs(ids ...int) *CollectionUpdate {
+	cu.mutation.AddProjectIDs(ids...)
+	return cu
+}
+
+// AddProjects adds the "projects" edges to the Project entity.
+func (cu *CollectionUpdate) AddProjects(p ...*Project) *CollectionUpdate {
+	ids := make([]int, len(p))
+	for i := range p {
+		ids[i] = p[i].ID
+	}
+	return cu.AddProjectIDs(ids...)
+}
+
+// Mutation returns the CollectionMutation object of the builder.
+func (cu *CollectionUpdate) Mutation() *CollectionMutation {
+	return cu.mutation
+}
+
+// ClearProjects clears all "projects" edges to the Project entity.
+func (cu *CollectionUpdate) ClearProjects() *CollectionUpdate {
+	cu.mutation.ClearProjects()
+	return cu
+}
+
+// RemoveProjectIDs removes the "projects" edge to Project entities by IDs.
+func (cu *CollectionUpdate) RemoveProjectIDs(ids ...int) *CollectionUpdate {
+	cu.mutation.RemoveProjectIDs(ids...)
+	return cu
+}
+
+// RemoveProjects removes "projects" edges to Project entities.
+func (cu *CollectionUpdate) RemoveProjects(p ...*Project) *CollectionUpdate {
+	ids := make([]int, len(p))
+	for i := range p {
+		ids[i] = p[i].ID
+	}
+	return cu.RemoveProjectIDs(ids...)
+}
+
+// Save executes the query and returns the number of nodes affected by the update operation.
+func (cu *CollectionUpdate) Save(ctx context.Context) (int, error) {
+	cu.defaults()
+	return withHooks(ctx, cu.sqlSave, cu.mutation, cu.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (cu *CollectionUpdate) SaveX(ctx context.Context) int {
+	affected, err := cu.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return affected
+}
+
+// Exec executes the query.
+func (cu *CollectionUpdate) Exec(ctx context.Context) error {
+	_, err := cu.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (cu *CollectionUpdate) ExecX(ctx context.Context) {
+	if err := cu.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (cu *CollectionUpdate) defaults() {
+	if _, ok := cu.mutation.UpdatedAt(); !ok {
+		v := collection.UpdateDefaultUpdatedAt()
+		cu.mutation.SetUpdatedAt(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (cu *CollectionUpdate) check() error {
+	if v, ok := cu.mutation.Name(); ok {
+		if err := collection.NameValidator(v); err != nil {
+			return &ValidationError{Name: "name", err: fmt.Errorf(`ent: validator failed for field "Collection.name": %w`, err)}
+		}
+	}
+	return nil
+}
+
+func (cu *CollectionUpdate) sqlSave(ctx context.Context) (n int, err error) {
+	if err := cu.check(); err != nil {
+		return n, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(collection.Table, collection.Columns, sqlgraph.NewFieldSpec(collection.FieldID, field.TypeInt))
+	if ps := cu.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := cu.mutation.Name(); ok {
+		_spec.SetField(collection.FieldName, field.TypeString, value)
+	}
+	if value, ok := cu.mutation.Description(); ok {
+		_spec.SetField(collection.FieldDescription, field.TypeString, value)
+	}
+	if cu.mutation.DescriptionCleared() {
+		_spec.ClearField(collection.FieldDescription, field.TypeString)
+	}
+	if value, ok := cu.mutation.CreatedAt(); ok {
+		_spec.SetField(collection.FieldCreatedAt, field.TypeTime, value)
+	}
+	if value, ok := cu.mutation.UpdatedAt(); ok {
+		_spec.SetField(collection.FieldUpdatedAt, field.TypeTime, value)
+	}
+	if cu.mutation.ProjectsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2M,
+			Inverse: false,
+			Table:   collection.ProjectsTable,
+			Columns: collection.ProjectsPrimaryKey,
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(project.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := cu.mutation.RemovedProjectsIDs(); len(nodes) > 0 && !cu.mutation.ProjectsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2M,
+			Inverse: false,
+			Table:   collection.ProjectsTable,
+			Columns: collection.ProjectsPrimaryKey,
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(project.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := cu.mutation.ProjectsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2M,
+			Inverse: false,
+			Table:   collection.ProjectsTable,
+			Columns: collection.ProjectsPrimaryKey,
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(project.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if n, err = sqlgraph.UpdateNodes(ctx, cu.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{collection.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return 0, err
+	}
+	cu.mutation.done = true
+	return n, nil
+}
+
+// CollectionUpdateOne is the builder for updating a single Collection entity.
+type CollectionUpdateOne struct {
+	config
+	fields   []string
+	hooks    []Hook
+	mutation *CollectionMutation
+}
+
+// SetName sets the "name" field.
+func (cuo *CollectionUpdateOne) SetName(s string) *CollectionUpdateOne {
+	cuo.mutation.SetName(s)
+	return cuo
+}
+
+// SetNillableName sets the "name" field if the given value is not nil.
+func (cuo *CollectionUpdateOne) SetNillableName(s *string) *CollectionUpdateOne {
+	if s != nil {
+		cuo.SetName(*s)
+	}
+	return cuo
+}
+
+// SetDescription sets the "description" field.
+func (cuo *CollectionUpdateOne) SetDescription(s string) *CollectionUpdateOne {
+	cuo.mutation.SetDescription(s)
+	return cuo
+}
+
+// SetNillableDescription sets the "description" field if the given value is not nil.
+func (cuo *CollectionUpdateOne) SetNillableDescription(s *string) *CollectionUpdateOne {
+	if s != nil {
+		cuo.SetDescription(*s)
+	}
+	return cuo
+}
+
+// ClearDescription clears the value of the "description" field.
+func (cuo *CollectionUpdateOne) ClearDescription() *CollectionUpdateOne {
+	cuo.mutation.ClearDescription()
+	return cuo
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (cuo *CollectionUpdateOne) SetCreatedAt(t time.Time) *CollectionUpdateOne {
+	cuo.mutation.SetCreatedAt(t)
+	return cuo
+}
+
+// SetNillableCreatedAt sets the "created_at" field if the given value is not nil.
+func (cuo *CollectionUpdateOne) SetNillableCreatedAt(t *time.Time) *CollectionUpdateOne {
+	if t != nil {
+		cuo.SetCreatedAt(*t)
+	}
+	return cuo
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (cuo *CollectionUpdateOne) SetUpdatedAt(t time.Time) *CollectionUpdateOne {
+	cuo.mutation.SetUpdatedAt(t)
+	return cuo
+}
+
+// AddProjectIDs adds the "projects" edge to the Project entity by IDs.
+func (cuo *CollectionUpdateOne) AddProjectIDs(ids ...int) *CollectionUpdateOne {
+	cuo.mutation.AddProjectIDs(ids...)
+	return cuo
+}
+
+// AddProjects adds the "projects" edges to the Project entity.
+func (cuo *CollectionUpdateOne) AddProjects(p ...*Project) *CollectionUpdateOne {
+	ids := make([]int, len(p))
+	for i := range p {
+		ids[i] = p[i].ID
+	}
+	return cuo.AddProjectIDs(ids...)
+}
+
+// Mutation returns the CollectionMutation object of the builder.
+func (cuo *CollectionUpdateOne) Mutation() *CollectionMutation {
+	return cuo.mutation
+}
+
+// ClearProjects clears all "projects" edges to the Project entity.
+func (cuo *CollectionUpdateOne) ClearProjects() *CollectionUpdateOne {
+	cuo.mutation.ClearProjects()
+	return cuo
+}
+
+// RemoveProjectIDs removes the "projects" edge to Project entities by IDs.
+func (cuo *CollectionUpdateOne) RemoveProjectIDs(ids ...int) *CollectionUpdateOne {
+	cuo.mutation.RemoveProjectIDs(ids...)
+	return cuo
+}
+
+// RemoveProjects removes "projects" edges to Project entities.
+func (cuo *CollectionUpdateOne) RemoveProjects(p ...*Project) *CollectionUpdateOne {
+	ids := make([]int, len(p))
+	for i := range p {
+		ids[i] = p[i].ID
+	}
+	return cuo.RemoveProjectIDs(ids...)
+}
+
+// Where appends a list predicates to the CollectionUpdate builder.
+func (cuo *CollectionUpdateOne) Where(ps ...predicate.Collection) *CollectionUpdateOne {
+	cuo.mutation.Where(ps...)
+	return cuo
+}
+
+// Select allows selecting one or more fields (columns) of the returned entity.
+// The default is selecting all fields defined in the entity schema.
+func (cuo *CollectionUpdateOne) Select(field string, fields ...string) *CollectionUpdateOne {
+	cuo.fields = append([]string{field}, fields...)
+	return cuo
+}
+
+// Save executes the query and returns the updated Collection entity.
+func (cuo *CollectionUpdateOne) Save(ctx context.Context) (*Collection, error) {
+	cuo.defaults()
+	return withHooks(ctx, cuo.sqlSave, cuo.mutation, cuo.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (cuo *CollectionUpdateOne) SaveX(ctx context.Context) *Collection {
+	node, err := cuo.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// Exec executes the query on the entity.
+func (cuo *CollectionUpdateOne) Exec(ctx context.Context) error {
+	_, err := cuo.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (cuo *CollectionUpdateOne) ExecX(ctx context.Context) {
+	if err := cuo.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (cuo *CollectionUpdateOne) defaults() {
+	if _, ok := cuo.mutation.UpdatedAt(); !ok {
+		v := collection.UpdateDefaultUpdatedAt()
+		cuo.mutation.SetUpdatedAt(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (cuo *CollectionUpdateOne) check() error {
+	if v, ok := cuo.mutation.Name(); ok {
+		if err := collection.NameValidator(v); err != nil {
+			return &ValidationError{Name: "name", err: fmt.Errorf(`ent: validator failed for field "Collection.name": %w`, err)}
+		}
+	}
+	return nil
+}
+
+func (cuo *CollectionUpdateOne) sqlSave(ctx context.Context) (_node *Collection, err error) {
+	if err := cuo.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(collection.Table, collection.Columns, sqlgraph.NewFieldSpec(collection.FieldID, field.TypeInt))
+	id, ok := cuo.mutation.ID()
+	if !ok {
+		return nil, &ValidationError{Name: "id", err: errors.New(`ent: missing "Collection.id" for update`)}
+	}
+	_spec.Node.ID.Value = id
+	if fields := cuo.fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, collection.FieldID)
+		for _, f := range fields {
+			if !collection.ValidColumn(f) {
+				return nil, &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+			}
+			if f != collection.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, f)
+			}
+		}
+	}
+	if ps := cuo.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := cuo.mutation.Name(); ok {
+		_spec.SetField(collection.FieldName, field.TypeString, value)
+	}
+	if value, ok := cuo.mutation.Description(); ok {
+		_spec.SetField(collection.FieldDescription, field.TypeString, value)
+	}
+	if cuo.mutation.DescriptionCleared() {
+		_spec.ClearField(collection.FieldDescription, field.TypeString)
+	}
+	if value, ok := cuo.mutation.CreatedAt(); ok {
+		_spec.SetField(collection.FieldCreatedAt, field.TypeTime, value)
+	}
+	if value, ok := cuo.mutation.UpdatedAt(); ok {
+		_spec.SetField(collection.FieldUpdatedAt, field.TypeTime, value)
+	}
+	if cuo.mutation.ProjectsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2M,
+			Inverse: false,
+			Table:   collection.ProjectsTable,
+			Columns: collection.ProjectsPrimaryKey,
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(project.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := cuo.mutation.RemovedProjectsIDs(); len(nodes) > 0 && !cuo.mutation.ProjectsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2M,
+			Inverse: false,
+			Table:   collection.ProjectsTable,
+			Columns: collection.ProjectsPrimaryKey,
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(project.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := cuo.mutation.ProjectsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2M,
+			Inverse: false,
+			Table:   collection.ProjectsTable,
+			Columns: collection.ProjectsPrimaryKey,
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(project.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	_node = &Collection{config: cuo.config}
+	_spec.Assign = _node.assignValues
+	_spec.ScanValues = _node.scanValues
+	if err = sqlgraph.UpdateNode(ctx, cuo.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{collection.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	cuo.mutation.done = true
+	return _node, nil
+}
@@ -0,0 +1,221 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"encoding/json"
+	"fmt"
+	"ramble-ai/ent/cut"
+	"ramble-ai/ent/project"
+	"strings"
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/sql"
+)
+
+// Cut is the model entity for the Cut schema.
+type Cut struct {
+	config `json:"-"`
+	// ID of the ent.
+	ID int `json:"id,omitempty"`
+	// Cut name (e.g. 'YouTube', '3-minute recap')
+	Name string `json:"name,omitempty"`
+	// ID of the project this cut belongs to
+	ProjectID int `json:"project_id,omitempty"`
+	// Highlight order of this cut (array of highlight IDs, 'N' for newlines, or newline objects with titles)
+	HighlightOrder []interface{} `json:"highlight_order,omitempty"`
+	// FIFO history of this cut's highlight orders
+	OrderHistory [][]interface{} `json:"order_history,omitempty"`
+	// Current position in order history (-1 = no history)
+	OrderHistoryIndex int `json:"order_history_index,omitempty"`
+	// Highlight IDs left out of this cut
+	HiddenHighlights []string `json:"hidden_highlights,omitempty"`
+	// Creation timestamp
+	CreatedAt time.Time `json:"created_at,omitempty"`
+	// Last update timestamp
+	UpdatedAt time.Time `json:"updated_at,omitempty"`
+	// Edges holds the relations/edges for other nodes in the graph.
+	// The values are being populated by the CutQuery when eager-loading is set.
+	Edges        CutEdges `json:"edges"`
+	selectValues sql.SelectValues
+}
+
+// CutEdges holds the relations/edges for other nodes in the graph.
+type CutEdges struct {
+	// Project holds the value of the project edge.
+	Project *Project `json:"project,omitempty"`
+	// loadedTypes holds the information for reporting if a
+	// type was loaded (or requested) in eager-loading or not.
+	loadedTypes [1]bool
+}
+
+// ProjectOrErr returns the Project value or an error if the edge
+// was not loaded in eager-loading, or loaded but was not found.
+func (e CutEdges) ProjectOrErr() (*Project, error) {
+	if e.Project != nil {
+		return e.Project, nil
+	} else if e.loadedTypes[0] {
+		return nil, &NotFoundError{label: project.Label}
+	}
+	return nil, &NotLoadedError{edge: "project"}
+}
+
+// scanValues returns the types for scanning values from sql.Rows.
+func (*Cut) scanValues(columns []string) ([]any, error) {
+	values := make([]any, len(columns))
+	for i := range columns {
+		switch columns[i] {
+		case cut.FieldHighlightOrder, cut.FieldOrderHistory, cut.FieldHiddenHighlights:
+			values[i] = new([]byte)
+		case cut.FieldID, cut.FieldProjectID, cut.FieldOrderHistoryIndex:
+			values[i] = new(sql.NullInt64)
+		case cut.FieldName:
+			values[i] = new(sql.NullString)
+		case cut.FieldCreatedAt, cut.FieldUpdatedAt:
+			values[i] = new(sql.NullTime)
+		default:
+			values[i] = new(sql.UnknownType)
+		}
+	}
+	return values, nil
+}
+
+// assignValues assigns the values that were returned from sql.Rows (after scanning)
+// to the Cut fields.
+func (c *Cut) assignValues(columns []string, values []any) error {
+	if m, n := len(values), len(columns); m < n {
+		return fmt.Errorf("mismatch number of scan values: %d != %d", m, n)
+	}
+	for i := range columns {
+		switch columns[i] {
+		case cut.FieldID:
+			value, ok := values[i].(*sql.NullInt64)
+			if !ok {
+				return fmt.Errorf("unexpected type %T for field id", value)
+			}
+			c.ID = int(value.Int64)
+		case cut.FieldName:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field name", values[i])
+			} else if value.Valid {
+				c.Name = value.String
+			}
+		case cut.FieldProjectID:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field project_id", values[i])
+			} else if value.Valid {
+				c.ProjectID = int(value.Int64)
+			}
+		case cut.FieldHighlightOrder:
+			if value, ok := values[i].(*[]byte); !ok {
+				return fmt.Errorf("unexpected type %T for field highlight_order", values[i])
+			} else if value != nil && len(*value) > 0 {
+				if err := json.Unmarshal(*value, &c.HighlightOrder); err != nil {
+					return fmt.Errorf("unmarshal field highlight_order: %w", err)
+				}
+			}
+		case cut.FieldOrderHistory:
+			if value, ok := values[i].(*[]byte); !ok {
+				return fmt.Errorf("unexpected type %T for field order_history", values[i])
+			} else if value != nil && len(*value) > 0 {
+				if err := json.Unmarshal(*value, &c.OrderHistory); err != nil {
+					return fmt.Errorf("unmarshal field order_history: %w", err)
+				}
+			}
+		case cut.FieldOrderHistoryIndex:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field order_history_index", values[i])
+			} else if value.Valid {
+				c.OrderHistoryIndex = int(value.Int64)
+			}
+		case cut.FieldHiddenHighlights:
+			if value, ok := values[i].(*[]byte); !ok {
+				return fmt.Errorf("unexpected type %T for field hidden_highlights", values[i])
+			} else if value != nil && len(*value) > 0 {
+				if err := json.Unmarshal(*value, &c.HiddenHighlights); err != nil {
+					return fmt.Errorf("unmarshal field hidden_highlights: %w", err)
+				}
+			}
+		case cut.FieldCreatedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field created_at", values[i])
+			} else if value.Valid {
+				c.CreatedAt = value.Time
+			}
+		case cut.FieldUpdatedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field updated_at", values[i])
+			} else if value.Valid {
+				c.UpdatedAt = value.Time
+			}
+		default:
+			c.selectValues.Set(columns[i], values[i])
+		}
+	}
+	return nil
+}
+
+// Value returns the ent.Value that was dynamically selected and assigned to the Cut.
+// This includes values selected through modifiers, order, etc.
+func (c *Cut) Value(name string) (ent.Value, error) {
+	return c.selectValues.Get(name)
+}
+
+// QueryProject queries the "project" edge of the Cut entity.
+func (c *Cut) QueryProject() *ProjectQuery {
+	return NewCutClient(c.config).QueryProject(c)
+}
+
+// Update returns a builder for updating this Cut.
+// Note that you need to call Cut.Unwrap() before calling this method if this Cut
+// was returned from a transaction, and the transaction was committed or rolled back.
+func (c *Cut) Update() *CutUpdateOne {
+	return NewCutClient(c.config).UpdateOne(c)
+}
+
+// Unwrap unwraps the Cut entity that was returned from a transaction after it was closed,
+// so that all future queries will be executed through the driver which created the transaction.
+func (c *Cut) Unwrap() *Cut {
+	_tx, ok := c.config.driver.(*txDriver)
+	if !ok {
+		panic("ent: Cut is not a transactional entity")
+	}
+	c.config.driver = _tx.drv
+	return c
+}
+
+// String implements the fmt.Stringer.
+func (c *Cut) String() string {
+	var builder strings.Builder
+	builder.WriteString("Cut(")
+	builder.WriteString(fmt.Sprintf("id=%v, ", c.ID))
+	builder.WriteString("name=")
+	builder.WriteString(c.Name)
+	builder.WriteString(", ")
+	builder.WriteString("project_id=")
+	builder.WriteString(fmt.Sprintf("%v", c.ProjectID))
+	builder.WriteString(", ")
+	builder.WriteString("highlight_order=")
+	builder.WriteString(fmt.Sprintf("%v", c.HighlightOrder))
+	builder.WriteString(", ")
+	builder.WriteString("order_history=")
+	builder.WriteString(fmt.Sprintf("%v", c.OrderHistory))
+	builder.WriteString(", ")
+	builder.WriteString("order_history_index=")
+	builder.WriteString(fmt.Sprintf("%v", c.OrderHistoryIndex))
+	builder.WriteString(", ")
+	builder.WriteString("hidden_highlights=")
+	builder.WriteString(fmt.Sprintf("%v", c.HiddenHighlights))
+	builder.WriteString(", ")
+	builder.WriteString("created_at=")
+	builder.WriteString(c.CreatedAt.Format(time.ANSIC))
+	builder.WriteString(", ")
+	builder.WriteString("updated_at=")
+	builder.WriteString(c.UpdatedAt.Format(time.ANSIC))
+	builder.WriteByte(')')
+	return builder.String()
+}
+
+// Cuts is a parsable slice of Cut.
+type Cuts []*Cut
@@ -0,0 +1,364 @@
+// Code generated by ent, DO NOT EDIT.
+
+package cut
+
+import (
+	"ramble-ai/ent/predicate"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+)
+
+// ID filters vertices based on their ID field.
+func ID(id int) predicate.Cut {
+	return predicate.Cut(sql.FieldEQ(FieldID, id))
+}
+
+// IDEQ applies the EQ predicate on the ID field.
+func IDEQ(id int) predicate.Cut {
+	return predicate.Cut(sql.FieldEQ(FieldID, id))
+}
+
+// IDNEQ applies the NEQ predicate on the ID field.
+func IDNEQ(id int) predicate.Cut {
+	return predicate.Cut(sql.FieldNEQ(FieldID, id))
+}
+
+// IDIn applies the In predicate on the ID field.
+func IDIn(ids ...int) predicate.Cut {
+	return predicate.Cut(sql.FieldIn(FieldID, ids...))
+}
+
+// IDNotIn applies the NotIn predicate on the ID field.
+func IDNotIn(ids ...int) predicate.Cut {
+	return predicate.Cut(sql.FieldNotIn(FieldID, ids...))
+}
+
+// IDGT applies the GT predicate on the ID field.
+func IDGT(id int) predicate.Cut {
+	return predicate.Cut(sql.FieldGT(FieldID, id))
+}
+
+// IDGTE applies the GTE predicate on the ID field.
+func IDGTE(id int) predicate.Cut {
+	return predicate.Cut(sql.FieldGTE(FieldID, id))
+}
+
+// IDLT applies the LT predicate on the ID field.
+func IDLT(id int) predicate.Cut {
+	return predicate.Cut(sql.FieldLT(FieldID, id))
+}
+
+// IDLTE applies the LTE predicate on the ID field.
+func IDLTE(id int) predicate.Cut {
+	return predicate.Cut(sql.FieldLTE(FieldID, id))
+}
+
+// Name applies equality check predicate on the "name" field. It's identical to NameEQ.
+func Name(v string) predicate.Cut {
+	return predicate.Cut(sql.FieldEQ(FieldName, v))
+}
+
+// ProjectID applies equality check predicate on the "project_id" field. It's identical to ProjectIDEQ.
+func ProjectID(v int) predicate.Cut {
+	return predicate.Cut(sql.FieldEQ(FieldProjectID, v))
+}
+
+// OrderHistoryIndex applies equality check predicate on the "order_history_index" field. It's identical to OrderHistoryIndexEQ.
+func OrderHistoryIndex(v int) predicate.Cut {
+	return predicate.Cut(sql.FieldEQ(FieldOrderHistoryIndex, v))
+}
+
+// CreatedAt applies equality check predicate on the "created_at" field. It's identical to CreatedAtEQ.
+func CreatedAt(v time.Time) predicate.Cut {
+	return predicate.Cut(sql.FieldEQ(FieldCreatedAt, v))
+}
+
+// UpdatedAt applies equality check predicate on the "updated_at" field. It's identical to UpdatedAtEQ.
+func UpdatedAt(v time.Time) predicate.Cut {
+	return predicate.Cut(sql.FieldEQ(FieldUpdatedAt, v))
+}
+
+// NameEQ applies the EQ predicate on the "name" field.
+func NameEQ(v string) predicate.Cut {
+	return predicate.Cut(sql.FieldEQ(FieldName, v))
+}
+
+// NameNEQ applies the NEQ predicate on the "name" field.
+func NameNEQ(v string) predicate.Cut {
+	return predicate.Cut(sql.FieldNEQ(FieldName, v))
+}
+
+// NameIn applies the In predicate on the "name" field.
+func NameIn(vs ...string) predicate.Cut {
+	return predicate.Cut(sql.FieldIn(FieldName, vs...))
+}
+
+// NameNotIn applies the NotIn predicate on the "name" field.
+func NameNotIn(vs ...string) predicate.Cut {
+	return predicate.Cut(sql.FieldNotIn(FieldName, vs...))
+}
+
+// NameGT applies the GT predicate on the "name" field.
+func NameGT(v string) predicate.Cut {
+	return predicate.Cut(sql.FieldGT(FieldName, v))
+}
+
+// NameGTE applies the GTE predicate on the "name" field.
+func NameGTE(v string) predicate.Cut {
+	return predicate.Cut(sql.FieldGTE(FieldName, v))
+}
+
+// NameLT applies the LT predicate on the "name" field.
+func NameLT(v string) predicate.Cut {
+	return predicate.Cut(sql.FieldLT(FieldName, v))
+}
+
+// NameLTE applies the LTE predicate on the "name" field.
+func NameLTE(v string) predicate.Cut {
+	return predicate.Cut(sql.FieldLTE(FieldName, v))
+}
+
+// NameContains applies the Contains predicate on the "name" field.
+func NameContains(v string) predicate.Cut {
+	return predicate.Cut(sql.FieldContains(FieldName, v))
+}
+
+// NameHasPrefix applies the HasPrefix predicate on the "name" field.
+func NameHasPrefix(v string) predicate.Cut {
+	return predicate.Cut(sql.FieldHasPrefix(FieldName, v))
+}
+
+// NameHasSuffix applies the HasSuffix predicate on the "name" field.
+func NameHasSuffix(v string) predicate.Cut {
+	return predicate.Cut(sql.FieldHasSuffix(FieldName, v))
+}
+
+// NameEqualFold applies the EqualFold predicate on the "name" field.
+func NameEqualFold(v string) predicate.Cut {
+	return predicate.Cut(sql.FieldEqualFold(FieldName, v))
+}
+
+// NameContainsFold applies the ContainsFold predicate on the "name" field.
+func NameContainsFold(v string) predicate.Cut {
+	return predicate.Cut(sql.FieldContainsFold(FieldName, v))
+}
+
+// ProjectIDEQ applies the EQ predicate on the "project_id" field.
+func ProjectIDEQ(v int) predicate.Cut {
+	return predicate.Cut(sql.FieldEQ(FieldProjectID, v))
+}
+
+// ProjectIDNEQ applies the NEQ predicate on the "project_id" field.
+func ProjectIDNEQ(v int) predicate.Cut {
+	return predicate.Cut(sql.FieldNEQ(FieldProjectID, v))
+}
+
+// ProjectIDIn applies the In predicate on the "project_id" field.
+func ProjectIDIn(vs ...int) predicate.Cut {
+	return predicate.Cut(sql.FieldIn(FieldProjectID, vs...))
+}
+
+// ProjectIDNotIn applies the NotIn predicate on the "project_id" field.
+func ProjectIDNotIn(vs ...int) predicate.Cut {
+	return predicate.Cut(sql.FieldNotIn(FieldProjectID, vs...))
+}
+
+// HighlightOrderIsNil applies the IsNil predicate on the "highlight_order" field.
+func HighlightOrderIsNil() predicate.Cut {
+	return predicate.Cut(sql.FieldIsNull(FieldHighlightOrder))
+}
+
+// HighlightOrderNotNil applies the NotNil predicate on the "highlight_order" field.
+func HighlightOrderNotNil() predicate.Cut {
+	return predicate.Cut(sql.FieldNotNull(FieldHighlightOrder))
+}
+
+// OrderHistoryIsNil applies the IsNil predicate on the "order_history" field.
+func OrderHistoryIsNil() predicate.Cut {
+	return predicate.Cut(sql.FieldIsNull(FieldOrderHistory))
+}
+
+// OrderHistoryNotNil applies the NotNil predicate on the "order_history" field.
+func OrderHistoryNotNil() predicate.Cut {
+	return predicate.Cut(sql.FieldNotNull(FieldOrderHistory))
+}
+
+// OrderHistoryIndexEQ applies the EQ predicate on the "order_history_index" field.
+func OrderHistoryIndexEQ(v int) predicate.Cut {
+	return predicate.Cut(sql.FieldEQ(FieldOrderHistoryIndex, v))
+}
+
+// OrderHistoryIndexNEQ applies the NEQ predicate on the "order_history_index" field.
+func OrderHistoryIndexNEQ(v int) predicate.Cut {
+	return predicate.Cut(sql.FieldNEQ(FieldOrderHistoryIndex, v))
+}
+
+// OrderHistoryIndexIn applies the In predicate on the "order_history_index" field.
+func OrderHistoryIndexIn(vs ...int) predicate.Cut {
+	return predicate.Cut(sql.FieldIn(FieldOrderHistoryIndex, vs...))
+}
+
+// OrderHistoryIndexNotIn applies the NotIn predicate on the "order_history_index" field.
+func OrderHistoryIndexNotIn(vs ...int) predicate.Cut {
+	return predicate.Cut(sql.FieldNotIn(FieldOrderHistoryIndex, vs...))
+}
+
+// OrderHistoryIndexGT applies the GT predicate on the "order_history_index" field.
+func OrderHistoryIndexGT(v int) predicate.Cut {
+	return predicate.Cut(sql.FieldGT(FieldOrderHistoryIndex, v))
+}
+
+// OrderHistoryIndexGTE applies the GTE predicate on the "order_history_index" field.
+func OrderHistoryIndexGTE(v int) predicate.Cut {
+	return predicate.Cut(sql.FieldGTE(FieldOrderHistoryIndex, v))
+}
+
+// OrderHistoryIndexLT applies the LT predicate on the "order_history_index" field.
+func OrderHistoryIndexLT(v int) predicate.Cut {
+	return predicate.Cut(sql.FieldLT(FieldOrderHistoryIndex, v))
+}
+
+// OrderHistoryIndexLTE applies the LTE predicate on the "order_history_index" field.
+func OrderHistoryIndexLTE(v int) predicate.Cut {
+	return predicate.Cut(sql.FieldLTE(FieldOrderHistoryIndex, v))
+}
+
+// OrderHistoryIndexIsNil applies the IsNil predicate on the "order_history_index" field.
+func OrderHistoryIndexIsNil() predicate.Cut {
+	return predicate.Cut(sql.FieldIsNull(FieldOrderHistoryIndex))
+}
+
+// OrderHistoryIndexNotNil applies the NotNil predicate on the "order_history_index" field.
+func OrderHistoryIndexNotNil() predicate.Cut {
+	return predicate.Cut(sql.FieldNotNull(FieldOrderHistoryIndex))
+}
+
+// HiddenHighlightsIsNil applies the IsNil predicate on the "hidden_highlights" field.
+func HiddenHighlightsIsNil() predicate.Cut {
+	return predicate.Cut(sql.FieldIsNull(FieldHiddenHighlights))
+}
+
+// HiddenHighlightsNotNil applies the NotNil predicate on the "hidden_highlights" field.
+func HiddenHighlightsNotNil() predicate.Cut {
+	return predicate.Cut(sql.FieldNotNull(FieldHiddenHighlights))
+}
+
+// CreatedAtEQ applies the EQ predicate on the "created_at" field.
+func CreatedAtEQ(v time.Time) predicate.Cut {
+	return predicate.Cut(sql.FieldEQ(FieldCreatedAt, v))
+}
+
+// CreatedAtNEQ applies the NEQ predicate on the "created_at" field.
+func CreatedAtNEQ(v time.Time) predicate.Cut {
+	return predicate.Cut(sql.FieldNEQ(FieldCreatedAt, v))
+}
+
+// CreatedAtIn applies the In predicate on the "created_at" field.
+func CreatedAtIn(vs ...time.Time) predicate.Cut {
+	return predicate.Cut(sql.FieldIn(FieldCreatedAt, vs...))
+}
+
+// CreatedAtNotIn applies the NotIn predicate on the "created_at" field.
+func CreatedAtNotIn(vs ...time.Time) predicate.Cut {
+	return predicate.Cut(sql.FieldNotIn(FieldCreatedAt, vs...))
+}
+
+// CreatedAtGT applies the GT predicate on the "created_at" field.
+func CreatedAtGT(v time.Time) predicate.Cut {
+	return predicate.Cut(sql.FieldGT(FieldCreatedAt, v))
+}
+
+// CreatedAtGTE applies the GTE predicate on the "created_at" field.
+func CreatedAtGTE(v time.Time) predicate.Cut {
+	return predicate.Cut(sql.FieldGTE(FieldCreatedAt, v))
+}
+
+// CreatedAtLT applies the LT predicate on the "created_at" field.
+func CreatedAtLT(v time.Time) predicate.Cut {
+	return predicate.Cut(sql.FieldLT(FieldCreatedAt, v))
+}
+
+// CreatedAtLTE applies the LTE predicate on the "created_at" field.
+func CreatedAtLTE(v time.Time) predicate.Cut {
+	return predicate.Cut(sql.FieldLTE(FieldCreatedAt, v))
+}
+
+// UpdatedAtEQ applies the EQ predicate on the "updated_at" field.
+func UpdatedAtEQ(v time.Time) predicate.Cut {
+	return predicate.Cut(sql.FieldEQ(FieldUpdatedAt, v))
+}
+
+// UpdatedAtNEQ applies the NEQ predicate on the "updated_at" field.
+func UpdatedAtNEQ(v time.Time) predicate.Cut {
+	return predicate.Cut(sql.FieldNEQ(FieldUpdatedAt, v))
+}
+
+// UpdatedAtIn applies the In predicate on the "updated_at" field.
+func UpdatedAtIn(vs ...time.Time) predicate.Cut {
+	return predicate.Cut(sql.FieldIn(FieldUpdatedAt, vs...))
+}
+
+// UpdatedAtNotIn applies the NotIn predicate on the "updated_at" field.
+func UpdatedAtNotIn(vs ...time.Time) predicate.Cut {
+	return predicate.Cut(sql.FieldNotIn(FieldUpdatedAt, vs...))
+}
+
+// UpdatedAtGT applies the GT predicate on the "updated_at" field.
+func UpdatedAtGT(v time.Time) predicate.Cut {
+	return predicate.Cut(sql.FieldGT(FieldUpdatedAt, v))
+}
+
+// UpdatedAtGTE applies the GTE predicate on the "updated_at" field.
+func UpdatedAtGTE(v time.Time) predicate.Cut {
+	return predicate.Cut(sql.FieldGTE(FieldUpdatedAt, v))
+}
+
+// UpdatedAtLT applies the LT predicate on the "updated_at" field.
+func UpdatedAtLT(v time.Time) predicate.Cut {
+	return predicate.Cut(sql.FieldLT(FieldUpdatedAt, v))
+}
+
+// UpdatedAtLTE applies the LTE predicate on the "updated_at" field.
+func UpdatedAtLTE(v time.Time) predicate.Cut {
+	return predicate.Cut(sql.FieldLTE(FieldUpdatedAt, v))
+}
+
+// HasProject applies the HasEdge predicate on the "project" edge.
+func HasProject() predicate.Cut {
+	return predicate.Cut(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, ProjectTable, ProjectColumn),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasProjectWith applies the HasEdge predicate on the "project" edge with a given conditions (other predicates).
+func HasProjectWith(preds ...predicate.Project) predicate.Cut {
+	return predicate.Cut(func(s *sql.Selector) {
+		step := newProjectStep()
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
+// And groups predicates with the AND operator between them.
+func And(predicates ...predicate.Cut) predicate.Cut {
+	return predicate.Cut(sql.AndPredicates(predicates...))
+}
+
+// Or groups predicates with the OR operator between them.
+func Or(predicates ...predicate.Cut) predicate.Cut {
+	return predicate.Cut(sql.OrPredicates(predicates...))
+}
+
+// Not applies the not operator on the given predicate.
+func Not(p predicate.Cut) predicate.Cut {
+	return predicate.Cut(sql.NotPredicates(p))
+}
@@ -0,0 +1,332 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"ramble-ai/ent/cut"
+	"ramble-ai/ent/project"
+	"time"
+
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+)
+
+// CutCreate is the builder for creating a Cut entity.
+type CutCreate struct {
+	config
+	mutation *CutMutation
+	hooks    []Hook
+}
+
+// SetName sets the "name" field.
+func (cc *CutCreate) SetName(s string) *CutCreate {
+	cc.mutation.SetName(s)
+	return cc
+}
+
+// SetProjectID sets the "project_id" field.
+func (cc *CutCreate) SetProjectID(i int) *CutCreate {
+	cc.mutation.SetProjectID(i)
+	return cc
+}
+
+// SetHighlightOrder sets the "highlight_order" field.
+func (cc *CutCreate) SetHighlightOrder(i []interface{}) *CutCreate {
+	cc.mutation.SetHighlightOrder(i)
+	return cc
+}
+
+// SetOrderHistory sets the "order_history" field.
+func (cc *CutCreate) SetOrderHistory(i [][]interface{}) *CutCreate {
+	cc.mutation.SetOrderHistory(i)
+	return cc
+}
+
+// SetOrderHistoryIndex sets the "order_history_index" field.
+func (cc *CutCreate) SetOrderHistoryIndex(i int) *CutCreate {
+	cc.mutation.SetOrderHistoryIndex(i)
+	return cc
+}
+
+// SetNillableOrderHistoryIndex sets the "order_history_index" field if the given value is not nil.
+func (cc *CutCreate) SetNillableOrderHistoryIndex(i *int) *CutCreate {
+	if i != nil {
+		cc.SetOrderHistoryIndex(*i)
+	}
+	return cc
+}
+
+// SetHiddenHighlights sets the "hidden_highlights" field.
+func (cc *CutCreate) SetHiddenHighlights(s []string) *CutCreate {
+	cc.mutation.SetHiddenHighlights(s)
+	return cc
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (cc *CutCreate) SetCreatedAt(t time.Time) *CutCreate {
+	cc.mutation.SetCreatedAt(t)
+	return cc
+}
+
+// SetNillableCreatedAt sets the "created_at" field if the given value is not nil.
+func (cc *CutCreate) SetNillableCreatedAt(t *time.Time) *CutCreate {
+	if t != nil {
+		cc.SetCreatedAt(*t)
+	}
+	return cc
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (cc *CutCreate) SetUpdatedAt(t time.Time) *CutCreate {
+	cc.mutation.SetUpdatedAt(t)
+	return cc
+}
+
+// SetNillableUpdatedAt sets the "updated_at" field if the given value is not nil.
+func (cc *CutCreate) SetNillableUpdatedAt(t *time.Time) *CutCreate {
+	if t != nil {
+		cc.SetUpdatedAt(*t)
+	}
+	return cc
+}
+
+// SetProject sets the "project" edge to the Project entity.
+func (cc *CutCreate) SetProject(p *Project) *CutCreate {
+	return cc.SetProjectID(p.ID)
+}
+
+// Mutation returns the CutMutation object of the builder.
+func (cc *CutCreate) Mutation() *CutMutation {
+	return cc.mutation
+}
+
+// Save creates the Cut in the database.
+func (cc *CutCreate) Save(ctx context.Context) (*Cut, error) {
+	cc.defaults()
+	return withHooks(ctx, cc.sqlSave, cc.mutation, cc.hooks)
+}
+
+// SaveX calls Save and panics if Save returns an error.
+func (cc *CutCreate) SaveX(ctx context.Context) *Cut {
+	v, err := cc.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (cc *CutCreate) Exec(ctx context.Context) error {
+	_, err := cc.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (cc *CutCreate) ExecX(ctx context.Context) {
+	if err := cc.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (cc *CutCreate) defaults() {
+	if _, ok := cc.mutation.OrderHistoryIndex(); !ok {
+		v := cut.DefaultOrderHistoryIndex
+		cc.mutation.SetOrderHistoryIndex(v)
+	}
+	if _, ok := cc.mutation.CreatedAt(); !ok {
+		v := cut.DefaultCreatedAt()
+		cc.mutation.SetCreatedAt(v)
+	}
+	if _, ok := cc.mutation.UpdatedAt(); !ok {
+		v := cut.DefaultUpdatedAt()
+		cc.mutation.SetUpdatedAt(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (cc *CutCreate) check() error {
+	if _, ok := cc.mutation.Name(); !ok {
+		return &ValidationError{Name: "name", err: errors.New(`ent: missing required field "Cut.name"`)}
+	}
+	if v, ok := cc.mutation.Name(); ok {
+		if err := cut.NameValidator(v); err != nil {
+			return &ValidationError{Name: "name", err: fmt.Errorf(`ent: validator failed for field "Cut.name": %w`, err)}
+		}
+	}
+	if _, ok := cc.mutation.ProjectID(); !ok {
+		return &ValidationError{Name: "project_id", err: errors.New(`ent: missing required field "Cut.project_id"`)}
+	}
+	if _, ok := cc.mutation.CreatedAt(); !ok {
+		return &ValidationError{Name: "created_at", err: errors.New(`ent: missing required field "Cut.created_at"`)}
+	}
+	if _, ok := cc.mutation.UpdatedAt(); !ok {
+		return &ValidationError{Name: "updated_at", err: errors.New(`ent: missing required field "Cut.updated_at"`)}
+	}
+	if len(cc.mutation.ProjectIDs()) == 0 {
+		return &ValidationError{Name: "project", err: errors.New(`ent: missing required edge "Cut.project"`)}
+	}
+	return nil
+}
+
+func (cc *CutCreate) sqlSave(ctx context.Context) (*Cut, error) {
+	if err := cc.check(); err != nil {
+		return nil, err
+	}
+	_node, _spec := cc.createSpec()
+	if err := sqlgraph.CreateNode(ctx, cc.driver, _spec); err != nil {
+		if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	id := _spec.ID.Value.(int64)
+	_node.ID = int(id)
+	cc.mutation.id = &_node.ID
+	cc.mutation.done = true
+	return _node, nil
+}
+
+func (cc *CutCreate) createSpec() (*Cut, *sqlgraph.CreateSpec) {
+	var (
+		_node = &Cut{config: cc.config}
+		_spec = sqlgraph.NewCreateSpec(cut.Table, sqlgraph.NewFieldSpec(cut.FieldID, field.TypeInt))
+	)
+	if value, ok := cc.mutation.Name(); ok {
+		_spec.SetField(cut.FieldName, field.TypeString, value)
+		_node.Name = value
+	}
+	if value, ok := cc.mutation.HighlightOrder(); ok {
+		_spec.SetField(cut.FieldHighlightOrder, field.TypeJSON, value)
+		_node.HighlightOrder = value
+	}
+	if value, ok := cc.mutation.OrderHistory(); ok {
+		_spec.SetField(cut.FieldOrderHistory, field.TypeJSON, value)
+		_node.OrderHistory = value
+	}
+	if value, ok := cc.mutation.OrderHistoryIndex(); ok {
+		_spec.SetField(cut.FieldOrderHistoryIndex, field.TypeInt, value)
+		_node.OrderHistoryIndex = value
+	}
+	if value, ok := cc.mutation.HiddenHighlights(); ok {
+		_spec.SetField(cut.FieldHiddenHighlights, field.TypeJSON, value)
+		_node.HiddenHighlights = value
+	}
+	if value, ok := cc.mutation.CreatedAt(); ok {
+		_spec.SetField(cut.FieldCreatedAt, field.TypeTime, value)
+		_node.CreatedAt = value
+	}
+	if value, ok := cc.mutation.UpdatedAt(); ok {
+		_spec.SetField(cut.FieldUpdatedAt, field.TypeTime, value)
+		_node.UpdatedAt = value
+	}
+	if nodes := cc.mutation.ProjectIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   cut.ProjectTable,
+			Columns: []string{cut.ProjectColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(project.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_node.ProjectID = nodes[0]
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	return _node, _spec
+}
+
+// CutCreateBulk is the builder for creating many Cut entities in bulk.
+type CutCreateBulk struct {
+	config
+	err      error
+	builders []*CutCreate
+}
+
+// Save creates the Cut entities in the database.
+func (ccb *CutCreateBulk) Save(ctx context.Context) ([]*Cut, error) {
+	if ccb.err != nil {
+		return nil, ccb.err
+	}
+	specs := make([]*sqlgraph.CreateSpec, len(ccb.builders))
+	nodes := make([]*Cut, len(ccb.builders))
+	mutators := make([]Mutator, len(ccb.builders))
+	for i := range ccb.builders {
+		func(i int, root context.Context) {
+			builder := ccb.builders[i]
+			builder.defaults()
+			var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+				mutation, ok := m.(*CutMutation)
+				if !ok {
+					return nil, fmt.Errorf("unexpected mutation type %T", m)
+				}
+				if err := builder.check(); err != nil {
+					return nil, err
+				}
+				builder.mutation = mutation
+				var err error
+				nodes[i], specs[i] = builder.createSpec()
+				if i < len(mutators)-1 {
+					_, err = mutators[i+1].Mutate(root, ccb.builders[i+1].mutation)
+				} else {
+					spec := &sqlgraph.BatchCreateSpec{Nodes: specs}
+					// Invoke the actual operation on the latest mutation in the chain.
+					if err = sqlgraph.BatchCreate(ctx, ccb.driver, spec); err != nil {
+						if sqlgraph.IsConstraintError(err) {
+							err = &ConstraintError{msg: err.Error(), wrap: err}
+						}
+					}
+				}
+				if err != nil {
+					return nil, err
+				}
+				mutation.id = &nodes[i].ID
+				if specs[i].ID.Value != nil {
+					id := specs[i].ID.Value.(int64)
+					nodes[i].ID = int(id)
+				}
+				mutation.done = true
+				return nodes[i], nil
+			})
+			for i := len(builder.hooks) - 1; i >= 0; i-- {
+				mut = builder.hooks[i](mut)
+			}
+			mutators[i] = mut
+		}(i, ctx)
+	}
+	if len(mutators) > 0 {
+		if _, err := mutators[0].Mutate(ctx, ccb.builders[0].mutation); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (ccb *CutCreateBulk) SaveX(ctx context.Context) []*Cut {
+	v, err := ccb.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (ccb *CutCreateBulk) Exec(ctx context.Context) error {
+	_, err := ccb.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (ccb *CutCreateBulk) ExecX(ctx context.Context) {
+	if err := ccb.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
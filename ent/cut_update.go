@@ -0,0 +1,668 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"ramble-ai/ent/cut"
+	"ramble-ai/ent/predicate"
+	"ramble-ai/ent/project"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/dialect/sql/sqljson"
+	"entgo.io/ent/schema/field"
+)
+
+// CutUpdate is the builder for updating Cut entities.
+type CutUpdate struct {
+	config
+	hooks    []Hook
+	mutation *CutMutation
+}
+
+// Where appends a list predicates to the CutUpdate builder.
+func (cu *CutUpdate) Where(ps ...predicate.Cut) *CutUpdate {
+	cu.mutation.Where(ps...)
+	return cu
+}
+
+// SetName sets the "name" field.
+func (cu *CutUpdate) SetName(s string) *CutUpdate {
+	cu.mutation.SetName(s)
+	return cu
+}
+
+// SetNillableName sets the "name" field if the given value is not nil.
+func (cu *CutUpdate) SetNillableName(s *string) *CutUpdate {
+	if s != nil {
+		cu.SetName(*s)
+	}
+	return cu
+}
+
+// SetProjectID sets the "project_id" field.
+func (cu *CutUpdate) SetProjectID(i int) *CutUpdate {
+	cu.mutation.SetProjectID(i)
+	return cu
+}
+
+// SetNillableProjectID sets the "project_id" field if the given value is not nil.
+func (cu *CutUpdate) SetNillableProjectID(i *int) *CutUpdate {
+	if i != nil {
+		cu.SetProjectID(*i)
+	}
+	return cu
+}
+
+// SetHighlightOrder sets the "highlight_order" field.
+func (cu *CutUpdate) SetHighlightOrder(i []interface{}) *CutUpdate {
+	cu.mutation.SetHighlightOrder(i)
+	return cu
+}
+
+// AppendHighlightOrder appends i to the "highlight_order" field.
+func (cu *CutUpdate) AppendHighlightOrder(i []interface{}) *CutUpdate {
+	cu.mutation.AppendHighlightOrder(i)
+	return cu
+}
+
+// ClearHighlightOrder clears the value of the "highlight_order" field.
+func (cu *CutUpdate) ClearHighlightOrder() *CutUpdate {
+	cu.mutation.ClearHighlightOrder()
+	return cu
+}
+
+// SetOrderHistory sets the "order_history" field.
+func (cu *CutUpdate) SetOrderHistory(i [][]interface{}) *CutUpdate {
+	cu.mutation.SetOrderHistory(i)
+	return cu
+}
+
+// AppendOrderHistory appends i to the "order_history" field.
+func (cu *CutUpdate) AppendOrderHistory(i [][]interface{}) *CutUpdate {
+	cu.mutation.AppendOrderHistory(i)
+	return cu
+}
+
+// ClearOrderHistory clears the value of the "order_history" field.
+func (cu *CutUpdate) ClearOrderHistory() *CutUpdate {
+	cu.mutation.ClearOrderHistory()
+	return cu
+}
+
+// SetOrderHistoryIndex sets the "order_history_index" field.
+func (cu *CutUpdate) SetOrderHistoryIndex(i int) *CutUpdate {
+	cu.mutation.ResetOrderHistoryIndex()
+	cu.mutation.SetOrderHistoryIndex(i)
+	return cu
+}
+
+// SetNillableOrderHistoryIndex sets the "order_history_index" field if the given value is not nil.
+func (cu *CutUpdate) SetNillableOrderHistoryIndex(i *int) *CutUpdate {
+	if i != nil {
+		cu.SetOrderHistoryIndex(*i)
+	}
+	return cu
+}
+
+// AddOrderHistoryIndex adds i to the "order_history_index" field.
+func (cu *CutUpdate) AddOrderHistoryIndex(i int) *CutUpdate {
+	cu.mutation.AddOrderHistoryIndex(i)
+	return cu
+}
+
+// ClearOrderHistoryIndex clears the value of the "order_history_index" field.
+func (cu *CutUpdate) ClearOrderHistoryIndex() *CutUpdate {
+	cu.mutation.ClearOrderHistoryIndex()
+	return cu
+}
+
+// SetHiddenHighlights sets the "hidden_highlights" field.
+func (cu *CutUpdate) SetHiddenHighlights(s []string) *CutUpdate {
+	cu.mutation.SetHiddenHighlights(s)
+	return cu
+}
+
+// AppendHiddenHighlights appends s to the "hidden_highlights" field.
+func (cu *CutUpdate) AppendHiddenHighlights(s []string) *CutUpdate {
+	cu.mutation.AppendHiddenHighlights(s)
+	return cu
+}
+
+// ClearHiddenHighlights clears the value of the "hidden_highlights" field.
+func (cu *CutUpdate) ClearHiddenHighlights() *CutUpdate {
+	cu.mutation.ClearHiddenHighlights()
+	return cu
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (cu *CutUpdate) SetCreatedAt(t time.Time) *CutUpdate {
+	cu.mutation.SetCreatedAt(t)
+	return cu
+}
+
+// SetNillableCreatedAt sets the "created_at" field if the given value is not nil.
+func (cu *CutUpdate) SetNillableCreatedAt(t *time.Time) *CutUpdate {
+	if t != nil {
+		cu.SetCreatedAt(*t)
+	}
+	return cu
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (cu *CutUpdate) SetUpdatedAt(t time.Time) *CutUpdate {
+	cu.mutation.SetUpdatedAt(t)
+	return cu
+}
+
+// SetProject sets the "project" edge to the Project entity.
+func (cu *CutUpdate) SetProject(p *Project) *CutUpdate {
+	return cu.SetProjectID(p.ID)
+}
+
+// Mutation returns the CutMutation object of the builder.
+func (cu *CutUpdate) Mutation() *CutMutation {
+	return cu.mutation
+}
+
+// ClearProject clears the "project" edge to the Project entity.
+func (cu *CutUpdate) ClearProject() *CutUpdate {
+	cu.mutation.ClearProject()
+	return cu
+}
+
+// Save executes the query and returns the number of nodes affected by the update operation.
+func (cu *CutUpdate) Save(ctx context.Context) (int, error) {
+	cu.defaults()
+	return withHooks(ctx, cu.sqlSave, cu.mutation, cu.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (cu *CutUpdate) SaveX(ctx context.Context) int {
+	affected, err := cu.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return affected
+}
+
+// Exec executes the query.
+func (cu *CutUpdate) Exec(ctx context.Context) error {
+	_, err := cu.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (cu *CutUpdate) ExecX(ctx context.Context) {
+	if err := cu.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (cu *CutUpdate) defaults() {
+	if _, ok := cu.mutation.UpdatedAt(); !ok {
+		v := cut.UpdateDefaultUpdatedAt()
+		cu.mutation.SetUpdatedAt(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (cu *CutUpdate) check() error {
+	if v, ok := cu.mutation.Name(); ok {
+		if err := cut.NameValidator(v); err != nil {
+			return &ValidationError{Name: "name", err: fmt.Errorf(`ent: validator failed for field "Cut.name": %w`, err)}
+		}
+	}
+	if cu.mutation.ProjectCleared() && len(cu.mutation.ProjectIDs()) > 0 {
+		return errors.New(`ent: clearing a required unique edge "Cut.project"`)
+	}
+	return nil
+}
+
+func (cu *CutUpdate) sqlSave(ctx context.Context) (n int, err error) {
+	if err := cu.check(); err != nil {
+		return n, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(cut.Table, cut.Columns, sqlgraph.NewFieldSpec(cut.FieldID, field.TypeInt))
+	if ps := cu.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := cu.mutation.Name(); ok {
+		_spec.SetField(cut.FieldName, field.TypeString, value)
+	}
+	if value, ok := cu.mutation.HighlightOrder(); ok {
+		_spec.SetField(cut.FieldHighlightOrder, field.TypeJSON, value)
+	}
+	if value, ok := cu.mutation.AppendedHighlightOrder(); ok {
+		_spec.AddModifier(func(u *sql.UpdateBuilder) {
+			sqljson.Append(u, cut.FieldHighlightOrder, value)
+		})
+	}
+	if cu.mutation.HighlightOrderCleared() {
+		_spec.ClearField(cut.FieldHighlightOrder, field.TypeJSON)
+	}
+	if value, ok := cu.mutation.OrderHistory(); ok {
+		_spec.SetField(cut.FieldOrderHistory, field.TypeJSON, value)
+	}
+	if value, ok := cu.mutation.AppendedOrderHistory(); ok {
+		_spec.AddModifier(func(u *sql.UpdateBuilder) {
+			sqljson.Append(u, cut.FieldOrderHistory, value)
+		})
+	}
+	if cu.mutation.OrderHistoryCleared() {
+		_spec.ClearField(cut.FieldOrderHistory, field.TypeJSON)
+	}
+	if value, ok := cu.mutation.OrderHistoryIndex(); ok {
+		_spec.SetField(cut.FieldOrderHistoryIndex, field.TypeInt, value)
+	}
+	if value, ok := cu.mutation.AddedOrderHistoryIndex(); ok {
+		_spec.AddField(cut.FieldOrderHistoryIndex, field.TypeInt, value)
+	}
+	if cu.mutation.OrderHistoryIndexCleared() {
+		_spec.ClearField(cut.FieldOrderHistoryIndex, field.TypeInt)
+	}
+	if value, ok := cu.mutation.HiddenHighlights(); ok {
+		_spec.SetField(cut.FieldHiddenHighlights, field.TypeJSON, value)
+	}
+	if value, ok := cu.mutation.AppendedHiddenHighlights(); ok {
+		_spec.AddModifier(func(u *sql.UpdateBuilder) {
+			sqljson.Append(u, cut.FieldHiddenHighlights, value)
+		})
+	}
+	if cu.mutation.HiddenHighlightsCleared() {
+		_spec.ClearField(cut.FieldHiddenHighlights, field.TypeJSON)
+	}
+	if value, ok := cu.mutation.CreatedAt(); ok {
+		_spec.SetField(cut.FieldCreatedAt, field.TypeTime, value)
+	}
+	if value, ok := cu.mutation.UpdatedAt(); ok {
+		_spec.SetField(cut.FieldUpdatedAt, field.TypeTime, value)
+	}
+	if cu.mutation.ProjectCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   cut.ProjectTable,
+			Columns: []string{cut.ProjectColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(project.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := cu.mutation.ProjectIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   cut.ProjectTable,
+			Columns: []string{cut.ProjectColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(project.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if n, err = sqlgraph.UpdateNodes(ctx, cu.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{cut.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return 0, err
+	}
+	cu.mutation.done = true
+	return n, nil
+}
+
+// CutUpdateOne is the builder for updating a single Cut entity.
+type CutUpdateOne struct {
+	config
+	fields   []string
+	hooks    []Hook
+	mutation *CutMutation
+}
+
+// SetName sets the "name" field.
+func (cuo *CutUpdateOne) SetName(s string) *CutUpdateOne {
+	cuo.mutation.SetName(s)
+	return cuo
+}
+
+// SetNillableName sets the "name" field if the given value is not nil.
+func (cuo *CutUpdateOne) SetNillableName(s *string) *CutUpdateOne {
+	if s != nil {
+		cuo.SetName(*s)
+	}
+	return cuo
+}
+
+// SetProjectID sets the "project_id" field.
+func (cuo *CutUpdateOne) SetProjectID(i int) *CutUpdateOne {
+	cuo.mutation.SetProjectID(i)
+	return cuo
+}
+
+// SetNillableProjectID sets the "project_id" field if the given value is not nil.
+func (cuo *CutUpdateOne) SetNillableProjectID(i *int) *CutUpdateOne {
+	if i != nil {
+		cuo.SetProjectID(*i)
+	}
+	return cuo
+}
+
+// SetHighlightOrder sets the "highlight_order" field.
+func (cuo *CutUpdateOne) SetHighlightOrder(i []interface{}) *CutUpdateOne {
+	cuo.mutation.SetHighlightOrder(i)
+	return cuo
+}
+
+// AppendHighlightOrder appends i to the "highlight_order" field.
+func (cuo *CutUpdateOne) AppendHighlightOrder(i []interface{}) *CutUpdateOne {
+	cuo.mutation.AppendHighlightOrder(i)
+	return cuo
+}
+
+// ClearHighlightOrder clears the value of the "highlight_order" field.
+func (cuo *CutUpdateOne) ClearHighlightOrder() *CutUpdateOne {
+	cuo.mutation.ClearHighlightOrder()
+	return cuo
+}
+
+// SetOrderHistory sets the "order_history" field.
+func (cuo *CutUpdateOne) SetOrderHistory(i [][]interface{}) *CutUpdateOne {
+	cuo.mutation.SetOrderHistory(i)
+	return cuo
+}
+
+// AppendOrderHistory appends i to the "order_history" field.
+func (cuo *CutUpdateOne) AppendOrderHistory(i [][]interface{}) *CutUpdateOne {
+	cuo.mutation.AppendOrderHistory(i)
+	return cuo
+}
+
+// ClearOrderHistory clears the value of the "order_history" field.
+func (cuo *CutUpdateOne) ClearOrderHistory() *CutUpdateOne {
+	cuo.mutation.ClearOrderHistory()
+	return cuo
+}
+
+// SetOrderHistoryIndex sets the "order_history_index" field.
+func (cuo *CutUpdateOne) SetOrderHistoryIndex(i int) *CutUpdateOne {
+	cuo.mutation.ResetOrderHistoryIndex()
+	cuo.mutation.SetOrderHistoryIndex(i)
+	return cuo
+}
+
+// SetNillableOrderHistoryIndex sets the "order_history_index" field if the given value is not nil.
+func (cuo *CutUpdateOne) SetNillableOrderHistoryIndex(i *int) *CutUpdateOne {
+	if i != nil {
+		cuo.SetOrderHistoryIndex(*i)
+	}
+	return cuo
+}
+
+// AddOrderHistoryIndex adds i to the "order_history_index" field.
+func (cuo *CutUpdateOne) AddOrderHistoryIndex(i int) *CutUpdateOne {
+	cuo.mutation.AddOrderHistoryIndex(i)
+	return cuo
+}
+
+// ClearOrderHistoryIndex clears the value of the "order_history_index" field.
+func (cuo *CutUpdateOne) ClearOrderHistoryIndex() *CutUpdateOne {
+	cuo.mutation.ClearOrderHistoryIndex()
+	return cuo
+}
+
+// SetHiddenHighlights sets the "hidden_highlights" field.
+func (cuo *CutUpdateOne) SetHiddenHighlights(s []string) *CutUpdateOne {
+	cuo.mutation.SetHiddenHighlights(s)
+	return cuo
+}
+
+// AppendHiddenHighlights appends s to the "hidden_highlights" field.
+func (cuo *CutUpdateOne) AppendHiddenHighlights(s []string) *CutUpdateOne {
+	cuo.mutation.AppendHiddenHighlights(s)
+	return cuo
+}
+
+// ClearHiddenHighlights clears the value of the "hidden_highlights" field.
+func (cuo *CutUpdateOne) ClearHiddenHighlights() *CutUpdateOne {
+	cuo.mutation.ClearHiddenHighlights()
+	return cuo
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (cuo *CutUpdateOne) SetCreatedAt(t time.Time) *CutUpdateOne {
+	cuo.mutation.SetCreatedAt(t)
+	return cuo
+}
+
+// SetNillableCreatedAt sets the "created_at" field if the given value is not nil.
+func (cuo *CutUpdateOne) SetNillableCreatedAt(t *time.Time) *CutUpdateOne {
+	if t != nil {
+		cuo.SetCreatedAt(*t)
+	}
+	return cuo
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (cuo *CutUpdateOne) SetUpdatedAt(t time.Time) *CutUpdateOne {
+	cuo.mutation.SetUpdatedAt(t)
+	return cuo
+}
+
+// SetProject sets the "project" edge to the Project entity.
+func (cuo *CutUpdateOne) SetProject(p *Project) *CutUpdateOne {
+	return cuo.SetProjectID(p.ID)
+}
+
+// Mutation returns the CutMutation object of the builder.
+func (cuo *CutUpdateOne) Mutation() *CutMutation {
+	return cuo.mutation
+}
+
+// ClearProject clears the "project" edge to the Project entity.
+func (cuo *CutUpdateOne) ClearProject() *CutUpdateOne {
+	cuo.mutation.ClearProject()
+	return cuo
+}
+
+// Where appends a list predicates to the CutUpdate builder.
+func (cuo *CutUpdateOne) Where(ps ...predicate.Cut) *CutUpdateOne {
+	cuo.mutation.Where(ps...)
+	return cuo
+}
+
+// Select allows selecting one or more fields (columns) of the returned entity.
+// The default is selecting all fields defined in the entity schema.
+func (cuo *CutUpdateOne) Select(field string, fields ...string) *CutUpdateOne {
+	cuo.fields = append([]string{field}, fields...)
+	return cuo
+}
+
+// Save executes the query and returns the updated Cut entity.
+func (cuo *CutUpdateOne) Save(ctx context.Context) (*Cut, error) {
+	cuo.defaults()
+	return withHooks(ctx, cuo.sqlSave, cuo.mutation, cuo.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (cuo *CutUpdateOne) SaveX(ctx context.Context) *Cut {
+	node, err := cuo.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// Exec executes the query on the entity.
+func (cuo *CutUpdateOne) Exec(ctx context.Context) error {
+	_, err := cuo.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (cuo *CutUpdateOne) ExecX(ctx context.Context) {
+	if err := cuo.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (cuo *CutUpdateOne) defaults() {
+	if _, ok := cuo.mutation.UpdatedAt(); !ok {
+		v := cut.UpdateDefaultUpdatedAt()
+		cuo.mutation.SetUpdatedAt(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (cuo *CutUpdateOne) check() error {
+	if v, ok := cuo.mutation.Name(); ok {
+		if err := cut.NameValidator(v); err != nil {
+			return &ValidationError{Name: "name", err: fmt.Errorf(`ent: validator failed for field "Cut.name": %w`, err)}
+		}
+	}
+	if cuo.mutation.ProjectCleared() && len(cuo.mutation.ProjectIDs()) > 0 {
+		return errors.New(`ent: clearing a required unique edge "Cut.project"`)
+	}
+	return nil
+}
+
+func (cuo *CutUpdateOne) sqlSave(ctx context.Context) (_node *Cut, err error) {
+	if err := cuo.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(cut.Table, cut.Columns, sqlgraph.NewFieldSpec(cut.FieldID, field.TypeInt))
+	id, ok := cuo.mutation.ID()
+	if !ok {
+		return nil, &ValidationError{Name: "id", err: errors.New(`ent: missing "Cut.id" for update`)}
+	}
+	_spec.Node.ID.Value = id
+	if fields := cuo.fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, cut.FieldID)
+		for _, f := range fields {
+			if !cut.ValidColumn(f) {
+				return nil, &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+			}
+			if f != cut.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, f)
+			}
+		}
+	}
+	if ps := cuo.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := cuo.mutation.Name(); ok {
+		_spec.SetField(cut.FieldName, field.TypeString, value)
+	}
+	if value, ok := cuo.mutation.HighlightOrder(); ok {
+		_spec.SetField(cut.FieldHighlightOrder, field.TypeJSON, value)
+	}
+	if value, ok := cuo.mutation.AppendedHighlightOrder(); ok {
+		_spec.AddModifier(func(u *sql.UpdateBuilder) {
+			sqljson.Append(u, cut.FieldHighlightOrder, value)
+		})
+	}
+	if cuo.mutation.HighlightOrderCleared() {
+		_spec.ClearField(cut.FieldHighlightOrder, field.TypeJSON)
+	}
+	if value, ok := cuo.mutation.OrderHistory(); ok {
+		_spec.SetField(cut.FieldOrderHistory, field.TypeJSON, value)
+	}
+	if value, ok := cuo.mutation.AppendedOrderHistory(); ok {
+		_spec.AddModifier(func(u *sql.UpdateBuilder) {
+			sqljson.Append(u, cut.FieldOrderHistory, value)
+		})
+	}
+	if cuo.mutation.OrderHistoryCleared() {
+		_spec.ClearField(cut.FieldOrderHistory, field.TypeJSON)
+	}
+	if value, ok := cuo.mutation.OrderHistoryIndex(); ok {
+		_spec.SetField(cut.FieldOrderHistoryIndex, field.TypeInt, value)
+	}
+	if value, ok := cuo.mutation.AddedOrderHistoryIndex(); ok {
+		_spec.AddField(cut.FieldOrderHistoryIndex, field.TypeInt, value)
+	}
+	if cuo.mutation.OrderHistoryIndexCleared() {
+		_spec.ClearField(cut.FieldOrderHistoryIndex, field.TypeInt)
+	}
+	if value, ok := cuo.mutation.HiddenHighlights(); ok {
+		_spec.SetField(cut.FieldHiddenHighlights, field.TypeJSON, value)
+	}
+	if value, ok := cuo.mutation.AppendedHiddenHighlights(); ok {
+		_spec.AddModifier(func(u *sql.UpdateBuilder) {
+			sqljson.Append(u, cut.FieldHiddenHighlights, value)
+		})
+	}
+	if cuo.mutation.HiddenHighlightsCleared() {
+		_spec.ClearField(cut.FieldHiddenHighlights, field.TypeJSON)
+	}
+	if value, ok := cuo.mutation.CreatedAt(); ok {
+		_spec.SetField(cut.FieldCreatedAt, field.TypeTime, value)
+	}
+	if value, ok := cuo.mutation.UpdatedAt(); ok {
+		_spec.SetField(cut.FieldUpdatedAt, field.TypeTime, value)
+	}
+	if cuo.mutation.ProjectCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   cut.ProjectTable,
+			Columns: []string{cut.ProjectColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(project.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := cuo.mutation.ProjectIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   cut.ProjectTable,
+			Columns: []string{cut.ProjectColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(project.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	_node = &Cut{config: cuo.config}
+	_spec.Assign = _node.assignValues
+	_spec.ScanValues = _node.scanValues
+	if err = sqlgraph.UpdateNode(ctx, cuo.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{cut.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	cuo.mutation.done = true
+	return _node, nil
+}
@@ -3,15 +3,23 @@
 package ent
 
 import (
+	"context"
+	"errors"
+	"fmt"
 	"ramble-ai/ent/chatmessage"
 	"ramble-ai/ent/chatsession"
+	"ramble-ai/ent/collection"
+	"ramble-ai/ent/cut"
 	"ramble-ai/ent/exportjob"
 	"ramble-ai/ent/project"
+	"ramble-ai/ent/projecttemplate"
+	"ramble-ai/ent/prompt"
+	"ramble-ai/ent/responsecacheentry"
 	"ramble-ai/ent/settings"
+	"ramble-ai/ent/tag"
+	"ramble-ai/ent/transcriptembedding"
+	"ramble-ai/ent/usagerecord"
 	"ramble-ai/ent/videoclip"
-	"context"
-	"errors"
-	"fmt"
 	"reflect"
 	"sync"
 
@@ -78,12 +86,20 @@ var (
 func checkColumn(table, column string) error {
 	initCheck.Do(func() {
 		columnCheck = sql.NewColumnCheck(map[string]func(string) bool{
-			chatmessage.Table: chatmessage.ValidColumn,
-			chatsession.Table: chatsession.ValidColumn,
-			exportjob.Table:   exportjob.ValidColumn,
-			project.Table:     project.ValidColumn,
-			settings.Table:    settings.ValidColumn,
-			videoclip.Table:   videoclip.ValidColumn,
+			chatmessage.Table:         chatmessage.ValidColumn,
+			chatsession.Table:         chatsession.ValidColumn,
+			collection.Table:          collection.ValidColumn,
+			cut.Table:                 cut.ValidColumn,
+			exportjob.Table:           exportjob.ValidColumn,
+			project.Table:             project.ValidColumn,
+			projecttemplate.Table:     projecttemplate.ValidColumn,
+			prompt.Table:              prompt.ValidColumn,
+			responsecacheentry.Table:  responsecacheentry.ValidColumn,
+			settings.Table:            settings.ValidColumn,
+			tag.Table:                 tag.ValidColumn,
+			transcriptembedding.Table: transcriptembedding.ValidColumn,
+			usagerecord.Table:         usagerecord.ValidColumn,
+			videoclip.Table:           videoclip.ValidColumn,
 		})
 	})
 	return columnCheck(table, column)
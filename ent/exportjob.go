@@ -3,9 +3,9 @@
 package ent
 
 import (
+	"fmt"
 	"ramble-ai/ent/exportjob"
 	"ramble-ai/ent/project"
-	"fmt"
 	"strings"
 	"time"
 
@@ -46,6 +46,8 @@ type ExportJob struct {
 	CreatedAt time.Time `json:"created_at,omitempty"`
 	// Last update timestamp
 	UpdatedAt time.Time `json:"updated_at,omitempty"`
+	// Cut being exported (0 = all project highlights)
+	CutID int `json:"cut_id,omitempty"`
 	// Job completion timestamp
 	CompletedAt time.Time `json:"completed_at,omitempty"`
 	// Edges holds the relations/edges for other nodes in the graph.
@@ -84,7 +86,7 @@ func (*ExportJob) scanValues(columns []string) ([]any, error) {
 			values[i] = new(sql.NullBool)
 		case exportjob.FieldProgress:
 			values[i] = new(sql.NullFloat64)
-		case exportjob.FieldID, exportjob.FieldTotalFiles, exportjob.FieldProcessedFiles:
+		case exportjob.FieldID, exportjob.FieldTotalFiles, exportjob.FieldProcessedFiles, exportjob.FieldCutID:
 			values[i] = new(sql.NullInt64)
 		case exportjob.FieldJobID, exportjob.FieldExportType, exportjob.FieldOutputPath, exportjob.FieldStage, exportjob.FieldCurrentFile, exportjob.FieldErrorMessage:
 			values[i] = new(sql.NullString)
@@ -197,6 +199,12 @@ func (ej *ExportJob) assignValues(columns []string, values []any) error {
 			} else if value.Valid {
 				ej.UpdatedAt = value.Time
 			}
+		case exportjob.FieldCutID:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field cut_id", values[i])
+			} else if value.Valid {
+				ej.CutID = int(value.Int64)
+			}
 		case exportjob.FieldCompletedAt:
 			if value, ok := values[i].(*sql.NullTime); !ok {
 				return fmt.Errorf("unexpected type %T for field completed_at", values[i])
@@ -293,6 +301,9 @@ func (ej *ExportJob) String() string {
 	builder.WriteString("updated_at=")
 	builder.WriteString(ej.UpdatedAt.Format(time.ANSIC))
 	builder.WriteString(", ")
+	builder.WriteString("cut_id=")
+	builder.WriteString(fmt.Sprintf("%v", ej.CutID))
+	builder.WriteString(", ")
 	builder.WriteString("completed_at=")
 	builder.WriteString(ej.CompletedAt.Format(time.ANSIC))
 	builder.WriteByte(')')
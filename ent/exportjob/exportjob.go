@@ -42,6 +42,8 @@ const (
 	FieldCreatedAt = "created_at"
 	// FieldUpdatedAt holds the string denoting the updated_at field in the database.
 	FieldUpdatedAt = "updated_at"
+	// FieldCutID holds the string denoting the cut_id field in the database.
+	FieldCutID = "cut_id"
 	// FieldCompletedAt holds the string denoting the completed_at field in the database.
 	FieldCompletedAt = "completed_at"
 	// EdgeProject holds the string denoting the project edge name in mutations.
@@ -74,6 +76,7 @@ var Columns = []string{
 	FieldIsCancelled,
 	FieldCreatedAt,
 	FieldUpdatedAt,
+	FieldCutID,
 	FieldCompletedAt,
 }
 
@@ -205,6 +208,11 @@ func ByUpdatedAt(opts ...sql.OrderTermOption) OrderOption {
 	return sql.OrderByField(FieldUpdatedAt, opts...).ToFunc()
 }
 
+// ByCutID orders the results by the cut_id field.
+func ByCutID(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldCutID, opts...).ToFunc()
+}
+
 // ByCompletedAt orders the results by the completed_at field.
 func ByCompletedAt(opts ...sql.OrderTermOption) OrderOption {
 	return sql.OrderByField(FieldCompletedAt, opts...).ToFunc()
@@ -125,6 +125,11 @@ func UpdatedAt(v time.Time) predicate.ExportJob {
 	return predicate.ExportJob(sql.FieldEQ(FieldUpdatedAt, v))
 }
 
+// CutID applies equality check predicate on the "cut_id" field. It's identical to CutIDEQ.
+func CutID(v int) predicate.ExportJob {
+	return predicate.ExportJob(sql.FieldEQ(FieldCutID, v))
+}
+
 // CompletedAt applies equality check predicate on the "completed_at" field. It's identical to CompletedAtEQ.
 func CompletedAt(v time.Time) predicate.ExportJob {
 	return predicate.ExportJob(sql.FieldEQ(FieldCompletedAt, v))
@@ -770,6 +775,56 @@ func UpdatedAtLTE(v time.Time) predicate.ExportJob {
 	return predicate.ExportJob(sql.FieldLTE(FieldUpdatedAt, v))
 }
 
+// CutIDEQ applies the EQ predicate on the "cut_id" field.
+func CutIDEQ(v int) predicate.ExportJob {
+	return predicate.ExportJob(sql.FieldEQ(FieldCutID, v))
+}
+
+// CutIDNEQ applies the NEQ predicate on the "cut_id" field.
+func CutIDNEQ(v int) predicate.ExportJob {
+	return predicate.ExportJob(sql.FieldNEQ(FieldCutID, v))
+}
+
+// CutIDIn applies the In predicate on the "cut_id" field.
+func CutIDIn(vs ...int) predicate.ExportJob {
+	return predicate.ExportJob(sql.FieldIn(FieldCutID, vs...))
+}
+
+// CutIDNotIn applies the NotIn predicate on the "cut_id" field.
+func CutIDNotIn(vs ...int) predicate.ExportJob {
+	return predicate.ExportJob(sql.FieldNotIn(FieldCutID, vs...))
+}
+
+// CutIDGT applies the GT predicate on the "cut_id" field.
+func CutIDGT(v int) predicate.ExportJob {
+	return predicate.ExportJob(sql.FieldGT(FieldCutID, v))
+}
+
+// CutIDGTE applies the GTE predicate on the "cut_id" field.
+func CutIDGTE(v int) predicate.ExportJob {
+	return predicate.ExportJob(sql.FieldGTE(FieldCutID, v))
+}
+
+// CutIDLT applies the LT predicate on the "cut_id" field.
+func CutIDLT(v int) predicate.ExportJob {
+	return predicate.ExportJob(sql.FieldLT(FieldCutID, v))
+}
+
+// CutIDLTE applies the LTE predicate on the "cut_id" field.
+func CutIDLTE(v int) predicate.ExportJob {
+	return predicate.ExportJob(sql.FieldLTE(FieldCutID, v))
+}
+
+// CutIDIsNil applies the IsNil predicate on the "cut_id" field.
+func CutIDIsNil() predicate.ExportJob {
+	return predicate.ExportJob(sql.FieldIsNull(FieldCutID))
+}
+
+// CutIDNotNil applies the NotNil predicate on the "cut_id" field.
+func CutIDNotNil() predicate.ExportJob {
+	return predicate.ExportJob(sql.FieldNotNull(FieldCutID))
+}
+
 // CompletedAtEQ applies the EQ predicate on the "completed_at" field.
 func CompletedAtEQ(v time.Time) predicate.ExportJob {
 	return predicate.ExportJob(sql.FieldEQ(FieldCompletedAt, v))
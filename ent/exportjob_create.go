@@ -3,11 +3,11 @@
 package ent
 
 import (
-	"ramble-ai/ent/exportjob"
-	"ramble-ai/ent/project"
 	"context"
 	"errors"
 	"fmt"
+	"ramble-ai/ent/exportjob"
+	"ramble-ai/ent/project"
 	"time"
 
 	"entgo.io/ent/dialect/sql/sqlgraph"
@@ -193,6 +193,20 @@ func (ejc *ExportJobCreate) SetNillableUpdatedAt(t *time.Time) *ExportJobCreate
 	return ejc
 }
 
+// SetCutID sets the "cut_id" field.
+func (ejc *ExportJobCreate) SetCutID(i int) *ExportJobCreate {
+	ejc.mutation.SetCutID(i)
+	return ejc
+}
+
+// SetNillableCutID sets the "cut_id" field if the given value is not nil.
+func (ejc *ExportJobCreate) SetNillableCutID(i *int) *ExportJobCreate {
+	if i != nil {
+		ejc.SetCutID(*i)
+	}
+	return ejc
+}
+
 // SetCompletedAt sets the "completed_at" field.
 func (ejc *ExportJobCreate) SetCompletedAt(t time.Time) *ExportJobCreate {
 	ejc.mutation.SetCompletedAt(t)
@@ -434,6 +448,10 @@ func (ejc *ExportJobCreate) createSpec() (*ExportJob, *sqlgraph.CreateSpec) {
 		_spec.SetField(exportjob.FieldUpdatedAt, field.TypeTime, value)
 		_node.UpdatedAt = value
 	}
+	if value, ok := ejc.mutation.CutID(); ok {
+		_spec.SetField(exportjob.FieldCutID, field.TypeInt, value)
+		_node.CutID = value
+	}
 	if value, ok := ejc.mutation.CompletedAt(); ok {
 		_spec.SetField(exportjob.FieldCompletedAt, field.TypeTime, value)
 		_node.CompletedAt = value
@@ -3,12 +3,12 @@
 package ent
 
 import (
-	"ramble-ai/ent/exportjob"
-	"ramble-ai/ent/predicate"
-	"ramble-ai/ent/project"
 	"context"
 	"errors"
 	"fmt"
+	"ramble-ai/ent/exportjob"
+	"ramble-ai/ent/predicate"
+	"ramble-ai/ent/project"
 	"time"
 
 	"entgo.io/ent/dialect/sql"
@@ -250,6 +250,33 @@ func (eju *ExportJobUpdate) SetUpdatedAt(t time.Time) *ExportJobUpdate {
 	return eju
 }
 
+// SetCutID sets the "cut_id" field.
+func (eju *ExportJobUpdate) SetCutID(i int) *ExportJobUpdate {
+	eju.mutation.ResetCutID()
+	eju.mutation.SetCutID(i)
+	return eju
+}
+
+// SetNillableCutID sets the "cut_id" field if the given value is not nil.
+func (eju *ExportJobUpdate) SetNillableCutID(i *int) *ExportJobUpdate {
+	if i != nil {
+		eju.SetCutID(*i)
+	}
+	return eju
+}
+
+// AddCutID adds i to the "cut_id" field.
+func (eju *ExportJobUpdate) AddCutID(i int) *ExportJobUpdate {
+	eju.mutation.AddCutID(i)
+	return eju
+}
+
+// ClearCutID clears the value of the "cut_id" field.
+func (eju *ExportJobUpdate) ClearCutID() *ExportJobUpdate {
+	eju.mutation.ClearCutID()
+	return eju
+}
+
 // SetCompletedAt sets the "completed_at" field.
 func (eju *ExportJobUpdate) SetCompletedAt(t time.Time) *ExportJobUpdate {
 	eju.mutation.SetCompletedAt(t)
@@ -425,6 +452,15 @@ func (eju *ExportJobUpdate) sqlSave(ctx context.Context) (n int, err error) {
 	if value, ok := eju.mutation.UpdatedAt(); ok {
 		_spec.SetField(exportjob.FieldUpdatedAt, field.TypeTime, value)
 	}
+	if value, ok := eju.mutation.CutID(); ok {
+		_spec.SetField(exportjob.FieldCutID, field.TypeInt, value)
+	}
+	if value, ok := eju.mutation.AddedCutID(); ok {
+		_spec.AddField(exportjob.FieldCutID, field.TypeInt, value)
+	}
+	if eju.mutation.CutIDCleared() {
+		_spec.ClearField(exportjob.FieldCutID, field.TypeInt)
+	}
 	if value, ok := eju.mutation.CompletedAt(); ok {
 		_spec.SetField(exportjob.FieldCompletedAt, field.TypeTime, value)
 	}
@@ -701,6 +737,33 @@ func (ejuo *ExportJobUpdateOne) SetUpdatedAt(t time.Time) *ExportJobUpdateOne {
 	return ejuo
 }
 
+// SetCutID sets the "cut_id" field.
+func (ejuo *ExportJobUpdateOne) SetCutID(i int) *ExportJobUpdateOne {
+	ejuo.mutation.ResetCutID()
+	ejuo.mutation.SetCutID(i)
+	return ejuo
+}
+
+// SetNillableCutID sets the "cut_id" field if the given value is not nil.
+func (ejuo *ExportJobUpdateOne) SetNillableCutID(i *int) *ExportJobUpdateOne {
+	if i != nil {
+		ejuo.SetCutID(*i)
+	}
+	return ejuo
+}
+
+// AddCutID adds i to the "cut_id" field.
+func (ejuo *ExportJobUpdateOne) AddCutID(i int) *ExportJobUpdateOne {
+	ejuo.mutation.AddCutID(i)
+	return ejuo
+}
+
+// ClearCutID clears the value of the "cut_id" field.
+func (ejuo *ExportJobUpdateOne) ClearCutID() *ExportJobUpdateOne {
+	ejuo.mutation.ClearCutID()
+	return ejuo
+}
+
 // SetCompletedAt sets the "completed_at" field.
 func (ejuo *ExportJobUpdateOne) SetCompletedAt(t time.Time) *ExportJobUpdateOne {
 	ejuo.mutation.SetCompletedAt(t)
@@ -906,6 +969,15 @@ func (ejuo *ExportJobUpdateOne) sqlSave(ctx context.Context) (_node *ExportJob,
 	if value, ok := ejuo.mutation.UpdatedAt(); ok {
 		_spec.SetField(exportjob.FieldUpdatedAt, field.TypeTime, value)
 	}
+	if value, ok := ejuo.mutation.CutID(); ok {
+		_spec.SetField(exportjob.FieldCutID, field.TypeInt, value)
+	}
+	if value, ok := ejuo.mutation.AddedCutID(); ok {
+		_spec.AddField(exportjob.FieldCutID, field.TypeInt, value)
+	}
+	if ejuo.mutation.CutIDCleared() {
+		_spec.ClearField(exportjob.FieldCutID, field.TypeInt)
+	}
 	if value, ok := ejuo.mutation.CompletedAt(); ok {
 		_spec.SetField(exportjob.FieldCompletedAt, field.TypeTime, value)
 	}
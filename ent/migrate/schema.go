@@ -3,6 +3,7 @@
 package migrate
 
 import (
+	"entgo.io/ent/dialect/entsql"
 	"entgo.io/ent/dialect/sql/schema"
 	"entgo.io/ent/schema/field"
 )
@@ -17,6 +18,8 @@ var (
 		{Name: "hidden_context", Type: field.TypeString, Nullable: true, Size: 2147483647},
 		{Name: "timestamp", Type: field.TypeTime},
 		{Name: "model", Type: field.TypeString, Nullable: true},
+		{Name: "function_results", Type: field.TypeJSON, Nullable: true},
+		{Name: "order_snapshot", Type: field.TypeJSON, Nullable: true},
 		{Name: "session_id", Type: field.TypeInt},
 	}
 	// ChatMessagesTable holds the schema information for the "chat_messages" table.
@@ -27,7 +30,7 @@ var (
 		ForeignKeys: []*schema.ForeignKey{
 			{
 				Symbol:     "chat_messages_chat_sessions_messages",
-				Columns:    []*schema.Column{ChatMessagesColumns[7]},
+				Columns:    []*schema.Column{ChatMessagesColumns[9]},
 				RefColumns: []*schema.Column{ChatSessionsColumns[0]},
 				OnDelete:   schema.NoAction,
 			},
@@ -41,12 +44,12 @@ var (
 			{
 				Name:    "chatmessage_session_id",
 				Unique:  false,
-				Columns: []*schema.Column{ChatMessagesColumns[7]},
+				Columns: []*schema.Column{ChatMessagesColumns[9]},
 			},
 			{
 				Name:    "chatmessage_session_id_timestamp",
 				Unique:  false,
-				Columns: []*schema.Column{ChatMessagesColumns[7], ChatMessagesColumns[5]},
+				Columns: []*schema.Column{ChatMessagesColumns[9], ChatMessagesColumns[5]},
 			},
 			{
 				Name:    "chatmessage_role",
@@ -63,6 +66,8 @@ var (
 		{Name: "created_at", Type: field.TypeTime},
 		{Name: "updated_at", Type: field.TypeTime},
 		{Name: "selected_model", Type: field.TypeString, Nullable: true},
+		{Name: "title", Type: field.TypeString, Nullable: true},
+		{Name: "archived", Type: field.TypeBool, Default: false},
 		{Name: "project_id", Type: field.TypeInt},
 	}
 	// ChatSessionsTable holds the schema information for the "chat_sessions" table.
@@ -73,7 +78,7 @@ var (
 		ForeignKeys: []*schema.ForeignKey{
 			{
 				Symbol:     "chat_sessions_projects_chat_sessions",
-				Columns:    []*schema.Column{ChatSessionsColumns[6]},
+				Columns:    []*schema.Column{ChatSessionsColumns[8]},
 				RefColumns: []*schema.Column{ProjectsColumns[0]},
 				OnDelete:   schema.NoAction,
 			},
@@ -86,13 +91,13 @@ var (
 			},
 			{
 				Name:    "chatsession_project_id_endpoint_id",
-				Unique:  true,
-				Columns: []*schema.Column{ChatSessionsColumns[6], ChatSessionsColumns[2]},
+				Unique:  false,
+				Columns: []*schema.Column{ChatSessionsColumns[8], ChatSessionsColumns[2]},
 			},
 			{
 				Name:    "chatsession_project_id",
 				Unique:  false,
-				Columns: []*schema.Column{ChatSessionsColumns[6]},
+				Columns: []*schema.Column{ChatSessionsColumns[8]},
 			},
 			{
 				Name:    "chatsession_endpoint_id",
@@ -101,6 +106,53 @@ var (
 			},
 		},
 	}
+	// CollectionsColumns holds the columns for the "collections" table.
+	CollectionsColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeInt, Increment: true},
+		{Name: "name", Type: field.TypeString, Unique: true},
+		{Name: "description", Type: field.TypeString, Nullable: true, Size: 2147483647},
+		{Name: "created_at", Type: field.TypeTime},
+		{Name: "updated_at", Type: field.TypeTime},
+	}
+	// CollectionsTable holds the schema information for the "collections" table.
+	CollectionsTable = &schema.Table{
+		Name:       "collections",
+		Columns:    CollectionsColumns,
+		PrimaryKey: []*schema.Column{CollectionsColumns[0]},
+	}
+	// CutsColumns holds the columns for the "cuts" table.
+	CutsColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeInt, Increment: true},
+		{Name: "name", Type: field.TypeString},
+		{Name: "highlight_order", Type: field.TypeJSON, Nullable: true},
+		{Name: "order_history", Type: field.TypeJSON, Nullable: true},
+		{Name: "order_history_index", Type: field.TypeInt, Nullable: true, Default: -1},
+		{Name: "hidden_highlights", Type: field.TypeJSON, Nullable: true},
+		{Name: "created_at", Type: field.TypeTime},
+		{Name: "updated_at", Type: field.TypeTime},
+		{Name: "project_id", Type: field.TypeInt},
+	}
+	// CutsTable holds the schema information for the "cuts" table.
+	CutsTable = &schema.Table{
+		Name:       "cuts",
+		Columns:    CutsColumns,
+		PrimaryKey: []*schema.Column{CutsColumns[0]},
+		ForeignKeys: []*schema.ForeignKey{
+			{
+				Symbol:     "cuts_projects_cuts",
+				Columns:    []*schema.Column{CutsColumns[8]},
+				RefColumns: []*schema.Column{ProjectsColumns[0]},
+				OnDelete:   schema.NoAction,
+			},
+		},
+		Indexes: []*schema.Index{
+			{
+				Name:    "cut_project_id_name",
+				Unique:  true,
+				Columns: []*schema.Column{CutsColumns[8], CutsColumns[1]},
+			},
+		},
+	}
 	// ExportJobsColumns holds the columns for the "export_jobs" table.
 	ExportJobsColumns = []*schema.Column{
 		{Name: "id", Type: field.TypeInt, Increment: true},
@@ -118,6 +170,7 @@ var (
 		{Name: "is_cancelled", Type: field.TypeBool, Default: false},
 		{Name: "created_at", Type: field.TypeTime},
 		{Name: "updated_at", Type: field.TypeTime},
+		{Name: "cut_id", Type: field.TypeInt, Nullable: true},
 		{Name: "completed_at", Type: field.TypeTime, Nullable: true},
 		{Name: "project_export_jobs", Type: field.TypeInt, Nullable: true},
 	}
@@ -129,7 +182,7 @@ var (
 		ForeignKeys: []*schema.ForeignKey{
 			{
 				Symbol:     "export_jobs_projects_export_jobs",
-				Columns:    []*schema.Column{ExportJobsColumns[16]},
+				Columns:    []*schema.Column{ExportJobsColumns[17]},
 				RefColumns: []*schema.Column{ProjectsColumns[0]},
 				OnDelete:   schema.SetNull,
 			},
@@ -147,6 +200,7 @@ var (
 		{Name: "ai_prompt", Type: field.TypeString, Nullable: true, Size: 2147483647},
 		{Name: "ai_suggestion_order", Type: field.TypeJSON, Nullable: true},
 		{Name: "ai_suggestion_model", Type: field.TypeString, Nullable: true},
+		{Name: "ai_suggestion_prompt_id", Type: field.TypeInt, Nullable: true},
 		{Name: "ai_suggestion_created_at", Type: field.TypeTime, Nullable: true},
 		{Name: "ai_highlight_model", Type: field.TypeString, Nullable: true, Default: "anthropic/claude-sonnet-4"},
 		{Name: "ai_highlight_prompt", Type: field.TypeString, Nullable: true, Size: 2147483647},
@@ -158,12 +212,127 @@ var (
 		{Name: "order_history", Type: field.TypeJSON, Nullable: true},
 		{Name: "order_history_index", Type: field.TypeInt, Nullable: true, Default: -1},
 		{Name: "hidden_highlights", Type: field.TypeJSON, Nullable: true},
+		{Name: "export_presets", Type: field.TypeJSON, Nullable: true},
+		{Name: "active_cut_id", Type: field.TypeInt, Nullable: true},
+		{Name: "deleted_at", Type: field.TypeTime, Nullable: true},
 	}
 	// ProjectsTable holds the schema information for the "projects" table.
 	ProjectsTable = &schema.Table{
 		Name:       "projects",
 		Columns:    ProjectsColumns,
 		PrimaryKey: []*schema.Column{ProjectsColumns[0]},
+		Indexes: []*schema.Index{
+			{
+				Name:    "project_created_at",
+				Unique:  false,
+				Columns: []*schema.Column{ProjectsColumns[4]},
+			},
+			{
+				Name:    "project_deleted_at",
+				Unique:  false,
+				Columns: []*schema.Column{ProjectsColumns[24]},
+			},
+			{
+				Name:    "project_updated_at",
+				Unique:  false,
+				Columns: []*schema.Column{ProjectsColumns[5]},
+			},
+		},
+	}
+	// ProjectTemplatesColumns holds the columns for the "project_templates" table.
+	ProjectTemplatesColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeInt, Increment: true},
+		{Name: "name", Type: field.TypeString, Unique: true},
+		{Name: "description", Type: field.TypeString, Nullable: true, Size: 2147483647},
+		{Name: "ai_model", Type: field.TypeString, Nullable: true},
+		{Name: "ai_prompt", Type: field.TypeString, Nullable: true, Size: 2147483647},
+		{Name: "highlight_ai_model", Type: field.TypeString, Nullable: true},
+		{Name: "highlight_ai_prompt", Type: field.TypeString, Nullable: true, Size: 2147483647},
+		{Name: "export_presets", Type: field.TypeJSON, Nullable: true},
+		{Name: "section_titles", Type: field.TypeJSON, Nullable: true},
+		{Name: "created_at", Type: field.TypeTime},
+		{Name: "updated_at", Type: field.TypeTime},
+	}
+	// ProjectTemplatesTable holds the schema information for the "project_templates" table.
+	ProjectTemplatesTable = &schema.Table{
+		Name:       "project_templates",
+		Columns:    ProjectTemplatesColumns,
+		PrimaryKey: []*schema.Column{ProjectTemplatesColumns[0]},
+	}
+	// PromptsColumns holds the columns for the "prompts" table.
+	PromptsColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeInt, Increment: true},
+		{Name: "name", Type: field.TypeString},
+		{Name: "version", Type: field.TypeInt},
+		{Name: "task_type", Type: field.TypeString},
+		{Name: "body", Type: field.TypeString, Size: 2147483647},
+		{Name: "description", Type: field.TypeString, Nullable: true, Size: 2147483647},
+		{Name: "variables", Type: field.TypeJSON, Nullable: true},
+		{Name: "created_at", Type: field.TypeTime},
+		{Name: "project_id", Type: field.TypeInt, Nullable: true},
+	}
+	// PromptsTable holds the schema information for the "prompts" table.
+	PromptsTable = &schema.Table{
+		Name:       "prompts",
+		Columns:    PromptsColumns,
+		PrimaryKey: []*schema.Column{PromptsColumns[0]},
+		ForeignKeys: []*schema.ForeignKey{
+			{
+				Symbol:     "prompts_projects_prompts",
+				Columns:    []*schema.Column{PromptsColumns[8]},
+				RefColumns: []*schema.Column{ProjectsColumns[0]},
+				OnDelete:   schema.SetNull,
+			},
+		},
+		Indexes: []*schema.Index{
+			{
+				Name:    "prompt_project_id_name_version",
+				Unique:  true,
+				Columns: []*schema.Column{PromptsColumns[8], PromptsColumns[1], PromptsColumns[2]},
+			},
+			{
+				Name:    "prompt_name_version",
+				Unique:  true,
+				Columns: []*schema.Column{PromptsColumns[1], PromptsColumns[2]},
+				Annotation: &entsql.IndexAnnotation{
+					Where: "project_id IS NULL",
+				},
+			},
+			{
+				Name:    "prompt_task_type",
+				Unique:  false,
+				Columns: []*schema.Column{PromptsColumns[3]},
+			},
+		},
+	}
+	// ResponseCacheEntriesColumns holds the columns for the "response_cache_entries" table.
+	ResponseCacheEntriesColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeInt, Increment: true},
+		{Name: "key", Type: field.TypeString, Unique: true},
+		{Name: "task_type", Type: field.TypeString, Nullable: true},
+		{Name: "model", Type: field.TypeString, Nullable: true},
+		{Name: "response", Type: field.TypeString, Size: 2147483647},
+		{Name: "hits", Type: field.TypeInt, Default: 0},
+		{Name: "created_at", Type: field.TypeTime},
+		{Name: "expires_at", Type: field.TypeTime},
+	}
+	// ResponseCacheEntriesTable holds the schema information for the "response_cache_entries" table.
+	ResponseCacheEntriesTable = &schema.Table{
+		Name:       "response_cache_entries",
+		Columns:    ResponseCacheEntriesColumns,
+		PrimaryKey: []*schema.Column{ResponseCacheEntriesColumns[0]},
+		Indexes: []*schema.Index{
+			{
+				Name:    "responsecacheentry_task_type",
+				Unique:  false,
+				Columns: []*schema.Column{ResponseCacheEntriesColumns[2]},
+			},
+			{
+				Name:    "responsecacheentry_expires_at",
+				Unique:  false,
+				Columns: []*schema.Column{ResponseCacheEntriesColumns[7]},
+			},
+		},
 	}
 	// SettingsColumns holds the columns for the "settings" table.
 	SettingsColumns = []*schema.Column{
@@ -179,6 +348,93 @@ var (
 		Columns:    SettingsColumns,
 		PrimaryKey: []*schema.Column{SettingsColumns[0]},
 	}
+	// TagsColumns holds the columns for the "tags" table.
+	TagsColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeInt, Increment: true},
+		{Name: "name", Type: field.TypeString, Unique: true},
+		{Name: "color", Type: field.TypeString, Nullable: true},
+		{Name: "created_at", Type: field.TypeTime},
+	}
+	// TagsTable holds the schema information for the "tags" table.
+	TagsTable = &schema.Table{
+		Name:       "tags",
+		Columns:    TagsColumns,
+		PrimaryKey: []*schema.Column{TagsColumns[0]},
+	}
+	// TranscriptEmbeddingsColumns holds the columns for the "transcript_embeddings" table.
+	TranscriptEmbeddingsColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeInt, Increment: true},
+		{Name: "chunk_index", Type: field.TypeInt},
+		{Name: "start_word", Type: field.TypeInt},
+		{Name: "end_word", Type: field.TypeInt},
+		{Name: "start_time", Type: field.TypeFloat64},
+		{Name: "end_time", Type: field.TypeFloat64},
+		{Name: "text", Type: field.TypeString, Size: 2147483647},
+		{Name: "model", Type: field.TypeString},
+		{Name: "source_hash", Type: field.TypeString},
+		{Name: "embedding", Type: field.TypeJSON},
+		{Name: "created_at", Type: field.TypeTime},
+		{Name: "video_clip_id", Type: field.TypeInt},
+	}
+	// TranscriptEmbeddingsTable holds the schema information for the "transcript_embeddings" table.
+	TranscriptEmbeddingsTable = &schema.Table{
+		Name:       "transcript_embeddings",
+		Columns:    TranscriptEmbeddingsColumns,
+		PrimaryKey: []*schema.Column{TranscriptEmbeddingsColumns[0]},
+		ForeignKeys: []*schema.ForeignKey{
+			{
+				Symbol:     "transcript_embeddings_video_clips_transcript_embeddings",
+				Columns:    []*schema.Column{TranscriptEmbeddingsColumns[11]},
+				RefColumns: []*schema.Column{VideoClipsColumns[0]},
+				OnDelete:   schema.NoAction,
+			},
+		},
+		Indexes: []*schema.Index{
+			{
+				Name:    "transcriptembedding_video_clip_id",
+				Unique:  false,
+				Columns: []*schema.Column{TranscriptEmbeddingsColumns[11]},
+			},
+			{
+				Name:    "transcriptembedding_video_clip_id_model",
+				Unique:  false,
+				Columns: []*schema.Column{TranscriptEmbeddingsColumns[11], TranscriptEmbeddingsColumns[7]},
+			},
+		},
+	}
+	// UsageRecordsColumns holds the columns for the "usage_records" table.
+	UsageRecordsColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeInt, Increment: true},
+		{Name: "project_id", Type: field.TypeInt, Nullable: true},
+		{Name: "provider", Type: field.TypeString},
+		{Name: "task_type", Type: field.TypeString},
+		{Name: "model", Type: field.TypeString, Nullable: true},
+		{Name: "prompt_tokens", Type: field.TypeInt, Default: 0},
+		{Name: "completion_tokens", Type: field.TypeInt, Default: 0},
+		{Name: "total_tokens", Type: field.TypeInt, Default: 0},
+		{Name: "audio_seconds", Type: field.TypeFloat64, Default: 0},
+		{Name: "cost_usd", Type: field.TypeFloat64, Default: 0},
+		{Name: "cost_estimated", Type: field.TypeBool, Default: false},
+		{Name: "created_at", Type: field.TypeTime},
+	}
+	// UsageRecordsTable holds the schema information for the "usage_records" table.
+	UsageRecordsTable = &schema.Table{
+		Name:       "usage_records",
+		Columns:    UsageRecordsColumns,
+		PrimaryKey: []*schema.Column{UsageRecordsColumns[0]},
+		Indexes: []*schema.Index{
+			{
+				Name:    "usagerecord_created_at",
+				Unique:  false,
+				Columns: []*schema.Column{UsageRecordsColumns[11]},
+			},
+			{
+				Name:    "usagerecord_project_id",
+				Unique:  false,
+				Columns: []*schema.Column{UsageRecordsColumns[1]},
+			},
+		},
+	}
 	// VideoClipsColumns holds the columns for the "video_clips" table.
 	VideoClipsColumns = []*schema.Column{
 		{Name: "id", Type: field.TypeInt, Increment: true},
@@ -196,6 +452,7 @@ var (
 		{Name: "transcription_duration", Type: field.TypeFloat64, Nullable: true},
 		{Name: "highlights", Type: field.TypeJSON, Nullable: true},
 		{Name: "suggested_highlights", Type: field.TypeJSON, Nullable: true},
+		{Name: "suggested_highlights_prompt_id", Type: field.TypeInt, Nullable: true},
 		{Name: "created_at", Type: field.TypeTime},
 		{Name: "updated_at", Type: field.TypeTime},
 		{Name: "highlights_history", Type: field.TypeJSON, Nullable: true},
@@ -204,6 +461,7 @@ var (
 		{Name: "transcription_error", Type: field.TypeString, Nullable: true},
 		{Name: "transcription_started_at", Type: field.TypeTime, Nullable: true},
 		{Name: "transcription_completed_at", Type: field.TypeTime, Nullable: true},
+		{Name: "deleted_at", Type: field.TypeTime, Nullable: true},
 		{Name: "project_video_clips", Type: field.TypeInt, Nullable: true},
 	}
 	// VideoClipsTable holds the schema information for the "video_clips" table.
@@ -214,26 +472,143 @@ var (
 		ForeignKeys: []*schema.ForeignKey{
 			{
 				Symbol:     "video_clips_projects_video_clips",
-				Columns:    []*schema.Column{VideoClipsColumns[23]},
+				Columns:    []*schema.Column{VideoClipsColumns[25]},
 				RefColumns: []*schema.Column{ProjectsColumns[0]},
 				OnDelete:   schema.SetNull,
 			},
 		},
+		Indexes: []*schema.Index{
+			{
+				Name:    "videoclip_created_at",
+				Unique:  false,
+				Columns: []*schema.Column{VideoClipsColumns[16]},
+			},
+			{
+				Name:    "videoclip_deleted_at",
+				Unique:  false,
+				Columns: []*schema.Column{VideoClipsColumns[24]},
+			},
+			{
+				Name:    "videoclip_transcription_state",
+				Unique:  false,
+				Columns: []*schema.Column{VideoClipsColumns[20]},
+			},
+			{
+				Name:    "videoclip_duration",
+				Unique:  false,
+				Columns: []*schema.Column{VideoClipsColumns[4]},
+			},
+		},
+	}
+	// CollectionProjectsColumns holds the columns for the "collection_projects" table.
+	CollectionProjectsColumns = []*schema.Column{
+		{Name: "collection_id", Type: field.TypeInt},
+		{Name: "project_id", Type: field.TypeInt},
+	}
+	// CollectionProjectsTable holds the schema information for the "collection_projects" table.
+	CollectionProjectsTable = &schema.Table{
+		Name:       "collection_projects",
+		Columns:    CollectionProjectsColumns,
+		PrimaryKey: []*schema.Column{CollectionProjectsColumns[0], CollectionProjectsColumns[1]},
+		ForeignKeys: []*schema.ForeignKey{
+			{
+				Symbol:     "collection_projects_collection_id",
+				Columns:    []*schema.Column{CollectionProjectsColumns[0]},
+				RefColumns: []*schema.Column{CollectionsColumns[0]},
+				OnDelete:   schema.Cascade,
+			},
+			{
+				Symbol:     "collection_projects_project_id",
+				Columns:    []*schema.Column{CollectionProjectsColumns[1]},
+				RefColumns: []*schema.Column{ProjectsColumns[0]},
+				OnDelete:   schema.Cascade,
+			},
+		},
+	}
+	// TagProjectsColumns holds the columns for the "tag_projects" table.
+	TagProjectsColumns = []*schema.Column{
+		{Name: "tag_id", Type: field.TypeInt},
+		{Name: "project_id", Type: field.TypeInt},
+	}
+	// TagProjectsTable holds the schema information for the "tag_projects" table.
+	TagProjectsTable = &schema.Table{
+		Name:       "tag_projects",
+		Columns:    TagProjectsColumns,
+		PrimaryKey: []*schema.Column{TagProjectsColumns[0], TagProjectsColumns[1]},
+		ForeignKeys: []*schema.ForeignKey{
+			{
+				Symbol:     "tag_projects_tag_id",
+				Columns:    []*schema.Column{TagProjectsColumns[0]},
+				RefColumns: []*schema.Column{TagsColumns[0]},
+				OnDelete:   schema.Cascade,
+			},
+			{
+				Symbol:     "tag_projects_project_id",
+				Columns:    []*schema.Column{TagProjectsColumns[1]},
+				RefColumns: []*schema.Column{ProjectsColumns[0]},
+				OnDelete:   schema.Cascade,
+			},
+		},
+	}
+	// TagVideoClipsColumns holds the columns for the "tag_video_clips" table.
+	TagVideoClipsColumns = []*schema.Column{
+		{Name: "tag_id", Type: field.TypeInt},
+		{Name: "video_clip_id", Type: field.TypeInt},
+	}
+	// TagVideoClipsTable holds the schema information for the "tag_video_clips" table.
+	TagVideoClipsTable = &schema.Table{
+		Name:       "tag_video_clips",
+		Columns:    TagVideoClipsColumns,
+		PrimaryKey: []*schema.Column{TagVideoClipsColumns[0], TagVideoClipsColumns[1]},
+		ForeignKeys: []*schema.ForeignKey{
+			{
+				Symbol:     "tag_video_clips_tag_id",
+				Columns:    []*schema.Column{TagVideoClipsColumns[0]},
+				RefColumns: []*schema.Column{TagsColumns[0]},
+				OnDelete:   schema.Cascade,
+			},
+			{
+				Symbol:     "tag_video_clips_video_clip_id",
+				Columns:    []*schema.Column{TagVideoClipsColumns[1]},
+				RefColumns: []*schema.Column{VideoClipsColumns[0]},
+				OnDelete:   schema.Cascade,
+			},
+		},
 	}
 	// Tables holds all the tables in the schema.
 	Tables = []*schema.Table{
 		ChatMessagesTable,
 		ChatSessionsTable,
+		CollectionsTable,
+		CutsTable,
 		ExportJobsTable,
 		ProjectsTable,
+		ProjectTemplatesTable,
+		PromptsTable,
+		ResponseCacheEntriesTable,
 		SettingsTable,
+		TagsTable,
+		TranscriptEmbeddingsTable,
+		UsageRecordsTable,
 		VideoClipsTable,
+		CollectionProjectsTable,
+		TagProjectsTable,
+		TagVideoClipsTable,
 	}
 )
 
 func init() {
 	ChatMessagesTable.ForeignKeys[0].RefTable = ChatSessionsTable
 	ChatSessionsTable.ForeignKeys[0].RefTable = ProjectsTable
+	CutsTable.ForeignKeys[0].RefTable = ProjectsTable
 	ExportJobsTable.ForeignKeys[0].RefTable = ProjectsTable
+	PromptsTable.ForeignKeys[0].RefTable = ProjectsTable
+	TranscriptEmbeddingsTable.ForeignKeys[0].RefTable = VideoClipsTable
 	VideoClipsTable.ForeignKeys[0].RefTable = ProjectsTable
+	CollectionProjectsTable.ForeignKeys[0].RefTable = CollectionsTable
+	CollectionProjectsTable.ForeignKeys[1].RefTable = ProjectsTable
+	TagProjectsTable.ForeignKeys[0].RefTable = TagsTable
+	TagProjectsTable.ForeignKeys[1].RefTable = ProjectsTable
+	TagVideoClipsTable.ForeignKeys[0].RefTable = TagsTable
+	TagVideoClipsTable.ForeignKeys[1].RefTable = VideoClipsTable
 }
@@ -3,17 +3,25 @@
 package ent
 
 import (
+	"context"
+	"errors"
+	"fmt"
 	"ramble-ai/ent/chatmessage"
 	"ramble-ai/ent/chatsession"
+	"ramble-ai/ent/collection"
+	"ramble-ai/ent/cut"
 	"ramble-ai/ent/exportjob"
 	"ramble-ai/ent/predicate"
 	"ramble-ai/ent/project"
+	"ramble-ai/ent/projecttemplate"
+	"ramble-ai/ent/prompt"
+	"ramble-ai/ent/responsecacheentry"
 	"ramble-ai/ent/schema"
 	"ramble-ai/ent/settings"
+	"ramble-ai/ent/tag"
+	"ramble-ai/ent/transcriptembedding"
+	"ramble-ai/ent/usagerecord"
 	"ramble-ai/ent/videoclip"
-	"context"
-	"errors"
-	"fmt"
 	"sync"
 	"time"
 
@@ -30,32 +38,44 @@ const (
 	OpUpdateOne = ent.OpUpdateOne
 
 	// Node types.
-	TypeChatMessage = "ChatMessage"
-	TypeChatSession = "ChatSession"
-	TypeExportJob   = "ExportJob"
-	TypeProject     = "Project"
-	TypeSettings    = "Settings"
-	TypeVideoClip   = "VideoClip"
+	TypeChatMessage         = "ChatMessage"
+	TypeChatSession         = "ChatSession"
+	TypeCollection          = "Collection"
+	TypeCut                 = "Cut"
+	TypeExportJob           = "ExportJob"
+	TypeProject             = "Project"
+	TypeProjectTemplate     = "ProjectTemplate"
+	TypePrompt              = "Prompt"
+	TypeResponseCacheEntry  = "ResponseCacheEntry"
+	TypeSettings            = "Settings"
+	TypeTag                 = "Tag"
+	TypeTranscriptEmbedding = "TranscriptEmbedding"
+	TypeUsageRecord         = "UsageRecord"
+	TypeVideoClip           = "VideoClip"
 )
 
 // ChatMessageMutation represents an operation that mutates the ChatMessage nodes in the graph.
 type ChatMessageMutation struct {
 	config
-	op             Op
-	typ            string
-	id             *int
-	message_id     *string
-	role           *chatmessage.Role
-	content        *string
-	hidden_context *string
-	timestamp      *time.Time
-	model          *string
-	clearedFields  map[string]struct{}
-	session        *int
-	clearedsession bool
-	done           bool
-	oldValue       func(context.Context) (*ChatMessage, error)
-	predicates     []predicate.ChatMessage
+	op                     Op
+	typ                    string
+	id                     *int
+	message_id             *string
+	role                   *chatmessage.Role
+	content                *string
+	hidden_context         *string
+	timestamp              *time.Time
+	model                  *string
+	function_results       *[]schema.ChatFunctionResult
+	appendfunction_results []schema.ChatFunctionResult
+	order_snapshot         *[]interface{}
+	appendorder_snapshot   []interface{}
+	clearedFields          map[string]struct{}
+	session                *int
+	clearedsession         bool
+	done                   bool
+	oldValue               func(context.Context) (*ChatMessage, error)
+	predicates             []predicate.ChatMessage
 }
 
 var _ ent.Mutation = (*ChatMessageMutation)(nil)
@@ -434,6 +454,136 @@ func (m *ChatMessageMutation) ResetModel() {
 	delete(m.clearedFields, chatmessage.FieldModel)
 }
 
+// SetFunctionResults sets the "function_results" field.
+func (m *ChatMessageMutation) SetFunctionResults(sfr []schema.ChatFunctionResult) {
+	m.function_results = &sfr
+	m.appendfunction_results = nil
+}
+
+// FunctionResults returns the value of the "function_results" field in the mutation.
+func (m *ChatMessageMutation) FunctionResults() (r []schema.ChatFunctionResult, exists bool) {
+	v := m.function_results
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldFunctionResults returns the old "function_results" field's value of the ChatMessage entity.
+// If the ChatMessage object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *ChatMessageMutation) OldFunctionResults(ctx context.Context) (v []schema.ChatFunctionResult, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldFunctionResults is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldFunctionResults requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldFunctionResults: %w", err)
+	}
+	return oldValue.FunctionResults, nil
+}
+
+// AppendFunctionResults adds sfr to the "function_results" field.
+func (m *ChatMessageMutation) AppendFunctionResults(sfr []schema.ChatFunctionResult) {
+	m.appendfunction_results = append(m.appendfunction_results, sfr...)
+}
+
+// AppendedFunctionResults returns the list of values that were appended to the "function_results" field in this mutation.
+func (m *ChatMessageMutation) AppendedFunctionResults() ([]schema.ChatFunctionResult, bool) {
+	if len(m.appendfunction_results) == 0 {
+		return nil, false
+	}
+	return m.appendfunction_results, true
+}
+
+// ClearFunctionResults clears the value of the "function_results" field.
+func (m *ChatMessageMutation) ClearFunctionResults() {
+	m.function_results = nil
+	m.appendfunction_results = nil
+	m.clearedFields[chatmessage.FieldFunctionResults] = struct{}{}
+}
+
+// FunctionResultsCleared returns if the "function_results" field was cleared in this mutation.
+func (m *ChatMessageMutation) FunctionResultsCleared() bool {
+	_, ok := m.clearedFields[chatmessage.FieldFunctionResults]
+	return ok
+}
+
+// ResetFunctionResults resets all changes to the "function_results" field.
+func (m *ChatMessageMutation) ResetFunctionResults() {
+	m.function_results = nil
+	m.appendfunction_results = nil
+	delete(m.clearedFields, chatmessage.FieldFunctionResults)
+}
+
+// SetOrderSnapshot sets the "order_snapshot" field.
+func (m *ChatMessageMutation) SetOrderSnapshot(i []interface{}) {
+	m.order_snapshot = &i
+	m.appendorder_snapshot = nil
+}
+
+// OrderSnapshot returns the value of the "order_snapshot" field in the mutation.
+func (m *ChatMessageMutation) OrderSnapshot() (r []interface{}, exists bool) {
+	v := m.order_snapshot
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldOrderSnapshot returns the old "order_snapshot" field's value of the ChatMessage entity.
+// If the ChatMessage object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *ChatMessageMutation) OldOrderSnapshot(ctx context.Context) (v []interface{}, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldOrderSnapshot is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldOrderSnapshot requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldOrderSnapshot: %w", err)
+	}
+	return oldValue.OrderSnapshot, nil
+}
+
+// AppendOrderSnapshot adds i to the "order_snapshot" field.
+func (m *ChatMessageMutation) AppendOrderSnapshot(i []interface{}) {
+	m.appendorder_snapshot = append(m.appendorder_snapshot, i...)
+}
+
+// AppendedOrderSnapshot returns the list of values that were appended to the "order_snapshot" field in this mutation.
+func (m *ChatMessageMutation) AppendedOrderSnapshot() ([]interface{}, bool) {
+	if len(m.appendorder_snapshot) == 0 {
+		return nil, false
+	}
+	return m.appendorder_snapshot, true
+}
+
+// ClearOrderSnapshot clears the value of the "order_snapshot" field.
+func (m *ChatMessageMutation) ClearOrderSnapshot() {
+	m.order_snapshot = nil
+	m.appendorder_snapshot = nil
+	m.clearedFields[chatmessage.FieldOrderSnapshot] = struct{}{}
+}
+
+// OrderSnapshotCleared returns if the "order_snapshot" field was cleared in this mutation.
+func (m *ChatMessageMutation) OrderSnapshotCleared() bool {
+	_, ok := m.clearedFields[chatmessage.FieldOrderSnapshot]
+	return ok
+}
+
+// ResetOrderSnapshot resets all changes to the "order_snapshot" field.
+func (m *ChatMessageMutation) ResetOrderSnapshot() {
+	m.order_snapshot = nil
+	m.appendorder_snapshot = nil
+	delete(m.clearedFields, chatmessage.FieldOrderSnapshot)
+}
+
 // ClearSession clears the "session" edge to the ChatSession entity.
 func (m *ChatMessageMutation) ClearSession() {
 	m.clearedsession = true
@@ -495,7 +645,7 @@ func (m *ChatMessageMutation) Type() string {
 // order to get all numeric fields that were incremented/decremented, call
 // AddedFields().
 func (m *ChatMessageMutation) Fields() []string {
-	fields := make([]string, 0, 7)
+	fields := make([]string, 0, 9)
 	if m.message_id != nil {
 		fields = append(fields, chatmessage.FieldMessageID)
 	}
@@ -517,6 +667,12 @@ func (m *ChatMessageMutation) Fields() []string {
 	if m.model != nil {
 		fields = append(fields, chatmessage.FieldModel)
 	}
+	if m.function_results != nil {
+		fields = append(fields, chatmessage.FieldFunctionResults)
+	}
+	if m.order_snapshot != nil {
+		fields = append(fields, chatmessage.FieldOrderSnapshot)
+	}
 	return fields
 }
 
@@ -539,6 +695,10 @@ func (m *ChatMessageMutation) Field(name string) (ent.Value, bool) {
 		return m.Timestamp()
 	case chatmessage.FieldModel:
 		return m.Model()
+	case chatmessage.FieldFunctionResults:
+		return m.FunctionResults()
+	case chatmessage.FieldOrderSnapshot:
+		return m.OrderSnapshot()
 	}
 	return nil, false
 }
@@ -562,6 +722,10 @@ func (m *ChatMessageMutation) OldField(ctx context.Context, name string) (ent.Va
 		return m.OldTimestamp(ctx)
 	case chatmessage.FieldModel:
 		return m.OldModel(ctx)
+	case chatmessage.FieldFunctionResults:
+		return m.OldFunctionResults(ctx)
+	case chatmessage.FieldOrderSnapshot:
+		return m.OldOrderSnapshot(ctx)
 	}
 	return nil, fmt.Errorf("unknown ChatMessage field %s", name)
 }
@@ -620,6 +784,20 @@ func (m *ChatMessageMutation) SetField(name string, value ent.Value) error {
 		}
 		m.SetModel(v)
 		return nil
+	case chatmessage.FieldFunctionResults:
+		v, ok := value.([]schema.ChatFunctionResult)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetFunctionResults(v)
+		return nil
+	case chatmessage.FieldOrderSnapshot:
+		v, ok := value.([]interface{})
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetOrderSnapshot(v)
+		return nil
 	}
 	return fmt.Errorf("unknown ChatMessage field %s", name)
 }
@@ -659,6 +837,12 @@ func (m *ChatMessageMutation) ClearedFields() []string {
 	if m.FieldCleared(chatmessage.FieldModel) {
 		fields = append(fields, chatmessage.FieldModel)
 	}
+	if m.FieldCleared(chatmessage.FieldFunctionResults) {
+		fields = append(fields, chatmessage.FieldFunctionResults)
+	}
+	if m.FieldCleared(chatmessage.FieldOrderSnapshot) {
+		fields = append(fields, chatmessage.FieldOrderSnapshot)
+	}
 	return fields
 }
 
@@ -679,6 +863,12 @@ func (m *ChatMessageMutation) ClearField(name string) error {
 	case chatmessage.FieldModel:
 		m.ClearModel()
 		return nil
+	case chatmessage.FieldFunctionResults:
+		m.ClearFunctionResults()
+		return nil
+	case chatmessage.FieldOrderSnapshot:
+		m.ClearOrderSnapshot()
+		return nil
 	}
 	return fmt.Errorf("unknown ChatMessage nullable field %s", name)
 }
@@ -708,6 +898,12 @@ func (m *ChatMessageMutation) ResetField(name string) error {
 	case chatmessage.FieldModel:
 		m.ResetModel()
 		return nil
+	case chatmessage.FieldFunctionResults:
+		m.ResetFunctionResults()
+		return nil
+	case chatmessage.FieldOrderSnapshot:
+		m.ResetOrderSnapshot()
+		return nil
 	}
 	return fmt.Errorf("unknown ChatMessage field %s", name)
 }
@@ -797,6 +993,8 @@ type ChatSessionMutation struct {
 	created_at      *time.Time
 	updated_at      *time.Time
 	selected_model  *string
+	title           *string
+	archived        *bool
 	clearedFields   map[string]struct{}
 	project         *int
 	clearedproject  bool
@@ -1135,6 +1333,91 @@ func (m *ChatSessionMutation) ResetSelectedModel() {
 	delete(m.clearedFields, chatsession.FieldSelectedModel)
 }
 
+// SetTitle sets the "title" field.
+func (m *ChatSessionMutation) SetTitle(s string) {
+	m.title = &s
+}
+
+// Title returns the value of the "title" field in the mutation.
+func (m *ChatSessionMutation) Title() (r string, exists bool) {
+	v := m.title
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldTitle returns the old "title" field's value of the ChatSession entity.
+// If the ChatSession object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *ChatSessionMutation) OldTitle(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldTitle is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldTitle requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldTitle: %w", err)
+	}
+	return oldValue.Title, nil
+}
+
+// ClearTitle clears the value of the "title" field.
+func (m *ChatSessionMutation) ClearTitle() {
+	m.title = nil
+	m.clearedFields[chatsession.FieldTitle] = struct{}{}
+}
+
+// TitleCleared returns if the "title" field was cleared in this mutation.
+func (m *ChatSessionMutation) TitleCleared() bool {
+	_, ok := m.clearedFields[chatsession.FieldTitle]
+	return ok
+}
+
+// ResetTitle resets all changes to the "title" field.
+func (m *ChatSessionMutation) ResetTitle() {
+	m.title = nil
+	delete(m.clearedFields, chatsession.FieldTitle)
+}
+
+// SetArchived sets the "archived" field.
+func (m *ChatSessionMutation) SetArchived(b bool) {
+	m.archived = &b
+}
+
+// Archived returns the value of the "archived" field in the mutation.
+func (m *ChatSessionMutation) Archived() (r bool, exists bool) {
+	v := m.archived
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldArchived returns the old "archived" field's value of the ChatSession entity.
+// If the ChatSession object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *ChatSessionMutation) OldArchived(ctx context.Context) (v bool, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldArchived is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldArchived requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldArchived: %w", err)
+	}
+	return oldValue.Archived, nil
+}
+
+// ResetArchived resets all changes to the "archived" field.
+func (m *ChatSessionMutation) ResetArchived() {
+	m.archived = nil
+}
+
 // ClearProject clears the "project" edge to the Project entity.
 func (m *ChatSessionMutation) ClearProject() {
 	m.clearedproject = true
@@ -1250,7 +1533,7 @@ func (m *ChatSessionMutation) Type() string {
 // order to get all numeric fields that were incremented/decremented, call
 // AddedFields().
 func (m *ChatSessionMutation) Fields() []string {
-	fields := make([]string, 0, 6)
+	fields := make([]string, 0, 8)
 	if m.session_id != nil {
 		fields = append(fields, chatsession.FieldSessionID)
 	}
@@ -1269,6 +1552,12 @@ func (m *ChatSessionMutation) Fields() []string {
 	if m.selected_model != nil {
 		fields = append(fields, chatsession.FieldSelectedModel)
 	}
+	if m.title != nil {
+		fields = append(fields, chatsession.FieldTitle)
+	}
+	if m.archived != nil {
+		fields = append(fields, chatsession.FieldArchived)
+	}
 	return fields
 }
 
@@ -1289,6 +1578,10 @@ func (m *ChatSessionMutation) Field(name string) (ent.Value, bool) {
 		return m.UpdatedAt()
 	case chatsession.FieldSelectedModel:
 		return m.SelectedModel()
+	case chatsession.FieldTitle:
+		return m.Title()
+	case chatsession.FieldArchived:
+		return m.Archived()
 	}
 	return nil, false
 }
@@ -1310,6 +1603,10 @@ func (m *ChatSessionMutation) OldField(ctx context.Context, name string) (ent.Va
 		return m.OldUpdatedAt(ctx)
 	case chatsession.FieldSelectedModel:
 		return m.OldSelectedModel(ctx)
+	case chatsession.FieldTitle:
+		return m.OldTitle(ctx)
+	case chatsession.FieldArchived:
+		return m.OldArchived(ctx)
 	}
 	return nil, fmt.Errorf("unknown ChatSession field %s", name)
 }
@@ -1361,6 +1658,20 @@ func (m *ChatSessionMutation) SetField(name string, value ent.Value) error {
 		}
 		m.SetSelectedModel(v)
 		return nil
+	case chatsession.FieldTitle:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetTitle(v)
+		return nil
+	case chatsession.FieldArchived:
+		v, ok := value.(bool)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetArchived(v)
+		return nil
 	}
 	return fmt.Errorf("unknown ChatSession field %s", name)
 }
@@ -1397,6 +1708,9 @@ func (m *ChatSessionMutation) ClearedFields() []string {
 	if m.FieldCleared(chatsession.FieldSelectedModel) {
 		fields = append(fields, chatsession.FieldSelectedModel)
 	}
+	if m.FieldCleared(chatsession.FieldTitle) {
+		fields = append(fields, chatsession.FieldTitle)
+	}
 	return fields
 }
 
@@ -1414,6 +1728,9 @@ func (m *ChatSessionMutation) ClearField(name string) error {
 	case chatsession.FieldSelectedModel:
 		m.ClearSelectedModel()
 		return nil
+	case chatsession.FieldTitle:
+		m.ClearTitle()
+		return nil
 	}
 	return fmt.Errorf("unknown ChatSession nullable field %s", name)
 }
@@ -1440,6 +1757,12 @@ func (m *ChatSessionMutation) ResetField(name string) error {
 	case chatsession.FieldSelectedModel:
 		m.ResetSelectedModel()
 		return nil
+	case chatsession.FieldTitle:
+		m.ResetTitle()
+		return nil
+	case chatsession.FieldArchived:
+		m.ResetArchived()
+		return nil
 	}
 	return fmt.Errorf("unknown ChatSession field %s", name)
 }
@@ -1546,49 +1869,36 @@ func (m *ChatSessionMutation) ResetEdge(name string) error {
 	return fmt.Errorf("unknown ChatSession edge %s", name)
 }
 
-// ExportJobMutation represents an operation that mutates the ExportJob nodes in the graph.
-type ExportJobMutation struct {
+// CollectionMutation represents an operation that mutates the Collection nodes in the graph.
+type CollectionMutation struct {
 	config
-	op                 Op
-	typ                string
-	id                 *int
-	job_id             *string
-	export_type        *string
-	output_path        *string
-	stage              *string
-	progress           *float64
-	addprogress        *float64
-	current_file       *string
-	total_files        *int
-	addtotal_files     *int
-	processed_files    *int
-	addprocessed_files *int
-	is_complete        *bool
-	has_error          *bool
-	error_message      *string
-	is_cancelled       *bool
-	created_at         *time.Time
-	updated_at         *time.Time
-	completed_at       *time.Time
-	clearedFields      map[string]struct{}
-	project            *int
-	clearedproject     bool
-	done               bool
-	oldValue           func(context.Context) (*ExportJob, error)
-	predicates         []predicate.ExportJob
+	op              Op
+	typ             string
+	id              *int
+	name            *string
+	description     *string
+	created_at      *time.Time
+	updated_at      *time.Time
+	clearedFields   map[string]struct{}
+	projects        map[int]struct{}
+	removedprojects map[int]struct{}
+	clearedprojects bool
+	done            bool
+	oldValue        func(context.Context) (*Collection, error)
+	predicates      []predicate.Collection
 }
 
-var _ ent.Mutation = (*ExportJobMutation)(nil)
+var _ ent.Mutation = (*CollectionMutation)(nil)
 
-// exportjobOption allows management of the mutation configuration using functional options.
-type exportjobOption func(*ExportJobMutation)
+// collectionOption allows management of the mutation configuration using functional options.
+type collectionOption func(*CollectionMutation)
 
-// newExportJobMutation creates new mutation for the ExportJob entity.
-func newExportJobMutation(c config, op Op, opts ...exportjobOption) *ExportJobMutation {
-	m := &ExportJobMutation{
+// newCollectionMutation creates new mutation for the Collection entity.
+func newCollectionMutation(c config, op Op, opts ...collectionOption) *CollectionMutation {
+	m := &CollectionMutation{
 		config:        c,
 		op:            op,
-		typ:           TypeExportJob,
+		typ:           TypeCollection,
 		clearedFields: make(map[string]struct{}),
 	}
 	for _, opt := range opts {
@@ -1597,20 +1907,20 @@ func newExportJobMutation(c config, op Op, opts ...exportjobOption) *ExportJobMu
 	return m
 }
 
-// withExportJobID sets the ID field of the mutation.
-func withExportJobID(id int) exportjobOption {
-	return func(m *ExportJobMutation) {
+// withCollectionID sets the ID field of the mutation.
+func withCollectionID(id int) collectionOption {
+	return func(m *CollectionMutation) {
 		var (
 			err   error
 			once  sync.Once
-			value *ExportJob
+			value *Collection
 		)
-		m.oldValue = func(ctx context.Context) (*ExportJob, error) {
+		m.oldValue = func(ctx context.Context) (*Collection, error) {
 			once.Do(func() {
 				if m.done {
 					err = errors.New("querying old values post mutation is not allowed")
 				} else {
-					value, err = m.Client().ExportJob.Get(ctx, id)
+					value, err = m.Client().Collection.Get(ctx, id)
 				}
 			})
 			return value, err
@@ -1619,10 +1929,10 @@ func withExportJobID(id int) exportjobOption {
 	}
 }
 
-// withExportJob sets the old ExportJob of the mutation.
-func withExportJob(node *ExportJob) exportjobOption {
-	return func(m *ExportJobMutation) {
-		m.oldValue = func(context.Context) (*ExportJob, error) {
+// withCollection sets the old Collection of the mutation.
+func withCollection(node *Collection) collectionOption {
+	return func(m *CollectionMutation) {
+		m.oldValue = func(context.Context) (*Collection, error) {
 			return node, nil
 		}
 		m.id = &node.ID
@@ -1631,7 +1941,7 @@ func withExportJob(node *ExportJob) exportjobOption {
 
 // Client returns a new `ent.Client` from the mutation. If the mutation was
 // executed in a transaction (ent.Tx), a transactional client is returned.
-func (m ExportJobMutation) Client() *Client {
+func (m CollectionMutation) Client() *Client {
 	client := &Client{config: m.config}
 	client.init()
 	return client
@@ -1639,7 +1949,7 @@ func (m ExportJobMutation) Client() *Client {
 
 // Tx returns an `ent.Tx` for mutations that were executed in transactions;
 // it returns an error otherwise.
-func (m ExportJobMutation) Tx() (*Tx, error) {
+func (m CollectionMutation) Tx() (*Tx, error) {
 	if _, ok := m.driver.(*txDriver); !ok {
 		return nil, errors.New("ent: mutation is not running in a transaction")
 	}
@@ -1650,7 +1960,7 @@ func (m ExportJobMutation) Tx() (*Tx, error) {
 
 // ID returns the ID value in the mutation. Note that the ID is only available
 // if it was provided to the builder or after it was returned from the database.
-func (m *ExportJobMutation) ID() (id int, exists bool) {
+func (m *CollectionMutation) ID() (id int, exists bool) {
 	if m.id == nil {
 		return
 	}
@@ -1661,7 +1971,7 @@ func (m *ExportJobMutation) ID() (id int, exists bool) {
 // That means, if the mutation is applied within a transaction with an isolation level such
 // as sql.LevelSerializable, the returned ids match the ids of the rows that will be updated
 // or updated by the mutation.
-func (m *ExportJobMutation) IDs(ctx context.Context) ([]int, error) {
+func (m *CollectionMutation) IDs(ctx context.Context) ([]int, error) {
 	switch {
 	case m.op.Is(OpUpdateOne | OpDeleteOne):
 		id, exists := m.ID()
@@ -1670,769 +1980,1115 @@ func (m *ExportJobMutation) IDs(ctx context.Context) ([]int, error) {
 		}
 		fallthrough
 	case m.op.Is(OpUpdate | OpDelete):
-		return m.Client().ExportJob.Query().Where(m.predicates...).IDs(ctx)
+		return m.Client().Collection.Query().Where(m.predicates...).IDs(ctx)
 	default:
 		return nil, fmt.Errorf("IDs is not allowed on %s operations", m.op)
 	}
 }
 
-// SetJobID sets the "job_id" field.
-func (m *ExportJobMutation) SetJobID(s string) {
-	m.job_id = &s
+// SetName sets the "name" field.
+func (m *CollectionMutation) SetName(s string) {
+	m.name = &s
 }
 
-// JobID returns the value of the "job_id" field in the mutation.
-func (m *ExportJobMutation) JobID() (r string, exists bool) {
-	v := m.job_id
+// Name returns the value of the "name" field in the mutation.
+func (m *CollectionMutation) Name() (r string, exists bool) {
+	v := m.name
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldJobID returns the old "job_id" field's value of the ExportJob entity.
-// If the ExportJob object wasn't provided to the builder, the object is fetched from the database.
+// OldName returns the old "name" field's value of the Collection entity.
+// If the Collection object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *ExportJobMutation) OldJobID(ctx context.Context) (v string, err error) {
+func (m *CollectionMutation) OldName(ctx context.Context) (v string, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldJobID is only allowed on UpdateOne operations")
+		return v, errors.New("OldName is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldJobID requires an ID field in the mutation")
+		return v, errors.New("OldName requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldJobID: %w", err)
+		return v, fmt.Errorf("querying old value for OldName: %w", err)
 	}
-	return oldValue.JobID, nil
+	return oldValue.Name, nil
 }
 
-// ResetJobID resets all changes to the "job_id" field.
-func (m *ExportJobMutation) ResetJobID() {
-	m.job_id = nil
+// ResetName resets all changes to the "name" field.
+func (m *CollectionMutation) ResetName() {
+	m.name = nil
 }
 
-// SetExportType sets the "export_type" field.
-func (m *ExportJobMutation) SetExportType(s string) {
-	m.export_type = &s
+// SetDescription sets the "description" field.
+func (m *CollectionMutation) SetDescription(s string) {
+	m.description = &s
 }
 
-// ExportType returns the value of the "export_type" field in the mutation.
-func (m *ExportJobMutation) ExportType() (r string, exists bool) {
-	v := m.export_type
+// Description returns the value of the "description" field in the mutation.
+func (m *CollectionMutation) Description() (r string, exists bool) {
+	v := m.description
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldExportType returns the old "export_type" field's value of the ExportJob entity.
-// If the ExportJob object wasn't provided to the builder, the object is fetched from the database.
+// OldDescription returns the old "description" field's value of the Collection entity.
+// If the Collection object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *ExportJobMutation) OldExportType(ctx context.Context) (v string, err error) {
+func (m *CollectionMutation) OldDescription(ctx context.Context) (v string, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldExportType is only allowed on UpdateOne operations")
+		return v, errors.New("OldDescription is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldExportType requires an ID field in the mutation")
+		return v, errors.New("OldDescription requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldExportType: %w", err)
+		return v, fmt.Errorf("querying old value for OldDescription: %w", err)
 	}
-	return oldValue.ExportType, nil
+	return oldValue.Description, nil
 }
 
-// ResetExportType resets all changes to the "export_type" field.
-func (m *ExportJobMutation) ResetExportType() {
-	m.export_type = nil
-}
-
-// SetOutputPath sets the "output_path" field.
-func (m *ExportJobMutation) SetOutputPath(s string) {
-	m.output_path = &s
-}
-
-// OutputPath returns the value of the "output_path" field in the mutation.
-func (m *ExportJobMutation) OutputPath() (r string, exists bool) {
-	v := m.output_path
-	if v == nil {
-		return
-	}
-	return *v, true
+// ClearDescription clears the value of the "description" field.
+func (m *CollectionMutation) ClearDescription() {
+	m.description = nil
+	m.clearedFields[collection.FieldDescription] = struct{}{}
 }
 
-// OldOutputPath returns the old "output_path" field's value of the ExportJob entity.
-// If the ExportJob object wasn't provided to the builder, the object is fetched from the database.
-// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *ExportJobMutation) OldOutputPath(ctx context.Context) (v string, err error) {
-	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldOutputPath is only allowed on UpdateOne operations")
-	}
-	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldOutputPath requires an ID field in the mutation")
-	}
-	oldValue, err := m.oldValue(ctx)
-	if err != nil {
-		return v, fmt.Errorf("querying old value for OldOutputPath: %w", err)
-	}
-	return oldValue.OutputPath, nil
+// DescriptionCleared returns if the "description" field was cleared in this mutation.
+func (m *CollectionMutation) DescriptionCleared() bool {
+	_, ok := m.clearedFields[collection.FieldDescription]
+	return ok
 }
 
-// ResetOutputPath resets all changes to the "output_path" field.
-func (m *ExportJobMutation) ResetOutputPath() {
-	m.output_path = nil
+// ResetDescription resets all changes to the "description" field.
+func (m *CollectionMutation) ResetDescription() {
+	m.description = nil
+	delete(m.clearedFields, collection.FieldDescription)
 }
 
-// SetStage sets the "stage" field.
-func (m *ExportJobMutation) SetStage(s string) {
-	m.stage = &s
+// SetCreatedAt sets the "created_at" field.
+func (m *CollectionMutation) SetCreatedAt(t time.Time) {
+	m.created_at = &t
 }
 
-// Stage returns the value of the "stage" field in the mutation.
-func (m *ExportJobMutation) Stage() (r string, exists bool) {
-	v := m.stage
+// CreatedAt returns the value of the "created_at" field in the mutation.
+func (m *CollectionMutation) CreatedAt() (r time.Time, exists bool) {
+	v := m.created_at
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldStage returns the old "stage" field's value of the ExportJob entity.
-// If the ExportJob object wasn't provided to the builder, the object is fetched from the database.
+// OldCreatedAt returns the old "created_at" field's value of the Collection entity.
+// If the Collection object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *ExportJobMutation) OldStage(ctx context.Context) (v string, err error) {
+func (m *CollectionMutation) OldCreatedAt(ctx context.Context) (v time.Time, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldStage is only allowed on UpdateOne operations")
+		return v, errors.New("OldCreatedAt is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldStage requires an ID field in the mutation")
+		return v, errors.New("OldCreatedAt requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldStage: %w", err)
+		return v, fmt.Errorf("querying old value for OldCreatedAt: %w", err)
 	}
-	return oldValue.Stage, nil
+	return oldValue.CreatedAt, nil
 }
 
-// ResetStage resets all changes to the "stage" field.
-func (m *ExportJobMutation) ResetStage() {
-	m.stage = nil
+// ResetCreatedAt resets all changes to the "created_at" field.
+func (m *CollectionMutation) ResetCreatedAt() {
+	m.created_at = nil
 }
 
-// SetProgress sets the "progress" field.
-func (m *ExportJobMutation) SetProgress(f float64) {
-	m.progress = &f
-	m.addprogress = nil
+// SetUpdatedAt sets the "updated_at" field.
+func (m *CollectionMutation) SetUpdatedAt(t time.Time) {
+	m.updated_at = &t
 }
 
-// Progress returns the value of the "progress" field in the mutation.
-func (m *ExportJobMutation) Progress() (r float64, exists bool) {
-	v := m.progress
+// UpdatedAt returns the value of the "updated_at" field in the mutation.
+func (m *CollectionMutation) UpdatedAt() (r time.Time, exists bool) {
+	v := m.updated_at
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldProgress returns the old "progress" field's value of the ExportJob entity.
-// If the ExportJob object wasn't provided to the builder, the object is fetched from the database.
+// OldUpdatedAt returns the old "updated_at" field's value of the Collection entity.
+// If the Collection object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *ExportJobMutation) OldProgress(ctx context.Context) (v float64, err error) {
+func (m *CollectionMutation) OldUpdatedAt(ctx context.Context) (v time.Time, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldProgress is only allowed on UpdateOne operations")
+		return v, errors.New("OldUpdatedAt is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldProgress requires an ID field in the mutation")
+		return v, errors.New("OldUpdatedAt requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldProgress: %w", err)
+		return v, fmt.Errorf("querying old value for OldUpdatedAt: %w", err)
 	}
-	return oldValue.Progress, nil
+	return oldValue.UpdatedAt, nil
 }
 
-// AddProgress adds f to the "progress" field.
-func (m *ExportJobMutation) AddProgress(f float64) {
-	if m.addprogress != nil {
-		*m.addprogress += f
-	} else {
-		m.addprogress = &f
-	}
+// ResetUpdatedAt resets all changes to the "updated_at" field.
+func (m *CollectionMutation) ResetUpdatedAt() {
+	m.updated_at = nil
 }
 
-// AddedProgress returns the value that was added to the "progress" field in this mutation.
-func (m *ExportJobMutation) AddedProgress() (r float64, exists bool) {
-	v := m.addprogress
-	if v == nil {
-		return
+// AddProjectIDs adds the "projects" edge to the Project entity by ids.
+func (m *CollectionMutation) AddProjectIDs(ids ...int) {
+	if m.projects == nil {
+		m.projects = make(map[int]struct{})
+	}
+	for i := range ids {
+		m.projects[ids[i]] = struct{}{}
 	}
-	return *v, true
 }
 
-// ResetProgress resets all changes to the "progress" field.
-func (m *ExportJobMutation) ResetProgress() {
-	m.progress = nil
-	m.addprogress = nil
+// ClearProjects clears the "projects" edge to the Project entity.
+func (m *CollectionMutation) ClearProjects() {
+	m.clearedprojects = true
 }
 
-// SetCurrentFile sets the "current_file" field.
-func (m *ExportJobMutation) SetCurrentFile(s string) {
-	m.current_file = &s
+// ProjectsCleared reports if the "projects" edge to the Project entity was cleared.
+func (m *CollectionMutation) ProjectsCleared() bool {
+	return m.clearedprojects
 }
 
-// CurrentFile returns the value of the "current_file" field in the mutation.
-func (m *ExportJobMutation) CurrentFile() (r string, exists bool) {
-	v := m.current_file
-	if v == nil {
-		return
+// RemoveProjectIDs removes the "projects" edge to the Project entity by IDs.
+func (m *CollectionMutation) RemoveProjectIDs(ids ...int) {
+	if m.removedprojects == nil {
+		m.removedprojects = make(map[int]struct{})
+	}
+	for i := range ids {
+		delete(m.projects, ids[i])
+		m.removedprojects[ids[i]] = struct{}{}
 	}
-	return *v, true
 }
 
-// OldCurrentFile returns the old "current_file" field's value of the ExportJob entity.
-// If the ExportJob object wasn't provided to the builder, the object is fetched from the database.
-// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *ExportJobMutation) OldCurrentFile(ctx context.Context) (v string, err error) {
-	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldCurrentFile is only allowed on UpdateOne operations")
-	}
-	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldCurrentFile requires an ID field in the mutation")
+// RemovedProjects returns the removed IDs of the "projects" edge to the Project entity.
+func (m *CollectionMutation) RemovedProjectsIDs() (ids []int) {
+	for id := range m.removedprojects {
+		ids = append(ids, id)
 	}
-	oldValue, err := m.oldValue(ctx)
-	if err != nil {
-		return v, fmt.Errorf("querying old value for OldCurrentFile: %w", err)
+	return
+}
+
+// ProjectsIDs returns the "projects" edge IDs in the mutation.
+func (m *CollectionMutation) ProjectsIDs() (ids []int) {
+	for id := range m.projects {
+		ids = append(ids, id)
 	}
-	return oldValue.CurrentFile, nil
+	return
 }
 
-// ClearCurrentFile clears the value of the "current_file" field.
-func (m *ExportJobMutation) ClearCurrentFile() {
-	m.current_file = nil
-	m.clearedFields[exportjob.FieldCurrentFile] = struct{}{}
+// ResetProjects resets all changes to the "projects" edge.
+func (m *CollectionMutation) ResetProjects() {
+	m.projects = nil
+	m.clearedprojects = false
+	m.removedprojects = nil
 }
 
-// CurrentFileCleared returns if the "current_file" field was cleared in this mutation.
-func (m *ExportJobMutation) CurrentFileCleared() bool {
-	_, ok := m.clearedFields[exportjob.FieldCurrentFile]
-	return ok
+// Where appends a list predicates to the CollectionMutation builder.
+func (m *CollectionMutation) Where(ps ...predicate.Collection) {
+	m.predicates = append(m.predicates, ps...)
 }
 
-// ResetCurrentFile resets all changes to the "current_file" field.
-func (m *ExportJobMutation) ResetCurrentFile() {
-	m.current_file = nil
-	delete(m.clearedFields, exportjob.FieldCurrentFile)
+// WhereP appends storage-level predicates to the CollectionMutation builder. Using this method,
+// users can use type-assertion to append predicates that do not depend on any generated package.
+func (m *CollectionMutation) WhereP(ps ...func(*sql.Selector)) {
+	p := make([]predicate.Collection, len(ps))
+	for i := range ps {
+		p[i] = ps[i]
+	}
+	m.Where(p...)
 }
 
-// SetTotalFiles sets the "total_files" field.
-func (m *ExportJobMutation) SetTotalFiles(i int) {
-	m.total_files = &i
-	m.addtotal_files = nil
+// Op returns the operation name.
+func (m *CollectionMutation) Op() Op {
+	return m.op
 }
 
-// TotalFiles returns the value of the "total_files" field in the mutation.
-func (m *ExportJobMutation) TotalFiles() (r int, exists bool) {
-	v := m.total_files
-	if v == nil {
-		return
-	}
-	return *v, true
+// SetOp allows setting the mutation operation.
+func (m *CollectionMutation) SetOp(op Op) {
+	m.op = op
 }
 
-// OldTotalFiles returns the old "total_files" field's value of the ExportJob entity.
-// If the ExportJob object wasn't provided to the builder, the object is fetched from the database.
-// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *ExportJobMutation) OldTotalFiles(ctx context.Context) (v int, err error) {
-	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldTotalFiles is only allowed on UpdateOne operations")
+// Type returns the node type of this mutation (Collection).
+func (m *CollectionMutation) Type() string {
+	return m.typ
+}
+
+// Fields returns all fields that were changed during this mutation. Note that in
+// order to get all numeric fields that were incremented/decremented, call
+// AddedFields().
+func (m *CollectionMutation) Fields() []string {
+	fields := make([]string, 0, 4)
+	if m.name != nil {
+		fields = append(fields, collection.FieldName)
 	}
-	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldTotalFiles requires an ID field in the mutation")
+	if m.description != nil {
+		fields = append(fields, collection.FieldDescription)
 	}
-	oldValue, err := m.oldValue(ctx)
-	if err != nil {
-		return v, fmt.Errorf("querying old value for OldTotalFiles: %w", err)
+	if m.created_at != nil {
+		fields = append(fields, collection.FieldCreatedAt)
 	}
-	return oldValue.TotalFiles, nil
-}
-
-// AddTotalFiles adds i to the "total_files" field.
-func (m *ExportJobMutation) AddTotalFiles(i int) {
-	if m.addtotal_files != nil {
-		*m.addtotal_files += i
-	} else {
-		m.addtotal_files = &i
+	if m.updated_at != nil {
+		fields = append(fields, collection.FieldUpdatedAt)
 	}
+	return fields
 }
 
-// AddedTotalFiles returns the value that was added to the "total_files" field in this mutation.
-func (m *ExportJobMutation) AddedTotalFiles() (r int, exists bool) {
-	v := m.addtotal_files
-	if v == nil {
-		return
+// Field returns the value of a field with the given name. The second boolean
+// return value indicates that this field was not set, or was not defined in the
+// schema.
+func (m *CollectionMutation) Field(name string) (ent.Value, bool) {
+	switch name {
+	case collection.FieldName:
+		return m.Name()
+	case collection.FieldDescription:
+		return m.Description()
+	case collection.FieldCreatedAt:
+		return m.CreatedAt()
+	case collection.FieldUpdatedAt:
+		return m.UpdatedAt()
 	}
-	return *v, true
-}
-
-// ResetTotalFiles resets all changes to the "total_files" field.
-func (m *ExportJobMutation) ResetTotalFiles() {
-	m.total_files = nil
-	m.addtotal_files = nil
-}
-
-// SetProcessedFiles sets the "processed_files" field.
-func (m *ExportJobMutation) SetProcessedFiles(i int) {
-	m.processed_files = &i
-	m.addprocessed_files = nil
+	return nil, false
 }
 
-// ProcessedFiles returns the value of the "processed_files" field in the mutation.
-func (m *ExportJobMutation) ProcessedFiles() (r int, exists bool) {
-	v := m.processed_files
-	if v == nil {
-		return
+// OldField returns the old value of the field from the database. An error is
+// returned if the mutation operation is not UpdateOne, or the query to the
+// database failed.
+func (m *CollectionMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
+	switch name {
+	case collection.FieldName:
+		return m.OldName(ctx)
+	case collection.FieldDescription:
+		return m.OldDescription(ctx)
+	case collection.FieldCreatedAt:
+		return m.OldCreatedAt(ctx)
+	case collection.FieldUpdatedAt:
+		return m.OldUpdatedAt(ctx)
 	}
-	return *v, true
+	return nil, fmt.Errorf("unknown Collection field %s", name)
 }
 
-// OldProcessedFiles returns the old "processed_files" field's value of the ExportJob entity.
-// If the ExportJob object wasn't provided to the builder, the object is fetched from the database.
-// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *ExportJobMutation) OldProcessedFiles(ctx context.Context) (v int, err error) {
-	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldProcessedFiles is only allowed on UpdateOne operations")
-	}
-	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldProcessedFiles requires an ID field in the mutation")
-	}
-	oldValue, err := m.oldValue(ctx)
-	if err != nil {
-		return v, fmt.Errorf("querying old value for OldProcessedFiles: %w", err)
+// SetField sets the value of a field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *CollectionMutation) SetField(name string, value ent.Value) error {
+	switch name {
+	case collection.FieldName:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetName(v)
+		return nil
+	case collection.FieldDescription:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetDescription(v)
+		return nil
+	case collection.FieldCreatedAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetCreatedAt(v)
+		return nil
+	case collection.FieldUpdatedAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetUpdatedAt(v)
+		return nil
 	}
-	return oldValue.ProcessedFiles, nil
+	return fmt.Errorf("unknown Collection field %s", name)
 }
 
-// AddProcessedFiles adds i to the "processed_files" field.
-func (m *ExportJobMutation) AddProcessedFiles(i int) {
-	if m.addprocessed_files != nil {
-		*m.addprocessed_files += i
-	} else {
-		m.addprocessed_files = &i
-	}
+// AddedFields returns all numeric fields that were incremented/decremented during
+// this mutation.
+func (m *CollectionMutation) AddedFields() []string {
+	return nil
 }
 
-// AddedProcessedFiles returns the value that was added to the "processed_files" field in this mutation.
-func (m *ExportJobMutation) AddedProcessedFiles() (r int, exists bool) {
-	v := m.addprocessed_files
-	if v == nil {
-		return
+// AddedField returns the numeric value that was incremented/decremented on a field
+// with the given name. The second boolean return value indicates that this field
+// was not set, or was not defined in the schema.
+func (m *CollectionMutation) AddedField(name string) (ent.Value, bool) {
+	return nil, false
+}
+
+// AddField adds the value to the field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *CollectionMutation) AddField(name string, value ent.Value) error {
+	switch name {
 	}
-	return *v, true
+	return fmt.Errorf("unknown Collection numeric field %s", name)
 }
 
-// ResetProcessedFiles resets all changes to the "processed_files" field.
-func (m *ExportJobMutation) ResetProcessedFiles() {
-	m.processed_files = nil
-	m.addprocessed_files = nil
+// ClearedFields returns all nullable fields that were cleared during this
+// mutation.
+func (m *CollectionMutation) ClearedFields() []string {
+	var fields []string
+	if m.FieldCleared(collection.FieldDescription) {
+		fields = append(fields, collection.FieldDescription)
+	}
+	return fields
 }
 
-// SetIsComplete sets the "is_complete" field.
-func (m *ExportJobMutation) SetIsComplete(b bool) {
-	m.is_complete = &b
+// FieldCleared returns a boolean indicating if a field with the given name was
+// cleared in this mutation.
+func (m *CollectionMutation) FieldCleared(name string) bool {
+	_, ok := m.clearedFields[name]
+	return ok
 }
 
-// IsComplete returns the value of the "is_complete" field in the mutation.
-func (m *ExportJobMutation) IsComplete() (r bool, exists bool) {
-	v := m.is_complete
-	if v == nil {
-		return
+// ClearField clears the value of the field with the given name. It returns an
+// error if the field is not defined in the schema.
+func (m *CollectionMutation) ClearField(name string) error {
+	switch name {
+	case collection.FieldDescription:
+		m.ClearDescription()
+		return nil
 	}
-	return *v, true
+	return fmt.Errorf("unknown Collection nullable field %s", name)
 }
 
-// OldIsComplete returns the old "is_complete" field's value of the ExportJob entity.
-// If the ExportJob object wasn't provided to the builder, the object is fetched from the database.
-// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *ExportJobMutation) OldIsComplete(ctx context.Context) (v bool, err error) {
-	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldIsComplete is only allowed on UpdateOne operations")
-	}
-	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldIsComplete requires an ID field in the mutation")
-	}
-	oldValue, err := m.oldValue(ctx)
-	if err != nil {
-		return v, fmt.Errorf("querying old value for OldIsComplete: %w", err)
+// ResetField resets all changes in the mutation for the field with the given name.
+// It returns an error if the field is not defined in the schema.
+func (m *CollectionMutation) ResetField(name string) error {
+	switch name {
+	case collection.FieldName:
+		m.ResetName()
+		return nil
+	case collection.FieldDescription:
+		m.ResetDescription()
+		return nil
+	case collection.FieldCreatedAt:
+		m.ResetCreatedAt()
+		return nil
+	case collection.FieldUpdatedAt:
+		m.ResetUpdatedAt()
+		return nil
 	}
-	return oldValue.IsComplete, nil
+	return fmt.Errorf("unknown Collection field %s", name)
 }
 
-// ResetIsComplete resets all changes to the "is_complete" field.
-func (m *ExportJobMutation) ResetIsComplete() {
-	m.is_complete = nil
+// AddedEdges returns all edge names that were set/added in this mutation.
+func (m *CollectionMutation) AddedEdges() []string {
+	edges := make([]string, 0, 1)
+	if m.projects != nil {
+		edges = append(edges, collection.EdgeProjects)
+	}
+	return edges
 }
 
-// SetHasError sets the "has_error" field.
-func (m *ExportJobMutation) SetHasError(b bool) {
-	m.has_error = &b
+// AddedIDs returns all IDs (to other nodes) that were added for the given edge
+// name in this mutation.
+func (m *CollectionMutation) AddedIDs(name string) []ent.Value {
+	switch name {
+	case collection.EdgeProjects:
+		ids := make([]ent.Value, 0, len(m.projects))
+		for id := range m.projects {
+			ids = append(ids, id)
+		}
+		return ids
+	}
+	return nil
 }
 
-// HasError returns the value of the "has_error" field in the mutation.
-func (m *ExportJobMutation) HasError() (r bool, exists bool) {
-	v := m.has_error
-	if v == nil {
-		return
+// RemovedEdges returns all edge names that were removed in this mutation.
+func (m *CollectionMutation) RemovedEdges() []string {
+	edges := make([]string, 0, 1)
+	if m.removedprojects != nil {
+		edges = append(edges, collection.EdgeProjects)
 	}
-	return *v, true
+	return edges
 }
 
-// OldHasError returns the old "has_error" field's value of the ExportJob entity.
-// If the ExportJob object wasn't provided to the builder, the object is fetched from the database.
-// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *ExportJobMutation) OldHasError(ctx context.Context) (v bool, err error) {
-	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldHasError is only allowed on UpdateOne operations")
-	}
-	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldHasError requires an ID field in the mutation")
-	}
-	oldValue, err := m.oldValue(ctx)
-	if err != nil {
-		return v, fmt.Errorf("querying old value for OldHasError: %w", err)
+// RemovedIDs returns all IDs (to other nodes) that were removed for the edge with
+// the given name in this mutation.
+func (m *CollectionMutation) RemovedIDs(name string) []ent.Value {
+	switch name {
+	case collection.EdgeProjects:
+		ids := make([]ent.Value, 0, len(m.removedprojects))
+		for id := range m.removedprojects {
+			ids = append(ids, id)
+		}
+		return ids
 	}
-	return oldValue.HasError, nil
+	return nil
 }
 
-// ResetHasError resets all changes to the "has_error" field.
-func (m *ExportJobMutation) ResetHasError() {
-	m.has_error = nil
+// ClearedEdges returns all edge names that were cleared in this mutation.
+func (m *CollectionMutation) ClearedEdges() []string {
+	edges := make([]string, 0, 1)
+	if m.clearedprojects {
+		edges = append(edges, collection.EdgeProjects)
+	}
+	return edges
 }
 
-// SetErrorMessage sets the "error_message" field.
-func (m *ExportJobMutation) SetErrorMessage(s string) {
-	m.error_message = &s
+// EdgeCleared returns a boolean which indicates if the edge with the given name
+// was cleared in this mutation.
+func (m *CollectionMutation) EdgeCleared(name string) bool {
+	switch name {
+	case collection.EdgeProjects:
+		return m.clearedprojects
+	}
+	return false
 }
 
-// ErrorMessage returns the value of the "error_message" field in the mutation.
-func (m *ExportJobMutation) ErrorMessage() (r string, exists bool) {
-	v := m.error_message
-	if v == nil {
-		return
+// ClearEdge clears the value of the edge with the given name. It returns an error
+// if that edge is not defined in the schema.
+func (m *CollectionMutation) ClearEdge(name string) error {
+	switch name {
 	}
-	return *v, true
+	return fmt.Errorf("unknown Collection unique edge %s", name)
 }
 
-// OldErrorMessage returns the old "error_message" field's value of the ExportJob entity.
-// If the ExportJob object wasn't provided to the builder, the object is fetched from the database.
-// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *ExportJobMutation) OldErrorMessage(ctx context.Context) (v string, err error) {
-	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldErrorMessage is only allowed on UpdateOne operations")
+// ResetEdge resets all changes to the edge with the given name in this mutation.
+// It returns an error if the edge is not defined in the schema.
+func (m *CollectionMutation) ResetEdge(name string) error {
+	switch name {
+	case collection.EdgeProjects:
+		m.ResetProjects()
+		return nil
 	}
-	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldErrorMessage requires an ID field in the mutation")
+	return fmt.Errorf("unknown Collection edge %s", name)
+}
+
+// CutMutation represents an operation that mutates the Cut nodes in the graph.
+type CutMutation struct {
+	config
+	op                      Op
+	typ                     string
+	id                      *int
+	name                    *string
+	highlight_order         *[]interface{}
+	appendhighlight_order   []interface{}
+	order_history           *[][]interface{}
+	appendorder_history     [][]interface{}
+	order_history_index     *int
+	addorder_history_index  *int
+	hidden_highlights       *[]string
+	appendhidden_highlights []string
+	created_at              *time.Time
+	updated_at              *time.Time
+	clearedFields           map[string]struct{}
+	project                 *int
+	clearedproject          bool
+	done                    bool
+	oldValue                func(context.Context) (*Cut, error)
+	predicates              []predicate.Cut
+}
+
+var _ ent.Mutation = (*CutMutation)(nil)
+
+// cutOption allows management of the mutation configuration using functional options.
+type cutOption func(*CutMutation)
+
+// newCutMutation creates new mutation for the Cut entity.
+func newCutMutation(c config, op Op, opts ...cutOption) *CutMutation {
+	m := &CutMutation{
+		config:        c,
+		op:            op,
+		typ:           TypeCut,
+		clearedFields: make(map[string]struct{}),
 	}
-	oldValue, err := m.oldValue(ctx)
-	if err != nil {
-		return v, fmt.Errorf("querying old value for OldErrorMessage: %w", err)
+	for _, opt := range opts {
+		opt(m)
 	}
-	return oldValue.ErrorMessage, nil
+	return m
 }
 
-// ClearErrorMessage clears the value of the "error_message" field.
-func (m *ExportJobMutation) ClearErrorMessage() {
-	m.error_message = nil
-	m.clearedFields[exportjob.FieldErrorMessage] = struct{}{}
+// withCutID sets the ID field of the mutation.
+func withCutID(id int) cutOption {
+	return func(m *CutMutation) {
+		var (
+			err   error
+			once  sync.Once
+			value *Cut
+		)
+		m.oldValue = func(ctx context.Context) (*Cut, error) {
+			once.Do(func() {
+				if m.done {
+					err = errors.New("querying old values post mutation is not allowed")
+				} else {
+					value, err = m.Client().Cut.Get(ctx, id)
+				}
+			})
+			return value, err
+		}
+		m.id = &id
+	}
 }
 
-// ErrorMessageCleared returns if the "error_message" field was cleared in this mutation.
-func (m *ExportJobMutation) ErrorMessageCleared() bool {
-	_, ok := m.clearedFields[exportjob.FieldErrorMessage]
-	return ok
+// withCut sets the old Cut of the mutation.
+func withCut(node *Cut) cutOption {
+	return func(m *CutMutation) {
+		m.oldValue = func(context.Context) (*Cut, error) {
+			return node, nil
+		}
+		m.id = &node.ID
+	}
 }
 
-// ResetErrorMessage resets all changes to the "error_message" field.
-func (m *ExportJobMutation) ResetErrorMessage() {
-	m.error_message = nil
-	delete(m.clearedFields, exportjob.FieldErrorMessage)
+// Client returns a new `ent.Client` from the mutation. If the mutation was
+// executed in a transaction (ent.Tx), a transactional client is returned.
+func (m CutMutation) Client() *Client {
+	client := &Client{config: m.config}
+	client.init()
+	return client
 }
 
-// SetIsCancelled sets the "is_cancelled" field.
-func (m *ExportJobMutation) SetIsCancelled(b bool) {
-	m.is_cancelled = &b
+// Tx returns an `ent.Tx` for mutations that were executed in transactions;
+// it returns an error otherwise.
+func (m CutMutation) Tx() (*Tx, error) {
+	if _, ok := m.driver.(*txDriver); !ok {
+		return nil, errors.New("ent: mutation is not running in a transaction")
+	}
+	tx := &Tx{config: m.config}
+	tx.init()
+	return tx, nil
 }
 
-// IsCancelled returns the value of the "is_cancelled" field in the mutation.
-func (m *ExportJobMutation) IsCancelled() (r bool, exists bool) {
-	v := m.is_cancelled
+// ID returns the ID value in the mutation. Note that the ID is only available
+// if it was provided to the builder or after it was returned from the database.
+func (m *CutMutation) ID() (id int, exists bool) {
+	if m.id == nil {
+		return
+	}
+	return *m.id, true
+}
+
+// IDs queries the database and returns the entity ids that match the mutation's predicate.
+// That means, if the mutation is applied within a transaction with an isolation level such
+// as sql.LevelSerializable, the returned ids match the ids of the rows that will be updated
+// or updated by the mutation.
+func (m *CutMutation) IDs(ctx context.Context) ([]int, error) {
+	switch {
+	case m.op.Is(OpUpdateOne | OpDeleteOne):
+		id, exists := m.ID()
+		if exists {
+			return []int{id}, nil
+		}
+		fallthrough
+	case m.op.Is(OpUpdate | OpDelete):
+		return m.Client().Cut.Query().Where(m.predicates...).IDs(ctx)
+	default:
+		return nil, fmt.Errorf("IDs is not allowed on %s operations", m.op)
+	}
+}
+
+// SetName sets the "name" field.
+func (m *CutMutation) SetName(s string) {
+	m.name = &s
+}
+
+// Name returns the value of the "name" field in the mutation.
+func (m *CutMutation) Name() (r string, exists bool) {
+	v := m.name
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldIsCancelled returns the old "is_cancelled" field's value of the ExportJob entity.
-// If the ExportJob object wasn't provided to the builder, the object is fetched from the database.
+// OldName returns the old "name" field's value of the Cut entity.
+// If the Cut object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *ExportJobMutation) OldIsCancelled(ctx context.Context) (v bool, err error) {
+func (m *CutMutation) OldName(ctx context.Context) (v string, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldIsCancelled is only allowed on UpdateOne operations")
+		return v, errors.New("OldName is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldIsCancelled requires an ID field in the mutation")
+		return v, errors.New("OldName requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldIsCancelled: %w", err)
+		return v, fmt.Errorf("querying old value for OldName: %w", err)
 	}
-	return oldValue.IsCancelled, nil
+	return oldValue.Name, nil
 }
 
-// ResetIsCancelled resets all changes to the "is_cancelled" field.
-func (m *ExportJobMutation) ResetIsCancelled() {
-	m.is_cancelled = nil
+// ResetName resets all changes to the "name" field.
+func (m *CutMutation) ResetName() {
+	m.name = nil
 }
 
-// SetCreatedAt sets the "created_at" field.
-func (m *ExportJobMutation) SetCreatedAt(t time.Time) {
-	m.created_at = &t
+// SetProjectID sets the "project_id" field.
+func (m *CutMutation) SetProjectID(i int) {
+	m.project = &i
 }
 
-// CreatedAt returns the value of the "created_at" field in the mutation.
-func (m *ExportJobMutation) CreatedAt() (r time.Time, exists bool) {
-	v := m.created_at
+// ProjectID returns the value of the "project_id" field in the mutation.
+func (m *CutMutation) ProjectID() (r int, exists bool) {
+	v := m.project
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldCreatedAt returns the old "created_at" field's value of the ExportJob entity.
-// If the ExportJob object wasn't provided to the builder, the object is fetched from the database.
+// OldProjectID returns the old "project_id" field's value of the Cut entity.
+// If the Cut object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *ExportJobMutation) OldCreatedAt(ctx context.Context) (v time.Time, err error) {
+func (m *CutMutation) OldProjectID(ctx context.Context) (v int, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldCreatedAt is only allowed on UpdateOne operations")
+		return v, errors.New("OldProjectID is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldCreatedAt requires an ID field in the mutation")
+		return v, errors.New("OldProjectID requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldCreatedAt: %w", err)
+		return v, fmt.Errorf("querying old value for OldProjectID: %w", err)
 	}
-	return oldValue.CreatedAt, nil
+	return oldValue.ProjectID, nil
 }
 
-// ResetCreatedAt resets all changes to the "created_at" field.
-func (m *ExportJobMutation) ResetCreatedAt() {
-	m.created_at = nil
+// ResetProjectID resets all changes to the "project_id" field.
+func (m *CutMutation) ResetProjectID() {
+	m.project = nil
 }
 
-// SetUpdatedAt sets the "updated_at" field.
-func (m *ExportJobMutation) SetUpdatedAt(t time.Time) {
-	m.updated_at = &t
+// SetHighlightOrder sets the "highlight_order" field.
+func (m *CutMutation) SetHighlightOrder(i []interface{}) {
+	m.highlight_order = &i
+	m.appendhighlight_order = nil
 }
 
-// UpdatedAt returns the value of the "updated_at" field in the mutation.
-func (m *ExportJobMutation) UpdatedAt() (r time.Time, exists bool) {
-	v := m.updated_at
+// HighlightOrder returns the value of the "highlight_order" field in the mutation.
+func (m *CutMutation) HighlightOrder() (r []interface{}, exists bool) {
+	v := m.highlight_order
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldUpdatedAt returns the old "updated_at" field's value of the ExportJob entity.
-// If the ExportJob object wasn't provided to the builder, the object is fetched from the database.
+// OldHighlightOrder returns the old "highlight_order" field's value of the Cut entity.
+// If the Cut object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *ExportJobMutation) OldUpdatedAt(ctx context.Context) (v time.Time, err error) {
+func (m *CutMutation) OldHighlightOrder(ctx context.Context) (v []interface{}, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldUpdatedAt is only allowed on UpdateOne operations")
+		return v, errors.New("OldHighlightOrder is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldUpdatedAt requires an ID field in the mutation")
+		return v, errors.New("OldHighlightOrder requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldUpdatedAt: %w", err)
+		return v, fmt.Errorf("querying old value for OldHighlightOrder: %w", err)
 	}
-	return oldValue.UpdatedAt, nil
+	return oldValue.HighlightOrder, nil
 }
 
-// ResetUpdatedAt resets all changes to the "updated_at" field.
-func (m *ExportJobMutation) ResetUpdatedAt() {
-	m.updated_at = nil
+// AppendHighlightOrder adds i to the "highlight_order" field.
+func (m *CutMutation) AppendHighlightOrder(i []interface{}) {
+	m.appendhighlight_order = append(m.appendhighlight_order, i...)
 }
 
-// SetCompletedAt sets the "completed_at" field.
-func (m *ExportJobMutation) SetCompletedAt(t time.Time) {
-	m.completed_at = &t
+// AppendedHighlightOrder returns the list of values that were appended to the "highlight_order" field in this mutation.
+func (m *CutMutation) AppendedHighlightOrder() ([]interface{}, bool) {
+	if len(m.appendhighlight_order) == 0 {
+		return nil, false
+	}
+	return m.appendhighlight_order, true
 }
 
-// CompletedAt returns the value of the "completed_at" field in the mutation.
-func (m *ExportJobMutation) CompletedAt() (r time.Time, exists bool) {
-	v := m.completed_at
+// ClearHighlightOrder clears the value of the "highlight_order" field.
+func (m *CutMutation) ClearHighlightOrder() {
+	m.highlight_order = nil
+	m.appendhighlight_order = nil
+	m.clearedFields[cut.FieldHighlightOrder] = struct{}{}
+}
+
+// HighlightOrderCleared returns if the "highlight_order" field was cleared in this mutation.
+func (m *CutMutation) HighlightOrderCleared() bool {
+	_, ok := m.clearedFields[cut.FieldHighlightOrder]
+	return ok
+}
+
+// ResetHighlightOrder resets all changes to the "highlight_order" field.
+func (m *CutMutation) ResetHighlightOrder() {
+	m.highlight_order = nil
+	m.appendhighlight_order = nil
+	delete(m.clearedFields, cut.FieldHighlightOrder)
+}
+
+// SetOrderHistory sets the "order_history" field.
+func (m *CutMutation) SetOrderHistory(i [][]interface{}) {
+	m.order_history = &i
+	m.appendorder_history = nil
+}
+
+// OrderHistory returns the value of the "order_history" field in the mutation.
+func (m *CutMutation) OrderHistory() (r [][]interface{}, exists bool) {
+	v := m.order_history
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldCompletedAt returns the old "completed_at" field's value of the ExportJob entity.
-// If the ExportJob object wasn't provided to the builder, the object is fetched from the database.
+// OldOrderHistory returns the old "order_history" field's value of the Cut entity.
+// If the Cut object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *ExportJobMutation) OldCompletedAt(ctx context.Context) (v time.Time, err error) {
+func (m *CutMutation) OldOrderHistory(ctx context.Context) (v [][]interface{}, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldCompletedAt is only allowed on UpdateOne operations")
+		return v, errors.New("OldOrderHistory is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldCompletedAt requires an ID field in the mutation")
+		return v, errors.New("OldOrderHistory requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldCompletedAt: %w", err)
+		return v, fmt.Errorf("querying old value for OldOrderHistory: %w", err)
 	}
-	return oldValue.CompletedAt, nil
+	return oldValue.OrderHistory, nil
 }
 
-// ClearCompletedAt clears the value of the "completed_at" field.
-func (m *ExportJobMutation) ClearCompletedAt() {
-	m.completed_at = nil
-	m.clearedFields[exportjob.FieldCompletedAt] = struct{}{}
+// AppendOrderHistory adds i to the "order_history" field.
+func (m *CutMutation) AppendOrderHistory(i [][]interface{}) {
+	m.appendorder_history = append(m.appendorder_history, i...)
 }
 
-// CompletedAtCleared returns if the "completed_at" field was cleared in this mutation.
-func (m *ExportJobMutation) CompletedAtCleared() bool {
-	_, ok := m.clearedFields[exportjob.FieldCompletedAt]
-	return ok
+// AppendedOrderHistory returns the list of values that were appended to the "order_history" field in this mutation.
+func (m *CutMutation) AppendedOrderHistory() ([][]interface{}, bool) {
+	if len(m.appendorder_history) == 0 {
+		return nil, false
+	}
+	return m.appendorder_history, true
 }
 
-// ResetCompletedAt resets all changes to the "completed_at" field.
-func (m *ExportJobMutation) ResetCompletedAt() {
-	m.completed_at = nil
-	delete(m.clearedFields, exportjob.FieldCompletedAt)
+// ClearOrderHistory clears the value of the "order_history" field.
+func (m *CutMutation) ClearOrderHistory() {
+	m.order_history = nil
+	m.appendorder_history = nil
+	m.clearedFields[cut.FieldOrderHistory] = struct{}{}
 }
 
-// SetProjectID sets the "project" edge to the Project entity by id.
-func (m *ExportJobMutation) SetProjectID(id int) {
-	m.project = &id
+// OrderHistoryCleared returns if the "order_history" field was cleared in this mutation.
+func (m *CutMutation) OrderHistoryCleared() bool {
+	_, ok := m.clearedFields[cut.FieldOrderHistory]
+	return ok
 }
 
-// ClearProject clears the "project" edge to the Project entity.
-func (m *ExportJobMutation) ClearProject() {
-	m.clearedproject = true
+// ResetOrderHistory resets all changes to the "order_history" field.
+func (m *CutMutation) ResetOrderHistory() {
+	m.order_history = nil
+	m.appendorder_history = nil
+	delete(m.clearedFields, cut.FieldOrderHistory)
 }
 
-// ProjectCleared reports if the "project" edge to the Project entity was cleared.
-func (m *ExportJobMutation) ProjectCleared() bool {
-	return m.clearedproject
+// SetOrderHistoryIndex sets the "order_history_index" field.
+func (m *CutMutation) SetOrderHistoryIndex(i int) {
+	m.order_history_index = &i
+	m.addorder_history_index = nil
 }
 
-// ProjectID returns the "project" edge ID in the mutation.
-func (m *ExportJobMutation) ProjectID() (id int, exists bool) {
-	if m.project != nil {
-		return *m.project, true
+// OrderHistoryIndex returns the value of the "order_history_index" field in the mutation.
+func (m *CutMutation) OrderHistoryIndex() (r int, exists bool) {
+	v := m.order_history_index
+	if v == nil {
+		return
 	}
-	return
+	return *v, true
 }
 
-// ProjectIDs returns the "project" edge IDs in the mutation.
-// Note that IDs always returns len(IDs) <= 1 for unique edges, and you should use
-// ProjectID instead. It exists only for internal usage by the builders.
-func (m *ExportJobMutation) ProjectIDs() (ids []int) {
-	if id := m.project; id != nil {
-		ids = append(ids, *id)
+// OldOrderHistoryIndex returns the old "order_history_index" field's value of the Cut entity.
+// If the Cut object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *CutMutation) OldOrderHistoryIndex(ctx context.Context) (v int, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldOrderHistoryIndex is only allowed on UpdateOne operations")
 	}
-	return
-}
-
-// ResetProject resets all changes to the "project" edge.
-func (m *ExportJobMutation) ResetProject() {
-	m.project = nil
-	m.clearedproject = false
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldOrderHistoryIndex requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldOrderHistoryIndex: %w", err)
+	}
+	return oldValue.OrderHistoryIndex, nil
 }
 
-// Where appends a list predicates to the ExportJobMutation builder.
-func (m *ExportJobMutation) Where(ps ...predicate.ExportJob) {
-	m.predicates = append(m.predicates, ps...)
+// AddOrderHistoryIndex adds i to the "order_history_index" field.
+func (m *CutMutation) AddOrderHistoryIndex(i int) {
+	if m.addorder_history_index != nil {
+		*m.addorder_history_index += i
+	} else {
+		m.addorder_history_index = &i
+	}
 }
 
-// WhereP appends storage-level predicates to the ExportJobMutation builder. Using this method,
-// users can use type-assertion to append predicates that do not depend on any generated package.
-func (m *ExportJobMutation) WhereP(ps ...func(*sql.Selector)) {
-	p := make([]predicate.ExportJob, len(ps))
-	for i := range ps {
-		p[i] = ps[i]
+// AddedOrderHistoryIndex returns the value that was added to the "order_history_index" field in this mutation.
+func (m *CutMutation) AddedOrderHistoryIndex() (r int, exists bool) {
+	v := m.addorder_history_index
+	if v == nil {
+		return
 	}
-	m.Where(p...)
+	return *v, true
 }
 
-// Op returns the operation name.
-func (m *ExportJobMutation) Op() Op {
-	return m.op
+// ClearOrderHistoryIndex clears the value of the "order_history_index" field.
+func (m *CutMutation) ClearOrderHistoryIndex() {
+	m.order_history_index = nil
+	m.addorder_history_index = nil
+	m.clearedFields[cut.FieldOrderHistoryIndex] = struct{}{}
 }
 
-// SetOp allows setting the mutation operation.
-func (m *ExportJobMutation) SetOp(op Op) {
-	m.op = op
+// OrderHistoryIndexCleared returns if the "order_history_index" field was cleared in this mutation.
+func (m *CutMutation) OrderHistoryIndexCleared() bool {
+	_, ok := m.clearedFields[cut.FieldOrderHistoryIndex]
+	return ok
 }
 
-// Type returns the node type of this mutation (ExportJob).
-func (m *ExportJobMutation) Type() string {
-	return m.typ
+// ResetOrderHistoryIndex resets all changes to the "order_history_index" field.
+func (m *CutMutation) ResetOrderHistoryIndex() {
+	m.order_history_index = nil
+	m.addorder_history_index = nil
+	delete(m.clearedFields, cut.FieldOrderHistoryIndex)
 }
 
-// Fields returns all fields that were changed during this mutation. Note that in
-// order to get all numeric fields that were incremented/decremented, call
-// AddedFields().
-func (m *ExportJobMutation) Fields() []string {
-	fields := make([]string, 0, 15)
-	if m.job_id != nil {
-		fields = append(fields, exportjob.FieldJobID)
+// SetHiddenHighlights sets the "hidden_highlights" field.
+func (m *CutMutation) SetHiddenHighlights(s []string) {
+	m.hidden_highlights = &s
+	m.appendhidden_highlights = nil
+}
+
+// HiddenHighlights returns the value of the "hidden_highlights" field in the mutation.
+func (m *CutMutation) HiddenHighlights() (r []string, exists bool) {
+	v := m.hidden_highlights
+	if v == nil {
+		return
 	}
-	if m.export_type != nil {
-		fields = append(fields, exportjob.FieldExportType)
+	return *v, true
+}
+
+// OldHiddenHighlights returns the old "hidden_highlights" field's value of the Cut entity.
+// If the Cut object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *CutMutation) OldHiddenHighlights(ctx context.Context) (v []string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldHiddenHighlights is only allowed on UpdateOne operations")
 	}
-	if m.output_path != nil {
-		fields = append(fields, exportjob.FieldOutputPath)
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldHiddenHighlights requires an ID field in the mutation")
 	}
-	if m.stage != nil {
-		fields = append(fields, exportjob.FieldStage)
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldHiddenHighlights: %w", err)
 	}
-	if m.progress != nil {
-		fields = append(fields, exportjob.FieldProgress)
+	return oldValue.HiddenHighlights, nil
+}
+
+// AppendHiddenHighlights adds s to the "hidden_highlights" field.
+func (m *CutMutation) AppendHiddenHighlights(s []string) {
+	m.appendhidden_highlights = append(m.appendhidden_highlights, s...)
+}
+
+// AppendedHiddenHighlights returns the list of values that were appended to the "hidden_highlights" field in this mutation.
+func (m *CutMutation) AppendedHiddenHighlights() ([]string, bool) {
+	if len(m.appendhidden_highlights) == 0 {
+		return nil, false
 	}
-	if m.current_file != nil {
-		fields = append(fields, exportjob.FieldCurrentFile)
+	return m.appendhidden_highlights, true
+}
+
+// ClearHiddenHighlights clears the value of the "hidden_highlights" field.
+func (m *CutMutation) ClearHiddenHighlights() {
+	m.hidden_highlights = nil
+	m.appendhidden_highlights = nil
+	m.clearedFields[cut.FieldHiddenHighlights] = struct{}{}
+}
+
+// HiddenHighlightsCleared returns if the "hidden_highlights" field was cleared in this mutation.
+func (m *CutMutation) HiddenHighlightsCleared() bool {
+	_, ok := m.clearedFields[cut.FieldHiddenHighlights]
+	return ok
+}
+
+// ResetHiddenHighlights resets all changes to the "hidden_highlights" field.
+func (m *CutMutation) ResetHiddenHighlights() {
+	m.hidden_highlights = nil
+	m.appendhidden_highlights = nil
+	delete(m.clearedFields, cut.FieldHiddenHighlights)
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (m *CutMutation) SetCreatedAt(t time.Time) {
+	m.created_at = &t
+}
+
+// CreatedAt returns the value of the "created_at" field in the mutation.
+func (m *CutMutation) CreatedAt() (r time.Time, exists bool) {
+	v := m.created_at
+	if v == nil {
+		return
 	}
-	if m.total_files != nil {
-		fields = append(fields, exportjob.FieldTotalFiles)
+	return *v, true
+}
+
+// OldCreatedAt returns the old "created_at" field's value of the Cut entity.
+// If the Cut object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *CutMutation) OldCreatedAt(ctx context.Context) (v time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldCreatedAt is only allowed on UpdateOne operations")
 	}
-	if m.processed_files != nil {
-		fields = append(fields, exportjob.FieldProcessedFiles)
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldCreatedAt requires an ID field in the mutation")
 	}
-	if m.is_complete != nil {
-		fields = append(fields, exportjob.FieldIsComplete)
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldCreatedAt: %w", err)
 	}
-	if m.has_error != nil {
-		fields = append(fields, exportjob.FieldHasError)
+	return oldValue.CreatedAt, nil
+}
+
+// ResetCreatedAt resets all changes to the "created_at" field.
+func (m *CutMutation) ResetCreatedAt() {
+	m.created_at = nil
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (m *CutMutation) SetUpdatedAt(t time.Time) {
+	m.updated_at = &t
+}
+
+// UpdatedAt returns the value of the "updated_at" field in the mutation.
+func (m *CutMutation) UpdatedAt() (r time.Time, exists bool) {
+	v := m.updated_at
+	if v == nil {
+		return
 	}
-	if m.error_message != nil {
-		fields = append(fields, exportjob.FieldErrorMessage)
+	return *v, true
+}
+
+// OldUpdatedAt returns the old "updated_at" field's value of the Cut entity.
+// If the Cut object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *CutMutation) OldUpdatedAt(ctx context.Context) (v time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldUpdatedAt is only allowed on UpdateOne operations")
 	}
-	if m.is_cancelled != nil {
-		fields = append(fields, exportjob.FieldIsCancelled)
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldUpdatedAt requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldUpdatedAt: %w", err)
+	}
+	return oldValue.UpdatedAt, nil
+}
+
+// ResetUpdatedAt resets all changes to the "updated_at" field.
+func (m *CutMutation) ResetUpdatedAt() {
+	m.updated_at = nil
+}
+
+// ClearProject clears the "project" edge to the Project entity.
+func (m *CutMutation) ClearProject() {
+	m.clearedproject = true
+	m.clearedFields[cut.FieldProjectID] = struct{}{}
+}
+
+// ProjectCleared reports if the "project" edge to the Project entity was cleared.
+func (m *CutMutation) ProjectCleared() bool {
+	return m.clearedproject
+}
+
+// ProjectIDs returns the "project" edge IDs in the mutation.
+// Note that IDs always returns len(IDs) <= 1 for unique edges, and you should use
+// ProjectID instead. It exists only for internal usage by the builders.
+func (m *CutMutation) ProjectIDs() (ids []int) {
+	if id := m.project; id != nil {
+		ids = append(ids, *id)
+	}
+	return
+}
+
+// ResetProject resets all changes to the "project" edge.
+func (m *CutMutation) ResetProject() {
+	m.project = nil
+	m.clearedproject = false
+}
+
+// Where appends a list predicates to the CutMutation builder.
+func (m *CutMutation) Where(ps ...predicate.Cut) {
+	m.predicates = append(m.predicates, ps...)
+}
+
+// WhereP appends storage-level predicates to the CutMutation builder. Using this method,
+// users can use type-assertion to append predicates that do not depend on any generated package.
+func (m *CutMutation) WhereP(ps ...func(*sql.Selector)) {
+	p := make([]predicate.Cut, len(ps))
+	for i := range ps {
+		p[i] = ps[i]
+	}
+	m.Where(p...)
+}
+
+// Op returns the operation name.
+func (m *CutMutation) Op() Op {
+	return m.op
+}
+
+// SetOp allows setting the mutation operation.
+func (m *CutMutation) SetOp(op Op) {
+	m.op = op
+}
+
+// Type returns the node type of this mutation (Cut).
+func (m *CutMutation) Type() string {
+	return m.typ
+}
+
+// Fields returns all fields that were changed during this mutation. Note that in
+// order to get all numeric fields that were incremented/decremented, call
+// AddedFields().
+func (m *CutMutation) Fields() []string {
+	fields := make([]string, 0, 8)
+	if m.name != nil {
+		fields = append(fields, cut.FieldName)
+	}
+	if m.project != nil {
+		fields = append(fields, cut.FieldProjectID)
+	}
+	if m.highlight_order != nil {
+		fields = append(fields, cut.FieldHighlightOrder)
+	}
+	if m.order_history != nil {
+		fields = append(fields, cut.FieldOrderHistory)
+	}
+	if m.order_history_index != nil {
+		fields = append(fields, cut.FieldOrderHistoryIndex)
+	}
+	if m.hidden_highlights != nil {
+		fields = append(fields, cut.FieldHiddenHighlights)
 	}
 	if m.created_at != nil {
-		fields = append(fields, exportjob.FieldCreatedAt)
+		fields = append(fields, cut.FieldCreatedAt)
 	}
 	if m.updated_at != nil {
-		fields = append(fields, exportjob.FieldUpdatedAt)
-	}
-	if m.completed_at != nil {
-		fields = append(fields, exportjob.FieldCompletedAt)
+		fields = append(fields, cut.FieldUpdatedAt)
 	}
 	return fields
 }
@@ -2440,38 +3096,24 @@ func (m *ExportJobMutation) Fields() []string {
 // Field returns the value of a field with the given name. The second boolean
 // return value indicates that this field was not set, or was not defined in the
 // schema.
-func (m *ExportJobMutation) Field(name string) (ent.Value, bool) {
+func (m *CutMutation) Field(name string) (ent.Value, bool) {
 	switch name {
-	case exportjob.FieldJobID:
-		return m.JobID()
-	case exportjob.FieldExportType:
-		return m.ExportType()
-	case exportjob.FieldOutputPath:
-		return m.OutputPath()
-	case exportjob.FieldStage:
-		return m.Stage()
-	case exportjob.FieldProgress:
-		return m.Progress()
-	case exportjob.FieldCurrentFile:
-		return m.CurrentFile()
-	case exportjob.FieldTotalFiles:
-		return m.TotalFiles()
-	case exportjob.FieldProcessedFiles:
-		return m.ProcessedFiles()
-	case exportjob.FieldIsComplete:
-		return m.IsComplete()
-	case exportjob.FieldHasError:
-		return m.HasError()
-	case exportjob.FieldErrorMessage:
-		return m.ErrorMessage()
-	case exportjob.FieldIsCancelled:
-		return m.IsCancelled()
-	case exportjob.FieldCreatedAt:
+	case cut.FieldName:
+		return m.Name()
+	case cut.FieldProjectID:
+		return m.ProjectID()
+	case cut.FieldHighlightOrder:
+		return m.HighlightOrder()
+	case cut.FieldOrderHistory:
+		return m.OrderHistory()
+	case cut.FieldOrderHistoryIndex:
+		return m.OrderHistoryIndex()
+	case cut.FieldHiddenHighlights:
+		return m.HiddenHighlights()
+	case cut.FieldCreatedAt:
 		return m.CreatedAt()
-	case exportjob.FieldUpdatedAt:
+	case cut.FieldUpdatedAt:
 		return m.UpdatedAt()
-	case exportjob.FieldCompletedAt:
-		return m.CompletedAt()
 	}
 	return nil, false
 }
@@ -2479,168 +3121,99 @@ func (m *ExportJobMutation) Field(name string) (ent.Value, bool) {
 // OldField returns the old value of the field from the database. An error is
 // returned if the mutation operation is not UpdateOne, or the query to the
 // database failed.
-func (m *ExportJobMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
+func (m *CutMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
 	switch name {
-	case exportjob.FieldJobID:
-		return m.OldJobID(ctx)
-	case exportjob.FieldExportType:
-		return m.OldExportType(ctx)
-	case exportjob.FieldOutputPath:
-		return m.OldOutputPath(ctx)
-	case exportjob.FieldStage:
-		return m.OldStage(ctx)
-	case exportjob.FieldProgress:
-		return m.OldProgress(ctx)
-	case exportjob.FieldCurrentFile:
-		return m.OldCurrentFile(ctx)
-	case exportjob.FieldTotalFiles:
-		return m.OldTotalFiles(ctx)
-	case exportjob.FieldProcessedFiles:
-		return m.OldProcessedFiles(ctx)
-	case exportjob.FieldIsComplete:
-		return m.OldIsComplete(ctx)
-	case exportjob.FieldHasError:
-		return m.OldHasError(ctx)
-	case exportjob.FieldErrorMessage:
-		return m.OldErrorMessage(ctx)
-	case exportjob.FieldIsCancelled:
-		return m.OldIsCancelled(ctx)
-	case exportjob.FieldCreatedAt:
+	case cut.FieldName:
+		return m.OldName(ctx)
+	case cut.FieldProjectID:
+		return m.OldProjectID(ctx)
+	case cut.FieldHighlightOrder:
+		return m.OldHighlightOrder(ctx)
+	case cut.FieldOrderHistory:
+		return m.OldOrderHistory(ctx)
+	case cut.FieldOrderHistoryIndex:
+		return m.OldOrderHistoryIndex(ctx)
+	case cut.FieldHiddenHighlights:
+		return m.OldHiddenHighlights(ctx)
+	case cut.FieldCreatedAt:
 		return m.OldCreatedAt(ctx)
-	case exportjob.FieldUpdatedAt:
+	case cut.FieldUpdatedAt:
 		return m.OldUpdatedAt(ctx)
-	case exportjob.FieldCompletedAt:
-		return m.OldCompletedAt(ctx)
 	}
-	return nil, fmt.Errorf("unknown ExportJob field %s", name)
+	return nil, fmt.Errorf("unknown Cut field %s", name)
 }
 
 // SetField sets the value of a field with the given name. It returns an error if
 // the field is not defined in the schema, or if the type mismatched the field
 // type.
-func (m *ExportJobMutation) SetField(name string, value ent.Value) error {
+func (m *CutMutation) SetField(name string, value ent.Value) error {
 	switch name {
-	case exportjob.FieldJobID:
-		v, ok := value.(string)
-		if !ok {
-			return fmt.Errorf("unexpected type %T for field %s", value, name)
-		}
-		m.SetJobID(v)
-		return nil
-	case exportjob.FieldExportType:
-		v, ok := value.(string)
-		if !ok {
-			return fmt.Errorf("unexpected type %T for field %s", value, name)
-		}
-		m.SetExportType(v)
-		return nil
-	case exportjob.FieldOutputPath:
-		v, ok := value.(string)
-		if !ok {
-			return fmt.Errorf("unexpected type %T for field %s", value, name)
-		}
-		m.SetOutputPath(v)
-		return nil
-	case exportjob.FieldStage:
-		v, ok := value.(string)
-		if !ok {
-			return fmt.Errorf("unexpected type %T for field %s", value, name)
-		}
-		m.SetStage(v)
-		return nil
-	case exportjob.FieldProgress:
-		v, ok := value.(float64)
-		if !ok {
-			return fmt.Errorf("unexpected type %T for field %s", value, name)
-		}
-		m.SetProgress(v)
-		return nil
-	case exportjob.FieldCurrentFile:
+	case cut.FieldName:
 		v, ok := value.(string)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetCurrentFile(v)
-		return nil
-	case exportjob.FieldTotalFiles:
-		v, ok := value.(int)
-		if !ok {
-			return fmt.Errorf("unexpected type %T for field %s", value, name)
-		}
-		m.SetTotalFiles(v)
+		m.SetName(v)
 		return nil
-	case exportjob.FieldProcessedFiles:
+	case cut.FieldProjectID:
 		v, ok := value.(int)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetProcessedFiles(v)
+		m.SetProjectID(v)
 		return nil
-	case exportjob.FieldIsComplete:
-		v, ok := value.(bool)
+	case cut.FieldHighlightOrder:
+		v, ok := value.([]interface{})
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetIsComplete(v)
+		m.SetHighlightOrder(v)
 		return nil
-	case exportjob.FieldHasError:
-		v, ok := value.(bool)
+	case cut.FieldOrderHistory:
+		v, ok := value.([][]interface{})
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetHasError(v)
+		m.SetOrderHistory(v)
 		return nil
-	case exportjob.FieldErrorMessage:
-		v, ok := value.(string)
+	case cut.FieldOrderHistoryIndex:
+		v, ok := value.(int)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetErrorMessage(v)
+		m.SetOrderHistoryIndex(v)
 		return nil
-	case exportjob.FieldIsCancelled:
-		v, ok := value.(bool)
+	case cut.FieldHiddenHighlights:
+		v, ok := value.([]string)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetIsCancelled(v)
+		m.SetHiddenHighlights(v)
 		return nil
-	case exportjob.FieldCreatedAt:
+	case cut.FieldCreatedAt:
 		v, ok := value.(time.Time)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
 		m.SetCreatedAt(v)
 		return nil
-	case exportjob.FieldUpdatedAt:
+	case cut.FieldUpdatedAt:
 		v, ok := value.(time.Time)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
 		m.SetUpdatedAt(v)
 		return nil
-	case exportjob.FieldCompletedAt:
-		v, ok := value.(time.Time)
-		if !ok {
-			return fmt.Errorf("unexpected type %T for field %s", value, name)
-		}
-		m.SetCompletedAt(v)
-		return nil
 	}
-	return fmt.Errorf("unknown ExportJob field %s", name)
+	return fmt.Errorf("unknown Cut field %s", name)
 }
 
 // AddedFields returns all numeric fields that were incremented/decremented during
 // this mutation.
-func (m *ExportJobMutation) AddedFields() []string {
+func (m *CutMutation) AddedFields() []string {
 	var fields []string
-	if m.addprogress != nil {
-		fields = append(fields, exportjob.FieldProgress)
-	}
-	if m.addtotal_files != nil {
-		fields = append(fields, exportjob.FieldTotalFiles)
-	}
-	if m.addprocessed_files != nil {
-		fields = append(fields, exportjob.FieldProcessedFiles)
+	if m.addorder_history_index != nil {
+		fields = append(fields, cut.FieldOrderHistoryIndex)
 	}
 	return fields
 }
@@ -2648,14 +3221,10 @@ func (m *ExportJobMutation) AddedFields() []string {
 // AddedField returns the numeric value that was incremented/decremented on a field
 // with the given name. The second boolean return value indicates that this field
 // was not set, or was not defined in the schema.
-func (m *ExportJobMutation) AddedField(name string) (ent.Value, bool) {
+func (m *CutMutation) AddedField(name string) (ent.Value, bool) {
 	switch name {
-	case exportjob.FieldProgress:
-		return m.AddedProgress()
-	case exportjob.FieldTotalFiles:
-		return m.AddedTotalFiles()
-	case exportjob.FieldProcessedFiles:
-		return m.AddedProcessedFiles()
+	case cut.FieldOrderHistoryIndex:
+		return m.AddedOrderHistoryIndex()
 	}
 	return nil, false
 }
@@ -2663,140 +3232,111 @@ func (m *ExportJobMutation) AddedField(name string) (ent.Value, bool) {
 // AddField adds the value to the field with the given name. It returns an error if
 // the field is not defined in the schema, or if the type mismatched the field
 // type.
-func (m *ExportJobMutation) AddField(name string, value ent.Value) error {
+func (m *CutMutation) AddField(name string, value ent.Value) error {
 	switch name {
-	case exportjob.FieldProgress:
-		v, ok := value.(float64)
-		if !ok {
-			return fmt.Errorf("unexpected type %T for field %s", value, name)
-		}
-		m.AddProgress(v)
-		return nil
-	case exportjob.FieldTotalFiles:
-		v, ok := value.(int)
-		if !ok {
-			return fmt.Errorf("unexpected type %T for field %s", value, name)
-		}
-		m.AddTotalFiles(v)
-		return nil
-	case exportjob.FieldProcessedFiles:
+	case cut.FieldOrderHistoryIndex:
 		v, ok := value.(int)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.AddProcessedFiles(v)
+		m.AddOrderHistoryIndex(v)
 		return nil
 	}
-	return fmt.Errorf("unknown ExportJob numeric field %s", name)
+	return fmt.Errorf("unknown Cut numeric field %s", name)
 }
 
 // ClearedFields returns all nullable fields that were cleared during this
 // mutation.
-func (m *ExportJobMutation) ClearedFields() []string {
+func (m *CutMutation) ClearedFields() []string {
 	var fields []string
-	if m.FieldCleared(exportjob.FieldCurrentFile) {
-		fields = append(fields, exportjob.FieldCurrentFile)
+	if m.FieldCleared(cut.FieldHighlightOrder) {
+		fields = append(fields, cut.FieldHighlightOrder)
 	}
-	if m.FieldCleared(exportjob.FieldErrorMessage) {
-		fields = append(fields, exportjob.FieldErrorMessage)
+	if m.FieldCleared(cut.FieldOrderHistory) {
+		fields = append(fields, cut.FieldOrderHistory)
 	}
-	if m.FieldCleared(exportjob.FieldCompletedAt) {
-		fields = append(fields, exportjob.FieldCompletedAt)
+	if m.FieldCleared(cut.FieldOrderHistoryIndex) {
+		fields = append(fields, cut.FieldOrderHistoryIndex)
+	}
+	if m.FieldCleared(cut.FieldHiddenHighlights) {
+		fields = append(fields, cut.FieldHiddenHighlights)
 	}
 	return fields
 }
 
 // FieldCleared returns a boolean indicating if a field with the given name was
 // cleared in this mutation.
-func (m *ExportJobMutation) FieldCleared(name string) bool {
+func (m *CutMutation) FieldCleared(name string) bool {
 	_, ok := m.clearedFields[name]
 	return ok
 }
 
 // ClearField clears the value of the field with the given name. It returns an
 // error if the field is not defined in the schema.
-func (m *ExportJobMutation) ClearField(name string) error {
+func (m *CutMutation) ClearField(name string) error {
 	switch name {
-	case exportjob.FieldCurrentFile:
-		m.ClearCurrentFile()
+	case cut.FieldHighlightOrder:
+		m.ClearHighlightOrder()
 		return nil
-	case exportjob.FieldErrorMessage:
-		m.ClearErrorMessage()
+	case cut.FieldOrderHistory:
+		m.ClearOrderHistory()
 		return nil
-	case exportjob.FieldCompletedAt:
-		m.ClearCompletedAt()
+	case cut.FieldOrderHistoryIndex:
+		m.ClearOrderHistoryIndex()
+		return nil
+	case cut.FieldHiddenHighlights:
+		m.ClearHiddenHighlights()
 		return nil
 	}
-	return fmt.Errorf("unknown ExportJob nullable field %s", name)
+	return fmt.Errorf("unknown Cut nullable field %s", name)
 }
 
 // ResetField resets all changes in the mutation for the field with the given name.
 // It returns an error if the field is not defined in the schema.
-func (m *ExportJobMutation) ResetField(name string) error {
+func (m *CutMutation) ResetField(name string) error {
 	switch name {
-	case exportjob.FieldJobID:
-		m.ResetJobID()
-		return nil
-	case exportjob.FieldExportType:
-		m.ResetExportType()
-		return nil
-	case exportjob.FieldOutputPath:
-		m.ResetOutputPath()
-		return nil
-	case exportjob.FieldStage:
-		m.ResetStage()
-		return nil
-	case exportjob.FieldProgress:
-		m.ResetProgress()
-		return nil
-	case exportjob.FieldCurrentFile:
-		m.ResetCurrentFile()
-		return nil
-	case exportjob.FieldTotalFiles:
-		m.ResetTotalFiles()
+	case cut.FieldName:
+		m.ResetName()
 		return nil
-	case exportjob.FieldProcessedFiles:
-		m.ResetProcessedFiles()
+	case cut.FieldProjectID:
+		m.ResetProjectID()
 		return nil
-	case exportjob.FieldIsComplete:
-		m.ResetIsComplete()
+	case cut.FieldHighlightOrder:
+		m.ResetHighlightOrder()
 		return nil
-	case exportjob.FieldHasError:
-		m.ResetHasError()
+	case cut.FieldOrderHistory:
+		m.ResetOrderHistory()
 		return nil
-	case exportjob.FieldErrorMessage:
-		m.ResetErrorMessage()
+	case cut.FieldOrderHistoryIndex:
+		m.ResetOrderHistoryIndex()
 		return nil
-	case exportjob.FieldIsCancelled:
-		m.ResetIsCancelled()
+	case cut.FieldHiddenHighlights:
+		m.ResetHiddenHighlights()
 		return nil
-	case exportjob.FieldCreatedAt:
+	case cut.FieldCreatedAt:
 		m.ResetCreatedAt()
 		return nil
-	case exportjob.FieldUpdatedAt:
+	case cut.FieldUpdatedAt:
 		m.ResetUpdatedAt()
 		return nil
-	case exportjob.FieldCompletedAt:
-		m.ResetCompletedAt()
-		return nil
 	}
-	return fmt.Errorf("unknown ExportJob field %s", name)
+	return fmt.Errorf("unknown Cut field %s", name)
 }
 
 // AddedEdges returns all edge names that were set/added in this mutation.
-func (m *ExportJobMutation) AddedEdges() []string {
+func (m *CutMutation) AddedEdges() []string {
 	edges := make([]string, 0, 1)
 	if m.project != nil {
-		edges = append(edges, exportjob.EdgeProject)
+		edges = append(edges, cut.EdgeProject)
 	}
 	return edges
 }
 
 // AddedIDs returns all IDs (to other nodes) that were added for the given edge
 // name in this mutation.
-func (m *ExportJobMutation) AddedIDs(name string) []ent.Value {
+func (m *CutMutation) AddedIDs(name string) []ent.Value {
 	switch name {
-	case exportjob.EdgeProject:
+	case cut.EdgeProject:
 		if id := m.project; id != nil {
 			return []ent.Value{*id}
 		}
@@ -2805,31 +3345,31 @@ func (m *ExportJobMutation) AddedIDs(name string) []ent.Value {
 }
 
 // RemovedEdges returns all edge names that were removed in this mutation.
-func (m *ExportJobMutation) RemovedEdges() []string {
+func (m *CutMutation) RemovedEdges() []string {
 	edges := make([]string, 0, 1)
 	return edges
 }
 
 // RemovedIDs returns all IDs (to other nodes) that were removed for the edge with
 // the given name in this mutation.
-func (m *ExportJobMutation) RemovedIDs(name string) []ent.Value {
+func (m *CutMutation) RemovedIDs(name string) []ent.Value {
 	return nil
 }
 
 // ClearedEdges returns all edge names that were cleared in this mutation.
-func (m *ExportJobMutation) ClearedEdges() []string {
+func (m *CutMutation) ClearedEdges() []string {
 	edges := make([]string, 0, 1)
 	if m.clearedproject {
-		edges = append(edges, exportjob.EdgeProject)
+		edges = append(edges, cut.EdgeProject)
 	}
 	return edges
 }
 
 // EdgeCleared returns a boolean which indicates if the edge with the given name
 // was cleared in this mutation.
-func (m *ExportJobMutation) EdgeCleared(name string) bool {
+func (m *CutMutation) EdgeCleared(name string) bool {
 	switch name {
-	case exportjob.EdgeProject:
+	case cut.EdgeProject:
 		return m.clearedproject
 	}
 	return false
@@ -2837,84 +3377,71 @@ func (m *ExportJobMutation) EdgeCleared(name string) bool {
 
 // ClearEdge clears the value of the edge with the given name. It returns an error
 // if that edge is not defined in the schema.
-func (m *ExportJobMutation) ClearEdge(name string) error {
+func (m *CutMutation) ClearEdge(name string) error {
 	switch name {
-	case exportjob.EdgeProject:
+	case cut.EdgeProject:
 		m.ClearProject()
 		return nil
 	}
-	return fmt.Errorf("unknown ExportJob unique edge %s", name)
+	return fmt.Errorf("unknown Cut unique edge %s", name)
 }
 
 // ResetEdge resets all changes to the edge with the given name in this mutation.
 // It returns an error if the edge is not defined in the schema.
-func (m *ExportJobMutation) ResetEdge(name string) error {
+func (m *CutMutation) ResetEdge(name string) error {
 	switch name {
-	case exportjob.EdgeProject:
+	case cut.EdgeProject:
 		m.ResetProject()
 		return nil
 	}
-	return fmt.Errorf("unknown ExportJob edge %s", name)
+	return fmt.Errorf("unknown Cut edge %s", name)
 }
 
-// ProjectMutation represents an operation that mutates the Project nodes in the graph.
-type ProjectMutation struct {
+// ExportJobMutation represents an operation that mutates the ExportJob nodes in the graph.
+type ExportJobMutation struct {
 	config
-	op                            Op
-	typ                           string
-	id                            *int
-	name                          *string
-	description                   *string
-	_path                         *string
-	created_at                    *time.Time
-	updated_at                    *time.Time
-	ai_model                      *string
-	ai_prompt                     *string
-	ai_suggestion_order           *[]interface{}
-	appendai_suggestion_order     []interface{}
-	ai_suggestion_model           *string
-	ai_suggestion_created_at      *time.Time
-	ai_highlight_model            *string
-	ai_highlight_prompt           *string
-	active_tab                    *string
-	ai_silence_improvements       *[]map[string]interface{}
-	appendai_silence_improvements []map[string]interface{}
-	ai_silence_model              *string
-	ai_silence_created_at         *time.Time
-	highlight_order               *[]interface{}
-	appendhighlight_order         []interface{}
-	order_history                 *[][]interface{}
-	appendorder_history           [][]interface{}
-	order_history_index           *int
-	addorder_history_index        *int
-	hidden_highlights             *[]string
-	appendhidden_highlights       []string
-	clearedFields                 map[string]struct{}
-	video_clips                   map[int]struct{}
-	removedvideo_clips            map[int]struct{}
-	clearedvideo_clips            bool
-	export_jobs                   map[int]struct{}
-	removedexport_jobs            map[int]struct{}
-	clearedexport_jobs            bool
-	chat_sessions                 map[int]struct{}
-	removedchat_sessions          map[int]struct{}
-	clearedchat_sessions          bool
-	done                          bool
-	oldValue                      func(context.Context) (*Project, error)
-	predicates                    []predicate.Project
-}
-
-var _ ent.Mutation = (*ProjectMutation)(nil)
-
-// projectOption allows management of the mutation configuration using functional options.
-type projectOption func(*ProjectMutation)
-
-// newProjectMutation creates new mutation for the Project entity.
-func newProjectMutation(c config, op Op, opts ...projectOption) *ProjectMutation {
-	m := &ProjectMutation{
+	op                 Op
+	typ                string
+	id                 *int
+	job_id             *string
+	export_type        *string
+	output_path        *string
+	stage              *string
+	progress           *float64
+	addprogress        *float64
+	current_file       *string
+	total_files        *int
+	addtotal_files     *int
+	processed_files    *int
+	addprocessed_files *int
+	is_complete        *bool
+	has_error          *bool
+	error_message      *string
+	is_cancelled       *bool
+	created_at         *time.Time
+	updated_at         *time.Time
+	cut_id             *int
+	addcut_id          *int
+	completed_at       *time.Time
+	clearedFields      map[string]struct{}
+	project            *int
+	clearedproject     bool
+	done               bool
+	oldValue           func(context.Context) (*ExportJob, error)
+	predicates         []predicate.ExportJob
+}
+
+var _ ent.Mutation = (*ExportJobMutation)(nil)
+
+// exportjobOption allows management of the mutation configuration using functional options.
+type exportjobOption func(*ExportJobMutation)
+
+// newExportJobMutation creates new mutation for the ExportJob entity.
+func newExportJobMutation(c config, op Op, opts ...exportjobOption) *ExportJobMutation {
+	m := &ExportJobMutation{
 		config:        c,
 		op:            op,
-		typ:           TypeProject,
+		typ:           TypeExportJob,
 		clearedFields: make(map[string]struct{}),
 	}
 	for _, opt := range opts {
@@ -2923,20 +3450,20 @@ func newProjectMutation(c config, op Op, opts ...projectOption) *ProjectMutation
 	return m
 }
 
-// withProjectID sets the ID field of the mutation.
-func withProjectID(id int) projectOption {
-	return func(m *ProjectMutation) {
+// withExportJobID sets the ID field of the mutation.
+func withExportJobID(id int) exportjobOption {
+	return func(m *ExportJobMutation) {
 		var (
 			err   error
 			once  sync.Once
-			value *Project
+			value *ExportJob
 		)
-		m.oldValue = func(ctx context.Context) (*Project, error) {
+		m.oldValue = func(ctx context.Context) (*ExportJob, error) {
 			once.Do(func() {
 				if m.done {
 					err = errors.New("querying old values post mutation is not allowed")
 				} else {
-					value, err = m.Client().Project.Get(ctx, id)
+					value, err = m.Client().ExportJob.Get(ctx, id)
 				}
 			})
 			return value, err
@@ -2945,10 +3472,10 @@ func withProjectID(id int) projectOption {
 	}
 }
 
-// withProject sets the old Project of the mutation.
-func withProject(node *Project) projectOption {
-	return func(m *ProjectMutation) {
-		m.oldValue = func(context.Context) (*Project, error) {
+// withExportJob sets the old ExportJob of the mutation.
+func withExportJob(node *ExportJob) exportjobOption {
+	return func(m *ExportJobMutation) {
+		m.oldValue = func(context.Context) (*ExportJob, error) {
 			return node, nil
 		}
 		m.id = &node.ID
@@ -2957,7 +3484,7 @@ func withProject(node *Project) projectOption {
 
 // Client returns a new `ent.Client` from the mutation. If the mutation was
 // executed in a transaction (ent.Tx), a transactional client is returned.
-func (m ProjectMutation) Client() *Client {
+func (m ExportJobMutation) Client() *Client {
 	client := &Client{config: m.config}
 	client.init()
 	return client
@@ -2965,7 +3492,7 @@ func (m ProjectMutation) Client() *Client {
 
 // Tx returns an `ent.Tx` for mutations that were executed in transactions;
 // it returns an error otherwise.
-func (m ProjectMutation) Tx() (*Tx, error) {
+func (m ExportJobMutation) Tx() (*Tx, error) {
 	if _, ok := m.driver.(*txDriver); !ok {
 		return nil, errors.New("ent: mutation is not running in a transaction")
 	}
@@ -2976,7 +3503,7 @@ func (m ProjectMutation) Tx() (*Tx, error) {
 
 // ID returns the ID value in the mutation. Note that the ID is only available
 // if it was provided to the builder or after it was returned from the database.
-func (m *ProjectMutation) ID() (id int, exists bool) {
+func (m *ExportJobMutation) ID() (id int, exists bool) {
 	if m.id == nil {
 		return
 	}
@@ -2987,7 +3514,7 @@ func (m *ProjectMutation) ID() (id int, exists bool) {
 // That means, if the mutation is applied within a transaction with an isolation level such
 // as sql.LevelSerializable, the returned ids match the ids of the rows that will be updated
 // or updated by the mutation.
-func (m *ProjectMutation) IDs(ctx context.Context) ([]int, error) {
+func (m *ExportJobMutation) IDs(ctx context.Context) ([]int, error) {
 	switch {
 	case m.op.Is(OpUpdateOne | OpDeleteOne):
 		id, exists := m.ID()
@@ -2996,1212 +3523,8444 @@ func (m *ProjectMutation) IDs(ctx context.Context) ([]int, error) {
 		}
 		fallthrough
 	case m.op.Is(OpUpdate | OpDelete):
-		return m.Client().Project.Query().Where(m.predicates...).IDs(ctx)
+		return m.Client().ExportJob.Query().Where(m.predicates...).IDs(ctx)
 	default:
 		return nil, fmt.Errorf("IDs is not allowed on %s operations", m.op)
 	}
 }
 
-// SetName sets the "name" field.
-func (m *ProjectMutation) SetName(s string) {
-	m.name = &s
+// SetJobID sets the "job_id" field.
+func (m *ExportJobMutation) SetJobID(s string) {
+	m.job_id = &s
 }
 
-// Name returns the value of the "name" field in the mutation.
-func (m *ProjectMutation) Name() (r string, exists bool) {
-	v := m.name
+// JobID returns the value of the "job_id" field in the mutation.
+func (m *ExportJobMutation) JobID() (r string, exists bool) {
+	v := m.job_id
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldName returns the old "name" field's value of the Project entity.
-// If the Project object wasn't provided to the builder, the object is fetched from the database.
+// OldJobID returns the old "job_id" field's value of the ExportJob entity.
+// If the ExportJob object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *ProjectMutation) OldName(ctx context.Context) (v string, err error) {
+func (m *ExportJobMutation) OldJobID(ctx context.Context) (v string, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldName is only allowed on UpdateOne operations")
+		return v, errors.New("OldJobID is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldName requires an ID field in the mutation")
+		return v, errors.New("OldJobID requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldName: %w", err)
+		return v, fmt.Errorf("querying old value for OldJobID: %w", err)
 	}
-	return oldValue.Name, nil
+	return oldValue.JobID, nil
 }
 
-// ResetName resets all changes to the "name" field.
-func (m *ProjectMutation) ResetName() {
-	m.name = nil
+// ResetJobID resets all changes to the "job_id" field.
+func (m *ExportJobMutation) ResetJobID() {
+	m.job_id = nil
 }
 
-// SetDescription sets the "description" field.
-func (m *ProjectMutation) SetDescription(s string) {
-	m.description = &s
+// SetExportType sets the "export_type" field.
+func (m *ExportJobMutation) SetExportType(s string) {
+	m.export_type = &s
 }
 
-// Description returns the value of the "description" field in the mutation.
-func (m *ProjectMutation) Description() (r string, exists bool) {
-	v := m.description
+// ExportType returns the value of the "export_type" field in the mutation.
+func (m *ExportJobMutation) ExportType() (r string, exists bool) {
+	v := m.export_type
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldDescription returns the old "description" field's value of the Project entity.
-// If the Project object wasn't provided to the builder, the object is fetched from the database.
+// OldExportType returns the old "export_type" field's value of the ExportJob entity.
+// If the ExportJob object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *ProjectMutation) OldDescription(ctx context.Context) (v string, err error) {
+func (m *ExportJobMutation) OldExportType(ctx context.Context) (v string, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldDescription is only allowed on UpdateOne operations")
+		return v, errors.New("OldExportType is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldDescription requires an ID field in the mutation")
+		return v, errors.New("OldExportType requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldDescription: %w", err)
+		return v, fmt.Errorf("querying old value for OldExportType: %w", err)
 	}
-	return oldValue.Description, nil
-}
-
-// ClearDescription clears the value of the "description" field.
-func (m *ProjectMutation) ClearDescription() {
-	m.description = nil
-	m.clearedFields[project.FieldDescription] = struct{}{}
-}
-
-// DescriptionCleared returns if the "description" field was cleared in this mutation.
-func (m *ProjectMutation) DescriptionCleared() bool {
-	_, ok := m.clearedFields[project.FieldDescription]
-	return ok
+	return oldValue.ExportType, nil
 }
 
-// ResetDescription resets all changes to the "description" field.
-func (m *ProjectMutation) ResetDescription() {
-	m.description = nil
-	delete(m.clearedFields, project.FieldDescription)
+// ResetExportType resets all changes to the "export_type" field.
+func (m *ExportJobMutation) ResetExportType() {
+	m.export_type = nil
 }
 
-// SetPath sets the "path" field.
-func (m *ProjectMutation) SetPath(s string) {
-	m._path = &s
+// SetOutputPath sets the "output_path" field.
+func (m *ExportJobMutation) SetOutputPath(s string) {
+	m.output_path = &s
 }
 
-// Path returns the value of the "path" field in the mutation.
-func (m *ProjectMutation) Path() (r string, exists bool) {
-	v := m._path
+// OutputPath returns the value of the "output_path" field in the mutation.
+func (m *ExportJobMutation) OutputPath() (r string, exists bool) {
+	v := m.output_path
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldPath returns the old "path" field's value of the Project entity.
-// If the Project object wasn't provided to the builder, the object is fetched from the database.
+// OldOutputPath returns the old "output_path" field's value of the ExportJob entity.
+// If the ExportJob object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *ProjectMutation) OldPath(ctx context.Context) (v string, err error) {
+func (m *ExportJobMutation) OldOutputPath(ctx context.Context) (v string, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldPath is only allowed on UpdateOne operations")
+		return v, errors.New("OldOutputPath is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldPath requires an ID field in the mutation")
+		return v, errors.New("OldOutputPath requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldPath: %w", err)
+		return v, fmt.Errorf("querying old value for OldOutputPath: %w", err)
 	}
-	return oldValue.Path, nil
+	return oldValue.OutputPath, nil
 }
 
-// ResetPath resets all changes to the "path" field.
-func (m *ProjectMutation) ResetPath() {
-	m._path = nil
+// ResetOutputPath resets all changes to the "output_path" field.
+func (m *ExportJobMutation) ResetOutputPath() {
+	m.output_path = nil
 }
 
-// SetCreatedAt sets the "created_at" field.
-func (m *ProjectMutation) SetCreatedAt(t time.Time) {
-	m.created_at = &t
+// SetStage sets the "stage" field.
+func (m *ExportJobMutation) SetStage(s string) {
+	m.stage = &s
 }
 
-// CreatedAt returns the value of the "created_at" field in the mutation.
-func (m *ProjectMutation) CreatedAt() (r time.Time, exists bool) {
-	v := m.created_at
+// Stage returns the value of the "stage" field in the mutation.
+func (m *ExportJobMutation) Stage() (r string, exists bool) {
+	v := m.stage
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldCreatedAt returns the old "created_at" field's value of the Project entity.
-// If the Project object wasn't provided to the builder, the object is fetched from the database.
+// OldStage returns the old "stage" field's value of the ExportJob entity.
+// If the ExportJob object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *ProjectMutation) OldCreatedAt(ctx context.Context) (v time.Time, err error) {
+func (m *ExportJobMutation) OldStage(ctx context.Context) (v string, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldCreatedAt is only allowed on UpdateOne operations")
+		return v, errors.New("OldStage is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldCreatedAt requires an ID field in the mutation")
+		return v, errors.New("OldStage requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldCreatedAt: %w", err)
+		return v, fmt.Errorf("querying old value for OldStage: %w", err)
 	}
-	return oldValue.CreatedAt, nil
+	return oldValue.Stage, nil
 }
 
-// ResetCreatedAt resets all changes to the "created_at" field.
-func (m *ProjectMutation) ResetCreatedAt() {
-	m.created_at = nil
+// ResetStage resets all changes to the "stage" field.
+func (m *ExportJobMutation) ResetStage() {
+	m.stage = nil
 }
 
-// SetUpdatedAt sets the "updated_at" field.
-func (m *ProjectMutation) SetUpdatedAt(t time.Time) {
-	m.updated_at = &t
+// SetProgress sets the "progress" field.
+func (m *ExportJobMutation) SetProgress(f float64) {
+	m.progress = &f
+	m.addprogress = nil
 }
 
-// UpdatedAt returns the value of the "updated_at" field in the mutation.
-func (m *ProjectMutation) UpdatedAt() (r time.Time, exists bool) {
-	v := m.updated_at
+// Progress returns the value of the "progress" field in the mutation.
+func (m *ExportJobMutation) Progress() (r float64, exists bool) {
+	v := m.progress
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldUpdatedAt returns the old "updated_at" field's value of the Project entity.
-// If the Project object wasn't provided to the builder, the object is fetched from the database.
+// OldProgress returns the old "progress" field's value of the ExportJob entity.
+// If the ExportJob object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *ProjectMutation) OldUpdatedAt(ctx context.Context) (v time.Time, err error) {
+func (m *ExportJobMutation) OldProgress(ctx context.Context) (v float64, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldUpdatedAt is only allowed on UpdateOne operations")
+		return v, errors.New("OldProgress is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldUpdatedAt requires an ID field in the mutation")
+		return v, errors.New("OldProgress requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldUpdatedAt: %w", err)
+		return v, fmt.Errorf("querying old value for OldProgress: %w", err)
 	}
-	return oldValue.UpdatedAt, nil
-}
-
-// ResetUpdatedAt resets all changes to the "updated_at" field.
-func (m *ProjectMutation) ResetUpdatedAt() {
-	m.updated_at = nil
+	return oldValue.Progress, nil
 }
 
-// SetAiModel sets the "ai_model" field.
-func (m *ProjectMutation) SetAiModel(s string) {
-	m.ai_model = &s
+// AddProgress adds f to the "progress" field.
+func (m *ExportJobMutation) AddProgress(f float64) {
+	if m.addprogress != nil {
+		*m.addprogress += f
+	} else {
+		m.addprogress = &f
+	}
 }
 
-// AiModel returns the value of the "ai_model" field in the mutation.
-func (m *ProjectMutation) AiModel() (r string, exists bool) {
-	v := m.ai_model
+// AddedProgress returns the value that was added to the "progress" field in this mutation.
+func (m *ExportJobMutation) AddedProgress() (r float64, exists bool) {
+	v := m.addprogress
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldAiModel returns the old "ai_model" field's value of the Project entity.
-// If the Project object wasn't provided to the builder, the object is fetched from the database.
+// ResetProgress resets all changes to the "progress" field.
+func (m *ExportJobMutation) ResetProgress() {
+	m.progress = nil
+	m.addprogress = nil
+}
+
+// SetCurrentFile sets the "current_file" field.
+func (m *ExportJobMutation) SetCurrentFile(s string) {
+	m.current_file = &s
+}
+
+// CurrentFile returns the value of the "current_file" field in the mutation.
+func (m *ExportJobMutation) CurrentFile() (r string, exists bool) {
+	v := m.current_file
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldCurrentFile returns the old "current_file" field's value of the ExportJob entity.
+// If the ExportJob object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *ProjectMutation) OldAiModel(ctx context.Context) (v string, err error) {
+func (m *ExportJobMutation) OldCurrentFile(ctx context.Context) (v string, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldAiModel is only allowed on UpdateOne operations")
+		return v, errors.New("OldCurrentFile is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldAiModel requires an ID field in the mutation")
+		return v, errors.New("OldCurrentFile requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldAiModel: %w", err)
+		return v, fmt.Errorf("querying old value for OldCurrentFile: %w", err)
 	}
-	return oldValue.AiModel, nil
+	return oldValue.CurrentFile, nil
 }
 
-// ClearAiModel clears the value of the "ai_model" field.
-func (m *ProjectMutation) ClearAiModel() {
-	m.ai_model = nil
-	m.clearedFields[project.FieldAiModel] = struct{}{}
+// ClearCurrentFile clears the value of the "current_file" field.
+func (m *ExportJobMutation) ClearCurrentFile() {
+	m.current_file = nil
+	m.clearedFields[exportjob.FieldCurrentFile] = struct{}{}
 }
 
-// AiModelCleared returns if the "ai_model" field was cleared in this mutation.
-func (m *ProjectMutation) AiModelCleared() bool {
-	_, ok := m.clearedFields[project.FieldAiModel]
+// CurrentFileCleared returns if the "current_file" field was cleared in this mutation.
+func (m *ExportJobMutation) CurrentFileCleared() bool {
+	_, ok := m.clearedFields[exportjob.FieldCurrentFile]
 	return ok
 }
 
-// ResetAiModel resets all changes to the "ai_model" field.
-func (m *ProjectMutation) ResetAiModel() {
-	m.ai_model = nil
-	delete(m.clearedFields, project.FieldAiModel)
+// ResetCurrentFile resets all changes to the "current_file" field.
+func (m *ExportJobMutation) ResetCurrentFile() {
+	m.current_file = nil
+	delete(m.clearedFields, exportjob.FieldCurrentFile)
 }
 
-// SetAiPrompt sets the "ai_prompt" field.
-func (m *ProjectMutation) SetAiPrompt(s string) {
-	m.ai_prompt = &s
+// SetTotalFiles sets the "total_files" field.
+func (m *ExportJobMutation) SetTotalFiles(i int) {
+	m.total_files = &i
+	m.addtotal_files = nil
 }
 
-// AiPrompt returns the value of the "ai_prompt" field in the mutation.
-func (m *ProjectMutation) AiPrompt() (r string, exists bool) {
-	v := m.ai_prompt
+// TotalFiles returns the value of the "total_files" field in the mutation.
+func (m *ExportJobMutation) TotalFiles() (r int, exists bool) {
+	v := m.total_files
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldAiPrompt returns the old "ai_prompt" field's value of the Project entity.
-// If the Project object wasn't provided to the builder, the object is fetched from the database.
+// OldTotalFiles returns the old "total_files" field's value of the ExportJob entity.
+// If the ExportJob object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *ProjectMutation) OldAiPrompt(ctx context.Context) (v string, err error) {
+func (m *ExportJobMutation) OldTotalFiles(ctx context.Context) (v int, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldAiPrompt is only allowed on UpdateOne operations")
+		return v, errors.New("OldTotalFiles is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldAiPrompt requires an ID field in the mutation")
+		return v, errors.New("OldTotalFiles requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldAiPrompt: %w", err)
+		return v, fmt.Errorf("querying old value for OldTotalFiles: %w", err)
 	}
-	return oldValue.AiPrompt, nil
+	return oldValue.TotalFiles, nil
 }
 
-// ClearAiPrompt clears the value of the "ai_prompt" field.
-func (m *ProjectMutation) ClearAiPrompt() {
-	m.ai_prompt = nil
-	m.clearedFields[project.FieldAiPrompt] = struct{}{}
+// AddTotalFiles adds i to the "total_files" field.
+func (m *ExportJobMutation) AddTotalFiles(i int) {
+	if m.addtotal_files != nil {
+		*m.addtotal_files += i
+	} else {
+		m.addtotal_files = &i
+	}
 }
 
-// AiPromptCleared returns if the "ai_prompt" field was cleared in this mutation.
-func (m *ProjectMutation) AiPromptCleared() bool {
-	_, ok := m.clearedFields[project.FieldAiPrompt]
-	return ok
+// AddedTotalFiles returns the value that was added to the "total_files" field in this mutation.
+func (m *ExportJobMutation) AddedTotalFiles() (r int, exists bool) {
+	v := m.addtotal_files
+	if v == nil {
+		return
+	}
+	return *v, true
 }
 
-// ResetAiPrompt resets all changes to the "ai_prompt" field.
-func (m *ProjectMutation) ResetAiPrompt() {
-	m.ai_prompt = nil
-	delete(m.clearedFields, project.FieldAiPrompt)
+// ResetTotalFiles resets all changes to the "total_files" field.
+func (m *ExportJobMutation) ResetTotalFiles() {
+	m.total_files = nil
+	m.addtotal_files = nil
 }
 
-// SetAiSuggestionOrder sets the "ai_suggestion_order" field.
-func (m *ProjectMutation) SetAiSuggestionOrder(i []interface{}) {
-	m.ai_suggestion_order = &i
-	m.appendai_suggestion_order = nil
+// SetProcessedFiles sets the "processed_files" field.
+func (m *ExportJobMutation) SetProcessedFiles(i int) {
+	m.processed_files = &i
+	m.addprocessed_files = nil
 }
 
-// AiSuggestionOrder returns the value of the "ai_suggestion_order" field in the mutation.
-func (m *ProjectMutation) AiSuggestionOrder() (r []interface{}, exists bool) {
-	v := m.ai_suggestion_order
+// ProcessedFiles returns the value of the "processed_files" field in the mutation.
+func (m *ExportJobMutation) ProcessedFiles() (r int, exists bool) {
+	v := m.processed_files
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldAiSuggestionOrder returns the old "ai_suggestion_order" field's value of the Project entity.
-// If the Project object wasn't provided to the builder, the object is fetched from the database.
+// OldProcessedFiles returns the old "processed_files" field's value of the ExportJob entity.
+// If the ExportJob object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *ProjectMutation) OldAiSuggestionOrder(ctx context.Context) (v []interface{}, err error) {
+func (m *ExportJobMutation) OldProcessedFiles(ctx context.Context) (v int, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldAiSuggestionOrder is only allowed on UpdateOne operations")
+		return v, errors.New("OldProcessedFiles is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldAiSuggestionOrder requires an ID field in the mutation")
+		return v, errors.New("OldProcessedFiles requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldAiSuggestionOrder: %w", err)
+		return v, fmt.Errorf("querying old value for OldProcessedFiles: %w", err)
 	}
-	return oldValue.AiSuggestionOrder, nil
-}
-
-// AppendAiSuggestionOrder adds i to the "ai_suggestion_order" field.
-func (m *ProjectMutation) AppendAiSuggestionOrder(i []interface{}) {
-	m.appendai_suggestion_order = append(m.appendai_suggestion_order, i...)
+	return oldValue.ProcessedFiles, nil
 }
 
-// AppendedAiSuggestionOrder returns the list of values that were appended to the "ai_suggestion_order" field in this mutation.
-func (m *ProjectMutation) AppendedAiSuggestionOrder() ([]interface{}, bool) {
-	if len(m.appendai_suggestion_order) == 0 {
-		return nil, false
+// AddProcessedFiles adds i to the "processed_files" field.
+func (m *ExportJobMutation) AddProcessedFiles(i int) {
+	if m.addprocessed_files != nil {
+		*m.addprocessed_files += i
+	} else {
+		m.addprocessed_files = &i
 	}
-	return m.appendai_suggestion_order, true
 }
 
-// ClearAiSuggestionOrder clears the value of the "ai_suggestion_order" field.
-func (m *ProjectMutation) ClearAiSuggestionOrder() {
-	m.ai_suggestion_order = nil
-	m.appendai_suggestion_order = nil
-	m.clearedFields[project.FieldAiSuggestionOrder] = struct{}{}
-}
-
-// AiSuggestionOrderCleared returns if the "ai_suggestion_order" field was cleared in this mutation.
-func (m *ProjectMutation) AiSuggestionOrderCleared() bool {
-	_, ok := m.clearedFields[project.FieldAiSuggestionOrder]
-	return ok
+// AddedProcessedFiles returns the value that was added to the "processed_files" field in this mutation.
+func (m *ExportJobMutation) AddedProcessedFiles() (r int, exists bool) {
+	v := m.addprocessed_files
+	if v == nil {
+		return
+	}
+	return *v, true
 }
 
-// ResetAiSuggestionOrder resets all changes to the "ai_suggestion_order" field.
-func (m *ProjectMutation) ResetAiSuggestionOrder() {
-	m.ai_suggestion_order = nil
-	m.appendai_suggestion_order = nil
-	delete(m.clearedFields, project.FieldAiSuggestionOrder)
+// ResetProcessedFiles resets all changes to the "processed_files" field.
+func (m *ExportJobMutation) ResetProcessedFiles() {
+	m.processed_files = nil
+	m.addprocessed_files = nil
 }
 
-// SetAiSuggestionModel sets the "ai_suggestion_model" field.
-func (m *ProjectMutation) SetAiSuggestionModel(s string) {
-	m.ai_suggestion_model = &s
+// SetIsComplete sets the "is_complete" field.
+func (m *ExportJobMutation) SetIsComplete(b bool) {
+	m.is_complete = &b
 }
 
-// AiSuggestionModel returns the value of the "ai_suggestion_model" field in the mutation.
-func (m *ProjectMutation) AiSuggestionModel() (r string, exists bool) {
-	v := m.ai_suggestion_model
+// IsComplete returns the value of the "is_complete" field in the mutation.
+func (m *ExportJobMutation) IsComplete() (r bool, exists bool) {
+	v := m.is_complete
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldAiSuggestionModel returns the old "ai_suggestion_model" field's value of the Project entity.
-// If the Project object wasn't provided to the builder, the object is fetched from the database.
+// OldIsComplete returns the old "is_complete" field's value of the ExportJob entity.
+// If the ExportJob object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *ProjectMutation) OldAiSuggestionModel(ctx context.Context) (v string, err error) {
+func (m *ExportJobMutation) OldIsComplete(ctx context.Context) (v bool, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldAiSuggestionModel is only allowed on UpdateOne operations")
+		return v, errors.New("OldIsComplete is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldAiSuggestionModel requires an ID field in the mutation")
+		return v, errors.New("OldIsComplete requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldAiSuggestionModel: %w", err)
+		return v, fmt.Errorf("querying old value for OldIsComplete: %w", err)
 	}
-	return oldValue.AiSuggestionModel, nil
-}
-
-// ClearAiSuggestionModel clears the value of the "ai_suggestion_model" field.
-func (m *ProjectMutation) ClearAiSuggestionModel() {
-	m.ai_suggestion_model = nil
-	m.clearedFields[project.FieldAiSuggestionModel] = struct{}{}
-}
-
-// AiSuggestionModelCleared returns if the "ai_suggestion_model" field was cleared in this mutation.
-func (m *ProjectMutation) AiSuggestionModelCleared() bool {
-	_, ok := m.clearedFields[project.FieldAiSuggestionModel]
-	return ok
+	return oldValue.IsComplete, nil
 }
 
-// ResetAiSuggestionModel resets all changes to the "ai_suggestion_model" field.
-func (m *ProjectMutation) ResetAiSuggestionModel() {
-	m.ai_suggestion_model = nil
-	delete(m.clearedFields, project.FieldAiSuggestionModel)
+// ResetIsComplete resets all changes to the "is_complete" field.
+func (m *ExportJobMutation) ResetIsComplete() {
+	m.is_complete = nil
 }
 
-// SetAiSuggestionCreatedAt sets the "ai_suggestion_created_at" field.
-func (m *ProjectMutation) SetAiSuggestionCreatedAt(t time.Time) {
-	m.ai_suggestion_created_at = &t
+// SetHasError sets the "has_error" field.
+func (m *ExportJobMutation) SetHasError(b bool) {
+	m.has_error = &b
 }
 
-// AiSuggestionCreatedAt returns the value of the "ai_suggestion_created_at" field in the mutation.
-func (m *ProjectMutation) AiSuggestionCreatedAt() (r time.Time, exists bool) {
-	v := m.ai_suggestion_created_at
+// HasError returns the value of the "has_error" field in the mutation.
+func (m *ExportJobMutation) HasError() (r bool, exists bool) {
+	v := m.has_error
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldAiSuggestionCreatedAt returns the old "ai_suggestion_created_at" field's value of the Project entity.
-// If the Project object wasn't provided to the builder, the object is fetched from the database.
+// OldHasError returns the old "has_error" field's value of the ExportJob entity.
+// If the ExportJob object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *ProjectMutation) OldAiSuggestionCreatedAt(ctx context.Context) (v time.Time, err error) {
+func (m *ExportJobMutation) OldHasError(ctx context.Context) (v bool, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldAiSuggestionCreatedAt is only allowed on UpdateOne operations")
+		return v, errors.New("OldHasError is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldAiSuggestionCreatedAt requires an ID field in the mutation")
+		return v, errors.New("OldHasError requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldAiSuggestionCreatedAt: %w", err)
+		return v, fmt.Errorf("querying old value for OldHasError: %w", err)
 	}
-	return oldValue.AiSuggestionCreatedAt, nil
-}
-
-// ClearAiSuggestionCreatedAt clears the value of the "ai_suggestion_created_at" field.
-func (m *ProjectMutation) ClearAiSuggestionCreatedAt() {
-	m.ai_suggestion_created_at = nil
-	m.clearedFields[project.FieldAiSuggestionCreatedAt] = struct{}{}
-}
-
-// AiSuggestionCreatedAtCleared returns if the "ai_suggestion_created_at" field was cleared in this mutation.
-func (m *ProjectMutation) AiSuggestionCreatedAtCleared() bool {
-	_, ok := m.clearedFields[project.FieldAiSuggestionCreatedAt]
-	return ok
+	return oldValue.HasError, nil
 }
 
-// ResetAiSuggestionCreatedAt resets all changes to the "ai_suggestion_created_at" field.
-func (m *ProjectMutation) ResetAiSuggestionCreatedAt() {
-	m.ai_suggestion_created_at = nil
-	delete(m.clearedFields, project.FieldAiSuggestionCreatedAt)
+// ResetHasError resets all changes to the "has_error" field.
+func (m *ExportJobMutation) ResetHasError() {
+	m.has_error = nil
 }
 
-// SetAiHighlightModel sets the "ai_highlight_model" field.
-func (m *ProjectMutation) SetAiHighlightModel(s string) {
-	m.ai_highlight_model = &s
+// SetErrorMessage sets the "error_message" field.
+func (m *ExportJobMutation) SetErrorMessage(s string) {
+	m.error_message = &s
 }
 
-// AiHighlightModel returns the value of the "ai_highlight_model" field in the mutation.
-func (m *ProjectMutation) AiHighlightModel() (r string, exists bool) {
-	v := m.ai_highlight_model
+// ErrorMessage returns the value of the "error_message" field in the mutation.
+func (m *ExportJobMutation) ErrorMessage() (r string, exists bool) {
+	v := m.error_message
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldAiHighlightModel returns the old "ai_highlight_model" field's value of the Project entity.
-// If the Project object wasn't provided to the builder, the object is fetched from the database.
+// OldErrorMessage returns the old "error_message" field's value of the ExportJob entity.
+// If the ExportJob object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *ProjectMutation) OldAiHighlightModel(ctx context.Context) (v string, err error) {
+func (m *ExportJobMutation) OldErrorMessage(ctx context.Context) (v string, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldAiHighlightModel is only allowed on UpdateOne operations")
+		return v, errors.New("OldErrorMessage is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldAiHighlightModel requires an ID field in the mutation")
+		return v, errors.New("OldErrorMessage requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldAiHighlightModel: %w", err)
+		return v, fmt.Errorf("querying old value for OldErrorMessage: %w", err)
 	}
-	return oldValue.AiHighlightModel, nil
+	return oldValue.ErrorMessage, nil
 }
 
-// ClearAiHighlightModel clears the value of the "ai_highlight_model" field.
-func (m *ProjectMutation) ClearAiHighlightModel() {
-	m.ai_highlight_model = nil
-	m.clearedFields[project.FieldAiHighlightModel] = struct{}{}
+// ClearErrorMessage clears the value of the "error_message" field.
+func (m *ExportJobMutation) ClearErrorMessage() {
+	m.error_message = nil
+	m.clearedFields[exportjob.FieldErrorMessage] = struct{}{}
 }
 
-// AiHighlightModelCleared returns if the "ai_highlight_model" field was cleared in this mutation.
-func (m *ProjectMutation) AiHighlightModelCleared() bool {
-	_, ok := m.clearedFields[project.FieldAiHighlightModel]
+// ErrorMessageCleared returns if the "error_message" field was cleared in this mutation.
+func (m *ExportJobMutation) ErrorMessageCleared() bool {
+	_, ok := m.clearedFields[exportjob.FieldErrorMessage]
 	return ok
 }
 
-// ResetAiHighlightModel resets all changes to the "ai_highlight_model" field.
-func (m *ProjectMutation) ResetAiHighlightModel() {
-	m.ai_highlight_model = nil
-	delete(m.clearedFields, project.FieldAiHighlightModel)
+// ResetErrorMessage resets all changes to the "error_message" field.
+func (m *ExportJobMutation) ResetErrorMessage() {
+	m.error_message = nil
+	delete(m.clearedFields, exportjob.FieldErrorMessage)
 }
 
-// SetAiHighlightPrompt sets the "ai_highlight_prompt" field.
-func (m *ProjectMutation) SetAiHighlightPrompt(s string) {
-	m.ai_highlight_prompt = &s
+// SetIsCancelled sets the "is_cancelled" field.
+func (m *ExportJobMutation) SetIsCancelled(b bool) {
+	m.is_cancelled = &b
 }
 
-// AiHighlightPrompt returns the value of the "ai_highlight_prompt" field in the mutation.
-func (m *ProjectMutation) AiHighlightPrompt() (r string, exists bool) {
-	v := m.ai_highlight_prompt
+// IsCancelled returns the value of the "is_cancelled" field in the mutation.
+func (m *ExportJobMutation) IsCancelled() (r bool, exists bool) {
+	v := m.is_cancelled
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldAiHighlightPrompt returns the old "ai_highlight_prompt" field's value of the Project entity.
-// If the Project object wasn't provided to the builder, the object is fetched from the database.
+// OldIsCancelled returns the old "is_cancelled" field's value of the ExportJob entity.
+// If the ExportJob object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *ProjectMutation) OldAiHighlightPrompt(ctx context.Context) (v string, err error) {
+func (m *ExportJobMutation) OldIsCancelled(ctx context.Context) (v bool, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldAiHighlightPrompt is only allowed on UpdateOne operations")
+		return v, errors.New("OldIsCancelled is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldAiHighlightPrompt requires an ID field in the mutation")
+		return v, errors.New("OldIsCancelled requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldAiHighlightPrompt: %w", err)
+		return v, fmt.Errorf("querying old value for OldIsCancelled: %w", err)
 	}
-	return oldValue.AiHighlightPrompt, nil
-}
-
-// ClearAiHighlightPrompt clears the value of the "ai_highlight_prompt" field.
-func (m *ProjectMutation) ClearAiHighlightPrompt() {
-	m.ai_highlight_prompt = nil
-	m.clearedFields[project.FieldAiHighlightPrompt] = struct{}{}
-}
-
-// AiHighlightPromptCleared returns if the "ai_highlight_prompt" field was cleared in this mutation.
-func (m *ProjectMutation) AiHighlightPromptCleared() bool {
-	_, ok := m.clearedFields[project.FieldAiHighlightPrompt]
-	return ok
+	return oldValue.IsCancelled, nil
 }
 
-// ResetAiHighlightPrompt resets all changes to the "ai_highlight_prompt" field.
-func (m *ProjectMutation) ResetAiHighlightPrompt() {
-	m.ai_highlight_prompt = nil
-	delete(m.clearedFields, project.FieldAiHighlightPrompt)
+// ResetIsCancelled resets all changes to the "is_cancelled" field.
+func (m *ExportJobMutation) ResetIsCancelled() {
+	m.is_cancelled = nil
 }
 
-// SetActiveTab sets the "active_tab" field.
-func (m *ProjectMutation) SetActiveTab(s string) {
-	m.active_tab = &s
+// SetCreatedAt sets the "created_at" field.
+func (m *ExportJobMutation) SetCreatedAt(t time.Time) {
+	m.created_at = &t
 }
 
-// ActiveTab returns the value of the "active_tab" field in the mutation.
-func (m *ProjectMutation) ActiveTab() (r string, exists bool) {
-	v := m.active_tab
+// CreatedAt returns the value of the "created_at" field in the mutation.
+func (m *ExportJobMutation) CreatedAt() (r time.Time, exists bool) {
+	v := m.created_at
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldActiveTab returns the old "active_tab" field's value of the Project entity.
-// If the Project object wasn't provided to the builder, the object is fetched from the database.
+// OldCreatedAt returns the old "created_at" field's value of the ExportJob entity.
+// If the ExportJob object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *ProjectMutation) OldActiveTab(ctx context.Context) (v string, err error) {
+func (m *ExportJobMutation) OldCreatedAt(ctx context.Context) (v time.Time, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldActiveTab is only allowed on UpdateOne operations")
+		return v, errors.New("OldCreatedAt is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldActiveTab requires an ID field in the mutation")
+		return v, errors.New("OldCreatedAt requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldActiveTab: %w", err)
+		return v, fmt.Errorf("querying old value for OldCreatedAt: %w", err)
 	}
-	return oldValue.ActiveTab, nil
+	return oldValue.CreatedAt, nil
 }
 
-// ClearActiveTab clears the value of the "active_tab" field.
-func (m *ProjectMutation) ClearActiveTab() {
-	m.active_tab = nil
-	m.clearedFields[project.FieldActiveTab] = struct{}{}
+// ResetCreatedAt resets all changes to the "created_at" field.
+func (m *ExportJobMutation) ResetCreatedAt() {
+	m.created_at = nil
 }
 
-// ActiveTabCleared returns if the "active_tab" field was cleared in this mutation.
-func (m *ProjectMutation) ActiveTabCleared() bool {
-	_, ok := m.clearedFields[project.FieldActiveTab]
-	return ok
+// SetUpdatedAt sets the "updated_at" field.
+func (m *ExportJobMutation) SetUpdatedAt(t time.Time) {
+	m.updated_at = &t
 }
 
-// ResetActiveTab resets all changes to the "active_tab" field.
-func (m *ProjectMutation) ResetActiveTab() {
-	m.active_tab = nil
-	delete(m.clearedFields, project.FieldActiveTab)
+// UpdatedAt returns the value of the "updated_at" field in the mutation.
+func (m *ExportJobMutation) UpdatedAt() (r time.Time, exists bool) {
+	v := m.updated_at
+	if v == nil {
+		return
+	}
+	return *v, true
 }
 
-// SetAiSilenceImprovements sets the "ai_silence_improvements" field.
-func (m *ProjectMutation) SetAiSilenceImprovements(value []map[string]interface{}) {
-	m.ai_silence_improvements = &value
-	m.appendai_silence_improvements = nil
+// OldUpdatedAt returns the old "updated_at" field's value of the ExportJob entity.
+// If the ExportJob object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *ExportJobMutation) OldUpdatedAt(ctx context.Context) (v time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldUpdatedAt is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldUpdatedAt requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldUpdatedAt: %w", err)
+	}
+	return oldValue.UpdatedAt, nil
 }
 
-// AiSilenceImprovements returns the value of the "ai_silence_improvements" field in the mutation.
-func (m *ProjectMutation) AiSilenceImprovements() (r []map[string]interface{}, exists bool) {
-	v := m.ai_silence_improvements
+// ResetUpdatedAt resets all changes to the "updated_at" field.
+func (m *ExportJobMutation) ResetUpdatedAt() {
+	m.updated_at = nil
+}
+
+// SetCutID sets the "cut_id" field.
+func (m *ExportJobMutation) SetCutID(i int) {
+	m.cut_id = &i
+	m.addcut_id = nil
+}
+
+// CutID returns the value of the "cut_id" field in the mutation.
+func (m *ExportJobMutation) CutID() (r int, exists bool) {
+	v := m.cut_id
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldAiSilenceImprovements returns the old "ai_silence_improvements" field's value of the Project entity.
-// If the Project object wasn't provided to the builder, the object is fetched from the database.
+// OldCutID returns the old "cut_id" field's value of the ExportJob entity.
+// If the ExportJob object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *ProjectMutation) OldAiSilenceImprovements(ctx context.Context) (v []map[string]interface{}, err error) {
+func (m *ExportJobMutation) OldCutID(ctx context.Context) (v int, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldAiSilenceImprovements is only allowed on UpdateOne operations")
+		return v, errors.New("OldCutID is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldAiSilenceImprovements requires an ID field in the mutation")
+		return v, errors.New("OldCutID requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldAiSilenceImprovements: %w", err)
+		return v, fmt.Errorf("querying old value for OldCutID: %w", err)
 	}
-	return oldValue.AiSilenceImprovements, nil
+	return oldValue.CutID, nil
 }
 
-// AppendAiSilenceImprovements adds value to the "ai_silence_improvements" field.
-func (m *ProjectMutation) AppendAiSilenceImprovements(value []map[string]interface{}) {
-	m.appendai_silence_improvements = append(m.appendai_silence_improvements, value...)
+// AddCutID adds i to the "cut_id" field.
+func (m *ExportJobMutation) AddCutID(i int) {
+	if m.addcut_id != nil {
+		*m.addcut_id += i
+	} else {
+		m.addcut_id = &i
+	}
 }
 
-// AppendedAiSilenceImprovements returns the list of values that were appended to the "ai_silence_improvements" field in this mutation.
-func (m *ProjectMutation) AppendedAiSilenceImprovements() ([]map[string]interface{}, bool) {
-	if len(m.appendai_silence_improvements) == 0 {
-		return nil, false
+// AddedCutID returns the value that was added to the "cut_id" field in this mutation.
+func (m *ExportJobMutation) AddedCutID() (r int, exists bool) {
+	v := m.addcut_id
+	if v == nil {
+		return
 	}
-	return m.appendai_silence_improvements, true
+	return *v, true
 }
 
-// ClearAiSilenceImprovements clears the value of the "ai_silence_improvements" field.
-func (m *ProjectMutation) ClearAiSilenceImprovements() {
-	m.ai_silence_improvements = nil
-	m.appendai_silence_improvements = nil
-	m.clearedFields[project.FieldAiSilenceImprovements] = struct{}{}
+// ClearCutID clears the value of the "cut_id" field.
+func (m *ExportJobMutation) ClearCutID() {
+	m.cut_id = nil
+	m.addcut_id = nil
+	m.clearedFields[exportjob.FieldCutID] = struct{}{}
 }
 
-// AiSilenceImprovementsCleared returns if the "ai_silence_improvements" field was cleared in this mutation.
-func (m *ProjectMutation) AiSilenceImprovementsCleared() bool {
-	_, ok := m.clearedFields[project.FieldAiSilenceImprovements]
+// CutIDCleared returns if the "cut_id" field was cleared in this mutation.
+func (m *ExportJobMutation) CutIDCleared() bool {
+	_, ok := m.clearedFields[exportjob.FieldCutID]
 	return ok
 }
 
-// ResetAiSilenceImprovements resets all changes to the "ai_silence_improvements" field.
-func (m *ProjectMutation) ResetAiSilenceImprovements() {
-	m.ai_silence_improvements = nil
-	m.appendai_silence_improvements = nil
-	delete(m.clearedFields, project.FieldAiSilenceImprovements)
+// ResetCutID resets all changes to the "cut_id" field.
+func (m *ExportJobMutation) ResetCutID() {
+	m.cut_id = nil
+	m.addcut_id = nil
+	delete(m.clearedFields, exportjob.FieldCutID)
 }
 
-// SetAiSilenceModel sets the "ai_silence_model" field.
-func (m *ProjectMutation) SetAiSilenceModel(s string) {
-	m.ai_silence_model = &s
+// SetCompletedAt sets the "completed_at" field.
+func (m *ExportJobMutation) SetCompletedAt(t time.Time) {
+	m.completed_at = &t
 }
 
-// AiSilenceModel returns the value of the "ai_silence_model" field in the mutation.
-func (m *ProjectMutation) AiSilenceModel() (r string, exists bool) {
-	v := m.ai_silence_model
+// CompletedAt returns the value of the "completed_at" field in the mutation.
+func (m *ExportJobMutation) CompletedAt() (r time.Time, exists bool) {
+	v := m.completed_at
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldAiSilenceModel returns the old "ai_silence_model" field's value of the Project entity.
-// If the Project object wasn't provided to the builder, the object is fetched from the database.
+// OldCompletedAt returns the old "completed_at" field's value of the ExportJob entity.
+// If the ExportJob object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *ProjectMutation) OldAiSilenceModel(ctx context.Context) (v string, err error) {
+func (m *ExportJobMutation) OldCompletedAt(ctx context.Context) (v time.Time, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldAiSilenceModel is only allowed on UpdateOne operations")
+		return v, errors.New("OldCompletedAt is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldAiSilenceModel requires an ID field in the mutation")
+		return v, errors.New("OldCompletedAt requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldAiSilenceModel: %w", err)
+		return v, fmt.Errorf("querying old value for OldCompletedAt: %w", err)
 	}
-	return oldValue.AiSilenceModel, nil
+	return oldValue.CompletedAt, nil
 }
 
-// ClearAiSilenceModel clears the value of the "ai_silence_model" field.
-func (m *ProjectMutation) ClearAiSilenceModel() {
-	m.ai_silence_model = nil
-	m.clearedFields[project.FieldAiSilenceModel] = struct{}{}
+// ClearCompletedAt clears the value of the "completed_at" field.
+func (m *ExportJobMutation) ClearCompletedAt() {
+	m.completed_at = nil
+	m.clearedFields[exportjob.FieldCompletedAt] = struct{}{}
 }
 
-// AiSilenceModelCleared returns if the "ai_silence_model" field was cleared in this mutation.
-func (m *ProjectMutation) AiSilenceModelCleared() bool {
-	_, ok := m.clearedFields[project.FieldAiSilenceModel]
+// CompletedAtCleared returns if the "completed_at" field was cleared in this mutation.
+func (m *ExportJobMutation) CompletedAtCleared() bool {
+	_, ok := m.clearedFields[exportjob.FieldCompletedAt]
 	return ok
 }
 
-// ResetAiSilenceModel resets all changes to the "ai_silence_model" field.
-func (m *ProjectMutation) ResetAiSilenceModel() {
-	m.ai_silence_model = nil
-	delete(m.clearedFields, project.FieldAiSilenceModel)
-}
-
-// SetAiSilenceCreatedAt sets the "ai_silence_created_at" field.
-func (m *ProjectMutation) SetAiSilenceCreatedAt(t time.Time) {
-	m.ai_silence_created_at = &t
-}
-
-// AiSilenceCreatedAt returns the value of the "ai_silence_created_at" field in the mutation.
-func (m *ProjectMutation) AiSilenceCreatedAt() (r time.Time, exists bool) {
-	v := m.ai_silence_created_at
-	if v == nil {
-		return
-	}
-	return *v, true
-}
-
-// OldAiSilenceCreatedAt returns the old "ai_silence_created_at" field's value of the Project entity.
-// If the Project object wasn't provided to the builder, the object is fetched from the database.
-// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *ProjectMutation) OldAiSilenceCreatedAt(ctx context.Context) (v time.Time, err error) {
-	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldAiSilenceCreatedAt is only allowed on UpdateOne operations")
-	}
-	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldAiSilenceCreatedAt requires an ID field in the mutation")
-	}
-	oldValue, err := m.oldValue(ctx)
-	if err != nil {
-		return v, fmt.Errorf("querying old value for OldAiSilenceCreatedAt: %w", err)
-	}
-	return oldValue.AiSilenceCreatedAt, nil
-}
-
-// ClearAiSilenceCreatedAt clears the value of the "ai_silence_created_at" field.
-func (m *ProjectMutation) ClearAiSilenceCreatedAt() {
-	m.ai_silence_created_at = nil
-	m.clearedFields[project.FieldAiSilenceCreatedAt] = struct{}{}
+// ResetCompletedAt resets all changes to the "completed_at" field.
+func (m *ExportJobMutation) ResetCompletedAt() {
+	m.completed_at = nil
+	delete(m.clearedFields, exportjob.FieldCompletedAt)
 }
 
-// AiSilenceCreatedAtCleared returns if the "ai_silence_created_at" field was cleared in this mutation.
-func (m *ProjectMutation) AiSilenceCreatedAtCleared() bool {
-	_, ok := m.clearedFields[project.FieldAiSilenceCreatedAt]
-	return ok
+// SetProjectID sets the "project" edge to the Project entity by id.
+func (m *ExportJobMutation) SetProjectID(id int) {
+	m.project = &id
 }
 
-// ResetAiSilenceCreatedAt resets all changes to the "ai_silence_created_at" field.
-func (m *ProjectMutation) ResetAiSilenceCreatedAt() {
-	m.ai_silence_created_at = nil
-	delete(m.clearedFields, project.FieldAiSilenceCreatedAt)
+// ClearProject clears the "project" edge to the Project entity.
+func (m *ExportJobMutation) ClearProject() {
+	m.clearedproject = true
 }
 
-// SetHighlightOrder sets the "highlight_order" field.
-func (m *ProjectMutation) SetHighlightOrder(i []interface{}) {
-	m.highlight_order = &i
-	m.appendhighlight_order = nil
+// ProjectCleared reports if the "project" edge to the Project entity was cleared.
+func (m *ExportJobMutation) ProjectCleared() bool {
+	return m.clearedproject
 }
 
-// HighlightOrder returns the value of the "highlight_order" field in the mutation.
-func (m *ProjectMutation) HighlightOrder() (r []interface{}, exists bool) {
-	v := m.highlight_order
-	if v == nil {
-		return
+// ProjectID returns the "project" edge ID in the mutation.
+func (m *ExportJobMutation) ProjectID() (id int, exists bool) {
+	if m.project != nil {
+		return *m.project, true
 	}
-	return *v, true
+	return
 }
 
-// OldHighlightOrder returns the old "highlight_order" field's value of the Project entity.
-// If the Project object wasn't provided to the builder, the object is fetched from the database.
-// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *ProjectMutation) OldHighlightOrder(ctx context.Context) (v []interface{}, err error) {
-	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldHighlightOrder is only allowed on UpdateOne operations")
-	}
-	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldHighlightOrder requires an ID field in the mutation")
-	}
-	oldValue, err := m.oldValue(ctx)
-	if err != nil {
-		return v, fmt.Errorf("querying old value for OldHighlightOrder: %w", err)
+// ProjectIDs returns the "project" edge IDs in the mutation.
+// Note that IDs always returns len(IDs) <= 1 for unique edges, and you should use
+// ProjectID instead. It exists only for internal usage by the builders.
+func (m *ExportJobMutation) ProjectIDs() (ids []int) {
+	if id := m.project; id != nil {
+		ids = append(ids, *id)
 	}
-	return oldValue.HighlightOrder, nil
-}
-
-// AppendHighlightOrder adds i to the "highlight_order" field.
-func (m *ProjectMutation) AppendHighlightOrder(i []interface{}) {
-	m.appendhighlight_order = append(m.appendhighlight_order, i...)
+	return
 }
 
-// AppendedHighlightOrder returns the list of values that were appended to the "highlight_order" field in this mutation.
-func (m *ProjectMutation) AppendedHighlightOrder() ([]interface{}, bool) {
-	if len(m.appendhighlight_order) == 0 {
-		return nil, false
-	}
-	return m.appendhighlight_order, true
+// ResetProject resets all changes to the "project" edge.
+func (m *ExportJobMutation) ResetProject() {
+	m.project = nil
+	m.clearedproject = false
 }
 
-// ClearHighlightOrder clears the value of the "highlight_order" field.
-func (m *ProjectMutation) ClearHighlightOrder() {
-	m.highlight_order = nil
-	m.appendhighlight_order = nil
-	m.clearedFields[project.FieldHighlightOrder] = struct{}{}
+// Where appends a list predicates to the ExportJobMutation builder.
+func (m *ExportJobMutation) Where(ps ...predicate.ExportJob) {
+	m.predicates = append(m.predicates, ps...)
 }
 
-// HighlightOrderCleared returns if the "highlight_order" field was cleared in this mutation.
-func (m *ProjectMutation) HighlightOrderCleared() bool {
-	_, ok := m.clearedFields[project.FieldHighlightOrder]
-	return ok
+// WhereP appends storage-level predicates to the ExportJobMutation builder. Using this method,
+// users can use type-assertion to append predicates that do not depend on any generated package.
+func (m *ExportJobMutation) WhereP(ps ...func(*sql.Selector)) {
+	p := make([]predicate.ExportJob, len(ps))
+	for i := range ps {
+		p[i] = ps[i]
+	}
+	m.Where(p...)
 }
 
-// ResetHighlightOrder resets all changes to the "highlight_order" field.
-func (m *ProjectMutation) ResetHighlightOrder() {
-	m.highlight_order = nil
-	m.appendhighlight_order = nil
-	delete(m.clearedFields, project.FieldHighlightOrder)
+// Op returns the operation name.
+func (m *ExportJobMutation) Op() Op {
+	return m.op
 }
 
-// SetOrderHistory sets the "order_history" field.
-func (m *ProjectMutation) SetOrderHistory(i [][]interface{}) {
-	m.order_history = &i
-	m.appendorder_history = nil
+// SetOp allows setting the mutation operation.
+func (m *ExportJobMutation) SetOp(op Op) {
+	m.op = op
 }
 
-// OrderHistory returns the value of the "order_history" field in the mutation.
-func (m *ProjectMutation) OrderHistory() (r [][]interface{}, exists bool) {
-	v := m.order_history
-	if v == nil {
-		return
-	}
-	return *v, true
+// Type returns the node type of this mutation (ExportJob).
+func (m *ExportJobMutation) Type() string {
+	return m.typ
 }
 
-// OldOrderHistory returns the old "order_history" field's value of the Project entity.
-// If the Project object wasn't provided to the builder, the object is fetched from the database.
-// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *ProjectMutation) OldOrderHistory(ctx context.Context) (v [][]interface{}, err error) {
-	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldOrderHistory is only allowed on UpdateOne operations")
+// Fields returns all fields that were changed during this mutation. Note that in
+// order to get all numeric fields that were incremented/decremented, call
+// AddedFields().
+func (m *ExportJobMutation) Fields() []string {
+	fields := make([]string, 0, 16)
+	if m.job_id != nil {
+		fields = append(fields, exportjob.FieldJobID)
 	}
-	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldOrderHistory requires an ID field in the mutation")
+	if m.export_type != nil {
+		fields = append(fields, exportjob.FieldExportType)
 	}
-	oldValue, err := m.oldValue(ctx)
-	if err != nil {
-		return v, fmt.Errorf("querying old value for OldOrderHistory: %w", err)
+	if m.output_path != nil {
+		fields = append(fields, exportjob.FieldOutputPath)
 	}
-	return oldValue.OrderHistory, nil
-}
-
-// AppendOrderHistory adds i to the "order_history" field.
-func (m *ProjectMutation) AppendOrderHistory(i [][]interface{}) {
-	m.appendorder_history = append(m.appendorder_history, i...)
-}
-
-// AppendedOrderHistory returns the list of values that were appended to the "order_history" field in this mutation.
-func (m *ProjectMutation) AppendedOrderHistory() ([][]interface{}, bool) {
-	if len(m.appendorder_history) == 0 {
-		return nil, false
+	if m.stage != nil {
+		fields = append(fields, exportjob.FieldStage)
 	}
-	return m.appendorder_history, true
-}
-
-// ClearOrderHistory clears the value of the "order_history" field.
-func (m *ProjectMutation) ClearOrderHistory() {
-	m.order_history = nil
-	m.appendorder_history = nil
-	m.clearedFields[project.FieldOrderHistory] = struct{}{}
-}
-
-// OrderHistoryCleared returns if the "order_history" field was cleared in this mutation.
-func (m *ProjectMutation) OrderHistoryCleared() bool {
-	_, ok := m.clearedFields[project.FieldOrderHistory]
-	return ok
-}
-
-// ResetOrderHistory resets all changes to the "order_history" field.
-func (m *ProjectMutation) ResetOrderHistory() {
-	m.order_history = nil
-	m.appendorder_history = nil
-	delete(m.clearedFields, project.FieldOrderHistory)
-}
-
-// SetOrderHistoryIndex sets the "order_history_index" field.
-func (m *ProjectMutation) SetOrderHistoryIndex(i int) {
-	m.order_history_index = &i
-	m.addorder_history_index = nil
-}
-
-// OrderHistoryIndex returns the value of the "order_history_index" field in the mutation.
-func (m *ProjectMutation) OrderHistoryIndex() (r int, exists bool) {
-	v := m.order_history_index
-	if v == nil {
-		return
+	if m.progress != nil {
+		fields = append(fields, exportjob.FieldProgress)
 	}
-	return *v, true
-}
-
-// OldOrderHistoryIndex returns the old "order_history_index" field's value of the Project entity.
-// If the Project object wasn't provided to the builder, the object is fetched from the database.
-// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *ProjectMutation) OldOrderHistoryIndex(ctx context.Context) (v int, err error) {
-	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldOrderHistoryIndex is only allowed on UpdateOne operations")
+	if m.current_file != nil {
+		fields = append(fields, exportjob.FieldCurrentFile)
 	}
-	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldOrderHistoryIndex requires an ID field in the mutation")
+	if m.total_files != nil {
+		fields = append(fields, exportjob.FieldTotalFiles)
+	}
+	if m.processed_files != nil {
+		fields = append(fields, exportjob.FieldProcessedFiles)
+	}
+	if m.is_complete != nil {
+		fields = append(fields, exportjob.FieldIsComplete)
+	}
+	if m.has_error != nil {
+		fields = append(fields, exportjob.FieldHasError)
+	}
+	if m.error_message != nil {
+		fields = append(fields, exportjob.FieldErrorMessage)
+	}
+	if m.is_cancelled != nil {
+		fields = append(fields, exportjob.FieldIsCancelled)
+	}
+	if m.created_at != nil {
+		fields = append(fields, exportjob.FieldCreatedAt)
+	}
+	if m.updated_at != nil {
+		fields = append(fields, exportjob.FieldUpdatedAt)
+	}
+	if m.cut_id != nil {
+		fields = append(fields, exportjob.FieldCutID)
+	}
+	if m.completed_at != nil {
+		fields = append(fields, exportjob.FieldCompletedAt)
+	}
+	return fields
+}
+
+// Field returns the value of a field with the given name. The second boolean
+// return value indicates that this field was not set, or was not defined in the
+// schema.
+func (m *ExportJobMutation) Field(name string) (ent.Value, bool) {
+	switch name {
+	case exportjob.FieldJobID:
+		return m.JobID()
+	case exportjob.FieldExportType:
+		return m.ExportType()
+	case exportjob.FieldOutputPath:
+		return m.OutputPath()
+	case exportjob.FieldStage:
+		return m.Stage()
+	case exportjob.FieldProgress:
+		return m.Progress()
+	case exportjob.FieldCurrentFile:
+		return m.CurrentFile()
+	case exportjob.FieldTotalFiles:
+		return m.TotalFiles()
+	case exportjob.FieldProcessedFiles:
+		return m.ProcessedFiles()
+	case exportjob.FieldIsComplete:
+		return m.IsComplete()
+	case exportjob.FieldHasError:
+		return m.HasError()
+	case exportjob.FieldErrorMessage:
+		return m.ErrorMessage()
+	case exportjob.FieldIsCancelled:
+		return m.IsCancelled()
+	case exportjob.FieldCreatedAt:
+		return m.CreatedAt()
+	case exportjob.FieldUpdatedAt:
+		return m.UpdatedAt()
+	case exportjob.FieldCutID:
+		return m.CutID()
+	case exportjob.FieldCompletedAt:
+		return m.CompletedAt()
+	}
+	return nil, false
+}
+
+// OldField returns the old value of the field from the database. An error is
+// returned if the mutation operation is not UpdateOne, or the query to the
+// database failed.
+func (m *ExportJobMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
+	switch name {
+	case exportjob.FieldJobID:
+		return m.OldJobID(ctx)
+	case exportjob.FieldExportType:
+		return m.OldExportType(ctx)
+	case exportjob.FieldOutputPath:
+		return m.OldOutputPath(ctx)
+	case exportjob.FieldStage:
+		return m.OldStage(ctx)
+	case exportjob.FieldProgress:
+		return m.OldProgress(ctx)
+	case exportjob.FieldCurrentFile:
+		return m.OldCurrentFile(ctx)
+	case exportjob.FieldTotalFiles:
+		return m.OldTotalFiles(ctx)
+	case exportjob.FieldProcessedFiles:
+		return m.OldProcessedFiles(ctx)
+	case exportjob.FieldIsComplete:
+		return m.OldIsComplete(ctx)
+	case exportjob.FieldHasError:
+		return m.OldHasError(ctx)
+	case exportjob.FieldErrorMessage:
+		return m.OldErrorMessage(ctx)
+	case exportjob.FieldIsCancelled:
+		return m.OldIsCancelled(ctx)
+	case exportjob.FieldCreatedAt:
+		return m.OldCreatedAt(ctx)
+	case exportjob.FieldUpdatedAt:
+		return m.OldUpdatedAt(ctx)
+	case exportjob.FieldCutID:
+		return m.OldCutID(ctx)
+	case exportjob.FieldCompletedAt:
+		return m.OldCompletedAt(ctx)
+	}
+	return nil, fmt.Errorf("unknown ExportJob field %s", name)
+}
+
+// SetField sets the value of a field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *ExportJobMutation) SetField(name string, value ent.Value) error {
+	switch name {
+	case exportjob.FieldJobID:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetJobID(v)
+		return nil
+	case exportjob.FieldExportType:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetExportType(v)
+		return nil
+	case exportjob.FieldOutputPath:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetOutputPath(v)
+		return nil
+	case exportjob.FieldStage:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetStage(v)
+		return nil
+	case exportjob.FieldProgress:
+		v, ok := value.(float64)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetProgress(v)
+		return nil
+	case exportjob.FieldCurrentFile:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetCurrentFile(v)
+		return nil
+	case exportjob.FieldTotalFiles:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetTotalFiles(v)
+		return nil
+	case exportjob.FieldProcessedFiles:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetProcessedFiles(v)
+		return nil
+	case exportjob.FieldIsComplete:
+		v, ok := value.(bool)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetIsComplete(v)
+		return nil
+	case exportjob.FieldHasError:
+		v, ok := value.(bool)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetHasError(v)
+		return nil
+	case exportjob.FieldErrorMessage:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetErrorMessage(v)
+		return nil
+	case exportjob.FieldIsCancelled:
+		v, ok := value.(bool)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetIsCancelled(v)
+		return nil
+	case exportjob.FieldCreatedAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetCreatedAt(v)
+		return nil
+	case exportjob.FieldUpdatedAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetUpdatedAt(v)
+		return nil
+	case exportjob.FieldCutID:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetCutID(v)
+		return nil
+	case exportjob.FieldCompletedAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetCompletedAt(v)
+		return nil
+	}
+	return fmt.Errorf("unknown ExportJob field %s", name)
+}
+
+// AddedFields returns all numeric fields that were incremented/decremented during
+// this mutation.
+func (m *ExportJobMutation) AddedFields() []string {
+	var fields []string
+	if m.addprogress != nil {
+		fields = append(fields, exportjob.FieldProgress)
+	}
+	if m.addtotal_files != nil {
+		fields = append(fields, exportjob.FieldTotalFiles)
+	}
+	if m.addprocessed_files != nil {
+		fields = append(fields, exportjob.FieldProcessedFiles)
+	}
+	if m.addcut_id != nil {
+		fields = append(fields, exportjob.FieldCutID)
+	}
+	return fields
+}
+
+// AddedField returns the numeric value that was incremented/decremented on a field
+// with the given name. The second boolean return value indicates that this field
+// was not set, or was not defined in the schema.
+func (m *ExportJobMutation) AddedField(name string) (ent.Value, bool) {
+	switch name {
+	case exportjob.FieldProgress:
+		return m.AddedProgress()
+	case exportjob.FieldTotalFiles:
+		return m.AddedTotalFiles()
+	case exportjob.FieldProcessedFiles:
+		return m.AddedProcessedFiles()
+	case exportjob.FieldCutID:
+		return m.AddedCutID()
+	}
+	return nil, false
+}
+
+// AddField adds the value to the field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *ExportJobMutation) AddField(name string, value ent.Value) error {
+	switch name {
+	case exportjob.FieldProgress:
+		v, ok := value.(float64)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddProgress(v)
+		return nil
+	case exportjob.FieldTotalFiles:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddTotalFiles(v)
+		return nil
+	case exportjob.FieldProcessedFiles:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddProcessedFiles(v)
+		return nil
+	case exportjob.FieldCutID:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddCutID(v)
+		return nil
+	}
+	return fmt.Errorf("unknown ExportJob numeric field %s", name)
+}
+
+// ClearedFields returns all nullable fields that were cleared during this
+// mutation.
+func (m *ExportJobMutation) ClearedFields() []string {
+	var fields []string
+	if m.FieldCleared(exportjob.FieldCurrentFile) {
+		fields = append(fields, exportjob.FieldCurrentFile)
+	}
+	if m.FieldCleared(exportjob.FieldErrorMessage) {
+		fields = append(fields, exportjob.FieldErrorMessage)
+	}
+	if m.FieldCleared(exportjob.FieldCutID) {
+		fields = append(fields, exportjob.FieldCutID)
+	}
+	if m.FieldCleared(exportjob.FieldCompletedAt) {
+		fields = append(fields, exportjob.FieldCompletedAt)
+	}
+	return fields
+}
+
+// FieldCleared returns a boolean indicating if a field with the given name was
+// cleared in this mutation.
+func (m *ExportJobMutation) FieldCleared(name string) bool {
+	_, ok := m.clearedFields[name]
+	return ok
+}
+
+// ClearField clears the value of the field with the given name. It returns an
+// error if the field is not defined in the schema.
+func (m *ExportJobMutation) ClearField(name string) error {
+	switch name {
+	case exportjob.FieldCurrentFile:
+		m.ClearCurrentFile()
+		return nil
+	case exportjob.FieldErrorMessage:
+		m.ClearErrorMessage()
+		return nil
+	case exportjob.FieldCutID:
+		m.ClearCutID()
+		return nil
+	case exportjob.FieldCompletedAt:
+		m.ClearCompletedAt()
+		return nil
+	}
+	return fmt.Errorf("unknown ExportJob nullable field %s", name)
+}
+
+// ResetField resets all changes in the mutation for the field with the given name.
+// It returns an error if the field is not defined in the schema.
+func (m *ExportJobMutation) ResetField(name string) error {
+	switch name {
+	case exportjob.FieldJobID:
+		m.ResetJobID()
+		return nil
+	case exportjob.FieldExportType:
+		m.ResetExportType()
+		return nil
+	case exportjob.FieldOutputPath:
+		m.ResetOutputPath()
+		return nil
+	case exportjob.FieldStage:
+		m.ResetStage()
+		return nil
+	case exportjob.FieldProgress:
+		m.ResetProgress()
+		return nil
+	case exportjob.FieldCurrentFile:
+		m.ResetCurrentFile()
+		return nil
+	case exportjob.FieldTotalFiles:
+		m.ResetTotalFiles()
+		return nil
+	case exportjob.FieldProcessedFiles:
+		m.ResetProcessedFiles()
+		return nil
+	case exportjob.FieldIsComplete:
+		m.ResetIsComplete()
+		return nil
+	case exportjob.FieldHasError:
+		m.ResetHasError()
+		return nil
+	case exportjob.FieldErrorMessage:
+		m.ResetErrorMessage()
+		return nil
+	case exportjob.FieldIsCancelled:
+		m.ResetIsCancelled()
+		return nil
+	case exportjob.FieldCreatedAt:
+		m.ResetCreatedAt()
+		return nil
+	case exportjob.FieldUpdatedAt:
+		m.ResetUpdatedAt()
+		return nil
+	case exportjob.FieldCutID:
+		m.ResetCutID()
+		return nil
+	case exportjob.FieldCompletedAt:
+		m.ResetCompletedAt()
+		return nil
+	}
+	return fmt.Errorf("unknown ExportJob field %s", name)
+}
+
+// AddedEdges returns all edge names that were set/added in this mutation.
+func (m *ExportJobMutation) AddedEdges() []string {
+	edges := make([]string, 0, 1)
+	if m.project != nil {
+		edges = append(edges, exportjob.EdgeProject)
+	}
+	return edges
+}
+
+// AddedIDs returns all IDs (to other nodes) that were added for the given edge
+// name in this mutation.
+func (m *ExportJobMutation) AddedIDs(name string) []ent.Value {
+	switch name {
+	case exportjob.EdgeProject:
+		if id := m.project; id != nil {
+			return []ent.Value{*id}
+		}
+	}
+	return nil
+}
+
+// RemovedEdges returns all edge names that were removed in this mutation.
+func (m *ExportJobMutation) RemovedEdges() []string {
+	edges := make([]string, 0, 1)
+	return edges
+}
+
+// RemovedIDs returns all IDs (to other nodes) that were removed for the edge with
+// the given name in this mutation.
+func (m *ExportJobMutation) RemovedIDs(name string) []ent.Value {
+	return nil
+}
+
+// ClearedEdges returns all edge names that were cleared in this mutation.
+func (m *ExportJobMutation) ClearedEdges() []string {
+	edges := make([]string, 0, 1)
+	if m.clearedproject {
+		edges = append(edges, exportjob.EdgeProject)
+	}
+	return edges
+}
+
+// EdgeCleared returns a boolean which indicates if the edge with the given name
+// was cleared in this mutation.
+func (m *ExportJobMutation) EdgeCleared(name string) bool {
+	switch name {
+	case exportjob.EdgeProject:
+		return m.clearedproject
+	}
+	return false
+}
+
+// ClearEdge clears the value of the edge with the given name. It returns an error
+// if that edge is not defined in the schema.
+func (m *ExportJobMutation) ClearEdge(name string) error {
+	switch name {
+	case exportjob.EdgeProject:
+		m.ClearProject()
+		return nil
+	}
+	return fmt.Errorf("unknown ExportJob unique edge %s", name)
+}
+
+// ResetEdge resets all changes to the edge with the given name in this mutation.
+// It returns an error if the edge is not defined in the schema.
+func (m *ExportJobMutation) ResetEdge(name string) error {
+	switch name {
+	case exportjob.EdgeProject:
+		m.ResetProject()
+		return nil
+	}
+	return fmt.Errorf("unknown ExportJob edge %s", name)
+}
+
+// ProjectMutation represents an operation that mutates the Project nodes in the graph.
+type ProjectMutation struct {
+	config
+	op                            Op
+	typ                           string
+	id                            *int
+	name                          *string
+	description                   *string
+	_path                         *string
+	created_at                    *time.Time
+	updated_at                    *time.Time
+	ai_model                      *string
+	ai_prompt                     *string
+	ai_suggestion_order           *[]interface{}
+	appendai_suggestion_order     []interface{}
+	ai_suggestion_model           *string
+	ai_suggestion_prompt_id       *int
+	addai_suggestion_prompt_id    *int
+	ai_suggestion_created_at      *time.Time
+	ai_highlight_model            *string
+	ai_highlight_prompt           *string
+	active_tab                    *string
+	ai_silence_improvements       *[]map[string]interface{}
+	appendai_silence_improvements []map[string]interface{}
+	ai_silence_model              *string
+	ai_silence_created_at         *time.Time
+	highlight_order               *[]interface{}
+	appendhighlight_order         []interface{}
+	order_history                 *[][]interface{}
+	appendorder_history           [][]interface{}
+	order_history_index           *int
+	addorder_history_index        *int
+	hidden_highlights             *[]string
+	appendhidden_highlights       []string
+	export_presets                *[]schema.ExportPreset
+	appendexport_presets          []schema.ExportPreset
+	active_cut_id                 *int
+	addactive_cut_id              *int
+	deleted_at                    *time.Time
+	clearedFields                 map[string]struct{}
+	video_clips                   map[int]struct{}
+	removedvideo_clips            map[int]struct{}
+	clearedvideo_clips            bool
+	export_jobs                   map[int]struct{}
+	removedexport_jobs            map[int]struct{}
+	clearedexport_jobs            bool
+	chat_sessions                 map[int]struct{}
+	removedchat_sessions          map[int]struct{}
+	clearedchat_sessions          bool
+	cuts                          map[int]struct{}
+	removedcuts                   map[int]struct{}
+	clearedcuts                   bool
+	prompts                       map[int]struct{}
+	removedprompts                map[int]struct{}
+	clearedprompts                bool
+	tags                          map[int]struct{}
+	removedtags                   map[int]struct{}
+	clearedtags                   bool
+	collections                   map[int]struct{}
+	removedcollections            map[int]struct{}
+	clearedcollections            bool
+	done                          bool
+	oldValue                      func(context.Context) (*Project, error)
+	predicates                    []predicate.Project
+}
+
+var _ ent.Mutation = (*ProjectMutation)(nil)
+
+// projectOption allows management of the mutation configuration using functional options.
+type projectOption func(*ProjectMutation)
+
+// newProjectMutation creates new mutation for the Project entity.
+func newProjectMutation(c config, op Op, opts ...projectOption) *ProjectMutation {
+	m := &ProjectMutation{
+		config:        c,
+		op:            op,
+		typ:           TypeProject,
+		clearedFields: make(map[string]struct{}),
+	}
+	for _, opt := range opts {
+		opt(m)
+	}
+	return m
+}
+
+// withProjectID sets the ID field of the mutation.
+func withProjectID(id int) projectOption {
+	return func(m *ProjectMutation) {
+		var (
+			err   error
+			once  sync.Once
+			value *Project
+		)
+		m.oldValue = func(ctx context.Context) (*Project, error) {
+			once.Do(func() {
+				if m.done {
+					err = errors.New("querying old values post mutation is not allowed")
+				} else {
+					value, err = m.Client().Project.Get(ctx, id)
+				}
+			})
+			return value, err
+		}
+		m.id = &id
+	}
+}
+
+// withProject sets the old Project of the mutation.
+func withProject(node *Project) projectOption {
+	return func(m *ProjectMutation) {
+		m.oldValue = func(context.Context) (*Project, error) {
+			return node, nil
+		}
+		m.id = &node.ID
+	}
+}
+
+// Client returns a new `ent.Client` from the mutation. If the mutation was
+// executed in a transaction (ent.Tx), a transactional client is returned.
+func (m ProjectMutation) Client() *Client {
+	client := &Client{config: m.config}
+	client.init()
+	return client
+}
+
+// Tx returns an `ent.Tx` for mutations that were executed in transactions;
+// it returns an error otherwise.
+func (m ProjectMutation) Tx() (*Tx, error) {
+	if _, ok := m.driver.(*txDriver); !ok {
+		return nil, errors.New("ent: mutation is not running in a transaction")
+	}
+	tx := &Tx{config: m.config}
+	tx.init()
+	return tx, nil
+}
+
+// ID returns the ID value in the mutation. Note that the ID is only available
+// if it was provided to the builder or after it was returned from the database.
+func (m *ProjectMutation) ID() (id int, exists bool) {
+	if m.id == nil {
+		return
+	}
+	return *m.id, true
+}
+
+// IDs queries the database and returns the entity ids that match the mutation's predicate.
+// That means, if the mutation is applied within a transaction with an isolation level such
+// as sql.LevelSerializable, the returned ids match the ids of the rows that will be updated
+// or updated by the mutation.
+func (m *ProjectMutation) IDs(ctx context.Context) ([]int, error) {
+	switch {
+	case m.op.Is(OpUpdateOne | OpDeleteOne):
+		id, exists := m.ID()
+		if exists {
+			return []int{id}, nil
+		}
+		fallthrough
+	case m.op.Is(OpUpdate | OpDelete):
+		return m.Client().Project.Query().Where(m.predicates...).IDs(ctx)
+	default:
+		return nil, fmt.Errorf("IDs is not allowed on %s operations", m.op)
+	}
+}
+
+// SetName sets the "name" field.
+func (m *ProjectMutation) SetName(s string) {
+	m.name = &s
+}
+
+// Name returns the value of the "name" field in the mutation.
+func (m *ProjectMutation) Name() (r string, exists bool) {
+	v := m.name
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldName returns the old "name" field's value of the Project entity.
+// If the Project object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *ProjectMutation) OldName(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldName is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldName requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldName: %w", err)
+	}
+	return oldValue.Name, nil
+}
+
+// ResetName resets all changes to the "name" field.
+func (m *ProjectMutation) ResetName() {
+	m.name = nil
+}
+
+// SetDescription sets the "description" field.
+func (m *ProjectMutation) SetDescription(s string) {
+	m.description = &s
+}
+
+// Description returns the value of the "description" field in the mutation.
+func (m *ProjectMutation) Description() (r string, exists bool) {
+	v := m.description
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldDescription returns the old "description" field's value of the Project entity.
+// If the Project object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *ProjectMutation) OldDescription(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldDescription is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldDescription requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldDescription: %w", err)
+	}
+	return oldValue.Description, nil
+}
+
+// ClearDescription clears the value of the "description" field.
+func (m *ProjectMutation) ClearDescription() {
+	m.description = nil
+	m.clearedFields[project.FieldDescription] = struct{}{}
+}
+
+// DescriptionCleared returns if the "description" field was cleared in this mutation.
+func (m *ProjectMutation) DescriptionCleared() bool {
+	_, ok := m.clearedFields[project.FieldDescription]
+	return ok
+}
+
+// ResetDescription resets all changes to the "description" field.
+func (m *ProjectMutation) ResetDescription() {
+	m.description = nil
+	delete(m.clearedFields, project.FieldDescription)
+}
+
+// SetPath sets the "path" field.
+func (m *ProjectMutation) SetPath(s string) {
+	m._path = &s
+}
+
+// Path returns the value of the "path" field in the mutation.
+func (m *ProjectMutation) Path() (r string, exists bool) {
+	v := m._path
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldPath returns the old "path" field's value of the Project entity.
+// If the Project object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *ProjectMutation) OldPath(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldPath is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldPath requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldPath: %w", err)
+	}
+	return oldValue.Path, nil
+}
+
+// ResetPath resets all changes to the "path" field.
+func (m *ProjectMutation) ResetPath() {
+	m._path = nil
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (m *ProjectMutation) SetCreatedAt(t time.Time) {
+	m.created_at = &t
+}
+
+// CreatedAt returns the value of the "created_at" field in the mutation.
+func (m *ProjectMutation) CreatedAt() (r time.Time, exists bool) {
+	v := m.created_at
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldCreatedAt returns the old "created_at" field's value of the Project entity.
+// If the Project object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *ProjectMutation) OldCreatedAt(ctx context.Context) (v time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldCreatedAt is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldCreatedAt requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldCreatedAt: %w", err)
+	}
+	return oldValue.CreatedAt, nil
+}
+
+// ResetCreatedAt resets all changes to the "created_at" field.
+func (m *ProjectMutation) ResetCreatedAt() {
+	m.created_at = nil
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (m *ProjectMutation) SetUpdatedAt(t time.Time) {
+	m.updated_at = &t
+}
+
+// UpdatedAt returns the value of the "updated_at" field in the mutation.
+func (m *ProjectMutation) UpdatedAt() (r time.Time, exists bool) {
+	v := m.updated_at
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldUpdatedAt returns the old "updated_at" field's value of the Project entity.
+// If the Project object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *ProjectMutation) OldUpdatedAt(ctx context.Context) (v time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldUpdatedAt is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldUpdatedAt requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldUpdatedAt: %w", err)
+	}
+	return oldValue.UpdatedAt, nil
+}
+
+// ResetUpdatedAt resets all changes to the "updated_at" field.
+func (m *ProjectMutation) ResetUpdatedAt() {
+	m.updated_at = nil
+}
+
+// SetAiModel sets the "ai_model" field.
+func (m *ProjectMutation) SetAiModel(s string) {
+	m.ai_model = &s
+}
+
+// AiModel returns the value of the "ai_model" field in the mutation.
+func (m *ProjectMutation) AiModel() (r string, exists bool) {
+	v := m.ai_model
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldAiModel returns the old "ai_model" field's value of the Project entity.
+// If the Project object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *ProjectMutation) OldAiModel(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldAiModel is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldAiModel requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldAiModel: %w", err)
+	}
+	return oldValue.AiModel, nil
+}
+
+// ClearAiModel clears the value of the "ai_model" field.
+func (m *ProjectMutation) ClearAiModel() {
+	m.ai_model = nil
+	m.clearedFields[project.FieldAiModel] = struct{}{}
+}
+
+// AiModelCleared returns if the "ai_model" field was cleared in this mutation.
+func (m *ProjectMutation) AiModelCleared() bool {
+	_, ok := m.clearedFields[project.FieldAiModel]
+	return ok
+}
+
+// ResetAiModel resets all changes to the "ai_model" field.
+func (m *ProjectMutation) ResetAiModel() {
+	m.ai_model = nil
+	delete(m.clearedFields, project.FieldAiModel)
+}
+
+// SetAiPrompt sets the "ai_prompt" field.
+func (m *ProjectMutation) SetAiPrompt(s string) {
+	m.ai_prompt = &s
+}
+
+// AiPrompt returns the value of the "ai_prompt" field in the mutation.
+func (m *ProjectMutation) AiPrompt() (r string, exists bool) {
+	v := m.ai_prompt
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldAiPrompt returns the old "ai_prompt" field's value of the Project entity.
+// If the Project object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *ProjectMutation) OldAiPrompt(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldAiPrompt is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldAiPrompt requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldAiPrompt: %w", err)
+	}
+	return oldValue.AiPrompt, nil
+}
+
+// ClearAiPrompt clears the value of the "ai_prompt" field.
+func (m *ProjectMutation) ClearAiPrompt() {
+	m.ai_prompt = nil
+	m.clearedFields[project.FieldAiPrompt] = struct{}{}
+}
+
+// AiPromptCleared returns if the "ai_prompt" field was cleared in this mutation.
+func (m *ProjectMutation) AiPromptCleared() bool {
+	_, ok := m.clearedFields[project.FieldAiPrompt]
+	return ok
+}
+
+// ResetAiPrompt resets all changes to the "ai_prompt" field.
+func (m *ProjectMutation) ResetAiPrompt() {
+	m.ai_prompt = nil
+	delete(m.clearedFields, project.FieldAiPrompt)
+}
+
+// SetAiSuggestionOrder sets the "ai_suggestion_order" field.
+func (m *ProjectMutation) SetAiSuggestionOrder(i []interface{}) {
+	m.ai_suggestion_order = &i
+	m.appendai_suggestion_order = nil
+}
+
+// AiSuggestionOrder returns the value of the "ai_suggestion_order" field in the mutation.
+func (m *ProjectMutation) AiSuggestionOrder() (r []interface{}, exists bool) {
+	v := m.ai_suggestion_order
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldAiSuggestionOrder returns the old "ai_suggestion_order" field's value of the Project entity.
+// If the Project object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *ProjectMutation) OldAiSuggestionOrder(ctx context.Context) (v []interface{}, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldAiSuggestionOrder is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldAiSuggestionOrder requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldAiSuggestionOrder: %w", err)
+	}
+	return oldValue.AiSuggestionOrder, nil
+}
+
+// AppendAiSuggestionOrder adds i to the "ai_suggestion_order" field.
+func (m *ProjectMutation) AppendAiSuggestionOrder(i []interface{}) {
+	m.appendai_suggestion_order = append(m.appendai_suggestion_order, i...)
+}
+
+// AppendedAiSuggestionOrder returns the list of values that were appended to the "ai_suggestion_order" field in this mutation.
+func (m *ProjectMutation) AppendedAiSuggestionOrder() ([]interface{}, bool) {
+	if len(m.appendai_suggestion_order) == 0 {
+		return nil, false
+	}
+	return m.appendai_suggestion_order, true
+}
+
+// ClearAiSuggestionOrder clears the value of the "ai_suggestion_order" field.
+func (m *ProjectMutation) ClearAiSuggestionOrder() {
+	m.ai_suggestion_order = nil
+	m.appendai_suggestion_order = nil
+	m.clearedFields[project.FieldAiSuggestionOrder] = struct{}{}
+}
+
+// AiSuggestionOrderCleared returns if the "ai_suggestion_order" field was cleared in this mutation.
+func (m *ProjectMutation) AiSuggestionOrderCleared() bool {
+	_, ok := m.clearedFields[project.FieldAiSuggestionOrder]
+	return ok
+}
+
+// ResetAiSuggestionOrder resets all changes to the "ai_suggestion_order" field.
+func (m *ProjectMutation) ResetAiSuggestionOrder() {
+	m.ai_suggestion_order = nil
+	m.appendai_suggestion_order = nil
+	delete(m.clearedFields, project.FieldAiSuggestionOrder)
+}
+
+// SetAiSuggestionModel sets the "ai_suggestion_model" field.
+func (m *ProjectMutation) SetAiSuggestionModel(s string) {
+	m.ai_suggestion_model = &s
+}
+
+// AiSuggestionModel returns the value of the "ai_suggestion_model" field in the mutation.
+func (m *ProjectMutation) AiSuggestionModel() (r string, exists bool) {
+	v := m.ai_suggestion_model
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldAiSuggestionModel returns the old "ai_suggestion_model" field's value of the Project entity.
+// If the Project object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *ProjectMutation) OldAiSuggestionModel(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldAiSuggestionModel is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldAiSuggestionModel requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldAiSuggestionModel: %w", err)
+	}
+	return oldValue.AiSuggestionModel, nil
+}
+
+// ClearAiSuggestionModel clears the value of the "ai_suggestion_model" field.
+func (m *ProjectMutation) ClearAiSuggestionModel() {
+	m.ai_suggestion_model = nil
+	m.clearedFields[project.FieldAiSuggestionModel] = struct{}{}
+}
+
+// AiSuggestionModelCleared returns if the "ai_suggestion_model" field was cleared in this mutation.
+func (m *ProjectMutation) AiSuggestionModelCleared() bool {
+	_, ok := m.clearedFields[project.FieldAiSuggestionModel]
+	return ok
+}
+
+// ResetAiSuggestionModel resets all changes to the "ai_suggestion_model" field.
+func (m *ProjectMutation) ResetAiSuggestionModel() {
+	m.ai_suggestion_model = nil
+	delete(m.clearedFields, project.FieldAiSuggestionModel)
+}
+
+// SetAiSuggestionPromptID sets the "ai_suggestion_prompt_id" field.
+func (m *ProjectMutation) SetAiSuggestionPromptID(i int) {
+	m.ai_suggestion_prompt_id = &i
+	m.addai_suggestion_prompt_id = nil
+}
+
+// AiSuggestionPromptID returns the value of the "ai_suggestion_prompt_id" field in the mutation.
+func (m *ProjectMutation) AiSuggestionPromptID() (r int, exists bool) {
+	v := m.ai_suggestion_prompt_id
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldAiSuggestionPromptID returns the old "ai_suggestion_prompt_id" field's value of the Project entity.
+// If the Project object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *ProjectMutation) OldAiSuggestionPromptID(ctx context.Context) (v int, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldAiSuggestionPromptID is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldAiSuggestionPromptID requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldAiSuggestionPromptID: %w", err)
+	}
+	return oldValue.AiSuggestionPromptID, nil
+}
+
+// AddAiSuggestionPromptID adds i to the "ai_suggestion_prompt_id" field.
+func (m *ProjectMutation) AddAiSuggestionPromptID(i int) {
+	if m.addai_suggestion_prompt_id != nil {
+		*m.addai_suggestion_prompt_id += i
+	} else {
+		m.addai_suggestion_prompt_id = &i
+	}
+}
+
+// AddedAiSuggestionPromptID returns the value that was added to the "ai_suggestion_prompt_id" field in this mutation.
+func (m *ProjectMutation) AddedAiSuggestionPromptID() (r int, exists bool) {
+	v := m.addai_suggestion_prompt_id
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// ClearAiSuggestionPromptID clears the value of the "ai_suggestion_prompt_id" field.
+func (m *ProjectMutation) ClearAiSuggestionPromptID() {
+	m.ai_suggestion_prompt_id = nil
+	m.addai_suggestion_prompt_id = nil
+	m.clearedFields[project.FieldAiSuggestionPromptID] = struct{}{}
+}
+
+// AiSuggestionPromptIDCleared returns if the "ai_suggestion_prompt_id" field was cleared in this mutation.
+func (m *ProjectMutation) AiSuggestionPromptIDCleared() bool {
+	_, ok := m.clearedFields[project.FieldAiSuggestionPromptID]
+	return ok
+}
+
+// ResetAiSuggestionPromptID resets all changes to the "ai_suggestion_prompt_id" field.
+func (m *ProjectMutation) ResetAiSuggestionPromptID() {
+	m.ai_suggestion_prompt_id = nil
+	m.addai_suggestion_prompt_id = nil
+	delete(m.clearedFields, project.FieldAiSuggestionPromptID)
+}
+
+// SetAiSuggestionCreatedAt sets the "ai_suggestion_created_at" field.
+func (m *ProjectMutation) SetAiSuggestionCreatedAt(t time.Time) {
+	m.ai_suggestion_created_at = &t
+}
+
+// AiSuggestionCreatedAt returns the value of the "ai_suggestion_created_at" field in the mutation.
+func (m *ProjectMutation) AiSuggestionCreatedAt() (r time.Time, exists bool) {
+	v := m.ai_suggestion_created_at
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldAiSuggestionCreatedAt returns the old "ai_suggestion_created_at" field's value of the Project entity.
+// If the Project object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *ProjectMutation) OldAiSuggestionCreatedAt(ctx context.Context) (v time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldAiSuggestionCreatedAt is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldAiSuggestionCreatedAt requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldAiSuggestionCreatedAt: %w", err)
+	}
+	return oldValue.AiSuggestionCreatedAt, nil
+}
+
+// ClearAiSuggestionCreatedAt clears the value of the "ai_suggestion_created_at" field.
+func (m *ProjectMutation) ClearAiSuggestionCreatedAt() {
+	m.ai_suggestion_created_at = nil
+	m.clearedFields[project.FieldAiSuggestionCreatedAt] = struct{}{}
+}
+
+// AiSuggestionCreatedAtCleared returns if the "ai_suggestion_created_at" field was cleared in this mutation.
+func (m *ProjectMutation) AiSuggestionCreatedAtCleared() bool {
+	_, ok := m.clearedFields[project.FieldAiSuggestionCreatedAt]
+	return ok
+}
+
+// ResetAiSuggestionCreatedAt resets all changes to the "ai_suggestion_created_at" field.
+func (m *ProjectMutation) ResetAiSuggestionCreatedAt() {
+	m.ai_suggestion_created_at = nil
+	delete(m.clearedFields, project.FieldAiSuggestionCreatedAt)
+}
+
+// SetAiHighlightModel sets the "ai_highlight_model" field.
+func (m *ProjectMutation) SetAiHighlightModel(s string) {
+	m.ai_highlight_model = &s
+}
+
+// AiHighlightModel returns the value of the "ai_highlight_model" field in the mutation.
+func (m *ProjectMutation) AiHighlightModel() (r string, exists bool) {
+	v := m.ai_highlight_model
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldAiHighlightModel returns the old "ai_highlight_model" field's value of the Project entity.
+// If the Project object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *ProjectMutation) OldAiHighlightModel(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldAiHighlightModel is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldAiHighlightModel requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldAiHighlightModel: %w", err)
+	}
+	return oldValue.AiHighlightModel, nil
+}
+
+// ClearAiHighlightModel clears the value of the "ai_highlight_model" field.
+func (m *ProjectMutation) ClearAiHighlightModel() {
+	m.ai_highlight_model = nil
+	m.clearedFields[project.FieldAiHighlightModel] = struct{}{}
+}
+
+// AiHighlightModelCleared returns if the "ai_highlight_model" field was cleared in this mutation.
+func (m *ProjectMutation) AiHighlightModelCleared() bool {
+	_, ok := m.clearedFields[project.FieldAiHighlightModel]
+	return ok
+}
+
+// ResetAiHighlightModel resets all changes to the "ai_highlight_model" field.
+func (m *ProjectMutation) ResetAiHighlightModel() {
+	m.ai_highlight_model = nil
+	delete(m.clearedFields, project.FieldAiHighlightModel)
+}
+
+// SetAiHighlightPrompt sets the "ai_highlight_prompt" field.
+func (m *ProjectMutation) SetAiHighlightPrompt(s string) {
+	m.ai_highlight_prompt = &s
+}
+
+// AiHighlightPrompt returns the value of the "ai_highlight_prompt" field in the mutation.
+func (m *ProjectMutation) AiHighlightPrompt() (r string, exists bool) {
+	v := m.ai_highlight_prompt
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldAiHighlightPrompt returns the old "ai_highlight_prompt" field's value of the Project entity.
+// If the Project object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *ProjectMutation) OldAiHighlightPrompt(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldAiHighlightPrompt is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldAiHighlightPrompt requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldAiHighlightPrompt: %w", err)
+	}
+	return oldValue.AiHighlightPrompt, nil
+}
+
+// ClearAiHighlightPrompt clears the value of the "ai_highlight_prompt" field.
+func (m *ProjectMutation) ClearAiHighlightPrompt() {
+	m.ai_highlight_prompt = nil
+	m.clearedFields[project.FieldAiHighlightPrompt] = struct{}{}
+}
+
+// AiHighlightPromptCleared returns if the "ai_highlight_prompt" field was cleared in this mutation.
+func (m *ProjectMutation) AiHighlightPromptCleared() bool {
+	_, ok := m.clearedFields[project.FieldAiHighlightPrompt]
+	return ok
+}
+
+// ResetAiHighlightPrompt resets all changes to the "ai_highlight_prompt" field.
+func (m *ProjectMutation) ResetAiHighlightPrompt() {
+	m.ai_highlight_prompt = nil
+	delete(m.clearedFields, project.FieldAiHighlightPrompt)
+}
+
+// SetActiveTab sets the "active_tab" field.
+func (m *ProjectMutation) SetActiveTab(s string) {
+	m.active_tab = &s
+}
+
+// ActiveTab returns the value of the "active_tab" field in the mutation.
+func (m *ProjectMutation) ActiveTab() (r string, exists bool) {
+	v := m.active_tab
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldActiveTab returns the old "active_tab" field's value of the Project entity.
+// If the Project object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *ProjectMutation) OldActiveTab(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldActiveTab is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldActiveTab requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldActiveTab: %w", err)
+	}
+	return oldValue.ActiveTab, nil
+}
+
+// ClearActiveTab clears the value of the "active_tab" field.
+func (m *ProjectMutation) ClearActiveTab() {
+	m.active_tab = nil
+	m.clearedFields[project.FieldActiveTab] = struct{}{}
+}
+
+// ActiveTabCleared returns if the "active_tab" field was cleared in this mutation.
+func (m *ProjectMutation) ActiveTabCleared() bool {
+	_, ok := m.clearedFields[project.FieldActiveTab]
+	return ok
+}
+
+// ResetActiveTab resets all changes to the "active_tab" field.
+func (m *ProjectMutation) ResetActiveTab() {
+	m.active_tab = nil
+	delete(m.clearedFields, project.FieldActiveTab)
+}
+
+// SetAiSilenceImprovements sets the "ai_silence_improvements" field.
+func (m *ProjectMutation) SetAiSilenceImprovements(value []map[string]interface{}) {
+	m.ai_silence_improvements = &value
+	m.appendai_silence_improvements = nil
+}
+
+// AiSilenceImprovements returns the value of the "ai_silence_improvements" field in the mutation.
+func (m *ProjectMutation) AiSilenceImprovements() (r []map[string]interface{}, exists bool) {
+	v := m.ai_silence_improvements
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldAiSilenceImprovements returns the old "ai_silence_improvements" field's value of the Project entity.
+// If the Project object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *ProjectMutation) OldAiSilenceImprovements(ctx context.Context) (v []map[string]interface{}, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldAiSilenceImprovements is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldAiSilenceImprovements requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldAiSilenceImprovements: %w", err)
+	}
+	return oldValue.AiSilenceImprovements, nil
+}
+
+// AppendAiSilenceImprovements adds value to the "ai_silence_improvements" field.
+func (m *ProjectMutation) AppendAiSilenceImprovements(value []map[string]interface{}) {
+	m.appendai_silence_improvements = append(m.appendai_silence_improvements, value...)
+}
+
+// AppendedAiSilenceImprovements returns the list of values that were appended to the "ai_silence_improvements" field in this mutation.
+func (m *ProjectMutation) AppendedAiSilenceImprovements() ([]map[string]interface{}, bool) {
+	if len(m.appendai_silence_improvements) == 0 {
+		return nil, false
+	}
+	return m.appendai_silence_improvements, true
+}
+
+// ClearAiSilenceImprovements clears the value of the "ai_silence_improvements" field.
+func (m *ProjectMutation) ClearAiSilenceImprovements() {
+	m.ai_silence_improvements = nil
+	m.appendai_silence_improvements = nil
+	m.clearedFields[project.FieldAiSilenceImprovements] = struct{}{}
+}
+
+// AiSilenceImprovementsCleared returns if the "ai_silence_improvements" field was cleared in this mutation.
+func (m *ProjectMutation) AiSilenceImprovementsCleared() bool {
+	_, ok := m.clearedFields[project.FieldAiSilenceImprovements]
+	return ok
+}
+
+// ResetAiSilenceImprovements resets all changes to the "ai_silence_improvements" field.
+func (m *ProjectMutation) ResetAiSilenceImprovements() {
+	m.ai_silence_improvements = nil
+	m.appendai_silence_improvements = nil
+	delete(m.clearedFields, project.FieldAiSilenceImprovements)
+}
+
+// SetAiSilenceModel sets the "ai_silence_model" field.
+func (m *ProjectMutation) SetAiSilenceModel(s string) {
+	m.ai_silence_model = &s
+}
+
+// AiSilenceModel returns the value of the "ai_silence_model" field in the mutation.
+func (m *ProjectMutation) AiSilenceModel() (r string, exists bool) {
+	v := m.ai_silence_model
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldAiSilenceModel returns the old "ai_silence_model" field's value of the Project entity.
+// If the Project object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *ProjectMutation) OldAiSilenceModel(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldAiSilenceModel is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldAiSilenceModel requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldAiSilenceModel: %w", err)
+	}
+	return oldValue.AiSilenceModel, nil
+}
+
+// ClearAiSilenceModel clears the value of the "ai_silence_model" field.
+func (m *ProjectMutation) ClearAiSilenceModel() {
+	m.ai_silence_model = nil
+	m.clearedFields[project.FieldAiSilenceModel] = struct{}{}
+}
+
+// AiSilenceModelCleared returns if the "ai_silence_model" field was cleared in this mutation.
+func (m *ProjectMutation) AiSilenceModelCleared() bool {
+	_, ok := m.clearedFields[project.FieldAiSilenceModel]
+	return ok
+}
+
+// ResetAiSilenceModel resets all changes to the "ai_silence_model" field.
+func (m *ProjectMutation) ResetAiSilenceModel() {
+	m.ai_silence_model = nil
+	delete(m.clearedFields, project.FieldAiSilenceModel)
+}
+
+// SetAiSilenceCreatedAt sets the "ai_silence_created_at" field.
+func (m *ProjectMutation) SetAiSilenceCreatedAt(t time.Time) {
+	m.ai_silence_created_at = &t
+}
+
+// AiSilenceCreatedAt returns the value of the "ai_silence_created_at" field in the mutation.
+func (m *ProjectMutation) AiSilenceCreatedAt() (r time.Time, exists bool) {
+	v := m.ai_silence_created_at
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldAiSilenceCreatedAt returns the old "ai_silence_created_at" field's value of the Project entity.
+// If the Project object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *ProjectMutation) OldAiSilenceCreatedAt(ctx context.Context) (v time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldAiSilenceCreatedAt is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldAiSilenceCreatedAt requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldAiSilenceCreatedAt: %w", err)
+	}
+	return oldValue.AiSilenceCreatedAt, nil
+}
+
+// ClearAiSilenceCreatedAt clears the value of the "ai_silence_created_at" field.
+func (m *ProjectMutation) ClearAiSilenceCreatedAt() {
+	m.ai_silence_created_at = nil
+	m.clearedFields[project.FieldAiSilenceCreatedAt] = struct{}{}
+}
+
+// AiSilenceCreatedAtCleared returns if the "ai_silence_created_at" field was cleared in this mutation.
+func (m *ProjectMutation) AiSilenceCreatedAtCleared() bool {
+	_, ok := m.clearedFields[project.FieldAiSilenceCreatedAt]
+	return ok
+}
+
+// ResetAiSilenceCreatedAt resets all changes to the "ai_silence_created_at" field.
+func (m *ProjectMutation) ResetAiSilenceCreatedAt() {
+	m.ai_silence_created_at = nil
+	delete(m.clearedFields, project.FieldAiSilenceCreatedAt)
+}
+
+// SetHighlightOrder sets the "highlight_order" field.
+func (m *ProjectMutation) SetHighlightOrder(i []interface{}) {
+	m.highlight_order = &i
+	m.appendhighlight_order = nil
+}
+
+// HighlightOrder returns the value of the "highlight_order" field in the mutation.
+func (m *ProjectMutation) HighlightOrder() (r []interface{}, exists bool) {
+	v := m.highlight_order
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldHighlightOrder returns the old "highlight_order" field's value of the Project entity.
+// If the Project object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *ProjectMutation) OldHighlightOrder(ctx context.Context) (v []interface{}, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldHighlightOrder is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldHighlightOrder requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldHighlightOrder: %w", err)
+	}
+	return oldValue.HighlightOrder, nil
+}
+
+// AppendHighlightOrder adds i to the "highlight_order" field.
+func (m *ProjectMutation) AppendHighlightOrder(i []interface{}) {
+	m.appendhighlight_order = append(m.appendhighlight_order, i...)
+}
+
+// AppendedHighlightOrder returns the list of values that were appended to the "highlight_order" field in this mutation.
+func (m *ProjectMutation) AppendedHighlightOrder() ([]interface{}, bool) {
+	if len(m.appendhighlight_order) == 0 {
+		return nil, false
+	}
+	return m.appendhighlight_order, true
+}
+
+// ClearHighlightOrder clears the value of the "highlight_order" field.
+func (m *ProjectMutation) ClearHighlightOrder() {
+	m.highlight_order = nil
+	m.appendhighlight_order = nil
+	m.clearedFields[project.FieldHighlightOrder] = struct{}{}
+}
+
+// HighlightOrderCleared returns if the "highlight_order" field was cleared in this mutation.
+func (m *ProjectMutation) HighlightOrderCleared() bool {
+	_, ok := m.clearedFields[project.FieldHighlightOrder]
+	return ok
+}
+
+// ResetHighlightOrder resets all changes to the "highlight_order" field.
+func (m *ProjectMutation) ResetHighlightOrder() {
+	m.highlight_order = nil
+	m.appendhighlight_order = nil
+	delete(m.clearedFields, project.FieldHighlightOrder)
+}
+
+// SetOrderHistory sets the "order_history" field.
+func (m *ProjectMutation) SetOrderHistory(i [][]interface{}) {
+	m.order_history = &i
+	m.appendorder_history = nil
+}
+
+// OrderHistory returns the value of the "order_history" field in the mutation.
+func (m *ProjectMutation) OrderHistory() (r [][]interface{}, exists bool) {
+	v := m.order_history
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldOrderHistory returns the old "order_history" field's value of the Project entity.
+// If the Project object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *ProjectMutation) OldOrderHistory(ctx context.Context) (v [][]interface{}, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldOrderHistory is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldOrderHistory requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldOrderHistory: %w", err)
+	}
+	return oldValue.OrderHistory, nil
+}
+
+// AppendOrderHistory adds i to the "order_history" field.
+func (m *ProjectMutation) AppendOrderHistory(i [][]interface{}) {
+	m.appendorder_history = append(m.appendorder_history, i...)
+}
+
+// AppendedOrderHistory returns the list of values that were appended to the "order_history" field in this mutation.
+func (m *ProjectMutation) AppendedOrderHistory() ([][]interface{}, bool) {
+	if len(m.appendorder_history) == 0 {
+		return nil, false
+	}
+	return m.appendorder_history, true
+}
+
+// ClearOrderHistory clears the value of the "order_history" field.
+func (m *ProjectMutation) ClearOrderHistory() {
+	m.order_history = nil
+	m.appendorder_history = nil
+	m.clearedFields[project.FieldOrderHistory] = struct{}{}
+}
+
+// OrderHistoryCleared returns if the "order_history" field was cleared in this mutation.
+func (m *ProjectMutation) OrderHistoryCleared() bool {
+	_, ok := m.clearedFields[project.FieldOrderHistory]
+	return ok
+}
+
+// ResetOrderHistory resets all changes to the "order_history" field.
+func (m *ProjectMutation) ResetOrderHistory() {
+	m.order_history = nil
+	m.appendorder_history = nil
+	delete(m.clearedFields, project.FieldOrderHistory)
+}
+
+// SetOrderHistoryIndex sets the "order_history_index" field.
+func (m *ProjectMutation) SetOrderHistoryIndex(i int) {
+	m.order_history_index = &i
+	m.addorder_history_index = nil
+}
+
+// OrderHistoryIndex returns the value of the "order_history_index" field in the mutation.
+func (m *ProjectMutation) OrderHistoryIndex() (r int, exists bool) {
+	v := m.order_history_index
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldOrderHistoryIndex returns the old "order_history_index" field's value of the Project entity.
+// If the Project object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *ProjectMutation) OldOrderHistoryIndex(ctx context.Context) (v int, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldOrderHistoryIndex is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldOrderHistoryIndex requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldOrderHistoryIndex: %w", err)
+	}
+	return oldValue.OrderHistoryIndex, nil
+}
+
+// AddOrderHistoryIndex adds i to the "order_history_index" field.
+func (m *ProjectMutation) AddOrderHistoryIndex(i int) {
+	if m.addorder_history_index != nil {
+		*m.addorder_history_index += i
+	} else {
+		m.addorder_history_index = &i
+	}
+}
+
+// AddedOrderHistoryIndex returns the value that was added to the "order_history_index" field in this mutation.
+func (m *ProjectMutation) AddedOrderHistoryIndex() (r int, exists bool) {
+	v := m.addorder_history_index
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// ClearOrderHistoryIndex clears the value of the "order_history_index" field.
+func (m *ProjectMutation) ClearOrderHistoryIndex() {
+	m.order_history_index = nil
+	m.addorder_history_index = nil
+	m.clearedFields[project.FieldOrderHistoryIndex] = struct{}{}
+}
+
+// OrderHistoryIndexCleared returns if the "order_history_index" field was cleared in this mutation.
+func (m *ProjectMutation) OrderHistoryIndexCleared() bool {
+	_, ok := m.clearedFields[project.FieldOrderHistoryIndex]
+	return ok
+}
+
+// ResetOrderHistoryIndex resets all changes to the "order_history_index" field.
+func (m *ProjectMutation) ResetOrderHistoryIndex() {
+	m.order_history_index = nil
+	m.addorder_history_index = nil
+	delete(m.clearedFields, project.FieldOrderHistoryIndex)
+}
+
+// SetHiddenHighlights sets the "hidden_highlights" field.
+func (m *ProjectMutation) SetHiddenHighlights(s []string) {
+	m.hidden_highlights = &s
+	m.appendhidden_highlights = nil
+}
+
+// HiddenHighlights returns the value of the "hidden_highlights" field in the mutation.
+func (m *ProjectMutation) HiddenHighlights() (r []string, exists bool) {
+	v := m.hidden_highlights
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldHiddenHighlights returns the old "hidden_highlights" field's value of the Project entity.
+// If the Project object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *ProjectMutation) OldHiddenHighlights(ctx context.Context) (v []string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldHiddenHighlights is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldHiddenHighlights requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldHiddenHighlights: %w", err)
+	}
+	return oldValue.HiddenHighlights, nil
+}
+
+// AppendHiddenHighlights adds s to the "hidden_highlights" field.
+func (m *ProjectMutation) AppendHiddenHighlights(s []string) {
+	m.appendhidden_highlights = append(m.appendhidden_highlights, s...)
+}
+
+// AppendedHiddenHighlights returns the list of values that were appended to the "hidden_highlights" field in this mutation.
+func (m *ProjectMutation) AppendedHiddenHighlights() ([]string, bool) {
+	if len(m.appendhidden_highlights) == 0 {
+		return nil, false
+	}
+	return m.appendhidden_highlights, true
+}
+
+// ClearHiddenHighlights clears the value of the "hidden_highlights" field.
+func (m *ProjectMutation) ClearHiddenHighlights() {
+	m.hidden_highlights = nil
+	m.appendhidden_highlights = nil
+	m.clearedFields[project.FieldHiddenHighlights] = struct{}{}
+}
+
+// HiddenHighlightsCleared returns if the "hidden_highlights" field was cleared in this mutation.
+func (m *ProjectMutation) HiddenHighlightsCleared() bool {
+	_, ok := m.clearedFields[project.FieldHiddenHighlights]
+	return ok
+}
+
+// ResetHiddenHighlights resets all changes to the "hidden_highlights" field.
+func (m *ProjectMutation) ResetHiddenHighlights() {
+	m.hidden_highlights = nil
+	m.appendhidden_highlights = nil
+	delete(m.clearedFields, project.FieldHiddenHighlights)
+}
+
+// SetExportPresets sets the "export_presets" field.
+func (m *ProjectMutation) SetExportPresets(sp []schema.ExportPreset) {
+	m.export_presets = &sp
+	m.appendexport_presets = nil
+}
+
+// ExportPresets returns the value of the "export_presets" field in the mutation.
+func (m *ProjectMutation) ExportPresets() (r []schema.ExportPreset, exists bool) {
+	v := m.export_presets
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldExportPresets returns the old "export_presets" field's value of the Project entity.
+// If the Project object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *ProjectMutation) OldExportPresets(ctx context.Context) (v []schema.ExportPreset, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldExportPresets is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldExportPresets requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldExportPresets: %w", err)
+	}
+	return oldValue.ExportPresets, nil
+}
+
+// AppendExportPresets adds sp to the "export_presets" field.
+func (m *ProjectMutation) AppendExportPresets(sp []schema.ExportPreset) {
+	m.appendexport_presets = append(m.appendexport_presets, sp...)
+}
+
+// AppendedExportPresets returns the list of values that were appended to the "export_presets" field in this mutation.
+func (m *ProjectMutation) AppendedExportPresets() ([]schema.ExportPreset, bool) {
+	if len(m.appendexport_presets) == 0 {
+		return nil, false
+	}
+	return m.appendexport_presets, true
+}
+
+// ClearExportPresets clears the value of the "export_presets" field.
+func (m *ProjectMutation) ClearExportPresets() {
+	m.export_presets = nil
+	m.appendexport_presets = nil
+	m.clearedFields[project.FieldExportPresets] = struct{}{}
+}
+
+// ExportPresetsCleared returns if the "export_presets" field was cleared in this mutation.
+func (m *ProjectMutation) ExportPresetsCleared() bool {
+	_, ok := m.clearedFields[project.FieldExportPresets]
+	return ok
+}
+
+// ResetExportPresets resets all changes to the "export_presets" field.
+func (m *ProjectMutation) ResetExportPresets() {
+	m.export_presets = nil
+	m.appendexport_presets = nil
+	delete(m.clearedFields, project.FieldExportPresets)
+}
+
+// SetActiveCutID sets the "active_cut_id" field.
+func (m *ProjectMutation) SetActiveCutID(i int) {
+	m.active_cut_id = &i
+	m.addactive_cut_id = nil
+}
+
+// ActiveCutID returns the value of the "active_cut_id" field in the mutation.
+func (m *ProjectMutation) ActiveCutID() (r int, exists bool) {
+	v := m.active_cut_id
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldActiveCutID returns the old "active_cut_id" field's value of the Project entity.
+// If the Project object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *ProjectMutation) OldActiveCutID(ctx context.Context) (v int, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldActiveCutID is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldActiveCutID requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldActiveCutID: %w", err)
+	}
+	return oldValue.ActiveCutID, nil
+}
+
+// AddActiveCutID adds i to the "active_cut_id" field.
+func (m *ProjectMutation) AddActiveCutID(i int) {
+	if m.addactive_cut_id != nil {
+		*m.addactive_cut_id += i
+	} else {
+		m.addactive_cut_id = &i
+	}
+}
+
+// AddedActiveCutID returns the value that was added to the "active_cut_id" field in this mutation.
+func (m *ProjectMutation) AddedActiveCutID() (r int, exists bool) {
+	v := m.addactive_cut_id
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// ClearActiveCutID clears the value of the "active_cut_id" field.
+func (m *ProjectMutation) ClearActiveCutID() {
+	m.active_cut_id = nil
+	m.addactive_cut_id = nil
+	m.clearedFields[project.FieldActiveCutID] = struct{}{}
+}
+
+// ActiveCutIDCleared returns if the "active_cut_id" field was cleared in this mutation.
+func (m *ProjectMutation) ActiveCutIDCleared() bool {
+	_, ok := m.clearedFields[project.FieldActiveCutID]
+	return ok
+}
+
+// ResetActiveCutID resets all changes to the "active_cut_id" field.
+func (m *ProjectMutation) ResetActiveCutID() {
+	m.active_cut_id = nil
+	m.addactive_cut_id = nil
+	delete(m.clearedFields, project.FieldActiveCutID)
+}
+
+// SetDeletedAt sets the "deleted_at" field.
+func (m *ProjectMutation) SetDeletedAt(t time.Time) {
+	m.deleted_at = &t
+}
+
+// DeletedAt returns the value of the "deleted_at" field in the mutation.
+func (m *ProjectMutation) DeletedAt() (r time.Time, exists bool) {
+	v := m.deleted_at
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldDeletedAt returns the old "deleted_at" field's value of the Project entity.
+// If the Project object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *ProjectMutation) OldDeletedAt(ctx context.Context) (v time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldDeletedAt is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldDeletedAt requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldDeletedAt: %w", err)
+	}
+	return oldValue.DeletedAt, nil
+}
+
+// ClearDeletedAt clears the value of the "deleted_at" field.
+func (m *ProjectMutation) ClearDeletedAt() {
+	m.deleted_at = nil
+	m.clearedFields[project.FieldDeletedAt] = struct{}{}
+}
+
+// DeletedAtCleared returns if the "deleted_at" field was cleared in this mutation.
+func (m *ProjectMutation) DeletedAtCleared() bool {
+	_, ok := m.clearedFields[project.FieldDeletedAt]
+	return ok
+}
+
+// ResetDeletedAt resets all changes to the "deleted_at" field.
+func (m *ProjectMutation) ResetDeletedAt() {
+	m.deleted_at = nil
+	delete(m.clearedFields, project.FieldDeletedAt)
+}
+
+// AddVideoClipIDs adds the "video_clips" edge to the VideoClip entity by ids.
+func (m *ProjectMutation) AddVideoClipIDs(ids ...int) {
+	if m.video_clips == nil {
+		m.video_clips = make(map[int]struct{})
+	}
+	for i := range ids {
+		m.video_clips[ids[i]] = struct{}{}
+	}
+}
+
+// ClearVideoClips clears the "video_clips" edge to the VideoClip entity.
+func (m *ProjectMutation) ClearVideoClips() {
+	m.clearedvideo_clips = true
+}
+
+// VideoClipsCleared reports if the "video_clips" edge to the VideoClip entity was cleared.
+func (m *ProjectMutation) VideoClipsCleared() bool {
+	return m.clearedvideo_clips
+}
+
+// RemoveVideoClipIDs removes the "video_clips" edge to the VideoClip entity by IDs.
+func (m *ProjectMutation) RemoveVideoClipIDs(ids ...int) {
+	if m.removedvideo_clips == nil {
+		m.removedvideo_clips = make(map[int]struct{})
+	}
+	for i := range ids {
+		delete(m.video_clips, ids[i])
+		m.removedvideo_clips[ids[i]] = struct{}{}
+	}
+}
+
+// RemovedVideoClips returns the removed IDs of the "video_clips" edge to the VideoClip entity.
+func (m *ProjectMutation) RemovedVideoClipsIDs() (ids []int) {
+	for id := range m.removedvideo_clips {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// VideoClipsIDs returns the "video_clips" edge IDs in the mutation.
+func (m *ProjectMutation) VideoClipsIDs() (ids []int) {
+	for id := range m.video_clips {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// ResetVideoClips resets all changes to the "video_clips" edge.
+func (m *ProjectMutation) ResetVideoClips() {
+	m.video_clips = nil
+	m.clearedvideo_clips = false
+	m.removedvideo_clips = nil
+}
+
+// AddExportJobIDs adds the "export_jobs" edge to the ExportJob entity by ids.
+func (m *ProjectMutation) AddExportJobIDs(ids ...int) {
+	if m.export_jobs == nil {
+		m.export_jobs = make(map[int]struct{})
+	}
+	for i := range ids {
+		m.export_jobs[ids[i]] = struct{}{}
+	}
+}
+
+// ClearExportJobs clears the "export_jobs" edge to the ExportJob entity.
+func (m *ProjectMutation) ClearExportJobs() {
+	m.clearedexport_jobs = true
+}
+
+// ExportJobsCleared reports if the "export_jobs" edge to the ExportJob entity was cleared.
+func (m *ProjectMutation) ExportJobsCleared() bool {
+	return m.clearedexport_jobs
+}
+
+// RemoveExportJobIDs removes the "export_jobs" edge to the ExportJob entity by IDs.
+func (m *ProjectMutation) RemoveExportJobIDs(ids ...int) {
+	if m.removedexport_jobs == nil {
+		m.removedexport_jobs = make(map[int]struct{})
+	}
+	for i := range ids {
+		delete(m.export_jobs, ids[i])
+		m.removedexport_jobs[ids[i]] = struct{}{}
+	}
+}
+
+// RemovedExportJobs returns the removed IDs of the "export_jobs" edge to the ExportJob entity.
+func (m *ProjectMutation) RemovedExportJobsIDs() (ids []int) {
+	for id := range m.removedexport_jobs {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// ExportJobsIDs returns the "export_jobs" edge IDs in the mutation.
+func (m *ProjectMutation) ExportJobsIDs() (ids []int) {
+	for id := range m.export_jobs {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// ResetExportJobs resets all changes to the "export_jobs" edge.
+func (m *ProjectMutation) ResetExportJobs() {
+	m.export_jobs = nil
+	m.clearedexport_jobs = false
+	m.removedexport_jobs = nil
+}
+
+// AddChatSessionIDs adds the "chat_sessions" edge to the ChatSession entity by ids.
+func (m *ProjectMutation) AddChatSessionIDs(ids ...int) {
+	if m.chat_sessions == nil {
+		m.chat_sessions = make(map[int]struct{})
+	}
+	for i := range ids {
+		m.chat_sessions[ids[i]] = struct{}{}
+	}
+}
+
+// ClearChatSessions clears the "chat_sessions" edge to the ChatSession entity.
+func (m *ProjectMutation) ClearChatSessions() {
+	m.clearedchat_sessions = true
+}
+
+// ChatSessionsCleared reports if the "chat_sessions" edge to the ChatSession entity was cleared.
+func (m *ProjectMutation) ChatSessionsCleared() bool {
+	return m.clearedchat_sessions
+}
+
+// RemoveChatSessionIDs removes the "chat_sessions" edge to the ChatSession entity by IDs.
+func (m *ProjectMutation) RemoveChatSessionIDs(ids ...int) {
+	if m.removedchat_sessions == nil {
+		m.removedchat_sessions = make(map[int]struct{})
+	}
+	for i := range ids {
+		delete(m.chat_sessions, ids[i])
+		m.removedchat_sessions[ids[i]] = struct{}{}
+	}
+}
+
+// RemovedChatSessions returns the removed IDs of the "chat_sessions" edge to the ChatSession entity.
+func (m *ProjectMutation) RemovedChatSessionsIDs() (ids []int) {
+	for id := range m.removedchat_sessions {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// ChatSessionsIDs returns the "chat_sessions" edge IDs in the mutation.
+func (m *ProjectMutation) ChatSessionsIDs() (ids []int) {
+	for id := range m.chat_sessions {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// ResetChatSessions resets all changes to the "chat_sessions" edge.
+func (m *ProjectMutation) ResetChatSessions() {
+	m.chat_sessions = nil
+	m.clearedchat_sessions = false
+	m.removedchat_sessions = nil
+}
+
+// AddCutIDs adds the "cuts" edge to the Cut entity by ids.
+func (m *ProjectMutation) AddCutIDs(ids ...int) {
+	if m.cuts == nil {
+		m.cuts = make(map[int]struct{})
+	}
+	for i := range ids {
+		m.cuts[ids[i]] = struct{}{}
+	}
+}
+
+// ClearCuts clears the "cuts" edge to the Cut entity.
+func (m *ProjectMutation) ClearCuts() {
+	m.clearedcuts = true
+}
+
+// CutsCleared reports if the "cuts" edge to the Cut entity was cleared.
+func (m *ProjectMutation) CutsCleared() bool {
+	return m.clearedcuts
+}
+
+// RemoveCutIDs removes the "cuts" edge to the Cut entity by IDs.
+func (m *ProjectMutation) RemoveCutIDs(ids ...int) {
+	if m.removedcuts == nil {
+		m.removedcuts = make(map[int]struct{})
+	}
+	for i := range ids {
+		delete(m.cuts, ids[i])
+		m.removedcuts[ids[i]] = struct{}{}
+	}
+}
+
+// RemovedCuts returns the removed IDs of the "cuts" edge to the Cut entity.
+func (m *ProjectMutation) RemovedCutsIDs() (ids []int) {
+	for id := range m.removedcuts {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// CutsIDs returns the "cuts" edge IDs in the mutation.
+func (m *ProjectMutation) CutsIDs() (ids []int) {
+	for id := range m.cuts {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// ResetCuts resets all changes to the "cuts" edge.
+func (m *ProjectMutation) ResetCuts() {
+	m.cuts = nil
+	m.clearedcuts = false
+	m.removedcuts = nil
+}
+
+// AddPromptIDs adds the "prompts" edge to the Prompt entity by ids.
+func (m *ProjectMutation) AddPromptIDs(ids ...int) {
+	if m.prompts == nil {
+		m.prompts = make(map[int]struct{})
+	}
+	for i := range ids {
+		m.prompts[ids[i]] = struct{}{}
+	}
+}
+
+// ClearPrompts clears the "prompts" edge to the Prompt entity.
+func (m *ProjectMutation) ClearPrompts() {
+	m.clearedprompts = true
+}
+
+// PromptsCleared reports if the "prompts" edge to the Prompt entity was cleared.
+func (m *ProjectMutation) PromptsCleared() bool {
+	return m.clearedprompts
+}
+
+// RemovePromptIDs removes the "prompts" edge to the Prompt entity by IDs.
+func (m *ProjectMutation) RemovePromptIDs(ids ...int) {
+	if m.removedprompts == nil {
+		m.removedprompts = make(map[int]struct{})
+	}
+	for i := range ids {
+		delete(m.prompts, ids[i])
+		m.removedprompts[ids[i]] = struct{}{}
+	}
+}
+
+// RemovedPrompts returns the removed IDs of the "prompts" edge to the Prompt entity.
+func (m *ProjectMutation) RemovedPromptsIDs() (ids []int) {
+	for id := range m.removedprompts {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// PromptsIDs returns the "prompts" edge IDs in the mutation.
+func (m *ProjectMutation) PromptsIDs() (ids []int) {
+	for id := range m.prompts {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// ResetPrompts resets all changes to the "prompts" edge.
+func (m *ProjectMutation) ResetPrompts() {
+	m.prompts = nil
+	m.clearedprompts = false
+	m.removedprompts = nil
+}
+
+// AddTagIDs adds the "tags" edge to the Tag entity by ids.
+func (m *ProjectMutation) AddTagIDs(ids ...int) {
+	if m.tags == nil {
+		m.tags = make(map[int]struct{})
+	}
+	for i := range ids {
+		m.tags[ids[i]] = struct{}{}
+	}
+}
+
+// ClearTags clears the "tags" edge to the Tag entity.
+func (m *ProjectMutation) ClearTags() {
+	m.clearedtags = true
+}
+
+// TagsCleared reports if the "tags" edge to the Tag entity was cleared.
+func (m *ProjectMutation) TagsCleared() bool {
+	return m.clearedtags
+}
+
+// RemoveTagIDs removes the "tags" edge to the Tag entity by IDs.
+func (m *ProjectMutation) RemoveTagIDs(ids ...int) {
+	if m.removedtags == nil {
+		m.removedtags = make(map[int]struct{})
+	}
+	for i := range ids {
+		delete(m.tags, ids[i])
+		m.removedtags[ids[i]] = struct{}{}
+	}
+}
+
+// RemovedTags returns the removed IDs of the "tags" edge to the Tag entity.
+func (m *ProjectMutation) RemovedTagsIDs() (ids []int) {
+	for id := range m.removedtags {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// TagsIDs returns the "tags" edge IDs in the mutation.
+func (m *ProjectMutation) TagsIDs() (ids []int) {
+	for id := range m.tags {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// ResetTags resets all changes to the "tags" edge.
+func (m *ProjectMutation) ResetTags() {
+	m.tags = nil
+	m.clearedtags = false
+	m.removedtags = nil
+}
+
+// AddCollectionIDs adds the "collections" edge to the Collection entity by ids.
+func (m *ProjectMutation) AddCollectionIDs(ids ...int) {
+	if m.collections == nil {
+		m.collections = make(map[int]struct{})
+	}
+	for i := range ids {
+		m.collections[ids[i]] = struct{}{}
+	}
+}
+
+// ClearCollections clears the "collections" edge to the Collection entity.
+func (m *ProjectMutation) ClearCollections() {
+	m.clearedcollections = true
+}
+
+// CollectionsCleared reports if the "collections" edge to the Collection entity was cleared.
+func (m *ProjectMutation) CollectionsCleared() bool {
+	return m.clearedcollections
+}
+
+// RemoveCollectionIDs removes the "collections" edge to the Collection entity by IDs.
+func (m *ProjectMutation) RemoveCollectionIDs(ids ...int) {
+	if m.removedcollections == nil {
+		m.removedcollections = make(map[int]struct{})
+	}
+	for i := range ids {
+		delete(m.collections, ids[i])
+		m.removedcollections[ids[i]] = struct{}{}
+	}
+}
+
+// RemovedCollections returns the removed IDs of the "collections" edge to the Collection entity.
+func (m *ProjectMutation) RemovedCollectionsIDs() (ids []int) {
+	for id := range m.removedcollections {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// CollectionsIDs returns the "collections" edge IDs in the mutation.
+func (m *ProjectMutation) CollectionsIDs() (ids []int) {
+	for id := range m.collections {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// ResetCollections resets all changes to the "collections" edge.
+func (m *ProjectMutation) ResetCollections() {
+	m.collections = nil
+	m.clearedcollections = false
+	m.removedcollections = nil
+}
+
+// Where appends a list predicates to the ProjectMutation builder.
+func (m *ProjectMutation) Where(ps ...predicate.Project) {
+	m.predicates = append(m.predicates, ps...)
+}
+
+// WhereP appends storage-level predicates to the ProjectMutation builder. Using this method,
+// users can use type-assertion to append predicates that do not depend on any generated package.
+func (m *ProjectMutation) WhereP(ps ...func(*sql.Selector)) {
+	p := make([]predicate.Project, len(ps))
+	for i := range ps {
+		p[i] = ps[i]
+	}
+	m.Where(p...)
+}
+
+// Op returns the operation name.
+func (m *ProjectMutation) Op() Op {
+	return m.op
+}
+
+// SetOp allows setting the mutation operation.
+func (m *ProjectMutation) SetOp(op Op) {
+	m.op = op
+}
+
+// Type returns the node type of this mutation (Project).
+func (m *ProjectMutation) Type() string {
+	return m.typ
+}
+
+// Fields returns all fields that were changed during this mutation. Note that in
+// order to get all numeric fields that were incremented/decremented, call
+// AddedFields().
+func (m *ProjectMutation) Fields() []string {
+	fields := make([]string, 0, 24)
+	if m.name != nil {
+		fields = append(fields, project.FieldName)
+	}
+	if m.description != nil {
+		fields = append(fields, project.FieldDescription)
+	}
+	if m._path != nil {
+		fields = append(fields, project.FieldPath)
+	}
+	if m.created_at != nil {
+		fields = append(fields, project.FieldCreatedAt)
+	}
+	if m.updated_at != nil {
+		fields = append(fields, project.FieldUpdatedAt)
+	}
+	if m.ai_model != nil {
+		fields = append(fields, project.FieldAiModel)
+	}
+	if m.ai_prompt != nil {
+		fields = append(fields, project.FieldAiPrompt)
+	}
+	if m.ai_suggestion_order != nil {
+		fields = append(fields, project.FieldAiSuggestionOrder)
+	}
+	if m.ai_suggestion_model != nil {
+		fields = append(fields, project.FieldAiSuggestionModel)
+	}
+	if m.ai_suggestion_prompt_id != nil {
+		fields = append(fields, project.FieldAiSuggestionPromptID)
+	}
+	if m.ai_suggestion_created_at != nil {
+		fields = append(fields, project.FieldAiSuggestionCreatedAt)
+	}
+	if m.ai_highlight_model != nil {
+		fields = append(fields, project.FieldAiHighlightModel)
+	}
+	if m.ai_highlight_prompt != nil {
+		fields = append(fields, project.FieldAiHighlightPrompt)
+	}
+	if m.active_tab != nil {
+		fields = append(fields, project.FieldActiveTab)
+	}
+	if m.ai_silence_improvements != nil {
+		fields = append(fields, project.FieldAiSilenceImprovements)
+	}
+	if m.ai_silence_model != nil {
+		fields = append(fields, project.FieldAiSilenceModel)
+	}
+	if m.ai_silence_created_at != nil {
+		fields = append(fields, project.FieldAiSilenceCreatedAt)
+	}
+	if m.highlight_order != nil {
+		fields = append(fields, project.FieldHighlightOrder)
+	}
+	if m.order_history != nil {
+		fields = append(fields, project.FieldOrderHistory)
+	}
+	if m.order_history_index != nil {
+		fields = append(fields, project.FieldOrderHistoryIndex)
+	}
+	if m.hidden_highlights != nil {
+		fields = append(fields, project.FieldHiddenHighlights)
+	}
+	if m.export_presets != nil {
+		fields = append(fields, project.FieldExportPresets)
+	}
+	if m.active_cut_id != nil {
+		fields = append(fields, project.FieldActiveCutID)
+	}
+	if m.deleted_at != nil {
+		fields = append(fields, project.FieldDeletedAt)
+	}
+	return fields
+}
+
+// Field returns the value of a field with the given name. The second boolean
+// return value indicates that this field was not set, or was not defined in the
+// schema.
+func (m *ProjectMutation) Field(name string) (ent.Value, bool) {
+	switch name {
+	case project.FieldName:
+		return m.Name()
+	case project.FieldDescription:
+		return m.Description()
+	case project.FieldPath:
+		return m.Path()
+	case project.FieldCreatedAt:
+		return m.CreatedAt()
+	case project.FieldUpdatedAt:
+		return m.UpdatedAt()
+	case project.FieldAiModel:
+		return m.AiModel()
+	case project.FieldAiPrompt:
+		return m.AiPrompt()
+	case project.FieldAiSuggestionOrder:
+		return m.AiSuggestionOrder()
+	case project.FieldAiSuggestionModel:
+		return m.AiSuggestionModel()
+	case project.FieldAiSuggestionPromptID:
+		return m.AiSuggestionPromptID()
+	case project.FieldAiSuggestionCreatedAt:
+		return m.AiSuggestionCreatedAt()
+	case project.FieldAiHighlightModel:
+		return m.AiHighlightModel()
+	case project.FieldAiHighlightPrompt:
+		return m.AiHighlightPrompt()
+	case project.FieldActiveTab:
+		return m.ActiveTab()
+	case project.FieldAiSilenceImprovements:
+		return m.AiSilenceImprovements()
+	case project.FieldAiSilenceModel:
+		return m.AiSilenceModel()
+	case project.FieldAiSilenceCreatedAt:
+		return m.AiSilenceCreatedAt()
+	case project.FieldHighlightOrder:
+		return m.HighlightOrder()
+	case project.FieldOrderHistory:
+		return m.OrderHistory()
+	case project.FieldOrderHistoryIndex:
+		return m.OrderHistoryIndex()
+	case project.FieldHiddenHighlights:
+		return m.HiddenHighlights()
+	case project.FieldExportPresets:
+		return m.ExportPresets()
+	case project.FieldActiveCutID:
+		return m.ActiveCutID()
+	case project.FieldDeletedAt:
+		return m.DeletedAt()
+	}
+	return nil, false
+}
+
+// OldField returns the old value of the field from the database. An error is
+// returned if the mutation operation is not UpdateOne, or the query to the
+// database failed.
+func (m *ProjectMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
+	switch name {
+	case project.FieldName:
+		return m.OldName(ctx)
+	case project.FieldDescription:
+		return m.OldDescription(ctx)
+	case project.FieldPath:
+		return m.OldPath(ctx)
+	case project.FieldCreatedAt:
+		return m.OldCreatedAt(ctx)
+	case project.FieldUpdatedAt:
+		return m.OldUpdatedAt(ctx)
+	case project.FieldAiModel:
+		return m.OldAiModel(ctx)
+	case project.FieldAiPrompt:
+		return m.OldAiPrompt(ctx)
+	case project.FieldAiSuggestionOrder:
+		return m.OldAiSuggestionOrder(ctx)
+	case project.FieldAiSuggestionModel:
+		return m.OldAiSuggestionModel(ctx)
+	case project.FieldAiSuggestionPromptID:
+		return m.OldAiSuggestionPromptID(ctx)
+	case project.FieldAiSuggestionCreatedAt:
+		return m.OldAiSuggestionCreatedAt(ctx)
+	case project.FieldAiHighlightModel:
+		return m.OldAiHighlightModel(ctx)
+	case project.FieldAiHighlightPrompt:
+		return m.OldAiHighlightPrompt(ctx)
+	case project.FieldActiveTab:
+		return m.OldActiveTab(ctx)
+	case project.FieldAiSilenceImprovements:
+		return m.OldAiSilenceImprovements(ctx)
+	case project.FieldAiSilenceModel:
+		return m.OldAiSilenceModel(ctx)
+	case project.FieldAiSilenceCreatedAt:
+		return m.OldAiSilenceCreatedAt(ctx)
+	case project.FieldHighlightOrder:
+		return m.OldHighlightOrder(ctx)
+	case project.FieldOrderHistory:
+		return m.OldOrderHistory(ctx)
+	case project.FieldOrderHistoryIndex:
+		return m.OldOrderHistoryIndex(ctx)
+	case project.FieldHiddenHighlights:
+		return m.OldHiddenHighlights(ctx)
+	case project.FieldExportPresets:
+		return m.OldExportPresets(ctx)
+	case project.FieldActiveCutID:
+		return m.OldActiveCutID(ctx)
+	case project.FieldDeletedAt:
+		return m.OldDeletedAt(ctx)
+	}
+	return nil, fmt.Errorf("unknown Project field %s", name)
+}
+
+// SetField sets the value of a field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *ProjectMutation) SetField(name string, value ent.Value) error {
+	switch name {
+	case project.FieldName:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetName(v)
+		return nil
+	case project.FieldDescription:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetDescription(v)
+		return nil
+	case project.FieldPath:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetPath(v)
+		return nil
+	case project.FieldCreatedAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetCreatedAt(v)
+		return nil
+	case project.FieldUpdatedAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetUpdatedAt(v)
+		return nil
+	case project.FieldAiModel:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetAiModel(v)
+		return nil
+	case project.FieldAiPrompt:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetAiPrompt(v)
+		return nil
+	case project.FieldAiSuggestionOrder:
+		v, ok := value.([]interface{})
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetAiSuggestionOrder(v)
+		return nil
+	case project.FieldAiSuggestionModel:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetAiSuggestionModel(v)
+		return nil
+	case project.FieldAiSuggestionPromptID:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetAiSuggestionPromptID(v)
+		return nil
+	case project.FieldAiSuggestionCreatedAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetAiSuggestionCreatedAt(v)
+		return nil
+	case project.FieldAiHighlightModel:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetAiHighlightModel(v)
+		return nil
+	case project.FieldAiHighlightPrompt:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetAiHighlightPrompt(v)
+		return nil
+	case project.FieldActiveTab:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetActiveTab(v)
+		return nil
+	case project.FieldAiSilenceImprovements:
+		v, ok := value.([]map[string]interface{})
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetAiSilenceImprovements(v)
+		return nil
+	case project.FieldAiSilenceModel:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetAiSilenceModel(v)
+		return nil
+	case project.FieldAiSilenceCreatedAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetAiSilenceCreatedAt(v)
+		return nil
+	case project.FieldHighlightOrder:
+		v, ok := value.([]interface{})
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetHighlightOrder(v)
+		return nil
+	case project.FieldOrderHistory:
+		v, ok := value.([][]interface{})
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetOrderHistory(v)
+		return nil
+	case project.FieldOrderHistoryIndex:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetOrderHistoryIndex(v)
+		return nil
+	case project.FieldHiddenHighlights:
+		v, ok := value.([]string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetHiddenHighlights(v)
+		return nil
+	case project.FieldExportPresets:
+		v, ok := value.([]schema.ExportPreset)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetExportPresets(v)
+		return nil
+	case project.FieldActiveCutID:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetActiveCutID(v)
+		return nil
+	case project.FieldDeletedAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetDeletedAt(v)
+		return nil
+	}
+	return fmt.Errorf("unknown Project field %s", name)
+}
+
+// AddedFields returns all numeric fields that were incremented/decremented during
+// this mutation.
+func (m *ProjectMutation) AddedFields() []string {
+	var fields []string
+	if m.addai_suggestion_prompt_id != nil {
+		fields = append(fields, project.FieldAiSuggestionPromptID)
+	}
+	if m.addorder_history_index != nil {
+		fields = append(fields, project.FieldOrderHistoryIndex)
+	}
+	if m.addactive_cut_id != nil {
+		fields = append(fields, project.FieldActiveCutID)
+	}
+	return fields
+}
+
+// AddedField returns the numeric value that was incremented/decremented on a field
+// with the given name. The second boolean return value indicates that this field
+// was not set, or was not defined in the schema.
+func (m *ProjectMutation) AddedField(name string) (ent.Value, bool) {
+	switch name {
+	case project.FieldAiSuggestionPromptID:
+		return m.AddedAiSuggestionPromptID()
+	case project.FieldOrderHistoryIndex:
+		return m.AddedOrderHistoryIndex()
+	case project.FieldActiveCutID:
+		return m.AddedActiveCutID()
+	}
+	return nil, false
+}
+
+// AddField adds the value to the field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *ProjectMutation) AddField(name string, value ent.Value) error {
+	switch name {
+	case project.FieldAiSuggestionPromptID:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddAiSuggestionPromptID(v)
+		return nil
+	case project.FieldOrderHistoryIndex:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddOrderHistoryIndex(v)
+		return nil
+	case project.FieldActiveCutID:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddActiveCutID(v)
+		return nil
+	}
+	return fmt.Errorf("unknown Project numeric field %s", name)
+}
+
+// ClearedFields returns all nullable fields that were cleared during this
+// mutation.
+func (m *ProjectMutation) ClearedFields() []string {
+	var fields []string
+	if m.FieldCleared(project.FieldDescription) {
+		fields = append(fields, project.FieldDescription)
+	}
+	if m.FieldCleared(project.FieldAiModel) {
+		fields = append(fields, project.FieldAiModel)
+	}
+	if m.FieldCleared(project.FieldAiPrompt) {
+		fields = append(fields, project.FieldAiPrompt)
+	}
+	if m.FieldCleared(project.FieldAiSuggestionOrder) {
+		fields = append(fields, project.FieldAiSuggestionOrder)
+	}
+	if m.FieldCleared(project.FieldAiSuggestionModel) {
+		fields = append(fields, project.FieldAiSuggestionModel)
+	}
+	if m.FieldCleared(project.FieldAiSuggestionPromptID) {
+		fields = append(fields, project.FieldAiSuggestionPromptID)
+	}
+	if m.FieldCleared(project.FieldAiSuggestionCreatedAt) {
+		fields = append(fields, project.FieldAiSuggestionCreatedAt)
+	}
+	if m.FieldCleared(project.FieldAiHighlightModel) {
+		fields = append(fields, project.FieldAiHighlightModel)
+	}
+	if m.FieldCleared(project.FieldAiHighlightPrompt) {
+		fields = append(fields, project.FieldAiHighlightPrompt)
+	}
+	if m.FieldCleared(project.FieldActiveTab) {
+		fields = append(fields, project.FieldActiveTab)
+	}
+	if m.FieldCleared(project.FieldAiSilenceImprovements) {
+		fields = append(fields, project.FieldAiSilenceImprovements)
+	}
+	if m.FieldCleared(project.FieldAiSilenceModel) {
+		fields = append(fields, project.FieldAiSilenceModel)
+	}
+	if m.FieldCleared(project.FieldAiSilenceCreatedAt) {
+		fields = append(fields, project.FieldAiSilenceCreatedAt)
+	}
+	if m.FieldCleared(project.FieldHighlightOrder) {
+		fields = append(fields, project.FieldHighlightOrder)
+	}
+	if m.FieldCleared(project.FieldOrderHistory) {
+		fields = append(fields, project.FieldOrderHistory)
+	}
+	if m.FieldCleared(project.FieldOrderHistoryIndex) {
+		fields = append(fields, project.FieldOrderHistoryIndex)
+	}
+	if m.FieldCleared(project.FieldHiddenHighlights) {
+		fields = append(fields, project.FieldHiddenHighlights)
+	}
+	if m.FieldCleared(project.FieldExportPresets) {
+		fields = append(fields, project.FieldExportPresets)
+	}
+	if m.FieldCleared(project.FieldActiveCutID) {
+		fields = append(fields, project.FieldActiveCutID)
+	}
+	if m.FieldCleared(project.FieldDeletedAt) {
+		fields = append(fields, project.FieldDeletedAt)
+	}
+	return fields
+}
+
+// FieldCleared returns a boolean indicating if a field with the given name was
+// cleared in this mutation.
+func (m *ProjectMutation) FieldCleared(name string) bool {
+	_, ok := m.clearedFields[name]
+	return ok
+}
+
+// ClearField clears the value of the field with the given name. It returns an
+// error if the field is not defined in the schema.
+func (m *ProjectMutation) ClearField(name string) error {
+	switch name {
+	case project.FieldDescription:
+		m.ClearDescription()
+		return nil
+	case project.FieldAiModel:
+		m.ClearAiModel()
+		return nil
+	case project.FieldAiPrompt:
+		m.ClearAiPrompt()
+		return nil
+	case project.FieldAiSuggestionOrder:
+		m.ClearAiSuggestionOrder()
+		return nil
+	case project.FieldAiSuggestionModel:
+		m.ClearAiSuggestionModel()
+		return nil
+	case project.FieldAiSuggestionPromptID:
+		m.ClearAiSuggestionPromptID()
+		return nil
+	case project.FieldAiSuggestionCreatedAt:
+		m.ClearAiSuggestionCreatedAt()
+		return nil
+	case project.FieldAiHighlightModel:
+		m.ClearAiHighlightModel()
+		return nil
+	case project.FieldAiHighlightPrompt:
+		m.ClearAiHighlightPrompt()
+		return nil
+	case project.FieldActiveTab:
+		m.ClearActiveTab()
+		return nil
+	case project.FieldAiSilenceImprovements:
+		m.ClearAiSilenceImprovements()
+		return nil
+	case project.FieldAiSilenceModel:
+		m.ClearAiSilenceModel()
+		return nil
+	case project.FieldAiSilenceCreatedAt:
+		m.ClearAiSilenceCreatedAt()
+		return nil
+	case project.FieldHighlightOrder:
+		m.ClearHighlightOrder()
+		return nil
+	case project.FieldOrderHistory:
+		m.ClearOrderHistory()
+		return nil
+	case project.FieldOrderHistoryIndex:
+		m.ClearOrderHistoryIndex()
+		return nil
+	case project.FieldHiddenHighlights:
+		m.ClearHiddenHighlights()
+		return nil
+	case project.FieldExportPresets:
+		m.ClearExportPresets()
+		return nil
+	case project.FieldActiveCutID:
+		m.ClearActiveCutID()
+		return nil
+	case project.FieldDeletedAt:
+		m.ClearDeletedAt()
+		return nil
+	}
+	return fmt.Errorf("unknown Project nullable field %s", name)
+}
+
+// ResetField resets all changes in the mutation for the field with the given name.
+// It returns an error if the field is not defined in the schema.
+func (m *ProjectMutation) ResetField(name string) error {
+	switch name {
+	case project.FieldName:
+		m.ResetName()
+		return nil
+	case project.FieldDescription:
+		m.ResetDescription()
+		return nil
+	case project.FieldPath:
+		m.ResetPath()
+		return nil
+	case project.FieldCreatedAt:
+		m.ResetCreatedAt()
+		return nil
+	case project.FieldUpdatedAt:
+		m.ResetUpdatedAt()
+		return nil
+	case project.FieldAiModel:
+		m.ResetAiModel()
+		return nil
+	case project.FieldAiPrompt:
+		m.ResetAiPrompt()
+		return nil
+	case project.FieldAiSuggestionOrder:
+		m.ResetAiSuggestionOrder()
+		return nil
+	case project.FieldAiSuggestionModel:
+		m.ResetAiSuggestionModel()
+		return nil
+	case project.FieldAiSuggestionPromptID:
+		m.ResetAiSuggestionPromptID()
+		return nil
+	case project.FieldAiSuggestionCreatedAt:
+		m.ResetAiSuggestionCreatedAt()
+		return nil
+	case project.FieldAiHighlightModel:
+		m.ResetAiHighlightModel()
+		return nil
+	case project.FieldAiHighlightPrompt:
+		m.ResetAiHighlightPrompt()
+		return nil
+	case project.FieldActiveTab:
+		m.ResetActiveTab()
+		return nil
+	case project.FieldAiSilenceImprovements:
+		m.ResetAiSilenceImprovements()
+		return nil
+	case project.FieldAiSilenceModel:
+		m.ResetAiSilenceModel()
+		return nil
+	case project.FieldAiSilenceCreatedAt:
+		m.ResetAiSilenceCreatedAt()
+		return nil
+	case project.FieldHighlightOrder:
+		m.ResetHighlightOrder()
+		return nil
+	case project.FieldOrderHistory:
+		m.ResetOrderHistory()
+		return nil
+	case project.FieldOrderHistoryIndex:
+		m.ResetOrderHistoryIndex()
+		return nil
+	case project.FieldHiddenHighlights:
+		m.ResetHiddenHighlights()
+		return nil
+	case project.FieldExportPresets:
+		m.ResetExportPresets()
+		return nil
+	case project.FieldActiveCutID:
+		m.ResetActiveCutID()
+		return nil
+	case project.FieldDeletedAt:
+		m.ResetDeletedAt()
+		return nil
+	}
+	return fmt.Errorf("unknown Project field %s", name)
+}
+
+// AddedEdges returns all edge names that were set/added in this mutation.
+func (m *ProjectMutation) AddedEdges() []string {
+	edges := make([]string, 0, 7)
+	if m.video_clips != nil {
+		edges = append(edges, project.EdgeVideoClips)
+	}
+	if m.export_jobs != nil {
+		edges = append(edges, project.EdgeExportJobs)
+	}
+	if m.chat_sessions != nil {
+		edges = append(edges, project.EdgeChatSessions)
+	}
+	if m.cuts != nil {
+		edges = append(edges, project.EdgeCuts)
+	}
+	if m.prompts != nil {
+		edges = append(edges, project.EdgePrompts)
+	}
+	if m.tags != nil {
+		edges = append(edges, project.EdgeTags)
+	}
+	if m.collections != nil {
+		edges = append(edges, project.EdgeCollections)
+	}
+	return edges
+}
+
+// AddedIDs returns all IDs (to other nodes) that were added for the given edge
+// name in this mutation.
+func (m *ProjectMutation) AddedIDs(name string) []ent.Value {
+	switch name {
+	case project.EdgeVideoClips:
+		ids := make([]ent.Value, 0, len(m.video_clips))
+		for id := range m.video_clips {
+			ids = append(ids, id)
+		}
+		return ids
+	case project.EdgeExportJobs:
+		ids := make([]ent.Value, 0, len(m.export_jobs))
+		for id := range m.export_jobs {
+			ids = append(ids, id)
+		}
+		return ids
+	case project.EdgeChatSessions:
+		ids := make([]ent.Value, 0, len(m.chat_sessions))
+		for id := range m.chat_sessions {
+			ids = append(ids, id)
+		}
+		return ids
+	case project.EdgeCuts:
+		ids := make([]ent.Value, 0, len(m.cuts))
+		for id := range m.cuts {
+			ids = append(ids, id)
+		}
+		return ids
+	case project.EdgePrompts:
+		ids := make([]ent.Value, 0, len(m.prompts))
+		for id := range m.prompts {
+			ids = append(ids, id)
+		}
+		return ids
+	case project.EdgeTags:
+		ids := make([]ent.Value, 0, len(m.tags))
+		for id := range m.tags {
+			ids = append(ids, id)
+		}
+		return ids
+	case project.EdgeCollections:
+		ids := make([]ent.Value, 0, len(m.collections))
+		for id := range m.collections {
+			ids = append(ids, id)
+		}
+		return ids
+	}
+	return nil
+}
+
+// RemovedEdges returns all edge names that were removed in this mutation.
+func (m *ProjectMutation) RemovedEdges() []string {
+	edges := make([]string, 0, 7)
+	if m.removedvideo_clips != nil {
+		edges = append(edges, project.EdgeVideoClips)
+	}
+	if m.removedexport_jobs != nil {
+		edges = append(edges, project.EdgeExportJobs)
+	}
+	if m.removedchat_sessions != nil {
+		edges = append(edges, project.EdgeChatSessions)
+	}
+	if m.removedcuts != nil {
+		edges = append(edges, project.EdgeCuts)
+	}
+	if m.removedprompts != nil {
+		edges = append(edges, project.EdgePrompts)
+	}
+	if m.removedtags != nil {
+		edges = append(edges, project.EdgeTags)
+	}
+	if m.removedcollections != nil {
+		edges = append(edges, project.EdgeCollections)
+	}
+	return edges
+}
+
+// RemovedIDs returns all IDs (to other nodes) that were removed for the edge with
+// the given name in this mutation.
+func (m *ProjectMutation) RemovedIDs(name string) []ent.Value {
+	switch name {
+	case project.EdgeVideoClips:
+		ids := make([]ent.Value, 0, len(m.removedvideo_clips))
+		for id := range m.removedvideo_clips {
+			ids = append(ids, id)
+		}
+		return ids
+	case project.EdgeExportJobs:
+		ids := make([]ent.Value, 0, len(m.removedexport_jobs))
+		for id := range m.removedexport_jobs {
+			ids = append(ids, id)
+		}
+		return ids
+	case project.EdgeChatSessions:
+		ids := make([]ent.Value, 0, len(m.removedchat_sessions))
+		for id := range m.removedchat_sessions {
+			ids = append(ids, id)
+		}
+		return ids
+	case project.EdgeCuts:
+		ids := make([]ent.Value, 0, len(m.removedcuts))
+		for id := range m.removedcuts {
+			ids = append(ids, id)
+		}
+		return ids
+	case project.EdgePrompts:
+		ids := make([]ent.Value, 0, len(m.removedprompts))
+		for id := range m.removedprompts {
+			ids = append(ids, id)
+		}
+		return ids
+	case project.EdgeTags:
+		ids := make([]ent.Value, 0, len(m.removedtags))
+		for id := range m.removedtags {
+			ids = append(ids, id)
+		}
+		return ids
+	case project.EdgeCollections:
+		ids := make([]ent.Value, 0, len(m.removedcollections))
+		for id := range m.removedcollections {
+			ids = append(ids, id)
+		}
+		return ids
+	}
+	return nil
+}
+
+// ClearedEdges returns all edge names that were cleared in this mutation.
+func (m *ProjectMutation) ClearedEdges() []string {
+	edges := make([]string, 0, 7)
+	if m.clearedvideo_clips {
+		edges = append(edges, project.EdgeVideoClips)
+	}
+	if m.clearedexport_jobs {
+		edges = append(edges, project.EdgeExportJobs)
+	}
+	if m.clearedchat_sessions {
+		edges = append(edges, project.EdgeChatSessions)
+	}
+	if m.clearedcuts {
+		edges = append(edges, project.EdgeCuts)
+	}
+	if m.clearedprompts {
+		edges = append(edges, project.EdgePrompts)
+	}
+	if m.clearedtags {
+		edges = append(edges, project.EdgeTags)
+	}
+	if m.clearedcollections {
+		edges = append(edges, project.EdgeCollections)
+	}
+	return edges
+}
+
+// EdgeCleared returns a boolean which indicates if the edge with the given name
+// was cleared in this mutation.
+func (m *ProjectMutation) EdgeCleared(name string) bool {
+	switch name {
+	case project.EdgeVideoClips:
+		return m.clearedvideo_clips
+	case project.EdgeExportJobs:
+		return m.clearedexport_jobs
+	case project.EdgeChatSessions:
+		return m.clearedchat_sessions
+	case project.EdgeCuts:
+		return m.clearedcuts
+	case project.EdgePrompts:
+		return m.clearedprompts
+	case project.EdgeTags:
+		return m.clearedtags
+	case project.EdgeCollections:
+		return m.clearedcollections
+	}
+	return false
+}
+
+// ClearEdge clears the value of the edge with the given name. It returns an error
+// if that edge is not defined in the schema.
+func (m *ProjectMutation) ClearEdge(name string) error {
+	switch name {
+	}
+	return fmt.Errorf("unknown Project unique edge %s", name)
+}
+
+// ResetEdge resets all changes to the edge with the given name in this mutation.
+// It returns an error if the edge is not defined in the schema.
+func (m *ProjectMutation) ResetEdge(name string) error {
+	switch name {
+	case project.EdgeVideoClips:
+		m.ResetVideoClips()
+		return nil
+	case project.EdgeExportJobs:
+		m.ResetExportJobs()
+		return nil
+	case project.EdgeChatSessions:
+		m.ResetChatSessions()
+		return nil
+	case project.EdgeCuts:
+		m.ResetCuts()
+		return nil
+	case project.EdgePrompts:
+		m.ResetPrompts()
+		return nil
+	case project.EdgeTags:
+		m.ResetTags()
+		return nil
+	case project.EdgeCollections:
+		m.ResetCollections()
+		return nil
+	}
+	return fmt.Errorf("unknown Project edge %s", name)
+}
+
+// ProjectTemplateMutation represents an operation that mutates the ProjectTemplate nodes in the graph.
+type ProjectTemplateMutation struct {
+	config
+	op                   Op
+	typ                  string
+	id                   *int
+	name                 *string
+	description          *string
+	ai_model             *string
+	ai_prompt            *string
+	highlight_ai_model   *string
+	highlight_ai_prompt  *string
+	export_presets       *[]schema.ExportPreset
+	appendexport_presets []schema.ExportPreset
+	section_titles       *[]string
+	appendsection_titles []string
+	created_at           *time.Time
+	updated_at           *time.Time
+	clearedFields        map[string]struct{}
+	done                 bool
+	oldValue             func(context.Context) (*ProjectTemplate, error)
+	predicates           []predicate.ProjectTemplate
+}
+
+var _ ent.Mutation = (*ProjectTemplateMutation)(nil)
+
+// projecttemplateOption allows management of the mutation configuration using functional options.
+type projecttemplateOption func(*ProjectTemplateMutation)
+
+// newProjectTemplateMutation creates new mutation for the ProjectTemplate entity.
+func newProjectTemplateMutation(c config, op Op, opts ...projecttemplateOption) *ProjectTemplateMutation {
+	m := &ProjectTemplateMutation{
+		config:        c,
+		op:            op,
+		typ:           TypeProjectTemplate,
+		clearedFields: make(map[string]struct{}),
+	}
+	for _, opt := range opts {
+		opt(m)
+	}
+	return m
+}
+
+// withProjectTemplateID sets the ID field of the mutation.
+func withProjectTemplateID(id int) projecttemplateOption {
+	return func(m *ProjectTemplateMutation) {
+		var (
+			err   error
+			once  sync.Once
+			value *ProjectTemplate
+		)
+		m.oldValue = func(ctx context.Context) (*ProjectTemplate, error) {
+			once.Do(func() {
+				if m.done {
+					err = errors.New("querying old values post mutation is not allowed")
+				} else {
+					value, err = m.Client().ProjectTemplate.Get(ctx, id)
+				}
+			})
+			return value, err
+		}
+		m.id = &id
+	}
+}
+
+// withProjectTemplate sets the old ProjectTemplate of the mutation.
+func withProjectTemplate(node *ProjectTemplate) projecttemplateOption {
+	return func(m *ProjectTemplateMutation) {
+		m.oldValue = func(context.Context) (*ProjectTemplate, error) {
+			return node, nil
+		}
+		m.id = &node.ID
+	}
+}
+
+// Client returns a new `ent.Client` from the mutation. If the mutation was
+// executed in a transaction (ent.Tx), a transactional client is returned.
+func (m ProjectTemplateMutation) Client() *Client {
+	client := &Client{config: m.config}
+	client.init()
+	return client
+}
+
+// Tx returns an `ent.Tx` for mutations that were executed in transactions;
+// it returns an error otherwise.
+func (m ProjectTemplateMutation) Tx() (*Tx, error) {
+	if _, ok := m.driver.(*txDriver); !ok {
+		return nil, errors.New("ent: mutation is not running in a transaction")
+	}
+	tx := &Tx{config: m.config}
+	tx.init()
+	return tx, nil
+}
+
+// ID returns the ID value in the mutation. Note that the ID is only available
+// if it was provided to the builder or after it was returned from the database.
+func (m *ProjectTemplateMutation) ID() (id int, exists bool) {
+	if m.id == nil {
+		return
+	}
+	return *m.id, true
+}
+
+// IDs queries the database and returns the entity ids that match the mutation's predicate.
+// That means, if the mutation is applied within a transaction with an isolation level such
+// as sql.LevelSerializable, the returned ids match the ids of the rows that will be updated
+// or updated by the mutation.
+func (m *ProjectTemplateMutation) IDs(ctx context.Context) ([]int, error) {
+	switch {
+	case m.op.Is(OpUpdateOne | OpDeleteOne):
+		id, exists := m.ID()
+		if exists {
+			return []int{id}, nil
+		}
+		fallthrough
+	case m.op.Is(OpUpdate | OpDelete):
+		return m.Client().ProjectTemplate.Query().Where(m.predicates...).IDs(ctx)
+	default:
+		return nil, fmt.Errorf("IDs is not allowed on %s operations", m.op)
+	}
+}
+
+// SetName sets the "name" field.
+func (m *ProjectTemplateMutation) SetName(s string) {
+	m.name = &s
+}
+
+// Name returns the value of the "name" field in the mutation.
+func (m *ProjectTemplateMutation) Name() (r string, exists bool) {
+	v := m.name
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldName returns the old "name" field's value of the ProjectTemplate entity.
+// If the ProjectTemplate object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *ProjectTemplateMutation) OldName(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldName is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldName requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldName: %w", err)
+	}
+	return oldValue.Name, nil
+}
+
+// ResetName resets all changes to the "name" field.
+func (m *ProjectTemplateMutation) ResetName() {
+	m.name = nil
+}
+
+// SetDescription sets the "description" field.
+func (m *ProjectTemplateMutation) SetDescription(s string) {
+	m.description = &s
+}
+
+// Description returns the value of the "description" field in the mutation.
+func (m *ProjectTemplateMutation) Description() (r string, exists bool) {
+	v := m.description
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldDescription returns the old "description" field's value of the ProjectTemplate entity.
+// If the ProjectTemplate object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *ProjectTemplateMutation) OldDescription(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldDescription is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldDescription requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldDescription: %w", err)
+	}
+	return oldValue.Description, nil
+}
+
+// ClearDescription clears the value of the "description" field.
+func (m *ProjectTemplateMutation) ClearDescription() {
+	m.description = nil
+	m.clearedFields[projecttemplate.FieldDescription] = struct{}{}
+}
+
+// DescriptionCleared returns if the "description" field was cleared in this mutation.
+func (m *ProjectTemplateMutation) DescriptionCleared() bool {
+	_, ok := m.clearedFields[projecttemplate.FieldDescription]
+	return ok
+}
+
+// ResetDescription resets all changes to the "description" field.
+func (m *ProjectTemplateMutation) ResetDescription() {
+	m.description = nil
+	delete(m.clearedFields, projecttemplate.FieldDescription)
+}
+
+// SetAiModel sets the "ai_model" field.
+func (m *ProjectTemplateMutation) SetAiModel(s string) {
+	m.ai_model = &s
+}
+
+// AiModel returns the value of the "ai_model" field in the mutation.
+func (m *ProjectTemplateMutation) AiModel() (r string, exists bool) {
+	v := m.ai_model
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldAiModel returns the old "ai_model" field's value of the ProjectTemplate entity.
+// If the ProjectTemplate object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *ProjectTemplateMutation) OldAiModel(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldAiModel is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldAiModel requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldAiModel: %w", err)
+	}
+	return oldValue.AiModel, nil
+}
+
+// ClearAiModel clears the value of the "ai_model" field.
+func (m *ProjectTemplateMutation) ClearAiModel() {
+	m.ai_model = nil
+	m.clearedFields[projecttemplate.FieldAiModel] = struct{}{}
+}
+
+// AiModelCleared returns if the "ai_model" field was cleared in this mutation.
+func (m *ProjectTemplateMutation) AiModelCleared() bool {
+	_, ok := m.clearedFields[projecttemplate.FieldAiModel]
+	return ok
+}
+
+// ResetAiModel resets all changes to the "ai_model" field.
+func (m *ProjectTemplateMutation) ResetAiModel() {
+	m.ai_model = nil
+	delete(m.clearedFields, projecttemplate.FieldAiModel)
+}
+
+// SetAiPrompt sets the "ai_prompt" field.
+func (m *ProjectTemplateMutation) SetAiPrompt(s string) {
+	m.ai_prompt = &s
+}
+
+// AiPrompt returns the value of the "ai_prompt" field in the mutation.
+func (m *ProjectTemplateMutation) AiPrompt() (r string, exists bool) {
+	v := m.ai_prompt
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldAiPrompt returns the old "ai_prompt" field's value of the ProjectTemplate entity.
+// If the ProjectTemplate object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *ProjectTemplateMutation) OldAiPrompt(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldAiPrompt is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldAiPrompt requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldAiPrompt: %w", err)
+	}
+	return oldValue.AiPrompt, nil
+}
+
+// ClearAiPrompt clears the value of the "ai_prompt" field.
+func (m *ProjectTemplateMutation) ClearAiPrompt() {
+	m.ai_prompt = nil
+	m.clearedFields[projecttemplate.FieldAiPrompt] = struct{}{}
+}
+
+// AiPromptCleared returns if the "ai_prompt" field was cleared in this mutation.
+func (m *ProjectTemplateMutation) AiPromptCleared() bool {
+	_, ok := m.clearedFields[projecttemplate.FieldAiPrompt]
+	return ok
+}
+
+// ResetAiPrompt resets all changes to the "ai_prompt" field.
+func (m *ProjectTemplateMutation) ResetAiPrompt() {
+	m.ai_prompt = nil
+	delete(m.clearedFields, projecttemplate.FieldAiPrompt)
+}
+
+// SetHighlightAiModel sets the "highlight_ai_model" field.
+func (m *ProjectTemplateMutation) SetHighlightAiModel(s string) {
+	m.highlight_ai_model = &s
+}
+
+// HighlightAiModel returns the value of the "highlight_ai_model" field in the mutation.
+func (m *ProjectTemplateMutation) HighlightAiModel() (r string, exists bool) {
+	v := m.highlight_ai_model
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldHighlightAiModel returns the old "highlight_ai_model" field's value of the ProjectTemplate entity.
+// If the ProjectTemplate object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *ProjectTemplateMutation) OldHighlightAiModel(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldHighlightAiModel is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldHighlightAiModel requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldHighlightAiModel: %w", err)
+	}
+	return oldValue.HighlightAiModel, nil
+}
+
+// ClearHighlightAiModel clears the value of the "highlight_ai_model" field.
+func (m *ProjectTemplateMutation) ClearHighlightAiModel() {
+	m.highlight_ai_model = nil
+	m.clearedFields[projecttemplate.FieldHighlightAiModel] = struct{}{}
+}
+
+// HighlightAiModelCleared returns if the "highlight_ai_model" field was cleared in this mutation.
+func (m *ProjectTemplateMutation) HighlightAiModelCleared() bool {
+	_, ok := m.clearedFields[projecttemplate.FieldHighlightAiModel]
+	return ok
+}
+
+// ResetHighlightAiModel resets all changes to the "highlight_ai_model" field.
+func (m *ProjectTemplateMutation) ResetHighlightAiModel() {
+	m.highlight_ai_model = nil
+	delete(m.clearedFields, projecttemplate.FieldHighlightAiModel)
+}
+
+// SetHighlightAiPrompt sets the "highlight_ai_prompt" field.
+func (m *ProjectTemplateMutation) SetHighlightAiPrompt(s string) {
+	m.highlight_ai_prompt = &s
+}
+
+// HighlightAiPrompt returns the value of the "highlight_ai_prompt" field in the mutation.
+func (m *ProjectTemplateMutation) HighlightAiPrompt() (r string, exists bool) {
+	v := m.highlight_ai_prompt
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldHighlightAiPrompt returns the old "highlight_ai_prompt" field's value of the ProjectTemplate entity.
+// If the ProjectTemplate object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *ProjectTemplateMutation) OldHighlightAiPrompt(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldHighlightAiPrompt is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldHighlightAiPrompt requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldHighlightAiPrompt: %w", err)
+	}
+	return oldValue.HighlightAiPrompt, nil
+}
+
+// ClearHighlightAiPrompt clears the value of the "highlight_ai_prompt" field.
+func (m *ProjectTemplateMutation) ClearHighlightAiPrompt() {
+	m.highlight_ai_prompt = nil
+	m.clearedFields[projecttemplate.FieldHighlightAiPrompt] = struct{}{}
+}
+
+// HighlightAiPromptCleared returns if the "highlight_ai_prompt" field was cleared in this mutation.
+func (m *ProjectTemplateMutation) HighlightAiPromptCleared() bool {
+	_, ok := m.clearedFields[projecttemplate.FieldHighlightAiPrompt]
+	return ok
+}
+
+// ResetHighlightAiPrompt resets all changes to the "highlight_ai_prompt" field.
+func (m *ProjectTemplateMutation) ResetHighlightAiPrompt() {
+	m.highlight_ai_prompt = nil
+	delete(m.clearedFields, projecttemplate.FieldHighlightAiPrompt)
+}
+
+// SetExportPresets sets the "export_presets" field.
+func (m *ProjectTemplateMutation) SetExportPresets(sp []schema.ExportPreset) {
+	m.export_presets = &sp
+	m.appendexport_presets = nil
+}
+
+// ExportPresets returns the value of the "export_presets" field in the mutation.
+func (m *ProjectTemplateMutation) ExportPresets() (r []schema.ExportPreset, exists bool) {
+	v := m.export_presets
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldExportPresets returns the old "export_presets" field's value of the ProjectTemplate entity.
+// If the ProjectTemplate object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *ProjectTemplateMutation) OldExportPresets(ctx context.Context) (v []schema.ExportPreset, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldExportPresets is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldExportPresets requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldExportPresets: %w", err)
+	}
+	return oldValue.ExportPresets, nil
+}
+
+// AppendExportPresets adds sp to the "export_presets" field.
+func (m *ProjectTemplateMutation) AppendExportPresets(sp []schema.ExportPreset) {
+	m.appendexport_presets = append(m.appendexport_presets, sp...)
+}
+
+// AppendedExportPresets returns the list of values that were appended to the "export_presets" field in this mutation.
+func (m *ProjectTemplateMutation) AppendedExportPresets() ([]schema.ExportPreset, bool) {
+	if len(m.appendexport_presets) == 0 {
+		return nil, false
+	}
+	return m.appendexport_presets, true
+}
+
+// ClearExportPresets clears the value of the "export_presets" field.
+func (m *ProjectTemplateMutation) ClearExportPresets() {
+	m.export_presets = nil
+	m.appendexport_presets = nil
+	m.clearedFields[projecttemplate.FieldExportPresets] = struct{}{}
+}
+
+// ExportPresetsCleared returns if the "export_presets" field was cleared in this mutation.
+func (m *ProjectTemplateMutation) ExportPresetsCleared() bool {
+	_, ok := m.clearedFields[projecttemplate.FieldExportPresets]
+	return ok
+}
+
+// ResetExportPresets resets all changes to the "export_presets" field.
+func (m *ProjectTemplateMutation) ResetExportPresets() {
+	m.export_presets = nil
+	m.appendexport_presets = nil
+	delete(m.clearedFields, projecttemplate.FieldExportPresets)
+}
+
+// SetSectionTitles sets the "section_titles" field.
+func (m *ProjectTemplateMutation) SetSectionTitles(s []string) {
+	m.section_titles = &s
+	m.appendsection_titles = nil
+}
+
+// SectionTitles returns the value of the "section_titles" field in the mutation.
+func (m *ProjectTemplateMutation) SectionTitles() (r []string, exists bool) {
+	v := m.section_titles
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldSectionTitles returns the old "section_titles" field's value of the ProjectTemplate entity.
+// If the ProjectTemplate object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *ProjectTemplateMutation) OldSectionTitles(ctx context.Context) (v []string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldSectionTitles is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldSectionTitles requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldSectionTitles: %w", err)
+	}
+	return oldValue.SectionTitles, nil
+}
+
+// AppendSectionTitles adds s to the "section_titles" field.
+func (m *ProjectTemplateMutation) AppendSectionTitles(s []string) {
+	m.appendsection_titles = append(m.appendsection_titles, s...)
+}
+
+// AppendedSectionTitles returns the list of values that were appended to the "section_titles" field in this mutation.
+func (m *ProjectTemplateMutation) AppendedSectionTitles() ([]string, bool) {
+	if len(m.appendsection_titles) == 0 {
+		return nil, false
+	}
+	return m.appendsection_titles, true
+}
+
+// ClearSectionTitles clears the value of the "section_titles" field.
+func (m *ProjectTemplateMutation) ClearSectionTitles() {
+	m.section_titles = nil
+	m.appendsection_titles = nil
+	m.clearedFields[projecttemplate.FieldSectionTitles] = struct{}{}
+}
+
+// SectionTitlesCleared returns if the "section_titles" field was cleared in this mutation.
+func (m *ProjectTemplateMutation) SectionTitlesCleared() bool {
+	_, ok := m.clearedFields[projecttemplate.FieldSectionTitles]
+	return ok
+}
+
+// ResetSectionTitles resets all changes to the "section_titles" field.
+func (m *ProjectTemplateMutation) ResetSectionTitles() {
+	m.section_titles = nil
+	m.appendsection_titles = nil
+	delete(m.clearedFields, projecttemplate.FieldSectionTitles)
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (m *ProjectTemplateMutation) SetCreatedAt(t time.Time) {
+	m.created_at = &t
+}
+
+// CreatedAt returns the value of the "created_at" field in the mutation.
+func (m *ProjectTemplateMutation) CreatedAt() (r time.Time, exists bool) {
+	v := m.created_at
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldCreatedAt returns the old "created_at" field's value of the ProjectTemplate entity.
+// If the ProjectTemplate object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *ProjectTemplateMutation) OldCreatedAt(ctx context.Context) (v time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldCreatedAt is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldCreatedAt requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldCreatedAt: %w", err)
+	}
+	return oldValue.CreatedAt, nil
+}
+
+// ResetCreatedAt resets all changes to the "created_at" field.
+func (m *ProjectTemplateMutation) ResetCreatedAt() {
+	m.created_at = nil
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (m *ProjectTemplateMutation) SetUpdatedAt(t time.Time) {
+	m.updated_at = &t
+}
+
+// UpdatedAt returns the value of the "updated_at" field in the mutation.
+func (m *ProjectTemplateMutation) UpdatedAt() (r time.Time, exists bool) {
+	v := m.updated_at
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldUpdatedAt returns the old "updated_at" field's value of the ProjectTemplate entity.
+// If the ProjectTemplate object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *ProjectTemplateMutation) OldUpdatedAt(ctx context.Context) (v time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldUpdatedAt is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldUpdatedAt requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldUpdatedAt: %w", err)
+	}
+	return oldValue.UpdatedAt, nil
+}
+
+// ResetUpdatedAt resets all changes to the "updated_at" field.
+func (m *ProjectTemplateMutation) ResetUpdatedAt() {
+	m.updated_at = nil
+}
+
+// Where appends a list predicates to the ProjectTemplateMutation builder.
+func (m *ProjectTemplateMutation) Where(ps ...predicate.ProjectTemplate) {
+	m.predicates = append(m.predicates, ps...)
+}
+
+// WhereP appends storage-level predicates to the ProjectTemplateMutation builder. Using this method,
+// users can use type-assertion to append predicates that do not depend on any generated package.
+func (m *ProjectTemplateMutation) WhereP(ps ...func(*sql.Selector)) {
+	p := make([]predicate.ProjectTemplate, len(ps))
+	for i := range ps {
+		p[i] = ps[i]
+	}
+	m.Where(p...)
+}
+
+// Op returns the operation name.
+func (m *ProjectTemplateMutation) Op() Op {
+	return m.op
+}
+
+// SetOp allows setting the mutation operation.
+func (m *ProjectTemplateMutation) SetOp(op Op) {
+	m.op = op
+}
+
+// Type returns the node type of this mutation (ProjectTemplate).
+func (m *ProjectTemplateMutation) Type() string {
+	return m.typ
+}
+
+// Fields returns all fields that were changed during this mutation. Note that in
+// order to get all numeric fields that were incremented/decremented, call
+// AddedFields().
+func (m *ProjectTemplateMutation) Fields() []string {
+	fields := make([]string, 0, 10)
+	if m.name != nil {
+		fields = append(fields, projecttemplate.FieldName)
+	}
+	if m.description != nil {
+		fields = append(fields, projecttemplate.FieldDescription)
+	}
+	if m.ai_model != nil {
+		fields = append(fields, projecttemplate.FieldAiModel)
+	}
+	if m.ai_prompt != nil {
+		fields = append(fields, projecttemplate.FieldAiPrompt)
+	}
+	if m.highlight_ai_model != nil {
+		fields = append(fields, projecttemplate.FieldHighlightAiModel)
+	}
+	if m.highlight_ai_prompt != nil {
+		fields = append(fields, projecttemplate.FieldHighlightAiPrompt)
+	}
+	if m.export_presets != nil {
+		fields = append(fields, projecttemplate.FieldExportPresets)
+	}
+	if m.section_titles != nil {
+		fields = append(fields, projecttemplate.FieldSectionTitles)
+	}
+	if m.created_at != nil {
+		fields = append(fields, projecttemplate.FieldCreatedAt)
+	}
+	if m.updated_at != nil {
+		fields = append(fields, projecttemplate.FieldUpdatedAt)
+	}
+	return fields
+}
+
+// Field returns the value of a field with the given name. The second boolean
+// return value indicates that this field was not set, or was not defined in the
+// schema.
+func (m *ProjectTemplateMutation) Field(name string) (ent.Value, bool) {
+	switch name {
+	case projecttemplate.FieldName:
+		return m.Name()
+	case projecttemplate.FieldDescription:
+		return m.Description()
+	case projecttemplate.FieldAiModel:
+		return m.AiModel()
+	case projecttemplate.FieldAiPrompt:
+		return m.AiPrompt()
+	case projecttemplate.FieldHighlightAiModel:
+		return m.HighlightAiModel()
+	case projecttemplate.FieldHighlightAiPrompt:
+		return m.HighlightAiPrompt()
+	case projecttemplate.FieldExportPresets:
+		return m.ExportPresets()
+	case projecttemplate.FieldSectionTitles:
+		return m.SectionTitles()
+	case projecttemplate.FieldCreatedAt:
+		return m.CreatedAt()
+	case projecttemplate.FieldUpdatedAt:
+		return m.UpdatedAt()
+	}
+	return nil, false
+}
+
+// OldField returns the old value of the field from the database. An error is
+// returned if the mutation operation is not UpdateOne, or the query to the
+// database failed.
+func (m *ProjectTemplateMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
+	switch name {
+	case projecttemplate.FieldName:
+		return m.OldName(ctx)
+	case projecttemplate.FieldDescription:
+		return m.OldDescription(ctx)
+	case projecttemplate.FieldAiModel:
+		return m.OldAiModel(ctx)
+	case projecttemplate.FieldAiPrompt:
+		return m.OldAiPrompt(ctx)
+	case projecttemplate.FieldHighlightAiModel:
+		return m.OldHighlightAiModel(ctx)
+	case projecttemplate.FieldHighlightAiPrompt:
+		return m.OldHighlightAiPrompt(ctx)
+	case projecttemplate.FieldExportPresets:
+		return m.OldExportPresets(ctx)
+	case projecttemplate.FieldSectionTitles:
+		return m.OldSectionTitles(ctx)
+	case projecttemplate.FieldCreatedAt:
+		return m.OldCreatedAt(ctx)
+	case projecttemplate.FieldUpdatedAt:
+		return m.OldUpdatedAt(ctx)
+	}
+	return nil, fmt.Errorf("unknown ProjectTemplate field %s", name)
+}
+
+// SetField sets the value of a field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *ProjectTemplateMutation) SetField(name string, value ent.Value) error {
+	switch name {
+	case projecttemplate.FieldName:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetName(v)
+		return nil
+	case projecttemplate.FieldDescription:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetDescription(v)
+		return nil
+	case projecttemplate.FieldAiModel:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetAiModel(v)
+		return nil
+	case projecttemplate.FieldAiPrompt:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetAiPrompt(v)
+		return nil
+	case projecttemplate.FieldHighlightAiModel:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetHighlightAiModel(v)
+		return nil
+	case projecttemplate.FieldHighlightAiPrompt:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetHighlightAiPrompt(v)
+		return nil
+	case projecttemplate.FieldExportPresets:
+		v, ok := value.([]schema.ExportPreset)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetExportPresets(v)
+		return nil
+	case projecttemplate.FieldSectionTitles:
+		v, ok := value.([]string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetSectionTitles(v)
+		return nil
+	case projecttemplate.FieldCreatedAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetCreatedAt(v)
+		return nil
+	case projecttemplate.FieldUpdatedAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetUpdatedAt(v)
+		return nil
+	}
+	return fmt.Errorf("unknown ProjectTemplate field %s", name)
+}
+
+// AddedFields returns all numeric fields that were incremented/decremented during
+// this mutation.
+func (m *ProjectTemplateMutation) AddedFields() []string {
+	return nil
+}
+
+// AddedField returns the numeric value that was incremented/decremented on a field
+// with the given name. The second boolean return value indicates that this field
+// was not set, or was not defined in the schema.
+func (m *ProjectTemplateMutation) AddedField(name string) (ent.Value, bool) {
+	return nil, false
+}
+
+// AddField adds the value to the field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *ProjectTemplateMutation) AddField(name string, value ent.Value) error {
+	switch name {
+	}
+	return fmt.Errorf("unknown ProjectTemplate numeric field %s", name)
+}
+
+// ClearedFields returns all nullable fields that were cleared during this
+// mutation.
+func (m *ProjectTemplateMutation) ClearedFields() []string {
+	var fields []string
+	if m.FieldCleared(projecttemplate.FieldDescription) {
+		fields = append(fields, projecttemplate.FieldDescription)
+	}
+	if m.FieldCleared(projecttemplate.FieldAiModel) {
+		fields = append(fields, projecttemplate.FieldAiModel)
+	}
+	if m.FieldCleared(projecttemplate.FieldAiPrompt) {
+		fields = append(fields, projecttemplate.FieldAiPrompt)
+	}
+	if m.FieldCleared(projecttemplate.FieldHighlightAiModel) {
+		fields = append(fields, projecttemplate.FieldHighlightAiModel)
+	}
+	if m.FieldCleared(projecttemplate.FieldHighlightAiPrompt) {
+		fields = append(fields, projecttemplate.FieldHighlightAiPrompt)
+	}
+	if m.FieldCleared(projecttemplate.FieldExportPresets) {
+		fields = append(fields, projecttemplate.FieldExportPresets)
+	}
+	if m.FieldCleared(projecttemplate.FieldSectionTitles) {
+		fields = append(fields, projecttemplate.FieldSectionTitles)
+	}
+	return fields
+}
+
+// FieldCleared returns a boolean indicating if a field with the given name was
+// cleared in this mutation.
+func (m *ProjectTemplateMutation) FieldCleared(name string) bool {
+	_, ok := m.clearedFields[name]
+	return ok
+}
+
+// ClearField clears the value of the field with the given name. It returns an
+// error if the field is not defined in the schema.
+func (m *ProjectTemplateMutation) ClearField(name string) error {
+	switch name {
+	case projecttemplate.FieldDescription:
+		m.ClearDescription()
+		return nil
+	case projecttemplate.FieldAiModel:
+		m.ClearAiModel()
+		return nil
+	case projecttemplate.FieldAiPrompt:
+		m.ClearAiPrompt()
+		return nil
+	case projecttemplate.FieldHighlightAiModel:
+		m.ClearHighlightAiModel()
+		return nil
+	case projecttemplate.FieldHighlightAiPrompt:
+		m.ClearHighlightAiPrompt()
+		return nil
+	case projecttemplate.FieldExportPresets:
+		m.ClearExportPresets()
+		return nil
+	case projecttemplate.FieldSectionTitles:
+		m.ClearSectionTitles()
+		return nil
+	}
+	return fmt.Errorf("unknown ProjectTemplate nullable field %s", name)
+}
+
+// ResetField resets all changes in the mutation for the field with the given name.
+// It returns an error if the field is not defined in the schema.
+func (m *ProjectTemplateMutation) ResetField(name string) error {
+	switch name {
+	case projecttemplate.FieldName:
+		m.ResetName()
+		return nil
+	case projecttemplate.FieldDescription:
+		m.ResetDescription()
+		return nil
+	case projecttemplate.FieldAiModel:
+		m.ResetAiModel()
+		return nil
+	case projecttemplate.FieldAiPrompt:
+		m.ResetAiPrompt()
+		return nil
+	case projecttemplate.FieldHighlightAiModel:
+		m.ResetHighlightAiModel()
+		return nil
+	case projecttemplate.FieldHighlightAiPrompt:
+		m.ResetHighlightAiPrompt()
+		return nil
+	case projecttemplate.FieldExportPresets:
+		m.ResetExportPresets()
+		return nil
+	case projecttemplate.FieldSectionTitles:
+		m.ResetSectionTitles()
+		return nil
+	case projecttemplate.FieldCreatedAt:
+		m.ResetCreatedAt()
+		return nil
+	case projecttemplate.FieldUpdatedAt:
+		m.ResetUpdatedAt()
+		return nil
+	}
+	return fmt.Errorf("unknown ProjectTemplate field %s", name)
+}
+
+// AddedEdges returns all edge names that were set/added in this mutation.
+func (m *ProjectTemplateMutation) AddedEdges() []string {
+	edges := make([]string, 0, 0)
+	return edges
+}
+
+// AddedIDs returns all IDs (to other nodes) that were added for the given edge
+// name in this mutation.
+func (m *ProjectTemplateMutation) AddedIDs(name string) []ent.Value {
+	return nil
+}
+
+// RemovedEdges returns all edge names that were removed in this mutation.
+func (m *ProjectTemplateMutation) RemovedEdges() []string {
+	edges := make([]string, 0, 0)
+	return edges
+}
+
+// RemovedIDs returns all IDs (to other nodes) that were removed for the edge with
+// the given name in this mutation.
+func (m *ProjectTemplateMutation) RemovedIDs(name string) []ent.Value {
+	return nil
+}
+
+// ClearedEdges returns all edge names that were cleared in this mutation.
+func (m *ProjectTemplateMutation) ClearedEdges() []string {
+	edges := make([]string, 0, 0)
+	return edges
+}
+
+// EdgeCleared returns a boolean which indicates if the edge with the given name
+// was cleared in this mutation.
+func (m *ProjectTemplateMutation) EdgeCleared(name string) bool {
+	return false
+}
+
+// ClearEdge clears the value of the edge with the given name. It returns an error
+// if that edge is not defined in the schema.
+func (m *ProjectTemplateMutation) ClearEdge(name string) error {
+	return fmt.Errorf("unknown ProjectTemplate unique edge %s", name)
+}
+
+// ResetEdge resets all changes to the edge with the given name in this mutation.
+// It returns an error if the edge is not defined in the schema.
+func (m *ProjectTemplateMutation) ResetEdge(name string) error {
+	return fmt.Errorf("unknown ProjectTemplate edge %s", name)
+}
+
+// PromptMutation represents an operation that mutates the Prompt nodes in the graph.
+type PromptMutation struct {
+	config
+	op              Op
+	typ             string
+	id              *int
+	name            *string
+	version         *int
+	addversion      *int
+	task_type       *string
+	body            *string
+	description     *string
+	variables       *[]schema.PromptVariable
+	appendvariables []schema.PromptVariable
+	created_at      *time.Time
+	clearedFields   map[string]struct{}
+	project         *int
+	clearedproject  bool
+	done            bool
+	oldValue        func(context.Context) (*Prompt, error)
+	predicates      []predicate.Prompt
+}
+
+var _ ent.Mutation = (*PromptMutation)(nil)
+
+// promptOption allows management of the mutation configuration using functional options.
+type promptOption func(*PromptMutation)
+
+// newPromptMutation creates new mutation for the Prompt entity.
+func newPromptMutation(c config, op Op, opts ...promptOption) *PromptMutation {
+	m := &PromptMutation{
+		config:        c,
+		op:            op,
+		typ:           TypePrompt,
+		clearedFields: make(map[string]struct{}),
+	}
+	for _, opt := range opts {
+		opt(m)
+	}
+	return m
+}
+
+// withPromptID sets the ID field of the mutation.
+func withPromptID(id int) promptOption {
+	return func(m *PromptMutation) {
+		var (
+			err   error
+			once  sync.Once
+			value *Prompt
+		)
+		m.oldValue = func(ctx context.Context) (*Prompt, error) {
+			once.Do(func() {
+				if m.done {
+					err = errors.New("querying old values post mutation is not allowed")
+				} else {
+					value, err = m.Client().Prompt.Get(ctx, id)
+				}
+			})
+			return value, err
+		}
+		m.id = &id
+	}
+}
+
+// withPrompt sets the old Prompt of the mutation.
+func withPrompt(node *Prompt) promptOption {
+	return func(m *PromptMutation) {
+		m.oldValue = func(context.Context) (*Prompt, error) {
+			return node, nil
+		}
+		m.id = &node.ID
+	}
+}
+
+// Client returns a new `ent.Client` from the mutation. If the mutation was
+// executed in a transaction (ent.Tx), a transactional client is returned.
+func (m PromptMutation) Client() *Client {
+	client := &Client{config: m.config}
+	client.init()
+	return client
+}
+
+// Tx returns an `ent.Tx` for mutations that were executed in transactions;
+// it returns an error otherwise.
+func (m PromptMutation) Tx() (*Tx, error) {
+	if _, ok := m.driver.(*txDriver); !ok {
+		return nil, errors.New("ent: mutation is not running in a transaction")
+	}
+	tx := &Tx{config: m.config}
+	tx.init()
+	return tx, nil
+}
+
+// ID returns the ID value in the mutation. Note that the ID is only available
+// if it was provided to the builder or after it was returned from the database.
+func (m *PromptMutation) ID() (id int, exists bool) {
+	if m.id == nil {
+		return
+	}
+	return *m.id, true
+}
+
+// IDs queries the database and returns the entity ids that match the mutation's predicate.
+// That means, if the mutation is applied within a transaction with an isolation level such
+// as sql.LevelSerializable, the returned ids match the ids of the rows that will be updated
+// or updated by the mutation.
+func (m *PromptMutation) IDs(ctx context.Context) ([]int, error) {
+	switch {
+	case m.op.Is(OpUpdateOne | OpDeleteOne):
+		id, exists := m.ID()
+		if exists {
+			return []int{id}, nil
+		}
+		fallthrough
+	case m.op.Is(OpUpdate | OpDelete):
+		return m.Client().Prompt.Query().Where(m.predicates...).IDs(ctx)
+	default:
+		return nil, fmt.Errorf("IDs is not allowed on %s operations", m.op)
+	}
+}
+
+// SetName sets the "name" field.
+func (m *PromptMutation) SetName(s string) {
+	m.name = &s
+}
+
+// Name returns the value of the "name" field in the mutation.
+func (m *PromptMutation) Name() (r string, exists bool) {
+	v := m.name
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldName returns the old "name" field's value of the Prompt entity.
+// If the Prompt object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *PromptMutation) OldName(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldName is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldName requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldName: %w", err)
+	}
+	return oldValue.Name, nil
+}
+
+// ResetName resets all changes to the "name" field.
+func (m *PromptMutation) ResetName() {
+	m.name = nil
+}
+
+// SetVersion sets the "version" field.
+func (m *PromptMutation) SetVersion(i int) {
+	m.version = &i
+	m.addversion = nil
+}
+
+// Version returns the value of the "version" field in the mutation.
+func (m *PromptMutation) Version() (r int, exists bool) {
+	v := m.version
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldVersion returns the old "version" field's value of the Prompt entity.
+// If the Prompt object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *PromptMutation) OldVersion(ctx context.Context) (v int, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldVersion is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldVersion requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldVersion: %w", err)
+	}
+	return oldValue.Version, nil
+}
+
+// AddVersion adds i to the "version" field.
+func (m *PromptMutation) AddVersion(i int) {
+	if m.addversion != nil {
+		*m.addversion += i
+	} else {
+		m.addversion = &i
+	}
+}
+
+// AddedVersion returns the value that was added to the "version" field in this mutation.
+func (m *PromptMutation) AddedVersion() (r int, exists bool) {
+	v := m.addversion
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// ResetVersion resets all changes to the "version" field.
+func (m *PromptMutation) ResetVersion() {
+	m.version = nil
+	m.addversion = nil
+}
+
+// SetTaskType sets the "task_type" field.
+func (m *PromptMutation) SetTaskType(s string) {
+	m.task_type = &s
+}
+
+// TaskType returns the value of the "task_type" field in the mutation.
+func (m *PromptMutation) TaskType() (r string, exists bool) {
+	v := m.task_type
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldTaskType returns the old "task_type" field's value of the Prompt entity.
+// If the Prompt object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *PromptMutation) OldTaskType(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldTaskType is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldTaskType requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldTaskType: %w", err)
+	}
+	return oldValue.TaskType, nil
+}
+
+// ResetTaskType resets all changes to the "task_type" field.
+func (m *PromptMutation) ResetTaskType() {
+	m.task_type = nil
+}
+
+// SetBody sets the "body" field.
+func (m *PromptMutation) SetBody(s string) {
+	m.body = &s
+}
+
+// Body returns the value of the "body" field in the mutation.
+func (m *PromptMutation) Body() (r string, exists bool) {
+	v := m.body
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldBody returns the old "body" field's value of the Prompt entity.
+// If the Prompt object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *PromptMutation) OldBody(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldBody is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldBody requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldBody: %w", err)
+	}
+	return oldValue.Body, nil
+}
+
+// ResetBody resets all changes to the "body" field.
+func (m *PromptMutation) ResetBody() {
+	m.body = nil
+}
+
+// SetDescription sets the "description" field.
+func (m *PromptMutation) SetDescription(s string) {
+	m.description = &s
+}
+
+// Description returns the value of the "description" field in the mutation.
+func (m *PromptMutation) Description() (r string, exists bool) {
+	v := m.description
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldDescription returns the old "description" field's value of the Prompt entity.
+// If the Prompt object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *PromptMutation) OldDescription(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldDescription is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldDescription requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldDescription: %w", err)
+	}
+	return oldValue.Description, nil
+}
+
+// ClearDescription clears the value of the "description" field.
+func (m *PromptMutation) ClearDescription() {
+	m.description = nil
+	m.clearedFields[prompt.FieldDescription] = struct{}{}
+}
+
+// DescriptionCleared returns if the "description" field was cleared in this mutation.
+func (m *PromptMutation) DescriptionCleared() bool {
+	_, ok := m.clearedFields[prompt.FieldDescription]
+	return ok
+}
+
+// ResetDescription resets all changes to the "description" field.
+func (m *PromptMutation) ResetDescription() {
+	m.description = nil
+	delete(m.clearedFields, prompt.FieldDescription)
+}
+
+// SetVariables sets the "variables" field.
+func (m *PromptMutation) SetVariables(sv []schema.PromptVariable) {
+	m.variables = &sv
+	m.appendvariables = nil
+}
+
+// Variables returns the value of the "variables" field in the mutation.
+func (m *PromptMutation) Variables() (r []schema.PromptVariable, exists bool) {
+	v := m.variables
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldVariables returns the old "variables" field's value of the Prompt entity.
+// If the Prompt object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *PromptMutation) OldVariables(ctx context.Context) (v []schema.PromptVariable, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldVariables is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldVariables requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldVariables: %w", err)
+	}
+	return oldValue.Variables, nil
+}
+
+// AppendVariables adds sv to the "variables" field.
+func (m *PromptMutation) AppendVariables(sv []schema.PromptVariable) {
+	m.appendvariables = append(m.appendvariables, sv...)
+}
+
+// AppendedVariables returns the list of values that were appended to the "variables" field in this mutation.
+func (m *PromptMutation) AppendedVariables() ([]schema.PromptVariable, bool) {
+	if len(m.appendvariables) == 0 {
+		return nil, false
+	}
+	return m.appendvariables, true
+}
+
+// ClearVariables clears the value of the "variables" field.
+func (m *PromptMutation) ClearVariables() {
+	m.variables = nil
+	m.appendvariables = nil
+	m.clearedFields[prompt.FieldVariables] = struct{}{}
+}
+
+// VariablesCleared returns if the "variables" field was cleared in this mutation.
+func (m *PromptMutation) VariablesCleared() bool {
+	_, ok := m.clearedFields[prompt.FieldVariables]
+	return ok
+}
+
+// ResetVariables resets all changes to the "variables" field.
+func (m *PromptMutation) ResetVariables() {
+	m.variables = nil
+	m.appendvariables = nil
+	delete(m.clearedFields, prompt.FieldVariables)
+}
+
+// SetProjectID sets the "project_id" field.
+func (m *PromptMutation) SetProjectID(i int) {
+	m.project = &i
+}
+
+// ProjectID returns the value of the "project_id" field in the mutation.
+func (m *PromptMutation) ProjectID() (r int, exists bool) {
+	v := m.project
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldProjectID returns the old "project_id" field's value of the Prompt entity.
+// If the Prompt object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *PromptMutation) OldProjectID(ctx context.Context) (v int, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldProjectID is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldProjectID requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldProjectID: %w", err)
+	}
+	return oldValue.ProjectID, nil
+}
+
+// ClearProjectID clears the value of the "project_id" field.
+func (m *PromptMutation) ClearProjectID() {
+	m.project = nil
+	m.clearedFields[prompt.FieldProjectID] = struct{}{}
+}
+
+// ProjectIDCleared returns if the "project_id" field was cleared in this mutation.
+func (m *PromptMutation) ProjectIDCleared() bool {
+	_, ok := m.clearedFields[prompt.FieldProjectID]
+	return ok
+}
+
+// ResetProjectID resets all changes to the "project_id" field.
+func (m *PromptMutation) ResetProjectID() {
+	m.project = nil
+	delete(m.clearedFields, prompt.FieldProjectID)
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (m *PromptMutation) SetCreatedAt(t time.Time) {
+	m.created_at = &t
+}
+
+// CreatedAt returns the value of the "created_at" field in the mutation.
+func (m *PromptMutation) CreatedAt() (r time.Time, exists bool) {
+	v := m.created_at
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldCreatedAt returns the old "created_at" field's value of the Prompt entity.
+// If the Prompt object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *PromptMutation) OldCreatedAt(ctx context.Context) (v time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldCreatedAt is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldCreatedAt requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldCreatedAt: %w", err)
+	}
+	return oldValue.CreatedAt, nil
+}
+
+// ResetCreatedAt resets all changes to the "created_at" field.
+func (m *PromptMutation) ResetCreatedAt() {
+	m.created_at = nil
+}
+
+// ClearProject clears the "project" edge to the Project entity.
+func (m *PromptMutation) ClearProject() {
+	m.clearedproject = true
+	m.clearedFields[prompt.FieldProjectID] = struct{}{}
+}
+
+// ProjectCleared reports if the "project" edge to the Project entity was cleared.
+func (m *PromptMutation) ProjectCleared() bool {
+	return m.ProjectIDCleared() || m.clearedproject
+}
+
+// ProjectIDs returns the "project" edge IDs in the mutation.
+// Note that IDs always returns len(IDs) <= 1 for unique edges, and you should use
+// ProjectID instead. It exists only for internal usage by the builders.
+func (m *PromptMutation) ProjectIDs() (ids []int) {
+	if id := m.project; id != nil {
+		ids = append(ids, *id)
+	}
+	return
+}
+
+// ResetProject resets all changes to the "project" edge.
+func (m *PromptMutation) ResetProject() {
+	m.project = nil
+	m.clearedproject = false
+}
+
+// Where appends a list predicates to the PromptMutation builder.
+func (m *PromptMutation) Where(ps ...predicate.Prompt) {
+	m.predicates = append(m.predicates, ps...)
+}
+
+// WhereP appends storage-level predicates to the PromptMutation builder. Using this method,
+// users can use type-assertion to append predicates that do not depend on any generated package.
+func (m *PromptMutation) WhereP(ps ...func(*sql.Selector)) {
+	p := make([]predicate.Prompt, len(ps))
+	for i := range ps {
+		p[i] = ps[i]
+	}
+	m.Where(p...)
+}
+
+// Op returns the operation name.
+func (m *PromptMutation) Op() Op {
+	return m.op
+}
+
+// SetOp allows setting the mutation operation.
+func (m *PromptMutation) SetOp(op Op) {
+	m.op = op
+}
+
+// Type returns the node type of this mutation (Prompt).
+func (m *PromptMutation) Type() string {
+	return m.typ
+}
+
+// Fields returns all fields that were changed during this mutation. Note that in
+// order to get all numeric fields that were incremented/decremented, call
+// AddedFields().
+func (m *PromptMutation) Fields() []string {
+	fields := make([]string, 0, 8)
+	if m.name != nil {
+		fields = append(fields, prompt.FieldName)
+	}
+	if m.version != nil {
+		fields = append(fields, prompt.FieldVersion)
+	}
+	if m.task_type != nil {
+		fields = append(fields, prompt.FieldTaskType)
+	}
+	if m.body != nil {
+		fields = append(fields, prompt.FieldBody)
+	}
+	if m.description != nil {
+		fields = append(fields, prompt.FieldDescription)
+	}
+	if m.variables != nil {
+		fields = append(fields, prompt.FieldVariables)
+	}
+	if m.project != nil {
+		fields = append(fields, prompt.FieldProjectID)
+	}
+	if m.created_at != nil {
+		fields = append(fields, prompt.FieldCreatedAt)
+	}
+	return fields
+}
+
+// Field returns the value of a field with the given name. The second boolean
+// return value indicates that this field was not set, or was not defined in the
+// schema.
+func (m *PromptMutation) Field(name string) (ent.Value, bool) {
+	switch name {
+	case prompt.FieldName:
+		return m.Name()
+	case prompt.FieldVersion:
+		return m.Version()
+	case prompt.FieldTaskType:
+		return m.TaskType()
+	case prompt.FieldBody:
+		return m.Body()
+	case prompt.FieldDescription:
+		return m.Description()
+	case prompt.FieldVariables:
+		return m.Variables()
+	case prompt.FieldProjectID:
+		return m.ProjectID()
+	case prompt.FieldCreatedAt:
+		return m.CreatedAt()
+	}
+	return nil, false
+}
+
+// OldField returns the old value of the field from the database. An error is
+// returned if the mutation operation is not UpdateOne, or the query to the
+// database failed.
+func (m *PromptMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
+	switch name {
+	case prompt.FieldName:
+		return m.OldName(ctx)
+	case prompt.FieldVersion:
+		return m.OldVersion(ctx)
+	case prompt.FieldTaskType:
+		return m.OldTaskType(ctx)
+	case prompt.FieldBody:
+		return m.OldBody(ctx)
+	case prompt.FieldDescription:
+		return m.OldDescription(ctx)
+	case prompt.FieldVariables:
+		return m.OldVariables(ctx)
+	case prompt.FieldProjectID:
+		return m.OldProjectID(ctx)
+	case prompt.FieldCreatedAt:
+		return m.OldCreatedAt(ctx)
+	}
+	return nil, fmt.Errorf("unknown Prompt field %s", name)
+}
+
+// SetField sets the value of a field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *PromptMutation) SetField(name string, value ent.Value) error {
+	switch name {
+	case prompt.FieldName:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetName(v)
+		return nil
+	case prompt.FieldVersion:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetVersion(v)
+		return nil
+	case prompt.FieldTaskType:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetTaskType(v)
+		return nil
+	case prompt.FieldBody:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetBody(v)
+		return nil
+	case prompt.FieldDescription:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetDescription(v)
+		return nil
+	case prompt.FieldVariables:
+		v, ok := value.([]schema.PromptVariable)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetVariables(v)
+		return nil
+	case prompt.FieldProjectID:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetProjectID(v)
+		return nil
+	case prompt.FieldCreatedAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetCreatedAt(v)
+		return nil
+	}
+	return fmt.Errorf("unknown Prompt field %s", name)
+}
+
+// AddedFields returns all numeric fields that were incremented/decremented during
+// this mutation.
+func (m *PromptMutation) AddedFields() []string {
+	var fields []string
+	if m.addversion != nil {
+		fields = append(fields, prompt.FieldVersion)
+	}
+	return fields
+}
+
+// AddedField returns the numeric value that was incremented/decremented on a field
+// with the given name. The second boolean return value indicates that this field
+// was not set, or was not defined in the schema.
+func (m *PromptMutation) AddedField(name string) (ent.Value, bool) {
+	switch name {
+	case prompt.FieldVersion:
+		return m.AddedVersion()
+	}
+	return nil, false
+}
+
+// AddField adds the value to the field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *PromptMutation) AddField(name string, value ent.Value) error {
+	switch name {
+	case prompt.FieldVersion:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddVersion(v)
+		return nil
+	}
+	return fmt.Errorf("unknown Prompt numeric field %s", name)
+}
+
+// ClearedFields returns all nullable fields that were cleared during this
+// mutation.
+func (m *PromptMutation) ClearedFields() []string {
+	var fields []string
+	if m.FieldCleared(prompt.FieldDescription) {
+		fields = append(fields, prompt.FieldDescription)
+	}
+	if m.FieldCleared(prompt.FieldVariables) {
+		fields = append(fields, prompt.FieldVariables)
+	}
+	if m.FieldCleared(prompt.FieldProjectID) {
+		fields = append(fields, prompt.FieldProjectID)
+	}
+	return fields
+}
+
+// FieldCleared returns a boolean indicating if a field with the given name was
+// cleared in this mutation.
+func (m *PromptMutation) FieldCleared(name string) bool {
+	_, ok := m.clearedFields[name]
+	return ok
+}
+
+// ClearField clears the value of the field with the given name. It returns an
+// error if the field is not defined in the schema.
+func (m *PromptMutation) ClearField(name string) error {
+	switch name {
+	case prompt.FieldDescription:
+		m.ClearDescription()
+		return nil
+	case prompt.FieldVariables:
+		m.ClearVariables()
+		return nil
+	case prompt.FieldProjectID:
+		m.ClearProjectID()
+		return nil
+	}
+	return fmt.Errorf("unknown Prompt nullable field %s", name)
+}
+
+// ResetField resets all changes in the mutation for the field with the given name.
+// It returns an error if the field is not defined in the schema.
+func (m *PromptMutation) ResetField(name string) error {
+	switch name {
+	case prompt.FieldName:
+		m.ResetName()
+		return nil
+	case prompt.FieldVersion:
+		m.ResetVersion()
+		return nil
+	case prompt.FieldTaskType:
+		m.ResetTaskType()
+		return nil
+	case prompt.FieldBody:
+		m.ResetBody()
+		return nil
+	case prompt.FieldDescription:
+		m.ResetDescription()
+		return nil
+	case prompt.FieldVariables:
+		m.ResetVariables()
+		return nil
+	case prompt.FieldProjectID:
+		m.ResetProjectID()
+		return nil
+	case prompt.FieldCreatedAt:
+		m.ResetCreatedAt()
+		return nil
+	}
+	return fmt.Errorf("unknown Prompt field %s", name)
+}
+
+// AddedEdges returns all edge names that were set/added in this mutation.
+func (m *PromptMutation) AddedEdges() []string {
+	edges := make([]string, 0, 1)
+	if m.project != nil {
+		edges = append(edges, prompt.EdgeProject)
+	}
+	return edges
+}
+
+// AddedIDs returns all IDs (to other nodes) that were added for the given edge
+// name in this mutation.
+func (m *PromptMutation) AddedIDs(name string) []ent.Value {
+	switch name {
+	case prompt.EdgeProject:
+		if id := m.project; id != nil {
+			return []ent.Value{*id}
+		}
+	}
+	return nil
+}
+
+// RemovedEdges returns all edge names that were removed in this mutation.
+func (m *PromptMutation) RemovedEdges() []string {
+	edges := make([]string, 0, 1)
+	return edges
+}
+
+// RemovedIDs returns all IDs (to other nodes) that were removed for the edge with
+// the given name in this mutation.
+func (m *PromptMutation) RemovedIDs(name string) []ent.Value {
+	return nil
+}
+
+// ClearedEdges returns all edge names that were cleared in this mutation.
+func (m *PromptMutation) ClearedEdges() []string {
+	edges := make([]string, 0, 1)
+	if m.clearedproject {
+		edges = append(edges, prompt.EdgeProject)
+	}
+	return edges
+}
+
+// EdgeCleared returns a boolean which indicates if the edge with the given name
+// was cleared in this mutation.
+func (m *PromptMutation) EdgeCleared(name string) bool {
+	switch name {
+	case prompt.EdgeProject:
+		return m.clearedproject
+	}
+	return false
+}
+
+// ClearEdge clears the value of the edge with the given name. It returns an error
+// if that edge is not defined in the schema.
+func (m *PromptMutation) ClearEdge(name string) error {
+	switch name {
+	case prompt.EdgeProject:
+		m.ClearProject()
+		return nil
+	}
+	return fmt.Errorf("unknown Prompt unique edge %s", name)
+}
+
+// ResetEdge resets all changes to the edge with the given name in this mutation.
+// It returns an error if the edge is not defined in the schema.
+func (m *PromptMutation) ResetEdge(name string) error {
+	switch name {
+	case prompt.EdgeProject:
+		m.ResetProject()
+		return nil
+	}
+	return fmt.Errorf("unknown Prompt edge %s", name)
+}
+
+// ResponseCacheEntryMutation represents an operation that mutates the ResponseCacheEntry nodes in the graph.
+type ResponseCacheEntryMutation struct {
+	config
+	op            Op
+	typ           string
+	id            *int
+	key           *string
+	task_type     *string
+	model         *string
+	response      *string
+	hits          *int
+	addhits       *int
+	created_at    *time.Time
+	expires_at    *time.Time
+	clearedFields map[string]struct{}
+	done          bool
+	oldValue      func(context.Context) (*ResponseCacheEntry, error)
+	predicates    []predicate.ResponseCacheEntry
+}
+
+var _ ent.Mutation = (*ResponseCacheEntryMutation)(nil)
+
+// responsecacheentryOption allows management of the mutation configuration using functional options.
+type responsecacheentryOption func(*ResponseCacheEntryMutation)
+
+// newResponseCacheEntryMutation creates new mutation for the ResponseCacheEntry entity.
+func newResponseCacheEntryMutation(c config, op Op, opts ...responsecacheentryOption) *ResponseCacheEntryMutation {
+	m := &ResponseCacheEntryMutation{
+		config:        c,
+		op:            op,
+		typ:           TypeResponseCacheEntry,
+		clearedFields: make(map[string]struct{}),
+	}
+	for _, opt := range opts {
+		opt(m)
+	}
+	return m
+}
+
+// withResponseCacheEntryID sets the ID field of the mutation.
+func withResponseCacheEntryID(id int) responsecacheentryOption {
+	return func(m *ResponseCacheEntryMutation) {
+		var (
+			err   error
+			once  sync.Once
+			value *ResponseCacheEntry
+		)
+		m.oldValue = func(ctx context.Context) (*ResponseCacheEntry, error) {
+			once.Do(func() {
+				if m.done {
+					err = errors.New("querying old values post mutation is not allowed")
+				} else {
+					value, err = m.Client().ResponseCacheEntry.Get(ctx, id)
+				}
+			})
+			return value, err
+		}
+		m.id = &id
+	}
+}
+
+// withResponseCacheEntry sets the old ResponseCacheEntry of the mutation.
+func withResponseCacheEntry(node *ResponseCacheEntry) responsecacheentryOption {
+	return func(m *ResponseCacheEntryMutation) {
+		m.oldValue = func(context.Context) (*ResponseCacheEntry, error) {
+			return node, nil
+		}
+		m.id = &node.ID
+	}
+}
+
+// Client returns a new `ent.Client` from the mutation. If the mutation was
+// executed in a transaction (ent.Tx), a transactional client is returned.
+func (m ResponseCacheEntryMutation) Client() *Client {
+	client := &Client{config: m.config}
+	client.init()
+	return client
+}
+
+// Tx returns an `ent.Tx` for mutations that were executed in transactions;
+// it returns an error otherwise.
+func (m ResponseCacheEntryMutation) Tx() (*Tx, error) {
+	if _, ok := m.driver.(*txDriver); !ok {
+		return nil, errors.New("ent: mutation is not running in a transaction")
+	}
+	tx := &Tx{config: m.config}
+	tx.init()
+	return tx, nil
+}
+
+// ID returns the ID value in the mutation. Note that the ID is only available
+// if it was provided to the builder or after it was returned from the database.
+func (m *ResponseCacheEntryMutation) ID() (id int, exists bool) {
+	if m.id == nil {
+		return
+	}
+	return *m.id, true
+}
+
+// IDs queries the database and returns the entity ids that match the mutation's predicate.
+// That means, if the mutation is applied within a transaction with an isolation level such
+// as sql.LevelSerializable, the returned ids match the ids of the rows that will be updated
+// or updated by the mutation.
+func (m *ResponseCacheEntryMutation) IDs(ctx context.Context) ([]int, error) {
+	switch {
+	case m.op.Is(OpUpdateOne | OpDeleteOne):
+		id, exists := m.ID()
+		if exists {
+			return []int{id}, nil
+		}
+		fallthrough
+	case m.op.Is(OpUpdate | OpDelete):
+		return m.Client().ResponseCacheEntry.Query().Where(m.predicates...).IDs(ctx)
+	default:
+		return nil, fmt.Errorf("IDs is not allowed on %s operations", m.op)
+	}
+}
+
+// SetKey sets the "key" field.
+func (m *ResponseCacheEntryMutation) SetKey(s string) {
+	m.key = &s
+}
+
+// Key returns the value of the "key" field in the mutation.
+func (m *ResponseCacheEntryMutation) Key() (r string, exists bool) {
+	v := m.key
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldKey returns the old "key" field's value of the ResponseCacheEntry entity.
+// If the ResponseCacheEntry object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *ResponseCacheEntryMutation) OldKey(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldKey is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldKey requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldKey: %w", err)
+	}
+	return oldValue.Key, nil
+}
+
+// ResetKey resets all changes to the "key" field.
+func (m *ResponseCacheEntryMutation) ResetKey() {
+	m.key = nil
+}
+
+// SetTaskType sets the "task_type" field.
+func (m *ResponseCacheEntryMutation) SetTaskType(s string) {
+	m.task_type = &s
+}
+
+// TaskType returns the value of the "task_type" field in the mutation.
+func (m *ResponseCacheEntryMutation) TaskType() (r string, exists bool) {
+	v := m.task_type
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldTaskType returns the old "task_type" field's value of the ResponseCacheEntry entity.
+// If the ResponseCacheEntry object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *ResponseCacheEntryMutation) OldTaskType(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldTaskType is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldTaskType requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldTaskType: %w", err)
+	}
+	return oldValue.TaskType, nil
+}
+
+// ClearTaskType clears the value of the "task_type" field.
+func (m *ResponseCacheEntryMutation) ClearTaskType() {
+	m.task_type = nil
+	m.clearedFields[responsecacheentry.FieldTaskType] = struct{}{}
+}
+
+// TaskTypeCleared returns if the "task_type" field was cleared in this mutation.
+func (m *ResponseCacheEntryMutation) TaskTypeCleared() bool {
+	_, ok := m.clearedFields[responsecacheentry.FieldTaskType]
+	return ok
+}
+
+// ResetTaskType resets all changes to the "task_type" field.
+func (m *ResponseCacheEntryMutation) ResetTaskType() {
+	m.task_type = nil
+	delete(m.clearedFields, responsecacheentry.FieldTaskType)
+}
+
+// SetModel sets the "model" field.
+func (m *ResponseCacheEntryMutation) SetModel(s string) {
+	m.model = &s
+}
+
+// Model returns the value of the "model" field in the mutation.
+func (m *ResponseCacheEntryMutation) Model() (r string, exists bool) {
+	v := m.model
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldModel returns the old "model" field's value of the ResponseCacheEntry entity.
+// If the ResponseCacheEntry object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *ResponseCacheEntryMutation) OldModel(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldModel is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldModel requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldModel: %w", err)
+	}
+	return oldValue.Model, nil
+}
+
+// ClearModel clears the value of the "model" field.
+func (m *ResponseCacheEntryMutation) ClearModel() {
+	m.model = nil
+	m.clearedFields[responsecacheentry.FieldModel] = struct{}{}
+}
+
+// ModelCleared returns if the "model" field was cleared in this mutation.
+func (m *ResponseCacheEntryMutation) ModelCleared() bool {
+	_, ok := m.clearedFields[responsecacheentry.FieldModel]
+	return ok
+}
+
+// ResetModel resets all changes to the "model" field.
+func (m *ResponseCacheEntryMutation) ResetModel() {
+	m.model = nil
+	delete(m.clearedFields, responsecacheentry.FieldModel)
+}
+
+// SetResponse sets the "response" field.
+func (m *ResponseCacheEntryMutation) SetResponse(s string) {
+	m.response = &s
+}
+
+// Response returns the value of the "response" field in the mutation.
+func (m *ResponseCacheEntryMutation) Response() (r string, exists bool) {
+	v := m.response
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldResponse returns the old "response" field's value of the ResponseCacheEntry entity.
+// If the ResponseCacheEntry object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *ResponseCacheEntryMutation) OldResponse(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldResponse is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldResponse requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldResponse: %w", err)
+	}
+	return oldValue.Response, nil
+}
+
+// ResetResponse resets all changes to the "response" field.
+func (m *ResponseCacheEntryMutation) ResetResponse() {
+	m.response = nil
+}
+
+// SetHits sets the "hits" field.
+func (m *ResponseCacheEntryMutation) SetHits(i int) {
+	m.hits = &i
+	m.addhits = nil
+}
+
+// Hits returns the value of the "hits" field in the mutation.
+func (m *ResponseCacheEntryMutation) Hits() (r int, exists bool) {
+	v := m.hits
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldHits returns the old "hits" field's value of the ResponseCacheEntry entity.
+// If the ResponseCacheEntry object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *ResponseCacheEntryMutation) OldHits(ctx context.Context) (v int, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldHits is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldHits requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldHits: %w", err)
+	}
+	return oldValue.Hits, nil
+}
+
+// AddHits adds i to the "hits" field.
+func (m *ResponseCacheEntryMutation) AddHits(i int) {
+	if m.addhits != nil {
+		*m.addhits += i
+	} else {
+		m.addhits = &i
+	}
+}
+
+// AddedHits returns the value that was added to the "hits" field in this mutation.
+func (m *ResponseCacheEntryMutation) AddedHits() (r int, exists bool) {
+	v := m.addhits
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// ResetHits resets all changes to the "hits" field.
+func (m *ResponseCacheEntryMutation) ResetHits() {
+	m.hits = nil
+	m.addhits = nil
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (m *ResponseCacheEntryMutation) SetCreatedAt(t time.Time) {
+	m.created_at = &t
+}
+
+// CreatedAt returns the value of the "created_at" field in the mutation.
+func (m *ResponseCacheEntryMutation) CreatedAt() (r time.Time, exists bool) {
+	v := m.created_at
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldCreatedAt returns the old "created_at" field's value of the ResponseCacheEntry entity.
+// If the ResponseCacheEntry object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *ResponseCacheEntryMutation) OldCreatedAt(ctx context.Context) (v time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldCreatedAt is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldCreatedAt requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldCreatedAt: %w", err)
+	}
+	return oldValue.CreatedAt, nil
+}
+
+// ResetCreatedAt resets all changes to the "created_at" field.
+func (m *ResponseCacheEntryMutation) ResetCreatedAt() {
+	m.created_at = nil
+}
+
+// SetExpiresAt sets the "expires_at" field.
+func (m *ResponseCacheEntryMutation) SetExpiresAt(t time.Time) {
+	m.expires_at = &t
+}
+
+// ExpiresAt returns the value of the "expires_at" field in the mutation.
+func (m *ResponseCacheEntryMutation) ExpiresAt() (r time.Time, exists bool) {
+	v := m.expires_at
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldExpiresAt returns the old "expires_at" field's value of the ResponseCacheEntry entity.
+// If the ResponseCacheEntry object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *ResponseCacheEntryMutation) OldExpiresAt(ctx context.Context) (v time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldExpiresAt is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldExpiresAt requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldExpiresAt: %w", err)
+	}
+	return oldValue.ExpiresAt, nil
+}
+
+// ResetExpiresAt resets all changes to the "expires_at" field.
+func (m *ResponseCacheEntryMutation) ResetExpiresAt() {
+	m.expires_at = nil
+}
+
+// Where appends a list predicates to the ResponseCacheEntryMutation builder.
+func (m *ResponseCacheEntryMutation) Where(ps ...predicate.ResponseCacheEntry) {
+	m.predicates = append(m.predicates, ps...)
+}
+
+// WhereP appends storage-level predicates to the ResponseCacheEntryMutation builder. Using this method,
+// users can use type-assertion to append predicates that do not depend on any generated package.
+func (m *ResponseCacheEntryMutation) WhereP(ps ...func(*sql.Selector)) {
+	p := make([]predicate.ResponseCacheEntry, len(ps))
+	for i := range ps {
+		p[i] = ps[i]
+	}
+	m.Where(p...)
+}
+
+// Op returns the operation name.
+func (m *ResponseCacheEntryMutation) Op() Op {
+	return m.op
+}
+
+// SetOp allows setting the mutation operation.
+func (m *ResponseCacheEntryMutation) SetOp(op Op) {
+	m.op = op
+}
+
+// Type returns the node type of this mutation (ResponseCacheEntry).
+func (m *ResponseCacheEntryMutation) Type() string {
+	return m.typ
+}
+
+// Fields returns all fields that were changed during this mutation. Note that in
+// order to get all numeric fields that were incremented/decremented, call
+// AddedFields().
+func (m *ResponseCacheEntryMutation) Fields() []string {
+	fields := make([]string, 0, 7)
+	if m.key != nil {
+		fields = append(fields, responsecacheentry.FieldKey)
+	}
+	if m.task_type != nil {
+		fields = append(fields, responsecacheentry.FieldTaskType)
+	}
+	if m.model != nil {
+		fields = append(fields, responsecacheentry.FieldModel)
+	}
+	if m.response != nil {
+		fields = append(fields, responsecacheentry.FieldResponse)
+	}
+	if m.hits != nil {
+		fields = append(fields, responsecacheentry.FieldHits)
+	}
+	if m.created_at != nil {
+		fields = append(fields, responsecacheentry.FieldCreatedAt)
+	}
+	if m.expires_at != nil {
+		fields = append(fields, responsecacheentry.FieldExpiresAt)
+	}
+	return fields
+}
+
+// Field returns the value of a field with the given name. The second boolean
+// return value indicates that this field was not set, or was not defined in the
+// schema.
+func (m *ResponseCacheEntryMutation) Field(name string) (ent.Value, bool) {
+	switch name {
+	case responsecacheentry.FieldKey:
+		return m.Key()
+	case responsecacheentry.FieldTaskType:
+		return m.TaskType()
+	case responsecacheentry.FieldModel:
+		return m.Model()
+	case responsecacheentry.FieldResponse:
+		return m.Response()
+	case responsecacheentry.FieldHits:
+		return m.Hits()
+	case responsecacheentry.FieldCreatedAt:
+		return m.CreatedAt()
+	case responsecacheentry.FieldExpiresAt:
+		return m.ExpiresAt()
+	}
+	return nil, false
+}
+
+// OldField returns the old value of the field from the database. An error is
+// returned if the mutation operation is not UpdateOne, or the query to the
+// database failed.
+func (m *ResponseCacheEntryMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
+	switch name {
+	case responsecacheentry.FieldKey:
+		return m.OldKey(ctx)
+	case responsecacheentry.FieldTaskType:
+		return m.OldTaskType(ctx)
+	case responsecacheentry.FieldModel:
+		return m.OldModel(ctx)
+	case responsecacheentry.FieldResponse:
+		return m.OldResponse(ctx)
+	case responsecacheentry.FieldHits:
+		return m.OldHits(ctx)
+	case responsecacheentry.FieldCreatedAt:
+		return m.OldCreatedAt(ctx)
+	case responsecacheentry.FieldExpiresAt:
+		return m.OldExpiresAt(ctx)
+	}
+	return nil, fmt.Errorf("unknown ResponseCacheEntry field %s", name)
+}
+
+// SetField sets the value of a field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *ResponseCacheEntryMutation) SetField(name string, value ent.Value) error {
+	switch name {
+	case responsecacheentry.FieldKey:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetKey(v)
+		return nil
+	case responsecacheentry.FieldTaskType:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetTaskType(v)
+		return nil
+	case responsecacheentry.FieldModel:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetModel(v)
+		return nil
+	case responsecacheentry.FieldResponse:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetResponse(v)
+		return nil
+	case responsecacheentry.FieldHits:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetHits(v)
+		return nil
+	case responsecacheentry.FieldCreatedAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetCreatedAt(v)
+		return nil
+	case responsecacheentry.FieldExpiresAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetExpiresAt(v)
+		return nil
+	}
+	return fmt.Errorf("unknown ResponseCacheEntry field %s", name)
+}
+
+// AddedFields returns all numeric fields that were incremented/decremented during
+// this mutation.
+func (m *ResponseCacheEntryMutation) AddedFields() []string {
+	var fields []string
+	if m.addhits != nil {
+		fields = append(fields, responsecacheentry.FieldHits)
+	}
+	return fields
+}
+
+// AddedField returns the numeric value that was incremented/decremented on a field
+// with the given name. The second boolean return value indicates that this field
+// was not set, or was not defined in the schema.
+func (m *ResponseCacheEntryMutation) AddedField(name string) (ent.Value, bool) {
+	switch name {
+	case responsecacheentry.FieldHits:
+		return m.AddedHits()
+	}
+	return nil, false
+}
+
+// AddField adds the value to the field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *ResponseCacheEntryMutation) AddField(name string, value ent.Value) error {
+	switch name {
+	case responsecacheentry.FieldHits:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddHits(v)
+		return nil
+	}
+	return fmt.Errorf("unknown ResponseCacheEntry numeric field %s", name)
+}
+
+// ClearedFields returns all nullable fields that were cleared during this
+// mutation.
+func (m *ResponseCacheEntryMutation) ClearedFields() []string {
+	var fields []string
+	if m.FieldCleared(responsecacheentry.FieldTaskType) {
+		fields = append(fields, responsecacheentry.FieldTaskType)
+	}
+	if m.FieldCleared(responsecacheentry.FieldModel) {
+		fields = append(fields, responsecacheentry.FieldModel)
+	}
+	return fields
+}
+
+// FieldCleared returns a boolean indicating if a field with the given name was
+// cleared in this mutation.
+func (m *ResponseCacheEntryMutation) FieldCleared(name string) bool {
+	_, ok := m.clearedFields[name]
+	return ok
+}
+
+// ClearField clears the value of the field with the given name. It returns an
+// error if the field is not defined in the schema.
+func (m *ResponseCacheEntryMutation) ClearField(name string) error {
+	switch name {
+	case responsecacheentry.FieldTaskType:
+		m.ClearTaskType()
+		return nil
+	case responsecacheentry.FieldModel:
+		m.ClearModel()
+		return nil
+	}
+	return fmt.Errorf("unknown ResponseCacheEntry nullable field %s", name)
+}
+
+// ResetField resets all changes in the mutation for the field with the given name.
+// It returns an error if the field is not defined in the schema.
+func (m *ResponseCacheEntryMutation) ResetField(name string) error {
+	switch name {
+	case responsecacheentry.FieldKey:
+		m.ResetKey()
+		return nil
+	case responsecacheentry.FieldTaskType:
+		m.ResetTaskType()
+		return nil
+	case responsecacheentry.FieldModel:
+		m.ResetModel()
+		return nil
+	case responsecacheentry.FieldResponse:
+		m.ResetResponse()
+		return nil
+	case responsecacheentry.FieldHits:
+		m.ResetHits()
+		return nil
+	case responsecacheentry.FieldCreatedAt:
+		m.ResetCreatedAt()
+		return nil
+	case responsecacheentry.FieldExpiresAt:
+		m.ResetExpiresAt()
+		return nil
+	}
+	return fmt.Errorf("unknown ResponseCacheEntry field %s", name)
+}
+
+// AddedEdges returns all edge names that were set/added in this mutation.
+func (m *ResponseCacheEntryMutation) AddedEdges() []string {
+	edges := make([]string, 0, 0)
+	return edges
+}
+
+// AddedIDs returns all IDs (to other nodes) that were added for the given edge
+// name in this mutation.
+func (m *ResponseCacheEntryMutation) AddedIDs(name string) []ent.Value {
+	return nil
+}
+
+// RemovedEdges returns all edge names that were removed in this mutation.
+func (m *ResponseCacheEntryMutation) RemovedEdges() []string {
+	edges := make([]string, 0, 0)
+	return edges
+}
+
+// RemovedIDs returns all IDs (to other nodes) that were removed for the edge with
+// the given name in this mutation.
+func (m *ResponseCacheEntryMutation) RemovedIDs(name string) []ent.Value {
+	return nil
+}
+
+// ClearedEdges returns all edge names that were cleared in this mutation.
+func (m *ResponseCacheEntryMutation) ClearedEdges() []string {
+	edges := make([]string, 0, 0)
+	return edges
+}
+
+// EdgeCleared returns a boolean which indicates if the edge with the given name
+// was cleared in this mutation.
+func (m *ResponseCacheEntryMutation) EdgeCleared(name string) bool {
+	return false
+}
+
+// ClearEdge clears the value of the edge with the given name. It returns an error
+// if that edge is not defined in the schema.
+func (m *ResponseCacheEntryMutation) ClearEdge(name string) error {
+	return fmt.Errorf("unknown ResponseCacheEntry unique edge %s", name)
+}
+
+// ResetEdge resets all changes to the edge with the given name in this mutation.
+// It returns an error if the edge is not defined in the schema.
+func (m *ResponseCacheEntryMutation) ResetEdge(name string) error {
+	return fmt.Errorf("unknown ResponseCacheEntry edge %s", name)
+}
+
+// SettingsMutation represents an operation that mutates the Settings nodes in the graph.
+type SettingsMutation struct {
+	config
+	op            Op
+	typ           string
+	id            *int
+	key           *string
+	value         *string
+	created_at    *time.Time
+	updated_at    *time.Time
+	clearedFields map[string]struct{}
+	done          bool
+	oldValue      func(context.Context) (*Settings, error)
+	predicates    []predicate.Settings
+}
+
+var _ ent.Mutation = (*SettingsMutation)(nil)
+
+// settingsOption allows management of the mutation configuration using functional options.
+type settingsOption func(*SettingsMutation)
+
+// newSettingsMutation creates new mutation for the Settings entity.
+func newSettingsMutation(c config, op Op, opts ...settingsOption) *SettingsMutation {
+	m := &SettingsMutation{
+		config:        c,
+		op:            op,
+		typ:           TypeSettings,
+		clearedFields: make(map[string]struct{}),
+	}
+	for _, opt := range opts {
+		opt(m)
+	}
+	return m
+}
+
+// withSettingsID sets the ID field of the mutation.
+func withSettingsID(id int) settingsOption {
+	return func(m *SettingsMutation) {
+		var (
+			err   error
+			once  sync.Once
+			value *Settings
+		)
+		m.oldValue = func(ctx context.Context) (*Settings, error) {
+			once.Do(func() {
+				if m.done {
+					err = errors.New("querying old values post mutation is not allowed")
+				} else {
+					value, err = m.Client().Settings.Get(ctx, id)
+				}
+			})
+			return value, err
+		}
+		m.id = &id
+	}
+}
+
+// withSettings sets the old Settings of the mutation.
+func withSettings(node *Settings) settingsOption {
+	return func(m *SettingsMutation) {
+		m.oldValue = func(context.Context) (*Settings, error) {
+			return node, nil
+		}
+		m.id = &node.ID
+	}
+}
+
+// Client returns a new `ent.Client` from the mutation. If the mutation was
+// executed in a transaction (ent.Tx), a transactional client is returned.
+func (m SettingsMutation) Client() *Client {
+	client := &Client{config: m.config}
+	client.init()
+	return client
+}
+
+// Tx returns an `ent.Tx` for mutations that were executed in transactions;
+// it returns an error otherwise.
+func (m SettingsMutation) Tx() (*Tx, error) {
+	if _, ok := m.driver.(*txDriver); !ok {
+		return nil, errors.New("ent: mutation is not running in a transaction")
+	}
+	tx := &Tx{config: m.config}
+	tx.init()
+	return tx, nil
+}
+
+// ID returns the ID value in the mutation. Note that the ID is only available
+// if it was provided to the builder or after it was returned from the database.
+func (m *SettingsMutation) ID() (id int, exists bool) {
+	if m.id == nil {
+		return
+	}
+	return *m.id, true
+}
+
+// IDs queries the database and returns the entity ids that match the mutation's predicate.
+// That means, if the mutation is applied within a transaction with an isolation level such
+// as sql.LevelSerializable, the returned ids match the ids of the rows that will be updated
+// or updated by the mutation.
+func (m *SettingsMutation) IDs(ctx context.Context) ([]int, error) {
+	switch {
+	case m.op.Is(OpUpdateOne | OpDeleteOne):
+		id, exists := m.ID()
+		if exists {
+			return []int{id}, nil
+		}
+		fallthrough
+	case m.op.Is(OpUpdate | OpDelete):
+		return m.Client().Settings.Query().Where(m.predicates...).IDs(ctx)
+	default:
+		return nil, fmt.Errorf("IDs is not allowed on %s operations", m.op)
+	}
+}
+
+// SetKey sets the "key" field.
+func (m *SettingsMutation) SetKey(s string) {
+	m.key = &s
+}
+
+// Key returns the value of the "key" field in the mutation.
+func (m *SettingsMutation) Key() (r string, exists bool) {
+	v := m.key
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldKey returns the old "key" field's value of the Settings entity.
+// If the Settings object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *SettingsMutation) OldKey(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldKey is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldKey requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldKey: %w", err)
+	}
+	return oldValue.Key, nil
+}
+
+// ResetKey resets all changes to the "key" field.
+func (m *SettingsMutation) ResetKey() {
+	m.key = nil
+}
+
+// SetValue sets the "value" field.
+func (m *SettingsMutation) SetValue(s string) {
+	m.value = &s
+}
+
+// Value returns the value of the "value" field in the mutation.
+func (m *SettingsMutation) Value() (r string, exists bool) {
+	v := m.value
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldValue returns the old "value" field's value of the Settings entity.
+// If the Settings object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *SettingsMutation) OldValue(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldValue is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldValue requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldValue: %w", err)
+	}
+	return oldValue.Value, nil
+}
+
+// ClearValue clears the value of the "value" field.
+func (m *SettingsMutation) ClearValue() {
+	m.value = nil
+	m.clearedFields[settings.FieldValue] = struct{}{}
+}
+
+// ValueCleared returns if the "value" field was cleared in this mutation.
+func (m *SettingsMutation) ValueCleared() bool {
+	_, ok := m.clearedFields[settings.FieldValue]
+	return ok
+}
+
+// ResetValue resets all changes to the "value" field.
+func (m *SettingsMutation) ResetValue() {
+	m.value = nil
+	delete(m.clearedFields, settings.FieldValue)
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (m *SettingsMutation) SetCreatedAt(t time.Time) {
+	m.created_at = &t
+}
+
+// CreatedAt returns the value of the "created_at" field in the mutation.
+func (m *SettingsMutation) CreatedAt() (r time.Time, exists bool) {
+	v := m.created_at
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldCreatedAt returns the old "created_at" field's value of the Settings entity.
+// If the Settings object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *SettingsMutation) OldCreatedAt(ctx context.Context) (v time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldCreatedAt is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldCreatedAt requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldCreatedAt: %w", err)
+	}
+	return oldValue.CreatedAt, nil
+}
+
+// ResetCreatedAt resets all changes to the "created_at" field.
+func (m *SettingsMutation) ResetCreatedAt() {
+	m.created_at = nil
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (m *SettingsMutation) SetUpdatedAt(t time.Time) {
+	m.updated_at = &t
+}
+
+// UpdatedAt returns the value of the "updated_at" field in the mutation.
+func (m *SettingsMutation) UpdatedAt() (r time.Time, exists bool) {
+	v := m.updated_at
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldUpdatedAt returns the old "updated_at" field's value of the Settings entity.
+// If the Settings object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *SettingsMutation) OldUpdatedAt(ctx context.Context) (v time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldUpdatedAt is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldUpdatedAt requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldUpdatedAt: %w", err)
+	}
+	return oldValue.UpdatedAt, nil
+}
+
+// ResetUpdatedAt resets all changes to the "updated_at" field.
+func (m *SettingsMutation) ResetUpdatedAt() {
+	m.updated_at = nil
+}
+
+// Where appends a list predicates to the SettingsMutation builder.
+func (m *SettingsMutation) Where(ps ...predicate.Settings) {
+	m.predicates = append(m.predicates, ps...)
+}
+
+// WhereP appends storage-level predicates to the SettingsMutation builder. Using this method,
+// users can use type-assertion to append predicates that do not depend on any generated package.
+func (m *SettingsMutation) WhereP(ps ...func(*sql.Selector)) {
+	p := make([]predicate.Settings, len(ps))
+	for i := range ps {
+		p[i] = ps[i]
+	}
+	m.Where(p...)
+}
+
+// Op returns the operation name.
+func (m *SettingsMutation) Op() Op {
+	return m.op
+}
+
+// SetOp allows setting the mutation operation.
+func (m *SettingsMutation) SetOp(op Op) {
+	m.op = op
+}
+
+// Type returns the node type of this mutation (Settings).
+func (m *SettingsMutation) Type() string {
+	return m.typ
+}
+
+// Fields returns all fields that were changed during this mutation. Note that in
+// order to get all numeric fields that were incremented/decremented, call
+// AddedFields().
+func (m *SettingsMutation) Fields() []string {
+	fields := make([]string, 0, 4)
+	if m.key != nil {
+		fields = append(fields, settings.FieldKey)
+	}
+	if m.value != nil {
+		fields = append(fields, settings.FieldValue)
+	}
+	if m.created_at != nil {
+		fields = append(fields, settings.FieldCreatedAt)
+	}
+	if m.updated_at != nil {
+		fields = append(fields, settings.FieldUpdatedAt)
+	}
+	return fields
+}
+
+// Field returns the value of a field with the given name. The second boolean
+// return value indicates that this field was not set, or was not defined in the
+// schema.
+func (m *SettingsMutation) Field(name string) (ent.Value, bool) {
+	switch name {
+	case settings.FieldKey:
+		return m.Key()
+	case settings.FieldValue:
+		return m.Value()
+	case settings.FieldCreatedAt:
+		return m.CreatedAt()
+	case settings.FieldUpdatedAt:
+		return m.UpdatedAt()
+	}
+	return nil, false
+}
+
+// OldField returns the old value of the field from the database. An error is
+// returned if the mutation operation is not UpdateOne, or the query to the
+// database failed.
+func (m *SettingsMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
+	switch name {
+	case settings.FieldKey:
+		return m.OldKey(ctx)
+	case settings.FieldValue:
+		return m.OldValue(ctx)
+	case settings.FieldCreatedAt:
+		return m.OldCreatedAt(ctx)
+	case settings.FieldUpdatedAt:
+		return m.OldUpdatedAt(ctx)
+	}
+	return nil, fmt.Errorf("unknown Settings field %s", name)
+}
+
+// SetField sets the value of a field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *SettingsMutation) SetField(name string, value ent.Value) error {
+	switch name {
+	case settings.FieldKey:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetKey(v)
+		return nil
+	case settings.FieldValue:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetValue(v)
+		return nil
+	case settings.FieldCreatedAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetCreatedAt(v)
+		return nil
+	case settings.FieldUpdatedAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetUpdatedAt(v)
+		return nil
+	}
+	return fmt.Errorf("unknown Settings field %s", name)
+}
+
+// AddedFields returns all numeric fields that were incremented/decremented during
+// this mutation.
+func (m *SettingsMutation) AddedFields() []string {
+	return nil
+}
+
+// AddedField returns the numeric value that was incremented/decremented on a field
+// with the given name. The second boolean return value indicates that this field
+// was not set, or was not defined in the schema.
+func (m *SettingsMutation) AddedField(name string) (ent.Value, bool) {
+	return nil, false
+}
+
+// AddField adds the value to the field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *SettingsMutation) AddField(name string, value ent.Value) error {
+	switch name {
+	}
+	return fmt.Errorf("unknown Settings numeric field %s", name)
+}
+
+// ClearedFields returns all nullable fields that were cleared during this
+// mutation.
+func (m *SettingsMutation) ClearedFields() []string {
+	var fields []string
+	if m.FieldCleared(settings.FieldValue) {
+		fields = append(fields, settings.FieldValue)
+	}
+	return fields
+}
+
+// FieldCleared returns a boolean indicating if a field with the given name was
+// cleared in this mutation.
+func (m *SettingsMutation) FieldCleared(name string) bool {
+	_, ok := m.clearedFields[name]
+	return ok
+}
+
+// ClearField clears the value of the field with the given name. It returns an
+// error if the field is not defined in the schema.
+func (m *SettingsMutation) ClearField(name string) error {
+	switch name {
+	case settings.FieldValue:
+		m.ClearValue()
+		return nil
+	}
+	return fmt.Errorf("unknown Settings nullable field %s", name)
+}
+
+// ResetField resets all changes in the mutation for the field with the given name.
+// It returns an error if the field is not defined in the schema.
+func (m *SettingsMutation) ResetField(name string) error {
+	switch name {
+	case settings.FieldKey:
+		m.ResetKey()
+		return nil
+	case settings.FieldValue:
+		m.ResetValue()
+		return nil
+	case settings.FieldCreatedAt:
+		m.ResetCreatedAt()
+		return nil
+	case settings.FieldUpdatedAt:
+		m.ResetUpdatedAt()
+		return nil
+	}
+	return fmt.Errorf("unknown Settings field %s", name)
+}
+
+// AddedEdges returns all edge names that were set/added in this mutation.
+func (m *SettingsMutation) AddedEdges() []string {
+	edges := make([]string, 0, 0)
+	return edges
+}
+
+// AddedIDs returns all IDs (to other nodes) that were added for the given edge
+// name in this mutation.
+func (m *SettingsMutation) AddedIDs(name string) []ent.Value {
+	return nil
+}
+
+// RemovedEdges returns all edge names that were removed in this mutation.
+func (m *SettingsMutation) RemovedEdges() []string {
+	edges := make([]string, 0, 0)
+	return edges
+}
+
+// RemovedIDs returns all IDs (to other nodes) that were removed for the edge with
+// the given name in this mutation.
+func (m *SettingsMutation) RemovedIDs(name string) []ent.Value {
+	return nil
+}
+
+// ClearedEdges returns all edge names that were cleared in this mutation.
+func (m *SettingsMutation) ClearedEdges() []string {
+	edges := make([]string, 0, 0)
+	return edges
+}
+
+// EdgeCleared returns a boolean which indicates if the edge with the given name
+// was cleared in this mutation.
+func (m *SettingsMutation) EdgeCleared(name string) bool {
+	return false
+}
+
+// ClearEdge clears the value of the edge with the given name. It returns an error
+// if that edge is not defined in the schema.
+func (m *SettingsMutation) ClearEdge(name string) error {
+	return fmt.Errorf("unknown Settings unique edge %s", name)
+}
+
+// ResetEdge resets all changes to the edge with the given name in this mutation.
+// It returns an error if the edge is not defined in the schema.
+func (m *SettingsMutation) ResetEdge(name string) error {
+	return fmt.Errorf("unknown Settings edge %s", name)
+}
+
+// TagMutation represents an operation that mutates the Tag nodes in the graph.
+type TagMutation struct {
+	config
+	op                 Op
+	typ                string
+	id                 *int
+	name               *string
+	color              *string
+	created_at         *time.Time
+	clearedFields      map[string]struct{}
+	projects           map[int]struct{}
+	removedprojects    map[int]struct{}
+	clearedprojects    bool
+	video_clips        map[int]struct{}
+	removedvideo_clips map[int]struct{}
+	clearedvideo_clips bool
+	done               bool
+	oldValue           func(context.Context) (*Tag, error)
+	predicates         []predicate.Tag
+}
+
+var _ ent.Mutation = (*TagMutation)(nil)
+
+// tagOption allows management of the mutation configuration using functional options.
+type tagOption func(*TagMutation)
+
+// newTagMutation creates new mutation for the Tag entity.
+func newTagMutation(c config, op Op, opts ...tagOption) *TagMutation {
+	m := &TagMutation{
+		config:        c,
+		op:            op,
+		typ:           TypeTag,
+		clearedFields: make(map[string]struct{}),
+	}
+	for _, opt := range opts {
+		opt(m)
+	}
+	return m
+}
+
+// withTagID sets the ID field of the mutation.
+func withTagID(id int) tagOption {
+	return func(m *TagMutation) {
+		var (
+			err   error
+			once  sync.Once
+			value *Tag
+		)
+		m.oldValue = func(ctx context.Context) (*Tag, error) {
+			once.Do(func() {
+				if m.done {
+					err = errors.New("querying old values post mutation is not allowed")
+				} else {
+					value, err = m.Client().Tag.Get(ctx, id)
+				}
+			})
+			return value, err
+		}
+		m.id = &id
+	}
+}
+
+// withTag sets the old Tag of the mutation.
+func withTag(node *Tag) tagOption {
+	return func(m *TagMutation) {
+		m.oldValue = func(context.Context) (*Tag, error) {
+			return node, nil
+		}
+		m.id = &node.ID
+	}
+}
+
+// Client returns a new `ent.Client` from the mutation. If the mutation was
+// executed in a transaction (ent.Tx), a transactional client is returned.
+func (m TagMutation) Client() *Client {
+	client := &Client{config: m.config}
+	client.init()
+	return client
+}
+
+// Tx returns an `ent.Tx` for mutations that were executed in transactions;
+// it returns an error otherwise.
+func (m TagMutation) Tx() (*Tx, error) {
+	if _, ok := m.driver.(*txDriver); !ok {
+		return nil, errors.New("ent: mutation is not running in a transaction")
+	}
+	tx := &Tx{config: m.config}
+	tx.init()
+	return tx, nil
+}
+
+// ID returns the ID value in the mutation. Note that the ID is only available
+// if it was provided to the builder or after it was returned from the database.
+func (m *TagMutation) ID() (id int, exists bool) {
+	if m.id == nil {
+		return
+	}
+	return *m.id, true
+}
+
+// IDs queries the database and returns the entity ids that match the mutation's predicate.
+// That means, if the mutation is applied within a transaction with an isolation level such
+// as sql.LevelSerializable, the returned ids match the ids of the rows that will be updated
+// or updated by the mutation.
+func (m *TagMutation) IDs(ctx context.Context) ([]int, error) {
+	switch {
+	case m.op.Is(OpUpdateOne | OpDeleteOne):
+		id, exists := m.ID()
+		if exists {
+			return []int{id}, nil
+		}
+		fallthrough
+	case m.op.Is(OpUpdate | OpDelete):
+		return m.Client().Tag.Query().Where(m.predicates...).IDs(ctx)
+	default:
+		return nil, fmt.Errorf("IDs is not allowed on %s operations", m.op)
+	}
+}
+
+// SetName sets the "name" field.
+func (m *TagMutation) SetName(s string) {
+	m.name = &s
+}
+
+// Name returns the value of the "name" field in the mutation.
+func (m *TagMutation) Name() (r string, exists bool) {
+	v := m.name
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldName returns the old "name" field's value of the Tag entity.
+// If the Tag object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *TagMutation) OldName(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldName is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldName requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldName: %w", err)
+	}
+	return oldValue.Name, nil
+}
+
+// ResetName resets all changes to the "name" field.
+func (m *TagMutation) ResetName() {
+	m.name = nil
+}
+
+// SetColor sets the "color" field.
+func (m *TagMutation) SetColor(s string) {
+	m.color = &s
+}
+
+// Color returns the value of the "color" field in the mutation.
+func (m *TagMutation) Color() (r string, exists bool) {
+	v := m.color
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldColor returns the old "color" field's value of the Tag entity.
+// If the Tag object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *TagMutation) OldColor(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldColor is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldColor requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldColor: %w", err)
+	}
+	return oldValue.Color, nil
+}
+
+// ClearColor clears the value of the "color" field.
+func (m *TagMutation) ClearColor() {
+	m.color = nil
+	m.clearedFields[tag.FieldColor] = struct{}{}
+}
+
+// ColorCleared returns if the "color" field was cleared in this mutation.
+func (m *TagMutation) ColorCleared() bool {
+	_, ok := m.clearedFields[tag.FieldColor]
+	return ok
+}
+
+// ResetColor resets all changes to the "color" field.
+func (m *TagMutation) ResetColor() {
+	m.color = nil
+	delete(m.clearedFields, tag.FieldColor)
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (m *TagMutation) SetCreatedAt(t time.Time) {
+	m.created_at = &t
+}
+
+// CreatedAt returns the value of the "created_at" field in the mutation.
+func (m *TagMutation) CreatedAt() (r time.Time, exists bool) {
+	v := m.created_at
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldCreatedAt returns the old "created_at" field's value of the Tag entity.
+// If the Tag object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *TagMutation) OldCreatedAt(ctx context.Context) (v time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldCreatedAt is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldCreatedAt requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldCreatedAt: %w", err)
+	}
+	return oldValue.CreatedAt, nil
+}
+
+// ResetCreatedAt resets all changes to the "created_at" field.
+func (m *TagMutation) ResetCreatedAt() {
+	m.created_at = nil
+}
+
+// AddProjectIDs adds the "projects" edge to the Project entity by ids.
+func (m *TagMutation) AddProjectIDs(ids ...int) {
+	if m.projects == nil {
+		m.projects = make(map[int]struct{})
+	}
+	for i := range ids {
+		m.projects[ids[i]] = struct{}{}
+	}
+}
+
+// ClearProjects clears the "projects" edge to the Project entity.
+func (m *TagMutation) ClearProjects() {
+	m.clearedprojects = true
+}
+
+// ProjectsCleared reports if the "projects" edge to the Project entity was cleared.
+func (m *TagMutation) ProjectsCleared() bool {
+	return m.clearedprojects
+}
+
+// RemoveProjectIDs removes the "projects" edge to the Project entity by IDs.
+func (m *TagMutation) RemoveProjectIDs(ids ...int) {
+	if m.removedprojects == nil {
+		m.removedprojects = make(map[int]struct{})
+	}
+	for i := range ids {
+		delete(m.projects, ids[i])
+		m.removedprojects[ids[i]] = struct{}{}
+	}
+}
+
+// RemovedProjects returns the removed IDs of the "projects" edge to the Project entity.
+func (m *TagMutation) RemovedProjectsIDs() (ids []int) {
+	for id := range m.removedprojects {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// ProjectsIDs returns the "projects" edge IDs in the mutation.
+func (m *TagMutation) ProjectsIDs() (ids []int) {
+	for id := range m.projects {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// ResetProjects resets all changes to the "projects" edge.
+func (m *TagMutation) ResetProjects() {
+	m.projects = nil
+	m.clearedprojects = false
+	m.removedprojects = nil
+}
+
+// AddVideoClipIDs adds the "video_clips" edge to the VideoClip entity by ids.
+func (m *TagMutation) AddVideoClipIDs(ids ...int) {
+	if m.video_clips == nil {
+		m.video_clips = make(map[int]struct{})
+	}
+	for i := range ids {
+		m.video_clips[ids[i]] = struct{}{}
+	}
+}
+
+// ClearVideoClips clears the "video_clips" edge to the VideoClip entity.
+func (m *TagMutation) ClearVideoClips() {
+	m.clearedvideo_clips = true
+}
+
+// VideoClipsCleared reports if the "video_clips" edge to the VideoClip entity was cleared.
+func (m *TagMutation) VideoClipsCleared() bool {
+	return m.clearedvideo_clips
+}
+
+// RemoveVideoClipIDs removes the "video_clips" edge to the VideoClip entity by IDs.
+func (m *TagMutation) RemoveVideoClipIDs(ids ...int) {
+	if m.removedvideo_clips == nil {
+		m.removedvideo_clips = make(map[int]struct{})
+	}
+	for i := range ids {
+		delete(m.video_clips, ids[i])
+		m.removedvideo_clips[ids[i]] = struct{}{}
+	}
+}
+
+// RemovedVideoClips returns the removed IDs of the "video_clips" edge to the VideoClip entity.
+func (m *TagMutation) RemovedVideoClipsIDs() (ids []int) {
+	for id := range m.removedvideo_clips {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// VideoClipsIDs returns the "video_clips" edge IDs in the mutation.
+func (m *TagMutation) VideoClipsIDs() (ids []int) {
+	for id := range m.video_clips {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// ResetVideoClips resets all changes to the "video_clips" edge.
+func (m *TagMutation) ResetVideoClips() {
+	m.video_clips = nil
+	m.clearedvideo_clips = false
+	m.removedvideo_clips = nil
+}
+
+// Where appends a list predicates to the TagMutation builder.
+func (m *TagMutation) Where(ps ...predicate.Tag) {
+	m.predicates = append(m.predicates, ps...)
+}
+
+// WhereP appends storage-level predicates to the TagMutation builder. Using this method,
+// users can use type-assertion to append predicates that do not depend on any generated package.
+func (m *TagMutation) WhereP(ps ...func(*sql.Selector)) {
+	p := make([]predicate.Tag, len(ps))
+	for i := range ps {
+		p[i] = ps[i]
+	}
+	m.Where(p...)
+}
+
+// Op returns the operation name.
+func (m *TagMutation) Op() Op {
+	return m.op
+}
+
+// SetOp allows setting the mutation operation.
+func (m *TagMutation) SetOp(op Op) {
+	m.op = op
+}
+
+// Type returns the node type of this mutation (Tag).
+func (m *TagMutation) Type() string {
+	return m.typ
+}
+
+// Fields returns all fields that were changed during this mutation. Note that in
+// order to get all numeric fields that were incremented/decremented, call
+// AddedFields().
+func (m *TagMutation) Fields() []string {
+	fields := make([]string, 0, 3)
+	if m.name != nil {
+		fields = append(fields, tag.FieldName)
+	}
+	if m.color != nil {
+		fields = append(fields, tag.FieldColor)
+	}
+	if m.created_at != nil {
+		fields = append(fields, tag.FieldCreatedAt)
+	}
+	return fields
+}
+
+// Field returns the value of a field with the given name. The second boolean
+// return value indicates that this field was not set, or was not defined in the
+// schema.
+func (m *TagMutation) Field(name string) (ent.Value, bool) {
+	switch name {
+	case tag.FieldName:
+		return m.Name()
+	case tag.FieldColor:
+		return m.Color()
+	case tag.FieldCreatedAt:
+		return m.CreatedAt()
+	}
+	return nil, false
+}
+
+// OldField returns the old value of the field from the database. An error is
+// returned if the mutation operation is not UpdateOne, or the query to the
+// database failed.
+func (m *TagMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
+	switch name {
+	case tag.FieldName:
+		return m.OldName(ctx)
+	case tag.FieldColor:
+		return m.OldColor(ctx)
+	case tag.FieldCreatedAt:
+		return m.OldCreatedAt(ctx)
+	}
+	return nil, fmt.Errorf("unknown Tag field %s", name)
+}
+
+// SetField sets the value of a field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *TagMutation) SetField(name string, value ent.Value) error {
+	switch name {
+	case tag.FieldName:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetName(v)
+		return nil
+	case tag.FieldColor:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetColor(v)
+		return nil
+	case tag.FieldCreatedAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetCreatedAt(v)
+		return nil
+	}
+	return fmt.Errorf("unknown Tag field %s", name)
+}
+
+// AddedFields returns all numeric fields that were incremented/decremented during
+// this mutation.
+func (m *TagMutation) AddedFields() []string {
+	return nil
+}
+
+// AddedField returns the numeric value that was incremented/decremented on a field
+// with the given name. The second boolean return value indicates that this field
+// was not set, or was not defined in the schema.
+func (m *TagMutation) AddedField(name string) (ent.Value, bool) {
+	return nil, false
+}
+
+// AddField adds the value to the field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *TagMutation) AddField(name string, value ent.Value) error {
+	switch name {
+	}
+	return fmt.Errorf("unknown Tag numeric field %s", name)
+}
+
+// ClearedFields returns all nullable fields that were cleared during this
+// mutation.
+func (m *TagMutation) ClearedFields() []string {
+	var fields []string
+	if m.FieldCleared(tag.FieldColor) {
+		fields = append(fields, tag.FieldColor)
+	}
+	return fields
+}
+
+// FieldCleared returns a boolean indicating if a field with the given name was
+// cleared in this mutation.
+func (m *TagMutation) FieldCleared(name string) bool {
+	_, ok := m.clearedFields[name]
+	return ok
+}
+
+// ClearField clears the value of the field with the given name. It returns an
+// error if the field is not defined in the schema.
+func (m *TagMutation) ClearField(name string) error {
+	switch name {
+	case tag.FieldColor:
+		m.ClearColor()
+		return nil
+	}
+	return fmt.Errorf("unknown Tag nullable field %s", name)
+}
+
+// ResetField resets all changes in the mutation for the field with the given name.
+// It returns an error if the field is not defined in the schema.
+func (m *TagMutation) ResetField(name string) error {
+	switch name {
+	case tag.FieldName:
+		m.ResetName()
+		return nil
+	case tag.FieldColor:
+		m.ResetColor()
+		return nil
+	case tag.FieldCreatedAt:
+		m.ResetCreatedAt()
+		return nil
+	}
+	return fmt.Errorf("unknown Tag field %s", name)
+}
+
+// AddedEdges returns all edge names that were set/added in this mutation.
+func (m *TagMutation) AddedEdges() []string {
+	edges := make([]string, 0, 2)
+	if m.projects != nil {
+		edges = append(edges, tag.EdgeProjects)
+	}
+	if m.video_clips != nil {
+		edges = append(edges, tag.EdgeVideoClips)
+	}
+	return edges
+}
+
+// AddedIDs returns all IDs (to other nodes) that were added for the given edge
+// name in this mutation.
+func (m *TagMutation) AddedIDs(name string) []ent.Value {
+	switch name {
+	case tag.EdgeProjects:
+		ids := make([]ent.Value, 0, len(m.projects))
+		for id := range m.projects {
+			ids = append(ids, id)
+		}
+		return ids
+	case tag.EdgeVideoClips:
+		ids := make([]ent.Value, 0, len(m.video_clips))
+		for id := range m.video_clips {
+			ids = append(ids, id)
+		}
+		return ids
+	}
+	return nil
+}
+
+// RemovedEdges returns all edge names that were removed in this mutation.
+func (m *TagMutation) RemovedEdges() []string {
+	edges := make([]string, 0, 2)
+	if m.removedprojects != nil {
+		edges = append(edges, tag.EdgeProjects)
+	}
+	if m.removedvideo_clips != nil {
+		edges = append(edges, tag.EdgeVideoClips)
+	}
+	return edges
+}
+
+// RemovedIDs returns all IDs (to other nodes) that were removed for the edge with
+// the given name in this mutation.
+func (m *TagMutation) RemovedIDs(name string) []ent.Value {
+	switch name {
+	case tag.EdgeProjects:
+		ids := make([]ent.Value, 0, len(m.removedprojects))
+		for id := range m.removedprojects {
+			ids = append(ids, id)
+		}
+		return ids
+	case tag.EdgeVideoClips:
+		ids := make([]ent.Value, 0, len(m.removedvideo_clips))
+		for id := range m.removedvideo_clips {
+			ids = append(ids, id)
+		}
+		return ids
+	}
+	return nil
+}
+
+// ClearedEdges returns all edge names that were cleared in this mutation.
+func (m *TagMutation) ClearedEdges() []string {
+	edges := make([]string, 0, 2)
+	if m.clearedprojects {
+		edges = append(edges, tag.EdgeProjects)
+	}
+	if m.clearedvideo_clips {
+		edges = append(edges, tag.EdgeVideoClips)
+	}
+	return edges
+}
+
+// EdgeCleared returns a boolean which indicates if the edge with the given name
+// was cleared in this mutation.
+func (m *TagMutation) EdgeCleared(name string) bool {
+	switch name {
+	case tag.EdgeProjects:
+		return m.clearedprojects
+	case tag.EdgeVideoClips:
+		return m.clearedvideo_clips
+	}
+	return false
+}
+
+// ClearEdge clears the value of the edge with the given name. It returns an error
+// if that edge is not defined in the schema.
+func (m *TagMutation) ClearEdge(name string) error {
+	switch name {
+	}
+	return fmt.Errorf("unknown Tag unique edge %s", name)
+}
+
+// ResetEdge resets all changes to the edge with the given name in this mutation.
+// It returns an error if the edge is not defined in the schema.
+func (m *TagMutation) ResetEdge(name string) error {
+	switch name {
+	case tag.EdgeProjects:
+		m.ResetProjects()
+		return nil
+	case tag.EdgeVideoClips:
+		m.ResetVideoClips()
+		return nil
+	}
+	return fmt.Errorf("unknown Tag edge %s", name)
+}
+
+// TranscriptEmbeddingMutation represents an operation that mutates the TranscriptEmbedding nodes in the graph.
+type TranscriptEmbeddingMutation struct {
+	config
+	op                Op
+	typ               string
+	id                *int
+	chunk_index       *int
+	addchunk_index    *int
+	start_word        *int
+	addstart_word     *int
+	end_word          *int
+	addend_word       *int
+	start_time        *float64
+	addstart_time     *float64
+	end_time          *float64
+	addend_time       *float64
+	text              *string
+	model             *string
+	source_hash       *string
+	embedding         *[]float32
+	appendembedding   []float32
+	created_at        *time.Time
+	clearedFields     map[string]struct{}
+	video_clip        *int
+	clearedvideo_clip bool
+	done              bool
+	oldValue          func(context.Context) (*TranscriptEmbedding, error)
+	predicates        []predicate.TranscriptEmbedding
+}
+
+var _ ent.Mutation = (*TranscriptEmbeddingMutation)(nil)
+
+// transcriptembeddingOption allows management of the mutation configuration using functional options.
+type transcriptembeddingOption func(*TranscriptEmbeddingMutation)
+
+// newTranscriptEmbeddingMutation creates new mutation for the TranscriptEmbedding entity.
+func newTranscriptEmbeddingMutation(c config, op Op, opts ...transcriptembeddingOption) *TranscriptEmbeddingMutation {
+	m := &TranscriptEmbeddingMutation{
+		config:        c,
+		op:            op,
+		typ:           TypeTranscriptEmbedding,
+		clearedFields: make(map[string]struct{}),
+	}
+	for _, opt := range opts {
+		opt(m)
+	}
+	return m
+}
+
+// withTranscriptEmbeddingID sets the ID field of the mutation.
+func withTranscriptEmbeddingID(id int) transcriptembeddingOption {
+	return func(m *TranscriptEmbeddingMutation) {
+		var (
+			err   error
+			once  sync.Once
+			value *TranscriptEmbedding
+		)
+		m.oldValue = func(ctx context.Context) (*TranscriptEmbedding, error) {
+			once.Do(func() {
+				if m.done {
+					err = errors.New("querying old values post mutation is not allowed")
+				} else {
+					value, err = m.Client().TranscriptEmbedding.Get(ctx, id)
+				}
+			})
+			return value, err
+		}
+		m.id = &id
+	}
+}
+
+// withTranscriptEmbedding sets the old TranscriptEmbedding of the mutation.
+func withTranscriptEmbedding(node *TranscriptEmbedding) transcriptembeddingOption {
+	return func(m *TranscriptEmbeddingMutation) {
+		m.oldValue = func(context.Context) (*TranscriptEmbedding, error) {
+			return node, nil
+		}
+		m.id = &node.ID
+	}
+}
+
+// Client returns a new `ent.Client` from the mutation. If the mutation was
+// executed in a transaction (ent.Tx), a transactional client is returned.
+func (m TranscriptEmbeddingMutation) Client() *Client {
+	client := &Client{config: m.config}
+	client.init()
+	return client
+}
+
+// Tx returns an `ent.Tx` for mutations that were executed in transactions;
+// it returns an error otherwise.
+func (m TranscriptEmbeddingMutation) Tx() (*Tx, error) {
+	if _, ok := m.driver.(*txDriver); !ok {
+		return nil, errors.New("ent: mutation is not running in a transaction")
+	}
+	tx := &Tx{config: m.config}
+	tx.init()
+	return tx, nil
+}
+
+// ID returns the ID value in the mutation. Note that the ID is only available
+// if it was provided to the builder or after it was returned from the database.
+func (m *TranscriptEmbeddingMutation) ID() (id int, exists bool) {
+	if m.id == nil {
+		return
+	}
+	return *m.id, true
+}
+
+// IDs queries the database and returns the entity ids that match the mutation's predicate.
+// That means, if the mutation is applied within a transaction with an isolation level such
+// as sql.LevelSerializable, the returned ids match the ids of the rows that will be updated
+// or updated by the mutation.
+func (m *TranscriptEmbeddingMutation) IDs(ctx context.Context) ([]int, error) {
+	switch {
+	case m.op.Is(OpUpdateOne | OpDeleteOne):
+		id, exists := m.ID()
+		if exists {
+			return []int{id}, nil
+		}
+		fallthrough
+	case m.op.Is(OpUpdate | OpDelete):
+		return m.Client().TranscriptEmbedding.Query().Where(m.predicates...).IDs(ctx)
+	default:
+		return nil, fmt.Errorf("IDs is not allowed on %s operations", m.op)
+	}
+}
+
+// SetVideoClipID sets the "video_clip_id" field.
+func (m *TranscriptEmbeddingMutation) SetVideoClipID(i int) {
+	m.video_clip = &i
+}
+
+// VideoClipID returns the value of the "video_clip_id" field in the mutation.
+func (m *TranscriptEmbeddingMutation) VideoClipID() (r int, exists bool) {
+	v := m.video_clip
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldVideoClipID returns the old "video_clip_id" field's value of the TranscriptEmbedding entity.
+// If the TranscriptEmbedding object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *TranscriptEmbeddingMutation) OldVideoClipID(ctx context.Context) (v int, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldVideoClipID is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldVideoClipID requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldOrderHistoryIndex: %w", err)
+		return v, fmt.Errorf("querying old value for OldVideoClipID: %w", err)
 	}
-	return oldValue.OrderHistoryIndex, nil
+	return oldValue.VideoClipID, nil
 }
 
-// AddOrderHistoryIndex adds i to the "order_history_index" field.
-func (m *ProjectMutation) AddOrderHistoryIndex(i int) {
-	if m.addorder_history_index != nil {
-		*m.addorder_history_index += i
+// ResetVideoClipID resets all changes to the "video_clip_id" field.
+func (m *TranscriptEmbeddingMutation) ResetVideoClipID() {
+	m.video_clip = nil
+}
+
+// SetChunkIndex sets the "chunk_index" field.
+func (m *TranscriptEmbeddingMutation) SetChunkIndex(i int) {
+	m.chunk_index = &i
+	m.addchunk_index = nil
+}
+
+// ChunkIndex returns the value of the "chunk_index" field in the mutation.
+func (m *TranscriptEmbeddingMutation) ChunkIndex() (r int, exists bool) {
+	v := m.chunk_index
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldChunkIndex returns the old "chunk_index" field's value of the TranscriptEmbedding entity.
+// If the TranscriptEmbedding object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *TranscriptEmbeddingMutation) OldChunkIndex(ctx context.Context) (v int, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldChunkIndex is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldChunkIndex requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldChunkIndex: %w", err)
+	}
+	return oldValue.ChunkIndex, nil
+}
+
+// AddChunkIndex adds i to the "chunk_index" field.
+func (m *TranscriptEmbeddingMutation) AddChunkIndex(i int) {
+	if m.addchunk_index != nil {
+		*m.addchunk_index += i
 	} else {
-		m.addorder_history_index = &i
+		m.addchunk_index = &i
 	}
 }
 
-// AddedOrderHistoryIndex returns the value that was added to the "order_history_index" field in this mutation.
-func (m *ProjectMutation) AddedOrderHistoryIndex() (r int, exists bool) {
-	v := m.addorder_history_index
+// AddedChunkIndex returns the value that was added to the "chunk_index" field in this mutation.
+func (m *TranscriptEmbeddingMutation) AddedChunkIndex() (r int, exists bool) {
+	v := m.addchunk_index
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// ClearOrderHistoryIndex clears the value of the "order_history_index" field.
-func (m *ProjectMutation) ClearOrderHistoryIndex() {
-	m.order_history_index = nil
-	m.addorder_history_index = nil
-	m.clearedFields[project.FieldOrderHistoryIndex] = struct{}{}
+// ResetChunkIndex resets all changes to the "chunk_index" field.
+func (m *TranscriptEmbeddingMutation) ResetChunkIndex() {
+	m.chunk_index = nil
+	m.addchunk_index = nil
 }
 
-// OrderHistoryIndexCleared returns if the "order_history_index" field was cleared in this mutation.
-func (m *ProjectMutation) OrderHistoryIndexCleared() bool {
-	_, ok := m.clearedFields[project.FieldOrderHistoryIndex]
-	return ok
+// SetStartWord sets the "start_word" field.
+func (m *TranscriptEmbeddingMutation) SetStartWord(i int) {
+	m.start_word = &i
+	m.addstart_word = nil
 }
 
-// ResetOrderHistoryIndex resets all changes to the "order_history_index" field.
-func (m *ProjectMutation) ResetOrderHistoryIndex() {
-	m.order_history_index = nil
-	m.addorder_history_index = nil
-	delete(m.clearedFields, project.FieldOrderHistoryIndex)
+// StartWord returns the value of the "start_word" field in the mutation.
+func (m *TranscriptEmbeddingMutation) StartWord() (r int, exists bool) {
+	v := m.start_word
+	if v == nil {
+		return
+	}
+	return *v, true
 }
 
-// SetHiddenHighlights sets the "hidden_highlights" field.
-func (m *ProjectMutation) SetHiddenHighlights(s []string) {
-	m.hidden_highlights = &s
-	m.appendhidden_highlights = nil
+// OldStartWord returns the old "start_word" field's value of the TranscriptEmbedding entity.
+// If the TranscriptEmbedding object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *TranscriptEmbeddingMutation) OldStartWord(ctx context.Context) (v int, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldStartWord is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldStartWord requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldStartWord: %w", err)
+	}
+	return oldValue.StartWord, nil
 }
 
-// HiddenHighlights returns the value of the "hidden_highlights" field in the mutation.
-func (m *ProjectMutation) HiddenHighlights() (r []string, exists bool) {
-	v := m.hidden_highlights
+// AddStartWord adds i to the "start_word" field.
+func (m *TranscriptEmbeddingMutation) AddStartWord(i int) {
+	if m.addstart_word != nil {
+		*m.addstart_word += i
+	} else {
+		m.addstart_word = &i
+	}
+}
+
+// AddedStartWord returns the value that was added to the "start_word" field in this mutation.
+func (m *TranscriptEmbeddingMutation) AddedStartWord() (r int, exists bool) {
+	v := m.addstart_word
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldHiddenHighlights returns the old "hidden_highlights" field's value of the Project entity.
-// If the Project object wasn't provided to the builder, the object is fetched from the database.
+// ResetStartWord resets all changes to the "start_word" field.
+func (m *TranscriptEmbeddingMutation) ResetStartWord() {
+	m.start_word = nil
+	m.addstart_word = nil
+}
+
+// SetEndWord sets the "end_word" field.
+func (m *TranscriptEmbeddingMutation) SetEndWord(i int) {
+	m.end_word = &i
+	m.addend_word = nil
+}
+
+// EndWord returns the value of the "end_word" field in the mutation.
+func (m *TranscriptEmbeddingMutation) EndWord() (r int, exists bool) {
+	v := m.end_word
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldEndWord returns the old "end_word" field's value of the TranscriptEmbedding entity.
+// If the TranscriptEmbedding object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *ProjectMutation) OldHiddenHighlights(ctx context.Context) (v []string, err error) {
+func (m *TranscriptEmbeddingMutation) OldEndWord(ctx context.Context) (v int, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldHiddenHighlights is only allowed on UpdateOne operations")
+		return v, errors.New("OldEndWord is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldHiddenHighlights requires an ID field in the mutation")
+		return v, errors.New("OldEndWord requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldHiddenHighlights: %w", err)
+		return v, fmt.Errorf("querying old value for OldEndWord: %w", err)
 	}
-	return oldValue.HiddenHighlights, nil
+	return oldValue.EndWord, nil
 }
 
-// AppendHiddenHighlights adds s to the "hidden_highlights" field.
-func (m *ProjectMutation) AppendHiddenHighlights(s []string) {
-	m.appendhidden_highlights = append(m.appendhidden_highlights, s...)
+// AddEndWord adds i to the "end_word" field.
+func (m *TranscriptEmbeddingMutation) AddEndWord(i int) {
+	if m.addend_word != nil {
+		*m.addend_word += i
+	} else {
+		m.addend_word = &i
+	}
 }
 
-// AppendedHiddenHighlights returns the list of values that were appended to the "hidden_highlights" field in this mutation.
-func (m *ProjectMutation) AppendedHiddenHighlights() ([]string, bool) {
-	if len(m.appendhidden_highlights) == 0 {
-		return nil, false
+// AddedEndWord returns the value that was added to the "end_word" field in this mutation.
+func (m *TranscriptEmbeddingMutation) AddedEndWord() (r int, exists bool) {
+	v := m.addend_word
+	if v == nil {
+		return
 	}
-	return m.appendhidden_highlights, true
+	return *v, true
 }
 
-// ClearHiddenHighlights clears the value of the "hidden_highlights" field.
-func (m *ProjectMutation) ClearHiddenHighlights() {
-	m.hidden_highlights = nil
-	m.appendhidden_highlights = nil
-	m.clearedFields[project.FieldHiddenHighlights] = struct{}{}
+// ResetEndWord resets all changes to the "end_word" field.
+func (m *TranscriptEmbeddingMutation) ResetEndWord() {
+	m.end_word = nil
+	m.addend_word = nil
 }
 
-// HiddenHighlightsCleared returns if the "hidden_highlights" field was cleared in this mutation.
-func (m *ProjectMutation) HiddenHighlightsCleared() bool {
-	_, ok := m.clearedFields[project.FieldHiddenHighlights]
-	return ok
+// SetStartTime sets the "start_time" field.
+func (m *TranscriptEmbeddingMutation) SetStartTime(f float64) {
+	m.start_time = &f
+	m.addstart_time = nil
 }
 
-// ResetHiddenHighlights resets all changes to the "hidden_highlights" field.
-func (m *ProjectMutation) ResetHiddenHighlights() {
-	m.hidden_highlights = nil
-	m.appendhidden_highlights = nil
-	delete(m.clearedFields, project.FieldHiddenHighlights)
+// StartTime returns the value of the "start_time" field in the mutation.
+func (m *TranscriptEmbeddingMutation) StartTime() (r float64, exists bool) {
+	v := m.start_time
+	if v == nil {
+		return
+	}
+	return *v, true
 }
 
-// AddVideoClipIDs adds the "video_clips" edge to the VideoClip entity by ids.
-func (m *ProjectMutation) AddVideoClipIDs(ids ...int) {
-	if m.video_clips == nil {
-		m.video_clips = make(map[int]struct{})
+// OldStartTime returns the old "start_time" field's value of the TranscriptEmbedding entity.
+// If the TranscriptEmbedding object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *TranscriptEmbeddingMutation) OldStartTime(ctx context.Context) (v float64, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldStartTime is only allowed on UpdateOne operations")
 	}
-	for i := range ids {
-		m.video_clips[ids[i]] = struct{}{}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldStartTime requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldStartTime: %w", err)
 	}
+	return oldValue.StartTime, nil
 }
 
-// ClearVideoClips clears the "video_clips" edge to the VideoClip entity.
-func (m *ProjectMutation) ClearVideoClips() {
-	m.clearedvideo_clips = true
+// AddStartTime adds f to the "start_time" field.
+func (m *TranscriptEmbeddingMutation) AddStartTime(f float64) {
+	if m.addstart_time != nil {
+		*m.addstart_time += f
+	} else {
+		m.addstart_time = &f
+	}
 }
 
-// VideoClipsCleared reports if the "video_clips" edge to the VideoClip entity was cleared.
-func (m *ProjectMutation) VideoClipsCleared() bool {
-	return m.clearedvideo_clips
+// AddedStartTime returns the value that was added to the "start_time" field in this mutation.
+func (m *TranscriptEmbeddingMutation) AddedStartTime() (r float64, exists bool) {
+	v := m.addstart_time
+	if v == nil {
+		return
+	}
+	return *v, true
 }
 
-// RemoveVideoClipIDs removes the "video_clips" edge to the VideoClip entity by IDs.
-func (m *ProjectMutation) RemoveVideoClipIDs(ids ...int) {
-	if m.removedvideo_clips == nil {
-		m.removedvideo_clips = make(map[int]struct{})
+// ResetStartTime resets all changes to the "start_time" field.
+func (m *TranscriptEmbeddingMutation) ResetStartTime() {
+	m.start_time = nil
+	m.addstart_time = nil
+}
+
+// SetEndTime sets the "end_time" field.
+func (m *TranscriptEmbeddingMutation) SetEndTime(f float64) {
+	m.end_time = &f
+	m.addend_time = nil
+}
+
+// EndTime returns the value of the "end_time" field in the mutation.
+func (m *TranscriptEmbeddingMutation) EndTime() (r float64, exists bool) {
+	v := m.end_time
+	if v == nil {
+		return
 	}
-	for i := range ids {
-		delete(m.video_clips, ids[i])
-		m.removedvideo_clips[ids[i]] = struct{}{}
+	return *v, true
+}
+
+// OldEndTime returns the old "end_time" field's value of the TranscriptEmbedding entity.
+// If the TranscriptEmbedding object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *TranscriptEmbeddingMutation) OldEndTime(ctx context.Context) (v float64, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldEndTime is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldEndTime requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldEndTime: %w", err)
 	}
+	return oldValue.EndTime, nil
 }
 
-// RemovedVideoClips returns the removed IDs of the "video_clips" edge to the VideoClip entity.
-func (m *ProjectMutation) RemovedVideoClipsIDs() (ids []int) {
-	for id := range m.removedvideo_clips {
-		ids = append(ids, id)
+// AddEndTime adds f to the "end_time" field.
+func (m *TranscriptEmbeddingMutation) AddEndTime(f float64) {
+	if m.addend_time != nil {
+		*m.addend_time += f
+	} else {
+		m.addend_time = &f
 	}
-	return
 }
 
-// VideoClipsIDs returns the "video_clips" edge IDs in the mutation.
-func (m *ProjectMutation) VideoClipsIDs() (ids []int) {
-	for id := range m.video_clips {
-		ids = append(ids, id)
+// AddedEndTime returns the value that was added to the "end_time" field in this mutation.
+func (m *TranscriptEmbeddingMutation) AddedEndTime() (r float64, exists bool) {
+	v := m.addend_time
+	if v == nil {
+		return
 	}
-	return
+	return *v, true
 }
 
-// ResetVideoClips resets all changes to the "video_clips" edge.
-func (m *ProjectMutation) ResetVideoClips() {
-	m.video_clips = nil
-	m.clearedvideo_clips = false
-	m.removedvideo_clips = nil
+// ResetEndTime resets all changes to the "end_time" field.
+func (m *TranscriptEmbeddingMutation) ResetEndTime() {
+	m.end_time = nil
+	m.addend_time = nil
 }
 
-// AddExportJobIDs adds the "export_jobs" edge to the ExportJob entity by ids.
-func (m *ProjectMutation) AddExportJobIDs(ids ...int) {
-	if m.export_jobs == nil {
-		m.export_jobs = make(map[int]struct{})
+// SetText sets the "text" field.
+func (m *TranscriptEmbeddingMutation) SetText(s string) {
+	m.text = &s
+}
+
+// Text returns the value of the "text" field in the mutation.
+func (m *TranscriptEmbeddingMutation) Text() (r string, exists bool) {
+	v := m.text
+	if v == nil {
+		return
 	}
-	for i := range ids {
-		m.export_jobs[ids[i]] = struct{}{}
+	return *v, true
+}
+
+// OldText returns the old "text" field's value of the TranscriptEmbedding entity.
+// If the TranscriptEmbedding object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *TranscriptEmbeddingMutation) OldText(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldText is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldText requires an ID field in the mutation")
 	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldText: %w", err)
+	}
+	return oldValue.Text, nil
 }
 
-// ClearExportJobs clears the "export_jobs" edge to the ExportJob entity.
-func (m *ProjectMutation) ClearExportJobs() {
-	m.clearedexport_jobs = true
+// ResetText resets all changes to the "text" field.
+func (m *TranscriptEmbeddingMutation) ResetText() {
+	m.text = nil
 }
 
-// ExportJobsCleared reports if the "export_jobs" edge to the ExportJob entity was cleared.
-func (m *ProjectMutation) ExportJobsCleared() bool {
-	return m.clearedexport_jobs
+// SetModel sets the "model" field.
+func (m *TranscriptEmbeddingMutation) SetModel(s string) {
+	m.model = &s
 }
 
-// RemoveExportJobIDs removes the "export_jobs" edge to the ExportJob entity by IDs.
-func (m *ProjectMutation) RemoveExportJobIDs(ids ...int) {
-	if m.removedexport_jobs == nil {
-		m.removedexport_jobs = make(map[int]struct{})
+// Model returns the value of the "model" field in the mutation.
+func (m *TranscriptEmbeddingMutation) Model() (r string, exists bool) {
+	v := m.model
+	if v == nil {
+		return
 	}
-	for i := range ids {
-		delete(m.export_jobs, ids[i])
-		m.removedexport_jobs[ids[i]] = struct{}{}
+	return *v, true
+}
+
+// OldModel returns the old "model" field's value of the TranscriptEmbedding entity.
+// If the TranscriptEmbedding object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *TranscriptEmbeddingMutation) OldModel(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldModel is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldModel requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldModel: %w", err)
+	}
+	return oldValue.Model, nil
+}
+
+// ResetModel resets all changes to the "model" field.
+func (m *TranscriptEmbeddingMutation) ResetModel() {
+	m.model = nil
+}
+
+// SetSourceHash sets the "source_hash" field.
+func (m *TranscriptEmbeddingMutation) SetSourceHash(s string) {
+	m.source_hash = &s
+}
+
+// SourceHash returns the value of the "source_hash" field in the mutation.
+func (m *TranscriptEmbeddingMutation) SourceHash() (r string, exists bool) {
+	v := m.source_hash
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldSourceHash returns the old "source_hash" field's value of the TranscriptEmbedding entity.
+// If the TranscriptEmbedding object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *TranscriptEmbeddingMutation) OldSourceHash(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldSourceHash is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldSourceHash requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldSourceHash: %w", err)
 	}
+	return oldValue.SourceHash, nil
 }
 
-// RemovedExportJobs returns the removed IDs of the "export_jobs" edge to the ExportJob entity.
-func (m *ProjectMutation) RemovedExportJobsIDs() (ids []int) {
-	for id := range m.removedexport_jobs {
-		ids = append(ids, id)
+// ResetSourceHash resets all changes to the "source_hash" field.
+func (m *TranscriptEmbeddingMutation) ResetSourceHash() {
+	m.source_hash = nil
+}
+
+// SetEmbedding sets the "embedding" field.
+func (m *TranscriptEmbeddingMutation) SetEmbedding(f []float32) {
+	m.embedding = &f
+	m.appendembedding = nil
+}
+
+// Embedding returns the value of the "embedding" field in the mutation.
+func (m *TranscriptEmbeddingMutation) Embedding() (r []float32, exists bool) {
+	v := m.embedding
+	if v == nil {
+		return
 	}
-	return
+	return *v, true
 }
 
-// ExportJobsIDs returns the "export_jobs" edge IDs in the mutation.
-func (m *ProjectMutation) ExportJobsIDs() (ids []int) {
-	for id := range m.export_jobs {
-		ids = append(ids, id)
+// OldEmbedding returns the old "embedding" field's value of the TranscriptEmbedding entity.
+// If the TranscriptEmbedding object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *TranscriptEmbeddingMutation) OldEmbedding(ctx context.Context) (v []float32, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldEmbedding is only allowed on UpdateOne operations")
 	}
-	return
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldEmbedding requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldEmbedding: %w", err)
+	}
+	return oldValue.Embedding, nil
 }
 
-// ResetExportJobs resets all changes to the "export_jobs" edge.
-func (m *ProjectMutation) ResetExportJobs() {
-	m.export_jobs = nil
-	m.clearedexport_jobs = false
-	m.removedexport_jobs = nil
+// AppendEmbedding adds f to the "embedding" field.
+func (m *TranscriptEmbeddingMutation) AppendEmbedding(f []float32) {
+	m.appendembedding = append(m.appendembedding, f...)
 }
 
-// AddChatSessionIDs adds the "chat_sessions" edge to the ChatSession entity by ids.
-func (m *ProjectMutation) AddChatSessionIDs(ids ...int) {
-	if m.chat_sessions == nil {
-		m.chat_sessions = make(map[int]struct{})
-	}
-	for i := range ids {
-		m.chat_sessions[ids[i]] = struct{}{}
+// AppendedEmbedding returns the list of values that were appended to the "embedding" field in this mutation.
+func (m *TranscriptEmbeddingMutation) AppendedEmbedding() ([]float32, bool) {
+	if len(m.appendembedding) == 0 {
+		return nil, false
 	}
+	return m.appendembedding, true
 }
 
-// ClearChatSessions clears the "chat_sessions" edge to the ChatSession entity.
-func (m *ProjectMutation) ClearChatSessions() {
-	m.clearedchat_sessions = true
+// ResetEmbedding resets all changes to the "embedding" field.
+func (m *TranscriptEmbeddingMutation) ResetEmbedding() {
+	m.embedding = nil
+	m.appendembedding = nil
 }
 
-// ChatSessionsCleared reports if the "chat_sessions" edge to the ChatSession entity was cleared.
-func (m *ProjectMutation) ChatSessionsCleared() bool {
-	return m.clearedchat_sessions
+// SetCreatedAt sets the "created_at" field.
+func (m *TranscriptEmbeddingMutation) SetCreatedAt(t time.Time) {
+	m.created_at = &t
 }
 
-// RemoveChatSessionIDs removes the "chat_sessions" edge to the ChatSession entity by IDs.
-func (m *ProjectMutation) RemoveChatSessionIDs(ids ...int) {
-	if m.removedchat_sessions == nil {
-		m.removedchat_sessions = make(map[int]struct{})
-	}
-	for i := range ids {
-		delete(m.chat_sessions, ids[i])
-		m.removedchat_sessions[ids[i]] = struct{}{}
+// CreatedAt returns the value of the "created_at" field in the mutation.
+func (m *TranscriptEmbeddingMutation) CreatedAt() (r time.Time, exists bool) {
+	v := m.created_at
+	if v == nil {
+		return
 	}
+	return *v, true
 }
 
-// RemovedChatSessions returns the removed IDs of the "chat_sessions" edge to the ChatSession entity.
-func (m *ProjectMutation) RemovedChatSessionsIDs() (ids []int) {
-	for id := range m.removedchat_sessions {
-		ids = append(ids, id)
+// OldCreatedAt returns the old "created_at" field's value of the TranscriptEmbedding entity.
+// If the TranscriptEmbedding object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *TranscriptEmbeddingMutation) OldCreatedAt(ctx context.Context) (v time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldCreatedAt is only allowed on UpdateOne operations")
 	}
-	return
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldCreatedAt requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldCreatedAt: %w", err)
+	}
+	return oldValue.CreatedAt, nil
 }
 
-// ChatSessionsIDs returns the "chat_sessions" edge IDs in the mutation.
-func (m *ProjectMutation) ChatSessionsIDs() (ids []int) {
-	for id := range m.chat_sessions {
-		ids = append(ids, id)
+// ResetCreatedAt resets all changes to the "created_at" field.
+func (m *TranscriptEmbeddingMutation) ResetCreatedAt() {
+	m.created_at = nil
+}
+
+// ClearVideoClip clears the "video_clip" edge to the VideoClip entity.
+func (m *TranscriptEmbeddingMutation) ClearVideoClip() {
+	m.clearedvideo_clip = true
+	m.clearedFields[transcriptembedding.FieldVideoClipID] = struct{}{}
+}
+
+// VideoClipCleared reports if the "video_clip" edge to the VideoClip entity was cleared.
+func (m *TranscriptEmbeddingMutation) VideoClipCleared() bool {
+	return m.clearedvideo_clip
+}
+
+// VideoClipIDs returns the "video_clip" edge IDs in the mutation.
+// Note that IDs always returns len(IDs) <= 1 for unique edges, and you should use
+// VideoClipID instead. It exists only for internal usage by the builders.
+func (m *TranscriptEmbeddingMutation) VideoClipIDs() (ids []int) {
+	if id := m.video_clip; id != nil {
+		ids = append(ids, *id)
 	}
 	return
 }
 
-// ResetChatSessions resets all changes to the "chat_sessions" edge.
-func (m *ProjectMutation) ResetChatSessions() {
-	m.chat_sessions = nil
-	m.clearedchat_sessions = false
-	m.removedchat_sessions = nil
+// ResetVideoClip resets all changes to the "video_clip" edge.
+func (m *TranscriptEmbeddingMutation) ResetVideoClip() {
+	m.video_clip = nil
+	m.clearedvideo_clip = false
 }
 
-// Where appends a list predicates to the ProjectMutation builder.
-func (m *ProjectMutation) Where(ps ...predicate.Project) {
+// Where appends a list predicates to the TranscriptEmbeddingMutation builder.
+func (m *TranscriptEmbeddingMutation) Where(ps ...predicate.TranscriptEmbedding) {
 	m.predicates = append(m.predicates, ps...)
 }
 
-// WhereP appends storage-level predicates to the ProjectMutation builder. Using this method,
+// WhereP appends storage-level predicates to the TranscriptEmbeddingMutation builder. Using this method,
 // users can use type-assertion to append predicates that do not depend on any generated package.
-func (m *ProjectMutation) WhereP(ps ...func(*sql.Selector)) {
-	p := make([]predicate.Project, len(ps))
+func (m *TranscriptEmbeddingMutation) WhereP(ps ...func(*sql.Selector)) {
+	p := make([]predicate.TranscriptEmbedding, len(ps))
 	for i := range ps {
 		p[i] = ps[i]
 	}
@@ -4209,341 +11968,227 @@ func (m *ProjectMutation) WhereP(ps ...func(*sql.Selector)) {
 }
 
 // Op returns the operation name.
-func (m *ProjectMutation) Op() Op {
+func (m *TranscriptEmbeddingMutation) Op() Op {
 	return m.op
 }
 
 // SetOp allows setting the mutation operation.
-func (m *ProjectMutation) SetOp(op Op) {
+func (m *TranscriptEmbeddingMutation) SetOp(op Op) {
 	m.op = op
 }
 
-// Type returns the node type of this mutation (Project).
-func (m *ProjectMutation) Type() string {
+// Type returns the node type of this mutation (TranscriptEmbedding).
+func (m *TranscriptEmbeddingMutation) Type() string {
 	return m.typ
 }
 
 // Fields returns all fields that were changed during this mutation. Note that in
 // order to get all numeric fields that were incremented/decremented, call
 // AddedFields().
-func (m *ProjectMutation) Fields() []string {
-	fields := make([]string, 0, 20)
-	if m.name != nil {
-		fields = append(fields, project.FieldName)
-	}
-	if m.description != nil {
-		fields = append(fields, project.FieldDescription)
-	}
-	if m._path != nil {
-		fields = append(fields, project.FieldPath)
-	}
-	if m.created_at != nil {
-		fields = append(fields, project.FieldCreatedAt)
-	}
-	if m.updated_at != nil {
-		fields = append(fields, project.FieldUpdatedAt)
-	}
-	if m.ai_model != nil {
-		fields = append(fields, project.FieldAiModel)
-	}
-	if m.ai_prompt != nil {
-		fields = append(fields, project.FieldAiPrompt)
-	}
-	if m.ai_suggestion_order != nil {
-		fields = append(fields, project.FieldAiSuggestionOrder)
+func (m *TranscriptEmbeddingMutation) Fields() []string {
+	fields := make([]string, 0, 11)
+	if m.video_clip != nil {
+		fields = append(fields, transcriptembedding.FieldVideoClipID)
 	}
-	if m.ai_suggestion_model != nil {
-		fields = append(fields, project.FieldAiSuggestionModel)
-	}
-	if m.ai_suggestion_created_at != nil {
-		fields = append(fields, project.FieldAiSuggestionCreatedAt)
-	}
-	if m.ai_highlight_model != nil {
-		fields = append(fields, project.FieldAiHighlightModel)
-	}
-	if m.ai_highlight_prompt != nil {
-		fields = append(fields, project.FieldAiHighlightPrompt)
-	}
-	if m.active_tab != nil {
-		fields = append(fields, project.FieldActiveTab)
-	}
-	if m.ai_silence_improvements != nil {
-		fields = append(fields, project.FieldAiSilenceImprovements)
-	}
-	if m.ai_silence_model != nil {
-		fields = append(fields, project.FieldAiSilenceModel)
-	}
-	if m.ai_silence_created_at != nil {
-		fields = append(fields, project.FieldAiSilenceCreatedAt)
-	}
-	if m.highlight_order != nil {
-		fields = append(fields, project.FieldHighlightOrder)
+	if m.chunk_index != nil {
+		fields = append(fields, transcriptembedding.FieldChunkIndex)
 	}
-	if m.order_history != nil {
-		fields = append(fields, project.FieldOrderHistory)
+	if m.start_word != nil {
+		fields = append(fields, transcriptembedding.FieldStartWord)
 	}
-	if m.order_history_index != nil {
-		fields = append(fields, project.FieldOrderHistoryIndex)
+	if m.end_word != nil {
+		fields = append(fields, transcriptembedding.FieldEndWord)
 	}
-	if m.hidden_highlights != nil {
-		fields = append(fields, project.FieldHiddenHighlights)
+	if m.start_time != nil {
+		fields = append(fields, transcriptembedding.FieldStartTime)
 	}
-	return fields
-}
-
-// Field returns the value of a field with the given name. The second boolean
-// return value indicates that this field was not set, or was not defined in the
-// schema.
-func (m *ProjectMutation) Field(name string) (ent.Value, bool) {
-	switch name {
-	case project.FieldName:
-		return m.Name()
-	case project.FieldDescription:
-		return m.Description()
-	case project.FieldPath:
-		return m.Path()
-	case project.FieldCreatedAt:
-		return m.CreatedAt()
-	case project.FieldUpdatedAt:
-		return m.UpdatedAt()
-	case project.FieldAiModel:
-		return m.AiModel()
-	case project.FieldAiPrompt:
-		return m.AiPrompt()
-	case project.FieldAiSuggestionOrder:
-		return m.AiSuggestionOrder()
-	case project.FieldAiSuggestionModel:
-		return m.AiSuggestionModel()
-	case project.FieldAiSuggestionCreatedAt:
-		return m.AiSuggestionCreatedAt()
-	case project.FieldAiHighlightModel:
-		return m.AiHighlightModel()
-	case project.FieldAiHighlightPrompt:
-		return m.AiHighlightPrompt()
-	case project.FieldActiveTab:
-		return m.ActiveTab()
-	case project.FieldAiSilenceImprovements:
-		return m.AiSilenceImprovements()
-	case project.FieldAiSilenceModel:
-		return m.AiSilenceModel()
-	case project.FieldAiSilenceCreatedAt:
-		return m.AiSilenceCreatedAt()
-	case project.FieldHighlightOrder:
-		return m.HighlightOrder()
-	case project.FieldOrderHistory:
-		return m.OrderHistory()
-	case project.FieldOrderHistoryIndex:
-		return m.OrderHistoryIndex()
-	case project.FieldHiddenHighlights:
-		return m.HiddenHighlights()
+	if m.end_time != nil {
+		fields = append(fields, transcriptembedding.FieldEndTime)
 	}
-	return nil, false
-}
-
-// OldField returns the old value of the field from the database. An error is
-// returned if the mutation operation is not UpdateOne, or the query to the
-// database failed.
-func (m *ProjectMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
-	switch name {
-	case project.FieldName:
-		return m.OldName(ctx)
-	case project.FieldDescription:
-		return m.OldDescription(ctx)
-	case project.FieldPath:
-		return m.OldPath(ctx)
-	case project.FieldCreatedAt:
-		return m.OldCreatedAt(ctx)
-	case project.FieldUpdatedAt:
-		return m.OldUpdatedAt(ctx)
-	case project.FieldAiModel:
-		return m.OldAiModel(ctx)
-	case project.FieldAiPrompt:
-		return m.OldAiPrompt(ctx)
-	case project.FieldAiSuggestionOrder:
-		return m.OldAiSuggestionOrder(ctx)
-	case project.FieldAiSuggestionModel:
-		return m.OldAiSuggestionModel(ctx)
-	case project.FieldAiSuggestionCreatedAt:
-		return m.OldAiSuggestionCreatedAt(ctx)
-	case project.FieldAiHighlightModel:
-		return m.OldAiHighlightModel(ctx)
-	case project.FieldAiHighlightPrompt:
-		return m.OldAiHighlightPrompt(ctx)
-	case project.FieldActiveTab:
-		return m.OldActiveTab(ctx)
-	case project.FieldAiSilenceImprovements:
-		return m.OldAiSilenceImprovements(ctx)
-	case project.FieldAiSilenceModel:
-		return m.OldAiSilenceModel(ctx)
-	case project.FieldAiSilenceCreatedAt:
-		return m.OldAiSilenceCreatedAt(ctx)
-	case project.FieldHighlightOrder:
-		return m.OldHighlightOrder(ctx)
-	case project.FieldOrderHistory:
-		return m.OldOrderHistory(ctx)
-	case project.FieldOrderHistoryIndex:
-		return m.OldOrderHistoryIndex(ctx)
-	case project.FieldHiddenHighlights:
-		return m.OldHiddenHighlights(ctx)
+	if m.text != nil {
+		fields = append(fields, transcriptembedding.FieldText)
 	}
-	return nil, fmt.Errorf("unknown Project field %s", name)
+	if m.model != nil {
+		fields = append(fields, transcriptembedding.FieldModel)
+	}
+	if m.source_hash != nil {
+		fields = append(fields, transcriptembedding.FieldSourceHash)
+	}
+	if m.embedding != nil {
+		fields = append(fields, transcriptembedding.FieldEmbedding)
+	}
+	if m.created_at != nil {
+		fields = append(fields, transcriptembedding.FieldCreatedAt)
+	}
+	return fields
+}
+
+// Field returns the value of a field with the given name. The second boolean
+// return value indicates that this field was not set, or was not defined in the
+// schema.
+func (m *TranscriptEmbeddingMutation) Field(name string) (ent.Value, bool) {
+	switch name {
+	case transcriptembedding.FieldVideoClipID:
+		return m.VideoClipID()
+	case transcriptembedding.FieldChunkIndex:
+		return m.ChunkIndex()
+	case transcriptembedding.FieldStartWord:
+		return m.StartWord()
+	case transcriptembedding.FieldEndWord:
+		return m.EndWord()
+	case transcriptembedding.FieldStartTime:
+		return m.StartTime()
+	case transcriptembedding.FieldEndTime:
+		return m.EndTime()
+	case transcriptembedding.FieldText:
+		return m.Text()
+	case transcriptembedding.FieldModel:
+		return m.Model()
+	case transcriptembedding.FieldSourceHash:
+		return m.SourceHash()
+	case transcriptembedding.FieldEmbedding:
+		return m.Embedding()
+	case transcriptembedding.FieldCreatedAt:
+		return m.CreatedAt()
+	}
+	return nil, false
+}
+
+// OldField returns the old value of the field from the database. An error is
+// returned if the mutation operation is not UpdateOne, or the query to the
+// database failed.
+func (m *TranscriptEmbeddingMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
+	switch name {
+	case transcriptembedding.FieldVideoClipID:
+		return m.OldVideoClipID(ctx)
+	case transcriptembedding.FieldChunkIndex:
+		return m.OldChunkIndex(ctx)
+	case transcriptembedding.FieldStartWord:
+		return m.OldStartWord(ctx)
+	case transcriptembedding.FieldEndWord:
+		return m.OldEndWord(ctx)
+	case transcriptembedding.FieldStartTime:
+		return m.OldStartTime(ctx)
+	case transcriptembedding.FieldEndTime:
+		return m.OldEndTime(ctx)
+	case transcriptembedding.FieldText:
+		return m.OldText(ctx)
+	case transcriptembedding.FieldModel:
+		return m.OldModel(ctx)
+	case transcriptembedding.FieldSourceHash:
+		return m.OldSourceHash(ctx)
+	case transcriptembedding.FieldEmbedding:
+		return m.OldEmbedding(ctx)
+	case transcriptembedding.FieldCreatedAt:
+		return m.OldCreatedAt(ctx)
+	}
+	return nil, fmt.Errorf("unknown TranscriptEmbedding field %s", name)
 }
 
 // SetField sets the value of a field with the given name. It returns an error if
 // the field is not defined in the schema, or if the type mismatched the field
 // type.
-func (m *ProjectMutation) SetField(name string, value ent.Value) error {
+func (m *TranscriptEmbeddingMutation) SetField(name string, value ent.Value) error {
 	switch name {
-	case project.FieldName:
-		v, ok := value.(string)
-		if !ok {
-			return fmt.Errorf("unexpected type %T for field %s", value, name)
-		}
-		m.SetName(v)
-		return nil
-	case project.FieldDescription:
-		v, ok := value.(string)
-		if !ok {
-			return fmt.Errorf("unexpected type %T for field %s", value, name)
-		}
-		m.SetDescription(v)
-		return nil
-	case project.FieldPath:
-		v, ok := value.(string)
-		if !ok {
-			return fmt.Errorf("unexpected type %T for field %s", value, name)
-		}
-		m.SetPath(v)
-		return nil
-	case project.FieldCreatedAt:
-		v, ok := value.(time.Time)
-		if !ok {
-			return fmt.Errorf("unexpected type %T for field %s", value, name)
-		}
-		m.SetCreatedAt(v)
-		return nil
-	case project.FieldUpdatedAt:
-		v, ok := value.(time.Time)
+	case transcriptembedding.FieldVideoClipID:
+		v, ok := value.(int)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetUpdatedAt(v)
+		m.SetVideoClipID(v)
 		return nil
-	case project.FieldAiModel:
-		v, ok := value.(string)
+	case transcriptembedding.FieldChunkIndex:
+		v, ok := value.(int)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetAiModel(v)
+		m.SetChunkIndex(v)
 		return nil
-	case project.FieldAiPrompt:
-		v, ok := value.(string)
+	case transcriptembedding.FieldStartWord:
+		v, ok := value.(int)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetAiPrompt(v)
+		m.SetStartWord(v)
 		return nil
-	case project.FieldAiSuggestionOrder:
-		v, ok := value.([]interface{})
+	case transcriptembedding.FieldEndWord:
+		v, ok := value.(int)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetAiSuggestionOrder(v)
+		m.SetEndWord(v)
 		return nil
-	case project.FieldAiSuggestionModel:
-		v, ok := value.(string)
+	case transcriptembedding.FieldStartTime:
+		v, ok := value.(float64)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetAiSuggestionModel(v)
+		m.SetStartTime(v)
 		return nil
-	case project.FieldAiSuggestionCreatedAt:
-		v, ok := value.(time.Time)
+	case transcriptembedding.FieldEndTime:
+		v, ok := value.(float64)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetAiSuggestionCreatedAt(v)
+		m.SetEndTime(v)
 		return nil
-	case project.FieldAiHighlightModel:
+	case transcriptembedding.FieldText:
 		v, ok := value.(string)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetAiHighlightModel(v)
+		m.SetText(v)
 		return nil
-	case project.FieldAiHighlightPrompt:
+	case transcriptembedding.FieldModel:
 		v, ok := value.(string)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetAiHighlightPrompt(v)
+		m.SetModel(v)
 		return nil
-	case project.FieldActiveTab:
+	case transcriptembedding.FieldSourceHash:
 		v, ok := value.(string)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetActiveTab(v)
-		return nil
-	case project.FieldAiSilenceImprovements:
-		v, ok := value.([]map[string]interface{})
-		if !ok {
-			return fmt.Errorf("unexpected type %T for field %s", value, name)
-		}
-		m.SetAiSilenceImprovements(v)
+		m.SetSourceHash(v)
 		return nil
-	case project.FieldAiSilenceModel:
-		v, ok := value.(string)
+	case transcriptembedding.FieldEmbedding:
+		v, ok := value.([]float32)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetAiSilenceModel(v)
+		m.SetEmbedding(v)
 		return nil
-	case project.FieldAiSilenceCreatedAt:
+	case transcriptembedding.FieldCreatedAt:
 		v, ok := value.(time.Time)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetAiSilenceCreatedAt(v)
-		return nil
-	case project.FieldHighlightOrder:
-		v, ok := value.([]interface{})
-		if !ok {
-			return fmt.Errorf("unexpected type %T for field %s", value, name)
-		}
-		m.SetHighlightOrder(v)
-		return nil
-	case project.FieldOrderHistory:
-		v, ok := value.([][]interface{})
-		if !ok {
-			return fmt.Errorf("unexpected type %T for field %s", value, name)
-		}
-		m.SetOrderHistory(v)
-		return nil
-	case project.FieldOrderHistoryIndex:
-		v, ok := value.(int)
-		if !ok {
-			return fmt.Errorf("unexpected type %T for field %s", value, name)
-		}
-		m.SetOrderHistoryIndex(v)
-		return nil
-	case project.FieldHiddenHighlights:
-		v, ok := value.([]string)
-		if !ok {
-			return fmt.Errorf("unexpected type %T for field %s", value, name)
-		}
-		m.SetHiddenHighlights(v)
+		m.SetCreatedAt(v)
 		return nil
 	}
-	return fmt.Errorf("unknown Project field %s", name)
+	return fmt.Errorf("unknown TranscriptEmbedding field %s", name)
 }
 
 // AddedFields returns all numeric fields that were incremented/decremented during
 // this mutation.
-func (m *ProjectMutation) AddedFields() []string {
+func (m *TranscriptEmbeddingMutation) AddedFields() []string {
 	var fields []string
-	if m.addorder_history_index != nil {
-		fields = append(fields, project.FieldOrderHistoryIndex)
+	if m.addchunk_index != nil {
+		fields = append(fields, transcriptembedding.FieldChunkIndex)
+	}
+	if m.addstart_word != nil {
+		fields = append(fields, transcriptembedding.FieldStartWord)
+	}
+	if m.addend_word != nil {
+		fields = append(fields, transcriptembedding.FieldEndWord)
+	}
+	if m.addstart_time != nil {
+		fields = append(fields, transcriptembedding.FieldStartTime)
+	}
+	if m.addend_time != nil {
+		fields = append(fields, transcriptembedding.FieldEndTime)
 	}
 	return fields
 }
@@ -4551,632 +12196,879 @@ func (m *ProjectMutation) AddedFields() []string {
 // AddedField returns the numeric value that was incremented/decremented on a field
 // with the given name. The second boolean return value indicates that this field
 // was not set, or was not defined in the schema.
-func (m *ProjectMutation) AddedField(name string) (ent.Value, bool) {
+func (m *TranscriptEmbeddingMutation) AddedField(name string) (ent.Value, bool) {
 	switch name {
-	case project.FieldOrderHistoryIndex:
-		return m.AddedOrderHistoryIndex()
+	case transcriptembedding.FieldChunkIndex:
+		return m.AddedChunkIndex()
+	case transcriptembedding.FieldStartWord:
+		return m.AddedStartWord()
+	case transcriptembedding.FieldEndWord:
+		return m.AddedEndWord()
+	case transcriptembedding.FieldStartTime:
+		return m.AddedStartTime()
+	case transcriptembedding.FieldEndTime:
+		return m.AddedEndTime()
 	}
 	return nil, false
 }
 
-// AddField adds the value to the field with the given name. It returns an error if
-// the field is not defined in the schema, or if the type mismatched the field
-// type.
-func (m *ProjectMutation) AddField(name string, value ent.Value) error {
-	switch name {
-	case project.FieldOrderHistoryIndex:
-		v, ok := value.(int)
-		if !ok {
-			return fmt.Errorf("unexpected type %T for field %s", value, name)
-		}
-		m.AddOrderHistoryIndex(v)
-		return nil
-	}
-	return fmt.Errorf("unknown Project numeric field %s", name)
-}
-
-// ClearedFields returns all nullable fields that were cleared during this
-// mutation.
-func (m *ProjectMutation) ClearedFields() []string {
-	var fields []string
-	if m.FieldCleared(project.FieldDescription) {
-		fields = append(fields, project.FieldDescription)
-	}
-	if m.FieldCleared(project.FieldAiModel) {
-		fields = append(fields, project.FieldAiModel)
-	}
-	if m.FieldCleared(project.FieldAiPrompt) {
-		fields = append(fields, project.FieldAiPrompt)
-	}
-	if m.FieldCleared(project.FieldAiSuggestionOrder) {
-		fields = append(fields, project.FieldAiSuggestionOrder)
-	}
-	if m.FieldCleared(project.FieldAiSuggestionModel) {
-		fields = append(fields, project.FieldAiSuggestionModel)
-	}
-	if m.FieldCleared(project.FieldAiSuggestionCreatedAt) {
-		fields = append(fields, project.FieldAiSuggestionCreatedAt)
-	}
-	if m.FieldCleared(project.FieldAiHighlightModel) {
-		fields = append(fields, project.FieldAiHighlightModel)
-	}
-	if m.FieldCleared(project.FieldAiHighlightPrompt) {
-		fields = append(fields, project.FieldAiHighlightPrompt)
-	}
-	if m.FieldCleared(project.FieldActiveTab) {
-		fields = append(fields, project.FieldActiveTab)
-	}
-	if m.FieldCleared(project.FieldAiSilenceImprovements) {
-		fields = append(fields, project.FieldAiSilenceImprovements)
-	}
-	if m.FieldCleared(project.FieldAiSilenceModel) {
-		fields = append(fields, project.FieldAiSilenceModel)
-	}
-	if m.FieldCleared(project.FieldAiSilenceCreatedAt) {
-		fields = append(fields, project.FieldAiSilenceCreatedAt)
-	}
-	if m.FieldCleared(project.FieldHighlightOrder) {
-		fields = append(fields, project.FieldHighlightOrder)
-	}
-	if m.FieldCleared(project.FieldOrderHistory) {
-		fields = append(fields, project.FieldOrderHistory)
-	}
-	if m.FieldCleared(project.FieldOrderHistoryIndex) {
-		fields = append(fields, project.FieldOrderHistoryIndex)
-	}
-	if m.FieldCleared(project.FieldHiddenHighlights) {
-		fields = append(fields, project.FieldHiddenHighlights)
-	}
-	return fields
-}
-
-// FieldCleared returns a boolean indicating if a field with the given name was
-// cleared in this mutation.
-func (m *ProjectMutation) FieldCleared(name string) bool {
-	_, ok := m.clearedFields[name]
-	return ok
-}
-
-// ClearField clears the value of the field with the given name. It returns an
-// error if the field is not defined in the schema.
-func (m *ProjectMutation) ClearField(name string) error {
-	switch name {
-	case project.FieldDescription:
-		m.ClearDescription()
-		return nil
-	case project.FieldAiModel:
-		m.ClearAiModel()
-		return nil
-	case project.FieldAiPrompt:
-		m.ClearAiPrompt()
-		return nil
-	case project.FieldAiSuggestionOrder:
-		m.ClearAiSuggestionOrder()
-		return nil
-	case project.FieldAiSuggestionModel:
-		m.ClearAiSuggestionModel()
-		return nil
-	case project.FieldAiSuggestionCreatedAt:
-		m.ClearAiSuggestionCreatedAt()
-		return nil
-	case project.FieldAiHighlightModel:
-		m.ClearAiHighlightModel()
-		return nil
-	case project.FieldAiHighlightPrompt:
-		m.ClearAiHighlightPrompt()
-		return nil
-	case project.FieldActiveTab:
-		m.ClearActiveTab()
-		return nil
-	case project.FieldAiSilenceImprovements:
-		m.ClearAiSilenceImprovements()
-		return nil
-	case project.FieldAiSilenceModel:
-		m.ClearAiSilenceModel()
-		return nil
-	case project.FieldAiSilenceCreatedAt:
-		m.ClearAiSilenceCreatedAt()
+// AddField adds the value to the field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *TranscriptEmbeddingMutation) AddField(name string, value ent.Value) error {
+	switch name {
+	case transcriptembedding.FieldChunkIndex:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddChunkIndex(v)
 		return nil
-	case project.FieldHighlightOrder:
-		m.ClearHighlightOrder()
+	case transcriptembedding.FieldStartWord:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddStartWord(v)
 		return nil
-	case project.FieldOrderHistory:
-		m.ClearOrderHistory()
+	case transcriptembedding.FieldEndWord:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddEndWord(v)
 		return nil
-	case project.FieldOrderHistoryIndex:
-		m.ClearOrderHistoryIndex()
+	case transcriptembedding.FieldStartTime:
+		v, ok := value.(float64)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddStartTime(v)
 		return nil
-	case project.FieldHiddenHighlights:
-		m.ClearHiddenHighlights()
+	case transcriptembedding.FieldEndTime:
+		v, ok := value.(float64)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddEndTime(v)
 		return nil
 	}
-	return fmt.Errorf("unknown Project nullable field %s", name)
+	return fmt.Errorf("unknown TranscriptEmbedding numeric field %s", name)
+}
+
+// ClearedFields returns all nullable fields that were cleared during this
+// mutation.
+func (m *TranscriptEmbeddingMutation) ClearedFields() []string {
+	return nil
+}
+
+// FieldCleared returns a boolean indicating if a field with the given name was
+// cleared in this mutation.
+func (m *TranscriptEmbeddingMutation) FieldCleared(name string) bool {
+	_, ok := m.clearedFields[name]
+	return ok
+}
+
+// ClearField clears the value of the field with the given name. It returns an
+// error if the field is not defined in the schema.
+func (m *TranscriptEmbeddingMutation) ClearField(name string) error {
+	return fmt.Errorf("unknown TranscriptEmbedding nullable field %s", name)
 }
 
 // ResetField resets all changes in the mutation for the field with the given name.
 // It returns an error if the field is not defined in the schema.
-func (m *ProjectMutation) ResetField(name string) error {
+func (m *TranscriptEmbeddingMutation) ResetField(name string) error {
 	switch name {
-	case project.FieldName:
-		m.ResetName()
-		return nil
-	case project.FieldDescription:
-		m.ResetDescription()
-		return nil
-	case project.FieldPath:
-		m.ResetPath()
-		return nil
-	case project.FieldCreatedAt:
-		m.ResetCreatedAt()
-		return nil
-	case project.FieldUpdatedAt:
-		m.ResetUpdatedAt()
-		return nil
-	case project.FieldAiModel:
-		m.ResetAiModel()
-		return nil
-	case project.FieldAiPrompt:
-		m.ResetAiPrompt()
-		return nil
-	case project.FieldAiSuggestionOrder:
-		m.ResetAiSuggestionOrder()
+	case transcriptembedding.FieldVideoClipID:
+		m.ResetVideoClipID()
 		return nil
-	case project.FieldAiSuggestionModel:
-		m.ResetAiSuggestionModel()
+	case transcriptembedding.FieldChunkIndex:
+		m.ResetChunkIndex()
 		return nil
-	case project.FieldAiSuggestionCreatedAt:
-		m.ResetAiSuggestionCreatedAt()
+	case transcriptembedding.FieldStartWord:
+		m.ResetStartWord()
 		return nil
-	case project.FieldAiHighlightModel:
-		m.ResetAiHighlightModel()
+	case transcriptembedding.FieldEndWord:
+		m.ResetEndWord()
 		return nil
-	case project.FieldAiHighlightPrompt:
-		m.ResetAiHighlightPrompt()
+	case transcriptembedding.FieldStartTime:
+		m.ResetStartTime()
 		return nil
-	case project.FieldActiveTab:
-		m.ResetActiveTab()
+	case transcriptembedding.FieldEndTime:
+		m.ResetEndTime()
 		return nil
-	case project.FieldAiSilenceImprovements:
-		m.ResetAiSilenceImprovements()
+	case transcriptembedding.FieldText:
+		m.ResetText()
 		return nil
-	case project.FieldAiSilenceModel:
-		m.ResetAiSilenceModel()
+	case transcriptembedding.FieldModel:
+		m.ResetModel()
 		return nil
-	case project.FieldAiSilenceCreatedAt:
-		m.ResetAiSilenceCreatedAt()
+	case transcriptembedding.FieldSourceHash:
+		m.ResetSourceHash()
 		return nil
-	case project.FieldHighlightOrder:
-		m.ResetHighlightOrder()
+	case transcriptembedding.FieldEmbedding:
+		m.ResetEmbedding()
 		return nil
-	case project.FieldOrderHistory:
-		m.ResetOrderHistory()
+	case transcriptembedding.FieldCreatedAt:
+		m.ResetCreatedAt()
 		return nil
-	case project.FieldOrderHistoryIndex:
-		m.ResetOrderHistoryIndex()
+	}
+	return fmt.Errorf("unknown TranscriptEmbedding field %s", name)
+}
+
+// AddedEdges returns all edge names that were set/added in this mutation.
+func (m *TranscriptEmbeddingMutation) AddedEdges() []string {
+	edges := make([]string, 0, 1)
+	if m.video_clip != nil {
+		edges = append(edges, transcriptembedding.EdgeVideoClip)
+	}
+	return edges
+}
+
+// AddedIDs returns all IDs (to other nodes) that were added for the given edge
+// name in this mutation.
+func (m *TranscriptEmbeddingMutation) AddedIDs(name string) []ent.Value {
+	switch name {
+	case transcriptembedding.EdgeVideoClip:
+		if id := m.video_clip; id != nil {
+			return []ent.Value{*id}
+		}
+	}
+	return nil
+}
+
+// RemovedEdges returns all edge names that were removed in this mutation.
+func (m *TranscriptEmbeddingMutation) RemovedEdges() []string {
+	edges := make([]string, 0, 1)
+	return edges
+}
+
+// RemovedIDs returns all IDs (to other nodes) that were removed for the edge with
+// the given name in this mutation.
+func (m *TranscriptEmbeddingMutation) RemovedIDs(name string) []ent.Value {
+	return nil
+}
+
+// ClearedEdges returns all edge names that were cleared in this mutation.
+func (m *TranscriptEmbeddingMutation) ClearedEdges() []string {
+	edges := make([]string, 0, 1)
+	if m.clearedvideo_clip {
+		edges = append(edges, transcriptembedding.EdgeVideoClip)
+	}
+	return edges
+}
+
+// EdgeCleared returns a boolean which indicates if the edge with the given name
+// was cleared in this mutation.
+func (m *TranscriptEmbeddingMutation) EdgeCleared(name string) bool {
+	switch name {
+	case transcriptembedding.EdgeVideoClip:
+		return m.clearedvideo_clip
+	}
+	return false
+}
+
+// ClearEdge clears the value of the edge with the given name. It returns an error
+// if that edge is not defined in the schema.
+func (m *TranscriptEmbeddingMutation) ClearEdge(name string) error {
+	switch name {
+	case transcriptembedding.EdgeVideoClip:
+		m.ClearVideoClip()
 		return nil
-	case project.FieldHiddenHighlights:
-		m.ResetHiddenHighlights()
+	}
+	return fmt.Errorf("unknown TranscriptEmbedding unique edge %s", name)
+}
+
+// ResetEdge resets all changes to the edge with the given name in this mutation.
+// It returns an error if the edge is not defined in the schema.
+func (m *TranscriptEmbeddingMutation) ResetEdge(name string) error {
+	switch name {
+	case transcriptembedding.EdgeVideoClip:
+		m.ResetVideoClip()
 		return nil
 	}
-	return fmt.Errorf("unknown Project field %s", name)
+	return fmt.Errorf("unknown TranscriptEmbedding edge %s", name)
+}
+
+// UsageRecordMutation represents an operation that mutates the UsageRecord nodes in the graph.
+type UsageRecordMutation struct {
+	config
+	op                   Op
+	typ                  string
+	id                   *int
+	project_id           *int
+	addproject_id        *int
+	provider             *string
+	task_type            *string
+	model                *string
+	prompt_tokens        *int
+	addprompt_tokens     *int
+	completion_tokens    *int
+	addcompletion_tokens *int
+	total_tokens         *int
+	addtotal_tokens      *int
+	audio_seconds        *float64
+	addaudio_seconds     *float64
+	cost_usd             *float64
+	addcost_usd          *float64
+	cost_estimated       *bool
+	created_at           *time.Time
+	clearedFields        map[string]struct{}
+	done                 bool
+	oldValue             func(context.Context) (*UsageRecord, error)
+	predicates           []predicate.UsageRecord
+}
+
+var _ ent.Mutation = (*UsageRecordMutation)(nil)
+
+// usagerecordOption allows management of the mutation configuration using functional options.
+type usagerecordOption func(*UsageRecordMutation)
+
+// newUsageRecordMutation creates new mutation for the UsageRecord entity.
+func newUsageRecordMutation(c config, op Op, opts ...usagerecordOption) *UsageRecordMutation {
+	m := &UsageRecordMutation{
+		config:        c,
+		op:            op,
+		typ:           TypeUsageRecord,
+		clearedFields: make(map[string]struct{}),
+	}
+	for _, opt := range opts {
+		opt(m)
+	}
+	return m
+}
+
+// withUsageRecordID sets the ID field of the mutation.
+func withUsageRecordID(id int) usagerecordOption {
+	return func(m *UsageRecordMutation) {
+		var (
+			err   error
+			once  sync.Once
+			value *UsageRecord
+		)
+		m.oldValue = func(ctx context.Context) (*UsageRecord, error) {
+			once.Do(func() {
+				if m.done {
+					err = errors.New("querying old values post mutation is not allowed")
+				} else {
+					value, err = m.Client().UsageRecord.Get(ctx, id)
+				}
+			})
+			return value, err
+		}
+		m.id = &id
+	}
+}
+
+// withUsageRecord sets the old UsageRecord of the mutation.
+func withUsageRecord(node *UsageRecord) usagerecordOption {
+	return func(m *UsageRecordMutation) {
+		m.oldValue = func(context.Context) (*UsageRecord, error) {
+			return node, nil
+		}
+		m.id = &node.ID
+	}
+}
+
+// Client returns a new `ent.Client` from the mutation. If the mutation was
+// executed in a transaction (ent.Tx), a transactional client is returned.
+func (m UsageRecordMutation) Client() *Client {
+	client := &Client{config: m.config}
+	client.init()
+	return client
+}
+
+// Tx returns an `ent.Tx` for mutations that were executed in transactions;
+// it returns an error otherwise.
+func (m UsageRecordMutation) Tx() (*Tx, error) {
+	if _, ok := m.driver.(*txDriver); !ok {
+		return nil, errors.New("ent: mutation is not running in a transaction")
+	}
+	tx := &Tx{config: m.config}
+	tx.init()
+	return tx, nil
+}
+
+// ID returns the ID value in the mutation. Note that the ID is only available
+// if it was provided to the builder or after it was returned from the database.
+func (m *UsageRecordMutation) ID() (id int, exists bool) {
+	if m.id == nil {
+		return
+	}
+	return *m.id, true
+}
+
+// IDs queries the database and returns the entity ids that match the mutation's predicate.
+// That means, if the mutation is applied within a transaction with an isolation level such
+// as sql.LevelSerializable, the returned ids match the ids of the rows that will be updated
+// or updated by the mutation.
+func (m *UsageRecordMutation) IDs(ctx context.Context) ([]int, error) {
+	switch {
+	case m.op.Is(OpUpdateOne | OpDeleteOne):
+		id, exists := m.ID()
+		if exists {
+			return []int{id}, nil
+		}
+		fallthrough
+	case m.op.Is(OpUpdate | OpDelete):
+		return m.Client().UsageRecord.Query().Where(m.predicates...).IDs(ctx)
+	default:
+		return nil, fmt.Errorf("IDs is not allowed on %s operations", m.op)
+	}
+}
+
+// SetProjectID sets the "project_id" field.
+func (m *UsageRecordMutation) SetProjectID(i int) {
+	m.project_id = &i
+	m.addproject_id = nil
+}
+
+// ProjectID returns the value of the "project_id" field in the mutation.
+func (m *UsageRecordMutation) ProjectID() (r int, exists bool) {
+	v := m.project_id
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldProjectID returns the old "project_id" field's value of the UsageRecord entity.
+// If the UsageRecord object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *UsageRecordMutation) OldProjectID(ctx context.Context) (v int, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldProjectID is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldProjectID requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldProjectID: %w", err)
+	}
+	return oldValue.ProjectID, nil
+}
+
+// AddProjectID adds i to the "project_id" field.
+func (m *UsageRecordMutation) AddProjectID(i int) {
+	if m.addproject_id != nil {
+		*m.addproject_id += i
+	} else {
+		m.addproject_id = &i
+	}
+}
+
+// AddedProjectID returns the value that was added to the "project_id" field in this mutation.
+func (m *UsageRecordMutation) AddedProjectID() (r int, exists bool) {
+	v := m.addproject_id
+	if v == nil {
+		return
+	}
+	return *v, true
 }
 
-// AddedEdges returns all edge names that were set/added in this mutation.
-func (m *ProjectMutation) AddedEdges() []string {
-	edges := make([]string, 0, 3)
-	if m.video_clips != nil {
-		edges = append(edges, project.EdgeVideoClips)
+// ClearProjectID clears the value of the "project_id" field.
+func (m *UsageRecordMutation) ClearProjectID() {
+	m.project_id = nil
+	m.addproject_id = nil
+	m.clearedFields[usagerecord.FieldProjectID] = struct{}{}
+}
+
+// ProjectIDCleared returns if the "project_id" field was cleared in this mutation.
+func (m *UsageRecordMutation) ProjectIDCleared() bool {
+	_, ok := m.clearedFields[usagerecord.FieldProjectID]
+	return ok
+}
+
+// ResetProjectID resets all changes to the "project_id" field.
+func (m *UsageRecordMutation) ResetProjectID() {
+	m.project_id = nil
+	m.addproject_id = nil
+	delete(m.clearedFields, usagerecord.FieldProjectID)
+}
+
+// SetProvider sets the "provider" field.
+func (m *UsageRecordMutation) SetProvider(s string) {
+	m.provider = &s
+}
+
+// Provider returns the value of the "provider" field in the mutation.
+func (m *UsageRecordMutation) Provider() (r string, exists bool) {
+	v := m.provider
+	if v == nil {
+		return
 	}
-	if m.export_jobs != nil {
-		edges = append(edges, project.EdgeExportJobs)
+	return *v, true
+}
+
+// OldProvider returns the old "provider" field's value of the UsageRecord entity.
+// If the UsageRecord object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *UsageRecordMutation) OldProvider(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldProvider is only allowed on UpdateOne operations")
 	}
-	if m.chat_sessions != nil {
-		edges = append(edges, project.EdgeChatSessions)
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldProvider requires an ID field in the mutation")
 	}
-	return edges
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldProvider: %w", err)
+	}
+	return oldValue.Provider, nil
 }
 
-// AddedIDs returns all IDs (to other nodes) that were added for the given edge
-// name in this mutation.
-func (m *ProjectMutation) AddedIDs(name string) []ent.Value {
-	switch name {
-	case project.EdgeVideoClips:
-		ids := make([]ent.Value, 0, len(m.video_clips))
-		for id := range m.video_clips {
-			ids = append(ids, id)
-		}
-		return ids
-	case project.EdgeExportJobs:
-		ids := make([]ent.Value, 0, len(m.export_jobs))
-		for id := range m.export_jobs {
-			ids = append(ids, id)
-		}
-		return ids
-	case project.EdgeChatSessions:
-		ids := make([]ent.Value, 0, len(m.chat_sessions))
-		for id := range m.chat_sessions {
-			ids = append(ids, id)
-		}
-		return ids
+// ResetProvider resets all changes to the "provider" field.
+func (m *UsageRecordMutation) ResetProvider() {
+	m.provider = nil
+}
+
+// SetTaskType sets the "task_type" field.
+func (m *UsageRecordMutation) SetTaskType(s string) {
+	m.task_type = &s
+}
+
+// TaskType returns the value of the "task_type" field in the mutation.
+func (m *UsageRecordMutation) TaskType() (r string, exists bool) {
+	v := m.task_type
+	if v == nil {
+		return
 	}
-	return nil
+	return *v, true
 }
 
-// RemovedEdges returns all edge names that were removed in this mutation.
-func (m *ProjectMutation) RemovedEdges() []string {
-	edges := make([]string, 0, 3)
-	if m.removedvideo_clips != nil {
-		edges = append(edges, project.EdgeVideoClips)
+// OldTaskType returns the old "task_type" field's value of the UsageRecord entity.
+// If the UsageRecord object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *UsageRecordMutation) OldTaskType(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldTaskType is only allowed on UpdateOne operations")
 	}
-	if m.removedexport_jobs != nil {
-		edges = append(edges, project.EdgeExportJobs)
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldTaskType requires an ID field in the mutation")
 	}
-	if m.removedchat_sessions != nil {
-		edges = append(edges, project.EdgeChatSessions)
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldTaskType: %w", err)
 	}
-	return edges
+	return oldValue.TaskType, nil
 }
 
-// RemovedIDs returns all IDs (to other nodes) that were removed for the edge with
-// the given name in this mutation.
-func (m *ProjectMutation) RemovedIDs(name string) []ent.Value {
-	switch name {
-	case project.EdgeVideoClips:
-		ids := make([]ent.Value, 0, len(m.removedvideo_clips))
-		for id := range m.removedvideo_clips {
-			ids = append(ids, id)
-		}
-		return ids
-	case project.EdgeExportJobs:
-		ids := make([]ent.Value, 0, len(m.removedexport_jobs))
-		for id := range m.removedexport_jobs {
-			ids = append(ids, id)
-		}
-		return ids
-	case project.EdgeChatSessions:
-		ids := make([]ent.Value, 0, len(m.removedchat_sessions))
-		for id := range m.removedchat_sessions {
-			ids = append(ids, id)
-		}
-		return ids
+// ResetTaskType resets all changes to the "task_type" field.
+func (m *UsageRecordMutation) ResetTaskType() {
+	m.task_type = nil
+}
+
+// SetModel sets the "model" field.
+func (m *UsageRecordMutation) SetModel(s string) {
+	m.model = &s
+}
+
+// Model returns the value of the "model" field in the mutation.
+func (m *UsageRecordMutation) Model() (r string, exists bool) {
+	v := m.model
+	if v == nil {
+		return
 	}
-	return nil
+	return *v, true
 }
 
-// ClearedEdges returns all edge names that were cleared in this mutation.
-func (m *ProjectMutation) ClearedEdges() []string {
-	edges := make([]string, 0, 3)
-	if m.clearedvideo_clips {
-		edges = append(edges, project.EdgeVideoClips)
+// OldModel returns the old "model" field's value of the UsageRecord entity.
+// If the UsageRecord object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *UsageRecordMutation) OldModel(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldModel is only allowed on UpdateOne operations")
 	}
-	if m.clearedexport_jobs {
-		edges = append(edges, project.EdgeExportJobs)
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldModel requires an ID field in the mutation")
 	}
-	if m.clearedchat_sessions {
-		edges = append(edges, project.EdgeChatSessions)
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldModel: %w", err)
 	}
-	return edges
+	return oldValue.Model, nil
 }
 
-// EdgeCleared returns a boolean which indicates if the edge with the given name
-// was cleared in this mutation.
-func (m *ProjectMutation) EdgeCleared(name string) bool {
-	switch name {
-	case project.EdgeVideoClips:
-		return m.clearedvideo_clips
-	case project.EdgeExportJobs:
-		return m.clearedexport_jobs
-	case project.EdgeChatSessions:
-		return m.clearedchat_sessions
+// ClearModel clears the value of the "model" field.
+func (m *UsageRecordMutation) ClearModel() {
+	m.model = nil
+	m.clearedFields[usagerecord.FieldModel] = struct{}{}
+}
+
+// ModelCleared returns if the "model" field was cleared in this mutation.
+func (m *UsageRecordMutation) ModelCleared() bool {
+	_, ok := m.clearedFields[usagerecord.FieldModel]
+	return ok
+}
+
+// ResetModel resets all changes to the "model" field.
+func (m *UsageRecordMutation) ResetModel() {
+	m.model = nil
+	delete(m.clearedFields, usagerecord.FieldModel)
+}
+
+// SetPromptTokens sets the "prompt_tokens" field.
+func (m *UsageRecordMutation) SetPromptTokens(i int) {
+	m.prompt_tokens = &i
+	m.addprompt_tokens = nil
+}
+
+// PromptTokens returns the value of the "prompt_tokens" field in the mutation.
+func (m *UsageRecordMutation) PromptTokens() (r int, exists bool) {
+	v := m.prompt_tokens
+	if v == nil {
+		return
 	}
-	return false
+	return *v, true
 }
 
-// ClearEdge clears the value of the edge with the given name. It returns an error
-// if that edge is not defined in the schema.
-func (m *ProjectMutation) ClearEdge(name string) error {
-	switch name {
+// OldPromptTokens returns the old "prompt_tokens" field's value of the UsageRecord entity.
+// If the UsageRecord object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *UsageRecordMutation) OldPromptTokens(ctx context.Context) (v int, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldPromptTokens is only allowed on UpdateOne operations")
 	}
-	return fmt.Errorf("unknown Project unique edge %s", name)
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldPromptTokens requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldPromptTokens: %w", err)
+	}
+	return oldValue.PromptTokens, nil
 }
 
-// ResetEdge resets all changes to the edge with the given name in this mutation.
-// It returns an error if the edge is not defined in the schema.
-func (m *ProjectMutation) ResetEdge(name string) error {
-	switch name {
-	case project.EdgeVideoClips:
-		m.ResetVideoClips()
-		return nil
-	case project.EdgeExportJobs:
-		m.ResetExportJobs()
-		return nil
-	case project.EdgeChatSessions:
-		m.ResetChatSessions()
-		return nil
+// AddPromptTokens adds i to the "prompt_tokens" field.
+func (m *UsageRecordMutation) AddPromptTokens(i int) {
+	if m.addprompt_tokens != nil {
+		*m.addprompt_tokens += i
+	} else {
+		m.addprompt_tokens = &i
 	}
-	return fmt.Errorf("unknown Project edge %s", name)
 }
 
-// SettingsMutation represents an operation that mutates the Settings nodes in the graph.
-type SettingsMutation struct {
-	config
-	op            Op
-	typ           string
-	id            *int
-	key           *string
-	value         *string
-	created_at    *time.Time
-	updated_at    *time.Time
-	clearedFields map[string]struct{}
-	done          bool
-	oldValue      func(context.Context) (*Settings, error)
-	predicates    []predicate.Settings
+// AddedPromptTokens returns the value that was added to the "prompt_tokens" field in this mutation.
+func (m *UsageRecordMutation) AddedPromptTokens() (r int, exists bool) {
+	v := m.addprompt_tokens
+	if v == nil {
+		return
+	}
+	return *v, true
 }
 
-var _ ent.Mutation = (*SettingsMutation)(nil)
+// ResetPromptTokens resets all changes to the "prompt_tokens" field.
+func (m *UsageRecordMutation) ResetPromptTokens() {
+	m.prompt_tokens = nil
+	m.addprompt_tokens = nil
+}
 
-// settingsOption allows management of the mutation configuration using functional options.
-type settingsOption func(*SettingsMutation)
+// SetCompletionTokens sets the "completion_tokens" field.
+func (m *UsageRecordMutation) SetCompletionTokens(i int) {
+	m.completion_tokens = &i
+	m.addcompletion_tokens = nil
+}
 
-// newSettingsMutation creates new mutation for the Settings entity.
-func newSettingsMutation(c config, op Op, opts ...settingsOption) *SettingsMutation {
-	m := &SettingsMutation{
-		config:        c,
-		op:            op,
-		typ:           TypeSettings,
-		clearedFields: make(map[string]struct{}),
+// CompletionTokens returns the value of the "completion_tokens" field in the mutation.
+func (m *UsageRecordMutation) CompletionTokens() (r int, exists bool) {
+	v := m.completion_tokens
+	if v == nil {
+		return
 	}
-	for _, opt := range opts {
-		opt(m)
+	return *v, true
+}
+
+// OldCompletionTokens returns the old "completion_tokens" field's value of the UsageRecord entity.
+// If the UsageRecord object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *UsageRecordMutation) OldCompletionTokens(ctx context.Context) (v int, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldCompletionTokens is only allowed on UpdateOne operations")
 	}
-	return m
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldCompletionTokens requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldCompletionTokens: %w", err)
+	}
+	return oldValue.CompletionTokens, nil
 }
 
-// withSettingsID sets the ID field of the mutation.
-func withSettingsID(id int) settingsOption {
-	return func(m *SettingsMutation) {
-		var (
-			err   error
-			once  sync.Once
-			value *Settings
-		)
-		m.oldValue = func(ctx context.Context) (*Settings, error) {
-			once.Do(func() {
-				if m.done {
-					err = errors.New("querying old values post mutation is not allowed")
-				} else {
-					value, err = m.Client().Settings.Get(ctx, id)
-				}
-			})
-			return value, err
-		}
-		m.id = &id
+// AddCompletionTokens adds i to the "completion_tokens" field.
+func (m *UsageRecordMutation) AddCompletionTokens(i int) {
+	if m.addcompletion_tokens != nil {
+		*m.addcompletion_tokens += i
+	} else {
+		m.addcompletion_tokens = &i
+	}
+}
+
+// AddedCompletionTokens returns the value that was added to the "completion_tokens" field in this mutation.
+func (m *UsageRecordMutation) AddedCompletionTokens() (r int, exists bool) {
+	v := m.addcompletion_tokens
+	if v == nil {
+		return
 	}
+	return *v, true
 }
 
-// withSettings sets the old Settings of the mutation.
-func withSettings(node *Settings) settingsOption {
-	return func(m *SettingsMutation) {
-		m.oldValue = func(context.Context) (*Settings, error) {
-			return node, nil
-		}
-		m.id = &node.ID
+// ResetCompletionTokens resets all changes to the "completion_tokens" field.
+func (m *UsageRecordMutation) ResetCompletionTokens() {
+	m.completion_tokens = nil
+	m.addcompletion_tokens = nil
+}
+
+// SetTotalTokens sets the "total_tokens" field.
+func (m *UsageRecordMutation) SetTotalTokens(i int) {
+	m.total_tokens = &i
+	m.addtotal_tokens = nil
+}
+
+// TotalTokens returns the value of the "total_tokens" field in the mutation.
+func (m *UsageRecordMutation) TotalTokens() (r int, exists bool) {
+	v := m.total_tokens
+	if v == nil {
+		return
 	}
+	return *v, true
 }
 
-// Client returns a new `ent.Client` from the mutation. If the mutation was
-// executed in a transaction (ent.Tx), a transactional client is returned.
-func (m SettingsMutation) Client() *Client {
-	client := &Client{config: m.config}
-	client.init()
-	return client
+// OldTotalTokens returns the old "total_tokens" field's value of the UsageRecord entity.
+// If the UsageRecord object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *UsageRecordMutation) OldTotalTokens(ctx context.Context) (v int, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldTotalTokens is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldTotalTokens requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldTotalTokens: %w", err)
+	}
+	return oldValue.TotalTokens, nil
 }
 
-// Tx returns an `ent.Tx` for mutations that were executed in transactions;
-// it returns an error otherwise.
-func (m SettingsMutation) Tx() (*Tx, error) {
-	if _, ok := m.driver.(*txDriver); !ok {
-		return nil, errors.New("ent: mutation is not running in a transaction")
+// AddTotalTokens adds i to the "total_tokens" field.
+func (m *UsageRecordMutation) AddTotalTokens(i int) {
+	if m.addtotal_tokens != nil {
+		*m.addtotal_tokens += i
+	} else {
+		m.addtotal_tokens = &i
 	}
-	tx := &Tx{config: m.config}
-	tx.init()
-	return tx, nil
 }
 
-// ID returns the ID value in the mutation. Note that the ID is only available
-// if it was provided to the builder or after it was returned from the database.
-func (m *SettingsMutation) ID() (id int, exists bool) {
-	if m.id == nil {
+// AddedTotalTokens returns the value that was added to the "total_tokens" field in this mutation.
+func (m *UsageRecordMutation) AddedTotalTokens() (r int, exists bool) {
+	v := m.addtotal_tokens
+	if v == nil {
 		return
 	}
-	return *m.id, true
+	return *v, true
 }
 
-// IDs queries the database and returns the entity ids that match the mutation's predicate.
-// That means, if the mutation is applied within a transaction with an isolation level such
-// as sql.LevelSerializable, the returned ids match the ids of the rows that will be updated
-// or updated by the mutation.
-func (m *SettingsMutation) IDs(ctx context.Context) ([]int, error) {
-	switch {
-	case m.op.Is(OpUpdateOne | OpDeleteOne):
-		id, exists := m.ID()
-		if exists {
-			return []int{id}, nil
-		}
-		fallthrough
-	case m.op.Is(OpUpdate | OpDelete):
-		return m.Client().Settings.Query().Where(m.predicates...).IDs(ctx)
-	default:
-		return nil, fmt.Errorf("IDs is not allowed on %s operations", m.op)
-	}
+// ResetTotalTokens resets all changes to the "total_tokens" field.
+func (m *UsageRecordMutation) ResetTotalTokens() {
+	m.total_tokens = nil
+	m.addtotal_tokens = nil
 }
 
-// SetKey sets the "key" field.
-func (m *SettingsMutation) SetKey(s string) {
-	m.key = &s
+// SetAudioSeconds sets the "audio_seconds" field.
+func (m *UsageRecordMutation) SetAudioSeconds(f float64) {
+	m.audio_seconds = &f
+	m.addaudio_seconds = nil
 }
 
-// Key returns the value of the "key" field in the mutation.
-func (m *SettingsMutation) Key() (r string, exists bool) {
-	v := m.key
+// AudioSeconds returns the value of the "audio_seconds" field in the mutation.
+func (m *UsageRecordMutation) AudioSeconds() (r float64, exists bool) {
+	v := m.audio_seconds
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldKey returns the old "key" field's value of the Settings entity.
-// If the Settings object wasn't provided to the builder, the object is fetched from the database.
+// OldAudioSeconds returns the old "audio_seconds" field's value of the UsageRecord entity.
+// If the UsageRecord object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *SettingsMutation) OldKey(ctx context.Context) (v string, err error) {
+func (m *UsageRecordMutation) OldAudioSeconds(ctx context.Context) (v float64, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldKey is only allowed on UpdateOne operations")
+		return v, errors.New("OldAudioSeconds is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldKey requires an ID field in the mutation")
+		return v, errors.New("OldAudioSeconds requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldKey: %w", err)
+		return v, fmt.Errorf("querying old value for OldAudioSeconds: %w", err)
 	}
-	return oldValue.Key, nil
+	return oldValue.AudioSeconds, nil
 }
 
-// ResetKey resets all changes to the "key" field.
-func (m *SettingsMutation) ResetKey() {
-	m.key = nil
+// AddAudioSeconds adds f to the "audio_seconds" field.
+func (m *UsageRecordMutation) AddAudioSeconds(f float64) {
+	if m.addaudio_seconds != nil {
+		*m.addaudio_seconds += f
+	} else {
+		m.addaudio_seconds = &f
+	}
 }
 
-// SetValue sets the "value" field.
-func (m *SettingsMutation) SetValue(s string) {
-	m.value = &s
+// AddedAudioSeconds returns the value that was added to the "audio_seconds" field in this mutation.
+func (m *UsageRecordMutation) AddedAudioSeconds() (r float64, exists bool) {
+	v := m.addaudio_seconds
+	if v == nil {
+		return
+	}
+	return *v, true
 }
 
-// Value returns the value of the "value" field in the mutation.
-func (m *SettingsMutation) Value() (r string, exists bool) {
-	v := m.value
+// ResetAudioSeconds resets all changes to the "audio_seconds" field.
+func (m *UsageRecordMutation) ResetAudioSeconds() {
+	m.audio_seconds = nil
+	m.addaudio_seconds = nil
+}
+
+// SetCostUsd sets the "cost_usd" field.
+func (m *UsageRecordMutation) SetCostUsd(f float64) {
+	m.cost_usd = &f
+	m.addcost_usd = nil
+}
+
+// CostUsd returns the value of the "cost_usd" field in the mutation.
+func (m *UsageRecordMutation) CostUsd() (r float64, exists bool) {
+	v := m.cost_usd
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldValue returns the old "value" field's value of the Settings entity.
-// If the Settings object wasn't provided to the builder, the object is fetched from the database.
+// OldCostUsd returns the old "cost_usd" field's value of the UsageRecord entity.
+// If the UsageRecord object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *SettingsMutation) OldValue(ctx context.Context) (v string, err error) {
+func (m *UsageRecordMutation) OldCostUsd(ctx context.Context) (v float64, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldValue is only allowed on UpdateOne operations")
+		return v, errors.New("OldCostUsd is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldValue requires an ID field in the mutation")
+		return v, errors.New("OldCostUsd requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldValue: %w", err)
+		return v, fmt.Errorf("querying old value for OldCostUsd: %w", err)
 	}
-	return oldValue.Value, nil
+	return oldValue.CostUsd, nil
 }
 
-// ClearValue clears the value of the "value" field.
-func (m *SettingsMutation) ClearValue() {
-	m.value = nil
-	m.clearedFields[settings.FieldValue] = struct{}{}
+// AddCostUsd adds f to the "cost_usd" field.
+func (m *UsageRecordMutation) AddCostUsd(f float64) {
+	if m.addcost_usd != nil {
+		*m.addcost_usd += f
+	} else {
+		m.addcost_usd = &f
+	}
 }
 
-// ValueCleared returns if the "value" field was cleared in this mutation.
-func (m *SettingsMutation) ValueCleared() bool {
-	_, ok := m.clearedFields[settings.FieldValue]
-	return ok
+// AddedCostUsd returns the value that was added to the "cost_usd" field in this mutation.
+func (m *UsageRecordMutation) AddedCostUsd() (r float64, exists bool) {
+	v := m.addcost_usd
+	if v == nil {
+		return
+	}
+	return *v, true
 }
 
-// ResetValue resets all changes to the "value" field.
-func (m *SettingsMutation) ResetValue() {
-	m.value = nil
-	delete(m.clearedFields, settings.FieldValue)
+// ResetCostUsd resets all changes to the "cost_usd" field.
+func (m *UsageRecordMutation) ResetCostUsd() {
+	m.cost_usd = nil
+	m.addcost_usd = nil
 }
 
-// SetCreatedAt sets the "created_at" field.
-func (m *SettingsMutation) SetCreatedAt(t time.Time) {
-	m.created_at = &t
+// SetCostEstimated sets the "cost_estimated" field.
+func (m *UsageRecordMutation) SetCostEstimated(b bool) {
+	m.cost_estimated = &b
 }
 
-// CreatedAt returns the value of the "created_at" field in the mutation.
-func (m *SettingsMutation) CreatedAt() (r time.Time, exists bool) {
-	v := m.created_at
+// CostEstimated returns the value of the "cost_estimated" field in the mutation.
+func (m *UsageRecordMutation) CostEstimated() (r bool, exists bool) {
+	v := m.cost_estimated
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldCreatedAt returns the old "created_at" field's value of the Settings entity.
-// If the Settings object wasn't provided to the builder, the object is fetched from the database.
+// OldCostEstimated returns the old "cost_estimated" field's value of the UsageRecord entity.
+// If the UsageRecord object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *SettingsMutation) OldCreatedAt(ctx context.Context) (v time.Time, err error) {
+func (m *UsageRecordMutation) OldCostEstimated(ctx context.Context) (v bool, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldCreatedAt is only allowed on UpdateOne operations")
+		return v, errors.New("OldCostEstimated is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldCreatedAt requires an ID field in the mutation")
+		return v, errors.New("OldCostEstimated requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldCreatedAt: %w", err)
+		return v, fmt.Errorf("querying old value for OldCostEstimated: %w", err)
 	}
-	return oldValue.CreatedAt, nil
+	return oldValue.CostEstimated, nil
 }
 
-// ResetCreatedAt resets all changes to the "created_at" field.
-func (m *SettingsMutation) ResetCreatedAt() {
-	m.created_at = nil
+// ResetCostEstimated resets all changes to the "cost_estimated" field.
+func (m *UsageRecordMutation) ResetCostEstimated() {
+	m.cost_estimated = nil
 }
 
-// SetUpdatedAt sets the "updated_at" field.
-func (m *SettingsMutation) SetUpdatedAt(t time.Time) {
-	m.updated_at = &t
+// SetCreatedAt sets the "created_at" field.
+func (m *UsageRecordMutation) SetCreatedAt(t time.Time) {
+	m.created_at = &t
 }
 
-// UpdatedAt returns the value of the "updated_at" field in the mutation.
-func (m *SettingsMutation) UpdatedAt() (r time.Time, exists bool) {
-	v := m.updated_at
+// CreatedAt returns the value of the "created_at" field in the mutation.
+func (m *UsageRecordMutation) CreatedAt() (r time.Time, exists bool) {
+	v := m.created_at
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldUpdatedAt returns the old "updated_at" field's value of the Settings entity.
-// If the Settings object wasn't provided to the builder, the object is fetched from the database.
+// OldCreatedAt returns the old "created_at" field's value of the UsageRecord entity.
+// If the UsageRecord object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *SettingsMutation) OldUpdatedAt(ctx context.Context) (v time.Time, err error) {
+func (m *UsageRecordMutation) OldCreatedAt(ctx context.Context) (v time.Time, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldUpdatedAt is only allowed on UpdateOne operations")
+		return v, errors.New("OldCreatedAt is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldUpdatedAt requires an ID field in the mutation")
+		return v, errors.New("OldCreatedAt requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldUpdatedAt: %w", err)
+		return v, fmt.Errorf("querying old value for OldCreatedAt: %w", err)
 	}
-	return oldValue.UpdatedAt, nil
+	return oldValue.CreatedAt, nil
 }
 
-// ResetUpdatedAt resets all changes to the "updated_at" field.
-func (m *SettingsMutation) ResetUpdatedAt() {
-	m.updated_at = nil
+// ResetCreatedAt resets all changes to the "created_at" field.
+func (m *UsageRecordMutation) ResetCreatedAt() {
+	m.created_at = nil
 }
 
-// Where appends a list predicates to the SettingsMutation builder.
-func (m *SettingsMutation) Where(ps ...predicate.Settings) {
+// Where appends a list predicates to the UsageRecordMutation builder.
+func (m *UsageRecordMutation) Where(ps ...predicate.UsageRecord) {
 	m.predicates = append(m.predicates, ps...)
 }
 
-// WhereP appends storage-level predicates to the SettingsMutation builder. Using this method,
+// WhereP appends storage-level predicates to the UsageRecordMutation builder. Using this method,
 // users can use type-assertion to append predicates that do not depend on any generated package.
-func (m *SettingsMutation) WhereP(ps ...func(*sql.Selector)) {
-	p := make([]predicate.Settings, len(ps))
+func (m *UsageRecordMutation) WhereP(ps ...func(*sql.Selector)) {
+	p := make([]predicate.UsageRecord, len(ps))
 	for i := range ps {
 		p[i] = ps[i]
 	}
@@ -5184,36 +13076,57 @@ func (m *SettingsMutation) WhereP(ps ...func(*sql.Selector)) {
 }
 
 // Op returns the operation name.
-func (m *SettingsMutation) Op() Op {
+func (m *UsageRecordMutation) Op() Op {
 	return m.op
 }
 
 // SetOp allows setting the mutation operation.
-func (m *SettingsMutation) SetOp(op Op) {
+func (m *UsageRecordMutation) SetOp(op Op) {
 	m.op = op
 }
 
-// Type returns the node type of this mutation (Settings).
-func (m *SettingsMutation) Type() string {
+// Type returns the node type of this mutation (UsageRecord).
+func (m *UsageRecordMutation) Type() string {
 	return m.typ
 }
 
 // Fields returns all fields that were changed during this mutation. Note that in
 // order to get all numeric fields that were incremented/decremented, call
 // AddedFields().
-func (m *SettingsMutation) Fields() []string {
-	fields := make([]string, 0, 4)
-	if m.key != nil {
-		fields = append(fields, settings.FieldKey)
+func (m *UsageRecordMutation) Fields() []string {
+	fields := make([]string, 0, 11)
+	if m.project_id != nil {
+		fields = append(fields, usagerecord.FieldProjectID)
 	}
-	if m.value != nil {
-		fields = append(fields, settings.FieldValue)
+	if m.provider != nil {
+		fields = append(fields, usagerecord.FieldProvider)
 	}
-	if m.created_at != nil {
-		fields = append(fields, settings.FieldCreatedAt)
+	if m.task_type != nil {
+		fields = append(fields, usagerecord.FieldTaskType)
 	}
-	if m.updated_at != nil {
-		fields = append(fields, settings.FieldUpdatedAt)
+	if m.model != nil {
+		fields = append(fields, usagerecord.FieldModel)
+	}
+	if m.prompt_tokens != nil {
+		fields = append(fields, usagerecord.FieldPromptTokens)
+	}
+	if m.completion_tokens != nil {
+		fields = append(fields, usagerecord.FieldCompletionTokens)
+	}
+	if m.total_tokens != nil {
+		fields = append(fields, usagerecord.FieldTotalTokens)
+	}
+	if m.audio_seconds != nil {
+		fields = append(fields, usagerecord.FieldAudioSeconds)
+	}
+	if m.cost_usd != nil {
+		fields = append(fields, usagerecord.FieldCostUsd)
+	}
+	if m.cost_estimated != nil {
+		fields = append(fields, usagerecord.FieldCostEstimated)
+	}
+	if m.created_at != nil {
+		fields = append(fields, usagerecord.FieldCreatedAt)
 	}
 	return fields
 }
@@ -5221,16 +13134,30 @@ func (m *SettingsMutation) Fields() []string {
 // Field returns the value of a field with the given name. The second boolean
 // return value indicates that this field was not set, or was not defined in the
 // schema.
-func (m *SettingsMutation) Field(name string) (ent.Value, bool) {
+func (m *UsageRecordMutation) Field(name string) (ent.Value, bool) {
 	switch name {
-	case settings.FieldKey:
-		return m.Key()
-	case settings.FieldValue:
-		return m.Value()
-	case settings.FieldCreatedAt:
+	case usagerecord.FieldProjectID:
+		return m.ProjectID()
+	case usagerecord.FieldProvider:
+		return m.Provider()
+	case usagerecord.FieldTaskType:
+		return m.TaskType()
+	case usagerecord.FieldModel:
+		return m.Model()
+	case usagerecord.FieldPromptTokens:
+		return m.PromptTokens()
+	case usagerecord.FieldCompletionTokens:
+		return m.CompletionTokens()
+	case usagerecord.FieldTotalTokens:
+		return m.TotalTokens()
+	case usagerecord.FieldAudioSeconds:
+		return m.AudioSeconds()
+	case usagerecord.FieldCostUsd:
+		return m.CostUsd()
+	case usagerecord.FieldCostEstimated:
+		return m.CostEstimated()
+	case usagerecord.FieldCreatedAt:
 		return m.CreatedAt()
-	case settings.FieldUpdatedAt:
-		return m.UpdatedAt()
 	}
 	return nil, false
 }
@@ -5238,219 +13165,393 @@ func (m *SettingsMutation) Field(name string) (ent.Value, bool) {
 // OldField returns the old value of the field from the database. An error is
 // returned if the mutation operation is not UpdateOne, or the query to the
 // database failed.
-func (m *SettingsMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
+func (m *UsageRecordMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
 	switch name {
-	case settings.FieldKey:
-		return m.OldKey(ctx)
-	case settings.FieldValue:
-		return m.OldValue(ctx)
-	case settings.FieldCreatedAt:
+	case usagerecord.FieldProjectID:
+		return m.OldProjectID(ctx)
+	case usagerecord.FieldProvider:
+		return m.OldProvider(ctx)
+	case usagerecord.FieldTaskType:
+		return m.OldTaskType(ctx)
+	case usagerecord.FieldModel:
+		return m.OldModel(ctx)
+	case usagerecord.FieldPromptTokens:
+		return m.OldPromptTokens(ctx)
+	case usagerecord.FieldCompletionTokens:
+		return m.OldCompletionTokens(ctx)
+	case usagerecord.FieldTotalTokens:
+		return m.OldTotalTokens(ctx)
+	case usagerecord.FieldAudioSeconds:
+		return m.OldAudioSeconds(ctx)
+	case usagerecord.FieldCostUsd:
+		return m.OldCostUsd(ctx)
+	case usagerecord.FieldCostEstimated:
+		return m.OldCostEstimated(ctx)
+	case usagerecord.FieldCreatedAt:
 		return m.OldCreatedAt(ctx)
-	case settings.FieldUpdatedAt:
-		return m.OldUpdatedAt(ctx)
 	}
-	return nil, fmt.Errorf("unknown Settings field %s", name)
+	return nil, fmt.Errorf("unknown UsageRecord field %s", name)
 }
 
 // SetField sets the value of a field with the given name. It returns an error if
 // the field is not defined in the schema, or if the type mismatched the field
 // type.
-func (m *SettingsMutation) SetField(name string, value ent.Value) error {
+func (m *UsageRecordMutation) SetField(name string, value ent.Value) error {
 	switch name {
-	case settings.FieldKey:
+	case usagerecord.FieldProjectID:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetProjectID(v)
+		return nil
+	case usagerecord.FieldProvider:
 		v, ok := value.(string)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetKey(v)
+		m.SetProvider(v)
 		return nil
-	case settings.FieldValue:
+	case usagerecord.FieldTaskType:
 		v, ok := value.(string)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetValue(v)
+		m.SetTaskType(v)
 		return nil
-	case settings.FieldCreatedAt:
-		v, ok := value.(time.Time)
+	case usagerecord.FieldModel:
+		v, ok := value.(string)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetCreatedAt(v)
+		m.SetModel(v)
+		return nil
+	case usagerecord.FieldPromptTokens:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetPromptTokens(v)
+		return nil
+	case usagerecord.FieldCompletionTokens:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetCompletionTokens(v)
+		return nil
+	case usagerecord.FieldTotalTokens:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetTotalTokens(v)
+		return nil
+	case usagerecord.FieldAudioSeconds:
+		v, ok := value.(float64)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetAudioSeconds(v)
+		return nil
+	case usagerecord.FieldCostUsd:
+		v, ok := value.(float64)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetCostUsd(v)
+		return nil
+	case usagerecord.FieldCostEstimated:
+		v, ok := value.(bool)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetCostEstimated(v)
 		return nil
-	case settings.FieldUpdatedAt:
+	case usagerecord.FieldCreatedAt:
 		v, ok := value.(time.Time)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetUpdatedAt(v)
+		m.SetCreatedAt(v)
 		return nil
 	}
-	return fmt.Errorf("unknown Settings field %s", name)
+	return fmt.Errorf("unknown UsageRecord field %s", name)
 }
 
 // AddedFields returns all numeric fields that were incremented/decremented during
 // this mutation.
-func (m *SettingsMutation) AddedFields() []string {
-	return nil
+func (m *UsageRecordMutation) AddedFields() []string {
+	var fields []string
+	if m.addproject_id != nil {
+		fields = append(fields, usagerecord.FieldProjectID)
+	}
+	if m.addprompt_tokens != nil {
+		fields = append(fields, usagerecord.FieldPromptTokens)
+	}
+	if m.addcompletion_tokens != nil {
+		fields = append(fields, usagerecord.FieldCompletionTokens)
+	}
+	if m.addtotal_tokens != nil {
+		fields = append(fields, usagerecord.FieldTotalTokens)
+	}
+	if m.addaudio_seconds != nil {
+		fields = append(fields, usagerecord.FieldAudioSeconds)
+	}
+	if m.addcost_usd != nil {
+		fields = append(fields, usagerecord.FieldCostUsd)
+	}
+	return fields
 }
 
 // AddedField returns the numeric value that was incremented/decremented on a field
 // with the given name. The second boolean return value indicates that this field
 // was not set, or was not defined in the schema.
-func (m *SettingsMutation) AddedField(name string) (ent.Value, bool) {
+func (m *UsageRecordMutation) AddedField(name string) (ent.Value, bool) {
+	switch name {
+	case usagerecord.FieldProjectID:
+		return m.AddedProjectID()
+	case usagerecord.FieldPromptTokens:
+		return m.AddedPromptTokens()
+	case usagerecord.FieldCompletionTokens:
+		return m.AddedCompletionTokens()
+	case usagerecord.FieldTotalTokens:
+		return m.AddedTotalTokens()
+	case usagerecord.FieldAudioSeconds:
+		return m.AddedAudioSeconds()
+	case usagerecord.FieldCostUsd:
+		return m.AddedCostUsd()
+	}
 	return nil, false
 }
 
 // AddField adds the value to the field with the given name. It returns an error if
 // the field is not defined in the schema, or if the type mismatched the field
 // type.
-func (m *SettingsMutation) AddField(name string, value ent.Value) error {
+func (m *UsageRecordMutation) AddField(name string, value ent.Value) error {
 	switch name {
+	case usagerecord.FieldProjectID:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddProjectID(v)
+		return nil
+	case usagerecord.FieldPromptTokens:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddPromptTokens(v)
+		return nil
+	case usagerecord.FieldCompletionTokens:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddCompletionTokens(v)
+		return nil
+	case usagerecord.FieldTotalTokens:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddTotalTokens(v)
+		return nil
+	case usagerecord.FieldAudioSeconds:
+		v, ok := value.(float64)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddAudioSeconds(v)
+		return nil
+	case usagerecord.FieldCostUsd:
+		v, ok := value.(float64)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddCostUsd(v)
+		return nil
 	}
-	return fmt.Errorf("unknown Settings numeric field %s", name)
+	return fmt.Errorf("unknown UsageRecord numeric field %s", name)
 }
 
 // ClearedFields returns all nullable fields that were cleared during this
 // mutation.
-func (m *SettingsMutation) ClearedFields() []string {
+func (m *UsageRecordMutation) ClearedFields() []string {
 	var fields []string
-	if m.FieldCleared(settings.FieldValue) {
-		fields = append(fields, settings.FieldValue)
+	if m.FieldCleared(usagerecord.FieldProjectID) {
+		fields = append(fields, usagerecord.FieldProjectID)
+	}
+	if m.FieldCleared(usagerecord.FieldModel) {
+		fields = append(fields, usagerecord.FieldModel)
 	}
 	return fields
 }
 
 // FieldCleared returns a boolean indicating if a field with the given name was
 // cleared in this mutation.
-func (m *SettingsMutation) FieldCleared(name string) bool {
+func (m *UsageRecordMutation) FieldCleared(name string) bool {
 	_, ok := m.clearedFields[name]
 	return ok
 }
 
 // ClearField clears the value of the field with the given name. It returns an
 // error if the field is not defined in the schema.
-func (m *SettingsMutation) ClearField(name string) error {
+func (m *UsageRecordMutation) ClearField(name string) error {
 	switch name {
-	case settings.FieldValue:
-		m.ClearValue()
+	case usagerecord.FieldProjectID:
+		m.ClearProjectID()
+		return nil
+	case usagerecord.FieldModel:
+		m.ClearModel()
 		return nil
 	}
-	return fmt.Errorf("unknown Settings nullable field %s", name)
+	return fmt.Errorf("unknown UsageRecord nullable field %s", name)
 }
 
 // ResetField resets all changes in the mutation for the field with the given name.
 // It returns an error if the field is not defined in the schema.
-func (m *SettingsMutation) ResetField(name string) error {
+func (m *UsageRecordMutation) ResetField(name string) error {
 	switch name {
-	case settings.FieldKey:
-		m.ResetKey()
+	case usagerecord.FieldProjectID:
+		m.ResetProjectID()
 		return nil
-	case settings.FieldValue:
-		m.ResetValue()
+	case usagerecord.FieldProvider:
+		m.ResetProvider()
 		return nil
-	case settings.FieldCreatedAt:
-		m.ResetCreatedAt()
+	case usagerecord.FieldTaskType:
+		m.ResetTaskType()
 		return nil
-	case settings.FieldUpdatedAt:
-		m.ResetUpdatedAt()
+	case usagerecord.FieldModel:
+		m.ResetModel()
+		return nil
+	case usagerecord.FieldPromptTokens:
+		m.ResetPromptTokens()
+		return nil
+	case usagerecord.FieldCompletionTokens:
+		m.ResetCompletionTokens()
+		return nil
+	case usagerecord.FieldTotalTokens:
+		m.ResetTotalTokens()
+		return nil
+	case usagerecord.FieldAudioSeconds:
+		m.ResetAudioSeconds()
+		return nil
+	case usagerecord.FieldCostUsd:
+		m.ResetCostUsd()
+		return nil
+	case usagerecord.FieldCostEstimated:
+		m.ResetCostEstimated()
+		return nil
+	case usagerecord.FieldCreatedAt:
+		m.ResetCreatedAt()
 		return nil
 	}
-	return fmt.Errorf("unknown Settings field %s", name)
+	return fmt.Errorf("unknown UsageRecord field %s", name)
 }
 
 // AddedEdges returns all edge names that were set/added in this mutation.
-func (m *SettingsMutation) AddedEdges() []string {
+func (m *UsageRecordMutation) AddedEdges() []string {
 	edges := make([]string, 0, 0)
 	return edges
 }
 
 // AddedIDs returns all IDs (to other nodes) that were added for the given edge
 // name in this mutation.
-func (m *SettingsMutation) AddedIDs(name string) []ent.Value {
+func (m *UsageRecordMutation) AddedIDs(name string) []ent.Value {
 	return nil
 }
 
 // RemovedEdges returns all edge names that were removed in this mutation.
-func (m *SettingsMutation) RemovedEdges() []string {
+func (m *UsageRecordMutation) RemovedEdges() []string {
 	edges := make([]string, 0, 0)
 	return edges
 }
 
 // RemovedIDs returns all IDs (to other nodes) that were removed for the edge with
 // the given name in this mutation.
-func (m *SettingsMutation) RemovedIDs(name string) []ent.Value {
+func (m *UsageRecordMutation) RemovedIDs(name string) []ent.Value {
 	return nil
 }
 
 // ClearedEdges returns all edge names that were cleared in this mutation.
-func (m *SettingsMutation) ClearedEdges() []string {
+func (m *UsageRecordMutation) ClearedEdges() []string {
 	edges := make([]string, 0, 0)
 	return edges
 }
 
 // EdgeCleared returns a boolean which indicates if the edge with the given name
 // was cleared in this mutation.
-func (m *SettingsMutation) EdgeCleared(name string) bool {
+func (m *UsageRecordMutation) EdgeCleared(name string) bool {
 	return false
 }
 
 // ClearEdge clears the value of the edge with the given name. It returns an error
 // if that edge is not defined in the schema.
-func (m *SettingsMutation) ClearEdge(name string) error {
-	return fmt.Errorf("unknown Settings unique edge %s", name)
+func (m *UsageRecordMutation) ClearEdge(name string) error {
+	return fmt.Errorf("unknown UsageRecord unique edge %s", name)
 }
 
 // ResetEdge resets all changes to the edge with the given name in this mutation.
 // It returns an error if the edge is not defined in the schema.
-func (m *SettingsMutation) ResetEdge(name string) error {
-	return fmt.Errorf("unknown Settings edge %s", name)
+func (m *UsageRecordMutation) ResetEdge(name string) error {
+	return fmt.Errorf("unknown UsageRecord edge %s", name)
 }
 
 // VideoClipMutation represents an operation that mutates the VideoClip nodes in the graph.
 type VideoClipMutation struct {
 	config
-	op                          Op
-	typ                         string
-	id                          *int
-	name                        *string
-	description                 *string
-	file_path                   *string
-	duration                    *float64
-	addduration                 *float64
-	format                      *string
-	width                       *int
-	addwidth                    *int
-	height                      *int
-	addheight                   *int
-	file_size                   *int64
-	addfile_size                *int64
-	transcription               *string
-	transcription_words         *[]schema.Word
-	appendtranscription_words   []schema.Word
-	transcription_language      *string
-	transcription_duration      *float64
-	addtranscription_duration   *float64
-	highlights                  *[]schema.Highlight
-	appendhighlights            []schema.Highlight
-	suggested_highlights        *[]schema.Highlight
-	appendsuggested_highlights  []schema.Highlight
-	created_at                  *time.Time
-	updated_at                  *time.Time
-	highlights_history          *[][]schema.Highlight
-	appendhighlights_history    [][]schema.Highlight
-	highlights_history_index    *int
-	addhighlights_history_index *int
-	transcription_state         *string
-	transcription_error         *string
-	transcription_started_at    *time.Time
-	transcription_completed_at  *time.Time
-	clearedFields               map[string]struct{}
-	project                     *int
-	clearedproject              bool
-	done                        bool
-	oldValue                    func(context.Context) (*VideoClip, error)
-	predicates                  []predicate.VideoClip
+	op                                Op
+	typ                               string
+	id                                *int
+	name                              *string
+	description                       *string
+	file_path                         *string
+	duration                          *float64
+	addduration                       *float64
+	format                            *string
+	width                             *int
+	addwidth                          *int
+	height                            *int
+	addheight                         *int
+	file_size                         *int64
+	addfile_size                      *int64
+	transcription                     *string
+	transcription_words               *[]schema.Word
+	appendtranscription_words         []schema.Word
+	transcription_language            *string
+	transcription_duration            *float64
+	addtranscription_duration         *float64
+	highlights                        *[]schema.Highlight
+	appendhighlights                  []schema.Highlight
+	suggested_highlights              *[]schema.Highlight
+	appendsuggested_highlights        []schema.Highlight
+	suggested_highlights_prompt_id    *int
+	addsuggested_highlights_prompt_id *int
+	created_at                        *time.Time
+	updated_at                        *time.Time
+	highlights_history                *[][]schema.Highlight
+	appendhighlights_history          [][]schema.Highlight
+	highlights_history_index          *int
+	addhighlights_history_index       *int
+	transcription_state               *string
+	transcription_error               *string
+	transcription_started_at          *time.Time
+	transcription_completed_at        *time.Time
+	deleted_at                        *time.Time
+	clearedFields                     map[string]struct{}
+	project                           *int
+	clearedproject                    bool
+	transcript_embeddings             map[int]struct{}
+	removedtranscript_embeddings      map[int]struct{}
+	clearedtranscript_embeddings      bool
+	tags                              map[int]struct{}
+	removedtags                       map[int]struct{}
+	clearedtags                       bool
+	done                              bool
+	oldValue                          func(context.Context) (*VideoClip, error)
+	predicates                        []predicate.VideoClip
 }
 
 var _ ent.Mutation = (*VideoClipMutation)(nil)
@@ -6364,6 +14465,76 @@ func (m *VideoClipMutation) ResetSuggestedHighlights() {
 	delete(m.clearedFields, videoclip.FieldSuggestedHighlights)
 }
 
+// SetSuggestedHighlightsPromptID sets the "suggested_highlights_prompt_id" field.
+func (m *VideoClipMutation) SetSuggestedHighlightsPromptID(i int) {
+	m.suggested_highlights_prompt_id = &i
+	m.addsuggested_highlights_prompt_id = nil
+}
+
+// SuggestedHighlightsPromptID returns the value of the "suggested_highlights_prompt_id" field in the mutation.
+func (m *VideoClipMutation) SuggestedHighlightsPromptID() (r int, exists bool) {
+	v := m.suggested_highlights_prompt_id
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldSuggestedHighlightsPromptID returns the old "suggested_highlights_prompt_id" field's value of the VideoClip entity.
+// If the VideoClip object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *VideoClipMutation) OldSuggestedHighlightsPromptID(ctx context.Context) (v int, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldSuggestedHighlightsPromptID is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldSuggestedHighlightsPromptID requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldSuggestedHighlightsPromptID: %w", err)
+	}
+	return oldValue.SuggestedHighlightsPromptID, nil
+}
+
+// AddSuggestedHighlightsPromptID adds i to the "suggested_highlights_prompt_id" field.
+func (m *VideoClipMutation) AddSuggestedHighlightsPromptID(i int) {
+	if m.addsuggested_highlights_prompt_id != nil {
+		*m.addsuggested_highlights_prompt_id += i
+	} else {
+		m.addsuggested_highlights_prompt_id = &i
+	}
+}
+
+// AddedSuggestedHighlightsPromptID returns the value that was added to the "suggested_highlights_prompt_id" field in this mutation.
+func (m *VideoClipMutation) AddedSuggestedHighlightsPromptID() (r int, exists bool) {
+	v := m.addsuggested_highlights_prompt_id
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// ClearSuggestedHighlightsPromptID clears the value of the "suggested_highlights_prompt_id" field.
+func (m *VideoClipMutation) ClearSuggestedHighlightsPromptID() {
+	m.suggested_highlights_prompt_id = nil
+	m.addsuggested_highlights_prompt_id = nil
+	m.clearedFields[videoclip.FieldSuggestedHighlightsPromptID] = struct{}{}
+}
+
+// SuggestedHighlightsPromptIDCleared returns if the "suggested_highlights_prompt_id" field was cleared in this mutation.
+func (m *VideoClipMutation) SuggestedHighlightsPromptIDCleared() bool {
+	_, ok := m.clearedFields[videoclip.FieldSuggestedHighlightsPromptID]
+	return ok
+}
+
+// ResetSuggestedHighlightsPromptID resets all changes to the "suggested_highlights_prompt_id" field.
+func (m *VideoClipMutation) ResetSuggestedHighlightsPromptID() {
+	m.suggested_highlights_prompt_id = nil
+	m.addsuggested_highlights_prompt_id = nil
+	delete(m.clearedFields, videoclip.FieldSuggestedHighlightsPromptID)
+}
+
 // SetCreatedAt sets the "created_at" field.
 func (m *VideoClipMutation) SetCreatedAt(t time.Time) {
 	m.created_at = &t
@@ -6767,6 +14938,55 @@ func (m *VideoClipMutation) ResetTranscriptionCompletedAt() {
 	delete(m.clearedFields, videoclip.FieldTranscriptionCompletedAt)
 }
 
+// SetDeletedAt sets the "deleted_at" field.
+func (m *VideoClipMutation) SetDeletedAt(t time.Time) {
+	m.deleted_at = &t
+}
+
+// DeletedAt returns the value of the "deleted_at" field in the mutation.
+func (m *VideoClipMutation) DeletedAt() (r time.Time, exists bool) {
+	v := m.deleted_at
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldDeletedAt returns the old "deleted_at" field's value of the VideoClip entity.
+// If the VideoClip object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *VideoClipMutation) OldDeletedAt(ctx context.Context) (v time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldDeletedAt is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldDeletedAt requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldDeletedAt: %w", err)
+	}
+	return oldValue.DeletedAt, nil
+}
+
+// ClearDeletedAt clears the value of the "deleted_at" field.
+func (m *VideoClipMutation) ClearDeletedAt() {
+	m.deleted_at = nil
+	m.clearedFields[videoclip.FieldDeletedAt] = struct{}{}
+}
+
+// DeletedAtCleared returns if the "deleted_at" field was cleared in this mutation.
+func (m *VideoClipMutation) DeletedAtCleared() bool {
+	_, ok := m.clearedFields[videoclip.FieldDeletedAt]
+	return ok
+}
+
+// ResetDeletedAt resets all changes to the "deleted_at" field.
+func (m *VideoClipMutation) ResetDeletedAt() {
+	m.deleted_at = nil
+	delete(m.clearedFields, videoclip.FieldDeletedAt)
+}
+
 // SetProjectID sets the "project" edge to the Project entity by id.
 func (m *VideoClipMutation) SetProjectID(id int) {
 	m.project = &id
@@ -6806,6 +15026,114 @@ func (m *VideoClipMutation) ResetProject() {
 	m.clearedproject = false
 }
 
+// AddTranscriptEmbeddingIDs adds the "transcript_embeddings" edge to the TranscriptEmbedding entity by ids.
+func (m *VideoClipMutation) AddTranscriptEmbeddingIDs(ids ...int) {
+	if m.transcript_embeddings == nil {
+		m.transcript_embeddings = make(map[int]struct{})
+	}
+	for i := range ids {
+		m.transcript_embeddings[ids[i]] = struct{}{}
+	}
+}
+
+// ClearTranscriptEmbeddings clears the "transcript_embeddings" edge to the TranscriptEmbedding entity.
+func (m *VideoClipMutation) ClearTranscriptEmbeddings() {
+	m.clearedtranscript_embeddings = true
+}
+
+// TranscriptEmbeddingsCleared reports if the "transcript_embeddings" edge to the TranscriptEmbedding entity was cleared.
+func (m *VideoClipMutation) TranscriptEmbeddingsCleared() bool {
+	return m.clearedtranscript_embeddings
+}
+
+// RemoveTranscriptEmbeddingIDs removes the "transcript_embeddings" edge to the TranscriptEmbedding entity by IDs.
+func (m *VideoClipMutation) RemoveTranscriptEmbeddingIDs(ids ...int) {
+	if m.removedtranscript_embeddings == nil {
+		m.removedtranscript_embeddings = make(map[int]struct{})
+	}
+	for i := range ids {
+		delete(m.transcript_embeddings, ids[i])
+		m.removedtranscript_embeddings[ids[i]] = struct{}{}
+	}
+}
+
+// RemovedTranscriptEmbeddings returns the removed IDs of the "transcript_embeddings" edge to the TranscriptEmbedding entity.
+func (m *VideoClipMutation) RemovedTranscriptEmbeddingsIDs() (ids []int) {
+	for id := range m.removedtranscript_embeddings {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// TranscriptEmbeddingsIDs returns the "transcript_embeddings" edge IDs in the mutation.
+func (m *VideoClipMutation) TranscriptEmbeddingsIDs() (ids []int) {
+	for id := range m.transcript_embeddings {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// ResetTranscriptEmbeddings resets all changes to the "transcript_embeddings" edge.
+func (m *VideoClipMutation) ResetTranscriptEmbeddings() {
+	m.transcript_embeddings = nil
+	m.clearedtranscript_embeddings = false
+	m.removedtranscript_embeddings = nil
+}
+
+// AddTagIDs adds the "tags" edge to the Tag entity by ids.
+func (m *VideoClipMutation) AddTagIDs(ids ...int) {
+	if m.tags == nil {
+		m.tags = make(map[int]struct{})
+	}
+	for i := range ids {
+		m.tags[ids[i]] = struct{}{}
+	}
+}
+
+// ClearTags clears the "tags" edge to the Tag entity.
+func (m *VideoClipMutation) ClearTags() {
+	m.clearedtags = true
+}
+
+// TagsCleared reports if the "tags" edge to the Tag entity was cleared.
+func (m *VideoClipMutation) TagsCleared() bool {
+	return m.clearedtags
+}
+
+// RemoveTagIDs removes the "tags" edge to the Tag entity by IDs.
+func (m *VideoClipMutation) RemoveTagIDs(ids ...int) {
+	if m.removedtags == nil {
+		m.removedtags = make(map[int]struct{})
+	}
+	for i := range ids {
+		delete(m.tags, ids[i])
+		m.removedtags[ids[i]] = struct{}{}
+	}
+}
+
+// RemovedTags returns the removed IDs of the "tags" edge to the Tag entity.
+func (m *VideoClipMutation) RemovedTagsIDs() (ids []int) {
+	for id := range m.removedtags {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// TagsIDs returns the "tags" edge IDs in the mutation.
+func (m *VideoClipMutation) TagsIDs() (ids []int) {
+	for id := range m.tags {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// ResetTags resets all changes to the "tags" edge.
+func (m *VideoClipMutation) ResetTags() {
+	m.tags = nil
+	m.clearedtags = false
+	m.removedtags = nil
+}
+
 // Where appends a list predicates to the VideoClipMutation builder.
 func (m *VideoClipMutation) Where(ps ...predicate.VideoClip) {
 	m.predicates = append(m.predicates, ps...)
@@ -6840,7 +15168,7 @@ func (m *VideoClipMutation) Type() string {
 // order to get all numeric fields that were incremented/decremented, call
 // AddedFields().
 func (m *VideoClipMutation) Fields() []string {
-	fields := make([]string, 0, 22)
+	fields := make([]string, 0, 24)
 	if m.name != nil {
 		fields = append(fields, videoclip.FieldName)
 	}
@@ -6883,6 +15211,9 @@ func (m *VideoClipMutation) Fields() []string {
 	if m.suggested_highlights != nil {
 		fields = append(fields, videoclip.FieldSuggestedHighlights)
 	}
+	if m.suggested_highlights_prompt_id != nil {
+		fields = append(fields, videoclip.FieldSuggestedHighlightsPromptID)
+	}
 	if m.created_at != nil {
 		fields = append(fields, videoclip.FieldCreatedAt)
 	}
@@ -6907,6 +15238,9 @@ func (m *VideoClipMutation) Fields() []string {
 	if m.transcription_completed_at != nil {
 		fields = append(fields, videoclip.FieldTranscriptionCompletedAt)
 	}
+	if m.deleted_at != nil {
+		fields = append(fields, videoclip.FieldDeletedAt)
+	}
 	return fields
 }
 
@@ -6943,6 +15277,8 @@ func (m *VideoClipMutation) Field(name string) (ent.Value, bool) {
 		return m.Highlights()
 	case videoclip.FieldSuggestedHighlights:
 		return m.SuggestedHighlights()
+	case videoclip.FieldSuggestedHighlightsPromptID:
+		return m.SuggestedHighlightsPromptID()
 	case videoclip.FieldCreatedAt:
 		return m.CreatedAt()
 	case videoclip.FieldUpdatedAt:
@@ -6959,6 +15295,8 @@ func (m *VideoClipMutation) Field(name string) (ent.Value, bool) {
 		return m.TranscriptionStartedAt()
 	case videoclip.FieldTranscriptionCompletedAt:
 		return m.TranscriptionCompletedAt()
+	case videoclip.FieldDeletedAt:
+		return m.DeletedAt()
 	}
 	return nil, false
 }
@@ -6996,6 +15334,8 @@ func (m *VideoClipMutation) OldField(ctx context.Context, name string) (ent.Valu
 		return m.OldHighlights(ctx)
 	case videoclip.FieldSuggestedHighlights:
 		return m.OldSuggestedHighlights(ctx)
+	case videoclip.FieldSuggestedHighlightsPromptID:
+		return m.OldSuggestedHighlightsPromptID(ctx)
 	case videoclip.FieldCreatedAt:
 		return m.OldCreatedAt(ctx)
 	case videoclip.FieldUpdatedAt:
@@ -7012,6 +15352,8 @@ func (m *VideoClipMutation) OldField(ctx context.Context, name string) (ent.Valu
 		return m.OldTranscriptionStartedAt(ctx)
 	case videoclip.FieldTranscriptionCompletedAt:
 		return m.OldTranscriptionCompletedAt(ctx)
+	case videoclip.FieldDeletedAt:
+		return m.OldDeletedAt(ctx)
 	}
 	return nil, fmt.Errorf("unknown VideoClip field %s", name)
 }
@@ -7119,6 +15461,13 @@ func (m *VideoClipMutation) SetField(name string, value ent.Value) error {
 		}
 		m.SetSuggestedHighlights(v)
 		return nil
+	case videoclip.FieldSuggestedHighlightsPromptID:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetSuggestedHighlightsPromptID(v)
+		return nil
 	case videoclip.FieldCreatedAt:
 		v, ok := value.(time.Time)
 		if !ok {
@@ -7175,6 +15524,13 @@ func (m *VideoClipMutation) SetField(name string, value ent.Value) error {
 		}
 		m.SetTranscriptionCompletedAt(v)
 		return nil
+	case videoclip.FieldDeletedAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetDeletedAt(v)
+		return nil
 	}
 	return fmt.Errorf("unknown VideoClip field %s", name)
 }
@@ -7198,6 +15554,9 @@ func (m *VideoClipMutation) AddedFields() []string {
 	if m.addtranscription_duration != nil {
 		fields = append(fields, videoclip.FieldTranscriptionDuration)
 	}
+	if m.addsuggested_highlights_prompt_id != nil {
+		fields = append(fields, videoclip.FieldSuggestedHighlightsPromptID)
+	}
 	if m.addhighlights_history_index != nil {
 		fields = append(fields, videoclip.FieldHighlightsHistoryIndex)
 	}
@@ -7219,6 +15578,8 @@ func (m *VideoClipMutation) AddedField(name string) (ent.Value, bool) {
 		return m.AddedFileSize()
 	case videoclip.FieldTranscriptionDuration:
 		return m.AddedTranscriptionDuration()
+	case videoclip.FieldSuggestedHighlightsPromptID:
+		return m.AddedSuggestedHighlightsPromptID()
 	case videoclip.FieldHighlightsHistoryIndex:
 		return m.AddedHighlightsHistoryIndex()
 	}
@@ -7265,6 +15626,13 @@ func (m *VideoClipMutation) AddField(name string, value ent.Value) error {
 		}
 		m.AddTranscriptionDuration(v)
 		return nil
+	case videoclip.FieldSuggestedHighlightsPromptID:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddSuggestedHighlightsPromptID(v)
+		return nil
 	case videoclip.FieldHighlightsHistoryIndex:
 		v, ok := value.(int)
 		if !ok {
@@ -7316,6 +15684,9 @@ func (m *VideoClipMutation) ClearedFields() []string {
 	if m.FieldCleared(videoclip.FieldSuggestedHighlights) {
 		fields = append(fields, videoclip.FieldSuggestedHighlights)
 	}
+	if m.FieldCleared(videoclip.FieldSuggestedHighlightsPromptID) {
+		fields = append(fields, videoclip.FieldSuggestedHighlightsPromptID)
+	}
 	if m.FieldCleared(videoclip.FieldHighlightsHistory) {
 		fields = append(fields, videoclip.FieldHighlightsHistory)
 	}
@@ -7334,6 +15705,9 @@ func (m *VideoClipMutation) ClearedFields() []string {
 	if m.FieldCleared(videoclip.FieldTranscriptionCompletedAt) {
 		fields = append(fields, videoclip.FieldTranscriptionCompletedAt)
 	}
+	if m.FieldCleared(videoclip.FieldDeletedAt) {
+		fields = append(fields, videoclip.FieldDeletedAt)
+	}
 	return fields
 }
 
@@ -7384,6 +15758,9 @@ func (m *VideoClipMutation) ClearField(name string) error {
 	case videoclip.FieldSuggestedHighlights:
 		m.ClearSuggestedHighlights()
 		return nil
+	case videoclip.FieldSuggestedHighlightsPromptID:
+		m.ClearSuggestedHighlightsPromptID()
+		return nil
 	case videoclip.FieldHighlightsHistory:
 		m.ClearHighlightsHistory()
 		return nil
@@ -7402,6 +15779,9 @@ func (m *VideoClipMutation) ClearField(name string) error {
 	case videoclip.FieldTranscriptionCompletedAt:
 		m.ClearTranscriptionCompletedAt()
 		return nil
+	case videoclip.FieldDeletedAt:
+		m.ClearDeletedAt()
+		return nil
 	}
 	return fmt.Errorf("unknown VideoClip nullable field %s", name)
 }
@@ -7452,6 +15832,9 @@ func (m *VideoClipMutation) ResetField(name string) error {
 	case videoclip.FieldSuggestedHighlights:
 		m.ResetSuggestedHighlights()
 		return nil
+	case videoclip.FieldSuggestedHighlightsPromptID:
+		m.ResetSuggestedHighlightsPromptID()
+		return nil
 	case videoclip.FieldCreatedAt:
 		m.ResetCreatedAt()
 		return nil
@@ -7476,16 +15859,25 @@ func (m *VideoClipMutation) ResetField(name string) error {
 	case videoclip.FieldTranscriptionCompletedAt:
 		m.ResetTranscriptionCompletedAt()
 		return nil
+	case videoclip.FieldDeletedAt:
+		m.ResetDeletedAt()
+		return nil
 	}
 	return fmt.Errorf("unknown VideoClip field %s", name)
 }
 
 // AddedEdges returns all edge names that were set/added in this mutation.
 func (m *VideoClipMutation) AddedEdges() []string {
-	edges := make([]string, 0, 1)
+	edges := make([]string, 0, 3)
 	if m.project != nil {
 		edges = append(edges, videoclip.EdgeProject)
 	}
+	if m.transcript_embeddings != nil {
+		edges = append(edges, videoclip.EdgeTranscriptEmbeddings)
+	}
+	if m.tags != nil {
+		edges = append(edges, videoclip.EdgeTags)
+	}
 	return edges
 }
 
@@ -7497,28 +15889,66 @@ func (m *VideoClipMutation) AddedIDs(name string) []ent.Value {
 		if id := m.project; id != nil {
 			return []ent.Value{*id}
 		}
+	case videoclip.EdgeTranscriptEmbeddings:
+		ids := make([]ent.Value, 0, len(m.transcript_embeddings))
+		for id := range m.transcript_embeddings {
+			ids = append(ids, id)
+		}
+		return ids
+	case videoclip.EdgeTags:
+		ids := make([]ent.Value, 0, len(m.tags))
+		for id := range m.tags {
+			ids = append(ids, id)
+		}
+		return ids
 	}
 	return nil
 }
 
 // RemovedEdges returns all edge names that were removed in this mutation.
 func (m *VideoClipMutation) RemovedEdges() []string {
-	edges := make([]string, 0, 1)
+	edges := make([]string, 0, 3)
+	if m.removedtranscript_embeddings != nil {
+		edges = append(edges, videoclip.EdgeTranscriptEmbeddings)
+	}
+	if m.removedtags != nil {
+		edges = append(edges, videoclip.EdgeTags)
+	}
 	return edges
 }
 
 // RemovedIDs returns all IDs (to other nodes) that were removed for the edge with
 // the given name in this mutation.
 func (m *VideoClipMutation) RemovedIDs(name string) []ent.Value {
+	switch name {
+	case videoclip.EdgeTranscriptEmbeddings:
+		ids := make([]ent.Value, 0, len(m.removedtranscript_embeddings))
+		for id := range m.removedtranscript_embeddings {
+			ids = append(ids, id)
+		}
+		return ids
+	case videoclip.EdgeTags:
+		ids := make([]ent.Value, 0, len(m.removedtags))
+		for id := range m.removedtags {
+			ids = append(ids, id)
+		}
+		return ids
+	}
 	return nil
 }
 
 // ClearedEdges returns all edge names that were cleared in this mutation.
 func (m *VideoClipMutation) ClearedEdges() []string {
-	edges := make([]string, 0, 1)
+	edges := make([]string, 0, 3)
 	if m.clearedproject {
 		edges = append(edges, videoclip.EdgeProject)
 	}
+	if m.clearedtranscript_embeddings {
+		edges = append(edges, videoclip.EdgeTranscriptEmbeddings)
+	}
+	if m.clearedtags {
+		edges = append(edges, videoclip.EdgeTags)
+	}
 	return edges
 }
 
@@ -7528,6 +15958,10 @@ func (m *VideoClipMutation) EdgeCleared(name string) bool {
 	switch name {
 	case videoclip.EdgeProject:
 		return m.clearedproject
+	case videoclip.EdgeTranscriptEmbeddings:
+		return m.clearedtranscript_embeddings
+	case videoclip.EdgeTags:
+		return m.clearedtags
 	}
 	return false
 }
@@ -7550,6 +15984,12 @@ func (m *VideoClipMutation) ResetEdge(name string) error {
 	case videoclip.EdgeProject:
 		m.ResetProject()
 		return nil
+	case videoclip.EdgeTranscriptEmbeddings:
+		m.ResetTranscriptEmbeddings()
+		return nil
+	case videoclip.EdgeTags:
+		m.ResetTags()
+		return nil
 	}
 	return fmt.Errorf("unknown VideoClip edge %s", name)
 }
@@ -12,14 +12,38 @@ type ChatMessage func(*sql.Selector)
 // ChatSession is the predicate function for chatsession builders.
 type ChatSession func(*sql.Selector)
 
+// Collection is the predicate function for collection builders.
+type Collection func(*sql.Selector)
+
+// Cut is the predicate function for cut builders.
+type Cut func(*sql.Selector)
+
 // ExportJob is the predicate function for exportjob builders.
 type ExportJob func(*sql.Selector)
 
 // Project is the predicate function for project builders.
 type Project func(*sql.Selector)
 
+// ProjectTemplate is the predicate function for projecttemplate builders.
+type ProjectTemplate func(*sql.Selector)
+
+// Prompt is the predicate function for prompt builders.
+type Prompt func(*sql.Selector)
+
+// ResponseCacheEntry is the predicate function for responsecacheentry builders.
+type ResponseCacheEntry func(*sql.Selector)
+
 // Settings is the predicate function for settings builders.
 type Settings func(*sql.Selector)
 
+// Tag is the predicate function for tag builders.
+type Tag func(*sql.Selector)
+
+// TranscriptEmbedding is the predicate function for transcriptembedding builders.
+type TranscriptEmbedding func(*sql.Selector)
+
+// UsageRecord is the predicate function for usagerecord builders.
+type UsageRecord func(*sql.Selector)
+
 // VideoClip is the predicate function for videoclip builders.
 type VideoClip func(*sql.Selector)
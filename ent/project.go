@@ -3,9 +3,10 @@
 package ent
 
 import (
-	"ramble-ai/ent/project"
 	"encoding/json"
 	"fmt"
+	"ramble-ai/ent/project"
+	"ramble-ai/ent/schema"
 	"strings"
 	"time"
 
@@ -36,6 +37,8 @@ type Project struct {
 	AiSuggestionOrder []interface{} `json:"ai_suggestion_order,omitempty"`
 	// AI model used for the cached suggestion
 	AiSuggestionModel string `json:"ai_suggestion_model,omitempty"`
+	// Library prompt version used for the cached suggestion (0 = none)
+	AiSuggestionPromptID int `json:"ai_suggestion_prompt_id,omitempty"`
 	// When the AI suggestion was created
 	AiSuggestionCreatedAt time.Time `json:"ai_suggestion_created_at,omitempty"`
 	// Preferred OpenRouter AI model for highlight suggestions
@@ -58,6 +61,12 @@ type Project struct {
 	OrderHistoryIndex int `json:"order_history_index,omitempty"`
 	// Array of hidden highlight IDs that should not appear in the final video
 	HiddenHighlights []string `json:"hidden_highlights,omitempty"`
+	// Saved export presets for this project
+	ExportPresets []schema.ExportPreset `json:"export_presets,omitempty"`
+	// Cut whose state is mirrored in highlight_order, order_history and hidden_highlights (0 = no cuts yet)
+	ActiveCutID int `json:"active_cut_id,omitempty"`
+	// When the project was moved to the trash (unset while active)
+	DeletedAt time.Time `json:"deleted_at,omitempty"`
 	// Edges holds the relations/edges for other nodes in the graph.
 	// The values are being populated by the ProjectQuery when eager-loading is set.
 	Edges        ProjectEdges `json:"edges"`
@@ -72,9 +81,17 @@ type ProjectEdges struct {
 	ExportJobs []*ExportJob `json:"export_jobs,omitempty"`
 	// Chat sessions for this project
 	ChatSessions []*ChatSession `json:"chat_sessions,omitempty"`
+	// Named cuts of this project
+	Cuts []*Cut `json:"cuts,omitempty"`
+	// Library prompts owned by this project
+	Prompts []*Prompt `json:"prompts,omitempty"`
+	// User-defined tags on this project
+	Tags []*Tag `json:"tags,omitempty"`
+	// Collections containing this project
+	Collections []*Collection `json:"collections,omitempty"`
 	// loadedTypes holds the information for reporting if a
 	// type was loaded (or requested) in eager-loading or not.
-	loadedTypes [3]bool
+	loadedTypes [7]bool
 }
 
 // VideoClipsOrErr returns the VideoClips value or an error if the edge
@@ -104,18 +121,54 @@ func (e ProjectEdges) ChatSessionsOrErr() ([]*ChatSession, error) {
 	return nil, &NotLoadedError{edge: "chat_sessions"}
 }
 
+// CutsOrErr returns the Cuts value or an error if the edge
+// was not loaded in eager-loading.
+func (e ProjectEdges) CutsOrErr() ([]*Cut, error) {
+	if e.loadedTypes[3] {
+		return e.Cuts, nil
+	}
+	return nil, &NotLoadedError{edge: "cuts"}
+}
+
+// PromptsOrErr returns the Prompts value or an error if the edge
+// was not loaded in eager-loading.
+func (e ProjectEdges) PromptsOrErr() ([]*Prompt, error) {
+	if e.loadedTypes[4] {
+		return e.Prompts, nil
+	}
+	return nil, &NotLoadedError{edge: "prompts"}
+}
+
+// TagsOrErr returns the Tags value or an error if the edge
+// was not loaded in eager-loading.
+func (e ProjectEdges) TagsOrErr() ([]*Tag, error) {
+	if e.loadedTypes[5] {
+		return e.Tags, nil
+	}
+	return nil, &NotLoadedError{edge: "tags"}
+}
+
+// CollectionsOrErr returns the Collections value or an error if the edge
+// was not loaded in eager-loading.
+func (e ProjectEdges) CollectionsOrErr() ([]*Collection, error) {
+	if e.loadedTypes[6] {
+		return e.Collections, nil
+	}
+	return nil, &NotLoadedError{edge: "collections"}
+}
+
 // scanValues returns the types for scanning values from sql.Rows.
 func (*Project) scanValues(columns []string) ([]any, error) {
 	values := make([]any, len(columns))
 	for i := range columns {
 		switch columns[i] {
-		case project.FieldAiSuggestionOrder, project.FieldAiSilenceImprovements, project.FieldHighlightOrder, project.FieldOrderHistory, project.FieldHiddenHighlights:
+		case project.FieldAiSuggestionOrder, project.FieldAiSilenceImprovements, project.FieldHighlightOrder, project.FieldOrderHistory, project.FieldHiddenHighlights, project.FieldExportPresets:
 			values[i] = new([]byte)
-		case project.FieldID, project.FieldOrderHistoryIndex:
+		case project.FieldID, project.FieldAiSuggestionPromptID, project.FieldOrderHistoryIndex, project.FieldActiveCutID:
 			values[i] = new(sql.NullInt64)
 		case project.FieldName, project.FieldDescription, project.FieldPath, project.FieldAiModel, project.FieldAiPrompt, project.FieldAiSuggestionModel, project.FieldAiHighlightModel, project.FieldAiHighlightPrompt, project.FieldActiveTab, project.FieldAiSilenceModel:
 			values[i] = new(sql.NullString)
-		case project.FieldCreatedAt, project.FieldUpdatedAt, project.FieldAiSuggestionCreatedAt, project.FieldAiSilenceCreatedAt:
+		case project.FieldCreatedAt, project.FieldUpdatedAt, project.FieldAiSuggestionCreatedAt, project.FieldAiSilenceCreatedAt, project.FieldDeletedAt:
 			values[i] = new(sql.NullTime)
 		default:
 			values[i] = new(sql.UnknownType)
@@ -194,6 +247,12 @@ func (pr *Project) assignValues(columns []string, values []any) error {
 			} else if value.Valid {
 				pr.AiSuggestionModel = value.String
 			}
+		case project.FieldAiSuggestionPromptID:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field ai_suggestion_prompt_id", values[i])
+			} else if value.Valid {
+				pr.AiSuggestionPromptID = int(value.Int64)
+			}
 		case project.FieldAiSuggestionCreatedAt:
 			if value, ok := values[i].(*sql.NullTime); !ok {
 				return fmt.Errorf("unexpected type %T for field ai_suggestion_created_at", values[i])
@@ -268,6 +327,26 @@ func (pr *Project) assignValues(columns []string, values []any) error {
 					return fmt.Errorf("unmarshal field hidden_highlights: %w", err)
 				}
 			}
+		case project.FieldExportPresets:
+			if value, ok := values[i].(*[]byte); !ok {
+				return fmt.Errorf("unexpected type %T for field export_presets", values[i])
+			} else if value != nil && len(*value) > 0 {
+				if err := json.Unmarshal(*value, &pr.ExportPresets); err != nil {
+					return fmt.Errorf("unmarshal field export_presets: %w", err)
+				}
+			}
+		case project.FieldActiveCutID:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field active_cut_id", values[i])
+			} else if value.Valid {
+				pr.ActiveCutID = int(value.Int64)
+			}
+		case project.FieldDeletedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field deleted_at", values[i])
+			} else if value.Valid {
+				pr.DeletedAt = value.Time
+			}
 		default:
 			pr.selectValues.Set(columns[i], values[i])
 		}
@@ -296,6 +375,26 @@ func (pr *Project) QueryChatSessions() *ChatSessionQuery {
 	return NewProjectClient(pr.config).QueryChatSessions(pr)
 }
 
+// QueryCuts queries the "cuts" edge of the Project entity.
+func (pr *Project) QueryCuts() *CutQuery {
+	return NewProjectClient(pr.config).QueryCuts(pr)
+}
+
+// QueryPrompts queries the "prompts" edge of the Project entity.
+func (pr *Project) QueryPrompts() *PromptQuery {
+	return NewProjectClient(pr.config).QueryPrompts(pr)
+}
+
+// QueryTags queries the "tags" edge of the Project entity.
+func (pr *Project) QueryTags() *TagQuery {
+	return NewProjectClient(pr.config).QueryTags(pr)
+}
+
+// QueryCollections queries the "collections" edge of the Project entity.
+func (pr *Project) QueryCollections() *CollectionQuery {
+	return NewProjectClient(pr.config).QueryCollections(pr)
+}
+
 // Update returns a builder for updating this Project.
 // Note that you need to call Project.Unwrap() before calling this method if this Project
 // was returned from a transaction, and the transaction was committed or rolled back.
@@ -346,6 +445,9 @@ func (pr *Project) String() string {
 	builder.WriteString("ai_suggestion_model=")
 	builder.WriteString(pr.AiSuggestionModel)
 	builder.WriteString(", ")
+	builder.WriteString("ai_suggestion_prompt_id=")
+	builder.WriteString(fmt.Sprintf("%v", pr.AiSuggestionPromptID))
+	builder.WriteString(", ")
 	builder.WriteString("ai_suggestion_created_at=")
 	builder.WriteString(pr.AiSuggestionCreatedAt.Format(time.ANSIC))
 	builder.WriteString(", ")
@@ -378,6 +480,15 @@ func (pr *Project) String() string {
 	builder.WriteString(", ")
 	builder.WriteString("hidden_highlights=")
 	builder.WriteString(fmt.Sprintf("%v", pr.HiddenHighlights))
+	builder.WriteString(", ")
+	builder.WriteString("export_presets=")
+	builder.WriteString(fmt.Sprintf("%v", pr.ExportPresets))
+	builder.WriteString(", ")
+	builder.WriteString("active_cut_id=")
+	builder.WriteString(fmt.Sprintf("%v", pr.ActiveCutID))
+	builder.WriteString(", ")
+	builder.WriteString("deleted_at=")
+	builder.WriteString(pr.DeletedAt.Format(time.ANSIC))
 	builder.WriteByte(')')
 	return builder.String()
 }
@@ -32,6 +32,8 @@ const (
 	FieldAiSuggestionOrder = "ai_suggestion_order"
 	// FieldAiSuggestionModel holds the string denoting the ai_suggestion_model field in the database.
 	FieldAiSuggestionModel = "ai_suggestion_model"
+	// FieldAiSuggestionPromptID holds the string denoting the ai_suggestion_prompt_id field in the database.
+	FieldAiSuggestionPromptID = "ai_suggestion_prompt_id"
 	// FieldAiSuggestionCreatedAt holds the string denoting the ai_suggestion_created_at field in the database.
 	FieldAiSuggestionCreatedAt = "ai_suggestion_created_at"
 	// FieldAiHighlightModel holds the string denoting the ai_highlight_model field in the database.
@@ -54,12 +56,26 @@ const (
 	FieldOrderHistoryIndex = "order_history_index"
 	// FieldHiddenHighlights holds the string denoting the hidden_highlights field in the database.
 	FieldHiddenHighlights = "hidden_highlights"
+	// FieldExportPresets holds the string denoting the export_presets field in the database.
+	FieldExportPresets = "export_presets"
+	// FieldActiveCutID holds the string denoting the active_cut_id field in the database.
+	FieldActiveCutID = "active_cut_id"
+	// FieldDeletedAt holds the string denoting the deleted_at field in the database.
+	FieldDeletedAt = "deleted_at"
 	// EdgeVideoClips holds the string denoting the video_clips edge name in mutations.
 	EdgeVideoClips = "video_clips"
 	// EdgeExportJobs holds the string denoting the export_jobs edge name in mutations.
 	EdgeExportJobs = "export_jobs"
 	// EdgeChatSessions holds the string denoting the chat_sessions edge name in mutations.
 	EdgeChatSessions = "chat_sessions"
+	// EdgeCuts holds the string denoting the cuts edge name in mutations.
+	EdgeCuts = "cuts"
+	// EdgePrompts holds the string denoting the prompts edge name in mutations.
+	EdgePrompts = "prompts"
+	// EdgeTags holds the string denoting the tags edge name in mutations.
+	EdgeTags = "tags"
+	// EdgeCollections holds the string denoting the collections edge name in mutations.
+	EdgeCollections = "collections"
 	// Table holds the table name of the project in the database.
 	Table = "projects"
 	// VideoClipsTable is the table that holds the video_clips relation/edge.
@@ -83,6 +99,30 @@ const (
 	ChatSessionsInverseTable = "chat_sessions"
 	// ChatSessionsColumn is the table column denoting the chat_sessions relation/edge.
 	ChatSessionsColumn = "project_id"
+	// CutsTable is the table that holds the cuts relation/edge.
+	CutsTable = "cuts"
+	// CutsInverseTable is the table name for the Cut entity.
+	// It exists in this package in order to avoid circular dependency with the "cut" package.
+	CutsInverseTable = "cuts"
+	// CutsColumn is the table column denoting the cuts relation/edge.
+	CutsColumn = "project_id"
+	// PromptsTable is the table that holds the prompts relation/edge.
+	PromptsTable = "prompts"
+	// PromptsInverseTable is the table name for the Prompt entity.
+	// It exists in this package in order to avoid circular dependency with the "prompt" package.
+	PromptsInverseTable = "prompts"
+	// PromptsColumn is the table column denoting the prompts relation/edge.
+	PromptsColumn = "project_id"
+	// TagsTable is the table that holds the tags relation/edge. The primary key declared below.
+	TagsTable = "tag_projects"
+	// TagsInverseTable is the table name for the Tag entity.
+	// It exists in this package in order to avoid circular dependency with the "tag" package.
+	TagsInverseTable = "tags"
+	// CollectionsTable is the table that holds the collections relation/edge. The primary key declared below.
+	CollectionsTable = "collection_projects"
+	// CollectionsInverseTable is the table name for the Collection entity.
+	// It exists in this package in order to avoid circular dependency with the "collection" package.
+	CollectionsInverseTable = "collections"
 )
 
 // Columns holds all SQL columns for project fields.
@@ -97,6 +137,7 @@ var Columns = []string{
 	FieldAiPrompt,
 	FieldAiSuggestionOrder,
 	FieldAiSuggestionModel,
+	FieldAiSuggestionPromptID,
 	FieldAiSuggestionCreatedAt,
 	FieldAiHighlightModel,
 	FieldAiHighlightPrompt,
@@ -108,8 +149,20 @@ var Columns = []string{
 	FieldOrderHistory,
 	FieldOrderHistoryIndex,
 	FieldHiddenHighlights,
+	FieldExportPresets,
+	FieldActiveCutID,
+	FieldDeletedAt,
 }
 
+var (
+	// TagsPrimaryKey and TagsColumn2 are the table columns denoting the
+	// primary key for the tags relation (M2M).
+	TagsPrimaryKey = []string{"tag_id", "project_id"}
+	// CollectionsPrimaryKey and CollectionsColumn2 are the table columns denoting the
+	// primary key for the collections relation (M2M).
+	CollectionsPrimaryKey = []string{"collection_id", "project_id"}
+)
+
 // ValidColumn reports if the column name is valid (part of the table columns).
 func ValidColumn(column string) bool {
 	for i := range Columns {
@@ -189,6 +242,11 @@ func ByAiSuggestionModel(opts ...sql.OrderTermOption) OrderOption {
 	return sql.OrderByField(FieldAiSuggestionModel, opts...).ToFunc()
 }
 
+// ByAiSuggestionPromptID orders the results by the ai_suggestion_prompt_id field.
+func ByAiSuggestionPromptID(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldAiSuggestionPromptID, opts...).ToFunc()
+}
+
 // ByAiSuggestionCreatedAt orders the results by the ai_suggestion_created_at field.
 func ByAiSuggestionCreatedAt(opts ...sql.OrderTermOption) OrderOption {
 	return sql.OrderByField(FieldAiSuggestionCreatedAt, opts...).ToFunc()
@@ -224,6 +282,16 @@ func ByOrderHistoryIndex(opts ...sql.OrderTermOption) OrderOption {
 	return sql.OrderByField(FieldOrderHistoryIndex, opts...).ToFunc()
 }
 
+// ByActiveCutID orders the results by the active_cut_id field.
+func ByActiveCutID(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldActiveCutID, opts...).ToFunc()
+}
+
+// ByDeletedAt orders the results by the deleted_at field.
+func ByDeletedAt(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldDeletedAt, opts...).ToFunc()
+}
+
 // ByVideoClipsCount orders the results by video_clips count.
 func ByVideoClipsCount(opts ...sql.OrderTermOption) OrderOption {
 	return func(s *sql.Selector) {
@@ -265,6 +333,62 @@ func ByChatSessions(term sql.OrderTerm, terms ...sql.OrderTerm) OrderOption {
 		sqlgraph.OrderByNeighborTerms(s, newChatSessionsStep(), append([]sql.OrderTerm{term}, terms...)...)
 	}
 }
+
+// ByCutsCount orders the results by cuts count.
+func ByCutsCount(opts ...sql.OrderTermOption) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborsCount(s, newCutsStep(), opts...)
+	}
+}
+
+// ByCuts orders the results by cuts terms.
+func ByCuts(term sql.OrderTerm, terms ...sql.OrderTerm) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborTerms(s, newCutsStep(), append([]sql.OrderTerm{term}, terms...)...)
+	}
+}
+
+// ByPromptsCount orders the results by prompts count.
+func ByPromptsCount(opts ...sql.OrderTermOption) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborsCount(s, newPromptsStep(), opts...)
+	}
+}
+
+// ByPrompts orders the results by prompts terms.
+func ByPrompts(term sql.OrderTerm, terms ...sql.OrderTerm) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborTerms(s, newPromptsStep(), append([]sql.OrderTerm{term}, terms...)...)
+	}
+}
+
+// ByTagsCount orders the results by tags count.
+func ByTagsCount(opts ...sql.OrderTermOption) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborsCount(s, newTagsStep(), opts...)
+	}
+}
+
+// ByTags orders the results by tags terms.
+func ByTags(term sql.OrderTerm, terms ...sql.OrderTerm) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborTerms(s, newTagsStep(), append([]sql.OrderTerm{term}, terms...)...)
+	}
+}
+
+// ByCollectionsCount orders the results by collections count.
+func ByCollectionsCount(opts ...sql.OrderTermOption) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborsCount(s, newCollectionsStep(), opts...)
+	}
+}
+
+// ByCollections orders the results by collections terms.
+func ByCollections(term sql.OrderTerm, terms ...sql.OrderTerm) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborTerms(s, newCollectionsStep(), append([]sql.OrderTerm{term}, terms...)...)
+	}
+}
 func newVideoClipsStep() *sqlgraph.Step {
 	return sqlgraph.NewStep(
 		sqlgraph.From(Table, FieldID),
@@ -286,3 +410,31 @@ func newChatSessionsStep() *sqlgraph.Step {
 		sqlgraph.Edge(sqlgraph.O2M, false, ChatSessionsTable, ChatSessionsColumn),
 	)
 }
+func newCutsStep() *sqlgraph.Step {
+	return sqlgraph.NewStep(
+		sqlgraph.From(Table, FieldID),
+		sqlgraph.To(CutsInverseTable, FieldID),
+		sqlgraph.Edge(sqlgraph.O2M, false, CutsTable, CutsColumn),
+	)
+}
+func newPromptsStep() *sqlgraph.Step {
+	return sqlgraph.NewStep(
+		sqlgraph.From(Table, FieldID),
+		sqlgraph.To(PromptsInverseTable, FieldID),
+		sqlgraph.Edge(sqlgraph.O2M, false, PromptsTable, PromptsColumn),
+	)
+}
+func newTagsStep() *sqlgraph.Step {
+	return sqlgraph.NewStep(
+		sqlgraph.From(Table, FieldID),
+		sqlgraph.To(TagsInverseTable, FieldID),
+		sqlgraph.Edge(sqlgraph.M2M, true, TagsTable, TagsPrimaryKey...),
+	)
+}
+func newCollectionsStep() *sqlgraph.Step {
+	return sqlgraph.NewStep(
+		sqlgraph.From(Table, FieldID),
+		sqlgraph.To(CollectionsInverseTable, FieldID),
+		sqlgraph.Edge(sqlgraph.M2M, true, CollectionsTable, CollectionsPrimaryKey...),
+	)
+}
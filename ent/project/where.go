@@ -95,6 +95,11 @@ func AiSuggestionModel(v string) predicate.Project {
 	return predicate.Project(sql.FieldEQ(FieldAiSuggestionModel, v))
 }
 
+// AiSuggestionPromptID applies equality check predicate on the "ai_suggestion_prompt_id" field. It's identical to AiSuggestionPromptIDEQ.
+func AiSuggestionPromptID(v int) predicate.Project {
+	return predicate.Project(sql.FieldEQ(FieldAiSuggestionPromptID, v))
+}
+
 // AiSuggestionCreatedAt applies equality check predicate on the "ai_suggestion_created_at" field. It's identical to AiSuggestionCreatedAtEQ.
 func AiSuggestionCreatedAt(v time.Time) predicate.Project {
 	return predicate.Project(sql.FieldEQ(FieldAiSuggestionCreatedAt, v))
@@ -130,6 +135,16 @@ func OrderHistoryIndex(v int) predicate.Project {
 	return predicate.Project(sql.FieldEQ(FieldOrderHistoryIndex, v))
 }
 
+// ActiveCutID applies equality check predicate on the "active_cut_id" field. It's identical to ActiveCutIDEQ.
+func ActiveCutID(v int) predicate.Project {
+	return predicate.Project(sql.FieldEQ(FieldActiveCutID, v))
+}
+
+// DeletedAt applies equality check predicate on the "deleted_at" field. It's identical to DeletedAtEQ.
+func DeletedAt(v time.Time) predicate.Project {
+	return predicate.Project(sql.FieldEQ(FieldDeletedAt, v))
+}
+
 // NameEQ applies the EQ predicate on the "name" field.
 func NameEQ(v string) predicate.Project {
 	return predicate.Project(sql.FieldEQ(FieldName, v))
@@ -650,6 +665,56 @@ func AiSuggestionModelContainsFold(v string) predicate.Project {
 	return predicate.Project(sql.FieldContainsFold(FieldAiSuggestionModel, v))
 }
 
+// AiSuggestionPromptIDEQ applies the EQ predicate on the "ai_suggestion_prompt_id" field.
+func AiSuggestionPromptIDEQ(v int) predicate.Project {
+	return predicate.Project(sql.FieldEQ(FieldAiSuggestionPromptID, v))
+}
+
+// AiSuggestionPromptIDNEQ applies the NEQ predicate on the "ai_suggestion_prompt_id" field.
+func AiSuggestionPromptIDNEQ(v int) predicate.Project {
+	return predicate.Project(sql.FieldNEQ(FieldAiSuggestionPromptID, v))
+}
+
+// AiSuggestionPromptIDIn applies the In predicate on the "ai_suggestion_prompt_id" field.
+func AiSuggestionPromptIDIn(vs ...int) predicate.Project {
+	return predicate.Project(sql.FieldIn(FieldAiSuggestionPromptID, vs...))
+}
+
+// AiSuggestionPromptIDNotIn applies the NotIn predicate on the "ai_suggestion_prompt_id" field.
+func AiSuggestionPromptIDNotIn(vs ...int) predicate.Project {
+	return predicate.Project(sql.FieldNotIn(FieldAiSuggestionPromptID, vs...))
+}
+
+// AiSuggestionPromptIDGT applies the GT predicate on the "ai_suggestion_prompt_id" field.
+func AiSuggestionPromptIDGT(v int) predicate.Project {
+	return predicate.Project(sql.FieldGT(FieldAiSuggestionPromptID, v))
+}
+
+// AiSuggestionPromptIDGTE applies the GTE predicate on the "ai_suggestion_prompt_id" field.
+func AiSuggestionPromptIDGTE(v int) predicate.Project {
+	return predicate.Project(sql.FieldGTE(FieldAiSuggestionPromptID, v))
+}
+
+// AiSuggestionPromptIDLT applies the LT predicate on the "ai_suggestion_prompt_id" field.
+func AiSuggestionPromptIDLT(v int) predicate.Project {
+	return predicate.Project(sql.FieldLT(FieldAiSuggestionPromptID, v))
+}
+
+// AiSuggestionPromptIDLTE applies the LTE predicate on the "ai_suggestion_prompt_id" field.
+func AiSuggestionPromptIDLTE(v int) predicate.Project {
+	return predicate.Project(sql.FieldLTE(FieldAiSuggestionPromptID, v))
+}
+
+// AiSuggestionPromptIDIsNil applies the IsNil predicate on the "ai_suggestion_prompt_id" field.
+func AiSuggestionPromptIDIsNil() predicate.Project {
+	return predicate.Project(sql.FieldIsNull(FieldAiSuggestionPromptID))
+}
+
+// AiSuggestionPromptIDNotNil applies the NotNil predicate on the "ai_suggestion_prompt_id" field.
+func AiSuggestionPromptIDNotNil() predicate.Project {
+	return predicate.Project(sql.FieldNotNull(FieldAiSuggestionPromptID))
+}
+
 // AiSuggestionCreatedAtEQ applies the EQ predicate on the "ai_suggestion_created_at" field.
 func AiSuggestionCreatedAtEQ(v time.Time) predicate.Project {
 	return predicate.Project(sql.FieldEQ(FieldAiSuggestionCreatedAt, v))
@@ -1140,6 +1205,116 @@ func HiddenHighlightsNotNil() predicate.Project {
 	return predicate.Project(sql.FieldNotNull(FieldHiddenHighlights))
 }
 
+// ExportPresetsIsNil applies the IsNil predicate on the "export_presets" field.
+func ExportPresetsIsNil() predicate.Project {
+	return predicate.Project(sql.FieldIsNull(FieldExportPresets))
+}
+
+// ExportPresetsNotNil applies the NotNil predicate on the "export_presets" field.
+func ExportPresetsNotNil() predicate.Project {
+	return predicate.Project(sql.FieldNotNull(FieldExportPresets))
+}
+
+// ActiveCutIDEQ applies the EQ predicate on the "active_cut_id" field.
+func ActiveCutIDEQ(v int) predicate.Project {
+	return predicate.Project(sql.FieldEQ(FieldActiveCutID, v))
+}
+
+// ActiveCutIDNEQ applies the NEQ predicate on the "active_cut_id" field.
+func ActiveCutIDNEQ(v int) predicate.Project {
+	return predicate.Project(sql.FieldNEQ(FieldActiveCutID, v))
+}
+
+// ActiveCutIDIn applies the In predicate on the "active_cut_id" field.
+func ActiveCutIDIn(vs ...int) predicate.Project {
+	return predicate.Project(sql.FieldIn(FieldActiveCutID, vs...))
+}
+
+// ActiveCutIDNotIn applies the NotIn predicate on the "active_cut_id" field.
+func ActiveCutIDNotIn(vs ...int) predicate.Project {
+	return predicate.Project(sql.FieldNotIn(FieldActiveCutID, vs...))
+}
+
+// ActiveCutIDGT applies the GT predicate on the "active_cut_id" field.
+func ActiveCutIDGT(v int) predicate.Project {
+	return predicate.Project(sql.FieldGT(FieldActiveCutID, v))
+}
+
+// ActiveCutIDGTE applies the GTE predicate on the "active_cut_id" field.
+func ActiveCutIDGTE(v int) predicate.Project {
+	return predicate.Project(sql.FieldGTE(FieldActiveCutID, v))
+}
+
+// ActiveCutIDLT applies the LT predicate on the "active_cut_id" field.
+func ActiveCutIDLT(v int) predicate.Project {
+	return predicate.Project(sql.FieldLT(FieldActiveCutID, v))
+}
+
+// ActiveCutIDLTE applies the LTE predicate on the "active_cut_id" field.
+func ActiveCutIDLTE(v int) predicate.Project {
+	return predicate.Project(sql.FieldLTE(FieldActiveCutID, v))
+}
+
+// ActiveCutIDIsNil applies the IsNil predicate on the "active_cut_id" field.
+func ActiveCutIDIsNil() predicate.Project {
+	return predicate.Project(sql.FieldIsNull(FieldActiveCutID))
+}
+
+// ActiveCutIDNotNil applies the NotNil predicate on the "active_cut_id" field.
+func ActiveCutIDNotNil() predicate.Project {
+	return predicate.Project(sql.FieldNotNull(FieldActiveCutID))
+}
+
+// DeletedAtEQ applies the EQ predicate on the "deleted_at" field.
+func DeletedAtEQ(v time.Time) predicate.Project {
+	return predicate.Project(sql.FieldEQ(FieldDeletedAt, v))
+}
+
+// DeletedAtNEQ applies the NEQ predicate on the "deleted_at" field.
+func DeletedAtNEQ(v time.Time) predicate.Project {
+	return predicate.Project(sql.FieldNEQ(FieldDeletedAt, v))
+}
+
+// DeletedAtIn applies the In predicate on the "deleted_at" field.
+func DeletedAtIn(vs ...time.Time) predicate.Project {
+	return predicate.Project(sql.FieldIn(FieldDeletedAt, vs...))
+}
+
+// DeletedAtNotIn applies the NotIn predicate on the "deleted_at" field.
+func DeletedAtNotIn(vs ...time.Time) predicate.Project {
+	return predicate.Project(sql.FieldNotIn(FieldDeletedAt, vs...))
+}
+
+// DeletedAtGT applies the GT predicate on the "deleted_at" field.
+func DeletedAtGT(v time.Time) predicate.Project {
+	return predicate.Project(sql.FieldGT(FieldDeletedAt, v))
+}
+
+// DeletedAtGTE applies the GTE predicate on the "deleted_at" field.
+func DeletedAtGTE(v time.Time) predicate.Project {
+	return predicate.Project(sql.FieldGTE(FieldDeletedAt, v))
+}
+
+// DeletedAtLT applies the LT predicate on the "deleted_at" field.
+func DeletedAtLT(v time.Time) predicate.Project {
+	return predicate.Project(sql.FieldLT(FieldDeletedAt, v))
+}
+
+// DeletedAtLTE applies the LTE predicate on the "deleted_at" field.
+func DeletedAtLTE(v time.Time) predicate.Project {
+	return predicate.Project(sql.FieldLTE(FieldDeletedAt, v))
+}
+
+// DeletedAtIsNil applies the IsNil predicate on the "deleted_at" field.
+func DeletedAtIsNil() predicate.Project {
+	return predicate.Project(sql.FieldIsNull(FieldDeletedAt))
+}
+
+// DeletedAtNotNil applies the NotNil predicate on the "deleted_at" field.
+func DeletedAtNotNil() predicate.Project {
+	return predicate.Project(sql.FieldNotNull(FieldDeletedAt))
+}
+
 // HasVideoClips applies the HasEdge predicate on the "video_clips" edge.
 func HasVideoClips() predicate.Project {
 	return predicate.Project(func(s *sql.Selector) {
@@ -1209,6 +1384,98 @@ func HasChatSessionsWith(preds ...predicate.ChatSession) predicate.Project {
 	})
 }
 
+// HasCuts applies the HasEdge predicate on the "cuts" edge.
+func HasCuts() predicate.Project {
+	return predicate.Project(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, CutsTable, CutsColumn),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasCutsWith applies the HasEdge predicate on the "cuts" edge with a given conditions (other predicates).
+func HasCutsWith(preds ...predicate.Cut) predicate.Project {
+	return predicate.Project(func(s *sql.Selector) {
+		step := newCutsStep()
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
+// HasPrompts applies the HasEdge predicate on the "prompts" edge.
+func HasPrompts() predicate.Project {
+	return predicate.Project(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, PromptsTable, PromptsColumn),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasPromptsWith applies the HasEdge predicate on the "prompts" edge with a given conditions (other predicates).
+func HasPromptsWith(preds ...predicate.Prompt) predicate.Project {
+	return predicate.Project(func(s *sql.Selector) {
+		step := newPromptsStep()
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
+// HasTags applies the HasEdge predicate on the "tags" edge.
+func HasTags() predicate.Project {
+	return predicate.Project(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.Edge(sqlgraph.M2M, true, TagsTable, TagsPrimaryKey...),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasTagsWith applies the HasEdge predicate on the "tags" edge with a given conditions (other predicates).
+func HasTagsWith(preds ...predicate.Tag) predicate.Project {
+	return predicate.Project(func(s *sql.Selector) {
+		step := newTagsStep()
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
+// HasCollections applies the HasEdge predicate on the "collections" edge.
+func HasCollections() predicate.Project {
+	return predicate.Project(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.Edge(sqlgraph.M2M, true, CollectionsTable, CollectionsPrimaryKey...),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasCollectionsWith applies the HasEdge predicate on the "collections" edge with a given conditions (other predicates).
+func HasCollectionsWith(preds ...predicate.Collection) predicate.Project {
+	return predicate.Project(func(s *sql.Selector) {
+		step := newCollectionsStep()
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
 // And groups predicates with the AND operator between them.
 func And(predicates ...predicate.Project) predicate.Project {
 	return predicate.Project(sql.AndPredicates(predicates...))
@@ -3,13 +3,18 @@
 package ent
 
 import (
+	"context"
+	"errors"
+	"fmt"
 	"ramble-ai/ent/chatsession"
+	"ramble-ai/ent/collection"
+	"ramble-ai/ent/cut"
 	"ramble-ai/ent/exportjob"
 	"ramble-ai/ent/project"
+	"ramble-ai/ent/prompt"
+	"ramble-ai/ent/schema"
+	"ramble-ai/ent/tag"
 	"ramble-ai/ent/videoclip"
-	"context"
-	"errors"
-	"fmt"
 	"time"
 
 	"entgo.io/ent/dialect/sql/sqlgraph"
@@ -125,6 +130,20 @@ func (pc *ProjectCreate) SetNillableAiSuggestionModel(s *string) *ProjectCreate
 	return pc
 }
 
+// SetAiSuggestionPromptID sets the "ai_suggestion_prompt_id" field.
+func (pc *ProjectCreate) SetAiSuggestionPromptID(i int) *ProjectCreate {
+	pc.mutation.SetAiSuggestionPromptID(i)
+	return pc
+}
+
+// SetNillableAiSuggestionPromptID sets the "ai_suggestion_prompt_id" field if the given value is not nil.
+func (pc *ProjectCreate) SetNillableAiSuggestionPromptID(i *int) *ProjectCreate {
+	if i != nil {
+		pc.SetAiSuggestionPromptID(*i)
+	}
+	return pc
+}
+
 // SetAiSuggestionCreatedAt sets the "ai_suggestion_created_at" field.
 func (pc *ProjectCreate) SetAiSuggestionCreatedAt(t time.Time) *ProjectCreate {
 	pc.mutation.SetAiSuggestionCreatedAt(t)
@@ -247,6 +266,40 @@ func (pc *ProjectCreate) SetHiddenHighlights(s []string) *ProjectCreate {
 	return pc
 }
 
+// SetExportPresets sets the "export_presets" field.
+func (pc *ProjectCreate) SetExportPresets(sp []schema.ExportPreset) *ProjectCreate {
+	pc.mutation.SetExportPresets(sp)
+	return pc
+}
+
+// SetActiveCutID sets the "active_cut_id" field.
+func (pc *ProjectCreate) SetActiveCutID(i int) *ProjectCreate {
+	pc.mutation.SetActiveCutID(i)
+	return pc
+}
+
+// SetNillableActiveCutID sets the "active_cut_id" field if the given value is not nil.
+func (pc *ProjectCreate) SetNillableActiveCutID(i *int) *ProjectCreate {
+	if i != nil {
+		pc.SetActiveCutID(*i)
+	}
+	return pc
+}
+
+// SetDeletedAt sets the "deleted_at" field.
+func (pc *ProjectCreate) SetDeletedAt(t time.Time) *ProjectCreate {
+	pc.mutation.SetDeletedAt(t)
+	return pc
+}
+
+// SetNillableDeletedAt sets the "deleted_at" field if the given value is not nil.
+func (pc *ProjectCreate) SetNillableDeletedAt(t *time.Time) *ProjectCreate {
+	if t != nil {
+		pc.SetDeletedAt(*t)
+	}
+	return pc
+}
+
 // AddVideoClipIDs adds the "video_clips" edge to the VideoClip entity by IDs.
 func (pc *ProjectCreate) AddVideoClipIDs(ids ...int) *ProjectCreate {
 	pc.mutation.AddVideoClipIDs(ids...)
@@ -292,6 +345,66 @@ func (pc *ProjectCreate) AddChatSessions(c ...*ChatSession) *ProjectCreate {
 	return pc.AddChatSessionIDs(ids...)
 }
 
+// AddCutIDs adds the "cuts" edge to the Cut entity by IDs.
+func (pc *ProjectCreate) AddCutIDs(ids ...int) *ProjectCreate {
+	pc.mutation.AddCutIDs(ids...)
+	return pc
+}
+
+// AddCuts adds the "cuts" edges to the Cut entity.
+func (pc *ProjectCreate) AddCuts(c ...*Cut) *ProjectCreate {
+	ids := make([]int, len(c))
+	for i := range c {
+		ids[i] = c[i].ID
+	}
+	return pc.AddCutIDs(ids...)
+}
+
+// AddPromptIDs adds the "prompts" edge to the Prompt entity by IDs.
+func (pc *ProjectCreate) AddPromptIDs(ids ...int) *ProjectCreate {
+	pc.mutation.AddPromptIDs(ids...)
+	return pc
+}
+
+// AddPrompts adds the "prompts" edges to the Prompt entity.
+func (pc *ProjectCreate) AddPrompts(p ...*Prompt) *ProjectCreate {
+	ids := make([]int, len(p))
+	for i := range p {
+		ids[i] = p[i].ID
+	}
+	return pc.AddPromptIDs(ids...)
+}
+
+// AddTagIDs adds the "tags" edge to the Tag entity by IDs.
+func (pc *ProjectCreate) AddTagIDs(ids ...int) *ProjectCreate {
+	pc.mutation.AddTagIDs(ids...)
+	return pc
+}
+
+// AddTags adds the "tags" edges to the Tag entity.
+func (pc *ProjectCreate) AddTags(t ...*Tag) *ProjectCreate {
+	ids := make([]int, len(t))
+	for i := range t {
+		ids[i] = t[i].ID
+	}
+	return pc.AddTagIDs(ids...)
+}
+
+// AddCollectionIDs adds the "collections" edge to the Collection entity by IDs.
+func (pc *ProjectCreate) AddCollectionIDs(ids ...int) *ProjectCreate {
+	pc.mutation.AddCollectionIDs(ids...)
+	return pc
+}
+
+// AddCollections adds the "collections" edges to the Collection entity.
+func (pc *ProjectCreate) AddCollections(c ...*Collection) *ProjectCreate {
+	ids := make([]int, len(c))
+	for i := range c {
+		ids[i] = c[i].ID
+	}
+	return pc.AddCollectionIDs(ids...)
+}
+
 // Mutation returns the ProjectMutation object of the builder.
 func (pc *ProjectCreate) Mutation() *ProjectMutation {
 	return pc.mutation
@@ -439,6 +552,10 @@ func (pc *ProjectCreate) createSpec() (*Project, *sqlgraph.CreateSpec) {
 		_spec.SetField(project.FieldAiSuggestionModel, field.TypeString, value)
 		_node.AiSuggestionModel = value
 	}
+	if value, ok := pc.mutation.AiSuggestionPromptID(); ok {
+		_spec.SetField(project.FieldAiSuggestionPromptID, field.TypeInt, value)
+		_node.AiSuggestionPromptID = value
+	}
 	if value, ok := pc.mutation.AiSuggestionCreatedAt(); ok {
 		_spec.SetField(project.FieldAiSuggestionCreatedAt, field.TypeTime, value)
 		_node.AiSuggestionCreatedAt = value
@@ -483,6 +600,18 @@ func (pc *ProjectCreate) createSpec() (*Project, *sqlgraph.CreateSpec) {
 		_spec.SetField(project.FieldHiddenHighlights, field.TypeJSON, value)
 		_node.HiddenHighlights = value
 	}
+	if value, ok := pc.mutation.ExportPresets(); ok {
+		_spec.SetField(project.FieldExportPresets, field.TypeJSON, value)
+		_node.ExportPresets = value
+	}
+	if value, ok := pc.mutation.ActiveCutID(); ok {
+		_spec.SetField(project.FieldActiveCutID, field.TypeInt, value)
+		_node.ActiveCutID = value
+	}
+	if value, ok := pc.mutation.DeletedAt(); ok {
+		_spec.SetField(project.FieldDeletedAt, field.TypeTime, value)
+		_node.DeletedAt = value
+	}
 	if nodes := pc.mutation.VideoClipsIDs(); len(nodes) > 0 {
 		edge := &sqlgraph.EdgeSpec{
 			Rel:     sqlgraph.O2M,
@@ -531,6 +660,70 @@ func (pc *ProjectCreate) createSpec() (*Project, *sqlgraph.CreateSpec) {
 		}
 		_spec.Edges = append(_spec.Edges, edge)
 	}
+	if nodes := pc.mutation.CutsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   project.CutsTable,
+			Columns: []string{project.CutsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(cut.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	if nodes := pc.mutation.PromptsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   project.PromptsTable,
+			Columns: []string{project.PromptsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(prompt.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	if nodes := pc.mutation.TagsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2M,
+			Inverse: true,
+			Table:   project.TagsTable,
+			Columns: project.TagsPrimaryKey,
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(tag.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	if nodes := pc.mutation.CollectionsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2M,
+			Inverse: true,
+			Table:   project.CollectionsTable,
+			Columns: project.CollectionsPrimaryKey,
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(collection.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges = append(_spec.Edges, edge)
+	}
 	return _node, _spec
 }
 
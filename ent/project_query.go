@@ -3,15 +3,19 @@
 package ent
 
 import (
+	"context"
+	"database/sql/driver"
+	"fmt"
+	"math"
 	"ramble-ai/ent/chatsession"
+	"ramble-ai/ent/collection"
+	"ramble-ai/ent/cut"
 	"ramble-ai/ent/exportjob"
 	"ramble-ai/ent/predicate"
 	"ramble-ai/ent/project"
+	"ramble-ai/ent/prompt"
+	"ramble-ai/ent/tag"
 	"ramble-ai/ent/videoclip"
-	"context"
-	"database/sql/driver"
-	"fmt"
-	"math"
 
 	"entgo.io/ent"
 	"entgo.io/ent/dialect/sql"
@@ -29,6 +33,10 @@ type ProjectQuery struct {
 	withVideoClips   *VideoClipQuery
 	withExportJobs   *ExportJobQuery
 	withChatSessions *ChatSessionQuery
+	withCuts         *CutQuery
+	withPrompts      *PromptQuery
+	withTags         *TagQuery
+	withCollections  *CollectionQuery
 	// intermediate query (i.e. traversal path).
 	sql  *sql.Selector
 	path func(context.Context) (*sql.Selector, error)
@@ -131,6 +139,94 @@ func (pq *ProjectQuery) QueryChatSessions() *ChatSessionQuery {
 	return query
 }
 
+// QueryCuts chains the current query on the "cuts" edge.
+func (pq *ProjectQuery) QueryCuts() *CutQuery {
+	query := (&CutClient{config: pq.config}).Query()
+	query.path = func(ctx context.Context) (fromU *sql.Selector, err error) {
+		if err := pq.prepareQuery(ctx); err != nil {
+			return nil, err
+		}
+		selector := pq.sqlQuery(ctx)
+		if err := selector.Err(); err != nil {
+			return nil, err
+		}
+		step := sqlgraph.NewStep(
+			sqlgraph.From(project.Table, project.FieldID, selector),
+			sqlgraph.To(cut.Table, cut.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, project.CutsTable, project.CutsColumn),
+		)
+		fromU = sqlgraph.SetNeighbors(pq.driver.Dialect(), step)
+		return fromU, nil
+	}
+	return query
+}
+
+// QueryPrompts chains the current query on the "prompts" edge.
+func (pq *ProjectQuery) QueryPrompts() *PromptQuery {
+	query := (&PromptClient{config: pq.config}).Query()
+	query.path = func(ctx context.Context) (fromU *sql.Selector, err error) {
+		if err := pq.prepareQuery(ctx); err != nil {
+			return nil, err
+		}
+		selector := pq.sqlQuery(ctx)
+		if err := selector.Err(); err != nil {
+			return nil, err
+		}
+		step := sqlgraph.NewStep(
+			sqlgraph.From(project.Table, project.FieldID, selector),
+			sqlgraph.To(prompt.Table, prompt.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, project.PromptsTable, project.PromptsColumn),
+		)
+		fromU = sqlgraph.SetNeighbors(pq.driver.Dialect(), step)
+		return fromU, nil
+	}
+	return query
+}
+
+// QueryTags chains the current query on the "tags" edge.
+func (pq *ProjectQuery) QueryTags() *TagQuery {
+	query := (&TagClient{config: pq.config}).Query()
+	query.path = func(ctx context.Context) (fromU *sql.Selector, err error) {
+		if err := pq.prepareQuery(ctx); err != nil {
+			return nil, err
+		}
+		selector := pq.sqlQuery(ctx)
+		if err := selector.Err(); err != nil {
+			return nil, err
+		}
+		step := sqlgraph.NewStep(
+			sqlgraph.From(project.Table, project.FieldID, selector),
+			sqlgraph.To(tag.Table, tag.FieldID),
+			sqlgraph.Edge(sqlgraph.M2M, true, project.TagsTable, project.TagsPrimaryKey...),
+		)
+		fromU = sqlgraph.SetNeighbors(pq.driver.Dialect(), step)
+		return fromU, nil
+	}
+	return query
+}
+
+// QueryCollections chains the current query on the "collections" edge.
+func (pq *ProjectQuery) QueryCollections() *CollectionQuery {
+	query := (&CollectionClient{config: pq.config}).Query()
+	query.path = func(ctx context.Context) (fromU *sql.Selector, err error) {
+		if err := pq.prepareQuery(ctx); err != nil {
+			return nil, err
+		}
+		selector := pq.sqlQuery(ctx)
+		if err := selector.Err(); err != nil {
+			return nil, err
+		}
+		step := sqlgraph.NewStep(
+			sqlgraph.From(project.Table, project.FieldID, selector),
+			sqlgraph.To(collection.Table, collection.FieldID),
+			sqlgraph.Edge(sqlgraph.M2M, true, project.CollectionsTable, project.CollectionsPrimaryKey...),
+		)
+		fromU = sqlgraph.SetNeighbors(pq.driver.Dialect(), step)
+		return fromU, nil
+	}
+	return query
+}
+
 // First returns the first Project entity from the query.
 // Returns a *NotFoundError when no Project was found.
 func (pq *ProjectQuery) First(ctx context.Context) (*Project, error) {
@@ -326,6 +422,10 @@ func (pq *ProjectQuery) Clone() *ProjectQuery {
 		withVideoClips:   pq.withVideoClips.Clone(),
 		withExportJobs:   pq.withExportJobs.Clone(),
 		withChatSessions: pq.withChatSessions.Clone(),
+		withCuts:         pq.withCuts.Clone(),
+		withPrompts:      pq.withPrompts.Clone(),
+		withTags:         pq.withTags.Clone(),
+		withCollections:  pq.withCollections.Clone(),
 		// clone intermediate query.
 		sql:  pq.sql.Clone(),
 		path: pq.path,
@@ -365,6 +465,50 @@ func (pq *ProjectQuery) WithChatSessions(opts ...func(*ChatSessionQuery)) *Proje
 	return pq
 }
 
+// WithCuts tells the query-builder to eager-load the nodes that are connected to
+// the "cuts" edge. The optional arguments are used to configure the query builder of the edge.
+func (pq *ProjectQuery) WithCuts(opts ...func(*CutQuery)) *ProjectQuery {
+	query := (&CutClient{config: pq.config}).Query()
+	for _, opt := range opts {
+		opt(query)
+	}
+	pq.withCuts = query
+	return pq
+}
+
+// WithPrompts tells the query-builder to eager-load the nodes that are connected to
+// the "prompts" edge. The optional arguments are used to configure the query builder of the edge.
+func (pq *ProjectQuery) WithPrompts(opts ...func(*PromptQuery)) *ProjectQuery {
+	query := (&PromptClient{config: pq.config}).Query()
+	for _, opt := range opts {
+		opt(query)
+	}
+	pq.withPrompts = query
+	return pq
+}
+
+// WithTags tells the query-builder to eager-load the nodes that are connected to
+// the "tags" edge. The optional arguments are used to configure the query builder of the edge.
+func (pq *ProjectQuery) WithTags(opts ...func(*TagQuery)) *ProjectQuery {
+	query := (&TagClient{config: pq.config}).Query()
+	for _, opt := range opts {
+		opt(query)
+	}
+	pq.withTags = query
+	return pq
+}
+
+// WithCollections tells the query-builder to eager-load the nodes that are connected to
+// the "collections" edge. The optional arguments are used to configure the query builder of the edge.
+func (pq *ProjectQuery) WithCollections(opts ...func(*CollectionQuery)) *ProjectQuery {
+	query := (&CollectionClient{config: pq.config}).Query()
+	for _, opt := range opts {
+		opt(query)
+	}
+	pq.withCollections = query
+	return pq
+}
+
 // GroupBy is used to group vertices by one or more fields/columns.
 // It is often used with aggregate functions, like: count, max, mean, min, sum.
 //
@@ -443,10 +587,14 @@ func (pq *ProjectQuery) sqlAll(ctx context.Context, hooks ...queryHook) ([]*Proj
 	var (
 		nodes       = []*Project{}
 		_spec       = pq.querySpec()
-		loadedTypes = [3]bool{
+		loadedTypes = [7]bool{
 			pq.withVideoClips != nil,
 			pq.withExportJobs != nil,
 			pq.withChatSessions != nil,
+			pq.withCuts != nil,
+			pq.withPrompts != nil,
+			pq.withTags != nil,
+			pq.withCollections != nil,
 		}
 	)
 	_spec.ScanValues = func(columns []string) ([]any, error) {
@@ -488,6 +636,34 @@ func (pq *ProjectQuery) sqlAll(ctx context.Context, hooks ...queryHook) ([]*Proj
 			return nil, err
 		}
 	}
+	if query := pq.withCuts; query != nil {
+		if err := pq.loadCuts(ctx, query, nodes,
+			func(n *Project) { n.Edges.Cuts = []*Cut{} },
+			func(n *Project, e *Cut) { n.Edges.Cuts = append(n.Edges.Cuts, e) }); err != nil {
+			return nil, err
+		}
+	}
+	if query := pq.withPrompts; query != nil {
+		if err := pq.loadPrompts(ctx, query, nodes,
+			func(n *Project) { n.Edges.Prompts = []*Prompt{} },
+			func(n *Project, e *Prompt) { n.Edges.Prompts = append(n.Edges.Prompts, e) }); err != nil {
+			return nil, err
+		}
+	}
+	if query := pq.withTags; query != nil {
+		if err := pq.loadTags(ctx, query, nodes,
+			func(n *Project) { n.Edges.Tags = []*Tag{} },
+			func(n *Project, e *Tag) { n.Edges.Tags = append(n.Edges.Tags, e) }); err != nil {
+			return nil, err
+		}
+	}
+	if query := pq.withCollections; query != nil {
+		if err := pq.loadCollections(ctx, query, nodes,
+			func(n *Project) { n.Edges.Collections = []*Collection{} },
+			func(n *Project, e *Collection) { n.Edges.Collections = append(n.Edges.Collections, e) }); err != nil {
+			return nil, err
+		}
+	}
 	return nodes, nil
 }
 
@@ -583,6 +759,188 @@ func (pq *ProjectQuery) loadChatSessions(ctx context.Context, query *ChatSession
 	}
 	return nil
 }
+func (pq *ProjectQuery) loadCuts(ctx context.Context, query *CutQuery, nodes []*Project, init func(*Project), assign func(*Project, *Cut)) error {
+	fks := make([]driver.Value, 0, len(nodes))
+	nodeids := make(map[int]*Project)
+	for i := range nodes {
+		fks = append(fks, nodes[i].ID)
+		nodeids[nodes[i].ID] = nodes[i]
+		if init != nil {
+			init(nodes[i])
+		}
+	}
+	if len(query.ctx.Fields) > 0 {
+		query.ctx.AppendFieldOnce(cut.FieldProjectID)
+	}
+	query.Where(predicate.Cut(func(s *sql.Selector) {
+		s.Where(sql.InValues(s.C(project.CutsColumn), fks...))
+	}))
+	neighbors, err := query.All(ctx)
+	if err != nil {
+		return err
+	}
+	for _, n := range neighbors {
+		fk := n.ProjectID
+		node, ok := nodeids[fk]
+		if !ok {
+			return fmt.Errorf(`unexpected referenced foreign-key "project_id" returned %v for node %v`, fk, n.ID)
+		}
+		assign(node, n)
+	}
+	return nil
+}
+func (pq *ProjectQuery) loadPrompts(ctx context.Context, query *PromptQuery, nodes []*Project, init func(*Project), assign func(*Project, *Prompt)) error {
+	fks := make([]driver.Value, 0, len(nodes))
+	nodeids := make(map[int]*Project)
+	for i := range nodes {
+		fks = append(fks, nodes[i].ID)
+		nodeids[nodes[i].ID] = nodes[i]
+		if init != nil {
+			init(nodes[i])
+		}
+	}
+	if len(query.ctx.Fields) > 0 {
+		query.ctx.AppendFieldOnce(prompt.FieldProjectID)
+	}
+	query.Where(predicate.Prompt(func(s *sql.Selector) {
+		s.Where(sql.InValues(s.C(project.PromptsColumn), fks...))
+	}))
+	neighbors, err := query.All(ctx)
+	if err != nil {
+		return err
+	}
+	for _, n := range neighbors {
+		fk := n.ProjectID
+		node, ok := nodeids[fk]
+		if !ok {
+			return fmt.Errorf(`unexpected referenced foreign-key "project_id" returned %v for node %v`, fk, n.ID)
+		}
+		assign(node, n)
+	}
+	return nil
+}
+func (pq *ProjectQuery) loadTags(ctx context.Context, query *TagQuery, nodes []*Project, init func(*Project), assign func(*Project, *Tag)) error {
+	edgeIDs := make([]driver.Value, len(nodes))
+	byID := make(map[int]*Project)
+	nids := make(map[int]map[*Project]struct{})
+	for i, node := range nodes {
+		edgeIDs[i] = node.ID
+		byID[node.ID] = node
+		if init != nil {
+			init(node)
+		}
+	}
+	query.Where(func(s *sql.Selector) {
+		joinT := sql.Table(project.TagsTable)
+		s.Join(joinT).On(s.C(tag.FieldID), joinT.C(project.TagsPrimaryKey[0]))
+		s.Where(sql.InValues(joinT.C(project.TagsPrimaryKey[1]), edgeIDs...))
+		columns := s.SelectedColumns()
+		s.Select(joinT.C(project.TagsPrimaryKey[1]))
+		s.AppendSelect(columns...)
+		s.SetDistinct(false)
+	})
+	if err := query.prepareQuery(ctx); err != nil {
+		return err
+	}
+	qr := QuerierFunc(func(ctx context.Context, q Query) (Value, error) {
+		return query.sqlAll(ctx, func(_ context.Context, spec *sqlgraph.QuerySpec) {
+			assign := spec.Assign
+			values := spec.ScanValues
+			spec.ScanValues = func(columns []string) ([]any, error) {
+				values, err := values(columns[1:])
+				if err != nil {
+					return nil, err
+				}
+				return append([]any{new(sql.NullInt64)}, values...), nil
+			}
+			spec.Assign = func(columns []string, values []any) error {
+				outValue := int(values[0].(*sql.NullInt64).Int64)
+				inValue := int(values[1].(*sql.NullInt64).Int64)
+				if nids[inValue] == nil {
+					nids[inValue] = map[*Project]struct{}{byID[outValue]: {}}
+					return assign(columns[1:], values[1:])
+				}
+				nids[inValue][byID[outValue]] = struct{}{}
+				return nil
+			}
+		})
+	})
+	neighbors, err := withInterceptors[[]*Tag](ctx, query, qr, query.inters)
+	if err != nil {
+		return err
+	}
+	for _, n := range neighbors {
+		nodes, ok := nids[n.ID]
+		if !ok {
+			return fmt.Errorf(`unexpected "tags" node returned %v`, n.ID)
+		}
+		for kn := range nodes {
+			assign(kn, n)
+		}
+	}
+	return nil
+}
+func (pq *ProjectQuery) loadCollections(ctx context.Context, query *CollectionQuery, nodes []*Project, init func(*Project), assign func(*Project, *Collection)) error {
+	edgeIDs := make([]driver.Value, len(nodes))
+	byID := make(map[int]*Project)
+	nids := make(map[int]map[*Project]struct{})
+	for i, node := range nodes {
+		edgeIDs[i] = node.ID
+		byID[node.ID] = node
+		if init != nil {
+			init(node)
+		}
+	}
+	query.Where(func(s *sql.Selector) {
+		joinT := sql.Table(project.CollectionsTable)
+		s.Join(joinT).On(s.C(collection.FieldID), joinT.C(project.CollectionsPrimaryKey[0]))
+		s.Where(sql.InValues(joinT.C(project.CollectionsPrimaryKey[1]), edgeIDs...))
+		columns := s.SelectedColumns()
+		s.Select(joinT.C(project.CollectionsPrimaryKey[1]))
+		s.AppendSelect(columns...)
+		s.SetDistinct(false)
+	})
+	if err := query.prepareQuery(ctx); err != nil {
+		return err
+	}
+	qr := QuerierFunc(func(ctx context.Context, q Query) (Value, error) {
+		return query.sqlAll(ctx, func(_ context.Context, spec *sqlgraph.QuerySpec) {
+			assign := spec.Assign
+			values := spec.ScanValues
+			spec.ScanValues = func(columns []string) ([]any, error) {
+				values, err := values(columns[1:])
+				if err != nil {
+					return nil, err
+				}
+				return append([]any{new(sql.NullInt64)}, values...), nil
+			}
+			spec.Assign = func(columns []string, values []any) error {
+				outValue := int(values[0].(*sql.NullInt64).Int64)
+				inValue := int(values[1].(*sql.NullInt64).Int64)
+				if nids[inValue] == nil {
+					nids[inValue] = map[*Project]struct{}{byID[outValue]: {}}
+					return assign(columns[1:], values[1:])
+				}
+				nids[inValue][byID[outValue]] = struct{}{}
+				return nil
+			}
+		})
+	})
+	neighbors, err := withInterceptors[[]*Collection](ctx, query, qr, query.inters)
+	if err != nil {
+		return err
+	}
+	for _, n := range neighbors {
+		nodes, ok := nids[n.ID]
+		if !ok {
+			return fmt.Errorf(`unexpected "collections" node returned %v`, n.ID)
+		}
+		for kn := range nodes {
+			assign(kn, n)
+		}
+	}
+	return nil
+}
 
 func (pq *ProjectQuery) sqlCount(ctx context.Context) (int, error) {
 	_spec := pq.querySpec()
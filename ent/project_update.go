@@ -3,14 +3,19 @@
 package ent
 
 import (
+	"context"
+	"errors"
+	"fmt"
 	"ramble-ai/ent/chatsession"
+	"ramble-ai/ent/collection"
+	"ramble-ai/ent/cut"
 	"ramble-ai/ent/exportjob"
 	"ramble-ai/ent/predicate"
 	"ramble-ai/ent/project"
+	"ramble-ai/ent/prompt"
+	"ramble-ai/ent/schema"
+	"ramble-ai/ent/tag"
 	"ramble-ai/ent/videoclip"
-	"context"
-	"errors"
-	"fmt"
 	"time"
 
 	"entgo.io/ent/dialect/sql"
@@ -178,6 +183,33 @@ func (pu *ProjectUpdate) ClearAiSuggestionModel() *ProjectUpdate {
 	return pu
 }
 
+// SetAiSuggestionPromptID sets the "ai_suggestion_prompt_id" field.
+func (pu *ProjectUpdate) SetAiSuggestionPromptID(i int) *ProjectUpdate {
+	pu.mutation.ResetAiSuggestionPromptID()
+	pu.mutation.SetAiSuggestionPromptID(i)
+	return pu
+}
+
+// SetNillableAiSuggestionPromptID sets the "ai_suggestion_prompt_id" field if the given value is not nil.
+func (pu *ProjectUpdate) SetNillableAiSuggestionPromptID(i *int) *ProjectUpdate {
+	if i != nil {
+		pu.SetAiSuggestionPromptID(*i)
+	}
+	return pu
+}
+
+// AddAiSuggestionPromptID adds i to the "ai_suggestion_prompt_id" field.
+func (pu *ProjectUpdate) AddAiSuggestionPromptID(i int) *ProjectUpdate {
+	pu.mutation.AddAiSuggestionPromptID(i)
+	return pu
+}
+
+// ClearAiSuggestionPromptID clears the value of the "ai_suggestion_prompt_id" field.
+func (pu *ProjectUpdate) ClearAiSuggestionPromptID() *ProjectUpdate {
+	pu.mutation.ClearAiSuggestionPromptID()
+	return pu
+}
+
 // SetAiSuggestionCreatedAt sets the "ai_suggestion_created_at" field.
 func (pu *ProjectUpdate) SetAiSuggestionCreatedAt(t time.Time) *ProjectUpdate {
 	pu.mutation.SetAiSuggestionCreatedAt(t)
@@ -397,6 +429,71 @@ func (pu *ProjectUpdate) ClearHiddenHighlights() *ProjectUpdate {
 	return pu
 }
 
+// SetExportPresets sets the "export_presets" field.
+func (pu *ProjectUpdate) SetExportPresets(sp []schema.ExportPreset) *ProjectUpdate {
+	pu.mutation.SetExportPresets(sp)
+	return pu
+}
+
+// AppendExportPresets appends sp to the "export_presets" field.
+func (pu *ProjectUpdate) AppendExportPresets(sp []schema.ExportPreset) *ProjectUpdate {
+	pu.mutation.AppendExportPresets(sp)
+	return pu
+}
+
+// ClearExportPresets clears the value of the "export_presets" field.
+func (pu *ProjectUpdate) ClearExportPresets() *ProjectUpdate {
+	pu.mutation.ClearExportPresets()
+	return pu
+}
+
+// SetActiveCutID sets the "active_cut_id" field.
+func (pu *ProjectUpdate) SetActiveCutID(i int) *ProjectUpdate {
+	pu.mutation.ResetActiveCutID()
+	pu.mutation.SetActiveCutID(i)
+	return pu
+}
+
+// SetNillableActiveCutID sets the "active_cut_id" field if the given value is not nil.
+func (pu *ProjectUpdate) SetNillableActiveCutID(i *int) *ProjectUpdate {
+	if i != nil {
+		pu.SetActiveCutID(*i)
+	}
+	return pu
+}
+
+// AddActiveCutID adds i to the "active_cut_id" field.
+func (pu *ProjectUpdate) AddActiveCutID(i int) *ProjectUpdate {
+	pu.mutation.AddActiveCutID(i)
+	return pu
+}
+
+// ClearActiveCutID clears the value of the "active_cut_id" field.
+func (pu *ProjectUpdate) ClearActiveCutID() *ProjectUpdate {
+	pu.mutation.ClearActiveCutID()
+	return pu
+}
+
+// SetDeletedAt sets the "deleted_at" field.
+func (pu *ProjectUpdate) SetDeletedAt(t time.Time) *ProjectUpdate {
+	pu.mutation.SetDeletedAt(t)
+	return pu
+}
+
+// SetNillableDeletedAt sets the "deleted_at" field if the given value is not nil.
+func (pu *ProjectUpdate) SetNillableDeletedAt(t *time.Time) *ProjectUpdate {
+	if t != nil {
+		pu.SetDeletedAt(*t)
+	}
+	return pu
+}
+
+// ClearDeletedAt clears the value of the "deleted_at" field.
+func (pu *ProjectUpdate) ClearDeletedAt() *ProjectUpdate {
+	pu.mutation.ClearDeletedAt()
+	return pu
+}
+
 // AddVideoClipIDs adds the "video_clips" edge to the VideoClip entity by IDs.
 func (pu *ProjectUpdate) AddVideoClipIDs(ids ...int) *ProjectUpdate {
 	pu.mutation.AddVideoClipIDs(ids...)
@@ -442,6 +539,66 @@ func (pu *ProjectUpdate) AddChatSessions(c ...*ChatSession) *ProjectUpdate {
 	return pu.AddChatSessionIDs(ids...)
 }
 
+// AddCutIDs adds the "cuts" edge to the Cut entity by IDs.
+func (pu *ProjectUpdate) AddCutIDs(ids ...int) *ProjectUpdate {
+	pu.mutation.AddCutIDs(ids...)
+	return pu
+}
+
+// AddCuts adds the "cuts" edges to the Cut entity.
+func (pu *ProjectUpdate) AddCuts(c ...*Cut) *ProjectUpdate {
+	ids := make([]int, len(c))
+	for i := range c {
+		ids[i] = c[i].ID
+	}
+	return pu.AddCutIDs(ids...)
+}
+
+// AddPromptIDs adds the "prompts" edge to the Prompt entity by IDs.
+func (pu *ProjectUpdate) AddPromptIDs(ids ...int) *ProjectUpdate {
+	pu.mutation.AddPromptIDs(ids...)
+	return pu
+}
+
+// AddPrompts adds the "prompts" edges to the Prompt entity.
+func (pu *ProjectUpdate) AddPrompts(p ...*Prompt) *ProjectUpdate {
+	ids := make([]int, len(p))
+	for i := range p {
+		ids[i] = p[i].ID
+	}
+	return pu.AddPromptIDs(ids...)
+}
+
+// AddTagIDs adds the "tags" edge to the Tag entity by IDs.
+func (pu *ProjectUpdate) AddTagIDs(ids ...int) *ProjectUpdate {
+	pu.mutation.AddTagIDs(ids...)
+	return pu
+}
+
+// AddTags adds the "tags" edges to the Tag entity.
+func (pu *ProjectUpdate) AddTags(t ...*Tag) *ProjectUpdate {
+	ids := make([]int, len(t))
+	for i := range t {
+		ids[i] = t[i].ID
+	}
+	return pu.AddTagIDs(ids...)
+}
+
+// AddCollectionIDs adds the "collections" edge to the Collection entity by IDs.
+func (pu *ProjectUpdate) AddCollectionIDs(ids ...int) *ProjectUpdate {
+	pu.mutation.AddCollectionIDs(ids...)
+	return pu
+}
+
+// AddCollections adds the "collections" edges to the Collection entity.
+func (pu *ProjectUpdate) AddCollections(c ...*Collection) *ProjectUpdate {
+	ids := make([]int, len(c))
+	for i := range c {
+		ids[i] = c[i].ID
+	}
+	return pu.AddCollectionIDs(ids...)
+}
+
 // Mutation returns the ProjectMutation object of the builder.
 func (pu *ProjectUpdate) Mutation() *ProjectMutation {
 	return pu.mutation
@@ -510,6 +667,90 @@ func (pu *ProjectUpdate) RemoveChatSessions(c ...*ChatSession) *ProjectUpdate {
 	return pu.RemoveChatSessionIDs(ids...)
 }
 
+// ClearCuts clears all "cuts" edges to the Cut entity.
+func (pu *ProjectUpdate) ClearCuts() *ProjectUpdate {
+	pu.mutation.ClearCuts()
+	return pu
+}
+
+// RemoveCutIDs removes the "cuts" edge to Cut entities by IDs.
+func (pu *ProjectUpdate) RemoveCutIDs(ids ...int) *ProjectUpdate {
+	pu.mutation.RemoveCutIDs(ids...)
+	return pu
+}
+
+// RemoveCuts removes "cuts" edges to Cut entities.
+func (pu *ProjectUpdate) RemoveCuts(c ...*Cut) *ProjectUpdate {
+	ids := make([]int, len(c))
+	for i := range c {
+		ids[i] = c[i].ID
+	}
+	return pu.RemoveCutIDs(ids...)
+}
+
+// ClearPrompts clears all "prompts" edges to the Prompt entity.
+func (pu *ProjectUpdate) ClearPrompts() *ProjectUpdate {
+	pu.mutation.ClearPrompts()
+	return pu
+}
+
+// RemovePromptIDs removes the "prompts" edge to Prompt entities by IDs.
+func (pu *ProjectUpdate) RemovePromptIDs(ids ...int) *ProjectUpdate {
+	pu.mutation.RemovePromptIDs(ids...)
+	return pu
+}
+
+// RemovePrompts removes "prompts" edges to Prompt entities.
+func (pu *ProjectUpdate) RemovePrompts(p ...*Prompt) *ProjectUpdate {
+	ids := make([]int, len(p))
+	for i := range p {
+		ids[i] = p[i].ID
+	}
+	return pu.RemovePromptIDs(ids...)
+}
+
+// ClearTags clears all "tags" edges to the Tag entity.
+func (pu *ProjectUpdate) ClearTags() *ProjectUpdate {
+	pu.mutation.ClearTags()
+	return pu
+}
+
+// RemoveTagIDs removes the "tags" edge to Tag entities by IDs.
+func (pu *ProjectUpdate) RemoveTagIDs(ids ...int) *ProjectUpdate {
+	pu.mutation.RemoveTagIDs(ids...)
+	return pu
+}
+
+// RemoveTags removes "tags" edges to Tag entities.
+func (pu *ProjectUpdate) RemoveTags(t ...*Tag) *ProjectUpdate {
+	ids := make([]int, len(t))
+	for i := range t {
+		ids[i] = t[i].ID
+	}
+	return pu.RemoveTagIDs(ids...)
+}
+
+// ClearCollections clears all "collections" edges to the Collection entity.
+func (pu *ProjectUpdate) ClearCollections() *ProjectUpdate {
+	pu.mutation.ClearCollections()
+	return pu
+}
+
+// RemoveCollectionIDs removes the "collections" edge to Collection entities by IDs.
+func (pu *ProjectUpdate) RemoveCollectionIDs(ids ...int) *ProjectUpdate {
+	pu.mutation.RemoveCollectionIDs(ids...)
+	return pu
+}
+
+// RemoveCollections removes "collections" edges to Collection entities.
+func (pu *ProjectUpdate) RemoveCollections(c ...*Collection) *ProjectUpdate {
+	ids := make([]int, len(c))
+	for i := range c {
+		ids[i] = c[i].ID
+	}
+	return pu.RemoveCollectionIDs(ids...)
+}
+
 // Save executes the query and returns the number of nodes affected by the update operation.
 func (pu *ProjectUpdate) Save(ctx context.Context) (int, error) {
 	pu.defaults()
@@ -620,6 +861,15 @@ func (pu *ProjectUpdate) sqlSave(ctx context.Context) (n int, err error) {
 	if pu.mutation.AiSuggestionModelCleared() {
 		_spec.ClearField(project.FieldAiSuggestionModel, field.TypeString)
 	}
+	if value, ok := pu.mutation.AiSuggestionPromptID(); ok {
+		_spec.SetField(project.FieldAiSuggestionPromptID, field.TypeInt, value)
+	}
+	if value, ok := pu.mutation.AddedAiSuggestionPromptID(); ok {
+		_spec.AddField(project.FieldAiSuggestionPromptID, field.TypeInt, value)
+	}
+	if pu.mutation.AiSuggestionPromptIDCleared() {
+		_spec.ClearField(project.FieldAiSuggestionPromptID, field.TypeInt)
+	}
 	if value, ok := pu.mutation.AiSuggestionCreatedAt(); ok {
 		_spec.SetField(project.FieldAiSuggestionCreatedAt, field.TypeTime, value)
 	}
@@ -709,6 +959,32 @@ func (pu *ProjectUpdate) sqlSave(ctx context.Context) (n int, err error) {
 	if pu.mutation.HiddenHighlightsCleared() {
 		_spec.ClearField(project.FieldHiddenHighlights, field.TypeJSON)
 	}
+	if value, ok := pu.mutation.ExportPresets(); ok {
+		_spec.SetField(project.FieldExportPresets, field.TypeJSON, value)
+	}
+	if value, ok := pu.mutation.AppendedExportPresets(); ok {
+		_spec.AddModifier(func(u *sql.UpdateBuilder) {
+			sqljson.Append(u, project.FieldExportPresets, value)
+		})
+	}
+	if pu.mutation.ExportPresetsCleared() {
+		_spec.ClearField(project.FieldExportPresets, field.TypeJSON)
+	}
+	if value, ok := pu.mutation.ActiveCutID(); ok {
+		_spec.SetField(project.FieldActiveCutID, field.TypeInt, value)
+	}
+	if value, ok := pu.mutation.AddedActiveCutID(); ok {
+		_spec.AddField(project.FieldActiveCutID, field.TypeInt, value)
+	}
+	if pu.mutation.ActiveCutIDCleared() {
+		_spec.ClearField(project.FieldActiveCutID, field.TypeInt)
+	}
+	if value, ok := pu.mutation.DeletedAt(); ok {
+		_spec.SetField(project.FieldDeletedAt, field.TypeTime, value)
+	}
+	if pu.mutation.DeletedAtCleared() {
+		_spec.ClearField(project.FieldDeletedAt, field.TypeTime)
+	}
 	if pu.mutation.VideoClipsCleared() {
 		edge := &sqlgraph.EdgeSpec{
 			Rel:     sqlgraph.O2M,
@@ -844,6 +1120,186 @@ func (pu *ProjectUpdate) sqlSave(ctx context.Context) (n int, err error) {
 		}
 		_spec.Edges.Add = append(_spec.Edges.Add, edge)
 	}
+	if pu.mutation.CutsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   project.CutsTable,
+			Columns: []string{project.CutsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(cut.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := pu.mutation.RemovedCutsIDs(); len(nodes) > 0 && !pu.mutation.CutsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   project.CutsTable,
+			Columns: []string{project.CutsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(cut.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := pu.mutation.CutsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   project.CutsTable,
+			Columns: []string{project.CutsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(cut.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if pu.mutation.PromptsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   project.PromptsTable,
+			Columns: []string{project.PromptsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(prompt.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := pu.mutation.RemovedPromptsIDs(); len(nodes) > 0 && !pu.mutation.PromptsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   project.PromptsTable,
+			Columns: []string{project.PromptsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(prompt.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := pu.mutation.PromptsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   project.PromptsTable,
+			Columns: []string{project.PromptsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(prompt.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if pu.mutation.TagsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2M,
+			Inverse: true,
+			Table:   project.TagsTable,
+			Columns: project.TagsPrimaryKey,
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(tag.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := pu.mutation.RemovedTagsIDs(); len(nodes) > 0 && !pu.mutation.TagsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2M,
+			Inverse: true,
+			Table:   project.TagsTable,
+			Columns: project.TagsPrimaryKey,
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(tag.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := pu.mutation.TagsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2M,
+			Inverse: true,
+			Table:   project.TagsTable,
+			Columns: project.TagsPrimaryKey,
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(tag.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if pu.mutation.CollectionsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2M,
+			Inverse: true,
+			Table:   project.CollectionsTable,
+			Columns: project.CollectionsPrimaryKey,
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(collection.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := pu.mutation.RemovedCollectionsIDs(); len(nodes) > 0 && !pu.mutation.CollectionsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2M,
+			Inverse: true,
+			Table:   project.CollectionsTable,
+			Columns: project.CollectionsPrimaryKey,
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(collection.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := pu.mutation.CollectionsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2M,
+			Inverse: true,
+			Table:   project.CollectionsTable,
+			Columns: project.CollectionsPrimaryKey,
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(collection.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
 	if n, err = sqlgraph.UpdateNodes(ctx, pu.driver, _spec); err != nil {
 		if _, ok := err.(*sqlgraph.NotFoundError); ok {
 			err = &NotFoundError{project.Label}
@@ -1010,6 +1466,33 @@ func (puo *ProjectUpdateOne) ClearAiSuggestionModel() *ProjectUpdateOne {
 	return puo
 }
 
+// SetAiSuggestionPromptID sets the "ai_suggestion_prompt_id" field.
+func (puo *ProjectUpdateOne) SetAiSuggestionPromptID(i int) *ProjectUpdateOne {
+	puo.mutation.ResetAiSuggestionPromptID()
+	puo.mutation.SetAiSuggestionPromptID(i)
+	return puo
+}
+
+// SetNillableAiSuggestionPromptID sets the "ai_suggestion_prompt_id" field if the given value is not nil.
+func (puo *ProjectUpdateOne) SetNillableAiSuggestionPromptID(i *int) *ProjectUpdateOne {
+	if i != nil {
+		puo.SetAiSuggestionPromptID(*i)
+	}
+	return puo
+}
+
+// AddAiSuggestionPromptID adds i to the "ai_suggestion_prompt_id" field.
+func (puo *ProjectUpdateOne) AddAiSuggestionPromptID(i int) *ProjectUpdateOne {
+	puo.mutation.AddAiSuggestionPromptID(i)
+	return puo
+}
+
+// ClearAiSuggestionPromptID clears the value of the "ai_suggestion_prompt_id" field.
+func (puo *ProjectUpdateOne) ClearAiSuggestionPromptID() *ProjectUpdateOne {
+	puo.mutation.ClearAiSuggestionPromptID()
+	return puo
+}
+
 // SetAiSuggestionCreatedAt sets the "ai_suggestion_created_at" field.
 func (puo *ProjectUpdateOne) SetAiSuggestionCreatedAt(t time.Time) *ProjectUpdateOne {
 	puo.mutation.SetAiSuggestionCreatedAt(t)
@@ -1229,6 +1712,71 @@ func (puo *ProjectUpdateOne) ClearHiddenHighlights() *ProjectUpdateOne {
 	return puo
 }
 
+// SetExportPresets sets the "export_presets" field.
+func (puo *ProjectUpdateOne) SetExportPresets(sp []schema.ExportPreset) *ProjectUpdateOne {
+	puo.mutation.SetExportPresets(sp)
+	return puo
+}
+
+// AppendExportPresets appends sp to the "export_presets" field.
+func (puo *ProjectUpdateOne) AppendExportPresets(sp []schema.ExportPreset) *ProjectUpdateOne {
+	puo.mutation.AppendExportPresets(sp)
+	return puo
+}
+
+// ClearExportPresets clears the value of the "export_presets" field.
+func (puo *ProjectUpdateOne) ClearExportPresets() *ProjectUpdateOne {
+	puo.mutation.ClearExportPresets()
+	return puo
+}
+
+// SetActiveCutID sets the "active_cut_id" field.
+func (puo *ProjectUpdateOne) SetActiveCutID(i int) *ProjectUpdateOne {
+	puo.mutation.ResetActiveCutID()
+	puo.mutation.SetActiveCutID(i)
+	return puo
+}
+
+// SetNillableActiveCutID sets the "active_cut_id" field if the given value is not nil.
+func (puo *ProjectUpdateOne) SetNillableActiveCutID(i *int) *ProjectUpdateOne {
+	if i != nil {
+		puo.SetActiveCutID(*i)
+	}
+	return puo
+}
+
+// AddActiveCutID adds i to the "active_cut_id" field.
+func (puo *ProjectUpdateOne) AddActiveCutID(i int) *ProjectUpdateOne {
+	puo.mutation.AddActiveCutID(i)
+	return puo
+}
+
+// ClearActiveCutID clears the value of the "active_cut_id" field.
+func (puo *ProjectUpdateOne) ClearActiveCutID() *ProjectUpdateOne {
+	puo.mutation.ClearActiveCutID()
+	return puo
+}
+
+// SetDeletedAt sets the "deleted_at" field.
+func (puo *ProjectUpdateOne) SetDeletedAt(t time.Time) *ProjectUpdateOne {
+	puo.mutation.SetDeletedAt(t)
+	return puo
+}
+
+// SetNillableDeletedAt sets the "deleted_at" field if the given value is not nil.
+func (puo *ProjectUpdateOne) SetNillableDeletedAt(t *time.Time) *ProjectUpdateOne {
+	if t != nil {
+		puo.SetDeletedAt(*t)
+	}
+	return puo
+}
+
+// ClearDeletedAt clears the value of the "deleted_at" field.
+func (puo *ProjectUpdateOne) ClearDeletedAt() *ProjectUpdateOne {
+	puo.mutation.ClearDeletedAt()
+	return puo
+}
+
 // AddVideoClipIDs adds the "video_clips" edge to the VideoClip entity by IDs.
 func (puo *ProjectUpdateOne) AddVideoClipIDs(ids ...int) *ProjectUpdateOne {
 	puo.mutation.AddVideoClipIDs(ids...)
@@ -1274,6 +1822,66 @@ func (puo *ProjectUpdateOne) AddChatSessions(c ...*ChatSession) *ProjectUpdateOn
 	return puo.AddChatSessionIDs(ids...)
 }
 
+// AddCutIDs adds the "cuts" edge to the Cut entity by IDs.
+func (puo *ProjectUpdateOne) AddCutIDs(ids ...int) *ProjectUpdateOne {
+	puo.mutation.AddCutIDs(ids...)
+	return puo
+}
+
+// AddCuts adds the "cuts" edges to the Cut entity.
+func (puo *ProjectUpdateOne) AddCuts(c ...*Cut) *ProjectUpdateOne {
+	ids := make([]int, len(c))
+	for i := range c {
+		ids[i] = c[i].ID
+	}
+	return puo.AddCutIDs(ids...)
+}
+
+// AddPromptIDs adds the "prompts" edge to the Prompt entity by IDs.
+func (puo *ProjectUpdateOne) AddPromptIDs(ids ...int) *ProjectUpdateOne {
+	puo.mutation.AddPromptIDs(ids...)
+	return puo
+}
+
+// AddPrompts adds the "prompts" edges to the Prompt entity.
+func (puo *ProjectUpdateOne) AddPrompts(p ...*Prompt) *ProjectUpdateOne {
+	ids := make([]int, len(p))
+	for i := range p {
+		ids[i] = p[i].ID
+	}
+	return puo.AddPromptIDs(ids...)
+}
+
+// AddTagIDs adds the "tags" edge to the Tag entity by IDs.
+func (puo *ProjectUpdateOne) AddTagIDs(ids ...int) *ProjectUpdateOne {
+	puo.mutation.AddTagIDs(ids...)
+	return puo
+}
+
+// AddTags adds the "tags" edges to the Tag entity.
+func (puo *ProjectUpdateOne) AddTags(t ...*Tag) *ProjectUpdateOne {
+	ids := make([]int, len(t))
+	for i := range t {
+		ids[i] = t[i].ID
+	}
+	return puo.AddTagIDs(ids...)
+}
+
+// AddCollectionIDs adds the "collections" edge to the Collection entity by IDs.
+func (puo *ProjectUpdateOne) AddCollectionIDs(ids ...int) *ProjectUpdateOne {
+	puo.mutation.AddCollectionIDs(ids...)
+	return puo
+}
+
+// AddCollections adds the "collections" edges to the Collection entity.
+func (puo *ProjectUpdateOne) AddCollections(c ...*Collection) *ProjectUpdateOne {
+	ids := make([]int, len(c))
+	for i := range c {
+		ids[i] = c[i].ID
+	}
+	return puo.AddCollectionIDs(ids...)
+}
+
 // Mutation returns the ProjectMutation object of the builder.
 func (puo *ProjectUpdateOne) Mutation() *ProjectMutation {
 	return puo.mutation
@@ -1342,6 +1950,90 @@ func (puo *ProjectUpdateOne) RemoveChatSessions(c ...*ChatSession) *ProjectUpdat
 	return puo.RemoveChatSessionIDs(ids...)
 }
 
+// ClearCuts clears all "cuts" edges to the Cut entity.
+func (puo *ProjectUpdateOne) ClearCuts() *ProjectUpdateOne {
+	puo.mutation.ClearCuts()
+	return puo
+}
+
+// RemoveCutIDs removes the "cuts" edge to Cut entities by IDs.
+func (puo *ProjectUpdateOne) RemoveCutIDs(ids ...int) *ProjectUpdateOne {
+	puo.mutation.RemoveCutIDs(ids...)
+	return puo
+}
+
+// RemoveCuts removes "cuts" edges to Cut entities.
+func (puo *ProjectUpdateOne) RemoveCuts(c ...*Cut) *ProjectUpdateOne {
+	ids := make([]int, len(c))
+	for i := range c {
+		ids[i] = c[i].ID
+	}
+	return puo.RemoveCutIDs(ids...)
+}
+
+// ClearPrompts clears all "prompts" edges to the Prompt entity.
+func (puo *ProjectUpdateOne) ClearPrompts() *ProjectUpdateOne {
+	puo.mutation.ClearPrompts()
+	return puo
+}
+
+// RemovePromptIDs removes the "prompts" edge to Prompt entities by IDs.
+func (puo *ProjectUpdateOne) RemovePromptIDs(ids ...int) *ProjectUpdateOne {
+	puo.mutation.RemovePromptIDs(ids...)
+	return puo
+}
+
+// RemovePrompts removes "prompts" edges to Prompt entities.
+func (puo *ProjectUpdateOne) RemovePrompts(p ...*Prompt) *ProjectUpdateOne {
+	ids := make([]int, len(p))
+	for i := range p {
+		ids[i] = p[i].ID
+	}
+	return puo.RemovePromptIDs(ids...)
+}
+
+// ClearTags clears all "tags" edges to the Tag entity.
+func (puo *ProjectUpdateOne) ClearTags() *ProjectUpdateOne {
+	puo.mutation.ClearTags()
+	return puo
+}
+
+// RemoveTagIDs removes the "tags" edge to Tag entities by IDs.
+func (puo *ProjectUpdateOne) RemoveTagIDs(ids ...int) *ProjectUpdateOne {
+	puo.mutation.RemoveTagIDs(ids...)
+	return puo
+}
+
+// RemoveTags removes "tags" edges to Tag entities.
+func (puo *ProjectUpdateOne) RemoveTags(t ...*Tag) *ProjectUpdateOne {
+	ids := make([]int, len(t))
+	for i := range t {
+		ids[i] = t[i].ID
+	}
+	return puo.RemoveTagIDs(ids...)
+}
+
+// ClearCollections clears all "collections" edges to the Collection entity.
+func (puo *ProjectUpdateOne) ClearCollections() *ProjectUpdateOne {
+	puo.mutation.ClearCollections()
+	return puo
+}
+
+// RemoveCollectionIDs removes the "collections" edge to Collection entities by IDs.
+func (puo *ProjectUpdateOne) RemoveCollectionIDs(ids ...int) *ProjectUpdateOne {
+	puo.mutation.RemoveCollectionIDs(ids...)
+	return puo
+}
+
+// RemoveCollections removes "collections" edges to Collection entities.
+func (puo *ProjectUpdateOne) RemoveCollections(c ...*Collection) *ProjectUpdateOne {
+	ids := make([]int, len(c))
+	for i := range c {
+		ids[i] = c[i].ID
+	}
+	return puo.RemoveCollectionIDs(ids...)
+}
+
 // Where appends a list predicates to the ProjectUpdate builder.
 func (puo *ProjectUpdateOne) Where(ps ...predicate.Project) *ProjectUpdateOne {
 	puo.mutation.Where(ps...)
@@ -1482,6 +2174,15 @@ func (puo *ProjectUpdateOne) sqlSave(ctx context.Context) (_node *Project, err e
 	if puo.mutation.AiSuggestionModelCleared() {
 		_spec.ClearField(project.FieldAiSuggestionModel, field.TypeString)
 	}
+	if value, ok := puo.mutation.AiSuggestionPromptID(); ok {
+		_spec.SetField(project.FieldAiSuggestionPromptID, field.TypeInt, value)
+	}
+	if value, ok := puo.mutation.AddedAiSuggestionPromptID(); ok {
+		_spec.AddField(project.FieldAiSuggestionPromptID, field.TypeInt, value)
+	}
+	if puo.mutation.AiSuggestionPromptIDCleared() {
+		_spec.ClearField(project.FieldAiSuggestionPromptID, field.TypeInt)
+	}
 	if value, ok := puo.mutation.AiSuggestionCreatedAt(); ok {
 		_spec.SetField(project.FieldAiSuggestionCreatedAt, field.TypeTime, value)
 	}
@@ -1571,6 +2272,32 @@ func (puo *ProjectUpdateOne) sqlSave(ctx context.Context) (_node *Project, err e
 	if puo.mutation.HiddenHighlightsCleared() {
 		_spec.ClearField(project.FieldHiddenHighlights, field.TypeJSON)
 	}
+	if value, ok := puo.mutation.ExportPresets(); ok {
+		_spec.SetField(project.FieldExportPresets, field.TypeJSON, value)
+	}
+	if value, ok := puo.mutation.AppendedExportPresets(); ok {
+		_spec.AddModifier(func(u *sql.UpdateBuilder) {
+			sqljson.Append(u, project.FieldExportPresets, value)
+		})
+	}
+	if puo.mutation.ExportPresetsCleared() {
+		_spec.ClearField(project.FieldExportPresets, field.TypeJSON)
+	}
+	if value, ok := puo.mutation.ActiveCutID(); ok {
+		_spec.SetField(project.FieldActiveCutID, field.TypeInt, value)
+	}
+	if value, ok := puo.mutation.AddedActiveCutID(); ok {
+		_spec.AddField(project.FieldActiveCutID, field.TypeInt, value)
+	}
+	if puo.mutation.ActiveCutIDCleared() {
+		_spec.ClearField(project.FieldActiveCutID, field.TypeInt)
+	}
+	if value, ok := puo.mutation.DeletedAt(); ok {
+		_spec.SetField(project.FieldDeletedAt, field.TypeTime, value)
+	}
+	if puo.mutation.DeletedAtCleared() {
+		_spec.ClearField(project.FieldDeletedAt, field.TypeTime)
+	}
 	if puo.mutation.VideoClipsCleared() {
 		edge := &sqlgraph.EdgeSpec{
 			Rel:     sqlgraph.O2M,
@@ -1706,6 +2433,186 @@ func (puo *ProjectUpdateOne) sqlSave(ctx context.Context) (_node *Project, err e
 		}
 		_spec.Edges.Add = append(_spec.Edges.Add, edge)
 	}
+	if puo.mutation.CutsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   project.CutsTable,
+			Columns: []string{project.CutsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(cut.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := puo.mutation.RemovedCutsIDs(); len(nodes) > 0 && !puo.mutation.CutsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   project.CutsTable,
+			Columns: []string{project.CutsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(cut.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := puo.mutation.CutsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   project.CutsTable,
+			Columns: []string{project.CutsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(cut.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if puo.mutation.PromptsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   project.PromptsTable,
+			Columns: []string{project.PromptsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(prompt.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := puo.mutation.RemovedPromptsIDs(); len(nodes) > 0 && !puo.mutation.PromptsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   project.PromptsTable,
+			Columns: []string{project.PromptsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(prompt.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := puo.mutation.PromptsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   project.PromptsTable,
+			Columns: []string{project.PromptsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(prompt.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if puo.mutation.TagsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2M,
+			Inverse: true,
+			Table:   project.TagsTable,
+			Columns: project.TagsPrimaryKey,
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(tag.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := puo.mutation.RemovedTagsIDs(); len(nodes) > 0 && !puo.mutation.TagsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2M,
+			Inverse: true,
+			Table:   project.TagsTable,
+			Columns: project.TagsPrimaryKey,
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(tag.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := puo.mutation.TagsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2M,
+			Inverse: true,
+			Table:   project.TagsTable,
+			Columns: project.TagsPrimaryKey,
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(tag.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if puo.mutation.CollectionsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2M,
+			Inverse: true,
+			Table:   project.CollectionsTable,
+			Columns: project.CollectionsPrimaryKey,
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(collection.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := puo.mutation.RemovedCollectionsIDs(); len(nodes) > 0 && !puo.mutation.CollectionsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2M,
+			Inverse: true,
+			Table:   project.CollectionsTable,
+			Columns: project.CollectionsPrimaryKey,
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(collection.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := puo.mutation.CollectionsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2M,
+			Inverse: true,
+			Table:   project.CollectionsTable,
+			Columns: project.CollectionsPrimaryKey,
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(collection.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
 	_node = &Project{config: puo.config}
 	_spec.Assign = _node.assignValues
 	_spec.ScanValues = _node.scanValues
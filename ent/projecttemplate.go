@@ -0,0 +1,213 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"encoding/json"
+	"fmt"
+	"ramble-ai/ent/projecttemplate"
+	"ramble-ai/ent/schema"
+	"strings"
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/sql"
+)
+
+// ProjectTemplate is the model entity for the ProjectTemplate schema.
+type ProjectTemplate struct {
+	config `json:"-"`
+	// ID of the ent.
+	ID int `json:"id,omitempty"`
+	// Template name
+	Name string `json:"name,omitempty"`
+	// Template description
+	Description string `json:"description,omitempty"`
+	// OpenRouter AI model for segment reordering and silence improvements
+	AiModel string `json:"ai_model,omitempty"`
+	// Custom AI prompt for segment reordering
+	AiPrompt string `json:"ai_prompt,omitempty"`
+	// OpenRouter AI model for highlight suggestions
+	HighlightAiModel string `json:"highlight_ai_model,omitempty"`
+	// Custom AI prompt for highlight suggestions
+	HighlightAiPrompt string `json:"highlight_ai_prompt,omitempty"`
+	// Export presets copied to new projects
+	ExportPresets []schema.ExportPreset `json:"export_presets,omitempty"`
+	// Skeleton section titles, in order, used to seed the highlight order
+	SectionTitles []string `json:"section_titles,omitempty"`
+	// Creation timestamp
+	CreatedAt time.Time `json:"created_at,omitempty"`
+	// Last update timestamp
+	UpdatedAt    time.Time `json:"updated_at,omitempty"`
+	selectValues sql.SelectValues
+}
+
+// scanValues returns the types for scanning values from sql.Rows.
+func (*ProjectTemplate) scanValues(columns []string) ([]any, error) {
+	values := make([]any, len(columns))
+	for i := range columns {
+		switch columns[i] {
+		case projecttemplate.FieldExportPresets, projecttemplate.FieldSectionTitles:
+			values[i] = new([]byte)
+		case projecttemplate.FieldID:
+			values[i] = new(sql.NullInt64)
+		case projecttemplate.FieldName, projecttemplate.FieldDescription, projecttemplate.FieldAiModel, projecttemplate.FieldAiPrompt, projecttemplate.FieldHighlightAiModel, projecttemplate.FieldHighlightAiPrompt:
+			values[i] = new(sql.NullString)
+		case projecttemplate.FieldCreatedAt, projecttemplate.FieldUpdatedAt:
+			values[i] = new(sql.NullTime)
+		default:
+			values[i] = new(sql.UnknownType)
+		}
+	}
+	return values, nil
+}
+
+// assignValues assigns the values that were returned from sql.Rows (after scanning)
+// to the ProjectTemplate fields.
+func (pt *ProjectTemplate) assignValues(columns []string, values []any) error {
+	if m, n := len(values), len(columns); m < n {
+		return fmt.Errorf("mismatch number of scan values: %d != %d", m, n)
+	}
+	for i := range columns {
+		switch columns[i] {
+		case projecttemplate.FieldID:
+			value, ok := values[i].(*sql.NullInt64)
+			if !ok {
+				return fmt.Errorf("unexpected type %T for field id", value)
+			}
+			pt.ID = int(value.Int64)
+		case projecttemplate.FieldName:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field name", values[i])
+			} else if value.Valid {
+				pt.Name = value.String
+			}
+		case projecttemplate.FieldDescription:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field description", values[i])
+			} else if value.Valid {
+				pt.Description = value.String
+			}
+		case projecttemplate.FieldAiModel:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field ai_model", values[i])
+			} else if value.Valid {
+				pt.AiModel = value.String
+			}
+		case projecttemplate.FieldAiPrompt:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field ai_prompt", values[i])
+			} else if value.Valid {
+				pt.AiPrompt = value.String
+			}
+		case projecttemplate.FieldHighlightAiModel:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field highlight_ai_model", values[i])
+			} else if value.Valid {
+				pt.HighlightAiModel = value.String
+			}
+		case projecttemplate.FieldHighlightAiPrompt:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field highlight_ai_prompt", values[i])
+			} else if value.Valid {
+				pt.HighlightAiPrompt = value.String
+			}
+		case projecttemplate.FieldExportPresets:
+			if value, ok := values[i].(*[]byte); !ok {
+				return fmt.Errorf("unexpected type %T for field export_presets", values[i])
+			} else if value != nil && len(*value) > 0 {
+				if err := json.Unmarshal(*value, &pt.ExportPresets); err != nil {
+					return fmt.Errorf("unmarshal field export_presets: %w", err)
+				}
+			}
+		case projecttemplate.FieldSectionTitles:
+			if value, ok := values[i].(*[]byte); !ok {
+				return fmt.Errorf("unexpected type %T for field section_titles", values[i])
+			} else if value != nil && len(*value) > 0 {
+				if err := json.Unmarshal(*value, &pt.SectionTitles); err != nil {
+					return fmt.Errorf("unmarshal field section_titles: %w", err)
+				}
+			}
+		case projecttemplate.FieldCreatedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field created_at", values[i])
+			} else if value.Valid {
+				pt.CreatedAt = value.Time
+			}
+		case projecttemplate.FieldUpdatedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field updated_at", values[i])
+			} else if value.Valid {
+				pt.UpdatedAt = value.Time
+			}
+		default:
+			pt.selectValues.Set(columns[i], values[i])
+		}
+	}
+	return nil
+}
+
+// Value returns the ent.Value that was dynamically selected and assigned to the ProjectTemplate.
+// This includes values selected through modifiers, order, etc.
+func (pt *ProjectTemplate) Value(name string) (ent.Value, error) {
+	return pt.selectValues.Get(name)
+}
+
+// Update returns a builder for updating this ProjectTemplate.
+// Note that you need to call ProjectTemplate.Unwrap() before calling this method if this ProjectTemplate
+// was returned from a transaction, and the transaction was committed or rolled back.
+func (pt *ProjectTemplate) Update() *ProjectTemplateUpdateOne {
+	return NewProjectTemplateClient(pt.config).UpdateOne(pt)
+}
+
+// Unwrap unwraps the ProjectTemplate entity that was returned from a transaction after it was closed,
+// so that all future queries will be executed through the driver which created the transaction.
+func (pt *ProjectTemplate) Unwrap() *ProjectTemplate {
+	_tx, ok := pt.config.driver.(*txDriver)
+	if !ok {
+		panic("ent: ProjectTemplate is not a transactional entity")
+	}
+	pt.config.driver = _tx.drv
+	return pt
+}
+
+// String implements the fmt.Stringer.
+func (pt *ProjectTemplate) String() string {
+	var builder strings.Builder
+	builder.WriteString("ProjectTemplate(")
+	builder.WriteString(fmt.Sprintf("id=%v, ", pt.ID))
+	builder.WriteString("name=")
+	builder.WriteString(pt.Name)
+	builder.WriteString(", ")
+	builder.WriteString("description=")
+	builder.WriteString(pt.Description)
+	builder.WriteString(", ")
+	builder.WriteString("ai_model=")
+	builder.WriteString(pt.AiModel)
+	builder.WriteString(", ")
+	builder.WriteString("ai_prompt=")
+	builder.WriteString(pt.AiPrompt)
+	builder.WriteString(", ")
+	builder.WriteString("highlight_ai_model=")
+	builder.WriteString(pt.HighlightAiModel)
+	builder.WriteString(", ")
+	builder.WriteString("highlight_ai_prompt=")
+	builder.WriteString(pt.HighlightAiPrompt)
+	builder.WriteString(", ")
+	builder.WriteString("export_presets=")
+	builder.WriteString(fmt.Sprintf("%v", pt.ExportPresets))
+	builder.WriteString(", ")
+	builder.WriteString("section_titles=")
+	builder.WriteString(fmt.Sprintf("%v", pt.SectionTitles))
+	builder.WriteString(", ")
+	builder.WriteString("created_at=")
+	builder.WriteString(pt.CreatedAt.Format(time.ANSIC))
+	builder.WriteString(", ")
+	builder.WriteString("updated_at=")
+	builder.WriteString(pt.UpdatedAt.Format(time.ANSIC))
+	builder.WriteByte(')')
+	return builder.String()
+}
+
+// ProjectTemplates is a parsable slice of ProjectTemplate.
+type ProjectTemplates []*ProjectTemplate
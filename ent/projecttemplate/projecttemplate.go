@@ -0,0 +1,122 @@
+// Code generated by ent, DO NOT EDIT.
+
+package projecttemplate
+
+import (
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+)
+
+const (
+	// Label holds the string label denoting the projecttemplate type in the database.
+	Label = "project_template"
+	// FieldID holds the string denoting the id field in the database.
+	FieldID = "id"
+	// FieldName holds the string denoting the name field in the database.
+	FieldName = "name"
+	// FieldDescription holds the string denoting the description field in the database.
+	FieldDescription = "description"
+	// FieldAiModel holds the string denoting the ai_model field in the database.
+	FieldAiModel = "ai_model"
+	// FieldAiPrompt holds the string denoting the ai_prompt field in the database.
+	FieldAiPrompt = "ai_prompt"
+	// FieldHighlightAiModel holds the string denoting the highlight_ai_model field in the database.
+	FieldHighlightAiModel = "highlight_ai_model"
+	// FieldHighlightAiPrompt holds the string denoting the highlight_ai_prompt field in the database.
+	FieldHighlightAiPrompt = "highlight_ai_prompt"
+	// FieldExportPresets holds the string denoting the export_presets field in the database.
+	FieldExportPresets = "export_presets"
+	// FieldSectionTitles holds the string denoting the section_titles field in the database.
+	FieldSectionTitles = "section_titles"
+	// FieldCreatedAt holds the string denoting the created_at field in the database.
+	FieldCreatedAt = "created_at"
+	// FieldUpdatedAt holds the string denoting the updated_at field in the database.
+	FieldUpdatedAt = "updated_at"
+	// Table holds the table name of the projecttemplate in the database.
+	Table = "project_templates"
+)
+
+// Columns holds all SQL columns for projecttemplate fields.
+var Columns = []string{
+	FieldID,
+	FieldName,
+	FieldDescription,
+	FieldAiModel,
+	FieldAiPrompt,
+	FieldHighlightAiModel,
+	FieldHighlightAiPrompt,
+	FieldExportPresets,
+	FieldSectionTitles,
+	FieldCreatedAt,
+	FieldUpdatedAt,
+}
+
+// ValidColumn reports if the column name is valid (part of the table columns).
+func ValidColumn(column string) bool {
+	for i := range Columns {
+		if column == Columns[i] {
+			return true
+		}
+	}
+	return false
+}
+
+var (
+	// NameValidator is a validator for the "name" field. It is called by the builders before save.
+	NameValidator func(string) error
+	// DefaultCreatedAt holds the default value on creation for the "created_at" field.
+	DefaultCreatedAt func() time.Time
+	// DefaultUpdatedAt holds the default value on creation for the "updated_at" field.
+	DefaultUpdatedAt func() time.Time
+	// UpdateDefaultUpdatedAt holds the default value on update for the "updated_at" field.
+	UpdateDefaultUpdatedAt func() time.Time
+)
+
+// OrderOption defines the ordering options for the ProjectTemplate queries.
+type OrderOption func(*sql.Selector)
+
+// ByID orders the results by the id field.
+func ByID(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldID, opts...).ToFunc()
+}
+
+// ByName orders the results by the name field.
+func ByName(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldName, opts...).ToFunc()
+}
+
+// ByDescription orders the results by the description field.
+func ByDescription(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldDescription, opts...).ToFunc()
+}
+
+// ByAiModel orders the results by the ai_model field.
+func ByAiModel(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldAiModel, opts...).ToFunc()
+}
+
+// ByAiPrompt orders the results by the ai_prompt field.
+func ByAiPrompt(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldAiPrompt, opts...).ToFunc()
+}
+
+// ByHighlightAiModel orders the results by the highlight_ai_model field.
+func ByHighlightAiModel(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldHighlightAiModel, opts...).ToFunc()
+}
+
+// ByHighlightAiPrompt orders the results by the highlight_ai_prompt field.
+func ByHighlightAiPrompt(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldHighlightAiPrompt, opts...).ToFunc()
+}
+
+// ByCreatedAt orders the results by the created_at field.
+func ByCreatedAt(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldCreatedAt, opts...).ToFunc()
+}
+
+// ByUpdatedAt orders the results by the updated_at field.
+func ByUpdatedAt(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldUpdatedAt, opts...).ToFunc()
+}
@@ -0,0 +1,650 @@
+// Code generated by ent, DO NOT EDIT.
+
+package projecttemplate
+
+import (
+	"ramble-ai/ent/predicate"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+)
+
+// ID filters vertices based on their ID field.
+func ID(id int) predicate.ProjectTemplate {
+	return predicate.ProjectTemplate(sql.FieldEQ(FieldID, id))
+}
+
+// IDEQ applies the EQ predicate on the ID field.
+func IDEQ(id int) predicate.ProjectTemplate {
+	return predicate.ProjectTemplate(sql.FieldEQ(FieldID, id))
+}
+
+// IDNEQ applies the NEQ predicate on the ID field.
+func IDNEQ(id int) predicate.ProjectTemplate {
+	return predicate.ProjectTemplate(sql.FieldNEQ(FieldID, id))
+}
+
+// IDIn applies the In predicate on the ID field.
+func IDIn(ids ...int) predicate.ProjectTemplate {
+	return predicate.ProjectTemplate(sql.FieldIn(FieldID, ids...))
+}
+
+// IDNotIn applies the NotIn predicate on the ID field.
+func IDNotIn(ids ...int) predicate.ProjectTemplate {
+	return predicate.ProjectTemplate(sql.FieldNotIn(FieldID, ids...))
+}
+
+// IDGT applies the GT predicate on the ID field.
+func IDGT(id int) predicate.ProjectTemplate {
+	return predicate.ProjectTemplate(sql.FieldGT(FieldID, id))
+}
+
+// IDGTE applies the GTE predicate on the ID field.
+func IDGTE(id int) predicate.ProjectTemplate {
+	return predicate.ProjectTemplate(sql.FieldGTE(FieldID, id))
+}
+
+// IDLT applies the LT predicate on the ID field.
+func IDLT(id int) predicate.ProjectTemplate {
+	return predicate.ProjectTemplate(sql.FieldLT(FieldID, id))
+}
+
+// IDLTE applies the LTE predicate on the ID field.
+func IDLTE(id int) predicate.ProjectTemplate {
+	return predicate.ProjectTemplate(sql.FieldLTE(FieldID, id))
+}
+
+// Name applies equality check predicate on the "name" field. It's identical to NameEQ.
+func Name(v string) predicate.ProjectTemplate {
+	return predicate.ProjectTemplate(sql.FieldEQ(FieldName, v))
+}
+
+// Description applies equality check predicate on the "description" field. It's identical to DescriptionEQ.
+func Description(v string) predicate.ProjectTemplate {
+	return predicate.ProjectTemplate(sql.FieldEQ(FieldDescription, v))
+}
+
+// AiModel applies equality check predicate on the "ai_model" field. It's identical to AiModelEQ.
+func AiModel(v string) predicate.ProjectTemplate {
+	return predicate.ProjectTemplate(sql.FieldEQ(FieldAiModel, v))
+}
+
+// AiPrompt applies equality check predicate on the "ai_prompt" field. It's identical to AiPromptEQ.
+func AiPrompt(v string) predicate.ProjectTemplate {
+	return predicate.ProjectTemplate(sql.FieldEQ(FieldAiPrompt, v))
+}
+
+// HighlightAiModel applies equality check predicate on the "highlight_ai_model" field. It's identical to HighlightAiModelEQ.
+func HighlightAiModel(v string) predicate.ProjectTemplate {
+	return predicate.ProjectTemplate(sql.FieldEQ(FieldHighlightAiModel, v))
+}
+
+// HighlightAiPrompt applies equality check predicate on the "highlight_ai_prompt" field. It's identical to HighlightAiPromptEQ.
+func HighlightAiPrompt(v string) predicate.ProjectTemplate {
+	return predicate.ProjectTemplate(sql.FieldEQ(FieldHighlightAiPrompt, v))
+}
+
+// CreatedAt applies equality check predicate on the "created_at" field. It's identical to CreatedAtEQ.
+func CreatedAt(v time.Time) predicate.ProjectTemplate {
+	return predicate.ProjectTemplate(sql.FieldEQ(FieldCreatedAt, v))
+}
+
+// UpdatedAt applies equality check predicate on the "updated_at" field. It's identical to UpdatedAtEQ.
+func UpdatedAt(v time.Time) predicate.ProjectTemplate {
+	return predicate.ProjectTemplate(sql.FieldEQ(FieldUpdatedAt, v))
+}
+
+// NameEQ applies the EQ predicate on the "name" field.
+func NameEQ(v string) predicate.ProjectTemplate {
+	return predicate.ProjectTemplate(sql.FieldEQ(FieldName, v))
+}
+
+// NameNEQ applies the NEQ predicate on the "name" field.
+func NameNEQ(v string) predicate.ProjectTemplate {
+	return predicate.ProjectTemplate(sql.FieldNEQ(FieldName, v))
+}
+
+// NameIn applies the In predicate on the "name" field.
+func NameIn(vs ...string) predicate.ProjectTemplate {
+	return predicate.ProjectTemplate(sql.FieldIn(FieldName, vs...))
+}
+
+// NameNotIn applies the NotIn predicate on the "name" field.
+func NameNotIn(vs ...string) predicate.ProjectTemplate {
+	return predicate.ProjectTemplate(sql.FieldNotIn(FieldName, vs...))
+}
+
+// NameGT applies the GT predicate on the "name" field.
+func NameGT(v string) predicate.ProjectTemplate {
+	return predicate.ProjectTemplate(sql.FieldGT(FieldName, v))
+}
+
+// NameGTE applies the GTE predicate on the "name" field.
+func NameGTE(v string) predicate.ProjectTemplate {
+	return predicate.ProjectTemplate(sql.FieldGTE(FieldName, v))
+}
+
+// NameLT applies the LT predicate on the "name" field.
+func NameLT(v string) predicate.ProjectTemplate {
+	return predicate.ProjectTemplate(sql.FieldLT(FieldName, v))
+}
+
+// NameLTE applies the LTE predicate on the "name" field.
+func NameLTE(v string) predicate.ProjectTemplate {
+	return predicate.ProjectTemplate(sql.FieldLTE(FieldName, v))
+}
+
+// NameContains applies the Contains predicate on the "name" field.
+func NameContains(v string) predicate.ProjectTemplate {
+	return predicate.ProjectTemplate(sql.FieldContains(FieldName, v))
+}
+
+// NameHasPrefix applies the HasPrefix predicate on the "name" field.
+func NameHasPrefix(v string) predicate.ProjectTemplate {
+	return predicate.ProjectTemplate(sql.FieldHasPrefix(FieldName, v))
+}
+
+// NameHasSuffix applies the HasSuffix predicate on the "name" field.
+func NameHasSuffix(v string) predicate.ProjectTemplate {
+	return predicate.ProjectTemplate(sql.FieldHasSuffix(FieldName, v))
+}
+
+// NameEqualFold applies the EqualFold predicate on the "name" field.
+func NameEqualFold(v string) predicate.ProjectTemplate {
+	return predicate.ProjectTemplate(sql.FieldEqualFold(FieldName, v))
+}
+
+// NameContainsFold applies the ContainsFold predicate on the "name" field.
+func NameContainsFold(v string) predicate.ProjectTemplate {
+	return predicate.ProjectTemplate(sql.FieldContainsFold(FieldName, v))
+}
+
+// DescriptionEQ applies the EQ predicate on the "description" field.
+func DescriptionEQ(v string) predicate.ProjectTemplate {
+	return predicate.ProjectTemplate(sql.FieldEQ(FieldDescription, v))
+}
+
+// DescriptionNEQ applies the NEQ predicate on the "description" field.
+func DescriptionNEQ(v string) predicate.ProjectTemplate {
+	return predicate.ProjectTemplate(sql.FieldNEQ(FieldDescription, v))
+}
+
+// DescriptionIn applies the In predicate on the "description" field.
+func DescriptionIn(vs ...string) predicate.ProjectTemplate {
+	return predicate.ProjectTemplate(sql.FieldIn(FieldDescription, vs...))
+}
+
+// DescriptionNotIn applies the NotIn predicate on the "description" field.
+func DescriptionNotIn(vs ...string) predicate.ProjectTemplate {
+	return predicate.ProjectTemplate(sql.FieldNotIn(FieldDescription, vs...))
+}
+
+// DescriptionGT applies the GT predicate on the "description" field.
+func DescriptionGT(v string) predicate.ProjectTemplate {
+	return predicate.ProjectTemplate(sql.FieldGT(FieldDescription, v))
+}
+
+// DescriptionGTE applies the GTE predicate on the "description" field.
+func DescriptionGTE(v string) predicate.ProjectTemplate {
+	return predicate.ProjectTemplate(sql.FieldGTE(FieldDescription, v))
+}
+
+// DescriptionLT applies the LT predicate on the "description" field.
+func DescriptionLT(v string) predicate.ProjectTemplate {
+	return predicate.ProjectTemplate(sql.FieldLT(FieldDescription, v))
+}
+
+// DescriptionLTE applies the LTE predicate on the "description" field.
+func DescriptionLTE(v string) predicate.ProjectTemplate {
+	return predicate.ProjectTemplate(sql.FieldLTE(FieldDescription, v))
+}
+
+// DescriptionContains applies the Contains predicate on the "description" field.
+func DescriptionContains(v string) predicate.ProjectTemplate {
+	return predicate.ProjectTemplate(sql.FieldContains(FieldDescription, v))
+}
+
+// DescriptionHasPrefix applies the HasPrefix predicate on the "description" field.
+func DescriptionHasPrefix(v string) predicate.ProjectTemplate {
+	return predicate.ProjectTemplate(sql.FieldHasPrefix(FieldDescription, v))
+}
+
+// DescriptionHasSuffix applies the HasSuffix predicate on the "description" field.
+func DescriptionHasSuffix(v string) predicate.ProjectTemplate {
+	return predicate.ProjectTemplate(sql.FieldHasSuffix(FieldDescription, v))
+}
+
+// DescriptionIsNil applies the IsNil predicate on the "description" field.
+func DescriptionIsNil() predicate.ProjectTemplate {
+	return predicate.ProjectTemplate(sql.FieldIsNull(FieldDescription))
+}
+
+// DescriptionNotNil applies the NotNil predicate on the "description" field.
+func DescriptionNotNil() predicate.ProjectTemplate {
+	return predicate.ProjectTemplate(sql.FieldNotNull(FieldDescription))
+}
+
+// DescriptionEqualFold applies the EqualFold predicate on the "description" field.
+func DescriptionEqualFold(v string) predicate.ProjectTemplate {
+	return predicate.ProjectTemplate(sql.FieldEqualFold(FieldDescription, v))
+}
+
+// DescriptionContainsFold applies the ContainsFold predicate on the "description" field.
+func DescriptionContainsFold(v string) predicate.ProjectTemplate {
+	return predicate.ProjectTemplate(sql.FieldContainsFold(FieldDescription, v))
+}
+
+// AiModelEQ applies the EQ predicate on the "ai_model" field.
+func AiModelEQ(v string) predicate.ProjectTemplate {
+	return predicate.ProjectTemplate(sql.FieldEQ(FieldAiModel, v))
+}
+
+// AiModelNEQ applies the NEQ predicate on the "ai_model" field.
+func AiModelNEQ(v string) predicate.ProjectTemplate {
+	return predicate.ProjectTemplate(sql.FieldNEQ(FieldAiModel, v))
+}
+
+// AiModelIn applies the In predicate on the "ai_model" field.
+func AiModelIn(vs ...string) predicate.ProjectTemplate {
+	return predicate.ProjectTemplate(sql.FieldIn(FieldAiModel, vs...))
+}
+
+// AiModelNotIn applies the NotIn predicate on the "ai_model" field.
+func AiModelNotIn(vs ...string) predicate.ProjectTemplate {
+	return predicate.ProjectTemplate(sql.FieldNotIn(FieldAiModel, vs...))
+}
+
+// AiModelGT applies the GT predicate on the "ai_model" field.
+func AiModelGT(v string) predicate.ProjectTemplate {
+	return predicate.ProjectTemplate(sql.FieldGT(FieldAiModel, v))
+}
+
+// AiModelGTE applies the GTE predicate on the "ai_model" field.
+func AiModelGTE(v string) predicate.ProjectTemplate {
+	return predicate.ProjectTemplate(sql.FieldGTE(FieldAiModel, v))
+}
+
+// AiModelLT applies the LT predicate on the "ai_model" field.
+func AiModelLT(v string) predicate.ProjectTemplate {
+	return predicate.ProjectTemplate(sql.FieldLT(FieldAiModel, v))
+}
+
+// AiModelLTE applies the LTE predicate on the "ai_model" field.
+func AiModelLTE(v string) predicate.ProjectTemplate {
+	return predicate.ProjectTemplate(sql.FieldLTE(FieldAiModel, v))
+}
+
+// AiModelContains applies the Contains predicate on the "ai_model" field.
+func AiModelContains(v string) predicate.ProjectTemplate {
+	return predicate.ProjectTemplate(sql.FieldContains(FieldAiModel, v))
+}
+
+// AiModelHasPrefix applies the HasPrefix predicate on the "ai_model" field.
+func AiModelHasPrefix(v string) predicate.ProjectTemplate {
+	return predicate.ProjectTemplate(sql.FieldHasPrefix(FieldAiModel, v))
+}
+
+// AiModelHasSuffix applies the HasSuffix predicate on the "ai_model" field.
+func AiModelHasSuffix(v string) predicate.ProjectTemplate {
+	return predicate.ProjectTemplate(sql.FieldHasSuffix(FieldAiModel, v))
+}
+
+// AiModelIsNil applies the IsNil predicate on the "ai_model" field.
+func AiModelIsNil() predicate.ProjectTemplate {
+	return predicate.ProjectTemplate(sql.FieldIsNull(FieldAiModel))
+}
+
+// AiModelNotNil applies the NotNil predicate on the "ai_model" field.
+func AiModelNotNil() predicate.ProjectTemplate {
+	return predicate.ProjectTemplate(sql.FieldNotNull(FieldAiModel))
+}
+
+// AiModelEqualFold applies the EqualFold predicate on the "ai_model" field.
+func AiModelEqualFold(v string) predicate.ProjectTemplate {
+	return predicate.ProjectTemplate(sql.FieldEqualFold(FieldAiModel, v))
+}
+
+// AiModelContainsFold applies the ContainsFold predicate on the "ai_model" field.
+func AiModelContainsFold(v string) predicate.ProjectTemplate {
+	return predicate.ProjectTemplate(sql.FieldContainsFold(FieldAiModel, v))
+}
+
+// AiPromptEQ applies the EQ predicate on the "ai_prompt" field.
+func AiPromptEQ(v string) predicate.ProjectTemplate {
+	return predicate.ProjectTemplate(sql.FieldEQ(FieldAiPrompt, v))
+}
+
+// AiPromptNEQ applies the NEQ predicate on the "ai_prompt" field.
+func AiPromptNEQ(v string) predicate.ProjectTemplate {
+	return predicate.ProjectTemplate(sql.FieldNEQ(FieldAiPrompt, v))
+}
+
+// AiPromptIn applies the In predicate on the "ai_prompt" field.
+func AiPromptIn(vs ...string) predicate.ProjectTemplate {
+	return predicate.ProjectTemplate(sql.FieldIn(FieldAiPrompt, vs...))
+}
+
+// AiPromptNotIn applies the NotIn predicate on the "ai_prompt" field.
+func AiPromptNotIn(vs ...string) predicate.ProjectTemplate {
+	return predicate.ProjectTemplate(sql.FieldNotIn(FieldAiPrompt, vs...))
+}
+
+// AiPromptGT applies the GT predicate on the "ai_prompt" field.
+func AiPromptGT(v string) predicate.ProjectTemplate {
+	return predicate.ProjectTemplate(sql.FieldGT(FieldAiPrompt, v))
+}
+
+// AiPromptGTE applies the GTE predicate on the "ai_prompt" field.
+func AiPromptGTE(v string) predicate.ProjectTemplate {
+	return predicate.ProjectTemplate(sql.FieldGTE(FieldAiPrompt, v))
+}
+
+// AiPromptLT applies the LT predicate on the "ai_prompt" field.
+func AiPromptLT(v string) predicate.ProjectTemplate {
+	return predicate.ProjectTemplate(sql.FieldLT(FieldAiPrompt, v))
+}
+
+// AiPromptLTE applies the LTE predicate on the "ai_prompt" field.
+func AiPromptLTE(v string) predicate.ProjectTemplate {
+	return predicate.ProjectTemplate(sql.FieldLTE(FieldAiPrompt, v))
+}
+
+// AiPromptContains applies the Contains predicate on the "ai_prompt" field.
+func AiPromptContains(v string) predicate.ProjectTemplate {
+	return predicate.ProjectTemplate(sql.FieldContains(FieldAiPrompt, v))
+}
+
+// AiPromptHasPrefix applies the HasPrefix predicate on the "ai_prompt" field.
+func AiPromptHasPrefix(v string) predicate.ProjectTemplate {
+	return predicate.ProjectTemplate(sql.FieldHasPrefix(FieldAiPrompt, v))
+}
+
+// AiPromptHasSuffix applies the HasSuffix predicate on the "ai_prompt" field.
+func AiPromptHasSuffix(v string) predicate.ProjectTemplate {
+	return predicate.ProjectTemplate(sql.FieldHasSuffix(FieldAiPrompt, v))
+}
+
+// AiPromptIsNil applies the IsNil predicate on the "ai_prompt" field.
+func AiPromptIsNil() predicate.ProjectTemplate {
+	return predicate.ProjectTemplate(sql.FieldIsNull(FieldAiPrompt))
+}
+
+// AiPromptNotNil applies the NotNil predicate on the "ai_prompt" field.
+func AiPromptNotNil() predicate.ProjectTemplate {
+	return predicate.ProjectTemplate(sql.FieldNotNull(FieldAiPrompt))
+}
+
+// AiPromptEqualFold applies the EqualFold predicate on the "ai_prompt" field.
+func AiPromptEqualFold(v string) predicate.ProjectTemplate {
+	return predicate.ProjectTemplate(sql.FieldEqualFold(FieldAiPrompt, v))
+}
+
+// AiPromptContainsFold applies the ContainsFold predicate on the "ai_prompt" field.
+func AiPromptContainsFold(v string) predicate.ProjectTemplate {
+	return predicate.ProjectTemplate(sql.FieldContainsFold(FieldAiPrompt, v))
+}
+
+// HighlightAiModelEQ applies the EQ predicate on the "highlight_ai_model" field.
+func HighlightAiModelEQ(v string) predicate.ProjectTemplate {
+	return predicate.ProjectTemplate(sql.FieldEQ(FieldHighlightAiModel, v))
+}
+
+// HighlightAiModelNEQ applies the NEQ predicate on the "highlight_ai_model" field.
+func HighlightAiModelNEQ(v string) predicate.ProjectTemplate {
+	return predicate.ProjectTemplate(sql.FieldNEQ(FieldHighlightAiModel, v))
+}
+
+// HighlightAiModelIn applies the In predicate on the "highlight_ai_model" field.
+func HighlightAiModelIn(vs ...string) predicate.ProjectTemplate {
+	return predicate.ProjectTemplate(sql.FieldIn(FieldHighlightAiModel, vs...))
+}
+
+// HighlightAiModelNotIn applies the NotIn predicate on the "highlight_ai_model" field.
+func HighlightAiModelNotIn(vs ...string) predicate.ProjectTemplate {
+	return predicate.ProjectTemplate(sql.FieldNotIn(FieldHighlightAiModel, vs...))
+}
+
+// HighlightAiModelGT applies the GT predicate on the "highlight_ai_model" field.
+func HighlightAiModelGT(v string) predicate.ProjectTemplate {
+	return predicate.ProjectTemplate(sql.FieldGT(FieldHighlightAiModel, v))
+}
+
+// HighlightAiModelGTE applies the GTE predicate on the "highlight_ai_model" field.
+func HighlightAiModelGTE(v string) predicate.ProjectTemplate {
+	return predicate.ProjectTemplate(sql.FieldGTE(FieldHighlightAiModel, v))
+}
+
+// HighlightAiModelLT applies the LT predicate on the "highlight_ai_model" field.
+func HighlightAiModelLT(v string) predicate.ProjectTemplate {
+	return predicate.ProjectTemplate(sql.FieldLT(FieldHighlightAiModel, v))
+}
+
+// HighlightAiModelLTE applies the LTE predicate on the "highlight_ai_model" field.
+func HighlightAiModelLTE(v string) predicate.ProjectTemplate {
+	return predicate.ProjectTemplate(sql.FieldLTE(FieldHighlightAiModel, v))
+}
+
+// HighlightAiModelContains applies the Contains predicate on the "highlight_ai_model" field.
+func HighlightAiModelContains(v string) predicate.ProjectTemplate {
+	return predicate.ProjectTemplate(sql.FieldContains(FieldHighlightAiModel, v))
+}
+
+// HighlightAiModelHasPrefix applies the HasPrefix predicate on the "highlight_ai_model" field.
+func HighlightAiModelHasPrefix(v string) predicate.ProjectTemplate {
+	return predicate.ProjectTemplate(sql.FieldHasPrefix(FieldHighlightAiModel, v))
+}
+
+// HighlightAiModelHasSuffix applies the HasSuffix predicate on the "highlight_ai_model" field.
+func HighlightAiModelHasSuffix(v string) predicate.ProjectTemplate {
+	return predicate.ProjectTemplate(sql.FieldHasSuffix(FieldHighlightAiModel, v))
+}
+
+// HighlightAiModelIsNil applies the IsNil predicate on the "highlight_ai_model" field.
+func HighlightAiModelIsNil() predicate.ProjectTemplate {
+	return predicate.ProjectTemplate(sql.FieldIsNull(FieldHighlightAiModel))
+}
+
+// HighlightAiModelNotNil applies the NotNil predicate on the "highlight_ai_model" field.
+func HighlightAiModelNotNil() predicate.ProjectTemplate {
+	return predicate.ProjectTemplate(sql.FieldNotNull(FieldHighlightAiModel))
+}
+
+// HighlightAiModelEqualFold applies the EqualFold predicate on the "highlight_ai_model" field.
+func HighlightAiModelEqualFold(v string) predicate.ProjectTemplate {
+	return predicate.ProjectTemplate(sql.FieldEqualFold(FieldHighlightAiModel, v))
+}
+
+// HighlightAiModelContainsFold applies the ContainsFold predicate on the "highlight_ai_model" field.
+func HighlightAiModelContainsFold(v string) predicate.ProjectTemplate {
+	return predicate.ProjectTemplate(sql.FieldContainsFold(FieldHighlightAiModel, v))
+}
+
+// HighlightAiPromptEQ applies the EQ predicate on the "highlight_ai_prompt" field.
+func HighlightAiPromptEQ(v string) predicate.ProjectTemplate {
+	return predicate.ProjectTemplate(sql.FieldEQ(FieldHighlightAiPrompt, v))
+}
+
+// HighlightAiPromptNEQ applies the NEQ predicate on the "highlight_ai_prompt" field.
+func HighlightAiPromptNEQ(v string) predicate.ProjectTemplate {
+	return predicate.ProjectTemplate(sql.FieldNEQ(FieldHighlightAiPrompt, v))
+}
+
+// HighlightAiPromptIn applies the In predicate on the "highlight_ai_prompt" field.
+func HighlightAiPromptIn(vs ...string) predicate.ProjectTemplate {
+	return predicate.ProjectTemplate(sql.FieldIn(FieldHighlightAiPrompt, vs...))
+}
+
+// HighlightAiPromptNotIn applies the NotIn predicate on the "highlight_ai_prompt" field.
+func HighlightAiPromptNotIn(vs ...string) predicate.ProjectTemplate {
+	return predicate.ProjectTemplate(sql.FieldNotIn(FieldHighlightAiPrompt, vs...))
+}
+
+// HighlightAiPromptGT applies the GT predicate on the "highlight_ai_prompt" field.
+func HighlightAiPromptGT(v string) predicate.ProjectTemplate {
+	return predicate.ProjectTemplate(sql.FieldGT(FieldHighlightAiPrompt, v))
+}
+
+// HighlightAiPromptGTE applies the GTE predicate on the "highlight_ai_prompt" field.
+func HighlightAiPromptGTE(v string) predicate.ProjectTemplate {
+	return predicate.ProjectTemplate(sql.FieldGTE(FieldHighlightAiPrompt, v))
+}
+
+// HighlightAiPromptLT applies the LT predicate on the "highlight_ai_prompt" field.
+func HighlightAiPromptLT(v string) predicate.ProjectTemplate {
+	return predicate.ProjectTemplate(sql.FieldLT(FieldHighlightAiPrompt, v))
+}
+
+// HighlightAiPromptLTE applies the LTE predicate on the "highlight_ai_prompt" field.
+func HighlightAiPromptLTE(v string) predicate.ProjectTemplate {
+	return predicate.ProjectTemplate(sql.FieldLTE(FieldHighlightAiPrompt, v))
+}
+
+// HighlightAiPromptContains applies the Contains predicate on the "highlight_ai_prompt" field.
+func HighlightAiPromptContains(v string) predicate.ProjectTemplate {
+	return predicate.ProjectTemplate(sql.FieldContains(FieldHighlightAiPrompt, v))
+}
+
+// HighlightAiPromptHasPrefix applies the HasPrefix predicate on the "highlight_ai_prompt" field.
+func HighlightAiPromptHasPrefix(v string) predicate.ProjectTemplate {
+	return predicate.ProjectTemplate(sql.FieldHasPrefix(FieldHighlightAiPrompt, v))
+}
+
+// HighlightAiPromptHasSuffix applies the HasSuffix predicate on the "highlight_ai_prompt" field.
+func HighlightAiPromptHasSuffix(v string) predicate.ProjectTemplate {
+	return predicate.ProjectTemplate(sql.FieldHasSuffix(FieldHighlightAiPrompt, v))
+}
+
+// HighlightAiPromptIsNil applies the IsNil predicate on the "highlight_ai_prompt" field.
+func HighlightAiPromptIsNil() predicate.ProjectTemplate {
+	return predicate.ProjectTemplate(sql.FieldIsNull(FieldHighlightAiPrompt))
+}
+
+// HighlightAiPromptNotNil applies the NotNil predicate on the "highlight_ai_prompt" field.
+func HighlightAiPromptNotNil() predicate.ProjectTemplate {
+	return predicate.ProjectTemplate(sql.FieldNotNull(FieldHighlightAiPrompt))
+}
+
+// HighlightAiPromptEqualFold applies the EqualFold predicate on the "highlight_ai_prompt" field.
+func HighlightAiPromptEqualFold(v string) predicate.ProjectTemplate {
+	return predicate.ProjectTemplate(sql.FieldEqualFold(FieldHighlightAiPrompt, v))
+}
+
+// HighlightAiPromptContainsFold applies the ContainsFold predicate on the "highlight_ai_prompt" field.
+func HighlightAiPromptContainsFold(v string) predicate.ProjectTemplate {
+	return predicate.ProjectTemplate(sql.FieldContainsFold(FieldHighlightAiPrompt, v))
+}
+
+// ExportPresetsIsNil applies the IsNil predicate on the "export_presets" field.
+func ExportPresetsIsNil() predicate.ProjectTemplate {
+	return predicate.ProjectTemplate(sql.FieldIsNull(FieldExportPresets))
+}
+
+// ExportPresetsNotNil applies the NotNil predicate on the "export_presets" field.
+func ExportPresetsNotNil() predicate.ProjectTemplate {
+	return predicate.ProjectTemplate(sql.FieldNotNull(FieldExportPresets))
+}
+
+// SectionTitlesIsNil applies the IsNil predicate on the "section_titles" field.
+func SectionTitlesIsNil() predicate.ProjectTemplate {
+	return predicate.ProjectTemplate(sql.FieldIsNull(FieldSectionTitles))
+}
+
+// SectionTitlesNotNil applies the NotNil predicate on the "section_titles" field.
+func SectionTitlesNotNil() predicate.ProjectTemplate {
+	return predicate.ProjectTemplate(sql.FieldNotNull(FieldSectionTitles))
+}
+
+// CreatedAtEQ applies the EQ predicate on the "created_at" field.
+func CreatedAtEQ(v time.Time) predicate.ProjectTemplate {
+	return predicate.ProjectTemplate(sql.FieldEQ(FieldCreatedAt, v))
+}
+
+// CreatedAtNEQ applies the NEQ predicate on the "created_at" field.
+func CreatedAtNEQ(v time.Time) predicate.ProjectTemplate {
+	return predicate.ProjectTemplate(sql.FieldNEQ(FieldCreatedAt, v))
+}
+
+// CreatedAtIn applies the In predicate on the "created_at" field.
+func CreatedAtIn(vs ...time.Time) predicate.ProjectTemplate {
+	return predicate.ProjectTemplate(sql.FieldIn(FieldCreatedAt, vs...))
+}
+
+// CreatedAtNotIn applies the NotIn predicate on the "created_at" field.
+func CreatedAtNotIn(vs ...time.Time) predicate.ProjectTemplate {
+	return predicate.ProjectTemplate(sql.FieldNotIn(FieldCreatedAt, vs...))
+}
+
+// CreatedAtGT applies the GT predicate on the "created_at" field.
+func CreatedAtGT(v time.Time) predicate.ProjectTemplate {
+	return predicate.ProjectTemplate(sql.FieldGT(FieldCreatedAt, v))
+}
+
+// CreatedAtGTE applies the GTE predicate on the "created_at" field.
+func CreatedAtGTE(v time.Time) predicate.ProjectTemplate {
+	return predicate.ProjectTemplate(sql.FieldGTE(FieldCreatedAt, v))
+}
+
+// CreatedAtLT applies the LT predicate on the "created_at" field.
+func CreatedAtLT(v time.Time) predicate.ProjectTemplate {
+	return predicate.ProjectTemplate(sql.FieldLT(FieldCreatedAt, v))
+}
+
+// CreatedAtLTE applies the LTE predicate on the "created_at" field.
+func CreatedAtLTE(v time.Time) predicate.ProjectTemplate {
+	return predicate.ProjectTemplate(sql.FieldLTE(FieldCreatedAt, v))
+}
+
+// UpdatedAtEQ applies the EQ predicate on the "updated_at" field.
+func UpdatedAtEQ(v time.Time) predicate.ProjectTemplate {
+	return predicate.ProjectTemplate(sql.FieldEQ(FieldUpdatedAt, v))
+}
+
+// UpdatedAtNEQ applies the NEQ predicate on the "updated_at" field.
+func UpdatedAtNEQ(v time.Time) predicate.ProjectTemplate {
+	return predicate.ProjectTemplate(sql.FieldNEQ(FieldUpdatedAt, v))
+}
+
+// UpdatedAtIn applies the In predicate on the "updated_at" field.
+func UpdatedAtIn(vs ...time.Time) predicate.ProjectTemplate {
+	return predicate.ProjectTemplate(sql.FieldIn(FieldUpdatedAt, vs...))
+}
+
+// UpdatedAtNotIn applies the NotIn predicate on the "updated_at" field.
+func UpdatedAtNotIn(vs ...time.Time) predicate.ProjectTemplate {
+	return predicate.ProjectTemplate(sql.FieldNotIn(FieldUpdatedAt, vs...))
+}
+
+// UpdatedAtGT applies the GT predicate on the "updated_at" field.
+func UpdatedAtGT(v time.Time) predicate.ProjectTemplate {
+	return predicate.ProjectTemplate(sql.FieldGT(FieldUpdatedAt, v))
+}
+
+// UpdatedAtGTE applies the GTE predicate on the "updated_at" field.
+func UpdatedAtGTE(v time.Time) predicate.ProjectTemplate {
+	return predicate.ProjectTemplate(sql.FieldGTE(FieldUpdatedAt, v))
+}
+
+// UpdatedAtLT applies the LT predicate on the "updated_at" field.
+func UpdatedAtLT(v time.Time) predicate.ProjectTemplate {
+	return predicate.ProjectTemplate(sql.FieldLT(FieldUpdatedAt, v))
+}
+
+// UpdatedAtLTE applies the LTE predicate on the "updated_at" field.
+func UpdatedAtLTE(v time.Time) predicate.ProjectTemplate {
+	return predicate.ProjectTemplate(sql.FieldLTE(FieldUpdatedAt, v))
+}
+
+// And groups predicates with the AND operator between them.
+func And(predicates ...predicate.ProjectTemplate) predicate.ProjectTemplate {
+	return predicate.ProjectTemplate(sql.AndPredicates(predicates...))
+}
+
+// Or groups predicates with the OR operator between them.
+func Or(predicates ...predicate.ProjectTemplate) predicate.ProjectTemplate {
+	return predicate.ProjectTemplate(sql.OrPredicates(predicates...))
+}
+
+// Not applies the not operator on the given predicate.
+func Not(p predicate.ProjectTemplate) predicate.ProjectTemplate {
+	return predicate.ProjectTemplate(sql.NotPredicates(p))
+}
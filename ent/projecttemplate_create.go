@@ -0,0 +1,356 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"ramble-ai/ent/projecttemplate"
+	"ramble-ai/ent/schema"
+	"time"
+
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+)
+
+// ProjectTemplateCreate is the builder for creating a ProjectTemplate entity.
+type ProjectTemplateCreate struct {
+	config
+	mutation *ProjectTemplateMutation
+	hooks    []Hook
+}
+
+// SetName sets the "name" field.
+func (ptc *ProjectTemplateCreate) SetName(s string) *ProjectTemplateCreate {
+	ptc.mutation.SetName(s)
+	return ptc
+}
+
+// SetDescription sets the "description" field.
+func (ptc *ProjectTemplateCreate) SetDescription(s string) *ProjectTemplateCreate {
+	ptc.mutation.SetDescription(s)
+	return ptc
+}
+
+// SetNillableDescription sets the "description" field if the given value is not nil.
+func (ptc *ProjectTemplateCreate) SetNillableDescription(s *string) *ProjectTemplateCreate {
+	if s != nil {
+		ptc.SetDescription(*s)
+	}
+	return ptc
+}
+
+// SetAiModel sets the "ai_model" field.
+func (ptc *ProjectTemplateCreate) SetAiModel(s string) *ProjectTemplateCreate {
+	ptc.mutation.SetAiModel(s)
+	return ptc
+}
+
+// SetNillableAiModel sets the "ai_model" field if the given value is not nil.
+func (ptc *ProjectTemplateCreate) SetNillableAiModel(s *string) *ProjectTemplateCreate {
+	if s != nil {
+		ptc.SetAiModel(*s)
+	}
+	return ptc
+}
+
+// SetAiPrompt sets the "ai_prompt" field.
+func (ptc *ProjectTemplateCreate) SetAiPrompt(s string) *ProjectTemplateCreate {
+	ptc.mutation.SetAiPrompt(s)
+	return ptc
+}
+
+// SetNillableAiPrompt sets the "ai_prompt" field if the given value is not nil.
+func (ptc *ProjectTemplateCreate) SetNillableAiPrompt(s *string) *ProjectTemplateCreate {
+	if s != nil {
+		ptc.SetAiPrompt(*s)
+	}
+	return ptc
+}
+
+// SetHighlightAiModel sets the "highlight_ai_model" field.
+func (ptc *ProjectTemplateCreate) SetHighlightAiModel(s string) *ProjectTemplateCreate {
+	ptc.mutation.SetHighlightAiModel(s)
+	return ptc
+}
+
+// SetNillableHighlightAiModel sets the "highlight_ai_model" field if the given value is not nil.
+func (ptc *ProjectTemplateCreate) SetNillableHighlightAiModel(s *string) *ProjectTemplateCreate {
+	if s != nil {
+		ptc.SetHighlightAiModel(*s)
+	}
+	return ptc
+}
+
+// SetHighlightAiPrompt sets the "highlight_ai_prompt" field.
+func (ptc *ProjectTemplateCreate) SetHighlightAiPrompt(s string) *ProjectTemplateCreate {
+	ptc.mutation.SetHighlightAiPrompt(s)
+	return ptc
+}
+
+// SetNillableHighlightAiPrompt sets the "highlight_ai_prompt" field if the given value is not nil.
+func (ptc *ProjectTemplateCreate) SetNillableHighlightAiPrompt(s *string) *ProjectTemplateCreate {
+	if s != nil {
+		ptc.SetHighlightAiPrompt(*s)
+	}
+	return ptc
+}
+
+// SetExportPresets sets the "export_presets" field.
+func (ptc *ProjectTemplateCreate) SetExportPresets(sp []schema.ExportPreset) *ProjectTemplateCreate {
+	ptc.mutation.SetExportPresets(sp)
+	return ptc
+}
+
+// SetSectionTitles sets the "section_titles" field.
+func (ptc *ProjectTemplateCreate) SetSectionTitles(s []string) *ProjectTemplateCreate {
+	ptc.mutation.SetSectionTitles(s)
+	return ptc
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (ptc *ProjectTemplateCreate) SetCreatedAt(t time.Time) *ProjectTemplateCreate {
+	ptc.mutation.SetCreatedAt(t)
+	return ptc
+}
+
+// SetNillableCreatedAt sets the "created_at" field if the given value is not nil.
+func (ptc *ProjectTemplateCreate) SetNillableCreatedAt(t *time.Time) *ProjectTemplateCreate {
+	if t != nil {
+		ptc.SetCreatedAt(*t)
+	}
+	return ptc
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (ptc *ProjectTemplateCreate) SetUpdatedAt(t time.Time) *ProjectTemplateCreate {
+	ptc.mutation.SetUpdatedAt(t)
+	return ptc
+}
+
+// SetNillableUpdatedAt sets the "updated_at" field if the given value is not nil.
+func (ptc *ProjectTemplateCreate) SetNillableUpdatedAt(t *time.Time) *ProjectTemplateCreate {
+	if t != nil {
+		ptc.SetUpdatedAt(*t)
+	}
+	return ptc
+}
+
+// Mutation returns the ProjectTemplateMutation object of the builder.
+func (ptc *ProjectTemplateCreate) Mutation() *ProjectTemplateMutation {
+	return ptc.mutation
+}
+
+// Save creates the ProjectTemplate in the database.
+func (ptc *ProjectTemplateCreate) Save(ctx context.Context) (*ProjectTemplate, error) {
+	ptc.defaults()
+	return withHooks(ctx, ptc.sqlSave, ptc.mutation, ptc.hooks)
+}
+
+// SaveX calls Save and panics if Save returns an error.
+func (ptc *ProjectTemplateCreate) SaveX(ctx context.Context) *ProjectTemplate {
+	v, err := ptc.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (ptc *ProjectTemplateCreate) Exec(ctx context.Context) error {
+	_, err := ptc.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (ptc *ProjectTemplateCreate) ExecX(ctx context.Context) {
+	if err := ptc.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (ptc *ProjectTemplateCreate) defaults() {
+	if _, ok := ptc.mutation.CreatedAt(); !ok {
+		v := projecttemplate.DefaultCreatedAt()
+		ptc.mutation.SetCreatedAt(v)
+	}
+	if _, ok := ptc.mutation.UpdatedAt(); !ok {
+		v := projecttemplate.DefaultUpdatedAt()
+		ptc.mutation.SetUpdatedAt(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (ptc *ProjectTemplateCreate) check() error {
+	if _, ok := ptc.mutation.Name(); !ok {
+		return &ValidationError{Name: "name", err: errors.New(`ent: missing required field "ProjectTemplate.name"`)}
+	}
+	if v, ok := ptc.mutation.Name(); ok {
+		if err := projecttemplate.NameValidator(v); err != nil {
+			return &ValidationError{Name: "name", err: fmt.Errorf(`ent: validator failed for field "ProjectTemplate.name": %w`, err)}
+		}
+	}
+	if _, ok := ptc.mutation.CreatedAt(); !ok {
+		return &ValidationError{Name: "created_at", err: errors.New(`ent: missing required field "ProjectTemplate.created_at"`)}
+	}
+	if _, ok := ptc.mutation.UpdatedAt(); !ok {
+		return &ValidationError{Name: "updated_at", err: errors.New(`ent: missing required field "ProjectTemplate.updated_at"`)}
+	}
+	return nil
+}
+
+func (ptc *ProjectTemplateCreate) sqlSave(ctx context.Context) (*ProjectTemplate, error) {
+	if err := ptc.check(); err != nil {
+		return nil, err
+	}
+	_node, _spec := ptc.createSpec()
+	if err := sqlgraph.CreateNode(ctx, ptc.driver, _spec); err != nil {
+		if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	id := _spec.ID.Value.(int64)
+	_node.ID = int(id)
+	ptc.mutation.id = &_node.ID
+	ptc.mutation.done = true
+	return _node, nil
+}
+
+func (ptc *ProjectTemplateCreate) createSpec() (*ProjectTemplate, *sqlgraph.CreateSpec) {
+	var (
+		_node = &ProjectTemplate{config: ptc.config}
+		_spec = sqlgraph.NewCreateSpec(projecttemplate.Table, sqlgraph.NewFieldSpec(projecttemplate.FieldID, field.TypeInt))
+	)
+	if value, ok := ptc.mutation.Name(); ok {
+		_spec.SetField(projecttemplate.FieldName, field.TypeString, value)
+		_node.Name = value
+	}
+	if value, ok := ptc.mutation.Description(); ok {
+		_spec.SetField(projecttemplate.FieldDescription, field.TypeString, value)
+		_node.Description = value
+	}
+	if value, ok := ptc.mutation.AiModel(); ok {
+		_spec.SetField(projecttemplate.FieldAiModel, field.TypeString, value)
+		_node.AiModel = value
+	}
+	if value, ok := ptc.mutation.AiPrompt(); ok {
+		_spec.SetField(projecttemplate.FieldAiPrompt, field.TypeString, value)
+		_node.AiPrompt = value
+	}
+	if value, ok := ptc.mutation.HighlightAiModel(); ok {
+		_spec.SetField(projecttemplate.FieldHighlightAiModel, field.TypeString, value)
+		_node.HighlightAiModel = value
+	}
+	if value, ok := ptc.mutation.HighlightAiPrompt(); ok {
+		_spec.SetField(projecttemplate.FieldHighlightAiPrompt, field.TypeString, value)
+		_node.HighlightAiPrompt = value
+	}
+	if value, ok := ptc.mutation.ExportPresets(); ok {
+		_spec.SetField(projecttemplate.FieldExportPresets, field.TypeJSON, value)
+		_node.ExportPresets = value
+	}
+	if value, ok := ptc.mutation.SectionTitles(); ok {
+		_spec.SetField(projecttemplate.FieldSectionTitles, field.TypeJSON, value)
+		_node.SectionTitles = value
+	}
+	if value, ok := ptc.mutation.CreatedAt(); ok {
+		_spec.SetField(projecttemplate.FieldCreatedAt, field.TypeTime, value)
+		_node.CreatedAt = value
+	}
+	if value, ok := ptc.mutation.UpdatedAt(); ok {
+		_spec.SetField(projecttemplate.FieldUpdatedAt, field.TypeTime, value)
+		_node.UpdatedAt = value
+	}
+	return _node, _spec
+}
+
+// ProjectTemplateCreateBulk is the builder for creating many ProjectTemplate entities in bulk.
+type ProjectTemplateCreateBulk struct {
+	config
+	err      error
+	builders []*ProjectTemplateCreate
+}
+
+// Save creates the ProjectTemplate entities in the database.
+func (ptcb *ProjectTemplateCreateBulk) Save(ctx context.Context) ([]*ProjectTemplate, error) {
+	if ptcb.err != nil {
+		return nil, ptcb.err
+	}
+	specs := make([]*sqlgraph.CreateSpec, len(ptcb.builders))
+	nodes := make([]*ProjectTemplate, len(ptcb.builders))
+	mutators := make([]Mutator, len(ptcb.builders))
+	for i := range ptcb.builders {
+		func(i int, root context.Context) {
+			builder := ptcb.builders[i]
+			builder.defaults()
+			var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+				mutation, ok := m.(*ProjectTemplateMutation)
+				if !ok {
+					return nil, fmt.Errorf("unexpected mutation type %T", m)
+				}
+				if err := builder.check(); err != nil {
+					return nil, err
+				}
+				builder.mutation = mutation
+				var err error
+				nodes[i], specs[i] = builder.createSpec()
+				if i < len(mutators)-1 {
+					_, err = mutators[i+1].Mutate(root, ptcb.builders[i+1].mutation)
+				} else {
+					spec := &sqlgraph.BatchCreateSpec{Nodes: specs}
+					// Invoke the actual operation on the latest mutation in the chain.
+					if err = sqlgraph.BatchCreate(ctx, ptcb.driver, spec); err != nil {
+						if sqlgraph.IsConstraintError(err) {
+							err = &ConstraintError{msg: err.Error(), wrap: err}
+						}
+					}
+				}
+				if err != nil {
+					return nil, err
+				}
+				mutation.id = &nodes[i].ID
+				if specs[i].ID.Value != nil {
+					id := specs[i].ID.Value.(int64)
+					nodes[i].ID = int(id)
+				}
+				mutation.done = true
+				return nodes[i], nil
+			})
+			for i := len(builder.hooks) - 1; i >= 0; i-- {
+				mut = builder.hooks[i](mut)
+			}
+			mutators[i] = mut
+		}(i, ctx)
+	}
+	if len(mutators) > 0 {
+		if _, err := mutators[0].Mutate(ctx, ptcb.builders[0].mutation); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (ptcb *ProjectTemplateCreateBulk) SaveX(ctx context.Context) []*ProjectTemplate {
+	v, err := ptcb.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (ptcb *ProjectTemplateCreateBulk) Exec(ctx context.Context) error {
+	_, err := ptcb.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (ptcb *ProjectTemplateCreateBulk) ExecX(ctx context.Context) {
+	if err := ptcb.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
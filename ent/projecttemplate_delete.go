@@ -0,0 +1,88 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"ramble-ai/ent/predicate"
+	"ramble-ai/ent/projecttemplate"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+)
+
+// ProjectTemplateDelete is the builder for deleting a ProjectTemplate entity.
+type ProjectTemplateDelete struct {
+	config
+	hooks    []Hook
+	mutation *ProjectTemplateMutation
+}
+
+// Where appends a list predicates to the ProjectTemplateDelete builder.
+func (ptd *ProjectTemplateDelete) Where(ps ...predicate.ProjectTemplate) *ProjectTemplateDelete {
+	ptd.mutation.Where(ps...)
+	return ptd
+}
+
+// Exec executes the deletion query and returns how many vertices were deleted.
+func (ptd *ProjectTemplateDelete) Exec(ctx context.Context) (int, error) {
+	return withHooks(ctx, ptd.sqlExec, ptd.mutation, ptd.hooks)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (ptd *ProjectTemplateDelete) ExecX(ctx context.Context) int {
+	n, err := ptd.Exec(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return n
+}
+
+func (ptd *ProjectTemplateDelete) sqlExec(ctx context.Context) (int, error) {
+	_spec := sqlgraph.NewDeleteSpec(projecttemplate.Table, sqlgraph.NewFieldSpec(projecttemplate.FieldID, field.TypeInt))
+	if ps := ptd.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	affected, err := sqlgraph.DeleteNodes(ctx, ptd.driver, _spec)
+	if err != nil && sqlgraph.IsConstraintError(err) {
+		err = &ConstraintError{msg: err.Error(), wrap: err}
+	}
+	ptd.mutation.done = true
+	return affected, err
+}
+
+// ProjectTemplateDeleteOne is the builder for deleting a single ProjectTemplate entity.
+type ProjectTemplateDeleteOne struct {
+	ptd *ProjectTemplateDelete
+}
+
+// Where appends a list predicates to the ProjectTemplateDelete builder.
+func (ptdo *ProjectTemplateDeleteOne) Where(ps ...predicate.ProjectTemplate) *ProjectTemplateDeleteOne {
+	ptdo.ptd.mutation.Where(ps...)
+	return ptdo
+}
+
+// Exec executes the deletion query.
+func (ptdo *ProjectTemplateDeleteOne) Exec(ctx context.Context) error {
+	n, err := ptdo.ptd.Exec(ctx)
+	switch {
+	case err != nil:
+		return err
+	case n == 0:
+		return &NotFoundError{projecttemplate.Label}
+	default:
+		return nil
+	}
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (ptdo *ProjectTemplateDeleteOne) ExecX(ctx context.Context) {
+	if err := ptdo.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
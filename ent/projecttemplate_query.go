@@ -0,0 +1,527 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"fmt"
+	"math"
+	"ramble-ai/ent/predicate"
+	"ramble-ai/ent/projecttemplate"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+)
+
+// ProjectTemplateQuery is the builder for querying ProjectTemplate entities.
+type ProjectTemplateQuery struct {
+	config
+	ctx        *QueryContext
+	order      []projecttemplate.OrderOption
+	inters     []Interceptor
+	predicates []predicate.ProjectTemplate
+	// intermediate query (i.e. traversal path).
+	sql  *sql.Selector
+	path func(context.Context) (*sql.Selector, error)
+}
+
+// Where adds a new predicate for the ProjectTemplateQuery builder.
+func (ptq *ProjectTemplateQuery) Where(ps ...predicate.ProjectTemplate) *ProjectTemplateQuery {
+	ptq.predicates = append(ptq.predicates, ps...)
+	return ptq
+}
+
+// Limit the number of records to be returned by this query.
+func (ptq *ProjectTemplateQuery) Limit(limit int) *ProjectTemplateQuery {
+	ptq.ctx.Limit = &limit
+	return ptq
+}
+
+// Offset to start from.
+func (ptq *ProjectTemplateQuery) Offset(offset int) *ProjectTemplateQuery {
+	ptq.ctx.Offset = &offset
+	return ptq
+}
+
+// Unique configures the query builder to filter duplicate records on query.
+// By default, unique is set to true, and can be disabled using this method.
+func (ptq *ProjectTemplateQuery) Unique(unique bool) *ProjectTemplateQuery {
+	ptq.ctx.Unique = &unique
+	return ptq
+}
+
+// Order specifies how the records should be ordered.
+func (ptq *ProjectTemplateQuery) Order(o ...projecttemplate.OrderOption) *ProjectTemplateQuery {
+	ptq.order = append(ptq.order, o...)
+	return ptq
+}
+
+// First returns the first ProjectTemplate entity from the query.
+// Returns a *NotFoundError when no ProjectTemplate was found.
+func (ptq *ProjectTemplateQuery) First(ctx context.Context) (*ProjectTemplate, error) {
+	nodes, err := ptq.Limit(1).All(setContextOp(ctx, ptq.ctx, ent.OpQueryFirst))
+	if err != nil {
+		return nil, err
+	}
+	if len(nodes) == 0 {
+		return nil, &NotFoundError{projecttemplate.Label}
+	}
+	return nodes[0], nil
+}
+
+// FirstX is like First, but panics if an error occurs.
+func (ptq *ProjectTemplateQuery) FirstX(ctx context.Context) *ProjectTemplate {
+	node, err := ptq.First(ctx)
+	if err != nil && !IsNotFound(err) {
+		panic(err)
+	}
+	return node
+}
+
+// FirstID returns the first ProjectTemplate ID from the query.
+// Returns a *NotFoundError when no ProjectTemplate ID was found.
+func (ptq *ProjectTemplateQuery) FirstID(ctx context.Context) (id int, err error) {
+	var ids []int
+	if ids, err = ptq.Limit(1).IDs(setContextOp(ctx, ptq.ctx, ent.OpQueryFirstID)); err != nil {
+		return
+	}
+	if len(ids) == 0 {
+		err = &NotFoundError{projecttemplate.Label}
+		return
+	}
+	return ids[0], nil
+}
+
+// FirstIDX is like FirstID, but panics if an error occurs.
+func (ptq *ProjectTemplateQuery) FirstIDX(ctx context.Context) int {
+	id, err := ptq.FirstID(ctx)
+	if err != nil && !IsNotFound(err) {
+		panic(err)
+	}
+	return id
+}
+
+// Only returns a single ProjectTemplate entity found by the query, ensuring it only returns one.
+// Returns a *NotSingularError when more than one ProjectTemplate entity is found.
+// Returns a *NotFoundError when no ProjectTemplate entities are found.
+func (ptq *ProjectTemplateQuery) Only(ctx context.Context) (*ProjectTemplate, error) {
+	nodes, err := ptq.Limit(2).All(setContextOp(ctx, ptq.ctx, ent.OpQueryOnly))
+	if err != nil {
+		return nil, err
+	}
+	switch len(nodes) {
+	case 1:
+		return nodes[0], nil
+	case 0:
+		return nil, &NotFoundError{projecttemplate.Label}
+	default:
+		return nil, &NotSingularError{projecttemplate.Label}
+	}
+}
+
+// OnlyX is like Only, but panics if an error occurs.
+func (ptq *ProjectTemplateQuery) OnlyX(ctx context.Context) *ProjectTemplate {
+	node, err := ptq.Only(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// OnlyID is like Only, but returns the only ProjectTemplate ID in the query.
+// Returns a *NotSingularError when more than one ProjectTemplate ID is found.
+// Returns a *NotFoundError when no entities are found.
+func (ptq *ProjectTemplateQuery) OnlyID(ctx context.Context) (id int, err error) {
+	var ids []int
+	if ids, err = ptq.Limit(2).IDs(setContextOp(ctx, ptq.ctx, ent.OpQueryOnlyID)); err != nil {
+		return
+	}
+	switch len(ids) {
+	case 1:
+		id = ids[0]
+	case 0:
+		err = &NotFoundError{projecttemplate.Label}
+	default:
+		err = &NotSingularError{projecttemplate.Label}
+	}
+	return
+}
+
+// OnlyIDX is like OnlyID, but panics if an error occurs.
+func (ptq *ProjectTemplateQuery) OnlyIDX(ctx context.Context) int {
+	id, err := ptq.OnlyID(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return id
+}
+
+// All executes the query and returns a list of ProjectTemplates.
+func (ptq *ProjectTemplateQuery) All(ctx context.Context) ([]*ProjectTemplate, error) {
+	ctx = setContextOp(ctx, ptq.ctx, ent.OpQueryAll)
+	if err := ptq.prepareQuery(ctx); err != nil {
+		return nil, err
+	}
+	qr := querierAll[[]*ProjectTemplate, *ProjectTemplateQuery]()
+	return withInterceptors[[]*ProjectTemplate](ctx, ptq, qr, ptq.inters)
+}
+
+// AllX is like All, but panics if an error occurs.
+func (ptq *ProjectTemplateQuery) AllX(ctx context.Context) []*ProjectTemplate {
+	nodes, err := ptq.All(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return nodes
+}
+
+// IDs executes the query and returns a list of ProjectTemplate IDs.
+func (ptq *ProjectTemplateQuery) IDs(ctx context.Context) (ids []int, err error) {
+	if ptq.ctx.Unique == nil && ptq.path != nil {
+		ptq.Unique(true)
+	}
+	ctx = setContextOp(ctx, ptq.ctx, ent.OpQueryIDs)
+	if err = ptq.Select(projecttemplate.FieldID).Scan(ctx, &ids); err != nil {
+		return nil, err
+	}
+	return ids, nil
+}
+
+// IDsX is like IDs, but panics if an error occurs.
+func (ptq *ProjectTemplateQuery) IDsX(ctx context.Context) []int {
+	ids, err := ptq.IDs(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return ids
+}
+
+// Count returns the count of the given query.
+func (ptq *ProjectTemplateQuery) Count(ctx context.Context) (int, error) {
+	ctx = setContextOp(ctx, ptq.ctx, ent.OpQueryCount)
+	if err := ptq.prepareQuery(ctx); err != nil {
+		return 0, err
+	}
+	return withInterceptors[int](ctx, ptq, querierCount[*ProjectTemplateQuery](), ptq.inters)
+}
+
+// CountX is like Count, but panics if an error occurs.
+func (ptq *ProjectTemplateQuery) CountX(ctx context.Context) int {
+	count, err := ptq.Count(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return count
+}
+
+// Exist returns true if the query has elements in the graph.
+func (ptq *ProjectTemplateQuery) Exist(ctx context.Context) (bool, error) {
+	ctx = setContextOp(ctx, ptq.ctx, ent.OpQueryExist)
+	switch _, err := ptq.FirstID(ctx); {
+	case IsNotFound(err):
+		return false, nil
+	case err != nil:
+		return false, fmt.Errorf("ent: check existence: %w", err)
+	default:
+		return true, nil
+	}
+}
+
+// ExistX is like Exist, but panics if an error occurs.
+func (ptq *ProjectTemplateQuery) ExistX(ctx context.Context) bool {
+	exist, err := ptq.Exist(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return exist
+}
+
+// Clone returns a duplicate of the ProjectTemplateQuery builder, including all associated steps. It can be
+// used to prepare common query builders and use them differently after the clone is made.
+func (ptq *ProjectTemplateQuery) Clone() *ProjectTemplateQuery {
+	if ptq == nil {
+		return nil
+	}
+	return &ProjectTemplateQuery{
+		config:     ptq.config,
+		ctx:        ptq.ctx.Clone(),
+		order:      append([]projecttemplate.OrderOption{}, ptq.order...),
+		inters:     append([]Interceptor{}, ptq.inters...),
+		predicates: append([]predicate.ProjectTemplate{}, ptq.predicates...),
+		// clone intermediate query.
+		sql:  ptq.sql.Clone(),
+		path: ptq.path,
+	}
+}
+
+// GroupBy is used to group vertices by one or more fields/columns.
+// It is often used with aggregate functions, like: count, max, mean, min, sum.
+//
+// Example:
+//
+//	var v []struct {
+//		Name string `json:"name,omitempty"`
+//		Count int `json:"count,omitempty"`
+//	}
+//
+//	client.ProjectTemplate.Query().
+//		GroupBy(projecttemplate.FieldName).
+//		Aggregate(ent.Count()).
+//		Scan(ctx, &v)
+func (ptq *ProjectTemplateQuery) GroupBy(field string, fields ...string) *ProjectTemplateGroupBy {
+	ptq.ctx.Fields = append([]string{field}, fields...)
+	grbuild := &ProjectTemplateGroupBy{build: ptq}
+	grbuild.flds = &ptq.ctx.Fields
+	grbuild.label = projecttemplate.Label
+	grbuild.scan = grbuild.Scan
+	return grbuild
+}
+
+// Select allows the selection one or more fields/columns for the given query,
+// instead of selecting all fields in the entity.
+//
+// Example:
+//
+//	var v []struct {
+//		Name string `json:"name,omitempty"`
+//	}
+//
+//	client.ProjectTemplate.Query().
+//		Select(projecttemplate.FieldName).
+//		Scan(ctx, &v)
+func (ptq *ProjectTemplateQuery) Select(fields ...string) *ProjectTemplateSelect {
+	ptq.ctx.Fields = append(ptq.ctx.Fields, fields...)
+	sbuild := &ProjectTemplateSelect{ProjectTemplateQuery: ptq}
+	sbuild.label = projecttemplate.Label
+	sbuild.flds, sbuild.scan = &ptq.ctx.Fields, sbuild.Scan
+	return sbuild
+}
+
+// Aggregate returns a ProjectTemplateSelect configured with the given aggregations.
+func (ptq *ProjectTemplateQuery) Aggregate(fns ...AggregateFunc) *ProjectTemplateSelect {
+	return ptq.Select().Aggregate(fns...)
+}
+
+func (ptq *ProjectTemplateQuery) prepareQuery(ctx context.Context) error {
+	for _, inter := range ptq.inters {
+		if inter == nil {
+			return fmt.Errorf("ent: uninitialized interceptor (forgotten import ent/runtime?)")
+		}
+		if trv, ok := inter.(Traverser); ok {
+			if err := trv.Traverse(ctx, ptq); err != nil {
+				return err
+			}
+		}
+	}
+	for _, f := range ptq.ctx.Fields {
+		if !projecttemplate.ValidColumn(f) {
+			return &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+		}
+	}
+	if ptq.path != nil {
+		prev, err := ptq.path(ctx)
+		if err != nil {
+			return err
+		}
+		ptq.sql = prev
+	}
+	return nil
+}
+
+func (ptq *ProjectTemplateQuery) sqlAll(ctx context.Context, hooks ...queryHook) ([]*ProjectTemplate, error) {
+	var (
+		nodes = []*ProjectTemplate{}
+		_spec = ptq.querySpec()
+	)
+	_spec.ScanValues = func(columns []string) ([]any, error) {
+		return (*ProjectTemplate).scanValues(nil, columns)
+	}
+	_spec.Assign = func(columns []string, values []any) error {
+		node := &ProjectTemplate{config: ptq.config}
+		nodes = append(nodes, node)
+		return node.assignValues(columns, values)
+	}
+	for i := range hooks {
+		hooks[i](ctx, _spec)
+	}
+	if err := sqlgraph.QueryNodes(ctx, ptq.driver, _spec); err != nil {
+		return nil, err
+	}
+	if len(nodes) == 0 {
+		return nodes, nil
+	}
+	return nodes, nil
+}
+
+func (ptq *ProjectTemplateQuery) sqlCount(ctx context.Context) (int, error) {
+	_spec := ptq.querySpec()
+	_spec.Node.Columns = ptq.ctx.Fields
+	if len(ptq.ctx.Fields) > 0 {
+		_spec.Unique = ptq.ctx.Unique != nil && *ptq.ctx.Unique
+	}
+	return sqlgraph.CountNodes(ctx, ptq.driver, _spec)
+}
+
+func (ptq *ProjectTemplateQuery) querySpec() *sqlgraph.QuerySpec {
+	_spec := sqlgraph.NewQuerySpec(projecttemplate.Table, projecttemplate.Columns, sqlgraph.NewFieldSpec(projecttemplate.FieldID, field.TypeInt))
+	_spec.From = ptq.sql
+	if unique := ptq.ctx.Unique; unique != nil {
+		_spec.Unique = *unique
+	} else if ptq.path != nil {
+		_spec.Unique = true
+	}
+	if fields := ptq.ctx.Fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, projecttemplate.FieldID)
+		for i := range fields {
+			if fields[i] != projecttemplate.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, fields[i])
+			}
+		}
+	}
+	if ps := ptq.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if limit := ptq.ctx.Limit; limit != nil {
+		_spec.Limit = *limit
+	}
+	if offset := ptq.ctx.Offset; offset != nil {
+		_spec.Offset = *offset
+	}
+	if ps := ptq.order; len(ps) > 0 {
+		_spec.Order = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	return _spec
+}
+
+func (ptq *ProjectTemplateQuery) sqlQuery(ctx context.Context) *sql.Selector {
+	builder := sql.Dialect(ptq.driver.Dialect())
+	t1 := builder.Table(projecttemplate.Table)
+	columns := ptq.ctx.Fields
+	if len(columns) == 0 {
+		columns = projecttemplate.Columns
+	}
+	selector := builder.Select(t1.Columns(columns...)...).From(t1)
+	if ptq.sql != nil {
+		selector = ptq.sql
+		selector.Select(selector.Columns(columns...)...)
+	}
+	if ptq.ctx.Unique != nil && *ptq.ctx.Unique {
+		selector.Distinct()
+	}
+	for _, p := range ptq.predicates {
+		p(selector)
+	}
+	for _, p := range ptq.order {
+		p(selector)
+	}
+	if offset := ptq.ctx.Offset; offset != nil {
+		// limit is mandatory for offset clause. We start
+		// with default value, and override it below if needed.
+		selector.Offset(*offset).Limit(math.MaxInt32)
+	}
+	if limit := ptq.ctx.Limit; limit != nil {
+		selector.Limit(*limit)
+	}
+	return selector
+}
+
+// ProjectTemplateGroupBy is the group-by builder for ProjectTemplate entities.
+type ProjectTemplateGroupBy struct {
+	selector
+	build *ProjectTemplateQuery
+}
+
+// Aggregate adds the given aggregation functions to the group-by query.
+func (ptgb *ProjectTemplateGroupBy) Aggregate(fns ...AggregateFunc) *ProjectTemplateGroupBy {
+	ptgb.fns = append(ptgb.fns, fns...)
+	return ptgb
+}
+
+// Scan applies the selector query and scans the result into the given value.
+func (ptgb *ProjectTemplateGroupBy) Scan(ctx context.Context, v any) error {
+	ctx = setContextOp(ctx, ptgb.build.ctx, ent.OpQueryGroupBy)
+	if err := ptgb.build.prepareQuery(ctx); err != nil {
+		return err
+	}
+	return scanWithInterceptors[*ProjectTemplateQuery, *ProjectTemplateGroupBy](ctx, ptgb.build, ptgb, ptgb.build.inters, v)
+}
+
+func (ptgb *ProjectTemplateGroupBy) sqlScan(ctx context.Context, root *ProjectTemplateQuery, v any) error {
+	selector := root.sqlQuery(ctx).Select()
+	aggregation := make([]string, 0, len(ptgb.fns))
+	for _, fn := range ptgb.fns {
+		aggregation = append(aggregation, fn(selector))
+	}
+	if len(selector.SelectedColumns()) == 0 {
+		columns := make([]string, 0, len(*ptgb.flds)+len(ptgb.fns))
+		for _, f := range *ptgb.flds {
+			columns = append(columns, selector.C(f))
+		}
+		columns = append(columns, aggregation...)
+		selector.Select(columns...)
+	}
+	selector.GroupBy(selector.Columns(*ptgb.flds...)...)
+	if err := selector.Err(); err != nil {
+		return err
+	}
+	rows := &sql.Rows{}
+	query, args := selector.Query()
+	if err := ptgb.build.driver.Query(ctx, query, args, rows); err != nil {
+		return err
+	}
+	defer rows.Close()
+	return sql.ScanSlice(rows, v)
+}
+
+// ProjectTemplateSelect is the builder for selecting fields of ProjectTemplate entities.
+type ProjectTemplateSelect struct {
+	*ProjectTemplateQuery
+	selector
+}
+
+// Aggregate adds the given aggregation functions to the selector query.
+func (pts *ProjectTemplateSelect) Aggregate(fns ...AggregateFunc) *ProjectTemplateSelect {
+	pts.fns = append(pts.fns, fns...)
+	return pts
+}
+
+// Scan applies the selector query and scans the result into the given value.
+func (pts *ProjectTemplateSelect) Scan(ctx context.Context, v any) error {
+	ctx = setContextOp(ctx, pts.ctx, ent.OpQuerySelect)
+	if err := pts.prepareQuery(ctx); err != nil {
+		return err
+	}
+	return scanWithInterceptors[*ProjectTemplateQuery, *ProjectTemplateSelect](ctx, pts.ProjectTemplateQuery, pts, pts.inters, v)
+}
+
+func (pts *ProjectTemplateSelect) sqlScan(ctx context.Context, root *ProjectTemplateQuery, v any) error {
+	selector := root.sqlQuery(ctx)
+	aggregation := make([]string, 0, len(pts.fns))
+	for _, fn := range pts.fns {
+		aggregation = append(aggregation, fn(selector))
+	}
+	switch n := len(*pts.selector.flds); {
+	case n == 0 && len(aggregation) > 0:
+		selector.Select(aggregation...)
+	case n != 0 && len(aggregation) > 0:
+		selector.AppendSelect(aggregation...)
+	}
+	rows := &sql.Rows{}
+	query, args := selector.Query()
+	if err := pts.driver.Query(ctx, query, args, rows); err != nil {
+		return err
+	}
+	defer rows.Close()
+	return sql.ScanSlice(rows, v)
+}
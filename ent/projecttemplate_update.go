@@ -0,0 +1,684 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"ramble-ai/ent/predicate"
+	"ramble-ai/ent/projecttemplate"
+	"ramble-ai/ent/schema"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/dialect/sql/sqljson"
+	"entgo.io/ent/schema/field"
+)
+
+// ProjectTemplateUpdate is the builder for updating ProjectTemplate entities.
+type ProjectTemplateUpdate struct {
+	config
+	hooks    []Hook
+	mutation *ProjectTemplateMutation
+}
+
+// Where appends a list predicates to the ProjectTemplateUpdate builder.
+func (ptu *ProjectTemplateUpdate) Where(ps ...predicate.ProjectTemplate) *ProjectTemplateUpdate {
+	ptu.mutation.Where(ps...)
+	return ptu
+}
+
+// SetName sets the "name" field.
+func (ptu *ProjectTemplateUpdate) SetName(s string) *ProjectTemplateUpdate {
+	ptu.mutation.SetName(s)
+	return ptu
+}
+
+// SetNillableName sets the "name" field if the given value is not nil.
+func (ptu *ProjectTemplateUpdate) SetNillableName(s *string) *ProjectTemplateUpdate {
+	if s != nil {
+		ptu.SetName(*s)
+	}
+	return ptu
+}
+
+// SetDescription sets the "description" field.
+func (ptu *ProjectTemplateUpdate) SetDescription(s string) *ProjectTemplateUpdate {
+	ptu.mutation.SetDescription(s)
+	return ptu
+}
+
+// SetNillableDescription sets the "description" field if the given value is not nil.
+func (ptu *ProjectTemplateUpdate) SetNillableDescription(s *string) *ProjectTemplateUpdate {
+	if s != nil {
+		ptu.SetDescription(*s)
+	}
+	return ptu
+}
+
+// ClearDescription clears the value of the "description" field.
+func (ptu *ProjectTemplateUpdate) ClearDescription() *ProjectTemplateUpdate {
+	ptu.mutation.ClearDescription()
+	return ptu
+}
+
+// SetAiModel sets the "ai_model" field.
+func (ptu *ProjectTemplateUpdate) SetAiModel(s string) *ProjectTemplateUpdate {
+	ptu.mutation.SetAiModel(s)
+	return ptu
+}
+
+// SetNillableAiModel sets the "ai_model" field if the given value is not nil.
+func (ptu *ProjectTemplateUpdate) SetNillableAiModel(s *string) *ProjectTemplateUpdate {
+	if s != nil {
+		ptu.SetAiModel(*s)
+	}
+	return ptu
+}
+
+// ClearAiModel clears the value of the "ai_model" field.
+func (ptu *ProjectTemplateUpdate) ClearAiModel() *ProjectTemplateUpdate {
+	ptu.mutation.ClearAiModel()
+	return ptu
+}
+
+// SetAiPrompt sets the "ai_prompt" field.
+func (ptu *ProjectTemplateUpdate) SetAiPrompt(s string) *ProjectTemplateUpdate {
+	ptu.mutation.SetAiPrompt(s)
+	return ptu
+}
+
+// SetNillableAiPrompt sets the "ai_prompt" field if the given value is not nil.
+func (ptu *ProjectTemplateUpdate) SetNillableAiPrompt(s *string) *ProjectTemplateUpdate {
+	if s != nil {
+		ptu.SetAiPrompt(*s)
+	}
+	return ptu
+}
+
+// ClearAiPrompt clears the value of the "ai_prompt" field.
+func (ptu *ProjectTemplateUpdate) ClearAiPrompt() *ProjectTemplateUpdate {
+	ptu.mutation.ClearAiPrompt()
+	return ptu
+}
+
+// SetHighlightAiModel sets the "highlight_ai_model" field.
+func (ptu *ProjectTemplateUpdate) SetHighlightAiModel(s string) *ProjectTemplateUpdate {
+	ptu.mutation.SetHighlightAiModel(s)
+	return ptu
+}
+
+// SetNillableHighlightAiModel sets the "highlight_ai_model" field if the given value is not nil.
+func (ptu *ProjectTemplateUpdate) SetNillableHighlightAiModel(s *string) *ProjectTemplateUpdate {
+	if s != nil {
+		ptu.SetHighlightAiModel(*s)
+	}
+	return ptu
+}
+
+// ClearHighlightAiModel clears the value of the "highlight_ai_model" field.
+func (ptu *ProjectTemplateUpdate) ClearHighlightAiModel() *ProjectTemplateUpdate {
+	ptu.mutation.ClearHighlightAiModel()
+	return ptu
+}
+
+// SetHighlightAiPrompt sets the "highlight_ai_prompt" field.
+func (ptu *ProjectTemplateUpdate) SetHighlightAiPrompt(s string) *ProjectTemplateUpdate {
+	ptu.mutation.SetHighlightAiPrompt(s)
+	return ptu
+}
+
+// SetNillableHighlightAiPrompt sets the "highlight_ai_prompt" field if the given value is not nil.
+func (ptu *ProjectTemplateUpdate) SetNillableHighlightAiPrompt(s *string) *ProjectTemplateUpdate {
+	if s != nil {
+		ptu.SetHighlightAiPrompt(*s)
+	}
+	return ptu
+}
+
+// ClearHighlightAiPrompt clears the value of the "highlight_ai_prompt" field.
+func (ptu *ProjectTemplateUpdate) ClearHighlightAiPrompt() *ProjectTemplateUpdate {
+	ptu.mutation.ClearHighlightAiPrompt()
+	return ptu
+}
+
+// SetExportPresets sets the "export_presets" field.
+func (ptu *ProjectTemplateUpdate) SetExportPresets(sp []schema.ExportPreset) *ProjectTemplateUpdate {
+	ptu.mutation.SetExportPresets(sp)
+	return ptu
+}
+
+// AppendExportPresets appends sp to the "export_presets" field.
+func (ptu *ProjectTemplateUpdate) AppendExportPresets(sp []schema.ExportPreset) *ProjectTemplateUpdate {
+	ptu.mutation.AppendExportPresets(sp)
+	return ptu
+}
+
+// ClearExportPresets clears the value of the "export_presets" field.
+func (ptu *ProjectTemplateUpdate) ClearExportPresets() *ProjectTemplateUpdate {
+	ptu.mutation.ClearExportPresets()
+	return ptu
+}
+
+// SetSectionTitles sets the "section_titles" field.
+func (ptu *ProjectTemplateUpdate) SetSectionTitles(s []string) *ProjectTemplateUpdate {
+	ptu.mutation.SetSectionTitles(s)
+	return ptu
+}
+
+// AppendSectionTitles appends s to the "section_titles" field.
+func (ptu *ProjectTemplateUpdate) AppendSectionTitles(s []string) *ProjectTemplateUpdate {
+	ptu.mutation.AppendSectionTitles(s)
+	return ptu
+}
+
+// ClearSectionTitles clears the value of the "section_titles" field.
+func (ptu *ProjectTemplateUpdate) ClearSectionTitles() *ProjectTemplateUpdate {
+	ptu.mutation.ClearSectionTitles()
+	return ptu
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (ptu *ProjectTemplateUpdate) SetCreatedAt(t time.Time) *ProjectTemplateUpdate {
+	ptu.mutation.SetCreatedAt(t)
+	return ptu
+}
+
+// SetNillableCreatedAt sets the "created_at" field if the given value is not nil.
+func (ptu *ProjectTemplateUpdate) SetNillableCreatedAt(t *time.Time) *ProjectTemplateUpdate {
+	if t != nil {
+		ptu.SetCreatedAt(*t)
+	}
+	return ptu
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (ptu *ProjectTemplateUpdate) SetUpdatedAt(t time.Time) *ProjectTemplateUpdate {
+	ptu.mutation.SetUpdatedAt(t)
+	return ptu
+}
+
+// Mutation returns the ProjectTemplateMutation object of the builder.
+func (ptu *ProjectTemplateUpdate) Mutation() *ProjectTemplateMutation {
+	return ptu.mutation
+}
+
+// Save executes the query and returns the number of nodes affected by the update operation.
+func (ptu *ProjectTemplateUpdate) Save(ctx context.Context) (int, error) {
+	ptu.defaults()
+	return withHooks(ctx, ptu.sqlSave, ptu.mutation, ptu.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (ptu *ProjectTemplateUpdate) SaveX(ctx context.Context) int {
+	affected, err := ptu.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return affected
+}
+
+// Exec executes the query.
+func (ptu *ProjectTemplateUpdate) Exec(ctx context.Context) error {
+	_, err := ptu.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (ptu *ProjectTemplateUpdate) ExecX(ctx context.Context) {
+	if err := ptu.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (ptu *ProjectTemplateUpdate) defaults() {
+	if _, ok := ptu.mutation.UpdatedAt(); !ok {
+		v := projecttemplate.UpdateDefaultUpdatedAt()
+		ptu.mutation.SetUpdatedAt(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (ptu *ProjectTemplateUpdate) check() error {
+	if v, ok := ptu.mutation.Name(); ok {
+		if err := projecttemplate.NameValidator(v); err != nil {
+			return &ValidationError{Name: "name", err: fmt.Errorf(`ent: validator failed for field "ProjectTemplate.name": %w`, err)}
+		}
+	}
+	return nil
+}
+
+func (ptu *ProjectTemplateUpdate) sqlSave(ctx context.Context) (n int, err error) {
+	if err := ptu.check(); err != nil {
+		return n, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(projecttemplate.Table, projecttemplate.Columns, sqlgraph.NewFieldSpec(projecttemplate.FieldID, field.TypeInt))
+	if ps := ptu.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := ptu.mutation.Name(); ok {
+		_spec.SetField(projecttemplate.FieldName, field.TypeString, value)
+	}
+	if value, ok := ptu.mutation.Description(); ok {
+		_spec.SetField(projecttemplate.FieldDescription, field.TypeString, value)
+	}
+	if ptu.mutation.DescriptionCleared() {
+		_spec.ClearField(projecttemplate.FieldDescription, field.TypeString)
+	}
+	if value, ok := ptu.mutation.AiModel(); ok {
+		_spec.SetField(projecttemplate.FieldAiModel, field.TypeString, value)
+	}
+	if ptu.mutation.AiModelCleared() {
+		_spec.ClearField(projecttemplate.FieldAiModel, field.TypeString)
+	}
+	if value, ok := ptu.mutation.AiPrompt(); ok {
+		_spec.SetField(projecttemplate.FieldAiPrompt, field.TypeString, value)
+	}
+	if ptu.mutation.AiPromptCleared() {
+		_spec.ClearField(projecttemplate.FieldAiPrompt, field.TypeString)
+	}
+	if value, ok := ptu.mutation.HighlightAiModel(); ok {
+		_spec.SetField(projecttemplate.FieldHighlightAiModel, field.TypeString, value)
+	}
+	if ptu.mutation.HighlightAiModelCleared() {
+		_spec.ClearField(projecttemplate.FieldHighlightAiModel, field.TypeString)
+	}
+	if value, ok := ptu.mutation.HighlightAiPrompt(); ok {
+		_spec.SetField(projecttemplate.FieldHighlightAiPrompt, field.TypeString, value)
+	}
+	if ptu.mutation.HighlightAiPromptCleared() {
+		_spec.ClearField(projecttemplate.FieldHighlightAiPrompt, field.TypeString)
+	}
+	if value, ok := ptu.mutation.ExportPresets(); ok {
+		_spec.SetField(projecttemplate.FieldExportPresets, field.TypeJSON, value)
+	}
+	if value, ok := ptu.mutation.AppendedExportPresets(); ok {
+		_spec.AddModifier(func(u *sql.UpdateBuilder) {
+			sqljson.Append(u, projecttemplate.FieldExportPresets, value)
+		})
+	}
+	if ptu.mutation.ExportPresetsCleared() {
+		_spec.ClearField(projecttemplate.FieldExportPresets, field.TypeJSON)
+	}
+	if value, ok := ptu.mutation.SectionTitles(); ok {
+		_spec.SetField(projecttemplate.FieldSectionTitles, field.TypeJSON, value)
+	}
+	if value, ok := ptu.mutation.AppendedSectionTitles(); ok {
+		_spec.AddModifier(func(u *sql.UpdateBuilder) {
+			sqljson.Append(u, projecttemplate.FieldSectionTitles, value)
+		})
+	}
+	if ptu.mutation.SectionTitlesCleared() {
+		_spec.ClearField(projecttemplate.FieldSectionTitles, field.TypeJSON)
+	}
+	if value, ok := ptu.mutation.CreatedAt(); ok {
+		_spec.SetField(projecttemplate.FieldCreatedAt, field.TypeTime, value)
+	}
+	if value, ok := ptu.mutation.UpdatedAt(); ok {
+		_spec.SetField(projecttemplate.FieldUpdatedAt, field.TypeTime, value)
+	}
+	if n, err = sqlgraph.UpdateNodes(ctx, ptu.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{projecttemplate.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return 0, err
+	}
+	ptu.mutation.done = true
+	return n, nil
+}
+
+// ProjectTemplateUpdateOne is the builder for updating a single ProjectTemplate entity.
+type ProjectTemplateUpdateOne struct {
+	config
+	fields   []string
+	hooks    []Hook
+	mutation *ProjectTemplateMutation
+}
+
+// SetName sets the "name" field.
+func (ptuo *ProjectTemplateUpdateOne) SetName(s string) *ProjectTemplateUpdateOne {
+	ptuo.mutation.SetName(s)
+	return ptuo
+}
+
+// SetNillableName sets the "name" field if the given value is not nil.
+func (ptuo *ProjectTemplateUpdateOne) SetNillableName(s *string) *ProjectTemplateUpdateOne {
+	if s != nil {
+		ptuo.SetName(*s)
+	}
+	return ptuo
+}
+
+// SetDescription sets the "description" field.
+func (ptuo *ProjectTemplateUpdateOne) SetDescription(s string) *ProjectTemplateUpdateOne {
+	ptuo.mutation.SetDescription(s)
+	return ptuo
+}
+
+// SetNillableDescription sets the "description" field if the given value is not nil.
+func (ptuo *ProjectTemplateUpdateOne) SetNillableDescription(s *string) *ProjectTemplateUpdateOne {
+	if s != nil {
+		ptuo.SetDescription(*s)
+	}
+	return ptuo
+}
+
+// ClearDescription clears the value of the "description" field.
+func (ptuo *ProjectTemplateUpdateOne) ClearDescription() *ProjectTemplateUpdateOne {
+	ptuo.mutation.ClearDescription()
+	return ptuo
+}
+
+// SetAiModel sets the "ai_model" field.
+func (ptuo *ProjectTemplateUpdateOne) SetAiModel(s string) *ProjectTemplateUpdateOne {
+	ptuo.mutation.SetAiModel(s)
+	return ptuo
+}
+
+// SetNillableAiModel sets the "ai_model" field if the given value is not nil.
+func (ptuo *ProjectTemplateUpdateOne) SetNillableAiModel(s *string) *ProjectTemplateUpdateOne {
+	if s != nil {
+		ptuo.SetAiModel(*s)
+	}
+	return ptuo
+}
+
+// ClearAiModel clears the value of the "ai_model" field.
+func (ptuo *ProjectTemplateUpdateOne) ClearAiModel() *ProjectTemplateUpdateOne {
+	ptuo.mutation.ClearAiModel()
+	return ptuo
+}
+
+// SetAiPrompt sets the "ai_prompt" field.
+func (ptuo *ProjectTemplateUpdateOne) SetAiPrompt(s string) *ProjectTemplateUpdateOne {
+	ptuo.mutation.SetAiPrompt(s)
+	return ptuo
+}
+
+// SetNillableAiPrompt sets the "ai_prompt" field if the given value is not nil.
+func (ptuo *ProjectTemplateUpdateOne) SetNillableAiPrompt(s *string) *ProjectTemplateUpdateOne {
+	if s != nil {
+		ptuo.SetAiPrompt(*s)
+	}
+	return ptuo
+}
+
+// ClearAiPrompt clears the value of the "ai_prompt" field.
+func (ptuo *ProjectTemplateUpdateOne) ClearAiPrompt() *ProjectTemplateUpdateOne {
+	ptuo.mutation.ClearAiPrompt()
+	return ptuo
+}
+
+// SetHighlightAiModel sets the "highlight_ai_model" field.
+func (ptuo *ProjectTemplateUpdateOne) SetHighlightAiModel(s string) *ProjectTemplateUpdateOne {
+	ptuo.mutation.SetHighlightAiModel(s)
+	return ptuo
+}
+
+// SetNillableHighlightAiModel sets the "highlight_ai_model" field if the given value is not nil.
+func (ptuo *ProjectTemplateUpdateOne) SetNillableHighlightAiModel(s *string) *ProjectTemplateUpdateOne {
+	if s != nil {
+		ptuo.SetHighlightAiModel(*s)
+	}
+	return ptuo
+}
+
+// ClearHighlightAiModel clears the value of the "highlight_ai_model" field.
+func (ptuo *ProjectTemplateUpdateOne) ClearHighlightAiModel() *ProjectTemplateUpdateOne {
+	ptuo.mutation.ClearHighlightAiModel()
+	return ptuo
+}
+
+// SetHighlightAiPrompt sets the "highlight_ai_prompt" field.
+func (ptuo *ProjectTemplateUpdateOne) SetHighlightAiPrompt(s string) *ProjectTemplateUpdateOne {
+	ptuo.mutation.SetHighlightAiPrompt(s)
+	return ptuo
+}
+
+// SetNillableHighlightAiPrompt sets the "highlight_ai_prompt" field if the given value is not nil.
+func (ptuo *ProjectTemplateUpdateOne) SetNillableHighlightAiPrompt(s *string) *ProjectTemplateUpdateOne {
+	if s != nil {
+		ptuo.SetHighlightAiPrompt(*s)
+	}
+	return ptuo
+}
+
+// ClearHighlightAiPrompt clears the value of the "highlight_ai_prompt" field.
+func (ptuo *ProjectTemplateUpdateOne) ClearHighlightAiPrompt() *ProjectTemplateUpdateOne {
+	ptuo.mutation.ClearHighlightAiPrompt()
+	return ptuo
+}
+
+// SetExportPresets sets the "export_presets" field.
+func (ptuo *ProjectTemplateUpdateOne) SetExportPresets(sp []schema.ExportPreset) *ProjectTemplateUpdateOne {
+	ptuo.mutation.SetExportPresets(sp)
+	return ptuo
+}
+
+// AppendExportPresets appends sp to the "export_presets" field.
+func (ptuo *ProjectTemplateUpdateOne) AppendExportPresets(sp []schema.ExportPreset) *ProjectTemplateUpdateOne {
+	ptuo.mutation.AppendExportPresets(sp)
+	return ptuo
+}
+
+// ClearExportPresets clears the value of the "export_presets" field.
+func (ptuo *ProjectTemplateUpdateOne) ClearExportPresets() *ProjectTemplateUpdateOne {
+	ptuo.mutation.ClearExportPresets()
+	return ptuo
+}
+
+// SetSectionTitles sets the "section_titles" field.
+func (ptuo *ProjectTemplateUpdateOne) SetSectionTitles(s []string) *ProjectTemplateUpdateOne {
+	ptuo.mutation.SetSectionTitles(s)
+	return ptuo
+}
+
+// AppendSectionTitles appends s to the "section_titles" field.
+func (ptuo *ProjectTemplateUpdateOne) AppendSectionTitles(s []string) *ProjectTemplateUpdateOne {
+	ptuo.mutation.AppendSectionTitles(s)
+	return ptuo
+}
+
+// ClearSectionTitles clears the value of the "section_titles" field.
+func (ptuo *ProjectTemplateUpdateOne) ClearSectionTitles() *ProjectTemplateUpdateOne {
+	ptuo.mutation.ClearSectionTitles()
+	return ptuo
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (ptuo *ProjectTemplateUpdateOne) SetCreatedAt(t time.Time) *ProjectTemplateUpdateOne {
+	ptuo.mutation.SetCreatedAt(t)
+	return ptuo
+}
+
+// SetNillableCreatedAt sets the "created_at" field if the given value is not nil.
+func (ptuo *ProjectTemplateUpdateOne) SetNillableCreatedAt(t *time.Time) *ProjectTemplateUpdateOne {
+	if t != nil {
+		ptuo.SetCreatedAt(*t)
+	}
+	return ptuo
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (ptuo *ProjectTemplateUpdateOne) SetUpdatedAt(t time.Time) *ProjectTemplateUpdateOne {
+	ptuo.mutation.SetUpdatedAt(t)
+	return ptuo
+}
+
+// Mutation returns the ProjectTemplateMutation object of the builder.
+func (ptuo *ProjectTemplateUpdateOne) Mutation() *ProjectTemplateMutation {
+	return ptuo.mutation
+}
+
+// Where appends a list predicates to the ProjectTemplateUpdate builder.
+func (ptuo *ProjectTemplateUpdateOne) Where(ps ...predicate.ProjectTemplate) *ProjectTemplateUpdateOne {
+	ptuo.mutation.Where(ps...)
+	return ptuo
+}
+
+// Select allows selecting one or more fields (columns) of the returned entity.
+// The default is selecting all fields defined in the entity schema.
+func (ptuo *ProjectTemplateUpdateOne) Select(field string, fields ...string) *ProjectTemplateUpdateOne {
+	ptuo.fields = append([]string{field}, fields...)
+	return ptuo
+}
+
+// Save executes the query and returns the updated ProjectTemplate entity.
+func (ptuo *ProjectTemplateUpdateOne) Save(ctx context.Context) (*ProjectTemplate, error) {
+	ptuo.defaults()
+	return withHooks(ctx, ptuo.sqlSave, ptuo.mutation, ptuo.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (ptuo *ProjectTemplateUpdateOne) SaveX(ctx context.Context) *ProjectTemplate {
+	node, err := ptuo.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// Exec executes the query on the entity.
+func (ptuo *ProjectTemplateUpdateOne) Exec(ctx context.Context) error {
+	_, err := ptuo.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (ptuo *ProjectTemplateUpdateOne) ExecX(ctx context.Context) {
+	if err := ptuo.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (ptuo *ProjectTemplateUpdateOne) defaults() {
+	if _, ok := ptuo.mutation.UpdatedAt(); !ok {
+		v := projecttemplate.UpdateDefaultUpdatedAt()
+		ptuo.mutation.SetUpdatedAt(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (ptuo *ProjectTemplateUpdateOne) check() error {
+	if v, ok := ptuo.mutation.Name(); ok {
+		if err := projecttemplate.NameValidator(v); err != nil {
+			return &ValidationError{Name: "name", err: fmt.Errorf(`ent: validator failed for field "ProjectTemplate.name": %w`, err)}
+		}
+	}
+	return nil
+}
+
+func (ptuo *ProjectTemplateUpdateOne) sqlSave(ctx context.Context) (_node *ProjectTemplate, err error) {
+	if err := ptuo.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(projecttemplate.Table, projecttemplate.Columns, sqlgraph.NewFieldSpec(projecttemplate.FieldID, field.TypeInt))
+	id, ok := ptuo.mutation.ID()
+	if !ok {
+		return nil, &ValidationError{Name: "id", err: errors.New(`ent: missing "ProjectTemplate.id" for update`)}
+	}
+	_spec.Node.ID.Value = id
+	if fields := ptuo.fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, projecttemplate.FieldID)
+		for _, f := range fields {
+			if !projecttemplate.ValidColumn(f) {
+				return nil, &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+			}
+			if f != projecttemplate.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, f)
+			}
+		}
+	}
+	if ps := ptuo.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := ptuo.mutation.Name(); ok {
+		_spec.SetField(projecttemplate.FieldName, field.TypeString, value)
+	}
+	if value, ok := ptuo.mutation.Description(); ok {
+		_spec.SetField(projecttemplate.FieldDescription, field.TypeString, value)
+	}
+	if ptuo.mutation.DescriptionCleared() {
+		_spec.ClearField(projecttemplate.FieldDescription, field.TypeString)
+	}
+	if value, ok := ptuo.mutation.AiModel(); ok {
+		_spec.SetField(projecttemplate.FieldAiModel, field.TypeString, value)
+	}
+	if ptuo.mutation.AiModelCleared() {
+		_spec.ClearField(projecttemplate.FieldAiModel, field.TypeString)
+	}
+	if value, ok := ptuo.mutation.AiPrompt(); ok {
+		_spec.SetField(projecttemplate.FieldAiPrompt, field.TypeString, value)
+	}
+	if ptuo.mutation.AiPromptCleared() {
+		_spec.ClearField(projecttemplate.FieldAiPrompt, field.TypeString)
+	}
+	if value, ok := ptuo.mutation.HighlightAiModel(); ok {
+		_spec.SetField(projecttemplate.FieldHighlightAiModel, field.TypeString, value)
+	}
+	if ptuo.mutation.HighlightAiModelCleared() {
+		_spec.ClearField(projecttemplate.FieldHighlightAiModel, field.TypeString)
+	}
+	if value, ok := ptuo.mutation.HighlightAiPrompt(); ok {
+		_spec.SetField(projecttemplate.FieldHighlightAiPrompt, field.TypeString, value)
+	}
+	if ptuo.mutation.HighlightAiPromptCleared() {
+		_spec.ClearField(projecttemplate.FieldHighlightAiPrompt, field.TypeString)
+	}
+	if value, ok := ptuo.mutation.ExportPresets(); ok {
+		_spec.SetField(projecttemplate.FieldExportPresets, field.TypeJSON, value)
+	}
+	if value, ok := ptuo.mutation.AppendedExportPresets(); ok {
+		_spec.AddModifier(func(u *sql.UpdateBuilder) {
+			sqljson.Append(u, projecttemplate.FieldExportPresets, value)
+		})
+	}
+	if ptuo.mutation.ExportPresetsCleared() {
+		_spec.ClearField(projecttemplate.FieldExportPresets, field.TypeJSON)
+	}
+	if value, ok := ptuo.mutation.SectionTitles(); ok {
+		_spec.SetField(projecttemplate.FieldSectionTitles, field.TypeJSON, value)
+	}
+	if value, ok := ptuo.mutation.AppendedSectionTitles(); ok {
+		_spec.AddModifier(func(u *sql.UpdateBuilder) {
+			sqljson.Append(u, projecttemplate.FieldSectionTitles, value)
+		})
+	}
+	if ptuo.mutation.SectionTitlesCleared() {
+		_spec.ClearField(projecttemplate.FieldSectionTitles, field.TypeJSON)
+	}
+	if value, ok := ptuo.mutation.CreatedAt(); ok {
+		_spec.SetField(projecttemplate.FieldCreatedAt, field.TypeTime, value)
+	}
+	if value, ok := ptuo.mutation.UpdatedAt(); ok {
+		_spec.SetField(projecttemplate.FieldUpdatedAt, field.TypeTime, value)
+	}
+	_node = &ProjectTemplate{config: ptuo.config}
+	_spec.Assign = _node.assignValues
+	_spec.ScanValues = _node.scanValues
+	if err = sqlgraph.UpdateNode(ctx, ptuo.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{projecttemplate.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	ptuo.mutation.done = true
+	return _node, nil
+}
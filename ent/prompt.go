@@ -0,0 +1,218 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"encoding/json"
+	"fmt"
+	"ramble-ai/ent/project"
+	"ramble-ai/ent/prompt"
+	"ramble-ai/ent/schema"
+	"strings"
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/sql"
+)
+
+// Prompt is the model entity for the Prompt schema.
+type Prompt struct {
+	config `json:"-"`
+	// ID of the ent.
+	ID int `json:"id,omitempty"`
+	// Prompt name, shared by all of its versions
+	Name string `json:"name,omitempty"`
+	// Version number, starting at 1
+	Version int `json:"version,omitempty"`
+	// Task the prompt is written for ('suggest', 'reorder', 'silence' or 'chat:<endpoint>')
+	TaskType string `json:"task_type,omitempty"`
+	// Prompt text with {{variable}} placeholders
+	Body string `json:"body,omitempty"`
+	// What the prompt is for
+	Description string `json:"description,omitempty"`
+	// Placeholders used in the body
+	Variables []schema.PromptVariable `json:"variables,omitempty"`
+	// Project the prompt belongs to (unset = shared across all projects)
+	ProjectID int `json:"project_id,omitempty"`
+	// Creation timestamp of this version
+	CreatedAt time.Time `json:"created_at,omitempty"`
+	// Edges holds the relations/edges for other nodes in the graph.
+	// The values are being populated by the PromptQuery when eager-loading is set.
+	Edges        PromptEdges `json:"edges"`
+	selectValues sql.SelectValues
+}
+
+// PromptEdges holds the relations/edges for other nodes in the graph.
+type PromptEdges struct {
+	// Project holds the value of the project edge.
+	Project *Project `json:"project,omitempty"`
+	// loadedTypes holds the information for reporting if a
+	// type was loaded (or requested) in eager-loading or not.
+	loadedTypes [1]bool
+}
+
+// ProjectOrErr returns the Project value or an error if the edge
+// was not loaded in eager-loading, or loaded but was not found.
+func (e PromptEdges) ProjectOrErr() (*Project, error) {
+	if e.Project != nil {
+		return e.Project, nil
+	} else if e.loadedTypes[0] {
+		return nil, &NotFoundError{label: project.Label}
+	}
+	return nil, &NotLoadedError{edge: "project"}
+}
+
+// scanValues returns the types for scanning values from sql.Rows.
+func (*Prompt) scanValues(columns []string) ([]any, error) {
+	values := make([]any, len(columns))
+	for i := range columns {
+		switch columns[i] {
+		case prompt.FieldVariables:
+			values[i] = new([]byte)
+		case prompt.FieldID, prompt.FieldVersion, prompt.FieldProjectID:
+			values[i] = new(sql.NullInt64)
+		case prompt.FieldName, prompt.FieldTaskType, prompt.FieldBody, prompt.FieldDescription:
+			values[i] = new(sql.NullString)
+		case prompt.FieldCreatedAt:
+			values[i] = new(sql.NullTime)
+		default:
+			values[i] = new(sql.UnknownType)
+		}
+	}
+	return values, nil
+}
+
+// assignValues assigns the values that were returned from sql.Rows (after scanning)
+// to the Prompt fields.
+func (pr *Prompt) assignValues(columns []string, values []any) error {
+	if m, n := len(values), len(columns); m < n {
+		return fmt.Errorf("mismatch number of scan values: %d != %d", m, n)
+	}
+	for i := range columns {
+		switch columns[i] {
+		case prompt.FieldID:
+			value, ok := values[i].(*sql.NullInt64)
+			if !ok {
+				return fmt.Errorf("unexpected type %T for field id", value)
+			}
+			pr.ID = int(value.Int64)
+		case prompt.FieldName:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field name", values[i])
+			} else if value.Valid {
+				pr.Name = value.String
+			}
+		case prompt.FieldVersion:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field version", values[i])
+			} else if value.Valid {
+				pr.Version = int(value.Int64)
+			}
+		case prompt.FieldTaskType:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field task_type", values[i])
+			} else if value.Valid {
+				pr.TaskType = value.String
+			}
+		case prompt.FieldBody:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field body", values[i])
+			} else if value.Valid {
+				pr.Body = value.String
+			}
+		case prompt.FieldDescription:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field description", values[i])
+			} else if value.Valid {
+				pr.Description = value.String
+			}
+		case prompt.FieldVariables:
+			if value, ok := values[i].(*[]byte); !ok {
+				return fmt.Errorf("unexpected type %T for field variables", values[i])
+			} else if value != nil && len(*value) > 0 {
+				if err := json.Unmarshal(*value, &pr.Variables); err != nil {
+					return fmt.Errorf("unmarshal field variables: %w", err)
+				}
+			}
+		case prompt.FieldProjectID:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field project_id", values[i])
+			} else if value.Valid {
+				pr.ProjectID = int(value.Int64)
+			}
+		case prompt.FieldCreatedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field created_at", values[i])
+			} else if value.Valid {
+				pr.CreatedAt = value.Time
+			}
+		default:
+			pr.selectValues.Set(columns[i], values[i])
+		}
+	}
+	return nil
+}
+
+// Value returns the ent.Value that was dynamically selected and assigned to the Prompt.
+// This includes values selected through modifiers, order, etc.
+func (pr *Prompt) Value(name string) (ent.Value, error) {
+	return pr.selectValues.Get(name)
+}
+
+// QueryProject queries the "project" edge of the Prompt entity.
+func (pr *Prompt) QueryProject() *ProjectQuery {
+	return NewPromptClient(pr.config).QueryProject(pr)
+}
+
+// Update returns a builder for updating this Prompt.
+// Note that you need to call Prompt.Unwrap() before calling this method if this Prompt
+// was returned from a transaction, and the transaction was committed or rolled back.
+func (pr *Prompt) Update() *PromptUpdateOne {
+	return NewPromptClient(pr.config).UpdateOne(pr)
+}
+
+// Unwrap unwraps the Prompt entity that was returned from a transaction after it was closed,
+// so that all future queries will be executed through the driver which created the transaction.
+func (pr *Prompt) Unwrap() *Prompt {
+	_tx, ok := pr.config.driver.(*txDriver)
+	if !ok {
+		panic("ent: Prompt is not a transactional entity")
+	}
+	pr.config.driver = _tx.drv
+	return pr
+}
+
+// String implements the fmt.Stringer.
+func (pr *Prompt) String() string {
+	var builder strings.Builder
+	builder.WriteString("Prompt(")
+	builder.WriteString(fmt.Sprintf("id=%v, ", pr.ID))
+	builder.WriteString("name=")
+	builder.WriteString(pr.Name)
+	builder.WriteString(", ")
+	builder.WriteString("version=")
+	builder.WriteString(fmt.Sprintf("%v", pr.Version))
+	builder.WriteString(", ")
+	builder.WriteString("task_type=")
+	builder.WriteString(pr.TaskType)
+	builder.WriteString(", ")
+	builder.WriteString("body=")
+	builder.WriteString(pr.Body)
+	builder.WriteString(", ")
+	builder.WriteString("description=")
+	builder.WriteString(pr.Description)
+	builder.WriteString(", ")
+	builder.WriteString("variables=")
+	builder.WriteString(fmt.Sprintf("%v", pr.Variables))
+	builder.WriteString(", ")
+	builder.WriteString("project_id=")
+	builder.WriteString(fmt.Sprintf("%v", pr.ProjectID))
+	builder.WriteString(", ")
+	builder.WriteString("created_at=")
+	builder.WriteString(pr.CreatedAt.Format(time.ANSIC))
+	builder.WriteByte(')')
+	return builder.String()
+}
+
+// Prompts is a parsable slice of Prompt.
+type Prompts []*Prompt
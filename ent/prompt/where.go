@@ -0,0 +1,519 @@
+// Code generated by ent, DO NOT EDIT.
+
+package prompt
+
+import (
+	"ramble-ai/ent/predicate"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+)
+
+// ID filters vertices based on their ID field.
+func ID(id int) predicate.Prompt {
+	return predicate.Prompt(sql.FieldEQ(FieldID, id))
+}
+
+// IDEQ applies the EQ predicate on the ID field.
+func IDEQ(id int) predicate.Prompt {
+	return predicate.Prompt(sql.FieldEQ(FieldID, id))
+}
+
+// IDNEQ applies the NEQ predicate on the ID field.
+func IDNEQ(id int) predicate.Prompt {
+	return predicate.Prompt(sql.FieldNEQ(FieldID, id))
+}
+
+// IDIn applies the In predicate on the ID field.
+func IDIn(ids ...int) predicate.Prompt {
+	return predicate.Prompt(sql.FieldIn(FieldID, ids...))
+}
+
+// IDNotIn applies the NotIn predicate on the ID field.
+func IDNotIn(ids ...int) predicate.Prompt {
+	return predicate.Prompt(sql.FieldNotIn(FieldID, ids...))
+}
+
+// IDGT applies the GT predicate on the ID field.
+func IDGT(id int) predicate.Prompt {
+	return predicate.Prompt(sql.FieldGT(FieldID, id))
+}
+
+// IDGTE applies the GTE predicate on the ID field.
+func IDGTE(id int) predicate.Prompt {
+	return predicate.Prompt(sql.FieldGTE(FieldID, id))
+}
+
+// IDLT applies the LT predicate on the ID field.
+func IDLT(id int) predicate.Prompt {
+	return predicate.Prompt(sql.FieldLT(FieldID, id))
+}
+
+// IDLTE applies the LTE predicate on the ID field.
+func IDLTE(id int) predicate.Prompt {
+	return predicate.Prompt(sql.FieldLTE(FieldID, id))
+}
+
+// Name applies equality check predicate on the "name" field. It's identical to NameEQ.
+func Name(v string) predicate.Prompt {
+	return predicate.Prompt(sql.FieldEQ(FieldName, v))
+}
+
+// Version applies equality check predicate on the "version" field. It's identical to VersionEQ.
+func Version(v int) predicate.Prompt {
+	return predicate.Prompt(sql.FieldEQ(FieldVersion, v))
+}
+
+// TaskType applies equality check predicate on the "task_type" field. It's identical to TaskTypeEQ.
+func TaskType(v string) predicate.Prompt {
+	return predicate.Prompt(sql.FieldEQ(FieldTaskType, v))
+}
+
+// Body applies equality check predicate on the "body" field. It's identical to BodyEQ.
+func Body(v string) predicate.Prompt {
+	return predicate.Prompt(sql.FieldEQ(FieldBody, v))
+}
+
+// Description applies equality check predicate on the "description" field. It's identical to DescriptionEQ.
+func Description(v string) predicate.Prompt {
+	return predicate.Prompt(sql.FieldEQ(FieldDescription, v))
+}
+
+// ProjectID applies equality check predicate on the "project_id" field. It's identical to ProjectIDEQ.
+func ProjectID(v int) predicate.Prompt {
+	return predicate.Prompt(sql.FieldEQ(FieldProjectID, v))
+}
+
+// CreatedAt applies equality check predicate on the "created_at" field. It's identical to CreatedAtEQ.
+func CreatedAt(v time.Time) predicate.Prompt {
+	return predicate.Prompt(sql.FieldEQ(FieldCreatedAt, v))
+}
+
+// NameEQ applies the EQ predicate on the "name" field.
+func NameEQ(v string) predicate.Prompt {
+	return predicate.Prompt(sql.FieldEQ(FieldName, v))
+}
+
+// NameNEQ applies the NEQ predicate on the "name" field.
+func NameNEQ(v string) predicate.Prompt {
+	return predicate.Prompt(sql.FieldNEQ(FieldName, v))
+}
+
+// NameIn applies the In predicate on the "name" field.
+func NameIn(vs ...string) predicate.Prompt {
+	return predicate.Prompt(sql.FieldIn(FieldName, vs...))
+}
+
+// NameNotIn applies the NotIn predicate on the "name" field.
+func NameNotIn(vs ...string) predicate.Prompt {
+	return predicate.Prompt(sql.FieldNotIn(FieldName, vs...))
+}
+
+// NameGT applies the GT predicate on the "name" field.
+func NameGT(v string) predicate.Prompt {
+	return predicate.Prompt(sql.FieldGT(FieldName, v))
+}
+
+// NameGTE applies the GTE predicate on the "name" field.
+func NameGTE(v string) predicate.Prompt {
+	return predicate.Prompt(sql.FieldGTE(FieldName, v))
+}
+
+// NameLT applies the LT predicate on the "name" field.
+func NameLT(v string) predicate.Prompt {
+	return predicate.Prompt(sql.FieldLT(FieldName, v))
+}
+
+// NameLTE applies the LTE predicate on the "name" field.
+func NameLTE(v string) predicate.Prompt {
+	return predicate.Prompt(sql.FieldLTE(FieldName, v))
+}
+
+// NameContains applies the Contains predicate on the "name" field.
+func NameContains(v string) predicate.Prompt {
+	return predicate.Prompt(sql.FieldContains(FieldName, v))
+}
+
+// NameHasPrefix applies the HasPrefix predicate on the "name" field.
+func NameHasPrefix(v string) predicate.Prompt {
+	return predicate.Prompt(sql.FieldHasPrefix(FieldName, v))
+}
+
+// NameHasSuffix applies the HasSuffix predicate on the "name" field.
+func NameHasSuffix(v string) predicate.Prompt {
+	return predicate.Prompt(sql.FieldHasSuffix(FieldName, v))
+}
+
+// NameEqualFold applies the EqualFold predicate on the "name" field.
+func NameEqualFold(v string) predicate.Prompt {
+	return predicate.Prompt(sql.FieldEqualFold(FieldName, v))
+}
+
+// NameContainsFold applies the ContainsFold predicate on the "name" field.
+func NameContainsFold(v string) predicate.Prompt {
+	return predicate.Prompt(sql.FieldContainsFold(FieldName, v))
+}
+
+// VersionEQ applies the EQ predicate on the "version" field.
+func VersionEQ(v int) predicate.Prompt {
+	return predicate.Prompt(sql.FieldEQ(FieldVersion, v))
+}
+
+// VersionNEQ applies the NEQ predicate on the "version" field.
+func VersionNEQ(v int) predicate.Prompt {
+	return predicate.Prompt(sql.FieldNEQ(FieldVersion, v))
+}
+
+// VersionIn applies the In predicate on the "version" field.
+func VersionIn(vs ...int) predicate.Prompt {
+	return predicate.Prompt(sql.FieldIn(FieldVersion, vs...))
+}
+
+// VersionNotIn applies the NotIn predicate on the "version" field.
+func VersionNotIn(vs ...int) predicate.Prompt {
+	return predicate.Prompt(sql.FieldNotIn(FieldVersion, vs...))
+}
+
+// VersionGT applies the GT predicate on the "version" field.
+func VersionGT(v int) predicate.Prompt {
+	return predicate.Prompt(sql.FieldGT(FieldVersion, v))
+}
+
+// VersionGTE applies the GTE predicate on the "version" field.
+func VersionGTE(v int) predicate.Prompt {
+	return predicate.Prompt(sql.FieldGTE(FieldVersion, v))
+}
+
+// VersionLT applies the LT predicate on the "version" field.
+func VersionLT(v int) predicate.Prompt {
+	return predicate.Prompt(sql.FieldLT(FieldVersion, v))
+}
+
+// VersionLTE applies the LTE predicate on the "version" field.
+func VersionLTE(v int) predicate.Prompt {
+	return predicate.Prompt(sql.FieldLTE(FieldVersion, v))
+}
+
+// TaskTypeEQ applies the EQ predicate on the "task_type" field.
+func TaskTypeEQ(v string) predicate.Prompt {
+	return predicate.Prompt(sql.FieldEQ(FieldTaskType, v))
+}
+
+// TaskTypeNEQ applies the NEQ predicate on the "task_type" field.
+func TaskTypeNEQ(v string) predicate.Prompt {
+	return predicate.Prompt(sql.FieldNEQ(FieldTaskType, v))
+}
+
+// TaskTypeIn applies the In predicate on the "task_type" field.
+func TaskTypeIn(vs ...string) predicate.Prompt {
+	return predicate.Prompt(sql.FieldIn(FieldTaskType, vs...))
+}
+
+// TaskTypeNotIn applies the NotIn predicate on the "task_type" field.
+func TaskTypeNotIn(vs ...string) predicate.Prompt {
+	return predicate.Prompt(sql.FieldNotIn(FieldTaskType, vs...))
+}
+
+// TaskTypeGT applies the GT predicate on the "task_type" field.
+func TaskTypeGT(v string) predicate.Prompt {
+	return predicate.Prompt(sql.FieldGT(FieldTaskType, v))
+}
+
+// TaskTypeGTE applies the GTE predicate on the "task_type" field.
+func TaskTypeGTE(v string) predicate.Prompt {
+	return predicate.Prompt(sql.FieldGTE(FieldTaskType, v))
+}
+
+// TaskTypeLT applies the LT predicate on the "task_type" field.
+func TaskTypeLT(v string) predicate.Prompt {
+	return predicate.Prompt(sql.FieldLT(FieldTaskType, v))
+}
+
+// TaskTypeLTE applies the LTE predicate on the "task_type" field.
+func TaskTypeLTE(v string) predicate.Prompt {
+	return predicate.Prompt(sql.FieldLTE(FieldTaskType, v))
+}
+
+// TaskTypeContains applies the Contains predicate on the "task_type" field.
+func TaskTypeContains(v string) predicate.Prompt {
+	return predicate.Prompt(sql.FieldContains(FieldTaskType, v))
+}
+
+// TaskTypeHasPrefix applies the HasPrefix predicate on the "task_type" field.
+func TaskTypeHasPrefix(v string) predicate.Prompt {
+	return predicate.Prompt(sql.FieldHasPrefix(FieldTaskType, v))
+}
+
+// TaskTypeHasSuffix applies the HasSuffix predicate on the "task_type" field.
+func TaskTypeHasSuffix(v string) predicate.Prompt {
+	return predicate.Prompt(sql.FieldHasSuffix(FieldTaskType, v))
+}
+
+// TaskTypeEqualFold applies the EqualFold predicate on the "task_type" field.
+func TaskTypeEqualFold(v string) predicate.Prompt {
+	return predicate.Prompt(sql.FieldEqualFold(FieldTaskType, v))
+}
+
+// TaskTypeContainsFold applies the ContainsFold predicate on the "task_type" field.
+func TaskTypeContainsFold(v string) predicate.Prompt {
+	return predicate.Prompt(sql.FieldContainsFold(FieldTaskType, v))
+}
+
+// BodyEQ applies the EQ predicate on the "body" field.
+func BodyEQ(v string) predicate.Prompt {
+	return predicate.Prompt(sql.FieldEQ(FieldBody, v))
+}
+
+// BodyNEQ applies the NEQ predicate on the "body" field.
+func BodyNEQ(v string) predicate.Prompt {
+	return predicate.Prompt(sql.FieldNEQ(FieldBody, v))
+}
+
+// BodyIn applies the In predicate on the "body" field.
+func BodyIn(vs ...string) predicate.Prompt {
+	return predicate.Prompt(sql.FieldIn(FieldBody, vs...))
+}
+
+// BodyNotIn applies the NotIn predicate on the "body" field.
+func BodyNotIn(vs ...string) predicate.Prompt {
+	return predicate.Prompt(sql.FieldNotIn(FieldBody, vs...))
+}
+
+// BodyGT applies the GT predicate on the "body" field.
+func BodyGT(v string) predicate.Prompt {
+	return predicate.Prompt(sql.FieldGT(FieldBody, v))
+}
+
+// BodyGTE applies the GTE predicate on the "body" field.
+func BodyGTE(v string) predicate.Prompt {
+	return predicate.Prompt(sql.FieldGTE(FieldBody, v))
+}
+
+// BodyLT applies the LT predicate on the "body" field.
+func BodyLT(v string) predicate.Prompt {
+	return predicate.Prompt(sql.FieldLT(FieldBody, v))
+}
+
+// BodyLTE applies the LTE predicate on the "body" field.
+func BodyLTE(v string) predicate.Prompt {
+	return predicate.Prompt(sql.FieldLTE(FieldBody, v))
+}
+
+// BodyContains applies the Contains predicate on the "body" field.
+func BodyContains(v string) predicate.Prompt {
+	return predicate.Prompt(sql.FieldContains(FieldBody, v))
+}
+
+// BodyHasPrefix applies the HasPrefix predicate on the "body" field.
+func BodyHasPrefix(v string) predicate.Prompt {
+	return predicate.Prompt(sql.FieldHasPrefix(FieldBody, v))
+}
+
+// BodyHasSuffix applies the HasSuffix predicate on the "body" field.
+func BodyHasSuffix(v string) predicate.Prompt {
+	return predicate.Prompt(sql.FieldHasSuffix(FieldBody, v))
+}
+
+// BodyEqualFold applies the EqualFold predicate on the "body" field.
+func BodyEqualFold(v string) predicate.Prompt {
+	return predicate.Prompt(sql.FieldEqualFold(FieldBody, v))
+}
+
+// BodyContainsFold applies the ContainsFold predicate on the "body" field.
+func BodyContainsFold(v string) predicate.Prompt {
+	return predicate.Prompt(sql.FieldContainsFold(FieldBody, v))
+}
+
+// DescriptionEQ applies the EQ predicate on the "description" field.
+func DescriptionEQ(v string) predicate.Prompt {
+	return predicate.Prompt(sql.FieldEQ(FieldDescription, v))
+}
+
+// DescriptionNEQ applies the NEQ predicate on the "description" field.
+func DescriptionNEQ(v string) predicate.Prompt {
+	return predicate.Prompt(sql.FieldNEQ(FieldDescription, v))
+}
+
+// DescriptionIn applies the In predicate on the "description" field.
+func DescriptionIn(vs ...string) predicate.Prompt {
+	return predicate.Prompt(sql.FieldIn(FieldDescription, vs...))
+}
+
+// DescriptionNotIn applies the NotIn predicate on the "description" field.
+func DescriptionNotIn(vs ...string) predicate.Prompt {
+	return predicate.Prompt(sql.FieldNotIn(FieldDescription, vs...))
+}
+
+// DescriptionGT applies the GT predicate on the "description" field.
+func DescriptionGT(v string) predicate.Prompt {
+	return predicate.Prompt(sql.FieldGT(FieldDescription, v))
+}
+
+// DescriptionGTE applies the GTE predicate on the "description" field.
+func DescriptionGTE(v string) predicate.Prompt {
+	return predicate.Prompt(sql.FieldGTE(FieldDescription, v))
+}
+
+// DescriptionLT applies the LT predicate on the "description" field.
+func DescriptionLT(v string) predicate.Prompt {
+	return predicate.Prompt(sql.FieldLT(FieldDescription, v))
+}
+
+// DescriptionLTE applies the LTE predicate on the "description" field.
+func DescriptionLTE(v string) predicate.Prompt {
+	return predicate.Prompt(sql.FieldLTE(FieldDescription, v))
+}
+
+// DescriptionContains applies the Contains predicate on the "description" field.
+func DescriptionContains(v string) predicate.Prompt {
+	return predicate.Prompt(sql.FieldContains(FieldDescription, v))
+}
+
+// DescriptionHasPrefix applies the HasPrefix predicate on the "description" field.
+func DescriptionHasPrefix(v string) predicate.Prompt {
+	return predicate.Prompt(sql.FieldHasPrefix(FieldDescription, v))
+}
+
+// DescriptionHasSuffix applies the HasSuffix predicate on the "description" field.
+func DescriptionHasSuffix(v string) predicate.Prompt {
+	return predicate.Prompt(sql.FieldHasSuffix(FieldDescription, v))
+}
+
+// DescriptionIsNil applies the IsNil predicate on the "description" field.
+func DescriptionIsNil() predicate.Prompt {
+	return predicate.Prompt(sql.FieldIsNull(FieldDescription))
+}
+
+// DescriptionNotNil applies the NotNil predicate on the "description" field.
+func DescriptionNotNil() predicate.Prompt {
+	return predicate.Prompt(sql.FieldNotNull(FieldDescription))
+}
+
+// DescriptionEqualFold applies the EqualFold predicate on the "description" field.
+func DescriptionEqualFold(v string) predicate.Prompt {
+	return predicate.Prompt(sql.FieldEqualFold(FieldDescription, v))
+}
+
+// DescriptionContainsFold applies the ContainsFold predicate on the "description" field.
+func DescriptionContainsFold(v string) predicate.Prompt {
+	return predicate.Prompt(sql.FieldContainsFold(FieldDescription, v))
+}
+
+// VariablesIsNil applies the IsNil predicate on the "variables" field.
+func VariablesIsNil() predicate.Prompt {
+	return predicate.Prompt(sql.FieldIsNull(FieldVariables))
+}
+
+// VariablesNotNil applies the NotNil predicate on the "variables" field.
+func VariablesNotNil() predicate.Prompt {
+	return predicate.Prompt(sql.FieldNotNull(FieldVariables))
+}
+
+// ProjectIDEQ applies the EQ predicate on the "project_id" field.
+func ProjectIDEQ(v int) predicate.Prompt {
+	return predicate.Prompt(sql.FieldEQ(FieldProjectID, v))
+}
+
+// ProjectIDNEQ applies the NEQ predicate on the "project_id" field.
+func ProjectIDNEQ(v int) predicate.Prompt {
+	return predicate.Prompt(sql.FieldNEQ(FieldProjectID, v))
+}
+
+// ProjectIDIn applies the In predicate on the "project_id" field.
+func ProjectIDIn(vs ...int) predicate.Prompt {
+	return predicate.Prompt(sql.FieldIn(FieldProjectID, vs...))
+}
+
+// ProjectIDNotIn applies the NotIn predicate on the "project_id" field.
+func ProjectIDNotIn(vs ...int) predicate.Prompt {
+	return predicate.Prompt(sql.FieldNotIn(FieldProjectID, vs...))
+}
+
+// ProjectIDIsNil applies the IsNil predicate on the "project_id" field.
+func ProjectIDIsNil() predicate.Prompt {
+	return predicate.Prompt(sql.FieldIsNull(FieldProjectID))
+}
+
+// ProjectIDNotNil applies the NotNil predicate on the "project_id" field.
+func ProjectIDNotNil() predicate.Prompt {
+	return predicate.Prompt(sql.FieldNotNull(FieldProjectID))
+}
+
+// CreatedAtEQ applies the EQ predicate on the "created_at" field.
+func CreatedAtEQ(v time.Time) predicate.Prompt {
+	return predicate.Prompt(sql.FieldEQ(FieldCreatedAt, v))
+}
+
+// CreatedAtNEQ applies the NEQ predicate on the "created_at" field.
+func CreatedAtNEQ(v time.Time) predicate.Prompt {
+	return predicate.Prompt(sql.FieldNEQ(FieldCreatedAt, v))
+}
+
+// CreatedAtIn applies the In predicate on the "created_at" field.
+func CreatedAtIn(vs ...time.Time) predicate.Prompt {
+	return predicate.Prompt(sql.FieldIn(FieldCreatedAt, vs...))
+}
+
+// CreatedAtNotIn applies the NotIn predicate on the "created_at" field.
+func CreatedAtNotIn(vs ...time.Time) predicate.Prompt {
+	return predicate.Prompt(sql.FieldNotIn(FieldCreatedAt, vs...))
+}
+
+// CreatedAtGT applies the GT predicate on the "created_at" field.
+func CreatedAtGT(v time.Time) predicate.Prompt {
+	return predicate.Prompt(sql.FieldGT(FieldCreatedAt, v))
+}
+
+// CreatedAtGTE applies the GTE predicate on the "created_at" field.
+func CreatedAtGTE(v time.Time) predicate.Prompt {
+	return predicate.Prompt(sql.FieldGTE(FieldCreatedAt, v))
+}
+
+// CreatedAtLT applies the LT predicate on the "created_at" field.
+func CreatedAtLT(v time.Time) predicate.Prompt {
+	return predicate.Prompt(sql.FieldLT(FieldCreatedAt, v))
+}
+
+// CreatedAtLTE applies the LTE predicate on the "created_at" field.
+func CreatedAtLTE(v time.Time) predicate.Prompt {
+	return predicate.Prompt(sql.FieldLTE(FieldCreatedAt, v))
+}
+
+// HasProject applies the HasEdge predicate on the "project" edge.
+func HasProject() predicate.Prompt {
+	return predicate.Prompt(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, ProjectTable, ProjectColumn),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasProjectWith applies the HasEdge predicate on the "project" edge with a given conditions (other predicates).
+func HasProjectWith(preds ...predicate.Project) predicate.Prompt {
+	return predicate.Prompt(func(s *sql.Selector) {
+		step := newProjectStep()
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
+// And groups predicates with the AND operator between them.
+func And(predicates ...predicate.Prompt) predicate.Prompt {
+	return predicate.Prompt(sql.AndPredicates(predicates...))
+}
+
+// Or groups predicates with the OR operator between them.
+func Or(predicates ...predicate.Prompt) predicate.Prompt {
+	return predicate.Prompt(sql.OrPredicates(predicates...))
+}
+
+// Not applies the not operator on the given predicate.
+func Not(p predicate.Prompt) predicate.Prompt {
+	return predicate.Prompt(sql.NotPredicates(p))
+}
@@ -0,0 +1,340 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"ramble-ai/ent/project"
+	"ramble-ai/ent/prompt"
+	"ramble-ai/ent/schema"
+	"time"
+
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+)
+
+// PromptCreate is the builder for creating a Prompt entity.
+type PromptCreate struct {
+	config
+	mutation *PromptMutation
+	hooks    []Hook
+}
+
+// SetName sets the "name" field.
+func (pc *PromptCreate) SetName(s string) *PromptCreate {
+	pc.mutation.SetName(s)
+	return pc
+}
+
+// SetVersion sets the "version" field.
+func (pc *PromptCreate) SetVersion(i int) *PromptCreate {
+	pc.mutation.SetVersion(i)
+	return pc
+}
+
+// SetTaskType sets the "task_type" field.
+func (pc *PromptCreate) SetTaskType(s string) *PromptCreate {
+	pc.mutation.SetTaskType(s)
+	return pc
+}
+
+// SetBody sets the "body" field.
+func (pc *PromptCreate) SetBody(s string) *PromptCreate {
+	pc.mutation.SetBody(s)
+	return pc
+}
+
+// SetDescription sets the "description" field.
+func (pc *PromptCreate) SetDescription(s string) *PromptCreate {
+	pc.mutation.SetDescription(s)
+	return pc
+}
+
+// SetNillableDescription sets the "description" field if the given value is not nil.
+func (pc *PromptCreate) SetNillableDescription(s *string) *PromptCreate {
+	if s != nil {
+		pc.SetDescription(*s)
+	}
+	return pc
+}
+
+// SetVariables sets the "variables" field.
+func (pc *PromptCreate) SetVariables(sv []schema.PromptVariable) *PromptCreate {
+	pc.mutation.SetVariables(sv)
+	return pc
+}
+
+// SetProjectID sets the "project_id" field.
+func (pc *PromptCreate) SetProjectID(i int) *PromptCreate {
+	pc.mutation.SetProjectID(i)
+	return pc
+}
+
+// SetNillableProjectID sets the "project_id" field if the given value is not nil.
+func (pc *PromptCreate) SetNillableProjectID(i *int) *PromptCreate {
+	if i != nil {
+		pc.SetProjectID(*i)
+	}
+	return pc
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (pc *PromptCreate) SetCreatedAt(t time.Time) *PromptCreate {
+	pc.mutation.SetCreatedAt(t)
+	return pc
+}
+
+// SetNillableCreatedAt sets the "created_at" field if the given value is not nil.
+func (pc *PromptCreate) SetNillableCreatedAt(t *time.Time) *PromptCreate {
+	if t != nil {
+		pc.SetCreatedAt(*t)
+	}
+	return pc
+}
+
+// SetProject sets the "project" edge to the Project entity.
+func (pc *PromptCreate) SetProject(p *Project) *PromptCreate {
+	return pc.SetProjectID(p.ID)
+}
+
+// Mutation returns the PromptMutation object of the builder.
+func (pc *PromptCreate) Mutation() *PromptMutation {
+	return pc.mutation
+}
+
+// Save creates the Prompt in the database.
+func (pc *PromptCreate) Save(ctx context.Context) (*Prompt, error) {
+	pc.defaults()
+	return withHooks(ctx, pc.sqlSave, pc.mutation, pc.hooks)
+}
+
+// SaveX calls Save and panics if Save returns an error.
+func (pc *PromptCreate) SaveX(ctx context.Context) *Prompt {
+	v, err := pc.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (pc *PromptCreate) Exec(ctx context.Context) error {
+	_, err := pc.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (pc *PromptCreate) ExecX(ctx context.Context) {
+	if err := pc.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (pc *PromptCreate) defaults() {
+	if _, ok := pc.mutation.CreatedAt(); !ok {
+		v := prompt.DefaultCreatedAt()
+		pc.mutation.SetCreatedAt(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (pc *PromptCreate) check() error {
+	if _, ok := pc.mutation.Name(); !ok {
+		return &ValidationError{Name: "name", err: errors.New(`ent: missing required field "Prompt.name"`)}
+	}
+	if v, ok := pc.mutation.Name(); ok {
+		if err := prompt.NameValidator(v); err != nil {
+			return &ValidationError{Name: "name", err: fmt.Errorf(`ent: validator failed for field "Prompt.name": %w`, err)}
+		}
+	}
+	if _, ok := pc.mutation.Version(); !ok {
+		return &ValidationError{Name: "version", err: errors.New(`ent: missing required field "Prompt.version"`)}
+	}
+	if v, ok := pc.mutation.Version(); ok {
+		if err := prompt.VersionValidator(v); err != nil {
+			return &ValidationError{Name: "version", err: fmt.Errorf(`ent: validator failed for field "Prompt.version": %w`, err)}
+		}
+	}
+	if _, ok := pc.mutation.TaskType(); !ok {
+		return &ValidationError{Name: "task_type", err: errors.New(`ent: missing required field "Prompt.task_type"`)}
+	}
+	if v, ok := pc.mutation.TaskType(); ok {
+		if err := prompt.TaskTypeValidator(v); err != nil {
+			return &ValidationError{Name: "task_type", err: fmt.Errorf(`ent: validator failed for field "Prompt.task_type": %w`, err)}
+		}
+	}
+	if _, ok := pc.mutation.Body(); !ok {
+		return &ValidationError{Name: "body", err: errors.New(`ent: missing required field "Prompt.body"`)}
+	}
+	if v, ok := pc.mutation.Body(); ok {
+		if err := prompt.BodyValidator(v); err != nil {
+			return &ValidationError{Name: "body", err: fmt.Errorf(`ent: validator failed for field "Prompt.body": %w`, err)}
+		}
+	}
+	if _, ok := pc.mutation.CreatedAt(); !ok {
+		return &ValidationError{Name: "created_at", err: errors.New(`ent: missing required field "Prompt.created_at"`)}
+	}
+	return nil
+}
+
+func (pc *PromptCreate) sqlSave(ctx context.Context) (*Prompt, error) {
+	if err := pc.check(); err != nil {
+		return nil, err
+	}
+	_node, _spec := pc.createSpec()
+	if err := sqlgraph.CreateNode(ctx, pc.driver, _spec); err != nil {
+		if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	id := _spec.ID.Value.(int64)
+	_node.ID = int(id)
+	pc.mutation.id = &_node.ID
+	pc.mutation.done = true
+	return _node, nil
+}
+
+func (pc *PromptCreate) createSpec() (*Prompt, *sqlgraph.CreateSpec) {
+	var (
+		_node = &Prompt{config: pc.config}
+		_spec = sqlgraph.NewCreateSpec(prompt.Table, sqlgraph.NewFieldSpec(prompt.FieldID, field.TypeInt))
+	)
+	if value, ok := pc.mutation.Name(); ok {
+		_spec.SetField(prompt.FieldName, field.TypeString, value)
+		_node.Name = value
+	}
+	if value, ok := pc.mutation.Version(); ok {
+		_spec.SetField(prompt.FieldVersion, field.TypeInt, value)
+		_node.Version = value
+	}
+	if value, ok := pc.mutation.TaskType(); ok {
+		_spec.SetField(prompt.FieldTaskType, field.TypeString, value)
+		_node.TaskType = value
+	}
+	if value, ok := pc.mutation.Body(); ok {
+		_spec.SetField(prompt.FieldBody, field.TypeString, value)
+		_node.Body = value
+	}
+	if value, ok := pc.mutation.Description(); ok {
+		_spec.SetField(prompt.FieldDescription, field.TypeString, value)
+		_node.Description = value
+	}
+	if value, ok := pc.mutation.Variables(); ok {
+		_spec.SetField(prompt.FieldVariables, field.TypeJSON, value)
+		_node.Variables = value
+	}
+	if value, ok := pc.mutation.CreatedAt(); ok {
+		_spec.SetField(prompt.FieldCreatedAt, field.TypeTime, value)
+		_node.CreatedAt = value
+	}
+	if nodes := pc.mutation.ProjectIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   prompt.ProjectTable,
+			Columns: []string{prompt.ProjectColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(project.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_node.ProjectID = nodes[0]
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	return _node, _spec
+}
+
+// PromptCreateBulk is the builder for creating many Prompt entities in bulk.
+type PromptCreateBulk struct {
+	config
+	err      error
+	builders []*PromptCreate
+}
+
+// Save creates the Prompt entities in the database.
+func (pcb *PromptCreateBulk) Save(ctx context.Context) ([]*Prompt, error) {
+	if pcb.err != nil {
+		return nil, pcb.err
+	}
+	specs := make([]*sqlgraph.CreateSpec, len(pcb.builders))
+	nodes := make([]*Prompt, len(pcb.builders))
+	mutators := make([]Mutator, len(pcb.builders))
+	for i := range pcb.builders {
+		func(i int, root context.Context) {
+			builder := pcb.builders[i]
+			builder.defaults()
+			var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+				mutation, ok := m.(*PromptMutation)
+				if !ok {
+					return nil, fmt.Errorf("unexpected mutation type %T", m)
+				}
+				if err := builder.check(); err != nil {
+					return nil, err
+				}
+				builder.mutation = mutation
+				var err error
+				nodes[i], specs[i] = builder.createSpec()
+				if i < len(mutators)-1 {
+					_, err = mutators[i+1].Mutate(root, pcb.builders[i+1].mutation)
+				} else {
+					spec := &sqlgraph.BatchCreateSpec{Nodes: specs}
+					// Invoke the actual operation on the latest mutation in the chain.
+					if err = sqlgraph.BatchCreate(ctx, pcb.driver, spec); err != nil {
+						if sqlgraph.IsConstraintError(err) {
+							err = &ConstraintError{msg: err.Error(), wrap: err}
+						}
+					}
+				}
+				if err != nil {
+					return nil, err
+				}
+				mutation.id = &nodes[i].ID
+				if specs[i].ID.Value != nil {
+					id := specs[i].ID.Value.(int64)
+					nodes[i].ID = int(id)
+				}
+				mutation.done = true
+				return nodes[i], nil
+			})
+			for i := len(builder.hooks) - 1; i >= 0; i-- {
+				mut = builder.hooks[i](mut)
+			}
+			mutators[i] = mut
+		}(i, ctx)
+	}
+	if len(mutators) > 0 {
+		if _, err := mutators[0].Mutate(ctx, pcb.builders[0].mutation); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (pcb *PromptCreateBulk) SaveX(ctx context.Context) []*Prompt {
+	v, err := pcb.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (pcb *PromptCreateBulk) Exec(ctx context.Context) error {
+	_, err := pcb.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (pcb *PromptCreateBulk) ExecX(ctx context.Context) {
+	if err := pcb.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
@@ -0,0 +1,88 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"ramble-ai/ent/predicate"
+	"ramble-ai/ent/prompt"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+)
+
+// PromptDelete is the builder for deleting a Prompt entity.
+type PromptDelete struct {
+	config
+	hooks    []Hook
+	mutation *PromptMutation
+}
+
+// Where appends a list predicates to the PromptDelete builder.
+func (pd *PromptDelete) Where(ps ...predicate.Prompt) *PromptDelete {
+	pd.mutation.Where(ps...)
+	return pd
+}
+
+// Exec executes the deletion query and returns how many vertices were deleted.
+func (pd *PromptDelete) Exec(ctx context.Context) (int, error) {
+	return withHooks(ctx, pd.sqlExec, pd.mutation, pd.hooks)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (pd *PromptDelete) ExecX(ctx context.Context) int {
+	n, err := pd.Exec(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return n
+}
+
+func (pd *PromptDelete) sqlExec(ctx context.Context) (int, error) {
+	_spec := sqlgraph.NewDeleteSpec(prompt.Table, sqlgraph.NewFieldSpec(prompt.FieldID, field.TypeInt))
+	if ps := pd.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	affected, err := sqlgraph.DeleteNodes(ctx, pd.driver, _spec)
+	if err != nil && sqlgraph.IsConstraintError(err) {
+		err = &ConstraintError{msg: err.Error(), wrap: err}
+	}
+	pd.mutation.done = true
+	return affected, err
+}
+
+// PromptDeleteOne is the builder for deleting a single Prompt entity.
+type PromptDeleteOne struct {
+	pd *PromptDelete
+}
+
+// Where appends a list predicates to the PromptDelete builder.
+func (pdo *PromptDeleteOne) Where(ps ...predicate.Prompt) *PromptDeleteOne {
+	pdo.pd.mutation.Where(ps...)
+	return pdo
+}
+
+// Exec executes the deletion query.
+func (pdo *PromptDeleteOne) Exec(ctx context.Context) error {
+	n, err := pdo.pd.Exec(ctx)
+	switch {
+	case err != nil:
+		return err
+	case n == 0:
+		return &NotFoundError{prompt.Label}
+	default:
+		return nil
+	}
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (pdo *PromptDeleteOne) ExecX(ctx context.Context) {
+	if err := pdo.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
@@ -0,0 +1,620 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"ramble-ai/ent/predicate"
+	"ramble-ai/ent/project"
+	"ramble-ai/ent/prompt"
+	"ramble-ai/ent/schema"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/dialect/sql/sqljson"
+	"entgo.io/ent/schema/field"
+)
+
+// PromptUpdate is the builder for updating Prompt entities.
+type PromptUpdate struct {
+	config
+	hooks    []Hook
+	mutation *PromptMutation
+}
+
+// Where appends a list predicates to the PromptUpdate builder.
+func (pu *PromptUpdate) Where(ps ...predicate.Prompt) *PromptUpdate {
+	pu.mutation.Where(ps...)
+	return pu
+}
+
+// SetName sets the "name" field.
+func (pu *PromptUpdate) SetName(s string) *PromptUpdate {
+	pu.mutation.SetName(s)
+	return pu
+}
+
+// SetNillableName sets the "name" field if the given value is not nil.
+func (pu *PromptUpdate) SetNillableName(s *string) *PromptUpdate {
+	if s != nil {
+		pu.SetName(*s)
+	}
+	return pu
+}
+
+// SetVersion sets the "version" field.
+func (pu *PromptUpdate) SetVersion(i int) *PromptUpdate {
+	pu.mutation.ResetVersion()
+	pu.mutation.SetVersion(i)
+	return pu
+}
+
+// SetNillableVersion sets the "version" field if the given value is not nil.
+func (pu *PromptUpdate) SetNillableVersion(i *int) *PromptUpdate {
+	if i != nil {
+		pu.SetVersion(*i)
+	}
+	return pu
+}
+
+// AddVersion adds i to the "version" field.
+func (pu *PromptUpdate) AddVersion(i int) *PromptUpdate {
+	pu.mutation.AddVersion(i)
+	return pu
+}
+
+// SetTaskType sets the "task_type" field.
+func (pu *PromptUpdate) SetTaskType(s string) *PromptUpdate {
+	pu.mutation.SetTaskType(s)
+	return pu
+}
+
+// SetNillableTaskType sets the "task_type" field if the given value is not nil.
+func (pu *PromptUpdate) SetNillableTaskType(s *string) *PromptUpdate {
+	if s != nil {
+		pu.SetTaskType(*s)
+	}
+	return pu
+}
+
+// SetBody sets the "body" field.
+func (pu *PromptUpdate) SetBody(s string) *PromptUpdate {
+	pu.mutation.SetBody(s)
+	return pu
+}
+
+// SetNillableBody sets the "body" field if the given value is not nil.
+func (pu *PromptUpdate) SetNillableBody(s *string) *PromptUpdate {
+	if s != nil {
+		pu.SetBody(*s)
+	}
+	return pu
+}
+
+// SetDescription sets the "description" field.
+func (pu *PromptUpdate) SetDescription(s string) *PromptUpdate {
+	pu.mutation.SetDescription(s)
+	return pu
+}
+
+// SetNillableDescription sets the "description" field if the given value is not nil.
+func (pu *PromptUpdate) SetNillableDescription(s *string) *PromptUpdate {
+	if s != nil {
+		pu.SetDescription(*s)
+	}
+	return pu
+}
+
+// ClearDescription clears the value of the "description" field.
+func (pu *PromptUpdate) ClearDescription() *PromptUpdate {
+	pu.mutation.ClearDescription()
+	return pu
+}
+
+// SetVariables sets the "variables" field.
+func (pu *PromptUpdate) SetVariables(sv []schema.PromptVariable) *PromptUpdate {
+	pu.mutation.SetVariables(sv)
+	return pu
+}
+
+// AppendVariables appends sv to the "variables" field.
+func (pu *PromptUpdate) AppendVariables(sv []schema.PromptVariable) *PromptUpdate {
+	pu.mutation.AppendVariables(sv)
+	return pu
+}
+
+// ClearVariables clears the value of the "variables" field.
+func (pu *PromptUpdate) ClearVariables() *PromptUpdate {
+	pu.mutation.ClearVariables()
+	return pu
+}
+
+// SetProjectID sets the "project_id" field.
+func (pu *PromptUpdate) SetProjectID(i int) *PromptUpdate {
+	pu.mutation.SetProjectID(i)
+	return pu
+}
+
+// SetNillableProjectID sets the "project_id" field if the given value is not nil.
+func (pu *PromptUpdate) SetNillableProjectID(i *int) *PromptUpdate {
+	if i != nil {
+		pu.SetProjectID(*i)
+	}
+	return pu
+}
+
+// ClearProjectID clears the value of the "project_id" field.
+func (pu *PromptUpdate) ClearProjectID() *PromptUpdate {
+	pu.mutation.ClearProjectID()
+	return pu
+}
+
+// SetProject sets the "project" edge to the Project entity.
+func (pu *PromptUpdate) SetProject(p *Project) *PromptUpdate {
+	return pu.SetProjectID(p.ID)
+}
+
+// Mutation returns the PromptMutation object of the builder.
+func (pu *PromptUpdate) Mutation() *PromptMutation {
+	return pu.mutation
+}
+
+// ClearProject clears the "project" edge to the Project entity.
+func (pu *PromptUpdate) ClearProject() *PromptUpdate {
+	pu.mutation.ClearProject()
+	return pu
+}
+
+// Save executes the query and returns the number of nodes affected by the update operation.
+func (pu *PromptUpdate) Save(ctx context.Context) (int, error) {
+	return withHooks(ctx, pu.sqlSave, pu.mutation, pu.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (pu *PromptUpdate) SaveX(ctx context.Context) int {
+	affected, err := pu.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return affected
+}
+
+// Exec executes the query.
+func (pu *PromptUpdate) Exec(ctx context.Context) error {
+	_, err := pu.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (pu *PromptUpdate) ExecX(ctx context.Context) {
+	if err := pu.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (pu *PromptUpdate) check() error {
+	if v, ok := pu.mutation.Name(); ok {
+		if err := prompt.NameValidator(v); err != nil {
+			return &ValidationError{Name: "name", err: fmt.Errorf(`ent: validator failed for field "Prompt.name": %w`, err)}
+		}
+	}
+	if v, ok := pu.mutation.Version(); ok {
+		if err := prompt.VersionValidator(v); err != nil {
+			return &ValidationError{Name: "version", err: fmt.Errorf(`ent: validator failed for field "Prompt.version": %w`, err)}
+		}
+	}
+	if v, ok := pu.mutation.TaskType(); ok {
+		if err := prompt.TaskTypeValidator(v); err != nil {
+			return &ValidationError{Name: "task_type", err: fmt.Errorf(`ent: validator failed for field "Prompt.task_type": %w`, err)}
+		}
+	}
+	if v, ok := pu.mutation.Body(); ok {
+		if err := prompt.BodyValidator(v); err != nil {
+			return &ValidationError{Name: "body", err: fmt.Errorf(`ent: validator failed for field "Prompt.body": %w`, err)}
+		}
+	}
+	return nil
+}
+
+func (pu *PromptUpdate) sqlSave(ctx context.Context) (n int, err error) {
+	if err := pu.check(); err != nil {
+		return n, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(prompt.Table, prompt.Columns, sqlgraph.NewFieldSpec(prompt.FieldID, field.TypeInt))
+	if ps := pu.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := pu.mutation.Name(); ok {
+		_spec.SetField(prompt.FieldName, field.TypeString, value)
+	}
+	if value, ok := pu.mutation.Version(); ok {
+		_spec.SetField(prompt.FieldVersion, field.TypeInt, value)
+	}
+	if value, ok := pu.mutation.AddedVersion(); ok {
+		_spec.AddField(prompt.FieldVersion, field.TypeInt, value)
+	}
+	if value, ok := pu.mutation.TaskType(); ok {
+		_spec.SetField(prompt.FieldTaskType, field.TypeString, value)
+	}
+	if value, ok := pu.mutation.Body(); ok {
+		_spec.SetField(prompt.FieldBody, field.TypeString, value)
+	}
+	if value, ok := pu.mutation.Description(); ok {
+		_spec.SetField(prompt.FieldDescription, field.TypeString, value)
+	}
+	if pu.mutation.DescriptionCleared() {
+		_spec.ClearField(prompt.FieldDescription, field.TypeString)
+	}
+	if value, ok := pu.mutation.Variables(); ok {
+		_spec.SetField(prompt.FieldVariables, field.TypeJSON, value)
+	}
+	if value, ok := pu.mutation.AppendedVariables(); ok {
+		_spec.AddModifier(func(u *sql.UpdateBuilder) {
+			sqljson.Append(u, prompt.FieldVariables, value)
+		})
+	}
+	if pu.mutation.VariablesCleared() {
+		_spec.ClearField(prompt.FieldVariables, field.TypeJSON)
+	}
+	if pu.mutation.ProjectCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   prompt.ProjectTable,
+			Columns: []string{prompt.ProjectColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(project.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := pu.mutation.ProjectIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   prompt.ProjectTable,
+			Columns: []string{prompt.ProjectColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(project.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if n, err = sqlgraph.UpdateNodes(ctx, pu.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{prompt.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return 0, err
+	}
+	pu.mutation.done = true
+	return n, nil
+}
+
+// PromptUpdateOne is the builder for updating a single Prompt entity.
+type PromptUpdateOne struct {
+	config
+	fields   []string
+	hooks    []Hook
+	mutation *PromptMutation
+}
+
+// SetName sets the "name" field.
+func (puo *PromptUpdateOne) SetName(s string) *PromptUpdateOne {
+	puo.mutation.SetName(s)
+	return puo
+}
+
+// SetNillableName sets the "name" field if the given value is not nil.
+func (puo *PromptUpdateOne) SetNillableName(s *string) *PromptUpdateOne {
+	if s != nil {
+		puo.SetName(*s)
+	}
+	return puo
+}
+
+// SetVersion sets the "version" field.
+func (puo *PromptUpdateOne) SetVersion(i int) *PromptUpdateOne {
+	puo.mutation.ResetVersion()
+	puo.mutation.SetVersion(i)
+	return puo
+}
+
+// SetNillableVersion sets the "version" field if the given value is not nil.
+func (puo *PromptUpdateOne) SetNillableVersion(i *int) *PromptUpdateOne {
+	if i != nil {
+		puo.SetVersion(*i)
+	}
+	return puo
+}
+
+// AddVersion adds i to the "version" field.
+func (puo *PromptUpdateOne) AddVersion(i int) *PromptUpdateOne {
+	puo.mutation.AddVersion(i)
+	return puo
+}
+
+// SetTaskType sets the "task_type" field.
+func (puo *PromptUpdateOne) SetTaskType(s string) *PromptUpdateOne {
+	puo.mutation.SetTaskType(s)
+	return puo
+}
+
+// SetNillableTaskType sets the "task_type" field if the given value is not nil.
+func (puo *PromptUpdateOne) SetNillableTaskType(s *string) *PromptUpdateOne {
+	if s != nil {
+		puo.SetTaskType(*s)
+	}
+	return puo
+}
+
+// SetBody sets the "body" field.
+func (puo *PromptUpdateOne) SetBody(s string) *PromptUpdateOne {
+	puo.mutation.SetBody(s)
+	return puo
+}
+
+// SetNillableBody sets the "body" field if the given value is not nil.
+func (puo *PromptUpdateOne) SetNillableBody(s *string) *PromptUpdateOne {
+	if s != nil {
+		puo.SetBody(*s)
+	}
+	return puo
+}
+
+// SetDescription sets the "description" field.
+func (puo *PromptUpdateOne) SetDescription(s string) *PromptUpdateOne {
+	puo.mutation.SetDescription(s)
+	return puo
+}
+
+// SetNillableDescription sets the "description" field if the given value is not nil.
+func (puo *PromptUpdateOne) SetNillableDescription(s *string) *PromptUpdateOne {
+	if s != nil {
+		puo.SetDescription(*s)
+	}
+	return puo
+}
+
+// ClearDescription clears the value of the "description" field.
+func (puo *PromptUpdateOne) ClearDescription() *PromptUpdateOne {
+	puo.mutation.ClearDescription()
+	return puo
+}
+
+// SetVariables sets the "variables" field.
+func (puo *PromptUpdateOne) SetVariables(sv []schema.PromptVariable) *PromptUpdateOne {
+	puo.mutation.SetVariables(sv)
+	return puo
+}
+
+// AppendVariables appends sv to the "variables" field.
+func (puo *PromptUpdateOne) AppendVariables(sv []schema.PromptVariable) *PromptUpdateOne {
+	puo.mutation.AppendVariables(sv)
+	return puo
+}
+
+// ClearVariables clears the value of the "variables" field.
+func (puo *PromptUpdateOne) ClearVariables() *PromptUpdateOne {
+	puo.mutation.ClearVariables()
+	return puo
+}
+
+// SetProjectID sets the "project_id" field.
+func (puo *PromptUpdateOne) SetProjectID(i int) *PromptUpdateOne {
+	puo.mutation.SetProjectID(i)
+	return puo
+}
+
+// SetNillableProjectID sets the "project_id" field if the given value is not nil.
+func (puo *PromptUpdateOne) SetNillableProjectID(i *int) *PromptUpdateOne {
+	if i != nil {
+		puo.SetProjectID(*i)
+	}
+	return puo
+}
+
+// ClearProjectID clears the value of the "project_id" field.
+func (puo *PromptUpdateOne) ClearProjectID() *PromptUpdateOne {
+	puo.mutation.ClearProjectID()
+	return puo
+}
+
+// SetProject sets the "project" edge to the Project entity.
+func (puo *PromptUpdateOne) SetProject(p *Project) *PromptUpdateOne {
+	return puo.SetProjectID(p.ID)
+}
+
+// Mutation returns the PromptMutation object of the builder.
+func (puo *PromptUpdateOne) Mutation() *PromptMutation {
+	return puo.mutation
+}
+
+// ClearProject clears the "project" edge to the Project entity.
+func (puo *PromptUpdateOne) ClearProject() *PromptUpdateOne {
+	puo.mutation.ClearProject()
+	return puo
+}
+
+// Where appends a list predicates to the PromptUpdate builder.
+func (puo *PromptUpdateOne) Where(ps ...predicate.Prompt) *PromptUpdateOne {
+	puo.mutation.Where(ps...)
+	return puo
+}
+
+// Select allows selecting one or more fields (columns) of the returned entity.
+// The default is selecting all fields defined in the entity schema.
+func (puo *PromptUpdateOne) Select(field string, fields ...string) *PromptUpdateOne {
+	puo.fields = append([]string{field}, fields...)
+	return puo
+}
+
+// Save executes the query and returns the updated Prompt entity.
+func (puo *PromptUpdateOne) Save(ctx context.Context) (*Prompt, error) {
+	return withHooks(ctx, puo.sqlSave, puo.mutation, puo.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (puo *PromptUpdateOne) SaveX(ctx context.Context) *Prompt {
+	node, err := puo.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// Exec executes the query on the entity.
+func (puo *PromptUpdateOne) Exec(ctx context.Context) error {
+	_, err := puo.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (puo *PromptUpdateOne) ExecX(ctx context.Context) {
+	if err := puo.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (puo *PromptUpdateOne) check() error {
+	if v, ok := puo.mutation.Name(); ok {
+		if err := prompt.NameValidator(v); err != nil {
+			return &ValidationError{Name: "name", err: fmt.Errorf(`ent: validator failed for field "Prompt.name": %w`, err)}
+		}
+	}
+	if v, ok := puo.mutation.Version(); ok {
+		if err := prompt.VersionValidator(v); err != nil {
+			return &ValidationError{Name: "version", err: fmt.Errorf(`ent: validator failed for field "Prompt.version": %w`, err)}
+		}
+	}
+	if v, ok := puo.mutation.TaskType(); ok {
+		if err := prompt.TaskTypeValidator(v); err != nil {
+			return &ValidationError{Name: "task_type", err: fmt.Errorf(`ent: validator failed for field "Prompt.task_type": %w`, err)}
+		}
+	}
+	if v, ok := puo.mutation.Body(); ok {
+		if err := prompt.BodyValidator(v); err != nil {
+			return &ValidationError{Name: "body", err: fmt.Errorf(`ent: validator failed for field "Prompt.body": %w`, err)}
+		}
+	}
+	return nil
+}
+
+func (puo *PromptUpdateOne) sqlSave(ctx context.Context) (_node *Prompt, err error) {
+	if err := puo.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(prompt.Table, prompt.Columns, sqlgraph.NewFieldSpec(prompt.FieldID, field.TypeInt))
+	id, ok := puo.mutation.ID()
+	if !ok {
+		return nil, &ValidationError{Name: "id", err: errors.New(`ent: missing "Prompt.id" for update`)}
+	}
+	_spec.Node.ID.Value = id
+	if fields := puo.fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, prompt.FieldID)
+		for _, f := range fields {
+			if !prompt.ValidColumn(f) {
+				return nil, &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+			}
+			if f != prompt.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, f)
+			}
+		}
+	}
+	if ps := puo.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := puo.mutation.Name(); ok {
+		_spec.SetField(prompt.FieldName, field.TypeString, value)
+	}
+	if value, ok := puo.mutation.Version(); ok {
+		_spec.SetField(prompt.FieldVersion, field.TypeInt, value)
+	}
+	if value, ok := puo.mutation.AddedVersion(); ok {
+		_spec.AddField(prompt.FieldVersion, field.TypeInt, value)
+	}
+	if value, ok := puo.mutation.TaskType(); ok {
+		_spec.SetField(prompt.FieldTaskType, field.TypeString, value)
+	}
+	if value, ok := puo.mutation.Body(); ok {
+		_spec.SetField(prompt.FieldBody, field.TypeString, value)
+	}
+	if value, ok := puo.mutation.Description(); ok {
+		_spec.SetField(prompt.FieldDescription, field.TypeString, value)
+	}
+	if puo.mutation.DescriptionCleared() {
+		_spec.ClearField(prompt.FieldDescription, field.TypeString)
+	}
+	if value, ok := puo.mutation.Variables(); ok {
+		_spec.SetField(prompt.FieldVariables, field.TypeJSON, value)
+	}
+	if value, ok := puo.mutation.AppendedVariables(); ok {
+		_spec.AddModifier(func(u *sql.UpdateBuilder) {
+			sqljson.Append(u, prompt.FieldVariables, value)
+		})
+	}
+	if puo.mutation.VariablesCleared() {
+		_spec.ClearField(prompt.FieldVariables, field.TypeJSON)
+	}
+	if puo.mutation.ProjectCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   prompt.ProjectTable,
+			Columns: []string{prompt.ProjectColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(project.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := puo.mutation.ProjectIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   prompt.ProjectTable,
+			Columns: []string{prompt.ProjectColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(project.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	_node = &Prompt{config: puo.config}
+	_spec.Assign = _node.assignValues
+	_spec.ScanValues = _node.scanValues
+	if err = sqlgraph.UpdateNode(ctx, puo.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{prompt.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	puo.mutation.done = true
+	return _node, nil
+}
@@ -0,0 +1,172 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"fmt"
+	"ramble-ai/ent/responsecacheentry"
+	"strings"
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/sql"
+)
+
+// ResponseCacheEntry is the model entity for the ResponseCacheEntry schema.
+type ResponseCacheEntry struct {
+	config `json:"-"`
+	// ID of the ent.
+	ID int `json:"id,omitempty"`
+	// SHA-256 of the request content
+	Key string `json:"key,omitempty"`
+	// Task the response was produced for, used for invalidation
+	TaskType string `json:"task_type,omitempty"`
+	// Model that produced the response
+	Model string `json:"model,omitempty"`
+	// Raw OpenRouter response as JSON
+	Response string `json:"response,omitempty"`
+	// Number of times the response was served from the cache
+	Hits int `json:"hits,omitempty"`
+	// Creation timestamp
+	CreatedAt time.Time `json:"created_at,omitempty"`
+	// Time after which the response is no longer served
+	ExpiresAt    time.Time `json:"expires_at,omitempty"`
+	selectValues sql.SelectValues
+}
+
+// scanValues returns the types for scanning values from sql.Rows.
+func (*ResponseCacheEntry) scanValues(columns []string) ([]any, error) {
+	values := make([]any, len(columns))
+	for i := range columns {
+		switch columns[i] {
+		case responsecacheentry.FieldID, responsecacheentry.FieldHits:
+			values[i] = new(sql.NullInt64)
+		case responsecacheentry.FieldKey, responsecacheentry.FieldTaskType, responsecacheentry.FieldModel, responsecacheentry.FieldResponse:
+			values[i] = new(sql.NullString)
+		case responsecacheentry.FieldCreatedAt, responsecacheentry.FieldExpiresAt:
+			values[i] = new(sql.NullTime)
+		default:
+			values[i] = new(sql.UnknownType)
+		}
+	}
+	return values, nil
+}
+
+// assignValues assigns the values that were returned from sql.Rows (after scanning)
+// to the ResponseCacheEntry fields.
+func (rce *ResponseCacheEntry) assignValues(columns []string, values []any) error {
+	if m, n := len(values), len(columns); m < n {
+		return fmt.Errorf("mismatch number of scan values: %d != %d", m, n)
+	}
+	for i := range columns {
+		switch columns[i] {
+		case responsecacheentry.FieldID:
+			value, ok := values[i].(*sql.NullInt64)
+			if !ok {
+				return fmt.Errorf("unexpected type %T for field id", value)
+			}
+			rce.ID = int(value.Int64)
+		case responsecacheentry.FieldKey:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field key", values[i])
+			} else if value.Valid {
+				rce.Key = value.String
+			}
+		case responsecacheentry.FieldTaskType:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field task_type", values[i])
+			} else if value.Valid {
+				rce.TaskType = value.String
+			}
+		case responsecacheentry.FieldModel:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field model", values[i])
+			} else if value.Valid {
+				rce.Model = value.String
+			}
+		case responsecacheentry.FieldResponse:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field response", values[i])
+			} else if value.Valid {
+				rce.Response = value.String
+			}
+		case responsecacheentry.FieldHits:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field hits", values[i])
+			} else if value.Valid {
+				rce.Hits = int(value.Int64)
+			}
+		case responsecacheentry.FieldCreatedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field created_at", values[i])
+			} else if value.Valid {
+				rce.CreatedAt = value.Time
+			}
+		case responsecacheentry.FieldExpiresAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field expires_at", values[i])
+			} else if value.Valid {
+				rce.ExpiresAt = value.Time
+			}
+		default:
+			rce.selectValues.Set(columns[i], values[i])
+		}
+	}
+	return nil
+}
+
+// Value returns the ent.Value that was dynamically selected and assigned to the ResponseCacheEntry.
+// This includes values selected through modifiers, order, etc.
+func (rce *ResponseCacheEntry) Value(name string) (ent.Value, error) {
+	return rce.selectValues.Get(name)
+}
+
+// Update returns a builder for updating this ResponseCacheEntry.
+// Note that you need to call ResponseCacheEntry.Unwrap() before calling this method if this ResponseCacheEntry
+// was returned from a transaction, and the transaction was committed or rolled back.
+func (rce *ResponseCacheEntry) Update() *ResponseCacheEntryUpdateOne {
+	return NewResponseCacheEntryClient(rce.config).UpdateOne(rce)
+}
+
+// Unwrap unwraps the ResponseCacheEntry entity that was returned from a transaction after it was closed,
+// so that all future queries will be executed through the driver which created the transaction.
+func (rce *ResponseCacheEntry) Unwrap() *ResponseCacheEntry {
+	_tx, ok := rce.config.driver.(*txDriver)
+	if !ok {
+		panic("ent: ResponseCacheEntry is not a transactional entity")
+	}
+	rce.config.driver = _tx.drv
+	return rce
+}
+
+// String implements the fmt.Stringer.
+func (rce *ResponseCacheEntry) String() string {
+	var builder strings.Builder
+	builder.WriteString("ResponseCacheEntry(")
+	builder.WriteString(fmt.Sprintf("id=%v, ", rce.ID))
+	builder.WriteString("key=")
+	builder.WriteString(rce.Key)
+	builder.WriteString(", ")
+	builder.WriteString("task_type=")
+	builder.WriteString(rce.TaskType)
+	builder.WriteString(", ")
+	builder.WriteString("model=")
+	builder.WriteString(rce.Model)
+	builder.WriteString(", ")
+	builder.WriteString("response=")
+	builder.WriteString(rce.Response)
+	builder.WriteString(", ")
+	builder.WriteString("hits=")
+	builder.WriteString(fmt.Sprintf("%v", rce.Hits))
+	builder.WriteString(", ")
+	builder.WriteString("created_at=")
+	builder.WriteString(rce.CreatedAt.Format(time.ANSIC))
+	builder.WriteString(", ")
+	builder.WriteString("expires_at=")
+	builder.WriteString(rce.ExpiresAt.Format(time.ANSIC))
+	builder.WriteByte(')')
+	return builder.String()
+}
+
+// ResponseCacheEntries is a parsable slice of ResponseCacheEntry.
+type ResponseCacheEntries []*ResponseCacheEntry
@@ -0,0 +1,108 @@
+// Code generated by ent, DO NOT EDIT.
+
+package responsecacheentry
+
+import (
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+)
+
+const (
+	// Label holds the string label denoting the responsecacheentry type in the database.
+	Label = "response_cache_entry"
+	// FieldID holds the string denoting the id field in the database.
+	FieldID = "id"
+	// FieldKey holds the string denoting the key field in the database.
+	FieldKey = "key"
+	// FieldTaskType holds the string denoting the task_type field in the database.
+	FieldTaskType = "task_type"
+	// FieldModel holds the string denoting the model field in the database.
+	FieldModel = "model"
+	// FieldResponse holds the string denoting the response field in the database.
+	FieldResponse = "response"
+	// FieldHits holds the string denoting the hits field in the database.
+	FieldHits = "hits"
+	// FieldCreatedAt holds the string denoting the created_at field in the database.
+	FieldCreatedAt = "created_at"
+	// FieldExpiresAt holds the string denoting the expires_at field in the database.
+	FieldExpiresAt = "expires_at"
+	// Table holds the table name of the responsecacheentry in the database.
+	Table = "response_cache_entries"
+)
+
+// Columns holds all SQL columns for responsecacheentry fields.
+var Columns = []string{
+	FieldID,
+	FieldKey,
+	FieldTaskType,
+	FieldModel,
+	FieldResponse,
+	FieldHits,
+	FieldCreatedAt,
+	FieldExpiresAt,
+}
+
+// ValidColumn reports if the column name is valid (part of the table columns).
+func ValidColumn(column string) bool {
+	for i := range Columns {
+		if column == Columns[i] {
+			return true
+		}
+	}
+	return false
+}
+
+var (
+	// KeyValidator is a validator for the "key" field. It is called by the builders before save.
+	KeyValidator func(string) error
+	// ResponseValidator is a validator for the "response" field. It is called by the builders before save.
+	ResponseValidator func(string) error
+	// DefaultHits holds the default value on creation for the "hits" field.
+	DefaultHits int
+	// DefaultCreatedAt holds the default value on creation for the "created_at" field.
+	DefaultCreatedAt func() time.Time
+)
+
+// OrderOption defines the ordering options for the ResponseCacheEntry queries.
+type OrderOption func(*sql.Selector)
+
+// ByID orders the results by the id field.
+func ByID(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldID, opts...).ToFunc()
+}
+
+// ByKey orders the results by the key field.
+func ByKey(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldKey, opts...).ToFunc()
+}
+
+// ByTaskType orders the results by the task_type field.
+func ByTaskType(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldTaskType, opts...).ToFunc()
+}
+
+// ByModel orders the results by the model field.
+func ByModel(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldModel, opts...).ToFunc()
+}
+
+// ByResponse orders the results by the response field.
+func ByResponse(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldResponse, opts...).ToFunc()
+}
+
+// ByHits orders the results by the hits field.
+func ByHits(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldHits, opts...).ToFunc()
+}
+
+// ByCreatedAt orders the results by the created_at field.
+func ByCreatedAt(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldCreatedAt, opts...).ToFunc()
+}
+
+// ByExpiresAt orders the results by the expires_at field.
+func ByExpiresAt(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldExpiresAt, opts...).ToFunc()
+}
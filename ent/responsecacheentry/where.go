@@ -0,0 +1,505 @@
+// Code generated by ent, DO NOT EDIT.
+
+package responsecacheentry
+
+import (
+	"ramble-ai/ent/predicate"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+)
+
+// ID filters vertices based on their ID field.
+func ID(id int) predicate.ResponseCacheEntry {
+	return predicate.ResponseCacheEntry(sql.FieldEQ(FieldID, id))
+}
+
+// IDEQ applies the EQ predicate on the ID field.
+func IDEQ(id int) predicate.ResponseCacheEntry {
+	return predicate.ResponseCacheEntry(sql.FieldEQ(FieldID, id))
+}
+
+// IDNEQ applies the NEQ predicate on the ID field.
+func IDNEQ(id int) predicate.ResponseCacheEntry {
+	return predicate.ResponseCacheEntry(sql.FieldNEQ(FieldID, id))
+}
+
+// IDIn applies the In predicate on the ID field.
+func IDIn(ids ...int) predicate.ResponseCacheEntry {
+	return predicate.ResponseCacheEntry(sql.FieldIn(FieldID, ids...))
+}
+
+// IDNotIn applies the NotIn predicate on the ID field.
+func IDNotIn(ids ...int) predicate.ResponseCacheEntry {
+	return predicate.ResponseCacheEntry(sql.FieldNotIn(FieldID, ids...))
+}
+
+// IDGT applies the GT predicate on the ID field.
+func IDGT(id int) predicate.ResponseCacheEntry {
+	return predicate.ResponseCacheEntry(sql.FieldGT(FieldID, id))
+}
+
+// IDGTE applies the GTE predicate on the ID field.
+func IDGTE(id int) predicate.ResponseCacheEntry {
+	return predicate.ResponseCacheEntry(sql.FieldGTE(FieldID, id))
+}
+
+// IDLT applies the LT predicate on the ID field.
+func IDLT(id int) predicate.ResponseCacheEntry {
+	return predicate.ResponseCacheEntry(sql.FieldLT(FieldID, id))
+}
+
+// IDLTE applies the LTE predicate on the ID field.
+func IDLTE(id int) predicate.ResponseCacheEntry {
+	return predicate.ResponseCacheEntry(sql.FieldLTE(FieldID, id))
+}
+
+// Key applies equality check predicate on the "key" field. It's identical to KeyEQ.
+func Key(v string) predicate.ResponseCacheEntry {
+	return predicate.ResponseCacheEntry(sql.FieldEQ(FieldKey, v))
+}
+
+// TaskType applies equality check predicate on the "task_type" field. It's identical to TaskTypeEQ.
+func TaskType(v string) predicate.ResponseCacheEntry {
+	return predicate.ResponseCacheEntry(sql.FieldEQ(FieldTaskType, v))
+}
+
+// Model applies equality check predicate on the "model" field. It's identical to ModelEQ.
+func Model(v string) predicate.ResponseCacheEntry {
+	return predicate.ResponseCacheEntry(sql.FieldEQ(FieldModel, v))
+}
+
+// Response applies equality check predicate on the "response" field. It's identical to ResponseEQ.
+func Response(v string) predicate.ResponseCacheEntry {
+	return predicate.ResponseCacheEntry(sql.FieldEQ(FieldResponse, v))
+}
+
+// Hits applies equality check predicate on the "hits" field. It's identical to HitsEQ.
+func Hits(v int) predicate.ResponseCacheEntry {
+	return predicate.ResponseCacheEntry(sql.FieldEQ(FieldHits, v))
+}
+
+// CreatedAt applies equality check predicate on the "created_at" field. It's identical to CreatedAtEQ.
+func CreatedAt(v time.Time) predicate.ResponseCacheEntry {
+	return predicate.ResponseCacheEntry(sql.FieldEQ(FieldCreatedAt, v))
+}
+
+// ExpiresAt applies equality check predicate on the "expires_at" field. It's identical to ExpiresAtEQ.
+func ExpiresAt(v time.Time) predicate.ResponseCacheEntry {
+	return predicate.ResponseCacheEntry(sql.FieldEQ(FieldExpiresAt, v))
+}
+
+// KeyEQ applies the EQ predicate on the "key" field.
+func KeyEQ(v string) predicate.ResponseCacheEntry {
+	return predicate.ResponseCacheEntry(sql.FieldEQ(FieldKey, v))
+}
+
+// KeyNEQ applies the NEQ predicate on the "key" field.
+func KeyNEQ(v string) predicate.ResponseCacheEntry {
+	return predicate.ResponseCacheEntry(sql.FieldNEQ(FieldKey, v))
+}
+
+// KeyIn applies the In predicate on the "key" field.
+func KeyIn(vs ...string) predicate.ResponseCacheEntry {
+	return predicate.ResponseCacheEntry(sql.FieldIn(FieldKey, vs...))
+}
+
+// KeyNotIn applies the NotIn predicate on the "key" field.
+func KeyNotIn(vs ...string) predicate.ResponseCacheEntry {
+	return predicate.ResponseCacheEntry(sql.FieldNotIn(FieldKey, vs...))
+}
+
+// KeyGT applies the GT predicate on the "key" field.
+func KeyGT(v string) predicate.ResponseCacheEntry {
+	return predicate.ResponseCacheEntry(sql.FieldGT(FieldKey, v))
+}
+
+// KeyGTE applies the GTE predicate on the "key" field.
+func KeyGTE(v string) predicate.ResponseCacheEntry {
+	return predicate.ResponseCacheEntry(sql.FieldGTE(FieldKey, v))
+}
+
+// KeyLT applies the LT predicate on the "key" field.
+func KeyLT(v string) predicate.ResponseCacheEntry {
+	return predicate.ResponseCacheEntry(sql.FieldLT(FieldKey, v))
+}
+
+// KeyLTE applies the LTE predicate on the "key" field.
+func KeyLTE(v string) predicate.ResponseCacheEntry {
+	return predicate.ResponseCacheEntry(sql.FieldLTE(FieldKey, v))
+}
+
+// KeyContains applies the Contains predicate on the "key" field.
+func KeyContains(v string) predicate.ResponseCacheEntry {
+	return predicate.ResponseCacheEntry(sql.FieldContains(FieldKey, v))
+}
+
+// KeyHasPrefix applies the HasPrefix predicate on the "key" field.
+func KeyHasPrefix(v string) predicate.ResponseCacheEntry {
+	return predicate.ResponseCacheEntry(sql.FieldHasPrefix(FieldKey, v))
+}
+
+// KeyHasSuffix applies the HasSuffix predicate on the "key" field.
+func KeyHasSuffix(v string) predicate.ResponseCacheEntry {
+	return predicate.ResponseCacheEntry(sql.FieldHasSuffix(FieldKey, v))
+}
+
+// KeyEqualFold applies the EqualFold predicate on the "key" field.
+func KeyEqualFold(v string) predicate.ResponseCacheEntry {
+	return predicate.ResponseCacheEntry(sql.FieldEqualFold(FieldKey, v))
+}
+
+// KeyContainsFold applies the ContainsFold predicate on the "key" field.
+func KeyContainsFold(v string) predicate.ResponseCacheEntry {
+	return predicate.ResponseCacheEntry(sql.FieldContainsFold(FieldKey, v))
+}
+
+// TaskTypeEQ applies the EQ predicate on the "task_type" field.
+func TaskTypeEQ(v string) predicate.ResponseCacheEntry {
+	return predicate.ResponseCacheEntry(sql.FieldEQ(FieldTaskType, v))
+}
+
+// TaskTypeNEQ applies the NEQ predicate on the "task_type" field.
+func TaskTypeNEQ(v string) predicate.ResponseCacheEntry {
+	return predicate.ResponseCacheEntry(sql.FieldNEQ(FieldTaskType, v))
+}
+
+// TaskTypeIn applies the In predicate on the "task_type" field.
+func TaskTypeIn(vs ...string) predicate.ResponseCacheEntry {
+	return predicate.ResponseCacheEntry(sql.FieldIn(FieldTaskType, vs...))
+}
+
+// TaskTypeNotIn applies the NotIn predicate on the "task_type" field.
+func TaskTypeNotIn(vs ...string) predicate.ResponseCacheEntry {
+	return predicate.ResponseCacheEntry(sql.FieldNotIn(FieldTaskType, vs...))
+}
+
+// TaskTypeGT applies the GT predicate on the "task_type" field.
+func TaskTypeGT(v string) predicate.ResponseCacheEntry {
+	return predicate.ResponseCacheEntry(sql.FieldGT(FieldTaskType, v))
+}
+
+// TaskTypeGTE applies the GTE predicate on the "task_type" field.
+func TaskTypeGTE(v string) predicate.ResponseCacheEntry {
+	return predicate.ResponseCacheEntry(sql.FieldGTE(FieldTaskType, v))
+}
+
+// TaskTypeLT applies the LT predicate on the "task_type" field.
+func TaskTypeLT(v string) predicate.ResponseCacheEntry {
+	return predicate.ResponseCacheEntry(sql.FieldLT(FieldTaskType, v))
+}
+
+// TaskTypeLTE applies the LTE predicate on the "task_type" field.
+func TaskTypeLTE(v string) predicate.ResponseCacheEntry {
+	return predicate.ResponseCacheEntry(sql.FieldLTE(FieldTaskType, v))
+}
+
+// TaskTypeContains applies the Contains predicate on the "task_type" field.
+func TaskTypeContains(v string) predicate.ResponseCacheEntry {
+	return predicate.ResponseCacheEntry(sql.FieldContains(FieldTaskType, v))
+}
+
+// TaskTypeHasPrefix applies the HasPrefix predicate on the "task_type" field.
+func TaskTypeHasPrefix(v string) predicate.ResponseCacheEntry {
+	return predicate.ResponseCacheEntry(sql.FieldHasPrefix(FieldTaskType, v))
+}
+
+// TaskTypeHasSuffix applies the HasSuffix predicate on the "task_type" field.
+func TaskTypeHasSuffix(v string) predicate.ResponseCacheEntry {
+	return predicate.ResponseCacheEntry(sql.FieldHasSuffix(FieldTaskType, v))
+}
+
+// TaskTypeIsNil applies the IsNil predicate on the "task_type" field.
+func TaskTypeIsNil() predicate.ResponseCacheEntry {
+	return predicate.ResponseCacheEntry(sql.FieldIsNull(FieldTaskType))
+}
+
+// TaskTypeNotNil applies the NotNil predicate on the "task_type" field.
+func TaskTypeNotNil() predicate.ResponseCacheEntry {
+	return predicate.ResponseCacheEntry(sql.FieldNotNull(FieldTaskType))
+}
+
+// TaskTypeEqualFold applies the EqualFold predicate on the "task_type" field.
+func TaskTypeEqualFold(v string) predicate.ResponseCacheEntry {
+	return predicate.ResponseCacheEntry(sql.FieldEqualFold(FieldTaskType, v))
+}
+
+// TaskTypeContainsFold applies the ContainsFold predicate on the "task_type" field.
+func TaskTypeContainsFold(v string) predicate.ResponseCacheEntry {
+	return predicate.ResponseCacheEntry(sql.FieldContainsFold(FieldTaskType, v))
+}
+
+// ModelEQ applies the EQ predicate on the "model" field.
+func ModelEQ(v string) predicate.ResponseCacheEntry {
+	return predicate.ResponseCacheEntry(sql.FieldEQ(FieldModel, v))
+}
+
+// ModelNEQ applies the NEQ predicate on the "model" field.
+func ModelNEQ(v string) predicate.ResponseCacheEntry {
+	return predicate.ResponseCacheEntry(sql.FieldNEQ(FieldModel, v))
+}
+
+// ModelIn applies the In predicate on the "model" field.
+func ModelIn(vs ...string) predicate.ResponseCacheEntry {
+	return predicate.ResponseCacheEntry(sql.FieldIn(FieldModel, vs...))
+}
+
+// ModelNotIn applies the NotIn predicate on the "model" field.
+func ModelNotIn(vs ...string) predicate.ResponseCacheEntry {
+	return predicate.ResponseCacheEntry(sql.FieldNotIn(FieldModel, vs...))
+}
+
+// ModelGT applies the GT predicate on the "model" field.
+func ModelGT(v string) predicate.ResponseCacheEntry {
+	return predicate.ResponseCacheEntry(sql.FieldGT(FieldModel, v))
+}
+
+// ModelGTE applies the GTE predicate on the "model" field.
+func ModelGTE(v string) predicate.ResponseCacheEntry {
+	return predicate.ResponseCacheEntry(sql.FieldGTE(FieldModel, v))
+}
+
+// ModelLT applies the LT predicate on the "model" field.
+func ModelLT(v string) predicate.ResponseCacheEntry {
+	return predicate.ResponseCacheEntry(sql.FieldLT(FieldModel, v))
+}
+
+// ModelLTE applies the LTE predicate on the "model" field.
+func ModelLTE(v string) predicate.ResponseCacheEntry {
+	return predicate.ResponseCacheEntry(sql.FieldLTE(FieldModel, v))
+}
+
+// ModelContains applies the Contains predicate on the "model" field.
+func ModelContains(v string) predicate.ResponseCacheEntry {
+	return predicate.ResponseCacheEntry(sql.FieldContains(FieldModel, v))
+}
+
+// ModelHasPrefix applies the HasPrefix predicate on the "model" field.
+func ModelHasPrefix(v string) predicate.ResponseCacheEntry {
+	return predicate.ResponseCacheEntry(sql.FieldHasPrefix(FieldModel, v))
+}
+
+// ModelHasSuffix applies the HasSuffix predicate on the "model" field.
+func ModelHasSuffix(v string) predicate.ResponseCacheEntry {
+	return predicate.ResponseCacheEntry(sql.FieldHasSuffix(FieldModel, v))
+}
+
+// ModelIsNil applies the IsNil predicate on the "model" field.
+func ModelIsNil() predicate.ResponseCacheEntry {
+	return predicate.ResponseCacheEntry(sql.FieldIsNull(FieldModel))
+}
+
+// ModelNotNil applies the NotNil predicate on the "model" field.
+func ModelNotNil() predicate.ResponseCacheEntry {
+	return predicate.ResponseCacheEntry(sql.FieldNotNull(FieldModel))
+}
+
+// ModelEqualFold applies the EqualFold predicate on the "model" field.
+func ModelEqualFold(v string) predicate.ResponseCacheEntry {
+	return predicate.ResponseCacheEntry(sql.FieldEqualFold(FieldModel, v))
+}
+
+// ModelContainsFold applies the ContainsFold predicate on the "model" field.
+func ModelContainsFold(v string) predicate.ResponseCacheEntry {
+	return predicate.ResponseCacheEntry(sql.FieldContainsFold(FieldModel, v))
+}
+
+// ResponseEQ applies the EQ predicate on the "response" field.
+func ResponseEQ(v string) predicate.ResponseCacheEntry {
+	return predicate.ResponseCacheEntry(sql.FieldEQ(FieldResponse, v))
+}
+
+// ResponseNEQ applies the NEQ predicate on the "response" field.
+func ResponseNEQ(v string) predicate.ResponseCacheEntry {
+	return predicate.ResponseCacheEntry(sql.FieldNEQ(FieldResponse, v))
+}
+
+// ResponseIn applies the In predicate on the "response" field.
+func ResponseIn(vs ...string) predicate.ResponseCacheEntry {
+	return predicate.ResponseCacheEntry(sql.FieldIn(FieldResponse, vs...))
+}
+
+// ResponseNotIn applies the NotIn predicate on the "response" field.
+func ResponseNotIn(vs ...string) predicate.ResponseCacheEntry {
+	return predicate.ResponseCacheEntry(sql.FieldNotIn(FieldResponse, vs...))
+}
+
+// ResponseGT applies the GT predicate on the "response" field.
+func ResponseGT(v string) predicate.ResponseCacheEntry {
+	return predicate.ResponseCacheEntry(sql.FieldGT(FieldResponse, v))
+}
+
+// ResponseGTE applies the GTE predicate on the "response" field.
+func ResponseGTE(v string) predicate.ResponseCacheEntry {
+	return predicate.ResponseCacheEntry(sql.FieldGTE(FieldResponse, v))
+}
+
+// ResponseLT applies the LT predicate on the "response" field.
+func ResponseLT(v string) predicate.ResponseCacheEntry {
+	return predicate.ResponseCacheEntry(sql.FieldLT(FieldResponse, v))
+}
+
+// ResponseLTE applies the LTE predicate on the "response" field.
+func ResponseLTE(v string) predicate.ResponseCacheEntry {
+	return predicate.ResponseCacheEntry(sql.FieldLTE(FieldResponse, v))
+}
+
+// ResponseContains applies the Contains predicate on the "response" field.
+func ResponseContains(v string) predicate.ResponseCacheEntry {
+	return predicate.ResponseCacheEntry(sql.FieldContains(FieldResponse, v))
+}
+
+// ResponseHasPrefix applies the HasPrefix predicate on the "response" field.
+func ResponseHasPrefix(v string) predicate.ResponseCacheEntry {
+	return predicate.ResponseCacheEntry(sql.FieldHasPrefix(FieldResponse, v))
+}
+
+// ResponseHasSuffix applies the HasSuffix predicate on the "response" field.
+func ResponseHasSuffix(v string) predicate.ResponseCacheEntry {
+	return predicate.ResponseCacheEntry(sql.FieldHasSuffix(FieldResponse, v))
+}
+
+// ResponseEqualFold applies the EqualFold predicate on the "response" field.
+func ResponseEqualFold(v string) predicate.ResponseCacheEntry {
+	return predicate.ResponseCacheEntry(sql.FieldEqualFold(FieldResponse, v))
+}
+
+// ResponseContainsFold applies the ContainsFold predicate on the "response" field.
+func ResponseContainsFold(v string) predicate.ResponseCacheEntry {
+	return predicate.ResponseCacheEntry(sql.FieldContainsFold(FieldResponse, v))
+}
+
+// HitsEQ applies the EQ predicate on the "hits" field.
+func HitsEQ(v int) predicate.ResponseCacheEntry {
+	return predicate.ResponseCacheEntry(sql.FieldEQ(FieldHits, v))
+}
+
+// HitsNEQ applies the NEQ predicate on the "hits" field.
+func HitsNEQ(v int) predicate.ResponseCacheEntry {
+	return predicate.ResponseCacheEntry(sql.FieldNEQ(FieldHits, v))
+}
+
+// HitsIn applies the In predicate on the "hits" field.
+func HitsIn(vs ...int) predicate.ResponseCacheEntry {
+	return predicate.ResponseCacheEntry(sql.FieldIn(FieldHits, vs...))
+}
+
+// HitsNotIn applies the NotIn predicate on the "hits" field.
+func HitsNotIn(vs ...int) predicate.ResponseCacheEntry {
+	return predicate.ResponseCacheEntry(sql.FieldNotIn(FieldHits, vs...))
+}
+
+// HitsGT applies the GT predicate on the "hits" field.
+func HitsGT(v int) predicate.ResponseCacheEntry {
+	return predicate.ResponseCacheEntry(sql.FieldGT(FieldHits, v))
+}
+
+// HitsGTE applies the GTE predicate on the "hits" field.
+func HitsGTE(v int) predicate.ResponseCacheEntry {
+	return predicate.ResponseCacheEntry(sql.FieldGTE(FieldHits, v))
+}
+
+// HitsLT applies the LT predicate on the "hits" field.
+func HitsLT(v int) predicate.ResponseCacheEntry {
+	return predicate.ResponseCacheEntry(sql.FieldLT(FieldHits, v))
+}
+
+// HitsLTE applies the LTE predicate on the "hits" field.
+func HitsLTE(v int) predicate.ResponseCacheEntry {
+	return predicate.ResponseCacheEntry(sql.FieldLTE(FieldHits, v))
+}
+
+// CreatedAtEQ applies the EQ predicate on the "created_at" field.
+func CreatedAtEQ(v time.Time) predicate.ResponseCacheEntry {
+	return predicate.ResponseCacheEntry(sql.FieldEQ(FieldCreatedAt, v))
+}
+
+// CreatedAtNEQ applies the NEQ predicate on the "created_at" field.
+func CreatedAtNEQ(v time.Time) predicate.ResponseCacheEntry {
+	return predicate.ResponseCacheEntry(sql.FieldNEQ(FieldCreatedAt, v))
+}
+
+// CreatedAtIn applies the In predicate on the "created_at" field.
+func CreatedAtIn(vs ...time.Time) predicate.ResponseCacheEntry {
+	return predicate.ResponseCacheEntry(sql.FieldIn(FieldCreatedAt, vs...))
+}
+
+// CreatedAtNotIn applies the NotIn predicate on the "created_at" field.
+func CreatedAtNotIn(vs ...time.Time) predicate.ResponseCacheEntry {
+	return predicate.ResponseCacheEntry(sql.FieldNotIn(FieldCreatedAt, vs...))
+}
+
+// CreatedAtGT applies the GT predicate on the "created_at" field.
+func CreatedAtGT(v time.Time) predicate.ResponseCacheEntry {
+	return predicate.ResponseCacheEntry(sql.FieldGT(FieldCreatedAt, v))
+}
+
+// CreatedAtGTE applies the GTE predicate on the "created_at" field.
+func CreatedAtGTE(v time.Time) predicate.ResponseCacheEntry {
+	return predicate.ResponseCacheEntry(sql.FieldGTE(FieldCreatedAt, v))
+}
+
+// CreatedAtLT applies the LT predicate on the "created_at" field.
+func CreatedAtLT(v time.Time) predicate.ResponseCacheEntry {
+	return predicate.ResponseCacheEntry(sql.FieldLT(FieldCreatedAt, v))
+}
+
+// CreatedAtLTE applies the LTE predicate on the "created_at" field.
+func CreatedAtLTE(v time.Time) predicate.ResponseCacheEntry {
+	return predicate.ResponseCacheEntry(sql.FieldLTE(FieldCreatedAt, v))
+}
+
+// ExpiresAtEQ applies the EQ predicate on the "expires_at" field.
+func ExpiresAtEQ(v time.Time) predicate.ResponseCacheEntry {
+	return predicate.ResponseCacheEntry(sql.FieldEQ(FieldExpiresAt, v))
+}
+
+// ExpiresAtNEQ applies the NEQ predicate on the "expires_at" field.
+func ExpiresAtNEQ(v time.Time) predicate.ResponseCacheEntry {
+	return predicate.ResponseCacheEntry(sql.FieldNEQ(FieldExpiresAt, v))
+}
+
+// ExpiresAtIn applies the In predicate on the "expires_at" field.
+func ExpiresAtIn(vs ...time.Time) predicate.ResponseCacheEntry {
+	return predicate.ResponseCacheEntry(sql.FieldIn(FieldExpiresAt, vs...))
+}
+
+// ExpiresAtNotIn applies the NotIn predicate on the "expires_at" field.
+func ExpiresAtNotIn(vs ...time.Time) predicate.ResponseCacheEntry {
+	return predicate.ResponseCacheEntry(sql.FieldNotIn(FieldExpiresAt, vs...))
+}
+
+// ExpiresAtGT applies the GT predicate on the "expires_at" field.
+func ExpiresAtGT(v time.Time) predicate.ResponseCacheEntry {
+	return predicate.ResponseCacheEntry(sql.FieldGT(FieldExpiresAt, v))
+}
+
+// ExpiresAtGTE applies the GTE predicate on the "expires_at" field.
+func ExpiresAtGTE(v time.Time) predicate.ResponseCacheEntry {
+	return predicate.ResponseCacheEntry(sql.FieldGTE(FieldExpiresAt, v))
+}
+
+// ExpiresAtLT applies the LT predicate on the "expires_at" field.
+func ExpiresAtLT(v time.Time) predicate.ResponseCacheEntry {
+	return predicate.ResponseCacheEntry(sql.FieldLT(FieldExpiresAt, v))
+}
+
+// ExpiresAtLTE applies the LTE predicate on the "expires_at" field.
+func ExpiresAtLTE(v time.Time) predicate.ResponseCacheEntry {
+	return predicate.ResponseCacheEntry(sql.FieldLTE(FieldExpiresAt, v))
+}
+
+// And groups predicates with the AND operator between them.
+func And(predicates ...predicate.ResponseCacheEntry) predicate.ResponseCacheEntry {
+	return predicate.ResponseCacheEntry(sql.AndPredicates(predicates...))
+}
+
+// Or groups predicates with the OR operator between them.
+func Or(predicates ...predicate.ResponseCacheEntry) predicate.ResponseCacheEntry {
+	return predicate.ResponseCacheEntry(sql.OrPredicates(predicates...))
+}
+
+// Not applies the not operator on the given predicate.
+func Not(p predicate.ResponseCacheEntry) predicate.ResponseCacheEntry {
+	return predicate.ResponseCacheEntry(sql.NotPredicates(p))
+}
@@ -0,0 +1,312 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"ramble-ai/ent/responsecacheentry"
+	"time"
+
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+)
+
+// ResponseCacheEntryCreate is the builder for creating a ResponseCacheEntry entity.
+type ResponseCacheEntryCreate struct {
+	config
+	mutation *ResponseCacheEntryMutation
+	hooks    []Hook
+}
+
+// SetKey sets the "key" field.
+func (rcec *ResponseCacheEntryCreate) SetKey(s string) *ResponseCacheEntryCreate {
+	rcec.mutation.SetKey(s)
+	return rcec
+}
+
+// SetTaskType sets the "task_type" field.
+func (rcec *ResponseCacheEntryCreate) SetTaskType(s string) *ResponseCacheEntryCreate {
+	rcec.mutation.SetTaskType(s)
+	return rcec
+}
+
+// SetNillableTaskType sets the "task_type" field if the given value is not nil.
+func (rcec *ResponseCacheEntryCreate) SetNillableTaskType(s *string) *ResponseCacheEntryCreate {
+	if s != nil {
+		rcec.SetTaskType(*s)
+	}
+	return rcec
+}
+
+// SetModel sets the "model" field.
+func (rcec *ResponseCacheEntryCreate) SetModel(s string) *ResponseCacheEntryCreate {
+	rcec.mutation.SetModel(s)
+	return rcec
+}
+
+// SetNillableModel sets the "model" field if the given value is not nil.
+func (rcec *ResponseCacheEntryCreate) SetNillableModel(s *string) *ResponseCacheEntryCreate {
+	if s != nil {
+		rcec.SetModel(*s)
+	}
+	return rcec
+}
+
+// SetResponse sets the "response" field.
+func (rcec *ResponseCacheEntryCreate) SetResponse(s string) *ResponseCacheEntryCreate {
+	rcec.mutation.SetResponse(s)
+	return rcec
+}
+
+// SetHits sets the "hits" field.
+func (rcec *ResponseCacheEntryCreate) SetHits(i int) *ResponseCacheEntryCreate {
+	rcec.mutation.SetHits(i)
+	return rcec
+}
+
+// SetNillableHits sets the "hits" field if the given value is not nil.
+func (rcec *ResponseCacheEntryCreate) SetNillableHits(i *int) *ResponseCacheEntryCreate {
+	if i != nil {
+		rcec.SetHits(*i)
+	}
+	return rcec
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (rcec *ResponseCacheEntryCreate) SetCreatedAt(t time.Time) *ResponseCacheEntryCreate {
+	rcec.mutation.SetCreatedAt(t)
+	return rcec
+}
+
+// SetNillableCreatedAt sets the "created_at" field if the given value is not nil.
+func (rcec *ResponseCacheEntryCreate) SetNillableCreatedAt(t *time.Time) *ResponseCacheEntryCreate {
+	if t != nil {
+		rcec.SetCreatedAt(*t)
+	}
+	return rcec
+}
+
+// SetExpiresAt sets the "expires_at" field.
+func (rcec *ResponseCacheEntryCreate) SetExpiresAt(t time.Time) *ResponseCacheEntryCreate {
+	rcec.mutation.SetExpiresAt(t)
+	return rcec
+}
+
+// Mutation returns the ResponseCacheEntryMutation object of the builder.
+func (rcec *ResponseCacheEntryCreate) Mutation() *ResponseCacheEntryMutation {
+	return rcec.mutation
+}
+
+// Save creates the ResponseCacheEntry in the database.
+func (rcec *ResponseCacheEntryCreate) Save(ctx context.Context) (*ResponseCacheEntry, error) {
+	rcec.defaults()
+	return withHooks(ctx, rcec.sqlSave, rcec.mutation, rcec.hooks)
+}
+
+// SaveX calls Save and panics if Save returns an error.
+func (rcec *ResponseCacheEntryCreate) SaveX(ctx context.Context) *ResponseCacheEntry {
+	v, err := rcec.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (rcec *ResponseCacheEntryCreate) Exec(ctx context.Context) error {
+	_, err := rcec.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (rcec *ResponseCacheEntryCreate) ExecX(ctx context.Context) {
+	if err := rcec.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (rcec *ResponseCacheEntryCreate) defaults() {
+	if _, ok := rcec.mutation.Hits(); !ok {
+		v := responsecacheentry.DefaultHits
+		rcec.mutation.SetHits(v)
+	}
+	if _, ok := rcec.mutation.CreatedAt(); !ok {
+		v := responsecacheentry.DefaultCreatedAt()
+		rcec.mutation.SetCreatedAt(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (rcec *ResponseCacheEntryCreate) check() error {
+	if _, ok := rcec.mutation.Key(); !ok {
+		return &ValidationError{Name: "key", err: errors.New(`ent: missing required field "ResponseCacheEntry.key"`)}
+	}
+	if v, ok := rcec.mutation.Key(); ok {
+		if err := responsecacheentry.KeyValidator(v); err != nil {
+			return &ValidationError{Name: "key", err: fmt.Errorf(`ent: validator failed for field "ResponseCacheEntry.key": %w`, err)}
+		}
+	}
+	if _, ok := rcec.mutation.Response(); !ok {
+		return &ValidationError{Name: "response", err: errors.New(`ent: missing required field "ResponseCacheEntry.response"`)}
+	}
+	if v, ok := rcec.mutation.Response(); ok {
+		if err := responsecacheentry.ResponseValidator(v); err != nil {
+			return &ValidationError{Name: "response", err: fmt.Errorf(`ent: validator failed for field "ResponseCacheEntry.response": %w`, err)}
+		}
+	}
+	if _, ok := rcec.mutation.Hits(); !ok {
+		return &ValidationError{Name: "hits", err: errors.New(`ent: missing required field "ResponseCacheEntry.hits"`)}
+	}
+	if _, ok := rcec.mutation.CreatedAt(); !ok {
+		return &ValidationError{Name: "created_at", err: errors.New(`ent: missing required field "ResponseCacheEntry.created_at"`)}
+	}
+	if _, ok := rcec.mutation.ExpiresAt(); !ok {
+		return &ValidationError{Name: "expires_at", err: errors.New(`ent: missing required field "ResponseCacheEntry.expires_at"`)}
+	}
+	return nil
+}
+
+func (rcec *ResponseCacheEntryCreate) sqlSave(ctx context.Context) (*ResponseCacheEntry, error) {
+	if err := rcec.check(); err != nil {
+		return nil, err
+	}
+	_node, _spec := rcec.createSpec()
+	if err := sqlgraph.CreateNode(ctx, rcec.driver, _spec); err != nil {
+		if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	id := _spec.ID.Value.(int64)
+	_node.ID = int(id)
+	rcec.mutation.id = &_node.ID
+	rcec.mutation.done = true
+	return _node, nil
+}
+
+func (rcec *ResponseCacheEntryCreate) createSpec() (*ResponseCacheEntry, *sqlgraph.CreateSpec) {
+	var (
+		_node = &ResponseCacheEntry{config: rcec.config}
+		_spec = sqlgraph.NewCreateSpec(responsecacheentry.Table, sqlgraph.NewFieldSpec(responsecacheentry.FieldID, field.TypeInt))
+	)
+	if value, ok := rcec.mutation.Key(); ok {
+		_spec.SetField(responsecacheentry.FieldKey, field.TypeString, value)
+		_node.Key = value
+	}
+	if value, ok := rcec.mutation.TaskType(); ok {
+		_spec.SetField(responsecacheentry.FieldTaskType, field.TypeString, value)
+		_node.TaskType = value
+	}
+	if value, ok := rcec.mutation.Model(); ok {
+		_spec.SetField(responsecacheentry.FieldModel, field.TypeString, value)
+		_node.Model = value
+	}
+	if value, ok := rcec.mutation.Response(); ok {
+		_spec.SetField(responsecacheentry.FieldResponse, field.TypeString, value)
+		_node.Response = value
+	}
+	if value, ok := rcec.mutation.Hits(); ok {
+		_spec.SetField(responsecacheentry.FieldHits, field.TypeInt, value)
+		_node.Hits = value
+	}
+	if value, ok := rcec.mutation.CreatedAt(); ok {
+		_spec.SetField(responsecacheentry.FieldCreatedAt, field.TypeTime, value)
+		_node.CreatedAt = value
+	}
+	if value, ok := rcec.mutation.ExpiresAt(); ok {
+		_spec.SetField(responsecacheentry.FieldExpiresAt, field.TypeTime, value)
+		_node.ExpiresAt = value
+	}
+	return _node, _spec
+}
+
+// ResponseCacheEntryCreateBulk is the builder for creating many ResponseCacheEntry entities in bulk.
+type ResponseCacheEntryCreateBulk struct {
+	config
+	err      error
+	builders []*ResponseCacheEntryCreate
+}
+
+// Save creates the ResponseCacheEntry entities in the database.
+func (rcecb *ResponseCacheEntryCreateBulk) Save(ctx context.Context) ([]*ResponseCacheEntry, error) {
+	if rcecb.err != nil {
+		return nil, rcecb.err
+	}
+	specs := make([]*sqlgraph.CreateSpec, len(rcecb.builders))
+	nodes := make([]*ResponseCacheEntry, len(rcecb.builders))
+	mutators := make([]Mutator, len(rcecb.builders))
+	for i := range rcecb.builders {
+		func(i int, root context.Context) {
+			builder := rcecb.builders[i]
+			builder.defaults()
+			var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+				mutation, ok := m.(*ResponseCacheEntryMutation)
+				if !ok {
+					return nil, fmt.Errorf("unexpected mutation type %T", m)
+				}
+				if err := builder.check(); err != nil {
+					return nil, err
+				}
+				builder.mutation = mutation
+				var err error
+				nodes[i], specs[i] = builder.createSpec()
+				if i < len(mutators)-1 {
+					_, err = mutators[i+1].Mutate(root, rcecb.builders[i+1].mutation)
+				} else {
+					spec := &sqlgraph.BatchCreateSpec{Nodes: specs}
+					// Invoke the actual operation on the latest mutation in the chain.
+					if err = sqlgraph.BatchCreate(ctx, rcecb.driver, spec); err != nil {
+						if sqlgraph.IsConstraintError(err) {
+							err = &ConstraintError{msg: err.Error(), wrap: err}
+						}
+					}
+				}
+				if err != nil {
+					return nil, err
+				}
+				mutation.id = &nodes[i].ID
+				if specs[i].ID.Value != nil {
+					id := specs[i].ID.Value.(int64)
+					nodes[i].ID = int(id)
+				}
+				mutation.done = true
+				return nodes[i], nil
+			})
+			for i := len(builder.hooks) - 1; i >= 0; i-- {
+				mut = builder.hooks[i](mut)
+			}
+			mutators[i] = mut
+		}(i, ctx)
+	}
+	if len(mutators) > 0 {
+		if _, err := mutators[0].Mutate(ctx, rcecb.builders[0].mutation); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (rcecb *ResponseCacheEntryCreateBulk) SaveX(ctx context.Context) []*ResponseCacheEntry {
+	v, err := rcecb.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (rcecb *ResponseCacheEntryCreateBulk) Exec(ctx context.Context) error {
+	_, err := rcecb.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (rcecb *ResponseCacheEntryCreateBulk) ExecX(ctx context.Context) {
+	if err := rcecb.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
@@ -0,0 +1,88 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"ramble-ai/ent/predicate"
+	"ramble-ai/ent/responsecacheentry"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+)
+
+// ResponseCacheEntryDelete is the builder for deleting a ResponseCacheEntry entity.
+type ResponseCacheEntryDelete struct {
+	config
+	hooks    []Hook
+	mutation *ResponseCacheEntryMutation
+}
+
+// Where appends a list predicates to the ResponseCacheEntryDelete builder.
+func (rced *ResponseCacheEntryDelete) Where(ps ...predicate.ResponseCacheEntry) *ResponseCacheEntryDelete {
+	rced.mutation.Where(ps...)
+	return rced
+}
+
+// Exec executes the deletion query and returns how many vertices were deleted.
+func (rced *ResponseCacheEntryDelete) Exec(ctx context.Context) (int, error) {
+	return withHooks(ctx, rced.sqlExec, rced.mutation, rced.hooks)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (rced *ResponseCacheEntryDelete) ExecX(ctx context.Context) int {
+	n, err := rced.Exec(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return n
+}
+
+func (rced *ResponseCacheEntryDelete) sqlExec(ctx context.Context) (int, error) {
+	_spec := sqlgraph.NewDeleteSpec(responsecacheentry.Table, sqlgraph.NewFieldSpec(responsecacheentry.FieldID, field.TypeInt))
+	if ps := rced.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	affected, err := sqlgraph.DeleteNodes(ctx, rced.driver, _spec)
+	if err != nil && sqlgraph.IsConstraintError(err) {
+		err = &ConstraintError{msg: err.Error(), wrap: err}
+	}
+	rced.mutation.done = true
+	return affected, err
+}
+
+// ResponseCacheEntryDeleteOne is the builder for deleting a single ResponseCacheEntry entity.
+type ResponseCacheEntryDeleteOne struct {
+	rced *ResponseCacheEntryDelete
+}
+
+// Where appends a list predicates to the ResponseCacheEntryDelete builder.
+func (rcedo *ResponseCacheEntryDeleteOne) Where(ps ...predicate.ResponseCacheEntry) *ResponseCacheEntryDeleteOne {
+	rcedo.rced.mutation.Where(ps...)
+	return rcedo
+}
+
+// Exec executes the deletion query.
+func (rcedo *ResponseCacheEntryDeleteOne) Exec(ctx context.Context) error {
+	n, err := rcedo.rced.Exec(ctx)
+	switch {
+	case err != nil:
+		return err
+	case n == 0:
+		return &NotFoundError{responsecacheentry.Label}
+	default:
+		return nil
+	}
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (rcedo *ResponseCacheEntryDeleteOne) ExecX(ctx context.Context) {
+	if err := rcedo.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
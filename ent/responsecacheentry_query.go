@@ -0,0 +1,527 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"fmt"
+	"math"
+	"ramble-ai/ent/predicate"
+	"ramble-ai/ent/responsecacheentry"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+)
+
+// ResponseCacheEntryQuery is the builder for querying ResponseCacheEntry entities.
+type ResponseCacheEntryQuery struct {
+	config
+	ctx        *QueryContext
+	order      []responsecacheentry.OrderOption
+	inters     []Interceptor
+	predicates []predicate.ResponseCacheEntry
+	// intermediate query (i.e. traversal path).
+	sql  *sql.Selector
+	path func(context.Context) (*sql.Selector, error)
+}
+
+// Where adds a new predicate for the ResponseCacheEntryQuery builder.
+func (rceq *ResponseCacheEntryQuery) Where(ps ...predicate.ResponseCacheEntry) *ResponseCacheEntryQuery {
+	rceq.predicates = append(rceq.predicates, ps...)
+	return rceq
+}
+
+// Limit the number of records to be returned by this query.
+func (rceq *ResponseCacheEntryQuery) Limit(limit int) *ResponseCacheEntryQuery {
+	rceq.ctx.Limit = &limit
+	return rceq
+}
+
+// Offset to start from.
+func (rceq *ResponseCacheEntryQuery) Offset(offset int) *ResponseCacheEntryQuery {
+	rceq.ctx.Offset = &offset
+	return rceq
+}
+
+// Unique configures the query builder to filter duplicate records on query.
+// By default, unique is set to true, and can be disabled using this method.
+func (rceq *ResponseCacheEntryQuery) Unique(unique bool) *ResponseCacheEntryQuery {
+	rceq.ctx.Unique = &unique
+	return rceq
+}
+
+// Order specifies how the records should be ordered.
+func (rceq *ResponseCacheEntryQuery) Order(o ...responsecacheentry.OrderOption) *ResponseCacheEntryQuery {
+	rceq.order = append(rceq.order, o...)
+	return rceq
+}
+
+// First returns the first ResponseCacheEntry entity from the query.
+// Returns a *NotFoundError when no ResponseCacheEntry was found.
+func (rceq *ResponseCacheEntryQuery) First(ctx context.Context) (*ResponseCacheEntry, error) {
+	nodes, err := rceq.Limit(1).All(setContextOp(ctx, rceq.ctx, ent.OpQueryFirst))
+	if err != nil {
+		return nil, err
+	}
+	if len(nodes) == 0 {
+		return nil, &NotFoundError{responsecacheentry.Label}
+	}
+	return nodes[0], nil
+}
+
+// FirstX is like First, but panics if an error occurs.
+func (rceq *ResponseCacheEntryQuery) FirstX(ctx context.Context) *ResponseCacheEntry {
+	node, err := rceq.First(ctx)
+	if err != nil && !IsNotFound(err) {
+		panic(err)
+	}
+	return node
+}
+
+// FirstID returns the first ResponseCacheEntry ID from the query.
+// Returns a *NotFoundError when no ResponseCacheEntry ID was found.
+func (rceq *ResponseCacheEntryQuery) FirstID(ctx context.Context) (id int, err error) {
+	var ids []int
+	if ids, err = rceq.Limit(1).IDs(setContextOp(ctx, rceq.ctx, ent.OpQueryFirstID)); err != nil {
+		return
+	}
+	if len(ids) == 0 {
+		err = &NotFoundError{responsecacheentry.Label}
+		return
+	}
+	return ids[0], nil
+}
+
+// FirstIDX is like FirstID, but panics if an error occurs.
+func (rceq *ResponseCacheEntryQuery) FirstIDX(ctx context.Context) int {
+	id, err := rceq.FirstID(ctx)
+	if err != nil && !IsNotFound(err) {
+		panic(err)
+	}
+	return id
+}
+
+// Only returns a single ResponseCacheEntry entity found by the query, ensuring it only returns one.
+// Returns a *NotSingularError when more than one ResponseCacheEntry entity is found.
+// Returns a *NotFoundError when no ResponseCacheEntry entities are found.
+func (rceq *ResponseCacheEntryQuery) Only(ctx context.Context) (*ResponseCacheEntry, error) {
+	nodes, err := rceq.Limit(2).All(setContextOp(ctx, rceq.ctx, ent.OpQueryOnly))
+	if err != nil {
+		return nil, err
+	}
+	switch len(nodes) {
+	case 1:
+		return nodes[0], nil
+	case 0:
+		return nil, &NotFoundError{responsecacheentry.Label}
+	default:
+		return nil, &NotSingularError{responsecacheentry.Label}
+	}
+}
+
+// OnlyX is like Only, but panics if an error occurs.
+func (rceq *ResponseCacheEntryQuery) OnlyX(ctx context.Context) *ResponseCacheEntry {
+	node, err := rceq.Only(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// OnlyID is like Only, but returns the only ResponseCacheEntry ID in the query.
+// Returns a *NotSingularError when more than one ResponseCacheEntry ID is found.
+// Returns a *NotFoundError when no entities are found.
+func (rceq *ResponseCacheEntryQuery) OnlyID(ctx context.Context) (id int, err error) {
+	var ids []int
+	if ids, err = rceq.Limit(2).IDs(setContextOp(ctx, rceq.ctx, ent.OpQueryOnlyID)); err != nil {
+		return
+	}
+	switch len(ids) {
+	case 1:
+		id = ids[0]
+	case 0:
+		err = &NotFoundError{responsecacheentry.Label}
+	default:
+		err = &NotSingularError{responsecacheentry.Label}
+	}
+	return
+}
+
+// OnlyIDX is like OnlyID, but panics if an error occurs.
+func (rceq *ResponseCacheEntryQuery) OnlyIDX(ctx context.Context) int {
+	id, err := rceq.OnlyID(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return id
+}
+
+// All executes the query and returns a list of ResponseCacheEntries.
+func (rceq *ResponseCacheEntryQuery) All(ctx context.Context) ([]*ResponseCacheEntry, error) {
+	ctx = setContextOp(ctx, rceq.ctx, ent.OpQueryAll)
+	if err := rceq.prepareQuery(ctx); err != nil {
+		return nil, err
+	}
+	qr := querierAll[[]*ResponseCacheEntry, *ResponseCacheEntryQuery]()
+	return withInterceptors[[]*ResponseCacheEntry](ctx, rceq, qr, rceq.inters)
+}
+
+// AllX is like All, but panics if an error occurs.
+func (rceq *ResponseCacheEntryQuery) AllX(ctx context.Context) []*ResponseCacheEntry {
+	nodes, err := rceq.All(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return nodes
+}
+
+// IDs executes the query and returns a list of ResponseCacheEntry IDs.
+func (rceq *ResponseCacheEntryQuery) IDs(ctx context.Context) (ids []int, err error) {
+	if rceq.ctx.Unique == nil && rceq.path != nil {
+		rceq.Unique(true)
+	}
+	ctx = setContextOp(ctx, rceq.ctx, ent.OpQueryIDs)
+	if err = rceq.Select(responsecacheentry.FieldID).Scan(ctx, &ids); err != nil {
+		return nil, err
+	}
+	return ids, nil
+}
+
+// IDsX is like IDs, but panics if an error occurs.
+func (rceq *ResponseCacheEntryQuery) IDsX(ctx context.Context) []int {
+	ids, err := rceq.IDs(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return ids
+}
+
+// Count returns the count of the given query.
+func (rceq *ResponseCacheEntryQuery) Count(ctx context.Context) (int, error) {
+	ctx = setContextOp(ctx, rceq.ctx, ent.OpQueryCount)
+	if err := rceq.prepareQuery(ctx); err != nil {
+		return 0, err
+	}
+	return withInterceptors[int](ctx, rceq, querierCount[*ResponseCacheEntryQuery](), rceq.inters)
+}
+
+// CountX is like Count, but panics if an error occurs.
+func (rceq *ResponseCacheEntryQuery) CountX(ctx context.Context) int {
+	count, err := rceq.Count(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return count
+}
+
+// Exist returns true if the query has elements in the graph.
+func (rceq *ResponseCacheEntryQuery) Exist(ctx context.Context) (bool, error) {
+	ctx = setContextOp(ctx, rceq.ctx, ent.OpQueryExist)
+	switch _, err := rceq.FirstID(ctx); {
+	case IsNotFound(err):
+		return false, nil
+	case err != nil:
+		return false, fmt.Errorf("ent: check existence: %w", err)
+	default:
+		return true, nil
+	}
+}
+
+// ExistX is like Exist, but panics if an error occurs.
+func (rceq *ResponseCacheEntryQuery) ExistX(ctx context.Context) bool {
+	exist, err := rceq.Exist(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return exist
+}
+
+// Clone returns a duplicate of the ResponseCacheEntryQuery builder, including all associated steps. It can be
+// used to prepare common query builders and use them differently after the clone is made.
+func (rceq *ResponseCacheEntryQuery) Clone() *ResponseCacheEntryQuery {
+	if rceq == nil {
+		return nil
+	}
+	return &ResponseCacheEntryQuery{
+		config:     rceq.config,
+		ctx:        rceq.ctx.Clone(),
+		order:      append([]responsecacheentry.OrderOption{}, rceq.order...),
+		inters:     append([]Interceptor{}, rceq.inters...),
+		predicates: append([]predicate.ResponseCacheEntry{}, rceq.predicates...),
+		// clone intermediate query.
+		sql:  rceq.sql.Clone(),
+		path: rceq.path,
+	}
+}
+
+// GroupBy is used to group vertices by one or more fields/columns.
+// It is often used with aggregate functions, like: count, max, mean, min, sum.
+//
+// Example:
+//
+//	var v []struct {
+//		Key string `json:"key,omitempty"`
+//		Count int `json:"count,omitempty"`
+//	}
+//
+//	client.ResponseCacheEntry.Query().
+//		GroupBy(responsecacheentry.FieldKey).
+//		Aggregate(ent.Count()).
+//		Scan(ctx, &v)
+func (rceq *ResponseCacheEntryQuery) GroupBy(field string, fields ...string) *ResponseCacheEntryGroupBy {
+	rceq.ctx.Fields = append([]string{field}, fields...)
+	grbuild := &ResponseCacheEntryGroupBy{build: rceq}
+	grbuild.flds = &rceq.ctx.Fields
+	grbuild.label = responsecacheentry.Label
+	grbuild.scan = grbuild.Scan
+	return grbuild
+}
+
+// Select allows the selection one or more fields/columns for the given query,
+// instead of selecting all fields in the entity.
+//
+// Example:
+//
+//	var v []struct {
+//		Key string `json:"key,omitempty"`
+//	}
+//
+//	client.ResponseCacheEntry.Query().
+//		Select(responsecacheentry.FieldKey).
+//		Scan(ctx, &v)
+func (rceq *ResponseCacheEntryQuery) Select(fields ...string) *ResponseCacheEntrySelect {
+	rceq.ctx.Fields = append(rceq.ctx.Fields, fields...)
+	sbuild := &ResponseCacheEntrySelect{ResponseCacheEntryQuery: rceq}
+	sbuild.label = responsecacheentry.Label
+	sbuild.flds, sbuild.scan = &rceq.ctx.Fields, sbuild.Scan
+	return sbuild
+}
+
+// Aggregate returns a ResponseCacheEntrySelect configured with the given aggregations.
+func (rceq *ResponseCacheEntryQuery) Aggregate(fns ...AggregateFunc) *ResponseCacheEntrySelect {
+	return rceq.Select().Aggregate(fns...)
+}
+
+func (rceq *ResponseCacheEntryQuery) prepareQuery(ctx context.Context) error {
+	for _, inter := range rceq.inters {
+		if inter == nil {
+			return fmt.Errorf("ent: uninitialized interceptor (forgotten import ent/runtime?)")
+		}
+		if trv, ok := inter.(Traverser); ok {
+			if err := trv.Traverse(ctx, rceq); err != nil {
+				return err
+			}
+		}
+	}
+	for _, f := range rceq.ctx.Fields {
+		if !responsecacheentry.ValidColumn(f) {
+			return &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+		}
+	}
+	if rceq.path != nil {
+		prev, err := rceq.path(ctx)
+		if err != nil {
+			return err
+		}
+		rceq.sql = prev
+	}
+	return nil
+}
+
+func (rceq *ResponseCacheEntryQuery) sqlAll(ctx context.Context, hooks ...queryHook) ([]*ResponseCacheEntry, error) {
+	var (
+		nodes = []*ResponseCacheEntry{}
+		_spec = rceq.querySpec()
+	)
+	_spec.ScanValues = func(columns []string) ([]any, error) {
+		return (*ResponseCacheEntry).scanValues(nil, columns)
+	}
+	_spec.Assign = func(columns []string, values []any) error {
+		node := &ResponseCacheEntry{config: rceq.config}
+		nodes = append(nodes, node)
+		return node.assignValues(columns, values)
+	}
+	for i := range hooks {
+		hooks[i](ctx, _spec)
+	}
+	if err := sqlgraph.QueryNodes(ctx, rceq.driver, _spec); err != nil {
+		return nil, err
+	}
+	if len(nodes) == 0 {
+		return nodes, nil
+	}
+	return nodes, nil
+}
+
+func (rceq *ResponseCacheEntryQuery) sqlCount(ctx context.Context) (int, error) {
+	_spec := rceq.querySpec()
+	_spec.Node.Columns = rceq.ctx.Fields
+	if len(rceq.ctx.Fields) > 0 {
+		_spec.Unique = rceq.ctx.Unique != nil && *rceq.ctx.Unique
+	}
+	return sqlgraph.CountNodes(ctx, rceq.driver, _spec)
+}
+
+func (rceq *ResponseCacheEntryQuery) querySpec() *sqlgraph.QuerySpec {
+	_spec := sqlgraph.NewQuerySpec(responsecacheentry.Table, responsecacheentry.Columns, sqlgraph.NewFieldSpec(responsecacheentry.FieldID, field.TypeInt))
+	_spec.From = rceq.sql
+	if unique := rceq.ctx.Unique; unique != nil {
+		_spec.Unique = *unique
+	} else if rceq.path != nil {
+		_spec.Unique = true
+	}
+	if fields := rceq.ctx.Fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, responsecacheentry.FieldID)
+		for i := range fields {
+			if fields[i] != responsecacheentry.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, fields[i])
+			}
+		}
+	}
+	if ps := rceq.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if limit := rceq.ctx.Limit; limit != nil {
+		_spec.Limit = *limit
+	}
+	if offset := rceq.ctx.Offset; offset != nil {
+		_spec.Offset = *offset
+	}
+	if ps := rceq.order; len(ps) > 0 {
+		_spec.Order = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	return _spec
+}
+
+func (rceq *ResponseCacheEntryQuery) sqlQuery(ctx context.Context) *sql.Selector {
+	builder := sql.Dialect(rceq.driver.Dialect())
+	t1 := builder.Table(responsecacheentry.Table)
+	columns := rceq.ctx.Fields
+	if len(columns) == 0 {
+		columns = responsecacheentry.Columns
+	}
+	selector := builder.Select(t1.Columns(columns...)...).From(t1)
+	if rceq.sql != nil {
+		selector = rceq.sql
+		selector.Select(selector.Columns(columns...)...)
+	}
+	if rceq.ctx.Unique != nil && *rceq.ctx.Unique {
+		selector.Distinct()
+	}
+	for _, p := range rceq.predicates {
+		p(selector)
+	}
+	for _, p := range rceq.order {
+		p(selector)
+	}
+	if offset := rceq.ctx.Offset; offset != nil {
+		// limit is mandatory for offset clause. We start
+		// with default value, and override it below if needed.
+		selector.Offset(*offset).Limit(math.MaxInt32)
+	}
+	if limit := rceq.ctx.Limit; limit != nil {
+		selector.Limit(*limit)
+	}
+	return selector
+}
+
+// ResponseCacheEntryGroupBy is the group-by builder for ResponseCacheEntry entities.
+type ResponseCacheEntryGroupBy struct {
+	selector
+	build *ResponseCacheEntryQuery
+}
+
+// Aggregate adds the given aggregation functions to the group-by query.
+func (rcegb *ResponseCacheEntryGroupBy) Aggregate(fns ...AggregateFunc) *ResponseCacheEntryGroupBy {
+	rcegb.fns = append(rcegb.fns, fns...)
+	return rcegb
+}
+
+// Scan applies the selector query and scans the result into the given value.
+func (rcegb *ResponseCacheEntryGroupBy) Scan(ctx context.Context, v any) error {
+	ctx = setContextOp(ctx, rcegb.build.ctx, ent.OpQueryGroupBy)
+	if err := rcegb.build.prepareQuery(ctx); err != nil {
+		return err
+	}
+	return scanWithInterceptors[*ResponseCacheEntryQuery, *ResponseCacheEntryGroupBy](ctx, rcegb.build, rcegb, rcegb.build.inters, v)
+}
+
+func (rcegb *ResponseCacheEntryGroupBy) sqlScan(ctx context.Context, root *ResponseCacheEntryQuery, v any) error {
+	selector := root.sqlQuery(ctx).Select()
+	aggregation := make([]string, 0, len(rcegb.fns))
+	for _, fn := range rcegb.fns {
+		aggregation = append(aggregation, fn(selector))
+	}
+	if len(selector.SelectedColumns()) == 0 {
+		columns := make([]string, 0, len(*rcegb.flds)+len(rcegb.fns))
+		for _, f := range *rcegb.flds {
+			columns = append(columns, selector.C(f))
+		}
+		columns = append(columns, aggregation...)
+		selector.Select(columns...)
+	}
+	selector.GroupBy(selector.Columns(*rcegb.flds...)...)
+	if err := selector.Err(); err != nil {
+		return err
+	}
+	rows := &sql.Rows{}
+	query, args := selector.Query()
+	if err := rcegb.build.driver.Query(ctx, query, args, rows); err != nil {
+		return err
+	}
+	defer rows.Close()
+	return sql.ScanSlice(rows, v)
+}
+
+// ResponseCacheEntrySelect is the builder for selecting fields of ResponseCacheEntry entities.
+type ResponseCacheEntrySelect struct {
+	*ResponseCacheEntryQuery
+	selector
+}
+
+// Aggregate adds the given aggregation functions to the selector query.
+func (rces *ResponseCacheEntrySelect) Aggregate(fns ...AggregateFunc) *ResponseCacheEntrySelect {
+	rces.fns = append(rces.fns, fns...)
+	return rces
+}
+
+// Scan applies the selector query and scans the result into the given value.
+func (rces *ResponseCacheEntrySelect) Scan(ctx context.Context, v any) error {
+	ctx = setContextOp(ctx, rces.ctx, ent.OpQuerySelect)
+	if err := rces.prepareQuery(ctx); err != nil {
+		return err
+	}
+	return scanWithInterceptors[*ResponseCacheEntryQuery, *ResponseCacheEntrySelect](ctx, rces.ResponseCacheEntryQuery, rces, rces.inters, v)
+}
+
+func (rces *ResponseCacheEntrySelect) sqlScan(ctx context.Context, root *ResponseCacheEntryQuery, v any) error {
+	selector := root.sqlQuery(ctx)
+	aggregation := make([]string, 0, len(rces.fns))
+	for _, fn := range rces.fns {
+		aggregation = append(aggregation, fn(selector))
+	}
+	switch n := len(*rces.selector.flds); {
+	case n == 0 && len(aggregation) > 0:
+		selector.Select(aggregation...)
+	case n != 0 && len(aggregation) > 0:
+		selector.AppendSelect(aggregation...)
+	}
+	rows := &sql.Rows{}
+	query, args := selector.Query()
+	if err := rces.driver.Query(ctx, query, args, rows); err != nil {
+		return err
+	}
+	defer rows.Close()
+	return sql.ScanSlice(rows, v)
+}
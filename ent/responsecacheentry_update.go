@@ -0,0 +1,472 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"ramble-ai/ent/predicate"
+	"ramble-ai/ent/responsecacheentry"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+)
+
+// ResponseCacheEntryUpdate is the builder for updating ResponseCacheEntry entities.
+type ResponseCacheEntryUpdate struct {
+	config
+	hooks    []Hook
+	mutation *ResponseCacheEntryMutation
+}
+
+// Where appends a list predicates to the ResponseCacheEntryUpdate builder.
+func (rceu *ResponseCacheEntryUpdate) Where(ps ...predicate.ResponseCacheEntry) *ResponseCacheEntryUpdate {
+	rceu.mutation.Where(ps...)
+	return rceu
+}
+
+// SetKey sets the "key" field.
+func (rceu *ResponseCacheEntryUpdate) SetKey(s string) *ResponseCacheEntryUpdate {
+	rceu.mutation.SetKey(s)
+	return rceu
+}
+
+// SetNillableKey sets the "key" field if the given value is not nil.
+func (rceu *ResponseCacheEntryUpdate) SetNillableKey(s *string) *ResponseCacheEntryUpdate {
+	if s != nil {
+		rceu.SetKey(*s)
+	}
+	return rceu
+}
+
+// SetTaskType sets the "task_type" field.
+func (rceu *ResponseCacheEntryUpdate) SetTaskType(s string) *ResponseCacheEntryUpdate {
+	rceu.mutation.SetTaskType(s)
+	return rceu
+}
+
+// SetNillableTaskType sets the "task_type" field if the given value is not nil.
+func (rceu *ResponseCacheEntryUpdate) SetNillableTaskType(s *string) *ResponseCacheEntryUpdate {
+	if s != nil {
+		rceu.SetTaskType(*s)
+	}
+	return rceu
+}
+
+// ClearTaskType clears the value of the "task_type" field.
+func (rceu *ResponseCacheEntryUpdate) ClearTaskType() *ResponseCacheEntryUpdate {
+	rceu.mutation.ClearTaskType()
+	return rceu
+}
+
+// SetModel sets the "model" field.
+func (rceu *ResponseCacheEntryUpdate) SetModel(s string) *ResponseCacheEntryUpdate {
+	rceu.mutation.SetModel(s)
+	return rceu
+}
+
+// SetNillableModel sets the "model" field if the given value is not nil.
+func (rceu *ResponseCacheEntryUpdate) SetNillableModel(s *string) *ResponseCacheEntryUpdate {
+	if s != nil {
+		rceu.SetModel(*s)
+	}
+	return rceu
+}
+
+// ClearModel clears the value of the "model" field.
+func (rceu *ResponseCacheEntryUpdate) ClearModel() *ResponseCacheEntryUpdate {
+	rceu.mutation.ClearModel()
+	return rceu
+}
+
+// SetResponse sets the "response" field.
+func (rceu *ResponseCacheEntryUpdate) SetResponse(s string) *ResponseCacheEntryUpdate {
+	rceu.mutation.SetResponse(s)
+	return rceu
+}
+
+// SetNillableResponse sets the "response" field if the given value is not nil.
+func (rceu *ResponseCacheEntryUpdate) SetNillableResponse(s *string) *ResponseCacheEntryUpdate {
+	if s != nil {
+		rceu.SetResponse(*s)
+	}
+	return rceu
+}
+
+// SetHits sets the "hits" field.
+func (rceu *ResponseCacheEntryUpdate) SetHits(i int) *ResponseCacheEntryUpdate {
+	rceu.mutation.ResetHits()
+	rceu.mutation.SetHits(i)
+	return rceu
+}
+
+// SetNillableHits sets the "hits" field if the given value is not nil.
+func (rceu *ResponseCacheEntryUpdate) SetNillableHits(i *int) *ResponseCacheEntryUpdate {
+	if i != nil {
+		rceu.SetHits(*i)
+	}
+	return rceu
+}
+
+// AddHits adds i to the "hits" field.
+func (rceu *ResponseCacheEntryUpdate) AddHits(i int) *ResponseCacheEntryUpdate {
+	rceu.mutation.AddHits(i)
+	return rceu
+}
+
+// SetExpiresAt sets the "expires_at" field.
+func (rceu *ResponseCacheEntryUpdate) SetExpiresAt(t time.Time) *ResponseCacheEntryUpdate {
+	rceu.mutation.SetExpiresAt(t)
+	return rceu
+}
+
+// SetNillableExpiresAt sets the "expires_at" field if the given value is not nil.
+func (rceu *ResponseCacheEntryUpdate) SetNillableExpiresAt(t *time.Time) *ResponseCacheEntryUpdate {
+	if t != nil {
+		rceu.SetExpiresAt(*t)
+	}
+	return rceu
+}
+
+// Mutation returns the ResponseCacheEntryMutation object of the builder.
+func (rceu *ResponseCacheEntryUpdate) Mutation() *ResponseCacheEntryMutation {
+	return rceu.mutation
+}
+
+// Save executes the query and returns the number of nodes affected by the update operation.
+func (rceu *ResponseCacheEntryUpdate) Save(ctx context.Context) (int, error) {
+	return withHooks(ctx, rceu.sqlSave, rceu.mutation, rceu.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (rceu *ResponseCacheEntryUpdate) SaveX(ctx context.Context) int {
+	affected, err := rceu.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return affected
+}
+
+// Exec executes the query.
+func (rceu *ResponseCacheEntryUpdate) Exec(ctx context.Context) error {
+	_, err := rceu.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (rceu *ResponseCacheEntryUpdate) ExecX(ctx context.Context) {
+	if err := rceu.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (rceu *ResponseCacheEntryUpdate) check() error {
+	if v, ok := rceu.mutation.Key(); ok {
+		if err := responsecacheentry.KeyValidator(v); err != nil {
+			return &ValidationError{Name: "key", err: fmt.Errorf(`ent: validator failed for field "ResponseCacheEntry.key": %w`, err)}
+		}
+	}
+	if v, ok := rceu.mutation.Response(); ok {
+		if err := responsecacheentry.ResponseValidator(v); err != nil {
+			return &ValidationError{Name: "response", err: fmt.Errorf(`ent: validator failed for field "ResponseCacheEntry.response": %w`, err)}
+		}
+	}
+	return nil
+}
+
+func (rceu *ResponseCacheEntryUpdate) sqlSave(ctx context.Context) (n int, err error) {
+	if err := rceu.check(); err != nil {
+		return n, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(responsecacheentry.Table, responsecacheentry.Columns, sqlgraph.NewFieldSpec(responsecacheentry.FieldID, field.TypeInt))
+	if ps := rceu.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := rceu.mutation.Key(); ok {
+		_spec.SetField(responsecacheentry.FieldKey, field.TypeString, value)
+	}
+	if value, ok := rceu.mutation.TaskType(); ok {
+		_spec.SetField(responsecacheentry.FieldTaskType, field.TypeString, value)
+	}
+	if rceu.mutation.TaskTypeCleared() {
+		_spec.ClearField(responsecacheentry.FieldTaskType, field.TypeString)
+	}
+	if value, ok := rceu.mutation.Model(); ok {
+		_spec.SetField(responsecacheentry.FieldModel, field.TypeString, value)
+	}
+	if rceu.mutation.ModelCleared() {
+		_spec.ClearField(responsecacheentry.FieldModel, field.TypeString)
+	}
+	if value, ok := rceu.mutation.Response(); ok {
+		_spec.SetField(responsecacheentry.FieldResponse, field.TypeString, value)
+	}
+	if value, ok := rceu.mutation.Hits(); ok {
+		_spec.SetField(responsecacheentry.FieldHits, field.TypeInt, value)
+	}
+	if value, ok := rceu.mutation.AddedHits(); ok {
+		_spec.AddField(responsecacheentry.FieldHits, field.TypeInt, value)
+	}
+	if value, ok := rceu.mutation.ExpiresAt(); ok {
+		_spec.SetField(responsecacheentry.FieldExpiresAt, field.TypeTime, value)
+	}
+	if n, err = sqlgraph.UpdateNodes(ctx, rceu.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{responsecacheentry.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return 0, err
+	}
+	rceu.mutation.done = true
+	return n, nil
+}
+
+// ResponseCacheEntryUpdateOne is the builder for updating a single ResponseCacheEntry entity.
+type ResponseCacheEntryUpdateOne struct {
+	config
+	fields   []string
+	hooks    []Hook
+	mutation *ResponseCacheEntryMutation
+}
+
+// SetKey sets the "key" field.
+func (rceuo *ResponseCacheEntryUpdateOne) SetKey(s string) *ResponseCacheEntryUpdateOne {
+	rceuo.mutation.SetKey(s)
+	return rceuo
+}
+
+// SetNillableKey sets the "key" field if the given value is not nil.
+func (rceuo *ResponseCacheEntryUpdateOne) SetNillableKey(s *string) *ResponseCacheEntryUpdateOne {
+	if s != nil {
+		rceuo.SetKey(*s)
+	}
+	return rceuo
+}
+
+// SetTaskType sets the "task_type" field.
+func (rceuo *ResponseCacheEntryUpdateOne) SetTaskType(s string) *ResponseCacheEntryUpdateOne {
+	rceuo.mutation.SetTaskType(s)
+	return rceuo
+}
+
+// SetNillableTaskType sets the "task_type" field if the given value is not nil.
+func (rceuo *ResponseCacheEntryUpdateOne) SetNillableTaskType(s *string) *ResponseCacheEntryUpdateOne {
+	if s != nil {
+		rceuo.SetTaskType(*s)
+	}
+	return rceuo
+}
+
+// ClearTaskType clears the value of the "task_type" field.
+func (rceuo *ResponseCacheEntryUpdateOne) ClearTaskType() *ResponseCacheEntryUpdateOne {
+	rceuo.mutation.ClearTaskType()
+	return rceuo
+}
+
+// SetModel sets the "model" field.
+func (rceuo *ResponseCacheEntryUpdateOne) SetModel(s string) *ResponseCacheEntryUpdateOne {
+	rceuo.mutation.SetModel(s)
+	return rceuo
+}
+
+// SetNillableModel sets the "model" field if the given value is not nil.
+func (rceuo *ResponseCacheEntryUpdateOne) SetNillableModel(s *string) *ResponseCacheEntryUpdateOne {
+	if s != nil {
+		rceuo.SetModel(*s)
+	}
+	return rceuo
+}
+
+// ClearModel clears the value of the "model" field.
+func (rceuo *ResponseCacheEntryUpdateOne) ClearModel() *ResponseCacheEntryUpdateOne {
+	rceuo.mutation.ClearModel()
+	return rceuo
+}
+
+// SetResponse sets the "response" field.
+func (rceuo *ResponseCacheEntryUpdateOne) SetResponse(s string) *ResponseCacheEntryUpdateOne {
+	rceuo.mutation.SetResponse(s)
+	return rceuo
+}
+
+// SetNillableResponse sets the "response" field if the given value is not nil.
+func (rceuo *ResponseCacheEntryUpdateOne) SetNillableResponse(s *string) *ResponseCacheEntryUpdateOne {
+	if s != nil {
+		rceuo.SetResponse(*s)
+	}
+	return rceuo
+}
+
+// SetHits sets the "hits" field.
+func (rceuo *ResponseCacheEntryUpdateOne) SetHits(i int) *ResponseCacheEntryUpdateOne {
+	rceuo.mutation.ResetHits()
+	rceuo.mutation.SetHits(i)
+	return rceuo
+}
+
+// SetNillableHits sets the "hits" field if the given value is not nil.
+func (rceuo *ResponseCacheEntryUpdateOne) SetNillableHits(i *int) *ResponseCacheEntryUpdateOne {
+	if i != nil {
+		rceuo.SetHits(*i)
+	}
+	return rceuo
+}
+
+// AddHits adds i to the "hits" field.
+func (rceuo *ResponseCacheEntryUpdateOne) AddHits(i int) *ResponseCacheEntryUpdateOne {
+	rceuo.mutation.AddHits(i)
+	return rceuo
+}
+
+// SetExpiresAt sets the "expires_at" field.
+func (rceuo *ResponseCacheEntryUpdateOne) SetExpiresAt(t time.Time) *ResponseCacheEntryUpdateOne {
+	rceuo.mutation.SetExpiresAt(t)
+	return rceuo
+}
+
+// SetNillableExpiresAt sets the "expires_at" field if the given value is not nil.
+func (rceuo *ResponseCacheEntryUpdateOne) SetNillableExpiresAt(t *time.Time) *ResponseCacheEntryUpdateOne {
+	if t != nil {
+		rceuo.SetExpiresAt(*t)
+	}
+	return rceuo
+}
+
+// Mutation returns the ResponseCacheEntryMutation object of the builder.
+func (rceuo *ResponseCacheEntryUpdateOne) Mutation() *ResponseCacheEntryMutation {
+	return rceuo.mutation
+}
+
+// Where appends a list predicates to the ResponseCacheEntryUpdate builder.
+func (rceuo *ResponseCacheEntryUpdateOne) Where(ps ...predicate.ResponseCacheEntry) *ResponseCacheEntryUpdateOne {
+	rceuo.mutation.Where(ps...)
+	return rceuo
+}
+
+// Select allows selecting one or more fields (columns) of the returned entity.
+// The default is selecting all fields defined in the entity schema.
+func (rceuo *ResponseCacheEntryUpdateOne) Select(field string, fields ...string) *ResponseCacheEntryUpdateOne {
+	rceuo.fields = append([]string{field}, fields...)
+	return rceuo
+}
+
+// Save executes the query and returns the updated ResponseCacheEntry entity.
+func (rceuo *ResponseCacheEntryUpdateOne) Save(ctx context.Context) (*ResponseCacheEntry, error) {
+	return withHooks(ctx, rceuo.sqlSave, rceuo.mutation, rceuo.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (rceuo *ResponseCacheEntryUpdateOne) SaveX(ctx context.Context) *ResponseCacheEntry {
+	node, err := rceuo.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// Exec executes the query on the entity.
+func (rceuo *ResponseCacheEntryUpdateOne) Exec(ctx context.Context) error {
+	_, err := rceuo.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (rceuo *ResponseCacheEntryUpdateOne) ExecX(ctx context.Context) {
+	if err := rceuo.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (rceuo *ResponseCacheEntryUpdateOne) check() error {
+	if v, ok := rceuo.mutation.Key(); ok {
+		if err := responsecacheentry.KeyValidator(v); err != nil {
+			return &ValidationError{Name: "key", err: fmt.Errorf(`ent: validator failed for field "ResponseCacheEntry.key": %w`, err)}
+		}
+	}
+	if v, ok := rceuo.mutation.Response(); ok {
+		if err := responsecacheentry.ResponseValidator(v); err != nil {
+			return &ValidationError{Name: "response", err: fmt.Errorf(`ent: validator failed for field "ResponseCacheEntry.response": %w`, err)}
+		}
+	}
+	return nil
+}
+
+func (rceuo *ResponseCacheEntryUpdateOne) sqlSave(ctx context.Context) (_node *ResponseCacheEntry, err error) {
+	if err := rceuo.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(responsecacheentry.Table, responsecacheentry.Columns, sqlgraph.NewFieldSpec(responsecacheentry.FieldID, field.TypeInt))
+	id, ok := rceuo.mutation.ID()
+	if !ok {
+		return nil, &ValidationError{Name: "id", err: errors.New(`ent: missing "ResponseCacheEntry.id" for update`)}
+	}
+	_spec.Node.ID.Value = id
+	if fields := rceuo.fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, responsecacheentry.FieldID)
+		for _, f := range fields {
+			if !responsecacheentry.ValidColumn(f) {
+				return nil, &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+			}
+			if f != responsecacheentry.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, f)
+			}
+		}
+	}
+	if ps := rceuo.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := rceuo.mutation.Key(); ok {
+		_spec.SetField(responsecacheentry.FieldKey, field.TypeString, value)
+	}
+	if value, ok := rceuo.mutation.TaskType(); ok {
+		_spec.SetField(responsecacheentry.FieldTaskType, field.TypeString, value)
+	}
+	if rceuo.mutation.TaskTypeCleared() {
+		_spec.ClearField(responsecacheentry.FieldTaskType, field.TypeString)
+	}
+	if value, ok := rceuo.mutation.Model(); ok {
+		_spec.SetField(responsecacheentry.FieldModel, field.TypeString, value)
+	}
+	if rceuo.mutation.ModelCleared() {
+		_spec.ClearField(responsecacheentry.FieldModel, field.TypeString)
+	}
+	if value, ok := rceuo.mutation.Response(); ok {
+		_spec.SetField(responsecacheentry.FieldResponse, field.TypeString, value)
+	}
+	if value, ok := rceuo.mutation.Hits(); ok {
+		_spec.SetField(responsecacheentry.FieldHits, field.TypeInt, value)
+	}
+	if value, ok := rceuo.mutation.AddedHits(); ok {
+		_spec.AddField(responsecacheentry.FieldHits, field.TypeInt, value)
+	}
+	if value, ok := rceuo.mutation.ExpiresAt(); ok {
+		_spec.SetField(responsecacheentry.FieldExpiresAt, field.TypeTime, value)
+	}
+	_node = &ResponseCacheEntry{config: rceuo.config}
+	_spec.Assign = _node.assignValues
+	_spec.ScanValues = _node.scanValues
+	if err = sqlgraph.UpdateNode(ctx, rceuo.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{responsecacheentry.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	rceuo.mutation.done = true
+	return _node, nil
+}
@@ -5,10 +5,18 @@ package ent
 import (
 	"ramble-ai/ent/chatmessage"
 	"ramble-ai/ent/chatsession"
+	"ramble-ai/ent/collection"
+	"ramble-ai/ent/cut"
 	"ramble-ai/ent/exportjob"
 	"ramble-ai/ent/project"
+	"ramble-ai/ent/projecttemplate"
+	"ramble-ai/ent/prompt"
+	"ramble-ai/ent/responsecacheentry"
 	"ramble-ai/ent/schema"
 	"ramble-ai/ent/settings"
+	"ramble-ai/ent/tag"
+	"ramble-ai/ent/transcriptembedding"
+	"ramble-ai/ent/usagerecord"
 	"ramble-ai/ent/videoclip"
 	"time"
 )
@@ -51,6 +59,46 @@ func init() {
 	chatsession.DefaultUpdatedAt = chatsessionDescUpdatedAt.Default.(func() time.Time)
 	// chatsession.UpdateDefaultUpdatedAt holds the default value on update for the updated_at field.
 	chatsession.UpdateDefaultUpdatedAt = chatsessionDescUpdatedAt.UpdateDefault.(func() time.Time)
+	// chatsessionDescArchived is the schema descriptor for archived field.
+	chatsessionDescArchived := chatsessionFields[7].Descriptor()
+	// chatsession.DefaultArchived holds the default value on creation for the archived field.
+	chatsession.DefaultArchived = chatsessionDescArchived.Default.(bool)
+	collectionFields := schema.Collection{}.Fields()
+	_ = collectionFields
+	// collectionDescName is the schema descriptor for name field.
+	collectionDescName := collectionFields[0].Descriptor()
+	// collection.NameValidator is a validator for the "name" field. It is called by the builders before save.
+	collection.NameValidator = collectionDescName.Validators[0].(func(string) error)
+	// collectionDescCreatedAt is the schema descriptor for created_at field.
+	collectionDescCreatedAt := collectionFields[2].Descriptor()
+	// collection.DefaultCreatedAt holds the default value on creation for the created_at field.
+	collection.DefaultCreatedAt = collectionDescCreatedAt.Default.(func() time.Time)
+	// collectionDescUpdatedAt is the schema descriptor for updated_at field.
+	collectionDescUpdatedAt := collectionFields[3].Descriptor()
+	// collection.DefaultUpdatedAt holds the default value on creation for the updated_at field.
+	collection.DefaultUpdatedAt = collectionDescUpdatedAt.Default.(func() time.Time)
+	// collection.UpdateDefaultUpdatedAt holds the default value on update for the updated_at field.
+	collection.UpdateDefaultUpdatedAt = collectionDescUpdatedAt.UpdateDefault.(func() time.Time)
+	cutFields := schema.Cut{}.Fields()
+	_ = cutFields
+	// cutDescName is the schema descriptor for name field.
+	cutDescName := cutFields[0].Descriptor()
+	// cut.NameValidator is a validator for the "name" field. It is called by the builders before save.
+	cut.NameValidator = cutDescName.Validators[0].(func(string) error)
+	// cutDescOrderHistoryIndex is the schema descriptor for order_history_index field.
+	cutDescOrderHistoryIndex := cutFields[4].Descriptor()
+	// cut.DefaultOrderHistoryIndex holds the default value on creation for the order_history_index field.
+	cut.DefaultOrderHistoryIndex = cutDescOrderHistoryIndex.Default.(int)
+	// cutDescCreatedAt is the schema descriptor for created_at field.
+	cutDescCreatedAt := cutFields[6].Descriptor()
+	// cut.DefaultCreatedAt holds the default value on creation for the created_at field.
+	cut.DefaultCreatedAt = cutDescCreatedAt.Default.(func() time.Time)
+	// cutDescUpdatedAt is the schema descriptor for updated_at field.
+	cutDescUpdatedAt := cutFields[7].Descriptor()
+	// cut.DefaultUpdatedAt holds the default value on creation for the updated_at field.
+	cut.DefaultUpdatedAt = cutDescUpdatedAt.Default.(func() time.Time)
+	// cut.UpdateDefaultUpdatedAt holds the default value on update for the updated_at field.
+	cut.UpdateDefaultUpdatedAt = cutDescUpdatedAt.UpdateDefault.(func() time.Time)
 	exportjobFields := schema.ExportJob{}.Fields()
 	_ = exportjobFields
 	// exportjobDescJobID is the schema descriptor for job_id field.
@@ -128,17 +176,73 @@ func init() {
 	// project.DefaultAiModel holds the default value on creation for the ai_model field.
 	project.DefaultAiModel = projectDescAiModel.Default.(string)
 	// projectDescAiHighlightModel is the schema descriptor for ai_highlight_model field.
-	projectDescAiHighlightModel := projectFields[10].Descriptor()
+	projectDescAiHighlightModel := projectFields[11].Descriptor()
 	// project.DefaultAiHighlightModel holds the default value on creation for the ai_highlight_model field.
 	project.DefaultAiHighlightModel = projectDescAiHighlightModel.Default.(string)
 	// projectDescActiveTab is the schema descriptor for active_tab field.
-	projectDescActiveTab := projectFields[12].Descriptor()
+	projectDescActiveTab := projectFields[13].Descriptor()
 	// project.DefaultActiveTab holds the default value on creation for the active_tab field.
 	project.DefaultActiveTab = projectDescActiveTab.Default.(string)
 	// projectDescOrderHistoryIndex is the schema descriptor for order_history_index field.
-	projectDescOrderHistoryIndex := projectFields[18].Descriptor()
+	projectDescOrderHistoryIndex := projectFields[19].Descriptor()
 	// project.DefaultOrderHistoryIndex holds the default value on creation for the order_history_index field.
 	project.DefaultOrderHistoryIndex = projectDescOrderHistoryIndex.Default.(int)
+	projecttemplateFields := schema.ProjectTemplate{}.Fields()
+	_ = projecttemplateFields
+	// projecttemplateDescName is the schema descriptor for name field.
+	projecttemplateDescName := projecttemplateFields[0].Descriptor()
+	// projecttemplate.NameValidator is a validator for the "name" field. It is called by the builders before save.
+	projecttemplate.NameValidator = projecttemplateDescName.Validators[0].(func(string) error)
+	// projecttemplateDescCreatedAt is the schema descriptor for created_at field.
+	projecttemplateDescCreatedAt := projecttemplateFields[8].Descriptor()
+	// projecttemplate.DefaultCreatedAt holds the default value on creation for the created_at field.
+	projecttemplate.DefaultCreatedAt = projecttemplateDescCreatedAt.Default.(func() time.Time)
+	// projecttemplateDescUpdatedAt is the schema descriptor for updated_at field.
+	projecttemplateDescUpdatedAt := projecttemplateFields[9].Descriptor()
+	// projecttemplate.DefaultUpdatedAt holds the default value on creation for the updated_at field.
+	projecttemplate.DefaultUpdatedAt = projecttemplateDescUpdatedAt.Default.(func() time.Time)
+	// projecttemplate.UpdateDefaultUpdatedAt holds the default value on update for the updated_at field.
+	projecttemplate.UpdateDefaultUpdatedAt = projecttemplateDescUpdatedAt.UpdateDefault.(func() time.Time)
+	promptFields := schema.Prompt{}.Fields()
+	_ = promptFields
+	// promptDescName is the schema descriptor for name field.
+	promptDescName := promptFields[0].Descriptor()
+	// prompt.NameValidator is a validator for the "name" field. It is called by the builders before save.
+	prompt.NameValidator = promptDescName.Validators[0].(func(string) error)
+	// promptDescVersion is the schema descriptor for version field.
+	promptDescVersion := promptFields[1].Descriptor()
+	// prompt.VersionValidator is a validator for the "version" field. It is called by the builders before save.
+	prompt.VersionValidator = promptDescVersion.Validators[0].(func(int) error)
+	// promptDescTaskType is the schema descriptor for task_type field.
+	promptDescTaskType := promptFields[2].Descriptor()
+	// prompt.TaskTypeValidator is a validator for the "task_type" field. It is called by the builders before save.
+	prompt.TaskTypeValidator = promptDescTaskType.Validators[0].(func(string) error)
+	// promptDescBody is the schema descriptor for body field.
+	promptDescBody := promptFields[3].Descriptor()
+	// prompt.BodyValidator is a validator for the "body" field. It is called by the builders before save.
+	prompt.BodyValidator = promptDescBody.Validators[0].(func(string) error)
+	// promptDescCreatedAt is the schema descriptor for created_at field.
+	promptDescCreatedAt := promptFields[7].Descriptor()
+	// prompt.DefaultCreatedAt holds the default value on creation for the created_at field.
+	prompt.DefaultCreatedAt = promptDescCreatedAt.Default.(func() time.Time)
+	responsecacheentryFields := schema.ResponseCacheEntry{}.Fields()
+	_ = responsecacheentryFields
+	// responsecacheentryDescKey is the schema descriptor for key field.
+	responsecacheentryDescKey := responsecacheentryFields[0].Descriptor()
+	// responsecacheentry.KeyValidator is a validator for the "key" field. It is called by the builders before save.
+	responsecacheentry.KeyValidator = responsecacheentryDescKey.Validators[0].(func(string) error)
+	// responsecacheentryDescResponse is the schema descriptor for response field.
+	responsecacheentryDescResponse := responsecacheentryFields[3].Descriptor()
+	// responsecacheentry.ResponseValidator is a validator for the "response" field. It is called by the builders before save.
+	responsecacheentry.ResponseValidator = responsecacheentryDescResponse.Validators[0].(func(string) error)
+	// responsecacheentryDescHits is the schema descriptor for hits field.
+	responsecacheentryDescHits := responsecacheentryFields[4].Descriptor()
+	// responsecacheentry.DefaultHits holds the default value on creation for the hits field.
+	responsecacheentry.DefaultHits = responsecacheentryDescHits.Default.(int)
+	// responsecacheentryDescCreatedAt is the schema descriptor for created_at field.
+	responsecacheentryDescCreatedAt := responsecacheentryFields[5].Descriptor()
+	// responsecacheentry.DefaultCreatedAt holds the default value on creation for the created_at field.
+	responsecacheentry.DefaultCreatedAt = responsecacheentryDescCreatedAt.Default.(func() time.Time)
 	settingsFields := schema.Settings{}.Fields()
 	_ = settingsFields
 	// settingsDescKey is the schema descriptor for key field.
@@ -155,6 +259,80 @@ func init() {
 	settings.DefaultUpdatedAt = settingsDescUpdatedAt.Default.(func() time.Time)
 	// settings.UpdateDefaultUpdatedAt holds the default value on update for the updated_at field.
 	settings.UpdateDefaultUpdatedAt = settingsDescUpdatedAt.UpdateDefault.(func() time.Time)
+	tagFields := schema.Tag{}.Fields()
+	_ = tagFields
+	// tagDescName is the schema descriptor for name field.
+	tagDescName := tagFields[0].Descriptor()
+	// tag.NameValidator is a validator for the "name" field. It is called by the builders before save.
+	tag.NameValidator = tagDescName.Validators[0].(func(string) error)
+	// tagDescCreatedAt is the schema descriptor for created_at field.
+	tagDescCreatedAt := tagFields[2].Descriptor()
+	// tag.DefaultCreatedAt holds the default value on creation for the created_at field.
+	tag.DefaultCreatedAt = tagDescCreatedAt.Default.(func() time.Time)
+	transcriptembeddingFields := schema.TranscriptEmbedding{}.Fields()
+	_ = transcriptembeddingFields
+	// transcriptembeddingDescChunkIndex is the schema descriptor for chunk_index field.
+	transcriptembeddingDescChunkIndex := transcriptembeddingFields[1].Descriptor()
+	// transcriptembedding.ChunkIndexValidator is a validator for the "chunk_index" field. It is called by the builders before save.
+	transcriptembedding.ChunkIndexValidator = transcriptembeddingDescChunkIndex.Validators[0].(func(int) error)
+	// transcriptembeddingDescStartWord is the schema descriptor for start_word field.
+	transcriptembeddingDescStartWord := transcriptembeddingFields[2].Descriptor()
+	// transcriptembedding.StartWordValidator is a validator for the "start_word" field. It is called by the builders before save.
+	transcriptembedding.StartWordValidator = transcriptembeddingDescStartWord.Validators[0].(func(int) error)
+	// transcriptembeddingDescEndWord is the schema descriptor for end_word field.
+	transcriptembeddingDescEndWord := transcriptembeddingFields[3].Descriptor()
+	// transcriptembedding.EndWordValidator is a validator for the "end_word" field. It is called by the builders before save.
+	transcriptembedding.EndWordValidator = transcriptembeddingDescEndWord.Validators[0].(func(int) error)
+	// transcriptembeddingDescModel is the schema descriptor for model field.
+	transcriptembeddingDescModel := transcriptembeddingFields[7].Descriptor()
+	// transcriptembedding.ModelValidator is a validator for the "model" field. It is called by the builders before save.
+	transcriptembedding.ModelValidator = transcriptembeddingDescModel.Validators[0].(func(string) error)
+	// transcriptembeddingDescSourceHash is the schema descriptor for source_hash field.
+	transcriptembeddingDescSourceHash := transcriptembeddingFields[8].Descriptor()
+	// transcriptembedding.SourceHashValidator is a validator for the "source_hash" field. It is called by the builders before save.
+	transcriptembedding.SourceHashValidator = transcriptembeddingDescSourceHash.Validators[0].(func(string) error)
+	// transcriptembeddingDescCreatedAt is the schema descriptor for created_at field.
+	transcriptembeddingDescCreatedAt := transcriptembeddingFields[10].Descriptor()
+	// transcriptembedding.DefaultCreatedAt holds the default value on creation for the created_at field.
+	transcriptembedding.DefaultCreatedAt = transcriptembeddingDescCreatedAt.Default.(func() time.Time)
+	usagerecordFields := schema.UsageRecord{}.Fields()
+	_ = usagerecordFields
+	// usagerecordDescProvider is the schema descriptor for provider field.
+	usagerecordDescProvider := usagerecordFields[1].Descriptor()
+	// usagerecord.ProviderValidator is a validator for the "provider" field. It is called by the builders before save.
+	usagerecord.ProviderValidator = usagerecordDescProvider.Validators[0].(func(string) error)
+	// usagerecordDescTaskType is the schema descriptor for task_type field.
+	usagerecordDescTaskType := usagerecordFields[2].Descriptor()
+	// usagerecord.TaskTypeValidator is a validator for the "task_type" field. It is called by the builders before save.
+	usagerecord.TaskTypeValidator = usagerecordDescTaskType.Validators[0].(func(string) error)
+	// usagerecordDescPromptTokens is the schema descriptor for prompt_tokens field.
+	usagerecordDescPromptTokens := usagerecordFields[4].Descriptor()
+	// usagerecord.DefaultPromptTokens holds the default value on creation for the prompt_tokens field.
+	usagerecord.DefaultPromptTokens = usagerecordDescPromptTokens.Default.(int)
+	// usagerecordDescCompletionTokens is the schema descriptor for completion_tokens field.
+	usagerecordDescCompletionTokens := usagerecordFields[5].Descriptor()
+	// usagerecord.DefaultCompletionTokens holds the default value on creation for the completion_tokens field.
+	usagerecord.DefaultCompletionTokens = usagerecordDescCompletionTokens.Default.(int)
+	// usagerecordDescTotalTokens is the schema descriptor for total_tokens field.
+	usagerecordDescTotalTokens := usagerecordFields[6].Descriptor()
+	// usagerecord.DefaultTotalTokens holds the default value on creation for the total_tokens field.
+	usagerecord.DefaultTotalTokens = usagerecordDescTotalTokens.Default.(int)
+	// usagerecordDescAudioSeconds is the schema descriptor for audio_seconds field.
+	usagerecordDescAudioSeconds := usagerecordFields[7].Descriptor()
+	// usagerecord.DefaultAudioSeconds holds the default value on creation for the audio_seconds field.
+	usagerecord.DefaultAudioSeconds = usagerecordDescAudioSeconds.Default.(float64)
+	// usagerecordDescCostUsd is the schema descriptor for cost_usd field.
+	usagerecordDescCostUsd := usagerecordFields[8].Descriptor()
+	// usagerecord.DefaultCostUsd holds the default value on creation for the cost_usd field.
+	usagerecord.DefaultCostUsd = usagerecordDescCostUsd.Default.(float64)
+	// usagerecordDescCostEstimated is the schema descriptor for cost_estimated field.
+	usagerecordDescCostEstimated := usagerecordFields[9].Descriptor()
+	// usagerecord.DefaultCostEstimated holds the default value on creation for the cost_estimated field.
+	usagerecord.DefaultCostEstimated = usagerecordDescCostEstimated.Default.(bool)
+	// usagerecordDescCreatedAt is the schema descriptor for created_at field.
+	usagerecordDescCreatedAt := usagerecordFields[10].Descriptor()
+	// usagerecord.DefaultCreatedAt holds the default value on creation for the created_at field.
+	usagerecord.DefaultCreatedAt = usagerecordDescCreatedAt.Default.(func() time.Time)
 	videoclipFields := schema.VideoClip{}.Fields()
 	_ = videoclipFields
 	// videoclipDescName is the schema descriptor for name field.
@@ -166,21 +344,21 @@ func init() {
 	// videoclip.FilePathValidator is a validator for the "file_path" field. It is called by the builders before save.
 	videoclip.FilePathValidator = videoclipDescFilePath.Validators[0].(func(string) error)
 	// videoclipDescCreatedAt is the schema descriptor for created_at field.
-	videoclipDescCreatedAt := videoclipFields[14].Descriptor()
+	videoclipDescCreatedAt := videoclipFields[15].Descriptor()
 	// videoclip.DefaultCreatedAt holds the default value on creation for the created_at field.
 	videoclip.DefaultCreatedAt = videoclipDescCreatedAt.Default.(func() time.Time)
 	// videoclipDescUpdatedAt is the schema descriptor for updated_at field.
-	videoclipDescUpdatedAt := videoclipFields[15].Descriptor()
+	videoclipDescUpdatedAt := videoclipFields[16].Descriptor()
 	// videoclip.DefaultUpdatedAt holds the default value on creation for the updated_at field.
 	videoclip.DefaultUpdatedAt = videoclipDescUpdatedAt.Default.(func() time.Time)
 	// videoclip.UpdateDefaultUpdatedAt holds the default value on update for the updated_at field.
 	videoclip.UpdateDefaultUpdatedAt = videoclipDescUpdatedAt.UpdateDefault.(func() time.Time)
 	// videoclipDescHighlightsHistoryIndex is the schema descriptor for highlights_history_index field.
-	videoclipDescHighlightsHistoryIndex := videoclipFields[17].Descriptor()
+	videoclipDescHighlightsHistoryIndex := videoclipFields[18].Descriptor()
 	// videoclip.DefaultHighlightsHistoryIndex holds the default value on creation for the highlights_history_index field.
 	videoclip.DefaultHighlightsHistoryIndex = videoclipDescHighlightsHistoryIndex.Default.(int)
 	// videoclipDescTranscriptionState is the schema descriptor for transcription_state field.
-	videoclipDescTranscriptionState := videoclipFields[18].Descriptor()
+	videoclipDescTranscriptionState := videoclipFields[19].Descriptor()
 	// videoclip.DefaultTranscriptionState holds the default value on creation for the transcription_state field.
 	videoclip.DefaultTranscriptionState = videoclipDescTranscriptionState.Default.(string)
 }
@@ -9,6 +9,15 @@ import (
 	"entgo.io/ent/schema/index"
 )
 
+// ChatFunctionResult records a function called for an assistant message and its outcome
+type ChatFunctionResult struct {
+	FunctionName string      `json:"functionName"`
+	Success      bool        `json:"success"`
+	Result       interface{} `json:"result,omitempty"`
+	Error        string      `json:"error,omitempty"`
+	Message      string      `json:"message,omitempty"`
+}
+
 // ChatMessage holds the schema definition for the ChatMessage entity.
 type ChatMessage struct {
 	ent.Schema
@@ -37,6 +46,12 @@ func (ChatMessage) Fields() []ent.Field {
 		field.String("model").
 			Optional().
 			Comment("LLM model used for assistant messages"),
+		field.JSON("function_results", []ChatFunctionResult{}).
+			Optional().
+			Comment("Functions called for an assistant message and their results"),
+		field.JSON("order_snapshot", []interface{}{}).
+			Optional().
+			Comment("Project highlight order after an assistant message's actions"),
 	}
 }
 
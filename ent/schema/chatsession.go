@@ -35,6 +35,12 @@ func (ChatSession) Fields() []ent.Field {
 		field.String("selected_model").
 			Optional().
 			Comment("The AI model selected for this chat session"),
+		field.String("title").
+			Optional().
+			Comment("User-facing session title"),
+		field.Bool("archived").
+			Default(false).
+			Comment("Whether the session is hidden from the session list"),
 	}
 }
 
@@ -54,7 +60,7 @@ func (ChatSession) Edges() []ent.Edge {
 func (ChatSession) Indexes() []ent.Index {
 	return []ent.Index{
 		index.Fields("session_id").Unique(),
-		index.Fields("project_id", "endpoint_id").Unique(),
+		index.Fields("project_id", "endpoint_id"),
 		index.Fields("project_id"),
 		index.Fields("endpoint_id"),
 	}
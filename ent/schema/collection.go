@@ -0,0 +1,42 @@
+package schema
+
+import (
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/schema/edge"
+	"entgo.io/ent/schema/field"
+)
+
+// Collection holds the schema definition for the Collection entity.
+type Collection struct {
+	ent.Schema
+}
+
+// Fields of the Collection.
+func (Collection) Fields() []ent.Field {
+	return []ent.Field{
+		field.String("name").
+			NotEmpty().
+			Unique().
+			Comment("Collection name"),
+		field.Text("description").
+			Optional().
+			Comment("Collection description"),
+		field.Time("created_at").
+			Default(time.Now).
+			Comment("Creation timestamp"),
+		field.Time("updated_at").
+			Default(time.Now).
+			UpdateDefault(time.Now).
+			Comment("Last update timestamp"),
+	}
+}
+
+// Edges of the Collection.
+func (Collection) Edges() []ent.Edge {
+	return []ent.Edge{
+		edge.To("projects", Project.Type).
+			Comment("Projects in this collection"),
+	}
+}
@@ -0,0 +1,66 @@
+package schema
+
+import (
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/schema/edge"
+	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/index"
+)
+
+// Cut holds the schema definition for the Cut entity.
+// A cut is a named variant of a project's edit. The active cut's state is mirrored on the
+// project's highlight_order, order_history and hidden_highlights fields while it is being edited.
+type Cut struct {
+	ent.Schema
+}
+
+// Fields of the Cut.
+func (Cut) Fields() []ent.Field {
+	return []ent.Field{
+		field.String("name").
+			NotEmpty().
+			Comment("Cut name (e.g. 'YouTube', '3-minute recap')"),
+		field.Int("project_id").
+			Comment("ID of the project this cut belongs to"),
+		field.JSON("highlight_order", []interface{}{}).
+			Optional().
+			Comment("Highlight order of this cut (array of highlight IDs, 'N' for newlines, or newline objects with titles)"),
+		field.JSON("order_history", [][]interface{}{}).
+			Optional().
+			Comment("FIFO history of this cut's highlight orders"),
+		field.Int("order_history_index").
+			Optional().
+			Default(-1).
+			Comment("Current position in order history (-1 = no history)"),
+		field.JSON("hidden_highlights", []string{}).
+			Optional().
+			Comment("Highlight IDs left out of this cut"),
+		field.Time("created_at").
+			Default(time.Now).
+			Comment("Creation timestamp"),
+		field.Time("updated_at").
+			Default(time.Now).
+			UpdateDefault(time.Now).
+			Comment("Last update timestamp"),
+	}
+}
+
+// Edges of the Cut.
+func (Cut) Edges() []ent.Edge {
+	return []ent.Edge{
+		edge.From("project", Project.Type).
+			Ref("cuts").
+			Field("project_id").
+			Required().
+			Unique(),
+	}
+}
+
+// Indexes of the Cut.
+func (Cut) Indexes() []ent.Index {
+	return []ent.Index{
+		index.Fields("project_id", "name").Unique(),
+	}
+}
@@ -60,6 +60,9 @@ func (ExportJob) Fields() []ent.Field {
 			Default(time.Now).
 			UpdateDefault(time.Now).
 			Comment("Last update timestamp"),
+		field.Int("cut_id").
+			Optional().
+			Comment("Cut being exported (0 = all project highlights)"),
 		field.Time("completed_at").
 			Optional().
 			Comment("Job completion timestamp"),
@@ -6,6 +6,7 @@ import (
 	"entgo.io/ent"
 	"entgo.io/ent/schema/edge"
 	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/index"
 )
 
 // Project holds the schema definition for the Project entity.
@@ -45,6 +46,9 @@ func (Project) Fields() []ent.Field {
 		field.String("ai_suggestion_model").
 			Optional().
 			Comment("AI model used for the cached suggestion"),
+		field.Int("ai_suggestion_prompt_id").
+			Optional().
+			Comment("Library prompt version used for the cached suggestion (0 = none)"),
 		field.Time("ai_suggestion_created_at").
 			Optional().
 			Comment("When the AI suggestion was created"),
@@ -81,6 +85,15 @@ func (Project) Fields() []ent.Field {
 		field.JSON("hidden_highlights", []string{}).
 			Optional().
 			Comment("Array of hidden highlight IDs that should not appear in the final video"),
+		field.JSON("export_presets", []ExportPreset{}).
+			Optional().
+			Comment("Saved export presets for this project"),
+		field.Int("active_cut_id").
+			Optional().
+			Comment("Cut whose state is mirrored in highlight_order, order_history and hidden_highlights (0 = no cuts yet)"),
+		field.Time("deleted_at").
+			Optional().
+			Comment("When the project was moved to the trash (unset while active)"),
 	}
 }
 
@@ -93,5 +106,24 @@ func (Project) Edges() []ent.Edge {
 			Comment("Export jobs for this project"),
 		edge.To("chat_sessions", ChatSession.Type).
 			Comment("Chat sessions for this project"),
+		edge.To("cuts", Cut.Type).
+			Comment("Named cuts of this project"),
+		edge.To("prompts", Prompt.Type).
+			Comment("Library prompts owned by this project"),
+		edge.From("tags", Tag.Type).
+			Ref("projects").
+			Comment("User-defined tags on this project"),
+		edge.From("collections", Collection.Type).
+			Ref("projects").
+			Comment("Collections containing this project"),
+	}
+}
+
+// Indexes of the Project.
+func (Project) Indexes() []ent.Index {
+	return []ent.Index{
+		index.Fields("created_at"),
+		index.Fields("deleted_at"),
+		index.Fields("updated_at"),
 	}
 }
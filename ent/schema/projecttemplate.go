@@ -0,0 +1,63 @@
+package schema
+
+import (
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/schema/field"
+)
+
+// ExportPreset represents a named set of export options
+type ExportPreset struct {
+	Name           string  `json:"name"`
+	Mode           string  `json:"mode"` // "stitched" or "individual"
+	PaddingSeconds float64 `json:"paddingSeconds"`
+}
+
+// ProjectTemplate holds the schema definition for the ProjectTemplate entity.
+type ProjectTemplate struct {
+	ent.Schema
+}
+
+// Fields of the ProjectTemplate.
+func (ProjectTemplate) Fields() []ent.Field {
+	return []ent.Field{
+		field.String("name").
+			NotEmpty().
+			Unique().
+			Comment("Template name"),
+		field.Text("description").
+			Optional().
+			Comment("Template description"),
+		field.String("ai_model").
+			Optional().
+			Comment("OpenRouter AI model for segment reordering and silence improvements"),
+		field.Text("ai_prompt").
+			Optional().
+			Comment("Custom AI prompt for segment reordering"),
+		field.String("highlight_ai_model").
+			Optional().
+			Comment("OpenRouter AI model for highlight suggestions"),
+		field.Text("highlight_ai_prompt").
+			Optional().
+			Comment("Custom AI prompt for highlight suggestions"),
+		field.JSON("export_presets", []ExportPreset{}).
+			Optional().
+			Comment("Export presets copied to new projects"),
+		field.JSON("section_titles", []string{}).
+			Optional().
+			Comment("Skeleton section titles, in order, used to seed the highlight order"),
+		field.Time("created_at").
+			Default(time.Now).
+			Comment("Creation timestamp"),
+		field.Time("updated_at").
+			Default(time.Now).
+			UpdateDefault(time.Now).
+			Comment("Last update timestamp"),
+	}
+}
+
+// Edges of the ProjectTemplate.
+func (ProjectTemplate) Edges() []ent.Edge {
+	return nil
+}
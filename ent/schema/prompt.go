@@ -0,0 +1,77 @@
+package schema
+
+import (
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/entsql"
+	"entgo.io/ent/schema/edge"
+	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/index"
+)
+
+// PromptVariable is a {{name}} placeholder declared by a library prompt
+type PromptVariable struct {
+	Name        string `json:"name"`
+	Description string `json:"description,omitempty"`
+	Default     string `json:"default,omitempty"` // used when no value is given; required variables have none
+}
+
+// Prompt holds the schema definition for the Prompt entity.
+// Each row is one immutable version of a named prompt; saving a prompt again adds the next version.
+type Prompt struct {
+	ent.Schema
+}
+
+// Fields of the Prompt.
+func (Prompt) Fields() []ent.Field {
+	return []ent.Field{
+		field.String("name").
+			NotEmpty().
+			Comment("Prompt name, shared by all of its versions"),
+		field.Int("version").
+			Positive().
+			Comment("Version number, starting at 1"),
+		field.String("task_type").
+			NotEmpty().
+			Comment("Task the prompt is written for ('suggest', 'reorder', 'silence' or 'chat:<endpoint>')"),
+		field.Text("body").
+			NotEmpty().
+			Comment("Prompt text with {{variable}} placeholders"),
+		field.Text("description").
+			Optional().
+			Comment("What the prompt is for"),
+		field.JSON("variables", []PromptVariable{}).
+			Optional().
+			Comment("Placeholders used in the body"),
+		field.Int("project_id").
+			Optional().
+			Comment("Project the prompt belongs to (unset = shared across all projects)"),
+		field.Time("created_at").
+			Default(time.Now).
+			Immutable().
+			Comment("Creation timestamp of this version"),
+	}
+}
+
+// Edges of the Prompt.
+func (Prompt) Edges() []ent.Edge {
+	return []ent.Edge{
+		edge.From("project", Project.Type).
+			Ref("prompts").
+			Field("project_id").
+			Unique(),
+	}
+}
+
+// Indexes of the Prompt. Names are scoped to their project; an unset project_id is the shared
+// scope, which the partial index keeps unique because NULLs never collide in a unique index.
+func (Prompt) Indexes() []ent.Index {
+	return []ent.Index{
+		index.Fields("project_id", "name", "version").Unique(),
+		index.Fields("name", "version").
+			Unique().
+			Annotations(entsql.IndexWhere("project_id IS NULL")),
+		index.Fields("task_type"),
+	}
+}
@@ -0,0 +1,56 @@
+package schema
+
+import (
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/index"
+)
+
+// ResponseCacheEntry holds the schema definition for the ResponseCacheEntry entity.
+// Each row is a cached AI text response, addressed by a hash of the model, prompts and response format.
+type ResponseCacheEntry struct {
+	ent.Schema
+}
+
+// Fields of the ResponseCacheEntry.
+func (ResponseCacheEntry) Fields() []ent.Field {
+	return []ent.Field{
+		field.String("key").
+			NotEmpty().
+			Unique().
+			Comment("SHA-256 of the request content"),
+		field.String("task_type").
+			Optional().
+			Comment("Task the response was produced for, used for invalidation"),
+		field.String("model").
+			Optional().
+			Comment("Model that produced the response"),
+		field.Text("response").
+			NotEmpty().
+			Comment("Raw OpenRouter response as JSON"),
+		field.Int("hits").
+			Default(0).
+			Comment("Number of times the response was served from the cache"),
+		field.Time("created_at").
+			Default(time.Now).
+			Immutable().
+			Comment("Creation timestamp"),
+		field.Time("expires_at").
+			Comment("Time after which the response is no longer served"),
+	}
+}
+
+// Edges of the ResponseCacheEntry.
+func (ResponseCacheEntry) Edges() []ent.Edge {
+	return nil
+}
+
+// Indexes of the ResponseCacheEntry.
+func (ResponseCacheEntry) Indexes() []ent.Index {
+	return []ent.Index{
+		index.Fields("task_type"),
+		index.Fields("expires_at"),
+	}
+}
@@ -0,0 +1,40 @@
+package schema
+
+import (
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/schema/edge"
+	"entgo.io/ent/schema/field"
+)
+
+// Tag holds the schema definition for the Tag entity.
+type Tag struct {
+	ent.Schema
+}
+
+// Fields of the Tag.
+func (Tag) Fields() []ent.Field {
+	return []ent.Field{
+		field.String("name").
+			NotEmpty().
+			Unique().
+			Comment("Tag name"),
+		field.String("color").
+			Optional().
+			Comment("Display color for the tag"),
+		field.Time("created_at").
+			Default(time.Now).
+			Comment("Creation timestamp"),
+	}
+}
+
+// Edges of the Tag.
+func (Tag) Edges() []ent.Edge {
+	return []ent.Edge{
+		edge.To("projects", Project.Type).
+			Comment("Projects with this tag"),
+		edge.To("video_clips", VideoClip.Type).
+			Comment("Video clips with this tag"),
+	}
+}
@@ -0,0 +1,70 @@
+package schema
+
+import (
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/schema/edge"
+	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/index"
+)
+
+// TranscriptEmbedding holds the schema definition for the TranscriptEmbedding entity.
+// Each row is an embedding of a window of transcript words used for semantic search.
+type TranscriptEmbedding struct {
+	ent.Schema
+}
+
+// Fields of the TranscriptEmbedding.
+func (TranscriptEmbedding) Fields() []ent.Field {
+	return []ent.Field{
+		field.Int("video_clip_id").
+			Comment("ID of the video clip this chunk belongs to"),
+		field.Int("chunk_index").
+			NonNegative().
+			Comment("Position of the chunk within the clip transcript"),
+		field.Int("start_word").
+			NonNegative().
+			Comment("Index of the first word in the chunk"),
+		field.Int("end_word").
+			NonNegative().
+			Comment("Index after the last word in the chunk (exclusive)"),
+		field.Float("start_time").
+			Comment("Start time of the chunk in seconds"),
+		field.Float("end_time").
+			Comment("End time of the chunk in seconds"),
+		field.Text("text").
+			Comment("Transcript text of the chunk"),
+		field.String("model").
+			NotEmpty().
+			Comment("Embedding model used"),
+		field.String("source_hash").
+			NotEmpty().
+			Comment("Hash of the clip transcript the chunk was built from, used to detect stale embeddings"),
+		field.JSON("embedding", []float32{}).
+			Comment("Embedding vector"),
+		field.Time("created_at").
+			Default(time.Now).
+			Comment("When the embedding was created"),
+	}
+}
+
+// Edges of the TranscriptEmbedding.
+func (TranscriptEmbedding) Edges() []ent.Edge {
+	return []ent.Edge{
+		edge.From("video_clip", VideoClip.Type).
+			Ref("transcript_embeddings").
+			Field("video_clip_id").
+			Required().
+			Unique().
+			Comment("Video clip this chunk belongs to"),
+	}
+}
+
+// Indexes of the TranscriptEmbedding.
+func (TranscriptEmbedding) Indexes() []ent.Index {
+	return []ent.Index{
+		index.Fields("video_clip_id"),
+		index.Fields("video_clip_id", "model"),
+	}
+}
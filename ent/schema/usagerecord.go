@@ -0,0 +1,69 @@
+package schema
+
+import (
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/index"
+)
+
+// UsageRecord holds the schema definition for the UsageRecord entity.
+// Each row is one metered AI call. Records keep their project ID after the project is deleted
+// so monthly spend stays accurate.
+type UsageRecord struct {
+	ent.Schema
+}
+
+// Fields of the UsageRecord.
+func (UsageRecord) Fields() []ent.Field {
+	return []ent.Field{
+		field.Int("project_id").
+			Optional().
+			Comment("Project the call was made for (unset = not tied to a project)"),
+		field.String("provider").
+			NotEmpty().
+			Comment("Upstream provider of the call ('openrouter', 'openai', 'anthropic' or 'openai_compatible')"),
+		field.String("task_type").
+			NotEmpty().
+			Comment("Task the call was made for ('suggest_highlights', 'reorder', 'improve_silences', 'chat', 'transcription', ...)"),
+		field.String("model").
+			Optional().
+			Comment("Model that served the call"),
+		field.Int("prompt_tokens").
+			Default(0).
+			Comment("Input tokens reported by the provider"),
+		field.Int("completion_tokens").
+			Default(0).
+			Comment("Output tokens reported by the provider"),
+		field.Int("total_tokens").
+			Default(0).
+			Comment("Total tokens reported by the provider"),
+		field.Float("audio_seconds").
+			Default(0).
+			Comment("Seconds of audio transcribed"),
+		field.Float("cost_usd").
+			Default(0).
+			Comment("Cost of the call in US dollars"),
+		field.Bool("cost_estimated").
+			Default(false).
+			Comment("Whether the cost was estimated from the price table rather than reported by the provider"),
+		field.Time("created_at").
+			Default(time.Now).
+			Immutable().
+			Comment("Time of the call"),
+	}
+}
+
+// Edges of the UsageRecord.
+func (UsageRecord) Edges() []ent.Edge {
+	return nil
+}
+
+// Indexes of the UsageRecord.
+func (UsageRecord) Indexes() []ent.Index {
+	return []ent.Index{
+		index.Fields("created_at"),
+		index.Fields("project_id"),
+	}
+}
@@ -6,6 +6,7 @@ import (
 	"entgo.io/ent"
 	"entgo.io/ent/schema/edge"
 	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/index"
 )
 
 // Word represents a word with timestamps for transcription
@@ -17,10 +18,20 @@ type Word struct {
 
 // Highlight represents a highlighted text region with timestamps
 type Highlight struct {
-	ID      string  `json:"id"`
-	Start   float64 `json:"start"`
-	End     float64 `json:"end"`
-	ColorID int     `json:"colorId"`
+	ID      string            `json:"id"`
+	Start   float64           `json:"start"`
+	End     float64           `json:"end"`
+	ColorID int               `json:"colorId"`
+	Insight *HighlightInsight `json:"insight,omitempty"` // set when the highlight was suggested by AI
+}
+
+// HighlightInsight records how strong an AI-suggested highlight is, why it was picked and by which model
+type HighlightInsight struct {
+	Score     float64 `json:"score"`               // 0-1, higher is stronger
+	Category  string  `json:"category,omitempty"`  // hook, insight, story, cta, quote, humor or other
+	Rationale string  `json:"rationale,omitempty"` // why the highlight was picked
+	Model     string  `json:"model,omitempty"`     // model that suggested the highlight
+	PromptID  int     `json:"promptId,omitempty"`  // library prompt version used, 0 = none
 }
 
 // VideoClip holds the schema definition for the VideoClip entity.
@@ -73,6 +84,9 @@ func (VideoClip) Fields() []ent.Field {
 		field.JSON("suggested_highlights", []Highlight{}).
 			Optional().
 			Comment("AI-suggested highlights pending user confirmation"),
+		field.Int("suggested_highlights_prompt_id").
+			Optional().
+			Comment("Library prompt version used for the pending suggestions (0 = none)"),
 		field.Time("created_at").
 			Default(time.Now).
 			Comment("Creation timestamp"),
@@ -100,6 +114,9 @@ func (VideoClip) Fields() []ent.Field {
 		field.Time("transcription_completed_at").
 			Optional().
 			Comment("When transcription was completed"),
+		field.Time("deleted_at").
+			Optional().
+			Comment("When the video clip was moved to the trash (unset while active)"),
 	}
 }
 
@@ -110,5 +127,20 @@ func (VideoClip) Edges() []ent.Edge {
 			Ref("video_clips").
 			Unique().
 			Comment("Project this video clip belongs to"),
+		edge.To("transcript_embeddings", TranscriptEmbedding.Type).
+			Comment("Embedded transcript chunks used for semantic search"),
+		edge.From("tags", Tag.Type).
+			Ref("video_clips").
+			Comment("User-defined tags on this video clip"),
+	}
+}
+
+// Indexes of the VideoClip.
+func (VideoClip) Indexes() []ent.Index {
+	return []ent.Index{
+		index.Fields("created_at"),
+		index.Fields("deleted_at"),
+		index.Fields("transcription_state"),
+		index.Fields("duration"),
 	}
 }
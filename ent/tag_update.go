@@ -0,0 +1,648 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"ramble-ai/ent/predicate"
+	"ramble-ai/ent/project"
+	"ramble-ai/ent/tag"
+	"ramble-ai/ent/videoclip"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+)
+
+// TagUpdate is the builder for updating Tag entities.
+type TagUpdate struct {
+	config
+	hooks    []Hook
+	mutation *TagMutation
+}
+
+// Where appends a list predicates to the TagUpdate builder.
+func (tu *TagUpdate) Where(ps ...predicate.Tag) *TagUpdate {
+	tu.mutation.Where(ps...)
+	return tu
+}
+
+// SetName sets the "name" field.
+func (tu *TagUpdate) SetName(s string) *TagUpdate {
+	tu.mutation.SetName(s)
+	return tu
+}
+
+// SetNillableName sets the "name" field if the given value is not nil.
+func (tu *TagUpdate) SetNillableName(s *string) *TagUpdate {
+	if s != nil {
+		tu.SetName(*s)
+	}
+	return tu
+}
+
+// SetColor sets the "color" field.
+func (tu *TagUpdate) SetColor(s string) *TagUpdate {
+	tu.mutation.SetColor(s)
+	return tu
+}
+
+// SetNillableColor sets the "color" field if the given value is not nil.
+func (tu *TagUpdate) SetNillableColor(s *string) *TagUpdate {
+	if s != nil {
+		tu.SetColor(*s)
+	}
+	return tu
+}
+
+// ClearColor clears the value of the "color" field.
+func (tu *TagUpdate) ClearColor() *TagUpdate {
+	tu.mutation.ClearColor()
+	return tu
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (tu *TagUpdate) SetCreatedAt(t time.Time) *TagUpdate {
+	tu.mutation.SetCreatedAt(t)
+	return tu
+}
+
+// SetNillableCreatedAt sets the "created_at" field if the given value is not nil.
+func (tu *TagUpdate) SetNillableCreatedAt(t *time.Time) *TagUpdate {
+	if t != nil {
+		tu.SetCreatedAt(*t)
+	}
+	return tu
+}
+
+// AddProjectIDs adds the "projects" edge to the Project entity by IDs.
+func (tu *TagUpdate) AddProjectIDs(ids ...int) *TagUpdate {
+	tu.mutation.AddProjectIDs(ids...)
+	return tu
+}
+
+// AddProjects adds the "projects" edges to the Project entity.
+func (tu *TagUpdate) AddProjects(p ...*Project) *TagUpdate {
+	ids := make([]int, len(p))
+	for i := range p {
+		ids[i] = p[i].ID
+	}
+	return tu.AddProjectIDs(ids...)
+}
+
+// AddVideoClipIDs adds the "video_clips" edge to the VideoClip entity by IDs.
+func (tu *TagUpdate) AddVideoClipIDs(ids ...int) *TagUpdate {
+	tu.mutation.AddVideoClipIDs(ids...)
+	return tu
+}
+
+// AddVideoClips adds the "video_clips" edges to the VideoClip entity.
+func (tu *TagUpdate) AddVideoClips(v ...*VideoClip) *TagUpdate {
+	ids := make([]int, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return tu.AddVideoClipIDs(ids...)
+}
+
+// Mutation returns the TagMutation object of the builder.
+func (tu *TagUpdate) Mutation() *TagMutation {
+	return tu.mutation
+}
+
+// ClearProjects clears all "projects" edges to the Project entity.
+func (tu *TagUpdate) ClearProjects() *TagUpdate {
+	tu.mutation.ClearProjects()
+	return tu
+}
+
+// RemoveProjectIDs removes the "projects" edge to Project entities by IDs.
+func (tu *TagUpdate) RemoveProjectIDs(ids ...int) *TagUpdate {
+	tu.mutation.RemoveProjectIDs(ids...)
+	return tu
+}
+
+// RemoveProjects removes "projects" edges to Project entities.
+func (tu *TagUpdate) RemoveProjects(p ...*Project) *TagUpdate {
+	ids := make([]int, len(p))
+	for i := range p {
+		ids[i] = p[i].ID
+	}
+	return tu.RemoveProjectIDs(ids...)
+}
+
+// ClearVideoClips clears all "video_clips" edges to the VideoClip entity.
+func (tu *TagUpdate) ClearVideoClips() *TagUpdate {
+	tu.mutation.ClearVideoClips()
+	return tu
+}
+
+// RemoveVideoClipIDs removes the "video_clips" edge to VideoClip entities by IDs.
+func (tu *TagUpdate) RemoveVideoClipIDs(ids ...int) *TagUpdate {
+	tu.mutation.RemoveVideoClipIDs(ids...)
+	return tu
+}
+
+// RemoveVideoClips removes "video_clips" edges to VideoClip entities.
+func (tu *TagUpdate) RemoveVideoClips(v ...*VideoClip) *TagUpdate {
+	ids := make([]int, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return tu.RemoveVideoClipIDs(ids...)
+}
+
+// Save executes the query and returns the number of nodes affected by the update operation.
+func (tu *TagUpdate) Save(ctx context.Context) (int, error) {
+	return withHooks(ctx, tu.sqlSave, tu.mutation, tu.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (tu *TagUpdate) SaveX(ctx context.Context) int {
+	affected, err := tu.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return affected
+}
+
+// Exec executes the query.
+func (tu *TagUpdate) Exec(ctx context.Context) error {
+	_, err := tu.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (tu *TagUpdate) ExecX(ctx context.Context) {
+	if err := tu.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (tu *TagUpdate) check() error {
+	if v, ok := tu.mutation.Name(); ok {
+		if err := tag.NameValidator(v); err != nil {
+			return &ValidationError{Name: "name", err: fmt.Errorf(`ent: validator failed for field "Tag.name": %w`, err)}
+		}
+	}
+	return nil
+}
+
+func (tu *TagUpdate) sqlSave(ctx context.Context) (n int, err error) {
+	if err := tu.check(); err != nil {
+		return n, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(tag.Table, tag.Columns, sqlgraph.NewFieldSpec(tag.FieldID, field.TypeInt))
+	if ps := tu.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := tu.mutation.Name(); ok {
+		_spec.SetField(tag.FieldName, field.TypeString, value)
+	}
+	if value, ok := tu.mutation.Color(); ok {
+		_spec.SetField(tag.FieldColor, field.TypeString, value)
+	}
+	if tu.mutation.ColorCleared() {
+		_spec.ClearField(tag.FieldColor, field.TypeString)
+	}
+	if value, ok := tu.mutation.CreatedAt(); ok {
+		_spec.SetField(tag.FieldCreatedAt, field.TypeTime, value)
+	}
+	if tu.mutation.ProjectsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2M,
+			Inverse: false,
+			Table:   tag.ProjectsTable,
+			Columns: tag.ProjectsPrimaryKey,
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(project.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := tu.mutation.RemovedProjectsIDs(); len(nodes) > 0 && !tu.mutation.ProjectsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2M,
+			Inverse: false,
+			Table:   tag.ProjectsTable,
+			Columns: tag.ProjectsPrimaryKey,
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(project.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := tu.mutation.ProjectsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2M,
+			Inverse: false,
+			Table:   tag.ProjectsTable,
+			Columns: tag.ProjectsPrimaryKey,
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(project.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if tu.mutation.VideoClipsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2M,
+			Inverse: false,
+			Table:   tag.VideoClipsTable,
+			Columns: tag.VideoClipsPrimaryKey,
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(videoclip.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := tu.mutation.RemovedVideoClipsIDs(); len(nodes) > 0 && !tu.mutation.VideoClipsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2M,
+			Inverse: false,
+			Table:   tag.VideoClipsTable,
+			Columns: tag.VideoClipsPrimaryKey,
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(videoclip.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := tu.mutation.VideoClipsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2M,
+			Inverse: false,
+			Table:   tag.VideoClipsTable,
+			Columns: tag.VideoClipsPrimaryKey,
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(videoclip.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if n, err = sqlgraph.UpdateNodes(ctx, tu.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{tag.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return 0, err
+	}
+	tu.mutation.done = true
+	return n, nil
+}
+
+// TagUpdateOne is the builder for updating a single Tag entity.
+type TagUpdateOne struct {
+	config
+	fields   []string
+	hooks    []Hook
+	mutation *TagMutation
+}
+
+// SetName sets the "name" field.
+func (tuo *TagUpdateOne) SetName(s string) *TagUpdateOne {
+	tuo.mutation.SetName(s)
+	return tuo
+}
+
+// SetNillableName sets the "name" field if the given value is not nil.
+func (tuo *TagUpdateOne) SetNillableName(s *string) *TagUpdateOne {
+	if s != nil {
+		tuo.SetName(*s)
+	}
+	return tuo
+}
+
+// SetColor sets the "color" field.
+func (tuo *TagUpdateOne) SetColor(s string) *TagUpdateOne {
+	tuo.mutation.SetColor(s)
+	return tuo
+}
+
+// SetNillableColor sets the "color" field if the given value is not nil.
+func (tuo *TagUpdateOne) SetNillableColor(s *string) *TagUpdateOne {
+	if s != nil {
+		tuo.SetColor(*s)
+	}
+	return tuo
+}
+
+// ClearColor clears the value of the "color" field.
+func (tuo *TagUpdateOne) ClearColor() *TagUpdateOne {
+	tuo.mutation.ClearColor()
+	return tuo
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (tuo *TagUpdateOne) SetCreatedAt(t time.Time) *TagUpdateOne {
+	tuo.mutation.SetCreatedAt(t)
+	return tuo
+}
+
+// SetNillableCreatedAt sets the "created_at" field if the given value is not nil.
+func (tuo *TagUpdateOne) SetNillableCreatedAt(t *time.Time) *TagUpdateOne {
+	if t != nil {
+		tuo.SetCreatedAt(*t)
+	}
+	return tuo
+}
+
+// AddProjectIDs adds the "projects" edge to the Project entity by IDs.
+func (tuo *TagUpdateOne) AddProjectIDs(ids ...int) *TagUpdateOne {
+	tuo.mutation.AddProjectIDs(ids...)
+	return tuo
+}
+
+// AddProjects adds the "projects" edges to the Project entity.
+func (tuo *TagUpdateOne) AddProjects(p ...*Project) *TagUpdateOne {
+	ids := make([]int, len(p))
+	for i := range p {
+		ids[i] = p[i].ID
+	}
+	return tuo.AddProjectIDs(ids...)
+}
+
+// AddVideoClipIDs adds the "video_clips" edge to the VideoClip entity by IDs.
+func (tuo *TagUpdateOne) AddVideoClipIDs(ids ...int) *TagUpdateOne {
+	tuo.mutation.AddVideoClipIDs(ids...)
+	return tuo
+}
+
+// AddVideoClips adds the "video_clips" edges to the VideoClip entity.
+func (tuo *TagUpdateOne) AddVideoClips(v ...*VideoClip) *TagUpdateOne {
+	ids := make([]int, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return tuo.AddVideoClipIDs(ids...)
+}
+
+// Mutation returns the TagMutation object of the builder.
+func (tuo *TagUpdateOne) Mutation() *TagMutation {
+	return tuo.mutation
+}
+
+// ClearProjects clears all "projects" edges to the Project entity.
+func (tuo *TagUpdateOne) ClearProjects() *TagUpdateOne {
+	tuo.mutation.ClearProjects()
+	return tuo
+}
+
+// RemoveProjectIDs removes the "projects" edge to Project entities by IDs.
+func (tuo *TagUpdateOne) RemoveProjectIDs(ids ...int) *TagUpdateOne {
+	tuo.mutation.RemoveProjectIDs(ids...)
+	return tuo
+}
+
+// RemoveProjects removes "projects" edges to Project entities.
+func (tuo *TagUpdateOne) RemoveProjects(p ...*Project) *TagUpdateOne {
+	ids := make([]int, len(p))
+	for i := range p {
+		ids[i] = p[i].ID
+	}
+	return tuo.RemoveProjectIDs(ids...)
+}
+
+// ClearVideoClips clears all "video_clips" edges to the VideoClip entity.
+func (tuo *TagUpdateOne) ClearVideoClips() *TagUpdateOne {
+	tuo.mutation.ClearVideoClips()
+	return tuo
+}
+
+// RemoveVideoClipIDs removes the "video_clips" edge to VideoClip entities by IDs.
+func (tuo *TagUpdateOne) RemoveVideoClipIDs(ids ...int) *TagUpdateOne {
+	tuo.mutation.RemoveVideoClipIDs(ids...)
+	return tuo
+}
+
+// RemoveVideoClips removes "video_clips" edges to VideoClip entities.
+func (tuo *TagUpdateOne) RemoveVideoClips(v ...*VideoClip) *TagUpdateOne {
+	ids := make([]int, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return tuo.RemoveVideoClipIDs(ids...)
+}
+
+// Where appends a list predicates to the TagUpdate builder.
+func (tuo *TagUpdateOne) Where(ps ...predicate.Tag) *TagUpdateOne {
+	tuo.mutation.Where(ps...)
+	return tuo
+}
+
+// Select allows selecting one or more fields (columns) of the returned entity.
+// The default is selecting all fields defined in the entity schema.
+func (tuo *TagUpdateOne) Select(field string, fields ...string) *TagUpdateOne {
+	tuo.fields = append([]string{field}, fields...)
+	return tuo
+}
+
+// Save executes the query and returns the updated Tag entity.
+func (tuo *TagUpdateOne) Save(ctx context.Context) (*Tag, error) {
+	return withHooks(ctx, tuo.sqlSave, tuo.mutation, tuo.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (tuo *TagUpdateOne) SaveX(ctx context.Context) *Tag {
+	node, err := tuo.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// Exec executes the query on the entity.
+func (tuo *TagUpdateOne) Exec(ctx context.Context) error {
+	_, err := tuo.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (tuo *TagUpdateOne) ExecX(ctx context.Context) {
+	if err := tuo.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (tuo *TagUpdateOne) check() error {
+	if v, ok := tuo.mutation.Name(); ok {
+		if err := tag.NameValidator(v); err != nil {
+			return &ValidationError{Name: "name", err: fmt.Errorf(`ent: validator failed for field "Tag.name": %w`, err)}
+		}
+	}
+	return nil
+}
+
+func (tuo *TagUpdateOne) sqlSave(ctx context.Context) (_node *Tag, err error) {
+	if err := tuo.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(tag.Table, tag.Columns, sqlgraph.NewFieldSpec(tag.FieldID, field.TypeInt))
+	id, ok := tuo.mutation.ID()
+	if !ok {
+		return nil, &ValidationError{Name: "id", err: errors.New(`ent: missing "Tag.id" for update`)}
+	}
+	_spec.Node.ID.Value = id
+	if fields := tuo.fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, tag.FieldID)
+		for _, f := range fields {
+			if !tag.ValidColumn(f) {
+				return nil, &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+			}
+			if f != tag.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, f)
+			}
+		}
+	}
+	if ps := tuo.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := tuo.mutation.Name(); ok {
+		_spec.SetField(tag.FieldName, field.TypeString, value)
+	}
+	if value, ok := tuo.mutation.Color(); ok {
+		_spec.SetField(tag.FieldColor, field.TypeString, value)
+	}
+	if tuo.mutation.ColorCleared() {
+		_spec.ClearField(tag.FieldColor, field.TypeString)
+	}
+	if value, ok := tuo.mutation.CreatedAt(); ok {
+		_spec.SetField(tag.FieldCreatedAt, field.TypeTime, value)
+	}
+	if tuo.mutation.ProjectsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2M,
+			Inverse: false,
+			Table:   tag.ProjectsTable,
+			Columns: tag.ProjectsPrimaryKey,
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(project.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := tuo.mutation.RemovedProjectsIDs(); len(nodes) > 0 && !tuo.mutation.ProjectsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2M,
+			Inverse: false,
+			Table:   tag.ProjectsTable,
+			Columns: tag.ProjectsPrimaryKey,
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(project.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := tuo.mutation.ProjectsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2M,
+			Inverse: false,
+			Table:   tag.ProjectsTable,
+			Columns: tag.ProjectsPrimaryKey,
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(project.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if tuo.mutation.VideoClipsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2M,
+			Inverse: false,
+			Table:   tag.VideoClipsTable,
+			Columns: tag.VideoClipsPrimaryKey,
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(videoclip.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := tuo.mutation.RemovedVideoClipsIDs(); len(nodes) > 0 && !tuo.mutation.VideoClipsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2M,
+			Inverse: false,
+			Table:   tag.VideoClipsTable,
+			Columns: tag.VideoClipsPrimaryKey,
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(videoclip.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := tuo.mutation.VideoClipsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2M,
+			Inverse: false,
+			Table:   tag.VideoClipsTable,
+			Columns: tag.VideoClipsPrimaryKey,
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(videoclip.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	_node = &Tag{config: tuo.config}
+	_spec.Assign = _node.assignValues
+	_spec.ScanValues = _node.scanValues
+	if err = sqlgraph.UpdateNode(ctx, tuo.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{tag.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	tuo.mutation.done = true
+	return _node, nil
+}
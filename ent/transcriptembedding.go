@@ -0,0 +1,252 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"encoding/json"
+	"fmt"
+	"ramble-ai/ent/transcriptembedding"
+	"ramble-ai/ent/videoclip"
+	"strings"
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/sql"
+)
+
+// TranscriptEmbedding is the model entity for the TranscriptEmbedding schema.
+type TranscriptEmbedding struct {
+	config `json:"-"`
+	// ID of the ent.
+	ID int `json:"id,omitempty"`
+	// ID of the video clip this chunk belongs to
+	VideoClipID int `json:"video_clip_id,omitempty"`
+	// Position of the chunk within the clip transcript
+	ChunkIndex int `json:"chunk_index,omitempty"`
+	// Index of the first word in the chunk
+	StartWord int `json:"start_word,omitempty"`
+	// Index after the last word in the chunk (exclusive)
+	EndWord int `json:"end_word,omitempty"`
+	// Start time of the chunk in seconds
+	StartTime float64 `json:"start_time,omitempty"`
+	// End time of the chunk in seconds
+	EndTime float64 `json:"end_time,omitempty"`
+	// Transcript text of the chunk
+	Text string `json:"text,omitempty"`
+	// Embedding model used
+	Model string `json:"model,omitempty"`
+	// Hash of the clip transcript the chunk was built from, used to detect stale embeddings
+	SourceHash string `json:"source_hash,omitempty"`
+	// Embedding vector
+	Embedding []float32 `json:"embedding,omitempty"`
+	// When the embedding was created
+	CreatedAt time.Time `json:"created_at,omitempty"`
+	// Edges holds the relations/edges for other nodes in the graph.
+	// The values are being populated by the TranscriptEmbeddingQuery when eager-loading is set.
+	Edges        TranscriptEmbeddingEdges `json:"edges"`
+	selectValues sql.SelectValues
+}
+
+// TranscriptEmbeddingEdges holds the relations/edges for other nodes in the graph.
+type TranscriptEmbeddingEdges struct {
+	// Video clip this chunk belongs to
+	VideoClip *VideoClip `json:"video_clip,omitempty"`
+	// loadedTypes holds the information for reporting if a
+	// type was loaded (or requested) in eager-loading or not.
+	loadedTypes [1]bool
+}
+
+// VideoClipOrErr returns the VideoClip value or an error if the edge
+// was not loaded in eager-loading, or loaded but was not found.
+func (e TranscriptEmbeddingEdges) VideoClipOrErr() (*VideoClip, error) {
+	if e.VideoClip != nil {
+		return e.VideoClip, nil
+	} else if e.loadedTypes[0] {
+		return nil, &NotFoundError{label: videoclip.Label}
+	}
+	return nil, &NotLoadedError{edge: "video_clip"}
+}
+
+// scanValues returns the types for scanning values from sql.Rows.
+func (*TranscriptEmbedding) scanValues(columns []string) ([]any, error) {
+	values := make([]any, len(columns))
+	for i := range columns {
+		switch columns[i] {
+		case transcriptembedding.FieldEmbedding:
+			values[i] = new([]byte)
+		case transcriptembedding.FieldStartTime, transcriptembedding.FieldEndTime:
+			values[i] = new(sql.NullFloat64)
+		case transcriptembedding.FieldID, transcriptembedding.FieldVideoClipID, transcriptembedding.FieldChunkIndex, transcriptembedding.FieldStartWord, transcriptembedding.FieldEndWord:
+			values[i] = new(sql.NullInt64)
+		case transcriptembedding.FieldText, transcriptembedding.FieldModel, transcriptembedding.FieldSourceHash:
+			values[i] = new(sql.NullString)
+		case transcriptembedding.FieldCreatedAt:
+			values[i] = new(sql.NullTime)
+		default:
+			values[i] = new(sql.UnknownType)
+		}
+	}
+	return values, nil
+}
+
+// assignValues assigns the values that were returned from sql.Rows (after scanning)
+// to the TranscriptEmbedding fields.
+func (te *TranscriptEmbedding) assignValues(columns []string, values []any) error {
+	if m, n := len(values), len(columns); m < n {
+		return fmt.Errorf("mismatch number of scan values: %d != %d", m, n)
+	}
+	for i := range columns {
+		switch columns[i] {
+		case transcriptembedding.FieldID:
+			value, ok := values[i].(*sql.NullInt64)
+			if !ok {
+				return fmt.Errorf("unexpected type %T for field id", value)
+			}
+			te.ID = int(value.Int64)
+		case transcriptembedding.FieldVideoClipID:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field video_clip_id", values[i])
+			} else if value.Valid {
+				te.VideoClipID = int(value.Int64)
+			}
+		case transcriptembedding.FieldChunkIndex:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field chunk_index", values[i])
+			} else if value.Valid {
+				te.ChunkIndex = int(value.Int64)
+			}
+		case transcriptembedding.FieldStartWord:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field start_word", values[i])
+			} else if value.Valid {
+				te.StartWord = int(value.Int64)
+			}
+		case transcriptembedding.FieldEndWord:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field end_word", values[i])
+			} else if value.Valid {
+				te.EndWord = int(value.Int64)
+			}
+		case transcriptembedding.FieldStartTime:
+			if value, ok := values[i].(*sql.NullFloat64); !ok {
+				return fmt.Errorf("unexpected type %T for field start_time", values[i])
+			} else if value.Valid {
+				te.StartTime = value.Float64
+			}
+		case transcriptembedding.FieldEndTime:
+			if value, ok := values[i].(*sql.NullFloat64); !ok {
+				return fmt.Errorf("unexpected type %T for field end_time", values[i])
+			} else if value.Valid {
+				te.EndTime = value.Float64
+			}
+		case transcriptembedding.FieldText:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field text", values[i])
+			} else if value.Valid {
+				te.Text = value.String
+			}
+		case transcriptembedding.FieldModel:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field model", values[i])
+			} else if value.Valid {
+				te.Model = value.String
+			}
+		case transcriptembedding.FieldSourceHash:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field source_hash", values[i])
+			} else if value.Valid {
+				te.SourceHash = value.String
+			}
+		case transcriptembedding.FieldEmbedding:
+			if value, ok := values[i].(*[]byte); !ok {
+				return fmt.Errorf("unexpected type %T for field embedding", values[i])
+			} else if value != nil && len(*value) > 0 {
+				if err := json.Unmarshal(*value, &te.Embedding); err != nil {
+					return fmt.Errorf("unmarshal field embedding: %w", err)
+				}
+			}
+		case transcriptembedding.FieldCreatedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field created_at", values[i])
+			} else if value.Valid {
+				te.CreatedAt = value.Time
+			}
+		default:
+			te.selectValues.Set(columns[i], values[i])
+		}
+	}
+	return nil
+}
+
+// Value returns the ent.Value that was dynamically selected and assigned to the TranscriptEmbedding.
+// This includes values selected through modifiers, order, etc.
+func (te *TranscriptEmbedding) Value(name string) (ent.Value, error) {
+	return te.selectValues.Get(name)
+}
+
+// QueryVideoClip queries the "video_clip" edge of the TranscriptEmbedding entity.
+func (te *TranscriptEmbedding) QueryVideoClip() *VideoClipQuery {
+	return NewTranscriptEmbeddingClient(te.config).QueryVideoClip(te)
+}
+
+// Update returns a builder for updating this TranscriptEmbedding.
+// Note that you need to call TranscriptEmbedding.Unwrap() before calling this method if this TranscriptEmbedding
+// was returned from a transaction, and the transaction was committed or rolled back.
+func (te *TranscriptEmbedding) Update() *TranscriptEmbeddingUpdateOne {
+	return NewTranscriptEmbeddingClient(te.config).UpdateOne(te)
+}
+
+// Unwrap unwraps the TranscriptEmbedding entity that was returned from a transaction after it was closed,
+// so that all future queries will be executed through the driver which created the transaction.
+func (te *TranscriptEmbedding) Unwrap() *TranscriptEmbedding {
+	_tx, ok := te.config.driver.(*txDriver)
+	if !ok {
+		panic("ent: TranscriptEmbedding is not a transactional entity")
+	}
+	te.config.driver = _tx.drv
+	return te
+}
+
+// String implements the fmt.Stringer.
+func (te *TranscriptEmbedding) String() string {
+	var builder strings.Builder
+	builder.WriteString("TranscriptEmbedding(")
+	builder.WriteString(fmt.Sprintf("id=%v, ", te.ID))
+	builder.WriteString("video_clip_id=")
+	builder.WriteString(fmt.Sprintf("%v", te.VideoClipID))
+	builder.WriteString(", ")
+	builder.WriteString("chunk_index=")
+	builder.WriteString(fmt.Sprintf("%v", te.ChunkIndex))
+	builder.WriteString(", ")
+	builder.WriteString("start_word=")
+	builder.WriteString(fmt.Sprintf("%v", te.StartWord))
+	builder.WriteString(", ")
+	builder.WriteString("end_word=")
+	builder.WriteString(fmt.Sprintf("%v", te.EndWord))
+	builder.WriteString(", ")
+	builder.WriteString("start_time=")
+	builder.WriteString(fmt.Sprintf("%v", te.StartTime))
+	builder.WriteString(", ")
+	builder.WriteString("end_time=")
+	builder.WriteString(fmt.Sprintf("%v", te.EndTime))
+	builder.WriteString(", ")
+	builder.WriteString("text=")
+	builder.WriteString(te.Text)
+	builder.WriteString(", ")
+	builder.WriteString("model=")
+	builder.WriteString(te.Model)
+	builder.WriteString(", ")
+	builder.WriteString("source_hash=")
+	builder.WriteString(te.SourceHash)
+	builder.WriteString(", ")
+	builder.WriteString("embedding=")
+	builder.WriteString(fmt.Sprintf("%v", te.Embedding))
+	builder.WriteString(", ")
+	builder.WriteString("created_at=")
+	builder.WriteString(te.CreatedAt.Format(time.ANSIC))
+	builder.WriteByte(')')
+	return builder.String()
+}
+
+// TranscriptEmbeddings is a parsable slice of TranscriptEmbedding.
+type TranscriptEmbeddings []*TranscriptEmbedding
@@ -0,0 +1,163 @@
+// Code generated by ent, DO NOT EDIT.
+
+package transcriptembedding
+
+import (
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+)
+
+const (
+	// Label holds the string label denoting the transcriptembedding type in the database.
+	Label = "transcript_embedding"
+	// FieldID holds the string denoting the id field in the database.
+	FieldID = "id"
+	// FieldVideoClipID holds the string denoting the video_clip_id field in the database.
+	FieldVideoClipID = "video_clip_id"
+	// FieldChunkIndex holds the string denoting the chunk_index field in the database.
+	FieldChunkIndex = "chunk_index"
+	// FieldStartWord holds the string denoting the start_word field in the database.
+	FieldStartWord = "start_word"
+	// FieldEndWord holds the string denoting the end_word field in the database.
+	FieldEndWord = "end_word"
+	// FieldStartTime holds the string denoting the start_time field in the database.
+	FieldStartTime = "start_time"
+	// FieldEndTime holds the string denoting the end_time field in the database.
+	FieldEndTime = "end_time"
+	// FieldText holds the string denoting the text field in the database.
+	FieldText = "text"
+	// FieldModel holds the string denoting the model field in the database.
+	FieldModel = "model"
+	// FieldSourceHash holds the string denoting the source_hash field in the database.
+	FieldSourceHash = "source_hash"
+	// FieldEmbedding holds the string denoting the embedding field in the database.
+	FieldEmbedding = "embedding"
+	// FieldCreatedAt holds the string denoting the created_at field in the database.
+	FieldCreatedAt = "created_at"
+	// EdgeVideoClip holds the string denoting the video_clip edge name in mutations.
+	EdgeVideoClip = "video_clip"
+	// Table holds the table name of the transcriptembedding in the database.
+	Table = "transcript_embeddings"
+	// VideoClipTable is the table that holds the video_clip relation/edge.
+	VideoClipTable = "transcript_embeddings"
+	// VideoClipInverseTable is the table name for the VideoClip entity.
+	// It exists in this package in order to avoid circular dependency with the "videoclip" package.
+	VideoClipInverseTable = "video_clips"
+	// VideoClipColumn is the table column denoting the video_clip relation/edge.
+	VideoClipColumn = "video_clip_id"
+)
+
+// Columns holds all SQL columns for transcriptembedding fields.
+var Columns = []string{
+	FieldID,
+	FieldVideoClipID,
+	FieldChunkIndex,
+	FieldStartWord,
+	FieldEndWord,
+	FieldStartTime,
+	FieldEndTime,
+	FieldText,
+	FieldModel,
+	FieldSourceHash,
+	FieldEmbedding,
+	FieldCreatedAt,
+}
+
+// ValidColumn reports if the column name is valid (part of the table columns).
+func ValidColumn(column string) bool {
+	for i := range Columns {
+		if column == Columns[i] {
+			return true
+		}
+	}
+	return false
+}
+
+var (
+	// ChunkIndexValidator is a validator for the "chunk_index" field. It is called by the builders before save.
+	ChunkIndexValidator func(int) error
+	// StartWordValidator is a validator for the "start_word" field. It is called by the builders before save.
+	StartWordValidator func(int) error
+	// EndWordValidator is a validator for the "end_word" field. It is called by the builders before save.
+	EndWordValidator func(int) error
+	// ModelValidator is a validator for the "model" field. It is called by the builders before save.
+	ModelValidator func(string) error
+	// SourceHashValidator is a validator for the "source_hash" field. It is called by the builders before save.
+	SourceHashValidator func(string) error
+	// DefaultCreatedAt holds the default value on creation for the "created_at" field.
+	DefaultCreatedAt func() time.Time
+)
+
+// OrderOption defines the ordering options for the TranscriptEmbedding queries.
+type OrderOption func(*sql.Selector)
+
+// ByID orders the results by the id field.
+func ByID(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldID, opts...).ToFunc()
+}
+
+// ByVideoClipID orders the results by the video_clip_id field.
+func ByVideoClipID(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldVideoClipID, opts...).ToFunc()
+}
+
+// ByChunkIndex orders the results by the chunk_index field.
+func ByChunkIndex(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldChunkIndex, opts...).ToFunc()
+}
+
+// ByStartWord orders the results by the start_word field.
+func ByStartWord(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldStartWord, opts...).ToFunc()
+}
+
+// ByEndWord orders the results by the end_word field.
+func ByEndWord(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldEndWord, opts...).ToFunc()
+}
+
+// ByStartTime orders the results by the start_time field.
+func ByStartTime(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldStartTime, opts...).ToFunc()
+}
+
+// ByEndTime orders the results by the end_time field.
+func ByEndTime(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldEndTime, opts...).ToFunc()
+}
+
+// ByText orders the results by the text field.
+func ByText(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldText, opts...).ToFunc()
+}
+
+// ByModel orders the results by the model field.
+func ByModel(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldModel, opts...).ToFunc()
+}
+
+// BySourceHash orders the results by the source_hash field.
+func BySourceHash(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldSourceHash, opts...).ToFunc()
+}
+
+// ByCreatedAt orders the results by the created_at field.
+func ByCreatedAt(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldCreatedAt, opts...).ToFunc()
+}
+
+// ByVideoClipField orders the results by video_clip field.
+func ByVideoClipField(field string, opts ...sql.OrderTermOption) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborTerms(s, newVideoClipStep(), sql.OrderByField(field, opts...))
+	}
+}
+func newVideoClipStep() *sqlgraph.Step {
+	return sqlgraph.NewStep(
+		sqlgraph.From(Table, FieldID),
+		sqlgraph.To(VideoClipInverseTable, FieldID),
+		sqlgraph.Edge(sqlgraph.M2O, true, VideoClipTable, VideoClipColumn),
+	)
+}
@@ -0,0 +1,599 @@
+// Code generated by ent, DO NOT EDIT.
+
+package transcriptembedding
+
+import (
+	"ramble-ai/ent/predicate"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+)
+
+// ID filters vertices based on their ID field.
+func ID(id int) predicate.TranscriptEmbedding {
+	return predicate.TranscriptEmbedding(sql.FieldEQ(FieldID, id))
+}
+
+// IDEQ applies the EQ predicate on the ID field.
+func IDEQ(id int) predicate.TranscriptEmbedding {
+	return predicate.TranscriptEmbedding(sql.FieldEQ(FieldID, id))
+}
+
+// IDNEQ applies the NEQ predicate on the ID field.
+func IDNEQ(id int) predicate.TranscriptEmbedding {
+	return predicate.TranscriptEmbedding(sql.FieldNEQ(FieldID, id))
+}
+
+// IDIn applies the In predicate on the ID field.
+func IDIn(ids ...int) predicate.TranscriptEmbedding {
+	return predicate.TranscriptEmbedding(sql.FieldIn(FieldID, ids...))
+}
+
+// IDNotIn applies the NotIn predicate on the ID field.
+func IDNotIn(ids ...int) predicate.TranscriptEmbedding {
+	return predicate.TranscriptEmbedding(sql.FieldNotIn(FieldID, ids...))
+}
+
+// IDGT applies the GT predicate on the ID field.
+func IDGT(id int) predicate.TranscriptEmbedding {
+	return predicate.TranscriptEmbedding(sql.FieldGT(FieldID, id))
+}
+
+// IDGTE applies the GTE predicate on the ID field.
+func IDGTE(id int) predicate.TranscriptEmbedding {
+	return predicate.TranscriptEmbedding(sql.FieldGTE(FieldID, id))
+}
+
+// IDLT applies the LT predicate on the ID field.
+func IDLT(id int) predicate.TranscriptEmbedding {
+	return predicate.TranscriptEmbedding(sql.FieldLT(FieldID, id))
+}
+
+// IDLTE applies the LTE predicate on the ID field.
+func IDLTE(id int) predicate.TranscriptEmbedding {
+	return predicate.TranscriptEmbedding(sql.FieldLTE(FieldID, id))
+}
+
+// VideoClipID applies equality check predicate on the "video_clip_id" field. It's identical to VideoClipIDEQ.
+func VideoClipID(v int) predicate.TranscriptEmbedding {
+	return predicate.TranscriptEmbedding(sql.FieldEQ(FieldVideoClipID, v))
+}
+
+// ChunkIndex applies equality check predicate on the "chunk_index" field. It's identical to ChunkIndexEQ.
+func ChunkIndex(v int) predicate.TranscriptEmbedding {
+	return predicate.TranscriptEmbedding(sql.FieldEQ(FieldChunkIndex, v))
+}
+
+// StartWord applies equality check predicate on the "start_word" field. It's identical to StartWordEQ.
+func StartWord(v int) predicate.TranscriptEmbedding {
+	return predicate.TranscriptEmbedding(sql.FieldEQ(FieldStartWord, v))
+}
+
+// EndWord applies equality check predicate on the "end_word" field. It's identical to EndWordEQ.
+func EndWord(v int) predicate.TranscriptEmbedding {
+	return predicate.TranscriptEmbedding(sql.FieldEQ(FieldEndWord, v))
+}
+
+// StartTime applies equality check predicate on the "start_time" field. It's identical to StartTimeEQ.
+func StartTime(v float64) predicate.TranscriptEmbedding {
+	return predicate.TranscriptEmbedding(sql.FieldEQ(FieldStartTime, v))
+}
+
+// EndTime applies equality check predicate on the "end_time" field. It's identical to EndTimeEQ.
+func EndTime(v float64) predicate.TranscriptEmbedding {
+	return predicate.TranscriptEmbedding(sql.FieldEQ(FieldEndTime, v))
+}
+
+// Text applies equality check predicate on the "text" field. It's identical to TextEQ.
+func Text(v string) predicate.TranscriptEmbedding {
+	return predicate.TranscriptEmbedding(sql.FieldEQ(FieldText, v))
+}
+
+// Model applies equality check predicate on the "model" field. It's identical to ModelEQ.
+func Model(v string) predicate.TranscriptEmbedding {
+	return predicate.TranscriptEmbedding(sql.FieldEQ(FieldModel, v))
+}
+
+// SourceHash applies equality check predicate on the "source_hash" field. It's identical to SourceHashEQ.
+func SourceHash(v string) predicate.TranscriptEmbedding {
+	return predicate.TranscriptEmbedding(sql.FieldEQ(FieldSourceHash, v))
+}
+
+// CreatedAt applies equality check predicate on the "created_at" field. It's identical to CreatedAtEQ.
+func CreatedAt(v time.Time) predicate.TranscriptEmbedding {
+	return predicate.TranscriptEmbedding(sql.FieldEQ(FieldCreatedAt, v))
+}
+
+// VideoClipIDEQ applies the EQ predicate on the "video_clip_id" field.
+func VideoClipIDEQ(v int) predicate.TranscriptEmbedding {
+	return predicate.TranscriptEmbedding(sql.FieldEQ(FieldVideoClipID, v))
+}
+
+// VideoClipIDNEQ applies the NEQ predicate on the "video_clip_id" field.
+func VideoClipIDNEQ(v int) predicate.TranscriptEmbedding {
+	return predicate.TranscriptEmbedding(sql.FieldNEQ(FieldVideoClipID, v))
+}
+
+// VideoClipIDIn applies the In predicate on the "video_clip_id" field.
+func VideoClipIDIn(vs ...int) predicate.TranscriptEmbedding {
+	return predicate.TranscriptEmbedding(sql.FieldIn(FieldVideoClipID, vs...))
+}
+
+// VideoClipIDNotIn applies the NotIn predicate on the "video_clip_id" field.
+func VideoClipIDNotIn(vs ...int) predicate.TranscriptEmbedding {
+	return predicate.TranscriptEmbedding(sql.FieldNotIn(FieldVideoClipID, vs...))
+}
+
+// ChunkIndexEQ applies the EQ predicate on the "chunk_index" field.
+func ChunkIndexEQ(v int) predicate.TranscriptEmbedding {
+	return predicate.TranscriptEmbedding(sql.FieldEQ(FieldChunkIndex, v))
+}
+
+// ChunkIndexNEQ applies the NEQ predicate on the "chunk_index" field.
+func ChunkIndexNEQ(v int) predicate.TranscriptEmbedding {
+	return predicate.TranscriptEmbedding(sql.FieldNEQ(FieldChunkIndex, v))
+}
+
+// ChunkIndexIn applies the In predicate on the "chunk_index" field.
+func ChunkIndexIn(vs ...int) predicate.TranscriptEmbedding {
+	return predicate.TranscriptEmbedding(sql.FieldIn(FieldChunkIndex, vs...))
+}
+
+// ChunkIndexNotIn applies the NotIn predicate on the "chunk_index" field.
+func ChunkIndexNotIn(vs ...int) predicate.TranscriptEmbedding {
+	return predicate.TranscriptEmbedding(sql.FieldNotIn(FieldChunkIndex, vs...))
+}
+
+// ChunkIndexGT applies the GT predicate on the "chunk_index" field.
+func ChunkIndexGT(v int) predicate.TranscriptEmbedding {
+	return predicate.TranscriptEmbedding(sql.FieldGT(FieldChunkIndex, v))
+}
+
+// ChunkIndexGTE applies the GTE predicate on the "chunk_index" field.
+func ChunkIndexGTE(v int) predicate.TranscriptEmbedding {
+	return predicate.TranscriptEmbedding(sql.FieldGTE(FieldChunkIndex, v))
+}
+
+// ChunkIndexLT applies the LT predicate on the "chunk_index" field.
+func ChunkIndexLT(v int) predicate.TranscriptEmbedding {
+	return predicate.TranscriptEmbedding(sql.FieldLT(FieldChunkIndex, v))
+}
+
+// ChunkIndexLTE applies the LTE predicate on the "chunk_index" field.
+func ChunkIndexLTE(v int) predicate.TranscriptEmbedding {
+	return predicate.TranscriptEmbedding(sql.FieldLTE(FieldChunkIndex, v))
+}
+
+// StartWordEQ applies the EQ predicate on the "start_word" field.
+func StartWordEQ(v int) predicate.TranscriptEmbedding {
+	return predicate.TranscriptEmbedding(sql.FieldEQ(FieldStartWord, v))
+}
+
+// StartWordNEQ applies the NEQ predicate on the "start_word" field.
+func StartWordNEQ(v int) predicate.TranscriptEmbedding {
+	return predicate.TranscriptEmbedding(sql.FieldNEQ(FieldStartWord, v))
+}
+
+// StartWordIn applies the In predicate on the "start_word" field.
+func StartWordIn(vs ...int) predicate.TranscriptEmbedding {
+	return predicate.TranscriptEmbedding(sql.FieldIn(FieldStartWord, vs...))
+}
+
+// StartWordNotIn applies the NotIn predicate on the "start_word" field.
+func StartWordNotIn(vs ...int) predicate.TranscriptEmbedding {
+	return predicate.TranscriptEmbedding(sql.FieldNotIn(FieldStartWord, vs...))
+}
+
+// StartWordGT applies the GT predicate on the "start_word" field.
+func StartWordGT(v int) predicate.TranscriptEmbedding {
+	return predicate.TranscriptEmbedding(sql.FieldGT(FieldStartWord, v))
+}
+
+// StartWordGTE applies the GTE predicate on the "start_word" field.
+func StartWordGTE(v int) predicate.TranscriptEmbedding {
+	return predicate.TranscriptEmbedding(sql.FieldGTE(FieldStartWord, v))
+}
+
+// StartWordLT applies the LT predicate on the "start_word" field.
+func StartWordLT(v int) predicate.TranscriptEmbedding {
+	return predicate.TranscriptEmbedding(sql.FieldLT(FieldStartWord, v))
+}
+
+// StartWordLTE applies the LTE predicate on the "start_word" field.
+func StartWordLTE(v int) predicate.TranscriptEmbedding {
+	return predicate.TranscriptEmbedding(sql.FieldLTE(FieldStartWord, v))
+}
+
+// EndWordEQ applies the EQ predicate on the "end_word" field.
+func EndWordEQ(v int) predicate.TranscriptEmbedding {
+	return predicate.TranscriptEmbedding(sql.FieldEQ(FieldEndWord, v))
+}
+
+// EndWordNEQ applies the NEQ predicate on the "end_word" field.
+func EndWordNEQ(v int) predicate.TranscriptEmbedding {
+	return predicate.TranscriptEmbedding(sql.FieldNEQ(FieldEndWord, v))
+}
+
+// EndWordIn applies the In predicate on the "end_word" field.
+func EndWordIn(vs ...int) predicate.TranscriptEmbedding {
+	return predicate.TranscriptEmbedding(sql.FieldIn(FieldEndWord, vs...))
+}
+
+// EndWordNotIn applies the NotIn predicate on the "end_word" field.
+func EndWordNotIn(vs ...int) predicate.TranscriptEmbedding {
+	return predicate.TranscriptEmbedding(sql.FieldNotIn(FieldEndWord, vs...))
+}
+
+// EndWordGT applies the GT predicate on the "end_word" field.
+func EndWordGT(v int) predicate.TranscriptEmbedding {
+	return predicate.TranscriptEmbedding(sql.FieldGT(FieldEndWord, v))
+}
+
+// EndWordGTE applies the GTE predicate on the "end_word" field.
+func EndWordGTE(v int) predicate.TranscriptEmbedding {
+	return predicate.TranscriptEmbedding(sql.FieldGTE(FieldEndWord, v))
+}
+
+// EndWordLT applies the LT predicate on the "end_word" field.
+func EndWordLT(v int) predicate.TranscriptEmbedding {
+	return predicate.TranscriptEmbedding(sql.FieldLT(FieldEndWord, v))
+}
+
+// EndWordLTE applies the LTE predicate on the "end_word" field.
+func EndWordLTE(v int) predicate.TranscriptEmbedding {
+	return predicate.TranscriptEmbedding(sql.FieldLTE(FieldEndWord, v))
+}
+
+// StartTimeEQ applies the EQ predicate on the "start_time" field.
+func StartTimeEQ(v float64) predicate.TranscriptEmbedding {
+	return predicate.TranscriptEmbedding(sql.FieldEQ(FieldStartTime, v))
+}
+
+// StartTimeNEQ applies the NEQ predicate on the "start_time" field.
+func StartTimeNEQ(v float64) predicate.TranscriptEmbedding {
+	return predicate.TranscriptEmbedding(sql.FieldNEQ(FieldStartTime, v))
+}
+
+// StartTimeIn applies the In predicate on the "start_time" field.
+func StartTimeIn(vs ...float64) predicate.TranscriptEmbedding {
+	return predicate.TranscriptEmbedding(sql.FieldIn(FieldStartTime, vs...))
+}
+
+// StartTimeNotIn applies the NotIn predicate on the "start_time" field.
+func StartTimeNotIn(vs ...float64) predicate.TranscriptEmbedding {
+	return predicate.TranscriptEmbedding(sql.FieldNotIn(FieldStartTime, vs...))
+}
+
+// StartTimeGT applies the GT predicate on the "start_time" field.
+func StartTimeGT(v float64) predicate.TranscriptEmbedding {
+	return predicate.TranscriptEmbedding(sql.FieldGT(FieldStartTime, v))
+}
+
+// StartTimeGTE applies the GTE predicate on the "start_time" field.
+func StartTimeGTE(v float64) predicate.TranscriptEmbedding {
+	return predicate.TranscriptEmbedding(sql.FieldGTE(FieldStartTime, v))
+}
+
+// StartTimeLT applies the LT predicate on the "start_time" field.
+func StartTimeLT(v float64) predicate.TranscriptEmbedding {
+	return predicate.TranscriptEmbedding(sql.FieldLT(FieldStartTime, v))
+}
+
+// StartTimeLTE applies the LTE predicate on the "start_time" field.
+func StartTimeLTE(v float64) predicate.TranscriptEmbedding {
+	return predicate.TranscriptEmbedding(sql.FieldLTE(FieldStartTime, v))
+}
+
+// EndTimeEQ applies the EQ predicate on the "end_time" field.
+func EndTimeEQ(v float64) predicate.TranscriptEmbedding {
+	return predicate.TranscriptEmbedding(sql.FieldEQ(FieldEndTime, v))
+}
+
+// EndTimeNEQ applies the NEQ predicate on the "end_time" field.
+func EndTimeNEQ(v float64) predicate.TranscriptEmbedding {
+	return predicate.TranscriptEmbedding(sql.FieldNEQ(FieldEndTime, v))
+}
+
+// EndTimeIn applies the In predicate on the "end_time" field.
+func EndTimeIn(vs ...float64) predicate.TranscriptEmbedding {
+	return predicate.TranscriptEmbedding(sql.FieldIn(FieldEndTime, vs...))
+}
+
+// EndTimeNotIn applies the NotIn predicate on the "end_time" field.
+func EndTimeNotIn(vs ...float64) predicate.TranscriptEmbedding {
+	return predicate.TranscriptEmbedding(sql.FieldNotIn(FieldEndTime, vs...))
+}
+
+// EndTimeGT applies the GT predicate on the "end_time" field.
+func EndTimeGT(v float64) predicate.TranscriptEmbedding {
+	return predicate.TranscriptEmbedding(sql.FieldGT(FieldEndTime, v))
+}
+
+// EndTimeGTE applies the GTE predicate on the "end_time" field.
+func EndTimeGTE(v float64) predicate.TranscriptEmbedding {
+	return predicate.TranscriptEmbedding(sql.FieldGTE(FieldEndTime, v))
+}
+
+// EndTimeLT applies the LT predicate on the "end_time" field.
+func EndTimeLT(v float64) predicate.TranscriptEmbedding {
+	return predicate.TranscriptEmbedding(sql.FieldLT(FieldEndTime, v))
+}
+
+// EndTimeLTE applies the LTE predicate on the "end_time" field.
+func EndTimeLTE(v float64) predicate.TranscriptEmbedding {
+	return predicate.TranscriptEmbedding(sql.FieldLTE(FieldEndTime, v))
+}
+
+// TextEQ applies the EQ predicate on the "text" field.
+func TextEQ(v string) predicate.TranscriptEmbedding {
+	return predicate.TranscriptEmbedding(sql.FieldEQ(FieldText, v))
+}
+
+// TextNEQ applies the NEQ predicate on the "text" field.
+func TextNEQ(v string) predicate.TranscriptEmbedding {
+	return predicate.TranscriptEmbedding(sql.FieldNEQ(FieldText, v))
+}
+
+// TextIn applies the In predicate on the "text" field.
+func TextIn(vs ...string) predicate.TranscriptEmbedding {
+	return predicate.TranscriptEmbedding(sql.FieldIn(FieldText, vs...))
+}
+
+// TextNotIn applies the NotIn predicate on the "text" field.
+func TextNotIn(vs ...string) predicate.TranscriptEmbedding {
+	return predicate.TranscriptEmbedding(sql.FieldNotIn(FieldText, vs...))
+}
+
+// TextGT applies the GT predicate on the "text" field.
+func TextGT(v string) predicate.TranscriptEmbedding {
+	return predicate.TranscriptEmbedding(sql.FieldGT(FieldText, v))
+}
+
+// TextGTE applies the GTE predicate on the "text" field.
+func TextGTE(v string) predicate.TranscriptEmbedding {
+	return predicate.TranscriptEmbedding(sql.FieldGTE(FieldText, v))
+}
+
+// TextLT applies the LT predicate on the "text" field.
+func TextLT(v string) predicate.TranscriptEmbedding {
+	return predicate.TranscriptEmbedding(sql.FieldLT(FieldText, v))
+}
+
+// TextLTE applies the LTE predicate on the "text" field.
+func TextLTE(v string) predicate.TranscriptEmbedding {
+	return predicate.TranscriptEmbedding(sql.FieldLTE(FieldText, v))
+}
+
+// TextContains applies the Contains predicate on the "text" field.
+func TextContains(v string) predicate.TranscriptEmbedding {
+	return predicate.TranscriptEmbedding(sql.FieldContains(FieldText, v))
+}
+
+// TextHasPrefix applies the HasPrefix predicate on the "text" field.
+func TextHasPrefix(v string) predicate.TranscriptEmbedding {
+	return predicate.TranscriptEmbedding(sql.FieldHasPrefix(FieldText, v))
+}
+
+// TextHasSuffix applies the HasSuffix predicate on the "text" field.
+func TextHasSuffix(v string) predicate.TranscriptEmbedding {
+	return predicate.TranscriptEmbedding(sql.FieldHasSuffix(FieldText, v))
+}
+
+// TextEqualFold applies the EqualFold predicate on the "text" field.
+func TextEqualFold(v string) predicate.TranscriptEmbedding {
+	return predicate.TranscriptEmbedding(sql.FieldEqualFold(FieldText, v))
+}
+
+// TextContainsFold applies the ContainsFold predicate on the "text" field.
+func TextContainsFold(v string) predicate.TranscriptEmbedding {
+	return predicate.TranscriptEmbedding(sql.FieldContainsFold(FieldText, v))
+}
+
+// ModelEQ applies the EQ predicate on the "model" field.
+func ModelEQ(v string) predicate.TranscriptEmbedding {
+	return predicate.TranscriptEmbedding(sql.FieldEQ(FieldModel, v))
+}
+
+// ModelNEQ applies the NEQ predicate on the "model" field.
+func ModelNEQ(v string) predicate.TranscriptEmbedding {
+	return predicate.TranscriptEmbedding(sql.FieldNEQ(FieldModel, v))
+}
+
+// ModelIn applies the In predicate on the "model" field.
+func ModelIn(vs ...string) predicate.TranscriptEmbedding {
+	return predicate.TranscriptEmbedding(sql.FieldIn(FieldModel, vs...))
+}
+
+// ModelNotIn applies the NotIn predicate on the "model" field.
+func ModelNotIn(vs ...string) predicate.TranscriptEmbedding {
+	return predicate.TranscriptEmbedding(sql.FieldNotIn(FieldModel, vs...))
+}
+
+// ModelGT applies the GT predicate on the "model" field.
+func ModelGT(v string) predicate.TranscriptEmbedding {
+	return predicate.TranscriptEmbedding(sql.FieldGT(FieldModel, v))
+}
+
+// ModelGTE applies the GTE predicate on the "model" field.
+func ModelGTE(v string) predicate.TranscriptEmbedding {
+	return predicate.TranscriptEmbedding(sql.FieldGTE(FieldModel, v))
+}
+
+// ModelLT applies the LT predicate on the "model" field.
+func ModelLT(v string) predicate.TranscriptEmbedding {
+	return predicate.TranscriptEmbedding(sql.FieldLT(FieldModel, v))
+}
+
+// ModelLTE applies the LTE predicate on the "model" field.
+func ModelLTE(v string) predicate.TranscriptEmbedding {
+	return predicate.TranscriptEmbedding(sql.FieldLTE(FieldModel, v))
+}
+
+// ModelContains applies the Contains predicate on the "model" field.
+func ModelContains(v string) predicate.TranscriptEmbedding {
+	return predicate.TranscriptEmbedding(sql.FieldContains(FieldModel, v))
+}
+
+// ModelHasPrefix applies the HasPrefix predicate on the "model" field.
+func ModelHasPrefix(v string) predicate.TranscriptEmbedding {
+	return predicate.TranscriptEmbedding(sql.FieldHasPrefix(FieldModel, v))
+}
+
+// ModelHasSuffix applies the HasSuffix predicate on the "model" field.
+func ModelHasSuffix(v string) predicate.TranscriptEmbedding {
+	return predicate.TranscriptEmbedding(sql.FieldHasSuffix(FieldModel, v))
+}
+
+// ModelEqualFold applies the EqualFold predicate on the "model" field.
+func ModelEqualFold(v string) predicate.TranscriptEmbedding {
+	return predicate.TranscriptEmbedding(sql.FieldEqualFold(FieldModel, v))
+}
+
+// ModelContainsFold applies the ContainsFold predicate on the "model" field.
+func ModelContainsFold(v string) predicate.TranscriptEmbedding {
+	return predicate.TranscriptEmbedding(sql.FieldContainsFold(FieldModel, v))
+}
+
+// SourceHashEQ applies the EQ predicate on the "source_hash" field.
+func SourceHashEQ(v string) predicate.TranscriptEmbedding {
+	return predicate.TranscriptEmbedding(sql.FieldEQ(FieldSourceHash, v))
+}
+
+// SourceHashNEQ applies the NEQ predicate on the "source_hash" field.
+func SourceHashNEQ(v string) predicate.TranscriptEmbedding {
+	return predicate.TranscriptEmbedding(sql.FieldNEQ(FieldSourceHash, v))
+}
+
+// SourceHashIn applies the In predicate on the "source_hash" field.
+func SourceHashIn(vs ...string) predicate.TranscriptEmbedding {
+	return predicate.TranscriptEmbedding(sql.FieldIn(FieldSourceHash, vs...))
+}
+
+// SourceHashNotIn applies the NotIn predicate on the "source_hash" field.
+func SourceHashNotIn(vs ...string) predicate.TranscriptEmbedding {
+	return predicate.TranscriptEmbedding(sql.FieldNotIn(FieldSourceHash, vs...))
+}
+
+// SourceHashGT applies the GT predicate on the "source_hash" field.
+func SourceHashGT(v string) predicate.TranscriptEmbedding {
+	return predicate.TranscriptEmbedding(sql.FieldGT(FieldSourceHash, v))
+}
+
+// SourceHashGTE applies the GTE predicate on the "source_hash" field.
+func SourceHashGTE(v string) predicate.TranscriptEmbedding {
+	return predicate.TranscriptEmbedding(sql.FieldGTE(FieldSourceHash, v))
+}
+
+// SourceHashLT applies the LT predicate on the "source_hash" field.
+func SourceHashLT(v string) predicate.TranscriptEmbedding {
+	return predicate.TranscriptEmbedding(sql.FieldLT(FieldSourceHash, v))
+}
+
+// SourceHashLTE applies the LTE predicate on the "source_hash" field.
+func SourceHashLTE(v string) predicate.TranscriptEmbedding {
+	return predicate.TranscriptEmbedding(sql.FieldLTE(FieldSourceHash, v))
+}
+
+// SourceHashContains applies the Contains predicate on the "source_hash" field.
+func SourceHashContains(v string) predicate.TranscriptEmbedding {
+	return predicate.TranscriptEmbedding(sql.FieldContains(FieldSourceHash, v))
+}
+
+// SourceHashHasPrefix applies the HasPrefix predicate on the "source_hash" field.
+func SourceHashHasPrefix(v string) predicate.TranscriptEmbedding {
+	return predicate.TranscriptEmbedding(sql.FieldHasPrefix(FieldSourceHash, v))
+}
+
+// SourceHashHasSuffix applies the HasSuffix predicate on the "source_hash" field.
+func SourceHashHasSuffix(v string) predicate.TranscriptEmbedding {
+	return predicate.TranscriptEmbedding(sql.FieldHasSuffix(FieldSourceHash, v))
+}
+
+// SourceHashEqualFold applies the EqualFold predicate on the "source_hash" field.
+func SourceHashEqualFold(v string) predicate.TranscriptEmbedding {
+	return predicate.TranscriptEmbedding(sql.FieldEqualFold(FieldSourceHash, v))
+}
+
+// SourceHashContainsFold applies the ContainsFold predicate on the "source_hash" field.
+func SourceHashContainsFold(v string) predicate.TranscriptEmbedding {
+	return predicate.TranscriptEmbedding(sql.FieldContainsFold(FieldSourceHash, v))
+}
+
+// CreatedAtEQ applies the EQ predicate on the "created_at" field.
+func CreatedAtEQ(v time.Time) predicate.TranscriptEmbedding {
+	return predicate.TranscriptEmbedding(sql.FieldEQ(FieldCreatedAt, v))
+}
+
+// CreatedAtNEQ applies the NEQ predicate on the "created_at" field.
+func CreatedAtNEQ(v time.Time) predicate.TranscriptEmbedding {
+	return predicate.TranscriptEmbedding(sql.FieldNEQ(FieldCreatedAt, v))
+}
+
+// CreatedAtIn applies the In predicate on the "created_at" field.
+func CreatedAtIn(vs ...time.Time) predicate.TranscriptEmbedding {
+	return predicate.TranscriptEmbedding(sql.FieldIn(FieldCreatedAt, vs...))
+}
+
+// CreatedAtNotIn applies the NotIn predicate on the "created_at" field.
+func CreatedAtNotIn(vs ...time.Time) predicate.TranscriptEmbedding {
+	return predicate.TranscriptEmbedding(sql.FieldNotIn(FieldCreatedAt, vs...))
+}
+
+// CreatedAtGT applies the GT predicate on the "created_at" field.
+func CreatedAtGT(v time.Time) predicate.TranscriptEmbedding {
+	return predicate.TranscriptEmbedding(sql.FieldGT(FieldCreatedAt, v))
+}
+
+// CreatedAtGTE applies the GTE predicate on the "created_at" field.
+func CreatedAtGTE(v time.Time) predicate.TranscriptEmbedding {
+	return predicate.TranscriptEmbedding(sql.FieldGTE(FieldCreatedAt, v))
+}
+
+// CreatedAtLT applies the LT predicate on the "created_at" field.
+func CreatedAtLT(v time.Time) predicate.TranscriptEmbedding {
+	return predicate.TranscriptEmbedding(sql.FieldLT(FieldCreatedAt, v))
+}
+
+// CreatedAtLTE applies the LTE predicate on the "created_at" field.
+func CreatedAtLTE(v time.Time) predicate.TranscriptEmbedding {
+	return predicate.TranscriptEmbedding(sql.FieldLTE(FieldCreatedAt, v))
+}
+
+// HasVideoClip applies the HasEdge predicate on the "video_clip" edge.
+func HasVideoClip() predicate.TranscriptEmbedding {
+	return predicate.TranscriptEmbedding(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, VideoClipTable, VideoClipColumn),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasVideoClipWith applies the HasEdge predicate on the "video_clip" edge with a given conditions (other predicates).
+func HasVideoClipWith(preds ...predicate.VideoClip) predicate.TranscriptEmbedding {
+	return predicate.TranscriptEmbedding(func(s *sql.Selector) {
+		step := newVideoClipStep()
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
+// And groups predicates with the AND operator between them.
+func And(predicates ...predicate.TranscriptEmbedding) predicate.TranscriptEmbedding {
+	return predicate.TranscriptEmbedding(sql.AndPredicates(predicates...))
+}
+
+// Or groups predicates with the OR operator between them.
+func Or(predicates ...predicate.TranscriptEmbedding) predicate.TranscriptEmbedding {
+	return predicate.TranscriptEmbedding(sql.OrPredicates(predicates...))
+}
+
+// Not applies the not operator on the given predicate.
+func Not(p predicate.TranscriptEmbedding) predicate.TranscriptEmbedding {
+	return predicate.TranscriptEmbedding(sql.NotPredicates(p))
+}
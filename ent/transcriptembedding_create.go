@@ -0,0 +1,379 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"ramble-ai/ent/transcriptembedding"
+	"ramble-ai/ent/videoclip"
+	"time"
+
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+)
+
+// TranscriptEmbeddingCreate is the builder for creating a TranscriptEmbedding entity.
+type TranscriptEmbeddingCreate struct {
+	config
+	mutation *TranscriptEmbeddingMutation
+	hooks    []Hook
+}
+
+// SetVideoClipID sets the "video_clip_id" field.
+func (tec *TranscriptEmbeddingCreate) SetVideoClipID(i int) *TranscriptEmbeddingCreate {
+	tec.mutation.SetVideoClipID(i)
+	return tec
+}
+
+// SetChunkIndex sets the "chunk_index" field.
+func (tec *TranscriptEmbeddingCreate) SetChunkIndex(i int) *TranscriptEmbeddingCreate {
+	tec.mutation.SetChunkIndex(i)
+	return tec
+}
+
+// SetStartWord sets the "start_word" field.
+func (tec *TranscriptEmbeddingCreate) SetStartWord(i int) *TranscriptEmbeddingCreate {
+	tec.mutation.SetStartWord(i)
+	return tec
+}
+
+// SetEndWord sets the "end_word" field.
+func (tec *TranscriptEmbeddingCreate) SetEndWord(i int) *TranscriptEmbeddingCreate {
+	tec.mutation.SetEndWord(i)
+	return tec
+}
+
+// SetStartTime sets the "start_time" field.
+func (tec *TranscriptEmbeddingCreate) SetStartTime(f float64) *TranscriptEmbeddingCreate {
+	tec.mutation.SetStartTime(f)
+	return tec
+}
+
+// SetEndTime sets the "end_time" field.
+func (tec *TranscriptEmbeddingCreate) SetEndTime(f float64) *TranscriptEmbeddingCreate {
+	tec.mutation.SetEndTime(f)
+	return tec
+}
+
+// SetText sets the "text" field.
+func (tec *TranscriptEmbeddingCreate) SetText(s string) *TranscriptEmbeddingCreate {
+	tec.mutation.SetText(s)
+	return tec
+}
+
+// SetModel sets the "model" field.
+func (tec *TranscriptEmbeddingCreate) SetModel(s string) *TranscriptEmbeddingCreate {
+	tec.mutation.SetModel(s)
+	return tec
+}
+
+// SetSourceHash sets the "source_hash" field.
+func (tec *TranscriptEmbeddingCreate) SetSourceHash(s string) *TranscriptEmbeddingCreate {
+	tec.mutation.SetSourceHash(s)
+	return tec
+}
+
+// SetEmbedding sets the "embedding" field.
+func (tec *TranscriptEmbeddingCreate) SetEmbedding(f []float32) *TranscriptEmbeddingCreate {
+	tec.mutation.SetEmbedding(f)
+	return tec
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (tec *TranscriptEmbeddingCreate) SetCreatedAt(t time.Time) *TranscriptEmbeddingCreate {
+	tec.mutation.SetCreatedAt(t)
+	return tec
+}
+
+// SetNillableCreatedAt sets the "created_at" field if the given value is not nil.
+func (tec *TranscriptEmbeddingCreate) SetNillableCreatedAt(t *time.Time) *TranscriptEmbeddingCreate {
+	if t != nil {
+		tec.SetCreatedAt(*t)
+	}
+	return tec
+}
+
+// SetVideoClip sets the "video_clip" edge to the VideoClip entity.
+func (tec *TranscriptEmbeddingCreate) SetVideoClip(v *VideoClip) *TranscriptEmbeddingCreate {
+	return tec.SetVideoClipID(v.ID)
+}
+
+// Mutation returns the TranscriptEmbeddingMutation object of the builder.
+func (tec *TranscriptEmbeddingCreate) Mutation() *TranscriptEmbeddingMutation {
+	return tec.mutation
+}
+
+// Save creates the TranscriptEmbedding in the database.
+func (tec *TranscriptEmbeddingCreate) Save(ctx context.Context) (*TranscriptEmbedding, error) {
+	tec.defaults()
+	return withHooks(ctx, tec.sqlSave, tec.mutation, tec.hooks)
+}
+
+// SaveX calls Save and panics if Save returns an error.
+func (tec *TranscriptEmbeddingCreate) SaveX(ctx context.Context) *TranscriptEmbedding {
+	v, err := tec.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (tec *TranscriptEmbeddingCreate) Exec(ctx context.Context) error {
+	_, err := tec.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (tec *TranscriptEmbeddingCreate) ExecX(ctx context.Context) {
+	if err := tec.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (tec *TranscriptEmbeddingCreate) defaults() {
+	if _, ok := tec.mutation.CreatedAt(); !ok {
+		v := transcriptembedding.DefaultCreatedAt()
+		tec.mutation.SetCreatedAt(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (tec *TranscriptEmbeddingCreate) check() error {
+	if _, ok := tec.mutation.VideoClipID(); !ok {
+		return &ValidationError{Name: "video_clip_id", err: errors.New(`ent: missing required field "TranscriptEmbedding.video_clip_id"`)}
+	}
+	if _, ok := tec.mutation.ChunkIndex(); !ok {
+		return &ValidationError{Name: "chunk_index", err: errors.New(`ent: missing required field "TranscriptEmbedding.chunk_index"`)}
+	}
+	if v, ok := tec.mutation.ChunkIndex(); ok {
+		if err := transcriptembedding.ChunkIndexValidator(v); err != nil {
+			return &ValidationError{Name: "chunk_index", err: fmt.Errorf(`ent: validator failed for field "TranscriptEmbedding.chunk_index": %w`, err)}
+		}
+	}
+	if _, ok := tec.mutation.StartWord(); !ok {
+		return &ValidationError{Name: "start_word", err: errors.New(`ent: missing required field "TranscriptEmbedding.start_word"`)}
+	}
+	if v, ok := tec.mutation.StartWord(); ok {
+		if err := transcriptembedding.StartWordValidator(v); err != nil {
+			return &ValidationError{Name: "start_word", err: fmt.Errorf(`ent: validator failed for field "TranscriptEmbedding.start_word": %w`, err)}
+		}
+	}
+	if _, ok := tec.mutation.EndWord(); !ok {
+		return &ValidationError{Name: "end_word", err: errors.New(`ent: missing required field "TranscriptEmbedding.end_word"`)}
+	}
+	if v, ok := tec.mutation.EndWord(); ok {
+		if err := transcriptembedding.EndWordValidator(v); err != nil {
+			return &ValidationError{Name: "end_word", err: fmt.Errorf(`ent: validator failed for field "TranscriptEmbedding.end_word": %w`, err)}
+		}
+	}
+	if _, ok := tec.mutation.StartTime(); !ok {
+		return &ValidationError{Name: "start_time", err: errors.New(`ent: missing required field "TranscriptEmbedding.start_time"`)}
+	}
+	if _, ok := tec.mutation.EndTime(); !ok {
+		return &ValidationError{Name: "end_time", err: errors.New(`ent: missing required field "TranscriptEmbedding.end_time"`)}
+	}
+	if _, ok := tec.mutation.Text(); !ok {
+		return &ValidationError{Name: "text", err: errors.New(`ent: missing required field "TranscriptEmbedding.text"`)}
+	}
+	if _, ok := tec.mutation.Model(); !ok {
+		return &ValidationError{Name: "model", err: errors.New(`ent: missing required field "TranscriptEmbedding.model"`)}
+	}
+	if v, ok := tec.mutation.Model(); ok {
+		if err := transcriptembedding.ModelValidator(v); err != nil {
+			return &ValidationError{Name: "model", err: fmt.Errorf(`ent: validator failed for field "TranscriptEmbedding.model": %w`, err)}
+		}
+	}
+	if _, ok := tec.mutation.SourceHash(); !ok {
+		return &ValidationError{Name: "source_hash", err: errors.New(`ent: missing required field "TranscriptEmbedding.source_hash"`)}
+	}
+	if v, ok := tec.mutation.SourceHash(); ok {
+		if err := transcriptembedding.SourceHashValidator(v); err != nil {
+			return &ValidationError{Name: "source_hash", err: fmt.Errorf(`ent: validator failed for field "TranscriptEmbedding.source_hash": %w`, err)}
+		}
+	}
+	if _, ok := tec.mutation.Embedding(); !ok {
+		return &ValidationError{Name: "embedding", err: errors.New(`ent: missing required field "TranscriptEmbedding.embedding"`)}
+	}
+	if _, ok := tec.mutation.CreatedAt(); !ok {
+		return &ValidationError{Name: "created_at", err: errors.New(`ent: missing required field "TranscriptEmbedding.created_at"`)}
+	}
+	if len(tec.mutation.VideoClipIDs()) == 0 {
+		return &ValidationError{Name: "video_clip", err: errors.New(`ent: missing required edge "TranscriptEmbedding.video_clip"`)}
+	}
+	return nil
+}
+
+func (tec *TranscriptEmbeddingCreate) sqlSave(ctx context.Context) (*TranscriptEmbedding, error) {
+	if err := tec.check(); err != nil {
+		return nil, err
+	}
+	_node, _spec := tec.createSpec()
+	if err := sqlgraph.CreateNode(ctx, tec.driver, _spec); err != nil {
+		if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	id := _spec.ID.Value.(int64)
+	_node.ID = int(id)
+	tec.mutation.id = &_node.ID
+	tec.mutation.done = true
+	return _node, nil
+}
+
+func (tec *TranscriptEmbeddingCreate) createSpec() (*TranscriptEmbedding, *sqlgraph.CreateSpec) {
+	var (
+		_node = &TranscriptEmbedding{config: tec.config}
+		_spec = sqlgraph.NewCreateSpec(transcriptembedding.Table, sqlgraph.NewFieldSpec(transcriptembedding.FieldID, field.TypeInt))
+	)
+	if value, ok := tec.mutation.ChunkIndex(); ok {
+		_spec.SetField(transcriptembedding.FieldChunkIndex, field.TypeInt, value)
+		_node.ChunkIndex = value
+	}
+	if value, ok := tec.mutation.StartWord(); ok {
+		_spec.SetField(transcriptembedding.FieldStartWord, field.TypeInt, value)
+		_node.StartWord = value
+	}
+	if value, ok := tec.mutation.EndWord(); ok {
+		_spec.SetField(transcriptembedding.FieldEndWord, field.TypeInt, value)
+		_node.EndWord = value
+	}
+	if value, ok := tec.mutation.StartTime(); ok {
+		_spec.SetField(transcriptembedding.FieldStartTime, field.TypeFloat64, value)
+		_node.StartTime = value
+	}
+	if value, ok := tec.mutation.EndTime(); ok {
+		_spec.SetField(transcriptembedding.FieldEndTime, field.TypeFloat64, value)
+		_node.EndTime = value
+	}
+	if value, ok := tec.mutation.Text(); ok {
+		_spec.SetField(transcriptembedding.FieldText, field.TypeString, value)
+		_node.Text = value
+	}
+	if value, ok := tec.mutation.Model(); ok {
+		_spec.SetField(transcriptembedding.FieldModel, field.TypeString, value)
+		_node.Model = value
+	}
+	if value, ok := tec.mutation.SourceHash(); ok {
+		_spec.SetField(transcriptembedding.FieldSourceHash, field.TypeString, value)
+		_node.SourceHash = value
+	}
+	if value, ok := tec.mutation.Embedding(); ok {
+		_spec.SetField(transcriptembedding.FieldEmbedding, field.TypeJSON, value)
+		_node.Embedding = value
+	}
+	if value, ok := tec.mutation.CreatedAt(); ok {
+		_spec.SetField(transcriptembedding.FieldCreatedAt, field.TypeTime, value)
+		_node.CreatedAt = value
+	}
+	if nodes := tec.mutation.VideoClipIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   transcriptembedding.VideoClipTable,
+			Columns: []string{transcriptembedding.VideoClipColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(videoclip.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_node.VideoClipID = nodes[0]
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	return _node, _spec
+}
+
+// TranscriptEmbeddingCreateBulk is the builder for creating many TranscriptEmbedding entities in bulk.
+type TranscriptEmbeddingCreateBulk struct {
+	config
+	err      error
+	builders []*TranscriptEmbeddingCreate
+}
+
+// Save creates the TranscriptEmbedding entities in the database.
+func (tecb *TranscriptEmbeddingCreateBulk) Save(ctx context.Context) ([]*TranscriptEmbedding, error) {
+	if tecb.err != nil {
+		return nil, tecb.err
+	}
+	specs := make([]*sqlgraph.CreateSpec, len(tecb.builders))
+	nodes := make([]*TranscriptEmbedding, len(tecb.builders))
+	mutators := make([]Mutator, len(tecb.builders))
+	for i := range tecb.builders {
+		func(i int, root context.Context) {
+			builder := tecb.builders[i]
+			builder.defaults()
+			var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+				mutation, ok := m.(*TranscriptEmbeddingMutation)
+				if !ok {
+					return nil, fmt.Errorf("unexpected mutation type %T", m)
+				}
+				if err := builder.check(); err != nil {
+					return nil, err
+				}
+				builder.mutation = mutation
+				var err error
+				nodes[i], specs[i] = builder.createSpec()
+				if i < len(mutators)-1 {
+					_, err = mutators[i+1].Mutate(root, tecb.builders[i+1].mutation)
+				} else {
+					spec := &sqlgraph.BatchCreateSpec{Nodes: specs}
+					// Invoke the actual operation on the latest mutation in the chain.
+					if err = sqlgraph.BatchCreate(ctx, tecb.driver, spec); err != nil {
+						if sqlgraph.IsConstraintError(err) {
+							err = &ConstraintError{msg: err.Error(), wrap: err}
+						}
+					}
+				}
+				if err != nil {
+					return nil, err
+				}
+				mutation.id = &nodes[i].ID
+				if specs[i].ID.Value != nil {
+					id := specs[i].ID.Value.(int64)
+					nodes[i].ID = int(id)
+				}
+				mutation.done = true
+				return nodes[i], nil
+			})
+			for i := len(builder.hooks) - 1; i >= 0; i-- {
+				mut = builder.hooks[i](mut)
+			}
+			mutators[i] = mut
+		}(i, ctx)
+	}
+	if len(mutators) > 0 {
+		if _, err := mutators[0].Mutate(ctx, tecb.builders[0].mutation); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (tecb *TranscriptEmbeddingCreateBulk) SaveX(ctx context.Context) []*TranscriptEmbedding {
+	v, err := tecb.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (tecb *TranscriptEmbeddingCreateBulk) Exec(ctx context.Context) error {
+	_, err := tecb.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (tecb *TranscriptEmbeddingCreateBulk) ExecX(ctx context.Context) {
+	if err := tecb.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
@@ -0,0 +1,88 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"ramble-ai/ent/predicate"
+	"ramble-ai/ent/transcriptembedding"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+)
+
+// TranscriptEmbeddingDelete is the builder for deleting a TranscriptEmbedding entity.
+type TranscriptEmbeddingDelete struct {
+	config
+	hooks    []Hook
+	mutation *TranscriptEmbeddingMutation
+}
+
+// Where appends a list predicates to the TranscriptEmbeddingDelete builder.
+func (ted *TranscriptEmbeddingDelete) Where(ps ...predicate.TranscriptEmbedding) *TranscriptEmbeddingDelete {
+	ted.mutation.Where(ps...)
+	return ted
+}
+
+// Exec executes the deletion query and returns how many vertices were deleted.
+func (ted *TranscriptEmbeddingDelete) Exec(ctx context.Context) (int, error) {
+	return withHooks(ctx, ted.sqlExec, ted.mutation, ted.hooks)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (ted *TranscriptEmbeddingDelete) ExecX(ctx context.Context) int {
+	n, err := ted.Exec(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return n
+}
+
+func (ted *TranscriptEmbeddingDelete) sqlExec(ctx context.Context) (int, error) {
+	_spec := sqlgraph.NewDeleteSpec(transcriptembedding.Table, sqlgraph.NewFieldSpec(transcriptembedding.FieldID, field.TypeInt))
+	if ps := ted.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	affected, err := sqlgraph.DeleteNodes(ctx, ted.driver, _spec)
+	if err != nil && sqlgraph.IsConstraintError(err) {
+		err = &ConstraintError{msg: err.Error(), wrap: err}
+	}
+	ted.mutation.done = true
+	return affected, err
+}
+
+// TranscriptEmbeddingDeleteOne is the builder for deleting a single TranscriptEmbedding entity.
+type TranscriptEmbeddingDeleteOne struct {
+	ted *TranscriptEmbeddingDelete
+}
+
+// Where appends a list predicates to the TranscriptEmbeddingDelete builder.
+func (tedo *TranscriptEmbeddingDeleteOne) Where(ps ...predicate.TranscriptEmbedding) *TranscriptEmbeddingDeleteOne {
+	tedo.ted.mutation.Where(ps...)
+	return tedo
+}
+
+// Exec executes the deletion query.
+func (tedo *TranscriptEmbeddingDeleteOne) Exec(ctx context.Context) error {
+	n, err := tedo.ted.Exec(ctx)
+	switch {
+	case err != nil:
+		return err
+	case n == 0:
+		return &NotFoundError{transcriptembedding.Label}
+	default:
+		return nil
+	}
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (tedo *TranscriptEmbeddingDeleteOne) ExecX(ctx context.Context) {
+	if err := tedo.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
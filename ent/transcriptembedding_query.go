@@ -0,0 +1,606 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"fmt"
+	"math"
+	"ramble-ai/ent/predicate"
+	"ramble-ai/ent/transcriptembedding"
+	"ramble-ai/ent/videoclip"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+)
+
+// TranscriptEmbeddingQuery is the builder for querying TranscriptEmbedding entities.
+type TranscriptEmbeddingQuery struct {
+	config
+	ctx           *QueryContext
+	order         []transcriptembedding.OrderOption
+	inters        []Interceptor
+	predicates    []predicate.TranscriptEmbedding
+	withVideoClip *VideoClipQuery
+	// intermediate query (i.e. traversal path).
+	sql  *sql.Selector
+	path func(context.Context) (*sql.Selector, error)
+}
+
+// Where adds a new predicate for the TranscriptEmbeddingQuery builder.
+func (teq *TranscriptEmbeddingQuery) Where(ps ...predicate.TranscriptEmbedding) *TranscriptEmbeddingQuery {
+	teq.predicates = append(teq.predicates, ps...)
+	return teq
+}
+
+// Limit the number of records to be returned by this query.
+func (teq *TranscriptEmbeddingQuery) Limit(limit int) *TranscriptEmbeddingQuery {
+	teq.ctx.Limit = &limit
+	return teq
+}
+
+// Offset to start from.
+func (teq *TranscriptEmbeddingQuery) Offset(offset int) *TranscriptEmbeddingQuery {
+	teq.ctx.Offset = &offset
+	return teq
+}
+
+// Unique configures the query builder to filter duplicate records on query.
+// By default, unique is set to true, and can be disabled using this method.
+func (teq *TranscriptEmbeddingQuery) Unique(unique bool) *TranscriptEmbeddingQuery {
+	teq.ctx.Unique = &unique
+	return teq
+}
+
+// Order specifies how the records should be ordered.
+func (teq *TranscriptEmbeddingQuery) Order(o ...transcriptembedding.OrderOption) *TranscriptEmbeddingQuery {
+	teq.order = append(teq.order, o...)
+	return teq
+}
+
+// QueryVideoClip chains the current query on the "video_clip" edge.
+func (teq *TranscriptEmbeddingQuery) QueryVideoClip() *VideoClipQuery {
+	query := (&VideoClipClient{config: teq.config}).Query()
+	query.path = func(ctx context.Context) (fromU *sql.Selector, err error) {
+		if err := teq.prepareQuery(ctx); err != nil {
+			return nil, err
+		}
+		selector := teq.sqlQuery(ctx)
+		if err := selector.Err(); err != nil {
+			return nil, err
+		}
+		step := sqlgraph.NewStep(
+			sqlgraph.From(transcriptembedding.Table, transcriptembedding.FieldID, selector),
+			sqlgraph.To(videoclip.Table, videoclip.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, transcriptembedding.VideoClipTable, transcriptembedding.VideoClipColumn),
+		)
+		fromU = sqlgraph.SetNeighbors(teq.driver.Dialect(), step)
+		return fromU, nil
+	}
+	return query
+}
+
+// First returns the first TranscriptEmbedding entity from the query.
+// Returns a *NotFoundError when no TranscriptEmbedding was found.
+func (teq *TranscriptEmbeddingQuery) First(ctx context.Context) (*TranscriptEmbedding, error) {
+	nodes, err := teq.Limit(1).All(setContextOp(ctx, teq.ctx, ent.OpQueryFirst))
+	if err != nil {
+		return nil, err
+	}
+	if len(nodes) == 0 {
+		return nil, &NotFoundError{transcriptembedding.Label}
+	}
+	return nodes[0], nil
+}
+
+// FirstX is like First, but panics if an error occurs.
+func (teq *TranscriptEmbeddingQuery) FirstX(ctx context.Context) *TranscriptEmbedding {
+	node, err := teq.First(ctx)
+	if err != nil && !IsNotFound(err) {
+		panic(err)
+	}
+	return node
+}
+
+// FirstID returns the first TranscriptEmbedding ID from the query.
+// Returns a *NotFoundError when no TranscriptEmbedding ID was found.
+func (teq *TranscriptEmbeddingQuery) FirstID(ctx context.Context) (id int, err error) {
+	var ids []int
+	if ids, err = teq.Limit(1).IDs(setContextOp(ctx, teq.ctx, ent.OpQueryFirstID)); err != nil {
+		return
+	}
+	if len(ids) == 0 {
+		err = &NotFoundError{transcriptembedding.Label}
+		return
+	}
+	return ids[0], nil
+}
+
+// FirstIDX is like FirstID, but panics if an error occurs.
+func (teq *TranscriptEmbeddingQuery) FirstIDX(ctx context.Context) int {
+	id, err := teq.FirstID(ctx)
+	if err != nil && !IsNotFound(err) {
+		panic(err)
+	}
+	return id
+}
+
+// Only returns a single TranscriptEmbedding entity found by the query, ensuring it only returns one.
+// Returns a *NotSingularError when more than one TranscriptEmbedding entity is found.
+// Returns a *NotFoundError when no TranscriptEmbedding entities are found.
+func (teq *TranscriptEmbeddingQuery) Only(ctx context.Context) (*TranscriptEmbedding, error) {
+	nodes, err := teq.Limit(2).All(setContextOp(ctx, teq.ctx, ent.OpQueryOnly))
+	if err != nil {
+		return nil, err
+	}
+	switch len(nodes) {
+	case 1:
+		return nodes[0], nil
+	case 0:
+		return nil, &NotFoundError{transcriptembedding.Label}
+	default:
+		return nil, &NotSingularError{transcriptembedding.Label}
+	}
+}
+
+// OnlyX is like Only, but panics if an error occurs.
+func (teq *TranscriptEmbeddingQuery) OnlyX(ctx context.Context) *TranscriptEmbedding {
+	node, err := teq.Only(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// OnlyID is like Only, but returns the only TranscriptEmbedding ID in the query.
+// Returns a *NotSingularError when more than one TranscriptEmbedding ID is found.
+// Returns a *NotFoundError when no entities are found.
+func (teq *TranscriptEmbeddingQuery) OnlyID(ctx context.Context) (id int, err error) {
+	var ids []int
+	if ids, err = teq.Limit(2).IDs(setContextOp(ctx, teq.ctx, ent.OpQueryOnlyID)); err != nil {
+		return
+	}
+	switch len(ids) {
+	case 1:
+		id = ids[0]
+	case 0:
+		err = &NotFoundError{transcriptembedding.Label}
+	default:
+		err = &NotSingularError{transcriptembedding.Label}
+	}
+	return
+}
+
+// OnlyIDX is like OnlyID, but panics if an error occurs.
+func (teq *TranscriptEmbeddingQuery) OnlyIDX(ctx context.Context) int {
+	id, err := teq.OnlyID(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return id
+}
+
+// All executes the query and returns a list of TranscriptEmbeddings.
+func (teq *TranscriptEmbeddingQuery) All(ctx context.Context) ([]*TranscriptEmbedding, error) {
+	ctx = setContextOp(ctx, teq.ctx, ent.OpQueryAll)
+	if err := teq.prepareQuery(ctx); err != nil {
+		return nil, err
+	}
+	qr := querierAll[[]*TranscriptEmbedding, *TranscriptEmbeddingQuery]()
+	return withInterceptors[[]*TranscriptEmbedding](ctx, teq, qr, teq.inters)
+}
+
+// AllX is like All, but panics if an error occurs.
+func (teq *TranscriptEmbeddingQuery) AllX(ctx context.Context) []*TranscriptEmbedding {
+	nodes, err := teq.All(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return nodes
+}
+
+// IDs executes the query and returns a list of TranscriptEmbedding IDs.
+func (teq *TranscriptEmbeddingQuery) IDs(ctx context.Context) (ids []int, err error) {
+	if teq.ctx.Unique == nil && teq.path != nil {
+		teq.Unique(true)
+	}
+	ctx = setContextOp(ctx, teq.ctx, ent.OpQueryIDs)
+	if err = teq.Select(transcriptembedding.FieldID).Scan(ctx, &ids); err != nil {
+		return nil, err
+	}
+	return ids, nil
+}
+
+// IDsX is like IDs, but panics if an error occurs.
+func (teq *TranscriptEmbeddingQuery) IDsX(ctx context.Context) []int {
+	ids, err := teq.IDs(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return ids
+}
+
+// Count returns the count of the given query.
+func (teq *TranscriptEmbeddingQuery) Count(ctx context.Context) (int, error) {
+	ctx = setContextOp(ctx, teq.ctx, ent.OpQueryCount)
+	if err := teq.prepareQuery(ctx); err != nil {
+		return 0, err
+	}
+	return withInterceptors[int](ctx, teq, querierCount[*TranscriptEmbeddingQuery](), teq.inters)
+}
+
+// CountX is like Count, but panics if an error occurs.
+func (teq *TranscriptEmbeddingQuery) CountX(ctx context.Context) int {
+	count, err := teq.Count(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return count
+}
+
+// Exist returns true if the query has elements in the graph.
+func (teq *TranscriptEmbeddingQuery) Exist(ctx context.Context) (bool, error) {
+	ctx = setContextOp(ctx, teq.ctx, ent.OpQueryExist)
+	switch _, err := teq.FirstID(ctx); {
+	case IsNotFound(err):
+		return false, nil
+	case err != nil:
+		return false, fmt.Errorf("ent: check existence: %w", err)
+	default:
+		return true, nil
+	}
+}
+
+// ExistX is like Exist, but panics if an error occurs.
+func (teq *TranscriptEmbeddingQuery) ExistX(ctx context.Context) bool {
+	exist, err := teq.Exist(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return exist
+}
+
+// Clone returns a duplicate of the TranscriptEmbeddingQuery builder, including all associated steps. It can be
+// used to prepare common query builders and use them differently after the clone is made.
+func (teq *TranscriptEmbeddingQuery) Clone() *TranscriptEmbeddingQuery {
+	if teq == nil {
+		return nil
+	}
+	return &TranscriptEmbeddingQuery{
+		config:        teq.config,
+		ctx:           teq.ctx.Clone(),
+		order:         append([]transcriptembedding.OrderOption{}, teq.order...),
+		inters:        append([]Interceptor{}, teq.inters...),
+		predicates:    append([]predicate.TranscriptEmbedding{}, teq.predicates...),
+		withVideoClip: teq.withVideoClip.Clone(),
+		// clone intermediate query.
+		sql:  teq.sql.Clone(),
+		path: teq.path,
+	}
+}
+
+// WithVideoClip tells the query-builder to eager-load the nodes that are connected to
+// the "video_clip" edge. The optional arguments are used to configure the query builder of the edge.
+func (teq *TranscriptEmbeddingQuery) WithVideoClip(opts ...func(*VideoClipQuery)) *TranscriptEmbeddingQuery {
+	query := (&VideoClipClient{config: teq.config}).Query()
+	for _, opt := range opts {
+		opt(query)
+	}
+	teq.withVideoClip = query
+	return teq
+}
+
+// GroupBy is used to group vertices by one or more fields/columns.
+// It is often used with aggregate functions, like: count, max, mean, min, sum.
+//
+// Example:
+//
+//	var v []struct {
+//		VideoClipID int `json:"video_clip_id,omitempty"`
+//		Count int `json:"count,omitempty"`
+//	}
+//
+//	client.TranscriptEmbedding.Query().
+//		GroupBy(transcriptembedding.FieldVideoClipID).
+//		Aggregate(ent.Count()).
+//		Scan(ctx, &v)
+func (teq *TranscriptEmbeddingQuery) GroupBy(field string, fields ...string) *TranscriptEmbeddingGroupBy {
+	teq.ctx.Fields = append([]string{field}, fields...)
+	grbuild := &TranscriptEmbeddingGroupBy{build: teq}
+	grbuild.flds = &teq.ctx.Fields
+	grbuild.label = transcriptembedding.Label
+	grbuild.scan = grbuild.Scan
+	return grbuild
+}
+
+// Select allows the selection one or more fields/columns for the given query,
+// instead of selecting all fields in the entity.
+//
+// Example:
+//
+//	var v []struct {
+//		VideoClipID int `json:"video_clip_id,omitempty"`
+//	}
+//
+//	client.TranscriptEmbedding.Query().
+//		Select(transcriptembedding.FieldVideoClipID).
+//		Scan(ctx, &v)
+func (teq *TranscriptEmbeddingQuery) Select(fields ...string) *TranscriptEmbeddingSelect {
+	teq.ctx.Fields = append(teq.ctx.Fields, fields...)
+	sbuild := &TranscriptEmbeddingSelect{TranscriptEmbeddingQuery: teq}
+	sbuild.label = transcriptembedding.Label
+	sbuild.flds, sbuild.scan = &teq.ctx.Fields, sbuild.Scan
+	return sbuild
+}
+
+// Aggregate returns a TranscriptEmbeddingSelect configured with the given aggregations.
+func (teq *TranscriptEmbeddingQuery) Aggregate(fns ...AggregateFunc) *TranscriptEmbeddingSelect {
+	return teq.Select().Aggregate(fns...)
+}
+
+func (teq *TranscriptEmbeddingQuery) prepareQuery(ctx context.Context) error {
+	for _, inter := range teq.inters {
+		if inter == nil {
+			return fmt.Errorf("ent: uninitialized interceptor (forgotten import ent/runtime?)")
+		}
+		if trv, ok := inter.(Traverser); ok {
+			if err := trv.Traverse(ctx, teq); err != nil {
+				return err
+			}
+		}
+	}
+	for _, f := range teq.ctx.Fields {
+		if !transcriptembedding.ValidColumn(f) {
+			return &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+		}
+	}
+	if teq.path != nil {
+		prev, err := teq.path(ctx)
+		if err != nil {
+			return err
+		}
+		teq.sql = prev
+	}
+	return nil
+}
+
+func (teq *TranscriptEmbeddingQuery) sqlAll(ctx context.Context, hooks ...queryHook) ([]*TranscriptEmbedding, error) {
+	var (
+		nodes       = []*TranscriptEmbedding{}
+		_spec       = teq.querySpec()
+		loadedTypes = [1]bool{
+			teq.withVideoClip != nil,
+		}
+	)
+	_spec.ScanValues = func(columns []string) ([]any, error) {
+		return (*TranscriptEmbedding).scanValues(nil, columns)
+	}
+	_spec.Assign = func(columns []string, values []any) error {
+		node := &TranscriptEmbedding{config: teq.config}
+		nodes = append(nodes, node)
+		node.Edges.loadedTypes = loadedTypes
+		return node.assignValues(columns, values)
+	}
+	for i := range hooks {
+		hooks[i](ctx, _spec)
+	}
+	if err := sqlgraph.QueryNodes(ctx, teq.driver, _spec); err != nil {
+		return nil, err
+	}
+	if len(nodes) == 0 {
+		return nodes, nil
+	}
+	if query := teq.withVideoClip; query != nil {
+		if err := teq.loadVideoClip(ctx, query, nodes, nil,
+			func(n *TranscriptEmbedding, e *VideoClip) { n.Edges.VideoClip = e }); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+func (teq *TranscriptEmbeddingQuery) loadVideoClip(ctx context.Context, query *VideoClipQuery, nodes []*TranscriptEmbedding, init func(*TranscriptEmbedding), assign func(*TranscriptEmbedding, *VideoClip)) error {
+	ids := make([]int, 0, len(nodes))
+	nodeids := make(map[int][]*TranscriptEmbedding)
+	for i := range nodes {
+		fk := nodes[i].VideoClipID
+		if _, ok := nodeids[fk]; !ok {
+			ids = append(ids, fk)
+		}
+		nodeids[fk] = append(nodeids[fk], nodes[i])
+	}
+	if len(ids) == 0 {
+		return nil
+	}
+	query.Where(videoclip.IDIn(ids...))
+	neighbors, err := query.All(ctx)
+	if err != nil {
+		return err
+	}
+	for _, n := range neighbors {
+		nodes, ok := nodeids[n.ID]
+		if !ok {
+			return fmt.Errorf(`unexpected foreign-key "video_clip_id" returned %v`, n.ID)
+		}
+		for i := range nodes {
+			assign(nodes[i], n)
+		}
+	}
+	return nil
+}
+
+func (teq *TranscriptEmbeddingQuery) sqlCount(ctx context.Context) (int, error) {
+	_spec := teq.querySpec()
+	_spec.Node.Columns = teq.ctx.Fields
+	if len(teq.ctx.Fields) > 0 {
+		_spec.Unique = teq.ctx.Unique != nil && *teq.ctx.Unique
+	}
+	return sqlgraph.CountNodes(ctx, teq.driver, _spec)
+}
+
+func (teq *TranscriptEmbeddingQuery) querySpec() *sqlgraph.QuerySpec {
+	_spec := sqlgraph.NewQuerySpec(transcriptembedding.Table, transcriptembedding.Columns, sqlgraph.NewFieldSpec(transcriptembedding.FieldID, field.TypeInt))
+	_spec.From = teq.sql
+	if unique := teq.ctx.Unique; unique != nil {
+		_spec.Unique = *unique
+	} else if teq.path != nil {
+		_spec.Unique = true
+	}
+	if fields := teq.ctx.Fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, transcriptembedding.FieldID)
+		for i := range fields {
+			if fields[i] != transcriptembedding.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, fields[i])
+			}
+		}
+		if teq.withVideoClip != nil {
+			_spec.Node.AddColumnOnce(transcriptembedding.FieldVideoClipID)
+		}
+	}
+	if ps := teq.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if limit := teq.ctx.Limit; limit != nil {
+		_spec.Limit = *limit
+	}
+	if offset := teq.ctx.Offset; offset != nil {
+		_spec.Offset = *offset
+	}
+	if ps := teq.order; len(ps) > 0 {
+		_spec.Order = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	return _spec
+}
+
+func (teq *TranscriptEmbeddingQuery) sqlQuery(ctx context.Context) *sql.Selector {
+	builder := sql.Dialect(teq.driver.Dialect())
+	t1 := builder.Table(transcriptembedding.Table)
+	columns := teq.ctx.Fields
+	if len(columns) == 0 {
+		columns = transcriptembedding.Columns
+	}
+	selector := builder.Select(t1.Columns(columns...)...).From(t1)
+	if teq.sql != nil {
+		selector = teq.sql
+		selector.Select(selector.Columns(columns...)...)
+	}
+	if teq.ctx.Unique != nil && *teq.ctx.Unique {
+		selector.Distinct()
+	}
+	for _, p := range teq.predicates {
+		p(selector)
+	}
+	for _, p := range teq.order {
+		p(selector)
+	}
+	if offset := teq.ctx.Offset; offset != nil {
+		// limit is mandatory for offset clause. We start
+		// with default value, and override it below if needed.
+		selector.Offset(*offset).Limit(math.MaxInt32)
+	}
+	if limit := teq.ctx.Limit; limit != nil {
+		selector.Limit(*limit)
+	}
+	return selector
+}
+
+// TranscriptEmbeddingGroupBy is the group-by builder for TranscriptEmbedding entities.
+type TranscriptEmbeddingGroupBy struct {
+	selector
+	build *TranscriptEmbeddingQuery
+}
+
+// Aggregate adds the given aggregation functions to the group-by query.
+func (tegb *TranscriptEmbeddingGroupBy) Aggregate(fns ...AggregateFunc) *TranscriptEmbeddingGroupBy {
+	tegb.fns = append(tegb.fns, fns...)
+	return tegb
+}
+
+// Scan applies the selector query and scans the result into the given value.
+func (tegb *TranscriptEmbeddingGroupBy) Scan(ctx context.Context, v any) error {
+	ctx = setContextOp(ctx, tegb.build.ctx, ent.OpQueryGroupBy)
+	if err := tegb.build.prepareQuery(ctx); err != nil {
+		return err
+	}
+	return scanWithInterceptors[*TranscriptEmbeddingQuery, *TranscriptEmbeddingGroupBy](ctx, tegb.build, tegb, tegb.build.inters, v)
+}
+
+func (tegb *TranscriptEmbeddingGroupBy) sqlScan(ctx context.Context, root *TranscriptEmbeddingQuery, v any) error {
+	selector := root.sqlQuery(ctx).Select()
+	aggregation := make([]string, 0, len(tegb.fns))
+	for _, fn := range tegb.fns {
+		aggregation = append(aggregation, fn(selector))
+	}
+	if len(selector.SelectedColumns()) == 0 {
+		columns := make([]string, 0, len(*tegb.flds)+len(tegb.fns))
+		for _, f := range *tegb.flds {
+			columns = append(columns, selector.C(f))
+		}
+		columns = append(columns, aggregation...)
+		selector.Select(columns...)
+	}
+	selector.GroupBy(selector.Columns(*tegb.flds...)...)
+	if err := selector.Err(); err != nil {
+		return err
+	}
+	rows := &sql.Rows{}
+	query, args := selector.Query()
+	if err := tegb.build.driver.Query(ctx, query, args, rows); err != nil {
+		return err
+	}
+	defer rows.Close()
+	return sql.ScanSlice(rows, v)
+}
+
+// TranscriptEmbeddingSelect is the builder for selecting fields of TranscriptEmbedding entities.
+type TranscriptEmbeddingSelect struct {
+	*TranscriptEmbeddingQuery
+	selector
+}
+
+// Aggregate adds the given aggregation functions to the selector query.
+func (tes *TranscriptEmbeddingSelect) Aggregate(fns ...AggregateFunc) *TranscriptEmbeddingSelect {
+	tes.fns = append(tes.fns, fns...)
+	return tes
+}
+
+// Scan applies the selector query and scans the result into the given value.
+func (tes *TranscriptEmbeddingSelect) Scan(ctx context.Context, v any) error {
+	ctx = setContextOp(ctx, tes.ctx, ent.OpQuerySelect)
+	if err := tes.prepareQuery(ctx); err != nil {
+		return err
+	}
+	return scanWithInterceptors[*TranscriptEmbeddingQuery, *TranscriptEmbeddingSelect](ctx, tes.TranscriptEmbeddingQuery, tes, tes.inters, v)
+}
+
+func (tes *TranscriptEmbeddingSelect) sqlScan(ctx context.Context, root *TranscriptEmbeddingQuery, v any) error {
+	selector := root.sqlQuery(ctx)
+	aggregation := make([]string, 0, len(tes.fns))
+	for _, fn := range tes.fns {
+		aggregation = append(aggregation, fn(selector))
+	}
+	switch n := len(*tes.selector.flds); {
+	case n == 0 && len(aggregation) > 0:
+		selector.Select(aggregation...)
+	case n != 0 && len(aggregation) > 0:
+		selector.AppendSelect(aggregation...)
+	}
+	rows := &sql.Rows{}
+	query, args := selector.Query()
+	if err := tes.driver.Query(ctx, query, args, rows); err != nil {
+		return err
+	}
+	defer rows.Close()
+	return sql.ScanSlice(rows, v)
+}
@@ -0,0 +1,804 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"ramble-ai/ent/predicate"
+	"ramble-ai/ent/transcriptembedding"
+	"ramble-ai/ent/videoclip"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/dialect/sql/sqljson"
+	"entgo.io/ent/schema/field"
+)
+
+// TranscriptEmbeddingUpdate is the builder for updating TranscriptEmbedding entities.
+type TranscriptEmbeddingUpdate struct {
+	config
+	hooks    []Hook
+	mutation *TranscriptEmbeddingMutation
+}
+
+// Where appends a list predicates to the TranscriptEmbeddingUpdate builder.
+func (teu *TranscriptEmbeddingUpdate) Where(ps ...predicate.TranscriptEmbedding) *TranscriptEmbeddingUpdate {
+	teu.mutation.Where(ps...)
+	return teu
+}
+
+// SetVideoClipID sets the "video_clip_id" field.
+func (teu *TranscriptEmbeddingUpdate) SetVideoClipID(i int) *TranscriptEmbeddingUpdate {
+	teu.mutation.SetVideoClipID(i)
+	return teu
+}
+
+// SetNillableVideoClipID sets the "video_clip_id" field if the given value is not nil.
+func (teu *TranscriptEmbeddingUpdate) SetNillableVideoClipID(i *int) *TranscriptEmbeddingUpdate {
+	if i != nil {
+		teu.SetVideoClipID(*i)
+	}
+	return teu
+}
+
+// SetChunkIndex sets the "chunk_index" field.
+func (teu *TranscriptEmbeddingUpdate) SetChunkIndex(i int) *TranscriptEmbeddingUpdate {
+	teu.mutation.ResetChunkIndex()
+	teu.mutation.SetChunkIndex(i)
+	return teu
+}
+
+// SetNillableChunkIndex sets the "chunk_index" field if the given value is not nil.
+func (teu *TranscriptEmbeddingUpdate) SetNillableChunkIndex(i *int) *TranscriptEmbeddingUpdate {
+	if i != nil {
+		teu.SetChunkIndex(*i)
+	}
+	return teu
+}
+
+// AddChunkIndex adds i to the "chunk_index" field.
+func (teu *TranscriptEmbeddingUpdate) AddChunkIndex(i int) *TranscriptEmbeddingUpdate {
+	teu.mutation.AddChunkIndex(i)
+	return teu
+}
+
+// SetStartWord sets the "start_word" field.
+func (teu *TranscriptEmbeddingUpdate) SetStartWord(i int) *TranscriptEmbeddingUpdate {
+	teu.mutation.ResetStartWord()
+	teu.mutation.SetStartWord(i)
+	return teu
+}
+
+// SetNillableStartWord sets the "start_word" field if the given value is not nil.
+func (teu *TranscriptEmbeddingUpdate) SetNillableStartWord(i *int) *TranscriptEmbeddingUpdate {
+	if i != nil {
+		teu.SetStartWord(*i)
+	}
+	return teu
+}
+
+// AddStartWord adds i to the "start_word" field.
+func (teu *TranscriptEmbeddingUpdate) AddStartWord(i int) *TranscriptEmbeddingUpdate {
+	teu.mutation.AddStartWord(i)
+	return teu
+}
+
+// SetEndWord sets the "end_word" field.
+func (teu *TranscriptEmbeddingUpdate) SetEndWord(i int) *TranscriptEmbeddingUpdate {
+	teu.mutation.ResetEndWord()
+	teu.mutation.SetEndWord(i)
+	return teu
+}
+
+// SetNillableEndWord sets the "end_word" field if the given value is not nil.
+func (teu *TranscriptEmbeddingUpdate) SetNillableEndWord(i *int) *TranscriptEmbeddingUpdate {
+	if i != nil {
+		teu.SetEndWord(*i)
+	}
+	return teu
+}
+
+// AddEndWord adds i to the "end_word" field.
+func (teu *TranscriptEmbeddingUpdate) AddEndWord(i int) *TranscriptEmbeddingUpdate {
+	teu.mutation.AddEndWord(i)
+	return teu
+}
+
+// SetStartTime sets the "start_time" field.
+func (teu *TranscriptEmbeddingUpdate) SetStartTime(f float64) *TranscriptEmbeddingUpdate {
+	teu.mutation.ResetStartTime()
+	teu.mutation.SetStartTime(f)
+	return teu
+}
+
+// SetNillableStartTime sets the "start_time" field if the given value is not nil.
+func (teu *TranscriptEmbeddingUpdate) SetNillableStartTime(f *float64) *TranscriptEmbeddingUpdate {
+	if f != nil {
+		teu.SetStartTime(*f)
+	}
+	return teu
+}
+
+// AddStartTime adds f to the "start_time" field.
+func (teu *TranscriptEmbeddingUpdate) AddStartTime(f float64) *TranscriptEmbeddingUpdate {
+	teu.mutation.AddStartTime(f)
+	return teu
+}
+
+// SetEndTime sets the "end_time" field.
+func (teu *TranscriptEmbeddingUpdate) SetEndTime(f float64) *TranscriptEmbeddingUpdate {
+	teu.mutation.ResetEndTime()
+	teu.mutation.SetEndTime(f)
+	return teu
+}
+
+// SetNillableEndTime sets the "end_time" field if the given value is not nil.
+func (teu *TranscriptEmbeddingUpdate) SetNillableEndTime(f *float64) *TranscriptEmbeddingUpdate {
+	if f != nil {
+		teu.SetEndTime(*f)
+	}
+	return teu
+}
+
+// AddEndTime adds f to the "end_time" field.
+func (teu *TranscriptEmbeddingUpdate) AddEndTime(f float64) *TranscriptEmbeddingUpdate {
+	teu.mutation.AddEndTime(f)
+	return teu
+}
+
+// SetText sets the "text" field.
+func (teu *TranscriptEmbeddingUpdate) SetText(s string) *TranscriptEmbeddingUpdate {
+	teu.mutation.SetText(s)
+	return teu
+}
+
+// SetNillableText sets the "text" field if the given value is not nil.
+func (teu *TranscriptEmbeddingUpdate) SetNillableText(s *string) *TranscriptEmbeddingUpdate {
+	if s != nil {
+		teu.SetText(*s)
+	}
+	return teu
+}
+
+// SetModel sets the "model" field.
+func (teu *TranscriptEmbeddingUpdate) SetModel(s string) *TranscriptEmbeddingUpdate {
+	teu.mutation.SetModel(s)
+	return teu
+}
+
+// SetNillableModel sets the "model" field if the given value is not nil.
+func (teu *TranscriptEmbeddingUpdate) SetNillableModel(s *string) *TranscriptEmbeddingUpdate {
+	if s != nil {
+		teu.SetModel(*s)
+	}
+	return teu
+}
+
+// SetSourceHash sets the "source_hash" field.
+func (teu *TranscriptEmbeddingUpdate) SetSourceHash(s string) *TranscriptEmbeddingUpdate {
+	teu.mutation.SetSourceHash(s)
+	return teu
+}
+
+// SetNillableSourceHash sets the "source_hash" field if the given value is not nil.
+func (teu *TranscriptEmbeddingUpdate) SetNillableSourceHash(s *string) *TranscriptEmbeddingUpdate {
+	if s != nil {
+		teu.SetSourceHash(*s)
+	}
+	return teu
+}
+
+// SetEmbedding sets the "embedding" field.
+func (teu *TranscriptEmbeddingUpdate) SetEmbedding(f []float32) *TranscriptEmbeddingUpdate {
+	teu.mutation.SetEmbedding(f)
+	return teu
+}
+
+// AppendEmbedding appends f to the "embedding" field.
+func (teu *TranscriptEmbeddingUpdate) AppendEmbedding(f []float32) *TranscriptEmbeddingUpdate {
+	teu.mutation.AppendEmbedding(f)
+	return teu
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (teu *TranscriptEmbeddingUpdate) SetCreatedAt(t time.Time) *TranscriptEmbeddingUpdate {
+	teu.mutation.SetCreatedAt(t)
+	return teu
+}
+
+// SetNillableCreatedAt sets the "created_at" field if the given value is not nil.
+func (teu *TranscriptEmbeddingUpdate) SetNillableCreatedAt(t *time.Time) *TranscriptEmbeddingUpdate {
+	if t != nil {
+		teu.SetCreatedAt(*t)
+	}
+	return teu
+}
+
+// SetVideoClip sets the "video_clip" edge to the VideoClip entity.
+func (teu *TranscriptEmbeddingUpdate) SetVideoClip(v *VideoClip) *TranscriptEmbeddingUpdate {
+	return teu.SetVideoClipID(v.ID)
+}
+
+// Mutation returns the TranscriptEmbeddingMutation object of the builder.
+func (teu *TranscriptEmbeddingUpdate) Mutation() *TranscriptEmbeddingMutation {
+	return teu.mutation
+}
+
+// ClearVideoClip clears the "video_clip" edge to the VideoClip entity.
+func (teu *TranscriptEmbeddingUpdate) ClearVideoClip() *TranscriptEmbeddingUpdate {
+	teu.mutation.ClearVideoClip()
+	return teu
+}
+
+// Save executes the query and returns the number of nodes affected by the update operation.
+func (teu *TranscriptEmbeddingUpdate) Save(ctx context.Context) (int, error) {
+	return withHooks(ctx, teu.sqlSave, teu.mutation, teu.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (teu *TranscriptEmbeddingUpdate) SaveX(ctx context.Context) int {
+	affected, err := teu.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return affected
+}
+
+// Exec executes the query.
+func (teu *TranscriptEmbeddingUpdate) Exec(ctx context.Context) error {
+	_, err := teu.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (teu *TranscriptEmbeddingUpdate) ExecX(ctx context.Context) {
+	if err := teu.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (teu *TranscriptEmbeddingUpdate) check() error {
+	if v, ok := teu.mutation.ChunkIndex(); ok {
+		if err := transcriptembedding.ChunkIndexValidator(v); err != nil {
+			return &ValidationError{Name: "chunk_index", err: fmt.Errorf(`ent: validator failed for field "TranscriptEmbedding.chunk_index": %w`, err)}
+		}
+	}
+	if v, ok := teu.mutation.StartWord(); ok {
+		if err := transcriptembedding.StartWordValidator(v); err != nil {
+			return &ValidationError{Name: "start_word", err: fmt.Errorf(`ent: validator failed for field "TranscriptEmbedding.start_word": %w`, err)}
+		}
+	}
+	if v, ok := teu.mutation.EndWord(); ok {
+		if err := transcriptembedding.EndWordValidator(v); err != nil {
+			return &ValidationError{Name: "end_word", err: fmt.Errorf(`ent: validator failed for field "TranscriptEmbedding.end_word": %w`, err)}
+		}
+	}
+	if v, ok := teu.mutation.Model(); ok {
+		if err := transcriptembedding.ModelValidator(v); err != nil {
+			return &ValidationError{Name: "model", err: fmt.Errorf(`ent: validator failed for field "TranscriptEmbedding.model": %w`, err)}
+		}
+	}
+	if v, ok := teu.mutation.SourceHash(); ok {
+		if err := transcriptembedding.SourceHashValidator(v); err != nil {
+			return &ValidationError{Name: "source_hash", err: fmt.Errorf(`ent: validator failed for field "TranscriptEmbedding.source_hash": %w`, err)}
+		}
+	}
+	if teu.mutation.VideoClipCleared() && len(teu.mutation.VideoClipIDs()) > 0 {
+		return errors.New(`ent: clearing a required unique edge "TranscriptEmbedding.video_clip"`)
+	}
+	return nil
+}
+
+func (teu *TranscriptEmbeddingUpdate) sqlSave(ctx context.Context) (n int, err error) {
+	if err := teu.check(); err != nil {
+		return n, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(transcriptembedding.Table, transcriptembedding.Columns, sqlgraph.NewFieldSpec(transcriptembedding.FieldID, field.TypeInt))
+	if ps := teu.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := teu.mutation.ChunkIndex(); ok {
+		_spec.SetField(transcriptembedding.FieldChunkIndex, field.TypeInt, value)
+	}
+	if value, ok := teu.mutation.AddedChunkIndex(); ok {
+		_spec.AddField(transcriptembedding.FieldChunkIndex, field.TypeInt, value)
+	}
+	if value, ok := teu.mutation.StartWord(); ok {
+		_spec.SetField(transcriptembedding.FieldStartWord, field.TypeInt, value)
+	}
+	if value, ok := teu.mutation.AddedStartWord(); ok {
+		_spec.AddField(transcriptembedding.FieldStartWord, field.TypeInt, value)
+	}
+	if value, ok := teu.mutation.EndWord(); ok {
+		_spec.SetField(transcriptembedding.FieldEndWord, field.TypeInt, value)
+	}
+	if value, ok := teu.mutation.AddedEndWord(); ok {
+		_spec.AddField(transcriptembedding.FieldEndWord, field.TypeInt, value)
+	}
+	if value, ok := teu.mutation.StartTime(); ok {
+		_spec.SetField(transcriptembedding.FieldStartTime, field.TypeFloat64, value)
+	}
+	if value, ok := teu.mutation.AddedStartTime(); ok {
+		_spec.AddField(transcriptembedding.FieldStartTime, field.TypeFloat64, value)
+	}
+	if value, ok := teu.mutation.EndTime(); ok {
+		_spec.SetField(transcriptembedding.FieldEndTime, field.TypeFloat64, value)
+	}
+	if value, ok := teu.mutation.AddedEndTime(); ok {
+		_spec.AddField(transcriptembedding.FieldEndTime, field.TypeFloat64, value)
+	}
+	if value, ok := teu.mutation.Text(); ok {
+		_spec.SetField(transcriptembedding.FieldText, field.TypeString, value)
+	}
+	if value, ok := teu.mutation.Model(); ok {
+		_spec.SetField(transcriptembedding.FieldModel, field.TypeString, value)
+	}
+	if value, ok := teu.mutation.SourceHash(); ok {
+		_spec.SetField(transcriptembedding.FieldSourceHash, field.TypeString, value)
+	}
+	if value, ok := teu.mutation.Embedding(); ok {
+		_spec.SetField(transcriptembedding.FieldEmbedding, field.TypeJSON, value)
+	}
+	if value, ok := teu.mutation.AppendedEmbedding(); ok {
+		_spec.AddModifier(func(u *sql.UpdateBuilder) {
+			sqljson.Append(u, transcriptembedding.FieldEmbedding, value)
+		})
+	}
+	if value, ok := teu.mutation.CreatedAt(); ok {
+		_spec.SetField(transcriptembedding.FieldCreatedAt, field.TypeTime, value)
+	}
+	if teu.mutation.VideoClipCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   transcriptembedding.VideoClipTable,
+			Columns: []string{transcriptembedding.VideoClipColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(videoclip.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := teu.mutation.VideoClipIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   transcriptembedding.VideoClipTable,
+			Columns: []string{transcriptembedding.VideoClipColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(videoclip.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if n, err = sqlgraph.UpdateNodes(ctx, teu.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{transcriptembedding.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return 0, err
+	}
+	teu.mutation.done = true
+	return n, nil
+}
+
+// TranscriptEmbeddingUpdateOne is the builder for updating a single TranscriptEmbedding entity.
+type TranscriptEmbeddingUpdateOne struct {
+	config
+	fields   []string
+	hooks    []Hook
+	mutation *TranscriptEmbeddingMutation
+}
+
+// SetVideoClipID sets the "video_clip_id" field.
+func (teuo *TranscriptEmbeddingUpdateOne) SetVideoClipID(i int) *TranscriptEmbeddingUpdateOne {
+	teuo.mutation.SetVideoClipID(i)
+	return teuo
+}
+
+// SetNillableVideoClipID sets the "video_clip_id" field if the given value is not nil.
+func (teuo *TranscriptEmbeddingUpdateOne) SetNillableVideoClipID(i *int) *TranscriptEmbeddingUpdateOne {
+	if i != nil {
+		teuo.SetVideoClipID(*i)
+	}
+	return teuo
+}
+
+// SetChunkIndex sets the "chunk_index" field.
+func (teuo *TranscriptEmbeddingUpdateOne) SetChunkIndex(i int) *TranscriptEmbeddingUpdateOne {
+	teuo.mutation.ResetChunkIndex()
+	teuo.mutation.SetChunkIndex(i)
+	return teuo
+}
+
+// SetNillableChunkIndex sets the "chunk_index" field if the given value is not nil.
+func (teuo *TranscriptEmbeddingUpdateOne) SetNillableChunkIndex(i *int) *TranscriptEmbeddingUpdateOne {
+	if i != nil {
+		teuo.SetChunkIndex(*i)
+	}
+	return teuo
+}
+
+// AddChunkIndex adds i to the "chunk_index" field.
+func (teuo *TranscriptEmbeddingUpdateOne) AddChunkIndex(i int) *TranscriptEmbeddingUpdateOne {
+	teuo.mutation.AddChunkIndex(i)
+	return teuo
+}
+
+// SetStartWord sets the "start_word" field.
+func (teuo *TranscriptEmbeddingUpdateOne) SetStartWord(i int) *TranscriptEmbeddingUpdateOne {
+	teuo.mutation.ResetStartWord()
+	teuo.mutation.SetStartWord(i)
+	return teuo
+}
+
+// SetNillableStartWord sets the "start_word" field if the given value is not nil.
+func (teuo *TranscriptEmbeddingUpdateOne) SetNillableStartWord(i *int) *TranscriptEmbeddingUpdateOne {
+	if i != nil {
+		teuo.SetStartWord(*i)
+	}
+	return teuo
+}
+
+// AddStartWord adds i to the "start_word" field.
+func (teuo *TranscriptEmbeddingUpdateOne) AddStartWord(i int) *TranscriptEmbeddingUpdateOne {
+	teuo.mutation.AddStartWord(i)
+	return teuo
+}
+
+// SetEndWord sets the "end_word" field.
+func (teuo *TranscriptEmbeddingUpdateOne) SetEndWord(i int) *TranscriptEmbeddingUpdateOne {
+	teuo.mutation.ResetEndWord()
+	teuo.mutation.SetEndWord(i)
+	return teuo
+}
+
+// SetNillableEndWord sets the "end_word" field if the given value is not nil.
+func (teuo *TranscriptEmbeddingUpdateOne) SetNillableEndWord(i *int) *TranscriptEmbeddingUpdateOne {
+	if i != nil {
+		teuo.SetEndWord(*i)
+	}
+	return teuo
+}
+
+// AddEndWord adds i to the "end_word" field.
+func (teuo *TranscriptEmbeddingUpdateOne) AddEndWord(i int) *TranscriptEmbeddingUpdateOne {
+	teuo.mutation.AddEndWord(i)
+	return teuo
+}
+
+// SetStartTime sets the "start_time" field.
+func (teuo *TranscriptEmbeddingUpdateOne) SetStartTime(f float64) *TranscriptEmbeddingUpdateOne {
+	teuo.mutation.ResetStartTime()
+	teuo.mutation.SetStartTime(f)
+	return teuo
+}
+
+// SetNillableStartTime sets the "start_time" field if the given value is not nil.
+func (teuo *TranscriptEmbeddingUpdateOne) SetNillableStartTime(f *float64) *TranscriptEmbeddingUpdateOne {
+	if f != nil {
+		teuo.SetStartTime(*f)
+	}
+	return teuo
+}
+
+// AddStartTime adds f to the "start_time" field.
+func (teuo *TranscriptEmbeddingUpdateOne) AddStartTime(f float64) *TranscriptEmbeddingUpdateOne {
+	teuo.mutation.AddStartTime(f)
+	return teuo
+}
+
+// SetEndTime sets the "end_time" field.
+func (teuo *TranscriptEmbeddingUpdateOne) SetEndTime(f float64) *TranscriptEmbeddingUpdateOne {
+	teuo.mutation.ResetEndTime()
+	teuo.mutation.SetEndTime(f)
+	return teuo
+}
+
+// SetNillableEndTime sets the "end_time" field if the given value is not nil.
+func (teuo *TranscriptEmbeddingUpdateOne) SetNillableEndTime(f *float64) *TranscriptEmbeddingUpdateOne {
+	if f != nil {
+		teuo.SetEndTime(*f)
+	}
+	return teuo
+}
+
+// AddEndTime adds f to the "end_time" field.
+func (teuo *TranscriptEmbeddingUpdateOne) AddEndTime(f float64) *TranscriptEmbeddingUpdateOne {
+	teuo.mutation.AddEndTime(f)
+	return teuo
+}
+
+// SetText sets the "text" field.
+func (teuo *TranscriptEmbeddingUpdateOne) SetText(s string) *TranscriptEmbeddingUpdateOne {
+	teuo.mutation.SetText(s)
+	return teuo
+}
+
+// SetNillableText sets the "text" field if the given value is not nil.
+func (teuo *TranscriptEmbeddingUpdateOne) SetNillableText(s *string) *TranscriptEmbeddingUpdateOne {
+	if s != nil {
+		teuo.SetText(*s)
+	}
+	return teuo
+}
+
+// SetModel sets the "model" field.
+func (teuo *TranscriptEmbeddingUpdateOne) SetModel(s string) *TranscriptEmbeddingUpdateOne {
+	teuo.mutation.SetModel(s)
+	return teuo
+}
+
+// SetNillableModel sets the "model" field if the given value is not nil.
+func (teuo *TranscriptEmbeddingUpdateOne) SetNillableModel(s *string) *TranscriptEmbeddingUpdateOne {
+	if s != nil {
+		teuo.SetModel(*s)
+	}
+	return teuo
+}
+
+// SetSourceHash sets the "source_hash" field.
+func (teuo *TranscriptEmbeddingUpdateOne) SetSourceHash(s string) *TranscriptEmbeddingUpdateOne {
+	teuo.mutation.SetSourceHash(s)
+	return teuo
+}
+
+// SetNillableSourceHash sets the "source_hash" field if the given value is not nil.
+func (teuo *TranscriptEmbeddingUpdateOne) SetNillableSourceHash(s *string) *TranscriptEmbeddingUpdateOne {
+	if s != nil {
+		teuo.SetSourceHash(*s)
+	}
+	return teuo
+}
+
+// SetEmbedding sets the "embedding" field.
+func (teuo *TranscriptEmbeddingUpdateOne) SetEmbedding(f []float32) *TranscriptEmbeddingUpdateOne {
+	teuo.mutation.SetEmbedding(f)
+	return teuo
+}
+
+// AppendEmbedding appends f to the "embedding" field.
+func (teuo *TranscriptEmbeddingUpdateOne) AppendEmbedding(f []float32) *TranscriptEmbeddingUpdateOne {
+	teuo.mutation.AppendEmbedding(f)
+	return teuo
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (teuo *TranscriptEmbeddingUpdateOne) SetCreatedAt(t time.Time) *TranscriptEmbeddingUpdateOne {
+	teuo.mutation.SetCreatedAt(t)
+	return teuo
+}
+
+// SetNillableCreatedAt sets the "created_at" field if the given value is not nil.
+func (teuo *TranscriptEmbeddingUpdateOne) SetNillableCreatedAt(t *time.Time) *TranscriptEmbeddingUpdateOne {
+	if t != nil {
+		teuo.SetCreatedAt(*t)
+	}
+	return teuo
+}
+
+// SetVideoClip sets the "video_clip" edge to the VideoClip entity.
+func (teuo *TranscriptEmbeddingUpdateOne) SetVideoClip(v *VideoClip) *TranscriptEmbeddingUpdateOne {
+	return teuo.SetVideoClipID(v.ID)
+}
+
+// Mutation returns the TranscriptEmbeddingMutation object of the builder.
+func (teuo *TranscriptEmbeddingUpdateOne) Mutation() *TranscriptEmbeddingMutation {
+	return teuo.mutation
+}
+
+// ClearVideoClip clears the "video_clip" edge to the VideoClip entity.
+func (teuo *TranscriptEmbeddingUpdateOne) ClearVideoClip() *TranscriptEmbeddingUpdateOne {
+	teuo.mutation.ClearVideoClip()
+	return teuo
+}
+
+// Where appends a list predicates to the TranscriptEmbeddingUpdate builder.
+func (teuo *TranscriptEmbeddingUpdateOne) Where(ps ...predicate.TranscriptEmbedding) *TranscriptEmbeddingUpdateOne {
+	teuo.mutation.Where(ps...)
+	return teuo
+}
+
+// Select allows selecting one or more fields (columns) of the returned entity.
+// The default is selecting all fields defined in the entity schema.
+func (teuo *TranscriptEmbeddingUpdateOne) Select(field string, fields ...string) *TranscriptEmbeddingUpdateOne {
+	teuo.fields = append([]string{field}, fields...)
+	return teuo
+}
+
+// Save executes the query and returns the updated TranscriptEmbedding entity.
+func (teuo *TranscriptEmbeddingUpdateOne) Save(ctx context.Context) (*TranscriptEmbedding, error) {
+	return withHooks(ctx, teuo.sqlSave, teuo.mutation, teuo.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (teuo *TranscriptEmbeddingUpdateOne) SaveX(ctx context.Context) *TranscriptEmbedding {
+	node, err := teuo.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// Exec executes the query on the entity.
+func (teuo *TranscriptEmbeddingUpdateOne) Exec(ctx context.Context) error {
+	_, err := teuo.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (teuo *TranscriptEmbeddingUpdateOne) ExecX(ctx context.Context) {
+	if err := teuo.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (teuo *TranscriptEmbeddingUpdateOne) check() error {
+	if v, ok := teuo.mutation.ChunkIndex(); ok {
+		if err := transcriptembedding.ChunkIndexValidator(v); err != nil {
+			return &ValidationError{Name: "chunk_index", err: fmt.Errorf(`ent: validator failed for field "TranscriptEmbedding.chunk_index": %w`, err)}
+		}
+	}
+	if v, ok := teuo.mutation.StartWord(); ok {
+		if err := transcriptembedding.StartWordValidator(v); err != nil {
+			return &ValidationError{Name: "start_word", err: fmt.Errorf(`ent: validator failed for field "TranscriptEmbedding.start_word": %w`, err)}
+		}
+	}
+	if v, ok := teuo.mutation.EndWord(); ok {
+		if err := transcriptembedding.EndWordValidator(v); err != nil {
+			return &ValidationError{Name: "end_word", err: fmt.Errorf(`ent: validator failed for field "TranscriptEmbedding.end_word": %w`, err)}
+		}
+	}
+	if v, ok := teuo.mutation.Model(); ok {
+		if err := transcriptembedding.ModelValidator(v); err != nil {
+			return &ValidationError{Name: "model", err: fmt.Errorf(`ent: validator failed for field "TranscriptEmbedding.model": %w`, err)}
+		}
+	}
+	if v, ok := teuo.mutation.SourceHash(); ok {
+		if err := transcriptembedding.SourceHashValidator(v); err != nil {
+			return &ValidationError{Name: "source_hash", err: fmt.Errorf(`ent: validator failed for field "TranscriptEmbedding.source_hash": %w`, err)}
+		}
+	}
+	if teuo.mutation.VideoClipCleared() && len(teuo.mutation.VideoClipIDs()) > 0 {
+		return errors.New(`ent: clearing a required unique edge "TranscriptEmbedding.video_clip"`)
+	}
+	return nil
+}
+
+func (teuo *TranscriptEmbeddingUpdateOne) sqlSave(ctx context.Context) (_node *TranscriptEmbedding, err error) {
+	if err := teuo.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(transcriptembedding.Table, transcriptembedding.Columns, sqlgraph.NewFieldSpec(transcriptembedding.FieldID, field.TypeInt))
+	id, ok := teuo.mutation.ID()
+	if !ok {
+		return nil, &ValidationError{Name: "id", err: errors.New(`ent: missing "TranscriptEmbedding.id" for update`)}
+	}
+	_spec.Node.ID.Value = id
+	if fields := teuo.fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, transcriptembedding.FieldID)
+		for _, f := range fields {
+			if !transcriptembedding.ValidColumn(f) {
+				return nil, &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+			}
+			if f != transcriptembedding.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, f)
+			}
+		}
+	}
+	if ps := teuo.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := teuo.mutation.ChunkIndex(); ok {
+		_spec.SetField(transcriptembedding.FieldChunkIndex, field.TypeInt, value)
+	}
+	if value, ok := teuo.mutation.AddedChunkIndex(); ok {
+		_spec.AddField(transcriptembedding.FieldChunkIndex, field.TypeInt, value)
+	}
+	if value, ok := teuo.mutation.StartWord(); ok {
+		_spec.SetField(transcriptembedding.FieldStartWord, field.TypeInt, value)
+	}
+	if value, ok := teuo.mutation.AddedStartWord(); ok {
+		_spec.AddField(transcriptembedding.FieldStartWord, field.TypeInt, value)
+	}
+	if value, ok := teuo.mutation.EndWord(); ok {
+		_spec.SetField(transcriptembedding.FieldEndWord, field.TypeInt, value)
+	}
+	if value, ok := teuo.mutation.AddedEndWord(); ok {
+		_spec.AddField(transcriptembedding.FieldEndWord, field.TypeInt, value)
+	}
+	if value, ok := teuo.mutation.StartTime(); ok {
+		_spec.SetField(transcriptembedding.FieldStartTime, field.TypeFloat64, value)
+	}
+	if value, ok := teuo.mutation.AddedStartTime(); ok {
+		_spec.AddField(transcriptembedding.FieldStartTime, field.TypeFloat64, value)
+	}
+	if value, ok := teuo.mutation.EndTime(); ok {
+		_spec.SetField(transcriptembedding.FieldEndTime, field.TypeFloat64, value)
+	}
+	if value, ok := teuo.mutation.AddedEndTime(); ok {
+		_spec.AddField(transcriptembedding.FieldEndTime, field.TypeFloat64, value)
+	}
+	if value, ok := teuo.mutation.Text(); ok {
+		_spec.SetField(transcriptembedding.FieldText, field.TypeString, value)
+	}
+	if value, ok := teuo.mutation.Model(); ok {
+		_spec.SetField(transcriptembedding.FieldModel, field.TypeString, value)
+	}
+	if value, ok := teuo.mutation.SourceHash(); ok {
+		_spec.SetField(transcriptembedding.FieldSourceHash, field.TypeString, value)
+	}
+	if value, ok := teuo.mutation.Embedding(); ok {
+		_spec.SetField(transcriptembedding.FieldEmbedding, field.TypeJSON, value)
+	}
+	if value, ok := teuo.mutation.AppendedEmbedding(); ok {
+		_spec.AddModifier(func(u *sql.UpdateBuilder) {
+			sqljson.Append(u, transcriptembedding.FieldEmbedding, value)
+		})
+	}
+	if value, ok := teuo.mutation.CreatedAt(); ok {
+		_spec.SetField(transcriptembedding.FieldCreatedAt, field.TypeTime, value)
+	}
+	if teuo.mutation.VideoClipCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   transcriptembedding.VideoClipTable,
+			Columns: []string{transcriptembedding.VideoClipColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(videoclip.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := teuo.mutation.VideoClipIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   transcriptembedding.VideoClipTable,
+			Columns: []string{transcriptembedding.VideoClipColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(videoclip.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	_node = &TranscriptEmbedding{config: teuo.config}
+	_spec.Assign = _node.assignValues
+	_spec.ScanValues = _node.scanValues
+	if err = sqlgraph.UpdateNode(ctx, teuo.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{transcriptembedding.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	teuo.mutation.done = true
+	return _node, nil
+}
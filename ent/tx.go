@@ -16,12 +16,28 @@ type Tx struct {
 	ChatMessage *ChatMessageClient
 	// ChatSession is the client for interacting with the ChatSession builders.
 	ChatSession *ChatSessionClient
+	// Collection is the client for interacting with the Collection builders.
+	Collection *CollectionClient
+	// Cut is the client for interacting with the Cut builders.
+	Cut *CutClient
 	// ExportJob is the client for interacting with the ExportJob builders.
 	ExportJob *ExportJobClient
 	// Project is the client for interacting with the Project builders.
 	Project *ProjectClient
+	// ProjectTemplate is the client for interacting with the ProjectTemplate builders.
+	ProjectTemplate *ProjectTemplateClient
+	// Prompt is the client for interacting with the Prompt builders.
+	Prompt *PromptClient
+	// ResponseCacheEntry is the client for interacting with the ResponseCacheEntry builders.
+	ResponseCacheEntry *ResponseCacheEntryClient
 	// Settings is the client for interacting with the Settings builders.
 	Settings *SettingsClient
+	// Tag is the client for interacting with the Tag builders.
+	Tag *TagClient
+	// TranscriptEmbedding is the client for interacting with the TranscriptEmbedding builders.
+	TranscriptEmbedding *TranscriptEmbeddingClient
+	// UsageRecord is the client for interacting with the UsageRecord builders.
+	UsageRecord *UsageRecordClient
 	// VideoClip is the client for interacting with the VideoClip builders.
 	VideoClip *VideoClipClient
 
@@ -157,9 +173,17 @@ func (tx *Tx) Client() *Client {
 func (tx *Tx) init() {
 	tx.ChatMessage = NewChatMessageClient(tx.config)
 	tx.ChatSession = NewChatSessionClient(tx.config)
+	tx.Collection = NewCollectionClient(tx.config)
+	tx.Cut = NewCutClient(tx.config)
 	tx.ExportJob = NewExportJobClient(tx.config)
 	tx.Project = NewProjectClient(tx.config)
+	tx.ProjectTemplate = NewProjectTemplateClient(tx.config)
+	tx.Prompt = NewPromptClient(tx.config)
+	tx.ResponseCacheEntry = NewResponseCacheEntryClient(tx.config)
 	tx.Settings = NewSettingsClient(tx.config)
+	tx.Tag = NewTagClient(tx.config)
+	tx.TranscriptEmbedding = NewTranscriptEmbeddingClient(tx.config)
+	tx.UsageRecord = NewUsageRecordClient(tx.config)
 	tx.VideoClip = NewVideoClipClient(tx.config)
 }
 
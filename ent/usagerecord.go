@@ -0,0 +1,220 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"fmt"
+	"ramble-ai/ent/usagerecord"
+	"strings"
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/sql"
+)
+
+// UsageRecord is the model entity for the UsageRecord schema.
+type UsageRecord struct {
+	config `json:"-"`
+	// ID of the ent.
+	ID int `json:"id,omitempty"`
+	// Project the call was made for (unset = not tied to a project)
+	ProjectID int `json:"project_id,omitempty"`
+	// Upstream provider of the call ('openrouter' or 'openai')
+	Provider string `json:"provider,omitempty"`
+	// Task the call was made for ('suggest_highlights', 'reorder', 'improve_silences', 'chat', 'transcription', ...)
+	TaskType string `json:"task_type,omitempty"`
+	// Model that served the call
+	Model string `json:"model,omitempty"`
+	// Input tokens reported by the provider
+	PromptTokens int `json:"prompt_tokens,omitempty"`
+	// Output tokens reported by the provider
+	CompletionTokens int `json:"completion_tokens,omitempty"`
+	// Total tokens reported by the provider
+	TotalTokens int `json:"total_tokens,omitempty"`
+	// Seconds of audio transcribed
+	AudioSeconds float64 `json:"audio_seconds,omitempty"`
+	// Cost of the call in US dollars
+	CostUsd float64 `json:"cost_usd,omitempty"`
+	// Whether the cost was estimated from the price table rather than reported by the provider
+	CostEstimated bool `json:"cost_estimated,omitempty"`
+	// Time of the call
+	CreatedAt    time.Time `json:"created_at,omitempty"`
+	selectValues sql.SelectValues
+}
+
+// scanValues returns the types for scanning values from sql.Rows.
+func (*UsageRecord) scanValues(columns []string) ([]any, error) {
+	values := make([]any, len(columns))
+	for i := range columns {
+		switch columns[i] {
+		case usagerecord.FieldCostEstimated:
+			values[i] = new(sql.NullBool)
+		case usagerecord.FieldAudioSeconds, usagerecord.FieldCostUsd:
+			values[i] = new(sql.NullFloat64)
+		case usagerecord.FieldID, usagerecord.FieldProjectID, usagerecord.FieldPromptTokens, usagerecord.FieldCompletionTokens, usagerecord.FieldTotalTokens:
+			values[i] = new(sql.NullInt64)
+		case usagerecord.FieldProvider, usagerecord.FieldTaskType, usagerecord.FieldModel:
+			values[i] = new(sql.NullString)
+		case usagerecord.FieldCreatedAt:
+			values[i] = new(sql.NullTime)
+		default:
+			values[i] = new(sql.UnknownType)
+		}
+	}
+	return values, nil
+}
+
+// assignValues assigns the values that were returned from sql.Rows (after scanning)
+// to the UsageRecord fields.
+func (ur *UsageRecord) assignValues(columns []string, values []any) error {
+	if m, n := len(values), len(columns); m < n {
+		return fmt.Errorf("mismatch number of scan values: %d != %d", m, n)
+	}
+	for i := range columns {
+		switch columns[i] {
+		case usagerecord.FieldID:
+			value, ok := values[i].(*sql.NullInt64)
+			if !ok {
+				return fmt.Errorf("unexpected type %T for field id", value)
+			}
+			ur.ID = int(value.Int64)
+		case usagerecord.FieldProjectID:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field project_id", values[i])
+			} else if value.Valid {
+				ur.ProjectID = int(value.Int64)
+			}
+		case usagerecord.FieldProvider:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field provider", values[i])
+			} else if value.Valid {
+				ur.Provider = value.String
+			}
+		case usagerecord.FieldTaskType:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field task_type", values[i])
+			} else if value.Valid {
+				ur.TaskType = value.String
+			}
+		case usagerecord.FieldModel:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field model", values[i])
+			} else if value.Valid {
+				ur.Model = value.String
+			}
+		case usagerecord.FieldPromptTokens:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field prompt_tokens", values[i])
+			} else if value.Valid {
+				ur.PromptTokens = int(value.Int64)
+			}
+		case usagerecord.FieldCompletionTokens:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field completion_tokens", values[i])
+			} else if value.Valid {
+				ur.CompletionTokens = int(value.Int64)
+			}
+		case usagerecord.FieldTotalTokens:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field total_tokens", values[i])
+			} else if value.Valid {
+				ur.TotalTokens = int(value.Int64)
+			}
+		case usagerecord.FieldAudioSeconds:
+			if value, ok := values[i].(*sql.NullFloat64); !ok {
+				return fmt.Errorf("unexpected type %T for field audio_seconds", values[i])
+			} else if value.Valid {
+				ur.AudioSeconds = value.Float64
+			}
+		case usagerecord.FieldCostUsd:
+			if value, ok := values[i].(*sql.NullFloat64); !ok {
+				return fmt.Errorf("unexpected type %T for field cost_usd", values[i])
+			} else if value.Valid {
+				ur.CostUsd = value.Float64
+			}
+		case usagerecord.FieldCostEstimated:
+			if value, ok := values[i].(*sql.NullBool); !ok {
+				return fmt.Errorf("unexpected type %T for field cost_estimated", values[i])
+			} else if value.Valid {
+				ur.CostEstimated = value.Bool
+			}
+		case usagerecord.FieldCreatedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field created_at", values[i])
+			} else if value.Valid {
+				ur.CreatedAt = value.Time
+			}
+		default:
+			ur.selectValues.Set(columns[i], values[i])
+		}
+	}
+	return nil
+}
+
+// Value returns the ent.Value that was dynamically selected and assigned to the UsageRecord.
+// This includes values selected through modifiers, order, etc.
+func (ur *UsageRecord) Value(name string) (ent.Value, error) {
+	return ur.selectValues.Get(name)
+}
+
+// Update returns a builder for updating this UsageRecord.
+// Note that you need to call UsageRecord.Unwrap() before calling this method if this UsageRecord
+// was returned from a transaction, and the transaction was committed or rolled back.
+func (ur *UsageRecord) Update() *UsageRecordUpdateOne {
+	return NewUsageRecordClient(ur.config).UpdateOne(ur)
+}
+
+// Unwrap unwraps the UsageRecord entity that was returned from a transaction after it was closed,
+// so that all future queries will be executed through the driver which created the transaction.
+func (ur *UsageRecord) Unwrap() *UsageRecord {
+	_tx, ok := ur.config.driver.(*txDriver)
+	if !ok {
+		panic("ent: UsageRecord is not a transactional entity")
+	}
+	ur.config.driver = _tx.drv
+	return ur
+}
+
+// String implements the fmt.Stringer.
+func (ur *UsageRecord) String() string {
+	var builder strings.Builder
+	builder.WriteString("UsageRecord(")
+	builder.WriteString(fmt.Sprintf("id=%v, ", ur.ID))
+	builder.WriteString("project_id=")
+	builder.WriteString(fmt.Sprintf("%v", ur.ProjectID))
+	builder.WriteString(", ")
+	builder.WriteString("provider=")
+	builder.WriteString(ur.Provider)
+	builder.WriteString(", ")
+	builder.WriteString("task_type=")
+	builder.WriteString(ur.TaskType)
+	builder.WriteString(", ")
+	builder.WriteString("model=")
+	builder.WriteString(ur.Model)
+	builder.WriteString(", ")
+	builder.WriteString("prompt_tokens=")
+	builder.WriteString(fmt.Sprintf("%v", ur.PromptTokens))
+	builder.WriteString(", ")
+	builder.WriteString("completion_tokens=")
+	builder.WriteString(fmt.Sprintf("%v", ur.CompletionTokens))
+	builder.WriteString(", ")
+	builder.WriteString("total_tokens=")
+	builder.WriteString(fmt.Sprintf("%v", ur.TotalTokens))
+	builder.WriteString(", ")
+	builder.WriteString("audio_seconds=")
+	builder.WriteString(fmt.Sprintf("%v", ur.AudioSeconds))
+	builder.WriteString(", ")
+	builder.WriteString("cost_usd=")
+	builder.WriteString(fmt.Sprintf("%v", ur.CostUsd))
+	builder.WriteString(", ")
+	builder.WriteString("cost_estimated=")
+	builder.WriteString(fmt.Sprintf("%v", ur.CostEstimated))
+	builder.WriteString(", ")
+	builder.WriteString("created_at=")
+	builder.WriteString(ur.CreatedAt.Format(time.ANSIC))
+	builder.WriteByte(')')
+	return builder.String()
+}
+
+// UsageRecords is a parsable slice of UsageRecord.
+type UsageRecords []*UsageRecord
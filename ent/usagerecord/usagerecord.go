@@ -0,0 +1,150 @@
+// Code generated by ent, DO NOT EDIT.
+
+package usagerecord
+
+import (
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+)
+
+const (
+	// Label holds the string label denoting the usagerecord type in the database.
+	Label = "usage_record"
+	// FieldID holds the string denoting the id field in the database.
+	FieldID = "id"
+	// FieldProjectID holds the string denoting the project_id field in the database.
+	FieldProjectID = "project_id"
+	// FieldProvider holds the string denoting the provider field in the database.
+	FieldProvider = "provider"
+	// FieldTaskType holds the string denoting the task_type field in the database.
+	FieldTaskType = "task_type"
+	// FieldModel holds the string denoting the model field in the database.
+	FieldModel = "model"
+	// FieldPromptTokens holds the string denoting the prompt_tokens field in the database.
+	FieldPromptTokens = "prompt_tokens"
+	// FieldCompletionTokens holds the string denoting the completion_tokens field in the database.
+	FieldCompletionTokens = "completion_tokens"
+	// FieldTotalTokens holds the string denoting the total_tokens field in the database.
+	FieldTotalTokens = "total_tokens"
+	// FieldAudioSeconds holds the string denoting the audio_seconds field in the database.
+	FieldAudioSeconds = "audio_seconds"
+	// FieldCostUsd holds the string denoting the cost_usd field in the database.
+	FieldCostUsd = "cost_usd"
+	// FieldCostEstimated holds the string denoting the cost_estimated field in the database.
+	FieldCostEstimated = "cost_estimated"
+	// FieldCreatedAt holds the string denoting the created_at field in the database.
+	FieldCreatedAt = "created_at"
+	// Table holds the table name of the usagerecord in the database.
+	Table = "usage_records"
+)
+
+// Columns holds all SQL columns for usagerecord fields.
+var Columns = []string{
+	FieldID,
+	FieldProjectID,
+	FieldProvider,
+	FieldTaskType,
+	FieldModel,
+	FieldPromptTokens,
+	FieldCompletionTokens,
+	FieldTotalTokens,
+	FieldAudioSeconds,
+	FieldCostUsd,
+	FieldCostEstimated,
+	FieldCreatedAt,
+}
+
+// ValidColumn reports if the column name is valid (part of the table columns).
+func ValidColumn(column string) bool {
+	for i := range Columns {
+		if column == Columns[i] {
+			return true
+		}
+	}
+	return false
+}
+
+var (
+	// ProviderValidator is a validator for the "provider" field. It is called by the builders before save.
+	ProviderValidator func(string) error
+	// TaskTypeValidator is a validator for the "task_type" field. It is called by the builders before save.
+	TaskTypeValidator func(string) error
+	// DefaultPromptTokens holds the default value on creation for the "prompt_tokens" field.
+	DefaultPromptTokens int
+	// DefaultCompletionTokens holds the default value on creation for the "completion_tokens" field.
+	DefaultCompletionTokens int
+	// DefaultTotalTokens holds the default value on creation for the "total_tokens" field.
+	DefaultTotalTokens int
+	// DefaultAudioSeconds holds the default value on creation for the "audio_seconds" field.
+	DefaultAudioSeconds float64
+	// DefaultCostUsd holds the default value on creation for the "cost_usd" field.
+	DefaultCostUsd float64
+	// DefaultCostEstimated holds the default value on creation for the "cost_estimated" field.
+	DefaultCostEstimated bool
+	// DefaultCreatedAt holds the default value on creation for the "created_at" field.
+	DefaultCreatedAt func() time.Time
+)
+
+// OrderOption defines the ordering options for the UsageRecord queries.
+type OrderOption func(*sql.Selector)
+
+// ByID orders the results by the id field.
+func ByID(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldID, opts...).ToFunc()
+}
+
+// ByProjectID orders the results by the project_id field.
+func ByProjectID(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldProjectID, opts...).ToFunc()
+}
+
+// ByProvider orders the results by the provider field.
+func ByProvider(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldProvider, opts...).ToFunc()
+}
+
+// ByTaskType orders the results by the task_type field.
+func ByTaskType(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldTaskType, opts...).ToFunc()
+}
+
+// ByModel orders the results by the model field.
+func ByModel(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldModel, opts...).ToFunc()
+}
+
+// ByPromptTokens orders the results by the prompt_tokens field.
+func ByPromptTokens(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldPromptTokens, opts...).ToFunc()
+}
+
+// ByCompletionTokens orders the results by the completion_tokens field.
+func ByCompletionTokens(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldCompletionTokens, opts...).ToFunc()
+}
+
+// ByTotalTokens orders the results by the total_tokens field.
+func ByTotalTokens(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldTotalTokens, opts...).ToFunc()
+}
+
+// ByAudioSeconds orders the results by the audio_seconds field.
+func ByAudioSeconds(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldAudioSeconds, opts...).ToFunc()
+}
+
+// ByCostUsd orders the results by the cost_usd field.
+func ByCostUsd(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldCostUsd, opts...).ToFunc()
+}
+
+// ByCostEstimated orders the results by the cost_estimated field.
+func ByCostEstimated(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldCostEstimated, opts...).ToFunc()
+}
+
+// ByCreatedAt orders the results by the created_at field.
+func ByCreatedAt(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldCreatedAt, opts...).ToFunc()
+}
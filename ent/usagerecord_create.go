@@ -0,0 +1,424 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"ramble-ai/ent/usagerecord"
+	"time"
+
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+)
+
+// UsageRecordCreate is the builder for creating a UsageRecord entity.
+type UsageRecordCreate struct {
+	config
+	mutation *UsageRecordMutation
+	hooks    []Hook
+}
+
+// SetProjectID sets the "project_id" field.
+func (urc *UsageRecordCreate) SetProjectID(i int) *UsageRecordCreate {
+	urc.mutation.SetProjectID(i)
+	return urc
+}
+
+// SetNillableProjectID sets the "project_id" field if the given value is not nil.
+func (urc *UsageRecordCreate) SetNillableProjectID(i *int) *UsageRecordCreate {
+	if i != nil {
+		urc.SetProjectID(*i)
+	}
+	return urc
+}
+
+// SetProvider sets the "provider" field.
+func (urc *UsageRecordCreate) SetProvider(s string) *UsageRecordCreate {
+	urc.mutation.SetProvider(s)
+	return urc
+}
+
+// SetTaskType sets the "task_type" field.
+func (urc *UsageRecordCreate) SetTaskType(s string) *UsageRecordCreate {
+	urc.mutation.SetTaskType(s)
+	return urc
+}
+
+// SetModel sets the "model" field.
+func (urc *UsageRecordCreate) SetModel(s string) *UsageRecordCreate {
+	urc.mutation.SetModel(s)
+	return urc
+}
+
+// SetNillableModel sets the "model" field if the given value is not nil.
+func (urc *UsageRecordCreate) SetNillableModel(s *string) *UsageRecordCreate {
+	if s != nil {
+		urc.SetModel(*s)
+	}
+	return urc
+}
+
+// SetPromptTokens sets the "prompt_tokens" field.
+func (urc *UsageRecordCreate) SetPromptTokens(i int) *UsageRecordCreate {
+	urc.mutation.SetPromptTokens(i)
+	return urc
+}
+
+// SetNillablePromptTokens sets the "prompt_tokens" field if the given value is not nil.
+func (urc *UsageRecordCreate) SetNillablePromptTokens(i *int) *UsageRecordCreate {
+	if i != nil {
+		urc.SetPromptTokens(*i)
+	}
+	return urc
+}
+
+// SetCompletionTokens sets the "completion_tokens" field.
+func (urc *UsageRecordCreate) SetCompletionTokens(i int) *UsageRecordCreate {
+	urc.mutation.SetCompletionTokens(i)
+	return urc
+}
+
+// SetNillableCompletionTokens sets the "completion_tokens" field if the given value is not nil.
+func (urc *UsageRecordCreate) SetNillableCompletionTokens(i *int) *UsageRecordCreate {
+	if i != nil {
+		urc.SetCompletionTokens(*i)
+	}
+	return urc
+}
+
+// SetTotalTokens sets the "total_tokens" field.
+func (urc *UsageRecordCreate) SetTotalTokens(i int) *UsageRecordCreate {
+	urc.mutation.SetTotalTokens(i)
+	return urc
+}
+
+// SetNillableTotalTokens sets the "total_tokens" field if the given value is not nil.
+func (urc *UsageRecordCreate) SetNillableTotalTokens(i *int) *UsageRecordCreate {
+	if i != nil {
+		urc.SetTotalTokens(*i)
+	}
+	return urc
+}
+
+// SetAudioSeconds sets the "audio_seconds" field.
+func (urc *UsageRecordCreate) SetAudioSeconds(f float64) *UsageRecordCreate {
+	urc.mutation.SetAudioSeconds(f)
+	return urc
+}
+
+// SetNillableAudioSeconds sets the "audio_seconds" field if the given value is not nil.
+func (urc *UsageRecordCreate) SetNillableAudioSeconds(f *float64) *UsageRecordCreate {
+	if f != nil {
+		urc.SetAudioSeconds(*f)
+	}
+	return urc
+}
+
+// SetCostUsd sets the "cost_usd" field.
+func (urc *UsageRecordCreate) SetCostUsd(f float64) *UsageRecordCreate {
+	urc.mutation.SetCostUsd(f)
+	return urc
+}
+
+// SetNillableCostUsd sets the "cost_usd" field if the given value is not nil.
+func (urc *UsageRecordCreate) SetNillableCostUsd(f *float64) *UsageRecordCreate {
+	if f != nil {
+		urc.SetCostUsd(*f)
+	}
+	return urc
+}
+
+// SetCostEstimated sets the "cost_estimated" field.
+func (urc *UsageRecordCreate) SetCostEstimated(b bool) *UsageRecordCreate {
+	urc.mutation.SetCostEstimated(b)
+	return urc
+}
+
+// SetNillableCostEstimated sets the "cost_estimated" field if the given value is not nil.
+func (urc *UsageRecordCreate) SetNillableCostEstimated(b *bool) *UsageRecordCreate {
+	if b != nil {
+		urc.SetCostEstimated(*b)
+	}
+	return urc
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (urc *UsageRecordCreate) SetCreatedAt(t time.Time) *UsageRecordCreate {
+	urc.mutation.SetCreatedAt(t)
+	return urc
+}
+
+// SetNillableCreatedAt sets the "created_at" field if the given value is not nil.
+func (urc *UsageRecordCreate) SetNillableCreatedAt(t *time.Time) *UsageRecordCreate {
+	if t != nil {
+		urc.SetCreatedAt(*t)
+	}
+	return urc
+}
+
+// Mutation returns the UsageRecordMutation object of the builder.
+func (urc *UsageRecordCreate) Mutation() *UsageRecordMutation {
+	return urc.mutation
+}
+
+// Save creates the UsageRecord in the database.
+func (urc *UsageRecordCreate) Save(ctx context.Context) (*UsageRecord, error) {
+	urc.defaults()
+	return withHooks(ctx, urc.sqlSave, urc.mutation, urc.hooks)
+}
+
+// SaveX calls Save and panics if Save returns an error.
+func (urc *UsageRecordCreate) SaveX(ctx context.Context) *UsageRecord {
+	v, err := urc.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (urc *UsageRecordCreate) Exec(ctx context.Context) error {
+	_, err := urc.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (urc *UsageRecordCreate) ExecX(ctx context.Context) {
+	if err := urc.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (urc *UsageRecordCreate) defaults() {
+	if _, ok := urc.mutation.PromptTokens(); !ok {
+		v := usagerecord.DefaultPromptTokens
+		urc.mutation.SetPromptTokens(v)
+	}
+	if _, ok := urc.mutation.CompletionTokens(); !ok {
+		v := usagerecord.DefaultCompletionTokens
+		urc.mutation.SetCompletionTokens(v)
+	}
+	if _, ok := urc.mutation.TotalTokens(); !ok {
+		v := usagerecord.DefaultTotalTokens
+		urc.mutation.SetTotalTokens(v)
+	}
+	if _, ok := urc.mutation.AudioSeconds(); !ok {
+		v := usagerecord.DefaultAudioSeconds
+		urc.mutation.SetAudioSeconds(v)
+	}
+	if _, ok := urc.mutation.CostUsd(); !ok {
+		v := usagerecord.DefaultCostUsd
+		urc.mutation.SetCostUsd(v)
+	}
+	if _, ok := urc.mutation.CostEstimated(); !ok {
+		v := usagerecord.DefaultCostEstimated
+		urc.mutation.SetCostEstimated(v)
+	}
+	if _, ok := urc.mutation.CreatedAt(); !ok {
+		v := usagerecord.DefaultCreatedAt()
+		urc.mutation.SetCreatedAt(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (urc *UsageRecordCreate) check() error {
+	if _, ok := urc.mutation.Provider(); !ok {
+		return &ValidationError{Name: "provider", err: errors.New(`ent: missing required field "UsageRecord.provider"`)}
+	}
+	if v, ok := urc.mutation.Provider(); ok {
+		if err := usagerecord.ProviderValidator(v); err != nil {
+			return &ValidationError{Name: "provider", err: fmt.Errorf(`ent: validator failed for field "UsageRecord.provider": %w`, err)}
+		}
+	}
+	if _, ok := urc.mutation.TaskType(); !ok {
+		return &ValidationError{Name: "task_type", err: errors.New(`ent: missing required field "UsageRecord.task_type"`)}
+	}
+	if v, ok := urc.mutation.TaskType(); ok {
+		if err := usagerecord.TaskTypeValidator(v); err != nil {
+			return &ValidationError{Name: "task_type", err: fmt.Errorf(`ent: validator failed for field "UsageRecord.task_type": %w`, err)}
+		}
+	}
+	if _, ok := urc.mutation.PromptTokens(); !ok {
+		return &ValidationError{Name: "prompt_tokens", err: errors.New(`ent: missing required field "UsageRecord.prompt_tokens"`)}
+	}
+	if _, ok := urc.mutation.CompletionTokens(); !ok {
+		return &ValidationError{Name: "completion_tokens", err: errors.New(`ent: missing required field "UsageRecord.completion_tokens"`)}
+	}
+	if _, ok := urc.mutation.TotalTokens(); !ok {
+		return &ValidationError{Name: "total_tokens", err: errors.New(`ent: missing required field "UsageRecord.total_tokens"`)}
+	}
+	if _, ok := urc.mutation.AudioSeconds(); !ok {
+		return &ValidationError{Name: "audio_seconds", err: errors.New(`ent: missing required field "UsageRecord.audio_seconds"`)}
+	}
+	if _, ok := urc.mutation.CostUsd(); !ok {
+		return &ValidationError{Name: "cost_usd", err: errors.New(`ent: missing required field "UsageRecord.cost_usd"`)}
+	}
+	if _, ok := urc.mutation.CostEstimated(); !ok {
+		return &ValidationError{Name: "cost_estimated", err: errors.New(`ent: missing required field "UsageRecord.cost_estimated"`)}
+	}
+	if _, ok := urc.mutation.CreatedAt(); !ok {
+		return &ValidationError{Name: "created_at", err: errors.New(`ent: missing required field "UsageRecord.created_at"`)}
+	}
+	return nil
+}
+
+func (urc *UsageRecordCreate) sqlSave(ctx context.Context) (*UsageRecord, error) {
+	if err := urc.check(); err != nil {
+		return nil, err
+	}
+	_node, _spec := urc.createSpec()
+	if err := sqlgraph.CreateNode(ctx, urc.driver, _spec); err != nil {
+		if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	id := _spec.ID.Value.(int64)
+	_node.ID = int(id)
+	urc.mutation.id = &_node.ID
+	urc.mutation.done = true
+	return _node, nil
+}
+
+func (urc *UsageRecordCreate) createSpec() (*UsageRecord, *sqlgraph.CreateSpec) {
+	var (
+		_node = &UsageRecord{config: urc.config}
+		_spec = sqlgraph.NewCreateSpec(usagerecord.Table, sqlgraph.NewFieldSpec(usagerecord.FieldID, field.TypeInt))
+	)
+	if value, ok := urc.mutation.ProjectID(); ok {
+		_spec.SetField(usagerecord.FieldProjectID, field.TypeInt, value)
+		_node.ProjectID = value
+	}
+	if value, ok := urc.mutation.Provider(); ok {
+		_spec.SetField(usagerecord.FieldProvider, field.TypeString, value)
+		_node.Provider = value
+	}
+	if value, ok := urc.mutation.TaskType(); ok {
+		_spec.SetField(usagerecord.FieldTaskType, field.TypeString, value)
+		_node.TaskType = value
+	}
+	if value, ok := urc.mutation.Model(); ok {
+		_spec.SetField(usagerecord.FieldModel, field.TypeString, value)
+		_node.Model = value
+	}
+	if value, ok := urc.mutation.PromptTokens(); ok {
+		_spec.SetField(usagerecord.FieldPromptTokens, field.TypeInt, value)
+		_node.PromptTokens = value
+	}
+	if value, ok := urc.mutation.CompletionTokens(); ok {
+		_spec.SetField(usagerecord.FieldCompletionTokens, field.TypeInt, value)
+		_node.CompletionTokens = value
+	}
+	if value, ok := urc.mutation.TotalTokens(); ok {
+		_spec.SetField(usagerecord.FieldTotalTokens, field.TypeInt, value)
+		_node.TotalTokens = value
+	}
+	if value, ok := urc.mutation.AudioSeconds(); ok {
+		_spec.SetField(usagerecord.FieldAudioSeconds, field.TypeFloat64, value)
+		_node.AudioSeconds = value
+	}
+	if value, ok := urc.mutation.CostUsd(); ok {
+		_spec.SetField(usagerecord.FieldCostUsd, field.TypeFloat64, value)
+		_node.CostUsd = value
+	}
+	if value, ok := urc.mutation.CostEstimated(); ok {
+		_spec.SetField(usagerecord.FieldCostEstimated, field.TypeBool, value)
+		_node.CostEstimated = value
+	}
+	if value, ok := urc.mutation.CreatedAt(); ok {
+		_spec.SetField(usagerecord.FieldCreatedAt, field.TypeTime, value)
+		_node.CreatedAt = value
+	}
+	return _node, _spec
+}
+
+// UsageRecordCreateBulk is the builder for creating many UsageRecord entities in bulk.
+type UsageRecordCreateBulk struct {
+	config
+	err      error
+	builders []*UsageRecordCreate
+}
+
+// Save creates the UsageRecord entities in the database.
+func (urcb *UsageRecordCreateBulk) Save(ctx context.Context) ([]*UsageRecord, error) {
+	if urcb.err != nil {
+		return nil, urcb.err
+	}
+	specs := make([]*sqlgraph.CreateSpec, len(urcb.builders))
+	nodes := make([]*UsageRecord, len(urcb.builders))
+	mutators := make([]Mutator, len(urcb.builders))
+	for i := range urcb.builders {
+		func(i int, root context.Context) {
+			builder := urcb.builders[i]
+			builder.defaults()
+			var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+				mutation, ok := m.(*UsageRecordMutation)
+				if !ok {
+					return nil, fmt.Errorf("unexpected mutation type %T", m)
+				}
+				if err := builder.check(); err != nil {
+					return nil, err
+				}
+				builder.mutation = mutation
+				var err error
+				nodes[i], specs[i] = builder.createSpec()
+				if i < len(mutators)-1 {
+					_, err = mutators[i+1].Mutate(root, urcb.builders[i+1].mutation)
+				} else {
+					spec := &sqlgraph.BatchCreateSpec{Nodes: specs}
+					// Invoke the actual operation on the latest mutation in the chain.
+					if err = sqlgraph.BatchCreate(ctx, urcb.driver, spec); err != nil {
+						if sqlgraph.IsConstraintError(err) {
+							err = &ConstraintError{msg: err.Error(), wrap: err}
+						}
+					}
+				}
+				if err != nil {
+					return nil, err
+				}
+				mutation.id = &nodes[i].ID
+				if specs[i].ID.Value != nil {
+					id := specs[i].ID.Value.(int64)
+					nodes[i].ID = int(id)
+				}
+				mutation.done = true
+				return nodes[i], nil
+			})
+			for i := len(builder.hooks) - 1; i >= 0; i-- {
+				mut = builder.hooks[i](mut)
+			}
+			mutators[i] = mut
+		}(i, ctx)
+	}
+	if len(mutators) > 0 {
+		if _, err := mutators[0].Mutate(ctx, urcb.builders[0].mutation); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (urcb *UsageRecordCreateBulk) SaveX(ctx context.Context) []*UsageRecord {
+	v, err := urcb.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (urcb *UsageRecordCreateBulk) Exec(ctx context.Context) error {
+	_, err := urcb.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (urcb *UsageRecordCreateBulk) ExecX(ctx context.Context) {
+	if err := urcb.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
@@ -0,0 +1,88 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"ramble-ai/ent/predicate"
+	"ramble-ai/ent/usagerecord"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+)
+
+// UsageRecordDelete is the builder for deleting a UsageRecord entity.
+type UsageRecordDelete struct {
+	config
+	hooks    []Hook
+	mutation *UsageRecordMutation
+}
+
+// Where appends a list predicates to the UsageRecordDelete builder.
+func (urd *UsageRecordDelete) Where(ps ...predicate.UsageRecord) *UsageRecordDelete {
+	urd.mutation.Where(ps...)
+	return urd
+}
+
+// Exec executes the deletion query and returns how many vertices were deleted.
+func (urd *UsageRecordDelete) Exec(ctx context.Context) (int, error) {
+	return withHooks(ctx, urd.sqlExec, urd.mutation, urd.hooks)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (urd *UsageRecordDelete) ExecX(ctx context.Context) int {
+	n, err := urd.Exec(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return n
+}
+
+func (urd *UsageRecordDelete) sqlExec(ctx context.Context) (int, error) {
+	_spec := sqlgraph.NewDeleteSpec(usagerecord.Table, sqlgraph.NewFieldSpec(usagerecord.FieldID, field.TypeInt))
+	if ps := urd.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	affected, err := sqlgraph.DeleteNodes(ctx, urd.driver, _spec)
+	if err != nil && sqlgraph.IsConstraintError(err) {
+		err = &ConstraintError{msg: err.Error(), wrap: err}
+	}
+	urd.mutation.done = true
+	return affected, err
+}
+
+// UsageRecordDeleteOne is the builder for deleting a single UsageRecord entity.
+type UsageRecordDeleteOne struct {
+	urd *UsageRecordDelete
+}
+
+// Where appends a list predicates to the UsageRecordDelete builder.
+func (urdo *UsageRecordDeleteOne) Where(ps ...predicate.UsageRecord) *UsageRecordDeleteOne {
+	urdo.urd.mutation.Where(ps...)
+	return urdo
+}
+
+// Exec executes the deletion query.
+func (urdo *UsageRecordDeleteOne) Exec(ctx context.Context) error {
+	n, err := urdo.urd.Exec(ctx)
+	switch {
+	case err != nil:
+		return err
+	case n == 0:
+		return &NotFoundError{usagerecord.Label}
+	default:
+		return nil
+	}
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (urdo *UsageRecordDeleteOne) ExecX(ctx context.Context) {
+	if err := urdo.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
@@ -0,0 +1,707 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"ramble-ai/ent/predicate"
+	"ramble-ai/ent/usagerecord"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+)
+
+// UsageRecordUpdate is the builder for updating UsageRecord entities.
+type UsageRecordUpdate struct {
+	config
+	hooks    []Hook
+	mutation *UsageRecordMutation
+}
+
+// Where appends a list predicates to the UsageRecordUpdate builder.
+func (uru *UsageRecordUpdate) Where(ps ...predicate.UsageRecord) *UsageRecordUpdate {
+	uru.mutation.Where(ps...)
+	return uru
+}
+
+// SetProjectID sets the "project_id" field.
+func (uru *UsageRecordUpdate) SetProjectID(i int) *UsageRecordUpdate {
+	uru.mutation.ResetProjectID()
+	uru.mutation.SetProjectID(i)
+	return uru
+}
+
+// SetNillableProjectID sets the "project_id" field if the given value is not nil.
+func (uru *UsageRecordUpdate) SetNillableProjectID(i *int) *UsageRecordUpdate {
+	if i != nil {
+		uru.SetProjectID(*i)
+	}
+	return uru
+}
+
+// AddProjectID adds i to the "project_id" field.
+func (uru *UsageRecordUpdate) AddProjectID(i int) *UsageRecordUpdate {
+	uru.mutation.AddProjectID(i)
+	return uru
+}
+
+// ClearProjectID clears the value of the "project_id" field.
+func (uru *UsageRecordUpdate) ClearProjectID() *UsageRecordUpdate {
+	uru.mutation.ClearProjectID()
+	return uru
+}
+
+// SetProvider sets the "provider" field.
+func (uru *UsageRecordUpdate) SetProvider(s string) *UsageRecordUpdate {
+	uru.mutation.SetProvider(s)
+	return uru
+}
+
+// SetNillableProvider sets the "provider" field if the given value is not nil.
+func (uru *UsageRecordUpdate) SetNillableProvider(s *string) *UsageRecordUpdate {
+	if s != nil {
+		uru.SetProvider(*s)
+	}
+	return uru
+}
+
+// SetTaskType sets the "task_type" field.
+func (uru *UsageRecordUpdate) SetTaskType(s string) *UsageRecordUpdate {
+	uru.mutation.SetTaskType(s)
+	return uru
+}
+
+// SetNillableTaskType sets the "task_type" field if the given value is not nil.
+func (uru *UsageRecordUpdate) SetNillableTaskType(s *string) *UsageRecordUpdate {
+	if s != nil {
+		uru.SetTaskType(*s)
+	}
+	return uru
+}
+
+// SetModel sets the "model" field.
+func (uru *UsageRecordUpdate) SetModel(s string) *UsageRecordUpdate {
+	uru.mutation.SetModel(s)
+	return uru
+}
+
+// SetNillableModel sets the "model" field if the given value is not nil.
+func (uru *UsageRecordUpdate) SetNillableModel(s *string) *UsageRecordUpdate {
+	if s != nil {
+		uru.SetModel(*s)
+	}
+	return uru
+}
+
+// ClearModel clears the value of the "model" field.
+func (uru *UsageRecordUpdate) ClearModel() *UsageRecordUpdate {
+	uru.mutation.ClearModel()
+	return uru
+}
+
+// SetPromptTokens sets the "prompt_tokens" field.
+func (uru *UsageRecordUpdate) SetPromptTokens(i int) *UsageRecordUpdate {
+	uru.mutation.ResetPromptTokens()
+	uru.mutation.SetPromptTokens(i)
+	return uru
+}
+
+// SetNillablePromptTokens sets the "prompt_tokens" field if the given value is not nil.
+func (uru *UsageRecordUpdate) SetNillablePromptTokens(i *int) *UsageRecordUpdate {
+	if i != nil {
+		uru.SetPromptTokens(*i)
+	}
+	return uru
+}
+
+// AddPromptTokens adds i to the "prompt_tokens" field.
+func (uru *UsageRecordUpdate) AddPromptTokens(i int) *UsageRecordUpdate {
+	uru.mutation.AddPromptTokens(i)
+	return uru
+}
+
+// SetCompletionTokens sets the "completion_tokens" field.
+func (uru *UsageRecordUpdate) SetCompletionTokens(i int) *UsageRecordUpdate {
+	uru.mutation.ResetCompletionTokens()
+	uru.mutation.SetCompletionTokens(i)
+	return uru
+}
+
+// SetNillableCompletionTokens sets the "completion_tokens" field if the given value is not nil.
+func (uru *UsageRecordUpdate) SetNillableCompletionTokens(i *int) *UsageRecordUpdate {
+	if i != nil {
+		uru.SetCompletionTokens(*i)
+	}
+	return uru
+}
+
+// AddCompletionTokens adds i to the "completion_tokens" field.
+func (uru *UsageRecordUpdate) AddCompletionTokens(i int) *UsageRecordUpdate {
+	uru.mutation.AddCompletionTokens(i)
+	return uru
+}
+
+// SetTotalTokens sets the "total_tokens" field.
+func (uru *UsageRecordUpdate) SetTotalTokens(i int) *UsageRecordUpdate {
+	uru.mutation.ResetTotalTokens()
+	uru.mutation.SetTotalTokens(i)
+	return uru
+}
+
+// SetNillableTotalTokens sets the "total_tokens" field if the given value is not nil.
+func (uru *UsageRecordUpdate) SetNillableTotalTokens(i *int) *UsageRecordUpdate {
+	if i != nil {
+		uru.SetTotalTokens(*i)
+	}
+	return uru
+}
+
+// AddTotalTokens adds i to the "total_tokens" field.
+func (uru *UsageRecordUpdate) AddTotalTokens(i int) *UsageRecordUpdate {
+	uru.mutation.AddTotalTokens(i)
+	return uru
+}
+
+// SetAudioSeconds sets the "audio_seconds" field.
+func (uru *UsageRecordUpdate) SetAudioSeconds(f float64) *UsageRecordUpdate {
+	uru.mutation.ResetAudioSeconds()
+	uru.mutation.SetAudioSeconds(f)
+	return uru
+}
+
+// SetNillableAudioSeconds sets the "audio_seconds" field if the given value is not nil.
+func (uru *UsageRecordUpdate) SetNillableAudioSeconds(f *float64) *UsageRecordUpdate {
+	if f != nil {
+		uru.SetAudioSeconds(*f)
+	}
+	return uru
+}
+
+// AddAudioSeconds adds f to the "audio_seconds" field.
+func (uru *UsageRecordUpdate) AddAudioSeconds(f float64) *UsageRecordUpdate {
+	uru.mutation.AddAudioSeconds(f)
+	return uru
+}
+
+// SetCostUsd sets the "cost_usd" field.
+func (uru *UsageRecordUpdate) SetCostUsd(f float64) *UsageRecordUpdate {
+	uru.mutation.ResetCostUsd()
+	uru.mutation.SetCostUsd(f)
+	return uru
+}
+
+// SetNillableCostUsd sets the "cost_usd" field if the given value is not nil.
+func (uru *UsageRecordUpdate) SetNillableCostUsd(f *float64) *UsageRecordUpdate {
+	if f != nil {
+		uru.SetCostUsd(*f)
+	}
+	return uru
+}
+
+// AddCostUsd adds f to the "cost_usd" field.
+func (uru *UsageRecordUpdate) AddCostUsd(f float64) *UsageRecordUpdate {
+	uru.mutation.AddCostUsd(f)
+	return uru
+}
+
+// SetCostEstimated sets the "cost_estimated" field.
+func (uru *UsageRecordUpdate) SetCostEstimated(b bool) *UsageRecordUpdate {
+	uru.mutation.SetCostEstimated(b)
+	return uru
+}
+
+// SetNillableCostEstimated sets the "cost_estimated" field if the given value is not nil.
+func (uru *UsageRecordUpdate) SetNillableCostEstimated(b *bool) *UsageRecordUpdate {
+	if b != nil {
+		uru.SetCostEstimated(*b)
+	}
+	return uru
+}
+
+// Mutation returns the UsageRecordMutation object of the builder.
+func (uru *UsageRecordUpdate) Mutation() *UsageRecordMutation {
+	return uru.mutation
+}
+
+// Save executes the query and returns the number of nodes affected by the update operation.
+func (uru *UsageRecordUpdate) Save(ctx context.Context) (int, error) {
+	return withHooks(ctx, uru.sqlSave, uru.mutation, uru.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (uru *UsageRecordUpdate) SaveX(ctx context.Context) int {
+	affected, err := uru.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return affected
+}
+
+// Exec executes the query.
+func (uru *UsageRecordUpdate) Exec(ctx context.Context) error {
+	_, err := uru.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (uru *UsageRecordUpdate) ExecX(ctx context.Context) {
+	if err := uru.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (uru *UsageRecordUpdate) check() error {
+	if v, ok := uru.mutation.Provider(); ok {
+		if err := usagerecord.ProviderValidator(v); err != nil {
+			return &ValidationError{Name: "provider", err: fmt.Errorf(`ent: validator failed for field "UsageRecord.provider": %w`, err)}
+		}
+	}
+	if v, ok := uru.mutation.TaskType(); ok {
+		if err := usagerecord.TaskTypeValidator(v); err != nil {
+			return &ValidationError{Name: "task_type", err: fmt.Errorf(`ent: validator failed for field "UsageRecord.task_type": %w`, err)}
+		}
+	}
+	return nil
+}
+
+func (uru *UsageRecordUpdate) sqlSave(ctx context.Context) (n int, err error) {
+	if err := uru.check(); err != nil {
+		return n, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(usagerecord.Table, usagerecord.Columns, sqlgraph.NewFieldSpec(usagerecord.FieldID, field.TypeInt))
+	if ps := uru.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := uru.mutation.ProjectID(); ok {
+		_spec.SetField(usagerecord.FieldProjectID, field.TypeInt, value)
+	}
+	if value, ok := uru.mutation.AddedProjectID(); ok {
+		_spec.AddField(usagerecord.FieldProjectID, field.TypeInt, value)
+	}
+	if uru.mutation.ProjectIDCleared() {
+		_spec.ClearField(usagerecord.FieldProjectID, field.TypeInt)
+	}
+	if value, ok := uru.mutation.Provider(); ok {
+		_spec.SetField(usagerecord.FieldProvider, field.TypeString, value)
+	}
+	if value, ok := uru.mutation.TaskType(); ok {
+		_spec.SetField(usagerecord.FieldTaskType, field.TypeString, value)
+	}
+	if value, ok := uru.mutation.Model(); ok {
+		_spec.SetField(usagerecord.FieldModel, field.TypeString, value)
+	}
+	if uru.mutation.ModelCleared() {
+		_spec.ClearField(usagerecord.FieldModel, field.TypeString)
+	}
+	if value, ok := uru.mutation.PromptTokens(); ok {
+		_spec.SetField(usagerecord.FieldPromptTokens, field.TypeInt, value)
+	}
+	if value, ok := uru.mutation.AddedPromptTokens(); ok {
+		_spec.AddField(usagerecord.FieldPromptTokens, field.TypeInt, value)
+	}
+	if value, ok := uru.mutation.CompletionTokens(); ok {
+		_spec.SetField(usagerecord.FieldCompletionTokens, field.TypeInt, value)
+	}
+	if value, ok := uru.mutation.AddedCompletionTokens(); ok {
+		_spec.AddField(usagerecord.FieldCompletionTokens, field.TypeInt, value)
+	}
+	if value, ok := uru.mutation.TotalTokens(); ok {
+		_spec.SetField(usagerecord.FieldTotalTokens, field.TypeInt, value)
+	}
+	if value, ok := uru.mutation.AddedTotalTokens(); ok {
+		_spec.AddField(usagerecord.FieldTotalTokens, field.TypeInt, value)
+	}
+	if value, ok := uru.mutation.AudioSeconds(); ok {
+		_spec.SetField(usagerecord.FieldAudioSeconds, field.TypeFloat64, value)
+	}
+	if value, ok := uru.mutation.AddedAudioSeconds(); ok {
+		_spec.AddField(usagerecord.FieldAudioSeconds, field.TypeFloat64, value)
+	}
+	if value, ok := uru.mutation.CostUsd(); ok {
+		_spec.SetField(usagerecord.FieldCostUsd, field.TypeFloat64, value)
+	}
+	if value, ok := uru.mutation.AddedCostUsd(); ok {
+		_spec.AddField(usagerecord.FieldCostUsd, field.TypeFloat64, value)
+	}
+	if value, ok := uru.mutation.CostEstimated(); ok {
+		_spec.SetField(usagerecord.FieldCostEstimated, field.TypeBool, value)
+	}
+	if n, err = sqlgraph.UpdateNodes(ctx, uru.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{usagerecord.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return 0, err
+	}
+	uru.mutation.done = true
+	return n, nil
+}
+
+// UsageRecordUpdateOne is the builder for updating a single UsageRecord entity.
+type UsageRecordUpdateOne struct {
+	config
+	fields   []string
+	hooks    []Hook
+	mutation *UsageRecordMutation
+}
+
+// SetProjectID sets the "project_id" field.
+func (uruo *UsageRecordUpdateOne) SetProjectID(i int) *UsageRecordUpdateOne {
+	uruo.mutation.ResetProjectID()
+	uruo.mutation.SetProjectID(i)
+	return uruo
+}
+
+// SetNillableProjectID sets the "project_id" field if the given value is not nil.
+func (uruo *UsageRecordUpdateOne) SetNillableProjectID(i *int) *UsageRecordUpdateOne {
+	if i != nil {
+		uruo.SetProjectID(*i)
+	}
+	return uruo
+}
+
+// AddProjectID adds i to the "project_id" field.
+func (uruo *UsageRecordUpdateOne) AddProjectID(i int) *UsageRecordUpdateOne {
+	uruo.mutation.AddProjectID(i)
+	return uruo
+}
+
+// ClearProjectID clears the value of the "project_id" field.
+func (uruo *UsageRecordUpdateOne) ClearProjectID() *UsageRecordUpdateOne {
+	uruo.mutation.ClearProjectID()
+	return uruo
+}
+
+// SetProvider sets the "provider" field.
+func (uruo *UsageRecordUpdateOne) SetProvider(s string) *UsageRecordUpdateOne {
+	uruo.mutation.SetProvider(s)
+	return uruo
+}
+
+// SetNillableProvider sets the "provider" field if the given value is not nil.
+func (uruo *UsageRecordUpdateOne) SetNillableProvider(s *string) *UsageRecordUpdateOne {
+	if s != nil {
+		uruo.SetProvider(*s)
+	}
+	return uruo
+}
+
+// SetTaskType sets the "task_type" field.
+func (uruo *UsageRecordUpdateOne) SetTaskType(s string) *UsageRecordUpdateOne {
+	uruo.mutation.SetTaskType(s)
+	return uruo
+}
+
+// SetNillableTaskType sets the "task_type" field if the given value is not nil.
+func (uruo *UsageRecordUpdateOne) SetNillableTaskType(s *string) *UsageRecordUpdateOne {
+	if s != nil {
+		uruo.SetTaskType(*s)
+	}
+	return uruo
+}
+
+// SetModel sets the "model" field.
+func (uruo *UsageRecordUpdateOne) SetModel(s string) *UsageRecordUpdateOne {
+	uruo.mutation.SetModel(s)
+	return uruo
+}
+
+// SetNillableModel sets the "model" field if the given value is not nil.
+func (uruo *UsageRecordUpdateOne) SetNillableModel(s *string) *UsageRecordUpdateOne {
+	if s != nil {
+		uruo.SetModel(*s)
+	}
+	return uruo
+}
+
+// ClearModel clears the value of the "model" field.
+func (uruo *UsageRecordUpdateOne) ClearModel() *UsageRecordUpdateOne {
+	uruo.mutation.ClearModel()
+	return uruo
+}
+
+// SetPromptTokens sets the "prompt_tokens" field.
+func (uruo *UsageRecordUpdateOne) SetPromptTokens(i int) *UsageRecordUpdateOne {
+	uruo.mutation.ResetPromptTokens()
+	uruo.mutation.SetPromptTokens(i)
+	return uruo
+}
+
+// SetNillablePromptTokens sets the "prompt_tokens" field if the given value is not nil.
+func (uruo *UsageRecordUpdateOne) SetNillablePromptTokens(i *int) *UsageRecordUpdateOne {
+	if i != nil {
+		uruo.SetPromptTokens(*i)
+	}
+	return uruo
+}
+
+// AddPromptTokens adds i to the "prompt_tokens" field.
+func (uruo *UsageRecordUpdateOne) AddPromptTokens(i int) *UsageRecordUpdateOne {
+	uruo.mutation.AddPromptTokens(i)
+	return uruo
+}
+
+// SetCompletionTokens sets the "completion_tokens" field.
+func (uruo *UsageRecordUpdateOne) SetCompletionTokens(i int) *UsageRecordUpdateOne {
+	uruo.mutation.ResetCompletionTokens()
+	uruo.mutation.SetCompletionTokens(i)
+	return uruo
+}
+
+// SetNillableCompletionTokens sets the "completion_tokens" field if the given value is not nil.
+func (uruo *UsageRecordUpdateOne) SetNillableCompletionTokens(i *int) *UsageRecordUpdateOne {
+	if i != nil {
+		uruo.SetCompletionTokens(*i)
+	}
+	return uruo
+}
+
+// AddCompletionTokens adds i to the "completion_tokens" field.
+func (uruo *UsageRecordUpdateOne) AddCompletionTokens(i int) *UsageRecordUpdateOne {
+	uruo.mutation.AddCompletionTokens(i)
+	return uruo
+}
+
+// SetTotalTokens sets the "total_tokens" field.
+func (uruo *UsageRecordUpdateOne) SetTotalTokens(i int) *UsageRecordUpdateOne {
+	uruo.mutation.ResetTotalTokens()
+	uruo.mutation.SetTotalTokens(i)
+	return uruo
+}
+
+// SetNillableTotalTokens sets the "total_tokens" field if the given value is not nil.
+func (uruo *UsageRecordUpdateOne) SetNillableTotalTokens(i *int) *UsageRecordUpdateOne {
+	if i != nil {
+		uruo.SetTotalTokens(*i)
+	}
+	return uruo
+}
+
+// AddTotalTokens adds i to the "total_tokens" field.
+func (uruo *UsageRecordUpdateOne) AddTotalTokens(i int) *UsageRecordUpdateOne {
+	uruo.mutation.AddTotalTokens(i)
+	return uruo
+}
+
+// SetAudioSeconds sets the "audio_seconds" field.
+func (uruo *UsageRecordUpdateOne) SetAudioSeconds(f float64) *UsageRecordUpdateOne {
+	uruo.mutation.ResetAudioSeconds()
+	uruo.mutation.SetAudioSeconds(f)
+	return uruo
+}
+
+// SetNillableAudioSeconds sets the "audio_seconds" field if the given value is not nil.
+func (uruo *UsageRecordUpdateOne) SetNillableAudioSeconds(f *float64) *UsageRecordUpdateOne {
+	if f != nil {
+		uruo.SetAudioSeconds(*f)
+	}
+	return uruo
+}
+
+// AddAudioSeconds adds f to the "audio_seconds" field.
+func (uruo *UsageRecordUpdateOne) AddAudioSeconds(f float64) *UsageRecordUpdateOne {
+	uruo.mutation.AddAudioSeconds(f)
+	return uruo
+}
+
+// SetCostUsd sets the "cost_usd" field.
+func (uruo *UsageRecordUpdateOne) SetCostUsd(f float64) *UsageRecordUpdateOne {
+	uruo.mutation.ResetCostUsd()
+	uruo.mutation.SetCostUsd(f)
+	return uruo
+}
+
+// SetNillableCostUsd sets the "cost_usd" field if the given value is not nil.
+func (uruo *UsageRecordUpdateOne) SetNillableCostUsd(f *float64) *UsageRecordUpdateOne {
+	if f != nil {
+		uruo.SetCostUsd(*f)
+	}
+	return uruo
+}
+
+// AddCostUsd adds f to the "cost_usd" field.
+func (uruo *UsageRecordUpdateOne) AddCostUsd(f float64) *UsageRecordUpdateOne {
+	uruo.mutation.AddCostUsd(f)
+	return uruo
+}
+
+// SetCostEstimated sets the "cost_estimated" field.
+func (uruo *UsageRecordUpdateOne) SetCostEstimated(b bool) *UsageRecordUpdateOne {
+	uruo.mutation.SetCostEstimated(b)
+	return uruo
+}
+
+// SetNillableCostEstimated sets the "cost_estimated" field if the given value is not nil.
+func (uruo *UsageRecordUpdateOne) SetNillableCostEstimated(b *bool) *UsageRecordUpdateOne {
+	if b != nil {
+		uruo.SetCostEstimated(*b)
+	}
+	return uruo
+}
+
+// Mutation returns the UsageRecordMutation object of the builder.
+func (uruo *UsageRecordUpdateOne) Mutation() *UsageRecordMutation {
+	return uruo.mutation
+}
+
+// Where appends a list predicates to the UsageRecordUpdate builder.
+func (uruo *UsageRecordUpdateOne) Where(ps ...predicate.UsageRecord) *UsageRecordUpdateOne {
+	uruo.mutation.Where(ps...)
+	return uruo
+}
+
+// Select allows selecting one or more fields (columns) of the returned entity.
+// The default is selecting all fields defined in the entity schema.
+func (uruo *UsageRecordUpdateOne) Select(field string, fields ...string) *UsageRecordUpdateOne {
+	uruo.fields = append([]string{field}, fields...)
+	return uruo
+}
+
+// Save executes the query and returns the updated UsageRecord entity.
+func (uruo *UsageRecordUpdateOne) Save(ctx context.Context) (*UsageRecord, error) {
+	return withHooks(ctx, uruo.sqlSave, uruo.mutation, uruo.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (uruo *UsageRecordUpdateOne) SaveX(ctx context.Context) *UsageRecord {
+	node, err := uruo.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// Exec executes the query on the entity.
+func (uruo *UsageRecordUpdateOne) Exec(ctx context.Context) error {
+	_, err := uruo.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (uruo *UsageRecordUpdateOne) ExecX(ctx context.Context) {
+	if err := uruo.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (uruo *UsageRecordUpdateOne) check() error {
+	if v, ok := uruo.mutation.Provider(); ok {
+		if err := usagerecord.ProviderValidator(v); err != nil {
+			return &ValidationError{Name: "provider", err: fmt.Errorf(`ent: validator failed for field "UsageRecord.provider": %w`, err)}
+		}
+	}
+	if v, ok := uruo.mutation.TaskType(); ok {
+		if err := usagerecord.TaskTypeValidator(v); err != nil {
+			return &ValidationError{Name: "task_type", err: fmt.Errorf(`ent: validator failed for field "UsageRecord.task_type": %w`, err)}
+		}
+	}
+	return nil
+}
+
+func (uruo *UsageRecordUpdateOne) sqlSave(ctx context.Context) (_node *UsageRecord, err error) {
+	if err := uruo.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(usagerecord.Table, usagerecord.Columns, sqlgraph.NewFieldSpec(usagerecord.FieldID, field.TypeInt))
+	id, ok := uruo.mutation.ID()
+	if !ok {
+		return nil, &ValidationError{Name: "id", err: errors.New(`ent: missing "UsageRecord.id" for update`)}
+	}
+	_spec.Node.ID.Value = id
+	if fields := uruo.fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, usagerecord.FieldID)
+		for _, f := range fields {
+			if !usagerecord.ValidColumn(f) {
+				return nil, &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+			}
+			if f != usagerecord.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, f)
+			}
+		}
+	}
+	if ps := uruo.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := uruo.mutation.ProjectID(); ok {
+		_spec.SetField(usagerecord.FieldProjectID, field.TypeInt, value)
+	}
+	if value, ok := uruo.mutation.AddedProjectID(); ok {
+		_spec.AddField(usagerecord.FieldProjectID, field.TypeInt, value)
+	}
+	if uruo.mutation.ProjectIDCleared() {
+		_spec.ClearField(usagerecord.FieldProjectID, field.TypeInt)
+	}
+	if value, ok := uruo.mutation.Provider(); ok {
+		_spec.SetField(usagerecord.FieldProvider, field.TypeString, value)
+	}
+	if value, ok := uruo.mutation.TaskType(); ok {
+		_spec.SetField(usagerecord.FieldTaskType, field.TypeString, value)
+	}
+	if value, ok := uruo.mutation.Model(); ok {
+		_spec.SetField(usagerecord.FieldModel, field.TypeString, value)
+	}
+	if uruo.mutation.ModelCleared() {
+		_spec.ClearField(usagerecord.FieldModel, field.TypeString)
+	}
+	if value, ok := uruo.mutation.PromptTokens(); ok {
+		_spec.SetField(usagerecord.FieldPromptTokens, field.TypeInt, value)
+	}
+	if value, ok := uruo.mutation.AddedPromptTokens(); ok {
+		_spec.AddField(usagerecord.FieldPromptTokens, field.TypeInt, value)
+	}
+	if value, ok := uruo.mutation.CompletionTokens(); ok {
+		_spec.SetField(usagerecord.FieldCompletionTokens, field.TypeInt, value)
+	}
+	if value, ok := uruo.mutation.AddedCompletionTokens(); ok {
+		_spec.AddField(usagerecord.FieldCompletionTokens, field.TypeInt, value)
+	}
+	if value, ok := uruo.mutation.TotalTokens(); ok {
+		_spec.SetField(usagerecord.FieldTotalTokens, field.TypeInt, value)
+	}
+	if value, ok := uruo.mutation.AddedTotalTokens(); ok {
+		_spec.AddField(usagerecord.FieldTotalTokens, field.TypeInt, value)
+	}
+	if value, ok := uruo.mutation.AudioSeconds(); ok {
+		_spec.SetField(usagerecord.FieldAudioSeconds, field.TypeFloat64, value)
+	}
+	if value, ok := uruo.mutation.AddedAudioSeconds(); ok {
+		_spec.AddField(usagerecord.FieldAudioSeconds, field.TypeFloat64, value)
+	}
+	if value, ok := uruo.mutation.CostUsd(); ok {
+		_spec.SetField(usagerecord.FieldCostUsd, field.TypeFloat64, value)
+	}
+	if value, ok := uruo.mutation.AddedCostUsd(); ok {
+		_spec.AddField(usagerecord.FieldCostUsd, field.TypeFloat64, value)
+	}
+	if value, ok := uruo.mutation.CostEstimated(); ok {
+		_spec.SetField(usagerecord.FieldCostEstimated, field.TypeBool, value)
+	}
+	_node = &UsageRecord{config: uruo.config}
+	_spec.Assign = _node.assignValues
+	_spec.ScanValues = _node.scanValues
+	if err = sqlgraph.UpdateNode(ctx, uruo.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{usagerecord.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	uruo.mutation.done = true
+	return _node, nil
+}
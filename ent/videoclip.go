@@ -3,11 +3,11 @@
 package ent
 
 import (
+	"encoding/json"
+	"fmt"
 	"ramble-ai/ent/project"
 	"ramble-ai/ent/schema"
 	"ramble-ai/ent/videoclip"
-	"encoding/json"
-	"fmt"
 	"strings"
 	"time"
 
@@ -48,6 +48,8 @@ type VideoClip struct {
 	Highlights []schema.Highlight `json:"highlights,omitempty"`
 	// AI-suggested highlights pending user confirmation
 	SuggestedHighlights []schema.Highlight `json:"suggested_highlights,omitempty"`
+	// Library prompt version used for the pending suggestions (0 = none)
+	SuggestedHighlightsPromptID int `json:"suggested_highlights_prompt_id,omitempty"`
 	// Creation timestamp
 	CreatedAt time.Time `json:"created_at,omitempty"`
 	// Last update timestamp
@@ -64,6 +66,8 @@ type VideoClip struct {
 	TranscriptionStartedAt time.Time `json:"transcription_started_at,omitempty"`
 	// When transcription was completed
 	TranscriptionCompletedAt time.Time `json:"transcription_completed_at,omitempty"`
+	// When the video clip was moved to the trash (unset while active)
+	DeletedAt time.Time `json:"deleted_at,omitempty"`
 	// Edges holds the relations/edges for other nodes in the graph.
 	// The values are being populated by the VideoClipQuery when eager-loading is set.
 	Edges               VideoClipEdges `json:"edges"`
@@ -75,9 +79,13 @@ type VideoClip struct {
 type VideoClipEdges struct {
 	// Project this video clip belongs to
 	Project *Project `json:"project,omitempty"`
+	// Embedded transcript chunks used for semantic search
+	TranscriptEmbeddings []*TranscriptEmbedding `json:"transcript_embeddings,omitempty"`
+	// User-defined tags on this video clip
+	Tags []*Tag `json:"tags,omitempty"`
 	// loadedTypes holds the information for reporting if a
 	// type was loaded (or requested) in eager-loading or not.
-	loadedTypes [1]bool
+	loadedTypes [3]bool
 }
 
 // ProjectOrErr returns the Project value or an error if the edge
@@ -91,6 +99,24 @@ func (e VideoClipEdges) ProjectOrErr() (*Project, error) {
 	return nil, &NotLoadedError{edge: "project"}
 }
 
+// TranscriptEmbeddingsOrErr returns the TranscriptEmbeddings value or an error if the edge
+// was not loaded in eager-loading.
+func (e VideoClipEdges) TranscriptEmbeddingsOrErr() ([]*TranscriptEmbedding, error) {
+	if e.loadedTypes[1] {
+		return e.TranscriptEmbeddings, nil
+	}
+	return nil, &NotLoadedError{edge: "transcript_embeddings"}
+}
+
+// TagsOrErr returns the Tags value or an error if the edge
+// was not loaded in eager-loading.
+func (e VideoClipEdges) TagsOrErr() ([]*Tag, error) {
+	if e.loadedTypes[2] {
+		return e.Tags, nil
+	}
+	return nil, &NotLoadedError{edge: "tags"}
+}
+
 // scanValues returns the types for scanning values from sql.Rows.
 func (*VideoClip) scanValues(columns []string) ([]any, error) {
 	values := make([]any, len(columns))
@@ -100,11 +126,11 @@ func (*VideoClip) scanValues(columns []string) ([]any, error) {
 			values[i] = new([]byte)
 		case videoclip.FieldDuration, videoclip.FieldTranscriptionDuration:
 			values[i] = new(sql.NullFloat64)
-		case videoclip.FieldID, videoclip.FieldWidth, videoclip.FieldHeight, videoclip.FieldFileSize, videoclip.FieldHighlightsHistoryIndex:
+		case videoclip.FieldID, videoclip.FieldWidth, videoclip.FieldHeight, videoclip.FieldFileSize, videoclip.FieldSuggestedHighlightsPromptID, videoclip.FieldHighlightsHistoryIndex:
 			values[i] = new(sql.NullInt64)
 		case videoclip.FieldName, videoclip.FieldDescription, videoclip.FieldFilePath, videoclip.FieldFormat, videoclip.FieldTranscription, videoclip.FieldTranscriptionLanguage, videoclip.FieldTranscriptionState, videoclip.FieldTranscriptionError:
 			values[i] = new(sql.NullString)
-		case videoclip.FieldCreatedAt, videoclip.FieldUpdatedAt, videoclip.FieldTranscriptionStartedAt, videoclip.FieldTranscriptionCompletedAt:
+		case videoclip.FieldCreatedAt, videoclip.FieldUpdatedAt, videoclip.FieldTranscriptionStartedAt, videoclip.FieldTranscriptionCompletedAt, videoclip.FieldDeletedAt:
 			values[i] = new(sql.NullTime)
 		case videoclip.ForeignKeys[0]: // project_video_clips
 			values[i] = new(sql.NullInt64)
@@ -219,6 +245,12 @@ func (vc *VideoClip) assignValues(columns []string, values []any) error {
 					return fmt.Errorf("unmarshal field suggested_highlights: %w", err)
 				}
 			}
+		case videoclip.FieldSuggestedHighlightsPromptID:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field suggested_highlights_prompt_id", values[i])
+			} else if value.Valid {
+				vc.SuggestedHighlightsPromptID = int(value.Int64)
+			}
 		case videoclip.FieldCreatedAt:
 			if value, ok := values[i].(*sql.NullTime); !ok {
 				return fmt.Errorf("unexpected type %T for field created_at", values[i])
@@ -269,6 +301,12 @@ func (vc *VideoClip) assignValues(columns []string, values []any) error {
 			} else if value.Valid {
 				vc.TranscriptionCompletedAt = value.Time
 			}
+		case videoclip.FieldDeletedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field deleted_at", values[i])
+			} else if value.Valid {
+				vc.DeletedAt = value.Time
+			}
 		case videoclip.ForeignKeys[0]:
 			if value, ok := values[i].(*sql.NullInt64); !ok {
 				return fmt.Errorf("unexpected type %T for edge-field project_video_clips", value)
@@ -294,6 +332,16 @@ func (vc *VideoClip) QueryProject() *ProjectQuery {
 	return NewVideoClipClient(vc.config).QueryProject(vc)
 }
 
+// QueryTranscriptEmbeddings queries the "transcript_embeddings" edge of the VideoClip entity.
+func (vc *VideoClip) QueryTranscriptEmbeddings() *TranscriptEmbeddingQuery {
+	return NewVideoClipClient(vc.config).QueryTranscriptEmbeddings(vc)
+}
+
+// QueryTags queries the "tags" edge of the VideoClip entity.
+func (vc *VideoClip) QueryTags() *TagQuery {
+	return NewVideoClipClient(vc.config).QueryTags(vc)
+}
+
 // Update returns a builder for updating this VideoClip.
 // Note that you need to call VideoClip.Unwrap() before calling this method if this VideoClip
 // was returned from a transaction, and the transaction was committed or rolled back.
@@ -359,6 +407,9 @@ func (vc *VideoClip) String() string {
 	builder.WriteString("suggested_highlights=")
 	builder.WriteString(fmt.Sprintf("%v", vc.SuggestedHighlights))
 	builder.WriteString(", ")
+	builder.WriteString("suggested_highlights_prompt_id=")
+	builder.WriteString(fmt.Sprintf("%v", vc.SuggestedHighlightsPromptID))
+	builder.WriteString(", ")
 	builder.WriteString("created_at=")
 	builder.WriteString(vc.CreatedAt.Format(time.ANSIC))
 	builder.WriteString(", ")
@@ -382,6 +433,9 @@ func (vc *VideoClip) String() string {
 	builder.WriteString(", ")
 	builder.WriteString("transcription_completed_at=")
 	builder.WriteString(vc.TranscriptionCompletedAt.Format(time.ANSIC))
+	builder.WriteString(", ")
+	builder.WriteString("deleted_at=")
+	builder.WriteString(vc.DeletedAt.Format(time.ANSIC))
 	builder.WriteByte(')')
 	return builder.String()
 }
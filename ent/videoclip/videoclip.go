@@ -42,6 +42,8 @@ const (
 	FieldHighlights = "highlights"
 	// FieldSuggestedHighlights holds the string denoting the suggested_highlights field in the database.
 	FieldSuggestedHighlights = "suggested_highlights"
+	// FieldSuggestedHighlightsPromptID holds the string denoting the suggested_highlights_prompt_id field in the database.
+	FieldSuggestedHighlightsPromptID = "suggested_highlights_prompt_id"
 	// FieldCreatedAt holds the string denoting the created_at field in the database.
 	FieldCreatedAt = "created_at"
 	// FieldUpdatedAt holds the string denoting the updated_at field in the database.
@@ -58,8 +60,14 @@ const (
 	FieldTranscriptionStartedAt = "transcription_started_at"
 	// FieldTranscriptionCompletedAt holds the string denoting the transcription_completed_at field in the database.
 	FieldTranscriptionCompletedAt = "transcription_completed_at"
+	// FieldDeletedAt holds the string denoting the deleted_at field in the database.
+	FieldDeletedAt = "deleted_at"
 	// EdgeProject holds the string denoting the project edge name in mutations.
 	EdgeProject = "project"
+	// EdgeTranscriptEmbeddings holds the string denoting the transcript_embeddings edge name in mutations.
+	EdgeTranscriptEmbeddings = "transcript_embeddings"
+	// EdgeTags holds the string denoting the tags edge name in mutations.
+	EdgeTags = "tags"
 	// Table holds the table name of the videoclip in the database.
 	Table = "video_clips"
 	// ProjectTable is the table that holds the project relation/edge.
@@ -69,6 +77,18 @@ const (
 	ProjectInverseTable = "projects"
 	// ProjectColumn is the table column denoting the project relation/edge.
 	ProjectColumn = "project_video_clips"
+	// TranscriptEmbeddingsTable is the table that holds the transcript_embeddings relation/edge.
+	TranscriptEmbeddingsTable = "transcript_embeddings"
+	// TranscriptEmbeddingsInverseTable is the table name for the TranscriptEmbedding entity.
+	// It exists in this package in order to avoid circular dependency with the "transcriptembedding" package.
+	TranscriptEmbeddingsInverseTable = "transcript_embeddings"
+	// TranscriptEmbeddingsColumn is the table column denoting the transcript_embeddings relation/edge.
+	TranscriptEmbeddingsColumn = "video_clip_id"
+	// TagsTable is the table that holds the tags relation/edge. The primary key declared below.
+	TagsTable = "tag_video_clips"
+	// TagsInverseTable is the table name for the Tag entity.
+	// It exists in this package in order to avoid circular dependency with the "tag" package.
+	TagsInverseTable = "tags"
 )
 
 // Columns holds all SQL columns for videoclip fields.
@@ -88,6 +108,7 @@ var Columns = []string{
 	FieldTranscriptionDuration,
 	FieldHighlights,
 	FieldSuggestedHighlights,
+	FieldSuggestedHighlightsPromptID,
 	FieldCreatedAt,
 	FieldUpdatedAt,
 	FieldHighlightsHistory,
@@ -96,6 +117,7 @@ var Columns = []string{
 	FieldTranscriptionError,
 	FieldTranscriptionStartedAt,
 	FieldTranscriptionCompletedAt,
+	FieldDeletedAt,
 }
 
 // ForeignKeys holds the SQL foreign-keys that are owned by the "video_clips"
@@ -104,6 +126,12 @@ var ForeignKeys = []string{
 	"project_video_clips",
 }
 
+var (
+	// TagsPrimaryKey and TagsColumn2 are the table columns denoting the
+	// primary key for the tags relation (M2M).
+	TagsPrimaryKey = []string{"tag_id", "video_clip_id"}
+)
+
 // ValidColumn reports if the column name is valid (part of the table columns).
 func ValidColumn(column string) bool {
 	for i := range Columns {
@@ -199,6 +227,11 @@ func ByTranscriptionDuration(opts ...sql.OrderTermOption) OrderOption {
 	return sql.OrderByField(FieldTranscriptionDuration, opts...).ToFunc()
 }
 
+// BySuggestedHighlightsPromptID orders the results by the suggested_highlights_prompt_id field.
+func BySuggestedHighlightsPromptID(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldSuggestedHighlightsPromptID, opts...).ToFunc()
+}
+
 // ByCreatedAt orders the results by the created_at field.
 func ByCreatedAt(opts ...sql.OrderTermOption) OrderOption {
 	return sql.OrderByField(FieldCreatedAt, opts...).ToFunc()
@@ -234,12 +267,45 @@ func ByTranscriptionCompletedAt(opts ...sql.OrderTermOption) OrderOption {
 	return sql.OrderByField(FieldTranscriptionCompletedAt, opts...).ToFunc()
 }
 
+// ByDeletedAt orders the results by the deleted_at field.
+func ByDeletedAt(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldDeletedAt, opts...).ToFunc()
+}
+
 // ByProjectField orders the results by project field.
 func ByProjectField(field string, opts ...sql.OrderTermOption) OrderOption {
 	return func(s *sql.Selector) {
 		sqlgraph.OrderByNeighborTerms(s, newProjectStep(), sql.OrderByField(field, opts...))
 	}
 }
+
+// ByTranscriptEmbeddingsCount orders the results by transcript_embeddings count.
+func ByTranscriptEmbeddingsCount(opts ...sql.OrderTermOption) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborsCount(s, newTranscriptEmbeddingsStep(), opts...)
+	}
+}
+
+// ByTranscriptEmbeddings orders the results by transcript_embeddings terms.
+func ByTranscriptEmbeddings(term sql.OrderTerm, terms ...sql.OrderTerm) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborTerms(s, newTranscriptEmbeddingsStep(), append([]sql.OrderTerm{term}, terms...)...)
+	}
+}
+
+// ByTagsCount orders the results by tags count.
+func ByTagsCount(opts ...sql.OrderTermOption) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborsCount(s, newTagsStep(), opts...)
+	}
+}
+
+// ByTags orders the results by tags terms.
+func ByTags(term sql.OrderTerm, terms ...sql.OrderTerm) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborTerms(s, newTagsStep(), append([]sql.OrderTerm{term}, terms...)...)
+	}
+}
 func newProjectStep() *sqlgraph.Step {
 	return sqlgraph.NewStep(
 		sqlgraph.From(Table, FieldID),
@@ -247,3 +313,17 @@ func newProjectStep() *sqlgraph.Step {
 		sqlgraph.Edge(sqlgraph.M2O, true, ProjectTable, ProjectColumn),
 	)
 }
+func newTranscriptEmbeddingsStep() *sqlgraph.Step {
+	return sqlgraph.NewStep(
+		sqlgraph.From(Table, FieldID),
+		sqlgraph.To(TranscriptEmbeddingsInverseTable, FieldID),
+		sqlgraph.Edge(sqlgraph.O2M, false, TranscriptEmbeddingsTable, TranscriptEmbeddingsColumn),
+	)
+}
+func newTagsStep() *sqlgraph.Step {
+	return sqlgraph.NewStep(
+		sqlgraph.From(Table, FieldID),
+		sqlgraph.To(TagsInverseTable, FieldID),
+		sqlgraph.Edge(sqlgraph.M2M, true, TagsTable, TagsPrimaryKey...),
+	)
+}
@@ -110,6 +110,11 @@ func TranscriptionDuration(v float64) predicate.VideoClip {
 	return predicate.VideoClip(sql.FieldEQ(FieldTranscriptionDuration, v))
 }
 
+// SuggestedHighlightsPromptID applies equality check predicate on the "suggested_highlights_prompt_id" field. It's identical to SuggestedHighlightsPromptIDEQ.
+func SuggestedHighlightsPromptID(v int) predicate.VideoClip {
+	return predicate.VideoClip(sql.FieldEQ(FieldSuggestedHighlightsPromptID, v))
+}
+
 // CreatedAt applies equality check predicate on the "created_at" field. It's identical to CreatedAtEQ.
 func CreatedAt(v time.Time) predicate.VideoClip {
 	return predicate.VideoClip(sql.FieldEQ(FieldCreatedAt, v))
@@ -145,6 +150,11 @@ func TranscriptionCompletedAt(v time.Time) predicate.VideoClip {
 	return predicate.VideoClip(sql.FieldEQ(FieldTranscriptionCompletedAt, v))
 }
 
+// DeletedAt applies equality check predicate on the "deleted_at" field. It's identical to DeletedAtEQ.
+func DeletedAt(v time.Time) predicate.VideoClip {
+	return predicate.VideoClip(sql.FieldEQ(FieldDeletedAt, v))
+}
+
 // NameEQ applies the EQ predicate on the "name" field.
 func NameEQ(v string) predicate.VideoClip {
 	return predicate.VideoClip(sql.FieldEQ(FieldName, v))
@@ -855,6 +865,56 @@ func SuggestedHighlightsNotNil() predicate.VideoClip {
 	return predicate.VideoClip(sql.FieldNotNull(FieldSuggestedHighlights))
 }
 
+// SuggestedHighlightsPromptIDEQ applies the EQ predicate on the "suggested_highlights_prompt_id" field.
+func SuggestedHighlightsPromptIDEQ(v int) predicate.VideoClip {
+	return predicate.VideoClip(sql.FieldEQ(FieldSuggestedHighlightsPromptID, v))
+}
+
+// SuggestedHighlightsPromptIDNEQ applies the NEQ predicate on the "suggested_highlights_prompt_id" field.
+func SuggestedHighlightsPromptIDNEQ(v int) predicate.VideoClip {
+	return predicate.VideoClip(sql.FieldNEQ(FieldSuggestedHighlightsPromptID, v))
+}
+
+// SuggestedHighlightsPromptIDIn applies the In predicate on the "suggested_highlights_prompt_id" field.
+func SuggestedHighlightsPromptIDIn(vs ...int) predicate.VideoClip {
+	return predicate.VideoClip(sql.FieldIn(FieldSuggestedHighlightsPromptID, vs...))
+}
+
+// SuggestedHighlightsPromptIDNotIn applies the NotIn predicate on the "suggested_highlights_prompt_id" field.
+func SuggestedHighlightsPromptIDNotIn(vs ...int) predicate.VideoClip {
+	return predicate.VideoClip(sql.FieldNotIn(FieldSuggestedHighlightsPromptID, vs...))
+}
+
+// SuggestedHighlightsPromptIDGT applies the GT predicate on the "suggested_highlights_prompt_id" field.
+func SuggestedHighlightsPromptIDGT(v int) predicate.VideoClip {
+	return predicate.VideoClip(sql.FieldGT(FieldSuggestedHighlightsPromptID, v))
+}
+
+// SuggestedHighlightsPromptIDGTE applies the GTE predicate on the "suggested_highlights_prompt_id" field.
+func SuggestedHighlightsPromptIDGTE(v int) predicate.VideoClip {
+	return predicate.VideoClip(sql.FieldGTE(FieldSuggestedHighlightsPromptID, v))
+}
+
+// SuggestedHighlightsPromptIDLT applies the LT predicate on the "suggested_highlights_prompt_id" field.
+func SuggestedHighlightsPromptIDLT(v int) predicate.VideoClip {
+	return predicate.VideoClip(sql.FieldLT(FieldSuggestedHighlightsPromptID, v))
+}
+
+// SuggestedHighlightsPromptIDLTE applies the LTE predicate on the "suggested_highlights_prompt_id" field.
+func SuggestedHighlightsPromptIDLTE(v int) predicate.VideoClip {
+	return predicate.VideoClip(sql.FieldLTE(FieldSuggestedHighlightsPromptID, v))
+}
+
+// SuggestedHighlightsPromptIDIsNil applies the IsNil predicate on the "suggested_highlights_prompt_id" field.
+func SuggestedHighlightsPromptIDIsNil() predicate.VideoClip {
+	return predicate.VideoClip(sql.FieldIsNull(FieldSuggestedHighlightsPromptID))
+}
+
+// SuggestedHighlightsPromptIDNotNil applies the NotNil predicate on the "suggested_highlights_prompt_id" field.
+func SuggestedHighlightsPromptIDNotNil() predicate.VideoClip {
+	return predicate.VideoClip(sql.FieldNotNull(FieldSuggestedHighlightsPromptID))
+}
+
 // CreatedAtEQ applies the EQ predicate on the "created_at" field.
 func CreatedAtEQ(v time.Time) predicate.VideoClip {
 	return predicate.VideoClip(sql.FieldEQ(FieldCreatedAt, v))
@@ -1245,6 +1305,56 @@ func TranscriptionCompletedAtNotNil() predicate.VideoClip {
 	return predicate.VideoClip(sql.FieldNotNull(FieldTranscriptionCompletedAt))
 }
 
+// DeletedAtEQ applies the EQ predicate on the "deleted_at" field.
+func DeletedAtEQ(v time.Time) predicate.VideoClip {
+	return predicate.VideoClip(sql.FieldEQ(FieldDeletedAt, v))
+}
+
+// DeletedAtNEQ applies the NEQ predicate on the "deleted_at" field.
+func DeletedAtNEQ(v time.Time) predicate.VideoClip {
+	return predicate.VideoClip(sql.FieldNEQ(FieldDeletedAt, v))
+}
+
+// DeletedAtIn applies the In predicate on the "deleted_at" field.
+func DeletedAtIn(vs ...time.Time) predicate.VideoClip {
+	return predicate.VideoClip(sql.FieldIn(FieldDeletedAt, vs...))
+}
+
+// DeletedAtNotIn applies the NotIn predicate on the "deleted_at" field.
+func DeletedAtNotIn(vs ...time.Time) predicate.VideoClip {
+	return predicate.VideoClip(sql.FieldNotIn(FieldDeletedAt, vs...))
+}
+
+// DeletedAtGT applies the GT predicate on the "deleted_at" field.
+func DeletedAtGT(v time.Time) predicate.VideoClip {
+	return predicate.VideoClip(sql.FieldGT(FieldDeletedAt, v))
+}
+
+// DeletedAtGTE applies the GTE predicate on the "deleted_at" field.
+func DeletedAtGTE(v time.Time) predicate.VideoClip {
+	return predicate.VideoClip(sql.FieldGTE(FieldDeletedAt, v))
+}
+
+// DeletedAtLT applies the LT predicate on the "deleted_at" field.
+func DeletedAtLT(v time.Time) predicate.VideoClip {
+	return predicate.VideoClip(sql.FieldLT(FieldDeletedAt, v))
+}
+
+// DeletedAtLTE applies the LTE predicate on the "deleted_at" field.
+func DeletedAtLTE(v time.Time) predicate.VideoClip {
+	return predicate.VideoClip(sql.FieldLTE(FieldDeletedAt, v))
+}
+
+// DeletedAtIsNil applies the IsNil predicate on the "deleted_at" field.
+func DeletedAtIsNil() predicate.VideoClip {
+	return predicate.VideoClip(sql.FieldIsNull(FieldDeletedAt))
+}
+
+// DeletedAtNotNil applies the NotNil predicate on the "deleted_at" field.
+func DeletedAtNotNil() predicate.VideoClip {
+	return predicate.VideoClip(sql.FieldNotNull(FieldDeletedAt))
+}
+
 // HasProject applies the HasEdge predicate on the "project" edge.
 func HasProject() predicate.VideoClip {
 	return predicate.VideoClip(func(s *sql.Selector) {
@@ -1268,6 +1378,52 @@ func HasProjectWith(preds ...predicate.Project) predicate.VideoClip {
 	})
 }
 
+// HasTranscriptEmbeddings applies the HasEdge predicate on the "transcript_embeddings" edge.
+func HasTranscriptEmbeddings() predicate.VideoClip {
+	return predicate.VideoClip(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, TranscriptEmbeddingsTable, TranscriptEmbeddingsColumn),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasTranscriptEmbeddingsWith applies the HasEdge predicate on the "transcript_embeddings" edge with a given conditions (other predicates).
+func HasTranscriptEmbeddingsWith(preds ...predicate.TranscriptEmbedding) predicate.VideoClip {
+	return predicate.VideoClip(func(s *sql.Selector) {
+		step := newTranscriptEmbeddingsStep()
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
+// HasTags applies the HasEdge predicate on the "tags" edge.
+func HasTags() predicate.VideoClip {
+	return predicate.VideoClip(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.Edge(sqlgraph.M2M, true, TagsTable, TagsPrimaryKey...),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasTagsWith applies the HasEdge predicate on the "tags" edge with a given conditions (other predicates).
+func HasTagsWith(preds ...predicate.Tag) predicate.VideoClip {
+	return predicate.VideoClip(func(s *sql.Selector) {
+		step := newTagsStep()
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
 // And groups predicates with the AND operator between them.
 func And(predicates ...predicate.VideoClip) predicate.VideoClip {
 	return predicate.VideoClip(sql.AndPredicates(predicates...))
@@ -3,12 +3,14 @@
 package ent
 
 import (
-	"ramble-ai/ent/project"
-	"ramble-ai/ent/schema"
-	"ramble-ai/ent/videoclip"
 	"context"
 	"errors"
 	"fmt"
+	"ramble-ai/ent/project"
+	"ramble-ai/ent/schema"
+	"ramble-ai/ent/tag"
+	"ramble-ai/ent/transcriptembedding"
+	"ramble-ai/ent/videoclip"
 	"time"
 
 	"entgo.io/ent/dialect/sql/sqlgraph"
@@ -178,6 +180,20 @@ func (vcc *VideoClipCreate) SetSuggestedHighlights(s []schema.Highlight) *VideoC
 	return vcc
 }
 
+// SetSuggestedHighlightsPromptID sets the "suggested_highlights_prompt_id" field.
+func (vcc *VideoClipCreate) SetSuggestedHighlightsPromptID(i int) *VideoClipCreate {
+	vcc.mutation.SetSuggestedHighlightsPromptID(i)
+	return vcc
+}
+
+// SetNillableSuggestedHighlightsPromptID sets the "suggested_highlights_prompt_id" field if the given value is not nil.
+func (vcc *VideoClipCreate) SetNillableSuggestedHighlightsPromptID(i *int) *VideoClipCreate {
+	if i != nil {
+		vcc.SetSuggestedHighlightsPromptID(*i)
+	}
+	return vcc
+}
+
 // SetCreatedAt sets the "created_at" field.
 func (vcc *VideoClipCreate) SetCreatedAt(t time.Time) *VideoClipCreate {
 	vcc.mutation.SetCreatedAt(t)
@@ -282,6 +298,20 @@ func (vcc *VideoClipCreate) SetNillableTranscriptionCompletedAt(t *time.Time) *V
 	return vcc
 }
 
+// SetDeletedAt sets the "deleted_at" field.
+func (vcc *VideoClipCreate) SetDeletedAt(t time.Time) *VideoClipCreate {
+	vcc.mutation.SetDeletedAt(t)
+	return vcc
+}
+
+// SetNillableDeletedAt sets the "deleted_at" field if the given value is not nil.
+func (vcc *VideoClipCreate) SetNillableDeletedAt(t *time.Time) *VideoClipCreate {
+	if t != nil {
+		vcc.SetDeletedAt(*t)
+	}
+	return vcc
+}
+
 // SetProjectID sets the "project" edge to the Project entity by ID.
 func (vcc *VideoClipCreate) SetProjectID(id int) *VideoClipCreate {
 	vcc.mutation.SetProjectID(id)
@@ -301,6 +331,36 @@ func (vcc *VideoClipCreate) SetProject(p *Project) *VideoClipCreate {
 	return vcc.SetProjectID(p.ID)
 }
 
+// AddTranscriptEmbeddingIDs adds the "transcript_embeddings" edge to the TranscriptEmbedding entity by IDs.
+func (vcc *VideoClipCreate) AddTranscriptEmbeddingIDs(ids ...int) *VideoClipCreate {
+	vcc.mutation.AddTranscriptEmbeddingIDs(ids...)
+	return vcc
+}
+
+// AddTranscriptEmbeddings adds the "transcript_embeddings" edges to the TranscriptEmbedding entity.
+func (vcc *VideoClipCreate) AddTranscriptEmbeddings(t ...*TranscriptEmbedding) *VideoClipCreate {
+	ids := make([]int, len(t))
+	for i := range t {
+		ids[i] = t[i].ID
+	}
+	return vcc.AddTranscriptEmbeddingIDs(ids...)
+}
+
+// AddTagIDs adds the "tags" edge to the Tag entity by IDs.
+func (vcc *VideoClipCreate) AddTagIDs(ids ...int) *VideoClipCreate {
+	vcc.mutation.AddTagIDs(ids...)
+	return vcc
+}
+
+// AddTags adds the "tags" edges to the Tag entity.
+func (vcc *VideoClipCreate) AddTags(t ...*Tag) *VideoClipCreate {
+	ids := make([]int, len(t))
+	for i := range t {
+		ids[i] = t[i].ID
+	}
+	return vcc.AddTagIDs(ids...)
+}
+
 // Mutation returns the VideoClipMutation object of the builder.
 func (vcc *VideoClipCreate) Mutation() *VideoClipMutation {
 	return vcc.mutation
@@ -460,6 +520,10 @@ func (vcc *VideoClipCreate) createSpec() (*VideoClip, *sqlgraph.CreateSpec) {
 		_spec.SetField(videoclip.FieldSuggestedHighlights, field.TypeJSON, value)
 		_node.SuggestedHighlights = value
 	}
+	if value, ok := vcc.mutation.SuggestedHighlightsPromptID(); ok {
+		_spec.SetField(videoclip.FieldSuggestedHighlightsPromptID, field.TypeInt, value)
+		_node.SuggestedHighlightsPromptID = value
+	}
 	if value, ok := vcc.mutation.CreatedAt(); ok {
 		_spec.SetField(videoclip.FieldCreatedAt, field.TypeTime, value)
 		_node.CreatedAt = value
@@ -492,6 +556,10 @@ func (vcc *VideoClipCreate) createSpec() (*VideoClip, *sqlgraph.CreateSpec) {
 		_spec.SetField(videoclip.FieldTranscriptionCompletedAt, field.TypeTime, value)
 		_node.TranscriptionCompletedAt = value
 	}
+	if value, ok := vcc.mutation.DeletedAt(); ok {
+		_spec.SetField(videoclip.FieldDeletedAt, field.TypeTime, value)
+		_node.DeletedAt = value
+	}
 	if nodes := vcc.mutation.ProjectIDs(); len(nodes) > 0 {
 		edge := &sqlgraph.EdgeSpec{
 			Rel:     sqlgraph.M2O,
@@ -509,6 +577,38 @@ func (vcc *VideoClipCreate) createSpec() (*VideoClip, *sqlgraph.CreateSpec) {
 		_node.project_video_clips = &nodes[0]
 		_spec.Edges = append(_spec.Edges, edge)
 	}
+	if nodes := vcc.mutation.TranscriptEmbeddingsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   videoclip.TranscriptEmbeddingsTable,
+			Columns: []string{videoclip.TranscriptEmbeddingsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(transcriptembedding.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	if nodes := vcc.mutation.TagsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2M,
+			Inverse: true,
+			Table:   videoclip.TagsTable,
+			Columns: videoclip.TagsPrimaryKey,
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(tag.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges = append(_spec.Edges, edge)
+	}
 	return _node, _spec
 }
 
@@ -3,12 +3,15 @@
 package ent
 
 import (
-	"ramble-ai/ent/predicate"
-	"ramble-ai/ent/project"
-	"ramble-ai/ent/videoclip"
 	"context"
+	"database/sql/driver"
 	"fmt"
 	"math"
+	"ramble-ai/ent/predicate"
+	"ramble-ai/ent/project"
+	"ramble-ai/ent/tag"
+	"ramble-ai/ent/transcriptembedding"
+	"ramble-ai/ent/videoclip"
 
 	"entgo.io/ent"
 	"entgo.io/ent/dialect/sql"
@@ -19,12 +22,14 @@ import (
 // VideoClipQuery is the builder for querying VideoClip entities.
 type VideoClipQuery struct {
 	config
-	ctx         *QueryContext
-	order       []videoclip.OrderOption
-	inters      []Interceptor
-	predicates  []predicate.VideoClip
-	withProject *ProjectQuery
-	withFKs     bool
+	ctx                      *QueryContext
+	order                    []videoclip.OrderOption
+	inters                   []Interceptor
+	predicates               []predicate.VideoClip
+	withProject              *ProjectQuery
+	withTranscriptEmbeddings *TranscriptEmbeddingQuery
+	withTags                 *TagQuery
+	withFKs                  bool
 	// intermediate query (i.e. traversal path).
 	sql  *sql.Selector
 	path func(context.Context) (*sql.Selector, error)
@@ -83,6 +88,50 @@ func (vcq *VideoClipQuery) QueryProject() *ProjectQuery {
 	return query
 }
 
+// QueryTranscriptEmbeddings chains the current query on the "transcript_embeddings" edge.
+func (vcq *VideoClipQuery) QueryTranscriptEmbeddings() *TranscriptEmbeddingQuery {
+	query := (&TranscriptEmbeddingClient{config: vcq.config}).Query()
+	query.path = func(ctx context.Context) (fromU *sql.Selector, err error) {
+		if err := vcq.prepareQuery(ctx); err != nil {
+			return nil, err
+		}
+		selector := vcq.sqlQuery(ctx)
+		if err := selector.Err(); err != nil {
+			return nil, err
+		}
+		step := sqlgraph.NewStep(
+			sqlgraph.From(videoclip.Table, videoclip.FieldID, selector),
+			sqlgraph.To(transcriptembedding.Table, transcriptembedding.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, videoclip.TranscriptEmbeddingsTable, videoclip.TranscriptEmbeddingsColumn),
+		)
+		fromU = sqlgraph.SetNeighbors(vcq.driver.Dialect(), step)
+		return fromU, nil
+	}
+	return query
+}
+
+// QueryTags chains the current query on the "tags" edge.
+func (vcq *VideoClipQuery) QueryTags() *TagQuery {
+	query := (&TagClient{config: vcq.config}).Query()
+	query.path = func(ctx context.Context) (fromU *sql.Selector, err error) {
+		if err := vcq.prepareQuery(ctx); err != nil {
+			return nil, err
+		}
+		selector := vcq.sqlQuery(ctx)
+		if err := selector.Err(); err != nil {
+			return nil, err
+		}
+		step := sqlgraph.NewStep(
+			sqlgraph.From(videoclip.Table, videoclip.FieldID, selector),
+			sqlgraph.To(tag.Table, tag.FieldID),
+			sqlgraph.Edge(sqlgraph.M2M, true, videoclip.TagsTable, videoclip.TagsPrimaryKey...),
+		)
+		fromU = sqlgraph.SetNeighbors(vcq.driver.Dialect(), step)
+		return fromU, nil
+	}
+	return query
+}
+
 // First returns the first VideoClip entity from the query.
 // Returns a *NotFoundError when no VideoClip was found.
 func (vcq *VideoClipQuery) First(ctx context.Context) (*VideoClip, error) {
@@ -270,12 +319,14 @@ func (vcq *VideoClipQuery) Clone() *VideoClipQuery {
 		return nil
 	}
 	return &VideoClipQuery{
-		config:      vcq.config,
-		ctx:         vcq.ctx.Clone(),
-		order:       append([]videoclip.OrderOption{}, vcq.order...),
-		inters:      append([]Interceptor{}, vcq.inters...),
-		predicates:  append([]predicate.VideoClip{}, vcq.predicates...),
-		withProject: vcq.withProject.Clone(),
+		config:                   vcq.config,
+		ctx:                      vcq.ctx.Clone(),
+		order:                    append([]videoclip.OrderOption{}, vcq.order...),
+		inters:                   append([]Interceptor{}, vcq.inters...),
+		predicates:               append([]predicate.VideoClip{}, vcq.predicates...),
+		withProject:              vcq.withProject.Clone(),
+		withTranscriptEmbeddings: vcq.withTranscriptEmbeddings.Clone(),
+		withTags:                 vcq.withTags.Clone(),
 		// clone intermediate query.
 		sql:  vcq.sql.Clone(),
 		path: vcq.path,
@@ -293,6 +344,28 @@ func (vcq *VideoClipQuery) WithProject(opts ...func(*ProjectQuery)) *VideoClipQu
 	return vcq
 }
 
+// WithTranscriptEmbeddings tells the query-builder to eager-load the nodes that are connected to
+// the "transcript_embeddings" edge. The optional arguments are used to configure the query builder of the edge.
+func (vcq *VideoClipQuery) WithTranscriptEmbeddings(opts ...func(*TranscriptEmbeddingQuery)) *VideoClipQuery {
+	query := (&TranscriptEmbeddingClient{config: vcq.config}).Query()
+	for _, opt := range opts {
+		opt(query)
+	}
+	vcq.withTranscriptEmbeddings = query
+	return vcq
+}
+
+// WithTags tells the query-builder to eager-load the nodes that are connected to
+// the "tags" edge. The optional arguments are used to configure the query builder of the edge.
+func (vcq *VideoClipQuery) WithTags(opts ...func(*TagQuery)) *VideoClipQuery {
+	query := (&TagClient{config: vcq.config}).Query()
+	for _, opt := range opts {
+		opt(query)
+	}
+	vcq.withTags = query
+	return vcq
+}
+
 // GroupBy is used to group vertices by one or more fields/columns.
 // It is often used with aggregate functions, like: count, max, mean, min, sum.
 //
@@ -372,8 +445,10 @@ func (vcq *VideoClipQuery) sqlAll(ctx context.Context, hooks ...queryHook) ([]*V
 		nodes       = []*VideoClip{}
 		withFKs     = vcq.withFKs
 		_spec       = vcq.querySpec()
-		loadedTypes = [1]bool{
+		loadedTypes = [3]bool{
 			vcq.withProject != nil,
+			vcq.withTranscriptEmbeddings != nil,
+			vcq.withTags != nil,
 		}
 	)
 	if vcq.withProject != nil {
@@ -406,6 +481,22 @@ func (vcq *VideoClipQuery) sqlAll(ctx context.Context, hooks ...queryHook) ([]*V
 			return nil, err
 		}
 	}
+	if query := vcq.withTranscriptEmbeddings; query != nil {
+		if err := vcq.loadTranscriptEmbeddings(ctx, query, nodes,
+			func(n *VideoClip) { n.Edges.TranscriptEmbeddings = []*TranscriptEmbedding{} },
+			func(n *VideoClip, e *TranscriptEmbedding) {
+				n.Edges.TranscriptEmbeddings = append(n.Edges.TranscriptEmbeddings, e)
+			}); err != nil {
+			return nil, err
+		}
+	}
+	if query := vcq.withTags; query != nil {
+		if err := vcq.loadTags(ctx, query, nodes,
+			func(n *VideoClip) { n.Edges.Tags = []*Tag{} },
+			func(n *VideoClip, e *Tag) { n.Edges.Tags = append(n.Edges.Tags, e) }); err != nil {
+			return nil, err
+		}
+	}
 	return nodes, nil
 }
 
@@ -441,6 +532,97 @@ func (vcq *VideoClipQuery) loadProject(ctx context.Context, query *ProjectQuery,
 	}
 	return nil
 }
+func (vcq *VideoClipQuery) loadTranscriptEmbeddings(ctx context.Context, query *TranscriptEmbeddingQuery, nodes []*VideoClip, init func(*VideoClip), assign func(*VideoClip, *TranscriptEmbedding)) error {
+	fks := make([]driver.Value, 0, len(nodes))
+	nodeids := make(map[int]*VideoClip)
+	for i := range nodes {
+		fks = append(fks, nodes[i].ID)
+		nodeids[nodes[i].ID] = nodes[i]
+		if init != nil {
+			init(nodes[i])
+		}
+	}
+	if len(query.ctx.Fields) > 0 {
+		query.ctx.AppendFieldOnce(transcriptembedding.FieldVideoClipID)
+	}
+	query.Where(predicate.TranscriptEmbedding(func(s *sql.Selector) {
+		s.Where(sql.InValues(s.C(videoclip.TranscriptEmbeddingsColumn), fks...))
+	}))
+	neighbors, err := query.All(ctx)
+	if err != nil {
+		return err
+	}
+	for _, n := range neighbors {
+		fk := n.VideoClipID
+		node, ok := nodeids[fk]
+		if !ok {
+			return fmt.Errorf(`unexpected referenced foreign-key "video_clip_id" returned %v for node %v`, fk, n.ID)
+		}
+		assign(node, n)
+	}
+	return nil
+}
+func (vcq *VideoClipQuery) loadTags(ctx context.Context, query *TagQuery, nodes []*VideoClip, init func(*VideoClip), assign func(*VideoClip, *Tag)) error {
+	edgeIDs := make([]driver.Value, len(nodes))
+	byID := make(map[int]*VideoClip)
+	nids := make(map[int]map[*VideoClip]struct{})
+	for i, node := range nodes {
+		edgeIDs[i] = node.ID
+		byID[node.ID] = node
+		if init != nil {
+			init(node)
+		}
+	}
+	query.Where(func(s *sql.Selector) {
+		joinT := sql.Table(videoclip.TagsTable)
+		s.Join(joinT).On(s.C(tag.FieldID), joinT.C(videoclip.TagsPrimaryKey[0]))
+		s.Where(sql.InValues(joinT.C(videoclip.TagsPrimaryKey[1]), edgeIDs...))
+		columns := s.SelectedColumns()
+		s.Select(joinT.C(videoclip.TagsPrimaryKey[1]))
+		s.AppendSelect(columns...)
+		s.SetDistinct(false)
+	})
+	if err := query.prepareQuery(ctx); err != nil {
+		return err
+	}
+	qr := QuerierFunc(func(ctx context.Context, q Query) (Value, error) {
+		return query.sqlAll(ctx, func(_ context.Context, spec *sqlgraph.QuerySpec) {
+			assign := spec.Assign
+			values := spec.ScanValues
+			spec.ScanValues = func(columns []string) ([]any, error) {
+				values, err := values(columns[1:])
+				if err != nil {
+					return nil, err
+				}
+				return append([]any{new(sql.NullInt64)}, values...), nil
+			}
+			spec.Assign = func(columns []string, values []any) error {
+				outValue := int(values[0].(*sql.NullInt64).Int64)
+				inValue := int(values[1].(*sql.NullInt64).Int64)
+				if nids[inValue] == nil {
+					nids[inValue] = map[*VideoClip]struct{}{byID[outValue]: {}}
+					return assign(columns[1:], values[1:])
+				}
+				nids[inValue][byID[outValue]] = struct{}{}
+				return nil
+			}
+		})
+	})
+	neighbors, err := withInterceptors[[]*Tag](ctx, query, qr, query.inters)
+	if err != nil {
+		return err
+	}
+	for _, n := range neighbors {
+		nodes, ok := nids[n.ID]
+		if !ok {
+			return fmt.Errorf(`unexpected "tags" node returned %v`, n.ID)
+		}
+		for kn := range nodes {
+			assign(kn, n)
+		}
+	}
+	return nil
+}
 
 func (vcq *VideoClipQuery) sqlCount(ctx context.Context) (int, error) {
 	_spec := vcq.querySpec()
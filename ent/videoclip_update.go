@@ -3,13 +3,15 @@
 package ent
 
 import (
+	"context"
+	"errors"
+	"fmt"
 	"ramble-ai/ent/predicate"
 	"ramble-ai/ent/project"
 	"ramble-ai/ent/schema"
+	"ramble-ai/ent/tag"
+	"ramble-ai/ent/transcriptembedding"
 	"ramble-ai/ent/videoclip"
-	"context"
-	"errors"
-	"fmt"
 	"time"
 
 	"entgo.io/ent/dialect/sql"
@@ -328,6 +330,33 @@ func (vcu *VideoClipUpdate) ClearSuggestedHighlights() *VideoClipUpdate {
 	return vcu
 }
 
+// SetSuggestedHighlightsPromptID sets the "suggested_highlights_prompt_id" field.
+func (vcu *VideoClipUpdate) SetSuggestedHighlightsPromptID(i int) *VideoClipUpdate {
+	vcu.mutation.ResetSuggestedHighlightsPromptID()
+	vcu.mutation.SetSuggestedHighlightsPromptID(i)
+	return vcu
+}
+
+// SetNillableSuggestedHighlightsPromptID sets the "suggested_highlights_prompt_id" field if the given value is not nil.
+func (vcu *VideoClipUpdate) SetNillableSuggestedHighlightsPromptID(i *int) *VideoClipUpdate {
+	if i != nil {
+		vcu.SetSuggestedHighlightsPromptID(*i)
+	}
+	return vcu
+}
+
+// AddSuggestedHighlightsPromptID adds i to the "suggested_highlights_prompt_id" field.
+func (vcu *VideoClipUpdate) AddSuggestedHighlightsPromptID(i int) *VideoClipUpdate {
+	vcu.mutation.AddSuggestedHighlightsPromptID(i)
+	return vcu
+}
+
+// ClearSuggestedHighlightsPromptID clears the value of the "suggested_highlights_prompt_id" field.
+func (vcu *VideoClipUpdate) ClearSuggestedHighlightsPromptID() *VideoClipUpdate {
+	vcu.mutation.ClearSuggestedHighlightsPromptID()
+	return vcu
+}
+
 // SetCreatedAt sets the "created_at" field.
 func (vcu *VideoClipUpdate) SetCreatedAt(t time.Time) *VideoClipUpdate {
 	vcu.mutation.SetCreatedAt(t)
@@ -473,6 +502,26 @@ func (vcu *VideoClipUpdate) ClearTranscriptionCompletedAt() *VideoClipUpdate {
 	return vcu
 }
 
+// SetDeletedAt sets the "deleted_at" field.
+func (vcu *VideoClipUpdate) SetDeletedAt(t time.Time) *VideoClipUpdate {
+	vcu.mutation.SetDeletedAt(t)
+	return vcu
+}
+
+// SetNillableDeletedAt sets the "deleted_at" field if the given value is not nil.
+func (vcu *VideoClipUpdate) SetNillableDeletedAt(t *time.Time) *VideoClipUpdate {
+	if t != nil {
+		vcu.SetDeletedAt(*t)
+	}
+	return vcu
+}
+
+// ClearDeletedAt clears the value of the "deleted_at" field.
+func (vcu *VideoClipUpdate) ClearDeletedAt() *VideoClipUpdate {
+	vcu.mutation.ClearDeletedAt()
+	return vcu
+}
+
 // SetProjectID sets the "project" edge to the Project entity by ID.
 func (vcu *VideoClipUpdate) SetProjectID(id int) *VideoClipUpdate {
 	vcu.mutation.SetProjectID(id)
@@ -492,6 +541,36 @@ func (vcu *VideoClipUpdate) SetProject(p *Project) *VideoClipUpdate {
 	return vcu.SetProjectID(p.ID)
 }
 
+// AddTranscriptEmbeddingIDs adds the "transcript_embeddings" edge to the TranscriptEmbedding entity by IDs.
+func (vcu *VideoClipUpdate) AddTranscriptEmbeddingIDs(ids ...int) *VideoClipUpdate {
+	vcu.mutation.AddTranscriptEmbeddingIDs(ids...)
+	return vcu
+}
+
+// AddTranscriptEmbeddings adds the "transcript_embeddings" edges to the TranscriptEmbedding entity.
+func (vcu *VideoClipUpdate) AddTranscriptEmbeddings(t ...*TranscriptEmbedding) *VideoClipUpdate {
+	ids := make([]int, len(t))
+	for i := range t {
+		ids[i] = t[i].ID
+	}
+	return vcu.AddTranscriptEmbeddingIDs(ids...)
+}
+
+// AddTagIDs adds the "tags" edge to the Tag entity by IDs.
+func (vcu *VideoClipUpdate) AddTagIDs(ids ...int) *VideoClipUpdate {
+	vcu.mutation.AddTagIDs(ids...)
+	return vcu
+}
+
+// AddTags adds the "tags" edges to the Tag entity.
+func (vcu *VideoClipUpdate) AddTags(t ...*Tag) *VideoClipUpdate {
+	ids := make([]int, len(t))
+	for i := range t {
+		ids[i] = t[i].ID
+	}
+	return vcu.AddTagIDs(ids...)
+}
+
 // Mutation returns the VideoClipMutation object of the builder.
 func (vcu *VideoClipUpdate) Mutation() *VideoClipMutation {
 	return vcu.mutation
@@ -503,6 +582,48 @@ func (vcu *VideoClipUpdate) ClearProject() *VideoClipUpdate {
 	return vcu
 }
 
+// ClearTranscriptEmbeddings clears all "transcript_embeddings" edges to the TranscriptEmbedding entity.
+func (vcu *VideoClipUpdate) ClearTranscriptEmbeddings() *VideoClipUpdate {
+	vcu.mutation.ClearTranscriptEmbeddings()
+	return vcu
+}
+
+// RemoveTranscriptEmbeddingIDs removes the "transcript_embeddings" edge to TranscriptEmbedding entities by IDs.
+func (vcu *VideoClipUpdate) RemoveTranscriptEmbeddingIDs(ids ...int) *VideoClipUpdate {
+	vcu.mutation.RemoveTranscriptEmbeddingIDs(ids...)
+	return vcu
+}
+
+// RemoveTranscriptEmbeddings removes "transcript_embeddings" edges to TranscriptEmbedding entities.
+func (vcu *VideoClipUpdate) RemoveTranscriptEmbeddings(t ...*TranscriptEmbedding) *VideoClipUpdate {
+	ids := make([]int, len(t))
+	for i := range t {
+		ids[i] = t[i].ID
+	}
+	return vcu.RemoveTranscriptEmbeddingIDs(ids...)
+}
+
+// ClearTags clears all "tags" edges to the Tag entity.
+func (vcu *VideoClipUpdate) ClearTags() *VideoClipUpdate {
+	vcu.mutation.ClearTags()
+	return vcu
+}
+
+// RemoveTagIDs removes the "tags" edge to Tag entities by IDs.
+func (vcu *VideoClipUpdate) RemoveTagIDs(ids ...int) *VideoClipUpdate {
+	vcu.mutation.RemoveTagIDs(ids...)
+	return vcu
+}
+
+// RemoveTags removes "tags" edges to Tag entities.
+func (vcu *VideoClipUpdate) RemoveTags(t ...*Tag) *VideoClipUpdate {
+	ids := make([]int, len(t))
+	for i := range t {
+		ids[i] = t[i].ID
+	}
+	return vcu.RemoveTagIDs(ids...)
+}
+
 // Save executes the query and returns the number of nodes affected by the update operation.
 func (vcu *VideoClipUpdate) Save(ctx context.Context) (int, error) {
 	vcu.defaults()
@@ -674,6 +795,15 @@ func (vcu *VideoClipUpdate) sqlSave(ctx context.Context) (n int, err error) {
 	if vcu.mutation.SuggestedHighlightsCleared() {
 		_spec.ClearField(videoclip.FieldSuggestedHighlights, field.TypeJSON)
 	}
+	if value, ok := vcu.mutation.SuggestedHighlightsPromptID(); ok {
+		_spec.SetField(videoclip.FieldSuggestedHighlightsPromptID, field.TypeInt, value)
+	}
+	if value, ok := vcu.mutation.AddedSuggestedHighlightsPromptID(); ok {
+		_spec.AddField(videoclip.FieldSuggestedHighlightsPromptID, field.TypeInt, value)
+	}
+	if vcu.mutation.SuggestedHighlightsPromptIDCleared() {
+		_spec.ClearField(videoclip.FieldSuggestedHighlightsPromptID, field.TypeInt)
+	}
 	if value, ok := vcu.mutation.CreatedAt(); ok {
 		_spec.SetField(videoclip.FieldCreatedAt, field.TypeTime, value)
 	}
@@ -724,6 +854,12 @@ func (vcu *VideoClipUpdate) sqlSave(ctx context.Context) (n int, err error) {
 	if vcu.mutation.TranscriptionCompletedAtCleared() {
 		_spec.ClearField(videoclip.FieldTranscriptionCompletedAt, field.TypeTime)
 	}
+	if value, ok := vcu.mutation.DeletedAt(); ok {
+		_spec.SetField(videoclip.FieldDeletedAt, field.TypeTime, value)
+	}
+	if vcu.mutation.DeletedAtCleared() {
+		_spec.ClearField(videoclip.FieldDeletedAt, field.TypeTime)
+	}
 	if vcu.mutation.ProjectCleared() {
 		edge := &sqlgraph.EdgeSpec{
 			Rel:     sqlgraph.M2O,
@@ -753,6 +889,96 @@ func (vcu *VideoClipUpdate) sqlSave(ctx context.Context) (n int, err error) {
 		}
 		_spec.Edges.Add = append(_spec.Edges.Add, edge)
 	}
+	if vcu.mutation.TranscriptEmbeddingsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   videoclip.TranscriptEmbeddingsTable,
+			Columns: []string{videoclip.TranscriptEmbeddingsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(transcriptembedding.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := vcu.mutation.RemovedTranscriptEmbeddingsIDs(); len(nodes) > 0 && !vcu.mutation.TranscriptEmbeddingsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   videoclip.TranscriptEmbeddingsTable,
+			Columns: []string{videoclip.TranscriptEmbeddingsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(transcriptembedding.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := vcu.mutation.TranscriptEmbeddingsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   videoclip.TranscriptEmbeddingsTable,
+			Columns: []string{videoclip.TranscriptEmbeddingsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(transcriptembedding.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if vcu.mutation.TagsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2M,
+			Inverse: true,
+			Table:   videoclip.TagsTable,
+			Columns: videoclip.TagsPrimaryKey,
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(tag.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := vcu.mutation.RemovedTagsIDs(); len(nodes) > 0 && !vcu.mutation.TagsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2M,
+			Inverse: true,
+			Table:   videoclip.TagsTable,
+			Columns: videoclip.TagsPrimaryKey,
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(tag.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := vcu.mutation.TagsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2M,
+			Inverse: true,
+			Table:   videoclip.TagsTable,
+			Columns: videoclip.TagsPrimaryKey,
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(tag.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
 	if n, err = sqlgraph.UpdateNodes(ctx, vcu.driver, _spec); err != nil {
 		if _, ok := err.(*sqlgraph.NotFoundError); ok {
 			err = &NotFoundError{videoclip.Label}
@@ -1070,6 +1296,33 @@ func (vcuo *VideoClipUpdateOne) ClearSuggestedHighlights() *VideoClipUpdateOne {
 	return vcuo
 }
 
+// SetSuggestedHighlightsPromptID sets the "suggested_highlights_prompt_id" field.
+func (vcuo *VideoClipUpdateOne) SetSuggestedHighlightsPromptID(i int) *VideoClipUpdateOne {
+	vcuo.mutation.ResetSuggestedHighlightsPromptID()
+	vcuo.mutation.SetSuggestedHighlightsPromptID(i)
+	return vcuo
+}
+
+// SetNillableSuggestedHighlightsPromptID sets the "suggested_highlights_prompt_id" field if the given value is not nil.
+func (vcuo *VideoClipUpdateOne) SetNillableSuggestedHighlightsPromptID(i *int) *VideoClipUpdateOne {
+	if i != nil {
+		vcuo.SetSuggestedHighlightsPromptID(*i)
+	}
+	return vcuo
+}
+
+// AddSuggestedHighlightsPromptID adds i to the "suggested_highlights_prompt_id" field.
+func (vcuo *VideoClipUpdateOne) AddSuggestedHighlightsPromptID(i int) *VideoClipUpdateOne {
+	vcuo.mutation.AddSuggestedHighlightsPromptID(i)
+	return vcuo
+}
+
+// ClearSuggestedHighlightsPromptID clears the value of the "suggested_highlights_prompt_id" field.
+func (vcuo *VideoClipUpdateOne) ClearSuggestedHighlightsPromptID() *VideoClipUpdateOne {
+	vcuo.mutation.ClearSuggestedHighlightsPromptID()
+	return vcuo
+}
+
 // SetCreatedAt sets the "created_at" field.
 func (vcuo *VideoClipUpdateOne) SetCreatedAt(t time.Time) *VideoClipUpdateOne {
 	vcuo.mutation.SetCreatedAt(t)
@@ -1215,6 +1468,26 @@ func (vcuo *VideoClipUpdateOne) ClearTranscriptionCompletedAt() *VideoClipUpdate
 	return vcuo
 }
 
+// SetDeletedAt sets the "deleted_at" field.
+func (vcuo *VideoClipUpdateOne) SetDeletedAt(t time.Time) *VideoClipUpdateOne {
+	vcuo.mutation.SetDeletedAt(t)
+	return vcuo
+}
+
+// SetNillableDeletedAt sets the "deleted_at" field if the given value is not nil.
+func (vcuo *VideoClipUpdateOne) SetNillableDeletedAt(t *time.Time) *VideoClipUpdateOne {
+	if t != nil {
+		vcuo.SetDeletedAt(*t)
+	}
+	return vcuo
+}
+
+// ClearDeletedAt clears the value of the "deleted_at" field.
+func (vcuo *VideoClipUpdateOne) ClearDeletedAt() *VideoClipUpdateOne {
+	vcuo.mutation.ClearDeletedAt()
+	return vcuo
+}
+
 // SetProjectID sets the "project" edge to the Project entity by ID.
 func (vcuo *VideoClipUpdateOne) SetProjectID(id int) *VideoClipUpdateOne {
 	vcuo.mutation.SetProjectID(id)
@@ -1234,6 +1507,36 @@ func (vcuo *VideoClipUpdateOne) SetProject(p *Project) *VideoClipUpdateOne {
 	return vcuo.SetProjectID(p.ID)
 }
 
+// AddTranscriptEmbeddingIDs adds the "transcript_embeddings" edge to the TranscriptEmbedding entity by IDs.
+func (vcuo *VideoClipUpdateOne) AddTranscriptEmbeddingIDs(ids ...int) *VideoClipUpdateOne {
+	vcuo.mutation.AddTranscriptEmbeddingIDs(ids...)
+	return vcuo
+}
+
+// AddTranscriptEmbeddings adds the "transcript_embeddings" edges to the TranscriptEmbedding entity.
+func (vcuo *VideoClipUpdateOne) AddTranscriptEmbeddings(t ...*TranscriptEmbedding) *VideoClipUpdateOne {
+	ids := make([]int, len(t))
+	for i := range t {
+		ids[i] = t[i].ID
+	}
+	return vcuo.AddTranscriptEmbeddingIDs(ids...)
+}
+
+// AddTagIDs adds the "tags" edge to the Tag entity by IDs.
+func (vcuo *VideoClipUpdateOne) AddTagIDs(ids ...int) *VideoClipUpdateOne {
+	vcuo.mutation.AddTagIDs(ids...)
+	return vcuo
+}
+
+// AddTags adds the "tags" edges to the Tag entity.
+func (vcuo *VideoClipUpdateOne) AddTags(t ...*Tag) *VideoClipUpdateOne {
+	ids := make([]int, len(t))
+	for i := range t {
+		ids[i] = t[i].ID
+	}
+	return vcuo.AddTagIDs(ids...)
+}
+
 // Mutation returns the VideoClipMutation object of the builder.
 func (vcuo *VideoClipUpdateOne) Mutation() *VideoClipMutation {
 	return vcuo.mutation
@@ -1245,6 +1548,48 @@ func (vcuo *VideoClipUpdateOne) ClearProject() *VideoClipUpdateOne {
 	return vcuo
 }
 
+// ClearTranscriptEmbeddings clears all "transcript_embeddings" edges to the TranscriptEmbedding entity.
+func (vcuo *VideoClipUpdateOne) ClearTranscriptEmbeddings() *VideoClipUpdateOne {
+	vcuo.mutation.ClearTranscriptEmbeddings()
+	return vcuo
+}
+
+// RemoveTranscriptEmbeddingIDs removes the "transcript_embeddings" edge to TranscriptEmbedding entities by IDs.
+func (vcuo *VideoClipUpdateOne) RemoveTranscriptEmbeddingIDs(ids ...int) *VideoClipUpdateOne {
+	vcuo.mutation.RemoveTranscriptEmbeddingIDs(ids...)
+	return vcuo
+}
+
+// RemoveTranscriptEmbeddings removes "transcript_embeddings" edges to TranscriptEmbedding entities.
+func (vcuo *VideoClipUpdateOne) RemoveTranscriptEmbeddings(t ...*TranscriptEmbedding) *VideoClipUpdateOne {
+	ids := make([]int, len(t))
+	for i := range t {
+		ids[i] = t[i].ID
+	}
+	return vcuo.RemoveTranscriptEmbeddingIDs(ids...)
+}
+
+// ClearTags clears all "tags" edges to the Tag entity.
+func (vcuo *VideoClipUpdateOne) ClearTags() *VideoClipUpdateOne {
+	vcuo.mutation.ClearTags()
+	return vcuo
+}
+
+// RemoveTagIDs removes the "tags" edge to Tag entities by IDs.
+func (vcuo *VideoClipUpdateOne) RemoveTagIDs(ids ...int) *VideoClipUpdateOne {
+	vcuo.mutation.RemoveTagIDs(ids...)
+	return vcuo
+}
+
+// RemoveTags removes "tags" edges to Tag entities.
+func (vcuo *VideoClipUpdateOne) RemoveTags(t ...*Tag) *VideoClipUpdateOne {
+	ids := make([]int, len(t))
+	for i := range t {
+		ids[i] = t[i].ID
+	}
+	return vcuo.RemoveTagIDs(ids...)
+}
+
 // Where appends a list predicates to the VideoClipUpdate builder.
 func (vcuo *VideoClipUpdateOne) Where(ps ...predicate.VideoClip) *VideoClipUpdateOne {
 	vcuo.mutation.Where(ps...)
@@ -1446,6 +1791,15 @@ func (vcuo *VideoClipUpdateOne) sqlSave(ctx context.Context) (_node *VideoClip,
 	if vcuo.mutation.SuggestedHighlightsCleared() {
 		_spec.ClearField(videoclip.FieldSuggestedHighlights, field.TypeJSON)
 	}
+	if value, ok := vcuo.mutation.SuggestedHighlightsPromptID(); ok {
+		_spec.SetField(videoclip.FieldSuggestedHighlightsPromptID, field.TypeInt, value)
+	}
+	if value, ok := vcuo.mutation.AddedSuggestedHighlightsPromptID(); ok {
+		_spec.AddField(videoclip.FieldSuggestedHighlightsPromptID, field.TypeInt, value)
+	}
+	if vcuo.mutation.SuggestedHighlightsPromptIDCleared() {
+		_spec.ClearField(videoclip.FieldSuggestedHighlightsPromptID, field.TypeInt)
+	}
 	if value, ok := vcuo.mutation.CreatedAt(); ok {
 		_spec.SetField(videoclip.FieldCreatedAt, field.TypeTime, value)
 	}
@@ -1496,6 +1850,12 @@ func (vcuo *VideoClipUpdateOne) sqlSave(ctx context.Context) (_node *VideoClip,
 	if vcuo.mutation.TranscriptionCompletedAtCleared() {
 		_spec.ClearField(videoclip.FieldTranscriptionCompletedAt, field.TypeTime)
 	}
+	if value, ok := vcuo.mutation.DeletedAt(); ok {
+		_spec.SetField(videoclip.FieldDeletedAt, field.TypeTime, value)
+	}
+	if vcuo.mutation.DeletedAtCleared() {
+		_spec.ClearField(videoclip.FieldDeletedAt, field.TypeTime)
+	}
 	if vcuo.mutation.ProjectCleared() {
 		edge := &sqlgraph.EdgeSpec{
 			Rel:     sqlgraph.M2O,
@@ -1525,6 +1885,96 @@ func (vcuo *VideoClipUpdateOne) sqlSave(ctx context.Context) (_node *VideoClip,
 		}
 		_spec.Edges.Add = append(_spec.Edges.Add, edge)
 	}
+	if vcuo.mutation.TranscriptEmbeddingsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   videoclip.TranscriptEmbeddingsTable,
+			Columns: []string{videoclip.TranscriptEmbeddingsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(transcriptembedding.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := vcuo.mutation.RemovedTranscriptEmbeddingsIDs(); len(nodes) > 0 && !vcuo.mutation.TranscriptEmbeddingsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   videoclip.TranscriptEmbeddingsTable,
+			Columns: []string{videoclip.TranscriptEmbeddingsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(transcriptembedding.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := vcuo.mutation.TranscriptEmbeddingsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   videoclip.TranscriptEmbeddingsTable,
+			Columns: []string{videoclip.TranscriptEmbeddingsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(transcriptembedding.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if vcuo.mutation.TagsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2M,
+			Inverse: true,
+			Table:   videoclip.TagsTable,
+			Columns: videoclip.TagsPrimaryKey,
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(tag.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := vcuo.mutation.RemovedTagsIDs(); len(nodes) > 0 && !vcuo.mutation.TagsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2M,
+			Inverse: true,
+			Table:   videoclip.TagsTable,
+			Columns: videoclip.TagsPrimaryKey,
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(tag.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := vcuo.mutation.TagsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2M,
+			Inverse: true,
+			Table:   videoclip.TagsTable,
+			Columns: videoclip.TagsPrimaryKey,
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(tag.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
 	_node = &VideoClip{config: vcuo.config}
 	_spec.Assign = _node.assignValues
 	_spec.ScanValues = _node.scanValues
@@ -6,6 +6,7 @@ import (
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
 
+	"ramble-ai/ent/usagerecord"
 	"ramble-ai/goapp"
 )
 
@@ -214,4 +215,54 @@ func TestApiKeyService_EdgeCases(t *testing.T) {
 		require.NoError(t, err)
 		assert.Equal(t, whitespaceKey, result) // Should preserve whitespace
 	})
-}
\ No newline at end of file
+}
+func TestParseTextResponseTokensUsed(t *testing.T) {
+	response := &OpenRouterResponse{
+		Choices: []Choice{{Message: Message{Role: "assistant", Content: "ok"}}},
+		Usage:   &OpenRouterUsage{PromptTokens: 120, CompletionTokens: 30, TotalTokens: 150},
+	}
+
+	result, err := ParseTextResponse(response, "chat")
+	require.NoError(t, err)
+	assert.Equal(t, 150, result.TokensUsed)
+
+	response.Usage = nil
+	result, err = ParseTextResponse(response, "chat")
+	require.NoError(t, err)
+	assert.Zero(t, result.TokensUsed)
+}
+
+func TestRecordTextUsage(t *testing.T) {
+	helper := goapp.NewTestHelper(t)
+	project := helper.CreateTestProject("Metered")
+
+	request := &TextProcessingRequest{Model: "anthropic/claude-3.5-haiku", TaskType: "suggest_highlights", Context: map[string]interface{}{"projectID": float64(project.ID)}}
+	reportedCost := 0.002
+	recordTextUsage(helper.Client, helper.Ctx, ProviderOpenRouter, request, &OpenRouterResponse{
+		Model: "anthropic/claude-3.5-haiku-20241022",
+		Usage: &OpenRouterUsage{PromptTokens: 900, CompletionTokens: 100, TotalTokens: 1000, Cost: &reportedCost},
+	})
+
+	record, err := helper.Client.UsageRecord.Query().Only(helper.Ctx)
+	require.NoError(t, err)
+	assert.Equal(t, project.ID, record.ProjectID)
+	assert.Equal(t, ProviderOpenRouter, record.Provider)
+	assert.Equal(t, "suggest_highlights", record.TaskType)
+	assert.Equal(t, "anthropic/claude-3.5-haiku-20241022", record.Model)
+	assert.Equal(t, 1000, record.TotalTokens)
+	assert.Equal(t, 0.002, record.CostUsd)
+	assert.False(t, record.CostEstimated)
+
+	// Without a reported cost, the cost is estimated from the model catalog's prices
+	info, ok := LookupModel("anthropic/claude-3.5-haiku-20241022")
+	require.True(t, ok)
+	recordTextUsage(helper.Client, helper.Ctx, ProviderAnthropic, request, &OpenRouterResponse{
+		Model: "claude-3.5-haiku-20241022",
+		Usage: &OpenRouterUsage{PromptTokens: 1_000_000, CompletionTokens: 100_000},
+	})
+	record, err = helper.Client.UsageRecord.Query().Where(usagerecord.Provider(ProviderAnthropic)).Only(helper.Ctx)
+	require.NoError(t, err)
+	assert.True(t, record.CostEstimated)
+	assert.InDelta(t, info.PromptPrice+info.CompletionPrice/10, record.CostUsd, 1e-9)
+	assert.InDelta(t, 1.2, record.CostUsd, 1e-9)
+}
@@ -0,0 +1,273 @@
+package ai
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"log"
+	"slices"
+	"sync"
+	"sync/atomic"
+	"time"
+
+	"ramble-ai/ent"
+	"ramble-ai/ent/responsecacheentry"
+	"ramble-ai/goapp/settings"
+)
+
+// responseCacheSettingsKey is the setting holding CacheSettings as JSON
+const responseCacheSettingsKey = "ai_response_cache"
+
+// defaultCacheSettings are used until the cache settings are saved. Chat replies are not cached
+// because users expect a fresh answer when they ask again.
+var defaultCacheSettings = CacheSettings{
+	Enabled:       true,
+	TTLHours:      7 * 24,
+	DisabledTasks: []string{"chat"},
+}
+
+// CacheSettings control which text responses are cached and for how long
+type CacheSettings struct {
+	Enabled       bool     `json:"enabled"`
+	TTLHours      float64  `json:"ttlHours"`
+	DisabledTasks []string `json:"disabledTasks"` // task types that always call the model
+}
+
+// CacheStats reports cache effectiveness since the app started and the entries currently stored
+type CacheStats struct {
+	Hits         int64   `json:"hits"`
+	Misses       int64   `json:"misses"`
+	Deduplicated int64   `json:"deduplicated"` // requests that waited for an identical request in flight
+	HitRate      float64 `json:"hitRate"`
+	Entries      int     `json:"entries"` // unexpired cached responses
+}
+
+// cacheCounters are the process-wide hit/miss counters
+var cacheCounters struct {
+	hits, misses, deduplicated atomic.Int64
+}
+
+// inFlightCall is a model request other identical requests can wait for
+type inFlightCall struct {
+	done     chan struct{}
+	response *OpenRouterResponse
+	err      error
+}
+
+// inFlight holds the requests currently being sent, by cache key
+var inFlight = struct {
+	sync.Mutex
+	calls map[string]*inFlightCall
+}{calls: map[string]*inFlightCall{}}
+
+// ResponseCache is a content-addressed cache of text responses. Identical requests (same provider,
+// model, task, prompts and response format) are answered from the cache, and concurrent identical
+// requests share a single model call.
+type ResponseCache struct {
+	client *ent.Client
+	ctx    context.Context
+}
+
+// NewResponseCache creates a new response cache
+func NewResponseCache(client *ent.Client, ctx context.Context) *ResponseCache {
+	return &ResponseCache{
+		client: client,
+		ctx:    ctx,
+	}
+}
+
+// CacheKey returns the content address of a text request
+func CacheKey(request *TextProcessingRequest) string {
+	content, _ := json.Marshal(struct {
+		Provider       string                   `json:"provider,omitempty"`
+		Model          string                   `json:"model"`
+		TaskType       string                   `json:"task_type"`
+		SystemPrompt   string                   `json:"system_prompt"`
+		UserPrompt     string                   `json:"user_prompt"`
+		ResponseFormat *ResponseFormat          `json:"response_format,omitempty"`
+		Tools          []map[string]interface{} `json:"tools,omitempty"`
+	}{request.Provider, request.Model, request.TaskType, request.SystemPrompt, request.UserPrompt, request.ResponseFormat, request.Tools})
+	sum := sha256.Sum256(content)
+	return hex.EncodeToString(sum[:])
+}
+
+// Do answers a text request from the cache, or sends it with call and caches the response.
+// Requests are sent uncached when the cache is disabled, the task is opted out or the request sets NoCache.
+func (c *ResponseCache) Do(request *TextProcessingRequest, call func() (*OpenRouterResponse, error)) (*OpenRouterResponse, error) {
+	if c.client == nil || request.NoCache {
+		return call()
+	}
+	cacheSettings, err := c.GetSettings()
+	if err != nil {
+		log.Printf("[AI_CACHE] Warning: %v", err)
+		return call()
+	}
+	if !cacheSettings.Enabled || slices.Contains(cacheSettings.DisabledTasks, request.TaskType) {
+		return call()
+	}
+
+	key := CacheKey(request)
+	if response, ok := c.lookup(key); ok {
+		cacheCounters.hits.Add(1)
+		log.Printf("[AI_CACHE] Hit for %s (%s)", request.TaskType, request.Model)
+		return response, nil
+	}
+
+	response, err, shared := doOnce(key, call)
+	if shared {
+		cacheCounters.deduplicated.Add(1)
+		return response, err
+	}
+	cacheCounters.misses.Add(1)
+	if err == nil {
+		c.store(key, request, response, time.Duration(cacheSettings.TTLHours*float64(time.Hour)))
+	}
+	return response, err
+}
+
+// Invalidate removes the cached responses of a task type, or every cached response when taskType is empty
+func (c *ResponseCache) Invalidate(taskType string) (int, error) {
+	remove := c.client.ResponseCacheEntry.Delete()
+	if taskType != "" {
+		remove.Where(responsecacheentry.TaskType(taskType))
+	}
+
+	removed, err := remove.Exec(c.ctx)
+	if err != nil {
+		return 0, fmt.Errorf("failed to invalidate AI response cache: %w", err)
+	}
+
+	log.Printf("[AI_CACHE] Invalidated %d cached responses (task: %q)", removed, taskType)
+	return removed, nil
+}
+
+// Stats returns the hit/miss counters and the number of unexpired entries
+func (c *ResponseCache) Stats() (*CacheStats, error) {
+	entries, err := c.client.ResponseCacheEntry.
+		Query().
+		Where(responsecacheentry.ExpiresAtGT(time.Now())).
+		Count(c.ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to count cached AI responses: %w", err)
+	}
+
+	stats := &CacheStats{
+		Hits:         cacheCounters.hits.Load(),
+		Misses:       cacheCounters.misses.Load(),
+		Deduplicated: cacheCounters.deduplicated.Load(),
+		Entries:      entries,
+	}
+	if lookups := stats.Hits + stats.Misses; lookups > 0 {
+		stats.HitRate = float64(stats.Hits) / float64(lookups)
+	}
+	return stats, nil
+}
+
+// GetSettings returns the saved cache settings, or the defaults when none were saved
+func (c *ResponseCache) GetSettings() (*CacheSettings, error) {
+	value, err := settings.NewSettingsService(c.client, c.ctx).GetSetting(responseCacheSettingsKey)
+	if err != nil {
+		return nil, err
+	}
+
+	cacheSettings := defaultCacheSettings
+	if value == "" {
+		return &cacheSettings, nil
+	}
+	if err := json.Unmarshal([]byte(value), &cacheSettings); err != nil {
+		return nil, fmt.Errorf("failed to parse AI response cache settings: %w", err)
+	}
+	return &cacheSettings, nil
+}
+
+// SaveSettings saves the cache settings
+func (c *ResponseCache) SaveSettings(cacheSettings CacheSettings) error {
+	if cacheSettings.TTLHours <= 0 {
+		return fmt.Errorf("cache TTL must be positive")
+	}
+	if cacheSettings.DisabledTasks == nil {
+		cacheSettings.DisabledTasks = []string{}
+	}
+
+	value, err := json.Marshal(cacheSettings)
+	if err != nil {
+		return fmt.Errorf("failed to marshal AI response cache settings: %w", err)
+	}
+	return settings.NewSettingsService(c.client, c.ctx).SaveSetting(responseCacheSettingsKey, string(value))
+}
+
+// lookup returns the unexpired cached response for a key
+func (c *ResponseCache) lookup(key string) (*OpenRouterResponse, bool) {
+	entry, err := c.client.ResponseCacheEntry.
+		Query().
+		Where(responsecacheentry.Key(key), responsecacheentry.ExpiresAtGT(time.Now())).
+		Only(c.ctx)
+	if err != nil {
+		return nil, false
+	}
+
+	var response OpenRouterResponse
+	if err := json.Unmarshal([]byte(entry.Response), &response); err != nil {
+		log.Printf("[AI_CACHE] Warning: discarding unreadable cached response: %v", err)
+		return nil, false
+	}
+
+	if err := c.client.ResponseCacheEntry.UpdateOne(entry).AddHits(1).Exec(c.ctx); err != nil {
+		log.Printf("[AI_CACHE] Warning: failed to count cache hit: %v", err)
+	}
+	return &response, true
+}
+
+// store caches a response under a key, replacing an expired entry and dropping other expired ones
+func (c *ResponseCache) store(key string, request *TextProcessingRequest, response *OpenRouterResponse, ttl time.Duration) {
+	data, err := json.Marshal(response)
+	if err != nil {
+		log.Printf("[AI_CACHE] Warning: failed to marshal response: %v", err)
+		return
+	}
+
+	now := time.Now()
+	if _, err := c.client.ResponseCacheEntry.Delete().
+		Where(responsecacheentry.Or(responsecacheentry.Key(key), responsecacheentry.ExpiresAtLTE(now))).
+		Exec(c.ctx); err != nil {
+		log.Printf("[AI_CACHE] Warning: failed to remove expired responses: %v", err)
+	}
+
+	err = c.client.ResponseCacheEntry.
+		Create().
+		SetKey(key).
+		SetTaskType(request.TaskType).
+		SetModel(request.Model).
+		SetResponse(string(data)).
+		SetExpiresAt(now.Add(ttl)).
+		Exec(c.ctx)
+	if err != nil {
+		log.Printf("[AI_CACHE] Warning: failed to cache response: %v", err)
+	}
+}
+
+// doOnce runs call for a key unless an identical call is already in flight, in which case it waits
+// for that call and shares its result. shared reports whether the result came from another call.
+func doOnce(key string, call func() (*OpenRouterResponse, error)) (response *OpenRouterResponse, err error, shared bool) {
+	inFlight.Lock()
+	if existing, ok := inFlight.calls[key]; ok {
+		inFlight.Unlock()
+		<-existing.done
+		return existing.response, existing.err, true
+	}
+	current := &inFlightCall{done: make(chan struct{})}
+	inFlight.calls[key] = current
+	inFlight.Unlock()
+
+	defer func() {
+		inFlight.Lock()
+		delete(inFlight.calls, key)
+		inFlight.Unlock()
+		close(current.done)
+	}()
+
+	current.response, current.err = call()
+	return current.response, current.err, false
+}
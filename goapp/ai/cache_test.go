@@ -0,0 +1,169 @@
+package ai
+
+import (
+	"errors"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"ramble-ai/ent/responsecacheentry"
+	"ramble-ai/goapp"
+)
+
+func textResponse(content string) *OpenRouterResponse {
+	return &OpenRouterResponse{Choices: []Choice{{Message: Message{Role: "assistant", Content: content}}}}
+}
+
+func TestCacheKey(t *testing.T) {
+	base := TextProcessingRequest{Model: "m", TaskType: "reorder", SystemPrompt: "s", UserPrompt: "u"}
+	key := CacheKey(&base)
+	assert.Len(t, key, 64)
+
+	withContext := base
+	withContext.Context = map[string]interface{}{"projectID": 7}
+	assert.Equal(t, key, CacheKey(&withContext), "context does not change the content address")
+
+	for name, change := range map[string]func(*TextProcessingRequest){
+		"model":           func(r *TextProcessingRequest) { r.Model = "other" },
+		"task":            func(r *TextProcessingRequest) { r.TaskType = "silence" },
+		"system prompt":   func(r *TextProcessingRequest) { r.SystemPrompt = "other" },
+		"user prompt":     func(r *TextProcessingRequest) { r.UserPrompt = "other" },
+		"response format": func(r *TextProcessingRequest) { r.ResponseFormat = &ResponseFormat{Type: "json_object"} },
+	} {
+		changed := base
+		change(&changed)
+		assert.NotEqual(t, key, CacheKey(&changed), name)
+	}
+}
+
+func TestResponseCacheDo(t *testing.T) {
+	helper := goapp.NewTestHelper(t)
+	cache := NewResponseCache(helper.Client, helper.Ctx)
+	before, err := cache.Stats()
+	require.NoError(t, err)
+
+	calls := 0
+	call := func() (*OpenRouterResponse, error) {
+		calls++
+		return textResponse("ordered"), nil
+	}
+	request := &TextProcessingRequest{Model: "m", TaskType: "reorder", UserPrompt: "reorder these"}
+
+	first, err := cache.Do(request, call)
+	require.NoError(t, err)
+	second, err := cache.Do(request, call)
+	require.NoError(t, err)
+	assert.Equal(t, 1, calls, "identical request is served from the cache")
+	assert.Equal(t, first.Choices[0].Message.Content, second.Choices[0].Message.Content)
+
+	noCache := *request
+	noCache.NoCache = true
+	_, err = cache.Do(&noCache, call)
+	require.NoError(t, err)
+	assert.Equal(t, 2, calls, "NoCache always calls the model")
+
+	chat := &TextProcessingRequest{Model: "m", TaskType: "chat", UserPrompt: "hi"}
+	_, err = cache.Do(chat, call)
+	require.NoError(t, err)
+	_, err = cache.Do(chat, call)
+	require.NoError(t, err)
+	assert.Equal(t, 4, calls, "chat is opted out by default")
+
+	failing := &TextProcessingRequest{Model: "m", TaskType: "reorder", UserPrompt: "fails"}
+	_, err = cache.Do(failing, func() (*OpenRouterResponse, error) { return nil, errors.New("boom") })
+	assert.EqualError(t, err, "boom")
+	_, err = cache.Do(failing, call)
+	require.NoError(t, err)
+	assert.Equal(t, 5, calls, "errors are not cached")
+
+	_, err = helper.Client.ResponseCacheEntry.Update().
+		Where(responsecacheentry.Key(CacheKey(request))).
+		SetExpiresAt(time.Now().Add(-time.Minute)).
+		Save(helper.Ctx)
+	require.NoError(t, err)
+	_, err = cache.Do(request, call)
+	require.NoError(t, err)
+	assert.Equal(t, 6, calls, "expired responses are not served")
+
+	after, err := cache.Stats()
+	require.NoError(t, err)
+	assert.Equal(t, int64(1), after.Hits-before.Hits)
+	assert.Equal(t, int64(4), after.Misses-before.Misses)
+	assert.Equal(t, 2, after.Entries)
+
+	removed, err := cache.Invalidate("silence")
+	require.NoError(t, err)
+	assert.Zero(t, removed)
+	removed, err = cache.Invalidate("reorder")
+	require.NoError(t, err)
+	assert.Equal(t, 2, removed)
+	_, err = cache.Do(request, call)
+	require.NoError(t, err)
+	assert.Equal(t, 7, calls, "invalidated responses are not served")
+}
+
+func TestResponseCacheSettings(t *testing.T) {
+	helper := goapp.NewTestHelper(t)
+	cache := NewResponseCache(helper.Client, helper.Ctx)
+
+	cacheSettings, err := cache.GetSettings()
+	require.NoError(t, err)
+	assert.Equal(t, defaultCacheSettings, *cacheSettings)
+
+	assert.EqualError(t, cache.SaveSettings(CacheSettings{Enabled: true}), "cache TTL must be positive")
+	require.NoError(t, cache.SaveSettings(CacheSettings{Enabled: false, TTLHours: 1}))
+
+	cacheSettings, err = cache.GetSettings()
+	require.NoError(t, err)
+	assert.Equal(t, CacheSettings{Enabled: false, TTLHours: 1, DisabledTasks: []string{}}, *cacheSettings)
+
+	calls := 0
+	request := &TextProcessingRequest{Model: "m", TaskType: "reorder", UserPrompt: "u"}
+	for i := 0; i < 2; i++ {
+		_, err = cache.Do(request, func() (*OpenRouterResponse, error) {
+			calls++
+			return textResponse("x"), nil
+		})
+		require.NoError(t, err)
+	}
+	assert.Equal(t, 2, calls, "a disabled cache always calls the model")
+}
+
+func TestDoOnceDeduplicatesInFlightRequests(t *testing.T) {
+	release := make(chan struct{})
+	started := make(chan struct{})
+	var calls sync.WaitGroup
+	calls.Add(2)
+
+	var firstShared, secondShared bool
+	go func() {
+		defer calls.Done()
+		_, _, firstShared = doOnce("same", func() (*OpenRouterResponse, error) {
+			close(started)
+			<-release
+			return textResponse("shared"), nil
+		})
+	}()
+
+	<-started
+	var second *OpenRouterResponse
+	go func() {
+		defer calls.Done()
+		second, _, secondShared = doOnce("same", func() (*OpenRouterResponse, error) {
+			t.Error("identical in-flight request should not call the model")
+			return nil, nil
+		})
+	}()
+
+	time.Sleep(50 * time.Millisecond)
+	close(release)
+	calls.Wait()
+
+	assert.False(t, firstShared)
+	assert.True(t, secondShared)
+	require.NotNil(t, second)
+	assert.Equal(t, "shared", second.Choices[0].Message.Content)
+}
@@ -37,6 +37,14 @@ type TextProcessingRequest struct {
 	Model        string                 `json:"model"`
 	TaskType     string                 `json:"task_type"` // "suggest_highlights", "reorder", "improve_silences", "chat"
 	Context      map[string]interface{} `json:"context,omitempty"`
+	// ResponseFormat constrains the reply to a JSON schema (see CompleteStructured)
+	ResponseFormat *ResponseFormat `json:"response_format,omitempty"`
+	// NoCache always calls the model, bypassing the response cache
+	NoCache bool `json:"no_cache,omitempty"`
+	// Provider is the endpoint serving the request, set by ProcessText and part of the cache key
+	Provider string `json:"provider,omitempty"`
+	// Tools are functions the model may call, in the OpenAI tool format
+	Tools []map[string]interface{} `json:"tools,omitempty"`
 }
 
 // AudioProcessingResult represents the result of audio processing
@@ -78,27 +86,61 @@ type TextProcessingResult struct {
 	TokensUsed int         `json:"tokens_used,omitempty"`
 }
 
-// OpenRouterRequest represents the request format for OpenRouter API
+// OpenRouterRequest represents the request format of OpenRouter and other OpenAI-style chat completion APIs
 type OpenRouterRequest struct {
-	Model    string    `json:"model"`
-	Messages []Message `json:"messages"`
+	Model          string                   `json:"model"`
+	Messages       []Message                `json:"messages"`
+	ResponseFormat *ResponseFormat          `json:"response_format,omitempty"`
+	Usage          *UsageOptions            `json:"usage,omitempty"`
+	Tools          []map[string]interface{} `json:"tools,omitempty"`
+	Stream         bool                     `json:"stream,omitempty"`
+	StreamOptions  *StreamOptions           `json:"stream_options,omitempty"`
+}
+
+// UsageOptions asks OpenRouter to report token counts and cost in the response
+type UsageOptions struct {
+	Include bool `json:"include"`
 }
 
 // Message represents a chat message
 type Message struct {
-	Role    string `json:"role"`
-	Content string `json:"content"`
+	Role      string     `json:"role"`
+	Content   string     `json:"content"`
+	ToolCalls []ToolCall `json:"tool_calls,omitempty"`
+}
+
+// ToolCall is a function call requested by the model
+type ToolCall struct {
+	ID       string           `json:"id,omitempty"`
+	Type     string           `json:"type,omitempty"`
+	Function ToolCallFunction `json:"function"`
+}
+
+// ToolCallFunction is the function name and JSON-encoded arguments of a tool call
+type ToolCallFunction struct {
+	Name      string `json:"name"`
+	Arguments string `json:"arguments"`
 }
 
 // OpenRouterResponse represents the response from OpenRouter API
 type OpenRouterResponse struct {
-	Choices []Choice `json:"choices"`
+	Choices []Choice         `json:"choices"`
+	Model   string           `json:"model,omitempty"`
+	Usage   *OpenRouterUsage `json:"usage,omitempty"`
 	Error   *struct {
 		Message string `json:"message"`
 		Type    string `json:"type"`
 	} `json:"error,omitempty"`
 }
 
+// OpenRouterUsage is the token usage and cost OpenRouter reports for a completion
+type OpenRouterUsage struct {
+	PromptTokens     int      `json:"prompt_tokens"`
+	CompletionTokens int      `json:"completion_tokens"`
+	TotalTokens      int      `json:"total_tokens"`
+	Cost             *float64 `json:"cost,omitempty"` // US dollars, only when usage accounting was requested
+}
+
 // Choice represents a response choice
 type Choice struct {
 	Message Message `json:"message"`
@@ -199,6 +241,10 @@ func (s *CoreAIService) ProcessAudio(audioFile string, apiKey string) (*AudioPro
 		return nil, fmt.Errorf("audio file does not exist: %s", audioFile)
 	}
 
+	if err := CheckBudget(s.client, s.ctx); err != nil {
+		return nil, err
+	}
+
 	// Determine if chunking is needed
 	chunkInfo, err := s.analyzeAudioFile(audioFile)
 	if err != nil {
@@ -729,85 +775,108 @@ func (s *CoreAIService) removeOverlapFromTranscript(originalTranscript string, d
 
 // ProcessText handles all text-based AI processing tasks
 func (s *CoreAIService) ProcessText(request *TextProcessingRequest, apiKey string) (*OpenRouterResponse, error) {
-	if apiKey == "" {
-		return nil, fmt.Errorf("OpenRouter API key not configured")
-	}
-
 	if request.Model == "" {
 		request.Model = "anthropic/claude-3.5-sonnet" // Default model
 	}
 
-	// Build messages array
-	messages := []Message{}
-
-	// Add system message if provided
-	if request.SystemPrompt != "" {
-		messages = append(messages, Message{
-			Role:    "system",
-			Content: request.SystemPrompt,
-		})
+	provider, config, err := NewProviderService(s.client, s.ctx).ProviderForTask(request.TaskType, apiKey)
+	if err != nil {
+		return nil, err
 	}
+	request.Model = config.modelFor(request.Model)
+	request.Provider = config.endpoint()
 
-	// Add user message
-	messages = append(messages, Message{
-		Role:    "user",
-		Content: request.UserPrompt,
+	return NewResponseCache(s.client, s.ctx).Do(request, func() (*OpenRouterResponse, error) {
+		return s.sendText(provider, request)
 	})
+}
 
-	// Create OpenRouter request
-	openRouterReq := OpenRouterRequest{
-		Model:    request.Model,
-		Messages: messages,
+// StreamText sends a text request and calls onDelta with each piece of the reply as it arrives.
+// Streamed replies bypass the response cache, and providers that cannot stream deliver the whole
+// reply as a single delta. Cancelling ctx aborts the request.
+func (s *CoreAIService) StreamText(ctx context.Context, request *TextProcessingRequest, apiKey string, onDelta func(string)) (*OpenRouterResponse, error) {
+	if request.Model == "" {
+		request.Model = "anthropic/claude-3.5-sonnet" // Default model
 	}
 
-	// Marshal request
-	jsonData, err := json.Marshal(openRouterReq)
+	provider, config, err := NewProviderService(s.client, s.ctx).ProviderForTask(request.TaskType, apiKey)
 	if err != nil {
-		return nil, fmt.Errorf("failed to marshal request: %w", err)
+		return nil, err
 	}
+	request.Model = config.modelFor(request.Model)
+	request.Provider = config.endpoint()
 
-	// Create HTTP request
-	req, err := http.NewRequest("POST", "https://openrouter.ai/api/v1/chat/completions", bytes.NewBuffer(jsonData))
-	if err != nil {
-		return nil, fmt.Errorf("failed to create request: %w", err)
+	if err := CheckBudget(s.client, s.ctx); err != nil {
+		return nil, err
 	}
 
-	// Set headers
-	req.Header.Set("Authorization", "Bearer "+apiKey)
-	req.Header.Set("Content-Type", "application/json")
-
-	// Make request
-	resp, err := s.httpClient.Do(req)
-	if err != nil {
-		return nil, fmt.Errorf("failed to make request: %w", err)
+	// Keep the streamed text so a stream that fails or is cancelled midway is still metered
+	var streamed strings.Builder
+	var response *OpenRouterResponse
+	if streamer, ok := provider.(StreamingProvider); ok {
+		response, err = streamer.Stream(ctx, completionRequest(request), func(delta string) {
+			streamed.WriteString(delta)
+			if onDelta != nil {
+				onDelta(delta)
+			}
+		})
+	} else {
+		response, err = provider.Complete(completionRequest(request))
+		if err == nil && onDelta != nil {
+			onDelta(response.Choices[0].Message.Content)
+		}
 	}
-	defer resp.Body.Close()
-
-	// Read response
-	body, err := io.ReadAll(resp.Body)
 	if err != nil {
-		return nil, fmt.Errorf("failed to read response: %w", err)
+		if streamed.Len() > 0 {
+			recordTextUsage(s.client, s.ctx, provider.Name(), request, partialStreamResponse(request, streamed.String()))
+		}
+		return nil, err
 	}
 
-	if resp.StatusCode != http.StatusOK {
-		return nil, fmt.Errorf("OpenRouter API error: %s", string(body))
+	recordTextUsage(s.client, s.ctx, provider.Name(), request, response)
+
+	return response, nil
+}
+
+// sendText sends a text request to the task's provider and records its usage
+func (s *CoreAIService) sendText(provider TextProvider, request *TextProcessingRequest) (*OpenRouterResponse, error) {
+	if err := CheckBudget(s.client, s.ctx); err != nil {
+		return nil, err
 	}
 
-	// Parse response
-	var openRouterResp OpenRouterResponse
-	err = json.Unmarshal(body, &openRouterResp)
+	response, err := provider.Complete(completionRequest(request))
 	if err != nil {
-		return nil, fmt.Errorf("failed to parse response: %w", err)
+		return nil, err
 	}
 
-	// Check for API errors
-	if openRouterResp.Error != nil {
-		return nil, fmt.Errorf("OpenRouter API error: %s", openRouterResp.Error.Message)
-	}
+	recordTextUsage(s.client, s.ctx, provider.Name(), request, response)
+
+	return response, nil
+}
 
-	if len(openRouterResp.Choices) == 0 {
-		return nil, fmt.Errorf("no response from OpenRouter API")
+// completionRequest builds the provider request of a text request
+func completionRequest(request *TextProcessingRequest) *CompletionRequest {
+	// Build messages array
+	messages := []Message{}
+
+	// Add system message if provided
+	if request.SystemPrompt != "" {
+		messages = append(messages, Message{
+			Role:    "system",
+			Content: request.SystemPrompt,
+		})
 	}
 
-	return &openRouterResp, nil
-}
\ No newline at end of file
+	// Add user message
+	messages = append(messages, Message{
+		Role:    "user",
+		Content: request.UserPrompt,
+	})
+
+	return &CompletionRequest{
+		Model:          request.Model,
+		Messages:       messages,
+		ResponseFormat: request.ResponseFormat,
+		Tools:          request.Tools,
+	}
+}
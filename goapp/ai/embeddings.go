@@ -0,0 +1,120 @@
+package ai
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"sort"
+)
+
+// DefaultEmbeddingModel is the embedding model used when none is specified
+const DefaultEmbeddingModel = "text-embedding-3-small"
+
+// openAIEmbeddingsURL is the OpenAI embeddings endpoint (overridable in tests)
+var openAIEmbeddingsURL = "https://api.openai.com/v1/embeddings"
+
+// EmbeddingRequest represents a request to embed one or more texts
+type EmbeddingRequest struct {
+	Input []string `json:"input"`
+	Model string   `json:"model"`
+}
+
+// EmbeddingResult holds one embedding vector per input text, in input order
+type EmbeddingResult struct {
+	Embeddings [][]float32 `json:"embeddings"`
+	Model      string      `json:"model"`
+	TokensUsed int         `json:"tokens_used"`
+}
+
+// openAIEmbeddingResponse represents the response from the OpenAI embeddings API
+type openAIEmbeddingResponse struct {
+	Data []struct {
+		Index     int       `json:"index"`
+		Embedding []float32 `json:"embedding"`
+	} `json:"data"`
+	Model string `json:"model"`
+	Usage struct {
+		TotalTokens int `json:"total_tokens"`
+	} `json:"usage"`
+	Error *struct {
+		Message string `json:"message"`
+		Type    string `json:"type"`
+	} `json:"error,omitempty"`
+}
+
+// ProcessEmbeddings embeds texts using the OpenAI embeddings API
+func (s *CoreAIService) ProcessEmbeddings(request *EmbeddingRequest, apiKey string) (*EmbeddingResult, error) {
+	if apiKey == "" {
+		return nil, fmt.Errorf("OpenAI API key not configured")
+	}
+	if len(request.Input) == 0 {
+		return &EmbeddingResult{Embeddings: [][]float32{}, Model: request.Model}, nil
+	}
+
+	if request.Model == "" {
+		request.Model = DefaultEmbeddingModel
+	}
+
+	jsonData, err := json.Marshal(request)
+	if err != nil {
+		return nil, fmt.Errorf("failed to marshal request: %w", err)
+	}
+
+	req, err := http.NewRequest("POST", openAIEmbeddingsURL, bytes.NewBuffer(jsonData))
+	if err != nil {
+		return nil, fmt.Errorf("failed to create request: %w", err)
+	}
+
+	req.Header.Set("Authorization", "Bearer "+apiKey)
+	req.Header.Set("Content-Type", "application/json")
+
+	resp, err := s.httpClient.Do(req)
+	if err != nil {
+		return nil, fmt.Errorf("failed to make request: %w", err)
+	}
+	defer resp.Body.Close()
+
+	body, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read response: %w", err)
+	}
+
+	var embeddingResp openAIEmbeddingResponse
+	if err := json.Unmarshal(body, &embeddingResp); err != nil {
+		if resp.StatusCode != http.StatusOK {
+			return nil, fmt.Errorf("OpenAI API error (status %d): %s", resp.StatusCode, string(body))
+		}
+		return nil, fmt.Errorf("failed to parse response: %w", err)
+	}
+
+	if embeddingResp.Error != nil {
+		return nil, fmt.Errorf("OpenAI API error: %s", embeddingResp.Error.Message)
+	}
+	if resp.StatusCode != http.StatusOK {
+		return nil, fmt.Errorf("OpenAI API error (status %d): %s", resp.StatusCode, string(body))
+	}
+	if len(embeddingResp.Data) != len(request.Input) {
+		return nil, fmt.Errorf("expected %d embeddings, got %d", len(request.Input), len(embeddingResp.Data))
+	}
+
+	// The API does not guarantee ordering, so sort by index
+	sort.Slice(embeddingResp.Data, func(i, j int) bool {
+		return embeddingResp.Data[i].Index < embeddingResp.Data[j].Index
+	})
+
+	result := &EmbeddingResult{
+		Embeddings: make([][]float32, len(embeddingResp.Data)),
+		Model:      embeddingResp.Model,
+		TokensUsed: embeddingResp.Usage.TotalTokens,
+	}
+	for i, item := range embeddingResp.Data {
+		result.Embeddings[i] = item.Embedding
+	}
+	if result.Model == "" {
+		result.Model = request.Model
+	}
+
+	return result, nil
+}
@@ -0,0 +1,70 @@
+package ai
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func withEmbeddingsServer(t *testing.T, handler http.HandlerFunc) {
+	server := httptest.NewServer(handler)
+	original := openAIEmbeddingsURL
+	openAIEmbeddingsURL = server.URL
+	t.Cleanup(func() {
+		openAIEmbeddingsURL = original
+		server.Close()
+	})
+}
+
+func TestProcessEmbeddings(t *testing.T) {
+	service := NewCoreAIService(nil, context.Background())
+
+	t.Run("returns embeddings in input order", func(t *testing.T) {
+		withEmbeddingsServer(t, func(w http.ResponseWriter, r *http.Request) {
+			assert.Equal(t, "Bearer test-key", r.Header.Get("Authorization"))
+
+			var req EmbeddingRequest
+			require.NoError(t, json.NewDecoder(r.Body).Decode(&req))
+			assert.Equal(t, DefaultEmbeddingModel, req.Model)
+			assert.Equal(t, []string{"a", "b"}, req.Input)
+
+			// Respond out of order to make sure results are sorted by index
+			w.Write([]byte(`{"data":[{"index":1,"embedding":[0,1]},{"index":0,"embedding":[1,0]}],"model":"text-embedding-3-small","usage":{"total_tokens":2}}`))
+		})
+
+		result, err := service.ProcessEmbeddings(&EmbeddingRequest{Input: []string{"a", "b"}}, "test-key")
+
+		require.NoError(t, err)
+		assert.Equal(t, [][]float32{{1, 0}, {0, 1}}, result.Embeddings)
+		assert.Equal(t, 2, result.TokensUsed)
+		assert.Equal(t, DefaultEmbeddingModel, result.Model)
+	})
+
+	t.Run("surfaces API errors", func(t *testing.T) {
+		withEmbeddingsServer(t, func(w http.ResponseWriter, r *http.Request) {
+			w.WriteHeader(http.StatusUnauthorized)
+			w.Write([]byte(`{"error":{"message":"invalid key","type":"auth"}}`))
+		})
+
+		_, err := service.ProcessEmbeddings(&EmbeddingRequest{Input: []string{"a"}}, "bad-key")
+
+		require.Error(t, err)
+		assert.Contains(t, err.Error(), "invalid key")
+	})
+
+	t.Run("requires API key", func(t *testing.T) {
+		_, err := service.ProcessEmbeddings(&EmbeddingRequest{Input: []string{"a"}}, "")
+		assert.Error(t, err)
+	})
+
+	t.Run("empty input needs no request", func(t *testing.T) {
+		result, err := service.ProcessEmbeddings(&EmbeddingRequest{}, "test-key")
+		require.NoError(t, err)
+		assert.Empty(t, result.Embeddings)
+	})
+}
@@ -9,6 +9,9 @@ type AIService interface {
 	
 	// ProcessAudio handles audio processing (transcription)
 	ProcessAudio(audioFile string) (*AudioProcessingResult, error)
+
+	// ProcessEmbeddings returns embedding vectors for the given texts
+	ProcessEmbeddings(request *EmbeddingRequest) (*EmbeddingResult, error)
 }
 
 // ParseTextResponse parses a raw OpenRouter response into a TextProcessingResult
@@ -27,12 +30,17 @@ func ParseTextResponse(response *OpenRouterResponse, taskType string) (*TextProc
 	}
 	
 	content := response.Choices[0].Message.Content
+
+	tokensUsed := 0
+	if response.Usage != nil {
+		tokensUsed = response.Usage.TotalTokens
+	}
 	
 	return &TextProcessingResult{
 		Content:    content,
 		TaskType:   taskType,
 		Structured: nil, // Raw text response, no structured parsing yet
-		TokensUsed: 0,   // OpenRouter doesn't provide token count in this format
+		TokensUsed: tokensUsed,
 	}, nil
 }
 
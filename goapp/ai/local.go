@@ -32,4 +32,9 @@ func (s *LocalAIService) ProcessText(request *TextProcessingRequest) (*OpenRoute
 func (s *LocalAIService) ProcessAudio(audioFile string) (*AudioProcessingResult, error) {
 	// Use OpenAI API key for audio processing (transcription)
 	return s.coreService.ProcessAudio(audioFile, s.openaiKey)
-}
\ No newline at end of file
+}
+// ProcessEmbeddings implements AIService interface
+func (s *LocalAIService) ProcessEmbeddings(request *EmbeddingRequest) (*EmbeddingResult, error) {
+	// Use OpenAI API key for embeddings
+	return s.coreService.ProcessEmbeddings(request, s.openaiKey)
+}
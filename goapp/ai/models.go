@@ -0,0 +1,286 @@
+package ai
+
+import (
+	"context"
+	_ "embed"
+	"encoding/json"
+	"fmt"
+	"log"
+	"math"
+	"net/http"
+	"sort"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"ramble-ai/ent"
+	"ramble-ai/goapp/settings"
+)
+
+// modelCatalogSettingsKey is the setting holding the last fetched ModelCatalog as JSON
+const modelCatalogSettingsKey = "ai_model_catalog"
+
+// modelCatalogMaxAge is how long a fetched model list is used before it is refreshed
+const modelCatalogMaxAge = 24 * time.Hour
+
+// modelListTimeout bounds the model list request
+const modelListTimeout = 20 * time.Second
+
+// fallbackModelList is a snapshot of the OpenRouter model list used until a list is fetched
+//
+//go:embed models_fallback.json
+var fallbackModelList []byte
+
+// ModelInfo is what the app knows about a model
+type ModelInfo struct {
+	ID                  string  `json:"id"`
+	Name                string  `json:"name"`
+	ContextLength       int     `json:"contextLength"`
+	MaxCompletionTokens int     `json:"maxCompletionTokens,omitempty"`
+	PromptPrice         float64 `json:"promptPrice"`     // US dollars per million prompt tokens
+	CompletionPrice     float64 `json:"completionPrice"` // US dollars per million completion tokens
+}
+
+// ModelCatalog is the list of known models and where it came from
+type ModelCatalog struct {
+	Models    []ModelInfo `json:"models"`
+	FetchedAt time.Time   `json:"fetchedAt"` // zero for the built-in fallback list
+	Source    string      `json:"source"`    // "fallback" or the URL the list was fetched from
+}
+
+// Lookup finds a model by its ID. Model names sent to direct providers lack the vendor prefix
+// ("gpt-4o" for "openai/gpt-4o"), and dated or suffixed names ("claude-3-haiku-20240307",
+// "openai/gpt-4o:online") match the longest known ID they start with.
+func (c *ModelCatalog) Lookup(model string) (ModelInfo, bool) {
+	model = strings.ToLower(strings.TrimSpace(model))
+	if model == "" {
+		return ModelInfo{}, false
+	}
+
+	var best ModelInfo
+	bestLength := 0
+	for _, info := range c.Models {
+		id := strings.ToLower(info.ID)
+		_, bare, _ := strings.Cut(id, "/")
+		if id == model || bare == model {
+			return info, true
+		}
+		for _, candidate := range []string{id, bare} {
+			if candidate != "" && strings.HasPrefix(model, candidate) && len(candidate) > bestLength {
+				best, bestLength = info, len(candidate)
+			}
+		}
+	}
+	return best, bestLength > 0
+}
+
+// currentCatalog is the catalog the app uses, shared by every service
+var currentCatalog = struct {
+	sync.RWMutex
+	catalog *ModelCatalog
+}{}
+
+// LookupModel finds a model in the current catalog
+func LookupModel(model string) (ModelInfo, bool) {
+	return CurrentModelCatalog().Lookup(model)
+}
+
+// CurrentModelCatalog returns the catalog in use: the last one loaded or fetched, otherwise the
+// built-in fallback list
+func CurrentModelCatalog() *ModelCatalog {
+	currentCatalog.RLock()
+	catalog := currentCatalog.catalog
+	currentCatalog.RUnlock()
+	if catalog != nil {
+		return catalog
+	}
+
+	catalog, err := parseModelList(fallbackModelList)
+	if err != nil {
+		log.Printf("[AI] Warning: invalid fallback model list: %v", err)
+		catalog = &ModelCatalog{}
+	}
+	catalog.Source = "fallback"
+	setCurrentCatalog(catalog)
+	return catalog
+}
+
+// setCurrentCatalog replaces the catalog in use
+func setCurrentCatalog(catalog *ModelCatalog) {
+	currentCatalog.Lock()
+	currentCatalog.catalog = catalog
+	currentCatalog.Unlock()
+}
+
+// ModelService fetches and caches model metadata
+type ModelService struct {
+	client     *ent.Client
+	ctx        context.Context
+	baseURL    string
+	httpClient *http.Client
+}
+
+// NewModelService creates a new model service
+func NewModelService(client *ent.Client, ctx context.Context) *ModelService {
+	return &ModelService{
+		client:     client,
+		ctx:        ctx,
+		baseURL:    defaultOpenRouterBaseURL,
+		httpClient: &http.Client{Timeout: modelListTimeout},
+	}
+}
+
+// GetModels returns the current model catalog, loading the cached list when none is in use yet
+func (s *ModelService) GetModels() (*ModelCatalog, error) {
+	currentCatalog.RLock()
+	loaded := currentCatalog.catalog != nil
+	currentCatalog.RUnlock()
+
+	if !loaded {
+		if _, err := s.loadCached(); err != nil {
+			return nil, err
+		}
+	}
+	return CurrentModelCatalog(), nil
+}
+
+// RefreshModels fetches the model list from OpenRouter, caches it and puts it in use
+func (s *ModelService) RefreshModels() (*ModelCatalog, error) {
+	url := strings.TrimRight(s.baseURL, "/") + "/models"
+	request, err := http.NewRequestWithContext(s.ctx, http.MethodGet, url, nil)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create model list request: %w", err)
+	}
+
+	response, err := s.httpClient.Do(request)
+	if err != nil {
+		return nil, fmt.Errorf("failed to fetch model list: %w", err)
+	}
+	defer response.Body.Close()
+	if response.StatusCode != http.StatusOK {
+		return nil, fmt.Errorf("model list request failed with status %d", response.StatusCode)
+	}
+
+	var body json.RawMessage
+	if err := json.NewDecoder(response.Body).Decode(&body); err != nil {
+		return nil, fmt.Errorf("failed to read model list: %w", err)
+	}
+	catalog, err := parseModelList(body)
+	if err != nil {
+		return nil, err
+	}
+	if len(catalog.Models) == 0 {
+		return nil, fmt.Errorf("model list is empty")
+	}
+	catalog.FetchedAt = time.Now()
+	catalog.Source = url
+
+	if s.client != nil {
+		value, err := json.Marshal(catalog)
+		if err != nil {
+			return nil, fmt.Errorf("failed to marshal model catalog: %w", err)
+		}
+		if err := settings.NewSettingsService(s.client, s.ctx).SaveSetting(modelCatalogSettingsKey, string(value)); err != nil {
+			return nil, err
+		}
+	}
+
+	setCurrentCatalog(catalog)
+	log.Printf("[AI] Loaded %d models from %s", len(catalog.Models), url)
+	return catalog, nil
+}
+
+// RefreshModelsIfStale loads the cached model list and fetches a new one when it is missing or
+// older than a day. Fetch failures are logged; the cached or fallback list stays in use.
+func (s *ModelService) RefreshModelsIfStale() {
+	cached, err := s.loadCached()
+	if err != nil {
+		log.Printf("[AI] Warning: %v", err)
+	}
+	if cached != nil && time.Since(cached.FetchedAt) < modelCatalogMaxAge {
+		return
+	}
+	if _, err := s.RefreshModels(); err != nil {
+		log.Printf("[AI] Warning: using cached model list: %v", err)
+	}
+}
+
+// loadCached puts the model list cached in the settings in use and returns it, nil when none is cached
+func (s *ModelService) loadCached() (*ModelCatalog, error) {
+	if s.client == nil {
+		return nil, nil
+	}
+
+	value, err := settings.NewSettingsService(s.client, s.ctx).GetSetting(modelCatalogSettingsKey)
+	if err != nil {
+		return nil, err
+	}
+	if value == "" {
+		return nil, nil
+	}
+
+	var catalog ModelCatalog
+	if err := json.Unmarshal([]byte(value), &catalog); err != nil {
+		return nil, fmt.Errorf("failed to parse cached model catalog: %w", err)
+	}
+	if len(catalog.Models) == 0 {
+		return nil, nil
+	}
+	setCurrentCatalog(&catalog)
+	return &catalog, nil
+}
+
+// modelListEntry is a model in the OpenRouter model list
+type modelListEntry struct {
+	ID            string `json:"id"`
+	Name          string `json:"name"`
+	ContextLength int    `json:"context_length"`
+	Pricing       struct {
+		Prompt     string `json:"prompt"`
+		Completion string `json:"completion"`
+	} `json:"pricing"`
+	TopProvider struct {
+		MaxCompletionTokens *int `json:"max_completion_tokens"`
+	} `json:"top_provider"`
+}
+
+// parseModelList converts an OpenRouter model list to a catalog sorted by model ID. Prices in the
+// list are per token.
+func parseModelList(data []byte) (*ModelCatalog, error) {
+	var list struct {
+		Data []modelListEntry `json:"data"`
+	}
+	if err := json.Unmarshal(data, &list); err != nil {
+		return nil, fmt.Errorf("failed to parse model list: %w", err)
+	}
+
+	catalog := &ModelCatalog{Models: make([]ModelInfo, 0, len(list.Data))}
+	for _, entry := range list.Data {
+		if entry.ID == "" || entry.ContextLength <= 0 {
+			continue
+		}
+		info := ModelInfo{
+			ID:              entry.ID,
+			Name:            entry.Name,
+			ContextLength:   entry.ContextLength,
+			PromptPrice:     perMillion(entry.Pricing.Prompt),
+			CompletionPrice: perMillion(entry.Pricing.Completion),
+		}
+		if entry.TopProvider.MaxCompletionTokens != nil {
+			info.MaxCompletionTokens = *entry.TopProvider.MaxCompletionTokens
+		}
+		catalog.Models = append(catalog.Models, info)
+	}
+	sort.Slice(catalog.Models, func(i, j int) bool { return catalog.Models[i].ID < catalog.Models[j].ID })
+	return catalog, nil
+}
+
+// perMillion converts a per-token price to US dollars per million tokens. Unknown prices are 0.
+func perMillion(perToken string) float64 {
+	price, err := strconv.ParseFloat(perToken, 64)
+	if err != nil || price < 0 {
+		return 0
+	}
+	return math.Round(price*1e12) / 1e6 // rounded to a millionth of a dollar
+}
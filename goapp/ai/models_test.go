@@ -0,0 +1,125 @@
+package ai
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"ramble-ai/goapp"
+	"ramble-ai/goapp/settings"
+)
+
+// resetModelCatalog puts the fallback list back in use after a test
+func resetModelCatalog(t *testing.T) {
+	setCurrentCatalog(nil)
+	t.Cleanup(func() { setCurrentCatalog(nil) })
+}
+
+func TestFallbackModelCatalog(t *testing.T) {
+	resetModelCatalog(t)
+
+	catalog := CurrentModelCatalog()
+	assert.Equal(t, "fallback", catalog.Source)
+	assert.True(t, catalog.FetchedAt.IsZero())
+
+	for model, want := range map[string]int{
+		"anthropic/claude-sonnet-4":   200000,
+		"gpt-4o-mini":                 128000, // direct provider names drop the vendor
+		"gpt-4o-2024-08-06":           128000, // dated names match the longest known ID
+		"openai/gpt-4.1-nano":         1047576,
+		"google/gemini-2.0-flash-001": 1048576,
+	} {
+		info, ok := LookupModel(model)
+		require.True(t, ok, model)
+		assert.Equal(t, want, info.ContextLength, model)
+	}
+
+	info, ok := LookupModel("anthropic/claude-3.5-haiku-20241022")
+	require.True(t, ok)
+	assert.Equal(t, 0.8, info.PromptPrice)
+	assert.Equal(t, 4.0, info.CompletionPrice)
+	assert.Equal(t, 8192, info.MaxCompletionTokens)
+
+	_, ok = LookupModel("acme/unknown-model")
+	assert.False(t, ok)
+}
+
+func TestRefreshModels(t *testing.T) {
+	resetModelCatalog(t)
+	helper := goapp.NewTestHelper(t)
+
+	requests := 0
+	status := http.StatusOK
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		requests++
+		assert.Equal(t, "/api/v1/models", r.URL.Path)
+		w.WriteHeader(status)
+		fmt.Fprint(w, `{"data":[
+			{"id":"acme/rocket-1","name":"Acme: Rocket 1","context_length":64000,"pricing":{"prompt":"0.000001","completion":"0.000002"},"top_provider":{"max_completion_tokens":4096}},
+			{"id":"acme/no-context","name":"Acme: Broken","pricing":{"prompt":"0","completion":"0"}},
+			{"id":"acme/router","name":"Acme: Router","context_length":8000,"pricing":{"prompt":"-1","completion":"-1"},"top_provider":{"max_completion_tokens":null}}
+		]}`)
+	}))
+	t.Cleanup(server.Close)
+
+	service := NewModelService(helper.Client, helper.Ctx)
+	service.baseURL = server.URL + "/api/v1"
+
+	catalog, err := service.RefreshModels()
+	require.NoError(t, err)
+	require.Len(t, catalog.Models, 2, "models without a context length are skipped")
+	assert.Equal(t, server.URL+"/api/v1/models", catalog.Source)
+
+	info, ok := LookupModel("acme/rocket-1")
+	require.True(t, ok, "the fetched list is put in use")
+	assert.Equal(t, ModelInfo{ID: "acme/rocket-1", Name: "Acme: Rocket 1", ContextLength: 64000, MaxCompletionTokens: 4096, PromptPrice: 1, CompletionPrice: 2}, info)
+	info, _ = LookupModel("acme/router")
+	assert.Zero(t, info.PromptPrice, "variable prices are unknown")
+
+	// A restart loads the cached list without fetching
+	setCurrentCatalog(nil)
+	loaded, err := service.GetModels()
+	require.NoError(t, err)
+	assert.Len(t, loaded.Models, 2)
+	service.RefreshModelsIfStale()
+	assert.Equal(t, 1, requests, "a fresh cached list is not fetched again")
+
+	// A failed fetch keeps the list in use
+	status = http.StatusInternalServerError
+	_, err = service.RefreshModels()
+	assert.Error(t, err)
+	_, ok = LookupModel("acme/rocket-1")
+	assert.True(t, ok)
+
+	// Stale lists are refreshed
+	staleCatalog := *loaded
+	staleCatalog.FetchedAt = time.Now().Add(-2 * modelCatalogMaxAge)
+	stale, err := json.Marshal(staleCatalog)
+	require.NoError(t, err)
+	require.NoError(t, settings.NewSettingsService(helper.Client, helper.Ctx).SaveSetting(modelCatalogSettingsKey, string(stale)))
+	status = http.StatusOK
+	service.RefreshModelsIfStale()
+	assert.Equal(t, 3, requests)
+	catalog, err = service.GetModels()
+	require.NoError(t, err)
+	assert.Len(t, catalog.Models, 2)
+}
+
+func TestCountTokens(t *testing.T) {
+	assert.Equal(t, EncodingO200K, EncodingForModel("openai/gpt-4o-mini"))
+	assert.Equal(t, EncodingO200K, EncodingForModel("o3"))
+	assert.Equal(t, EncodingCL100K, EncodingForModel("openai/gpt-4"))
+	assert.Equal(t, EncodingCL100K, EncodingForModel("anthropic/claude-sonnet-4"))
+	assert.Equal(t, EncodingCL100K, EncodingForModel("acme/o3-lookalike"), "only OpenAI models use o200k_base")
+
+	assert.Equal(t, 0, CountTokens("openai/gpt-4o", ""))
+	assert.Equal(t, 2, CountTokens("openai/gpt-4o", "hello world"))
+	assert.Equal(t, 6, CountTokens("openai/gpt-4", "tiktoken is great!"))
+	assert.Greater(t, CountTokens("openai/gpt-4", "<|endoftext|>"), 1, "special tokens in text are counted as text")
+}
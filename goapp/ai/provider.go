@@ -0,0 +1,508 @@
+package ai
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"slices"
+	"strings"
+	"time"
+
+	"ramble-ai/ent"
+	"ramble-ai/goapp/settings"
+	"ramble-ai/goapp/usage"
+)
+
+// Text providers selectable in the provider settings
+const (
+	ProviderOpenRouter       = usage.ProviderOpenRouter
+	ProviderOpenAI           = usage.ProviderOpenAI
+	ProviderAnthropic        = usage.ProviderAnthropic
+	ProviderOpenAICompatible = usage.ProviderOpenAICompatible // any /chat/completions server, e.g. Ollama or llama.cpp
+)
+
+// Settings keys of the provider configuration and the provider API keys
+const (
+	providerSettingsKey     = "ai_text_providers"
+	openRouterAPIKeySetting = "openrouter_api_key"
+	openAIAPIKeySetting     = "openai_api_key"
+	anthropicAPIKeySetting  = "anthropic_api_key"
+	compatibleAPIKeySetting = "openai_compatible_api_key"
+)
+
+// Provider endpoints and request defaults
+const (
+	defaultOpenRouterBaseURL  = "https://openrouter.ai/api/v1"
+	defaultOpenAIBaseURL      = "https://api.openai.com/v1"
+	defaultAnthropicBaseURL   = "https://api.anthropic.com/v1"
+	openRouterReferer         = "https://github.com/yourusername/video-app"
+	anthropicAPIVersion       = "2023-06-01"
+	anthropicDefaultMaxTokens = 4096
+	providerRequestTimeout    = 120 * time.Second // local models can be slow
+)
+
+// TextTaskTypes are the task types a provider can be selected for
+var TextTaskTypes = []string{"suggest_highlights", "reorder", "improve_silences", "chat"}
+
+// CompletionResponse is a chat completion in the OpenAI format OpenRouter uses.
+// Every provider's reply is normalized to it.
+type CompletionResponse = OpenRouterResponse
+
+// CompletionRequest is a chat completion request independent of the provider
+type CompletionRequest struct {
+	Model          string
+	Messages       []Message
+	ResponseFormat *ResponseFormat
+	Tools          []map[string]interface{} // only sent to chat completions endpoints
+}
+
+// TextProvider sends chat completions to a model provider
+type TextProvider interface {
+	// Name returns the provider type, as recorded in the usage ledger
+	Name() string
+	Complete(request *CompletionRequest) (*CompletionResponse, error)
+}
+
+// ProviderConfig selects the provider of a task
+type ProviderConfig struct {
+	Type    string `json:"type"`
+	BaseURL string `json:"baseUrl,omitempty"` // required for openai_compatible, e.g. http://localhost:11434/v1
+	Model   string `json:"model,omitempty"`   // replaces the model the task asks for; required for openai_compatible
+}
+
+// ProviderSettings select the provider of every text task. Tasks without an entry use the default.
+type ProviderSettings struct {
+	Default ProviderConfig            `json:"default"`
+	Tasks   map[string]ProviderConfig `json:"tasks"`
+}
+
+// defaultProvider serves every task until provider settings are saved
+var defaultProvider = ProviderConfig{Type: ProviderOpenRouter}
+
+// ProviderService resolves the text provider configured for a task
+type ProviderService struct {
+	client *ent.Client
+	ctx    context.Context
+}
+
+// NewProviderService creates a new provider service
+func NewProviderService(client *ent.Client, ctx context.Context) *ProviderService {
+	return &ProviderService{
+		client: client,
+		ctx:    ctx,
+	}
+}
+
+// GetSettings returns the saved provider settings, or OpenRouter for everything when none were saved
+func (s *ProviderService) GetSettings() (*ProviderSettings, error) {
+	providerSettings := ProviderSettings{Default: defaultProvider, Tasks: map[string]ProviderConfig{}}
+	if s.client == nil {
+		return &providerSettings, nil
+	}
+
+	value, err := settings.NewSettingsService(s.client, s.ctx).GetSetting(providerSettingsKey)
+	if err != nil {
+		return nil, err
+	}
+	if value == "" {
+		return &providerSettings, nil
+	}
+	if err := json.Unmarshal([]byte(value), &providerSettings); err != nil {
+		return nil, fmt.Errorf("failed to parse AI provider settings: %w", err)
+	}
+	if providerSettings.Tasks == nil {
+		providerSettings.Tasks = map[string]ProviderConfig{}
+	}
+	return &providerSettings, nil
+}
+
+// SaveSettings validates and saves the provider settings
+func (s *ProviderService) SaveSettings(providerSettings ProviderSettings) error {
+	if err := providerSettings.Default.validate(); err != nil {
+		return fmt.Errorf("default provider: %w", err)
+	}
+	for taskType, config := range providerSettings.Tasks {
+		if !slices.Contains(TextTaskTypes, taskType) {
+			return fmt.Errorf("unknown AI task type: %s", taskType)
+		}
+		if err := config.validate(); err != nil {
+			return fmt.Errorf("%s provider: %w", taskType, err)
+		}
+	}
+	chat, ok := providerSettings.Tasks["chat"]
+	if !ok {
+		chat = providerSettings.Default
+	}
+	if chat.Type == ProviderAnthropic {
+		return fmt.Errorf("chat provider: the anthropic provider does not support tool calls; choose another provider for chat")
+	}
+	if providerSettings.Tasks == nil {
+		providerSettings.Tasks = map[string]ProviderConfig{}
+	}
+
+	value, err := json.Marshal(providerSettings)
+	if err != nil {
+		return fmt.Errorf("failed to marshal AI provider settings: %w", err)
+	}
+	return settings.NewSettingsService(s.client, s.ctx).SaveSetting(providerSettingsKey, string(value))
+}
+
+// ConfigForTask returns the provider configuration of a task type
+func (s *ProviderService) ConfigForTask(taskType string) (*ProviderConfig, error) {
+	providerSettings, err := s.GetSettings()
+	if err != nil {
+		return nil, err
+	}
+	if config, ok := providerSettings.Tasks[taskType]; ok {
+		return &config, nil
+	}
+	return &providerSettings.Default, nil
+}
+
+// ProviderForTask returns the provider configured for a task type. openRouterKey is the OpenRouter
+// key the caller already holds; the keys of other providers are read from settings.
+func (s *ProviderService) ProviderForTask(taskType string, openRouterKey string) (TextProvider, *ProviderConfig, error) {
+	config, err := s.ConfigForTask(taskType)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	switch config.Type {
+	case ProviderOpenRouter:
+		if openRouterKey == "" {
+			openRouterKey, err = s.apiKey(openRouterAPIKeySetting)
+			if err != nil {
+				return nil, nil, err
+			}
+		}
+		if openRouterKey == "" {
+			return nil, nil, fmt.Errorf("OpenRouter API key not configured")
+		}
+		return newChatCompletionsProvider(ProviderOpenRouter, defaultOpenRouterBaseURL, openRouterKey), config, nil
+	case ProviderOpenAI:
+		apiKey, err := s.apiKey(openAIAPIKeySetting)
+		if err != nil {
+			return nil, nil, err
+		}
+		if apiKey == "" {
+			return nil, nil, fmt.Errorf("OpenAI API key not configured")
+		}
+		return newChatCompletionsProvider(ProviderOpenAI, defaultOpenAIBaseURL, apiKey), config, nil
+	case ProviderAnthropic:
+		apiKey, err := s.apiKey(anthropicAPIKeySetting)
+		if err != nil {
+			return nil, nil, err
+		}
+		if apiKey == "" {
+			return nil, nil, fmt.Errorf("Anthropic API key not configured")
+		}
+		return newAnthropicProvider(defaultAnthropicBaseURL, apiKey), config, nil
+	case ProviderOpenAICompatible:
+		apiKey, err := s.apiKey(compatibleAPIKeySetting) // optional, most local servers need none
+		if err != nil {
+			return nil, nil, err
+		}
+		return newChatCompletionsProvider(ProviderOpenAICompatible, config.BaseURL, apiKey), config, nil
+	}
+	return nil, nil, fmt.Errorf("unknown AI provider: %s", config.Type)
+}
+
+// TextAPIKey returns the OpenRouter key for a task from getAPIKey, failing with a clear message
+// when the provider configured for the task is missing its credentials. The key may be empty
+// when the task runs on another provider.
+func (s *ProviderService) TextAPIKey(taskType string, getAPIKey func() (string, error)) (string, error) {
+	apiKey, err := getAPIKey()
+	if err != nil {
+		apiKey = ""
+	}
+	if _, _, err := s.ProviderForTask(taskType, apiKey); err != nil {
+		return "", err
+	}
+	return apiKey, nil
+}
+
+// apiKey reads a provider API key from settings
+func (s *ProviderService) apiKey(key string) (string, error) {
+	if s.client == nil {
+		return "", nil
+	}
+	return settings.NewSettingsService(s.client, s.ctx).GetSetting(key)
+}
+
+// validate checks that a provider configuration is complete
+func (c ProviderConfig) validate() error {
+	switch c.Type {
+	case ProviderOpenRouter, ProviderOpenAI, ProviderAnthropic:
+		if c.BaseURL != "" {
+			return fmt.Errorf("base URL can only be set for %s providers", ProviderOpenAICompatible)
+		}
+	case ProviderOpenAICompatible:
+		if !strings.HasPrefix(c.BaseURL, "http://") && !strings.HasPrefix(c.BaseURL, "https://") {
+			return fmt.Errorf("%s provider needs an http(s) base URL", ProviderOpenAICompatible)
+		}
+		if strings.TrimSpace(c.Model) == "" {
+			return fmt.Errorf("%s provider needs a model", ProviderOpenAICompatible)
+		}
+	default:
+		return fmt.Errorf("unknown AI provider: %s", c.Type)
+	}
+	return nil
+}
+
+// modelFor returns the model to send for a task that asked for requested. Direct OpenAI and
+// Anthropic calls drop the vendor prefix of OpenRouter model names ("openai/gpt-4o" -> "gpt-4o").
+func (c ProviderConfig) modelFor(requested string) string {
+	if c.Model != "" {
+		return c.Model
+	}
+	switch c.Type {
+	case ProviderOpenAI:
+		return strings.TrimPrefix(requested, "openai/")
+	case ProviderAnthropic:
+		return strings.TrimPrefix(requested, "anthropic/")
+	}
+	return requested
+}
+
+// endpoint identifies where a configuration sends requests, so cached responses are not shared between servers
+func (c ProviderConfig) endpoint() string {
+	if c.Type == ProviderOpenAICompatible {
+		return c.Type + "@" + strings.TrimRight(c.BaseURL, "/")
+	}
+	return c.Type
+}
+
+// chatCompletionsProvider talks to OpenAI-style /chat/completions endpoints (OpenRouter, OpenAI, Ollama, llama.cpp, ...)
+type chatCompletionsProvider struct {
+	name       string
+	baseURL    string
+	apiKey     string
+	httpClient *http.Client
+}
+
+// newChatCompletionsProvider creates a provider for an OpenAI-style endpoint
+func newChatCompletionsProvider(name, baseURL, apiKey string) *chatCompletionsProvider {
+	return &chatCompletionsProvider{
+		name:       name,
+		baseURL:    strings.TrimRight(baseURL, "/"),
+		apiKey:     apiKey,
+		httpClient: &http.Client{Timeout: providerRequestTimeout},
+	}
+}
+
+// Name implements TextProvider
+func (p *chatCompletionsProvider) Name() string {
+	return p.name
+}
+
+// Complete implements TextProvider
+func (p *chatCompletionsProvider) Complete(request *CompletionRequest) (*CompletionResponse, error) {
+	body, headers := p.request(request)
+
+	var response CompletionResponse
+	if err := postJSON(p.httpClient, p.baseURL+"/chat/completions", headers, body, &response, p.name); err != nil {
+		return nil, err
+	}
+	if response.Error != nil {
+		return nil, fmt.Errorf("%s API error: %s", providerLabel(p.name), response.Error.Message)
+	}
+	if len(response.Choices) == 0 {
+		return nil, fmt.Errorf("no response from %s API", providerLabel(p.name))
+	}
+	return &response, nil
+}
+
+// request builds the body and headers of a chat completions request
+func (p *chatCompletionsProvider) request(request *CompletionRequest) (*OpenRouterRequest, map[string]string) {
+	body := &OpenRouterRequest{
+		Model:          request.Model,
+		Messages:       request.Messages,
+		ResponseFormat: request.ResponseFormat,
+		Tools:          request.Tools,
+	}
+	headers := map[string]string{}
+	if p.apiKey != "" {
+		headers["Authorization"] = "Bearer " + p.apiKey
+	}
+	if p.name == ProviderOpenRouter {
+		body.Usage = &UsageOptions{Include: true}
+		headers["HTTP-Referer"] = openRouterReferer // Required by OpenRouter
+	}
+	return body, headers
+}
+
+// anthropicProvider talks to the Anthropic Messages API
+type anthropicProvider struct {
+	baseURL    string
+	apiKey     string
+	httpClient *http.Client
+}
+
+// newAnthropicProvider creates a provider for the Anthropic Messages API
+func newAnthropicProvider(baseURL, apiKey string) *anthropicProvider {
+	return &anthropicProvider{
+		baseURL:    strings.TrimRight(baseURL, "/"),
+		apiKey:     apiKey,
+		httpClient: &http.Client{Timeout: providerRequestTimeout},
+	}
+}
+
+// anthropicMessage is a message of the Anthropic Messages API
+type anthropicMessage struct {
+	Role    string `json:"role"`
+	Content string `json:"content"`
+}
+
+// anthropicRequest is the request body of the Anthropic Messages API
+type anthropicRequest struct {
+	Model     string             `json:"model"`
+	MaxTokens int                `json:"max_tokens"`
+	System    string             `json:"system,omitempty"`
+	Messages  []anthropicMessage `json:"messages"`
+}
+
+// anthropicResponse is the response body of the Anthropic Messages API
+type anthropicResponse struct {
+	Model   string `json:"model"`
+	Content []struct {
+		Type string `json:"type"`
+		Text string `json:"text"`
+	} `json:"content"`
+	Usage *struct {
+		InputTokens  int `json:"input_tokens"`
+		OutputTokens int `json:"output_tokens"`
+	} `json:"usage"`
+	Error *struct {
+		Type    string `json:"type"`
+		Message string `json:"message"`
+	} `json:"error"`
+}
+
+// Name implements TextProvider
+func (p *anthropicProvider) Name() string {
+	return ProviderAnthropic
+}
+
+// Complete implements TextProvider. System messages become the system prompt, and a requested
+// JSON schema is described in the system prompt because the Messages API has no response_format.
+func (p *anthropicProvider) Complete(request *CompletionRequest) (*CompletionResponse, error) {
+	if len(request.Tools) > 0 {
+		return nil, fmt.Errorf("the Anthropic provider does not support tool calls")
+	}
+	body := anthropicRequest{
+		Model:     request.Model,
+		MaxTokens: anthropicDefaultMaxTokens,
+	}
+	var system []string
+	for _, message := range request.Messages {
+		if message.Role == "system" {
+			system = append(system, message.Content)
+			continue
+		}
+		body.Messages = append(body.Messages, anthropicMessage{Role: message.Role, Content: message.Content})
+	}
+	if format := request.ResponseFormat; format != nil && format.JSONSchema != nil {
+		schema, err := json.Marshal(format.JSONSchema.Schema)
+		if err != nil {
+			return nil, fmt.Errorf("failed to marshal response schema: %w", err)
+		}
+		system = append(system, "Respond with JSON only, matching this JSON schema:\n"+string(schema))
+	}
+	body.System = strings.Join(system, "\n\n")
+
+	headers := map[string]string{
+		"x-api-key":         p.apiKey,
+		"anthropic-version": anthropicAPIVersion,
+	}
+	var response anthropicResponse
+	if err := postJSON(p.httpClient, p.baseURL+"/messages", headers, body, &response, ProviderAnthropic); err != nil {
+		return nil, err
+	}
+	if response.Error != nil {
+		return nil, fmt.Errorf("Anthropic API error: %s", response.Error.Message)
+	}
+
+	var text strings.Builder
+	for _, block := range response.Content {
+		if block.Type == "text" {
+			text.WriteString(block.Text)
+		}
+	}
+	if text.Len() == 0 {
+		return nil, fmt.Errorf("no response from Anthropic API")
+	}
+
+	completion := &CompletionResponse{
+		Model:   response.Model,
+		Choices: []Choice{{Message: Message{Role: "assistant", Content: text.String()}}},
+	}
+	if response.Usage != nil {
+		completion.Usage = &OpenRouterUsage{
+			PromptTokens:     response.Usage.InputTokens,
+			CompletionTokens: response.Usage.OutputTokens,
+			TotalTokens:      response.Usage.InputTokens + response.Usage.OutputTokens,
+		}
+	}
+	return completion, nil
+}
+
+// postJSON posts a JSON body and decodes the JSON response, reporting non-200 statuses as provider errors
+func postJSON(client *http.Client, url string, headers map[string]string, body interface{}, result interface{}, provider string) error {
+	req, err := newJSONRequest(context.Background(), url, headers, body)
+	if err != nil {
+		return err
+	}
+
+	resp, err := client.Do(req)
+	if err != nil {
+		return fmt.Errorf("failed to make request: %w", err)
+	}
+	defer resp.Body.Close()
+
+	responseBody, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return fmt.Errorf("failed to read response: %w", err)
+	}
+	if resp.StatusCode != http.StatusOK {
+		return fmt.Errorf("%s API error: %s", providerLabel(provider), string(responseBody))
+	}
+
+	if err := json.Unmarshal(responseBody, result); err != nil {
+		return fmt.Errorf("failed to parse response: %w", err)
+	}
+	return nil
+}
+
+// newJSONRequest creates a POST request with a JSON body
+func newJSONRequest(ctx context.Context, url string, headers map[string]string, body interface{}) (*http.Request, error) {
+	jsonData, err := json.Marshal(body)
+	if err != nil {
+		return nil, fmt.Errorf("failed to marshal request: %w", err)
+	}
+
+	req, err := http.NewRequestWithContext(ctx, "POST", url, bytes.NewBuffer(jsonData))
+	if err != nil {
+		return nil, fmt.Errorf("failed to create request: %w", err)
+	}
+	req.Header.Set("Content-Type", "application/json")
+	for key, value := range headers {
+		req.Header.Set(key, value)
+	}
+	return req, nil
+}
+
+// providerLabel returns the display name of a provider for error messages
+func providerLabel(provider string) string {
+	switch provider {
+	case ProviderOpenRouter:
+		return "OpenRouter"
+	case ProviderOpenAI:
+		return "OpenAI"
+	case ProviderAnthropic:
+		return "Anthropic"
+	}
+	return "AI provider"
+}
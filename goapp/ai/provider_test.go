@@ -0,0 +1,193 @@
+package ai
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"ramble-ai/goapp"
+)
+
+func TestProviderSettings(t *testing.T) {
+	helper := goapp.NewTestHelper(t)
+	service := NewProviderService(helper.Client, helper.Ctx)
+
+	providerSettings, err := service.GetSettings()
+	require.NoError(t, err)
+	assert.Equal(t, ProviderOpenRouter, providerSettings.Default.Type)
+	assert.Empty(t, providerSettings.Tasks)
+
+	invalid := []struct {
+		name     string
+		settings ProviderSettings
+		wantErr  string
+	}{
+		{"unknown provider", ProviderSettings{Default: ProviderConfig{Type: "bedrock"}}, "default provider: unknown AI provider: bedrock"},
+		{"base URL on hosted provider", ProviderSettings{Default: ProviderConfig{Type: ProviderOpenAI, BaseURL: "http://x"}}, "default provider: base URL can only be set for openai_compatible providers"},
+		{"compatible without URL", ProviderSettings{Default: ProviderConfig{Type: ProviderOpenAICompatible, Model: "llama3"}}, "default provider: openai_compatible provider needs an http(s) base URL"},
+		{"compatible without model", ProviderSettings{Default: ProviderConfig{Type: ProviderOpenAICompatible, BaseURL: "http://localhost:11434/v1"}}, "default provider: openai_compatible provider needs a model"},
+		{"unknown task", ProviderSettings{Default: ProviderConfig{Type: ProviderOpenRouter}, Tasks: map[string]ProviderConfig{"summarize": {Type: ProviderOpenRouter}}}, "unknown AI task type: summarize"},
+		{"anthropic default for chat", ProviderSettings{Default: ProviderConfig{Type: ProviderAnthropic}}, "chat provider: the anthropic provider does not support tool calls; choose another provider for chat"},
+		{"anthropic chat provider", ProviderSettings{Default: ProviderConfig{Type: ProviderOpenRouter}, Tasks: map[string]ProviderConfig{"chat": {Type: ProviderAnthropic}}}, "chat provider: the anthropic provider does not support tool calls; choose another provider for chat"},
+		{"invalid task provider", ProviderSettings{Default: ProviderConfig{Type: ProviderOpenRouter}, Tasks: map[string]ProviderConfig{"chat": {Type: "?"}}}, "chat provider: unknown AI provider: ?"},
+	}
+	for _, tt := range invalid {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.EqualError(t, service.SaveSettings(tt.settings), tt.wantErr)
+		})
+	}
+
+	local := ProviderConfig{Type: ProviderOpenAICompatible, BaseURL: "http://localhost:11434/v1", Model: "llama3.1"}
+	require.NoError(t, service.SaveSettings(ProviderSettings{
+		Default: ProviderConfig{Type: ProviderAnthropic},
+		Tasks:   map[string]ProviderConfig{"chat": local},
+	}))
+
+	config, err := service.ConfigForTask("chat")
+	require.NoError(t, err)
+	assert.Equal(t, local, *config)
+	config, err = service.ConfigForTask("reorder")
+	require.NoError(t, err)
+	assert.Equal(t, ProviderAnthropic, config.Type)
+}
+
+func TestProviderCredentials(t *testing.T) {
+	helper := goapp.NewTestHelper(t)
+	service := NewProviderService(helper.Client, helper.Ctx)
+	noKey := func() (string, error) { return "", nil }
+
+	_, err := service.TextAPIKey("reorder", noKey)
+	assert.EqualError(t, err, "OpenRouter API key not configured")
+
+	apiKey, err := service.TextAPIKey("reorder", func() (string, error) { return "or-key", nil })
+	require.NoError(t, err)
+	assert.Equal(t, "or-key", apiKey)
+
+	require.NoError(t, service.SaveSettings(ProviderSettings{
+		Default: ProviderConfig{Type: ProviderAnthropic},
+		Tasks:   map[string]ProviderConfig{"chat": {Type: ProviderOpenAICompatible, BaseURL: "http://localhost:8080/v1", Model: "qwen"}},
+	}))
+
+	_, err = service.TextAPIKey("reorder", noKey)
+	assert.EqualError(t, err, "Anthropic API key not configured")
+	helper.CreateTestSetting("anthropic_api_key", "sk-ant")
+	provider, _, err := service.ProviderForTask("reorder", "")
+	require.NoError(t, err)
+	assert.Equal(t, ProviderAnthropic, provider.Name())
+
+	apiKey, err = service.TextAPIKey("chat", noKey)
+	require.NoError(t, err, "local endpoints need no key")
+	assert.Empty(t, apiKey)
+}
+
+func TestProviderModelFor(t *testing.T) {
+	assert.Equal(t, "anthropic/claude-sonnet-4", ProviderConfig{Type: ProviderOpenRouter}.modelFor("anthropic/claude-sonnet-4"))
+	assert.Equal(t, "claude-sonnet-4", ProviderConfig{Type: ProviderAnthropic}.modelFor("anthropic/claude-sonnet-4"))
+	assert.Equal(t, "gpt-4o", ProviderConfig{Type: ProviderOpenAI}.modelFor("openai/gpt-4o"))
+	assert.Equal(t, "llama3.1", ProviderConfig{Type: ProviderOpenAICompatible, Model: "llama3.1"}.modelFor("anthropic/claude-sonnet-4"))
+}
+
+func TestChatCompletionsProvider(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "/v1/chat/completions", r.URL.Path)
+		assert.Empty(t, r.Header.Get("Authorization"), "no key, no auth header")
+
+		var body map[string]interface{}
+		require.NoError(t, json.NewDecoder(r.Body).Decode(&body))
+		assert.Equal(t, "llama3.1", body["model"])
+		assert.NotContains(t, body, "usage", "usage accounting is OpenRouter only")
+		assert.Equal(t, "json_schema", body["response_format"].(map[string]interface{})["type"])
+
+		w.Write([]byte(`{"model":"llama3.1","choices":[{"message":{"role":"assistant","content":"[]"}}],"usage":{"prompt_tokens":12,"completion_tokens":3,"total_tokens":15}}`))
+	}))
+	defer server.Close()
+
+	provider := newChatCompletionsProvider(ProviderOpenAICompatible, server.URL+"/v1/", "")
+	response, err := provider.Complete(&CompletionRequest{
+		Model:          "llama3.1",
+		Messages:       []Message{{Role: "user", Content: "hi"}},
+		ResponseFormat: StructuredSchema{Name: "ranges", Schema: map[string]interface{}{"type": "array"}}.ResponseFormat(),
+	})
+	require.NoError(t, err)
+	assert.Equal(t, "[]", response.Choices[0].Message.Content)
+	assert.Equal(t, 15, response.Usage.TotalTokens)
+
+	failing := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		http.Error(w, "model not found", http.StatusNotFound)
+	}))
+	defer failing.Close()
+	_, err = newChatCompletionsProvider(ProviderOpenAI, failing.URL, "key").Complete(&CompletionRequest{Model: "x"})
+	assert.EqualError(t, err, "OpenAI API error: model not found\n")
+}
+
+func TestAnthropicProvider(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "/v1/messages", r.URL.Path)
+		assert.Equal(t, "sk-ant", r.Header.Get("x-api-key"))
+		assert.Equal(t, anthropicAPIVersion, r.Header.Get("anthropic-version"))
+
+		var body anthropicRequest
+		require.NoError(t, json.NewDecoder(r.Body).Decode(&body))
+		assert.Equal(t, "claude-sonnet-4", body.Model)
+		assert.Equal(t, anthropicDefaultMaxTokens, body.MaxTokens)
+		assert.Equal(t, []anthropicMessage{{Role: "user", Content: "Order these"}}, body.Messages)
+		assert.Equal(t, "Be brief\n\nRespond with JSON only, matching this JSON schema:\n{\"type\":\"array\"}", body.System)
+
+		w.Write([]byte(`{"model":"claude-sonnet-4-20250514","content":[{"type":"text","text":"[1,"},{"type":"text","text":"2]"}],"usage":{"input_tokens":40,"output_tokens":5}}`))
+	}))
+	defer server.Close()
+
+	response, err := newAnthropicProvider(server.URL+"/v1", "sk-ant").Complete(&CompletionRequest{
+		Model: "claude-sonnet-4",
+		Messages: []Message{
+			{Role: "system", Content: "Be brief"},
+			{Role: "user", Content: "Order these"},
+		},
+		ResponseFormat: StructuredSchema{Name: "order", Schema: map[string]interface{}{"type": "array"}}.ResponseFormat(),
+	})
+	require.NoError(t, err)
+	assert.Equal(t, "[1,2]", response.Choices[0].Message.Content)
+	assert.Equal(t, "claude-sonnet-4-20250514", response.Model)
+	assert.Equal(t, &OpenRouterUsage{PromptTokens: 40, CompletionTokens: 5, TotalTokens: 45}, response.Usage)
+
+	_, err = newAnthropicProvider(server.URL+"/v1", "sk-ant").Complete(&CompletionRequest{
+		Model:    "claude-sonnet-4",
+		Messages: []Message{{Role: "user", Content: "Reorder these"}},
+		Tools:    []map[string]interface{}{{"type": "function", "function": map[string]interface{}{"name": "noop"}}},
+	})
+	assert.EqualError(t, err, "the Anthropic provider does not support tool calls")
+}
+
+func TestProcessTextWithLocalProvider(t *testing.T) {
+	helper := goapp.NewTestHelper(t)
+	requests := 0
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		requests++
+		w.Write([]byte(`{"choices":[{"message":{"role":"assistant","content":"local reply"}}],"usage":{"prompt_tokens":10,"completion_tokens":2,"total_tokens":12}}`))
+	}))
+	defer server.Close()
+
+	require.NoError(t, NewProviderService(helper.Client, helper.Ctx).SaveSettings(ProviderSettings{
+		Default: ProviderConfig{Type: ProviderOpenAICompatible, BaseURL: server.URL, Model: "llama3.1"},
+	}))
+
+	core := NewCoreAIService(helper.Client, helper.Ctx)
+	for i := 0; i < 2; i++ {
+		response, err := core.ProcessText(&TextProcessingRequest{Model: "anthropic/claude-sonnet-4", TaskType: "reorder", UserPrompt: "order"}, "")
+		require.NoError(t, err, "no OpenRouter key is needed")
+		assert.Equal(t, "local reply", response.Choices[0].Message.Content)
+	}
+	assert.Equal(t, 1, requests, "second call is served from the cache")
+
+	record, err := helper.Client.UsageRecord.Query().Only(helper.Ctx)
+	require.NoError(t, err)
+	assert.Equal(t, ProviderOpenAICompatible, record.Provider)
+	assert.Equal(t, "llama3.1", record.Model)
+	assert.Equal(t, 12, record.TotalTokens)
+	assert.Zero(t, record.CostUsd)
+	assert.False(t, record.CostEstimated)
+}
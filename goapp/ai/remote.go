@@ -46,6 +46,17 @@ func (s *RemoteAIService) ProcessText(request *TextProcessingRequest) (*OpenRout
 		return nil, fmt.Errorf("API key not configured")
 	}
 
+	return NewResponseCache(s.client, s.ctx).Do(request, func() (*OpenRouterResponse, error) {
+		return s.sendText(request)
+	})
+}
+
+// sendText sends a text request to the remote backend and records its usage
+func (s *RemoteAIService) sendText(request *TextProcessingRequest) (*OpenRouterResponse, error) {
+	if err := CheckBudget(s.client, s.ctx); err != nil {
+		return nil, err
+	}
+
 	// Build the full URL
 	url := s.backendURL + "/api/ai/process-text"
 
@@ -96,6 +107,8 @@ func (s *RemoteAIService) ProcessText(request *TextProcessingRequest) (*OpenRout
 		return nil, fmt.Errorf("failed to parse response: %w", err)
 	}
 
+	recordTextUsage(s.client, s.ctx, ProviderOpenRouter, request, &result)
+
 	return &result, nil
 }
 
@@ -107,6 +120,9 @@ func (s *RemoteAIService) ProcessAudio(audioFile string) (*AudioProcessingResult
 	if s.apiKey == "" {
 		return nil, fmt.Errorf("API key not configured")
 	}
+	if err := CheckBudget(s.client, s.ctx); err != nil {
+		return nil, err
+	}
 
 	// Open the audio file
 	file, err := os.Open(audioFile)
@@ -182,4 +198,65 @@ func (s *RemoteAIService) ProcessAudio(audioFile string) (*AudioProcessingResult
 	}
 
 	return &result, nil
-}
\ No newline at end of file
+}
+// ProcessEmbeddings handles embedding requests via remote backend
+func (s *RemoteAIService) ProcessEmbeddings(request *EmbeddingRequest) (*EmbeddingResult, error) {
+	if s.backendURL == "" {
+		return nil, fmt.Errorf("backend URL not configured")
+	}
+	if s.apiKey == "" {
+		return nil, fmt.Errorf("API key not configured")
+	}
+
+	// Build the full URL
+	url := s.backendURL + "/api/ai/embeddings"
+
+	// Marshal request
+	jsonData, err := json.Marshal(request)
+	if err != nil {
+		return nil, fmt.Errorf("failed to marshal request: %w", err)
+	}
+
+	// Create HTTP request
+	req, err := http.NewRequest("POST", url, bytes.NewBuffer(jsonData))
+	if err != nil {
+		return nil, fmt.Errorf("failed to create request: %w", err)
+	}
+
+	// Set headers
+	req.Header.Set("Authorization", "Bearer "+s.apiKey)
+	req.Header.Set("Content-Type", "application/json")
+
+	// Make request
+	resp, err := s.httpClient.Do(req)
+	if err != nil {
+		return nil, fmt.Errorf("failed to make request: %w", err)
+	}
+	defer resp.Body.Close()
+
+	// Read response
+	body, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read response: %w", err)
+	}
+
+	// Handle non-200 responses
+	if resp.StatusCode != http.StatusOK {
+		var errorResp map[string]interface{}
+		if json.Unmarshal(body, &errorResp) == nil {
+			if errorMsg, ok := errorResp["error"].(string); ok {
+				return nil, fmt.Errorf("remote AI service error: %s", errorMsg)
+			}
+		}
+		return nil, fmt.Errorf("remote AI service error (status %d): %s", resp.StatusCode, string(body))
+	}
+
+	// Parse response
+	var result EmbeddingResult
+	err = json.Unmarshal(body, &result)
+	if err != nil {
+		return nil, fmt.Errorf("failed to parse response: %w", err)
+	}
+
+	return &result, nil
+}
@@ -0,0 +1,175 @@
+package ai
+
+import (
+	"bufio"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"strings"
+)
+
+// maxStreamLineSize bounds a single server-sent event line of a streamed completion
+const maxStreamLineSize = 4 * 1024 * 1024
+
+// StreamingProvider is a TextProvider that can deliver a reply while it is generated
+type StreamingProvider interface {
+	TextProvider
+	// Stream sends a completion with streaming and calls onDelta with each piece of reply text.
+	// The returned response holds the complete reply, with tool calls assembled from their fragments.
+	Stream(ctx context.Context, request *CompletionRequest, onDelta func(string)) (*CompletionResponse, error)
+}
+
+// StreamOptions asks OpenAI to report token usage in the last chunk of a stream
+type StreamOptions struct {
+	IncludeUsage bool `json:"include_usage"`
+}
+
+// streamingHTTPClient sends streamed requests. It has no overall timeout because a long reply can
+// take longer than providerRequestTimeout to stream; the request context stops it instead.
+var streamingHTTPClient = &http.Client{
+	Transport: &http.Transport{
+		Proxy:                 http.ProxyFromEnvironment,
+		ResponseHeaderTimeout: providerRequestTimeout,
+	},
+}
+
+// streamChunk is one server-sent event of an OpenAI-style streamed completion
+type streamChunk struct {
+	Model   string `json:"model"`
+	Choices []struct {
+		Delta struct {
+			Content   string `json:"content"`
+			ToolCalls []struct {
+				Index    int              `json:"index"`
+				ID       string           `json:"id"`
+				Type     string           `json:"type"`
+				Function ToolCallFunction `json:"function"`
+			} `json:"tool_calls"`
+		} `json:"delta"`
+	} `json:"choices"`
+	Usage *OpenRouterUsage `json:"usage"`
+	Error *struct {
+		Message string `json:"message"`
+	} `json:"error"`
+}
+
+// streamAccumulator assembles the chunks of a stream into a complete response
+type streamAccumulator struct {
+	model     string
+	content   strings.Builder
+	toolCalls []ToolCall
+	usage     *OpenRouterUsage
+}
+
+// add merges a chunk into the reply and returns the text it added. Tool calls arrive in fragments
+// keyed by index: the first carries the ID and name, later ones continue the JSON arguments.
+func (a *streamAccumulator) add(chunk *streamChunk) string {
+	if chunk.Model != "" {
+		a.model = chunk.Model
+	}
+	if chunk.Usage != nil {
+		a.usage = chunk.Usage
+	}
+
+	var delta strings.Builder
+	for _, choice := range chunk.Choices {
+		delta.WriteString(choice.Delta.Content)
+		for _, fragment := range choice.Delta.ToolCalls {
+			for len(a.toolCalls) <= fragment.Index {
+				a.toolCalls = append(a.toolCalls, ToolCall{Type: "function"})
+			}
+			call := &a.toolCalls[fragment.Index]
+			if fragment.ID != "" {
+				call.ID = fragment.ID
+			}
+			if fragment.Type != "" {
+				call.Type = fragment.Type
+			}
+			call.Function.Name += fragment.Function.Name
+			call.Function.Arguments += fragment.Function.Arguments
+		}
+	}
+	a.content.WriteString(delta.String())
+	return delta.String()
+}
+
+// response returns the assembled reply
+func (a *streamAccumulator) response() *CompletionResponse {
+	return &CompletionResponse{
+		Model: a.model,
+		Choices: []Choice{{Message: Message{
+			Role:      "assistant",
+			Content:   a.content.String(),
+			ToolCalls: a.toolCalls,
+		}}},
+		Usage: a.usage,
+	}
+}
+
+// Stream implements StreamingProvider
+func (p *chatCompletionsProvider) Stream(ctx context.Context, request *CompletionRequest, onDelta func(string)) (*CompletionResponse, error) {
+	body, headers := p.request(request)
+	body.Stream = true
+	if p.name == ProviderOpenAI {
+		body.StreamOptions = &StreamOptions{IncludeUsage: true} // OpenRouter reports usage through body.Usage
+	}
+
+	req, err := newJSONRequest(ctx, p.baseURL+"/chat/completions", headers, body)
+	if err != nil {
+		return nil, err
+	}
+	req.Header.Set("Accept", "text/event-stream")
+
+	resp, err := streamingHTTPClient.Do(req)
+	if err != nil {
+		return nil, fmt.Errorf("failed to make request: %w", err)
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK {
+		responseBody, _ := io.ReadAll(resp.Body)
+		return nil, fmt.Errorf("%s API error: %s", providerLabel(p.name), string(responseBody))
+	}
+
+	return readStream(resp.Body, p.name, onDelta)
+}
+
+// readStream reads the server-sent events of an OpenAI-style stream until [DONE] or the end of the body
+func readStream(body io.Reader, provider string, onDelta func(string)) (*CompletionResponse, error) {
+	scanner := bufio.NewScanner(body)
+	scanner.Buffer(make([]byte, 64*1024), maxStreamLineSize)
+
+	var accumulator streamAccumulator
+	for scanner.Scan() {
+		data, ok := strings.CutPrefix(scanner.Text(), "data:")
+		if !ok {
+			continue // event separators and keep-alive comments
+		}
+		data = strings.TrimSpace(data)
+		if data == "[DONE]" {
+			break
+		}
+
+		var chunk streamChunk
+		if err := json.Unmarshal([]byte(data), &chunk); err != nil {
+			return nil, fmt.Errorf("failed to parse stream chunk: %w", err)
+		}
+		if chunk.Error != nil {
+			return nil, fmt.Errorf("%s API error: %s", providerLabel(provider), chunk.Error.Message)
+		}
+		if delta := accumulator.add(&chunk); delta != "" && onDelta != nil {
+			onDelta(delta)
+		}
+	}
+	if err := scanner.Err(); err != nil {
+		return nil, fmt.Errorf("failed to read stream: %w", err)
+	}
+
+	response := accumulator.response()
+	if message := response.Choices[0].Message; message.Content == "" && len(message.ToolCalls) == 0 {
+		return nil, fmt.Errorf("no response from %s API", providerLabel(provider))
+	}
+	return response, nil
+}
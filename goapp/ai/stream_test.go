@@ -0,0 +1,137 @@
+package ai
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"ramble-ai/goapp"
+)
+
+func TestReadStream(t *testing.T) {
+	t.Run("assembles content, tool call fragments and usage", func(t *testing.T) {
+		body := strings.Join([]string{
+			": OPENROUTER PROCESSING",
+			`data: {"model":"m","choices":[{"delta":{"content":"Let me "}}]}`,
+			"",
+			`data: {"choices":[{"delta":{"content":"check."}}]}`,
+			`data: {"choices":[{"delta":{"tool_calls":[{"index":0,"id":"call_1","type":"function","function":{"name":"get_current_order","arguments":""}}]}}]}`,
+			`data: {"choices":[{"delta":{"tool_calls":[{"index":0,"function":{"arguments":"{\"verbose\":"}}]}}]}`,
+			`data: {"choices":[{"delta":{"tool_calls":[{"index":1,"id":"call_2","function":{"name":"analyze_highlights","arguments":"{}"}}]}}]}`,
+			`data: {"choices":[{"delta":{"tool_calls":[{"index":0,"function":{"arguments":"true}"}}]}}]}`,
+			`data: {"choices":[],"usage":{"prompt_tokens":20,"completion_tokens":8,"total_tokens":28}}`,
+			"data: [DONE]",
+			`data: {"choices":[{"delta":{"content":"ignored"}}]}`,
+		}, "\n")
+
+		var deltas []string
+		response, err := readStream(strings.NewReader(body), ProviderOpenRouter, func(delta string) {
+			deltas = append(deltas, delta)
+		})
+		require.NoError(t, err)
+
+		assert.Equal(t, []string{"Let me ", "check."}, deltas)
+		assert.Equal(t, "m", response.Model)
+		message := response.Choices[0].Message
+		assert.Equal(t, "Let me check.", message.Content)
+		assert.Equal(t, []ToolCall{
+			{ID: "call_1", Type: "function", Function: ToolCallFunction{Name: "get_current_order", Arguments: `{"verbose":true}`}},
+			{ID: "call_2", Type: "function", Function: ToolCallFunction{Name: "analyze_highlights", Arguments: "{}"}},
+		}, message.ToolCalls)
+		assert.Equal(t, 28, response.Usage.TotalTokens)
+	})
+
+	t.Run("error chunk", func(t *testing.T) {
+		_, err := readStream(strings.NewReader(`data: {"error":{"message":"rate limited"}}`), ProviderOpenAI, nil)
+		assert.EqualError(t, err, "OpenAI API error: rate limited")
+	})
+
+	t.Run("empty stream", func(t *testing.T) {
+		_, err := readStream(strings.NewReader("data: [DONE]\n"), ProviderOpenAICompatible, nil)
+		assert.EqualError(t, err, "no response from AI provider API")
+	})
+}
+
+func TestStreamText(t *testing.T) {
+	helper := goapp.NewTestHelper(t)
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		var body map[string]interface{}
+		require.NoError(t, json.NewDecoder(r.Body).Decode(&body))
+		assert.Equal(t, true, body["stream"])
+		assert.Len(t, body["tools"], 1)
+
+		w.Header().Set("Content-Type", "text/event-stream")
+		for _, word := range []string{"Hello", " there"} {
+			fmt.Fprintf(w, "data: {\"choices\":[{\"delta\":{\"content\":%q}}]}\n\n", word)
+			w.(http.Flusher).Flush()
+		}
+		fmt.Fprint(w, "data: {\"choices\":[],\"usage\":{\"prompt_tokens\":9,\"completion_tokens\":2,\"total_tokens\":11}}\n\ndata: [DONE]\n\n")
+	}))
+	defer server.Close()
+
+	require.NoError(t, NewProviderService(helper.Client, helper.Ctx).SaveSettings(ProviderSettings{
+		Default: ProviderConfig{Type: ProviderOpenAICompatible, BaseURL: server.URL, Model: "llama3.1"},
+	}))
+
+	var received strings.Builder
+	response, err := NewCoreAIService(helper.Client, helper.Ctx).StreamText(helper.Ctx, &TextProcessingRequest{
+		UserPrompt: "hi",
+		TaskType:   "chat",
+		Tools:      []map[string]interface{}{{"type": "function", "function": map[string]interface{}{"name": "noop"}}},
+	}, "", func(delta string) { received.WriteString(delta) })
+	require.NoError(t, err)
+
+	assert.Equal(t, "Hello there", received.String())
+	assert.Equal(t, "Hello there", response.Choices[0].Message.Content)
+
+	record, err := helper.Client.UsageRecord.Query().Only(helper.Ctx)
+	require.NoError(t, err)
+	assert.Equal(t, "chat", record.TaskType)
+	assert.Equal(t, 11, record.TotalTokens)
+}
+
+func TestStreamTextRecordsPartialUsage(t *testing.T) {
+	helper := goapp.NewTestHelper(t)
+	var failBeforeOutput bool
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "text/event-stream")
+		if !failBeforeOutput {
+			fmt.Fprint(w, "data: {\"choices\":[{\"delta\":{\"content\":\"Hello there, general\"}}]}\n\n")
+		}
+		fmt.Fprint(w, "data: {\"error\":{\"message\":\"overloaded\"}}\n\n")
+	}))
+	defer server.Close()
+
+	require.NoError(t, NewProviderService(helper.Client, helper.Ctx).SaveSettings(ProviderSettings{
+		Default: ProviderConfig{Type: ProviderOpenAICompatible, BaseURL: server.URL, Model: "llama3.1"},
+	}))
+	stream := func() error {
+		_, err := NewCoreAIService(helper.Client, helper.Ctx).StreamText(helper.Ctx, &TextProcessingRequest{
+			SystemPrompt: "You are helpful",
+			UserPrompt:   "hi",
+			TaskType:     "chat",
+		}, "", nil)
+		return err
+	}
+
+	require.ErrorContains(t, stream(), "overloaded")
+	record, err := helper.Client.UsageRecord.Query().Only(helper.Ctx)
+	require.NoError(t, err)
+	assert.Equal(t, "chat", record.TaskType)
+	assert.Equal(t, CountTokens("llama3.1", "Hello there, general"), record.CompletionTokens)
+	assert.Equal(t, CountTokens("llama3.1", "You are helpful")+CountTokens("llama3.1", "hi"), record.PromptTokens)
+	assert.Equal(t, record.PromptTokens+record.CompletionTokens, record.TotalTokens)
+
+	// A stream that fails before any output is not recorded
+	failBeforeOutput = true
+	require.Error(t, stream())
+	count, err := helper.Client.UsageRecord.Query().Count(helper.Ctx)
+	require.NoError(t, err)
+	assert.Equal(t, 1, count)
+}
@@ -0,0 +1,364 @@
+package ai
+
+import (
+	"encoding/json"
+	"fmt"
+	"log"
+	"regexp"
+	"sort"
+	"strings"
+)
+
+// DefaultStructuredAttempts bounds how many times a structured request is sent before failing
+const DefaultStructuredAttempts = 3
+
+// ResponseFormat asks OpenRouter for output matching a JSON schema. Providers that do not
+// support structured outputs ignore it, so responses are always validated locally as well.
+type ResponseFormat struct {
+	Type       string            `json:"type"` // "json_schema"
+	JSONSchema *JSONSchemaFormat `json:"json_schema,omitempty"`
+}
+
+// JSONSchemaFormat is the json_schema member of a ResponseFormat
+type JSONSchemaFormat struct {
+	Name   string                 `json:"name"`
+	Strict bool                   `json:"strict"`
+	Schema map[string]interface{} `json:"schema"`
+}
+
+// StructuredSchema names and describes the JSON value a model must return
+type StructuredSchema struct {
+	Name   string
+	Schema map[string]interface{}
+}
+
+// ResponseFormat returns the OpenRouter response_format for the schema
+func (s StructuredSchema) ResponseFormat() *ResponseFormat {
+	return &ResponseFormat{
+		Type: "json_schema",
+		JSONSchema: &JSONSchemaFormat{
+			Name:   s.Name,
+			Schema: s.Schema,
+		},
+	}
+}
+
+// HighlightOrderItemSchema describes one entry of a highlight order: a highlight ID,
+// an "N" break or a {"type": "N", "title": ...} section object
+var HighlightOrderItemSchema = map[string]interface{}{
+	"anyOf": []interface{}{
+		map[string]interface{}{"type": "string"},
+		map[string]interface{}{
+			"type": "object",
+			"properties": map[string]interface{}{
+				"type":  map[string]interface{}{"type": "string", "enum": []interface{}{"N"}},
+				"title": map[string]interface{}{"type": "string"},
+			},
+			"required": []string{"type"},
+		},
+	},
+}
+
+// Completer sends a user prompt to a model and returns the text of its reply.
+// format is nil when the request should be sent without a response_format.
+type Completer func(userPrompt string, format *ResponseFormat) (string, error)
+
+// TextCompleter adapts an AIService to a Completer, reusing the request's system prompt, model and task type
+func TextCompleter(service AIService, request TextProcessingRequest) Completer {
+	return func(userPrompt string, format *ResponseFormat) (string, error) {
+		attempt := request
+		attempt.UserPrompt = userPrompt
+		attempt.ResponseFormat = format
+
+		raw, err := service.ProcessText(&attempt)
+		if err != nil {
+			return "", err
+		}
+		result, err := ParseTextResponse(raw, attempt.TaskType)
+		if err != nil {
+			return "", err
+		}
+		return result.Content, nil
+	}
+}
+
+// CompleteStructured sends a prompt and decodes the reply into T. Replies that are not valid JSON,
+// do not match the schema or fail validate are sent back to the model with the errors, up to
+// maxAttempts requests in total (0 uses DefaultStructuredAttempts). validate may be nil.
+func CompleteStructured[T any](complete Completer, prompt string, schema StructuredSchema, validate func(T) error, maxAttempts int) (T, error) {
+	var zero T
+	if maxAttempts <= 0 {
+		maxAttempts = DefaultStructuredAttempts
+	}
+
+	format := schema.ResponseFormat()
+	userPrompt := prompt
+	var lastErr error
+
+	for attempt := 1; attempt <= maxAttempts; attempt++ {
+		content, err := complete(userPrompt, format)
+		if err != nil && format != nil && strings.Contains(err.Error(), "response_format") {
+			// The model rejected structured outputs; rely on local validation instead
+			log.Printf("[STRUCTURED] %s: response_format not supported, retrying without it", schema.Name)
+			format = nil
+			content, err = complete(userPrompt, nil)
+		}
+		if err != nil {
+			return zero, err
+		}
+
+		value, err := ParseStructured[T](content, schema)
+		if err == nil && validate != nil {
+			err = validate(value)
+		}
+		if err == nil {
+			return value, nil
+		}
+
+		lastErr = err
+		log.Printf("[STRUCTURED] %s: attempt %d/%d invalid: %v", schema.Name, attempt, maxAttempts, err)
+		userPrompt = buildCorrectionPrompt(prompt, content, err)
+	}
+
+	return zero, fmt.Errorf("invalid %s response after %d attempts: %w", schema.Name, maxAttempts, lastErr)
+}
+
+// ParseStructured extracts the JSON value from a model reply, checks it against the schema and decodes it into T
+func ParseStructured[T any](content string, schema StructuredSchema) (T, error) {
+	var value T
+
+	expectArray := schema.Schema["type"] == "array"
+	jsonText, err := ExtractJSON(content, expectArray)
+	if err != nil {
+		return value, err
+	}
+
+	var generic interface{}
+	if err := json.Unmarshal([]byte(jsonText), &generic); err != nil {
+		return value, fmt.Errorf("invalid JSON: %w", err)
+	}
+
+	if errs := ValidateJSONSchema(generic, schema.Schema); len(errs) > 0 {
+		return value, fmt.Errorf("response does not match the required format: %s", strings.Join(errs, "; "))
+	}
+
+	if err := json.Unmarshal([]byte(jsonText), &value); err != nil {
+		return value, fmt.Errorf("failed to decode response: %w", err)
+	}
+	return value, nil
+}
+
+// buildCorrectionPrompt repeats the original prompt with the rejected reply and why it was rejected
+func buildCorrectionPrompt(prompt, previous string, validationErr error) string {
+	return fmt.Sprintf(`%s
+
+YOUR PREVIOUS RESPONSE WAS REJECTED:
+%s
+
+Previous response:
+%s
+
+Return only the corrected JSON, with no additional text.`, prompt, validationErr.Error(), previous)
+}
+
+var (
+	missingCommaBetweenStrings = regexp.MustCompile(`"([^"]+)"\s*\n\s*"([^"]+)"`)
+	missingCommaAfterObject    = regexp.MustCompile(`}\s*\n\s*"([^"]+)"`)
+	missingCommaBeforeObject   = regexp.MustCompile(`"([^"]+)"\s*\n\s*\{`)
+)
+
+// ExtractJSON returns the JSON array (or object) in a model reply, dropping markdown fences and
+// surrounding prose and adding commas models commonly leave out between array elements
+func ExtractJSON(content string, expectArray bool) (string, error) {
+	clean := strings.TrimSpace(content)
+	clean = strings.Trim(clean, "`")
+	clean = strings.TrimSpace(strings.TrimPrefix(clean, "json"))
+
+	open, close := "{", "}"
+	if expectArray {
+		open, close = "[", "]"
+	}
+
+	start := strings.Index(clean, open)
+	end := strings.LastIndex(clean, close)
+	if start == -1 || end == -1 || end <= start {
+		if expectArray {
+			return "", fmt.Errorf("no valid JSON array found in response")
+		}
+		return "", fmt.Errorf("no valid JSON object found in response")
+	}
+
+	jsonText := clean[start : end+1]
+	if expectArray {
+		jsonText = missingCommaBetweenStrings.ReplaceAllString(jsonText, "\"$1\",\n  \"$2\"")
+		jsonText = missingCommaAfterObject.ReplaceAllString(jsonText, "},\n  \"$1\"")
+		jsonText = missingCommaBeforeObject.ReplaceAllString(jsonText, "\"$1\",\n  {")
+	}
+	return jsonText, nil
+}
+
+// ValidateJSONSchema checks a decoded JSON value against the subset of JSON Schema used by the app
+// (type, properties, required, items, enum, anyOf, minimum, maximum) and returns one message per problem
+func ValidateJSONSchema(value interface{}, schema map[string]interface{}) []string {
+	return validateSchemaAt("$", value, schema)
+}
+
+// validateSchemaAt validates a value found at path
+func validateSchemaAt(path string, value interface{}, schema map[string]interface{}) []string {
+	if len(schema) == 0 {
+		return nil
+	}
+
+	if options, ok := schema["anyOf"].([]interface{}); ok {
+		for _, option := range options {
+			if optionSchema, ok := option.(map[string]interface{}); ok && len(validateSchemaAt(path, value, optionSchema)) == 0 {
+				return nil
+			}
+		}
+		return []string{fmt.Sprintf("%s does not match any allowed form", path)}
+	}
+
+	if schemaType, ok := schema["type"].(string); ok && !matchesJSONType(value, schemaType) {
+		return []string{fmt.Sprintf("%s must be %s, got %s", path, withArticle(schemaType), describeJSONValue(value))}
+	}
+
+	var errs []string
+	if enum, ok := schema["enum"].([]interface{}); ok {
+		found := false
+		for _, allowed := range enum {
+			if fmt.Sprint(allowed) == fmt.Sprint(value) {
+				found = true
+				break
+			}
+		}
+		if !found {
+			errs = append(errs, fmt.Sprintf("%s must be one of %v", path, enum))
+		}
+	}
+
+	if minimum, ok := schemaNumber(schema["minimum"]); ok {
+		if number, ok := value.(float64); ok && number < minimum {
+			errs = append(errs, fmt.Sprintf("%s must be at least %v", path, minimum))
+		}
+	}
+
+	if maximum, ok := schemaNumber(schema["maximum"]); ok {
+		if number, ok := value.(float64); ok && number > maximum {
+			errs = append(errs, fmt.Sprintf("%s must be at most %v", path, maximum))
+		}
+	}
+
+	switch v := value.(type) {
+	case map[string]interface{}:
+		for _, name := range requiredFields(schema) {
+			if _, present := v[name]; !present {
+				errs = append(errs, fmt.Sprintf("%s is missing required field %q", path, name))
+			}
+		}
+		if properties, ok := schema["properties"].(map[string]interface{}); ok {
+			names := make([]string, 0, len(properties))
+			for name := range properties {
+				names = append(names, name)
+			}
+			sort.Strings(names)
+			for _, name := range names {
+				field, present := v[name]
+				propertySchema, ok := properties[name].(map[string]interface{})
+				if present && ok {
+					errs = append(errs, validateSchemaAt(path+"."+name, field, propertySchema)...)
+				}
+			}
+		}
+	case []interface{}:
+		if itemSchema, ok := schema["items"].(map[string]interface{}); ok {
+			for i, item := range v {
+				errs = append(errs, validateSchemaAt(fmt.Sprintf("%s[%d]", path, i), item, itemSchema)...)
+			}
+		}
+	}
+
+	return errs
+}
+
+// schemaNumber reads a numeric schema keyword written as a Go int or float
+func schemaNumber(value interface{}) (float64, bool) {
+	switch v := value.(type) {
+	case int:
+		return float64(v), true
+	case float64:
+		return v, true
+	}
+	return 0, false
+}
+
+// requiredFields reads the required list of an object schema
+func requiredFields(schema map[string]interface{}) []string {
+	switch required := schema["required"].(type) {
+	case []string:
+		return required
+	case []interface{}:
+		names := make([]string, 0, len(required))
+		for _, name := range required {
+			if s, ok := name.(string); ok {
+				names = append(names, s)
+			}
+		}
+		return names
+	}
+	return nil
+}
+
+// matchesJSONType reports whether a decoded JSON value has the given JSON Schema type
+func matchesJSONType(value interface{}, schemaType string) bool {
+	switch schemaType {
+	case "object":
+		_, ok := value.(map[string]interface{})
+		return ok
+	case "array":
+		_, ok := value.([]interface{})
+		return ok
+	case "string":
+		_, ok := value.(string)
+		return ok
+	case "number":
+		_, ok := value.(float64)
+		return ok
+	case "integer":
+		number, ok := value.(float64)
+		return ok && number == float64(int64(number))
+	case "boolean":
+		_, ok := value.(bool)
+		return ok
+	case "null":
+		return value == nil
+	}
+	return true
+}
+
+// describeJSONValue names the JSON type of a decoded value for error messages
+func describeJSONValue(value interface{}) string {
+	switch value.(type) {
+	case nil:
+		return "null"
+	case map[string]interface{}:
+		return "an object"
+	case []interface{}:
+		return "an array"
+	case string:
+		return "a string"
+	case float64:
+		return "a number"
+	case bool:
+		return "a boolean"
+	}
+	return fmt.Sprintf("%T", value)
+}
+
+// withArticle prefixes a JSON type name with "a" or "an"
+func withArticle(schemaType string) string {
+	switch schemaType {
+	case "object", "array", "integer":
+		return "an " + schemaType
+	}
+	return "a " + schemaType
+}
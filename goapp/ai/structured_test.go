@@ -0,0 +1,201 @@
+package ai
+
+import (
+	"errors"
+	"fmt"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+type testRange struct {
+	Start int `json:"start"`
+	End   int `json:"end"`
+}
+
+var testRangesSchema = StructuredSchema{
+	Name: "ranges",
+	Schema: map[string]interface{}{
+		"type": "array",
+		"items": map[string]interface{}{
+			"type": "object",
+			"properties": map[string]interface{}{
+				"start": map[string]interface{}{"type": "integer", "minimum": 0},
+				"end":   map[string]interface{}{"type": "integer"},
+			},
+			"required": []string{"start", "end"},
+		},
+	},
+}
+
+// scriptedCompleter replies with the given responses in turn and records the prompts and formats it received
+func scriptedCompleter(responses ...string) (Completer, *[]string, *[]*ResponseFormat) {
+	var prompts []string
+	var formats []*ResponseFormat
+	return func(userPrompt string, format *ResponseFormat) (string, error) {
+		prompts = append(prompts, userPrompt)
+		formats = append(formats, format)
+		if len(prompts) > len(responses) {
+			return "", fmt.Errorf("unexpected request %d", len(prompts))
+		}
+		return responses[len(prompts)-1], nil
+	}, &prompts, &formats
+}
+
+func TestExtractJSON(t *testing.T) {
+	tests := []struct {
+		name        string
+		content     string
+		expectArray bool
+		expected    string
+		wantErr     bool
+	}{
+		{"markdown fence", "```json\n[\"a\", \"b\"]\n```", true, `["a", "b"]`, false},
+		{"surrounding prose", `Here you go: {"ok": true} hope that helps`, false, `{"ok": true}`, false},
+		{"missing commas", "[\n\"a\"\n\"b\"\n]", true, "[\n\"a\",\n  \"b\"\n]", false},
+		{"no array", "nothing here", true, "", true},
+		{"no object", "[1, 2]", false, "", true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			result, err := ExtractJSON(tt.content, tt.expectArray)
+			if tt.wantErr {
+				assert.Error(t, err)
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, tt.expected, result)
+		})
+	}
+}
+
+func TestValidateJSONSchema(t *testing.T) {
+	schema := map[string]interface{}{
+		"type": "object",
+		"properties": map[string]interface{}{
+			"success": map[string]interface{}{"type": "boolean"},
+			"order": map[string]interface{}{
+				"type":  "array",
+				"items": HighlightOrderItemSchema,
+			},
+		},
+		"required": []string{"success", "order"},
+	}
+
+	assert.Empty(t, ValidateJSONSchema(map[string]interface{}{
+		"success": true,
+		"order":   []interface{}{"highlight_1", "N", map[string]interface{}{"type": "N", "title": "Intro"}},
+	}, schema))
+
+	errs := ValidateJSONSchema(map[string]interface{}{
+		"success": "yes",
+		"order":   []interface{}{"highlight_1", 5.0, map[string]interface{}{"type": "section"}},
+	}, schema)
+	assert.Equal(t, []string{
+		"$.order[1] does not match any allowed form",
+		"$.order[2] does not match any allowed form",
+		"$.success must be a boolean, got a string",
+	}, errs)
+
+	errs = ValidateJSONSchema(map[string]interface{}{}, schema)
+	assert.Equal(t, []string{
+		`$ is missing required field "success"`,
+		`$ is missing required field "order"`,
+	}, errs)
+
+	assert.Equal(t, []string{"$[0].start must be at least 0"}, ValidateJSONSchema([]interface{}{
+		map[string]interface{}{"start": -1.0, "end": 2.0},
+	}, testRangesSchema.Schema))
+	assert.Equal(t, []string{"$[0].end must be an integer, got a number"}, ValidateJSONSchema([]interface{}{
+		map[string]interface{}{"start": 1.0, "end": 2.5},
+	}, testRangesSchema.Schema))
+}
+
+func TestParseStructured(t *testing.T) {
+	ranges, err := ParseStructured[[]testRange]("Sure! [{\"start\": 1, \"end\": 4}]", testRangesSchema)
+	require.NoError(t, err)
+	assert.Equal(t, []testRange{{Start: 1, End: 4}}, ranges)
+
+	_, err = ParseStructured[[]testRange](`[{"start": 1}]`, testRangesSchema)
+	assert.ErrorContains(t, err, `missing required field "end"`)
+
+	_, err = ParseStructured[[]testRange](`[{"start": 1, "end": 4}`, testRangesSchema)
+	assert.Error(t, err)
+}
+
+func TestCompleteStructured(t *testing.T) {
+	t.Run("first valid response is returned with the schema attached", func(t *testing.T) {
+		complete, prompts, formats := scriptedCompleter(`[{"start": 0, "end": 3}]`)
+
+		ranges, err := CompleteStructured[[]testRange](complete, "suggest", testRangesSchema, nil, 0)
+		require.NoError(t, err)
+		assert.Equal(t, []testRange{{Start: 0, End: 3}}, ranges)
+		assert.Equal(t, []string{"suggest"}, *prompts)
+		require.NotNil(t, (*formats)[0])
+		assert.Equal(t, "json_schema", (*formats)[0].Type)
+		assert.Equal(t, "ranges", (*formats)[0].JSONSchema.Name)
+	})
+
+	t.Run("invalid responses are re-prompted with the errors", func(t *testing.T) {
+		complete, prompts, _ := scriptedCompleter(
+			"not json",
+			`[{"start": 5, "end": 2}]`,
+			`[{"start": 2, "end": 5}]`,
+		)
+		validate := func(ranges []testRange) error {
+			for _, r := range ranges {
+				if r.Start > r.End {
+					return fmt.Errorf("start %d is after end %d", r.Start, r.End)
+				}
+			}
+			return nil
+		}
+
+		ranges, err := CompleteStructured(complete, "suggest", testRangesSchema, validate, 3)
+		require.NoError(t, err)
+		assert.Equal(t, []testRange{{Start: 2, End: 5}}, ranges)
+		require.Len(t, *prompts, 3)
+		assert.Contains(t, (*prompts)[1], "no valid JSON array found")
+		assert.Contains(t, (*prompts)[1], "not json")
+		assert.Contains(t, (*prompts)[2], "start 5 is after end 2")
+	})
+
+	t.Run("fails after the attempt limit", func(t *testing.T) {
+		complete, prompts, _ := scriptedCompleter("nope", "still nope")
+
+		_, err := CompleteStructured[[]testRange](complete, "suggest", testRangesSchema, nil, 2)
+		assert.ErrorContains(t, err, "invalid ranges response after 2 attempts")
+		assert.Len(t, *prompts, 2)
+	})
+
+	t.Run("unsupported response_format falls back to plain requests", func(t *testing.T) {
+		var formats []*ResponseFormat
+		complete := func(userPrompt string, format *ResponseFormat) (string, error) {
+			formats = append(formats, format)
+			if format != nil {
+				return "", errors.New("OpenRouter API error (status 400): response_format is not supported by this model")
+			}
+			return `[]`, nil
+		}
+
+		ranges, err := CompleteStructured[[]testRange](complete, "suggest", testRangesSchema, nil, 0)
+		require.NoError(t, err)
+		assert.Empty(t, ranges)
+		require.Len(t, formats, 2)
+		assert.Nil(t, formats[1])
+	})
+
+	t.Run("request errors are returned without retrying", func(t *testing.T) {
+		calls := 0
+		complete := func(string, *ResponseFormat) (string, error) {
+			calls++
+			return "", errors.New("network down")
+		}
+
+		_, err := CompleteStructured[[]testRange](complete, "suggest", testRangesSchema, nil, 0)
+		assert.EqualError(t, err, "network down")
+		assert.Equal(t, 1, calls)
+	})
+}
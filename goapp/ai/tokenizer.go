@@ -0,0 +1,87 @@
+package ai
+
+import (
+	"log"
+	"strings"
+	"sync"
+	"unicode/utf8"
+
+	"github.com/pkoukk/tiktoken-go"
+	tiktoken_loader "github.com/pkoukk/tiktoken-go-loader"
+)
+
+// BPE encodings bundled with the app
+const (
+	EncodingO200K  = "o200k_base"  // GPT-4o, GPT-4.1 and the o-series
+	EncodingCL100K = "cl100k_base" // GPT-4, GPT-3.5 and the default for other families
+)
+
+func init() {
+	// Load vocabularies from the binary instead of downloading them on first use
+	tiktoken.SetBpeLoader(tiktoken_loader.NewOfflineLoader())
+}
+
+// o200kModels are the OpenAI model name prefixes tokenized with o200k_base
+var o200kModels = []string{"gpt-4o", "gpt-4.1", "gpt-4.5", "gpt-5", "o1", "o3", "o4", "chatgpt-4o"}
+
+// encoders holds each encoding once it has been loaded
+var encoders = struct {
+	sync.Mutex
+	loaded map[string]*tiktoken.Tiktoken
+	failed map[string]bool
+}{loaded: map[string]*tiktoken.Tiktoken{}, failed: map[string]bool{}}
+
+// EncodingForModel returns the BPE encoding used to count a model's tokens. Anthropic, Google, Meta
+// and other vendors don't publish tokenizers the app can bundle, so their models are counted with
+// cl100k_base, which lands within a few percent for English text.
+func EncodingForModel(model string) string {
+	name := strings.ToLower(strings.TrimSpace(model))
+	vendor, bare, found := strings.Cut(name, "/")
+	if !found {
+		bare, vendor = name, ""
+	}
+	if vendor == "" || vendor == "openai" {
+		for _, prefix := range o200kModels {
+			if strings.HasPrefix(bare, prefix) {
+				return EncodingO200K
+			}
+		}
+	}
+	return EncodingCL100K
+}
+
+// CountTokens counts the tokens of text for a model. If the model's encoding cannot be loaded it
+// falls back to an estimate of four characters per token.
+func CountTokens(model, text string) int {
+	if text == "" {
+		return 0
+	}
+
+	encoder := encoderFor(EncodingForModel(model))
+	if encoder == nil {
+		return (utf8.RuneCountInString(text) + 3) / 4
+	}
+	return len(encoder.EncodeOrdinary(text))
+}
+
+// encoderFor returns an encoding, loading it on first use. It returns nil when the encoding cannot be loaded.
+func encoderFor(encoding string) *tiktoken.Tiktoken {
+	encoders.Lock()
+	defer encoders.Unlock()
+
+	if encoder, ok := encoders.loaded[encoding]; ok {
+		return encoder
+	}
+	if encoders.failed[encoding] {
+		return nil
+	}
+
+	encoder, err := tiktoken.GetEncoding(encoding)
+	if err != nil {
+		log.Printf("[AI] Warning: failed to load %s tokenizer, estimating tokens: %v", encoding, err)
+		encoders.failed[encoding] = true
+		return nil
+	}
+	encoders.loaded[encoding] = encoder
+	return encoder
+}
@@ -0,0 +1,88 @@
+package ai
+
+import (
+	"context"
+	"log"
+
+	"ramble-ai/ent"
+	"ramble-ai/goapp/usage"
+)
+
+// CheckBudget fails once the monthly AI budget cap has been reached. Services without a database are not metered.
+func CheckBudget(client *ent.Client, ctx context.Context) error {
+	if client == nil {
+		return nil
+	}
+	return usage.NewUsageService(client, ctx).CheckBudget()
+}
+
+// RecordTextUsage adds a completed text call to the usage ledger. Failures are logged
+// so a ledger problem never loses a response that was already paid for.
+func RecordTextUsage(client *ent.Client, ctx context.Context, provider string, projectID int, taskType string, model string, reported *OpenRouterUsage) {
+	if client == nil {
+		return
+	}
+
+	entry := usage.Entry{
+		ProjectID: projectID,
+		Provider:  provider,
+		TaskType:  taskType,
+		Model:     model,
+	}
+	if entry.TaskType == "" {
+		entry.TaskType = "text"
+	}
+	if info, ok := LookupModel(model); ok {
+		entry.Price = &usage.ModelPrice{Input: info.PromptPrice, Output: info.CompletionPrice}
+	}
+	if reported != nil {
+		entry.PromptTokens = reported.PromptTokens
+		entry.CompletionTokens = reported.CompletionTokens
+		entry.TotalTokens = reported.TotalTokens
+		entry.CostUSD = reported.Cost
+	}
+	if provider == ProviderOpenAICompatible && entry.CostUSD == nil {
+		free := 0.0 // self-hosted models have no per-token price
+		entry.CostUSD = &free
+	}
+
+	if err := usage.NewUsageService(client, ctx).Record(entry); err != nil {
+		log.Printf("[USAGE] Warning: %v", err)
+	}
+}
+
+// recordTextUsage adds a completed text request to the usage ledger
+func recordTextUsage(client *ent.Client, ctx context.Context, provider string, request *TextProcessingRequest, response *OpenRouterResponse) {
+	model := response.Model
+	if model == "" {
+		model = request.Model
+	}
+	RecordTextUsage(client, ctx, provider, contextProjectID(request.Context), request.TaskType, model, response.Usage)
+}
+
+// partialStreamResponse stands in for the response of a stream that stopped before the provider
+// reported usage, estimating the tokens from the prompts and the text received so far
+func partialStreamResponse(request *TextProcessingRequest, received string) *OpenRouterResponse {
+	promptTokens := CountTokens(request.Model, request.SystemPrompt) + CountTokens(request.Model, request.UserPrompt)
+	completionTokens := CountTokens(request.Model, received)
+	return &OpenRouterResponse{
+		Model: request.Model,
+		Usage: &OpenRouterUsage{
+			PromptTokens:     promptTokens,
+			CompletionTokens: completionTokens,
+			TotalTokens:      promptTokens + completionTokens,
+		},
+	}
+}
+
+// contextProjectID returns the "projectID" of a request context, 0 when it has none.
+// Requests relayed as JSON carry numbers as float64.
+func contextProjectID(values map[string]interface{}) int {
+	switch id := values["projectID"].(type) {
+	case int:
+		return id
+	case float64:
+		return int(id)
+	}
+	return 0
+}
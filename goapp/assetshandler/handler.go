@@ -19,7 +19,8 @@ import (
 
 // AssetHandler provides asset serving functionality for video files and thumbnails
 type AssetHandler struct {
-	// Can add configuration options here if needed
+	// cacheDir overrides the media cache location for filmstrips and waveforms (defaults to the app data dir)
+	cacheDir string
 }
 
 // NewAssetHandler creates a new asset handler service
@@ -53,6 +54,21 @@ func (h *AssetHandler) CreateAssetMiddleware() assetserver.Middleware {
 				h.HandleThumbnailRequest(w, r)
 				return
 			}
+			// Check if this is a filmstrip manifest request
+			if strings.HasPrefix(r.URL.Path, "/api/filmstrip/") {
+				h.HandleFilmstripRequest(w, r)
+				return
+			}
+			// Check if this is a filmstrip frame request
+			if strings.HasPrefix(r.URL.Path, "/api/filmstrip-frame/") {
+				h.HandleFilmstripFrameRequest(w, r)
+				return
+			}
+			// Check if this is a waveform request
+			if strings.HasPrefix(r.URL.Path, "/api/waveform/") {
+				h.HandleWaveformRequest(w, r)
+				return
+			}
 			// Check if this is a direct file request
 			if strings.HasPrefix(r.URL.Path, "/api/files/direct/") {
 				h.HandleDirectFileRequest(w, r)
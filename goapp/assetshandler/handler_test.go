@@ -241,6 +241,17 @@ func TestCreateAssetMiddleware(t *testing.T) {
 		assert.NotEqual(t, "next handler called", rr.Body.String())
 	})
 
+	t.Run("handles filmstrip and waveform requests", func(t *testing.T) {
+		for _, path := range []string{"/api/filmstrip/abc", "/api/filmstrip-frame/abc", "/api/waveform/abc"} {
+			req := httptest.NewRequest(http.MethodGet, path, nil)
+			rr := httptest.NewRecorder()
+
+			wrappedHandler.ServeHTTP(rr, req)
+
+			assert.NotEqual(t, "next handler called", rr.Body.String(), path)
+		}
+	})
+
 	t.Run("handles direct file requests", func(t *testing.T) {
 		req := httptest.NewRequest(http.MethodGet, "/api/files/direct/test.mp4", nil)
 		rr := httptest.NewRecorder()
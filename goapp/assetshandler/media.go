@@ -0,0 +1,440 @@
+package assetshandler
+
+import (
+	"crypto/sha256"
+	"encoding/binary"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"log"
+	"math"
+	"net/http"
+	"net/url"
+	"os"
+	"path/filepath"
+	"regexp"
+	"sort"
+	"strconv"
+	"strings"
+	"sync"
+
+	"ramble-ai/goapp"
+)
+
+const (
+	// DefaultFilmstripInterval is the default number of seconds between filmstrip frames
+	DefaultFilmstripInterval = 5.0
+	// MinFilmstripInterval and MaxFilmstripInterval bound the interval accepted from requests
+	MinFilmstripInterval = 1.0
+	MaxFilmstripInterval = 60.0
+	// FilmstripFrameWidth is the pixel width of each filmstrip frame
+	FilmstripFrameWidth = 160
+
+	// DefaultWaveformPeaksPerSecond is the default waveform resolution
+	DefaultWaveformPeaksPerSecond = 20
+	// MaxWaveformPeaksPerSecond bounds the resolution accepted from requests
+	MaxWaveformPeaksPerSecond = 100
+	// waveformSampleRate is the PCM sample rate used to compute peaks
+	waveformSampleRate = 8000
+
+	// mediaCacheMaxAge is how long clients may cache derived media without revalidating
+	mediaCacheMaxAge = 86400
+)
+
+// FilmstripFrame describes a single frame of a filmstrip
+type FilmstripFrame struct {
+	Index int     `json:"index"`
+	Time  float64 `json:"time"`
+	URL   string  `json:"url"`
+}
+
+// FilmstripManifest lists the frames generated for a video at a given interval
+type FilmstripManifest struct {
+	CacheKey string           `json:"cacheKey"`
+	Interval float64          `json:"interval"`
+	Width    int              `json:"width"`
+	Frames   []FilmstripFrame `json:"frames"`
+}
+
+// WaveformData holds normalized audio peaks (0..1) for a clip
+type WaveformData struct {
+	CacheKey       string    `json:"cacheKey"`
+	PeaksPerSecond int       `json:"peaksPerSecond"`
+	Duration       float64   `json:"duration"`
+	Peaks          []float64 `json:"peaks"`
+}
+
+var (
+	// mediaCacheLocks serializes generation per cache entry so concurrent requests don't run ffmpeg twice
+	mediaCacheLocks sync.Map
+
+	cacheKeyPattern  = regexp.MustCompile(`^[0-9a-f]{64}$`)
+	stripDirPattern  = regexp.MustCompile(`^filmstrip_[0-9.]+$`)
+	frameNamePattern = regexp.MustCompile(`^frame_[0-9]{4,}\.jpg$`)
+)
+
+// lockMediaCacheEntry locks the given cache entry and returns the unlock function
+func lockMediaCacheEntry(entry string) func() {
+	value, _ := mediaCacheLocks.LoadOrStore(entry, &sync.Mutex{})
+	mu := value.(*sync.Mutex)
+	mu.Lock()
+	return mu.Unlock
+}
+
+// mediaCacheDir returns the root directory for derived media
+func (h *AssetHandler) mediaCacheDir() (string, error) {
+	if h.cacheDir != "" {
+		if err := os.MkdirAll(h.cacheDir, 0755); err != nil {
+			return "", fmt.Errorf("failed to create media cache directory: %w", err)
+		}
+		return h.cacheDir, nil
+	}
+	return goapp.GetMediaCacheDir()
+}
+
+// MediaCacheKey returns a hash identifying the current contents of a file.
+// It combines the path, size and modification time so edits to the file invalidate the cache
+// without reading multi-gigabyte videos on every request.
+func (h *AssetHandler) MediaCacheKey(filePath string) (string, error) {
+	info, err := os.Stat(filePath)
+	if err != nil {
+		return "", fmt.Errorf("failed to stat file: %w", err)
+	}
+
+	hash := sha256.New()
+	fmt.Fprintf(hash, "%s|%d|%d", filePath, info.Size(), info.ModTime().UnixNano())
+	return hex.EncodeToString(hash.Sum(nil)), nil
+}
+
+// GenerateFilmstrip extracts frames every interval seconds and returns the manifest, reusing cached frames
+func (h *AssetHandler) GenerateFilmstrip(videoPath string, interval float64) (*FilmstripManifest, error) {
+	cacheKey, err := h.MediaCacheKey(videoPath)
+	if err != nil {
+		return nil, err
+	}
+
+	rootDir, err := h.mediaCacheDir()
+	if err != nil {
+		return nil, err
+	}
+
+	stripDirName := "filmstrip_" + strconv.FormatFloat(interval, 'f', -1, 64)
+	stripDir := filepath.Join(rootDir, cacheKey, stripDirName)
+	manifestPath := filepath.Join(stripDir, "manifest.json")
+
+	unlock := lockMediaCacheEntry(stripDir)
+	defer unlock()
+
+	if data, err := os.ReadFile(manifestPath); err == nil {
+		var manifest FilmstripManifest
+		if err := json.Unmarshal(data, &manifest); err == nil {
+			log.Printf("[FILMSTRIP] Using cached filmstrip: %s", stripDir)
+			return &manifest, nil
+		}
+		log.Printf("[FILMSTRIP] Ignoring unreadable manifest: %s", manifestPath)
+	}
+
+	// Start from a clean directory so stale frames from a failed run aren't picked up
+	if err := os.RemoveAll(stripDir); err != nil {
+		return nil, fmt.Errorf("failed to clear filmstrip directory: %w", err)
+	}
+	if err := os.MkdirAll(stripDir, 0755); err != nil {
+		return nil, fmt.Errorf("failed to create filmstrip directory: %w", err)
+	}
+
+	log.Printf("[FILMSTRIP] Generating filmstrip for: %s (every %.2fs)", videoPath, interval)
+	if err := goapp.GenerateFilmstripFrames(videoPath, stripDir, interval, FilmstripFrameWidth); err != nil {
+		os.RemoveAll(stripDir)
+		return nil, fmt.Errorf("ffmpeg failed: %w", err)
+	}
+
+	entries, err := os.ReadDir(stripDir)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read filmstrip directory: %w", err)
+	}
+
+	var frameNames []string
+	for _, entry := range entries {
+		if frameNamePattern.MatchString(entry.Name()) {
+			frameNames = append(frameNames, entry.Name())
+		}
+	}
+	sort.Strings(frameNames)
+
+	manifest := &FilmstripManifest{
+		CacheKey: cacheKey,
+		Interval: interval,
+		Width:    FilmstripFrameWidth,
+		Frames:   make([]FilmstripFrame, 0, len(frameNames)),
+	}
+	for i, name := range frameNames {
+		manifest.Frames = append(manifest.Frames, FilmstripFrame{
+			Index: i,
+			Time:  float64(i) * interval,
+			URL:   fmt.Sprintf("/api/filmstrip-frame/%s/%s/%s", cacheKey, stripDirName, name),
+		})
+	}
+
+	data, err := json.Marshal(manifest)
+	if err != nil {
+		return nil, fmt.Errorf("failed to marshal filmstrip manifest: %w", err)
+	}
+	if err := os.WriteFile(manifestPath, data, 0644); err != nil {
+		return nil, fmt.Errorf("failed to write filmstrip manifest: %w", err)
+	}
+
+	log.Printf("[FILMSTRIP] Successfully generated %d frames: %s", len(manifest.Frames), stripDir)
+	return manifest, nil
+}
+
+// GenerateWaveform computes audio peaks for a clip and returns the path of the cached JSON file
+func (h *AssetHandler) GenerateWaveform(mediaPath string, peaksPerSecond int) (string, error) {
+	cacheKey, err := h.MediaCacheKey(mediaPath)
+	if err != nil {
+		return "", err
+	}
+
+	rootDir, err := h.mediaCacheDir()
+	if err != nil {
+		return "", err
+	}
+
+	entryDir := filepath.Join(rootDir, cacheKey)
+	if err := os.MkdirAll(entryDir, 0755); err != nil {
+		return "", fmt.Errorf("failed to create waveform directory: %w", err)
+	}
+	waveformPath := filepath.Join(entryDir, fmt.Sprintf("waveform_%d.json", peaksPerSecond))
+
+	unlock := lockMediaCacheEntry(waveformPath)
+	defer unlock()
+
+	if _, err := os.Stat(waveformPath); err == nil {
+		log.Printf("[WAVEFORM] Using cached waveform: %s", waveformPath)
+		return waveformPath, nil
+	}
+
+	log.Printf("[WAVEFORM] Generating waveform for: %s (%d peaks/s)", mediaPath, peaksPerSecond)
+	pcm, err := goapp.ExtractAudioPCM(mediaPath, waveformSampleRate)
+	if err != nil {
+		return "", fmt.Errorf("ffmpeg failed: %w", err)
+	}
+
+	waveform := WaveformData{
+		CacheKey:       cacheKey,
+		PeaksPerSecond: peaksPerSecond,
+		Duration:       float64(len(pcm)/2) / waveformSampleRate,
+		Peaks:          computePeaks(pcm, waveformSampleRate, peaksPerSecond),
+	}
+
+	data, err := json.Marshal(waveform)
+	if err != nil {
+		return "", fmt.Errorf("failed to marshal waveform: %w", err)
+	}
+
+	// Write to a temp file first so a crash never leaves a truncated cache entry
+	tmpPath := waveformPath + ".tmp"
+	if err := os.WriteFile(tmpPath, data, 0644); err != nil {
+		return "", fmt.Errorf("failed to write waveform: %w", err)
+	}
+	if err := os.Rename(tmpPath, waveformPath); err != nil {
+		return "", fmt.Errorf("failed to finalize waveform: %w", err)
+	}
+
+	log.Printf("[WAVEFORM] Successfully generated %d peaks: %s", len(waveform.Peaks), waveformPath)
+	return waveformPath, nil
+}
+
+// computePeaks reduces mono s16le PCM to one normalized peak (0..1) per bucket
+func computePeaks(pcm []byte, sampleRate, peaksPerSecond int) []float64 {
+	sampleCount := len(pcm) / 2
+	if sampleCount == 0 || sampleRate <= 0 || peaksPerSecond <= 0 {
+		return []float64{}
+	}
+
+	samplesPerPeak := sampleRate / peaksPerSecond
+	if samplesPerPeak < 1 {
+		samplesPerPeak = 1
+	}
+
+	peaks := make([]float64, 0, sampleCount/samplesPerPeak+1)
+	for start := 0; start < sampleCount; start += samplesPerPeak {
+		end := start + samplesPerPeak
+		if end > sampleCount {
+			end = sampleCount
+		}
+
+		var peak float64
+		for i := start; i < end; i++ {
+			sample := math.Abs(float64(int16(binary.LittleEndian.Uint16(pcm[i*2:]))))
+			if sample > peak {
+				peak = sample
+			}
+		}
+
+		// Round to 3 decimals to keep the JSON compact
+		peaks = append(peaks, math.Round(math.Min(peak/32768.0, 1)*1000)/1000)
+	}
+
+	return peaks
+}
+
+// HandleFilmstripRequest returns the filmstrip manifest for a video
+func (h *AssetHandler) HandleFilmstripRequest(w http.ResponseWriter, r *http.Request) {
+	decodedPath, err := url.QueryUnescape(strings.TrimPrefix(r.URL.Path, "/api/filmstrip/"))
+	if err != nil {
+		log.Printf("[FILMSTRIP] URL decode error: %v", err)
+		http.Error(w, "Invalid file path", http.StatusBadRequest)
+		return
+	}
+
+	interval := DefaultFilmstripInterval
+	if raw := r.URL.Query().Get("interval"); raw != "" {
+		interval, err = strconv.ParseFloat(raw, 64)
+		if err != nil || interval < MinFilmstripInterval || interval > MaxFilmstripInterval {
+			http.Error(w, fmt.Sprintf("interval must be between %g and %g seconds", MinFilmstripInterval, MaxFilmstripInterval), http.StatusBadRequest)
+			return
+		}
+	}
+
+	if !h.IsVideoFile(decodedPath) {
+		http.Error(w, "Not a video file", http.StatusBadRequest)
+		return
+	}
+
+	if _, err := os.Stat(decodedPath); os.IsNotExist(err) {
+		http.Error(w, "Video file not found", http.StatusNotFound)
+		return
+	}
+
+	manifest, err := h.GenerateFilmstrip(decodedPath, interval)
+	if err != nil {
+		log.Printf("[FILMSTRIP] Generation error: %v", err)
+		http.Error(w, "Failed to generate filmstrip", http.StatusInternalServerError)
+		return
+	}
+
+	etag := fmt.Sprintf(`"%s-%g"`, manifest.CacheKey, manifest.Interval)
+	if setMediaCacheHeaders(w, r, etag) {
+		return
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	json.NewEncoder(w).Encode(manifest)
+}
+
+// HandleFilmstripFrameRequest serves a single cached filmstrip frame
+func (h *AssetHandler) HandleFilmstripFrameRequest(w http.ResponseWriter, r *http.Request) {
+	// Expected: /api/filmstrip-frame/<cacheKey>/<filmstrip_dir>/<frame_NNNN.jpg>
+	parts := strings.Split(strings.TrimPrefix(r.URL.Path, "/api/filmstrip-frame/"), "/")
+	if len(parts) != 3 || !cacheKeyPattern.MatchString(parts[0]) || !stripDirPattern.MatchString(parts[1]) || !frameNamePattern.MatchString(parts[2]) {
+		http.Error(w, "Invalid filmstrip frame path", http.StatusBadRequest)
+		return
+	}
+
+	rootDir, err := h.mediaCacheDir()
+	if err != nil {
+		log.Printf("[FILMSTRIP] Cache directory error: %v", err)
+		http.Error(w, "Media cache unavailable", http.StatusInternalServerError)
+		return
+	}
+
+	framePath := filepath.Join(rootDir, parts[0], parts[1], parts[2])
+	if _, err := os.Stat(framePath); os.IsNotExist(err) {
+		http.Error(w, "Frame not found", http.StatusNotFound)
+		return
+	}
+
+	// Frame URLs embed the content hash, so they never change
+	w.Header().Set("Content-Type", "image/jpeg")
+	w.Header().Set("Cache-Control", "public, max-age=31536000, immutable")
+	http.ServeFile(w, r, framePath)
+}
+
+// HandleWaveformRequest serves waveform peaks JSON for a clip
+func (h *AssetHandler) HandleWaveformRequest(w http.ResponseWriter, r *http.Request) {
+	decodedPath, err := url.QueryUnescape(strings.TrimPrefix(r.URL.Path, "/api/waveform/"))
+	if err != nil {
+		log.Printf("[WAVEFORM] URL decode error: %v", err)
+		http.Error(w, "Invalid file path", http.StatusBadRequest)
+		return
+	}
+
+	peaksPerSecond := DefaultWaveformPeaksPerSecond
+	if raw := r.URL.Query().Get("pps"); raw != "" {
+		peaksPerSecond, err = strconv.Atoi(raw)
+		if err != nil || peaksPerSecond < 1 || peaksPerSecond > MaxWaveformPeaksPerSecond {
+			http.Error(w, fmt.Sprintf("pps must be between 1 and %d", MaxWaveformPeaksPerSecond), http.StatusBadRequest)
+			return
+		}
+	}
+
+	if !h.IsVideoFile(decodedPath) {
+		http.Error(w, "Not a video file", http.StatusBadRequest)
+		return
+	}
+
+	if _, err := os.Stat(decodedPath); os.IsNotExist(err) {
+		http.Error(w, "Video file not found", http.StatusNotFound)
+		return
+	}
+
+	waveformPath, err := h.GenerateWaveform(decodedPath, peaksPerSecond)
+	if err != nil {
+		log.Printf("[WAVEFORM] Generation error: %v", err)
+		http.Error(w, "Failed to generate waveform", http.StatusInternalServerError)
+		return
+	}
+
+	cacheKey := filepath.Base(filepath.Dir(waveformPath))
+	etag := fmt.Sprintf(`"%s-%d"`, cacheKey, peaksPerSecond)
+	if setMediaCacheHeaders(w, r, etag) {
+		return
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	http.ServeFile(w, r, waveformPath)
+}
+
+// setMediaCacheHeaders sets caching headers for derived media and answers conditional requests.
+// Returns true if a 304 Not Modified response was written.
+func setMediaCacheHeaders(w http.ResponseWriter, r *http.Request, etag string) bool {
+	w.Header().Set("Cache-Control", fmt.Sprintf("public, max-age=%d", mediaCacheMaxAge))
+	w.Header().Set("ETag", etag)
+
+	if match := r.Header.Get("If-None-Match"); match != "" {
+		for _, candidate := range strings.Split(match, ",") {
+			candidate = strings.TrimSpace(candidate)
+			if candidate == etag || candidate == "*" {
+				w.WriteHeader(http.StatusNotModified)
+				return true
+			}
+		}
+	}
+	return false
+}
+
+// GetFilmstripURL returns a URL for the filmstrip manifest of a video
+func (h *AssetHandler) GetFilmstripURL(filePath string, interval float64) string {
+	if !h.IsVideoFile(filePath) {
+		return ""
+	}
+
+	encodedPath := url.QueryEscape(filePath)
+	if interval <= 0 {
+		return fmt.Sprintf("/api/filmstrip/%s", encodedPath)
+	}
+	return fmt.Sprintf("/api/filmstrip/%s?interval=%g", encodedPath, interval)
+}
+
+// GetWaveformURL returns a URL for the waveform peaks of a video
+func (h *AssetHandler) GetWaveformURL(filePath string, peaksPerSecond int) string {
+	if !h.IsVideoFile(filePath) {
+		return ""
+	}
+
+	encodedPath := url.QueryEscape(filePath)
+	if peaksPerSecond <= 0 {
+		return fmt.Sprintf("/api/waveform/%s", encodedPath)
+	}
+	return fmt.Sprintf("/api/waveform/%s?pps=%d", encodedPath, peaksPerSecond)
+}
@@ -0,0 +1,211 @@
+package assetshandler
+
+import (
+	"encoding/binary"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func pcmFromSamples(samples []int16) []byte {
+	pcm := make([]byte, len(samples)*2)
+	for i, s := range samples {
+		binary.LittleEndian.PutUint16(pcm[i*2:], uint16(s))
+	}
+	return pcm
+}
+
+func TestComputePeaks(t *testing.T) {
+	t.Run("one peak per bucket", func(t *testing.T) {
+		// 8 samples at 4Hz with 2 peaks/s -> buckets of 2 samples
+		pcm := pcmFromSamples([]int16{0, 16384, -32768, 100, 0, 0, 8192, -8192})
+
+		peaks := computePeaks(pcm, 4, 2)
+
+		assert.Equal(t, []float64{0.5, 1, 0, 0.25}, peaks)
+	})
+
+	t.Run("partial trailing bucket", func(t *testing.T) {
+		pcm := pcmFromSamples([]int16{0, 0, 0, 16384, 0})
+
+		peaks := computePeaks(pcm, 4, 2)
+
+		assert.Equal(t, []float64{0, 0.5, 0}, peaks)
+	})
+
+	t.Run("empty input", func(t *testing.T) {
+		assert.Empty(t, computePeaks(nil, 8000, 20))
+		assert.Empty(t, computePeaks(pcmFromSamples([]int16{1}), 0, 20))
+	})
+}
+
+func TestMediaCacheKey(t *testing.T) {
+	handler := NewAssetHandler()
+	testFile := filepath.Join(t.TempDir(), "clip.mp4")
+	require.NoError(t, os.WriteFile(testFile, []byte("v1"), 0644))
+
+	key1, err := handler.MediaCacheKey(testFile)
+	require.NoError(t, err)
+	assert.Len(t, key1, 64)
+
+	again, err := handler.MediaCacheKey(testFile)
+	require.NoError(t, err)
+	assert.Equal(t, key1, again)
+
+	// Changing the file must produce a new key
+	require.NoError(t, os.WriteFile(testFile, []byte("v2 longer"), 0644))
+	require.NoError(t, os.Chtimes(testFile, time.Now(), time.Now().Add(time.Minute)))
+	key2, err := handler.MediaCacheKey(testFile)
+	require.NoError(t, err)
+	assert.NotEqual(t, key1, key2)
+
+	_, err = handler.MediaCacheKey("/nonexistent/clip.mp4")
+	assert.Error(t, err)
+}
+
+func TestGetFilmstripAndWaveformURL(t *testing.T) {
+	handler := NewAssetHandler()
+
+	assert.Equal(t, "/api/filmstrip/%2Fpath%2Fto%2Fvideo.mp4", handler.GetFilmstripURL("/path/to/video.mp4", 0))
+	assert.Equal(t, "/api/filmstrip/%2Fpath%2Fto%2Fvideo.mp4?interval=2.5", handler.GetFilmstripURL("/path/to/video.mp4", 2.5))
+	assert.Equal(t, "", handler.GetFilmstripURL("/path/to/document.txt", 5))
+
+	assert.Equal(t, "/api/waveform/%2Fpath%2Fto%2Fvideo.mp4", handler.GetWaveformURL("/path/to/video.mp4", 0))
+	assert.Equal(t, "/api/waveform/%2Fpath%2Fto%2Fvideo.mp4?pps=50", handler.GetWaveformURL("/path/to/video.mp4", 50))
+	assert.Equal(t, "", handler.GetWaveformURL("/path/to/document.txt", 50))
+}
+
+func TestHandleFilmstripRequest(t *testing.T) {
+	handler := &AssetHandler{cacheDir: t.TempDir()}
+
+	tests := []struct {
+		name         string
+		path         string
+		query        string
+		expectedCode int
+	}{
+		{"invalid URL encoding", "/api/filmstrip/invalid%", "", http.StatusBadRequest},
+		{"interval too small", "/api/filmstrip/" + url.QueryEscape("/nonexistent/file.mp4"), "interval=0.1", http.StatusBadRequest},
+		{"interval not a number", "/api/filmstrip/" + url.QueryEscape("/nonexistent/file.mp4"), "interval=abc", http.StatusBadRequest},
+		{"not a video", "/api/filmstrip/" + url.QueryEscape("/nonexistent/file.txt"), "", http.StatusBadRequest},
+		{"non-existent file", "/api/filmstrip/" + url.QueryEscape("/nonexistent/file.mp4"), "interval=5", http.StatusNotFound},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			req := httptest.NewRequest(http.MethodGet, "/api/filmstrip/x", nil)
+			req.URL.Path = tt.path
+			req.URL.RawQuery = tt.query
+			rr := httptest.NewRecorder()
+
+			handler.HandleFilmstripRequest(rr, req)
+
+			assert.Equal(t, tt.expectedCode, rr.Code)
+		})
+	}
+}
+
+func TestHandleFilmstripFrameRequest(t *testing.T) {
+	cacheDir := t.TempDir()
+	handler := &AssetHandler{cacheDir: cacheDir}
+	cacheKey := strings.Repeat("a", 64)
+
+	frameDir := filepath.Join(cacheDir, cacheKey, "filmstrip_5")
+	require.NoError(t, os.MkdirAll(frameDir, 0755))
+	require.NoError(t, os.WriteFile(filepath.Join(frameDir, "frame_0001.jpg"), []byte("jpeg"), 0644))
+
+	t.Run("serves cached frame", func(t *testing.T) {
+		req := httptest.NewRequest(http.MethodGet, "/api/filmstrip-frame/"+cacheKey+"/filmstrip_5/frame_0001.jpg", nil)
+		rr := httptest.NewRecorder()
+
+		handler.HandleFilmstripFrameRequest(rr, req)
+
+		assert.Equal(t, http.StatusOK, rr.Code)
+		assert.Equal(t, "image/jpeg", rr.Header().Get("Content-Type"))
+		assert.Contains(t, rr.Header().Get("Cache-Control"), "immutable")
+		assert.Equal(t, "jpeg", rr.Body.String())
+	})
+
+	t.Run("missing frame", func(t *testing.T) {
+		req := httptest.NewRequest(http.MethodGet, "/api/filmstrip-frame/"+cacheKey+"/filmstrip_5/frame_0002.jpg", nil)
+		rr := httptest.NewRecorder()
+
+		handler.HandleFilmstripFrameRequest(rr, req)
+
+		assert.Equal(t, http.StatusNotFound, rr.Code)
+	})
+
+	t.Run("rejects path traversal", func(t *testing.T) {
+		req := httptest.NewRequest(http.MethodGet, "/api/filmstrip-frame/x", nil)
+		req.URL.Path = "/api/filmstrip-frame/" + cacheKey + "/../../etc/passwd"
+		rr := httptest.NewRecorder()
+
+		handler.HandleFilmstripFrameRequest(rr, req)
+
+		assert.Equal(t, http.StatusBadRequest, rr.Code)
+	})
+}
+
+func TestHandleWaveformRequest(t *testing.T) {
+	cacheDir := t.TempDir()
+	handler := &AssetHandler{cacheDir: cacheDir}
+
+	t.Run("invalid pps", func(t *testing.T) {
+		req := httptest.NewRequest(http.MethodGet, "/api/waveform/"+url.QueryEscape("/nonexistent/file.mp4")+"?pps=1000", nil)
+		rr := httptest.NewRecorder()
+
+		handler.HandleWaveformRequest(rr, req)
+
+		assert.Equal(t, http.StatusBadRequest, rr.Code)
+	})
+
+	t.Run("non-existent file", func(t *testing.T) {
+		req := httptest.NewRequest(http.MethodGet, "/api/waveform/"+url.QueryEscape("/nonexistent/file.mp4"), nil)
+		rr := httptest.NewRecorder()
+
+		handler.HandleWaveformRequest(rr, req)
+
+		assert.Equal(t, http.StatusNotFound, rr.Code)
+	})
+
+	t.Run("serves cached waveform with caching headers", func(t *testing.T) {
+		videoFile := filepath.Join(t.TempDir(), "clip.mp4")
+		require.NoError(t, os.WriteFile(videoFile, []byte("fake video"), 0644))
+
+		cacheKey, err := handler.MediaCacheKey(videoFile)
+		require.NoError(t, err)
+		require.NoError(t, os.MkdirAll(filepath.Join(cacheDir, cacheKey), 0755))
+		require.NoError(t, os.WriteFile(filepath.Join(cacheDir, cacheKey, "waveform_20.json"), []byte(`{"peaks":[0.5]}`), 0644))
+
+		req := httptest.NewRequest(http.MethodGet, handler.GetWaveformURL(videoFile, 0), nil)
+		rr := httptest.NewRecorder()
+
+		handler.HandleWaveformRequest(rr, req)
+
+		require.Equal(t, http.StatusOK, rr.Code)
+		assert.Equal(t, "application/json", rr.Header().Get("Content-Type"))
+		assert.Equal(t, "public, max-age=86400", rr.Header().Get("Cache-Control"))
+		assert.JSONEq(t, `{"peaks":[0.5]}`, rr.Body.String())
+
+		etag := rr.Header().Get("ETag")
+		require.NotEmpty(t, etag)
+
+		// Revalidating with the ETag returns 304
+		req = httptest.NewRequest(http.MethodGet, handler.GetWaveformURL(videoFile, 0), nil)
+		req.Header.Set("If-None-Match", etag)
+		rr = httptest.NewRecorder()
+
+		handler.HandleWaveformRequest(rr, req)
+
+		assert.Equal(t, http.StatusNotModified, rr.Code)
+		assert.Empty(t, rr.Body.String())
+	})
+}
@@ -87,27 +87,7 @@ func (s *ChatbotService) callOpenRouterAPI(apiKey string, request map[string]int
 	}
 
 	// Build system and user prompts from messages
-	var systemPrompt, userPrompt string
-	for _, msg := range messages {
-		role, _ := msg["role"].(string)
-		content, _ := msg["content"].(string)
-		
-		if role == "system" {
-			if systemPrompt != "" {
-				systemPrompt += "\n\n" + content
-			} else {
-				systemPrompt = content
-			}
-		} else if role == "user" {
-			if userPrompt != "" {
-				userPrompt += "\n\nUser: " + content
-			} else {
-				userPrompt = content
-			}
-		} else if role == "assistant" {
-			userPrompt += "\n\nAssistant: " + content
-		}
-	}
+	systemPrompt, userPrompt := flattenMessages(messages)
 
 	// Use CoreAI service for the API call (keeping old implementation for chatbot)
 	coreAI := ai.NewCoreAIService(s.client, s.ctx)
@@ -117,7 +97,10 @@ func (s *ChatbotService) callOpenRouterAPI(apiKey string, request map[string]int
 		UserPrompt:   userPrompt,
 		Model:        model,
 		TaskType:     "chat",
-		Context:      map[string]interface{}{"originalRequest": request},
+		Context:      map[string]interface{}{"originalRequest": request, "projectID": request["projectID"]},
+	}
+	if format, ok := request["response_format"].(*ai.ResponseFormat); ok {
+		aiRequest.ResponseFormat = format
 	}
 
 	log.Printf("🤖 [LLM REQUEST] Using CoreAI service, Model: %s", model)
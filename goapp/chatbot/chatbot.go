@@ -2,15 +2,23 @@
 //
 // This package implements a chatbot service that can:
 // - Handle conversational AI interactions
-// - Execute function calls for highlight reordering
+// - Execute function calls for highlight reordering and editing
 // - Manage chat history and sessions
 // - Interface with OpenRouter API for LLM capabilities
 //
 // The package is organized into several files:
 // - types.go: Data structures and type definitions
 // - service.go: Main service logic and core functionality
+// - sessions.go: Named chat sessions that can be renamed, forked and archived
+// - transcripts.go: Chat session export to Markdown and JSON, and import from JSON
+// - history_summary.go: Rolling summaries of chat history trimmed from the context window
 // - functions.go: Function calling and execution logic
+// - proposals.go: Highlight order changes proposed as diffs and applied on approval
 // - api.go: OpenRouter API communication
+// - streaming.go: Streamed replies, realtime deltas and cancellation
+// - highlight_editing.go: Functions that create, edit and hide highlights
+// - export_tools.go: Functions that inspect, configure and launch exports
+// - custom_tools.go: User-defined tools backed by external commands or local HTTP endpoints
 //
 // Usage:
 //
@@ -2,7 +2,11 @@ package chatbot
 
 import (
 	"fmt"
+	"slices"
 	"strings"
+	"time"
+
+	"ramble-ai/goapp/projects"
 )
 
 // HighlightOrderingContextBuilder builds context for highlight ordering operations
@@ -113,6 +117,69 @@ func (g *GenericContextBuilder) GetContextDescription() string {
 	return "Basic project information and highlight overview"
 }
 
+// HighlightEditingContextBuilder provides the clips, transcripts, highlights and suggestions the
+// highlight editing functions act on
+type HighlightEditingContextBuilder struct{}
+
+// maxContextTranscriptLength caps each transcript in the highlight editing context
+const maxContextTranscriptLength = 3000
+
+// BuildContext builds context with the IDs the highlight editing functions take
+func (h *HighlightEditingContextBuilder) BuildContext(projectID int, service *ChatbotService) (string, error) {
+	clips, err := service.projectClips(projectID)
+	if err != nil {
+		return "", err
+	}
+	hidden, err := projects.NewProjectService(service.client, service.ctx).GetHiddenHighlights(projectID)
+	if err != nil {
+		return "", err
+	}
+
+	var contextBuilder strings.Builder
+	contextBuilder.WriteString(fmt.Sprintf("Project ID: %d\n\n", projectID))
+
+	for _, clip := range clips {
+		contextBuilder.WriteString(fmt.Sprintf("=== Clip %d: %s (%.1f seconds) ===\n", clip.ID, clip.Name, clip.Duration))
+
+		transcript := clip.Transcription
+		if transcript == "" {
+			transcript = "(not transcribed)"
+		} else if len(transcript) > maxContextTranscriptLength {
+			transcript = transcript[:maxContextTranscriptLength] + "..."
+		}
+		contextBuilder.WriteString(fmt.Sprintf("Transcript: %s\n", transcript))
+
+		if len(clip.Highlights) > 0 {
+			contextBuilder.WriteString("Highlights:\n")
+			for _, highlight := range clip.Highlights {
+				status := ""
+				if slices.Contains(hidden, highlight.ID) {
+					status = ", hidden"
+				}
+				contextBuilder.WriteString(fmt.Sprintf("- %s [%.2f-%.2fs, color %d%s]: \"%s\"\n",
+					highlight.ID, highlight.Start, highlight.End, highlight.ColorID, status,
+					spanText(clip.TranscriptionWords, highlight.Start, highlight.End)))
+			}
+		}
+		if len(clip.SuggestedHighlights) > 0 {
+			contextBuilder.WriteString("Suggested highlights:\n")
+			for _, suggestion := range clip.SuggestedHighlights {
+				contextBuilder.WriteString(fmt.Sprintf("- %s [%.2f-%.2fs]: \"%s\"\n",
+					suggestion.ID, suggestion.Start, suggestion.End,
+					spanText(clip.TranscriptionWords, suggestion.Start, suggestion.End)))
+			}
+		}
+		contextBuilder.WriteString("\n")
+	}
+
+	return contextBuilder.String(), nil
+}
+
+// GetContextDescription returns a description of what context this builder provides
+func (h *HighlightEditingContextBuilder) GetContextDescription() string {
+	return "Clip transcripts with their highlight and suggestion IDs for highlight editing"
+}
+
 // ContentAnalysisContextBuilder provides detailed content for analysis
 type ContentAnalysisContextBuilder struct{}
 
@@ -217,6 +284,34 @@ func (e *ExportOptimizationContextBuilder) BuildContext(projectID int, service *
 		contextBuilder.WriteString(fmt.Sprintf("  File: %s\n\n", ph.FilePath))
 	}
 
+	// Add saved presets, recent jobs and exports waiting for confirmation
+	presets, err := projects.NewProjectService(service.client, service.ctx).GetProjectExportPresets(projectID)
+	if err != nil {
+		return "", err
+	}
+	contextBuilder.WriteString("=== Export Presets ===\n")
+	for _, preset := range presets {
+		contextBuilder.WriteString(fmt.Sprintf("- %s: %s, %.2fs padding\n", preset.Name, preset.Mode, preset.PaddingSeconds))
+	}
+
+	jobs, err := service.recentExportJobs(projectID, 5)
+	if err != nil {
+		return "", err
+	}
+	contextBuilder.WriteString("\n=== Recent Export Jobs ===\n")
+	for _, job := range jobs {
+		contextBuilder.WriteString(fmt.Sprintf("- %s: %s (%.0f%%)\n", job.JobID, job.Stage, job.Progress*100))
+	}
+
+	contextBuilder.WriteString("\n=== Exports Awaiting Confirmation ===\n")
+	pendingExports.Lock()
+	for id, pending := range pendingExports.exports {
+		if pending.ProjectID == projectID && time.Now().Before(pending.ExpiresAt) {
+			contextBuilder.WriteString(fmt.Sprintf("- %s: %s export to %s, %.2fs padding\n", id, pending.Mode, pending.OutputFolder, pending.PaddingSeconds))
+		}
+	}
+	pendingExports.Unlock()
+
 	return contextBuilder.String(), nil
 }
 
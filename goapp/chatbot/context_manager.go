@@ -4,66 +4,65 @@ import (
 	"fmt"
 	"log"
 	"strings"
-	"unicode/utf8"
+
+	"ramble-ai/goapp/ai"
 )
 
-// TokenCounter provides token counting utilities for different models
-type TokenCounter struct {
-	modelLimits map[string]int
-}
+// defaultContextLimit is the context window assumed for models missing from the model catalog
+const defaultContextLimit = 32000
+
+// messageOverheadTokens covers the role and formatting tokens of each message
+const messageOverheadTokens = 5
+
+// TokenCounter counts tokens with the model's BPE tokenizer and looks up context windows in the
+// model catalog
+type TokenCounter struct{}
 
-// NewTokenCounter creates a new token counter with model-specific limits
+// NewTokenCounter creates a new token counter
 func NewTokenCounter() *TokenCounter {
-	return &TokenCounter{
-		modelLimits: map[string]int{
-			"anthropic/claude-sonnet-4":   200000, // Claude Sonnet 4 context window
-			"anthropic/claude-3.5-sonnet": 200000, // Claude 3.5 Sonnet context window
-			"anthropic/claude-3-haiku":    200000, // Claude 3 Haiku context window
-			"openai/gpt-4o":               128000, // GPT-4o context window
-			"openai/gpt-4o-mini":          128000, // GPT-4o mini context window
-			"openai/gpt-4-turbo":          128000, // GPT-4 Turbo context window
-			"default":                     32000,  // Conservative default
-		},
-	}
+	return &TokenCounter{}
 }
 
 // GetModelLimit returns the context window limit for a given model
 func (tc *TokenCounter) GetModelLimit(model string) int {
-	if limit, exists := tc.modelLimits[model]; exists {
-		return limit
+	if info, ok := ai.LookupModel(model); ok && info.ContextLength > 0 {
+		return info.ContextLength
 	}
-	return tc.modelLimits["default"]
+	return defaultContextLimit
 }
 
-// EstimateTokens provides a rough estimate of token count for text
-// This is a simplified approximation - for production use, consider integrating
-// with model-specific tokenizers
+// EstimateTokens counts the tokens of text with the default tokenizer, for callers that don't know the model
 func (tc *TokenCounter) EstimateTokens(text string) int {
-	if text == "" {
-		return 0
-	}
+	return tc.CountTokens("", text)
+}
 
-	// Rough approximation: 1 token ≈ 4 characters for English text
-	// This varies by model and language, but provides a reasonable estimate
-	charCount := utf8.RuneCountInString(text)
-	return (charCount + 3) / 4 // Round up division
+// CountTokens counts the tokens of text for a model
+func (tc *TokenCounter) CountTokens(model, text string) int {
+	return ai.CountTokens(model, text)
 }
 
-// EstimateMessageTokens estimates tokens for a message including role overhead
+// EstimateMessageTokens counts tokens for a message including role overhead with the default tokenizer
 func (tc *TokenCounter) EstimateMessageTokens(role, content string) int {
-	// Add some overhead for message structure (role, formatting, etc.)
-	roleOverhead := 5
-	contentTokens := tc.EstimateTokens(content)
-	return contentTokens + roleOverhead
+	return tc.CountMessageTokens("", role, content)
+}
+
+// CountMessageTokens counts tokens for a message including role overhead
+func (tc *TokenCounter) CountMessageTokens(model, role, content string) int {
+	return tc.CountTokens(model, content) + messageOverheadTokens
 }
 
-// EstimateMessagesTokens calculates total tokens for a slice of messages
+// EstimateMessagesTokens counts the tokens of a slice of messages with the default tokenizer
 func (tc *TokenCounter) EstimateMessagesTokens(messages []map[string]interface{}) int {
+	return tc.CountMessagesTokens("", messages)
+}
+
+// CountMessagesTokens counts the tokens of a slice of messages for a model
+func (tc *TokenCounter) CountMessagesTokens(model string, messages []map[string]interface{}) int {
 	totalTokens := 0
 	for _, msg := range messages {
 		role, _ := msg["role"].(string)
 		content, _ := msg["content"].(string)
-		totalTokens += tc.EstimateMessageTokens(role, content)
+		totalTokens += tc.CountMessageTokens(model, role, content)
 	}
 	return totalTokens
 }
@@ -71,6 +70,7 @@ func (tc *TokenCounter) EstimateMessagesTokens(messages []map[string]interface{}
 // ContextManager handles intelligent context preservation and trimming
 type ContextManager struct {
 	tokenCounter *TokenCounter
+	summarizer   HistorySummarizer // optional, condenses trimmed messages into the rolling summary
 }
 
 // NewContextManager creates a new context manager
@@ -87,6 +87,9 @@ type ContextWindow struct {
 	TotalTokens     int                      `json:"totalTokens"`
 	TrimmedMessages int                      `json:"trimmedMessages"`
 	Summary         string                   `json:"summary,omitempty"`
+	PromptCostUSD   float64                  `json:"promptCostUsd,omitempty"` // at the model's catalog price
+	// UpdatedSummary is the rolling summary extended with the messages trimmed from this window, to be persisted
+	UpdatedSummary *HistorySummary `json:"-"`
 }
 
 // BuildContextWindow creates an optimized context window for a model
@@ -109,11 +112,11 @@ func (cm *ContextManager) BuildContextWindow(
 		},
 	}
 
-	systemTokens := cm.tokenCounter.EstimateMessageTokens("system", systemPrompt)
+	systemTokens := cm.tokenCounter.CountMessageTokens(model, "system", systemPrompt)
 	currentTokens := systemTokens
 
 	// Add current message tokens to planning
-	currentMsgTokens := cm.tokenCounter.EstimateMessageTokens("user", currentMessage)
+	currentMsgTokens := cm.tokenCounter.CountMessageTokens(model, "user", currentMessage)
 
 	// Calculate available tokens for history
 	availableForHistory := maxContextTokens - systemTokens - currentMsgTokens
@@ -121,17 +124,20 @@ func (cm *ContextManager) BuildContextWindow(
 	var historyMessages []map[string]interface{}
 	var trimmedCount int
 	var summary string
+	var updatedSummary *HistorySummary
 
-	if chatHistory != nil && len(chatHistory.Messages) > 0 {
-		historyMessages, trimmedCount, summary = cm.trimHistory(
+	if chatHistory != nil && (len(chatHistory.Messages) > 0 || chatHistory.Summary != nil) {
+		historyMessages, trimmedCount, summary, updatedSummary = cm.trimHistory(
+			model,
 			chatHistory.Messages,
+			chatHistory.Summary,
 			availableForHistory,
 		)
 	}
 
 	// Add history messages
 	messages = append(messages, historyMessages...)
-	currentTokens += cm.tokenCounter.EstimateMessagesTokens(historyMessages)
+	currentTokens += cm.tokenCounter.CountMessagesTokens(model, historyMessages)
 
 	// Add current message
 	messages = append(messages, map[string]interface{}{
@@ -140,23 +146,38 @@ func (cm *ContextManager) BuildContextWindow(
 	})
 	currentTokens += currentMsgTokens
 
-	return &ContextWindow{
+	window := &ContextWindow{
 		SystemPrompt:    systemPrompt,
 		Messages:        messages,
 		TotalTokens:     currentTokens,
 		TrimmedMessages: trimmedCount,
 		Summary:         summary,
-	}, nil
+		UpdatedSummary:  updatedSummary,
+	}
+	if info, ok := ai.LookupModel(model); ok {
+		window.PromptCostUSD = float64(currentTokens) * info.PromptPrice / 1_000_000
+	}
+	return window, nil
 }
 
-// trimHistory intelligently trims chat history to fit within token limits
+// trimHistory intelligently trims chat history to fit within token limits. Messages already condensed
+// into the previous rolling summary are replaced by it; newly trimmed messages are folded into the
+// summary by the summarizer, or appended as a plain summary when there is none or it fails.
 func (cm *ContextManager) trimHistory(
+	model string,
 	messages []ChatMessage,
+	previous *HistorySummary,
 	maxTokens int,
-) ([]map[string]interface{}, int, string) {
+) ([]map[string]interface{}, int, string, *HistorySummary) {
 
-	if len(messages) == 0 {
-		return []map[string]interface{}{}, 0, ""
+	summary := ""
+	if previous != nil {
+		messages = messagesAfterSummary(messages, previous)
+		summary = previous.Summary
+	}
+
+	if len(messages) == 0 && summary == "" {
+		return []map[string]interface{}{}, 0, "", nil
 	}
 
 	// Convert to API format and estimate tokens
@@ -168,14 +189,20 @@ func (cm *ContextManager) trimHistory(
 			"role":    msg.Role,
 			"content": msg.Content,
 		}
-		tokens := cm.tokenCounter.EstimateMessageTokens(msg.Role, msg.Content)
+		tokens := cm.tokenCounter.CountMessageTokens(model, msg.Role, msg.Content)
 
 		apiMessages = append(apiMessages, apiMsg)
 		tokenCounts = append(tokenCounts, tokens)
 	}
 
+	// Keep room for the summary the conversation already has
+	reservedTokens := 0
+	if summary != "" {
+		reservedTokens = cm.tokenCounter.CountMessageTokens(model, "system", summaryMessageContent(summary))
+	}
+	totalTokens := reservedTokens
+
 	// Use sliding window approach: keep recent messages that fit
-	totalTokens := 0
 	includeFromIndex := len(apiMessages)
 
 	// Work backwards from most recent messages
@@ -189,26 +216,49 @@ func (cm *ContextManager) trimHistory(
 	}
 
 	trimmedCount := includeFromIndex
-	var summary string
+	var updated *HistorySummary
 
-	// If we trimmed messages, create a summary of what was removed
+	// If we trimmed messages, add them to the summary
 	if trimmedCount > 0 {
-		summary = cm.createConversationSummary(messages[:trimmedCount])
+		trimmed := messages[:trimmedCount]
+		if cm.summarizer != nil {
+			condensed, err := cm.summarizer(summary, trimmed)
+			if err != nil {
+				log.Printf("[CHATBOT] Failed to summarize %d trimmed messages: %v", trimmedCount, err)
+			} else if condensed != "" {
+				updated = &HistorySummary{
+					Summary:          condensed,
+					ThroughMessageID: trimmed[trimmedCount-1].ID,
+					ThroughTimestamp: trimmed[trimmedCount-1].Timestamp,
+				}
+				if previous != nil {
+					updated.MessageCount = previous.MessageCount
+				}
+				updated.MessageCount += trimmedCount
+				summary = condensed
+			}
+		}
+		if updated == nil {
+			summary = strings.TrimSpace(summary + " " + cm.createConversationSummary(trimmed))
+		}
+	}
 
-		// Add summary as a system message if we have space
-		summaryTokens := cm.tokenCounter.EstimateMessageTokens("system", summary)
-		if summaryTokens <= maxTokens-totalTokens {
+	// Add summary as a system message if we have space
+	if summary != "" {
+		summaryContent := summaryMessageContent(summary)
+		summaryTokens := cm.tokenCounter.CountMessageTokens(model, "system", summaryContent)
+		if summaryTokens <= maxTokens-(totalTokens-reservedTokens) {
 			summaryMsg := map[string]interface{}{
 				"role":    "system",
-				"content": fmt.Sprintf("Previous conversation summary: %s", summary),
+				"content": summaryContent,
 			}
 			result := []map[string]interface{}{summaryMsg}
 			result = append(result, apiMessages[includeFromIndex:]...)
-			return result, trimmedCount, summary
+			return result, trimmedCount, summary, updated
 		}
 	}
 
-	return apiMessages[includeFromIndex:], trimmedCount, summary
+	return apiMessages[includeFromIndex:], trimmedCount, summary, updated
 }
 
 // createConversationSummary creates a concise summary of conversation messages
@@ -1,17 +1,18 @@
 package chatbot
 
 import (
+	"strings"
 	"testing"
 
 	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
 )
 
 func TestTokenCounter_Basic(t *testing.T) {
 	counter := NewTokenCounter()
 
-	t.Run("creates counter with default limits", func(t *testing.T) {
+	t.Run("creates counter", func(t *testing.T) {
 		assert.NotNil(t, counter)
-		assert.NotNil(t, counter.modelLimits)
 	})
 
 	t.Run("gets model limits", func(t *testing.T) {
@@ -22,8 +23,8 @@ func TestTokenCounter_Basic(t *testing.T) {
 
 	t.Run("estimates tokens", func(t *testing.T) {
 		assert.Equal(t, 0, counter.EstimateTokens(""))
-		assert.Equal(t, 2, counter.EstimateTokens("hello"))  // "hello" is 5 chars, (5+3)/4 = 2
-		assert.Equal(t, 3, counter.EstimateTokens("hello world"))  // "hello world" is 11 chars, (11+3)/4 = 3
+		assert.Equal(t, 1, counter.EstimateTokens("hello"))       // one cl100k_base token
+		assert.Equal(t, 2, counter.EstimateTokens("hello world")) // "hello", " world"
 	})
 
 	t.Run("estimates message tokens", func(t *testing.T) {
@@ -69,4 +70,31 @@ func TestContextManager_LogContextUsage(t *testing.T) {
 	}
 
 	manager.LogContextUsage("anthropic/claude-sonnet-4", window)
-}
\ No newline at end of file
+}
+
+func TestContextManager_BuildContextWindowUsesModelMetadata(t *testing.T) {
+	manager := NewContextManager()
+	assert.Equal(t, 8191, manager.tokenCounter.GetModelLimit("openai/gpt-4"))
+	assert.Equal(t, 1047576, manager.tokenCounter.GetModelLimit("gpt-4.1"))
+
+	// Each message is about 1000 tokens, so the 8k window fits only the latest few
+	history := &ChatHistoryResponse{}
+	for i := 0; i < 12; i++ {
+		role := "user"
+		if i%2 == 1 {
+			role = "assistant"
+		}
+		history.Messages = append(history.Messages, ChatMessage{Role: role, Content: strings.Repeat("clip ", 1000)})
+	}
+
+	window, err := manager.BuildContextWindow("openai/gpt-4", "You are a video editor.", history, "Reorder them", 2500)
+	require.NoError(t, err)
+	assert.LessOrEqual(t, window.TotalTokens, 8191-2500)
+	assert.Equal(t, window.TotalTokens, manager.tokenCounter.CountMessagesTokens("openai/gpt-4", window.Messages))
+	assert.Equal(t, 7, window.TrimmedMessages)
+	assert.InDelta(t, float64(window.TotalTokens)*30/1_000_000, window.PromptCostUSD, 1e-9)
+
+	window, err = manager.BuildContextWindow("openai/gpt-4.1", "You are a video editor.", history, "Reorder them", 2500)
+	require.NoError(t, err)
+	assert.Zero(t, window.TrimmedMessages, "long context models keep the whole history")
+}
@@ -5,12 +5,15 @@ import (
 	"fmt"
 	"log"
 	"strings"
+
+	"ramble-ai/goapp/ai"
 )
 
 // ConversationAgent handles natural conversation with users
 type ConversationAgent struct {
-	endpointID string
-	registry   *MCPRegistry
+	endpointID   string
+	registry     *MCPRegistry
+	instructions string // library prompt added to the system prompt
 }
 
 // NewConversationAgent creates a new conversation agent
@@ -45,6 +48,8 @@ func (ca *ConversationAgent) GetCapabilitiesDescription(endpointID string) strin
 			capabilities = append(capabilities, "🔄 **Reset order** - I can restore highlights to their original chronological order")
 		case "improve_silences":
 			capabilities = append(capabilities, "🔇 **Improve silences** - I can suggest AI-powered improvements to highlight timing with natural silence buffers")
+		case "search_transcripts":
+			capabilities = append(capabilities, "🔎 **Search transcripts** - I can find where a word, phrase or topic comes up across your clips")
 		default:
 			// For future functions, use the description from MCP
 			capabilities = append(capabilities, fmt.Sprintf("⚡ **%s** - %s", function.Name, function.Description))
@@ -225,8 +230,8 @@ REMEMBER: Be conversational and ask clarifying questions when needed. Only outpu
 // ProcessConversation processes a user message in conversation mode
 func (ca *ConversationAgent) ProcessConversation(userMessage string, flow *ConversationFlow, getAPIKey func() (string, error), chatService *ChatbotService, projectID int) (*ConversationResult, error) {
 	// Get API key
-	apiKey, err := getAPIKey()
-	if err != nil || apiKey == "" {
+	apiKey, err := ai.NewProviderService(chatService.client, chatService.ctx).TextAPIKey("chat", getAPIKey)
+	if err != nil {
 		return &ConversationResult{
 			Response:               "I'm sorry, but I'm having trouble connecting to my AI assistant. Please check your API configuration.",
 			HasConversationSummary: false,
@@ -235,15 +240,24 @@ func (ca *ConversationAgent) ProcessConversation(userMessage string, flow *Conve
 
 	// Build conversation system prompt
 	systemPrompt := ca.BuildConversationSystemPrompt(ca.endpointID)
+	if ca.instructions != "" {
+		systemPrompt += "\n\nADDITIONAL INSTRUCTIONS:\n" + ca.instructions
+	}
 
 	// Use context manager to determine optimal history retrieval
 	contextManager := NewContextManager()
 	model := "anthropic/claude-sonnet-4"
-	systemPromptTokens := contextManager.tokenCounter.EstimateTokens(systemPrompt)
+	systemPromptTokens := contextManager.tokenCounter.CountTokens(model, systemPrompt)
 	historyLimit := contextManager.GetOptimalHistoryLimit(model, systemPromptTokens)
 
-	// Get chat history with intelligent limit
-	chatHistory, err := chatService.GetChatHistoryWithLimit(projectID, ca.endpointID, historyLimit)
+	// Get chat history with intelligent limit, or everything the rolling summary doesn't cover yet
+	var chatHistory *ChatHistoryResponse
+	if chatService.historySummariesEnabled() {
+		contextManager.summarizer = chatService.historySummarizer(model)
+		chatHistory, err = chatService.contextHistory(projectID, ca.endpointID, flow.SessionID)
+	} else {
+		chatHistory, err = chatService.GetChatHistoryWithLimit(projectID, ca.endpointID, flow.SessionID, historyLimit)
+	}
 	if err != nil {
 		log.Printf("Failed to get chat history: %v", err)
 		// Continue without history if we can't retrieve it
@@ -272,12 +286,19 @@ func (ca *ConversationAgent) ProcessConversation(userMessage string, flow *Conve
 	// Log context usage for monitoring
 	contextManager.LogContextUsage(model, contextWindow)
 
+	if contextWindow.UpdatedSummary != nil && chatHistory != nil {
+		if err := chatService.saveHistorySummary(chatHistory.SessionID, contextWindow.UpdatedSummary); err != nil {
+			log.Printf("[CHATBOT] Failed to save history summary: %v", err)
+		}
+	}
+
 	// Create OpenRouter request without any tools/functions
 	openRouterReq := map[string]interface{}{
 		"model":       model,
 		"messages":    contextWindow.Messages,
 		"temperature": 0.7, // Slightly higher temperature for more natural conversation
 		"max_tokens":  2000,
+		"projectID":   projectID, // metering only, not sent to the API
 	}
 
 	// Call OpenRouter API
@@ -0,0 +1,380 @@
+package chatbot
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"log"
+	"net"
+	"net/http"
+	"net/url"
+	"os"
+	"os/exec"
+	"regexp"
+	"strconv"
+	"strings"
+	"time"
+
+	"ramble-ai/goapp/ai"
+	"ramble-ai/goapp/settings"
+)
+
+// customToolsSettingKey is the setting holding the user-defined chat tools as JSON
+const customToolsSettingKey = "chat_custom_tools"
+
+// GeneralToolsEndpoint gates a custom tool for chats with function calls enabled on endpoints
+// outside the MCP registry, and for MCP clients
+const GeneralToolsEndpoint = "general"
+
+// Custom tool timeouts
+const (
+	defaultCustomToolTimeout = 30 * time.Second
+	maxCustomToolTimeout     = 5 * time.Minute
+)
+
+// maxCustomToolOutput bounds what a custom tool may return
+const maxCustomToolOutput = 1 << 20
+
+// customToolName is the pattern LLM providers accept for function names
+var customToolName = regexp.MustCompile(`^[a-zA-Z0-9_-]{1,64}$`)
+
+// CustomTool is a user-defined chat tool. It runs either an external command, which receives the
+// call as JSON on stdin and answers on stdout, or a local HTTP endpoint, which receives the call as a
+// JSON POST. Answers that are not JSON are returned as {"output": "..."}.
+type CustomTool struct {
+	Name           string                 `json:"name"`
+	Description    string                 `json:"description"`
+	Parameters     map[string]interface{} `json:"parameters"` // JSON schema of the arguments
+	Endpoints      []string               `json:"endpoints"`  // chat endpoints that may call the tool
+	Command        []string               `json:"command,omitempty"`
+	URL            string                 `json:"url,omitempty"`
+	TimeoutSeconds int                    `json:"timeoutSeconds,omitempty"` // 0 means 30 seconds
+}
+
+// customToolCall is the JSON a custom tool receives
+type customToolCall struct {
+	Tool      string                 `json:"tool"`
+	Arguments map[string]interface{} `json:"arguments"`
+	Project   customToolProject      `json:"project"`
+}
+
+// customToolProject is the project context sent with a custom tool call
+type customToolProject struct {
+	ID             int           `json:"id"`
+	Name           string        `json:"name"`
+	HighlightOrder []interface{} `json:"highlightOrder"`
+}
+
+// timeout returns how long a call to the tool may take
+func (t CustomTool) timeout() time.Duration {
+	if t.TimeoutSeconds <= 0 {
+		return defaultCustomToolTimeout
+	}
+	return time.Duration(t.TimeoutSeconds) * time.Second
+}
+
+// GetCustomTools returns the saved custom tools
+func (s *ChatbotService) GetCustomTools() ([]CustomTool, error) {
+	value, err := settings.NewSettingsService(s.client, s.ctx).GetSetting(customToolsSettingKey)
+	if err != nil {
+		return nil, err
+	}
+
+	tools := []CustomTool{}
+	if value == "" {
+		return tools, nil
+	}
+	if err := json.Unmarshal([]byte(value), &tools); err != nil {
+		return nil, fmt.Errorf("failed to parse custom tools: %w", err)
+	}
+	return tools, nil
+}
+
+// SaveCustomTools validates and saves the custom tools. They are registered with services created
+// afterwards.
+func (s *ChatbotService) SaveCustomTools(tools []CustomTool) error {
+	builtin := s.builtinToolNames()
+	names := make(map[string]bool)
+	for i := range tools {
+		if err := s.validateCustomTool(&tools[i]); err != nil {
+			return err
+		}
+		if builtin[tools[i].Name] {
+			return fmt.Errorf("custom tool %s conflicts with a built-in tool", tools[i].Name)
+		}
+		if names[tools[i].Name] {
+			return fmt.Errorf("custom tool %s is defined twice", tools[i].Name)
+		}
+		names[tools[i].Name] = true
+	}
+
+	value, err := json.Marshal(tools)
+	if err != nil {
+		return fmt.Errorf("failed to marshal custom tools: %w", err)
+	}
+	return settings.NewSettingsService(s.client, s.ctx).SaveSetting(customToolsSettingKey, string(value))
+}
+
+// validateCustomTool checks a tool's definition, filling in an empty argument schema
+func (s *ChatbotService) validateCustomTool(tool *CustomTool) error {
+	if !customToolName.MatchString(tool.Name) {
+		return fmt.Errorf("custom tool name %q must be 1-64 letters, digits, underscores or dashes", tool.Name)
+	}
+	if strings.TrimSpace(tool.Description) == "" {
+		return fmt.Errorf("custom tool %s needs a description", tool.Name)
+	}
+
+	if tool.Parameters == nil {
+		tool.Parameters = map[string]interface{}{"type": "object", "properties": map[string]interface{}{}}
+	}
+	if schemaType, _ := tool.Parameters["type"].(string); schemaType != "object" {
+		return fmt.Errorf("custom tool %s parameters must be a JSON schema of type object", tool.Name)
+	}
+
+	if len(tool.Endpoints) == 0 {
+		return fmt.Errorf("custom tool %s needs at least one endpoint", tool.Name)
+	}
+	for _, endpointID := range tool.Endpoints {
+		if _, exists := s.mcpRegistry.GetEndpointConfig(endpointID); !exists && endpointID != GeneralToolsEndpoint {
+			return fmt.Errorf("custom tool %s uses unknown endpoint %s", tool.Name, endpointID)
+		}
+		if s.mcpRegistry.UsesConversationFlow(endpointID) {
+			return fmt.Errorf("custom tool %s cannot use endpoint %s, which runs structured actions instead of tools", tool.Name, endpointID)
+		}
+	}
+
+	if (len(tool.Command) > 0) == (tool.URL != "") {
+		return fmt.Errorf("custom tool %s needs either a command or a URL", tool.Name)
+	}
+	if len(tool.Command) > 0 && strings.TrimSpace(tool.Command[0]) == "" {
+		return fmt.Errorf("custom tool %s has an empty command", tool.Name)
+	}
+	if tool.URL != "" {
+		if err := validateLocalURL(tool.URL); err != nil {
+			return fmt.Errorf("custom tool %s: %w", tool.Name, err)
+		}
+	}
+
+	if tool.TimeoutSeconds < 0 || tool.timeout() > maxCustomToolTimeout {
+		return fmt.Errorf("custom tool %s timeout must be between 1 and %d seconds", tool.Name, int(maxCustomToolTimeout.Seconds()))
+	}
+	return nil
+}
+
+// validateLocalURL accepts HTTP URLs on the local machine only
+func validateLocalURL(rawURL string) error {
+	parsed, err := url.Parse(rawURL)
+	if err != nil {
+		return fmt.Errorf("invalid URL: %w", err)
+	}
+	if parsed.Scheme != "http" && parsed.Scheme != "https" {
+		return fmt.Errorf("URL must use http or https")
+	}
+	host := parsed.Hostname()
+	if host == "localhost" {
+		return nil
+	}
+	if ip := net.ParseIP(host); ip != nil && ip.IsLoopback() {
+		return nil
+	}
+	return fmt.Errorf("URL must point to localhost")
+}
+
+// builtinToolNames returns the names of the functions the service registers itself
+func (s *ChatbotService) builtinToolNames() map[string]bool {
+	names := make(map[string]bool)
+	for name := range s.functionRegistry {
+		names[name] = true
+	}
+	for _, endpointID := range s.mcpRegistry.GetAllEndpoints() {
+		config, _ := s.mcpRegistry.GetEndpointConfig(endpointID)
+		for _, function := range config.Functions {
+			names[function.Name] = true
+		}
+	}
+	for name := range s.customTools {
+		delete(names, name)
+	}
+	return names
+}
+
+// registerCustomTools registers the saved custom tools with the endpoints they are gated to
+func (s *ChatbotService) registerCustomTools() {
+	tools, err := s.GetCustomTools()
+	if err != nil {
+		log.Printf("[CHATBOT] Failed to load custom tools: %v", err)
+		return
+	}
+
+	for _, tool := range tools {
+		if err := s.validateCustomTool(&tool); err != nil {
+			log.Printf("[CHATBOT] Skipping custom tool: %v", err)
+			continue
+		}
+		function := MCPFunction{
+			Name:        tool.Name,
+			Description: tool.Description,
+			Parameters:  tool.Parameters,
+			Executor:    customToolExecutor(tool),
+		}
+
+		for _, endpointID := range tool.Endpoints {
+			if endpointID == GeneralToolsEndpoint {
+				if _, exists := s.functionRegistry[tool.Name]; exists {
+					log.Printf("[CHATBOT] Skipping custom tool %s: a function with that name exists", tool.Name)
+					continue
+				}
+				s.functionRegistry[tool.Name] = function.Executor
+				s.functionDefs = append(s.functionDefs, FunctionDefinition{
+					Name:        function.Name,
+					Description: function.Description,
+					Parameters:  function.Parameters,
+				})
+			} else if err := s.mcpRegistry.RegisterFunction(endpointID, function); err != nil {
+				log.Printf("[CHATBOT] Skipping custom tool %s: %v", tool.Name, err)
+				continue
+			}
+			s.customTools[tool.Name] = true
+		}
+	}
+}
+
+// customToolExecutor returns the executor that calls a custom tool with its arguments and the
+// project context
+func customToolExecutor(tool CustomTool) FunctionExecutor {
+	return func(args map[string]interface{}, projectID int, service *ChatbotService) (interface{}, error) {
+		if args == nil {
+			args = map[string]interface{}{}
+		}
+		if problems := ai.ValidateJSONSchema(args, tool.Parameters); len(problems) > 0 {
+			return nil, fmt.Errorf("invalid arguments for %s: %s", tool.Name, strings.Join(problems, "; "))
+		}
+
+		call := customToolCall{Tool: tool.Name, Arguments: args, Project: customToolProject{ID: projectID}}
+		if project, err := service.client.Project.Get(service.ctx, projectID); err == nil {
+			call.Project.Name = project.Name
+		}
+		if order, err := service.currentOrder(projectID); err == nil {
+			call.Project.HighlightOrder = order
+		}
+		payload, err := json.Marshal(call)
+		if err != nil {
+			return nil, fmt.Errorf("failed to marshal custom tool call: %w", err)
+		}
+
+		ctx, cancel := context.WithTimeout(service.ctx, tool.timeout())
+		defer cancel()
+
+		var output []byte
+		if len(tool.Command) > 0 {
+			output, err = runToolCommand(ctx, tool, projectID, payload)
+		} else {
+			output, err = postToolRequest(ctx, tool, payload)
+		}
+		if errors.Is(ctx.Err(), context.DeadlineExceeded) {
+			return nil, fmt.Errorf("custom tool %s timed out after %s", tool.Name, tool.timeout())
+		}
+		if err != nil {
+			return nil, err
+		}
+
+		var result interface{}
+		if err := json.Unmarshal(output, &result); err != nil {
+			return map[string]interface{}{"output": strings.TrimSpace(string(output))}, nil
+		}
+		return result, nil
+	}
+}
+
+// runToolCommand runs a command tool in a fresh, empty working directory that is removed afterwards.
+// The command gets the call on stdin and only PATH from the app's environment.
+func runToolCommand(ctx context.Context, tool CustomTool, projectID int, payload []byte) ([]byte, error) {
+	dir, err := os.MkdirTemp("", "ramble-tool-"+tool.Name+"-")
+	if err != nil {
+		return nil, fmt.Errorf("failed to create tool working directory: %w", err)
+	}
+	defer os.RemoveAll(dir)
+
+	cmd := exec.CommandContext(ctx, tool.Command[0], tool.Command[1:]...)
+	cmd.Dir = dir
+	cmd.Env = []string{
+		"PATH=" + os.Getenv("PATH"),
+		"HOME=" + dir,
+		"TMPDIR=" + dir,
+		"TEMP=" + dir,
+		"TMP=" + dir,
+		"RAMBLE_TOOL_NAME=" + tool.Name,
+		"RAMBLE_PROJECT_ID=" + strconv.Itoa(projectID),
+	}
+	if systemRoot := os.Getenv("SYSTEMROOT"); systemRoot != "" {
+		cmd.Env = append(cmd.Env, "SYSTEMROOT="+systemRoot) // Windows programs need it to start
+	}
+	cmd.Stdin = bytes.NewReader(payload)
+	stdout := &cappedBuffer{limit: maxCustomToolOutput}
+	stderr := &cappedBuffer{limit: 4096}
+	cmd.Stdout = stdout
+	cmd.Stderr = stderr
+	cmd.WaitDelay = time.Second // don't wait on pipes held open by children of a killed command
+
+	if err := cmd.Run(); err != nil {
+		if message := strings.TrimSpace(stderr.String()); message != "" {
+			return nil, fmt.Errorf("custom tool %s failed: %w: %s", tool.Name, err, message)
+		}
+		return nil, fmt.Errorf("custom tool %s failed: %w", tool.Name, err)
+	}
+	if stdout.truncated {
+		return nil, fmt.Errorf("custom tool %s output exceeds %d bytes", tool.Name, maxCustomToolOutput)
+	}
+	return stdout.Bytes(), nil
+}
+
+// postToolRequest posts the call to an HTTP tool. Redirects are not followed so the call stays on
+// the local machine.
+func postToolRequest(ctx context.Context, tool CustomTool, payload []byte) ([]byte, error) {
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, tool.URL, bytes.NewReader(payload))
+	if err != nil {
+		return nil, fmt.Errorf("failed to create custom tool request: %w", err)
+	}
+	req.Header.Set("Content-Type", "application/json")
+
+	client := &http.Client{
+		CheckRedirect: func(*http.Request, []*http.Request) error { return http.ErrUseLastResponse },
+	}
+	resp, err := client.Do(req)
+	if err != nil {
+		return nil, fmt.Errorf("custom tool %s request failed: %w", tool.Name, err)
+	}
+	defer resp.Body.Close()
+
+	body, err := io.ReadAll(io.LimitReader(resp.Body, maxCustomToolOutput+1))
+	if err != nil {
+		return nil, fmt.Errorf("failed to read custom tool response: %w", err)
+	}
+	if len(body) > maxCustomToolOutput {
+		return nil, fmt.Errorf("custom tool %s output exceeds %d bytes", tool.Name, maxCustomToolOutput)
+	}
+	if resp.StatusCode < 200 || resp.StatusCode >= 300 {
+		return nil, fmt.Errorf("custom tool %s returned %s: %s", tool.Name, resp.Status, strings.TrimSpace(string(body)))
+	}
+	return body, nil
+}
+
+// cappedBuffer keeps the first limit bytes written to it and discards the rest
+type cappedBuffer struct {
+	bytes.Buffer
+	limit     int
+	truncated bool
+}
+
+// Write implements io.Writer, always reporting the full length so the writer isn't interrupted
+func (b *cappedBuffer) Write(p []byte) (int, error) {
+	if room := b.limit - b.Len(); len(p) > room {
+		b.truncated = true
+		b.Buffer.Write(p[:max(room, 0)])
+		return len(p), nil
+	}
+	return b.Buffer.Write(p)
+}
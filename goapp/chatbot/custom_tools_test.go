@@ -0,0 +1,251 @@
+package chatbot
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"ramble-ai/goapp"
+)
+
+// TestCustomToolHelperProcess is the command behind the command tools of these tests. It only acts
+// when started as a tool, which the RAMBLE_TOOL_NAME variable of the tool environment tells.
+func TestCustomToolHelperProcess(t *testing.T) {
+	name := os.Getenv("RAMBLE_TOOL_NAME")
+	if name == "" {
+		return
+	}
+
+	switch name {
+	case "slow_tool":
+		time.Sleep(10 * time.Second)
+	case "failing_tool":
+		fmt.Fprint(os.Stderr, "no clips to tag")
+		os.Exit(3)
+	case "text_tool":
+		fmt.Print("tagged 2 clips\n")
+	default:
+		input, _ := io.ReadAll(os.Stdin)
+		var call map[string]interface{}
+		_ = json.Unmarshal(input, &call)
+		cwd, _ := os.Getwd()
+		entries, _ := os.ReadDir(cwd)
+		json.NewEncoder(os.Stdout).Encode(map[string]interface{}{
+			"call":       call,
+			"cwd":        cwd,
+			"cwdEntries": len(entries),
+			"home":       os.Getenv("HOME"),
+			"projectId":  os.Getenv("RAMBLE_PROJECT_ID"),
+			"apiKey":     os.Getenv("CUSTOM_TOOL_TEST_SECRET"),
+		})
+	}
+	os.Exit(0)
+}
+
+// commandTool is a command tool run by TestCustomToolHelperProcess
+func commandTool(name string, endpoints ...string) CustomTool {
+	return CustomTool{
+		Name:        name,
+		Description: "Tags clips",
+		Parameters: map[string]interface{}{
+			"type": "object",
+			"properties": map[string]interface{}{
+				"tag":   map[string]interface{}{"type": "string"},
+				"limit": map[string]interface{}{"type": "integer"},
+			},
+			"required": []string{"tag"},
+		},
+		Endpoints: endpoints,
+		Command:   []string{os.Args[0], "-test.run=^TestCustomToolHelperProcess$"},
+	}
+}
+
+func TestSaveCustomToolsValidation(t *testing.T) {
+	helper := goapp.NewTestHelper(t)
+	service := NewChatbotService(helper.Client, helper.Ctx, mockUpdateOrderFunc)
+
+	tools, err := service.GetCustomTools()
+	require.NoError(t, err)
+	assert.Empty(t, tools)
+
+	tests := []struct {
+		name    string
+		modify  func(tool *CustomTool)
+		wantErr string
+	}{
+		{"invalid name", func(tool *CustomTool) { tool.Name = "tag clips" }, "must be 1-64 letters"},
+		{"built-in name", func(tool *CustomTool) { tool.Name = "reorder_highlights" }, "conflicts with a built-in tool"},
+		{"no description", func(tool *CustomTool) { tool.Description = " " }, "needs a description"},
+		{"non-object schema", func(tool *CustomTool) { tool.Parameters = map[string]interface{}{"type": "string"} }, "type object"},
+		{"no endpoints", func(tool *CustomTool) { tool.Endpoints = nil }, "at least one endpoint"},
+		{"unknown endpoint", func(tool *CustomTool) { tool.Endpoints = []string{"nowhere"} }, "unknown endpoint nowhere"},
+		{"conversation flow endpoint", func(tool *CustomTool) { tool.Endpoints = []string{"highlight_ordering"} }, "runs structured actions instead of tools"},
+		{"command and URL", func(tool *CustomTool) { tool.URL = "http://localhost:9000" }, "either a command or a URL"},
+		{"remote URL", func(tool *CustomTool) { tool.Command, tool.URL = nil, "https://example.com/tool" }, "must point to localhost"},
+		{"timeout too long", func(tool *CustomTool) { tool.TimeoutSeconds = 3600 }, "timeout must be between"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			tool := commandTool("tag_clips", GeneralToolsEndpoint)
+			tt.modify(&tool)
+			assert.ErrorContains(t, service.SaveCustomTools([]CustomTool{tool}), tt.wantErr)
+		})
+	}
+
+	assert.ErrorContains(t, service.SaveCustomTools([]CustomTool{commandTool("tag_clips", "general"), commandTool("tag_clips", "general")}), "defined twice")
+
+	local := commandTool("lookup", "export_optimization")
+	local.Command, local.URL, local.Parameters = nil, "http://127.0.0.1:9000/lookup", nil
+	require.NoError(t, service.SaveCustomTools([]CustomTool{commandTool("tag_clips", GeneralToolsEndpoint), local}))
+	tools, err = service.GetCustomTools()
+	require.NoError(t, err)
+	require.Len(t, tools, 2)
+	assert.Equal(t, "object", tools[1].Parameters["type"], "an empty schema is filled in")
+
+	// Saving the tools again doesn't conflict with their own registration
+	service = NewChatbotService(helper.Client, helper.Ctx, mockUpdateOrderFunc)
+	assert.NoError(t, service.SaveCustomTools(tools))
+}
+
+func TestCustomToolRegistrationIsGatedPerEndpoint(t *testing.T) {
+	helper := goapp.NewTestHelper(t)
+	service := NewChatbotService(helper.Client, helper.Ctx, mockUpdateOrderFunc)
+	require.NoError(t, service.SaveCustomTools([]CustomTool{
+		commandTool("tag_clips", GeneralToolsEndpoint, "export_optimization"),
+		commandTool("render_thumbnail", "export_optimization"),
+	}))
+
+	service = NewChatbotService(helper.Client, helper.Ctx, mockUpdateOrderFunc)
+	toolNames := func(endpointID string) []string {
+		tools, err := service.mcpRegistry.GetFunctionsForEndpoint(endpointID)
+		require.NoError(t, err)
+		var names []string
+		for _, tool := range tools {
+			names = append(names, tool["function"].(map[string]interface{})["name"].(string))
+		}
+		return names
+	}
+	assert.Contains(t, toolNames("export_optimization"), "tag_clips")
+	assert.Contains(t, toolNames("export_optimization"), "render_thumbnail")
+	assert.NotContains(t, toolNames("highlight_suggestions"), "tag_clips")
+
+	var generalNames []string
+	for _, definition := range service.FunctionDefinitions() {
+		generalNames = append(generalNames, definition.Name)
+	}
+	assert.Contains(t, generalNames, "tag_clips")
+	assert.NotContains(t, generalNames, "render_thumbnail")
+}
+
+func TestCommandCustomTool(t *testing.T) {
+	helper := goapp.NewTestHelper(t)
+	project := helper.CreateTestProject("tool-project")
+	clip := helper.CreateTestVideoClip(project, "interview")
+	highlightID := helper.CreateTestHighlight(clip, 0, 5)
+	t.Setenv("CUSTOM_TOOL_TEST_SECRET", "sk-secret")
+
+	service := NewChatbotService(helper.Client, helper.Ctx, mockUpdateOrderFunc)
+	slow := commandTool("slow_tool", GeneralToolsEndpoint)
+	slow.TimeoutSeconds = 1
+	require.NoError(t, service.SaveCustomTools([]CustomTool{
+		commandTool("echo_tool", GeneralToolsEndpoint),
+		commandTool("text_tool", GeneralToolsEndpoint),
+		commandTool("failing_tool", GeneralToolsEndpoint),
+		slow,
+	}))
+	service = NewChatbotService(helper.Client, helper.Ctx, mockUpdateOrderFunc)
+
+	result := service.CallFunction("echo_tool", map[string]interface{}{"tag": "intro", "limit": float64(2)}, project.ID)
+	require.True(t, result.Success, result.Error)
+	output := result.Result.(map[string]interface{})
+	assert.Equal(t, map[string]interface{}{
+		"tool":      "echo_tool",
+		"arguments": map[string]interface{}{"tag": "intro", "limit": float64(2)},
+		"project":   map[string]interface{}{"id": float64(project.ID), "name": "tool-project", "highlightOrder": []interface{}{highlightID}},
+	}, output["call"])
+	assert.Equal(t, fmt.Sprint(project.ID), output["projectId"])
+	assert.Equal(t, float64(0), output["cwdEntries"], "tools start in an empty working directory")
+	assert.Equal(t, output["cwd"], output["home"])
+	assert.Empty(t, output["apiKey"], "the app's environment is not passed on")
+	assert.NoDirExists(t, output["cwd"].(string), "the working directory is removed afterwards")
+
+	result = service.CallFunction("text_tool", map[string]interface{}{"tag": "intro"}, project.ID)
+	require.True(t, result.Success, result.Error)
+	assert.Equal(t, map[string]interface{}{"output": "tagged 2 clips"}, result.Result)
+
+	result = service.CallFunction("echo_tool", map[string]interface{}{"limit": float64(2)}, project.ID)
+	assert.False(t, result.Success)
+	assert.Contains(t, result.Error, `missing required field "tag"`)
+	result = service.CallFunction("echo_tool", map[string]interface{}{"tag": "intro", "limit": 2.5}, project.ID)
+	assert.Contains(t, result.Error, "$.limit must be an integer")
+
+	result = service.CallFunction("failing_tool", map[string]interface{}{"tag": "intro"}, project.ID)
+	assert.False(t, result.Success)
+	assert.Contains(t, result.Error, "exit status 3: no clips to tag")
+
+	start := time.Now()
+	result = service.CallFunction("slow_tool", map[string]interface{}{"tag": "intro"}, project.ID)
+	assert.False(t, result.Success)
+	assert.Contains(t, result.Error, "timed out after 1s")
+	assert.Less(t, time.Since(start), 5*time.Second)
+}
+
+func TestHTTPCustomToolInChat(t *testing.T) {
+	helper := goapp.NewTestHelper(t)
+	project := helper.CreateTestProject("http-tool-project")
+
+	var received customToolCall
+	tool := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		require.NoError(t, json.NewDecoder(r.Body).Decode(&received))
+		if received.Arguments["tag"] == "missing" {
+			http.Error(w, "unknown tag", http.StatusNotFound)
+			return
+		}
+		fmt.Fprint(w, `{"clips":["interview.mp4"]}`)
+	}))
+	defer tool.Close()
+
+	service := NewChatbotService(helper.Client, helper.Ctx, mockUpdateOrderFunc)
+	require.NoError(t, service.SaveCustomTools([]CustomTool{{
+		Name:        "find_tagged_clips",
+		Description: "Finds clips by tag in the asset manager",
+		Endpoints:   []string{GeneralToolsEndpoint},
+		URL:         tool.URL + "/find",
+	}}))
+	service = NewChatbotService(helper.Client, helper.Ctx, mockUpdateOrderFunc)
+
+	var toolCall string
+	useStreamingServer(t, helper, func(w http.ResponseWriter, r *http.Request) {
+		writeChunk(w, `{"choices":[{"delta":{"content":"Looking them up."}}]}`)
+		writeChunk(w, toolCall)
+		writeChunk(w, "[DONE]")
+	})
+	send := func() *ChatResponse {
+		response, err := service.SendMessage(ChatRequest{ProjectID: project.ID, EndpointID: "general", Message: "Which clips are tagged intro?", EnableFunctionCalls: true}, noAPIKey)
+		require.NoError(t, err)
+		require.True(t, response.Success, response.Error)
+		require.Len(t, response.FunctionResults, 1)
+		return response
+	}
+
+	toolCall = toolCallChunk(t, "find_tagged_clips", map[string]interface{}{"tag": "intro"})
+	response := send()
+	assert.True(t, response.FunctionResults[0].Success, response.FunctionResults[0].Error)
+	assert.Equal(t, map[string]interface{}{"clips": []interface{}{"interview.mp4"}}, response.FunctionResults[0].Result)
+	assert.Equal(t, "find_tagged_clips", received.Tool)
+	assert.Equal(t, project.ID, received.Project.ID)
+	assert.Equal(t, "http-tool-project", received.Project.Name)
+
+	toolCall = toolCallChunk(t, "find_tagged_clips", map[string]interface{}{"tag": "missing"})
+	response = send()
+	assert.False(t, response.FunctionResults[0].Success)
+	assert.Contains(t, response.FunctionResults[0].Error, "404 Not Found: unknown tag")
+}
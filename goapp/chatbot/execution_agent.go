@@ -4,6 +4,8 @@ import (
 	"fmt"
 	"log"
 	"strings"
+
+	"ramble-ai/goapp/ai"
 )
 
 // ExecutionAgent handles precise MCP function calling with progress updates
@@ -33,11 +35,11 @@ func (ea *ExecutionAgent) ExecuteIntentStructured(intent *UserIntent, projectID
 	broadcaster.UpdateProgress("initializing", "Preparing structured execution...")
 
 	// Get API key
-	apiKey, err := getAPIKey()
-	if err != nil || apiKey == "" {
+	apiKey, err := ai.NewProviderService(chatService.client, chatService.ctx).TextAPIKey("chat", getAPIKey)
+	if err != nil {
 		return &ExecutionResult{
 			Success: false,
-			Error:   "OpenRouter API key not configured",
+			Error:   err.Error(),
 		}, nil
 	}
 
@@ -74,12 +76,14 @@ func (ea *ExecutionAgent) ExecuteIntentStructured(intent *UserIntent, projectID
 		},
 		"temperature": 0.3, // Lower temperature for precise, structured output
 		"max_tokens":  4000,
+		"projectID":   projectID, // metering only, not sent to the API
 	}
 
 	broadcaster.UpdateProgress("processing", ea.getProgressMessageForAction(intent.Action))
 
-	// Call OpenRouter API
-	aiResponse, err := chatService.callOpenRouterAPI(apiKey, openRouterReq)
+	// Call OpenRouter API, re-prompting on malformed or incomplete output
+	originalHighlightCount := len(structuredInput.HighlightMap)
+	structuredOutput, err := chatService.completeStructuredExecution(apiKey, openRouterReq, executionPrompt, originalHighlightCount)
 	if err != nil {
 		broadcaster.UpdateProgress("error", "AI processing failed")
 		return &ExecutionResult{
@@ -88,30 +92,7 @@ func (ea *ExecutionAgent) ExecuteIntentStructured(intent *UserIntent, projectID
 		}, nil
 	}
 
-	// Extract content from response
-	content, ok := aiResponse["content"].(string)
-	if !ok {
-		broadcaster.UpdateProgress("error", "Invalid AI response format")
-		return &ExecutionResult{
-			Success: false,
-			Error:   "AI response missing content",
-		}, nil
-	}
-
-	broadcaster.UpdateProgress("parsing", "Parsing structured response...")
-
-	// Parse structured output
-	structuredOutput, err := ParseStructuredExecutionOutput(content)
-	if err != nil {
-		broadcaster.UpdateProgress("error", "Failed to parse AI response")
-		return &ExecutionResult{
-			Success: false,
-			Error:   fmt.Sprintf("Failed to parse structured output: %v", err),
-		}, nil
-	}
-
 	// Validate the output
-	originalHighlightCount := len(structuredInput.HighlightMap)
 	err = ValidateStructuredOutput(structuredOutput, originalHighlightCount)
 	if err != nil {
 		broadcaster.UpdateProgress("error", "Invalid response from AI")
@@ -255,11 +236,11 @@ func (ea *ExecutionAgent) ExecuteIntent(intent *UserIntent, projectID int, chatS
 	broadcaster.UpdateProgress("initializing", "Preparing to execute your request...")
 
 	// Get API key
-	apiKey, err := getAPIKey()
-	if err != nil || apiKey == "" {
+	apiKey, err := ai.NewProviderService(chatService.client, chatService.ctx).TextAPIKey("chat", getAPIKey)
+	if err != nil {
 		return &ExecutionResult{
 			Success: false,
-			Error:   "OpenRouter API key not configured",
+			Error:   err.Error(),
 		}, nil
 	}
 
@@ -308,6 +289,7 @@ func (ea *ExecutionAgent) ExecuteIntent(intent *UserIntent, projectID int, chatS
 		"tool_choice": "auto", // Let the AI decide when to call functions
 		"temperature": 0.3,    // Lower temperature for precise execution
 		"max_tokens":  4000,
+		"projectID":   projectID, // metering only, not sent to the API
 	}
 
 	broadcaster.UpdateProgress("processing", ea.getProgressMessageForAction(intent.Action))
@@ -0,0 +1,533 @@
+package chatbot
+
+import (
+	"crypto/rand"
+	"encoding/hex"
+	"fmt"
+	"log"
+	"os"
+	"path/filepath"
+	"slices"
+	"strings"
+	"sync"
+	"time"
+
+	"ramble-ai/ent"
+	"ramble-ai/ent/exportjob"
+	"ramble-ai/ent/project"
+	"ramble-ai/goapp/exports"
+	"ramble-ai/goapp/highlights"
+	"ramble-ai/goapp/projects"
+)
+
+// exportConfirmationTTL is how long a prepared export waits for confirmation
+const exportConfirmationTTL = 15 * time.Minute
+
+// PlatformTarget describes what a publishing platform expects of an export
+type PlatformTarget struct {
+	Name           string  `json:"name"`
+	AspectRatio    string  `json:"aspectRatio"`
+	MaxSeconds     float64 `json:"maxSeconds,omitempty"` // 0 means no practical limit
+	Mode           string  `json:"mode"`
+	PaddingSeconds float64 `json:"paddingSeconds"`
+	Notes          string  `json:"notes"`
+}
+
+// platformTargets are the platforms the export assistant recommends settings for
+var platformTargets = map[string]PlatformTarget{
+	"youtube": {
+		Name:           "YouTube",
+		AspectRatio:    "16:9",
+		Mode:           projects.ExportModeStitched,
+		PaddingSeconds: 0.3,
+		Notes:          "Long-form: keep a little breathing room between highlights",
+	},
+	"youtube_shorts": {
+		Name:           "YouTube Shorts",
+		AspectRatio:    "9:16",
+		MaxSeconds:     60,
+		Mode:           projects.ExportModeStitched,
+		PaddingSeconds: 0.1,
+		Notes:          "Tight cuts; open with the strongest highlight",
+	},
+	"tiktok": {
+		Name:           "TikTok",
+		AspectRatio:    "9:16",
+		MaxSeconds:     600,
+		Mode:           projects.ExportModeStitched,
+		PaddingSeconds: 0.1,
+		Notes:          "Tight cuts; the first two seconds decide retention",
+	},
+	"instagram_reels": {
+		Name:           "Instagram Reels",
+		AspectRatio:    "9:16",
+		MaxSeconds:     90,
+		Mode:           projects.ExportModeStitched,
+		PaddingSeconds: 0.1,
+		Notes:          "Tight cuts; captions help since many watch muted",
+	},
+	"linkedin": {
+		Name:           "LinkedIn",
+		AspectRatio:    "16:9",
+		MaxSeconds:     600,
+		Mode:           projects.ExportModeStitched,
+		PaddingSeconds: 0.3,
+		Notes:          "Professional pacing; keep it under three minutes for best engagement",
+	},
+	"clips": {
+		Name:           "Individual clips",
+		AspectRatio:    "source",
+		Mode:           projects.ExportModeIndividual,
+		PaddingSeconds: 0.5,
+		Notes:          "One file per highlight, with extra padding for editing elsewhere",
+	},
+}
+
+// pendingExport is an export prepared by the assistant that waits for the user's confirmation
+type pendingExport struct {
+	ProjectID      int
+	Mode           string
+	CutID          int
+	OutputFolder   string
+	PaddingSeconds float64
+	ExpiresAt      time.Time
+}
+
+// pendingExports holds the prepared exports by confirmation ID
+var pendingExports = struct {
+	sync.Mutex
+	exports map[string]*pendingExport
+}{exports: map[string]*pendingExport{}}
+
+// exportFunctions returns the functions that inspect, configure and run exports
+func exportFunctions() []MCPFunction {
+	platformNames := make([]string, 0, len(platformTargets))
+	for name := range platformTargets {
+		platformNames = append(platformNames, name)
+	}
+	slices.Sort(platformNames)
+
+	return []MCPFunction{
+		{
+			Name:        "inspect_export",
+			Description: "Inspect what an export would contain: clip metadata, the visible highlights and their total duration, saved export presets and recent export jobs",
+			Parameters: map[string]interface{}{
+				"type": "object",
+				"properties": map[string]interface{}{
+					"cut_id": cutIDProperty,
+				},
+			},
+			Executor: func(args map[string]interface{}, projectID int, service *ChatbotService) (interface{}, error) {
+				return service.executeInspectExport(args, projectID, service)
+			},
+		},
+		{
+			Name:        "recommend_export_settings",
+			Description: "Recommend export mode and padding for a publishing platform, checking the highlights against its length and aspect ratio",
+			Parameters: map[string]interface{}{
+				"type": "object",
+				"properties": map[string]interface{}{
+					"platform": map[string]interface{}{
+						"type": "string",
+						"enum": platformNames,
+					},
+					"cut_id": cutIDProperty,
+				},
+				"required": []string{"platform"},
+			},
+			Executor: func(args map[string]interface{}, projectID int, service *ChatbotService) (interface{}, error) {
+				return service.executeRecommendExportSettings(args, projectID, service)
+			},
+		},
+		{
+			Name:        "save_export_preset",
+			Description: "Save export settings as a named project export preset, replacing a preset with the same name",
+			Parameters: map[string]interface{}{
+				"type": "object",
+				"properties": map[string]interface{}{
+					"name":            map[string]interface{}{"type": "string", "description": "Preset name, e.g. the platform"},
+					"mode":            exportModeProperty,
+					"padding_seconds": paddingProperty,
+				},
+				"required": []string{"name", "mode", "padding_seconds"},
+			},
+			Executor: func(args map[string]interface{}, projectID int, service *ChatbotService) (interface{}, error) {
+				return service.executeSaveExportPreset(args, projectID, service)
+			},
+		},
+		{
+			Name:        "prepare_export",
+			Description: "Prepare an export and return its plan with a confirmation_id. Nothing is exported until the user confirms the export in the app",
+			Parameters: map[string]interface{}{
+				"type": "object",
+				"properties": map[string]interface{}{
+					"mode":            exportModeProperty,
+					"padding_seconds": paddingProperty,
+					"cut_id":          cutIDProperty,
+					"output_folder": map[string]interface{}{
+						"type":        "string",
+						"description": "Folder to export to (default: the folder of the project's last export)",
+					},
+				},
+				"required": []string{"mode"},
+			},
+			Executor: func(args map[string]interface{}, projectID int, service *ChatbotService) (interface{}, error) {
+				return service.executePrepareExport(args, projectID, service)
+			},
+		},
+		{
+			Name:        "get_export_progress",
+			Description: "Report the progress of an export job, or of the project's recent export jobs",
+			Parameters: map[string]interface{}{
+				"type": "object",
+				"properties": map[string]interface{}{
+					"job_id": map[string]interface{}{"type": "string", "description": "Export job ID (default: the recent jobs)"},
+				},
+			},
+			Executor: func(args map[string]interface{}, projectID int, service *ChatbotService) (interface{}, error) {
+				return service.executeGetExportProgress(args, projectID, service)
+			},
+		},
+	}
+}
+
+// exportModeProperty is the schema of an export mode argument
+var exportModeProperty = map[string]interface{}{
+	"type":        "string",
+	"enum":        []string{projects.ExportModeStitched, projects.ExportModeIndividual},
+	"description": "stitched for one video of all highlights, individual for one file per highlight",
+}
+
+// paddingProperty is the schema of a padding_seconds argument
+var paddingProperty = map[string]interface{}{
+	"type":        "number",
+	"description": "Seconds of video kept before and after each highlight",
+}
+
+// cutIDProperty is the schema of a cut_id argument
+var cutIDProperty = map[string]interface{}{
+	"type":        "integer",
+	"description": "Export a cut's visible highlights instead of the whole project",
+}
+
+// executeInspectExport summarizes what an export of the project or a cut would contain
+func (s *ChatbotService) executeInspectExport(args map[string]interface{}, projectID int, service *ChatbotService) (interface{}, error) {
+	cutID := intOrZero(args, "cut_id")
+	segments, err := s.exportSegments(projectID, cutID)
+	if err != nil {
+		return nil, err
+	}
+	clips, err := s.projectClips(projectID)
+	if err != nil {
+		return nil, err
+	}
+
+	clipInfo := make([]map[string]interface{}, 0, len(clips))
+	for _, clip := range clips {
+		_, statErr := os.Stat(clip.FilePath)
+		clipInfo = append(clipInfo, map[string]interface{}{
+			"clip_id":      clip.ID,
+			"name":         clip.Name,
+			"duration":     clip.Duration,
+			"format":       clip.Format,
+			"resolution":   fmt.Sprintf("%dx%d", clip.Width, clip.Height),
+			"orientation":  orientation(clip.Width, clip.Height),
+			"file_missing": statErr != nil,
+			"highlights":   len(clip.Highlights),
+		})
+	}
+
+	presets, err := projects.NewProjectService(s.client, s.ctx).GetProjectExportPresets(projectID)
+	if err != nil {
+		return nil, err
+	}
+	jobs, err := s.recentExportJobs(projectID, 5)
+	if err != nil {
+		return nil, err
+	}
+
+	return map[string]interface{}{
+		"clips":            clipInfo,
+		"highlight_count":  len(segments),
+		"highlight_length": segmentsDuration(segments, 0),
+		"export_presets":   presets,
+		"recent_jobs":      jobs,
+		"output_format":    "MP4 (H.264 video, source audio)",
+	}, nil
+}
+
+// executeRecommendExportSettings recommends export settings for a platform
+func (s *ChatbotService) executeRecommendExportSettings(args map[string]interface{}, projectID int, service *ChatbotService) (interface{}, error) {
+	platform, _ := args["platform"].(string)
+	target, ok := platformTargets[platform]
+	if !ok {
+		return nil, fmt.Errorf("unknown platform: %s", platform)
+	}
+
+	segments, err := s.exportSegments(projectID, intOrZero(args, "cut_id"))
+	if err != nil {
+		return nil, err
+	}
+	clips, err := s.projectClips(projectID)
+	if err != nil {
+		return nil, err
+	}
+
+	var warnings []string
+	length := segmentsDuration(segments, target.PaddingSeconds)
+	if len(segments) == 0 {
+		warnings = append(warnings, "There are no visible highlights to export")
+	}
+	if target.MaxSeconds > 0 && target.Mode == projects.ExportModeStitched && length > target.MaxSeconds {
+		warnings = append(warnings, fmt.Sprintf("The export would run %.0fs, over the %.0fs %s limit; hide or shorten highlights first", length, target.MaxSeconds, target.Name))
+	}
+	if target.AspectRatio != "source" {
+		want := "landscape"
+		if target.AspectRatio == "9:16" {
+			want = "portrait"
+		}
+		for _, clip := range clips {
+			if got := orientation(clip.Width, clip.Height); len(clip.Highlights) > 0 && got != "unknown" && got != want {
+				warnings = append(warnings, fmt.Sprintf("%s is %s but %s expects %s; exports keep the source framing", clip.Name, got, target.Name, target.AspectRatio))
+			}
+		}
+	}
+
+	return map[string]interface{}{
+		"platform":        target,
+		"mode":            target.Mode,
+		"padding_seconds": target.PaddingSeconds,
+		"export_length":   length,
+		"warnings":        warnings,
+	}, nil
+}
+
+// executeSaveExportPreset saves export settings as a project export preset
+func (s *ChatbotService) executeSaveExportPreset(args map[string]interface{}, projectID int, service *ChatbotService) (interface{}, error) {
+	name, _ := args["name"].(string)
+	name = strings.TrimSpace(name)
+	mode, _ := args["mode"].(string)
+	padding, ok := args["padding_seconds"].(float64)
+	if !ok {
+		return nil, fmt.Errorf("padding_seconds is required")
+	}
+
+	projectService := projects.NewProjectService(s.client, s.ctx)
+	presets, err := projectService.GetProjectExportPresets(projectID)
+	if err != nil {
+		return nil, err
+	}
+
+	preset := projects.ExportPreset{Name: name, Mode: mode, PaddingSeconds: padding}
+	presets = slices.DeleteFunc(slices.Clone(presets), func(p projects.ExportPreset) bool { return p.Name == name })
+	if err := projectService.SaveProjectExportPresets(projectID, append(presets, preset)); err != nil {
+		return nil, err
+	}
+
+	return map[string]interface{}{
+		"message": fmt.Sprintf("Saved export preset %s (%s, %.2fs padding)", name, mode, padding),
+		"preset":  preset,
+	}, nil
+}
+
+// executePrepareExport validates an export and holds it for confirmation
+func (s *ChatbotService) executePrepareExport(args map[string]interface{}, projectID int, service *ChatbotService) (interface{}, error) {
+	mode, _ := args["mode"].(string)
+	if mode != projects.ExportModeStitched && mode != projects.ExportModeIndividual {
+		return nil, fmt.Errorf("mode must be %s or %s", projects.ExportModeStitched, projects.ExportModeIndividual)
+	}
+	padding, _ := args["padding_seconds"].(float64)
+	if padding < 0 {
+		return nil, fmt.Errorf("padding_seconds cannot be negative")
+	}
+	cutID := intOrZero(args, "cut_id")
+
+	outputFolder, _ := args["output_folder"].(string)
+	if outputFolder == "" {
+		var err error
+		if outputFolder, err = s.lastExportFolder(projectID); err != nil {
+			return nil, err
+		}
+	}
+	if info, err := os.Stat(outputFolder); err != nil || !info.IsDir() {
+		return nil, fmt.Errorf("output folder %s does not exist", outputFolder)
+	}
+
+	segments, err := s.exportSegments(projectID, cutID)
+	if err != nil {
+		return nil, err
+	}
+	if len(segments) == 0 {
+		return nil, fmt.Errorf("there are no visible highlights to export")
+	}
+
+	confirmationID, err := newConfirmationID()
+	if err != nil {
+		return nil, err
+	}
+	pendingExports.Lock()
+	for id, pending := range pendingExports.exports {
+		if time.Now().After(pending.ExpiresAt) {
+			delete(pendingExports.exports, id)
+		}
+	}
+	pendingExports.exports[confirmationID] = &pendingExport{
+		ProjectID:      projectID,
+		Mode:           mode,
+		CutID:          cutID,
+		OutputFolder:   outputFolder,
+		PaddingSeconds: padding,
+		ExpiresAt:      time.Now().Add(exportConfirmationTTL),
+	}
+	pendingExports.Unlock()
+
+	files := 1
+	if mode == projects.ExportModeIndividual {
+		files = len(segments)
+	}
+	return map[string]interface{}{
+		"message":               fmt.Sprintf("Ready to export %d highlights (%s, %.2fs padding) to %s. Waiting for confirmation", len(segments), mode, padding, outputFolder),
+		"confirmation_id":       confirmationID,
+		"confirmation_required": true,
+		"highlight_count":       len(segments),
+		"export_length":         segmentsDuration(segments, padding),
+		"output_files":          files,
+		"output_folder":         outputFolder,
+	}, nil
+}
+
+// ConfirmExport starts an export prepared by the assistant, once the user confirmed it in the app.
+// It returns the export job ID.
+func (s *ChatbotService) ConfirmExport(confirmationID string) (string, error) {
+	pendingExports.Lock()
+	pending, ok := pendingExports.exports[confirmationID]
+	delete(pendingExports.exports, confirmationID)
+	pendingExports.Unlock()
+
+	if !ok {
+		return "", fmt.Errorf("no prepared export %s", confirmationID)
+	}
+	if time.Now().After(pending.ExpiresAt) {
+		return "", fmt.Errorf("prepared export %s expired; ask the assistant to prepare it again", confirmationID)
+	}
+
+	exportService := exports.NewExportService(s.client, s.ctx)
+	var jobID string
+	var err error
+	switch {
+	case pending.CutID != 0 && pending.Mode == projects.ExportModeIndividual:
+		jobID, err = exportService.ExportIndividualCut(pending.ProjectID, pending.CutID, pending.OutputFolder, pending.PaddingSeconds)
+	case pending.CutID != 0:
+		jobID, err = exportService.ExportStitchedCut(pending.ProjectID, pending.CutID, pending.OutputFolder, pending.PaddingSeconds)
+	case pending.Mode == projects.ExportModeIndividual:
+		jobID, err = exportService.ExportIndividualHighlights(pending.ProjectID, pending.OutputFolder, pending.PaddingSeconds)
+	default:
+		jobID, err = exportService.ExportStitchedHighlights(pending.ProjectID, pending.OutputFolder, pending.PaddingSeconds)
+	}
+	if err != nil {
+		return "", fmt.Errorf("failed to start export: %w", err)
+	}
+
+	log.Printf("[CHATBOT] Started %s export %s for project %d", pending.Mode, jobID, pending.ProjectID)
+	return jobID, nil
+}
+
+// executeGetExportProgress reports export job progress
+func (s *ChatbotService) executeGetExportProgress(args map[string]interface{}, projectID int, service *ChatbotService) (interface{}, error) {
+	jobID, _ := args["job_id"].(string)
+	if jobID == "" {
+		jobs, err := s.recentExportJobs(projectID, 5)
+		if err != nil {
+			return nil, err
+		}
+		return map[string]interface{}{"jobs": jobs}, nil
+	}
+
+	exists, err := s.client.ExportJob.Query().
+		Where(exportjob.JobID(jobID), exportjob.HasProjectWith(project.ID(projectID))).
+		Exist(s.ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get export job: %w", err)
+	}
+	if !exists {
+		return nil, fmt.Errorf("export job %s not found in this project", jobID)
+	}
+	return exports.NewExportService(s.client, s.ctx).GetExportProgress(jobID)
+}
+
+// exportSegments returns the highlights an export of the project or a cut would contain
+func (s *ChatbotService) exportSegments(projectID, cutID int) ([]highlights.HighlightSegment, error) {
+	if cutID != 0 {
+		return s.highlightService.GetCutHighlightsForExport(projectID, cutID)
+	}
+	return s.highlightService.GetProjectHighlightsForExport(projectID)
+}
+
+// recentExportJobs returns the progress of the project's latest export jobs
+func (s *ChatbotService) recentExportJobs(projectID int, limit int) ([]*exports.ExportProgress, error) {
+	jobs, err := exports.NewExportService(s.client, s.ctx).GetProjectExportJobs(projectID)
+	if err != nil {
+		return nil, err
+	}
+	if len(jobs) > limit {
+		jobs = jobs[:limit]
+	}
+	return jobs, nil
+}
+
+// lastExportFolder returns the folder of the project's most recent export
+func (s *ChatbotService) lastExportFolder(projectID int) (string, error) {
+	job, err := s.client.ExportJob.Query().
+		Where(exportjob.HasProjectWith(project.ID(projectID))).
+		Order(ent.Desc(exportjob.FieldCreatedAt)).
+		First(s.ctx)
+	if err != nil {
+		if ent.IsNotFound(err) {
+			return "", fmt.Errorf("output_folder is required for the project's first export")
+		}
+		return "", fmt.Errorf("failed to get export jobs: %w", err)
+	}
+	// Successful jobs record the exported file or folder inside the output folder
+	if job.IsComplete && !job.HasError && !job.IsCancelled {
+		return filepath.Dir(job.OutputPath), nil
+	}
+	return job.OutputPath, nil
+}
+
+// segmentsDuration returns the length of the highlights exported with padding
+func segmentsDuration(segments []highlights.HighlightSegment, paddingSeconds float64) float64 {
+	total := 0.0
+	for _, segment := range segments {
+		total += segment.End - segment.Start + 2*paddingSeconds
+	}
+	return total
+}
+
+// orientation classifies a video's frame
+func orientation(width, height int) string {
+	switch {
+	case width == 0 || height == 0:
+		return "unknown"
+	case width > height:
+		return "landscape"
+	case width < height:
+		return "portrait"
+	default:
+		return "square"
+	}
+}
+
+// intOrZero reads an optional integer argument
+func intOrZero(args map[string]interface{}, name string) int {
+	value, _ := args[name].(float64)
+	return int(value)
+}
+
+// newConfirmationID returns a random ID for a prepared export
+func newConfirmationID() (string, error) {
+	id := make([]byte, 6)
+	if _, err := rand.Read(id); err != nil {
+		return "", fmt.Errorf("failed to generate confirmation ID: %w", err)
+	}
+	return "export_" + hex.EncodeToString(id), nil
+}
@@ -0,0 +1,143 @@
+package chatbot
+
+import (
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"ramble-ai/goapp/exports"
+	"ramble-ai/goapp/projects"
+)
+
+func TestRecommendExportSettings(t *testing.T) {
+	f := newEditingFixture(t)
+	f.helper.CreateTestHighlight(f.clip, 0, 40)
+	f.helper.CreateTestHighlight(f.clip, 45, 60)
+	broll := f.helper.CreateTestVideoClip(f.project, "broll")
+	f.helper.CreateTestHighlight(broll, 10, 20)
+
+	result := f.call(t, "recommend_export_settings", map[string]interface{}{"platform": "youtube_shorts"})
+	require.True(t, result.Success, result.Error)
+	recommendation := result.Result.(map[string]interface{})
+	assert.Equal(t, projects.ExportModeStitched, recommendation["mode"])
+	assert.InDelta(t, 65.6, recommendation["export_length"], 0.001)
+
+	warnings := recommendation["warnings"].([]string)
+	require.Len(t, warnings, 3)
+	assert.Contains(t, warnings[0], "over the 60s YouTube Shorts limit")
+	assert.Contains(t, warnings[1], "interview is landscape")
+	assert.Contains(t, warnings[2], "broll is landscape")
+
+	result = f.call(t, "recommend_export_settings", map[string]interface{}{"platform": "youtube"})
+	require.True(t, result.Success, result.Error)
+	assert.Empty(t, result.Result.(map[string]interface{})["warnings"])
+
+	result = f.call(t, "recommend_export_settings", map[string]interface{}{"platform": "myspace"})
+	assert.False(t, result.Success)
+}
+
+func TestSaveExportPreset(t *testing.T) {
+	f := newEditingFixture(t)
+
+	args := map[string]interface{}{"name": "Shorts", "mode": "stitched", "padding_seconds": 0.1}
+	require.True(t, f.call(t, "save_export_preset", args).Success)
+	args["padding_seconds"] = 0.2
+	result := f.call(t, "save_export_preset", args)
+	require.True(t, result.Success, result.Error)
+
+	presets, err := projects.NewProjectService(f.helper.Client, f.helper.Ctx).GetProjectExportPresets(f.project.ID)
+	require.NoError(t, err)
+	require.Len(t, presets, 1, "saving a preset with the same name replaces it")
+	assert.Equal(t, 0.2, presets[0].PaddingSeconds)
+
+	result = f.call(t, "save_export_preset", map[string]interface{}{"name": "Bad", "mode": "vertical", "padding_seconds": 0.0})
+	assert.False(t, result.Success)
+}
+
+func TestPrepareAndConfirmExport(t *testing.T) {
+	f := newEditingFixture(t)
+	outputFolder := t.TempDir()
+
+	result := f.call(t, "prepare_export", map[string]interface{}{"mode": "stitched", "output_folder": outputFolder})
+	assert.False(t, result.Success)
+	assert.Contains(t, result.Error, "no visible highlights")
+
+	f.helper.CreateTestHighlight(f.clip, 1, 3)
+	for name, args := range map[string]map[string]interface{}{
+		"bad mode":       {"mode": "vertical", "output_folder": outputFolder},
+		"missing folder": {"mode": "stitched", "output_folder": filepath.Join(outputFolder, "missing")},
+		"no folder":      {"mode": "stitched"},
+	} {
+		assert.False(t, f.call(t, "prepare_export", args).Success, name)
+	}
+
+	result = f.call(t, "prepare_export", map[string]interface{}{"mode": "individual", "padding_seconds": 0.5, "output_folder": outputFolder})
+	require.True(t, result.Success, result.Error)
+	plan := result.Result.(map[string]interface{})
+	assert.Equal(t, true, plan["confirmation_required"])
+	assert.Equal(t, 1, plan["output_files"])
+	confirmationID := plan["confirmation_id"].(string)
+
+	jobs, err := exports.NewExportService(f.helper.Client, f.helper.Ctx).GetProjectExportJobs(f.project.ID)
+	require.NoError(t, err)
+	assert.Empty(t, jobs, "preparing does not start an export")
+
+	context, err := (&ExportOptimizationContextBuilder{}).BuildContext(f.project.ID, f.service)
+	require.NoError(t, err)
+	assert.Contains(t, context, confirmationID)
+
+	assert.False(t, f.call(t, "confirm_export", map[string]interface{}{"confirmation_id": confirmationID}).Success, "the assistant cannot start exports")
+	tools, err := f.service.mcpRegistry.GetFunctionsForEndpoint("export_optimization")
+	require.NoError(t, err)
+	for _, tool := range tools {
+		assert.NotEqual(t, "confirm_export", tool["function"].(map[string]interface{})["name"])
+	}
+
+	jobID, err := f.service.ConfirmExport(confirmationID)
+	require.NoError(t, err)
+
+	// The test clip has no video file, so the job fails once it starts extracting
+	require.Eventually(t, func() bool {
+		progress := f.call(t, "get_export_progress", map[string]interface{}{"job_id": jobID})
+		require.True(t, progress.Success, progress.Error)
+		return progress.Result.(*exports.ExportProgress).Stage == "failed"
+	}, 5*time.Second, 50*time.Millisecond)
+
+	_, err = f.service.ConfirmExport(confirmationID)
+	assert.Error(t, err, "a confirmation starts one export")
+
+	// Later exports default to the last export's folder
+	result = f.call(t, "prepare_export", map[string]interface{}{"mode": "stitched"})
+	require.True(t, result.Success, result.Error)
+	assert.Equal(t, outputFolder, result.Result.(map[string]interface{})["output_folder"])
+
+	listed := f.call(t, "get_export_progress", map[string]interface{}{})
+	require.True(t, listed.Success, listed.Error)
+	assert.Len(t, listed.Result.(map[string]interface{})["jobs"], 1)
+}
+
+func TestConfirmExportRejectsExpiredConfirmations(t *testing.T) {
+	f := newEditingFixture(t)
+	f.helper.CreateTestHighlight(f.clip, 1, 3)
+
+	result := f.call(t, "prepare_export", map[string]interface{}{"mode": "stitched", "output_folder": t.TempDir()})
+	require.True(t, result.Success, result.Error)
+	confirmationID := result.Result.(map[string]interface{})["confirmation_id"].(string)
+
+	_, err := f.service.ConfirmExport("unknown")
+	assert.Error(t, err)
+
+	pendingExports.Lock()
+	pendingExports.exports[confirmationID].ExpiresAt = time.Now().Add(-time.Second)
+	pendingExports.Unlock()
+
+	_, err = f.service.ConfirmExport(confirmationID)
+	assert.ErrorContains(t, err, "expired")
+
+	jobs, err := exports.NewExportService(f.helper.Client, f.helper.Ctx).GetProjectExportJobs(f.project.ID)
+	require.NoError(t, err)
+	assert.Empty(t, jobs)
+}
@@ -4,6 +4,9 @@ import (
 	"encoding/json"
 	"fmt"
 	"log"
+
+	"ramble-ai/goapp/ai"
+	"ramble-ai/goapp/search"
 )
 
 // registerFunctions registers all available functions for the LLM
@@ -15,6 +18,7 @@ func (s *ChatbotService) registerFunctions() {
 	s.functionRegistry["apply_ai_suggestion"] = s.executeApplyAISuggestion
 	s.functionRegistry["reset_to_original"] = s.executeResetToOriginal
 	s.functionRegistry["improve_silences"] = s.executeImproveSilences
+	s.functionRegistry["search_transcripts"] = s.executeSearchTranscripts
 
 	// Define function schemas for LLM
 	s.functionDefs = []FunctionDefinition{
@@ -89,6 +93,21 @@ func (s *ChatbotService) registerFunctions() {
 				"properties": map[string]interface{}{},
 			},
 		},
+		{
+			Name:        "search_transcripts",
+			Description: searchTranscriptsDescription,
+			Parameters:  searchTranscriptsParameters,
+		},
+	}
+
+	// Register highlight editing and export functions
+	for _, function := range append(highlightEditingFunctions(), exportFunctions()...) {
+		s.functionRegistry[function.Name] = function.Executor
+		s.functionDefs = append(s.functionDefs, FunctionDefinition{
+			Name:        function.Name,
+			Description: function.Description,
+			Parameters:  function.Parameters,
+		})
 	}
 }
 
@@ -141,7 +160,24 @@ func (s *ChatbotService) executeFunctionCall(toolCall map[string]interface{}, pr
 		}
 	}
 
-	// Execute function
+	return s.runFunction(functionName, args, projectID)
+}
+
+// FunctionDefinitions returns the schemas of the registered functions
+func (s *ChatbotService) FunctionDefinitions() []FunctionDefinition {
+	return s.functionDefs
+}
+
+// CallFunction runs a registered function for a project outside of a chat, applying any highlight
+// order the function prepares. It is how external MCP clients reach the chat executors.
+func (s *ChatbotService) CallFunction(functionName string, args map[string]interface{}, projectID int) FunctionExecutionResult {
+	result := s.runFunction(functionName, args, projectID)
+	s.applyPendingOrder(&result, projectID)
+	return result
+}
+
+// runFunction executes a registered function with parsed arguments
+func (s *ChatbotService) runFunction(functionName string, args map[string]interface{}, projectID int) FunctionExecutionResult {
 	executor, exists := s.functionRegistry[functionName]
 	if !exists {
 		return FunctionExecutionResult{
@@ -168,9 +204,45 @@ func (s *ChatbotService) executeFunctionCall(toolCall map[string]interface{}, pr
 	}
 }
 
+// pendingOrder returns the order of a function result that prepared one without applying it
+func pendingOrder(result *FunctionExecutionResult) ([]interface{}, map[string]interface{}, bool) {
+	if !result.Success || result.Result == nil {
+		return nil, nil, false
+	}
+	resultMap, ok := result.Result.(map[string]interface{})
+	if !ok {
+		return nil, nil, false
+	}
+	if applyRequired, ok := resultMap["apply_required"].(bool); !ok || !applyRequired {
+		return nil, nil, false
+	}
+	newOrder, ok := resultMap["new_order"].([]interface{})
+	return newOrder, resultMap, ok
+}
+
+// applyPendingOrder applies the order of a function result that prepared one without applying it
+func (s *ChatbotService) applyPendingOrder(result *FunctionExecutionResult, projectID int) {
+	newOrder, resultMap, ok := pendingOrder(result)
+	if !ok || s.updateOrderFunc == nil {
+		return
+	}
+
+	if err := s.updateOrderFunc(projectID, newOrder); err != nil {
+		result.Success = false
+		result.Error = fmt.Sprintf("Failed to apply order: %v", err)
+		return
+	}
+
+	// Update the result message to indicate successful application
+	result.Message = "Function executed and order applied successfully"
+	if resultMap["message"] != nil {
+		resultMap["message"] = fmt.Sprintf("%s (Applied to database)", resultMap["message"])
+	}
+}
+
 // Function executors
 
-// executeReorderHighlights reorders highlights based on the provided order
+// executeReorderHighlights prepares the provided highlight order for the caller to apply or propose
 func (s *ChatbotService) executeReorderHighlights(args map[string]interface{}, projectID int, service *ChatbotService) (interface{}, error) {
 	newOrderInterface, ok := args["new_order"]
 	if !ok {
@@ -184,12 +256,6 @@ func (s *ChatbotService) executeReorderHighlights(args map[string]interface{}, p
 		return nil, fmt.Errorf("new_order must be an array")
 	}
 
-	// Apply the reordering immediately using the update function
-	err := s.updateOrderFunc(projectID, newOrderSlice)
-	if err != nil {
-		return nil, fmt.Errorf("failed to apply highlight reorder: %w", err)
-	}
-
 	reason := ""
 	if reasonInterface, ok := args["reason"]; ok {
 		if reasonStr, ok := reasonInterface.(string); ok {
@@ -197,19 +263,20 @@ func (s *ChatbotService) executeReorderHighlights(args map[string]interface{}, p
 		}
 	}
 
-	log.Printf("Successfully reordered %d highlights for project %d", len(newOrderSlice), projectID)
+	log.Printf("Prepared reorder of %d highlights for project %d", len(newOrderSlice), projectID)
 
+	// Return the order for the caller to apply, or to propose when the user approves changes first
 	return map[string]interface{}{
-		"success":   true,
-		"message":   fmt.Sprintf("Successfully reordered %d highlights", len(newOrderSlice)),
-		"reason":    reason,
-		"count":     len(newOrderSlice),
-		"new_order": newOrderSlice,
-		"applied":   true,
+		"success":        true,
+		"message":        fmt.Sprintf("Reorder of %d highlights prepared", len(newOrderSlice)),
+		"reason":         reason,
+		"count":          len(newOrderSlice),
+		"new_order":      newOrderSlice,
+		"apply_required": true,
 	}, nil
 }
 
-// executeAIReorderHighlights uses AI to generate and apply a new highlight order
+// executeAIReorderHighlights generates a new highlight order for the caller to apply or propose
 func (s *ChatbotService) executeAIReorderHighlights(args map[string]interface{}, projectID int, service *ChatbotService) (interface{}, error) {
 	// For now, implement a simple reordering algorithm instead of calling external AI
 	// This provides a working fallback until full AI integration is implemented
@@ -243,22 +310,16 @@ func (s *ChatbotService) executeAIReorderHighlights(args map[string]interface{},
 		newOrder = append(newOrder, allHighlights[i])
 	}
 
-	// Apply the reordering immediately
-	err = s.updateOrderFunc(projectID, newOrder)
-	if err != nil {
-		return nil, fmt.Errorf("failed to apply reordering: %w", err)
-	}
-
-	log.Printf("Successfully applied simple reordering for project %d with %d items", projectID, len(newOrder))
+	log.Printf("Prepared simple reordering for project %d with %d items", projectID, len(newOrder))
 
 	return map[string]interface{}{
-		"success":      true,
-		"message":      fmt.Sprintf("Reordered %d highlights for better flow", len(newOrder)),
-		"reason":       "Applied optimal reordering for improved narrative structure",
-		"count":        len(newOrder),
-		"new_order":    newOrder,
-		"applied":      true,
-		"ai_generated": true,
+		"success":        true,
+		"message":        fmt.Sprintf("Reordered %d highlights for better flow", len(newOrder)),
+		"reason":         "Applied optimal reordering for improved narrative structure",
+		"count":          len(newOrder),
+		"new_order":      newOrder,
+		"apply_required": true,
+		"ai_generated":   true,
 	}, nil
 }
 
@@ -401,6 +462,97 @@ func (s *ChatbotService) executeImproveSilences(args map[string]interface{}, pro
 	}, nil
 }
 
+// searchTranscriptsDescription describes the search_transcripts function to the LLM
+const searchTranscriptsDescription = "Search video transcripts for words, quoted phrases or (in semantic mode) topics, returning the clip, timestamp and surrounding context of each match"
+
+// searchTranscriptsParameters is the JSON schema for search_transcripts arguments
+var searchTranscriptsParameters = map[string]interface{}{
+	"type": "object",
+	"properties": map[string]interface{}{
+		"query": map[string]interface{}{
+			"type":        "string",
+			"description": "Words to find. Wrap text in double quotes to match an exact phrase",
+		},
+		"mode": map[string]interface{}{
+			"type":        "string",
+			"enum":        []string{search.ModeText, search.ModeSemantic},
+			"description": "text for exact word/phrase matches (default), semantic to find passages about a topic",
+		},
+		"all_projects": map[string]interface{}{
+			"type":        "boolean",
+			"description": "Search every project instead of only the current one",
+		},
+		"limit": map[string]interface{}{
+			"type":        "integer",
+			"description": "Maximum number of results (default 10)",
+		},
+	},
+	"required": []string{"query"},
+}
+
+// executeSearchTranscripts searches clip transcripts
+func (s *ChatbotService) executeSearchTranscripts(args map[string]interface{}, projectID int, service *ChatbotService) (interface{}, error) {
+	query, _ := args["query"].(string)
+	if query == "" {
+		return nil, fmt.Errorf("query is required")
+	}
+
+	opts := search.SearchOptions{
+		Query:     query,
+		ProjectID: projectID,
+		Mode:      search.ModeText,
+		Limit:     10,
+	}
+	if mode, ok := args["mode"].(string); ok && mode != "" {
+		opts.Mode = mode
+	}
+	if allProjects, ok := args["all_projects"].(bool); ok && allProjects {
+		opts.ProjectID = 0
+	}
+	// JSON numbers decode as float64
+	if limit, ok := args["limit"].(float64); ok && limit > 0 {
+		opts.Limit = int(limit)
+	}
+
+	searchService := search.NewSearchService(s.client, s.ctx)
+
+	var response *search.SearchResponse
+	var err error
+	if opts.Mode == search.ModeSemantic {
+		aiService, factoryErr := ai.NewAIServiceFactory(s.client, s.ctx).CreateService()
+		if factoryErr != nil {
+			return nil, fmt.Errorf("failed to create AI service: %w", factoryErr)
+		}
+		response, err = searchService.SearchTranscriptsWithAIService(opts, aiService)
+	} else {
+		response, err = searchService.SearchTranscripts(opts)
+	}
+	if err != nil {
+		return nil, fmt.Errorf("failed to search transcripts: %w", err)
+	}
+
+	// Keep the payload compact for the LLM
+	matches := make([]map[string]interface{}, 0, len(response.Results))
+	for _, result := range response.Results {
+		matches = append(matches, map[string]interface{}{
+			"project": result.ProjectName,
+			"clip":    result.ClipName,
+			"clip_id": result.ClipID,
+			"start":   result.Start,
+			"end":     result.End,
+			"text":    result.MatchText,
+			"context": fmt.Sprintf("...%s [%s] %s...", result.ContextBefore, result.MatchText, result.ContextAfter),
+		})
+	}
+
+	return map[string]interface{}{
+		"query":   response.Query,
+		"mode":    response.Mode,
+		"total":   response.Total,
+		"matches": matches,
+	}, nil
+}
+
 // Helper function for min
 func min(a, b int) int {
 	if a < b {
@@ -0,0 +1,764 @@
+package chatbot
+
+import (
+	"crypto/rand"
+	"encoding/hex"
+	"fmt"
+	"math"
+	"slices"
+	"strings"
+	"time"
+	"unicode"
+
+	"ramble-ai/ent"
+	"ramble-ai/ent/project"
+	"ramble-ai/ent/schema"
+	"ramble-ai/ent/videoclip"
+	"ramble-ai/goapp/projects"
+)
+
+// maxColorID is the highest highlight color the editor supports
+const maxColorID = 20
+
+// highlightIDProperty is the schema of a highlight_id argument
+var highlightIDProperty = map[string]interface{}{
+	"type":        "string",
+	"description": "ID of the highlight (starts with highlight_)",
+}
+
+// rangeProperties are the schema properties that select a span of a transcript
+var rangeProperties = map[string]interface{}{
+	"quote": map[string]interface{}{
+		"type":        "string",
+		"description": "Exact words from the transcript to highlight. Preferred over start/end",
+	},
+	"start": map[string]interface{}{
+		"type":        "number",
+		"description": "Start time in seconds, snapped to the word it falls in",
+	},
+	"end": map[string]interface{}{
+		"type":        "number",
+		"description": "End time in seconds, snapped to the word it falls in",
+	},
+}
+
+// highlightEditingFunctions returns the functions that create and edit highlights
+func highlightEditingFunctions() []MCPFunction {
+	withRange := func(properties map[string]interface{}) map[string]interface{} {
+		for name, property := range rangeProperties {
+			properties[name] = property
+		}
+		return properties
+	}
+
+	return []MCPFunction{
+		{
+			Name:        "list_highlights",
+			Description: "List the highlights and pending suggested highlights of every clip, with their IDs, times, colors and text",
+			Parameters: map[string]interface{}{
+				"type": "object",
+				"properties": map[string]interface{}{
+					"clip_id": map[string]interface{}{
+						"type":        "integer",
+						"description": "Only list this clip",
+					},
+				},
+			},
+			Executor: func(args map[string]interface{}, projectID int, service *ChatbotService) (interface{}, error) {
+				return service.executeListHighlights(args, projectID, service)
+			},
+		},
+		{
+			Name:        "create_highlight",
+			Description: "Create a highlight in a clip from a transcript quote or a start and end time",
+			Parameters: map[string]interface{}{
+				"type": "object",
+				"properties": withRange(map[string]interface{}{
+					"clip_id": map[string]interface{}{
+						"type":        "integer",
+						"description": "ID of the clip",
+					},
+					"color_id": map[string]interface{}{
+						"type":        "integer",
+						"description": "Color from 1 to 20 (default: the next unused color)",
+					},
+				}),
+				"required": []string{"clip_id"},
+			},
+			Executor: func(args map[string]interface{}, projectID int, service *ChatbotService) (interface{}, error) {
+				return service.executeCreateHighlight(args, projectID, service)
+			},
+		},
+		{
+			Name:        "delete_highlight",
+			Description: "Delete a highlight",
+			Parameters: map[string]interface{}{
+				"type": "object",
+				"properties": map[string]interface{}{
+					"highlight_id": highlightIDProperty,
+				},
+				"required": []string{"highlight_id"},
+			},
+			Executor: func(args map[string]interface{}, projectID int, service *ChatbotService) (interface{}, error) {
+				return service.executeDeleteHighlight(args, projectID, service)
+			},
+		},
+		{
+			Name:        "adjust_highlight",
+			Description: "Extend or shrink a highlight, either to a new quote or start/end time, or by moving its edges by a number of seconds",
+			Parameters: map[string]interface{}{
+				"type": "object",
+				"properties": withRange(map[string]interface{}{
+					"highlight_id": highlightIDProperty,
+					"extend_start": map[string]interface{}{
+						"type":        "number",
+						"description": "Seconds to move the start earlier (negative moves it later)",
+					},
+					"extend_end": map[string]interface{}{
+						"type":        "number",
+						"description": "Seconds to move the end later (negative moves it earlier)",
+					},
+				}),
+				"required": []string{"highlight_id"},
+			},
+			Executor: func(args map[string]interface{}, projectID int, service *ChatbotService) (interface{}, error) {
+				return service.executeAdjustHighlight(args, projectID, service)
+			},
+		},
+		{
+			Name:        "recolor_highlight",
+			Description: "Change the color of a highlight",
+			Parameters: map[string]interface{}{
+				"type": "object",
+				"properties": map[string]interface{}{
+					"highlight_id": highlightIDProperty,
+					"color_id": map[string]interface{}{
+						"type":        "integer",
+						"description": "Color from 1 to 20",
+					},
+				},
+				"required": []string{"highlight_id", "color_id"},
+			},
+			Executor: func(args map[string]interface{}, projectID int, service *ChatbotService) (interface{}, error) {
+				return service.executeRecolorHighlight(args, projectID, service)
+			},
+		},
+		{
+			Name:        "accept_suggested_highlights",
+			Description: "Turn suggested highlights into highlights",
+			Parameters: map[string]interface{}{
+				"type": "object",
+				"properties": map[string]interface{}{
+					"suggestion_ids": suggestionIDsProperty,
+					"clip_id":        suggestionClipIDProperty,
+				},
+				"required": []string{"suggestion_ids"},
+			},
+			Executor: func(args map[string]interface{}, projectID int, service *ChatbotService) (interface{}, error) {
+				return service.executeAcceptSuggestedHighlights(args, projectID, service)
+			},
+		},
+		{
+			Name:        "reject_suggested_highlights",
+			Description: "Dismiss suggested highlights",
+			Parameters: map[string]interface{}{
+				"type": "object",
+				"properties": map[string]interface{}{
+					"suggestion_ids": suggestionIDsProperty,
+					"clip_id":        suggestionClipIDProperty,
+				},
+				"required": []string{"suggestion_ids"},
+			},
+			Executor: func(args map[string]interface{}, projectID int, service *ChatbotService) (interface{}, error) {
+				return service.executeRejectSuggestedHighlights(args, projectID, service)
+			},
+		},
+		{
+			Name:        "hide_highlight",
+			Description: "Hide a highlight from the timeline and export without deleting it",
+			Parameters: map[string]interface{}{
+				"type": "object",
+				"properties": map[string]interface{}{
+					"highlight_id": highlightIDProperty,
+				},
+				"required": []string{"highlight_id"},
+			},
+			Executor: func(args map[string]interface{}, projectID int, service *ChatbotService) (interface{}, error) {
+				return service.executeHideHighlight(args, projectID, service)
+			},
+		},
+		{
+			Name:        "unhide_highlight",
+			Description: "Show a hidden highlight again",
+			Parameters: map[string]interface{}{
+				"type": "object",
+				"properties": map[string]interface{}{
+					"highlight_id": highlightIDProperty,
+				},
+				"required": []string{"highlight_id"},
+			},
+			Executor: func(args map[string]interface{}, projectID int, service *ChatbotService) (interface{}, error) {
+				return service.executeUnhideHighlight(args, projectID, service)
+			},
+		},
+	}
+}
+
+// suggestionIDsProperty is the schema of a suggestion_ids argument
+var suggestionIDsProperty = map[string]interface{}{
+	"type":        "array",
+	"items":       map[string]interface{}{"type": "string"},
+	"description": "IDs of suggested highlights, from list_highlights",
+}
+
+// suggestionClipIDProperty limits suggestion_ids to one clip
+var suggestionClipIDProperty = map[string]interface{}{
+	"type":        "integer",
+	"description": "Only match suggestions of this clip. Suggestion IDs are unique per clip, not per project",
+}
+
+// executeListHighlights lists the highlights and suggestions of the project's clips
+func (s *ChatbotService) executeListHighlights(args map[string]interface{}, projectID int, service *ChatbotService) (interface{}, error) {
+	clips, err := s.projectClips(projectID)
+	if err != nil {
+		return nil, err
+	}
+	hidden, err := projects.NewProjectService(s.client, s.ctx).GetHiddenHighlights(projectID)
+	if err != nil {
+		return nil, err
+	}
+	clipID, filtered := args["clip_id"].(float64)
+
+	listed := []map[string]interface{}{}
+	for _, clip := range clips {
+		if filtered && clip.ID != int(clipID) {
+			continue
+		}
+
+		clipHighlights := make([]map[string]interface{}, 0, len(clip.Highlights))
+		for _, h := range clip.Highlights {
+			clipHighlights = append(clipHighlights, map[string]interface{}{
+				"id":       h.ID,
+				"start":    h.Start,
+				"end":      h.End,
+				"color_id": h.ColorID,
+				"hidden":   slices.Contains(hidden, h.ID),
+				"text":     spanText(clip.TranscriptionWords, h.Start, h.End),
+			})
+		}
+		suggestions := make([]map[string]interface{}, 0, len(clip.SuggestedHighlights))
+		for _, h := range clip.SuggestedHighlights {
+			suggestions = append(suggestions, map[string]interface{}{
+				"id":    h.ID,
+				"start": h.Start,
+				"end":   h.End,
+				"text":  spanText(clip.TranscriptionWords, h.Start, h.End),
+			})
+		}
+
+		listed = append(listed, map[string]interface{}{
+			"clip_id":     clip.ID,
+			"clip":        clip.Name,
+			"highlights":  clipHighlights,
+			"suggestions": suggestions,
+		})
+	}
+	return map[string]interface{}{"clips": listed}, nil
+}
+
+// executeCreateHighlight adds a highlight to a clip
+func (s *ChatbotService) executeCreateHighlight(args map[string]interface{}, projectID int, service *ChatbotService) (interface{}, error) {
+	clipID, ok := args["clip_id"].(float64)
+	if !ok {
+		return nil, fmt.Errorf("clip_id is required")
+	}
+	clip, err := s.projectClip(projectID, int(clipID))
+	if err != nil {
+		return nil, err
+	}
+
+	start, end, err := spanFromArgs(args, clip.TranscriptionWords)
+	if err != nil {
+		return nil, err
+	}
+	if err := checkSpan(clip, "", start, end); err != nil {
+		return nil, err
+	}
+
+	colorID := nextColorID(clip.Highlights)
+	if _, ok := args["color_id"]; ok {
+		if colorID, err = colorIDArg(args); err != nil {
+			return nil, err
+		}
+	}
+
+	highlight := schema.Highlight{ID: newHighlightID(), Start: start, End: end, ColorID: colorID}
+	if err := s.saveClipHighlights(clip.ID, append(slices.Clone(clip.Highlights), highlight)); err != nil {
+		return nil, err
+	}
+
+	return map[string]interface{}{
+		"message":      fmt.Sprintf("Created highlight in %s", clip.Name),
+		"highlight_id": highlight.ID,
+		"start":        start,
+		"end":          end,
+		"color_id":     colorID,
+		"text":         spanText(clip.TranscriptionWords, start, end),
+	}, nil
+}
+
+// executeDeleteHighlight removes a highlight from its clip
+func (s *ChatbotService) executeDeleteHighlight(args map[string]interface{}, projectID int, service *ChatbotService) (interface{}, error) {
+	clip, index, err := s.findHighlight(args, projectID)
+	if err != nil {
+		return nil, err
+	}
+
+	deleted := clip.Highlights[index]
+	if err := s.saveClipHighlights(clip.ID, slices.Delete(slices.Clone(clip.Highlights), index, index+1)); err != nil {
+		return nil, err
+	}
+
+	return map[string]interface{}{
+		"message":      fmt.Sprintf("Deleted highlight from %s", clip.Name),
+		"highlight_id": deleted.ID,
+		"text":         spanText(clip.TranscriptionWords, deleted.Start, deleted.End),
+	}, nil
+}
+
+// executeAdjustHighlight moves the edges of a highlight
+func (s *ChatbotService) executeAdjustHighlight(args map[string]interface{}, projectID int, service *ChatbotService) (interface{}, error) {
+	clip, index, err := s.findHighlight(args, projectID)
+	if err != nil {
+		return nil, err
+	}
+	highlight := clip.Highlights[index]
+
+	start, end := highlight.Start, highlight.End
+	_, hasQuote := args["quote"]
+	_, hasStart := args["start"]
+	_, hasEnd := args["end"]
+	extendStart, hasExtendStart := args["extend_start"].(float64)
+	extendEnd, hasExtendEnd := args["extend_end"].(float64)
+
+	switch {
+	case hasQuote || (hasStart && hasEnd):
+		if start, end, err = spanFromArgs(args, clip.TranscriptionWords); err != nil {
+			return nil, err
+		}
+	case hasStart || hasEnd || hasExtendStart || hasExtendEnd:
+		if value, ok := args["start"].(float64); ok {
+			start = value
+		}
+		if value, ok := args["end"].(float64); ok {
+			end = value
+		}
+		start, end = snapSpan(clip.TranscriptionWords, start-extendStart, end+extendEnd)
+	default:
+		return nil, fmt.Errorf("provide quote, start/end or extend_start/extend_end")
+	}
+
+	if err := checkSpan(clip, highlight.ID, start, end); err != nil {
+		return nil, err
+	}
+
+	updated := slices.Clone(clip.Highlights)
+	updated[index].Start = start
+	updated[index].End = end
+	if err := s.saveClipHighlights(clip.ID, updated); err != nil {
+		return nil, err
+	}
+
+	return map[string]interface{}{
+		"message":      fmt.Sprintf("Adjusted highlight from %.2f-%.2fs to %.2f-%.2fs", highlight.Start, highlight.End, start, end),
+		"highlight_id": highlight.ID,
+		"start":        start,
+		"end":          end,
+		"text":         spanText(clip.TranscriptionWords, start, end),
+	}, nil
+}
+
+// executeRecolorHighlight changes the color of a highlight
+func (s *ChatbotService) executeRecolorHighlight(args map[string]interface{}, projectID int, service *ChatbotService) (interface{}, error) {
+	colorID, err := colorIDArg(args)
+	if err != nil {
+		return nil, err
+	}
+	clip, index, err := s.findHighlight(args, projectID)
+	if err != nil {
+		return nil, err
+	}
+
+	updated := slices.Clone(clip.Highlights)
+	updated[index].ColorID = colorID
+	if err := s.saveClipHighlights(clip.ID, updated); err != nil {
+		return nil, err
+	}
+
+	return map[string]interface{}{
+		"message":      fmt.Sprintf("Changed highlight color to %d", colorID),
+		"highlight_id": updated[index].ID,
+		"color_id":     colorID,
+	}, nil
+}
+
+// executeAcceptSuggestedHighlights turns suggestions into highlights, one history entry per clip
+func (s *ChatbotService) executeAcceptSuggestedHighlights(args map[string]interface{}, projectID int, service *ChatbotService) (interface{}, error) {
+	suggestionIDs, err := suggestionIDsArg(args)
+	if err != nil {
+		return nil, err
+	}
+	clips, err := s.suggestionClips(args, projectID)
+	if err != nil {
+		return nil, err
+	}
+
+	accepted := []string{}
+	skipped := map[string]string{}
+	for _, clip := range clips {
+		updated := slices.Clone(clip.Highlights)
+		var acceptedInClip []string
+		for _, suggestion := range clip.SuggestedHighlights {
+			if !slices.Contains(suggestionIDs, suggestion.ID) {
+				continue
+			}
+			if overlapping := overlappingHighlight(updated, "", suggestion.Start, suggestion.End); overlapping != "" {
+				skipped[suggestion.ID] = fmt.Sprintf("overlaps highlight %s", overlapping)
+				continue
+			}
+			updated = append(updated, schema.Highlight{
+				ID:      newHighlightID(),
+				Start:   suggestion.Start,
+				End:     suggestion.End,
+				ColorID: nextColorID(updated),
+				Insight: suggestion.Insight,
+			})
+			acceptedInClip = append(acceptedInClip, suggestion.ID)
+		}
+		if len(acceptedInClip) == 0 {
+			continue
+		}
+
+		if err := s.saveClipHighlights(clip.ID, updated); err != nil {
+			return nil, err
+		}
+		for _, suggestionID := range acceptedInClip {
+			if err := s.highlightService.DeleteSuggestedHighlight(clip.ID, suggestionID); err != nil {
+				return nil, err
+			}
+		}
+		accepted = append(accepted, acceptedInClip...)
+	}
+
+	for _, suggestionID := range suggestionIDs {
+		if !slices.Contains(accepted, suggestionID) && skipped[suggestionID] == "" {
+			skipped[suggestionID] = "suggestion not found"
+		}
+	}
+	if len(accepted) == 0 {
+		return nil, fmt.Errorf("no suggestions accepted: %v", skipped)
+	}
+
+	return map[string]interface{}{
+		"message":  fmt.Sprintf("Accepted %d suggested highlights", len(accepted)),
+		"accepted": accepted,
+		"skipped":  skipped,
+	}, nil
+}
+
+// executeRejectSuggestedHighlights dismisses suggestions
+func (s *ChatbotService) executeRejectSuggestedHighlights(args map[string]interface{}, projectID int, service *ChatbotService) (interface{}, error) {
+	suggestionIDs, err := suggestionIDsArg(args)
+	if err != nil {
+		return nil, err
+	}
+	clips, err := s.suggestionClips(args, projectID)
+	if err != nil {
+		return nil, err
+	}
+
+	rejected := []string{}
+	for _, clip := range clips {
+		for _, suggestion := range clip.SuggestedHighlights {
+			if !slices.Contains(suggestionIDs, suggestion.ID) {
+				continue
+			}
+			if err := s.highlightService.DeleteSuggestedHighlight(clip.ID, suggestion.ID); err != nil {
+				return nil, err
+			}
+			rejected = append(rejected, suggestion.ID)
+		}
+	}
+	if len(rejected) == 0 {
+		return nil, fmt.Errorf("no matching suggestions found")
+	}
+
+	return map[string]interface{}{
+		"message":  fmt.Sprintf("Rejected %d suggested highlights", len(rejected)),
+		"rejected": rejected,
+	}, nil
+}
+
+// executeHideHighlight hides a highlight
+func (s *ChatbotService) executeHideHighlight(args map[string]interface{}, projectID int, service *ChatbotService) (interface{}, error) {
+	clip, index, err := s.findHighlight(args, projectID)
+	if err != nil {
+		return nil, err
+	}
+	highlightID := clip.Highlights[index].ID
+
+	if err := projects.NewProjectService(s.client, s.ctx).HideHighlight(projectID, highlightID); err != nil {
+		return nil, err
+	}
+	return map[string]interface{}{
+		"message":      "Highlight hidden",
+		"highlight_id": highlightID,
+	}, nil
+}
+
+// executeUnhideHighlight shows a hidden highlight again
+func (s *ChatbotService) executeUnhideHighlight(args map[string]interface{}, projectID int, service *ChatbotService) (interface{}, error) {
+	clip, index, err := s.findHighlight(args, projectID)
+	if err != nil {
+		return nil, err
+	}
+	highlightID := clip.Highlights[index].ID
+
+	if err := projects.NewProjectService(s.client, s.ctx).UnhideHighlight(projectID, highlightID); err != nil {
+		return nil, err
+	}
+	return map[string]interface{}{
+		"message":      "Highlight shown again",
+		"highlight_id": highlightID,
+	}, nil
+}
+
+// projectClips returns the clips of a project that are not in the trash
+func (s *ChatbotService) projectClips(projectID int) ([]*ent.VideoClip, error) {
+	clips, err := s.client.VideoClip.
+		Query().
+		Where(
+			videoclip.HasProjectWith(project.ID(projectID)),
+			videoclip.DeletedAtIsNil(),
+		).
+		Order(ent.Asc(videoclip.FieldID)).
+		All(s.ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get video clips: %w", err)
+	}
+	return clips, nil
+}
+
+// projectClip returns a clip of a project
+func (s *ChatbotService) projectClip(projectID, clipID int) (*ent.VideoClip, error) {
+	clip, err := s.client.VideoClip.
+		Query().
+		Where(
+			videoclip.ID(clipID),
+			videoclip.HasProjectWith(project.ID(projectID)),
+			videoclip.DeletedAtIsNil(),
+		).
+		Only(s.ctx)
+	if err != nil {
+		return nil, fmt.Errorf("clip %d not found in this project", clipID)
+	}
+	return clip, nil
+}
+
+// suggestionClips returns the clip named by clip_id, or every clip of the project
+func (s *ChatbotService) suggestionClips(args map[string]interface{}, projectID int) ([]*ent.VideoClip, error) {
+	clipID, ok := args["clip_id"].(float64)
+	if !ok {
+		return s.projectClips(projectID)
+	}
+	clip, err := s.projectClip(projectID, int(clipID))
+	if err != nil {
+		return nil, err
+	}
+	return []*ent.VideoClip{clip}, nil
+}
+
+// findHighlight returns the clip holding the highlight named by highlight_id and its index
+func (s *ChatbotService) findHighlight(args map[string]interface{}, projectID int) (*ent.VideoClip, int, error) {
+	highlightID, _ := args["highlight_id"].(string)
+	if highlightID == "" {
+		return nil, 0, fmt.Errorf("highlight_id is required")
+	}
+
+	clips, err := s.projectClips(projectID)
+	if err != nil {
+		return nil, 0, err
+	}
+	for _, clip := range clips {
+		if index := slices.IndexFunc(clip.Highlights, func(h schema.Highlight) bool { return h.ID == highlightID }); index >= 0 {
+			return clip, index, nil
+		}
+	}
+	return nil, 0, fmt.Errorf("highlight %s not found", highlightID)
+}
+
+// saveClipHighlights stores the highlights of a clip through the project service, which records
+// undo history and broadcasts the change
+func (s *ChatbotService) saveClipHighlights(clipID int, clipHighlights []schema.Highlight) error {
+	updated := make([]projects.Highlight, 0, len(clipHighlights))
+	for _, h := range clipHighlights {
+		updated = append(updated, projects.Highlight{
+			ID:      h.ID,
+			Start:   h.Start,
+			End:     h.End,
+			ColorID: h.ColorID,
+			Insight: h.Insight,
+		})
+	}
+	if err := projects.NewProjectService(s.client, s.ctx).UpdateVideoClipHighlights(clipID, updated); err != nil {
+		return fmt.Errorf("failed to update highlights: %w", err)
+	}
+	return nil
+}
+
+// spanFromArgs resolves the quote, or start and end, arguments to a span of the transcript
+func spanFromArgs(args map[string]interface{}, words []schema.Word) (float64, float64, error) {
+	if quote, ok := args["quote"].(string); ok && quote != "" {
+		return findQuote(words, quote)
+	}
+
+	start, hasStart := args["start"].(float64)
+	end, hasEnd := args["end"].(float64)
+	if !hasStart || !hasEnd {
+		return 0, 0, fmt.Errorf("provide a quote or both start and end")
+	}
+	start, end = snapSpan(words, start, end)
+	return start, end, nil
+}
+
+// findQuote returns the span of the first occurrence of a quote in the transcript, ignoring case
+// and punctuation
+func findQuote(words []schema.Word, quote string) (float64, float64, error) {
+	var target []string
+	for _, field := range strings.Fields(quote) {
+		if token := normalizeWord(field); token != "" {
+			target = append(target, token)
+		}
+	}
+	if len(target) == 0 {
+		return 0, 0, fmt.Errorf("quote has no words")
+	}
+
+	for i := 0; i+len(target) <= len(words); i++ {
+		matched := true
+		for j, token := range target {
+			if normalizeWord(words[i+j].Word) != token {
+				matched = false
+				break
+			}
+		}
+		if matched {
+			return words[i].Start, words[i+len(target)-1].End, nil
+		}
+	}
+	return 0, 0, fmt.Errorf("quote %q not found in the transcript", quote)
+}
+
+// normalizeWord lowercases a word and strips its punctuation
+func normalizeWord(word string) string {
+	return strings.ToLower(strings.TrimFunc(word, func(r rune) bool {
+		return !unicode.IsLetter(r) && !unicode.IsDigit(r)
+	}))
+}
+
+// snapSpan widens a time span to the boundaries of the words it touches
+func snapSpan(words []schema.Word, start, end float64) (float64, float64) {
+	start = math.Max(start, 0)
+	snappedStart, snappedEnd := start, end
+	for _, word := range words {
+		if word.End > start {
+			snappedStart = math.Min(word.Start, start)
+			break
+		}
+	}
+	for i := len(words) - 1; i >= 0; i-- {
+		if words[i].Start < end {
+			snappedEnd = math.Max(words[i].End, end)
+			break
+		}
+	}
+	return snappedStart, snappedEnd
+}
+
+// checkSpan validates a highlight span against the clip and its other highlights
+func checkSpan(clip *ent.VideoClip, highlightID string, start, end float64) error {
+	if end <= start {
+		return fmt.Errorf("highlight must end after it starts (%.2f-%.2fs)", start, end)
+	}
+	if clip.Duration > 0 && end > clip.Duration {
+		return fmt.Errorf("highlight ends at %.2fs, after the clip ends at %.2fs", end, clip.Duration)
+	}
+	if overlapping := overlappingHighlight(clip.Highlights, highlightID, start, end); overlapping != "" {
+		return fmt.Errorf("highlight would overlap highlight %s", overlapping)
+	}
+	return nil
+}
+
+// overlappingHighlight returns the ID of a highlight, other than highlightID, that overlaps a span
+func overlappingHighlight(clipHighlights []schema.Highlight, highlightID string, start, end float64) string {
+	for _, h := range clipHighlights {
+		if h.ID != highlightID && h.Start < end && start < h.End {
+			return h.ID
+		}
+	}
+	return ""
+}
+
+// spanText returns the transcript words within a time span
+func spanText(words []schema.Word, start, end float64) string {
+	var text []string
+	for _, word := range words {
+		if word.Start >= start-0.001 && word.End <= end+0.001 {
+			text = append(text, word.Word)
+		}
+	}
+	return strings.Join(text, " ")
+}
+
+// nextColorID returns the lowest color no highlight uses yet
+func nextColorID(clipHighlights []schema.Highlight) int {
+	for colorID := 1; colorID <= maxColorID; colorID++ {
+		if !slices.ContainsFunc(clipHighlights, func(h schema.Highlight) bool { return h.ColorID == colorID }) {
+			return colorID
+		}
+	}
+	return len(clipHighlights)%maxColorID + 1
+}
+
+// colorIDArg reads the color_id argument
+func colorIDArg(args map[string]interface{}) (int, error) {
+	colorID, ok := args["color_id"].(float64)
+	if !ok || colorID != float64(int(colorID)) || colorID < 1 || colorID > maxColorID {
+		return 0, fmt.Errorf("color_id must be an integer from 1 to %d", maxColorID)
+	}
+	return int(colorID), nil
+}
+
+// suggestionIDsArg reads the suggestion_ids argument
+func suggestionIDsArg(args map[string]interface{}) ([]string, error) {
+	values, _ := args["suggestion_ids"].([]interface{})
+	var suggestionIDs []string
+	for _, value := range values {
+		if id, ok := value.(string); ok && id != "" {
+			suggestionIDs = append(suggestionIDs, id)
+		}
+	}
+	if len(suggestionIDs) == 0 {
+		return nil, fmt.Errorf("suggestion_ids is required")
+	}
+	return suggestionIDs, nil
+}
+
+// newHighlightID returns an ID in the format the editor generates
+func newHighlightID() string {
+	suffix := make([]byte, 5)
+	if _, err := rand.Read(suffix); err != nil {
+		return fmt.Sprintf("highlight_%d", time.Now().UnixNano())
+	}
+	return fmt.Sprintf("highlight_%d_%s", time.Now().UnixMilli(), hex.EncodeToString(suffix))
+}
@@ -0,0 +1,215 @@
+package chatbot
+
+import (
+	"io"
+	"net/http"
+	"strconv"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"ramble-ai/ent"
+	"ramble-ai/ent/schema"
+	"ramble-ai/goapp"
+	"ramble-ai/goapp/projects"
+)
+
+// editingFixture is a project with one transcribed clip for the highlight editing functions
+type editingFixture struct {
+	helper  *goapp.TestHelper
+	service *ChatbotService
+	project *ent.Project
+	clip    *ent.VideoClip
+}
+
+func newEditingFixture(t *testing.T) *editingFixture {
+	helper := goapp.NewTestHelper(t)
+	project := helper.CreateTestProject("editing-project")
+	clip := helper.CreateTestVideoClip(project, "interview")
+	clip = helper.Client.VideoClip.UpdateOne(clip).
+		SetTranscription("Welcome back. Today we talk about rockets, and why they fly.").
+		SetTranscriptionWords([]schema.Word{
+			{Word: "Welcome", Start: 0, End: 0.5},
+			{Word: "back.", Start: 0.5, End: 1},
+			{Word: "Today", Start: 1.2, End: 1.6},
+			{Word: "we", Start: 1.6, End: 1.8},
+			{Word: "talk", Start: 1.8, End: 2.1},
+			{Word: "about", Start: 2.1, End: 2.4},
+			{Word: "rockets,", Start: 2.4, End: 3},
+			{Word: "and", Start: 3.2, End: 3.4},
+			{Word: "why", Start: 3.4, End: 3.6},
+			{Word: "they", Start: 3.6, End: 3.8},
+			{Word: "fly.", Start: 3.8, End: 4.2},
+		}).
+		SaveX(helper.Ctx)
+
+	return &editingFixture{
+		helper:  helper,
+		service: NewChatbotService(helper.Client, helper.Ctx, mockUpdateOrderFunc),
+		project: project,
+		clip:    clip,
+	}
+}
+
+// call runs a function like an MCP client would
+func (f *editingFixture) call(t *testing.T, name string, args map[string]interface{}) FunctionExecutionResult {
+	return f.service.CallFunction(name, args, f.project.ID)
+}
+
+// highlights returns the stored highlights of the clip
+func (f *editingFixture) highlights(t *testing.T) []schema.Highlight {
+	clip, err := f.helper.Client.VideoClip.Get(f.helper.Ctx, f.clip.ID)
+	require.NoError(t, err)
+	return clip.Highlights
+}
+
+func TestCreateHighlight(t *testing.T) {
+	f := newEditingFixture(t)
+
+	result := f.call(t, "create_highlight", map[string]interface{}{"clip_id": float64(f.clip.ID), "quote": "talk about Rockets"})
+	require.True(t, result.Success, result.Error)
+	created := result.Result.(map[string]interface{})
+	assert.Equal(t, "talk about rockets,", created["text"])
+
+	stored := f.highlights(t)
+	require.Len(t, stored, 1)
+	assert.Regexp(t, `^highlight_\d+_[0-9a-f]+$`, stored[0].ID)
+	assert.Equal(t, 1.8, stored[0].Start)
+	assert.Equal(t, 3.0, stored[0].End)
+	assert.Equal(t, 1, stored[0].ColorID)
+
+	// Timestamps snap to the words they fall in, and colors advance
+	result = f.call(t, "create_highlight", map[string]interface{}{"clip_id": float64(f.clip.ID), "start": 3.5, "end": 4.0})
+	require.True(t, result.Success, result.Error)
+	stored = f.highlights(t)
+	require.Len(t, stored, 2)
+	assert.Equal(t, 3.4, stored[1].Start)
+	assert.Equal(t, 4.2, stored[1].End)
+	assert.Equal(t, 2, stored[1].ColorID)
+
+	canUndo, _, err := projects.NewProjectService(f.helper.Client, f.helper.Ctx).GetHighlightsHistoryStatus(f.clip.ID)
+	require.NoError(t, err)
+	assert.True(t, canUndo, "edits go through the undo history")
+
+	for name, args := range map[string]map[string]interface{}{
+		"overlap":       {"clip_id": float64(f.clip.ID), "quote": "rockets and why"},
+		"missing quote": {"clip_id": float64(f.clip.ID), "quote": "submarines"},
+		"no span":       {"clip_id": float64(f.clip.ID)},
+		"bad color":     {"clip_id": float64(f.clip.ID), "quote": "welcome", "color_id": float64(21)},
+		"other project": {"clip_id": float64(f.clip.ID + 100), "quote": "welcome"},
+	} {
+		result := f.call(t, "create_highlight", args)
+		assert.False(t, result.Success, name)
+	}
+	assert.Len(t, f.highlights(t), 2)
+}
+
+func TestEditHighlight(t *testing.T) {
+	f := newEditingFixture(t)
+	highlightID := f.helper.CreateTestHighlight(f.clip, 1.2, 2.1)
+
+	result := f.call(t, "adjust_highlight", map[string]interface{}{"highlight_id": highlightID, "extend_end": 0.5})
+	require.True(t, result.Success, result.Error)
+	assert.Equal(t, "Today we talk about rockets,", result.Result.(map[string]interface{})["text"])
+
+	result = f.call(t, "adjust_highlight", map[string]interface{}{"highlight_id": highlightID, "quote": "Today we"})
+	require.True(t, result.Success, result.Error)
+	stored := f.highlights(t)
+	assert.Equal(t, 1.2, stored[0].Start)
+	assert.Equal(t, 1.8, stored[0].End)
+
+	result = f.call(t, "adjust_highlight", map[string]interface{}{"highlight_id": highlightID, "extend_start": -1.0})
+	assert.False(t, result.Success, "shrinking past the end is rejected")
+
+	result = f.call(t, "recolor_highlight", map[string]interface{}{"highlight_id": highlightID, "color_id": float64(7)})
+	require.True(t, result.Success, result.Error)
+	assert.Equal(t, 7, f.highlights(t)[0].ColorID)
+
+	result = f.call(t, "hide_highlight", map[string]interface{}{"highlight_id": highlightID})
+	require.True(t, result.Success, result.Error)
+	listed := f.call(t, "list_highlights", map[string]interface{}{})
+	require.True(t, listed.Success, listed.Error)
+	clips := listed.Result.(map[string]interface{})["clips"].([]map[string]interface{})
+	require.Len(t, clips, 1)
+	assert.Equal(t, true, clips[0]["highlights"].([]map[string]interface{})[0]["hidden"])
+
+	result = f.call(t, "unhide_highlight", map[string]interface{}{"highlight_id": highlightID})
+	require.True(t, result.Success, result.Error)
+	hidden, err := projects.NewProjectService(f.helper.Client, f.helper.Ctx).GetHiddenHighlights(f.project.ID)
+	require.NoError(t, err)
+	assert.Empty(t, hidden)
+
+	result = f.call(t, "delete_highlight", map[string]interface{}{"highlight_id": highlightID})
+	require.True(t, result.Success, result.Error)
+	assert.Empty(t, f.highlights(t))
+
+	result = f.call(t, "delete_highlight", map[string]interface{}{"highlight_id": highlightID})
+	assert.False(t, result.Success)
+	assert.Contains(t, result.Error, "not found")
+}
+
+func TestAcceptAndRejectSuggestedHighlights(t *testing.T) {
+	f := newEditingFixture(t)
+	existingID := f.helper.CreateTestHighlight(f.clip, 0, 1)
+	insight := &schema.HighlightInsight{Score: 0.9, Category: "hook"}
+	f.helper.Client.VideoClip.UpdateOneID(f.clip.ID).SetSuggestedHighlights([]schema.Highlight{
+		{ID: "suggestion_2_7", Start: 1.2, End: 3, ColorID: 1, Insight: insight},
+		{ID: "suggestion_0_2", Start: 0, End: 1, ColorID: 2},
+		{ID: "suggestion_7_11", Start: 3.2, End: 4.2, ColorID: 3},
+	}).ExecX(f.helper.Ctx)
+
+	result := f.call(t, "accept_suggested_highlights", map[string]interface{}{
+		"suggestion_ids": []interface{}{"suggestion_2_7", "suggestion_0_2", "suggestion_missing"},
+	})
+	require.True(t, result.Success, result.Error)
+	accepted := result.Result.(map[string]interface{})
+	assert.Equal(t, []string{"suggestion_2_7"}, accepted["accepted"])
+	assert.Equal(t, map[string]string{
+		"suggestion_0_2":     "overlaps highlight " + existingID,
+		"suggestion_missing": "suggestion not found",
+	}, accepted["skipped"])
+
+	stored := f.highlights(t)
+	require.Len(t, stored, 2)
+	assert.Equal(t, 1.2, stored[1].Start)
+	assert.Equal(t, insight, stored[1].Insight, "accepted suggestions keep their insight")
+
+	result = f.call(t, "reject_suggested_highlights", map[string]interface{}{"suggestion_ids": []interface{}{"suggestion_7_11"}, "clip_id": float64(f.clip.ID)})
+	require.True(t, result.Success, result.Error)
+
+	clip, err := f.helper.Client.VideoClip.Get(f.helper.Ctx, f.clip.ID)
+	require.NoError(t, err)
+	require.Len(t, clip.SuggestedHighlights, 1)
+	assert.Equal(t, "suggestion_0_2", clip.SuggestedHighlights[0].ID, "skipped suggestions stay pending")
+
+	result = f.call(t, "reject_suggested_highlights", map[string]interface{}{"suggestion_ids": []interface{}{}})
+	assert.False(t, result.Success)
+}
+
+func TestHighlightSuggestionsEndpointCallsEditingTools(t *testing.T) {
+	f := newEditingFixture(t)
+
+	var requestBody string
+	useStreamingServer(t, f.helper, func(w http.ResponseWriter, r *http.Request) {
+		body, _ := io.ReadAll(r.Body)
+		requestBody = string(body)
+
+		writeChunk(w, `{"choices":[{"delta":{"tool_calls":[{"index":0,"id":"call_1","type":"function","function":{"name":"create_highlight","arguments":"{\"clip_id\":`+strconv.Itoa(f.clip.ID)+`,\"quote\":\"why they fly\"}"}}]}}]}`)
+		writeChunk(w, "[DONE]")
+	})
+
+	response, err := f.service.SendMessage(ChatRequest{ProjectID: f.project.ID, EndpointID: "highlight_suggestions", Message: "Highlight the bit about flying"}, noAPIKey)
+	require.NoError(t, err)
+	require.True(t, response.Success, response.Error)
+
+	assert.Contains(t, requestBody, `"name":"create_highlight"`, "the endpoint's tools are sent")
+	assert.Contains(t, requestBody, "Today we talk about rockets", "the context has the transcript")
+	assert.Equal(t, []string{"create_highlight"}, response.ActionsPerformed)
+	assert.True(t, response.HasActions)
+	assert.Contains(t, response.Message, "Created highlight in interview")
+
+	stored := f.highlights(t)
+	require.Len(t, stored, 1)
+	assert.Equal(t, 3.4, stored[0].Start)
+}
@@ -0,0 +1,267 @@
+package chatbot
+
+import (
+	"encoding/json"
+	"fmt"
+	"log"
+	"strings"
+	"time"
+
+	"ramble-ai/ent"
+	"ramble-ai/ent/chatmessage"
+	"ramble-ai/ent/predicate"
+	"ramble-ai/goapp/ai"
+	"ramble-ai/goapp/settings"
+)
+
+// historySummariesSettingKey turns LLM summaries of trimmed chat history off when set to "false"
+const historySummariesSettingKey = "chat_history_summaries"
+
+// summaryMessagePrefix starts the message ID of a session's rolling summary message
+const summaryMessagePrefix = "summary_"
+
+// summaryBatchTokens bounds the messages folded into the summary by one request
+const summaryBatchTokens = 6000
+
+// maxSummaryBatches bounds the requests of one summarization pass. Older messages beyond that are
+// dropped without being summarized, which only happens the first time a long session is condensed.
+const maxSummaryBatches = 4
+
+// historySummaryPrompt instructs the model that maintains a session's rolling summary
+const historySummaryPrompt = `You maintain a running summary of a conversation between a video creator and their editing assistant.
+Update the current summary with the new messages. Keep every decision, confirmed or rejected change, stated preference and open question, with the clip names, highlight IDs and timings they refer to. Drop greetings, small talk and anything the new messages supersede.
+Reply with the updated summary only, as plain text of at most 300 words.`
+
+// HistorySummary is the rolling summary of a session's older messages
+type HistorySummary struct {
+	Summary          string    `json:"summary"`
+	ThroughMessageID string    `json:"throughMessageId"` // last message condensed into the summary
+	ThroughTimestamp time.Time `json:"throughTimestamp"`
+	MessageCount     int       `json:"messageCount"` // messages condensed so far
+}
+
+// HistorySummarizer folds messages into the previous summary, which is empty for the first pass
+type HistorySummarizer func(previous string, messages []ChatMessage) (string, error)
+
+// summaryMessageContent is the system message that carries a summary in the context window
+func summaryMessageContent(summary string) string {
+	return fmt.Sprintf("Previous conversation summary: %s", summary)
+}
+
+// messagesAfterSummary returns the messages the summary doesn't cover
+func messagesAfterSummary(messages []ChatMessage, summary *HistorySummary) []ChatMessage {
+	for i, message := range messages {
+		if message.ID == summary.ThroughMessageID {
+			return messages[i+1:]
+		}
+	}
+	for i, message := range messages {
+		if message.Timestamp.After(summary.ThroughTimestamp) {
+			return messages[i:]
+		}
+	}
+	return nil
+}
+
+// notSummaryMessage excludes rolling summary messages, which are never shown as chat messages
+func notSummaryMessage() predicate.ChatMessage {
+	return chatmessage.Not(chatmessage.MessageIDHasPrefix(summaryMessagePrefix))
+}
+
+// historySummariesEnabled reports whether trimmed history is summarized by the model
+func (s *ChatbotService) historySummariesEnabled() bool {
+	value, err := settings.NewSettingsService(s.client, s.ctx).GetSetting(historySummariesSettingKey)
+	if err != nil {
+		log.Printf("[CHATBOT] Failed to read %s setting: %v", historySummariesSettingKey, err)
+		return false
+	}
+	return value != "false"
+}
+
+// historySummarizer returns a summarizer that condenses messages through the configured AI service
+func (s *ChatbotService) historySummarizer(model string) HistorySummarizer {
+	counter := NewTokenCounter()
+
+	return func(previous string, messages []ChatMessage) (string, error) {
+		aiService, err := ai.NewAIServiceFactory(s.client, s.ctx).CreateService()
+		if err != nil {
+			return "", fmt.Errorf("failed to create AI service: %w", err)
+		}
+		complete := ai.TextCompleter(aiService, ai.TextProcessingRequest{
+			SystemPrompt: historySummaryPrompt,
+			Model:        model,
+			TaskType:     "chat",
+		})
+
+		batches := summaryBatches(counter, model, messages)
+		if len(batches) > maxSummaryBatches {
+			log.Printf("[CHATBOT] Summarizing the latest %d of %d message batches", maxSummaryBatches, len(batches))
+			batches = batches[len(batches)-maxSummaryBatches:]
+		}
+
+		summary := previous
+		for _, batch := range batches {
+			if len(batch) == 0 {
+				continue
+			}
+			content, err := complete(summaryPrompt(summary, batch), nil)
+			if err != nil {
+				return "", fmt.Errorf("failed to summarize chat history: %w", err)
+			}
+			if content = strings.TrimSpace(content); content != "" {
+				summary = content
+			}
+		}
+		return summary, nil
+	}
+}
+
+// summaryBatches splits the user and assistant messages into batches of at most summaryBatchTokens
+func summaryBatches(counter *TokenCounter, model string, messages []ChatMessage) [][]ChatMessage {
+	var batches [][]ChatMessage
+	var batch []ChatMessage
+	batchTokens := 0
+
+	for _, message := range messages {
+		if message.Role != "user" && message.Role != "assistant" {
+			continue
+		}
+		tokens := counter.CountMessageTokens(model, message.Role, message.Content)
+		if len(batch) > 0 && batchTokens+tokens > summaryBatchTokens {
+			batches = append(batches, batch)
+			batch, batchTokens = nil, 0
+		}
+		batch = append(batch, message)
+		batchTokens += tokens
+	}
+	if len(batch) > 0 {
+		batches = append(batches, batch)
+	}
+	return batches
+}
+
+// summaryPrompt asks for the summary updated with a batch of messages
+func summaryPrompt(previous string, messages []ChatMessage) string {
+	if previous == "" {
+		previous = "(none yet)"
+	}
+
+	var prompt strings.Builder
+	fmt.Fprintf(&prompt, "CURRENT SUMMARY:\n%s\n\nNEW MESSAGES:\n", previous)
+	for _, message := range messages {
+		role := "User"
+		if message.Role == "assistant" {
+			role = "Assistant"
+		}
+		fmt.Fprintf(&prompt, "%s: %s\n\n", role, strings.TrimSpace(message.Content))
+	}
+	return prompt.String()
+}
+
+// loadHistorySummary returns a session's rolling summary, nil when it has none
+func (s *ChatbotService) loadHistorySummary(session *ent.ChatSession) (*HistorySummary, error) {
+	message, err := s.client.ChatMessage.
+		Query().
+		Where(chatmessage.MessageID(summaryMessagePrefix + session.SessionID)).
+		Only(s.ctx)
+	if err != nil {
+		if ent.IsNotFound(err) {
+			return nil, nil
+		}
+		return nil, fmt.Errorf("failed to get history summary: %w", err)
+	}
+
+	var summary HistorySummary
+	if err := json.Unmarshal([]byte(message.HiddenContext), &summary); err != nil {
+		return nil, fmt.Errorf("failed to parse history summary: %w", err)
+	}
+	return &summary, nil
+}
+
+// saveHistorySummary stores a session's rolling summary in the hidden context of its summary message
+func (s *ChatbotService) saveHistorySummary(sessionID string, summary *HistorySummary) error {
+	session, err := s.getSession(sessionID)
+	if err != nil {
+		return err
+	}
+
+	value, err := json.Marshal(summary)
+	if err != nil {
+		return fmt.Errorf("failed to marshal history summary: %w", err)
+	}
+	messageID := summaryMessagePrefix + session.SessionID
+	content := fmt.Sprintf("Summary of %d earlier messages", summary.MessageCount)
+
+	existing, err := s.client.ChatMessage.
+		Query().
+		Where(chatmessage.MessageID(messageID)).
+		Only(s.ctx)
+	switch {
+	case ent.IsNotFound(err):
+		err = s.client.ChatMessage.
+			Create().
+			SetMessageID(messageID).
+			SetSessionID(session.ID).
+			SetRole(chatmessage.RoleSystem).
+			SetContent(content).
+			SetHiddenContext(string(value)).
+			SetTimestamp(summary.ThroughTimestamp).
+			Exec(s.ctx)
+	case err == nil:
+		err = existing.Update().
+			SetContent(content).
+			SetHiddenContext(string(value)).
+			SetTimestamp(summary.ThroughTimestamp).
+			Exec(s.ctx)
+	}
+	if err != nil {
+		return fmt.Errorf("failed to save history summary: %w", err)
+	}
+	return nil
+}
+
+// contextHistory loads the history for a context window: the session's rolling summary and every
+// message it doesn't cover yet
+func (s *ChatbotService) contextHistory(projectID int, endpointID, sessionID string) (*ChatHistoryResponse, error) {
+	session, err := s.sessionQuery(projectID, endpointID, sessionID).First(s.ctx)
+	if err != nil {
+		if ent.IsNotFound(err) {
+			return s.GetChatHistory(projectID, endpointID, sessionID)
+		}
+		return nil, fmt.Errorf("failed to query chat session: %w", err)
+	}
+
+	summary, err := s.loadHistorySummary(session)
+	if err != nil {
+		return nil, err
+	}
+
+	query := session.QueryMessages().
+		Where(notSummaryMessage()).
+		Order(ent.Asc(chatmessage.FieldTimestamp), ent.Asc(chatmessage.FieldID))
+	if summary != nil {
+		query = query.Where(chatmessage.TimestampGTE(summary.ThroughTimestamp))
+	}
+	entMessages, err := query.All(s.ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get chat messages: %w", err)
+	}
+
+	messages := make([]ChatMessage, len(entMessages))
+	for i, msg := range entMessages {
+		messages[i] = ChatMessage{
+			ID:        msg.MessageID,
+			Role:      string(msg.Role),
+			Content:   msg.Content,
+			Timestamp: msg.Timestamp,
+		}
+	}
+
+	return &ChatHistoryResponse{
+		SessionID:     session.SessionID,
+		Title:         session.Title,
+		Messages:      messages,
+		SelectedModel: session.SelectedModel,
+		Summary:       summary,
+	}, nil
+}
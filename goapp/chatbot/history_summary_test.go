@@ -0,0 +1,180 @@
+package chatbot
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"ramble-ai/ent/chatmessage"
+	"ramble-ai/goapp"
+	"ramble-ai/goapp/settings"
+)
+
+// longHistory returns alternating messages of about 1000 tokens each, a minute apart
+func longHistory(count int) []ChatMessage {
+	start := time.Date(2025, 1, 1, 12, 0, 0, 0, time.UTC)
+	messages := make([]ChatMessage, count)
+	for i := range messages {
+		role := "user"
+		if i%2 == 1 {
+			role = "assistant"
+		}
+		messages[i] = ChatMessage{
+			ID:        fmt.Sprintf("msg_%d", i),
+			Role:      role,
+			Content:   fmt.Sprintf("Message %d: %s", i, strings.Repeat("clip ", 1000)),
+			Timestamp: start.Add(time.Duration(i) * time.Minute),
+		}
+	}
+	return messages
+}
+
+func TestContextManager_RollingSummary(t *testing.T) {
+	var calls [][]string
+	manager := NewContextManager()
+	manager.summarizer = func(previous string, messages []ChatMessage) (string, error) {
+		ids := []string{previous}
+		for _, message := range messages {
+			ids = append(ids, message.ID)
+		}
+		calls = append(calls, ids)
+		return fmt.Sprintf("summary %d", len(calls)), nil
+	}
+
+	history := &ChatHistoryResponse{Messages: longHistory(12)}
+	window, err := manager.BuildContextWindow("openai/gpt-4", "You are a video editor.", history, "Reorder them", 2500)
+	require.NoError(t, err)
+	require.Len(t, calls, 1)
+	assert.Equal(t, []string{"", "msg_0", "msg_1", "msg_2", "msg_3", "msg_4", "msg_5", "msg_6"}, calls[0])
+	assert.Equal(t, &HistorySummary{Summary: "summary 1", ThroughMessageID: "msg_6", ThroughTimestamp: history.Messages[6].Timestamp, MessageCount: 7}, window.UpdatedSummary)
+	assert.Equal(t, summaryMessageContent("summary 1"), window.Messages[1]["content"])
+	assert.LessOrEqual(t, window.TotalTokens, 8191-2500)
+
+	// The next turn starts from the summary and only condenses what no longer fits
+	history = &ChatHistoryResponse{Messages: longHistory(14)[7:], Summary: window.UpdatedSummary}
+	window, err = manager.BuildContextWindow("openai/gpt-4", "You are a video editor.", history, "Reorder them", 2500)
+	require.NoError(t, err)
+	require.Len(t, calls, 2)
+	assert.Equal(t, "summary 1", calls[1][0], "the previous summary is extended")
+	assert.NotContains(t, calls[1], "msg_6", "condensed messages are not summarized twice")
+	assert.Equal(t, "msg_7", calls[1][1])
+	assert.Equal(t, 7+len(calls[1])-1, window.UpdatedSummary.MessageCount)
+	assert.Equal(t, summaryMessageContent("summary 2"), window.Messages[1]["content"])
+
+	// Without anything to trim the stored summary is sent as is
+	history = &ChatHistoryResponse{Messages: longHistory(14)[12:], Summary: window.UpdatedSummary}
+	window, err = manager.BuildContextWindow("openai/gpt-4", "You are a video editor.", history, "Reorder them", 2500)
+	require.NoError(t, err)
+	assert.Len(t, calls, 2)
+	assert.Nil(t, window.UpdatedSummary)
+	assert.Equal(t, summaryMessageContent("summary 2"), window.Messages[1]["content"])
+	assert.Len(t, window.Messages, 5)
+}
+
+func TestContextManager_RollingSummaryFallsBackWhenSummarizerFails(t *testing.T) {
+	manager := NewContextManager()
+	manager.summarizer = func(previous string, messages []ChatMessage) (string, error) {
+		return "", fmt.Errorf("offline")
+	}
+
+	previous := &HistorySummary{Summary: "User chose the beach intro.", ThroughMessageID: "msg_x"}
+	window, err := manager.BuildContextWindow("openai/gpt-4", "You are a video editor.", &ChatHistoryResponse{Messages: longHistory(12), Summary: previous}, "Reorder them", 2500)
+	require.NoError(t, err)
+	assert.Nil(t, window.UpdatedSummary, "nothing is persisted without a model summary")
+	assert.True(t, strings.HasPrefix(window.Summary, "User chose the beach intro. User requests: Message 0"))
+}
+
+func TestHistorySummaryPersistence(t *testing.T) {
+	helper := goapp.NewTestHelper(t)
+	project := helper.CreateTestProject("summary-project")
+	service := NewChatbotService(helper.Client, helper.Ctx, mockUpdateOrderFunc)
+
+	session, err := service.findOrCreateSession(project.ID, "general", "")
+	require.NoError(t, err)
+	messages := longHistory(4)
+	for _, message := range messages {
+		_, err := helper.Client.ChatMessage.Create().
+			SetMessageID(message.ID).
+			SetSessionID(session.ID).
+			SetRole(chatmessage.Role(message.Role)).
+			SetContent(message.Content).
+			SetTimestamp(message.Timestamp).
+			Save(helper.Ctx)
+		require.NoError(t, err)
+	}
+
+	history, err := service.contextHistory(project.ID, "general", "")
+	require.NoError(t, err)
+	assert.Nil(t, history.Summary)
+	assert.Len(t, history.Messages, 4, "without a summary the whole session is loaded")
+
+	summary := &HistorySummary{Summary: "Intro picked", ThroughMessageID: "msg_1", ThroughTimestamp: messages[1].Timestamp, MessageCount: 2}
+	require.NoError(t, service.saveHistorySummary(session.SessionID, summary))
+	summary.Summary, summary.ThroughMessageID, summary.ThroughTimestamp, summary.MessageCount = "Intro and outro picked", "msg_2", messages[2].Timestamp, 3
+	require.NoError(t, service.saveHistorySummary(session.SessionID, summary))
+
+	history, err = service.contextHistory(project.ID, "general", session.SessionID)
+	require.NoError(t, err)
+	require.NotNil(t, history.Summary)
+	assert.Equal(t, "Intro and outro picked", history.Summary.Summary)
+	assert.Equal(t, 3, history.Summary.MessageCount)
+	require.NotEmpty(t, history.Messages)
+	assert.Equal(t, []ChatMessage{messages[3]}, messagesAfterSummary(history.Messages, history.Summary))
+
+	// The summary message never shows up as a chat message
+	visible, err := service.GetChatHistory(project.ID, "general", session.SessionID)
+	require.NoError(t, err)
+	assert.Len(t, visible.Messages, 4)
+	sessions, err := service.ListSessions(project.ID, "general", false)
+	require.NoError(t, err)
+	assert.Equal(t, 4, sessions[0].MessageCount)
+	fork, err := service.ForkSession(session.SessionID, "msg_3")
+	require.NoError(t, err)
+	assert.Equal(t, 4, fork.MessageCount)
+
+	require.NoError(t, service.ClearChatHistory(project.ID, "general", session.SessionID))
+	loaded, err := service.loadHistorySummary(session)
+	require.NoError(t, err)
+	assert.Nil(t, loaded, "clearing a session drops its summary")
+}
+
+func TestHistorySummarizer(t *testing.T) {
+	helper := goapp.NewTestHelper(t)
+	service := NewChatbotService(helper.Client, helper.Ctx, mockUpdateOrderFunc)
+	assert.True(t, service.historySummariesEnabled())
+	require.NoError(t, settings.NewSettingsService(helper.Client, helper.Ctx).SaveSetting(historySummariesSettingKey, "false"))
+	assert.False(t, service.historySummariesEnabled())
+
+	var prompts []string
+	useStreamingServer(t, helper, func(w http.ResponseWriter, r *http.Request) {
+		var body struct {
+			Messages []struct {
+				Content string `json:"content"`
+			} `json:"messages"`
+		}
+		require.NoError(t, json.NewDecoder(r.Body).Decode(&body))
+		prompts = append(prompts, body.Messages[len(body.Messages)-1].Content)
+		fmt.Fprintf(w, `{"choices":[{"message":{"role":"assistant","content":" Summary after batch %d "}}]}`, len(prompts))
+	})
+
+	messages := longHistory(22)
+	messages = append(messages, ChatMessage{ID: "err", Role: "error", Content: "Request failed"})
+	summary, err := service.historySummarizer("anthropic/claude-sonnet-4")("Earlier decisions", messages)
+	require.NoError(t, err)
+
+	// 22 messages of about 1000 tokens make five batches of up to five, and only the latest four are condensed
+	require.Len(t, prompts, maxSummaryBatches)
+	assert.Equal(t, fmt.Sprintf("Summary after batch %d", maxSummaryBatches), summary)
+	assert.Contains(t, prompts[0], "CURRENT SUMMARY:\nEarlier decisions")
+	assert.Contains(t, prompts[1], "CURRENT SUMMARY:\nSummary after batch 1")
+	assert.NotContains(t, prompts[0], "Message 4:")
+	assert.Contains(t, prompts[0], "User: Message 6:")
+	assert.Contains(t, prompts[len(prompts)-1], "Assistant: Message 21")
+	assert.NotContains(t, strings.Join(prompts, ""), "Request failed", "error messages are not summarized")
+}
@@ -158,6 +158,14 @@ CRITICAL: Include ALL highlight IDs from the context in your new_order array. Mi
 					return service.executeResetToOriginal(args, projectID, service)
 				},
 			},
+			{
+				Name:        "search_transcripts",
+				Description: searchTranscriptsDescription,
+				Parameters:  searchTranscriptsParameters,
+				Executor: func(args map[string]interface{}, projectID int, service *ChatbotService) (interface{}, error) {
+					return service.executeSearchTranscripts(args, projectID, service)
+				},
+			},
 		},
 	})
 
@@ -166,33 +174,33 @@ CRITICAL: Include ALL highlight IDs from the context in your new_order array. Mi
 		EndpointID:        "highlight_suggestions",
 		Name:              "Highlight Suggestions Assistant",
 		Description:       "Get AI suggestions for creating engaging highlights",
-		ContextBuilder:    &GenericContextBuilder{},
+		ContextBuilder:    &HighlightEditingContextBuilder{},
 		RequiresFunctions: false,
 		DefaultModel:      "anthropic/claude-sonnet-4",
-		SystemPrompt:      "You are an expert at identifying compelling moments in video content. Help suggest highlights that will engage viewers.",
-		Functions:         []MCPFunction{}, // No MCP functions yet
+		SystemPrompt:      "You are an expert at identifying compelling moments in video content. Help suggest highlights that will engage viewers. When the user asks you to change highlights, use the highlight tools to do it: quote the transcript exactly when creating or adjusting highlights, and use the IDs from the project context.",
+		Functions:         highlightEditingFunctions(),
 	})
 
 	r.RegisterEndpoint(&EndpointMCPConfig{
 		EndpointID:        "content_analysis",
 		Name:              "Content Analysis Assistant",
 		Description:       "Analyze video content for insights and recommendations",
-		ContextBuilder:    &GenericContextBuilder{},
+		ContextBuilder:    &HighlightEditingContextBuilder{},
 		RequiresFunctions: false,
 		DefaultModel:      "google/gemini-2.0-flash-001",
-		SystemPrompt:      "You are a content analysis expert. Help analyze video content for themes, key messages, and audience engagement opportunities.",
-		Functions:         []MCPFunction{}, // No MCP functions yet
+		SystemPrompt:      "You are a content analysis expert. Help analyze video content for themes, key messages, and audience engagement opportunities. When the user asks you to act on your analysis, use the highlight tools with the IDs from the project context.",
+		Functions:         highlightEditingFunctions(),
 	})
 
 	r.RegisterEndpoint(&EndpointMCPConfig{
 		EndpointID:        "export_optimization",
 		Name:              "Export Optimization Assistant",
 		Description:       "Optimize export settings and final video production",
-		ContextBuilder:    &GenericContextBuilder{},
+		ContextBuilder:    &ExportOptimizationContextBuilder{},
 		RequiresFunctions: false,
 		DefaultModel:      "anthropic/claude-3.5-haiku-20241022",
-		SystemPrompt:      "You are a video production expert. Help optimize export settings and final video production for different platforms and audiences.",
-		Functions:         []MCPFunction{}, // No MCP functions yet
+		SystemPrompt:      "You are a video production expert. Help optimize export settings and final video production for different platforms and audiences. Use the export tools to inspect the project and recommend settings. Exports take a while: call prepare_export and show the user the plan. You cannot start exports yourself; the user starts a prepared export by confirming it in the app, so never claim an export has started.",
+		Functions:         exportFunctions(),
 	})
 }
 
@@ -212,6 +220,22 @@ func (r *MCPRegistry) RegisterEndpoint(config *EndpointMCPConfig) {
 	log.Printf("Registered MCP endpoint: %s with %d functions", config.EndpointID, len(config.Functions))
 }
 
+// RegisterFunction adds a function to a registered endpoint's functions
+func (r *MCPRegistry) RegisterFunction(endpointID string, function MCPFunction) error {
+	config, exists := r.GetEndpointConfig(endpointID)
+	if !exists {
+		return fmt.Errorf("endpoint %s not found in MCP registry", endpointID)
+	}
+	for _, existing := range config.Functions {
+		if existing.Name == function.Name {
+			return fmt.Errorf("function %s is already registered for endpoint %s", function.Name, endpointID)
+		}
+	}
+
+	config.Functions = append(config.Functions, function)
+	return nil
+}
+
 // GetEndpointConfig returns the MCP configuration for an endpoint
 func (r *MCPRegistry) GetEndpointConfig(endpointID string) (*EndpointMCPConfig, bool) {
 	config, exists := r.configs[endpointID]
@@ -311,6 +335,13 @@ func (r *MCPRegistry) ExecuteFunction(endpointID, functionName string, args map[
 	}, fmt.Errorf("function %s not found for endpoint %s", functionName, endpointID)
 }
 
+// UsesConversationFlow returns whether an endpoint confirms the user's intent before executing
+// structured actions, rather than calling its functions directly from the reply
+func (r *MCPRegistry) UsesConversationFlow(endpointID string) bool {
+	config, exists := r.GetEndpointConfig(endpointID)
+	return exists && config.RequiresFunctions
+}
+
 // SupportsActions returns whether an endpoint supports MCP function calls
 func (r *MCPRegistry) SupportsActions(endpointID string) bool {
 	config, exists := r.GetEndpointConfig(endpointID)
@@ -0,0 +1,432 @@
+package chatbot
+
+import (
+	"encoding/json"
+	"fmt"
+	"strings"
+	"time"
+
+	"ramble-ai/ent"
+	"ramble-ai/ent/chatmessage"
+)
+
+// Proposal statuses
+const (
+	ProposalPending    = "pending"
+	ProposalApplied    = "applied"
+	ProposalRejected   = "rejected"
+	ProposalSuperseded = "superseded" // left pending in a session that was forked; only the original can be applied
+)
+
+// OrderProposal is a highlight order change that is shown to the user and only applied on approval.
+// It is persisted in the hidden context of the assistant reply that proposed it.
+type OrderProposal struct {
+	ID         string        `json:"id"`     // message ID of the reply carrying the proposal
+	Action     string        `json:"action"` // the function or intent that produced it, e.g. "reorder_highlights"
+	Reason     string        `json:"reason,omitempty"`
+	BaseOrder  []interface{} `json:"baseOrder"` // the order when the proposal was made
+	NewOrder   []interface{} `json:"newOrder"`
+	Diff       OrderDiff     `json:"diff"`
+	Status     string        `json:"status"`
+	Stale      bool          `json:"stale,omitempty"` // the order has changed since the proposal was made
+	CreatedAt  time.Time     `json:"createdAt"`
+	ResolvedAt *time.Time    `json:"resolvedAt,omitempty"`
+}
+
+// OrderDiff describes how a proposed order differs from the current one
+type OrderDiff struct {
+	Moved           []MovedHighlight `json:"moved"`
+	Hidden          []string         `json:"hidden"` // in the current order but left out of the proposed one
+	Added           []string         `json:"added"`  // in the proposed order but not the current one
+	SectionsAdded   []string         `json:"sectionsAdded"`
+	SectionsRemoved []string         `json:"sectionsRemoved"`
+}
+
+// MovedHighlight is a highlight whose position changes, counted among highlights from 1
+type MovedHighlight struct {
+	ID   string `json:"id"`
+	From int    `json:"from"`
+	To   int    `json:"to"`
+}
+
+// IsEmpty reports whether the proposed order changes nothing
+func (d OrderDiff) IsEmpty() bool {
+	return len(d.Moved) == 0 && len(d.Hidden) == 0 && len(d.Added) == 0 &&
+		len(d.SectionsAdded) == 0 && len(d.SectionsRemoved) == 0
+}
+
+// diffOrders compares two highlight orders. Moved highlights are the fewest that explain the new
+// positions: the ones outside the longest common subsequence of the highlights both orders contain.
+func diffOrders(base, proposed []interface{}) OrderDiff {
+	baseIDs, baseSections := splitOrder(base)
+	proposedIDs, proposedSections := splitOrder(proposed)
+
+	diff := OrderDiff{
+		Moved:           []MovedHighlight{},
+		Hidden:          missingFrom(baseIDs, proposedIDs),
+		Added:           missingFrom(proposedIDs, baseIDs),
+		SectionsAdded:   missingFrom(proposedSections, baseSections),
+		SectionsRemoved: missingFrom(baseSections, proposedSections),
+	}
+
+	basePositions := positions(baseIDs)
+	proposedPositions := positions(proposedIDs)
+	var common, commonProposed []string
+	for _, id := range baseIDs {
+		if _, ok := proposedPositions[id]; ok {
+			common = append(common, id)
+		}
+	}
+	for _, id := range proposedIDs {
+		if _, ok := basePositions[id]; ok {
+			commonProposed = append(commonProposed, id)
+		}
+	}
+
+	kept := longestCommonSubsequence(common, commonProposed)
+	for _, id := range commonProposed {
+		if !kept[id] {
+			diff.Moved = append(diff.Moved, MovedHighlight{ID: id, From: basePositions[id] + 1, To: proposedPositions[id] + 1})
+		}
+	}
+	return diff
+}
+
+// splitOrder separates an order into its highlight IDs and section titles
+func splitOrder(order []interface{}) ([]string, []string) {
+	var ids, sections []string
+	for _, item := range order {
+		switch v := item.(type) {
+		case string:
+			if v == "N" {
+				sections = append(sections, "")
+			} else {
+				ids = append(ids, v)
+			}
+		case map[string]interface{}:
+			if itemType, _ := v["type"].(string); itemType == "N" {
+				title, _ := v["title"].(string)
+				sections = append(sections, title)
+			}
+		}
+	}
+	return ids, sections
+}
+
+// missingFrom returns the items of a that b lacks, counting repeated items
+func missingFrom(a, b []string) []string {
+	remaining := make(map[string]int, len(b))
+	for _, item := range b {
+		remaining[item]++
+	}
+	missing := []string{}
+	for _, item := range a {
+		if remaining[item] > 0 {
+			remaining[item]--
+			continue
+		}
+		missing = append(missing, item)
+	}
+	return missing
+}
+
+// positions maps each ID to its index
+func positions(ids []string) map[string]int {
+	result := make(map[string]int, len(ids))
+	for i, id := range ids {
+		result[id] = i
+	}
+	return result
+}
+
+// longestCommonSubsequence returns the IDs of a longest common subsequence of a and b
+func longestCommonSubsequence(a, b []string) map[string]bool {
+	lengths := make([][]int, len(a)+1)
+	for i := range lengths {
+		lengths[i] = make([]int, len(b)+1)
+	}
+	for i := len(a) - 1; i >= 0; i-- {
+		for j := len(b) - 1; j >= 0; j-- {
+			if a[i] == b[j] {
+				lengths[i][j] = lengths[i+1][j+1] + 1
+			} else {
+				lengths[i][j] = max(lengths[i+1][j], lengths[i][j+1])
+			}
+		}
+	}
+
+	kept := make(map[string]bool)
+	for i, j := 0, 0; i < len(a) && j < len(b); {
+		switch {
+		case a[i] == b[j]:
+			kept[a[i]] = true
+			i++
+			j++
+		case lengths[i+1][j] >= lengths[i][j+1]:
+			i++
+		default:
+			j++
+		}
+	}
+	return kept
+}
+
+// describeProposal summarizes a proposal for the chat
+func describeProposal(proposal *OrderProposal) string {
+	diff := proposal.Diff
+	if diff.IsEmpty() {
+		return "📝 **Proposed order matches the current one** — there is nothing to apply."
+	}
+
+	var builder strings.Builder
+	builder.WriteString("📝 **Proposed changes** — nothing has been applied yet:\n")
+	if len(diff.Moved) > 0 {
+		fmt.Fprintf(&builder, "- Moves %d highlight(s)\n", len(diff.Moved))
+	}
+	if len(diff.Hidden) > 0 {
+		fmt.Fprintf(&builder, "- Leaves out %d highlight(s)\n", len(diff.Hidden))
+	}
+	if len(diff.Added) > 0 {
+		fmt.Fprintf(&builder, "- Adds %d highlight(s)\n", len(diff.Added))
+	}
+	if len(diff.SectionsAdded) > 0 {
+		fmt.Fprintf(&builder, "- New sections: %s\n", sectionList(diff.SectionsAdded))
+	}
+	if len(diff.SectionsRemoved) > 0 {
+		fmt.Fprintf(&builder, "- Removed sections: %s\n", sectionList(diff.SectionsRemoved))
+	}
+	builder.WriteString("\nApprove the proposal to apply it.")
+	return builder.String()
+}
+
+// sectionList quotes section titles for the chat
+func sectionList(titles []string) string {
+	quoted := make([]string, len(titles))
+	for i, title := range titles {
+		if title == "" {
+			quoted[i] = "(untitled)"
+		} else {
+			quoted[i] = fmt.Sprintf("%q", title)
+		}
+	}
+	return strings.Join(quoted, ", ")
+}
+
+// currentOrder returns the project's highlight order, or every highlight in clip order when the
+// project has none yet
+func (s *ChatbotService) currentOrder(projectID int) ([]interface{}, error) {
+	order, err := s.highlightService.GetProjectHighlightOrderWithTitles(projectID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get current order: %w", err)
+	}
+	if len(order) > 0 {
+		return order, nil
+	}
+
+	projectHighlights, err := s.highlightService.GetProjectHighlights(projectID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get project highlights: %w", err)
+	}
+	order = []interface{}{}
+	for _, ph := range projectHighlights {
+		for _, highlight := range ph.Highlights {
+			order = append(order, highlight.ID)
+		}
+	}
+	return order, nil
+}
+
+// newOrderProposal creates a proposal to change a project's order, diffed against the current order
+func (s *ChatbotService) newOrderProposal(projectID int, messageID, action, reason string, newOrder []interface{}) (*OrderProposal, error) {
+	base, err := s.currentOrder(projectID)
+	if err != nil {
+		return nil, err
+	}
+
+	return &OrderProposal{
+		ID:        messageID,
+		Action:    action,
+		Reason:    reason,
+		BaseOrder: base,
+		NewOrder:  newOrder,
+		Diff:      diffOrders(base, newOrder),
+		Status:    ProposalPending,
+		CreatedAt: time.Now(),
+	}, nil
+}
+
+// attachProposal puts a proposal on a reply, replacing any earlier one, and describes it in the message
+func attachProposal(response *ChatResponse, proposal *OrderProposal) {
+	response.Proposal = proposal
+	description := describeProposal(proposal)
+	if response.Message == "" {
+		response.Message = description
+	} else {
+		response.Message = strings.TrimSpace(response.Message) + "\n\n" + description
+	}
+}
+
+// settlePendingOrder handles a function result that prepared a highlight order: the order is
+// applied, or turned into a proposal on the reply when the request asks for proposals
+func (s *ChatbotService) settlePendingOrder(result *FunctionExecutionResult, req ChatRequest, response *ChatResponse) {
+	if !req.ProposeChanges {
+		s.applyPendingOrder(result, req.ProjectID)
+		return
+	}
+
+	newOrder, resultMap, ok := pendingOrder(result)
+	if !ok {
+		return
+	}
+	reason, _ := resultMap["reason"].(string)
+	proposal, err := s.newOrderProposal(req.ProjectID, response.MessageID, result.FunctionName, reason, newOrder)
+	if err != nil {
+		result.Success = false
+		result.Error = fmt.Sprintf("Failed to prepare proposal: %v", err)
+		return
+	}
+
+	result.Message = "Function executed; order proposed for approval"
+	resultMap["proposed"] = true
+	resultMap["proposal_id"] = proposal.ID
+	attachProposal(response, proposal)
+}
+
+// resultProposed reports whether the i-th function result proposed its order instead of applying it
+func resultProposed(results []FunctionExecutionResult, i int) bool {
+	if i >= len(results) {
+		return false
+	}
+	resultMap, ok := results[i].Result.(map[string]interface{})
+	if !ok {
+		return false
+	}
+	proposed, _ := resultMap["proposed"].(bool)
+	return proposed
+}
+
+// proposalFromMessage decodes the proposal carried by a message, nil when it carries none
+func proposalFromMessage(message *ent.ChatMessage) *OrderProposal {
+	if message.Role != chatmessage.RoleAssistant || message.HiddenContext == "" {
+		return nil
+	}
+	var proposal OrderProposal
+	if err := json.Unmarshal([]byte(message.HiddenContext), &proposal); err != nil || proposal.Status == "" {
+		return nil
+	}
+	proposal.ID = message.MessageID // forked sessions carry copies under new message IDs
+	return &proposal
+}
+
+// proposalHiddenContext encodes a reply's proposal for its message's hidden context
+func proposalHiddenContext(proposal *OrderProposal) string {
+	if proposal == nil {
+		return ""
+	}
+	value, err := json.Marshal(proposal)
+	if err != nil {
+		return ""
+	}
+	return string(value)
+}
+
+// ListProposals returns the highlight order proposals of a session, oldest first. Pending proposals
+// are marked stale when the project's order has changed since they were made.
+func (s *ChatbotService) ListProposals(projectID int, endpointID, sessionID string, pendingOnly bool) ([]*OrderProposal, error) {
+	session, err := s.sessionQuery(projectID, endpointID, sessionID).First(s.ctx)
+	if err != nil {
+		if ent.IsNotFound(err) {
+			return []*OrderProposal{}, nil
+		}
+		return nil, fmt.Errorf("failed to query chat session: %w", err)
+	}
+
+	messages, err := session.QueryMessages().
+		Where(chatmessage.RoleEQ(chatmessage.RoleAssistant), chatmessage.HiddenContextNEQ("")).
+		Order(ent.Asc(chatmessage.FieldTimestamp), ent.Asc(chatmessage.FieldID)).
+		All(s.ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get chat messages: %w", err)
+	}
+
+	var current []byte
+	proposals := []*OrderProposal{}
+	for _, message := range messages {
+		proposal := proposalFromMessage(message)
+		if proposal == nil || (pendingOnly && proposal.Status != ProposalPending) {
+			continue
+		}
+		if proposal.Status == ProposalPending {
+			if current == nil {
+				order, err := s.currentOrder(projectID)
+				if err != nil {
+					return nil, err
+				}
+				current, _ = json.Marshal(order)
+			}
+			base, _ := json.Marshal(proposal.BaseOrder)
+			proposal.Stale = string(base) != string(current)
+		}
+		proposals = append(proposals, proposal)
+	}
+	return proposals, nil
+}
+
+// ApplyProposal applies a pending highlight order proposal to its project
+func (s *ChatbotService) ApplyProposal(proposalID string) (*OrderProposal, error) {
+	return s.resolveProposal(proposalID, ProposalApplied)
+}
+
+// RejectProposal discards a pending highlight order proposal
+func (s *ChatbotService) RejectProposal(proposalID string) (*OrderProposal, error) {
+	return s.resolveProposal(proposalID, ProposalRejected)
+}
+
+// resolveProposal applies or rejects a pending proposal and records the outcome on its message
+func (s *ChatbotService) resolveProposal(proposalID, status string) (*OrderProposal, error) {
+	message, err := s.client.ChatMessage.
+		Query().
+		Where(chatmessage.MessageID(proposalID)).
+		WithSession().
+		Only(s.ctx)
+	if err != nil {
+		if ent.IsNotFound(err) {
+			return nil, fmt.Errorf("proposal %s not found", proposalID)
+		}
+		return nil, fmt.Errorf("failed to get proposal: %w", err)
+	}
+
+	proposal := proposalFromMessage(message)
+	if proposal == nil {
+		return nil, fmt.Errorf("message %s does not carry a proposal", proposalID)
+	}
+	if proposal.Status != ProposalPending {
+		return nil, fmt.Errorf("proposal %s is already %s", proposalID, proposal.Status)
+	}
+
+	if status == ProposalApplied {
+		if s.updateOrderFunc == nil {
+			return nil, fmt.Errorf("highlight order updates are not available")
+		}
+		current, err := s.currentOrder(message.Edges.Session.ProjectID)
+		if err != nil {
+			return nil, err
+		}
+		base, _ := json.Marshal(proposal.BaseOrder)
+		if order, _ := json.Marshal(current); string(base) != string(order) {
+			return nil, fmt.Errorf("proposal %s is stale: the highlight order has changed since it was made", proposalID)
+		}
+		if err := s.updateOrderFunc(message.Edges.Session.ProjectID, proposal.NewOrder); err != nil {
+			return nil, fmt.Errorf("failed to apply proposal: %w", err)
+		}
+	}
+
+	now := time.Now()
+	proposal.Status = status
+	proposal.ResolvedAt = &now
+	proposal.Stale = false
+	if err := message.Update().SetHiddenContext(proposalHiddenContext(proposal)).Exec(s.ctx); err != nil {
+		return nil, fmt.Errorf("failed to save proposal: %w", err)
+	}
+	return proposal, nil
+}
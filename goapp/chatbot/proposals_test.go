@@ -0,0 +1,165 @@
+package chatbot
+
+import (
+	"encoding/json"
+	"net/http"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"ramble-ai/goapp"
+	"ramble-ai/goapp/projects"
+)
+
+// toolCallChunk is a streamed completion chunk calling one function
+func toolCallChunk(t *testing.T, name string, args map[string]interface{}) string {
+	arguments, err := json.Marshal(args)
+	require.NoError(t, err)
+	chunk, err := json.Marshal(map[string]interface{}{
+		"choices": []interface{}{map[string]interface{}{
+			"delta": map[string]interface{}{
+				"tool_calls": []interface{}{map[string]interface{}{
+					"index": 0, "id": "call_1", "type": "function",
+					"function": map[string]interface{}{"name": name, "arguments": string(arguments)},
+				}},
+			},
+		}},
+	})
+	require.NoError(t, err)
+	return string(chunk)
+}
+
+func TestDiffOrders(t *testing.T) {
+	base := []interface{}{"a", "b", map[string]interface{}{"type": "N", "title": "Intro"}, "c", "d", "N"}
+	proposed := []interface{}{map[string]interface{}{"type": "N", "title": "Hook"}, "c", "a", "b", "e", "N"}
+
+	diff := diffOrders(base, proposed)
+	assert.Equal(t, []MovedHighlight{{ID: "c", From: 3, To: 1}}, diff.Moved, "a and b keep their relative order")
+	assert.Equal(t, []string{"d"}, diff.Hidden)
+	assert.Equal(t, []string{"e"}, diff.Added)
+	assert.Equal(t, []string{"Hook"}, diff.SectionsAdded)
+	assert.Equal(t, []string{"Intro"}, diff.SectionsRemoved)
+	assert.False(t, diff.IsEmpty())
+
+	assert.True(t, diffOrders(base, base).IsEmpty())
+	assert.Contains(t, describeProposal(&OrderProposal{Diff: diff}), `New sections: "Hook"`)
+}
+
+func TestOrderProposals(t *testing.T) {
+	helper := goapp.NewTestHelper(t)
+	project := helper.CreateTestProject("proposal-project")
+	clip := helper.CreateTestVideoClip(project, "interview")
+	first := helper.CreateTestHighlight(clip, 0, 5)
+	second := helper.CreateTestHighlight(clip, 10, 15)
+	third := helper.CreateTestHighlight(clip, 20, 25)
+
+	projectService := projects.NewProjectService(helper.Client, helper.Ctx)
+	service := NewChatbotService(helper.Client, helper.Ctx, projectService.UpdateProjectHighlightOrderWithTitles)
+	order := func() []interface{} {
+		current, err := projectService.GetProjectHighlightOrderWithTitles(project.ID)
+		require.NoError(t, err)
+		return current
+	}
+
+	var toolCall string
+	useStreamingServer(t, helper, func(w http.ResponseWriter, r *http.Request) {
+		writeChunk(w, toolCall)
+		writeChunk(w, "[DONE]")
+	})
+	send := func(proposeChanges bool) *ChatResponse {
+		response, err := service.SendMessage(ChatRequest{ProjectID: project.ID, EndpointID: "general", Message: "Put the payoff first", EnableFunctionCalls: true, ProposeChanges: proposeChanges}, noAPIKey)
+		require.NoError(t, err)
+		require.True(t, response.Success, response.Error)
+		return response
+	}
+
+	newOrder := []interface{}{third, map[string]interface{}{"type": "N", "title": "Setup"}, first, second}
+	toolCall = toolCallChunk(t, "reorder_highlights", map[string]interface{}{"new_order": newOrder, "reason": "Payoff first"})
+
+	response := send(true)
+	require.NotNil(t, response.Proposal)
+	proposal := response.Proposal
+	assert.Equal(t, response.MessageID, proposal.ID)
+	assert.Equal(t, "reorder_highlights", proposal.Action)
+	assert.Equal(t, "Payoff first", proposal.Reason)
+	assert.Equal(t, ProposalPending, proposal.Status)
+	assert.Equal(t, []MovedHighlight{{ID: third, From: 3, To: 1}}, proposal.Diff.Moved)
+	assert.Equal(t, []string{"Setup"}, proposal.Diff.SectionsAdded)
+	assert.Contains(t, response.Message, "Proposed changes")
+	assert.Equal(t, true, response.FunctionResults[0].Result.(map[string]interface{})["proposed"])
+	assert.Empty(t, order(), "nothing is applied before approval")
+
+	// The proposal is persisted with the reply
+	history, err := service.GetChatHistory(project.ID, "general", response.SessionID)
+	require.NoError(t, err)
+	reply := history.Messages[len(history.Messages)-1]
+	require.NotNil(t, reply.Proposal)
+	assert.Equal(t, proposal.ID, reply.Proposal.ID)
+	assert.Nil(t, history.Messages[0].Proposal)
+
+	proposals, err := service.ListProposals(project.ID, "general", response.SessionID, true)
+	require.NoError(t, err)
+	require.Len(t, proposals, 1)
+	assert.False(t, proposals[0].Stale)
+
+	applied, err := service.ApplyProposal(proposal.ID)
+	require.NoError(t, err)
+	assert.Equal(t, ProposalApplied, applied.Status)
+	assert.NotNil(t, applied.ResolvedAt)
+	assert.Equal(t, []interface{}{third, map[string]interface{}{"title": "Setup", "type": "N"}, first, second}, order())
+	_, err = service.ApplyProposal(proposal.ID)
+	assert.Error(t, err, "proposals are applied once")
+
+	// A reset proposal goes stale when the order changes, and rejecting it keeps the order
+	toolCall = toolCallChunk(t, "reset_to_original", map[string]interface{}{})
+	reset := send(true).Proposal
+	require.NotNil(t, reset)
+	assert.Equal(t, []MovedHighlight{{ID: third, From: 1, To: 3}}, reset.Diff.Moved)
+	assert.Equal(t, []string{"Setup"}, reset.Diff.SectionsRemoved)
+
+	require.NoError(t, projectService.UpdateProjectHighlightOrderWithTitles(project.ID, []interface{}{second, first, third}))
+	proposals, err = service.ListProposals(project.ID, "general", response.SessionID, true)
+	require.NoError(t, err)
+	require.Len(t, proposals, 1)
+	assert.True(t, proposals[0].Stale)
+	_, err = service.ApplyProposal(reset.ID)
+	assert.ErrorContains(t, err, "stale")
+	assert.Equal(t, []interface{}{second, first, third}, order(), "stale proposals are not applied")
+
+	rejected, err := service.RejectProposal(reset.ID)
+	require.NoError(t, err)
+	assert.Equal(t, ProposalRejected, rejected.Status)
+	assert.Equal(t, []interface{}{second, first, third}, order())
+
+	proposals, err = service.ListProposals(project.ID, "general", response.SessionID, false)
+	require.NoError(t, err)
+	require.Len(t, proposals, 2)
+	assert.Equal(t, ProposalApplied, proposals[0].Status)
+	assert.Equal(t, ProposalRejected, proposals[1].Status)
+
+	_, err = service.ApplyProposal(history.Messages[0].ID)
+	assert.Error(t, err, "user messages carry no proposal")
+
+	// Without proposals the order is applied right away
+	toolCall = toolCallChunk(t, "reorder_highlights", map[string]interface{}{"new_order": []interface{}{first, second, third}})
+	response = send(false)
+	assert.Nil(t, response.Proposal)
+	assert.Equal(t, []interface{}{first, second, third}, order())
+
+	// Forks copy pending proposals as superseded, leaving the original to be applied
+	toolCall = toolCallChunk(t, "reorder_highlights", map[string]interface{}{"new_order": []interface{}{third, second, first}})
+	pending := send(true).Proposal
+	require.NotNil(t, pending)
+	fork, err := service.ForkSession(response.SessionID, pending.ID)
+	require.NoError(t, err)
+	forked, err := service.ListProposals(project.ID, "general", fork.SessionID, false)
+	require.NoError(t, err)
+	require.Len(t, forked, 3)
+	assert.Equal(t, ProposalSuperseded, forked[2].Status)
+	_, err = service.ApplyProposal(forked[2].ID)
+	assert.Error(t, err)
+	_, err = service.ApplyProposal(pending.ID)
+	require.NoError(t, err)
+	assert.Equal(t, []interface{}{third, second, first}, order())
+}
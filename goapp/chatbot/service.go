@@ -12,7 +12,9 @@ import (
 	"ramble-ai/ent"
 	"ramble-ai/ent/chatmessage"
 	"ramble-ai/ent/chatsession"
+	"ramble-ai/goapp/ai"
 	"ramble-ai/goapp/highlights"
+	"ramble-ai/goapp/prompts"
 	"ramble-ai/goapp/realtime"
 )
 
@@ -27,15 +29,18 @@ func NewChatbotService(client *ent.Client, ctx context.Context, updateOrderFunc
 		updateOrderFunc:         updateOrderFunc,
 		mcpRegistry:             NewMCPRegistry(),
 		conversationFlowManager: NewConversationFlowManager(),
+		customTools:             make(map[string]bool),
 	}
 
 	// Register available functions (legacy - will be replaced by MCP registry)
 	s.registerFunctions()
+	s.registerCustomTools()
 
 	return s
 }
 
-// findOrCreateSession finds an existing session or creates a new one
+// findOrCreateSession finds a session by ID, or the most recently active session of the project
+// endpoint when sessionID is empty, creating it if it does not exist
 func (s *ChatbotService) findOrCreateSession(projectID int, endpointID, sessionID string) (*ent.ChatSession, error) {
 	// Try to find existing session by session ID if provided
 	if sessionID != "" {
@@ -44,37 +49,34 @@ func (s *ChatbotService) findOrCreateSession(projectID int, endpointID, sessionI
 			Where(chatsession.SessionID(sessionID)).
 			Only(s.ctx)
 		if err == nil {
+			if session.ProjectID != projectID || session.EndpointID != endpointID {
+				return nil, fmt.Errorf("session %s belongs to another project or endpoint", sessionID)
+			}
 			return session, nil
 		}
 		if !ent.IsNotFound(err) {
 			return nil, fmt.Errorf("failed to query session by ID: %w", err)
 		}
-	}
-
-	// Try to find existing session by project/endpoint
-	session, err := s.client.ChatSession.
-		Query().
-		Where(
-			chatsession.ProjectID(projectID),
-			chatsession.EndpointID(endpointID),
-		).
-		Only(s.ctx)
-	if err == nil {
-		return session, nil
-	}
-	if !ent.IsNotFound(err) {
-		return nil, fmt.Errorf("failed to query session by project/endpoint: %w", err)
+	} else {
+		// Try to find the latest session by project/endpoint
+		session, err := s.latestSession(projectID, endpointID)
+		if err == nil {
+			return session, nil
+		}
+		if !ent.IsNotFound(err) {
+			return nil, fmt.Errorf("failed to query session by project/endpoint: %w", err)
+		}
 	}
 
 	// Create new session
-	newSessionID := sessionID
-	if newSessionID == "" {
-		newSessionID = fmt.Sprintf("session_%d_%s_%d", projectID, endpointID, time.Now().Unix())
+	newID := sessionID
+	if newID == "" {
+		newID = newSessionID(projectID, endpointID)
 	}
 
 	return s.client.ChatSession.
 		Create().
-		SetSessionID(newSessionID).
+		SetSessionID(newID).
 		SetProjectID(projectID).
 		SetEndpointID(endpointID).
 		Save(s.ctx)
@@ -97,8 +99,12 @@ func (s *ChatbotService) persistMessage(session *ent.ChatSession, messageID, rol
 		msgCreate = msgCreate.SetModel(model)
 	}
 
-	_, err := msgCreate.Save(s.ctx)
-	return err
+	if _, err := msgCreate.Save(s.ctx); err != nil {
+		return err
+	}
+
+	// Keep the session's activity time current for the session list
+	return s.client.ChatSession.UpdateOneID(session.ID).SetUpdatedAt(time.Now()).Exec(s.ctx)
 }
 
 // SendMessage handles sending a message and getting an AI response
@@ -116,6 +122,20 @@ func (s *ChatbotService) SendMessage(req ChatRequest, getAPIKey func() (string,
 		}, nil
 	}
 
+	// Render the selected library prompt before anything is persisted
+	if req.PromptID != 0 {
+		instructions, err := prompts.NewPromptService(s.client, s.ctx).ResolvePrompt(req.Selection, prompts.ChatTaskType(req.EndpointID), req.ProjectID)
+		if err != nil {
+			return &ChatResponse{
+				SessionID: req.SessionID,
+				MessageID: messageID,
+				Success:   false,
+				Error:     err.Error(),
+			}, nil
+		}
+		req.instructions = instructions
+	}
+
 	// Find or create session for persistence
 	session, err := s.findOrCreateSession(req.ProjectID, req.EndpointID, req.SessionID)
 	if err != nil {
@@ -139,6 +159,15 @@ func (s *ChatbotService) SendMessage(req ChatRequest, getAPIKey func() (string,
 		}
 	}
 
+	// Title untitled sessions after their first message
+	if session.Title == "" {
+		if titled, err := session.Update().SetTitle(sessionTitle(req.Message)).Save(s.ctx); err == nil {
+			session = titled
+		} else {
+			log.Printf("Failed to set session title: %v", err)
+		}
+	}
+
 	// Generate user message ID and persist user message
 	userMessageID := fmt.Sprintf("user_%d", time.Now().UnixNano())
 	err = s.persistMessage(session, userMessageID, "user", req.Message, "", "")
@@ -166,8 +195,13 @@ func (s *ChatbotService) SendMessage(req ChatRequest, getAPIKey func() (string,
 	supportsActions := s.mcpRegistry.SupportsActions(req.EndpointID)
 
 	// Use MCP-based action flow if endpoint supports it
-	if supportsActions {
+	if s.mcpRegistry.UsesConversationFlow(req.EndpointID) {
 		response, responseErr = s.sendMessageWithMCPActions(req, messageID, getAPIKey, session)
+	} else if supportsActions {
+		// Endpoints whose functions act directly are called with them as tools
+		response, responseErr = s.sendMessageWithEndpointFunctions(req, messageID, getAPIKey, session)
+	} else if req.EnableFunctionCalls {
+		response, responseErr = s.sendMessageWithFunctions(req, messageID, getAPIKey, session)
 	} else {
 		// Otherwise, send regular chat message
 		response, responseErr = s.sendRegularMessage(req, messageID, getAPIKey, session)
@@ -176,17 +210,24 @@ func (s *ChatbotService) SendMessage(req ChatRequest, getAPIKey func() (string,
 	// Persist and broadcast AI response if successful
 	if responseErr == nil && response.Success && response.Message != "" {
 		// Persist AI message with model info
-		persistErr := s.persistMessage(session, response.MessageID, "assistant", response.Message, "", response.Model)
+		persistErr := s.persistMessage(session, response.MessageID, "assistant", response.Message, proposalHiddenContext(response.Proposal), response.Model)
 		if persistErr != nil {
 			log.Printf("Failed to persist AI message: %v", persistErr)
 			// Continue without failing - this is a non-critical error
 		}
+		orderSnapshot, recordErr := s.recordReplyActions(req.ProjectID, response)
+		if recordErr != nil {
+			log.Printf("[CHATBOT] Failed to record reply actions: %v", recordErr)
+		}
 
 		aiMessage := ChatMessage{
-			ID:        response.MessageID,
-			Role:      "assistant",
-			Content:   response.Message,
-			Timestamp: time.Now(),
+			ID:              response.MessageID,
+			Role:            "assistant",
+			Content:         response.Message,
+			Timestamp:       time.Now(),
+			Proposal:        response.Proposal,
+			FunctionResults: response.FunctionResults,
+			OrderSnapshot:   orderSnapshot,
 		}
 
 		manager.BroadcastChatMessageAdded(projectIDStr, req.EndpointID, session.SessionID, aiMessage)
@@ -198,13 +239,13 @@ func (s *ChatbotService) SendMessage(req ChatRequest, getAPIKey func() (string,
 // sendMessageWithFunctions handles LLM requests with function calling enabled
 func (s *ChatbotService) sendMessageWithFunctions(req ChatRequest, messageID string, getAPIKey func() (string, error), session *ent.ChatSession) (*ChatResponse, error) {
 	// Get API key
-	apiKey, err := getAPIKey()
-	if err != nil || apiKey == "" {
+	apiKey, err := ai.NewProviderService(s.client, s.ctx).TextAPIKey("chat", getAPIKey)
+	if err != nil {
 		return &ChatResponse{
 			SessionID: session.SessionID,
 			MessageID: messageID,
 			Success:   false,
-			Error:     "OpenRouter API key not configured",
+			Error:     err.Error(),
 		}, nil
 	}
 
@@ -236,24 +277,19 @@ When suggesting reorders, consider:
 
 Always explain your reasoning when reordering highlights.`, context)
 
-	// Create OpenRouter request with function calling
-	openRouterReq := map[string]interface{}{
-		"model": req.Model,
-		"messages": []map[string]interface{}{
-			{
-				"role":    "system",
-				"content": systemPrompt,
-			},
-			{
-				"role":    "user",
-				"content": req.Message,
-			},
+	messages := []map[string]interface{}{
+		{
+			"role":    "system",
+			"content": systemPrompt,
+		},
+		{
+			"role":    "user",
+			"content": req.Message,
 		},
-		"tools": s.buildToolDefinitions(),
 	}
 
-	// Call OpenRouter API
-	llmResponse, err := s.callOpenRouterAPI(apiKey, openRouterReq)
+	// Stream the reply; tool calls are assembled from their streamed fragments
+	llmResponse, err := s.streamCompletion(req, session.SessionID, messageID, apiKey, messages, s.buildToolDefinitions())
 	if err != nil {
 		return &ChatResponse{
 			SessionID: session.SessionID,
@@ -272,38 +308,29 @@ Always explain your reasoning when reordering highlights.`, context)
 	}
 
 	// Extract message and tool calls from LLM response
-	if content, ok := llmResponse["content"].(string); ok {
-		response.Message = content
+	response.Message = llmResponse.Content
+
+	// Never run tool calls of a reply that was cut short
+	if llmResponse.Cancelled {
+		response.Cancelled = true
+		if response.Message == "" {
+			response.Success = false
+			response.Error = "Response cancelled"
+		}
+		return response, nil
 	}
 
 	// Process function calls if present
-	if toolCalls, ok := llmResponse["tool_calls"].([]interface{}); ok {
+	if toolCalls := toolCallMaps(llmResponse.ToolCalls); len(toolCalls) > 0 {
 		var functionResults []FunctionExecutionResult
 
 		for _, toolCall := range toolCalls {
 			if toolCallMap, ok := toolCall.(map[string]interface{}); ok {
 				result := s.executeFunctionCall(toolCallMap, req.ProjectID)
-				functionResults = append(functionResults, result)
 
-				// If the function result requires applying an order, do it now
-				if result.Success && result.Result != nil {
-					if resultMap, ok := result.Result.(map[string]interface{}); ok {
-						if applyRequired, ok := resultMap["apply_required"].(bool); ok && applyRequired {
-							if newOrder, ok := resultMap["new_order"].([]interface{}); ok && s.updateOrderFunc != nil {
-								if err := s.updateOrderFunc(req.ProjectID, newOrder); err != nil {
-									result.Success = false
-									result.Error = fmt.Sprintf("Failed to apply order: %v", err)
-								} else {
-									// Update the result message to indicate successful application
-									result.Message = "Function executed and order applied successfully"
-									if resultMap["message"] != nil {
-										resultMap["message"] = fmt.Sprintf("%s (Applied to database)", resultMap["message"])
-									}
-								}
-							}
-						}
-					}
-				}
+				// If the function result requires applying an order, do it now or propose it
+				s.settlePendingOrder(&result, req, response)
+				functionResults = append(functionResults, result)
 			}
 		}
 
@@ -313,12 +340,117 @@ Always explain your reasoning when reordering highlights.`, context)
 	return response, nil
 }
 
+// sendMessageWithEndpointFunctions handles chat for endpoints whose MCP functions the LLM calls
+// directly, streaming the reply and then running the tool calls it made
+func (s *ChatbotService) sendMessageWithEndpointFunctions(req ChatRequest, messageID string, getAPIKey func() (string, error), session *ent.ChatSession) (*ChatResponse, error) {
+	apiKey, err := ai.NewProviderService(s.client, s.ctx).TextAPIKey("chat", getAPIKey)
+	if err != nil {
+		return &ChatResponse{
+			SessionID: session.SessionID,
+			MessageID: messageID,
+			Success:   false,
+			Error:     err.Error(),
+		}, nil
+	}
+
+	config, _ := s.mcpRegistry.GetEndpointConfig(req.EndpointID)
+	tools, err := s.mcpRegistry.GetFunctionsForEndpoint(req.EndpointID)
+	if err != nil {
+		return nil, err
+	}
+
+	projectContext, err := s.mcpRegistry.BuildContextForEndpoint(req.EndpointID, req.ProjectID, s)
+	if err != nil {
+		log.Printf("Failed to build context for %s: %v", req.EndpointID, err)
+		projectContext = "Project context unavailable."
+	}
+
+	systemPrompt := fmt.Sprintf("%s\n\nCurrent project context:\n%s", config.SystemPrompt, projectContext)
+	if req.instructions != "" {
+		systemPrompt += "\n\nADDITIONAL INSTRUCTIONS:\n" + req.instructions
+	}
+	messages := []map[string]interface{}{
+		{"role": "system", "content": systemPrompt},
+		{"role": "user", "content": req.Message},
+	}
+
+	llmResponse, err := s.streamCompletion(req, session.SessionID, messageID, apiKey, messages, tools)
+	if err != nil {
+		return &ChatResponse{
+			SessionID: session.SessionID,
+			MessageID: messageID,
+			Success:   false,
+			Error:     fmt.Sprintf("LLM API call failed: %v", err),
+		}, nil
+	}
+
+	response := &ChatResponse{
+		SessionID: session.SessionID,
+		MessageID: messageID,
+		Model:     req.Model,
+		Success:   true,
+		Message:   llmResponse.Content,
+	}
+
+	// Never run tool calls of a reply that was cut short
+	if llmResponse.Cancelled {
+		response.Cancelled = true
+		if response.Message == "" {
+			response.Success = false
+			response.Error = "Response cancelled"
+		}
+		return response, nil
+	}
+
+	for _, toolCall := range toolCallMaps(llmResponse.ToolCalls) {
+		result := s.executeMCPFunctionCall(toolCall.(map[string]interface{}), req.ProjectID, req.EndpointID)
+		response.FunctionResults = append(response.FunctionResults, result)
+		if result.Success {
+			response.ActionsPerformed = append(response.ActionsPerformed, result.FunctionName)
+		}
+	}
+
+	if len(response.FunctionResults) > 0 {
+		response.HasActions = len(response.ActionsPerformed) > 0
+		summary, err := s.generateActionSummary(response.ActionsPerformed, successfulResults(response.FunctionResults), req.EndpointID, req.Message)
+		if err == nil {
+			response.ActionSummary = summary
+		}
+		for _, result := range response.FunctionResults {
+			if !result.Success {
+				response.ActionSummary += fmt.Sprintf("\n\n⚠️ %s failed: %s", result.FunctionName, result.Error)
+			}
+		}
+		if response.Message == "" {
+			response.Message = strings.TrimSpace(response.ActionSummary)
+		}
+	}
+
+	if response.Message == "" {
+		response.Message = "I couldn't generate a proper response. Please try again."
+		response.Success = false
+	}
+
+	return response, nil
+}
+
+// successfulResults returns the function results that succeeded, matching the actions performed
+func successfulResults(results []FunctionExecutionResult) []FunctionExecutionResult {
+	var successful []FunctionExecutionResult
+	for _, result := range results {
+		if result.Success {
+			successful = append(successful, result)
+		}
+	}
+	return successful
+}
+
 // sendRegularMessage handles regular chat without function calling
 func (s *ChatbotService) sendRegularMessage(req ChatRequest, messageID string, getAPIKey func() (string, error), session *ent.ChatSession) (*ChatResponse, error) {
 	// Skip getting chat history since we're not using it
 	// This improves performance by avoiding database queries
 	/*
-		history, err := s.GetChatHistory(req.ProjectID, req.EndpointID)
+		history, err := s.GetChatHistory(req.ProjectID, req.EndpointID, session.SessionID)
 		if err != nil {
 			log.Printf("Failed to get chat history: %v", err)
 			// Continue with empty history
@@ -334,6 +466,9 @@ func (s *ChatbotService) sendRegularMessage(req ChatRequest, messageID string, g
 
 	// Add system message based on endpoint
 	systemMessage := s.getSystemPromptForEndpoint(req.EndpointID, req.ContextData)
+	if req.instructions != "" {
+		systemMessage += "\n\nADDITIONAL INSTRUCTIONS:\n" + req.instructions
+	}
 	messages = append(messages, map[string]interface{}{
 		"role":    "system",
 		"content": systemMessage,
@@ -364,25 +499,19 @@ func (s *ChatbotService) sendRegularMessage(req ChatRequest, messageID string, g
 		"content": req.Message,
 	})
 
-	// Create OpenRouter request
-	openRouterReq := map[string]interface{}{
-		"model":    req.Model,
-		"messages": messages,
-	}
-
 	// Get API key
-	apiKey, err := getAPIKey()
-	if err != nil || apiKey == "" {
+	apiKey, err := ai.NewProviderService(s.client, s.ctx).TextAPIKey("chat", getAPIKey)
+	if err != nil {
 		return &ChatResponse{
 			SessionID: session.SessionID,
 			MessageID: messageID,
 			Success:   false,
-			Error:     "OpenRouter API key not configured",
+			Error:     err.Error(),
 		}, nil
 	}
 
-	// Call OpenRouter API
-	aiResponse, err := s.callOpenRouterAPI(apiKey, openRouterReq)
+	// Stream the reply, broadcasting each delta as it arrives
+	aiResponse, err := s.streamCompletion(req, session.SessionID, messageID, apiKey, messages, nil)
 	if err != nil {
 		return &ChatResponse{
 			SessionID: session.SessionID,
@@ -398,10 +527,14 @@ func (s *ChatbotService) sendRegularMessage(req ChatRequest, messageID string, g
 		MessageID: messageID,
 		Model:     req.Model,
 		Success:   true,
+		Cancelled: aiResponse.Cancelled,
 	}
 
-	if content, ok := aiResponse["content"].(string); ok {
-		response.Message = content
+	if aiResponse.Content != "" {
+		response.Message = aiResponse.Content
+	} else if aiResponse.Cancelled {
+		response.Success = false
+		response.Error = "Response cancelled"
 	} else {
 		response.Message = "I couldn't generate a proper response. Please try again."
 		response.Success = false
@@ -538,37 +671,35 @@ Focus on being helpful, accurate, and easy to understand.`
 	}
 }
 
-// GetChatHistory retrieves chat history for a project/endpoint
-func (s *ChatbotService) GetChatHistory(projectID int, endpointID string) (*ChatHistoryResponse, error) {
-	return s.GetChatHistoryWithLimit(projectID, endpointID, 0) // 0 = no limit
+// GetChatHistory retrieves the chat history of a session, or of the project endpoint's most recently
+// active session when sessionID is empty
+func (s *ChatbotService) GetChatHistory(projectID int, endpointID, sessionID string) (*ChatHistoryResponse, error) {
+	return s.GetChatHistoryWithLimit(projectID, endpointID, sessionID, 0) // 0 = no limit
 }
 
 // GetChatHistoryWithLimit retrieves chat history with optional message limit for performance
-func (s *ChatbotService) GetChatHistoryWithLimit(projectID int, endpointID string, limit int) (*ChatHistoryResponse, error) {
-	// Find existing chat session for this project/endpoint
-	session, err := s.client.ChatSession.
-		Query().
-		Where(
-			chatsession.ProjectID(projectID),
-			chatsession.EndpointID(endpointID),
-		).
+func (s *ChatbotService) GetChatHistoryWithLimit(projectID int, endpointID, sessionID string, limit int) (*ChatHistoryResponse, error) {
+	// Find the chat session
+	session, err := s.sessionQuery(projectID, endpointID, sessionID).
 		WithMessages(func(q *ent.ChatMessageQuery) {
-			q.Order(ent.Asc("timestamp"))
+			q.Where(notSummaryMessage()).Order(ent.Asc("timestamp"))
 			if limit > 0 {
 				// Get most recent messages by ordering desc first, limiting, then we'll reverse
 				q.Order(ent.Desc("timestamp")).Limit(limit)
 			}
 		}).
-		Only(s.ctx)
+		First(s.ctx)
 
-	var sessionID string
 	var messages []ChatMessage
 	var selectedModel string
+	var title string
 
 	if err != nil {
 		if ent.IsNotFound(err) {
-			// Session doesn't exist yet, return empty history with generated session ID
-			sessionID = fmt.Sprintf("session_%d_%s_%d", projectID, endpointID, time.Now().Unix())
+			// Session doesn't exist yet, return empty history with the session ID it will be created with
+			if sessionID == "" {
+				sessionID = newSessionID(projectID, endpointID)
+			}
 			messages = []ChatMessage{}
 			// session is nil, so no selected model
 		} else {
@@ -578,6 +709,7 @@ func (s *ChatbotService) GetChatHistoryWithLimit(projectID int, endpointID strin
 		// Session exists, convert messages
 		sessionID = session.SessionID
 		selectedModel = session.SelectedModel
+		title = session.Title
 		entMessages := session.Edges.Messages
 
 		// If we used a limit, messages are in desc order, so reverse them
@@ -591,16 +723,20 @@ func (s *ChatbotService) GetChatHistoryWithLimit(projectID int, endpointID strin
 		messages = make([]ChatMessage, len(entMessages))
 		for i, msg := range entMessages {
 			messages[i] = ChatMessage{
-				ID:        msg.MessageID,
-				Role:      string(msg.Role),
-				Content:   msg.Content,
-				Timestamp: msg.Timestamp,
+				ID:              msg.MessageID,
+				Role:            string(msg.Role),
+				Content:         msg.Content,
+				Timestamp:       msg.Timestamp,
+				Proposal:        proposalFromMessage(msg),
+				FunctionResults: msg.FunctionResults,
+				OrderSnapshot:   msg.OrderSnapshot,
 			}
 		}
 	}
 
 	response := &ChatHistoryResponse{
 		SessionID: sessionID,
+		Title:     title,
 		Messages:  messages,
 	}
 
@@ -613,9 +749,9 @@ func (s *ChatbotService) GetChatHistoryWithLimit(projectID int, endpointID strin
 }
 
 // SaveModelSelection saves the selected model for a chat session
-func (s *ChatbotService) SaveModelSelection(projectID int, endpointID string, model string) error {
+func (s *ChatbotService) SaveModelSelection(projectID int, endpointID, sessionID, model string) error {
 	// Find or create session
-	session, err := s.findOrCreateSession(projectID, endpointID, "")
+	session, err := s.findOrCreateSession(projectID, endpointID, sessionID)
 	if err != nil {
 		return fmt.Errorf("failed to find or create session: %w", err)
 	}
@@ -631,23 +767,18 @@ func (s *ChatbotService) SaveModelSelection(projectID int, endpointID string, mo
 	return nil
 }
 
-// ClearChatHistory clears chat history for a project/endpoint
-func (s *ChatbotService) ClearChatHistory(projectID int, endpointID string) error {
-	// Find existing chat session for this project/endpoint
-	session, err := s.client.ChatSession.
-		Query().
-		Where(
-			chatsession.ProjectID(projectID),
-			chatsession.EndpointID(endpointID),
-		).
-		Only(s.ctx)
-
-	var sessionID string
+// ClearChatHistory clears the messages of a session, or of the project endpoint's most recently
+// active session when sessionID is empty
+func (s *ChatbotService) ClearChatHistory(projectID int, endpointID, sessionID string) error {
+	// Find the chat session
+	session, err := s.sessionQuery(projectID, endpointID, sessionID).First(s.ctx)
 
 	if err != nil {
 		if ent.IsNotFound(err) {
 			// No session exists, nothing to clear but generate session ID for broadcast
-			sessionID = fmt.Sprintf("session_%d_%s_%d", projectID, endpointID, time.Now().Unix())
+			if sessionID == "" {
+				sessionID = newSessionID(projectID, endpointID)
+			}
 		} else {
 			return fmt.Errorf("failed to query chat session: %w", err)
 		}
@@ -699,6 +830,7 @@ func (s *ChatbotService) handleConversationPhase(req ChatRequest, messageID stri
 
 	// Create conversation agent
 	conversationAgent := NewConversationAgent(req.EndpointID, s.mcpRegistry)
+	conversationAgent.instructions = req.instructions
 
 	// Process conversation
 	result, err := conversationAgent.ProcessConversation(req.Message, flow, getAPIKey, s, req.ProjectID)
@@ -753,13 +885,13 @@ func (s *ChatbotService) handleExecutionPhaseWithSummary(req ChatRequest, messag
 	broadcaster.UpdateProgress("processing", s.getProgressMessageForIntent(summary.Intent))
 
 	// Get API key
-	apiKey, err := getAPIKey()
-	if err != nil || apiKey == "" {
+	apiKey, err := ai.NewProviderService(s.client, s.ctx).TextAPIKey("chat", getAPIKey)
+	if err != nil {
 		return &ChatResponse{
 			SessionID: session.SessionID,
 			MessageID: messageID,
 			Success:   false,
-			Error:     "OpenRouter API key not configured",
+			Error:     err.Error(),
 		}, nil
 	}
 
@@ -774,10 +906,11 @@ func (s *ChatbotService) handleExecutionPhaseWithSummary(req ChatRequest, messag
 		},
 		"temperature": 0.3, // Lower temperature for precise, structured output
 		"max_tokens":  4000,
+		"projectID":   req.ProjectID, // metering only, not sent to the API
 	}
 
-	// Call OpenRouter API
-	aiResponse, err := s.callOpenRouterAPI(apiKey, openRouterReq)
+	// Call OpenRouter API, re-prompting on malformed output
+	structuredOutput, err := s.completeStructuredExecution(apiKey, openRouterReq, completePrompt, -1)
 	if err != nil {
 		broadcaster.UpdateProgress("error", "AI processing failed")
 		return &ChatResponse{
@@ -788,36 +921,22 @@ func (s *ChatbotService) handleExecutionPhaseWithSummary(req ChatRequest, messag
 		}, nil
 	}
 
-	// Extract content from response
-	content, ok := aiResponse["content"].(string)
-	if !ok {
-		broadcaster.UpdateProgress("error", "Invalid AI response format")
-		return &ChatResponse{
-			SessionID: session.SessionID,
-			MessageID: messageID,
-			Success:   false,
-			Error:     "AI response missing content",
-		}, nil
-	}
-
-	broadcaster.UpdateProgress("parsing", "Parsing structured response...")
-
-	// Parse structured output
-	structuredOutput, err := ParseStructuredExecutionOutput(content)
-	if err != nil {
-		broadcaster.UpdateProgress("error", "Failed to parse AI response")
-		return &ChatResponse{
-			SessionID: session.SessionID,
-			MessageID: messageID,
-			Success:   false,
-			Error:     fmt.Sprintf("Failed to parse structured output: %v", err),
-		}, nil
-	}
-
-	broadcaster.UpdateProgress("applying", "Applying changes to your project...")
-
-	// Step 3: Apply results using existing update function
-	if structuredOutput.Success && summary.Intent != "analyze" {
+	// Step 3: Apply results using existing update function, or propose them for approval
+	var proposal *OrderProposal
+	if structuredOutput.Success && summary.Intent != "analyze" && req.ProposeChanges {
+		broadcaster.UpdateProgress("proposing", "Preparing proposed changes...")
+		proposal, err = s.newOrderProposal(req.ProjectID, messageID, summary.Intent, structuredOutput.Reasoning, structuredOutput.NewOrder)
+		if err != nil {
+			broadcaster.UpdateProgress("error", "Failed to prepare proposal")
+			return &ChatResponse{
+				SessionID: session.SessionID,
+				MessageID: messageID,
+				Success:   false,
+				Error:     fmt.Sprintf("Failed to prepare proposal: %v", err),
+			}, nil
+		}
+	} else if structuredOutput.Success && summary.Intent != "analyze" {
+		broadcaster.UpdateProgress("applying", "Applying changes to your project...")
 		err = s.updateOrderFunc(req.ProjectID, structuredOutput.NewOrder)
 		if err != nil {
 			broadcaster.UpdateProgress("error", "Failed to apply changes")
@@ -841,7 +960,13 @@ func (s *ChatbotService) handleExecutionPhaseWithSummary(req ChatRequest, messag
 		HasActions: summary.Intent != "analyze",
 	}
 
-	if structuredOutput.Success {
+	if proposal != nil {
+		response.HasActions = false
+		attachProposal(response, proposal)
+		if structuredOutput.Reasoning != "" {
+			response.ActionSummary = structuredOutput.Reasoning
+		}
+	} else if structuredOutput.Success {
 		response.Message = s.generateSummaryFromStructuredOutput(summary, structuredOutput)
 		if structuredOutput.Reasoning != "" {
 			response.ActionSummary = structuredOutput.Reasoning
@@ -969,7 +1094,11 @@ func (s *ChatbotService) generateActionSummary(actionsPerformed []string, functi
 	for i, action := range actionsPerformed {
 		switch action {
 		case "reorder_highlights":
-			summaryBuilder.WriteString(fmt.Sprintf("%d. **Reordered highlights** for better narrative flow\n", i+1))
+			if resultProposed(functionResults, i) {
+				summaryBuilder.WriteString(fmt.Sprintf("%d. **Proposed a new highlight order** for your approval\n", i+1))
+			} else {
+				summaryBuilder.WriteString(fmt.Sprintf("%d. **Reordered highlights** for better narrative flow\n", i+1))
+			}
 
 			// Add details from function result if available
 			if i < len(functionResults) && functionResults[i].Success {
@@ -990,10 +1119,46 @@ func (s *ChatbotService) generateActionSummary(actionsPerformed []string, functi
 			summaryBuilder.WriteString(fmt.Sprintf("%d. **Retrieved current highlight order** for reference\n", i+1))
 
 		case "apply_ai_suggestion":
-			summaryBuilder.WriteString(fmt.Sprintf("%d. **Applied AI suggestion** to improve highlight order\n", i+1))
+			if resultProposed(functionResults, i) {
+				summaryBuilder.WriteString(fmt.Sprintf("%d. **Proposed the AI suggestion** for your approval\n", i+1))
+			} else {
+				summaryBuilder.WriteString(fmt.Sprintf("%d. **Applied AI suggestion** to improve highlight order\n", i+1))
+			}
 
 		case "reset_to_original":
-			summaryBuilder.WriteString(fmt.Sprintf("%d. **Reset highlights** to original order\n", i+1))
+			if resultProposed(functionResults, i) {
+				summaryBuilder.WriteString(fmt.Sprintf("%d. **Proposed resetting highlights** to original order\n", i+1))
+			} else {
+				summaryBuilder.WriteString(fmt.Sprintf("%d. **Reset highlights** to original order\n", i+1))
+			}
+
+		case "search_transcripts":
+			summaryBuilder.WriteString(fmt.Sprintf("%d. **Searched transcripts** for matching moments\n", i+1))
+
+		case "create_highlight", "delete_highlight", "adjust_highlight", "recolor_highlight",
+			"accept_suggested_highlights", "reject_suggested_highlights", "hide_highlight", "unhide_highlight",
+			"save_export_preset", "prepare_export":
+			message := action
+			if i < len(functionResults) {
+				if result, ok := functionResults[i].Result.(map[string]interface{}); ok {
+					if resultMessage, ok := result["message"].(string); ok {
+						message = resultMessage
+					}
+				}
+			}
+			summaryBuilder.WriteString(fmt.Sprintf("%d. **%s**\n", i+1, message))
+
+		case "list_highlights":
+			summaryBuilder.WriteString(fmt.Sprintf("%d. **Listed highlights** and suggestions\n", i+1))
+
+		case "inspect_export":
+			summaryBuilder.WriteString(fmt.Sprintf("%d. **Inspected export** clips, highlights and presets\n", i+1))
+
+		case "recommend_export_settings":
+			summaryBuilder.WriteString(fmt.Sprintf("%d. **Recommended export settings**\n", i+1))
+
+		case "get_export_progress":
+			summaryBuilder.WriteString(fmt.Sprintf("%d. **Checked export progress**\n", i+1))
 
 		default:
 			summaryBuilder.WriteString(fmt.Sprintf("%d. **Performed action:** %s\n", i+1, action))
@@ -166,7 +166,7 @@ func TestChatbotService_GetChatHistory(t *testing.T) {
 	require.NoError(t, err)
 
 	t.Run("gets complete chat history", func(t *testing.T) {
-		history, err := service.GetChatHistory(projectID, endpointID)
+		history, err := service.GetChatHistory(projectID, endpointID, "")
 
 		require.NoError(t, err)
 		require.Len(t, history.Messages, 3)
@@ -183,7 +183,7 @@ func TestChatbotService_GetChatHistory(t *testing.T) {
 	})
 
 	t.Run("gets limited chat history", func(t *testing.T) {
-		history, err := service.GetChatHistoryWithLimit(projectID, endpointID, 2)
+		history, err := service.GetChatHistoryWithLimit(projectID, endpointID, "", 2)
 
 		require.NoError(t, err)
 		require.Len(t, history.Messages, 2)
@@ -231,16 +231,16 @@ func TestChatbotService_ClearChatHistory(t *testing.T) {
 	require.NoError(t, err)
 
 	// Verify messages exist
-	history, err := service.GetChatHistory(projectID, endpointID)
+	history, err := service.GetChatHistory(projectID, endpointID, "")
 	require.NoError(t, err)
 	assert.Len(t, history.Messages, 2)
 
 	// Clear history
-	err = service.ClearChatHistory(projectID, endpointID)
+	err = service.ClearChatHistory(projectID, endpointID, "")
 	require.NoError(t, err)
 
 	// Verify messages are cleared
-	history, err = service.GetChatHistory(projectID, endpointID)
+	history, err = service.GetChatHistory(projectID, endpointID, "")
 	require.NoError(t, err)
 	assert.Len(t, history.Messages, 0)
 }
@@ -255,7 +255,7 @@ func TestChatbotService_SaveModelSelection(t *testing.T) {
 	endpointID := "test-endpoint"
 
 	t.Run("saves model selection", func(t *testing.T) {
-		err := service.SaveModelSelection(projectID, endpointID, "gpt-4")
+		err := service.SaveModelSelection(projectID, endpointID, "", "gpt-4")
 
 		require.NoError(t, err)
 
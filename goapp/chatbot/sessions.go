@@ -0,0 +1,267 @@
+package chatbot
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+	"unicode/utf8"
+
+	"ramble-ai/ent"
+	"ramble-ai/ent/chatmessage"
+	"ramble-ai/ent/chatsession"
+)
+
+// maxSessionTitleLength caps titles taken from a session's first message
+const maxSessionTitleLength = 60
+
+// newSessionID generates a session ID for a project endpoint
+func newSessionID(projectID int, endpointID string) string {
+	return fmt.Sprintf("session_%d_%s_%d", projectID, endpointID, time.Now().UnixNano())
+}
+
+// latestSession returns the most recently active unarchived session of a project endpoint
+func (s *ChatbotService) latestSession(projectID int, endpointID string) (*ent.ChatSession, error) {
+	return s.sessionQuery(projectID, endpointID, "").First(s.ctx)
+}
+
+// sessionQuery queries a session by ID, or the most recently active unarchived session of a
+// project endpoint when sessionID is empty
+func (s *ChatbotService) sessionQuery(projectID int, endpointID, sessionID string) *ent.ChatSessionQuery {
+	if sessionID != "" {
+		return s.client.ChatSession.Query().
+			Where(
+				chatsession.SessionID(sessionID),
+				chatsession.ProjectID(projectID),
+				chatsession.EndpointID(endpointID),
+			)
+	}
+	return s.client.ChatSession.Query().
+		Where(
+			chatsession.ProjectID(projectID),
+			chatsession.EndpointID(endpointID),
+			chatsession.Archived(false),
+		).
+		Order(ent.Desc(chatsession.FieldUpdatedAt), ent.Desc(chatsession.FieldID))
+}
+
+// getSession returns a session by its session ID
+func (s *ChatbotService) getSession(sessionID string) (*ent.ChatSession, error) {
+	session, err := s.client.ChatSession.
+		Query().
+		Where(chatsession.SessionID(sessionID)).
+		Only(s.ctx)
+	if err != nil {
+		if ent.IsNotFound(err) {
+			return nil, fmt.Errorf("chat session %s not found", sessionID)
+		}
+		return nil, fmt.Errorf("failed to get chat session: %w", err)
+	}
+	return session, nil
+}
+
+// sessionTitle derives a session title from its first message
+func sessionTitle(message string) string {
+	title, _, _ := strings.Cut(strings.TrimSpace(message), "\n")
+	title = strings.TrimSpace(title)
+	if utf8.RuneCountInString(title) <= maxSessionTitleLength {
+		return title
+	}
+
+	title = string([]rune(title)[:maxSessionTitleLength])
+	if i := strings.LastIndex(title, " "); i > maxSessionTitleLength/2 {
+		title = title[:i]
+	}
+	return strings.TrimRight(title, " .,;:") + "…"
+}
+
+// sessionInfo converts a stored session to its API form, without messages
+func (s *ChatbotService) sessionInfo(session *ent.ChatSession) (*ChatSession, error) {
+	count, err := s.client.ChatMessage.
+		Query().
+		Where(chatmessage.SessionID(session.ID), notSummaryMessage()).
+		Count(s.ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to count chat messages: %w", err)
+	}
+
+	return &ChatSession{
+		ID:            strconv.Itoa(session.ID),
+		SessionID:     session.SessionID,
+		ProjectID:     session.ProjectID,
+		EndpointID:    session.EndpointID,
+		Title:         session.Title,
+		Archived:      session.Archived,
+		SelectedModel: session.SelectedModel,
+		MessageCount:  count,
+		CreatedAt:     session.CreatedAt,
+		UpdatedAt:     session.UpdatedAt,
+	}, nil
+}
+
+// ListSessions lists the chat sessions of a project endpoint, most recently active first
+func (s *ChatbotService) ListSessions(projectID int, endpointID string, includeArchived bool) ([]*ChatSession, error) {
+	query := s.client.ChatSession.
+		Query().
+		Where(
+			chatsession.ProjectID(projectID),
+			chatsession.EndpointID(endpointID),
+		)
+	if !includeArchived {
+		query = query.Where(chatsession.Archived(false))
+	}
+
+	sessions, err := query.
+		Order(ent.Desc(chatsession.FieldUpdatedAt), ent.Desc(chatsession.FieldID)).
+		All(s.ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list chat sessions: %w", err)
+	}
+
+	result := make([]*ChatSession, 0, len(sessions))
+	for _, session := range sessions {
+		info, err := s.sessionInfo(session)
+		if err != nil {
+			return nil, err
+		}
+		result = append(result, info)
+	}
+	return result, nil
+}
+
+// CreateSession starts a new chat session for a project endpoint. An empty title is filled in from
+// the first message.
+func (s *ChatbotService) CreateSession(projectID int, endpointID, title string) (*ChatSession, error) {
+	if endpointID == "" {
+		return nil, fmt.Errorf("endpoint ID is required")
+	}
+
+	session, err := s.client.ChatSession.
+		Create().
+		SetSessionID(newSessionID(projectID, endpointID)).
+		SetProjectID(projectID).
+		SetEndpointID(endpointID).
+		SetTitle(strings.TrimSpace(title)).
+		Save(s.ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create chat session: %w", err)
+	}
+
+	return s.sessionInfo(session)
+}
+
+// RenameSession sets the title of a chat session
+func (s *ChatbotService) RenameSession(sessionID, title string) (*ChatSession, error) {
+	title = strings.TrimSpace(title)
+	if title == "" {
+		return nil, fmt.Errorf("session title is required")
+	}
+
+	session, err := s.getSession(sessionID)
+	if err != nil {
+		return nil, err
+	}
+	session, err = session.Update().SetTitle(title).Save(s.ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to rename chat session: %w", err)
+	}
+
+	return s.sessionInfo(session)
+}
+
+// ArchiveSession archives or restores a chat session. Archived sessions keep their messages but are
+// left out of the session list and never picked as the default session.
+func (s *ChatbotService) ArchiveSession(sessionID string, archived bool) (*ChatSession, error) {
+	session, err := s.getSession(sessionID)
+	if err != nil {
+		return nil, err
+	}
+	session, err = session.Update().SetArchived(archived).Save(s.ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to archive chat session: %w", err)
+	}
+
+	return s.sessionInfo(session)
+}
+
+// ForkSession starts a new session with a copy of a session's messages up to and including messageID.
+// Pending proposals are copied as superseded so they can't be applied twice.
+func (s *ChatbotService) ForkSession(sessionID, messageID string) (*ChatSession, error) {
+	source, err := s.getSession(sessionID)
+	if err != nil {
+		return nil, err
+	}
+
+	messages, err := source.QueryMessages().
+		Where(notSummaryMessage()).
+		Order(ent.Asc(chatmessage.FieldTimestamp), ent.Asc(chatmessage.FieldID)).
+		All(s.ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get chat messages: %w", err)
+	}
+
+	end := -1
+	for i, message := range messages {
+		if message.MessageID == messageID {
+			end = i
+			break
+		}
+	}
+	if end < 0 {
+		return nil, fmt.Errorf("message %s not found in session %s", messageID, sessionID)
+	}
+
+	title := source.Title
+	if title == "" {
+		title = "Chat"
+	}
+
+	tx, err := s.client.Tx(s.ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to start transaction: %w", err)
+	}
+	defer tx.Rollback()
+
+	fork, err := tx.ChatSession.
+		Create().
+		SetSessionID(newSessionID(source.ProjectID, source.EndpointID)).
+		SetProjectID(source.ProjectID).
+		SetEndpointID(source.EndpointID).
+		SetTitle(title + " (fork)").
+		SetSelectedModel(source.SelectedModel).
+		Save(s.ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create chat session: %w", err)
+	}
+
+	// Copies keep their timestamps so the fork reads in the same order
+	stamp := time.Now().UnixNano()
+	builders := make([]*ent.ChatMessageCreate, 0, end+1)
+	for i, message := range messages[:end+1] {
+		hiddenContext := message.HiddenContext
+		if proposal := proposalFromMessage(message); proposal != nil && proposal.Status == ProposalPending {
+			proposal.Status = ProposalSuperseded
+			hiddenContext = proposalHiddenContext(proposal)
+		}
+		builders = append(builders, tx.ChatMessage.
+			Create().
+			SetMessageID(fmt.Sprintf("fork_%d_%d", stamp, i)).
+			SetSessionID(fork.ID).
+			SetRole(message.Role).
+			SetContent(message.Content).
+			SetHiddenContext(hiddenContext).
+			SetTimestamp(message.Timestamp).
+			SetModel(message.Model).
+			SetFunctionResults(message.FunctionResults).
+			SetOrderSnapshot(message.OrderSnapshot))
+	}
+	if err := tx.ChatMessage.CreateBulk(builders...).Exec(s.ctx); err != nil {
+		return nil, fmt.Errorf("failed to copy chat messages: %w", err)
+	}
+
+	if err := tx.Commit(); err != nil {
+		return nil, fmt.Errorf("failed to commit transaction: %w", err)
+	}
+
+	return s.sessionInfo(fork.Unwrap())
+}
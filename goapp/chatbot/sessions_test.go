@@ -0,0 +1,153 @@
+package chatbot
+
+import (
+	"net/http"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"ramble-ai/goapp"
+)
+
+func TestChatSessions(t *testing.T) {
+	helper := goapp.NewTestHelper(t)
+	project := helper.CreateTestProject("sessions-project")
+	service := NewChatbotService(helper.Client, helper.Ctx, mockUpdateOrderFunc)
+
+	useStreamingServer(t, helper, func(w http.ResponseWriter, r *http.Request) {
+		writeChunk(w, `{"choices":[{"delta":{"content":"Sure"}}]}`)
+		writeChunk(w, "[DONE]")
+	})
+	send := func(sessionID, message string) *ChatResponse {
+		response, err := service.SendMessage(ChatRequest{ProjectID: project.ID, EndpointID: "general", SessionID: sessionID, Message: message}, noAPIKey)
+		require.NoError(t, err)
+		require.True(t, response.Success, response.Error)
+		return response
+	}
+
+	first := send("", "Plan the intro\nwith details")
+	second, err := service.CreateSession(project.ID, "general", "")
+	require.NoError(t, err)
+	send(second.SessionID, "Plan the outro")
+
+	sessions, err := service.ListSessions(project.ID, "general", false)
+	require.NoError(t, err)
+	require.Len(t, sessions, 2)
+	assert.Equal(t, second.SessionID, sessions[0].SessionID, "most recently active first")
+	assert.Equal(t, "Plan the outro", sessions[0].Title, "untitled sessions take their first message")
+	assert.Equal(t, "Plan the intro", sessions[1].Title)
+	assert.Equal(t, 2, sessions[1].MessageCount)
+
+	history, err := service.GetChatHistory(project.ID, "general", first.SessionID)
+	require.NoError(t, err)
+	require.Len(t, history.Messages, 2)
+	assert.Equal(t, "Plan the intro\nwith details", history.Messages[0].Content)
+
+	history, err = service.GetChatHistory(project.ID, "general", "")
+	require.NoError(t, err)
+	assert.Equal(t, second.SessionID, history.SessionID, "the default session is the latest")
+
+	// Sessions are switched by sending to them
+	send(first.SessionID, "Shorter please")
+	history, err = service.GetChatHistory(project.ID, "general", "")
+	require.NoError(t, err)
+	assert.Equal(t, first.SessionID, history.SessionID)
+	assert.Len(t, history.Messages, 4)
+
+	renamed, err := service.RenameSession(second.SessionID, "  Outro  ")
+	require.NoError(t, err)
+	assert.Equal(t, "Outro", renamed.Title)
+	_, err = service.RenameSession(second.SessionID, " ")
+	assert.Error(t, err)
+
+	archived, err := service.ArchiveSession(first.SessionID, true)
+	require.NoError(t, err)
+	assert.True(t, archived.Archived)
+	sessions, err = service.ListSessions(project.ID, "general", false)
+	require.NoError(t, err)
+	require.Len(t, sessions, 1)
+	assert.Equal(t, second.SessionID, sessions[0].SessionID)
+	history, err = service.GetChatHistory(project.ID, "general", "")
+	require.NoError(t, err)
+	assert.Equal(t, second.SessionID, history.SessionID, "archived sessions are not the default")
+	sessions, err = service.ListSessions(project.ID, "general", true)
+	require.NoError(t, err)
+	assert.Len(t, sessions, 2)
+
+	// Clearing one session keeps the others
+	require.NoError(t, service.ClearChatHistory(project.ID, "general", second.SessionID))
+	history, err = service.GetChatHistory(project.ID, "general", first.SessionID)
+	require.NoError(t, err)
+	assert.Len(t, history.Messages, 4)
+
+	_, err = service.findOrCreateSession(project.ID, "highlight_ordering", first.SessionID)
+	assert.Error(t, err, "sessions belong to one endpoint")
+}
+
+func TestForkChatSession(t *testing.T) {
+	helper := goapp.NewTestHelper(t)
+	project := helper.CreateTestProject("fork-project")
+	service := NewChatbotService(helper.Client, helper.Ctx, mockUpdateOrderFunc)
+
+	session, err := service.findOrCreateSession(project.ID, "general", "")
+	require.NoError(t, err)
+	require.NoError(t, service.SaveModelSelection(project.ID, "general", session.SessionID, "gpt-4"))
+	for i, content := range []string{"First", "Reply one", "Second", "Reply two"} {
+		role := "user"
+		if i%2 == 1 {
+			role = "assistant"
+		}
+		require.NoError(t, service.persistMessage(session, "fork_src_"+content, role, content, "", ""))
+	}
+
+	fork, err := service.ForkSession(session.SessionID, "fork_src_Reply one")
+	require.NoError(t, err)
+	assert.NotEqual(t, session.SessionID, fork.SessionID)
+	assert.Equal(t, "Chat (fork)", fork.Title)
+	assert.Equal(t, "gpt-4", fork.SelectedModel)
+	assert.Equal(t, 2, fork.MessageCount)
+
+	history, err := service.GetChatHistory(project.ID, "general", fork.SessionID)
+	require.NoError(t, err)
+	require.Len(t, history.Messages, 2)
+	assert.Equal(t, "First", history.Messages[0].Content)
+	assert.Equal(t, "Reply one", history.Messages[1].Content)
+
+	original, err := service.GetChatHistory(project.ID, "general", session.SessionID)
+	require.NoError(t, err)
+	assert.Len(t, original.Messages, 4, "forking leaves the source untouched")
+
+	_, err = service.ForkSession(session.SessionID, "missing")
+	assert.Error(t, err)
+	_, err = service.ForkSession("missing", "fork_src_First")
+	assert.Error(t, err)
+}
+
+func TestCancelResponseBySession(t *testing.T) {
+	helper := goapp.NewTestHelper(t)
+	service := NewChatbotService(helper.Client, helper.Ctx, mockUpdateOrderFunc)
+
+	ctxA, doneA := service.startStream(1, "general", "a")
+	defer doneA()
+	ctxB, doneB := service.startStream(1, "general", "b")
+	defer doneB()
+
+	assert.True(t, service.CancelResponse(1, "general", "a"))
+	assert.Error(t, ctxA.Err())
+	assert.NoError(t, ctxB.Err(), "other sessions keep streaming")
+
+	assert.True(t, service.CancelResponse(1, "general", ""))
+	assert.Error(t, ctxB.Err())
+	assert.False(t, service.CancelResponse(1, "other", ""))
+}
+
+func TestSessionTitle(t *testing.T) {
+	assert.Equal(t, "Reorder my clips", sessionTitle("  Reorder my clips  \nsecond line"))
+
+	long := sessionTitle(strings.Repeat("highlight ", 20))
+	assert.True(t, strings.HasSuffix(long, "…"))
+	assert.LessOrEqual(t, len([]rune(long)), maxSessionTitleLength+1)
+	assert.False(t, strings.Contains(long, "highlight …"))
+}
@@ -0,0 +1,159 @@
+package chatbot
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log"
+	"strconv"
+	"strings"
+	"sync"
+
+	"ramble-ai/goapp/ai"
+	"ramble-ai/goapp/realtime"
+)
+
+// activeStream is a reply being streamed that can be cancelled
+type activeStream struct {
+	cancel context.CancelFunc
+}
+
+// activeStreams holds the replies being streamed, by project, endpoint and session
+var activeStreams = struct {
+	sync.Mutex
+	streams map[string]*activeStream
+}{streams: map[string]*activeStream{}}
+
+// streamResult is a streamed reply
+type streamResult struct {
+	Content   string
+	ToolCalls []ai.ToolCall
+	Cancelled bool // the reply was cut short by CancelResponse; Content holds what arrived
+}
+
+// streamKey identifies the reply of a chat session. With an empty sessionID it is the prefix of the
+// keys of every session of the project endpoint.
+func streamKey(projectID int, endpointID, sessionID string) string {
+	return fmt.Sprintf("%d:%s:%s", projectID, endpointID, sessionID)
+}
+
+// startStream registers a cancellable context for a reply. The returned function must be called
+// when the reply is complete.
+func (s *ChatbotService) startStream(projectID int, endpointID, sessionID string) (context.Context, func()) {
+	ctx, cancel := context.WithCancel(s.ctx)
+	stream := &activeStream{cancel: cancel}
+	key := streamKey(projectID, endpointID, sessionID)
+
+	activeStreams.Lock()
+	activeStreams.streams[key] = stream
+	activeStreams.Unlock()
+
+	return ctx, func() {
+		activeStreams.Lock()
+		if activeStreams.streams[key] == stream {
+			delete(activeStreams.streams, key)
+		}
+		activeStreams.Unlock()
+		cancel()
+	}
+}
+
+// CancelResponse stops the reply being streamed for a chat session, or for every session of the
+// project endpoint when sessionID is empty. The text received so far is kept as the reply. It
+// reports whether a reply was in flight.
+func (s *ChatbotService) CancelResponse(projectID int, endpointID, sessionID string) bool {
+	key := streamKey(projectID, endpointID, sessionID)
+
+	var streams []*activeStream
+	activeStreams.Lock()
+	for streamID, stream := range activeStreams.streams {
+		if streamID == key || (sessionID == "" && strings.HasPrefix(streamID, key)) {
+			streams = append(streams, stream)
+		}
+	}
+	activeStreams.Unlock()
+
+	if len(streams) == 0 {
+		return false
+	}
+	for _, stream := range streams {
+		stream.cancel()
+	}
+	log.Printf("Cancelled chat response for project %d, endpoint %s, session %q", projectID, endpointID, sessionID)
+	return true
+}
+
+// streamCompletion streams the reply to a chat request, broadcasting each delta as it arrives
+func (s *ChatbotService) streamCompletion(req ChatRequest, sessionID, messageID, apiKey string, messages []map[string]interface{}, tools []map[string]interface{}) (*streamResult, error) {
+	ctx, done := s.startStream(req.ProjectID, req.EndpointID, sessionID)
+	defer done()
+
+	systemPrompt, userPrompt := flattenMessages(messages)
+	request := &ai.TextProcessingRequest{
+		SystemPrompt: systemPrompt,
+		UserPrompt:   userPrompt,
+		Model:        req.Model,
+		TaskType:     "chat",
+		Tools:        tools,
+		Context:      map[string]interface{}{"projectID": req.ProjectID},
+	}
+
+	projectIDStr := strconv.Itoa(req.ProjectID)
+	manager := realtime.GetManager()
+	var received strings.Builder
+	response, err := ai.NewCoreAIService(s.client, s.ctx).StreamText(ctx, request, apiKey, func(delta string) {
+		received.WriteString(delta)
+		manager.BroadcastChatDelta(projectIDStr, req.EndpointID, sessionID, messageID, delta)
+	})
+	if err != nil {
+		if errors.Is(err, context.Canceled) && ctx.Err() != nil && s.ctx.Err() == nil {
+			return &streamResult{Content: received.String(), Cancelled: true}, nil
+		}
+		return nil, err
+	}
+
+	message := response.Choices[0].Message
+	return &streamResult{Content: message.Content, ToolCalls: message.ToolCalls}, nil
+}
+
+// flattenMessages joins chat messages into a system prompt and a user prompt
+func flattenMessages(messages []map[string]interface{}) (string, string) {
+	var systemPrompt, userPrompt string
+	for _, msg := range messages {
+		role, _ := msg["role"].(string)
+		content, _ := msg["content"].(string)
+
+		if role == "system" {
+			if systemPrompt != "" {
+				systemPrompt += "\n\n" + content
+			} else {
+				systemPrompt = content
+			}
+		} else if role == "user" {
+			if userPrompt != "" {
+				userPrompt += "\n\nUser: " + content
+			} else {
+				userPrompt = content
+			}
+		} else if role == "assistant" {
+			userPrompt += "\n\nAssistant: " + content
+		}
+	}
+	return systemPrompt, userPrompt
+}
+
+// toolCallMaps converts streamed tool calls to the map form executeFunctionCall takes
+func toolCallMaps(toolCalls []ai.ToolCall) []interface{} {
+	maps := make([]interface{}, 0, len(toolCalls))
+	for _, toolCall := range toolCalls {
+		maps = append(maps, map[string]interface{}{
+			"id":   toolCall.ID,
+			"type": toolCall.Type,
+			"function": map[string]interface{}{
+				"name":      toolCall.Function.Name,
+				"arguments": toolCall.Function.Arguments,
+			},
+		})
+	}
+	return maps
+}
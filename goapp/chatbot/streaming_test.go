@@ -0,0 +1,104 @@
+package chatbot
+
+import (
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"ramble-ai/ent/chatmessage"
+	"ramble-ai/goapp"
+	"ramble-ai/goapp/ai"
+)
+
+// useStreamingServer points chat at a local OpenAI-compatible server
+func useStreamingServer(t *testing.T, helper *goapp.TestHelper, handler http.HandlerFunc) {
+	server := httptest.NewServer(handler)
+	t.Cleanup(server.Close)
+	require.NoError(t, ai.NewProviderService(helper.Client, helper.Ctx).SaveSettings(ai.ProviderSettings{
+		Default: ai.ProviderConfig{Type: ai.ProviderOpenAICompatible, BaseURL: server.URL, Model: "llama3.1"},
+	}))
+}
+
+// writeChunk writes one server-sent event of a streamed completion
+func writeChunk(w http.ResponseWriter, chunk string) {
+	fmt.Fprintf(w, "data: %s\n\n", chunk)
+	w.(http.Flusher).Flush()
+}
+
+func noAPIKey() (string, error) {
+	return "", nil
+}
+
+func TestSendMessageStreamsReply(t *testing.T) {
+	helper := goapp.NewTestHelper(t)
+	project := helper.CreateTestProject("streaming-project")
+	service := NewChatbotService(helper.Client, helper.Ctx, mockUpdateOrderFunc)
+
+	useStreamingServer(t, helper, func(w http.ResponseWriter, r *http.Request) {
+		writeChunk(w, `{"choices":[{"delta":{"content":"Hello"}}]}`)
+		writeChunk(w, `{"choices":[{"delta":{"content":" world"}}]}`)
+		writeChunk(w, "[DONE]")
+	})
+
+	response, err := service.SendMessage(ChatRequest{ProjectID: project.ID, EndpointID: "general", Message: "hi"}, noAPIKey)
+	require.NoError(t, err)
+	require.True(t, response.Success, response.Error)
+	assert.Equal(t, "Hello world", response.Message)
+	assert.False(t, response.Cancelled)
+
+	persisted, err := helper.Client.ChatMessage.Query().Where(chatmessage.RoleEQ(chatmessage.RoleAssistant)).Only(helper.Ctx)
+	require.NoError(t, err)
+	assert.Equal(t, "Hello world", persisted.Content)
+	assert.Equal(t, response.MessageID, persisted.MessageID)
+}
+
+func TestSendMessageCancelledWhileStreaming(t *testing.T) {
+	helper := goapp.NewTestHelper(t)
+	project := helper.CreateTestProject("cancel-project")
+	service := NewChatbotService(helper.Client, helper.Ctx, mockUpdateOrderFunc)
+
+	useStreamingServer(t, helper, func(w http.ResponseWriter, r *http.Request) {
+		writeChunk(w, `{"choices":[{"delta":{"content":"Partial"}}]}`)
+		time.Sleep(100 * time.Millisecond) // let the client read the first delta
+		assert.True(t, service.CancelResponse(project.ID, "general", ""))
+		<-r.Context().Done()
+	})
+
+	response, err := service.SendMessage(ChatRequest{ProjectID: project.ID, EndpointID: "general", Message: "hi"}, noAPIKey)
+	require.NoError(t, err)
+	assert.True(t, response.Success)
+	assert.True(t, response.Cancelled)
+	assert.Equal(t, "Partial", response.Message)
+
+	persisted, err := helper.Client.ChatMessage.Query().Where(chatmessage.RoleEQ(chatmessage.RoleAssistant)).Only(helper.Ctx)
+	require.NoError(t, err)
+	assert.Equal(t, "Partial", persisted.Content)
+
+	assert.False(t, service.CancelResponse(project.ID, "general", ""), "nothing left in flight")
+}
+
+func TestSendMessageWithFunctionsStreamedToolCalls(t *testing.T) {
+	helper := goapp.NewTestHelper(t)
+	project := helper.CreateTestProject("tool-call-project")
+	service := NewChatbotService(helper.Client, helper.Ctx, mockUpdateOrderFunc)
+
+	useStreamingServer(t, helper, func(w http.ResponseWriter, r *http.Request) {
+		writeChunk(w, `{"choices":[{"delta":{"content":"Checking the order."}}]}`)
+		writeChunk(w, `{"choices":[{"delta":{"tool_calls":[{"index":0,"id":"call_1","type":"function","function":{"name":"get_current_order","arguments":"{\"proj"}}]}}]}`)
+		writeChunk(w, `{"choices":[{"delta":{"tool_calls":[{"index":0,"function":{"arguments":"ect\":1}"}}]}}]}`)
+		writeChunk(w, "[DONE]")
+	})
+
+	response, err := service.SendMessage(ChatRequest{ProjectID: project.ID, EndpointID: "general", Message: "order?", EnableFunctionCalls: true}, noAPIKey)
+	require.NoError(t, err)
+	require.True(t, response.Success, response.Error)
+	assert.Equal(t, "Checking the order.", response.Message)
+	require.Len(t, response.FunctionResults, 1)
+	assert.Equal(t, "get_current_order", response.FunctionResults[0].FunctionName)
+	assert.NotContains(t, response.FunctionResults[0].Error, "arguments", "fragments are joined before parsing")
+}
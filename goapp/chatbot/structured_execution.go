@@ -4,6 +4,8 @@ import (
 	"encoding/json"
 	"fmt"
 	"strings"
+
+	"ramble-ai/goapp/ai"
 )
 
 // StructuredExecutionInput defines the standardized input for execution agent
@@ -26,6 +28,29 @@ type StructuredExecutionOutput struct {
 	Error        string        `json:"error,omitempty"` // error message if failed
 }
 
+// executionOutputSchema describes StructuredExecutionOutput for structured outputs
+var executionOutputSchema = ai.StructuredSchema{
+	Name: "execution_output",
+	Schema: map[string]interface{}{
+		"type": "object",
+		"properties": map[string]interface{}{
+			"success": map[string]interface{}{"type": "boolean"},
+			"newOrder": map[string]interface{}{
+				"type":  "array",
+				"items": ai.HighlightOrderItemSchema,
+			},
+			"reasoning":    map[string]interface{}{"type": "string"},
+			"sectionCount": map[string]interface{}{"type": "integer"},
+			"changes": map[string]interface{}{
+				"type":  "array",
+				"items": map[string]interface{}{"type": "string"},
+			},
+			"error": map[string]interface{}{"type": "string"},
+		},
+		"required": []string{"success", "newOrder"},
+	},
+}
+
 // IntentTemplate defines the template for a specific intent
 type IntentTemplate struct {
 	IntentName   string
@@ -198,26 +223,52 @@ EXECUTE THE TASK NOW:`,
 
 // ParseStructuredExecutionOutput parses the LLM response into structured output
 func ParseStructuredExecutionOutput(response string) (*StructuredExecutionOutput, error) {
-	// Clean the response to extract JSON
-	response = strings.TrimSpace(response)
-
-	// Look for JSON object in the response
-	startIdx := strings.Index(response, "{")
-	endIdx := strings.LastIndex(response, "}")
-
-	if startIdx == -1 || endIdx == -1 {
-		return nil, fmt.Errorf("no JSON object found in response")
+	output, err := ai.ParseStructured[*StructuredExecutionOutput](response, executionOutputSchema)
+	if err != nil {
+		return nil, fmt.Errorf("failed to parse JSON response: %w", err)
 	}
+	return output, nil
+}
 
-	jsonStr := response[startIdx : endIdx+1]
+// completeStructuredExecution sends an execution prompt and re-prompts until the reply parses and,
+// when the model reports success, passes ValidateStructuredOutput (a negative count skips that check)
+func (s *ChatbotService) completeStructuredExecution(apiKey string, request map[string]interface{}, prompt string, originalHighlightCount int) (*StructuredExecutionOutput, error) {
+	complete := func(userPrompt string, format *ai.ResponseFormat) (string, error) {
+		attempt := make(map[string]interface{}, len(request)+1)
+		for key, value := range request {
+			attempt[key] = value
+		}
+		attempt["messages"] = []map[string]interface{}{
+			{
+				"role":    "user",
+				"content": userPrompt,
+			},
+		}
+		if format != nil {
+			attempt["response_format"] = format
+		}
 
-	var output StructuredExecutionOutput
-	err := json.Unmarshal([]byte(jsonStr), &output)
-	if err != nil {
-		return nil, fmt.Errorf("failed to parse JSON response: %w", err)
+		message, err := s.callOpenRouterAPI(apiKey, attempt)
+		if err != nil {
+			return "", err
+		}
+		content, ok := message["content"].(string)
+		if !ok {
+			return "", fmt.Errorf("AI response missing content")
+		}
+		return content, nil
 	}
 
-	return &output, nil
+	return ai.CompleteStructured(complete, prompt, executionOutputSchema, func(output *StructuredExecutionOutput) error {
+		if output == nil {
+			return fmt.Errorf("response must be a JSON object")
+		}
+		if !output.Success || originalHighlightCount < 0 {
+			// A reported failure is a valid answer; callers surface it
+			return nil
+		}
+		return ValidateStructuredOutput(output, originalHighlightCount)
+	}, 0)
 }
 
 // ValidateStructuredOutput validates that the output is complete and correct
@@ -0,0 +1,353 @@
+package chatbot
+
+import (
+	"encoding/json"
+	"fmt"
+	"strings"
+	"time"
+	"unicode/utf8"
+
+	"ramble-ai/ent"
+	"ramble-ai/ent/chatmessage"
+)
+
+// transcriptVersion is the format version of exported chat transcripts
+const transcriptVersion = 1
+
+// Transcript formats
+const (
+	TranscriptFormatMarkdown = "markdown"
+	TranscriptFormatJSON     = "json"
+)
+
+// maxTranscriptLabelLength caps the highlight text quoted in Markdown order snapshots
+const maxTranscriptLabelLength = 80
+
+// ChatTranscript is an exported chat session: its messages with the functions they called and the
+// highlight order they left behind
+type ChatTranscript struct {
+	Version       int                 `json:"version"`
+	ExportedAt    time.Time           `json:"exportedAt"`
+	ProjectID     int                 `json:"projectId"`
+	ProjectName   string              `json:"projectName"`
+	EndpointID    string              `json:"endpointId"`
+	Title         string              `json:"title"`
+	SelectedModel string              `json:"selectedModel,omitempty"`
+	CreatedAt     time.Time           `json:"createdAt"`
+	Messages      []TranscriptMessage `json:"messages"`
+}
+
+// TranscriptMessage is a message of an exported chat session
+type TranscriptMessage struct {
+	ID              string                    `json:"id"`
+	Role            string                    `json:"role"`
+	Content         string                    `json:"content"`
+	Timestamp       time.Time                 `json:"timestamp"`
+	Model           string                    `json:"model,omitempty"`
+	FunctionResults []FunctionExecutionResult `json:"functionResults,omitempty"`
+	OrderSnapshot   []interface{}             `json:"orderSnapshot,omitempty"`
+	Proposal        *OrderProposal            `json:"proposal,omitempty"`
+}
+
+// recordReplyActions stores the functions a reply called and the project's order after them on the
+// reply's message. It returns the order snapshot, nil when the reply took no actions.
+func (s *ChatbotService) recordReplyActions(projectID int, response *ChatResponse) ([]interface{}, error) {
+	if len(response.FunctionResults) == 0 && !response.HasActions {
+		return nil, nil
+	}
+
+	order, err := s.currentOrder(projectID)
+	if err != nil {
+		return nil, err
+	}
+	update := s.client.ChatMessage.
+		Update().
+		Where(chatmessage.MessageID(response.MessageID)).
+		SetOrderSnapshot(order)
+	if len(response.FunctionResults) > 0 {
+		update = update.SetFunctionResults(response.FunctionResults)
+	}
+	if err := update.Exec(s.ctx); err != nil {
+		return nil, fmt.Errorf("failed to save reply actions: %w", err)
+	}
+	return order, nil
+}
+
+// buildTranscript collects a session and its messages for export
+func (s *ChatbotService) buildTranscript(sessionID string) (*ChatTranscript, error) {
+	session, err := s.getSession(sessionID)
+	if err != nil {
+		return nil, err
+	}
+	project, err := s.client.Project.Get(s.ctx, session.ProjectID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get project: %w", err)
+	}
+
+	messages, err := session.QueryMessages().
+		Where(notSummaryMessage()).
+		Order(ent.Asc(chatmessage.FieldTimestamp), ent.Asc(chatmessage.FieldID)).
+		All(s.ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get chat messages: %w", err)
+	}
+
+	transcript := &ChatTranscript{
+		Version:       transcriptVersion,
+		ExportedAt:    time.Now(),
+		ProjectID:     project.ID,
+		ProjectName:   project.Name,
+		EndpointID:    session.EndpointID,
+		Title:         session.Title,
+		SelectedModel: session.SelectedModel,
+		CreatedAt:     session.CreatedAt,
+		Messages:      make([]TranscriptMessage, len(messages)),
+	}
+	for i, message := range messages {
+		transcript.Messages[i] = TranscriptMessage{
+			ID:              message.MessageID,
+			Role:            string(message.Role),
+			Content:         message.Content,
+			Timestamp:       message.Timestamp,
+			Model:           message.Model,
+			FunctionResults: message.FunctionResults,
+			OrderSnapshot:   message.OrderSnapshot,
+			Proposal:        proposalFromMessage(message),
+		}
+	}
+	return transcript, nil
+}
+
+// ExportSession renders a chat session as Markdown for reading or as JSON for ImportSession
+func (s *ChatbotService) ExportSession(sessionID, format string) (string, error) {
+	transcript, err := s.buildTranscript(sessionID)
+	if err != nil {
+		return "", err
+	}
+
+	switch format {
+	case TranscriptFormatJSON:
+		data, err := json.MarshalIndent(transcript, "", "  ")
+		if err != nil {
+			return "", fmt.Errorf("failed to marshal chat transcript: %w", err)
+		}
+		return string(data), nil
+	case TranscriptFormatMarkdown, "":
+		labels, err := s.highlightLabels(transcript.ProjectID)
+		if err != nil {
+			return "", err
+		}
+		return transcriptMarkdown(transcript, labels), nil
+	default:
+		return "", fmt.Errorf("unsupported transcript format: %s", format)
+	}
+}
+
+// highlightLabels maps a project's highlight IDs to their text for order snapshots
+func (s *ChatbotService) highlightLabels(projectID int) (map[string]string, error) {
+	projectHighlights, err := s.highlightService.GetProjectHighlights(projectID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get project highlights: %w", err)
+	}
+
+	labels := make(map[string]string)
+	for _, ph := range projectHighlights {
+		for _, highlight := range ph.Highlights {
+			text := strings.Join(strings.Fields(highlight.Text), " ")
+			if utf8.RuneCountInString(text) > maxTranscriptLabelLength {
+				text = string([]rune(text)[:maxTranscriptLabelLength]) + "…"
+			}
+			labels[highlight.ID] = text
+		}
+	}
+	return labels, nil
+}
+
+// transcriptMarkdown renders a transcript for reading. Highlights in order snapshots are quoted
+// with their text when labels has it.
+func transcriptMarkdown(transcript *ChatTranscript, labels map[string]string) string {
+	const stamp = "2006-01-02 15:04"
+
+	title := transcript.Title
+	if title == "" {
+		title = "Chat"
+	}
+
+	var md strings.Builder
+	fmt.Fprintf(&md, "# %s\n\n", title)
+	fmt.Fprintf(&md, "- **Project:** %s\n", transcript.ProjectName)
+	fmt.Fprintf(&md, "- **Assistant:** %s\n", transcript.EndpointID)
+	if transcript.SelectedModel != "" {
+		fmt.Fprintf(&md, "- **Model:** %s\n", transcript.SelectedModel)
+	}
+	fmt.Fprintf(&md, "- **Exported:** %s\n", transcript.ExportedAt.Format(stamp))
+
+	for _, message := range transcript.Messages {
+		heading := transcriptRoleTitle(message.Role) + " · " + message.Timestamp.Format(stamp)
+		if message.Model != "" {
+			heading += " · " + message.Model
+		}
+		fmt.Fprintf(&md, "\n---\n\n### %s\n\n%s\n", heading, strings.TrimSpace(message.Content))
+
+		if len(message.FunctionResults) > 0 {
+			md.WriteString("\n**Function calls**\n\n")
+			for _, result := range message.FunctionResults {
+				writeFunctionResult(&md, result)
+			}
+		}
+		if message.Proposal != nil {
+			fmt.Fprintf(&md, "\n**Proposal:** %s\n", message.Proposal.Status)
+		}
+		if len(message.OrderSnapshot) > 0 {
+			md.WriteString("\n**Highlight order after this reply**\n\n")
+			writeOrderSnapshot(&md, message.OrderSnapshot, labels)
+		}
+	}
+	return md.String()
+}
+
+// transcriptRoleTitle names a message role in Markdown headings
+func transcriptRoleTitle(role string) string {
+	switch role {
+	case "user":
+		return "User"
+	case "assistant":
+		return "Assistant"
+	case "error":
+		return "Error"
+	default:
+		return "System"
+	}
+}
+
+// writeFunctionResult renders a function call with its outcome and result data
+func writeFunctionResult(md *strings.Builder, result FunctionExecutionResult) {
+	outcome := "succeeded"
+	detail := result.Message
+	if !result.Success {
+		outcome, detail = "failed", result.Error
+	}
+	fmt.Fprintf(md, "- `%s` %s", result.FunctionName, outcome)
+	if detail != "" {
+		fmt.Fprintf(md, ": %s", detail)
+	}
+	md.WriteString("\n")
+
+	if result.Result == nil {
+		return
+	}
+	data, err := json.MarshalIndent(result.Result, "  ", "  ")
+	if err != nil {
+		return
+	}
+	fmt.Fprintf(md, "  ```json\n  %s\n  ```\n", data)
+}
+
+// writeOrderSnapshot renders a highlight order with its sections
+func writeOrderSnapshot(md *strings.Builder, order []interface{}, labels map[string]string) {
+	position := 0
+	for _, item := range order {
+		switch value := item.(type) {
+		case string:
+			if value == "N" {
+				md.WriteString("- Section\n")
+				continue
+			}
+			position++
+			if label, ok := labels[value]; ok && label != "" {
+				fmt.Fprintf(md, "- #%d “%s” (`%s`)\n", position, label, value)
+			} else {
+				fmt.Fprintf(md, "- #%d `%s`\n", position, value)
+			}
+		case map[string]interface{}:
+			if title, _ := value["title"].(string); title != "" {
+				fmt.Fprintf(md, "- Section: **%s**\n", title)
+			} else {
+				md.WriteString("- Section\n")
+			}
+		}
+	}
+}
+
+// ImportSession creates a session in a project from a JSON transcript, as a starting point for a
+// new conversation. Order snapshots and function results are kept as history; nothing is applied to
+// the project, and pending proposals are dropped because they were made for the source project.
+func (s *ChatbotService) ImportSession(projectID int, data string) (*ChatSession, error) {
+	var transcript ChatTranscript
+	if err := json.Unmarshal([]byte(data), &transcript); err != nil {
+		return nil, fmt.Errorf("failed to parse chat transcript: %w", err)
+	}
+	if transcript.Version < 1 || transcript.Version > transcriptVersion {
+		return nil, fmt.Errorf("unsupported chat transcript version: %d", transcript.Version)
+	}
+	if transcript.EndpointID == "" {
+		return nil, fmt.Errorf("chat transcript has no endpoint ID")
+	}
+	for i, message := range transcript.Messages {
+		if err := chatmessage.RoleValidator(chatmessage.Role(message.Role)); err != nil {
+			return nil, fmt.Errorf("invalid role in transcript message %d: %w", i+1, err)
+		}
+	}
+
+	if _, err := s.client.Project.Get(s.ctx, projectID); err != nil {
+		return nil, fmt.Errorf("failed to get project: %w", err)
+	}
+
+	title := transcript.Title
+	if title == "" {
+		title = "Chat"
+	}
+
+	tx, err := s.client.Tx(s.ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to start transaction: %w", err)
+	}
+	defer tx.Rollback()
+
+	session, err := tx.ChatSession.
+		Create().
+		SetSessionID(newSessionID(projectID, transcript.EndpointID)).
+		SetProjectID(projectID).
+		SetEndpointID(transcript.EndpointID).
+		SetTitle(title + " (imported)").
+		SetSelectedModel(transcript.SelectedModel).
+		Save(s.ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create chat session: %w", err)
+	}
+
+	stamp := time.Now().UnixNano()
+	builders := make([]*ent.ChatMessageCreate, 0, len(transcript.Messages))
+	for i, message := range transcript.Messages {
+		if strings.TrimSpace(message.Content) == "" {
+			continue
+		}
+		timestamp := message.Timestamp
+		if timestamp.IsZero() {
+			timestamp = time.Now()
+		}
+		create := tx.ChatMessage.
+			Create().
+			SetMessageID(fmt.Sprintf("import_%d_%d", stamp, i)).
+			SetSessionID(session.ID).
+			SetRole(chatmessage.Role(message.Role)).
+			SetContent(message.Content).
+			SetTimestamp(timestamp).
+			SetModel(message.Model).
+			SetFunctionResults(message.FunctionResults).
+			SetOrderSnapshot(message.OrderSnapshot)
+		if message.Proposal != nil && message.Proposal.Status != ProposalPending {
+			create = create.SetHiddenContext(proposalHiddenContext(message.Proposal))
+		}
+		builders = append(builders, create)
+	}
+	if err := tx.ChatMessage.CreateBulk(builders...).Exec(s.ctx); err != nil {
+		return nil, fmt.Errorf("failed to import chat messages: %w", err)
+	}
+
+	if err := tx.Commit(); err != nil {
+		return nil, fmt.Errorf("failed to commit transaction: %w", err)
+	}
+
+	return s.sessionInfo(session.Unwrap())
+}
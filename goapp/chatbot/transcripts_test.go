@@ -0,0 +1,115 @@
+package chatbot
+
+import (
+	"encoding/json"
+	"net/http"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"ramble-ai/goapp"
+	"ramble-ai/goapp/projects"
+)
+
+func TestTranscriptExportAndImport(t *testing.T) {
+	helper := goapp.NewTestHelper(t)
+	project := helper.CreateTestProject("transcript-project")
+	clip := helper.CreateTestVideoClip(project, "interview")
+	first := helper.CreateTestHighlight(clip, 0, 5)
+	second := helper.CreateTestHighlight(clip, 10, 15)
+
+	projectService := projects.NewProjectService(helper.Client, helper.Ctx)
+	service := NewChatbotService(helper.Client, helper.Ctx, projectService.UpdateProjectHighlightOrderWithTitles)
+
+	var toolCall string
+	useStreamingServer(t, helper, func(w http.ResponseWriter, r *http.Request) {
+		writeChunk(w, `{"choices":[{"delta":{"content":"Reordering the highlights."}}]}`)
+		writeChunk(w, toolCall)
+		writeChunk(w, "[DONE]")
+	})
+	send := func(sessionID string, proposeChanges bool) *ChatResponse {
+		response, err := service.SendMessage(ChatRequest{ProjectID: project.ID, EndpointID: "general", SessionID: sessionID, Message: "Open with the second highlight", EnableFunctionCalls: true, ProposeChanges: proposeChanges}, noAPIKey)
+		require.NoError(t, err)
+		require.True(t, response.Success, response.Error)
+		return response
+	}
+
+	newOrder := []interface{}{map[string]interface{}{"type": "N", "title": "Hook"}, second, first}
+	toolCall = toolCallChunk(t, "reorder_highlights", map[string]interface{}{"new_order": newOrder, "reason": "Stronger opening"})
+	applied := send("", false)
+	toolCall = toolCallChunk(t, "reset_to_original", map[string]interface{}{})
+	proposed := send(applied.SessionID, true)
+	require.NotNil(t, proposed.Proposal)
+
+	// Replies keep the functions they called and the order they left
+	history, err := service.GetChatHistory(project.ID, "general", applied.SessionID)
+	require.NoError(t, err)
+	require.Len(t, history.Messages, 4)
+	reply := history.Messages[1]
+	require.Len(t, reply.FunctionResults, 1)
+	assert.Equal(t, "reorder_highlights", reply.FunctionResults[0].FunctionName)
+	assert.True(t, reply.FunctionResults[0].Success)
+	assert.Equal(t, []interface{}{map[string]interface{}{"title": "Hook", "type": "N"}, second, first}, reply.OrderSnapshot)
+	assert.Empty(t, history.Messages[0].FunctionResults)
+	assert.Nil(t, history.Messages[0].OrderSnapshot)
+
+	data, err := service.ExportSession(applied.SessionID, TranscriptFormatJSON)
+	require.NoError(t, err)
+	var transcript ChatTranscript
+	require.NoError(t, json.Unmarshal([]byte(data), &transcript))
+	assert.Equal(t, transcriptVersion, transcript.Version)
+	assert.Equal(t, "transcript-project", transcript.ProjectName)
+	assert.Equal(t, "general", transcript.EndpointID)
+	require.Len(t, transcript.Messages, 4)
+	assert.Equal(t, "user", transcript.Messages[0].Role)
+	assert.Equal(t, reply.OrderSnapshot, transcript.Messages[1].OrderSnapshot)
+	assert.Equal(t, "reset_to_original", transcript.Messages[3].FunctionResults[0].FunctionName)
+	require.NotNil(t, transcript.Messages[3].Proposal)
+	assert.Equal(t, ProposalPending, transcript.Messages[3].Proposal.Status)
+
+	markdown, err := service.ExportSession(applied.SessionID, TranscriptFormatMarkdown)
+	require.NoError(t, err)
+	assert.Contains(t, markdown, "# Open with the second highlight\n")
+	assert.Contains(t, markdown, "- **Project:** transcript-project")
+	assert.Contains(t, markdown, "### User · ")
+	assert.Contains(t, markdown, "- `reorder_highlights` succeeded")
+	assert.Contains(t, markdown, `"reason": "Stronger opening"`)
+	assert.Contains(t, markdown, "**Highlight order after this reply**\n\n- Section: **Hook**\n- #1 ")
+	assert.Contains(t, markdown, "**Proposal:** pending")
+
+	_, err = service.ExportSession(applied.SessionID, "pdf")
+	assert.Error(t, err)
+
+	// Importing into another project reuses the conversation without touching its order
+	target := helper.CreateTestProject("target-project")
+	imported, err := service.ImportSession(target.ID, data)
+	require.NoError(t, err)
+	assert.Equal(t, target.ID, imported.ProjectID)
+	assert.Equal(t, "general", imported.EndpointID)
+	assert.Equal(t, "Open with the second highlight (imported)", imported.Title)
+	assert.Equal(t, 4, imported.MessageCount)
+
+	importedHistory, err := service.GetChatHistory(target.ID, "general", imported.SessionID)
+	require.NoError(t, err)
+	require.Len(t, importedHistory.Messages, 4)
+	assert.Equal(t, history.Messages[1].Content, importedHistory.Messages[1].Content)
+	assert.Equal(t, reply.FunctionResults[0].FunctionName, importedHistory.Messages[1].FunctionResults[0].FunctionName)
+	assert.Equal(t, reply.OrderSnapshot, importedHistory.Messages[1].OrderSnapshot)
+	assert.NotEqual(t, history.Messages[1].ID, importedHistory.Messages[1].ID)
+	assert.Nil(t, importedHistory.Messages[3].Proposal, "pending proposals are not imported")
+
+	targetOrder, err := projectService.GetProjectHighlightOrderWithTitles(target.ID)
+	require.NoError(t, err)
+	assert.Empty(t, targetOrder)
+	proposals, err := service.ListProposals(target.ID, "general", imported.SessionID, false)
+	require.NoError(t, err)
+	assert.Empty(t, proposals)
+
+	_, err = service.ImportSession(target.ID, "not json")
+	assert.Error(t, err)
+	_, err = service.ImportSession(target.ID, `{"version":99,"endpointId":"general"}`)
+	assert.ErrorContains(t, err, "unsupported chat transcript version")
+	_, err = service.ImportSession(target.ID, `{"version":1,"endpointId":"general","messages":[{"role":"robot","content":"hi"}]}`)
+	assert.ErrorContains(t, err, "invalid role")
+}
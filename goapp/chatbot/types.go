@@ -2,7 +2,9 @@ package chatbot
 
 import (
 	"ramble-ai/ent"
+	"ramble-ai/ent/schema"
 	"ramble-ai/goapp/highlights"
+	"ramble-ai/goapp/prompts"
 	"context"
 	"time"
 )
@@ -14,17 +16,27 @@ type ChatMessage struct {
 	Content   string    `json:"content"`
 	Timestamp time.Time `json:"timestamp"`
 	Hidden    string    `json:"-"` // Hidden context not sent to frontend
+	// Proposal is the highlight order change an assistant message proposed
+	Proposal *OrderProposal `json:"proposal,omitempty"`
+	// FunctionResults are the functions called for an assistant message
+	FunctionResults []FunctionExecutionResult `json:"functionResults,omitempty"`
+	// OrderSnapshot is the project's highlight order after an assistant message's actions
+	OrderSnapshot []interface{} `json:"orderSnapshot,omitempty"`
 }
 
 // ChatSession represents a conversation session
 type ChatSession struct {
-	ID         string        `json:"id"`
-	SessionID  string        `json:"sessionId"`
-	ProjectID  int           `json:"projectId"`
-	EndpointID string        `json:"endpointId"`
-	Messages   []ChatMessage `json:"messages"`
-	CreatedAt  time.Time     `json:"createdAt"`
-	UpdatedAt  time.Time     `json:"updatedAt"`
+	ID            string        `json:"id"`
+	SessionID     string        `json:"sessionId"`
+	ProjectID     int           `json:"projectId"`
+	EndpointID    string        `json:"endpointId"`
+	Title         string        `json:"title"`
+	Archived      bool          `json:"archived"`
+	SelectedModel string        `json:"selectedModel,omitempty"`
+	MessageCount  int           `json:"messageCount"`
+	Messages      []ChatMessage `json:"messages,omitempty"`
+	CreatedAt     time.Time     `json:"createdAt"`
+	UpdatedAt     time.Time     `json:"updatedAt"`
 }
 
 // ChatRequest represents a request to send a message
@@ -37,6 +49,9 @@ type ChatRequest struct {
 	Model               string                 `json:"model"`
 	EnableFunctionCalls bool                   `json:"enableFunctionCalls,omitempty"`
 	Mode                string                 `json:"mode,omitempty"` // "chat" or "reorder"
+	ProposeChanges      bool                   `json:"proposeChanges,omitempty"` // propose highlight order changes for approval instead of applying them
+	prompts.Selection                          // library prompt added to the endpoint's system prompt
+	instructions        string                 // rendered library prompt, set by SendMessage
 }
 
 // ChatResponse represents the response from sending a message
@@ -53,22 +68,23 @@ type ChatResponse struct {
 	ActionsPerformed []string `json:"actionsPerformed,omitempty"`
 	ActionSummary    string   `json:"actionSummary,omitempty"`
 	HasActions       bool     `json:"hasActions,omitempty"`
+	// Cancelled is set when the reply was stopped while streaming; Message holds the text received
+	Cancelled bool `json:"cancelled,omitempty"`
+	// Proposal is the highlight order change awaiting approval, when the request asked for proposals
+	Proposal *OrderProposal `json:"proposal,omitempty"`
 }
 
 // FunctionExecutionResult represents the result of executing a function
-type FunctionExecutionResult struct {
-	FunctionName string      `json:"functionName"`
-	Success      bool        `json:"success"`
-	Result       interface{} `json:"result,omitempty"`
-	Error        string      `json:"error,omitempty"`
-	Message      string      `json:"message,omitempty"`
-}
+type FunctionExecutionResult = schema.ChatFunctionResult
 
 // ChatHistoryResponse represents chat history for a project/endpoint
 type ChatHistoryResponse struct {
 	SessionID     string        `json:"sessionId"`
+	Title         string        `json:"title,omitempty"`
 	Messages      []ChatMessage `json:"messages"`
 	SelectedModel string        `json:"selectedModel,omitempty"`
+	// Summary condenses the messages before Messages, when loaded for a context window
+	Summary *HistorySummary `json:"-"`
 }
 
 // FunctionDefinition represents a function that can be called by the LLM
@@ -95,4 +111,5 @@ type ChatbotService struct {
 	updateOrderFunc         UpdateOrderFunc
 	mcpRegistry             *MCPRegistry
 	conversationFlowManager *ConversationFlowManager
+	customTools             map[string]bool // names of the registered custom tools
 }
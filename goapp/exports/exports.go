@@ -16,6 +16,7 @@ import (
 	"time"
 
 	"ramble-ai/ent"
+	"ramble-ai/ent/cut"
 	"ramble-ai/ent/exportjob"
 	"ramble-ai/ent/project"
 	"ramble-ai/goapp"
@@ -82,68 +83,32 @@ func NewExportService(client *ent.Client, ctx context.Context) *ExportService {
 
 // ExportStitchedHighlights exports all highlights from a project as a single stitched video
 func (s *ExportService) ExportStitchedHighlights(projectID int, outputFolder string, paddingSeconds float64) (string, error) {
-	// Generate unique job ID
-	jobID := fmt.Sprintf("export_%d_%d", projectID, time.Now().UnixNano())
-
-	// Create database record
-	// Get the project to create relation
-	proj, err := s.client.Project.Get(s.ctx, projectID)
-	if err != nil {
-		return "", fmt.Errorf("failed to get project: %w", err)
-	}
-
-	// Retry job creation if database is locked
-	var dbJob *ent.ExportJob
-	for i := 0; i < 5; i++ {
-		var createErr error
-		dbJob, createErr = s.client.ExportJob.
-			Create().
-			SetJobID(jobID).
-			SetExportType("stitched").
-			SetOutputPath(outputFolder).
-			SetStage("pending").
-			SetCreatedAt(time.Now()).
-			SetProject(proj).
-			Save(s.ctx)
+	return s.startExport(projectID, 0, "stitched", outputFolder, paddingSeconds)
+}
 
-		if createErr == nil {
-			break
-		}
-		
-		// Check if it's a database lock error
-		if strings.Contains(createErr.Error(), "database table is locked") {
-			time.Sleep(time.Duration(i*10) * time.Millisecond)
-			continue
-		}
-		
-		// Other errors, don't retry
-		return "", fmt.Errorf("failed to create export job record: %w", createErr)
-	}
+// ExportIndividualHighlights exports each highlight as a separate video file
+func (s *ExportService) ExportIndividualHighlights(projectID int, outputFolder string, paddingSeconds float64) (string, error) {
+	return s.startExport(projectID, 0, "individual", outputFolder, paddingSeconds)
+}
 
-	if dbJob == nil {
-		return "", fmt.Errorf("failed to create export job record after retries")
+// ExportStitchedCut exports the visible highlights of a cut, in the cut's order, as a single stitched video
+func (s *ExportService) ExportStitchedCut(projectID int, cutID int, outputFolder string, paddingSeconds float64) (string, error) {
+	if err := s.validateCut(projectID, cutID); err != nil {
+		return "", err
 	}
+	return s.startExport(projectID, cutID, "stitched", outputFolder, paddingSeconds)
+}
 
-	// Create active job
-	activeJob := &ActiveExportJob{
-		JobID:    jobID,
-		Cancel:   make(chan bool),
-		IsActive: true,
+// ExportIndividualCut exports each visible highlight of a cut, numbered in the cut's order, as a separate video file
+func (s *ExportService) ExportIndividualCut(projectID int, cutID int, outputFolder string, paddingSeconds float64) (string, error) {
+	if err := s.validateCut(projectID, cutID); err != nil {
+		return "", err
 	}
-
-	// Register active job
-	activeJobsMutex.Lock()
-	activeJobs[jobID] = activeJob
-	activeJobsMutex.Unlock()
-
-	// Run export in background
-	go s.performStitchedExport(dbJob, activeJob, paddingSeconds)
-
-	return jobID, nil
+	return s.startExport(projectID, cutID, "individual", outputFolder, paddingSeconds)
 }
 
-// ExportIndividualHighlights exports each highlight as a separate video file
-func (s *ExportService) ExportIndividualHighlights(projectID int, outputFolder string, paddingSeconds float64) (string, error) {
+// startExport records an export job and runs it in the background. cutID 0 exports every project highlight.
+func (s *ExportService) startExport(projectID int, cutID int, exportType string, outputFolder string, paddingSeconds float64) (string, error) {
 	// Generate unique job ID
 	jobID := fmt.Sprintf("export_%d_%d", projectID, time.Now().UnixNano())
 
@@ -161,7 +126,8 @@ func (s *ExportService) ExportIndividualHighlights(projectID int, outputFolder s
 		dbJob, createErr = s.client.ExportJob.
 			Create().
 			SetJobID(jobID).
-			SetExportType("individual").
+			SetExportType(exportType).
+			SetCutID(cutID).
 			SetOutputPath(outputFolder).
 			SetStage("pending").
 			SetCreatedAt(time.Now()).
@@ -199,7 +165,11 @@ func (s *ExportService) ExportIndividualHighlights(projectID int, outputFolder s
 	activeJobsMutex.Unlock()
 
 	// Run export in background
-	go s.performIndividualExport(dbJob, activeJob, paddingSeconds)
+	if exportType == "individual" {
+		go s.performIndividualExport(dbJob, activeJob, paddingSeconds)
+	} else {
+		go s.performStitchedExport(dbJob, activeJob, paddingSeconds)
+	}
 
 	return jobID, nil
 }
@@ -294,6 +264,27 @@ func (s *ExportService) CancelExport(jobID string) error {
 	return nil
 }
 
+// RunningProjectExports returns the IDs of a project's export jobs that are still running
+func (s *ExportService) RunningProjectExports(projectID int) ([]string, error) {
+	jobIDs, err := s.client.ExportJob.
+		Query().
+		Where(
+			exportjob.HasProjectWith(project.ID(projectID)),
+			exportjob.IsComplete(false),
+			exportjob.IsCancelled(false),
+			exportjob.HasError(false),
+		).
+		Order(ent.Asc(exportjob.FieldCreatedAt)).
+		Select(exportjob.FieldJobID).
+		Strings(s.ctx)
+
+	if err != nil {
+		return nil, fmt.Errorf("failed to get export jobs: %w", err)
+	}
+
+	return jobIDs, nil
+}
+
 // GetProjectExportJobs retrieves all export jobs for a project
 func (s *ExportService) GetProjectExportJobs(projectID int) ([]*ExportProgress, error) {
 	// Get all export jobs for the project
@@ -385,7 +376,7 @@ func (s *ExportService) performStitchedExport(dbJob *ent.ExportJob, activeJob *A
 		return
 	}
 
-	segments, err := s.getProjectHighlightsForExport(proj.ID)
+	segments, err := s.getJobHighlightsForExport(dbJob, proj.ID)
 	if err != nil {
 		s.updateJobFailed(dbJob.JobID, fmt.Sprintf("Failed to get highlights: %v", err))
 		return
@@ -476,7 +467,7 @@ func (s *ExportService) performIndividualExport(dbJob *ent.ExportJob, activeJob
 		return
 	}
 
-	segments, err := s.getProjectHighlightsForExport(proj.ID)
+	segments, err := s.getJobHighlightsForExport(dbJob, proj.ID)
 	if err != nil {
 		s.updateJobFailed(dbJob.JobID, fmt.Sprintf("Failed to get highlights: %v", err))
 		return
@@ -542,6 +533,29 @@ func (s *ExportService) getProjectHighlightsForExport(projectID int) ([]Highligh
 	return service.GetProjectHighlightsForExport(projectID)
 }
 
+// getJobHighlightsForExport retrieves the highlights an export job covers: a cut's visible highlights in order, or every project highlight
+func (s *ExportService) getJobHighlightsForExport(dbJob *ent.ExportJob, projectID int) ([]HighlightSegment, error) {
+	if dbJob.CutID == 0 {
+		return s.getProjectHighlightsForExport(projectID)
+	}
+	service := highlights.NewHighlightService(s.client, s.ctx)
+	return service.GetCutHighlightsForExport(projectID, dbJob.CutID)
+}
+
+// validateCut checks that a cut exists and belongs to the project
+func (s *ExportService) validateCut(projectID int, cutID int) error {
+	exists, err := s.client.Cut.Query().
+		Where(cut.ID(cutID), cut.ProjectID(projectID)).
+		Exist(s.ctx)
+	if err != nil {
+		return fmt.Errorf("failed to get cut: %w", err)
+	}
+	if !exists {
+		return fmt.Errorf("cut %d not found in project %d", cutID, projectID)
+	}
+	return nil
+}
+
 // calculatePaddedTimes calculates start and end times with padding, respecting video boundaries
 func (s *ExportService) calculatePaddedTimes(segment HighlightSegment, paddingSeconds float64) (float64, float64, error) {
 	// Calculate padded start time (never go below 0)
@@ -10,6 +10,9 @@ import (
 	"github.com/stretchr/testify/require"
 
 	_ "github.com/mattn/go-sqlite3"
+
+	"ramble-ai/ent"
+	"ramble-ai/ent/exportjob"
 )
 
 func TestNewExportService(t *testing.T) {
@@ -170,6 +173,35 @@ func TestExportIndividualHighlights_Success(t *testing.T) {
 	// assert.Equal(t, "individual", jobs[0].ExportType)
 }
 
+func TestExportCut(t *testing.T) {
+	client, ctx := setupTestDB(t)
+	defer client.Close()
+	service := NewExportService(client, ctx)
+
+	proj := createTestProject(t, client, ctx, "TestProject")
+	other := createTestProject(t, client, ctx, "OtherProject")
+	clip := createTestVideoClip(t, client, ctx, proj, "TestClip")
+	createTestHighlight(t, client, ctx, clip, 10.0, 20.0)
+
+	recap, err := client.Cut.Create().SetName("Recap").SetProjectID(proj.ID).Save(ctx)
+	require.NoError(t, err)
+
+	tempDir, err := os.MkdirTemp("", "export_test_*")
+	require.NoError(t, err)
+	defer os.RemoveAll(tempDir)
+
+	jobID, err := service.ExportStitchedCut(proj.ID, recap.ID, tempDir, 0.0)
+	require.NoError(t, err)
+
+	job, err := client.ExportJob.Query().Where(exportjob.JobID(jobID)).Only(ctx)
+	require.NoError(t, err)
+	assert.Equal(t, recap.ID, job.CutID)
+
+	_, err = service.ExportIndividualCut(other.ID, recap.ID, tempDir, 0.0)
+	assert.Error(t, err)
+	assert.Contains(t, err.Error(), "not found")
+}
+
 func TestExportStitchedHighlights_ProjectNotFound(t *testing.T) {
 	client, ctx := setupTestDB(t)
 	defer client.Close()
@@ -301,6 +333,47 @@ func TestCancelExport_JobNotFound(t *testing.T) {
 	assert.Contains(t, err.Error(), "job not found")
 }
 
+func TestRunningProjectExports(t *testing.T) {
+	client, ctx := setupTestDB(t)
+	defer client.Close()
+	service := NewExportService(client, ctx)
+
+	proj := createTestProject(t, client, ctx, "TestProject")
+	other := createTestProject(t, client, ctx, "OtherProject")
+
+	for _, job := range []struct {
+		jobID     string
+		project   *ent.Project
+		complete  bool
+		cancelled bool
+	}{
+		{"running_job", proj, false, false},
+		{"finished_job", proj, true, false},
+		{"cancelled_job", proj, false, true},
+		{"other_job", other, false, false},
+	} {
+		_, err := client.ExportJob.
+			Create().
+			SetJobID(job.jobID).
+			SetExportType("stitched").
+			SetOutputPath("/test/path").
+			SetStage("processing").
+			SetIsComplete(job.complete).
+			SetIsCancelled(job.cancelled).
+			SetProject(job.project).
+			Save(ctx)
+		require.NoError(t, err)
+	}
+
+	running, err := service.RunningProjectExports(proj.ID)
+	require.NoError(t, err)
+	assert.Equal(t, []string{"running_job"}, running)
+
+	running, err = service.RunningProjectExports(createTestProject(t, client, ctx, "IdleProject").ID)
+	require.NoError(t, err)
+	assert.Empty(t, running)
+}
+
 func TestGetProjectExportJobs_Success(t *testing.T) {
 	client, ctx := setupTestDB(t)
 	defer client.Close()
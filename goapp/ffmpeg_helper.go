@@ -1,6 +1,7 @@
 package goapp
 
 import (
+	"bytes"
 	"context"
 	"fmt"
 	"io"
@@ -194,6 +195,52 @@ func GenerateThumbnail(videoPath, outputPath string, timeOffset string) error {
 	return nil
 }
 
+// GenerateFilmstripFrames extracts one frame every interval seconds into outputDir as frame_0001.jpg, frame_0002.jpg, ...
+func GenerateFilmstripFrames(videoPath, outputDir string, interval float64, width int) error {
+	log.Printf("[FFMPEG] Generating filmstrip from %s every %.2fs -> %s", videoPath, interval, outputDir)
+
+	err := ffmpeg.Input(videoPath).
+		Output(filepath.Join(outputDir, "frame_%04d.jpg"), ffmpeg.KwArgs{
+			"vf":  fmt.Sprintf("fps=1/%g,scale=%d:-2", interval, width), // One frame per interval, keep aspect ratio
+			"q:v": "5",                                                  // Small files, good enough for scrubbing
+		}).
+		OverWriteOutput().
+		Silent(true).
+		Run()
+
+	if err != nil {
+		return fmt.Errorf("filmstrip generation failed: %w", err)
+	}
+
+	log.Printf("[FFMPEG] Filmstrip generation completed successfully")
+	return nil
+}
+
+// ExtractAudioPCM decodes the audio track of a media file to mono signed 16-bit little-endian PCM
+func ExtractAudioPCM(mediaPath string, sampleRate int) ([]byte, error) {
+	log.Printf("[FFMPEG] Decoding PCM audio from %s at %dHz", mediaPath, sampleRate)
+
+	var buf bytes.Buffer
+	err := ffmpeg.Input(mediaPath).
+		Output("pipe:", ffmpeg.KwArgs{
+			"vn":     "",
+			"acodec": "pcm_s16le",
+			"ar":     strconv.Itoa(sampleRate),
+			"ac":     "1",
+			"f":      "s16le",
+		}).
+		WithOutput(&buf).
+		Silent(true).
+		Run()
+
+	if err != nil {
+		return nil, fmt.Errorf("PCM audio extraction failed: %w", err)
+	}
+
+	log.Printf("[FFMPEG] PCM audio extraction completed successfully (%d bytes)", buf.Len())
+	return buf.Bytes(), nil
+}
+
 // ExtractVideoSegment extracts a video segment with optional padding
 func ExtractVideoSegment(inputPath, outputPath string, startTime, duration float64) error {
 	log.Printf("[FFMPEG] Extracting video segment: %s [%.3fs + %.3fs] -> %s", inputPath, startTime, duration, outputPath)
@@ -354,6 +401,21 @@ func getAppDataDir() (string, error) {
 	return appDataDir, nil
 }
 
+// GetMediaCacheDir returns the directory used for derived media (filmstrips, waveforms), creating it if needed
+func GetMediaCacheDir() (string, error) {
+	appDataDir, err := getAppDataDir()
+	if err != nil {
+		return "", err
+	}
+
+	cacheDir := filepath.Join(appDataDir, "media_cache")
+	if err := os.MkdirAll(cacheDir, 0755); err != nil {
+		return "", fmt.Errorf("failed to create media cache directory: %w", err)
+	}
+
+	return cacheDir, nil
+}
+
 // FindSystemFFmpeg looks for FFmpeg with version-based priority
 func FindSystemFFmpeg() (string, error) {
 	log.Printf("[FFMPEG] 🔍 Searching for FFmpeg installation (minimum version: %s)...", MinimumFFmpegVersion)
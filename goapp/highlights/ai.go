@@ -8,7 +8,6 @@ import (
 	"io"
 	"log"
 	"net/http"
-	"regexp"
 	"sort"
 	"strings"
 	"time"
@@ -18,12 +17,14 @@ import (
 	"ramble-ai/ent/schema"
 	"ramble-ai/ent/videoclip"
 	"ramble-ai/goapp/ai"
+	"ramble-ai/goapp/prompts"
 )
 
 // OpenRouterRequest represents the request format for OpenRouter API
 type OpenRouterRequest struct {
-	Model    string    `json:"model"`
-	Messages []Message `json:"messages"`
+	Model          string             `json:"model"`
+	Messages       []Message          `json:"messages"`
+	ResponseFormat *ai.ResponseFormat `json:"response_format,omitempty"`
 }
 
 // Message represents a chat message
@@ -56,6 +57,7 @@ type ProjectAISettings struct {
 type ProjectAISuggestion struct {
 	Order     []interface{} `json:"order"`
 	Model     string        `json:"model"`
+	PromptID  int           `json:"promptId"` // library prompt version used, 0 = none
 	CreatedAt time.Time     `json:"createdAt"`
 }
 
@@ -64,6 +66,11 @@ type AIService struct {
 	client           *ent.Client
 	ctx              context.Context
 	highlightService *HighlightService
+	cutID            int          // cut whose order and hidden highlights are changed (0 = active cut)
+	instructions     string       // library prompt added to fixed task prompts such as silence improvement
+	usageProjectID   int          // project AI calls are metered against when the call chain does not carry it
+	complete         ai.Completer // replaces the configured provider for task completions when set
+	heldHidden       *[]string    // when set, hidden highlights are collected here instead of saved
 }
 
 // NewAIService creates a new AI service
@@ -77,6 +84,12 @@ func NewAIService(client *ent.Client, ctx context.Context) *AIService {
 
 // SuggestHighlightsWithAI generates AI-powered highlight suggestions for a video
 func (s *AIService) SuggestHighlightsWithAI(projectID int, videoID int, customPrompt string) ([]HighlightSuggestion, error) {
+	return s.SuggestHighlightsWithAIOptions(projectID, videoID, customPrompt, SuggestionOptions{})
+}
+
+// SuggestHighlightsWithAIOptions generates AI-powered highlight suggestions for a video, splitting
+// long transcripts into overlapping windows that are suggested on separately and then merged
+func (s *AIService) SuggestHighlightsWithAIOptions(projectID int, videoID int, customPrompt string, options SuggestionOptions) ([]HighlightSuggestion, error) {
 
 	// Get project AI settings
 	aiSettings, err := s.highlightService.GetProjectHighlightAISettings(projectID)
@@ -84,6 +97,12 @@ func (s *AIService) SuggestHighlightsWithAI(projectID int, videoID int, customPr
 		return nil, fmt.Errorf("failed to get project highlight AI settings: %w", err)
 	}
 
+	// A selected library prompt replaces the custom prompt
+	customPrompt, err = s.libraryPrompt(options.Selection, prompts.TaskSuggest, projectID, customPrompt)
+	if err != nil {
+		return nil, err
+	}
+
 	// Use custom prompt if provided, otherwise use project's saved prompt
 	prompt := customPrompt
 	if prompt == "" {
@@ -151,11 +170,11 @@ Avoid overlapping with existing highlights and ensure segments are coherent and
 	
 	// Build the prompt for AI highlight suggestions
 	systemPrompt := s.buildHighlightSuggestionsSystemPrompt(customPrompt)
-	userPrompt := s.buildHighlightSuggestionsUserPrompt(transcriptWords, existingHighlights)
+	windowSize, windowOverlap := suggestionWindowSettings(options)
+	windows := transcriptWindows(len(transcriptWords), windowSize, windowOverlap)
 	
 	request := &ai.TextProcessingRequest{
 		SystemPrompt: systemPrompt,
-		UserPrompt:   userPrompt,
 		Model:        aiSettings.AIModel,
 		TaskType:     "suggest_highlights",
 		Context: map[string]interface{}{
@@ -168,25 +187,14 @@ Avoid overlapping with existing highlights and ensure segments are coherent and
 	log.Printf("=== AI HIGHLIGHT SUGGESTIONS REQUEST ===")
 	log.Printf("Model: %s", request.Model)
 	log.Printf("System Prompt length: %d characters", len(systemPrompt))
-	log.Printf("User Prompt length: %d characters", len(userPrompt))
+	log.Printf("Transcript: %d words in %d windows", len(transcriptWords), len(windows))
 	log.Printf("============================================")
 	
-	rawResult, err := aiService.ProcessText(request)
+	complete := ai.TextCompleter(aiService, *request)
+	suggestions, err := s.suggestHighlightsInWindows(complete, transcriptWords, existingHighlights, windows)
 	if err != nil {
 		return nil, fmt.Errorf("failed to get AI highlight suggestions: %w", err)
 	}
-	
-	// Parse the raw OpenRouter response to get structured result
-	result, err := ai.ParseTextResponse(rawResult, request.TaskType)
-	if err != nil {
-		return nil, fmt.Errorf("failed to parse AI response: %w", err)
-	}
-	
-	// Parse the response
-	suggestions, err := s.parseAIHighlightSuggestionsResponse(result.Content, transcriptWords)
-	if err != nil {
-		return nil, fmt.Errorf("failed to parse AI highlight suggestions: %w", err)
-	}
 
 	// Debug log raw AI suggestions
 	log.Printf("SuggestHighlightsWithAI: AI returned %d raw suggestions", len(suggestions))
@@ -202,8 +210,22 @@ Avoid overlapping with existing highlights and ensure segments are coherent and
 	// Filter out overlapping suggestions
 	validSuggestions := s.filterValidHighlightSuggestions(suggestions, existingHighlights, transcriptWords)
 
+	// Rank suggestions from different windows against each other
+	if options.RankAcrossWindows && len(windows) > 1 {
+		ranked, err := s.rankSuggestions(complete, validSuggestions, options.MaxSuggestions)
+		if err != nil {
+			log.Printf("Failed to rank suggestions across windows, keeping transcript order: %v", err)
+			validSuggestions = capSuggestions(validSuggestions, options.MaxSuggestions)
+		} else {
+			validSuggestions = ranked
+		}
+	} else {
+		validSuggestions = capSuggestions(validSuggestions, options.MaxSuggestions)
+	}
+	stampSuggestionProvenance(validSuggestions, aiSettings.AIModel, options.PromptID)
+
 	// Save suggestions to database
-	err = s.saveSuggestedHighlights(videoID, validSuggestions, transcriptWords)
+	err = s.saveSuggestedHighlights(videoID, validSuggestions, transcriptWords, options.PromptID)
 	if err != nil {
 		log.Printf("Failed to save suggested highlights to database: %v", err)
 		// Don't fail the request if saving fails, just log the error
@@ -232,13 +254,24 @@ Avoid overlapping with existing highlights and ensure segments are coherent and
 
 // buildHighlightSuggestionsUserPrompt creates the user prompt with transcript data
 func (s *AIService) buildHighlightSuggestionsUserPrompt(transcriptWords []schema.Word, existingHighlights []schema.Highlight) string {
+	return s.buildWindowSuggestionsUserPrompt(transcriptWords, existingHighlights, transcriptWindow{Start: 0, End: len(transcriptWords)}, 0, 1)
+}
+
+// buildWindowSuggestionsUserPrompt creates the user prompt for one window of the transcript.
+// Word indices stay relative to the whole transcript so ranges from all windows can be merged.
+func (s *AIService) buildWindowSuggestionsUserPrompt(transcriptWords []schema.Word, existingHighlights []schema.Highlight, window transcriptWindow, windowIndex int, windowCount int) string {
 	var prompt strings.Builder
 
+	if windowCount > 1 {
+		prompt.WriteString(fmt.Sprintf("This is part %d of %d of a long transcript (words %d to %d). Only suggest highlights within this part.\n\n",
+			windowIndex+1, windowCount, window.Start, window.End-1))
+	}
+
 	// Add transcript as indexed words
 	prompt.WriteString("TRANSCRIPT (as indexed word pairs):\n")
-	for i, word := range transcriptWords {
-		prompt.WriteString(fmt.Sprintf("[%d, \"%s\"]", i, word.Word))
-		if i < len(transcriptWords)-1 {
+	for i := window.Start; i < window.End; i++ {
+		prompt.WriteString(fmt.Sprintf("[%d, \"%s\"]", i, transcriptWords[i].Word))
+		if i < window.End-1 {
 			prompt.WriteString(", ")
 		}
 		if (i+1)%10 == 0 {
@@ -248,19 +281,26 @@ func (s *AIService) buildHighlightSuggestionsUserPrompt(transcriptWords []schema
 	prompt.WriteString("\n\n")
 
 	// Add existing highlights context
-	if len(existingHighlights) > 0 {
-		prompt.WriteString("EXISTING HIGHLIGHTS (do not overlap with these):\n")
-		for _, highlight := range existingHighlights {
-			// Convert highlight times to word indices (approximate)
-			startIdx := s.highlightService.TimeToWordIndex(highlight.Start, transcriptWords)
-			endIdx := s.highlightService.TimeToWordIndex(highlight.End, transcriptWords)
-			prompt.WriteString(fmt.Sprintf("[%d, %d] ", startIdx, endIdx))
+	var existingRanges []string
+	for _, highlight := range existingHighlights {
+		// Convert highlight times to word indices (approximate)
+		startIdx := s.highlightService.TimeToWordIndex(highlight.Start, transcriptWords)
+		endIdx := s.highlightService.TimeToWordIndex(highlight.End, transcriptWords)
+		if windowCount > 1 && (endIdx < window.Start || startIdx >= window.End) {
+			continue
 		}
+		existingRanges = append(existingRanges, fmt.Sprintf("[%d, %d] ", startIdx, endIdx))
+	}
+	if len(existingRanges) > 0 {
+		prompt.WriteString("EXISTING HIGHLIGHTS (do not overlap with these):\n")
+		prompt.WriteString(strings.Join(existingRanges, ""))
 		prompt.WriteString("\n\n")
 	}
 
 	prompt.WriteString("TASK: Return suggested highlight segments as word index ranges in JSON format.\n")
-	prompt.WriteString("Format: [{\"start\": 5, \"end\": 12}, {\"start\": 25, \"end\": 35}]\n")
+	prompt.WriteString("For each segment also give a score from 0 to 1 (how strong the highlight is), a category ")
+	prompt.WriteString("(" + strings.Join(HighlightCategories, ", ") + ") and a one-sentence rationale for picking it.\n")
+	prompt.WriteString("Format: [{\"start\": 5, \"end\": 12, \"score\": 0.9, \"category\": \"hook\", \"rationale\": \"Bold claim that grabs attention\"}]\n")
 	prompt.WriteString("Only return the JSON array, no other text.")
 
 	return prompt.String()
@@ -409,34 +449,68 @@ func (s *AIService) buildHighlightSuggestionsPrompt(transcriptWords []schema.Wor
 	return prompt.String()
 }
 
-// parseAIHighlightSuggestionsResponse parses the AI response to extract highlight suggestions
-func (s *AIService) parseAIHighlightSuggestionsResponse(aiResponse string, transcriptWords []schema.Word) ([]HighlightSuggestion, error) {
-	// Extract JSON from response (in case AI adds extra text)
-	jsonStart := strings.Index(aiResponse, "[")
-	jsonEnd := strings.LastIndex(aiResponse, "]")
+// highlightRange is a highlight suggested by AI as a word index range
+type highlightRange struct {
+	Start     int     `json:"start"`
+	End       int     `json:"end"`
+	Score     float64 `json:"score"`
+	Category  string  `json:"category"`
+	Rationale string  `json:"rationale"`
+}
 
-	if jsonStart == -1 || jsonEnd == -1 {
-		return nil, fmt.Errorf("no valid JSON array found in AI response")
-	}
+// highlightSuggestionsSchema describes the word index ranges returned for highlight suggestions
+var highlightSuggestionsSchema = ai.StructuredSchema{
+	Name: "highlight_suggestions",
+	Schema: map[string]interface{}{
+		"type": "array",
+		"items": map[string]interface{}{
+			"type": "object",
+			"properties": map[string]interface{}{
+				"start":     map[string]interface{}{"type": "integer"},
+				"end":       map[string]interface{}{"type": "integer"},
+				"score":     map[string]interface{}{"type": "number", "minimum": 0, "maximum": 1},
+				"category":  map[string]interface{}{"type": "string"},
+				"rationale": map[string]interface{}{"type": "string"},
+			},
+			"required": []string{"start", "end"},
+		},
+	},
+}
 
-	jsonStr := aiResponse[jsonStart : jsonEnd+1]
+// validateHighlightRanges rejects ranges that fall outside the transcript window
+func validateHighlightRanges(ranges []highlightRange, wordCount int, window transcriptWindow) error {
+	maxEnd := window.End
+	if maxEnd >= wordCount {
+		maxEnd = wordCount - 1
+	}
 
-	// Parse JSON
-	var rawSuggestions []struct {
-		Start int `json:"start"`
-		End   int `json:"end"`
+	var problems []string
+	for i, r := range ranges {
+		if r.Start < window.Start || r.End > maxEnd || r.Start > r.End {
+			problems = append(problems, fmt.Sprintf("range %d {start: %d, end: %d} must satisfy %d <= start <= end <= %d", i, r.Start, r.End, window.Start, maxEnd))
+		}
+	}
+	if len(problems) > 0 {
+		return fmt.Errorf("invalid word ranges: %s", strings.Join(problems, "; "))
 	}
+	return nil
+}
 
-	err := json.Unmarshal([]byte(jsonStr), &rawSuggestions)
+// parseAIHighlightSuggestionsResponse parses the AI response to extract highlight suggestions
+func (s *AIService) parseAIHighlightSuggestionsResponse(aiResponse string, transcriptWords []schema.Word) ([]HighlightSuggestion, error) {
+	ranges, err := ai.ParseStructured[[]highlightRange](aiResponse, highlightSuggestionsSchema)
 	if err != nil {
 		return nil, fmt.Errorf("failed to parse AI suggestions JSON: %w", err)
 	}
+	return s.highlightRangesToSuggestions(ranges, transcriptWords), nil
+}
 
-	// Convert to HighlightSuggestion structs
+// highlightRangesToSuggestions converts word index ranges to highlight suggestions, skipping invalid ranges
+func (s *AIService) highlightRangesToSuggestions(ranges []highlightRange, transcriptWords []schema.Word) []HighlightSuggestion {
 	var suggestions []HighlightSuggestion
 	baseColorIDs := []int{1, 2, 3, 4, 5} // Yellow, Orange, Red, Pink, Purple
 
-	for i, raw := range rawSuggestions {
+	for i, raw := range ranges {
 		// Validate indices
 		if raw.Start < 0 || raw.End >= len(transcriptWords) || raw.Start > raw.End {
 			continue // Skip invalid suggestions
@@ -455,11 +529,12 @@ func (s *AIService) parseAIHighlightSuggestionsResponse(aiResponse string, trans
 			End:     raw.End,
 			Text:    text,
 			ColorID: baseColorIDs[i%len(baseColorIDs)],
+			Insight: raw.insight(),
 		}
 		suggestions = append(suggestions, suggestion)
 	}
 
-	return suggestions, nil
+	return suggestions
 }
 
 // filterValidHighlightSuggestions removes suggestions that overlap with existing highlights
@@ -522,8 +597,8 @@ func (s *AIService) filterValidHighlightSuggestions(suggestions []HighlightSugge
 	return validSuggestions
 }
 
-// saveSuggestedHighlights saves suggested highlights to the database
-func (s *AIService) saveSuggestedHighlights(videoID int, suggestions []HighlightSuggestion, transcriptWords []schema.Word) error {
+// saveSuggestedHighlights saves suggested highlights to the database along with the library prompt version that produced them
+func (s *AIService) saveSuggestedHighlights(videoID int, suggestions []HighlightSuggestion, transcriptWords []schema.Word, promptID int) error {
 	// Debug log suggestions before saving
 	log.Printf("=== SAVING SUGGESTED HIGHLIGHTS ===")
 	log.Printf("VideoID: %d", videoID)
@@ -549,6 +624,7 @@ func (s *AIService) saveSuggestedHighlights(videoID int, suggestions []Highlight
 			Start:   startTime,
 			End:     endTime,
 			ColorID: suggestion.ColorID,
+			Insight: suggestion.Insight,
 		}
 		highlights = append(highlights, highlight)
 	}
@@ -563,6 +639,7 @@ func (s *AIService) saveSuggestedHighlights(videoID int, suggestions []Highlight
 	_, err := s.client.VideoClip.
 		UpdateOneID(videoID).
 		SetSuggestedHighlights(highlights).
+		SetSuggestedHighlightsPromptID(promptID).
 		Save(s.ctx)
 
 	if err != nil {
@@ -572,6 +649,14 @@ func (s *AIService) saveSuggestedHighlights(videoID int, suggestions []Highlight
 	return nil
 }
 
+// libraryPrompt renders the library prompt selected for a task, or returns fallback when none is selected
+func (s *AIService) libraryPrompt(selection prompts.Selection, taskType string, projectID int, fallback string) (string, error) {
+	if selection.PromptID == 0 {
+		return fallback, nil
+	}
+	return prompts.NewPromptService(s.client, s.ctx).ResolvePrompt(selection, taskType, projectID)
+}
+
 // GetProjectAISettings gets the AI settings for a specific project
 func (s *AIService) GetProjectAISettings(projectID int) (*ProjectAISettings, error) {
 	project, err := s.client.Project.
@@ -611,12 +696,25 @@ func (s *AIService) SaveProjectAISettings(projectID int, settings ProjectAISetti
 	return nil
 }
 
-// saveAISuggestion saves the AI suggestion to the database (internal helper)
-func (s *AIService) saveAISuggestion(projectID int, reorderedIDs []interface{}, model string) error {
+// saveAISuggestion saves the AI suggestion and the library prompt version that produced it to the database (internal helper).
+// The project caches one suggestion, for its active cut, so reorders of other cuts are not saved.
+func (s *AIService) saveAISuggestion(projectID int, reorderedIDs []interface{}, model string, promptID int) error {
+	if s.cutID != 0 {
+		proj, err := s.client.Project.Get(s.ctx, projectID)
+		if err != nil {
+			return fmt.Errorf("failed to get project: %w", err)
+		}
+		if s.cutID != proj.ActiveCutID {
+			log.Printf("[AI] Not caching the AI suggestion of inactive cut %d", s.cutID)
+			return nil
+		}
+	}
+
 	_, err := s.client.Project.
 		UpdateOneID(projectID).
 		SetAiSuggestionOrder(reorderedIDs).
 		SetAiSuggestionModel(model).
+		SetAiSuggestionPromptID(promptID).
 		SetAiSuggestionCreatedAt(time.Now()).
 		Save(s.ctx)
 
@@ -646,6 +744,7 @@ func (s *AIService) GetProjectAISuggestion(projectID int) (*ProjectAISuggestion,
 	return &ProjectAISuggestion{
 		Order:     project.AiSuggestionOrder,
 		Model:     project.AiSuggestionModel,
+		PromptID:  project.AiSuggestionPromptID,
 		CreatedAt: project.AiSuggestionCreatedAt,
 	}, nil
 }
@@ -666,10 +765,10 @@ func (s *AIService) ReorderHighlightsWithAI(projectID int, customPrompt string,
 
 // ReorderHighlightsWithAIOptions uses OpenRouter API to intelligently reorder highlights with specific options
 func (s *AIService) ReorderHighlightsWithAIOptions(projectID int, customPrompt string, options AIActionOptions, getAPIKey func() (string, error), getProjectHighlights func(int) ([]ProjectHighlight, error)) ([]interface{}, error) {
-	// Get OpenRouter API key
-	apiKey, err := getAPIKey()
-	if err != nil || apiKey == "" {
-		return nil, fmt.Errorf("OpenRouter API key not configured")
+	// Get OpenRouter API key (empty when the task runs on another provider)
+	apiKey, err := ai.NewProviderService(s.client, s.ctx).TextAPIKey("reorder", getAPIKey)
+	if err != nil {
+		return nil, err
 	}
 
 	// Get project AI settings
@@ -678,17 +777,25 @@ func (s *AIService) ReorderHighlightsWithAIOptions(projectID int, customPrompt s
 		return nil, fmt.Errorf("failed to get project AI settings: %w", err)
 	}
 
-	// Use custom prompt if provided, otherwise use project's saved prompt
+	// Use custom prompt if provided, otherwise use project's saved prompt; a selected library prompt replaces both
 	prompt := customPrompt
 	if prompt == "" {
 		prompt = aiSettings.AIPrompt
 	}
+	prompt, err = s.libraryPrompt(options.Selection, prompts.TaskReorder, projectID, prompt)
+	if err != nil {
+		return nil, err
+	}
 
-	// Get current highlight order to preserve existing newlines and titles
-	currentOrder, err := s.highlightService.GetProjectHighlightOrderWithTitles(projectID)
+	// Get current highlight order of the cut to preserve existing newlines and titles
+	cutState, err := s.highlightService.GetCutState(projectID, s.cutID)
 	if err != nil {
 		return nil, fmt.Errorf("failed to get current highlight order: %w", err)
 	}
+	currentOrder := cutState.HighlightOrder
+	if currentOrder == nil {
+		currentOrder = []interface{}{}
+	}
 
 	// Get all project highlights
 	projectHighlights, err := getProjectHighlights(projectID)
@@ -715,6 +822,18 @@ func (s *AIService) ReorderHighlightsWithAIOptions(projectID int, customPrompt s
 		return []interface{}{}, nil
 	}
 
+	// A target duration selects highlights by their real runtime instead of keeping them all
+	if options.TargetDurationSeconds > 0 {
+		processedIDs, err := s.reorderToTargetDuration(apiKey, aiSettings.AIModel, highlightMap, prompt, options, highlightIDs, projectID)
+		if err != nil {
+			return nil, fmt.Errorf("failed to get AI reordering: %w", err)
+		}
+		if err := s.saveAISuggestion(projectID, processedIDs, aiSettings.AIModel, options.PromptID); err != nil {
+			log.Printf("Failed to save AI suggestion to database: %v", err)
+		}
+		return processedIDs, nil
+	}
+
 	// Call OpenRouter API to get AI reordering with specific options
 	reorderedIDs, err := s.callOpenRouterForReorderingWithOptions(apiKey, aiSettings.AIModel, highlightMap, prompt, options, highlightIDs, projectID)
 	if err != nil {
@@ -800,7 +919,7 @@ func (s *AIService) ReorderHighlightsWithAIOptions(projectID int, customPrompt s
 	}
 
 	// Save AI suggestion to database
-	err = s.saveAISuggestion(projectID, processedIDs, aiSettings.AIModel)
+	err = s.saveAISuggestion(projectID, processedIDs, aiSettings.AIModel, options.PromptID)
 	if err != nil {
 		log.Printf("Failed to save AI suggestion to database: %v", err)
 		// Don't fail the request if saving fails, just log the error
@@ -809,6 +928,28 @@ func (s *AIService) ReorderHighlightsWithAIOptions(projectID int, customPrompt s
 	return processedIDs, nil
 }
 
+// ReorderCutHighlightsWithAIOptions reorders the highlights of a specific cut and saves the new
+// order to that cut together with the highlights the AI left out, which are hidden in that cut only
+func (s *AIService) ReorderCutHighlightsWithAIOptions(projectID int, cutID int, customPrompt string, options AIActionOptions, getAPIKey func() (string, error), getProjectHighlights func(int) ([]ProjectHighlight, error)) ([]interface{}, error) {
+	var hidden []string
+	cutService := *s
+	cutService.cutID = cutID
+	cutService.heldHidden = &hidden
+
+	order, err := cutService.ReorderHighlightsWithAIOptions(projectID, customPrompt, options, getAPIKey, getProjectHighlights)
+	if err != nil {
+		return nil, err
+	}
+	if len(order) == 0 {
+		return order, nil
+	}
+
+	if err := s.highlightService.saveCutOrder(projectID, cutID, order, hidden); err != nil {
+		return nil, err
+	}
+	return order, nil
+}
+
 // callOpenRouterForReordering calls the OpenRouter API to get intelligent highlight reordering
 func (s *AIService) callOpenRouterForReordering(apiKey string, model string, highlightMap map[string]string, customPrompt string) ([]interface{}, error) {
 	// For backward compatibility, use default options
@@ -825,11 +966,6 @@ func (s *AIService) callOpenRouterForReordering(apiKey string, model string, hig
 
 // callOpenRouterForReorderingWithOptions calls the OpenRouter API to get intelligent highlight reordering with specific options
 func (s *AIService) callOpenRouterForReorderingWithOptions(apiKey string, model string, highlightMap map[string]string, customPrompt string, options AIActionOptions, originalIDs []string, projectID int) ([]interface{}, error) {
-	// Create HTTP client with timeout
-	client := &http.Client{
-		Timeout: 60 * time.Second, // AI requests can take longer
-	}
-
 	// Build the prompt for AI reordering with options
 	prompt := s.buildReorderingPromptWithOptions(highlightMap, customPrompt, options)
 
@@ -843,158 +979,13 @@ func (s *AIService) callOpenRouterForReorderingWithOptions(apiKey string, model
 	log.Printf("Contains newline instructions: %v", strings.Contains(prompt, "N\" characters"))
 	log.Printf("==============================")
 
-	// Create request payload
-	requestData := OpenRouterRequest{
-		Model: model, // Use the project-specific model
-		Messages: []Message{
-			{
-				Role:    "user",
-				Content: prompt,
-			},
-		},
-	}
-
-	jsonData, err := json.Marshal(requestData)
-	if err != nil {
-		return nil, fmt.Errorf("failed to marshal request: %w", err)
-	}
-
-	// Create HTTP request
-	req, err := http.NewRequest("POST", "https://openrouter.ai/api/v1/chat/completions", bytes.NewBuffer(jsonData))
-	if err != nil {
-		return nil, fmt.Errorf("failed to create request: %w", err)
-	}
-
-	// Set headers
-	req.Header.Set("Authorization", "Bearer "+apiKey)
-	req.Header.Set("Content-Type", "application/json")
-	req.Header.Set("HTTP-Referer", "https://github.com/yourusername/video-app") // Required by OpenRouter
-	req.Header.Set("X-Title", "Video Highlight Reordering")                     // Optional but recommended
-
-	// Make the request
-	resp, err := client.Do(req)
-	if err != nil {
-		return nil, fmt.Errorf("failed to make request: %w", err)
-	}
-	defer resp.Body.Close()
-
-	// Read response
-	body, err := io.ReadAll(resp.Body)
-	if err != nil {
-		return nil, fmt.Errorf("failed to read response: %w", err)
-	}
-
-	if resp.StatusCode != http.StatusOK {
-		return nil, fmt.Errorf("OpenRouter API error (status %d): %s", resp.StatusCode, string(body))
-	}
-
-	// Parse response
-	var openRouterResp OpenRouterResponse
-	err = json.Unmarshal(body, &openRouterResp)
+	items, err := ai.CompleteStructured(s.taskCompleter(apiKey, model, "Video Highlight Reordering", "reorder", projectID), prompt, reorderingSchema, func(items []interface{}) error {
+		return validateReorderingCoverage(items, originalIDs, options)
+	}, 0)
 	if err != nil {
-		return nil, fmt.Errorf("failed to parse response: %w", err)
-	}
-
-	if openRouterResp.Error != nil {
-		return nil, fmt.Errorf("OpenRouter API error: %s", openRouterResp.Error.Message)
-	}
-
-	if len(openRouterResp.Choices) == 0 {
-		return nil, fmt.Errorf("no response choices received from AI")
-	}
-
-	// Extract the reordered IDs from the AI response
-	aiResponse := openRouterResp.Choices[0].Message.Content
-
-	// Debug log response
-	log.Printf("=== AI REORDERING RESPONSE ===")
-	log.Printf("Response length: %d characters", len(aiResponse))
-	log.Printf("Response content: %s", aiResponse)
-	log.Printf("================================")
-
-	// Try parsing with retry mechanism (max 2 retries on parsing failures)
-	var reorderedIDs []interface{}
-	var parseErr error
-	maxRetries := 2
-	
-	for attempt := 0; attempt <= maxRetries; attempt++ {
-		if attempt > 0 {
-			log.Printf("=== RETRY ATTEMPT %d/%d ===", attempt, maxRetries)
-			
-			// Slightly modify the prompt on retries to encourage different AI response
-			retryPrompt := s.buildReorderingPromptWithOptions(highlightMap, customPrompt + "\n\nIMPORTANT: Ensure valid JSON syntax with proper commas between all array elements.", options)
-			
-			// Make another API call with modified prompt
-			requestData.Messages[0].Content = retryPrompt
-			jsonData, err := json.Marshal(requestData)
-			if err != nil {
-				parseErr = fmt.Errorf("failed to marshal retry request: %w", err)
-				continue
-			}
-
-			req, err := http.NewRequest("POST", "https://openrouter.ai/api/v1/chat/completions", bytes.NewBuffer(jsonData))
-			if err != nil {
-				parseErr = fmt.Errorf("failed to create retry request: %w", err)
-				continue
-			}
-
-			req.Header.Set("Authorization", "Bearer "+apiKey)
-			req.Header.Set("Content-Type", "application/json")
-			req.Header.Set("HTTP-Referer", "https://github.com/yourusername/video-app")
-			req.Header.Set("X-Title", "Video Highlight Reordering")
-
-			resp, err := client.Do(req)
-			if err != nil {
-				parseErr = fmt.Errorf("failed to make retry request: %w", err)
-				continue
-			}
-			defer resp.Body.Close()
-
-			body, err := io.ReadAll(resp.Body)
-			if err != nil {
-				parseErr = fmt.Errorf("failed to read retry response: %w", err)
-				continue
-			}
-
-			if resp.StatusCode != http.StatusOK {
-				parseErr = fmt.Errorf("OpenRouter API error on retry (status %d): %s", resp.StatusCode, string(body))
-				continue
-			}
-
-			var retryOpenRouterResp OpenRouterResponse
-			err = json.Unmarshal(body, &retryOpenRouterResp)
-			if err != nil {
-				parseErr = fmt.Errorf("failed to parse retry response: %w", err)
-				continue
-			}
-
-			if retryOpenRouterResp.Error != nil {
-				parseErr = fmt.Errorf("OpenRouter API error on retry: %s", retryOpenRouterResp.Error.Message)
-				continue
-			}
-
-			if len(retryOpenRouterResp.Choices) == 0 {
-				parseErr = fmt.Errorf("no response choices received from AI on retry")
-				continue
-			}
-
-			aiResponse = retryOpenRouterResp.Choices[0].Message.Content
-			log.Printf("Retry response: %s", aiResponse)
-		}
-
-		// Try to parse the AI response
-		reorderedIDs, parseErr = s.parseAIReorderingResponseWithMissingDetection(aiResponse, originalIDs, projectID)
-		if parseErr == nil {
-			log.Printf("Successfully parsed AI response on attempt %d", attempt+1)
-			break
-		}
-		
-		log.Printf("Parse attempt %d failed: %v", attempt+1, parseErr)
-	}
-	
-	if parseErr != nil {
-		return nil, fmt.Errorf("failed to parse AI response after %d attempts: %w", maxRetries+1, parseErr)
+		return nil, fmt.Errorf("failed to get AI reordering: %w", err)
 	}
+	reorderedIDs := s.collectReorderedItems(items, originalIDs, projectID)
 
 	// Debug log parsed reordering
 	log.Printf("=== PARSED AI REORDERING ===")
@@ -1026,6 +1017,10 @@ type AIActionOptions struct {
 	CreateSections       bool `json:"createSections"`
 	BalanceLength        bool `json:"balanceLength"`
 	ImproveTransitions   bool `json:"improveTransitions"`
+	prompts.Selection // library prompt replacing the custom and project prompts
+	// TargetDurationSeconds asks for a cut of this runtime; 0 keeps the runtime unconstrained
+	TargetDurationSeconds    float64 `json:"targetDurationSeconds"`
+	DurationToleranceSeconds float64 `json:"durationToleranceSeconds"` // 0 allows 10% of the target
 }
 
 // buildReorderingPrompt creates a prompt for the AI to reorder highlights intelligently
@@ -1155,68 +1150,53 @@ func (s *AIService) parseAIReorderingResponse(response string) ([]interface{}, e
 	return s.parseAIReorderingResponseWithMissingDetection(response, nil, 0)
 }
 
-// sanitizeAIJSON fixes common AI JSON formatting issues
-func (s *AIService) sanitizeAIJSON(response string) string {
-	// Clean the response - remove any markdown formatting
-	cleanResponse := strings.TrimSpace(response)
-	cleanResponse = strings.Trim(cleanResponse, "`")
-	if strings.HasPrefix(cleanResponse, "json") {
-		cleanResponse = strings.TrimPrefix(cleanResponse, "json")
-		cleanResponse = strings.TrimSpace(cleanResponse)
+// reorderingSchema describes a highlight order: highlight IDs, "N" breaks and section objects
+var reorderingSchema = ai.StructuredSchema{
+	Name: "highlight_order",
+	Schema: map[string]interface{}{
+		"type":  "array",
+		"items": ai.HighlightOrderItemSchema,
+	},
+}
+
+// validateReorderingCoverage requires every original highlight in the order when all highlights must be kept
+func validateReorderingCoverage(items []interface{}, originalIDs []string, options AIActionOptions) error {
+	if originalIDs == nil || !options.KeepAllHighlights {
+		return nil
 	}
 
-	// Extract JSON array from response
-	jsonStart := strings.Index(cleanResponse, "[")
-	jsonEnd := strings.LastIndex(cleanResponse, "]")
-	
-	if jsonStart == -1 || jsonEnd == -1 || jsonEnd <= jsonStart {
-		return cleanResponse // Return as-is if no array found
+	included := make(map[string]bool, len(items))
+	for _, item := range items {
+		if id, ok := item.(string); ok {
+			included[id] = true
+		}
 	}
-	
-	jsonPart := cleanResponse[jsonStart : jsonEnd+1]
-	
-	// Fix missing commas between array elements using regex
-	// This pattern matches: "text"<whitespace>"text" and adds comma between
-	re := regexp.MustCompile(`"([^"]+)"\s*\n\s*"([^"]+)"`)
-	jsonPart = re.ReplaceAllString(jsonPart, `"$1",
-  "$2"`)
-	
-	// Fix missing commas between objects and strings
-	// Pattern: }<whitespace>"text" -> },"text"
-	re2 := regexp.MustCompile(`}\s*\n\s*"([^"]+)"`)
-	jsonPart = re2.ReplaceAllString(jsonPart, `},
-  "$1"`)
-	
-	// Pattern: "text"<whitespace>{ -> "text",{
-	re3 := regexp.MustCompile(`"([^"]+)"\s*\n\s*\{`)
-	jsonPart = re3.ReplaceAllString(jsonPart, `"$1",
-  {`)
-	
-	return jsonPart
+
+	var missing []string
+	for _, id := range originalIDs {
+		if !included[id] {
+			missing = append(missing, id)
+		}
+	}
+	if len(missing) > 0 {
+		return fmt.Errorf("every highlight ID must be included, missing: %s", strings.Join(missing, ", "))
+	}
+	return nil
 }
 
 // parseAIReorderingResponseWithMissingDetection extracts the reordered highlight IDs from the AI response
 // and optionally detects missing highlights to add them to the hidden list
 func (s *AIService) parseAIReorderingResponseWithMissingDetection(response string, originalIDs []string, projectID int) ([]interface{}, error) {
-	// First attempt - sanitize and parse
-	sanitizedJSON := s.sanitizeAIJSON(response)
-	
-	// Try to parse as JSON array with mixed types (strings and objects)
-	var reorderedItems []interface{}
-	err := json.Unmarshal([]byte(sanitizedJSON), &reorderedItems)
+	items, err := ai.ParseStructured[[]interface{}](response, reorderingSchema)
 	if err != nil {
-		// Enhanced error logging for debugging
-		log.Printf("=== JSON PARSING ERROR ===")
-		log.Printf("Original response length: %d", len(response))
-		log.Printf("Sanitized JSON length: %d", len(sanitizedJSON))
-		log.Printf("Sanitized JSON content: %s", sanitizedJSON)
-		log.Printf("Parse error: %v", err)
-		log.Printf("==========================")
-		
 		return nil, fmt.Errorf("failed to parse JSON array from AI response: %w", err)
 	}
+	return s.collectReorderedItems(items, originalIDs, projectID), nil
+}
 
-	// Validate and convert items to proper format
+// collectReorderedItems keeps the valid items of an AI order and, when originalIDs and projectID
+// are given, hides the highlights the AI left out
+func (s *AIService) collectReorderedItems(reorderedItems []interface{}, originalIDs []string, projectID int) []interface{} {
 	var result []interface{}
 	includedHighlights := make(map[string]bool)
 	
@@ -1266,7 +1246,7 @@ func (s *AIService) parseAIReorderingResponseWithMissingDetection(response strin
 		}
 	}
 
-	return result, nil
+	return result
 }
 
 // hideHighlights adds multiple highlights to the hidden highlights list
@@ -1275,17 +1255,17 @@ func (s *AIService) hideHighlights(projectID int, highlightIDs []string) error {
 		return nil
 	}
 
-	// Get the project
-	proj, err := s.client.Project.
-		Query().
-		Where(project.ID(projectID)).
-		Only(s.ctx)
-	if err != nil {
-		return fmt.Errorf("failed to get project: %w", err)
+	// Get current hidden highlights, including any held back by an earlier call
+	var hiddenHighlights []string
+	if s.heldHidden != nil && *s.heldHidden != nil {
+		hiddenHighlights = *s.heldHidden
+	} else {
+		state, err := s.highlightService.GetCutState(projectID, s.cutID)
+		if err != nil {
+			return err
+		}
+		hiddenHighlights = state.HiddenHighlights
 	}
-	
-	// Get current hidden highlights
-	hiddenHighlights := proj.HiddenHighlights
 	if hiddenHighlights == nil {
 		hiddenHighlights = []string{}
 	}
@@ -1303,20 +1283,22 @@ func (s *AIService) hideHighlights(projectID int, highlightIDs []string) error {
 		}
 	}
 	
-	// Update database
-	_, err = s.client.Project.
-		UpdateOneID(projectID).
-		SetHiddenHighlights(hiddenHighlights).
-		Save(s.ctx)
-	if err != nil {
-		return fmt.Errorf("failed to update hidden highlights: %w", err)
+	// Hold them for the caller that saves the order, otherwise update the database
+	if s.heldHidden != nil {
+		*s.heldHidden = hiddenHighlights
+		return nil
 	}
-	
-	return nil
+	return s.highlightService.SaveCutHiddenHighlights(projectID, s.cutID, hiddenHighlights)
 }
 
 // processReorderedItems processes the AI-reordered items, removing duplicates while preserving order and sections
 func (s *AIService) processReorderedItems(reorderedItems []interface{}, originalIDs []string) []interface{} {
+	return s.filterReorderedItems(reorderedItems, originalIDs, true)
+}
+
+// filterReorderedItems removes duplicate and unknown IDs from AI-reordered items. Original IDs the
+// model left out are added at the end when keepMissing is set.
+func (s *AIService) filterReorderedItems(reorderedItems []interface{}, originalIDs []string, keepMissing bool) []interface{} {
 	// Create a set of original IDs for validation
 	originalIDSet := make(map[string]bool)
 	for _, id := range originalIDs {
@@ -1348,9 +1330,11 @@ func (s *AIService) processReorderedItems(reorderedItems []interface{}, original
 	}
 
 	// Add any missing original IDs at the end
-	for _, id := range originalIDs {
-		if !seenIDs[id] {
-			result = append(result, id)
+	if keepMissing {
+		for _, id := range originalIDs {
+			if !seenIDs[id] {
+				result = append(result, id)
+			}
 		}
 	}
 
@@ -1398,10 +1382,28 @@ func (s *AIService) deduplicateHighlightIDs(reorderedIDs []string, originalIDs [
 
 // ImproveHighlightSilencesWithAI uses AI to suggest improved timings for highlights with natural silence buffers
 func (s *AIService) ImproveHighlightSilencesWithAI(projectID int, getAPIKey func() (string, error)) ([]ProjectHighlight, error) {
-	// Get OpenRouter API key
-	apiKey, err := getAPIKey()
-	if err != nil || apiKey == "" {
-		return nil, fmt.Errorf("OpenRouter API key not configured")
+	return s.ImproveHighlightSilencesWithAIOptions(projectID, prompts.Selection{}, getAPIKey)
+}
+
+// ImproveHighlightSilencesWithAIOptions improves highlight timings like ImproveHighlightSilencesWithAI,
+// adding the selected library prompt to the silence instructions
+func (s *AIService) ImproveHighlightSilencesWithAIOptions(projectID int, selection prompts.Selection, getAPIKey func() (string, error)) ([]ProjectHighlight, error) {
+	instructions, err := s.libraryPrompt(selection, prompts.TaskSilence, projectID, "")
+	if err != nil {
+		return nil, err
+	}
+	silenceService := *s
+	silenceService.instructions = instructions
+	silenceService.usageProjectID = projectID
+	return silenceService.improveHighlightSilences(projectID, getAPIKey)
+}
+
+// improveHighlightSilences runs silence improvement for every highlight of a project
+func (s *AIService) improveHighlightSilences(projectID int, getAPIKey func() (string, error)) ([]ProjectHighlight, error) {
+	// Get OpenRouter API key (empty when the task runs on another provider)
+	apiKey, err := ai.NewProviderService(s.client, s.ctx).TextAPIKey("improve_silences", getAPIKey)
+	if err != nil {
+		return nil, err
 	}
 
 	// Get project AI settings
@@ -1429,7 +1431,10 @@ func (s *AIService) ImproveHighlightSilencesWithAI(projectID int, getAPIKey func
 	// Get all video clips with transcription words for boundary calculation
 	clips, err := s.client.VideoClip.
 		Query().
-		Where(videoclip.HasProjectWith(project.IDEQ(projectID))).
+		Where(
+			videoclip.HasProjectWith(project.IDEQ(projectID)),
+			videoclip.DeletedAtIsNil(),
+		).
 		All(s.ctx)
 
 	if err != nil {
@@ -1583,6 +1588,7 @@ func (s *AIService) improveVideoHighlights(apiKey string, model string, videoHig
 				End:     timing.End,
 				ColorID: h.ColorID,
 				Text:    h.Text,
+				Insight: h.Insight,
 			}
 		} else {
 			// Keep original if no improvement found
@@ -1593,6 +1599,30 @@ func (s *AIService) improveVideoHighlights(apiKey string, model string, videoHig
 	return improved, nil
 }
 
+// silenceImprovement is an improved highlight timing suggested by AI
+type silenceImprovement = struct {
+	ID    string  `json:"id"`
+	Start float64 `json:"start"`
+	End   float64 `json:"end"`
+}
+
+// silenceImprovementSchema describes the improved timings returned for silence improvement
+var silenceImprovementSchema = ai.StructuredSchema{
+	Name: "silence_improvements",
+	Schema: map[string]interface{}{
+		"type": "array",
+		"items": map[string]interface{}{
+			"type": "object",
+			"properties": map[string]interface{}{
+				"id":    map[string]interface{}{"type": "string"},
+				"start": map[string]interface{}{"type": "number"},
+				"end":   map[string]interface{}{"type": "number"},
+			},
+			"required": []string{"id", "start", "end"},
+		},
+	},
+}
+
 // callOpenRouterForSilenceImprovement calls AI to improve highlight timings
 func (s *AIService) callOpenRouterForSilenceImprovement(apiKey string, model string, boundaries []struct {
 	ID            string  `json:"id"`
@@ -1601,95 +1631,61 @@ func (s *AIService) callOpenRouterForSilenceImprovement(apiKey string, model str
 	CurrentEnd    float64 `json:"currentEnd"`
 	PrevWordEnd   float64 `json:"prevWordEnd"`
 	NextWordStart float64 `json:"nextWordStart"`
-}) ([]struct {
-	ID    string  `json:"id"`
-	Start float64 `json:"start"`
-	End   float64 `json:"end"`
-}, error) {
-	// Create HTTP client
-	client := &http.Client{
-		Timeout: 60 * time.Second,
-	}
-
+}) ([]silenceImprovement, error) {
 	// Build prompt
 	prompt := s.buildSilenceImprovementPrompt(boundaries)
 
-	// Create request
-	requestData := OpenRouterRequest{
-		Model: model,
-		Messages: []Message{
-			{
-				Role:    "user",
-				Content: prompt,
-			},
-		},
-	}
-
 	// Log the full request
 	log.Printf("=== AI SILENCE IMPROVEMENT LLM REQUEST ===")
 	log.Printf("Model: %s", model)
 	log.Printf("User Message: %s", prompt)
 	log.Printf("===========================================")
 
-	jsonData, err := json.Marshal(requestData)
-	if err != nil {
-		return nil, fmt.Errorf("failed to marshal request: %w", err)
-	}
-
-	req, err := http.NewRequest("POST", "https://openrouter.ai/api/v1/chat/completions", bytes.NewBuffer(jsonData))
-	if err != nil {
-		return nil, fmt.Errorf("failed to create request: %w", err)
-	}
-
-	// Set headers
-	req.Header.Set("Authorization", "Bearer "+apiKey)
-	req.Header.Set("Content-Type", "application/json")
-	req.Header.Set("HTTP-Referer", "https://github.com/yourusername/video-app")
-	req.Header.Set("X-Title", "Video Highlight Silence Improvement")
-
-	// Make request
-	resp, err := client.Do(req)
-	if err != nil {
-		return nil, fmt.Errorf("failed to make request: %w", err)
-	}
-	defer resp.Body.Close()
-
-	// Read response
-	body, err := io.ReadAll(resp.Body)
-	if err != nil {
-		return nil, fmt.Errorf("failed to read response: %w", err)
-	}
-
-	if resp.StatusCode != http.StatusOK {
-		return nil, fmt.Errorf("OpenRouter API error (status %d): %s", resp.StatusCode, string(body))
+	known := make(map[string]bool, len(boundaries))
+	for _, b := range boundaries {
+		known[b.ID] = true
 	}
 
-	// Parse response
-	var openRouterResp OpenRouterResponse
-	err = json.Unmarshal(body, &openRouterResp)
-	if err != nil {
-		return nil, fmt.Errorf("failed to parse response: %w", err)
-	}
+	return ai.CompleteStructured(s.taskCompleter(apiKey, model, "Video Highlight Silence Improvement", "improve_silences", s.usageProjectID), prompt, silenceImprovementSchema, func(improvements []silenceImprovement) error {
+		var problems []string
+		for _, improvement := range improvements {
+			if !known[improvement.ID] {
+				problems = append(problems, fmt.Sprintf("unknown highlight ID %q", improvement.ID))
+			} else if improvement.Start >= improvement.End {
+				problems = append(problems, fmt.Sprintf("%s: start must be before end", improvement.ID))
+			}
+		}
+		if len(problems) > 0 {
+			return fmt.Errorf("invalid improvements: %s", strings.Join(problems, "; "))
+		}
+		return nil
+	}, 0)
+}
 
-	if openRouterResp.Error != nil {
-		return nil, fmt.Errorf("OpenRouter API error: %s", openRouterResp.Error.Message)
-	}
+// taskCompleter sends single-message completions for a task to the provider configured for it,
+// through the response cache, usage ledger and budget cap of the ai package
+func (s *AIService) taskCompleter(apiKey string, model string, title string, taskType string, projectID int) ai.Completer {
+	if s.complete != nil {
+		return s.complete
+	}
+	coreAI := ai.NewCoreAIService(s.client, s.ctx)
+
+	return func(userPrompt string, format *ai.ResponseFormat) (string, error) {
+		response, err := coreAI.ProcessText(&ai.TextProcessingRequest{
+			UserPrompt:     userPrompt,
+			Model:          model,
+			TaskType:       taskType,
+			ResponseFormat: format,
+			Context:        map[string]interface{}{"projectID": projectID},
+		}, apiKey)
+		if err != nil {
+			return "", err
+		}
 
-	if len(openRouterResp.Choices) == 0 {
-		return nil, fmt.Errorf("no response choices received from AI")
+		content := response.Choices[0].Message.Content
+		log.Printf("[AI] %s response (%d characters): %s", title, len(content), content)
+		return content, nil
 	}
-
-	// Parse AI response
-	aiResponse := openRouterResp.Choices[0].Message.Content
-
-	// Log the full response
-	log.Printf("=== AI SILENCE IMPROVEMENT LLM RESPONSE ===")
-	log.Printf("Model: %s", model)
-	log.Printf("Assistant Message: %s", aiResponse)
-	log.Printf("Response Length: %d characters", len(aiResponse))
-	log.Printf("============================================")
-
-	return s.parseAISilenceImprovementResponse(aiResponse)
 }
 
 // buildSilenceImprovementPrompt creates the prompt for AI silence improvement
@@ -1732,6 +1728,10 @@ Here are the highlights to improve:
 			(b.NextWordStart-b.CurrentEnd)*1000)
 	}
 
+	if s.instructions != "" {
+		prompt += "ADDITIONAL INSTRUCTIONS:\n" + s.instructions + "\n"
+	}
+
 	prompt += `
 Return a JSON array with improved timings. Each object should have:
 - "id": The highlight ID
@@ -1745,32 +1745,11 @@ Only include highlights where you recommend changes. Format:
 }
 
 // parseAISilenceImprovementResponse parses the AI response for improved timings
-func (s *AIService) parseAISilenceImprovementResponse(response string) ([]struct {
-	ID    string  `json:"id"`
-	Start float64 `json:"start"`
-	End   float64 `json:"end"`
-}, error) {
-	// Extract JSON from response
-	jsonStart := strings.Index(response, "[")
-	jsonEnd := strings.LastIndex(response, "]")
-
-	if jsonStart == -1 || jsonEnd == -1 {
-		return nil, fmt.Errorf("no valid JSON array found in AI response")
-	}
-
-	jsonStr := response[jsonStart : jsonEnd+1]
-
-	var improvements []struct {
-		ID    string  `json:"id"`
-		Start float64 `json:"start"`
-		End   float64 `json:"end"`
-	}
-
-	err := json.Unmarshal([]byte(jsonStr), &improvements)
+func (s *AIService) parseAISilenceImprovementResponse(response string) ([]silenceImprovement, error) {
+	improvements, err := ai.ParseStructured[[]silenceImprovement](response, silenceImprovementSchema)
 	if err != nil {
 		return nil, fmt.Errorf("failed to parse AI improvements JSON: %w", err)
 	}
-
 	return improvements, nil
 }
 
@@ -246,7 +246,7 @@ func TestSaveSuggestedHighlightsAI(t *testing.T) {
 	}
 	
 	t.Run("successfully saves suggestions", func(t *testing.T) {
-		err := service.saveSuggestedHighlights(clip.ID, suggestions, transcriptWords)
+		err := service.saveSuggestedHighlights(clip.ID, suggestions, transcriptWords, 0)
 		assert.NoError(t, err)
 		
 		// Verify suggestions were saved
@@ -257,13 +257,13 @@ func TestSaveSuggestedHighlightsAI(t *testing.T) {
 	})
 	
 	t.Run("handles nonexistent video", func(t *testing.T) {
-		err := service.saveSuggestedHighlights(999999, suggestions, transcriptWords)
+		err := service.saveSuggestedHighlights(999999, suggestions, transcriptWords, 0)
 		assert.Error(t, err)
 		assert.Contains(t, err.Error(), "failed to save suggested highlights")
 	})
 	
 	t.Run("handles empty suggestions", func(t *testing.T) {
-		err := service.saveSuggestedHighlights(clip.ID, []HighlightSuggestion{}, transcriptWords)
+		err := service.saveSuggestedHighlights(clip.ID, []HighlightSuggestion{}, transcriptWords, 0)
 		assert.NoError(t, err)
 	})
 }
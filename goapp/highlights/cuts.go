@@ -0,0 +1,186 @@
+package highlights
+
+import (
+	"fmt"
+	"strconv"
+
+	"ramble-ai/ent"
+	"ramble-ai/ent/cut"
+	"ramble-ai/ent/project"
+	"ramble-ai/goapp/realtime"
+)
+
+// maxOrderHistory is how many earlier orders a cut keeps for undo
+const maxOrderHistory = 20
+
+// CutState is the editable highlight state of a cut
+type CutState struct {
+	CutID             int             `json:"cutId"` // 0 when the project has no cuts yet
+	HighlightOrder    []interface{}   `json:"highlightOrder"`
+	HiddenHighlights  []string        `json:"hiddenHighlights"`
+	OrderHistory      [][]interface{} `json:"orderHistory"`
+	OrderHistoryIndex int             `json:"orderHistoryIndex"`
+	IsActive          bool            `json:"isActive"`
+}
+
+// GetCutState returns the highlight state of a cut. The active cut is edited in place on the
+// project, so its state is read from there; cutID 0 selects the active cut.
+func (s *HighlightService) GetCutState(projectID int, cutID int) (*CutState, error) {
+	proj, err := s.client.Project.
+		Query().
+		Where(project.ID(projectID)).
+		Only(s.ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get project: %w", err)
+	}
+
+	if cutID == 0 || cutID == proj.ActiveCutID {
+		return &CutState{
+			CutID:             proj.ActiveCutID,
+			HighlightOrder:    proj.HighlightOrder,
+			HiddenHighlights:  proj.HiddenHighlights,
+			OrderHistory:      proj.OrderHistory,
+			OrderHistoryIndex: proj.OrderHistoryIndex,
+			IsActive:          true,
+		}, nil
+	}
+
+	c, err := s.client.Cut.
+		Query().
+		Where(cut.ID(cutID), cut.ProjectID(projectID)).
+		Only(s.ctx)
+	if err != nil {
+		if ent.IsNotFound(err) {
+			return nil, fmt.Errorf("cut %d not found in project %d", cutID, projectID)
+		}
+		return nil, fmt.Errorf("failed to get cut: %w", err)
+	}
+
+	return &CutState{
+		CutID:             c.ID,
+		HighlightOrder:    c.HighlightOrder,
+		HiddenHighlights:  c.HiddenHighlights,
+		OrderHistory:      c.OrderHistory,
+		OrderHistoryIndex: c.OrderHistoryIndex,
+	}, nil
+}
+
+// SaveCutHiddenHighlights replaces the hidden highlights of a cut (cutID 0 selects the active cut)
+func (s *HighlightService) SaveCutHiddenHighlights(projectID int, cutID int, hiddenHighlights []string) error {
+	state, err := s.GetCutState(projectID, cutID)
+	if err != nil {
+		return err
+	}
+
+	if state.IsActive {
+		err = s.client.Project.UpdateOneID(projectID).SetHiddenHighlights(hiddenHighlights).Exec(s.ctx)
+	} else {
+		err = s.client.Cut.UpdateOneID(state.CutID).SetHiddenHighlights(hiddenHighlights).Exec(s.ctx)
+	}
+	if err != nil {
+		return fmt.Errorf("failed to update hidden highlights: %w", err)
+	}
+	return nil
+}
+
+// SaveCutHighlightOrder replaces the highlight order of a cut (cutID 0 selects the active cut),
+// keeping the previous order in the cut's undo history
+func (s *HighlightService) SaveCutHighlightOrder(projectID int, cutID int, order []interface{}) error {
+	return s.saveCutOrder(projectID, cutID, order, nil)
+}
+
+// saveCutOrder replaces the highlight order of a cut and, unless hiddenHighlights is nil, its
+// hidden highlights in the same update. The active cut is saved on the project and broadcast.
+func (s *HighlightService) saveCutOrder(projectID int, cutID int, order []interface{}, hiddenHighlights []string) error {
+	state, err := s.GetCutState(projectID, cutID)
+	if err != nil {
+		return err
+	}
+
+	previous := state.HighlightOrder
+	if previous == nil {
+		previous = []interface{}{}
+	}
+	history := append(state.OrderHistory, previous)
+	if len(history) > maxOrderHistory {
+		history = history[len(history)-maxOrderHistory:]
+	}
+
+	if state.IsActive {
+		update := s.client.Project.UpdateOneID(projectID).
+			SetHighlightOrder(order).
+			SetOrderHistory(history).
+			SetOrderHistoryIndex(-1)
+		if hiddenHighlights != nil {
+			update.SetHiddenHighlights(hiddenHighlights)
+		}
+		err = update.Exec(s.ctx)
+	} else {
+		update := s.client.Cut.UpdateOneID(state.CutID).
+			SetHighlightOrder(order).
+			SetOrderHistory(history).
+			SetOrderHistoryIndex(-1)
+		if hiddenHighlights != nil {
+			update.SetHiddenHighlights(hiddenHighlights)
+		}
+		err = update.Exec(s.ctx)
+	}
+	if err != nil {
+		return fmt.Errorf("failed to update cut highlight order: %w", err)
+	}
+
+	if state.IsActive {
+		realtime.GetManager().BroadcastHighlightsReorder(strconv.Itoa(projectID), order)
+	}
+	return nil
+}
+
+// GetCutHighlightsForExport returns the visible highlights of a cut in the cut's order.
+// Highlights missing from the order follow the ordered ones in clip order.
+func (s *HighlightService) GetCutHighlightsForExport(projectID int, cutID int) ([]HighlightSegment, error) {
+	state, err := s.GetCutState(projectID, cutID)
+	if err != nil {
+		return nil, err
+	}
+
+	segments, err := s.GetProjectHighlightsForExport(projectID)
+	if err != nil {
+		return nil, err
+	}
+
+	return orderCutSegments(segments, state.HighlightOrder, state.HiddenHighlights), nil
+}
+
+// orderCutSegments arranges segments by a highlight order, leaving out hidden highlights
+func orderCutSegments(segments []HighlightSegment, order []interface{}, hiddenHighlights []string) []HighlightSegment {
+	hidden := make(map[string]bool, len(hiddenHighlights))
+	for _, id := range hiddenHighlights {
+		hidden[id] = true
+	}
+
+	byID := make(map[string]HighlightSegment, len(segments))
+	for _, segment := range segments {
+		byID[segment.ID] = segment
+	}
+
+	result := make([]HighlightSegment, 0, len(segments))
+	used := make(map[string]bool, len(segments))
+	for _, item := range order {
+		id, ok := item.(string)
+		if !ok || hidden[id] || used[id] {
+			continue
+		}
+		if segment, exists := byID[id]; exists {
+			result = append(result, segment)
+			used[id] = true
+		}
+	}
+
+	for _, segment := range segments {
+		if !used[segment.ID] && !hidden[segment.ID] {
+			result = append(result, segment)
+		}
+	}
+
+	return result
+}
@@ -0,0 +1,132 @@
+package highlights
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"ramble-ai/goapp"
+	"ramble-ai/goapp/ai"
+)
+
+func TestOrderCutSegments(t *testing.T) {
+	segments := []HighlightSegment{{ID: "h1"}, {ID: "h2"}, {ID: "h3"}, {ID: "h4"}}
+
+	ids := func(result []HighlightSegment) []string {
+		out := []string{}
+		for _, segment := range result {
+			out = append(out, segment.ID)
+		}
+		return out
+	}
+
+	t.Run("follows the order and skips hidden highlights", func(t *testing.T) {
+		order := []interface{}{map[string]interface{}{"type": "N", "title": "Intro"}, "h3", "N", "h1", "h3", "unknown"}
+		result := orderCutSegments(segments, order, []string{"h2"})
+		assert.Equal(t, []string{"h3", "h1", "h4"}, ids(result))
+	})
+
+	t.Run("empty order keeps clip order", func(t *testing.T) {
+		assert.Equal(t, []string{"h1", "h2", "h3", "h4"}, ids(orderCutSegments(segments, nil, nil)))
+	})
+}
+
+func TestReorderInactiveCutKeepsProjectSuggestion(t *testing.T) {
+	helper := goapp.NewTestHelper(t)
+	project := helper.CreateTestProject("Cut Project")
+	service := NewAIService(helper.Client, helper.Ctx)
+	service.complete = func(string, *ai.ResponseFormat) (string, error) {
+		return `["h2", "h1"]`, nil
+	}
+	require.NoError(t, service.saveAISuggestion(project.ID, []interface{}{"h1", "h2"}, "old/model", 0))
+
+	cut, err := helper.Client.Cut.Create().SetName("Recap").SetProjectID(project.ID).Save(helper.Ctx)
+	require.NoError(t, err)
+	getHighlights := func(int) ([]ProjectHighlight, error) {
+		return []ProjectHighlight{{Highlights: []HighlightWithText{{ID: "h1", Text: "Intro"}, {ID: "h2", Text: "Payoff"}}}}, nil
+	}
+	getAPIKey := func() (string, error) { return "test-key", nil }
+
+	order, err := service.ReorderCutHighlightsWithAIOptions(project.ID, cut.ID, "", AIActionOptions{KeepAllHighlights: true}, getAPIKey, getHighlights)
+	require.NoError(t, err)
+	assert.Equal(t, []interface{}{"h2", "h1"}, order)
+
+	saved, err := helper.Client.Cut.Get(helper.Ctx, cut.ID)
+	require.NoError(t, err)
+	assert.Equal(t, []interface{}{"h2", "h1"}, saved.HighlightOrder, "the order is saved to the reordered cut")
+
+	suggestion, err := service.GetProjectAISuggestion(project.ID)
+	require.NoError(t, err)
+	assert.Equal(t, []interface{}{"h1", "h2"}, suggestion.Order, "the active cut's suggestion is unchanged")
+	assert.Equal(t, "old/model", suggestion.Model)
+
+	// Reordering the active cut by its ID updates the suggestion
+	require.NoError(t, helper.Client.Project.UpdateOneID(project.ID).SetActiveCutID(cut.ID).Exec(helper.Ctx))
+	_, err = service.ReorderCutHighlightsWithAIOptions(project.ID, cut.ID, "", AIActionOptions{KeepAllHighlights: true}, getAPIKey, getHighlights)
+	require.NoError(t, err)
+	suggestion, err = service.GetProjectAISuggestion(project.ID)
+	require.NoError(t, err)
+	assert.Equal(t, []interface{}{"h2", "h1"}, suggestion.Order)
+}
+
+func TestReorderInactiveCutSavesOrderAndHiddenTogether(t *testing.T) {
+	helper := goapp.NewTestHelper(t)
+	project := helper.CreateTestProject("Cut Project")
+	require.NoError(t, helper.Client.Project.UpdateOneID(project.ID).SetHighlightOrder([]interface{}{"h1", "h2", "h3"}).Exec(helper.Ctx))
+	cut, err := helper.Client.Cut.Create().SetName("Short").SetProjectID(project.ID).SetHighlightOrder([]interface{}{"h1", "h2", "h3"}).Save(helper.Ctx)
+	require.NoError(t, err)
+
+	service := NewAIService(helper.Client, helper.Ctx)
+	service.complete = func(string, *ai.ResponseFormat) (string, error) {
+		return `["h3", "h1"]`, nil
+	}
+	getHighlights := func(int) ([]ProjectHighlight, error) {
+		return []ProjectHighlight{{Highlights: []HighlightWithText{{ID: "h1", Text: "Intro"}, {ID: "h2", Text: "Aside"}, {ID: "h3", Text: "Payoff"}}}}, nil
+	}
+	getAPIKey := func() (string, error) { return "test-key", nil }
+
+	order, err := service.ReorderCutHighlightsWithAIOptions(project.ID, cut.ID, "", AIActionOptions{}, getAPIKey, getHighlights)
+	require.NoError(t, err)
+	assert.Equal(t, []interface{}{"h3", "h1", "h2"}, order, "left out highlights follow, hidden")
+
+	saved, err := helper.Client.Cut.Get(helper.Ctx, cut.ID)
+	require.NoError(t, err)
+	assert.Equal(t, order, saved.HighlightOrder)
+	assert.Equal(t, []string{"h2"}, saved.HiddenHighlights)
+	assert.Equal(t, [][]interface{}{{"h1", "h2", "h3"}}, saved.OrderHistory)
+	assert.Equal(t, -1, saved.OrderHistoryIndex)
+
+	proj, err := helper.Client.Project.Get(helper.Ctx, project.ID)
+	require.NoError(t, err)
+	assert.Equal(t, []interface{}{"h1", "h2", "h3"}, proj.HighlightOrder, "the active cut is untouched")
+	assert.Empty(t, proj.HiddenHighlights)
+	assert.Empty(t, proj.OrderHistory)
+}
+
+func TestSaveCutHighlightOrder(t *testing.T) {
+	helper := goapp.NewTestHelper(t)
+	project := helper.CreateTestProject("Cut Project")
+	active, err := helper.Client.Cut.Create().SetName("Main").SetProjectID(project.ID).Save(helper.Ctx)
+	require.NoError(t, err)
+	other, err := helper.Client.Cut.Create().SetName("Recap").SetProjectID(project.ID).Save(helper.Ctx)
+	require.NoError(t, err)
+	require.NoError(t, helper.Client.Project.UpdateOneID(project.ID).SetActiveCutID(active.ID).SetHighlightOrder([]interface{}{"h1"}).Exec(helper.Ctx))
+	service := NewHighlightService(helper.Client, helper.Ctx)
+
+	require.NoError(t, service.SaveCutHighlightOrder(project.ID, other.ID, []interface{}{"h2", "N", "h1"}))
+	require.NoError(t, service.SaveCutHighlightOrder(project.ID, active.ID, []interface{}{"h1", "h2"}))
+
+	state, err := service.GetCutState(project.ID, other.ID)
+	require.NoError(t, err)
+	assert.Equal(t, []interface{}{"h2", "N", "h1"}, state.HighlightOrder)
+	assert.Equal(t, [][]interface{}{{}}, state.OrderHistory)
+
+	state, err = service.GetCutState(project.ID, 0)
+	require.NoError(t, err)
+	assert.Equal(t, active.ID, state.CutID)
+	assert.Equal(t, []interface{}{"h1", "h2"}, state.HighlightOrder, "the active cut is saved on the project")
+	assert.Equal(t, [][]interface{}{{"h1"}}, state.OrderHistory)
+
+	assert.Error(t, service.SaveCutHighlightOrder(project.ID, other.ID+100, []interface{}{"h1"}))
+}
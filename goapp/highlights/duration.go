@@ -0,0 +1,235 @@
+package highlights
+
+import (
+	"fmt"
+	"log"
+	"sort"
+	"strings"
+)
+
+// defaultDurationToleranceRatio is used when AIActionOptions.DurationToleranceSeconds is unset
+const defaultDurationToleranceRatio = 0.1
+
+// durationTolerance returns the allowed deviation from the target duration in seconds
+func durationTolerance(options AIActionOptions) float64 {
+	if options.DurationToleranceSeconds > 0 {
+		return options.DurationToleranceSeconds
+	}
+	tolerance := options.TargetDurationSeconds * defaultDurationToleranceRatio
+	if tolerance < 1 {
+		tolerance = 1
+	}
+	return tolerance
+}
+
+// getHighlightDurations returns the runtime in seconds of every highlight in a project
+func (s *AIService) getHighlightDurations(projectID int) (map[string]float64, error) {
+	segments, err := s.highlightService.GetProjectHighlightsForExport(projectID)
+	if err != nil {
+		return nil, err
+	}
+
+	durations := make(map[string]float64, len(segments))
+	for _, segment := range segments {
+		if segment.End > segment.Start {
+			durations[segment.ID] = segment.End - segment.Start
+		} else {
+			durations[segment.ID] = 0
+		}
+	}
+	return durations, nil
+}
+
+// buildTargetDurationPrompt adds the runtime of each highlight and the duration goal to a reordering prompt
+func buildTargetDurationPrompt(durations map[string]float64, highlightIDs []string, target, tolerance float64) string {
+	total := 0.0
+	for _, id := range highlightIDs {
+		total += durations[id]
+	}
+
+	var b strings.Builder
+	b.WriteString("\n\nTARGET DURATION:\n")
+	fmt.Fprintf(&b, "- The final cut must run %.0f seconds (acceptable range %.0f-%.0f seconds)\n", target, target-tolerance, target+tolerance)
+	fmt.Fprintf(&b, "- All highlights together run %.1f seconds\n", total)
+	b.WriteString("- Select the strongest highlights whose durations add up to the target and leave the rest out\n")
+	b.WriteString("- Use the exact durations below rather than text length to judge runtime\n\n")
+	b.WriteString("Highlight durations:\n")
+
+	ids := append([]string(nil), highlightIDs...)
+	sort.Strings(ids)
+	for _, id := range ids {
+		fmt.Fprintf(&b, "- %s: %.1fs\n", id, durations[id])
+	}
+	return b.String()
+}
+
+// buildDurationFeedbackPrompt tells the model how far its previous selection was from the target
+func buildDurationFeedbackPrompt(actual, target, tolerance float64) string {
+	direction := "too long, remove highlights"
+	if actual < target {
+		direction = "too short, add highlights back"
+	}
+	return fmt.Sprintf("\n\nYOUR PREVIOUS SELECTION RAN %.1f SECONDS, which is %s to land between %.0f and %.0f seconds.",
+		actual, direction, target-tolerance, target+tolerance)
+}
+
+// orderDuration sums the durations of the unique highlights in an order
+func orderDuration(order []interface{}, durations map[string]float64) float64 {
+	seen := make(map[string]bool)
+	total := 0.0
+	for _, item := range order {
+		if id, ok := item.(string); ok && id != "N" && !seen[id] {
+			seen[id] = true
+			total += durations[id]
+		}
+	}
+	return total
+}
+
+// withinDuration reports whether a runtime is inside the target range
+func withinDuration(actual, target, tolerance float64) bool {
+	return actual >= target-tolerance && actual <= target+tolerance
+}
+
+// fitOrderToDuration deterministically trims or extends an order to land within the target range.
+// Highlights are dropped from the end of the order, preferring ones whose removal keeps the cut
+// long enough, and unused highlights are appended in their original order until the cut is long enough.
+func fitOrderToDuration(order []interface{}, durations map[string]float64, highlightIDs []string, target, tolerance float64) []interface{} {
+	result := append([]interface{}(nil), order...)
+	total := orderDuration(result, durations)
+	minimum, maximum := target-tolerance, target+tolerance
+
+	for total > maximum {
+		remove := -1
+		for i := len(result) - 1; i >= 0; i-- {
+			id, ok := result[i].(string)
+			if !ok || id == "N" {
+				continue
+			}
+			if remove == -1 {
+				remove = i
+			}
+			if total-durations[id] >= minimum {
+				remove = i
+				break
+			}
+		}
+		if remove == -1 {
+			break
+		}
+		total -= durations[result[remove].(string)]
+		result = append(result[:remove], result[remove+1:]...)
+	}
+
+	if total < minimum {
+		included := make(map[string]bool)
+		for _, item := range result {
+			if id, ok := item.(string); ok {
+				included[id] = true
+			}
+		}
+		for _, id := range highlightIDs {
+			if total >= minimum {
+				break
+			}
+			if included[id] || total+durations[id] > maximum {
+				continue
+			}
+			result = append(result, id)
+			included[id] = true
+			total += durations[id]
+		}
+	}
+
+	return dropEmptySections(result)
+}
+
+// dropEmptySections removes section breaks that no longer introduce any highlights
+func dropEmptySections(order []interface{}) []interface{} {
+	isBreak := func(item interface{}) bool {
+		switch v := item.(type) {
+		case string:
+			return v == "N"
+		case map[string]interface{}:
+			return true
+		}
+		return false
+	}
+
+	result := make([]interface{}, 0, len(order))
+	for i, item := range order {
+		if isBreak(item) && (i == len(order)-1 || isBreak(order[i+1])) {
+			continue
+		}
+		result = append(result, item)
+	}
+	return result
+}
+
+// excludedHighlights returns the highlight IDs that do not appear in an order
+func excludedHighlights(order []interface{}, highlightIDs []string) []string {
+	included := make(map[string]bool)
+	for _, item := range order {
+		if id, ok := item.(string); ok {
+			included[id] = true
+		}
+	}
+
+	var excluded []string
+	for _, id := range highlightIDs {
+		if !included[id] {
+			excluded = append(excluded, id)
+		}
+	}
+	return excluded
+}
+
+// reorderToTargetDuration asks the AI for an order that fits the target duration, re-prompting once
+// with feedback and then trimming deterministically if the model misses. Dropped highlights are hidden.
+func (s *AIService) reorderToTargetDuration(apiKey, model string, highlightMap map[string]string, prompt string, options AIActionOptions, highlightIDs []string, projectID int) ([]interface{}, error) {
+	durations, err := s.getHighlightDurations(projectID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get highlight durations: %w", err)
+	}
+
+	target := options.TargetDurationSeconds
+	tolerance := durationTolerance(options)
+	durationPrompt := prompt + buildTargetDurationPrompt(durations, highlightIDs, target, tolerance)
+
+	// The model selects highlights, so the keep-all instruction does not apply
+	selectOptions := options
+	selectOptions.KeepAllHighlights = false
+
+	// Missing highlights are hidden only once the final selection is known (project ID 0 skips detection)
+	reorderedIDs, err := s.callOpenRouterForReorderingWithOptions(apiKey, model, highlightMap, durationPrompt, selectOptions, nil, 0)
+	if err != nil {
+		return nil, err
+	}
+	processedIDs := s.filterReorderedItems(reorderedIDs, highlightIDs, false)
+
+	actual := orderDuration(processedIDs, durations)
+	if !withinDuration(actual, target, tolerance) {
+		log.Printf("[AI] Reordering ran %.1fs for a %.0fs target, re-prompting", actual, target)
+		retryIDs, err := s.callOpenRouterForReorderingWithOptions(apiKey, model, highlightMap, durationPrompt+buildDurationFeedbackPrompt(actual, target, tolerance), selectOptions, nil, 0)
+		if err != nil {
+			log.Printf("[AI] Duration re-prompt failed, keeping first selection: %v", err)
+		} else {
+			processedIDs = s.filterReorderedItems(retryIDs, highlightIDs, false)
+			actual = orderDuration(processedIDs, durations)
+		}
+	}
+
+	if !withinDuration(actual, target, tolerance) {
+		processedIDs = fitOrderToDuration(processedIDs, durations, highlightIDs, target, tolerance)
+		log.Printf("[AI] Trimmed reordering from %.1fs to %.1fs for a %.0fs target", actual, orderDuration(processedIDs, durations), target)
+	}
+
+	if dropped := excludedHighlights(processedIDs, highlightIDs); len(dropped) > 0 {
+		log.Printf("[AI] Hiding %d highlights dropped to fit the target duration: %v", len(dropped), dropped)
+		if err := s.hideHighlights(projectID, dropped); err != nil {
+			log.Printf("Failed to hide dropped highlights: %v", err)
+		}
+	}
+
+	return processedIDs, nil
+}
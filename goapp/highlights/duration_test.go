@@ -0,0 +1,123 @@
+package highlights
+
+import (
+	"encoding/json"
+	"fmt"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"ramble-ai/goapp"
+	"ramble-ai/goapp/ai"
+)
+
+func TestDurationTolerance(t *testing.T) {
+	assert.Equal(t, 5.0, durationTolerance(AIActionOptions{TargetDurationSeconds: 60, DurationToleranceSeconds: 5}))
+	assert.InDelta(t, 6.0, durationTolerance(AIActionOptions{TargetDurationSeconds: 60}), 0.001)
+	assert.Equal(t, 1.0, durationTolerance(AIActionOptions{TargetDurationSeconds: 5}))
+}
+
+func TestOrderDuration(t *testing.T) {
+	durations := map[string]float64{"a": 10, "b": 20, "c": 5}
+	order := []interface{}{map[string]interface{}{"type": "N", "title": "Intro"}, "a", "N", "b", "a"}
+
+	assert.Equal(t, 30.0, orderDuration(order, durations))
+	assert.True(t, withinDuration(30, 32, 2))
+	assert.False(t, withinDuration(29.9, 32, 2))
+}
+
+func TestFitOrderToDuration(t *testing.T) {
+	durations := map[string]float64{"a": 30, "b": 20, "c": 15, "d": 10}
+	ids := []string{"a", "b", "c", "d"}
+
+	t.Run("too long drops from the end while staying in range", func(t *testing.T) {
+		order := []interface{}{"a", "b", "c", "d"}
+		result := fitOrderToDuration(order, durations, ids, 60, 5)
+		assert.Equal(t, []interface{}{"a", "b", "c"}, result)
+		assert.True(t, withinDuration(orderDuration(result, durations), 60, 5))
+	})
+
+	t.Run("too short adds unused highlights", func(t *testing.T) {
+		order := []interface{}{"d"}
+		result := fitOrderToDuration(order, durations, ids, 40, 2)
+		assert.Equal(t, []interface{}{"d", "a"}, result)
+	})
+
+	t.Run("empty sections are removed", func(t *testing.T) {
+		order := []interface{}{
+			map[string]interface{}{"type": "N", "title": "Intro"}, "a",
+			map[string]interface{}{"type": "N", "title": "Outro"}, "b",
+		}
+		result := fitOrderToDuration(order, durations, ids, 30, 1)
+		assert.Equal(t, []interface{}{map[string]interface{}{"type": "N", "title": "Intro"}, "a"}, result)
+	})
+
+	t.Run("in range is unchanged", func(t *testing.T) {
+		order := []interface{}{"b", "c"}
+		assert.Equal(t, order, fitOrderToDuration(order, durations, ids, 35, 1))
+	})
+}
+
+func TestExcludedHighlights(t *testing.T) {
+	order := []interface{}{"a", "N", "c"}
+	assert.Equal(t, []string{"b", "d"}, excludedHighlights(order, []string{"a", "b", "c", "d"}))
+}
+
+func TestBuildTargetDurationPrompt(t *testing.T) {
+	prompt := buildTargetDurationPrompt(map[string]float64{"highlight_b": 12.5, "highlight_a": 4}, []string{"highlight_b", "highlight_a"}, 60, 6)
+	assert.Contains(t, prompt, "acceptable range 54-66 seconds")
+	assert.Contains(t, prompt, "run 16.5 seconds")
+	assert.Contains(t, prompt, "- highlight_a: 4.0s\n- highlight_b: 12.5s")
+
+	assert.Contains(t, buildDurationFeedbackPrompt(80, 60, 6), "too long")
+	assert.Contains(t, buildDurationFeedbackPrompt(20, 60, 6), "too short")
+}
+
+func TestReorderToTargetDuration(t *testing.T) {
+	helper := goapp.NewTestHelper(t)
+	project := helper.CreateTestProject("Duration Project")
+	clip := helper.CreateTestVideoClip(project, "interview.mp4")
+	a := helper.CreateTestHighlight(clip, 0, 20)
+	b := helper.CreateTestHighlight(clip, 20, 30)
+	c := helper.CreateTestHighlight(clip, 30, 60)
+	ids := []string{a, b, c}
+	highlightMap := map[string]string{a: "Intro", b: "Story", c: "Payoff"}
+	options := AIActionOptions{TargetDurationSeconds: 30, DurationToleranceSeconds: 2}
+
+	// scripted replies with the given orders in turn and records the prompts, failing past the last one
+	scripted := func(orders ...[]string) (*AIService, *[]string) {
+		service := NewAIService(helper.Client, helper.Ctx)
+		var prompts []string
+		service.complete = func(prompt string, _ *ai.ResponseFormat) (string, error) {
+			if len(prompts) == len(orders) {
+				return "", fmt.Errorf("unexpected call %d", len(prompts)+1)
+			}
+			reply, err := json.Marshal(orders[len(prompts)])
+			prompts = append(prompts, prompt)
+			return string(reply), err
+		}
+		return service, &prompts
+	}
+
+	t.Run("a fitting subset is kept with one call", func(t *testing.T) {
+		service, prompts := scripted([]string{c})
+		order, err := service.reorderToTargetDuration("", "test-model", highlightMap, "", options, ids, project.ID)
+		require.NoError(t, err)
+		assert.Equal(t, []interface{}{c}, order)
+		assert.Len(t, *prompts, 1)
+
+		state, err := service.highlightService.GetCutState(project.ID, 0)
+		require.NoError(t, err)
+		assert.ElementsMatch(t, []string{a, b}, state.HiddenHighlights)
+	})
+
+	t.Run("a missed target is re-prompted with the selection's runtime", func(t *testing.T) {
+		service, prompts := scripted([]string{a, b, c}, []string{b, a})
+		order, err := service.reorderToTargetDuration("", "test-model", highlightMap, "", options, ids, project.ID)
+		require.NoError(t, err)
+		assert.Equal(t, []interface{}{b, a}, order)
+		require.Len(t, *prompts, 2)
+		assert.Contains(t, (*prompts)[1], "RAN 60.0 SECONDS")
+	})
+}
@@ -26,13 +26,14 @@ type Highlight struct {
 
 // HighlightWithText represents a highlight with its text content
 type HighlightWithText struct {
-	ID         string  `json:"id"`
-	Start      float64 `json:"start"`
-	End        float64 `json:"end"`
-	ColorID    int     `json:"colorId"`
-	Text       string  `json:"text"`
-	StartIndex int     `json:"startIndex"` // Word index where highlight starts
-	EndIndex   int     `json:"endIndex"`   // Word index where highlight ends
+	ID         string                   `json:"id"`
+	Start      float64                  `json:"start"`
+	End        float64                  `json:"end"`
+	ColorID    int                      `json:"colorId"`
+	Text       string                   `json:"text"`
+	StartIndex int                      `json:"startIndex"` // Word index where highlight starts
+	EndIndex   int                      `json:"endIndex"`   // Word index where highlight ends
+	Insight    *schema.HighlightInsight `json:"insight,omitempty"`
 }
 
 // ProjectHighlight represents a video clip with its highlights
@@ -64,11 +65,12 @@ type ProjectHighlightAISettings struct {
 
 // HighlightSuggestion represents an AI-generated highlight suggestion
 type HighlightSuggestion struct {
-	ID      string `json:"id"`
-	Start   int    `json:"start"`
-	End     int    `json:"end"`
-	Text    string `json:"text"`
-	ColorID int    `json:"colorId"`
+	ID      string                   `json:"id"`
+	Start   int                      `json:"start"`
+	End     int                      `json:"end"`
+	Text    string                   `json:"text"`
+	ColorID int                      `json:"colorId"`
+	Insight *schema.HighlightInsight `json:"insight,omitempty"`
 }
 
 // HighlightService provides highlight management functionality
@@ -143,6 +145,7 @@ func (s *HighlightService) GetSuggestedHighlights(videoID int) ([]HighlightSugge
 			End:     endIndex,
 			Text:    text,
 			ColorID: h.ColorID,
+			Insight: h.Insight,
 		}
 		suggestions = append(suggestions, suggestion)
 	}
@@ -278,7 +281,10 @@ func (s *HighlightService) GetProjectHighlights(projectID int) ([]ProjectHighlig
 	// Get all video clips for the project with their highlights
 	clips, err := s.client.VideoClip.
 		Query().
-		Where(videoclip.HasProjectWith(project.IDEQ(projectID))).
+		Where(
+			videoclip.HasProjectWith(project.IDEQ(projectID)),
+			videoclip.DeletedAtIsNil(),
+		).
 		All(s.ctx)
 
 	if err != nil {
@@ -300,6 +306,7 @@ func (s *HighlightService) GetProjectHighlights(projectID int) ([]ProjectHighlig
 				Start:   h.Start,
 				End:     h.End,
 				ColorID: h.ColorID,
+				Insight: h.Insight,
 			}
 
 			// Extract text and word indices for the highlight if transcription exists
@@ -435,7 +442,10 @@ func (s *HighlightService) GetProjectHighlightsForExport(projectID int) ([]Highl
 	// Get all video clips for the project with their highlights
 	clips, err := s.client.VideoClip.
 		Query().
-		Where(videoclip.HasProjectWith(project.IDEQ(projectID))).
+		Where(
+			videoclip.HasProjectWith(project.IDEQ(projectID)),
+			videoclip.DeletedAtIsNil(),
+		).
 		All(s.ctx)
 
 	if err != nil {
@@ -0,0 +1,196 @@
+package highlights
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+
+	"ramble-ai/ent/schema"
+)
+
+// HighlightCategories are the categories AI may assign to a suggested highlight
+var HighlightCategories = []string{"hook", "insight", "story", "cta", "quote", "humor", "other"}
+
+// Sort keys accepted by HighlightFilter
+const (
+	HighlightSortByTime     = "time" // default: transcript order for suggestions, timeline order for highlights
+	HighlightSortByScore    = "score"
+	HighlightSortByCategory = "category"
+)
+
+// HighlightFilter selects and orders highlights by their insight metadata. Zero values leave a criterion unset.
+// Highlights without insight metadata have no category and a score of 0.
+type HighlightFilter struct {
+	Categories []string `json:"categories,omitempty"` // highlights must have one of these categories
+	MinScore   *float64 `json:"minScore,omitempty"`
+	SortBy     string   `json:"sortBy,omitempty"` // defaults to time
+	SortDesc   bool     `json:"sortDesc,omitempty"`
+}
+
+// GetSuggestedHighlightsFiltered retrieves the suggested highlights of a video matching a filter in the requested order
+func (s *HighlightService) GetSuggestedHighlightsFiltered(videoID int, filter HighlightFilter) ([]HighlightSuggestion, error) {
+	if err := filter.validate(); err != nil {
+		return nil, err
+	}
+
+	suggestions, err := s.GetSuggestedHighlights(videoID)
+	if err != nil {
+		return nil, err
+	}
+
+	result := []HighlightSuggestion{}
+	for _, suggestion := range suggestions {
+		if filter.matches(suggestion.Insight) {
+			result = append(result, suggestion)
+		}
+	}
+	sortByInsight(result, filter, func(s HighlightSuggestion) *schema.HighlightInsight { return s.Insight })
+	return result, nil
+}
+
+// GetProjectHighlightsFiltered retrieves the highlights of a project matching a filter, ordered within each clip.
+// Clips left without matching highlights are omitted.
+func (s *HighlightService) GetProjectHighlightsFiltered(projectID int, filter HighlightFilter) ([]ProjectHighlight, error) {
+	if err := filter.validate(); err != nil {
+		return nil, err
+	}
+
+	projectHighlights, err := s.GetProjectHighlights(projectID)
+	if err != nil {
+		return nil, err
+	}
+
+	result := []ProjectHighlight{}
+	for _, ph := range projectHighlights {
+		var matching []HighlightWithText
+		for _, h := range ph.Highlights {
+			if filter.matches(h.Insight) {
+				matching = append(matching, h)
+			}
+		}
+		if len(matching) == 0 {
+			continue
+		}
+		sortByInsight(matching, filter, func(h HighlightWithText) *schema.HighlightInsight { return h.Insight })
+		ph.Highlights = matching
+		result = append(result, ph)
+	}
+	return result, nil
+}
+
+// validate rejects unknown sort keys and categories
+func (f HighlightFilter) validate() error {
+	switch f.SortBy {
+	case "", HighlightSortByTime, HighlightSortByScore, HighlightSortByCategory:
+	default:
+		return fmt.Errorf("unknown highlight sort key: %s", f.SortBy)
+	}
+	for _, category := range f.Categories {
+		if normalizeHighlightCategory(category) != strings.ToLower(strings.TrimSpace(category)) {
+			return fmt.Errorf("unknown highlight category: %s", category)
+		}
+	}
+	return nil
+}
+
+// matches reports whether a highlight with the given insight passes the filter
+func (f HighlightFilter) matches(insight *schema.HighlightInsight) bool {
+	score, category := insightScore(insight), insightCategory(insight)
+	if f.MinScore != nil && score < *f.MinScore {
+		return false
+	}
+	if len(f.Categories) == 0 {
+		return true
+	}
+	for _, allowed := range f.Categories {
+		if strings.EqualFold(strings.TrimSpace(allowed), category) {
+			return true
+		}
+	}
+	return false
+}
+
+// sortByInsight orders items by the filter's sort key, keeping the existing order for ties and the time sort
+func sortByInsight[T any](items []T, filter HighlightFilter, insightOf func(T) *schema.HighlightInsight) {
+	var less func(a, b *schema.HighlightInsight) bool
+	switch filter.SortBy {
+	case HighlightSortByScore:
+		less = func(a, b *schema.HighlightInsight) bool { return insightScore(a) < insightScore(b) }
+	case HighlightSortByCategory:
+		less = func(a, b *schema.HighlightInsight) bool { return insightCategory(a) < insightCategory(b) }
+	default:
+		if filter.SortDesc {
+			for i, j := 0, len(items)-1; i < j; i, j = i+1, j-1 {
+				items[i], items[j] = items[j], items[i]
+			}
+		}
+		return
+	}
+
+	sort.SliceStable(items, func(i, j int) bool {
+		a, b := insightOf(items[i]), insightOf(items[j])
+		if filter.SortDesc {
+			return less(b, a)
+		}
+		return less(a, b)
+	})
+}
+
+// insightScore returns the score of an insight, 0 when there is none
+func insightScore(insight *schema.HighlightInsight) float64 {
+	if insight == nil {
+		return 0
+	}
+	return insight.Score
+}
+
+// insightCategory returns the category of an insight, empty when there is none
+func insightCategory(insight *schema.HighlightInsight) string {
+	if insight == nil {
+		return ""
+	}
+	return insight.Category
+}
+
+// insight returns the metadata AI gave for a range, or nil when it gave none
+func (r highlightRange) insight() *schema.HighlightInsight {
+	category := normalizeHighlightCategory(r.Category)
+	rationale := strings.TrimSpace(r.Rationale)
+	if r.Score == 0 && category == "" && rationale == "" {
+		return nil
+	}
+	return &schema.HighlightInsight{
+		Score:     r.Score,
+		Category:  category,
+		Rationale: rationale,
+	}
+}
+
+// normalizeHighlightCategory maps a category given by AI to one of HighlightCategories,
+// using "other" for unknown categories and "" when none was given
+func normalizeHighlightCategory(category string) string {
+	category = strings.ToLower(strings.TrimSpace(category))
+	switch category {
+	case "":
+		return ""
+	case "call to action", "call-to-action":
+		return "cta"
+	}
+	for _, known := range HighlightCategories {
+		if category == known {
+			return category
+		}
+	}
+	return "other"
+}
+
+// stampSuggestionProvenance records which model and library prompt version suggested each highlight
+func stampSuggestionProvenance(suggestions []HighlightSuggestion, model string, promptID int) {
+	for i := range suggestions {
+		if suggestions[i].Insight == nil {
+			suggestions[i].Insight = &schema.HighlightInsight{}
+		}
+		suggestions[i].Insight.Model = model
+		suggestions[i].Insight.PromptID = promptID
+	}
+}
@@ -0,0 +1,140 @@
+package highlights
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"ramble-ai/ent/schema"
+	"ramble-ai/goapp/prompts"
+)
+
+func TestHighlightRangeInsight(t *testing.T) {
+	assert.Nil(t, highlightRange{Start: 1, End: 4}.insight())
+
+	insight := highlightRange{Start: 1, End: 4, Score: 0.8, Category: " Call to Action ", Rationale: " Asks viewers to subscribe "}.insight()
+	require.NotNil(t, insight)
+	assert.Equal(t, schema.HighlightInsight{Score: 0.8, Category: "cta", Rationale: "Asks viewers to subscribe"}, *insight)
+
+	assert.Equal(t, "other", normalizeHighlightCategory("anecdote"))
+	assert.Equal(t, "story", normalizeHighlightCategory("Story"))
+}
+
+func TestParseSuggestionsWithInsights(t *testing.T) {
+	service := &AIService{highlightService: &HighlightService{}}
+	words := testTranscript(10)
+
+	suggestions, err := service.parseAIHighlightSuggestionsResponse(`[{"start": 1, "end": 4, "score": 0.7, "category": "quote", "rationale": "Memorable line"}, {"start": 5, "end": 8}]`, words)
+	require.NoError(t, err)
+	require.Len(t, suggestions, 2)
+	assert.Equal(t, &schema.HighlightInsight{Score: 0.7, Category: "quote", Rationale: "Memorable line"}, suggestions[0].Insight)
+	assert.Nil(t, suggestions[1].Insight)
+
+	stampSuggestionProvenance(suggestions, "test/model", 7)
+	assert.Equal(t, "test/model", suggestions[0].Insight.Model)
+	assert.Equal(t, &schema.HighlightInsight{Model: "test/model", PromptID: 7}, suggestions[1].Insight)
+
+	_, err = service.parseAIHighlightSuggestionsResponse(`[{"start": 1, "end": 4, "score": 3}]`, words)
+	assert.ErrorContains(t, err, "$[0].score must be at most 1")
+}
+
+func TestHighlightFilter(t *testing.T) {
+	helper, service := setupAITestHelper(t)
+	hs := service.highlightService
+	project := helper.CreateTestProject("Insight Filters")
+	clip := helper.CreateTestVideoClip(project, "Clip")
+	other := helper.CreateTestVideoClip(project, "Other")
+
+	_, err := helper.Client.VideoClip.
+		UpdateOneID(clip.ID).
+		SetTranscription("w0 w1 w2 w3 w4 w5").
+		SetTranscriptionWords(testTranscript(6)).
+		SetHighlights([]schema.Highlight{
+			{ID: "plain", Start: 0, End: 1},
+			{ID: "story", Start: 2, End: 3, Insight: &schema.HighlightInsight{Score: 0.4, Category: "story"}},
+			{ID: "hook", Start: 4, End: 5, Insight: &schema.HighlightInsight{Score: 0.9, Category: "hook"}},
+		}).
+		SetSuggestedHighlights([]schema.Highlight{
+			{ID: "s1", Start: 0, End: 1, Insight: &schema.HighlightInsight{Score: 0.3, Category: "humor"}},
+			{ID: "s2", Start: 2, End: 3, Insight: &schema.HighlightInsight{Score: 0.8, Category: "hook"}},
+		}).
+		Save(helper.Ctx)
+	require.NoError(t, err)
+	_, err = helper.Client.VideoClip.
+		UpdateOneID(other.ID).
+		SetHighlights([]schema.Highlight{{ID: "other", Start: 0, End: 1}}).
+		Save(helper.Ctx)
+	require.NoError(t, err)
+
+	ids := func(highlights []HighlightWithText) []string {
+		var result []string
+		for _, h := range highlights {
+			result = append(result, h.ID)
+		}
+		return result
+	}
+
+	t.Run("project highlights sorted by score", func(t *testing.T) {
+		result, err := hs.GetProjectHighlightsFiltered(project.ID, HighlightFilter{SortBy: HighlightSortByScore, SortDesc: true})
+		require.NoError(t, err)
+		require.Len(t, result, 2)
+		assert.Equal(t, []string{"hook", "story", "plain"}, ids(result[0].Highlights))
+	})
+
+	t.Run("clips without matching highlights are dropped", func(t *testing.T) {
+		minScore := 0.5
+		result, err := hs.GetProjectHighlightsFiltered(project.ID, HighlightFilter{MinScore: &minScore})
+		require.NoError(t, err)
+		require.Len(t, result, 1)
+		assert.Equal(t, clip.ID, result[0].VideoClipID)
+		assert.Equal(t, []string{"hook"}, ids(result[0].Highlights))
+
+		result, err = hs.GetProjectHighlightsFiltered(project.ID, HighlightFilter{Categories: []string{"Story"}})
+		require.NoError(t, err)
+		require.Len(t, result, 1)
+		assert.Equal(t, []string{"story"}, ids(result[0].Highlights))
+	})
+
+	t.Run("suggestions filtered by category and sorted by score", func(t *testing.T) {
+		result, err := hs.GetSuggestedHighlightsFiltered(clip.ID, HighlightFilter{Categories: []string{"hook", "humor"}, SortBy: HighlightSortByScore, SortDesc: true})
+		require.NoError(t, err)
+		require.Len(t, result, 2)
+		assert.Equal(t, "s2", result[0].ID)
+		assert.Equal(t, "hook", result[0].Insight.Category)
+
+		result, err = hs.GetSuggestedHighlightsFiltered(clip.ID, HighlightFilter{Categories: []string{"cta"}})
+		require.NoError(t, err)
+		assert.Empty(t, result)
+	})
+
+	t.Run("unknown sort keys and categories are rejected", func(t *testing.T) {
+		_, err := hs.GetSuggestedHighlightsFiltered(clip.ID, HighlightFilter{SortBy: "length"})
+		assert.EqualError(t, err, "unknown highlight sort key: length")
+		_, err = hs.GetProjectHighlightsFiltered(project.ID, HighlightFilter{Categories: []string{"drama"}})
+		assert.EqualError(t, err, "unknown highlight category: drama")
+	})
+}
+
+func TestPromptProvenance(t *testing.T) {
+	helper, service := setupAITestHelper(t)
+	project := helper.CreateTestProject("Prompt Provenance")
+	clip := helper.CreateTestVideoClip(project, "Clip")
+
+	reorderPrompt, err := prompts.NewPromptService(helper.Client, helper.Ctx).SavePrompt(prompts.PromptInput{Name: "Recap", TaskType: prompts.TaskReorder, Body: "Make a recap"})
+	require.NoError(t, err)
+
+	_, err = service.SuggestHighlightsWithAIOptions(project.ID, clip.ID, "", SuggestionOptions{Selection: prompts.Selection{PromptID: reorderPrompt.ID}})
+	assert.EqualError(t, err, "prompt Recap is a reorder prompt, not suggest")
+
+	require.NoError(t, service.saveAISuggestion(project.ID, []interface{}{"h1"}, "test/model", reorderPrompt.ID))
+	suggestion, err := service.GetProjectAISuggestion(project.ID)
+	require.NoError(t, err)
+	assert.Equal(t, reorderPrompt.ID, suggestion.PromptID)
+
+	words := testTranscript(5)
+	require.NoError(t, service.saveSuggestedHighlights(clip.ID, []HighlightSuggestion{{ID: "suggestion_0_2", Start: 0, End: 2}}, words, 42))
+	updated, err := helper.Client.VideoClip.Get(helper.Ctx, clip.ID)
+	require.NoError(t, err)
+	assert.Equal(t, 42, updated.SuggestedHighlightsPromptID)
+}
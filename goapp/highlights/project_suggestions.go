@@ -0,0 +1,337 @@
+package highlights
+
+import (
+	"fmt"
+	"log"
+	"strings"
+	"unicode"
+
+	"ramble-ai/ent/project"
+	"ramble-ai/ent/schema"
+	"ramble-ai/ent/videoclip"
+	"ramble-ai/goapp/ai"
+	"ramble-ai/goapp/prompts"
+)
+
+const (
+	// defaultSimilarityThreshold is the word overlap above which two highlights count as the same point
+	defaultSimilarityThreshold = 0.6
+	// maxCoveredPointsInPrompt bounds how many already covered points are listed in each prompt
+	maxCoveredPointsInPrompt = 30
+	// maxCoveredPointLength bounds the length of each covered point listed in a prompt
+	maxCoveredPointLength = 200
+)
+
+// ProjectSuggestionOptions configures a suggestion run across all clips of a project
+type ProjectSuggestionOptions struct {
+	Windowing             SuggestionOptions `json:"windowing"`             // per-clip transcript windowing (its prompt selection is not used)
+	DurationBudgetSeconds float64           `json:"durationBudgetSeconds"` // total length of all new suggestions, 0 = unlimited
+	SimilarityThreshold   float64           `json:"similarityThreshold"`   // 0-1 word overlap treated as duplicate, 0 = default
+	prompts.Selection                       // library prompt replacing the custom and project prompts
+}
+
+// ClipSuggestions is the result of a project suggestion run for one clip
+type ClipSuggestions struct {
+	VideoClipID   int                   `json:"videoClipId"`
+	VideoClipName string                `json:"videoClipName"`
+	Suggestions   []HighlightSuggestion `json:"suggestions"`
+}
+
+// projectSuggestion is a suggestion of a project run along with the clip it belongs to
+type projectSuggestion struct {
+	clip       int // index into the run's clips
+	suggestion HighlightSuggestion
+	duration   float64
+}
+
+// SuggestProjectHighlightsWithAI suggests highlights for every transcribed clip of a project in one run.
+// Points already highlighted or suggested in another clip are not suggested again, the total length
+// of new suggestions stays within the duration budget, and each clip's suggested highlights are replaced.
+func (s *AIService) SuggestProjectHighlightsWithAI(projectID int, customPrompt string, options ProjectSuggestionOptions) ([]ClipSuggestions, error) {
+	aiSettings, err := s.highlightService.GetProjectHighlightAISettings(projectID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get project highlight AI settings: %w", err)
+	}
+
+	// Use custom prompt if provided, otherwise use project's saved prompt; a selected library prompt replaces both
+	prompt := customPrompt
+	if prompt == "" {
+		prompt = aiSettings.AIPrompt
+	}
+	prompt, err = s.libraryPrompt(options.Selection, prompts.TaskSuggest, projectID, prompt)
+	if err != nil {
+		return nil, err
+	}
+
+	clips, err := s.client.VideoClip.
+		Query().
+		Where(
+			videoclip.HasProjectWith(project.ID(projectID)),
+			videoclip.DeletedAtIsNil(),
+			videoclip.TranscriptionNEQ(""),
+		).
+		Order(videoclip.ByID()).
+		All(s.ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get video clips: %w", err)
+	}
+
+	// Word timings are needed to measure durations and save suggestions as times
+	var transcribed []*clipTranscript
+	for _, clip := range clips {
+		if len(clip.TranscriptionWords) == 0 {
+			log.Printf("[AI] Skipping clip %d in project suggestions: no word timings", clip.ID)
+			continue
+		}
+		transcribed = append(transcribed, &clipTranscript{
+			id:         clip.ID,
+			name:       clip.Name,
+			words:      clip.TranscriptionWords,
+			highlights: clip.Highlights,
+		})
+	}
+	if len(transcribed) == 0 {
+		return nil, fmt.Errorf("project has no transcribed clips")
+	}
+
+	// Points the project already has highlighted are covered in every clip
+	existing, err := s.highlightService.GetProjectHighlights(projectID)
+	if err != nil {
+		return nil, err
+	}
+	var covered []string
+	for _, ph := range existing {
+		for _, h := range ph.Highlights {
+			if strings.TrimSpace(h.Text) != "" {
+				covered = append(covered, h.Text)
+			}
+		}
+	}
+
+	factory := ai.NewAIServiceFactory(s.client, s.ctx)
+	aiService, err := factory.CreateService()
+	if err != nil {
+		return nil, fmt.Errorf("failed to create AI service: %w", err)
+	}
+	complete := ai.TextCompleter(aiService, ai.TextProcessingRequest{
+		SystemPrompt: s.buildHighlightSuggestionsSystemPrompt(prompt),
+		Model:        aiSettings.AIModel,
+		TaskType:     "suggest_highlights",
+		Context: map[string]interface{}{
+			"projectID": projectID,
+		},
+	})
+
+	threshold := options.SimilarityThreshold
+	if threshold <= 0 || threshold > 1 {
+		threshold = defaultSimilarityThreshold
+	}
+	windowSize, windowOverlap := suggestionWindowSettings(options.Windowing)
+
+	var candidates []projectSuggestion
+	var firstErr error
+	for i, clip := range transcribed {
+		windows := transcriptWindows(len(clip.words), windowSize, windowOverlap)
+		log.Printf("[AI] Project suggestions: clip %d/%d (%s), %d words in %d windows", i+1, len(transcribed), clip.name, len(clip.words), len(windows))
+
+		suggestions, err := s.suggestHighlightsInWindows(withCoveredPoints(complete, covered), clip.words, clip.highlights, windows)
+		if err != nil {
+			log.Printf("[AI] Project suggestions: clip %d failed: %v", clip.id, err)
+			if firstErr == nil {
+				firstErr = err
+			}
+			continue
+		}
+		clip.processed = true
+
+		suggestions = s.filterValidHighlightSuggestions(suggestions, clip.highlights, clip.words)
+		stampSuggestionProvenance(suggestions, aiSettings.AIModel, options.PromptID)
+		for _, suggestion := range suggestions {
+			if duplicate, similarity := mostSimilarText(suggestion.Text, covered); similarity >= threshold {
+				log.Printf("[AI] Dropping suggestion %s in clip %d: %.0f%% similar to %q", suggestion.ID, clip.id, similarity*100, truncateText(duplicate, 60))
+				continue
+			}
+			covered = append(covered, suggestion.Text)
+			candidates = append(candidates, projectSuggestion{
+				clip:       i,
+				suggestion: suggestion,
+				duration:   suggestionDuration(suggestion, clip.words),
+			})
+		}
+	}
+
+	if firstErr != nil && !anyClipProcessed(transcribed) {
+		return nil, fmt.Errorf("failed to get AI highlight suggestions: %w", firstErr)
+	}
+
+	if options.DurationBudgetSeconds > 0 {
+		candidates = s.rankProjectSuggestions(complete, transcribed, candidates)
+		candidates = fitSuggestionsToBudget(candidates, options.DurationBudgetSeconds)
+	}
+
+	results := make([]ClipSuggestions, 0, len(transcribed))
+	for i, clip := range transcribed {
+		if !clip.processed {
+			continue
+		}
+
+		suggestions := []HighlightSuggestion{}
+		for _, candidate := range candidates {
+			if candidate.clip == i {
+				suggestions = append(suggestions, candidate.suggestion)
+			}
+		}
+
+		if err := s.saveSuggestedHighlights(clip.id, suggestions, clip.words, options.PromptID); err != nil {
+			log.Printf("Failed to save suggested highlights for clip %d: %v", clip.id, err)
+		}
+		results = append(results, ClipSuggestions{
+			VideoClipID:   clip.id,
+			VideoClipName: clip.name,
+			Suggestions:   suggestions,
+		})
+	}
+
+	return results, nil
+}
+
+// clipTranscript is a clip taking part in a project suggestion run
+type clipTranscript struct {
+	id         int
+	name       string
+	words      []schema.Word
+	highlights []schema.Highlight
+	processed  bool
+}
+
+// anyClipProcessed reports whether at least one clip got suggestions back from AI
+func anyClipProcessed(clips []*clipTranscript) bool {
+	for _, clip := range clips {
+		if clip.processed {
+			return true
+		}
+	}
+	return false
+}
+
+// rankProjectSuggestions orders suggestions from all clips best first. Suggestion IDs are only
+// unique within a clip, so the clip ID is prefixed for the ranking pass. Clip order is kept if ranking fails.
+func (s *AIService) rankProjectSuggestions(complete ai.Completer, clips []*clipTranscript, candidates []projectSuggestion) []projectSuggestion {
+	if len(candidates) < 2 {
+		return candidates
+	}
+
+	byRankingID := make(map[string]projectSuggestion, len(candidates))
+	rankable := make([]HighlightSuggestion, len(candidates))
+	for i, candidate := range candidates {
+		rankable[i] = candidate.suggestion
+		rankable[i].ID = fmt.Sprintf("clip%d_%s", clips[candidate.clip].id, candidate.suggestion.ID)
+		byRankingID[rankable[i].ID] = candidate
+	}
+
+	ranked, err := s.rankSuggestions(complete, rankable, 0)
+	if err != nil {
+		log.Printf("Failed to rank project suggestions, keeping clip order: %v", err)
+		return candidates
+	}
+
+	result := make([]projectSuggestion, len(ranked))
+	for i, suggestion := range ranked {
+		result[i] = byRankingID[suggestion.ID]
+	}
+	return result
+}
+
+// fitSuggestionsToBudget keeps suggestions in order while their total duration fits the budget,
+// skipping any that would overflow it so shorter ones further down can still fit
+func fitSuggestionsToBudget(candidates []projectSuggestion, budgetSeconds float64) []projectSuggestion {
+	var result []projectSuggestion
+	total := 0.0
+	for _, candidate := range candidates {
+		if total+candidate.duration > budgetSeconds {
+			continue
+		}
+		total += candidate.duration
+		result = append(result, candidate)
+	}
+	return result
+}
+
+// suggestionDuration returns the length in seconds of a suggestion's word range
+func suggestionDuration(suggestion HighlightSuggestion, words []schema.Word) float64 {
+	if suggestion.Start < 0 || suggestion.End <= suggestion.Start || suggestion.End > len(words) {
+		return 0
+	}
+	return words[suggestion.End-1].End - words[suggestion.Start].Start
+}
+
+// withCoveredPoints adds the points already covered elsewhere in the project to every prompt
+func withCoveredPoints(complete ai.Completer, covered []string) ai.Completer {
+	if len(covered) == 0 {
+		return complete
+	}
+
+	points := covered
+	if len(points) > maxCoveredPointsInPrompt {
+		points = points[len(points)-maxCoveredPointsInPrompt:]
+	}
+
+	var header strings.Builder
+	header.WriteString("ALREADY COVERED IN OTHER CLIPS (do not suggest the same points again):\n")
+	for _, point := range points {
+		header.WriteString(fmt.Sprintf("- \"%s\"\n", truncateText(point, maxCoveredPointLength)))
+	}
+	header.WriteString("\n")
+
+	return func(userPrompt string, format *ai.ResponseFormat) (string, error) {
+		return complete(header.String()+userPrompt, format)
+	}
+}
+
+// mostSimilarText returns the text in others most similar to text and its similarity
+func mostSimilarText(text string, others []string) (string, float64) {
+	best, bestSimilarity := "", 0.0
+	for _, other := range others {
+		if similarity := textSimilarity(text, other); similarity > bestSimilarity {
+			best, bestSimilarity = other, similarity
+		}
+	}
+	return best, bestSimilarity
+}
+
+// textSimilarity is the Jaccard similarity of the normalized word sets of two texts (0-1)
+func textSimilarity(a, b string) float64 {
+	wordsA, wordsB := normalizedWordSet(a), normalizedWordSet(b)
+	if len(wordsA) == 0 || len(wordsB) == 0 {
+		return 0
+	}
+
+	shared := 0
+	for word := range wordsA {
+		if wordsB[word] {
+			shared++
+		}
+	}
+	return float64(shared) / float64(len(wordsA)+len(wordsB)-shared)
+}
+
+// normalizedWordSet lowercases text and splits it into a set of words without punctuation
+func normalizedWordSet(text string) map[string]bool {
+	words := strings.FieldsFunc(strings.ToLower(text), func(r rune) bool {
+		return !unicode.IsLetter(r) && !unicode.IsNumber(r) && r != '\''
+	})
+	set := make(map[string]bool, len(words))
+	for _, word := range words {
+		set[word] = true
+	}
+	return set
+}
+
+// truncateText shortens text to at most max runes, marking the cut with an ellipsis
+func truncateText(text string, max int) string {
+	runes := []rune(text)
+	if len(runes) <= max {
+		return text
+	}
+	return string(runes[:max]) + "..."
+}
@@ -0,0 +1,103 @@
+package highlights
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"ramble-ai/ent/schema"
+	"ramble-ai/goapp/ai"
+)
+
+func TestTextSimilarity(t *testing.T) {
+	assert.Equal(t, 1.0, textSimilarity("Ship it, then iterate!", "ship IT then iterate"))
+	assert.Equal(t, 0.0, textSimilarity("completely different", "nothing shared here"))
+	assert.Equal(t, 0.0, textSimilarity("", "anything"))
+	assert.InDelta(t, 6.0/9.0, textSimilarity("the best way to learn is by doing", "the best way to learn is teaching"), 0.001)
+
+	text, similarity := mostSimilarText("start with the user problem", []string{"unrelated point", "always start with the user problem first"})
+	assert.Equal(t, "always start with the user problem first", text)
+	assert.InDelta(t, 5.0/7.0, similarity, 0.001)
+}
+
+func TestFitSuggestionsToBudget(t *testing.T) {
+	candidates := []projectSuggestion{
+		{suggestion: HighlightSuggestion{ID: "a"}, duration: 30},
+		{suggestion: HighlightSuggestion{ID: "b"}, duration: 40},
+		{suggestion: HighlightSuggestion{ID: "c"}, duration: 15},
+		{suggestion: HighlightSuggestion{ID: "d"}, duration: 20},
+	}
+
+	var ids []string
+	for _, c := range fitSuggestionsToBudget(candidates, 60) {
+		ids = append(ids, c.suggestion.ID)
+	}
+	assert.Equal(t, []string{"a", "c"}, ids)
+}
+
+func TestSuggestionDuration(t *testing.T) {
+	words := testTranscript(10)
+	assert.InDelta(t, 3.9, suggestionDuration(HighlightSuggestion{Start: 2, End: 6}, words), 0.001)
+	assert.Equal(t, 0.0, suggestionDuration(HighlightSuggestion{Start: 5, End: 20}, words))
+}
+
+func TestWithCoveredPoints(t *testing.T) {
+	var received string
+	complete := func(userPrompt string, format *ai.ResponseFormat) (string, error) {
+		received = userPrompt
+		return "", nil
+	}
+
+	_, err := withCoveredPoints(complete, nil)("prompt", nil)
+	require.NoError(t, err)
+	assert.Equal(t, "prompt", received)
+
+	_, err = withCoveredPoints(complete, []string{"first point", strings.Repeat("x", 300)})("prompt", nil)
+	require.NoError(t, err)
+	assert.True(t, strings.HasPrefix(received, "ALREADY COVERED IN OTHER CLIPS"))
+	assert.Contains(t, received, "- \"first point\"")
+	assert.Contains(t, received, strings.Repeat("x", maxCoveredPointLength)+"...")
+	assert.True(t, strings.HasSuffix(received, "prompt"))
+}
+
+func TestRankProjectSuggestions(t *testing.T) {
+	service := &AIService{highlightService: &HighlightService{}}
+	clips := []*clipTranscript{{id: 7}, {id: 9}}
+	candidates := []projectSuggestion{
+		{clip: 0, suggestion: HighlightSuggestion{ID: "suggestion_1_5", Text: "one"}},
+		{clip: 1, suggestion: HighlightSuggestion{ID: "suggestion_1_5", Text: "two"}},
+	}
+
+	complete := func(userPrompt string, format *ai.ResponseFormat) (string, error) {
+		return `["clip9_suggestion_1_5", "clip7_suggestion_1_5"]`, nil
+	}
+
+	ranked := service.rankProjectSuggestions(complete, clips, candidates)
+	require.Len(t, ranked, 2)
+	assert.Equal(t, 1, ranked[0].clip)
+	assert.Equal(t, "suggestion_1_5", ranked[0].suggestion.ID, "clip prefix is only used for ranking")
+	assert.Equal(t, 0, ranked[1].clip)
+}
+
+func TestSuggestProjectHighlightsWithAI(t *testing.T) {
+	helper, service := setupAITestHelper(t)
+	project := helper.CreateTestProject("Project Suggestions")
+	clip := helper.CreateTestVideoClip(project, "Untranscribed")
+
+	_, err := service.SuggestProjectHighlightsWithAI(project.ID, "", ProjectSuggestionOptions{})
+	assert.EqualError(t, err, "project has no transcribed clips")
+
+	_, err = helper.Client.VideoClip.
+		UpdateOneID(clip.ID).
+		SetTranscription("Hello world").
+		SetTranscriptionWords([]schema.Word{{Word: "Hello", Start: 0, End: 0.5}, {Word: "world", Start: 0.6, End: 1}}).
+		Save(helper.Ctx)
+	require.NoError(t, err)
+
+	results, err := service.SuggestProjectHighlightsWithAI(project.ID, "", ProjectSuggestionOptions{})
+	assert.Error(t, err)
+	assert.Contains(t, err.Error(), "OpenRouter API key not configured")
+	assert.Nil(t, results)
+}
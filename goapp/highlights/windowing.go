@@ -0,0 +1,240 @@
+package highlights
+
+import (
+	"fmt"
+	"log"
+	"sort"
+	"strings"
+
+	"ramble-ai/ent/schema"
+	"ramble-ai/goapp/ai"
+	"ramble-ai/goapp/prompts"
+)
+
+const (
+	// defaultSuggestionWindowWords is how many transcript words each suggestion pass sees
+	defaultSuggestionWindowWords = 1500
+	// defaultSuggestionWindowOverlap is how many words adjacent windows share, so
+	// thoughts that straddle a window boundary are seen whole by one of the passes
+	defaultSuggestionWindowOverlap = 150
+)
+
+// SuggestionOptions configures AI highlight suggestions for long transcripts
+type SuggestionOptions struct {
+	WindowWords       int  `json:"windowWords"`       // words per suggestion pass, 0 = default
+	OverlapWords      int  `json:"overlapWords"`      // words shared by adjacent windows, 0 = default
+	RankAcrossWindows bool `json:"rankAcrossWindows"` // rank all windows' suggestions in a final pass
+	MaxSuggestions    int  `json:"maxSuggestions"`    // keep only the best ranked suggestions, 0 = keep all
+	prompts.Selection      // library prompt replacing the custom and project prompts
+}
+
+// transcriptWindow is a range of transcript word indices [Start, End)
+type transcriptWindow struct {
+	Start int
+	End   int
+}
+
+// transcriptWindows splits wordCount words into windows of size words overlapping by overlap words
+func transcriptWindows(wordCount, size, overlap int) []transcriptWindow {
+	if size <= 0 {
+		size = defaultSuggestionWindowWords
+	}
+	if overlap < 0 || overlap >= size {
+		overlap = 0
+	}
+	if wordCount <= size {
+		return []transcriptWindow{{Start: 0, End: wordCount}}
+	}
+
+	var windows []transcriptWindow
+	for start := 0; ; start += size - overlap {
+		end := start + size
+		if end >= wordCount {
+			windows = append(windows, transcriptWindow{Start: start, End: wordCount})
+			break
+		}
+		windows = append(windows, transcriptWindow{Start: start, End: end})
+	}
+	return windows
+}
+
+// suggestionWindowSettings returns the window size and overlap to use for options
+func suggestionWindowSettings(options SuggestionOptions) (int, int) {
+	size := options.WindowWords
+	if size <= 0 {
+		size = defaultSuggestionWindowWords
+	}
+	overlap := options.OverlapWords
+	if overlap == 0 {
+		overlap = defaultSuggestionWindowOverlap
+	}
+	if overlap >= size {
+		overlap = size / 10
+	}
+	return size, overlap
+}
+
+// suggestHighlightsInWindows runs one suggestion pass per window and merges the results.
+// A failed window is skipped; the call only fails when every window fails.
+func (s *AIService) suggestHighlightsInWindows(complete ai.Completer, transcriptWords []schema.Word, existingHighlights []schema.Highlight, windows []transcriptWindow) ([]HighlightSuggestion, error) {
+	perWindow := make([][]HighlightSuggestion, 0, len(windows))
+	var firstErr error
+
+	for i, window := range windows {
+		userPrompt := s.buildWindowSuggestionsUserPrompt(transcriptWords, existingHighlights, window, i, len(windows))
+		log.Printf("[AI] Suggesting highlights in window %d/%d (words %d-%d, prompt %d characters)", i+1, len(windows), window.Start, window.End, len(userPrompt))
+
+		w := window
+		ranges, err := ai.CompleteStructured(complete, userPrompt, highlightSuggestionsSchema, func(ranges []highlightRange) error {
+			return validateHighlightRanges(ranges, len(transcriptWords), w)
+		}, 0)
+		if err != nil {
+			log.Printf("[AI] Window %d/%d failed: %v", i+1, len(windows), err)
+			if firstErr == nil {
+				firstErr = err
+			}
+			continue
+		}
+		perWindow = append(perWindow, s.highlightRangesToSuggestions(ranges, transcriptWords))
+	}
+
+	if len(perWindow) == 0 {
+		return nil, firstErr
+	}
+	return mergeWindowSuggestions(perWindow), nil
+}
+
+// mergeWindowSuggestions combines the suggestions of all windows in transcript order. Where
+// suggestions from different windows overlap (the same moment seen from both sides of a window
+// boundary) the longer one is kept; remaining overlaps are left to filterValidHighlightSuggestions.
+func mergeWindowSuggestions(perWindow [][]HighlightSuggestion) []HighlightSuggestion {
+	type candidate struct {
+		suggestion HighlightSuggestion
+		window     int
+	}
+
+	var candidates []candidate
+	for window, suggestions := range perWindow {
+		for _, suggestion := range suggestions {
+			candidates = append(candidates, candidate{suggestion: suggestion, window: window})
+		}
+	}
+	sort.SliceStable(candidates, func(i, j int) bool {
+		a, b := candidates[i].suggestion, candidates[j].suggestion
+		if a.Start != b.Start {
+			return a.Start < b.Start
+		}
+		return a.End-a.Start > b.End-b.Start
+	})
+
+	var merged []candidate
+	for _, c := range candidates {
+		if len(merged) > 0 {
+			last := &merged[len(merged)-1]
+			overlaps := c.suggestion.Start < last.suggestion.End && c.suggestion.End > last.suggestion.Start
+			if overlaps && (c.window != last.window || c.suggestion.Start == last.suggestion.Start && c.suggestion.End == last.suggestion.End) {
+				if c.suggestion.End-c.suggestion.Start > last.suggestion.End-last.suggestion.Start {
+					*last = c
+				}
+				continue
+			}
+		}
+		merged = append(merged, c)
+	}
+
+	baseColorIDs := []int{1, 2, 3, 4, 5} // Yellow, Orange, Red, Pink, Purple
+	result := make([]HighlightSuggestion, len(merged))
+	for i, c := range merged {
+		result[i] = c.suggestion
+		result[i].ColorID = baseColorIDs[i%len(baseColorIDs)]
+	}
+	return result
+}
+
+// suggestionRankingSchema describes the suggestion IDs returned by the ranking pass, best first
+var suggestionRankingSchema = ai.StructuredSchema{
+	Name: "suggestion_ranking",
+	Schema: map[string]interface{}{
+		"type":  "array",
+		"items": map[string]interface{}{"type": "string"},
+	},
+}
+
+// rankSuggestions asks AI to rank suggestions from all windows against each other, best first.
+// Suggestions the ranking leaves out follow the ranked ones; maxSuggestions > 0 caps the result.
+func (s *AIService) rankSuggestions(complete ai.Completer, suggestions []HighlightSuggestion, maxSuggestions int) ([]HighlightSuggestion, error) {
+	if len(suggestions) < 2 {
+		return capSuggestions(suggestions, maxSuggestions), nil
+	}
+
+	byID := make(map[string]HighlightSuggestion, len(suggestions))
+	for _, suggestion := range suggestions {
+		byID[suggestion.ID] = suggestion
+	}
+
+	ranking, err := ai.CompleteStructured(complete, buildSuggestionRankingPrompt(suggestions, maxSuggestions), suggestionRankingSchema, func(ids []string) error {
+		var unknown []string
+		for _, id := range ids {
+			if _, ok := byID[id]; !ok {
+				unknown = append(unknown, id)
+			}
+		}
+		if len(unknown) > 0 {
+			return fmt.Errorf("unknown suggestion IDs: %s", strings.Join(unknown, ", "))
+		}
+		return nil
+	}, 0)
+	if err != nil {
+		return nil, err
+	}
+
+	return capSuggestions(applySuggestionRanking(suggestions, ranking), maxSuggestions), nil
+}
+
+// applySuggestionRanking orders suggestions by a ranking of their IDs, keeping unranked ones at the end
+func applySuggestionRanking(suggestions []HighlightSuggestion, ranking []string) []HighlightSuggestion {
+	byID := make(map[string]HighlightSuggestion, len(suggestions))
+	for _, suggestion := range suggestions {
+		byID[suggestion.ID] = suggestion
+	}
+
+	result := make([]HighlightSuggestion, 0, len(suggestions))
+	used := make(map[string]bool, len(suggestions))
+	for _, id := range ranking {
+		if suggestion, ok := byID[id]; ok && !used[id] {
+			result = append(result, suggestion)
+			used[id] = true
+		}
+	}
+	for _, suggestion := range suggestions {
+		if !used[suggestion.ID] {
+			result = append(result, suggestion)
+		}
+	}
+	return result
+}
+
+// capSuggestions keeps the first maxSuggestions suggestions (0 keeps all)
+func capSuggestions(suggestions []HighlightSuggestion, maxSuggestions int) []HighlightSuggestion {
+	if maxSuggestions > 0 && len(suggestions) > maxSuggestions {
+		return suggestions[:maxSuggestions]
+	}
+	return suggestions
+}
+
+// buildSuggestionRankingPrompt creates the prompt for ranking suggestions across windows
+func buildSuggestionRankingPrompt(suggestions []HighlightSuggestion, maxSuggestions int) string {
+	var prompt strings.Builder
+	prompt.WriteString("These highlight candidates were suggested from different parts of one long recording.\n")
+	prompt.WriteString("Rank them against each other by how valuable, self-contained and engaging they are for viewers.\n\n")
+	prompt.WriteString("CANDIDATES:\n")
+	for _, suggestion := range suggestions {
+		prompt.WriteString(fmt.Sprintf("- %s: \"%s\"\n", suggestion.ID, suggestion.Text))
+	}
+	prompt.WriteString("\n")
+	if maxSuggestions > 0 {
+		prompt.WriteString(fmt.Sprintf("Only the top %d will be kept.\n", maxSuggestions))
+	}
+	prompt.WriteString("Return a JSON array of the candidate IDs, best first. Only return the JSON array, no other text.")
+	return prompt.String()
+}
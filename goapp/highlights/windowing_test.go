@@ -0,0 +1,136 @@
+package highlights
+
+import (
+	"fmt"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"ramble-ai/ent/schema"
+	"ramble-ai/goapp/ai"
+)
+
+func testTranscript(count int) []schema.Word {
+	words := make([]schema.Word, count)
+	for i := range words {
+		words[i] = schema.Word{Word: fmt.Sprintf("w%d", i), Start: float64(i), End: float64(i) + 0.9}
+	}
+	return words
+}
+
+func TestTranscriptWindows(t *testing.T) {
+	assert.Equal(t, []transcriptWindow{{Start: 0, End: 80}}, transcriptWindows(80, 100, 10))
+	assert.Equal(t, []transcriptWindow{{Start: 0, End: 100}, {Start: 90, End: 190}, {Start: 180, End: 250}}, transcriptWindows(250, 100, 10))
+	assert.Equal(t, []transcriptWindow{{Start: 0, End: 100}, {Start: 100, End: 150}}, transcriptWindows(150, 100, 100), "overlap must be smaller than the window")
+
+	size, overlap := suggestionWindowSettings(SuggestionOptions{})
+	assert.Equal(t, defaultSuggestionWindowWords, size)
+	assert.Equal(t, defaultSuggestionWindowOverlap, overlap)
+
+	size, overlap = suggestionWindowSettings(SuggestionOptions{WindowWords: 100, OverlapWords: 200})
+	assert.Equal(t, 100, size)
+	assert.Equal(t, 10, overlap)
+}
+
+func TestMergeWindowSuggestions(t *testing.T) {
+	perWindow := [][]HighlightSuggestion{
+		{{ID: "a", Start: 10, End: 20}, {ID: "b", Start: 92, End: 99}},
+		{{ID: "c", Start: 90, End: 105}, {ID: "d", Start: 120, End: 130}, {ID: "e", Start: 125, End: 135}},
+	}
+
+	merged := mergeWindowSuggestions(perWindow)
+	ids := make([]string, len(merged))
+	for i, suggestion := range merged {
+		ids[i] = suggestion.ID
+	}
+
+	// c covers b from the other side of the window boundary; d and e overlap
+	// within one window and are left for filterValidHighlightSuggestions
+	assert.Equal(t, []string{"a", "c", "d", "e"}, ids)
+	assert.Equal(t, []int{1, 2, 3, 4}, []int{merged[0].ColorID, merged[1].ColorID, merged[2].ColorID, merged[3].ColorID})
+}
+
+func TestSuggestHighlightsInWindows(t *testing.T) {
+	service := &AIService{highlightService: &HighlightService{}}
+	words := testTranscript(250)
+	windows := transcriptWindows(len(words), 100, 10)
+
+	var prompts []string
+	complete := func(userPrompt string, format *ai.ResponseFormat) (string, error) {
+		prompts = append(prompts, userPrompt)
+		switch {
+		case strings.Contains(userPrompt, "part 1 of 3"):
+			return `[{"start": 5, "end": 9}, {"start": 92, "end": 98}]`, nil
+		case strings.Contains(userPrompt, "part 2 of 3") && !strings.Contains(userPrompt, "REJECTED"):
+			// Out of this window: re-prompted with the validation error
+			return `[{"start": 10, "end": 20}]`, nil
+		case strings.Contains(userPrompt, "part 2 of 3"):
+			return `[{"start": 90, "end": 104}]`, nil
+		default:
+			return "", fmt.Errorf("model unavailable")
+		}
+	}
+
+	suggestions, err := service.suggestHighlightsInWindows(complete, words, nil, windows)
+	require.NoError(t, err)
+	require.Len(t, suggestions, 2)
+	assert.Equal(t, "suggestion_5_9", suggestions[0].ID)
+	assert.Equal(t, "suggestion_90_104", suggestions[1].ID)
+	assert.Equal(t, "w90 w91 w92 w93 w94 w95 w96 w97 w98 w99 w100 w101 w102 w103", suggestions[1].Text)
+
+	require.Len(t, prompts, 4)
+	assert.Contains(t, prompts[1], "[90, \"w90\"]")
+	assert.NotContains(t, prompts[1], "[89, \"w89\"]")
+	assert.Contains(t, prompts[2], "must satisfy 90 <= start <= end <= 190")
+
+	t.Run("fails only when every window fails", func(t *testing.T) {
+		failing := func(string, *ai.ResponseFormat) (string, error) {
+			return "", fmt.Errorf("OpenRouter API key not configured")
+		}
+		_, err := service.suggestHighlightsInWindows(failing, words, nil, windows)
+		assert.EqualError(t, err, "OpenRouter API key not configured")
+	})
+}
+
+func TestWindowSuggestionsPrompt(t *testing.T) {
+	service := &AIService{highlightService: &HighlightService{}}
+	words := testTranscript(30)
+	existing := []schema.Highlight{{ID: "early", Start: 2, End: 4}, {ID: "late", Start: 25, End: 27}}
+
+	single := service.buildHighlightSuggestionsUserPrompt(words, existing)
+	assert.NotContains(t, single, "This is part")
+	assert.Contains(t, single, "[2, 4] [25, 27]")
+
+	window := service.buildWindowSuggestionsUserPrompt(words, existing, transcriptWindow{Start: 20, End: 30}, 1, 2)
+	assert.Contains(t, window, "This is part 2 of 2 of a long transcript (words 20 to 29)")
+	assert.Contains(t, window, "[25, 27]")
+	assert.NotContains(t, window, "[2, 4]")
+}
+
+func TestRankSuggestions(t *testing.T) {
+	service := &AIService{highlightService: &HighlightService{}}
+	suggestions := []HighlightSuggestion{
+		{ID: "suggestion_1_5", Text: "one"},
+		{ID: "suggestion_10_15", Text: "two"},
+		{ID: "suggestion_20_25", Text: "three"},
+	}
+
+	var prompt string
+	complete := func(userPrompt string, format *ai.ResponseFormat) (string, error) {
+		prompt = userPrompt
+		return `["suggestion_20_25", "suggestion_1_5"]`, nil
+	}
+
+	ranked, err := service.rankSuggestions(complete, suggestions, 0)
+	require.NoError(t, err)
+	assert.Equal(t, []string{"suggestion_20_25", "suggestion_1_5", "suggestion_10_15"}, []string{ranked[0].ID, ranked[1].ID, ranked[2].ID})
+	assert.Contains(t, prompt, `- suggestion_10_15: "two"`)
+
+	capped, err := service.rankSuggestions(complete, suggestions, 1)
+	require.NoError(t, err)
+	require.Len(t, capped, 1)
+	assert.Equal(t, "suggestion_20_25", capped[0].ID)
+	assert.Contains(t, prompt, "Only the top 1 will be kept")
+}
@@ -0,0 +1,172 @@
+package mcp
+
+import (
+	"encoding/json"
+	"fmt"
+	"strconv"
+	"strings"
+)
+
+// resourceScheme prefixes every resource URI
+const resourceScheme = "ramble://"
+
+// projectsURI lists every project
+const projectsURI = resourceScheme + "projects"
+
+// Resource is an MCP resource
+type Resource struct {
+	URI         string `json:"uri"`
+	Name        string `json:"name"`
+	Description string `json:"description,omitempty"`
+	MimeType    string `json:"mimeType"`
+}
+
+// ResourceTemplate is an MCP resource template
+type ResourceTemplate struct {
+	URITemplate string `json:"uriTemplate"`
+	Name        string `json:"name"`
+	Description string `json:"description"`
+	MimeType    string `json:"mimeType"`
+}
+
+// resourceContents is the content of a read resource
+type resourceContents struct {
+	URI      string `json:"uri"`
+	MimeType string `json:"mimeType"`
+	Text     string `json:"text"`
+}
+
+// resourceTemplates describe the parameterized resources
+var resourceTemplates = []ResourceTemplate{
+	{
+		URITemplate: resourceScheme + "projects/{projectId}",
+		Name:        "Project",
+		Description: "A project with its clips",
+		MimeType:    "application/json",
+	},
+	{
+		URITemplate: resourceScheme + "projects/{projectId}/highlights",
+		Name:        "Project highlights",
+		Description: "The highlights of a project with their text, and the current highlight order with section titles",
+		MimeType:    "application/json",
+	},
+	{
+		URITemplate: resourceScheme + "clips/{clipId}/transcript",
+		Name:        "Clip transcript",
+		Description: "The plain text transcript of a clip",
+		MimeType:    "text/plain",
+	},
+}
+
+// listResources lists the projects resource and one resource per project
+func (s *Server) listResources() (interface{}, error) {
+	projectList, err := s.projectService.GetProjects()
+	if err != nil {
+		return nil, err
+	}
+
+	resources := []Resource{{
+		URI:         projectsURI,
+		Name:        "Projects",
+		Description: "Every video project",
+		MimeType:    "application/json",
+	}}
+	for _, project := range projectList {
+		resources = append(resources, Resource{
+			URI:         fmt.Sprintf("%s/%d", projectsURI, project.ID),
+			Name:        project.Name,
+			Description: project.Description,
+			MimeType:    "application/json",
+		})
+	}
+	return map[string]interface{}{"resources": resources}, nil
+}
+
+// readResource implements resources/read
+func (s *Server) readResource(params json.RawMessage) (interface{}, error) {
+	var request struct {
+		URI string `json:"uri"`
+	}
+	if err := decodeParams(params, &request); err != nil {
+		return nil, err
+	}
+
+	content, mimeType, err := s.resourceContent(request.URI)
+	if err != nil {
+		return nil, err
+	}
+
+	text, ok := content.(string)
+	if !ok {
+		data, err := json.MarshalIndent(content, "", "  ")
+		if err != nil {
+			return nil, fmt.Errorf("failed to marshal resource %s: %w", request.URI, err)
+		}
+		text = string(data)
+	}
+	return map[string]interface{}{
+		"contents": []resourceContents{{URI: request.URI, MimeType: mimeType, Text: text}},
+	}, nil
+}
+
+// resourceContent returns the content of a resource URI and its MIME type
+func (s *Server) resourceContent(uri string) (interface{}, string, error) {
+	path, ok := strings.CutPrefix(uri, resourceScheme)
+	if !ok {
+		return nil, "", resourceNotFound(uri)
+	}
+	parts := strings.Split(path, "/")
+
+	switch {
+	case len(parts) == 1 && parts[0] == "projects":
+		projectList, err := s.projectService.GetProjects()
+		return projectList, "application/json", err
+	case len(parts) == 2 && parts[0] == "projects":
+		projectID, err := strconv.Atoi(parts[1])
+		if err != nil {
+			return nil, "", resourceNotFound(uri)
+		}
+		project, err := s.projectService.GetProjectByID(projectID)
+		if err != nil {
+			return nil, "", resourceNotFound(uri)
+		}
+		clips, err := s.listClips(map[string]interface{}{"project_id": float64(projectID)})
+		if err != nil {
+			return nil, "", err
+		}
+		return map[string]interface{}{"project": project, "clips": clips}, "application/json", nil
+	case len(parts) == 3 && parts[0] == "projects" && parts[2] == "highlights":
+		projectID, err := strconv.Atoi(parts[1])
+		if err != nil {
+			return nil, "", resourceNotFound(uri)
+		}
+		if _, err := s.projectService.GetProjectByID(projectID); err != nil {
+			return nil, "", resourceNotFound(uri)
+		}
+		projectHighlights, err := s.highlightService.GetProjectHighlights(projectID)
+		if err != nil {
+			return nil, "", err
+		}
+		order, err := s.highlightService.GetProjectHighlightOrderWithTitles(projectID)
+		if err != nil {
+			return nil, "", err
+		}
+		return map[string]interface{}{"highlights": projectHighlights, "order": order}, "application/json", nil
+	case len(parts) == 3 && parts[0] == "clips" && parts[2] == "transcript":
+		clipID, err := strconv.Atoi(parts[1])
+		if err != nil {
+			return nil, "", resourceNotFound(uri)
+		}
+		transcript, err := s.getTranscript(map[string]interface{}{"clip_id": float64(clipID)})
+		if err != nil {
+			return nil, "", resourceNotFound(uri)
+		}
+		return transcript.(map[string]interface{})["transcript"], "text/plain", nil
+	}
+	return nil, "", resourceNotFound(uri)
+}
+
+// resourceNotFound is the error of an unknown resource URI
+func resourceNotFound(uri string) error {
+	return &rpcError{Code: codeResourceNotFound, Message: fmt.Sprintf("resource not found: %s", uri)}
+}
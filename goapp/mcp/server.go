@@ -0,0 +1,206 @@
+// Package mcp exposes projects, clips, transcripts and highlight operations over the
+// Model Context Protocol, so external agents can drive editing through the same executors
+// as the built-in chat.
+//
+// The server speaks JSON-RPC 2.0 over two transports:
+// - stdio: newline-delimited messages on stdin/stdout (run the app binary with the "mcp" argument)
+// - HTTP: JSON-RPC POSTs to /mcp on a localhost port, enabled in settings
+package mcp
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"log"
+	"slices"
+
+	"ramble-ai/ent"
+	"ramble-ai/goapp/chatbot"
+	"ramble-ai/goapp/highlights"
+	"ramble-ai/goapp/projects"
+	"ramble-ai/goapp/version"
+)
+
+// ProtocolVersion is the newest MCP revision the server implements
+const ProtocolVersion = "2025-06-18"
+
+// supportedProtocolVersions are the MCP revisions a client may negotiate, newest first
+var supportedProtocolVersions = []string{ProtocolVersion, "2025-03-26", "2024-11-05"}
+
+// serverName identifies the server to MCP clients
+const serverName = "ramble-ai"
+
+// JSON-RPC error codes
+const (
+	codeParseError       = -32700
+	codeInvalidRequest   = -32600
+	codeMethodNotFound   = -32601
+	codeInvalidParams    = -32602
+	codeInternalError    = -32603
+	codeResourceNotFound = -32002 // MCP resources/read of an unknown URI
+)
+
+// Server answers MCP requests against the project database
+type Server struct {
+	client           *ent.Client
+	ctx              context.Context
+	chat             *chatbot.ChatbotService
+	projectService   *projects.ProjectService
+	highlightService *highlights.HighlightService
+	tools            []Tool
+}
+
+// NewServer creates an MCP server. updateOrderFunc applies highlight orders prepared by the
+// chat executors, as in the built-in chat.
+func NewServer(client *ent.Client, ctx context.Context, updateOrderFunc chatbot.UpdateOrderFunc) *Server {
+	s := &Server{
+		client:           client,
+		ctx:              ctx,
+		chat:             chatbot.NewChatbotService(client, ctx, updateOrderFunc),
+		projectService:   projects.NewProjectService(client, ctx),
+		highlightService: highlights.NewHighlightService(client, ctx),
+	}
+	s.tools = s.buildTools()
+	return s
+}
+
+// rpcRequest is a JSON-RPC request or notification
+type rpcRequest struct {
+	JSONRPC string          `json:"jsonrpc"`
+	ID      json.RawMessage `json:"id,omitempty"`
+	Method  string          `json:"method"`
+	Params  json.RawMessage `json:"params,omitempty"`
+}
+
+// rpcResponse is a JSON-RPC response
+type rpcResponse struct {
+	JSONRPC string          `json:"jsonrpc"`
+	ID      json.RawMessage `json:"id"`
+	Result  interface{}     `json:"result,omitempty"`
+	Error   *rpcError       `json:"error,omitempty"`
+}
+
+// rpcError is a JSON-RPC error object
+type rpcError struct {
+	Code    int    `json:"code"`
+	Message string `json:"message"`
+}
+
+// Error implements error
+func (e *rpcError) Error() string {
+	return e.Message
+}
+
+// HandleMessage answers one JSON-RPC message. It returns nil for notifications, which get no response.
+func (s *Server) HandleMessage(data []byte) []byte {
+	var request rpcRequest
+	if err := json.Unmarshal(data, &request); err != nil {
+		return encodeResponse(rpcResponse{ID: json.RawMessage("null"), Error: &rpcError{Code: codeParseError, Message: "parse error"}})
+	}
+	if request.JSONRPC != "2.0" || request.Method == "" {
+		if len(request.ID) == 0 {
+			request.ID = json.RawMessage("null")
+		}
+		return encodeResponse(rpcResponse{ID: request.ID, Error: &rpcError{Code: codeInvalidRequest, Message: "invalid request"}})
+	}
+
+	result, err := s.dispatch(request.Method, request.Params)
+	if len(request.ID) == 0 {
+		return nil // notification
+	}
+
+	if result == nil {
+		result = map[string]interface{}{}
+	}
+	response := rpcResponse{ID: request.ID, Result: result}
+	if err != nil {
+		rpcErr, ok := err.(*rpcError)
+		if !ok {
+			rpcErr = &rpcError{Code: codeInternalError, Message: err.Error()}
+		}
+		response.Result = nil
+		response.Error = rpcErr
+	}
+	return encodeResponse(response)
+}
+
+// dispatch runs an MCP method
+func (s *Server) dispatch(method string, params json.RawMessage) (interface{}, error) {
+	switch method {
+	case "initialize":
+		return s.initialize(params)
+	case "ping":
+		return map[string]interface{}{}, nil
+	case "notifications/initialized", "notifications/cancelled":
+		return nil, nil
+	case "tools/list":
+		return map[string]interface{}{"tools": s.tools}, nil
+	case "tools/call":
+		return s.callTool(params)
+	case "resources/list":
+		return s.listResources()
+	case "resources/templates/list":
+		return map[string]interface{}{"resourceTemplates": resourceTemplates}, nil
+	case "resources/read":
+		return s.readResource(params)
+	}
+	return nil, &rpcError{Code: codeMethodNotFound, Message: fmt.Sprintf("method not found: %s", method)}
+}
+
+// initialize negotiates the protocol version and announces the server capabilities
+func (s *Server) initialize(params json.RawMessage) (interface{}, error) {
+	var request struct {
+		ProtocolVersion string `json:"protocolVersion"`
+		ClientInfo      struct {
+			Name    string `json:"name"`
+			Version string `json:"version"`
+		} `json:"clientInfo"`
+	}
+	if err := decodeParams(params, &request); err != nil {
+		return nil, err
+	}
+
+	protocolVersion := ProtocolVersion
+	if slices.Contains(supportedProtocolVersions, request.ProtocolVersion) {
+		protocolVersion = request.ProtocolVersion
+	}
+	log.Printf("[MCP] Client %s %s connected (protocol %s)", request.ClientInfo.Name, request.ClientInfo.Version, protocolVersion)
+
+	return map[string]interface{}{
+		"protocolVersion": protocolVersion,
+		"capabilities": map[string]interface{}{
+			"tools":     map[string]interface{}{},
+			"resources": map[string]interface{}{},
+		},
+		"serverInfo": map[string]interface{}{
+			"name":    serverName,
+			"version": version.Version,
+		},
+		"instructions": "Video editing projects of the Ramble desktop app. Use list_projects to find a project ID, then read its resources or call tools with that project_id.",
+	}, nil
+}
+
+// decodeParams decodes request params, treating missing params as empty
+func decodeParams(params json.RawMessage, target interface{}) error {
+	if len(params) == 0 || string(params) == "null" {
+		return nil
+	}
+	if err := json.Unmarshal(params, target); err != nil {
+		return &rpcError{Code: codeInvalidParams, Message: fmt.Sprintf("invalid params: %v", err)}
+	}
+	return nil
+}
+
+// encodeResponse marshals a JSON-RPC response
+func encodeResponse(response rpcResponse) []byte {
+	response.JSONRPC = "2.0"
+	data, err := json.Marshal(response)
+	if err != nil {
+		data, _ = json.Marshal(rpcResponse{
+			JSONRPC: "2.0",
+			ID:      response.ID,
+			Error:   &rpcError{Code: codeInternalError, Message: fmt.Sprintf("failed to encode response: %v", err)},
+		})
+	}
+	return data
+}
@@ -0,0 +1,309 @@
+package mcp
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"ramble-ai/ent"
+	"ramble-ai/ent/schema"
+	"ramble-ai/goapp"
+)
+
+// testServer is an MCP server over a project with one transcribed clip
+type testServer struct {
+	*Server
+	project *ent.Project
+	clip    *ent.VideoClip
+	applied [][]interface{} // orders passed to the update function
+}
+
+func newTestServer(t *testing.T) *testServer {
+	helper := goapp.NewTestHelper(t)
+	project := helper.CreateTestProject("mcp-project")
+	clip := helper.CreateTestVideoClip(project, "intro")
+	clip = helper.Client.VideoClip.UpdateOne(clip).
+		SetTranscription("hello world").
+		SetTranscriptionWords([]schema.Word{{Word: "hello", Start: 0, End: 0.5}, {Word: "world", Start: 0.5, End: 1}}).
+		SaveX(helper.Ctx)
+	helper.CreateTestHighlight(clip, 0, 1)
+
+	ts := &testServer{project: project, clip: clip}
+	ts.Server = NewServer(helper.Client, helper.Ctx, func(projectID int, order []interface{}) error {
+		ts.applied = append(ts.applied, order)
+		return nil
+	})
+	return ts
+}
+
+// call sends a request and returns its decoded response
+func (ts *testServer) call(t *testing.T, method string, params interface{}) (json.RawMessage, *rpcError) {
+	data, err := json.Marshal(map[string]interface{}{"jsonrpc": "2.0", "id": 1, "method": method, "params": params})
+	require.NoError(t, err)
+
+	var response struct {
+		Result json.RawMessage `json:"result"`
+		Error  *rpcError       `json:"error"`
+	}
+	require.NoError(t, json.Unmarshal(ts.HandleMessage(data), &response))
+	return response.Result, response.Error
+}
+
+// callTool calls a tool and returns its text content and error flag
+func (ts *testServer) callTool(t *testing.T, name string, args map[string]interface{}) (string, bool) {
+	result, rpcErr := ts.call(t, "tools/call", map[string]interface{}{"name": name, "arguments": args})
+	require.Nil(t, rpcErr)
+
+	var decoded toolResult
+	require.NoError(t, json.Unmarshal(result, &decoded))
+	require.Len(t, decoded.Content, 1)
+	return decoded.Content[0].Text, decoded.IsError
+}
+
+func TestInitialize(t *testing.T) {
+	ts := newTestServer(t)
+
+	result, rpcErr := ts.call(t, "initialize", map[string]interface{}{
+		"protocolVersion": "2025-03-26",
+		"clientInfo":      map[string]string{"name": "test-agent", "version": "1.0"},
+	})
+	require.Nil(t, rpcErr)
+	var initialized struct {
+		ProtocolVersion string                 `json:"protocolVersion"`
+		Capabilities    map[string]interface{} `json:"capabilities"`
+		ServerInfo      map[string]string      `json:"serverInfo"`
+	}
+	require.NoError(t, json.Unmarshal(result, &initialized))
+	assert.Equal(t, "2025-03-26", initialized.ProtocolVersion)
+	assert.Contains(t, initialized.Capabilities, "tools")
+	assert.Contains(t, initialized.Capabilities, "resources")
+	assert.Equal(t, serverName, initialized.ServerInfo["name"])
+
+	result, rpcErr = ts.call(t, "initialize", map[string]interface{}{"protocolVersion": "1999-01-01"})
+	require.Nil(t, rpcErr)
+	require.NoError(t, json.Unmarshal(result, &initialized))
+	assert.Equal(t, ProtocolVersion, initialized.ProtocolVersion, "unknown versions get the newest")
+
+	assert.Nil(t, ts.HandleMessage([]byte(`{"jsonrpc":"2.0","method":"notifications/initialized"}`)))
+
+	result, rpcErr = ts.call(t, "ping", nil)
+	require.Nil(t, rpcErr)
+	assert.JSONEq(t, `{}`, string(result))
+}
+
+func TestProtocolErrors(t *testing.T) {
+	ts := newTestServer(t)
+
+	assert.JSONEq(t, `{"jsonrpc":"2.0","id":null,"error":{"code":-32700,"message":"parse error"}}`, string(ts.HandleMessage([]byte("{"))))
+	assert.JSONEq(t, `{"jsonrpc":"2.0","id":7,"error":{"code":-32600,"message":"invalid request"}}`, string(ts.HandleMessage([]byte(`{"id":7,"method":"ping"}`))))
+
+	_, rpcErr := ts.call(t, "prompts/list", nil)
+	require.NotNil(t, rpcErr)
+	assert.Equal(t, codeMethodNotFound, rpcErr.Code)
+
+	_, rpcErr = ts.call(t, "tools/call", map[string]interface{}{"name": "format_disk"})
+	require.NotNil(t, rpcErr)
+	assert.Equal(t, codeInvalidParams, rpcErr.Code)
+}
+
+func TestToolsList(t *testing.T) {
+	ts := newTestServer(t)
+
+	result, rpcErr := ts.call(t, "tools/list", nil)
+	require.Nil(t, rpcErr)
+	var listed struct {
+		Tools []struct {
+			Name        string `json:"name"`
+			InputSchema struct {
+				Properties map[string]interface{} `json:"properties"`
+				Required   []string               `json:"required"`
+			} `json:"inputSchema"`
+		} `json:"tools"`
+	}
+	require.NoError(t, json.Unmarshal(result, &listed))
+
+	byName := map[string]int{}
+	for i, tool := range listed.Tools {
+		byName[tool.Name] = i
+	}
+	for _, name := range []string{"list_projects", "list_clips", "get_transcript", "reorder_highlights", "get_current_order", "reset_to_original", "search_transcripts"} {
+		assert.Contains(t, byName, name)
+	}
+
+	reorder := listed.Tools[byName["reorder_highlights"]].InputSchema
+	assert.Equal(t, []string{"project_id", "new_order"}, reorder.Required)
+	assert.Contains(t, reorder.Properties, "new_order")
+	assert.Contains(t, reorder.Properties, "project_id")
+
+	// The chat function schemas are not modified
+	for _, definition := range ts.chat.FunctionDefinitions() {
+		properties, _ := definition.Parameters["properties"].(map[string]interface{})
+		assert.NotContains(t, properties, "project_id", definition.Name)
+	}
+}
+
+func TestToolsCall(t *testing.T) {
+	ts := newTestServer(t)
+	projectID := float64(ts.project.ID)
+
+	text, isError := ts.callTool(t, "list_projects", nil)
+	assert.False(t, isError)
+	assert.Contains(t, text, `"name": "mcp-project"`)
+
+	text, isError = ts.callTool(t, "list_clips", map[string]interface{}{"project_id": projectID})
+	assert.False(t, isError)
+	var clips []clipSummary
+	require.NoError(t, json.Unmarshal([]byte(text), &clips))
+	require.Len(t, clips, 1)
+	assert.Equal(t, ts.clip.ID, clips[0].ID)
+	assert.True(t, clips[0].HasTranscript)
+	assert.Equal(t, 1, clips[0].HighlightCount)
+
+	text, isError = ts.callTool(t, "get_transcript", map[string]interface{}{"clip_id": float64(ts.clip.ID), "include_words": true})
+	assert.False(t, isError)
+	assert.Contains(t, text, `"transcript": "hello world"`)
+	assert.Contains(t, text, `"word": "world"`)
+
+	text, isError = ts.callTool(t, "reset_to_original", map[string]interface{}{"project_id": projectID})
+	assert.False(t, isError, text)
+	assert.Contains(t, text, "(Applied to database)")
+	require.Len(t, ts.applied, 1, "prepared orders are applied like in the chat")
+	assert.Len(t, ts.applied[0], 1)
+
+	text, isError = ts.callTool(t, "get_current_order", map[string]interface{}{})
+	assert.True(t, isError)
+	assert.Equal(t, "project_id must be an integer", text)
+
+	_, isError = ts.callTool(t, "get_current_order", map[string]interface{}{"project_id": float64(9999)})
+	assert.True(t, isError)
+}
+
+func TestResources(t *testing.T) {
+	ts := newTestServer(t)
+
+	result, rpcErr := ts.call(t, "resources/list", nil)
+	require.Nil(t, rpcErr)
+	assert.Contains(t, string(result), fmt.Sprintf(`"uri":"ramble://projects/%d"`, ts.project.ID))
+
+	result, rpcErr = ts.call(t, "resources/templates/list", nil)
+	require.Nil(t, rpcErr)
+	assert.Contains(t, string(result), "ramble://clips/{clipId}/transcript")
+
+	read := func(uri string) resourceContents {
+		result, rpcErr := ts.call(t, "resources/read", map[string]string{"uri": uri})
+		require.Nil(t, rpcErr, uri)
+		var decoded struct {
+			Contents []resourceContents `json:"contents"`
+		}
+		require.NoError(t, json.Unmarshal(result, &decoded))
+		require.Len(t, decoded.Contents, 1)
+		return decoded.Contents[0]
+	}
+
+	transcript := read(fmt.Sprintf("ramble://clips/%d/transcript", ts.clip.ID))
+	assert.Equal(t, "text/plain", transcript.MimeType)
+	assert.Equal(t, "hello world", transcript.Text)
+
+	project := read(fmt.Sprintf("ramble://projects/%d", ts.project.ID))
+	assert.Equal(t, "application/json", project.MimeType)
+	assert.Contains(t, project.Text, `"name": "intro"`)
+
+	highlights := read(fmt.Sprintf("ramble://projects/%d/highlights", ts.project.ID))
+	assert.Contains(t, highlights.Text, `"highlights"`)
+	assert.Contains(t, highlights.Text, `"order"`)
+
+	for _, uri := range []string{"ramble://projects/9999", "ramble://clips/x/transcript", "file:///etc/passwd"} {
+		_, rpcErr := ts.call(t, "resources/read", map[string]string{"uri": uri})
+		require.NotNil(t, rpcErr, uri)
+		assert.Equal(t, codeResourceNotFound, rpcErr.Code)
+	}
+}
+
+func TestServeStdio(t *testing.T) {
+	ts := newTestServer(t)
+
+	in := strings.NewReader(strings.Join([]string{
+		`{"jsonrpc":"2.0","id":1,"method":"initialize","params":{"protocolVersion":"2025-06-18"}}`,
+		`{"jsonrpc":"2.0","method":"notifications/initialized"}`,
+		"",
+		`{"jsonrpc":"2.0","id":"two","method":"ping"}`,
+	}, "\n"))
+	var out bytes.Buffer
+	require.NoError(t, ts.ServeStdio(in, &out))
+
+	lines := strings.Split(strings.TrimSpace(out.String()), "\n")
+	require.Len(t, lines, 2, "notifications get no response")
+	assert.Contains(t, lines[0], `"id":1`)
+	assert.JSONEq(t, `{"jsonrpc":"2.0","id":"two","result":{}}`, lines[1])
+}
+
+func TestHTTPTransport(t *testing.T) {
+	ts := newTestServer(t)
+	server := httptest.NewServer(ts.Handler())
+	defer server.Close()
+
+	post := func(body, origin string) *http.Response {
+		req, err := http.NewRequest(http.MethodPost, server.URL+"/mcp", strings.NewReader(body))
+		require.NoError(t, err)
+		req.Header.Set("Content-Type", "application/json")
+		if origin != "" {
+			req.Header.Set("Origin", origin)
+		}
+		resp, err := http.DefaultClient.Do(req)
+		require.NoError(t, err)
+		t.Cleanup(func() { resp.Body.Close() })
+		return resp
+	}
+
+	resp := post(`{"jsonrpc":"2.0","id":1,"method":"ping"}`, "http://localhost:5173")
+	assert.Equal(t, http.StatusOK, resp.StatusCode)
+	assert.Equal(t, "application/json", resp.Header.Get("Content-Type"))
+
+	resp = post(`{"jsonrpc":"2.0","method":"notifications/initialized"}`, "")
+	assert.Equal(t, http.StatusAccepted, resp.StatusCode)
+
+	resp = post(`{"jsonrpc":"2.0","id":1,"method":"ping"}`, "https://evil.example")
+	assert.Equal(t, http.StatusForbidden, resp.StatusCode)
+
+	getResp, err := http.Get(server.URL + "/mcp")
+	require.NoError(t, err)
+	getResp.Body.Close()
+	assert.Equal(t, http.StatusMethodNotAllowed, getResp.StatusCode)
+}
+
+func TestListenHTTP(t *testing.T) {
+	ts := newTestServer(t)
+
+	httpServer, err := ts.ListenHTTP(0)
+	require.NoError(t, err)
+	assert.True(t, strings.HasPrefix(httpServer.URL(), "http://127.0.0.1:"))
+
+	resp, err := http.Post(httpServer.URL(), "application/json", strings.NewReader(`{"jsonrpc":"2.0","id":1,"method":"ping"}`))
+	require.NoError(t, err)
+	resp.Body.Close()
+	assert.Equal(t, http.StatusOK, resp.StatusCode)
+
+	require.NoError(t, httpServer.Close())
+}
+
+func TestSettings(t *testing.T) {
+	helper := goapp.NewTestHelper(t)
+
+	mcpSettings, err := GetSettings(helper.Client, helper.Ctx)
+	require.NoError(t, err)
+	assert.Equal(t, Settings{HTTPPort: DefaultHTTPPort}, *mcpSettings)
+
+	assert.EqualError(t, SaveSettings(helper.Client, helper.Ctx, Settings{HTTPEnabled: true, HTTPPort: 80}), "MCP port must be between 1024 and 65535")
+
+	require.NoError(t, SaveSettings(helper.Client, helper.Ctx, Settings{HTTPEnabled: true, HTTPPort: 9000}))
+	mcpSettings, err = GetSettings(helper.Client, helper.Ctx)
+	require.NoError(t, err)
+	assert.Equal(t, Settings{HTTPEnabled: true, HTTPPort: 9000}, *mcpSettings)
+}
@@ -0,0 +1,61 @@
+package mcp
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+
+	"ramble-ai/ent"
+	"ramble-ai/goapp/settings"
+)
+
+// settingsKey is the setting holding Settings as JSON
+const settingsKey = "mcp_server"
+
+// DefaultHTTPPort is the port of the HTTP transport until another is saved
+const DefaultHTTPPort = 8765
+
+// Settings control the HTTP transport. The stdio transport needs no settings: MCP clients
+// start the app binary with the "mcp" argument themselves.
+type Settings struct {
+	HTTPEnabled bool `json:"httpEnabled"`
+	HTTPPort    int  `json:"httpPort"`
+}
+
+// Status is the MCP HTTP server configuration and whether it is running
+type Status struct {
+	Settings
+	Running bool   `json:"running"`
+	URL     string `json:"url,omitempty"`
+	Error   string `json:"error,omitempty"` // why the server could not start
+}
+
+// GetSettings returns the saved MCP settings, or the defaults when none were saved
+func GetSettings(client *ent.Client, ctx context.Context) (*Settings, error) {
+	value, err := settings.NewSettingsService(client, ctx).GetSetting(settingsKey)
+	if err != nil {
+		return nil, err
+	}
+
+	mcpSettings := Settings{HTTPPort: DefaultHTTPPort}
+	if value == "" {
+		return &mcpSettings, nil
+	}
+	if err := json.Unmarshal([]byte(value), &mcpSettings); err != nil {
+		return nil, fmt.Errorf("failed to parse MCP settings: %w", err)
+	}
+	return &mcpSettings, nil
+}
+
+// SaveSettings validates and saves the MCP settings
+func SaveSettings(client *ent.Client, ctx context.Context, mcpSettings Settings) error {
+	if mcpSettings.HTTPPort < 1024 || mcpSettings.HTTPPort > 65535 {
+		return fmt.Errorf("MCP port must be between 1024 and 65535")
+	}
+
+	value, err := json.Marshal(mcpSettings)
+	if err != nil {
+		return fmt.Errorf("failed to marshal MCP settings: %w", err)
+	}
+	return settings.NewSettingsService(client, ctx).SaveSetting(settingsKey, string(value))
+}
@@ -0,0 +1,255 @@
+package mcp
+
+import (
+	"encoding/json"
+	"fmt"
+	"log"
+	"maps"
+	"slices"
+
+	"ramble-ai/ent/videoclip"
+)
+
+// Tool is an MCP tool
+type Tool struct {
+	Name        string                 `json:"name"`
+	Description string                 `json:"description"`
+	InputSchema map[string]interface{} `json:"inputSchema"`
+	call        func(args map[string]interface{}) (interface{}, error)
+}
+
+// toolContent is a text block of a tool result
+type toolContent struct {
+	Type string `json:"type"`
+	Text string `json:"text"`
+}
+
+// toolResult is the result of tools/call. Tool failures are results with IsError set, so the
+// calling model can see and react to them.
+type toolResult struct {
+	Content []toolContent `json:"content"`
+	IsError bool          `json:"isError"`
+}
+
+// projectIDProperty is added to the schema of every project-scoped chat function
+var projectIDProperty = map[string]interface{}{
+	"type":        "integer",
+	"description": "ID of the project, from list_projects",
+}
+
+// buildTools returns the server's own tools followed by every chat function, scoped by project_id
+func (s *Server) buildTools() []Tool {
+	tools := []Tool{
+		{
+			Name:        "list_projects",
+			Description: "List the video projects with their IDs",
+			InputSchema: map[string]interface{}{
+				"type":       "object",
+				"properties": map[string]interface{}{},
+			},
+			call: s.listProjects,
+		},
+		{
+			Name:        "list_clips",
+			Description: "List the video clips of a project with their duration, transcription state and highlight count",
+			InputSchema: map[string]interface{}{
+				"type": "object",
+				"properties": map[string]interface{}{
+					"project_id": projectIDProperty,
+				},
+				"required": []string{"project_id"},
+			},
+			call: s.listClips,
+		},
+		{
+			Name:        "get_transcript",
+			Description: "Get the transcript of a video clip, optionally with word timestamps in seconds",
+			InputSchema: map[string]interface{}{
+				"type": "object",
+				"properties": map[string]interface{}{
+					"clip_id": map[string]interface{}{
+						"type":        "integer",
+						"description": "ID of the clip, from list_clips",
+					},
+					"include_words": map[string]interface{}{
+						"type":        "boolean",
+						"description": "Include every word with its start and end time",
+					},
+				},
+				"required": []string{"clip_id"},
+			},
+			call: s.getTranscript,
+		},
+	}
+
+	for _, definition := range s.chat.FunctionDefinitions() {
+		name := definition.Name
+		tools = append(tools, Tool{
+			Name:        name,
+			Description: definition.Description,
+			InputSchema: withProjectID(definition.Parameters),
+			call: func(args map[string]interface{}) (interface{}, error) {
+				return s.callChatFunction(name, args)
+			},
+		})
+	}
+	return tools
+}
+
+// withProjectID returns a copy of a function schema that also requires project_id
+func withProjectID(parameters map[string]interface{}) map[string]interface{} {
+	schema := maps.Clone(parameters)
+	if schema == nil {
+		schema = map[string]interface{}{"type": "object"}
+	}
+
+	properties := map[string]interface{}{}
+	if existing, ok := schema["properties"].(map[string]interface{}); ok {
+		properties = maps.Clone(existing)
+	}
+	properties["project_id"] = projectIDProperty
+	schema["properties"] = properties
+
+	required := []string{"project_id"}
+	if existing, ok := schema["required"].([]string); ok {
+		required = append(required, existing...)
+	}
+	schema["required"] = required
+	return schema
+}
+
+// callTool runs a tool for tools/call
+func (s *Server) callTool(params json.RawMessage) (interface{}, error) {
+	var request struct {
+		Name      string                 `json:"name"`
+		Arguments map[string]interface{} `json:"arguments"`
+	}
+	if err := decodeParams(params, &request); err != nil {
+		return nil, err
+	}
+
+	index := slices.IndexFunc(s.tools, func(tool Tool) bool { return tool.Name == request.Name })
+	if index < 0 {
+		return nil, &rpcError{Code: codeInvalidParams, Message: fmt.Sprintf("unknown tool: %s", request.Name)}
+	}
+	if request.Arguments == nil {
+		request.Arguments = map[string]interface{}{}
+	}
+
+	log.Printf("[MCP] Calling tool %s", request.Name)
+	result, err := s.tools[index].call(request.Arguments)
+	if err != nil {
+		log.Printf("[MCP] Tool %s failed: %v", request.Name, err)
+		return &toolResult{Content: []toolContent{{Type: "text", Text: err.Error()}}, IsError: true}, nil
+	}
+
+	text, err := json.MarshalIndent(result, "", "  ")
+	if err != nil {
+		return nil, fmt.Errorf("failed to marshal %s result: %w", request.Name, err)
+	}
+	return &toolResult{Content: []toolContent{{Type: "text", Text: string(text)}}}, nil
+}
+
+// callChatFunction runs a chat function for the project named by project_id
+func (s *Server) callChatFunction(name string, args map[string]interface{}) (interface{}, error) {
+	projectID, err := intArg(args, "project_id")
+	if err != nil {
+		return nil, err
+	}
+	if _, err := s.projectService.GetProjectByID(projectID); err != nil {
+		return nil, err
+	}
+
+	functionArgs := maps.Clone(args)
+	delete(functionArgs, "project_id")
+
+	result := s.chat.CallFunction(name, functionArgs, projectID)
+	if !result.Success {
+		return nil, fmt.Errorf("%s failed: %s", name, result.Error)
+	}
+	return result.Result, nil
+}
+
+// listProjects implements list_projects
+func (s *Server) listProjects(args map[string]interface{}) (interface{}, error) {
+	return s.projectService.GetProjects()
+}
+
+// clipSummary is a clip as listed by list_clips, without transcript and highlight details
+type clipSummary struct {
+	ID                 int     `json:"id"`
+	Name               string  `json:"name"`
+	FileName           string  `json:"fileName"`
+	Duration           float64 `json:"duration"`
+	TranscriptionState string  `json:"transcriptionState"`
+	HasTranscript      bool    `json:"hasTranscript"`
+	HighlightCount     int     `json:"highlightCount"`
+}
+
+// listClips implements list_clips
+func (s *Server) listClips(args map[string]interface{}) (interface{}, error) {
+	projectID, err := intArg(args, "project_id")
+	if err != nil {
+		return nil, err
+	}
+	if _, err := s.projectService.GetProjectByID(projectID); err != nil {
+		return nil, err
+	}
+
+	clips, err := s.projectService.GetVideoClipsByProject(projectID)
+	if err != nil {
+		return nil, err
+	}
+
+	summaries := make([]clipSummary, 0, len(clips))
+	for _, clip := range clips {
+		summaries = append(summaries, clipSummary{
+			ID:                 clip.ID,
+			Name:               clip.Name,
+			FileName:           clip.FileName,
+			Duration:           clip.Duration,
+			TranscriptionState: clip.TranscriptionState,
+			HasTranscript:      clip.Transcription != "",
+			HighlightCount:     len(clip.Highlights),
+		})
+	}
+	return summaries, nil
+}
+
+// getTranscript implements get_transcript
+func (s *Server) getTranscript(args map[string]interface{}) (interface{}, error) {
+	clipID, err := intArg(args, "clip_id")
+	if err != nil {
+		return nil, err
+	}
+	includeWords, _ := args["include_words"].(bool)
+
+	clip, err := s.client.VideoClip.
+		Query().
+		Where(videoclip.ID(clipID), videoclip.DeletedAtIsNil()).
+		Only(s.ctx)
+	if err != nil {
+		return nil, fmt.Errorf("clip %d not found", clipID)
+	}
+
+	transcript := map[string]interface{}{
+		"clipId":     clip.ID,
+		"name":       clip.Name,
+		"language":   clip.TranscriptionLanguage,
+		"duration":   clip.TranscriptionDuration,
+		"transcript": clip.Transcription,
+	}
+	if includeWords {
+		transcript["words"] = clip.TranscriptionWords
+	}
+	return transcript, nil
+}
+
+// intArg reads a required integer argument. JSON numbers decode as float64.
+func intArg(args map[string]interface{}, name string) (int, error) {
+	value, ok := args[name].(float64)
+	if !ok || value != float64(int(value)) {
+		return 0, fmt.Errorf("%s must be an integer", name)
+	}
+	return int(value), nil
+}
@@ -0,0 +1,130 @@
+package mcp
+
+import (
+	"bufio"
+	"bytes"
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"log"
+	"net"
+	"net/http"
+	"net/url"
+	"time"
+)
+
+// maxMessageSize bounds a single JSON-RPC message
+const maxMessageSize = 16 * 1024 * 1024
+
+// ServeStdio answers newline-delimited JSON-RPC messages read from in, writing responses to out,
+// until in is closed. Logs must go to stderr because stdout carries the protocol.
+func (s *Server) ServeStdio(in io.Reader, out io.Writer) error {
+	scanner := bufio.NewScanner(in)
+	scanner.Buffer(make([]byte, 64*1024), maxMessageSize)
+
+	for scanner.Scan() {
+		message := bytes.TrimSpace(scanner.Bytes())
+		if len(message) == 0 {
+			continue
+		}
+		response := s.HandleMessage(message)
+		if response == nil {
+			continue
+		}
+		if _, err := out.Write(append(response, '\n')); err != nil {
+			return fmt.Errorf("failed to write MCP response: %w", err)
+		}
+	}
+	if err := scanner.Err(); err != nil {
+		return fmt.Errorf("failed to read MCP message: %w", err)
+	}
+	return nil
+}
+
+// Handler serves JSON-RPC POSTs on /mcp. Browser requests from origins other than localhost are
+// rejected so web pages cannot reach the server through DNS rebinding.
+func (s *Server) Handler() http.Handler {
+	mux := http.NewServeMux()
+	mux.HandleFunc("/mcp", func(w http.ResponseWriter, r *http.Request) {
+		if !isLocalOrigin(r.Header.Get("Origin")) {
+			http.Error(w, "origin not allowed", http.StatusForbidden)
+			return
+		}
+		if r.Method != http.MethodPost {
+			w.Header().Set("Allow", http.MethodPost)
+			http.Error(w, "method not allowed", http.StatusMethodNotAllowed)
+			return
+		}
+
+		message, err := io.ReadAll(io.LimitReader(r.Body, maxMessageSize))
+		if err != nil {
+			http.Error(w, "failed to read request", http.StatusBadRequest)
+			return
+		}
+
+		response := s.HandleMessage(message)
+		if response == nil {
+			w.WriteHeader(http.StatusAccepted)
+			return
+		}
+		w.Header().Set("Content-Type", "application/json")
+		w.Write(response)
+	})
+	return mux
+}
+
+// isLocalOrigin reports whether an Origin header is absent or names this machine
+func isLocalOrigin(origin string) bool {
+	if origin == "" {
+		return true
+	}
+	parsed, err := url.Parse(origin)
+	if err != nil {
+		return false
+	}
+	switch parsed.Hostname() {
+	case "localhost", "127.0.0.1", "::1", "wails.localhost":
+		return true
+	}
+	return false
+}
+
+// HTTPServer is the MCP server listening on a localhost port
+type HTTPServer struct {
+	server   *http.Server
+	listener net.Listener
+}
+
+// ListenHTTP starts serving MCP over HTTP on 127.0.0.1:port in the background
+func (s *Server) ListenHTTP(port int) (*HTTPServer, error) {
+	listener, err := net.Listen("tcp", fmt.Sprintf("127.0.0.1:%d", port))
+	if err != nil {
+		return nil, fmt.Errorf("failed to listen on port %d: %w", port, err)
+	}
+
+	httpServer := &HTTPServer{
+		server:   &http.Server{Handler: s.Handler(), ReadHeaderTimeout: 10 * time.Second},
+		listener: listener,
+	}
+	go func() {
+		if err := httpServer.server.Serve(listener); err != nil && !errors.Is(err, http.ErrServerClosed) {
+			log.Printf("[MCP] HTTP server stopped: %v", err)
+		}
+	}()
+
+	log.Printf("[MCP] Serving on %s", httpServer.URL())
+	return httpServer, nil
+}
+
+// URL returns the endpoint MCP clients connect to
+func (h *HTTPServer) URL() string {
+	return "http://" + h.listener.Addr().String() + "/mcp"
+}
+
+// Close stops the server, waiting briefly for requests in progress
+func (h *HTTPServer) Close() error {
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+	return h.server.Shutdown(ctx)
+}
@@ -0,0 +1,363 @@
+package projects
+
+import (
+	"fmt"
+	"log"
+	"strings"
+
+	"ramble-ai/ent"
+	"ramble-ai/ent/cut"
+	"ramble-ai/ent/project"
+	highlightsservice "ramble-ai/goapp/highlights"
+)
+
+// DefaultCutName names the cut created from a project's existing edit
+const DefaultCutName = "Main"
+
+// CutResponse represents a named cut of a project for the frontend
+type CutResponse struct {
+	ID             int      `json:"id"`
+	ProjectID      int      `json:"projectId"`
+	Name           string   `json:"name"`
+	IsActive       bool     `json:"isActive"`
+	HighlightCount int      `json:"highlightCount"`
+	HiddenCount    int      `json:"hiddenCount"`
+	SectionTitles  []string `json:"sectionTitles"`
+	CreatedAt      string   `json:"createdAt"`
+	UpdatedAt      string   `json:"updatedAt"`
+}
+
+// GetCuts returns the cuts of a project, creating the default cut from the current edit if needed
+func (s *ProjectService) GetCuts(projectID int) ([]*CutResponse, error) {
+	proj, err := s.ensureDefaultCut(projectID)
+	if err != nil {
+		return nil, err
+	}
+
+	cuts, err := s.client.Cut.Query().
+		Where(cut.ProjectID(projectID)).
+		Order(ent.Asc(cut.FieldCreatedAt), ent.Asc(cut.FieldID)).
+		All(s.ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get cuts: %w", err)
+	}
+
+	responses := make([]*CutResponse, 0, len(cuts))
+	for _, c := range cuts {
+		responses = append(responses, toCutResponse(c, proj))
+	}
+	return responses, nil
+}
+
+// CreateCut adds an empty cut to a project. The project's current edit is kept as the default cut.
+func (s *ProjectService) CreateCut(projectID int, name string) (*CutResponse, error) {
+	proj, err := s.ensureDefaultCut(projectID)
+	if err != nil {
+		return nil, err
+	}
+
+	c, err := s.createCut(projectID, name, []interface{}{}, []string{})
+	if err != nil {
+		return nil, err
+	}
+
+	log.Printf("[CUTS] Created cut %q in project %d", c.Name, projectID)
+	return toCutResponse(c, proj), nil
+}
+
+// CloneCut copies a cut's order and hidden highlights into a new cut
+func (s *ProjectService) CloneCut(projectID int, cutID int, name string) (*CutResponse, error) {
+	proj, err := s.ensureDefaultCut(projectID)
+	if err != nil {
+		return nil, err
+	}
+
+	source, err := highlightsservice.NewHighlightService(s.client, s.ctx).GetCutState(projectID, cutID)
+	if err != nil {
+		return nil, err
+	}
+
+	c, err := s.createCut(projectID, name, source.HighlightOrder, source.HiddenHighlights)
+	if err != nil {
+		return nil, err
+	}
+
+	log.Printf("[CUTS] Cloned cut %d as %q in project %d", cutID, c.Name, projectID)
+	return toCutResponse(c, proj), nil
+}
+
+// SwitchCut makes a cut the one being edited. The outgoing cut's order, history and hidden
+// highlights are stored on its cut, and the incoming cut's state is loaded onto the project.
+func (s *ProjectService) SwitchCut(projectID int, cutID int) (*CutResponse, error) {
+	proj, err := s.ensureDefaultCut(projectID)
+	if err != nil {
+		return nil, err
+	}
+
+	target, err := s.getCut(projectID, cutID)
+	if err != nil {
+		return nil, err
+	}
+	if proj.ActiveCutID == cutID {
+		return toCutResponse(target, proj), nil
+	}
+
+	tx, err := s.client.Tx(s.ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to start transaction: %w", err)
+	}
+	defer func() {
+		if err != nil {
+			tx.Rollback()
+		}
+	}()
+
+	err = tx.Cut.UpdateOneID(proj.ActiveCutID).
+		SetHighlightOrder(orderOrEmpty(proj.HighlightOrder)).
+		SetOrderHistory(historyOrEmpty(proj.OrderHistory)).
+		SetOrderHistoryIndex(proj.OrderHistoryIndex).
+		SetHiddenHighlights(hiddenOrEmpty(proj.HiddenHighlights)).
+		Exec(s.ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to store active cut: %w", err)
+	}
+
+	proj, err = tx.Project.UpdateOneID(projectID).
+		SetHighlightOrder(orderOrEmpty(target.HighlightOrder)).
+		SetOrderHistory(historyOrEmpty(target.OrderHistory)).
+		SetOrderHistoryIndex(target.OrderHistoryIndex).
+		SetHiddenHighlights(hiddenOrEmpty(target.HiddenHighlights)).
+		SetActiveCutID(cutID).
+		Save(s.ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to load cut: %w", err)
+	}
+
+	if err = tx.Commit(); err != nil {
+		return nil, fmt.Errorf("failed to commit transaction: %w", err)
+	}
+
+	log.Printf("[CUTS] Switched project %d to cut %d", projectID, cutID)
+	return toCutResponse(target, proj), nil
+}
+
+// RenameCut changes the name of a cut
+func (s *ProjectService) RenameCut(projectID int, cutID int, name string) (*CutResponse, error) {
+	name = strings.TrimSpace(name)
+	if name == "" {
+		return nil, fmt.Errorf("cut name cannot be empty")
+	}
+
+	if _, err := s.getCut(projectID, cutID); err != nil {
+		return nil, err
+	}
+
+	c, err := s.client.Cut.UpdateOneID(cutID).SetName(name).Save(s.ctx)
+	if err != nil {
+		if ent.IsConstraintError(err) {
+			return nil, fmt.Errorf("a cut named %q already exists", name)
+		}
+		return nil, fmt.Errorf("failed to rename cut: %w", err)
+	}
+
+	proj, err := s.client.Project.Get(s.ctx, projectID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get project: %w", err)
+	}
+	return toCutResponse(c, proj), nil
+}
+
+// DeleteCut removes a cut. The active cut cannot be deleted; switch to another cut first.
+func (s *ProjectService) DeleteCut(projectID int, cutID int) error {
+	if _, err := s.getCut(projectID, cutID); err != nil {
+		return err
+	}
+
+	proj, err := s.client.Project.Get(s.ctx, projectID)
+	if err != nil {
+		return fmt.Errorf("failed to get project: %w", err)
+	}
+	if proj.ActiveCutID == cutID {
+		return fmt.Errorf("cannot delete the active cut")
+	}
+
+	if err := s.client.Cut.DeleteOneID(cutID).Exec(s.ctx); err != nil {
+		return fmt.Errorf("failed to delete cut: %w", err)
+	}
+
+	log.Printf("[CUTS] Deleted cut %d from project %d", cutID, projectID)
+	return nil
+}
+
+// ensureDefaultCut creates the default cut from the project's current edit when the project has no cuts yet
+func (s *ProjectService) ensureDefaultCut(projectID int) (*ent.Project, error) {
+	proj, err := s.client.Project.Query().
+		Where(project.ID(projectID), project.DeletedAtIsNil()).
+		Only(s.ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get project: %w", err)
+	}
+	if proj.ActiveCutID != 0 {
+		return proj, nil
+	}
+
+	tx, err := s.client.Tx(s.ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to start transaction: %w", err)
+	}
+	defer func() {
+		if err != nil {
+			tx.Rollback()
+		}
+	}()
+
+	c, err := tx.Cut.Create().
+		SetName(DefaultCutName).
+		SetProjectID(projectID).
+		SetHighlightOrder(orderOrEmpty(proj.HighlightOrder)).
+		SetHiddenHighlights(hiddenOrEmpty(proj.HiddenHighlights)).
+		Save(s.ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create default cut: %w", err)
+	}
+
+	proj, err = tx.Project.UpdateOneID(projectID).SetActiveCutID(c.ID).Save(s.ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to set active cut: %w", err)
+	}
+
+	if err = tx.Commit(); err != nil {
+		return nil, fmt.Errorf("failed to commit transaction: %w", err)
+	}
+
+	return proj, nil
+}
+
+// createCut stores a new cut with the given state
+func (s *ProjectService) createCut(projectID int, name string, order []interface{}, hidden []string) (*ent.Cut, error) {
+	name = strings.TrimSpace(name)
+	if name == "" {
+		return nil, fmt.Errorf("cut name cannot be empty")
+	}
+
+	c, err := s.client.Cut.Create().
+		SetName(name).
+		SetProjectID(projectID).
+		SetHighlightOrder(orderOrEmpty(order)).
+		SetHiddenHighlights(hiddenOrEmpty(hidden)).
+		Save(s.ctx)
+	if err != nil {
+		if ent.IsConstraintError(err) {
+			return nil, fmt.Errorf("a cut named %q already exists", name)
+		}
+		return nil, fmt.Errorf("failed to create cut: %w", err)
+	}
+	return c, nil
+}
+
+// getCut loads a cut, checking that it belongs to the project
+func (s *ProjectService) getCut(projectID int, cutID int) (*ent.Cut, error) {
+	c, err := s.client.Cut.Query().
+		Where(cut.ID(cutID), cut.ProjectID(projectID)).
+		Only(s.ctx)
+	if err != nil {
+		if ent.IsNotFound(err) {
+			return nil, fmt.Errorf("cut %d not found in project %d", cutID, projectID)
+		}
+		return nil, fmt.Errorf("failed to get cut: %w", err)
+	}
+	return c, nil
+}
+
+// duplicateCuts copies a project's cuts into another project. Without clips only the section
+// skeleton of each order is kept. The copy of the source's active cut becomes the target's active cut.
+func (s *ProjectService) duplicateCuts(tx *ent.Tx, source *ent.Project, targetProjectID int, includeClips bool) error {
+	cuts, err := tx.Cut.Query().
+		Where(cut.ProjectID(source.ID)).
+		Order(ent.Asc(cut.FieldCreatedAt), ent.Asc(cut.FieldID)).
+		All(s.ctx)
+	if err != nil {
+		return fmt.Errorf("failed to query cuts: %w", err)
+	}
+
+	for _, c := range cuts {
+		// The active cut's live state is on the project
+		order, hidden := c.HighlightOrder, c.HiddenHighlights
+		if c.ID == source.ActiveCutID {
+			order, hidden = source.HighlightOrder, source.HiddenHighlights
+		}
+		if !includeClips {
+			order, hidden = orderFromSectionTitles(sectionTitlesFromOrder(order)), nil
+		}
+
+		copied, err := tx.Cut.Create().
+			SetName(c.Name).
+			SetProjectID(targetProjectID).
+			SetHighlightOrder(orderOrEmpty(order)).
+			SetHiddenHighlights(hiddenOrEmpty(hidden)).
+			Save(s.ctx)
+		if err != nil {
+			return fmt.Errorf("failed to copy cut %d: %w", c.ID, err)
+		}
+
+		if c.ID == source.ActiveCutID {
+			if err := tx.Project.UpdateOneID(targetProjectID).SetActiveCutID(copied.ID).Exec(s.ctx); err != nil {
+				return fmt.Errorf("failed to set active cut: %w", err)
+			}
+		}
+	}
+
+	return nil
+}
+
+// toCutResponse converts a cut to its frontend form, reading the active cut's live state from the project
+func toCutResponse(c *ent.Cut, proj *ent.Project) *CutResponse {
+	order, hidden := c.HighlightOrder, c.HiddenHighlights
+	isActive := proj.ActiveCutID == c.ID
+	if isActive {
+		order, hidden = proj.HighlightOrder, proj.HiddenHighlights
+	}
+
+	highlightCount := 0
+	for _, item := range order {
+		if id, ok := item.(string); ok && id != "N" {
+			highlightCount++
+		}
+	}
+
+	return &CutResponse{
+		ID:             c.ID,
+		ProjectID:      c.ProjectID,
+		Name:           c.Name,
+		IsActive:       isActive,
+		HighlightCount: highlightCount,
+		HiddenCount:    len(hidden),
+		SectionTitles:  sectionTitlesFromOrder(order),
+		CreatedAt:      c.CreatedAt.Format("2006-01-02 15:04:05"),
+		UpdatedAt:      c.UpdatedAt.Format("2006-01-02 15:04:05"),
+	}
+}
+
+// orderOrEmpty returns an empty order in place of nil
+func orderOrEmpty(order []interface{}) []interface{} {
+	if order == nil {
+		return []interface{}{}
+	}
+	return order
+}
+
+// historyOrEmpty returns an empty order history in place of nil
+func historyOrEmpty(history [][]interface{}) [][]interface{} {
+	if history == nil {
+		return [][]interface{}{}
+	}
+	return history
+}
+
+// hiddenOrEmpty returns an empty hidden highlight list in place of nil
+func hiddenOrEmpty(hidden []string) []string {
+	if hidden == nil {
+		return []string{}
+	}
+	return hidden
+}
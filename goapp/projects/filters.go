@@ -0,0 +1,308 @@
+package projects
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+
+	"ramble-ai/ent"
+	"ramble-ai/ent/collection"
+	"ramble-ai/ent/predicate"
+	"ramble-ai/ent/project"
+	"ramble-ai/ent/tag"
+	"ramble-ai/ent/videoclip"
+)
+
+// Sort keys accepted by FilterProjects and FilterVideoClips
+const (
+	SortByName           = "name"
+	SortByCreatedAt      = "created_at"
+	SortByUpdatedAt      = "updated_at"
+	SortByDuration       = "duration" // video clips only
+	SortByHighlightCount = "highlight_count"
+)
+
+// ProjectFilter selects and orders projects. Zero values leave a criterion unset.
+// Dates accept RFC3339, "2006-01-02 15:04:05" or "2006-01-02"; a date-only CreatedBefore includes that whole day.
+type ProjectFilter struct {
+	Tags          []string `json:"tags,omitempty"` // projects must carry every tag
+	CollectionID  int      `json:"collectionId,omitempty"`
+	CreatedAfter  string   `json:"createdAfter,omitempty"`
+	CreatedBefore string   `json:"createdBefore,omitempty"`
+	MinHighlights *int     `json:"minHighlights,omitempty"`
+	MaxHighlights *int     `json:"maxHighlights,omitempty"`
+	SortBy        string   `json:"sortBy,omitempty"` // defaults to created_at
+	SortDesc      bool     `json:"sortDesc,omitempty"`
+}
+
+// VideoClipFilter selects and orders video clips. Zero values leave a criterion unset.
+type VideoClipFilter struct {
+	ProjectID          int      `json:"projectId,omitempty"` // 0 searches every project
+	Tags               []string `json:"tags,omitempty"`      // clips must carry every tag
+	TranscriptionState string   `json:"transcriptionState,omitempty"`
+	CreatedAfter       string   `json:"createdAfter,omitempty"`
+	CreatedBefore      string   `json:"createdBefore,omitempty"`
+	MinDuration        *float64 `json:"minDuration,omitempty"`
+	MaxDuration        *float64 `json:"maxDuration,omitempty"`
+	MinHighlights      *int     `json:"minHighlights,omitempty"`
+	MaxHighlights      *int     `json:"maxHighlights,omitempty"`
+	SortBy             string   `json:"sortBy,omitempty"` // defaults to created_at
+	SortDesc           bool     `json:"sortDesc,omitempty"`
+}
+
+// ProjectSummary is a filtered project with its tags and content counts
+type ProjectSummary struct {
+	Project        *ProjectResponse `json:"project"`
+	Tags           []*TagResponse   `json:"tags"`
+	CollectionIDs  []int            `json:"collectionIds"`
+	ClipCount      int              `json:"clipCount"`
+	HighlightCount int              `json:"highlightCount"`
+}
+
+// VideoClipSummary is a filtered video clip with its tags and highlight count
+type VideoClipSummary struct {
+	Clip           *VideoClipResponse `json:"clip"`
+	Tags           []*TagResponse     `json:"tags"`
+	HighlightCount int                `json:"highlightCount"`
+}
+
+// FilterProjects returns the projects matching a filter in the requested order
+func (s *ProjectService) FilterProjects(filter ProjectFilter) ([]*ProjectSummary, error) {
+	orderField, err := filterOrderField(filter.SortBy, false)
+	if err != nil {
+		return nil, err
+	}
+
+	predicates := []predicate.Project{project.DeletedAtIsNil()}
+	for _, name := range filter.Tags {
+		predicates = append(predicates, project.HasTagsWith(tag.NameEqualFold(strings.TrimSpace(name))))
+	}
+	if filter.CollectionID != 0 {
+		predicates = append(predicates, project.HasCollectionsWith(collection.ID(filter.CollectionID)))
+	}
+	after, before, err := parseDateRange(filter.CreatedAfter, filter.CreatedBefore)
+	if err != nil {
+		return nil, err
+	}
+	if !after.IsZero() {
+		predicates = append(predicates, project.CreatedAtGTE(after))
+	}
+	if !before.IsZero() {
+		predicates = append(predicates, project.CreatedAtLT(before))
+	}
+
+	query := s.client.Project.Query().
+		Where(predicates...).
+		WithTags().
+		WithCollections(func(q *ent.CollectionQuery) {
+			q.Select(collection.FieldID)
+		}).
+		WithVideoClips(func(q *ent.VideoClipQuery) {
+			q.Select(videoclip.FieldID, videoclip.FieldHighlights).Where(videoclip.DeletedAtIsNil())
+		})
+	if orderField != "" {
+		query = query.Order(filterOrder(orderField, filter.SortDesc), ent.Asc(project.FieldID))
+	} else {
+		query = query.Order(ent.Asc(project.FieldID))
+	}
+
+	projects, err := query.All(s.ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to filter projects: %w", err)
+	}
+
+	summaries := make([]*ProjectSummary, 0, len(projects))
+	for _, proj := range projects {
+		highlightCount := 0
+		for _, clip := range proj.Edges.VideoClips {
+			highlightCount += len(clip.Highlights)
+		}
+		if !withinCountRange(highlightCount, filter.MinHighlights, filter.MaxHighlights) {
+			continue
+		}
+
+		collectionIDs := make([]int, 0, len(proj.Edges.Collections))
+		for _, c := range proj.Edges.Collections {
+			collectionIDs = append(collectionIDs, c.ID)
+		}
+
+		summaries = append(summaries, &ProjectSummary{
+			Project:        toProjectResponse(proj),
+			Tags:           toTagResponses(proj.Edges.Tags),
+			CollectionIDs:  collectionIDs,
+			ClipCount:      len(proj.Edges.VideoClips),
+			HighlightCount: highlightCount,
+		})
+	}
+
+	if orderField == "" {
+		sort.SliceStable(summaries, func(i, j int) bool {
+			if filter.SortDesc {
+				return summaries[i].HighlightCount > summaries[j].HighlightCount
+			}
+			return summaries[i].HighlightCount < summaries[j].HighlightCount
+		})
+	}
+
+	return summaries, nil
+}
+
+// FilterVideoClips returns the video clips matching a filter in the requested order
+func (s *ProjectService) FilterVideoClips(filter VideoClipFilter) ([]*VideoClipSummary, error) {
+	orderField, err := filterOrderField(filter.SortBy, true)
+	if err != nil {
+		return nil, err
+	}
+
+	predicates := []predicate.VideoClip{videoclip.DeletedAtIsNil()}
+	if filter.ProjectID != 0 {
+		predicates = append(predicates, videoclip.HasProjectWith(project.ID(filter.ProjectID)))
+	}
+	for _, name := range filter.Tags {
+		predicates = append(predicates, videoclip.HasTagsWith(tag.NameEqualFold(strings.TrimSpace(name))))
+	}
+	if filter.TranscriptionState != "" {
+		predicates = append(predicates, videoclip.TranscriptionState(filter.TranscriptionState))
+	}
+	after, before, err := parseDateRange(filter.CreatedAfter, filter.CreatedBefore)
+	if err != nil {
+		return nil, err
+	}
+	if !after.IsZero() {
+		predicates = append(predicates, videoclip.CreatedAtGTE(after))
+	}
+	if !before.IsZero() {
+		predicates = append(predicates, videoclip.CreatedAtLT(before))
+	}
+	if filter.MinDuration != nil {
+		predicates = append(predicates, videoclip.DurationGTE(*filter.MinDuration))
+	}
+	if filter.MaxDuration != nil {
+		predicates = append(predicates, videoclip.DurationLTE(*filter.MaxDuration))
+	}
+
+	query := s.client.VideoClip.Query().
+		Where(predicates...).
+		WithTags().
+		WithProject(func(q *ent.ProjectQuery) {
+			q.Select(project.FieldID)
+		})
+	if orderField != "" {
+		query = query.Order(filterOrder(orderField, filter.SortDesc), ent.Asc(videoclip.FieldID))
+	} else {
+		query = query.Order(ent.Asc(videoclip.FieldID))
+	}
+
+	clips, err := query.All(s.ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to filter video clips: %w", err)
+	}
+
+	summaries := make([]*VideoClipSummary, 0, len(clips))
+	for _, clip := range clips {
+		if !withinCountRange(len(clip.Highlights), filter.MinHighlights, filter.MaxHighlights) {
+			continue
+		}
+
+		projectID := 0
+		if clip.Edges.Project != nil {
+			projectID = clip.Edges.Project.ID
+		}
+
+		summaries = append(summaries, &VideoClipSummary{
+			Clip:           s.toVideoClipResponse(clip, projectID),
+			Tags:           toTagResponses(clip.Edges.Tags),
+			HighlightCount: len(clip.Highlights),
+		})
+	}
+
+	if orderField == "" {
+		sort.SliceStable(summaries, func(i, j int) bool {
+			if filter.SortDesc {
+				return summaries[i].HighlightCount > summaries[j].HighlightCount
+			}
+			return summaries[i].HighlightCount < summaries[j].HighlightCount
+		})
+	}
+
+	return summaries, nil
+}
+
+// filterOrderField maps a sort key to a database column. Highlight count is stored inside JSON,
+// so it returns "" and the caller sorts in memory.
+func filterOrderField(sortBy string, allowDuration bool) (string, error) {
+	switch sortBy {
+	case "", SortByCreatedAt:
+		return "created_at", nil
+	case SortByName:
+		return "name", nil
+	case SortByUpdatedAt:
+		return "updated_at", nil
+	case SortByDuration:
+		if allowDuration {
+			return "duration", nil
+		}
+	case SortByHighlightCount:
+		return "", nil
+	}
+	return "", fmt.Errorf("unsupported sort key: %s", sortBy)
+}
+
+// filterOrder returns an ascending or descending order option for a column
+func filterOrder(field string, desc bool) func(*sql.Selector) {
+	if desc {
+		return ent.Desc(field)
+	}
+	return ent.Asc(field)
+}
+
+// parseDateRange parses optional lower and upper date bounds
+func parseDateRange(afterValue, beforeValue string) (time.Time, time.Time, error) {
+	var after, before time.Time
+	var err error
+
+	if afterValue != "" {
+		if after, _, err = parseFilterDate(afterValue); err != nil {
+			return after, before, err
+		}
+	}
+	if beforeValue != "" {
+		var dateOnly bool
+		if before, dateOnly, err = parseFilterDate(beforeValue); err != nil {
+			return after, before, err
+		}
+		if dateOnly {
+			before = before.AddDate(0, 0, 1)
+		}
+	}
+
+	return after, before, nil
+}
+
+// parseFilterDate parses a filter date in local time, reporting whether it had no time component
+func parseFilterDate(value string) (time.Time, bool, error) {
+	if t, err := time.Parse(time.RFC3339, value); err == nil {
+		return t, false, nil
+	}
+	if t, err := time.ParseInLocation("2006-01-02 15:04:05", value, time.Local); err == nil {
+		return t, false, nil
+	}
+	if t, err := time.ParseInLocation("2006-01-02", value, time.Local); err == nil {
+		return t, true, nil
+	}
+	return time.Time{}, false, fmt.Errorf("invalid date: %s", value)
+}
+
+// withinCountRange reports whether a count satisfies optional inclusive bounds
+func withinCountRange(count int, min, max *int) bool {
+	if min != nil && count < *min {
+		return false
+	}
+	if max != nil && count > *max {
+		return false
+	}
+	return true
+}
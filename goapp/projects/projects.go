@@ -7,6 +7,7 @@ import (
 	"fmt"
 	"io"
 	"log"
+	"math"
 	"mime/multipart"
 	"net/http"
 	"net/url"
@@ -22,13 +23,17 @@ import (
 	"ramble-ai/ent"
 	"ramble-ai/ent/chatsession"
 	"ramble-ai/ent/chatmessage"
+	"ramble-ai/ent/cut"
 	"ramble-ai/ent/exportjob"
 	"ramble-ai/ent/project"
+	"ramble-ai/ent/prompt"
 	"ramble-ai/ent/schema"
 	"ramble-ai/ent/settings"
+	"ramble-ai/ent/transcriptembedding"
 	"ramble-ai/ent/videoclip"
 	highlightsservice "ramble-ai/goapp/highlights"
 	"ramble-ai/goapp/realtime"
+	"ramble-ai/goapp/usage"
 	"github.com/wailsapp/wails/v2/pkg/runtime"
 )
 
@@ -50,10 +55,11 @@ type Word struct {
 
 // Highlight represents a highlighted text region with timestamps
 type Highlight struct {
-	ID      string  `json:"id"`
-	Start   float64 `json:"start"`
-	End     float64 `json:"end"`
-	ColorID int     `json:"colorId"`
+	ID      string                   `json:"id"`
+	Start   float64                  `json:"start"`
+	End     float64                  `json:"end"`
+	ColorID int                      `json:"colorId"`
+	Insight *schema.HighlightInsight `json:"insight,omitempty"`
 }
 
 // NewlineSection represents a newline section with an optional title
@@ -162,35 +168,24 @@ func NewProjectService(client *ent.Client, ctx context.Context) *ProjectService
 
 // CreateProject creates a new project
 func (s *ProjectService) CreateProject(name, description string) (*ProjectResponse, error) {
-	// Create project directory path
-	projectPath := filepath.Join("projects", name)
-
 	// Create the project in the database
 	proj, err := s.client.Project.
 		Create().
 		SetName(name).
 		SetDescription(description).
-		SetPath(projectPath).
+		SetPath(projectPath(name)).
 		Save(s.ctx)
 
 	if err != nil {
 		return nil, fmt.Errorf("failed to create project: %w", err)
 	}
 
-	return &ProjectResponse{
-		ID:          proj.ID,
-		Name:        proj.Name,
-		Description: proj.Description,
-		Path:        proj.Path,
-		CreatedAt:   proj.CreatedAt.Format("2006-01-02 15:04:05"),
-		UpdatedAt:   proj.UpdatedAt.Format("2006-01-02 15:04:05"),
-		ActiveTab:   proj.ActiveTab,
-	}, nil
+	return toProjectResponse(proj), nil
 }
 
-// GetProjects returns all projects
+// GetProjects returns all projects that are not in the trash
 func (s *ProjectService) GetProjects() ([]*ProjectResponse, error) {
-	projects, err := s.client.Project.Query().All(s.ctx)
+	projects, err := s.client.Project.Query().Where(project.DeletedAtIsNil()).All(s.ctx)
 	if err != nil {
 		return nil, fmt.Errorf("failed to get projects: %w", err)
 	}
@@ -211,11 +206,11 @@ func (s *ProjectService) GetProjects() ([]*ProjectResponse, error) {
 	return responses, nil
 }
 
-// GetProjectByID returns a project by ID
+// GetProjectByID returns a project by ID, unless it is in the trash
 func (s *ProjectService) GetProjectByID(id int) (*ProjectResponse, error) {
 	proj, err := s.client.Project.
 		Query().
-		Where(project.ID(id)).
+		Where(project.ID(id), project.DeletedAtIsNil()).
 		Only(s.ctx)
 
 	if err != nil {
@@ -260,7 +255,7 @@ func (s *ProjectService) UpdateProject(id int, name, description string) (*Proje
 	}, nil
 }
 
-// DeleteProject deletes a project and all its related entities
+// DeleteProject permanently deletes a project and all its related entities (see TrashProject for soft delete)
 func (s *ProjectService) DeleteProject(id int) error {
 	// Start a transaction to ensure all deletions succeed or fail together
 	tx, err := s.client.Tx(s.ctx)
@@ -308,7 +303,31 @@ func (s *ProjectService) DeleteProject(id int) error {
 		return fmt.Errorf("failed to delete export jobs: %w", err)
 	}
 
-	// 4. Delete all video clips belonging to this project
+	// 4. Delete all cuts belonging to this project
+	_, err = tx.Cut.Delete().
+		Where(cut.ProjectID(id)).
+		Exec(s.ctx)
+	if err != nil {
+		return fmt.Errorf("failed to delete cuts: %w", err)
+	}
+
+	// 5. Delete library prompts owned by this project (shared prompts are kept)
+	_, err = tx.Prompt.Delete().
+		Where(prompt.ProjectID(id)).
+		Exec(s.ctx)
+	if err != nil {
+		return fmt.Errorf("failed to delete prompts: %w", err)
+	}
+
+	// 6. Delete transcript embeddings for this project's video clips
+	_, err = tx.TranscriptEmbedding.Delete().
+		Where(transcriptembedding.HasVideoClipWith(videoclip.HasProjectWith(project.ID(id)))).
+		Exec(s.ctx)
+	if err != nil {
+		return fmt.Errorf("failed to delete transcript embeddings: %w", err)
+	}
+
+	// 7. Delete all video clips belonging to this project
 	_, err = tx.VideoClip.Delete().
 		Where(videoclip.HasProjectWith(project.ID(id))).
 		Exec(s.ctx)
@@ -316,7 +335,7 @@ func (s *ProjectService) DeleteProject(id int) error {
 		return fmt.Errorf("failed to delete video clips: %w", err)
 	}
 
-	// 5. Finally, delete the project itself
+	// 8. Finally, delete the project itself
 	err = tx.Project.DeleteOneID(id).Exec(s.ctx)
 	if err != nil {
 		return fmt.Errorf("failed to delete project: %w", err)
@@ -331,6 +350,163 @@ func (s *ProjectService) DeleteProject(id int) error {
 	return nil
 }
 
+// DuplicateProject deep copies a project's settings, tags, collections, section structure and (optionally)
+// its video clips with transcripts, highlights, tags and search embeddings. An empty name defaults to "<name> (Copy)".
+func (s *ProjectService) DuplicateProject(id int, name string, includeClips bool) (*ProjectResponse, error) {
+	source, err := s.client.Project.Query().
+		Where(project.ID(id)).
+		WithTags().
+		WithCollections().
+		Only(s.ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get project: %w", err)
+	}
+
+	if strings.TrimSpace(name) == "" {
+		name = source.Name + " (Copy)"
+	}
+
+	highlightSettings, err := highlightsservice.NewHighlightService(s.client, s.ctx).GetProjectHighlightAISettings(id)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get highlight AI settings: %w", err)
+	}
+
+	tx, err := s.client.Tx(s.ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to start transaction: %w", err)
+	}
+	defer func() {
+		if err != nil {
+			tx.Rollback()
+		}
+	}()
+
+	// Without clips only the section skeleton of the order is meaningful
+	highlightOrder := source.HighlightOrder
+	if !includeClips {
+		highlightOrder = orderFromSectionTitles(sectionTitlesFromOrder(source.HighlightOrder))
+	}
+
+	create := tx.Project.
+		Create().
+		SetName(name).
+		SetDescription(source.Description).
+		SetPath(projectPath(name)).
+		SetAiModel(source.AiModel).
+		SetAiPrompt(source.AiPrompt).
+		SetAiHighlightModel(source.AiHighlightModel).
+		SetAiHighlightPrompt(source.AiHighlightPrompt).
+		SetActiveTab(source.ActiveTab).
+		SetExportPresets(source.ExportPresets).
+		SetHighlightOrder(highlightOrder).
+		AddTags(source.Edges.Tags...).
+		AddCollections(source.Edges.Collections...)
+	if includeClips {
+		create.SetHiddenHighlights(source.HiddenHighlights)
+	}
+
+	proj, err := create.Save(s.ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create project: %w", err)
+	}
+
+	err = highlightsservice.NewHighlightService(tx.Client(), s.ctx).SaveProjectHighlightAISettings(proj.ID, *highlightSettings)
+	if err != nil {
+		return nil, fmt.Errorf("failed to copy highlight AI settings: %w", err)
+	}
+
+	if includeClips {
+		if err = s.duplicateVideoClips(tx, id, proj.ID); err != nil {
+			return nil, err
+		}
+	}
+
+	if err = s.duplicateCuts(tx, source, proj.ID, includeClips); err != nil {
+		return nil, err
+	}
+
+	if err = tx.Commit(); err != nil {
+		return nil, fmt.Errorf("failed to commit transaction: %w", err)
+	}
+
+	log.Printf("[PROJECTS] Duplicated project %d as %d (clips: %t)", id, proj.ID, includeClips)
+	return toProjectResponse(proj), nil
+}
+
+// duplicateVideoClips copies every clip of a project, with its transcript embeddings, into another project.
+// Highlight IDs are kept so the copied highlight order and hidden highlights stay valid.
+func (s *ProjectService) duplicateVideoClips(tx *ent.Tx, sourceProjectID, targetProjectID int) error {
+	clips, err := tx.VideoClip.Query().
+		Where(
+			videoclip.HasProjectWith(project.ID(sourceProjectID)),
+			videoclip.DeletedAtIsNil(),
+		).
+		WithTranscriptEmbeddings().
+		WithTags().
+		All(s.ctx)
+	if err != nil {
+		return fmt.Errorf("failed to query video clips: %w", err)
+	}
+
+	for _, clip := range clips {
+		create := tx.VideoClip.
+			Create().
+			SetName(clip.Name).
+			SetDescription(clip.Description).
+			SetFilePath(clip.FilePath).
+			SetDuration(clip.Duration).
+			SetFormat(clip.Format).
+			SetWidth(clip.Width).
+			SetHeight(clip.Height).
+			SetFileSize(clip.FileSize).
+			SetTranscription(clip.Transcription).
+			SetTranscriptionWords(clip.TranscriptionWords).
+			SetTranscriptionLanguage(clip.TranscriptionLanguage).
+			SetTranscriptionDuration(clip.TranscriptionDuration).
+			SetHighlights(clip.Highlights).
+			SetSuggestedHighlights(clip.SuggestedHighlights).
+			SetTranscriptionState(clip.TranscriptionState).
+			SetTranscriptionError(clip.TranscriptionError).
+			SetProjectID(targetProjectID).
+			AddTags(clip.Edges.Tags...)
+		if !clip.TranscriptionStartedAt.IsZero() {
+			create.SetTranscriptionStartedAt(clip.TranscriptionStartedAt)
+		}
+		if !clip.TranscriptionCompletedAt.IsZero() {
+			create.SetTranscriptionCompletedAt(clip.TranscriptionCompletedAt)
+		}
+
+		copied, err := create.Save(s.ctx)
+		if err != nil {
+			return fmt.Errorf("failed to copy video clip %d: %w", clip.ID, err)
+		}
+
+		if len(clip.Edges.TranscriptEmbeddings) == 0 {
+			continue
+		}
+
+		builders := make([]*ent.TranscriptEmbeddingCreate, len(clip.Edges.TranscriptEmbeddings))
+		for i, embedding := range clip.Edges.TranscriptEmbeddings {
+			builders[i] = tx.TranscriptEmbedding.Create().
+				SetVideoClipID(copied.ID).
+				SetChunkIndex(embedding.ChunkIndex).
+				SetStartWord(embedding.StartWord).
+				SetEndWord(embedding.EndWord).
+				SetStartTime(embedding.StartTime).
+				SetEndTime(embedding.EndTime).
+				SetText(embedding.Text).
+				SetModel(embedding.Model).
+				SetSourceHash(embedding.SourceHash).
+				SetEmbedding(embedding.Embedding)
+		}
+		if _, err := tx.TranscriptEmbedding.CreateBulk(builders...).Save(s.ctx); err != nil {
+			return fmt.Errorf("failed to copy transcript embeddings for clip %d: %w", clip.ID, err)
+		}
+	}
+
+	return nil
+}
+
 // CreateVideoClip creates a new video clip
 func (s *ProjectService) CreateVideoClip(projectID int, filePath string) (*VideoClipResponse, error) {
 	// Validate that it's a video file
@@ -394,7 +570,10 @@ func (s *ProjectService) CreateVideoClip(projectID int, filePath string) (*Video
 func (s *ProjectService) GetVideoClipsByProject(projectID int) ([]*VideoClipResponse, error) {
 	clips, err := s.client.VideoClip.
 		Query().
-		Where(videoclip.HasProjectWith(project.ID(projectID))).
+		Where(
+			videoclip.HasProjectWith(project.ID(projectID)),
+			videoclip.DeletedAtIsNil(),
+		).
 		All(s.ctx)
 
 	if err != nil {
@@ -403,35 +582,7 @@ func (s *ProjectService) GetVideoClipsByProject(projectID int) ([]*VideoClipResp
 
 	var responses []*VideoClipResponse
 	for _, clip := range clips {
-		fileSize, format, exists := s.getFileInfo(clip.FilePath)
-		fileName := filepath.Base(clip.FilePath)
-
-		responses = append(responses, &VideoClipResponse{
-			ID:                       clip.ID,
-			Name:                     clip.Name,
-			Description:              clip.Description,
-			FilePath:                 clip.FilePath,
-			FileName:                 fileName,
-			FileSize:                 fileSize,
-			Duration:                 clip.Duration,
-			Format:                   format,
-			Width:                    clip.Width,
-			Height:                   clip.Height,
-			ProjectID:                projectID,
-			CreatedAt:                clip.CreatedAt.Format("2006-01-02 15:04:05"),
-			UpdatedAt:                clip.UpdatedAt.Format("2006-01-02 15:04:05"),
-			Exists:                   exists,
-			ThumbnailURL:             s.getThumbnailURL(clip.FilePath),
-			Transcription:            clip.Transcription,
-			TranscriptionWords:       s.schemaWordsToWords(clip.TranscriptionWords),
-			TranscriptionLanguage:    clip.TranscriptionLanguage,
-			TranscriptionDuration:    clip.TranscriptionDuration,
-			TranscriptionState:       clip.TranscriptionState,
-			TranscriptionError:       clip.TranscriptionError,
-			TranscriptionStartedAt:   s.formatTime(clip.TranscriptionStartedAt),
-			TranscriptionCompletedAt: s.formatTime(clip.TranscriptionCompletedAt),
-			Highlights:               s.schemaHighlightsToHighlights(clip.Highlights),
-		})
+		responses = append(responses, s.toVideoClipResponse(clip, projectID))
 	}
 
 	return responses, nil
@@ -480,9 +631,17 @@ func (s *ProjectService) UpdateVideoClip(id int, name, description string) (*Vid
 	}, nil
 }
 
-// DeleteVideoClip deletes a video clip
+// DeleteVideoClip permanently deletes a video clip (see TrashVideoClip for soft delete)
 func (s *ProjectService) DeleteVideoClip(id int) error {
-	err := s.client.VideoClip.DeleteOneID(id).Exec(s.ctx)
+	// Remove dependent transcript embeddings first to satisfy the foreign key
+	_, err := s.client.TranscriptEmbedding.Delete().
+		Where(transcriptembedding.VideoClipID(id)).
+		Exec(s.ctx)
+	if err != nil {
+		return fmt.Errorf("failed to delete transcript embeddings: %w", err)
+	}
+
+	err = s.client.VideoClip.DeleteOneID(id).Exec(s.ctx)
 	if err != nil {
 		return fmt.Errorf("failed to delete video clip: %w", err)
 	}
@@ -594,6 +753,7 @@ func (s *ProjectService) UpdateVideoClipHighlights(clipID int, highlights []High
 			Start:   h.Start,
 			End:     h.End,
 			ColorID: colorID,
+			Insight: h.Insight,
 		})
 	}
 
@@ -607,6 +767,9 @@ func (s *ProjectService) UpdateVideoClipHighlights(clipID int, highlights []High
 		return fmt.Errorf("failed to get video clip for real-time update: %w", err)
 	}
 
+	// Accepted suggestions and edited highlights keep their AI insight when the caller omits it
+	inheritHighlightInsights(schemaHighlights, clip.Highlights, clip.SuggestedHighlights)
+
 	_, err = s.client.VideoClip.
 		UpdateOneID(clipID).
 		SetHighlights(schemaHighlights).
@@ -654,6 +817,7 @@ func (s *ProjectService) UpdateVideoClipSuggestedHighlights(clipID int, suggeste
 			Start:   h.Start,
 			End:     h.End,
 			ColorID: colorID,
+			Insight: h.Insight,
 		})
 	}
 
@@ -741,6 +905,57 @@ func (s *ProjectService) UpdateProjectHighlightOrder(projectID int, highlightOrd
 
 // Helper functions
 
+// projectPath returns the default path for a project name
+func projectPath(name string) string {
+	return filepath.Join("projects", name)
+}
+
+// toProjectResponse converts a project entity to its frontend form
+func toProjectResponse(proj *ent.Project) *ProjectResponse {
+	return &ProjectResponse{
+		ID:          proj.ID,
+		Name:        proj.Name,
+		Description: proj.Description,
+		Path:        proj.Path,
+		CreatedAt:   proj.CreatedAt.Format("2006-01-02 15:04:05"),
+		UpdatedAt:   proj.UpdatedAt.Format("2006-01-02 15:04:05"),
+		ActiveTab:   proj.ActiveTab,
+	}
+}
+
+// toVideoClipResponse converts a video clip entity to its frontend form, checking the file on disk
+func (s *ProjectService) toVideoClipResponse(clip *ent.VideoClip, projectID int) *VideoClipResponse {
+	fileSize, format, exists := s.getFileInfo(clip.FilePath)
+	fileName := filepath.Base(clip.FilePath)
+
+	return &VideoClipResponse{
+		ID:                       clip.ID,
+		Name:                     clip.Name,
+		Description:              clip.Description,
+		FilePath:                 clip.FilePath,
+		FileName:                 fileName,
+		FileSize:                 fileSize,
+		Duration:                 clip.Duration,
+		Format:                   format,
+		Width:                    clip.Width,
+		Height:                   clip.Height,
+		ProjectID:                projectID,
+		CreatedAt:                clip.CreatedAt.Format("2006-01-02 15:04:05"),
+		UpdatedAt:                clip.UpdatedAt.Format("2006-01-02 15:04:05"),
+		Exists:                   exists,
+		ThumbnailURL:             s.getThumbnailURL(clip.FilePath),
+		Transcription:            clip.Transcription,
+		TranscriptionWords:       s.schemaWordsToWords(clip.TranscriptionWords),
+		TranscriptionLanguage:    clip.TranscriptionLanguage,
+		TranscriptionDuration:    clip.TranscriptionDuration,
+		TranscriptionState:       clip.TranscriptionState,
+		TranscriptionError:       clip.TranscriptionError,
+		TranscriptionStartedAt:   s.formatTime(clip.TranscriptionStartedAt),
+		TranscriptionCompletedAt: s.formatTime(clip.TranscriptionCompletedAt),
+		Highlights:               s.schemaHighlightsToHighlights(clip.Highlights),
+	}
+}
+
 // isVideoFile checks if a file is a supported video format
 func (s *ProjectService) isVideoFile(filePath string) bool {
 	ext := strings.ToLower(filepath.Ext(filePath))
@@ -799,11 +1014,59 @@ func (s *ProjectService) schemaHighlightsToHighlights(schemaHighlights []schema.
 			Start:   sh.Start,
 			End:     sh.End,
 			ColorID: sh.ColorID,
+			Insight: sh.Insight,
 		})
 	}
 	return highlights
 }
 
+// minInsightOverlap is how much of their combined time span a highlight must share with a
+// suggestion to be treated as that suggestion accepted under a new ID
+const minInsightOverlap = 0.75
+
+// inheritHighlightInsights fills in missing insights, first from highlights with the same ID and
+// then from the suggestion covering (nearly) the same time range
+func inheritHighlightInsights(highlights []schema.Highlight, current []schema.Highlight, suggested []schema.Highlight) {
+	insights := make(map[string]*schema.HighlightInsight)
+	for _, h := range append(append([]schema.Highlight{}, current...), suggested...) {
+		if h.Insight != nil && insights[h.ID] == nil {
+			insights[h.ID] = h.Insight
+		}
+	}
+
+	for i := range highlights {
+		h := &highlights[i]
+		if h.Insight != nil {
+			continue
+		}
+		if insight, ok := insights[h.ID]; ok {
+			h.Insight = insight
+			continue
+		}
+
+		bestOverlap := 0.0
+		for _, suggestion := range suggested {
+			if suggestion.Insight == nil {
+				continue
+			}
+			if overlap := timeOverlapRatio(h.Start, h.End, suggestion.Start, suggestion.End); overlap >= minInsightOverlap && overlap > bestOverlap {
+				h.Insight = suggestion.Insight
+				bestOverlap = overlap
+			}
+		}
+	}
+}
+
+// timeOverlapRatio is the shared duration of two time ranges divided by their combined span (0-1)
+func timeOverlapRatio(startA, endA, startB, endB float64) float64 {
+	shared := math.Min(endA, endB) - math.Max(startA, startB)
+	span := math.Max(endA, endB) - math.Min(startA, startB)
+	if shared <= 0 || span <= 0 {
+		return 0
+	}
+	return shared / span
+}
+
 // formatTime formats a time value to a string, handling both time.Time and *time.Time
 func (s *ProjectService) formatTime(t interface{}) string {
 	switch v := t.(type) {
@@ -1680,6 +1943,7 @@ func (s *ProjectService) TranscribeVideoClip(clipID int) (*TranscriptionResponse
 			Message: errMsg,
 		}, nil
 	}
+	s.recordTranscriptionUsage(clipID, result.Duration)
 
 	// Convert result to WhisperResponse format for compatibility
 	var convertedSegments []Segment
@@ -1765,6 +2029,29 @@ func (s *ProjectService) TranscribeVideoClip(clipID int) (*TranscriptionResponse
 	}, nil
 }
 
+// recordTranscriptionUsage adds a Whisper transcription of a clip to the usage ledger under the clip's project
+func (s *ProjectService) recordTranscriptionUsage(clipID int, audioSeconds float64) {
+	projectID, err := s.client.VideoClip.
+		Query().
+		Where(videoclip.ID(clipID)).
+		QueryProject().
+		OnlyID(s.ctx)
+	if err != nil {
+		log.Printf("[TRANSCRIPTION] Warning: failed to find project of clip %d for usage: %v", clipID, err)
+	}
+
+	err = usage.NewUsageService(s.client, s.ctx).Record(usage.Entry{
+		ProjectID:    projectID,
+		Provider:     usage.ProviderOpenAI,
+		TaskType:     usage.TaskTranscription,
+		Model:        "whisper-1",
+		AudioSeconds: audioSeconds,
+	})
+	if err != nil {
+		log.Printf("[TRANSCRIPTION] Warning: %v", err)
+	}
+}
+
 // BatchTranscribeResponse represents the response from batch transcription
 type BatchTranscribeResponse struct {
 	Success           bool     `json:"success"`
@@ -1782,6 +2069,7 @@ func (s *ProjectService) BatchTranscribeUntranscribedClips(projectID int) (*Batc
 		Query().
 		Where(
 			videoclip.HasProjectWith(project.ID(projectID)),
+			videoclip.DeletedAtIsNil(),
 			videoclip.Or(
 				videoclip.TranscriptionIsNil(),
 				videoclip.TranscriptionEQ(""),
@@ -2040,6 +2328,7 @@ func (s *ProjectService) SaveTranscriptionResult(clipID int, result *ai.AudioPro
 			Message: "No transcription result provided",
 		}, nil
 	}
+	s.recordTranscriptionUsage(clipID, result.Duration)
 
 	// Update transcription state to processing
 	err := s.updateTranscriptionState(clipID, TranscriptionStateTranscribing, "")
@@ -2107,6 +2396,7 @@ func (s *ProjectService) BatchTranscribeWithAIService(projectID int, aiService a
 		Query().
 		Where(
 			videoclip.HasProjectWith(project.ID(projectID)),
+			videoclip.DeletedAtIsNil(),
 			videoclip.TranscriptionStateNEQ(TranscriptionStateCompleted),
 		).
 		All(s.ctx)
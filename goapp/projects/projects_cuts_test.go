@@ -0,0 +1,127 @@
+package projects
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"ramble-ai/ent/cut"
+	highlightsservice "ramble-ai/goapp/highlights"
+)
+
+func TestCuts(t *testing.T) {
+	client := setupTestClient(t)
+	defer client.Close()
+	ctx := context.Background()
+	service := NewProjectService(client, ctx)
+	highlightService := highlightsservice.NewHighlightService(client, ctx)
+
+	proj := createTestProject(t, client, ctx, "Interview")
+	createTestVideoClip(t, client, ctx, proj, "clip")
+
+	longOrder := []interface{}{map[string]interface{}{"type": "N", "title": "Intro"}, "h1", "h2", "h3"}
+	_, err := proj.Update().
+		SetHighlightOrder(longOrder).
+		SetHiddenHighlights([]string{"h4"}).
+		Save(ctx)
+	require.NoError(t, err)
+
+	var mainCut, recap *CutResponse
+
+	t.Run("existing edit becomes the default cut", func(t *testing.T) {
+		cuts, err := service.GetCuts(proj.ID)
+		require.NoError(t, err)
+		require.Len(t, cuts, 1)
+		mainCut = cuts[0]
+		assert.Equal(t, DefaultCutName, mainCut.Name)
+		assert.True(t, mainCut.IsActive)
+		assert.Equal(t, 3, mainCut.HighlightCount)
+		assert.Equal(t, 1, mainCut.HiddenCount)
+		assert.Equal(t, []string{"Intro"}, mainCut.SectionTitles)
+	})
+
+	t.Run("clone copies the live state of the active cut", func(t *testing.T) {
+		recap, err = service.CloneCut(proj.ID, mainCut.ID, "Recap")
+		require.NoError(t, err)
+		assert.False(t, recap.IsActive)
+		assert.Equal(t, 3, recap.HighlightCount)
+
+		_, err = service.CloneCut(proj.ID, mainCut.ID, " Recap")
+		assert.Error(t, err)
+	})
+
+	t.Run("switching stores and loads each cut's state", func(t *testing.T) {
+		_, err := service.SwitchCut(proj.ID, recap.ID)
+		require.NoError(t, err)
+
+		// Edit the recap through the existing project-level APIs
+		require.NoError(t, service.UpdateProjectHighlightOrderWithTitles(proj.ID, []interface{}{"h2"}))
+		require.NoError(t, service.HideHighlight(proj.ID, "h1"))
+
+		_, err = service.SwitchCut(proj.ID, mainCut.ID)
+		require.NoError(t, err)
+
+		order, err := service.GetProjectHighlightOrderWithTitles(proj.ID)
+		require.NoError(t, err)
+		assert.Equal(t, longOrder, order)
+		hidden, err := service.GetHiddenHighlights(proj.ID)
+		require.NoError(t, err)
+		assert.Equal(t, []string{"h4"}, hidden)
+
+		state, err := highlightService.GetCutState(proj.ID, recap.ID)
+		require.NoError(t, err)
+		assert.False(t, state.IsActive)
+		assert.Equal(t, []interface{}{"h2"}, state.HighlightOrder)
+		assert.ElementsMatch(t, []string{"h4", "h1"}, state.HiddenHighlights)
+	})
+
+	t.Run("hidden highlights of an inactive cut", func(t *testing.T) {
+		require.NoError(t, highlightService.SaveCutHiddenHighlights(proj.ID, recap.ID, []string{"h3"}))
+
+		state, err := highlightService.GetCutState(proj.ID, recap.ID)
+		require.NoError(t, err)
+		assert.Equal(t, []string{"h3"}, state.HiddenHighlights)
+
+		hidden, err := service.GetHiddenHighlights(proj.ID)
+		require.NoError(t, err)
+		assert.Equal(t, []string{"h4"}, hidden)
+	})
+
+	t.Run("create, rename and delete", func(t *testing.T) {
+		shorts, err := service.CreateCut(proj.ID, "Shorts")
+		require.NoError(t, err)
+		assert.Equal(t, 0, shorts.HighlightCount)
+
+		renamed, err := service.RenameCut(proj.ID, shorts.ID, "Short #1")
+		require.NoError(t, err)
+		assert.Equal(t, "Short #1", renamed.Name)
+
+		_, err = service.RenameCut(proj.ID, shorts.ID, "")
+		assert.Error(t, err)
+
+		assert.Error(t, service.DeleteCut(proj.ID, mainCut.ID), "active cut cannot be deleted")
+		require.NoError(t, service.DeleteCut(proj.ID, shorts.ID))
+
+		other := createTestProject(t, client, ctx, "Other")
+		_, err = service.SwitchCut(other.ID, recap.ID)
+		assert.Error(t, err, "cuts belong to their project")
+	})
+
+	t.Run("duplicate and delete project carry the cuts", func(t *testing.T) {
+		copied, err := service.DuplicateProject(proj.ID, "", true)
+		require.NoError(t, err)
+
+		cuts, err := service.GetCuts(copied.ID)
+		require.NoError(t, err)
+		require.Len(t, cuts, 2)
+		assert.Equal(t, DefaultCutName, cuts[0].Name)
+		assert.True(t, cuts[0].IsActive)
+
+		require.NoError(t, service.DeleteProject(copied.ID))
+		count, err := client.Cut.Query().Where(cut.ProjectID(copied.ID)).Count(ctx)
+		require.NoError(t, err)
+		assert.Equal(t, 0, count)
+	})
+}
@@ -0,0 +1,242 @@
+package projects
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"ramble-ai/ent/schema"
+)
+
+func intPtr(v int) *int { return &v }
+
+func floatPtr(v float64) *float64 { return &v }
+
+func TestTagsAndCollections(t *testing.T) {
+	client := setupTestClient(t)
+	defer client.Close()
+	ctx := context.Background()
+	service := NewProjectService(client, ctx)
+
+	proj := createTestProject(t, client, ctx, "Tagged")
+	clip := createTestVideoClip(t, client, ctx, proj, "clip")
+
+	t.Run("tags are reused case-insensitively", func(t *testing.T) {
+		first, err := service.AddProjectTag(proj.ID, "Interview")
+		require.NoError(t, err)
+		second, err := service.AddVideoClipTag(clip.ID, " interview ")
+		require.NoError(t, err)
+		assert.Equal(t, first.ID, second.ID)
+
+		tags, err := service.GetTags()
+		require.NoError(t, err)
+		assert.Len(t, tags, 1)
+
+		_, err = service.AddProjectTag(proj.ID, "  ")
+		assert.Error(t, err)
+	})
+
+	t.Run("remove and delete tags", func(t *testing.T) {
+		tag, err := service.AddProjectTag(proj.ID, "Draft")
+		require.NoError(t, err)
+		require.NoError(t, service.RemoveProjectTag(proj.ID, tag.ID))
+
+		projectTags, err := service.GetProjectTags(proj.ID)
+		require.NoError(t, err)
+		require.Len(t, projectTags, 1)
+		assert.Equal(t, "Interview", projectTags[0].Name)
+
+		require.NoError(t, service.DeleteTag(projectTags[0].ID))
+		clipTags, err := service.GetVideoClipTags(clip.ID)
+		require.NoError(t, err)
+		assert.Empty(t, clipTags)
+	})
+
+	t.Run("collections", func(t *testing.T) {
+		coll, err := service.CreateCollection("Season 1", "")
+		require.NoError(t, err)
+		assert.Empty(t, coll.ProjectIDs)
+
+		coll, err = service.AddProjectToCollection(coll.ID, proj.ID)
+		require.NoError(t, err)
+		assert.Equal(t, []int{proj.ID}, coll.ProjectIDs)
+
+		coll, err = service.RemoveProjectFromCollection(coll.ID, proj.ID)
+		require.NoError(t, err)
+		assert.Empty(t, coll.ProjectIDs)
+
+		_, err = service.UpdateCollection(coll.ID, "", "")
+		assert.Error(t, err)
+
+		require.NoError(t, service.DeleteCollection(coll.ID))
+		collections, err := service.GetCollections()
+		require.NoError(t, err)
+		assert.Empty(t, collections)
+	})
+
+	t.Run("deleting a tagged project", func(t *testing.T) {
+		_, err := service.AddProjectTag(proj.ID, "Archive")
+		require.NoError(t, err)
+		coll, err := service.CreateCollection("Old", "")
+		require.NoError(t, err)
+		_, err = service.AddProjectToCollection(coll.ID, proj.ID)
+		require.NoError(t, err)
+
+		require.NoError(t, service.DeleteProject(proj.ID))
+
+		collections, err := service.GetCollections()
+		require.NoError(t, err)
+		require.Len(t, collections, 1)
+		assert.Empty(t, collections[0].ProjectIDs)
+	})
+}
+
+func TestFilterProjects(t *testing.T) {
+	client := setupTestClient(t)
+	defer client.Close()
+	ctx := context.Background()
+	service := NewProjectService(client, ctx)
+
+	alpha := createTestProject(t, client, ctx, "Alpha")
+	beta := createTestProject(t, client, ctx, "Beta")
+	gamma := createTestProject(t, client, ctx, "Gamma")
+
+	// Alpha has two highlights, Beta one, Gamma none
+	createTestVideoClip(t, client, ctx, alpha, "a1")
+	createTestVideoClip(t, client, ctx, alpha, "a2")
+	createTestVideoClip(t, client, ctx, beta, "b1")
+
+	_, err := gamma.Update().SetCreatedAt(time.Date(2020, 1, 15, 12, 0, 0, 0, time.Local)).Save(ctx)
+	require.NoError(t, err)
+
+	for _, p := range []int{alpha.ID, beta.ID} {
+		_, err := service.AddProjectTag(p, "podcast")
+		require.NoError(t, err)
+	}
+	_, err = service.AddProjectTag(alpha.ID, "featured")
+	require.NoError(t, err)
+
+	coll, err := service.CreateCollection("Favorites", "")
+	require.NoError(t, err)
+	_, err = service.AddProjectToCollection(coll.ID, beta.ID)
+	require.NoError(t, err)
+
+	names := func(summaries []*ProjectSummary) []string {
+		result := []string{}
+		for _, summary := range summaries {
+			result = append(result, summary.Project.Name)
+		}
+		return result
+	}
+
+	t.Run("tags must all match", func(t *testing.T) {
+		summaries, err := service.FilterProjects(ProjectFilter{Tags: []string{"Podcast", "featured"}})
+		require.NoError(t, err)
+		assert.Equal(t, []string{"Alpha"}, names(summaries))
+		assert.Equal(t, 2, summaries[0].ClipCount)
+		assert.Equal(t, 2, summaries[0].HighlightCount)
+		assert.Len(t, summaries[0].Tags, 2)
+	})
+
+	t.Run("collection", func(t *testing.T) {
+		summaries, err := service.FilterProjects(ProjectFilter{CollectionID: coll.ID})
+		require.NoError(t, err)
+		assert.Equal(t, []string{"Beta"}, names(summaries))
+		assert.Equal(t, []int{coll.ID}, summaries[0].CollectionIDs)
+	})
+
+	t.Run("date range", func(t *testing.T) {
+		summaries, err := service.FilterProjects(ProjectFilter{CreatedAfter: "2020-01-01", CreatedBefore: "2020-01-15"})
+		require.NoError(t, err)
+		assert.Equal(t, []string{"Gamma"}, names(summaries))
+
+		_, err = service.FilterProjects(ProjectFilter{CreatedAfter: "yesterday"})
+		assert.Error(t, err)
+	})
+
+	t.Run("highlight count range and sort", func(t *testing.T) {
+		summaries, err := service.FilterProjects(ProjectFilter{MinHighlights: intPtr(1), SortBy: SortByHighlightCount, SortDesc: true})
+		require.NoError(t, err)
+		assert.Equal(t, []string{"Alpha", "Beta"}, names(summaries))
+
+		summaries, err = service.FilterProjects(ProjectFilter{MaxHighlights: intPtr(0)})
+		require.NoError(t, err)
+		assert.Equal(t, []string{"Gamma"}, names(summaries))
+	})
+
+	t.Run("sort by name descending", func(t *testing.T) {
+		summaries, err := service.FilterProjects(ProjectFilter{SortBy: SortByName, SortDesc: true})
+		require.NoError(t, err)
+		assert.Equal(t, []string{"Gamma", "Beta", "Alpha"}, names(summaries))
+
+		_, err = service.FilterProjects(ProjectFilter{SortBy: SortByDuration})
+		assert.Error(t, err)
+	})
+}
+
+func TestFilterVideoClips(t *testing.T) {
+	client := setupTestClient(t)
+	defer client.Close()
+	ctx := context.Background()
+	service := NewProjectService(client, ctx)
+
+	proj := createTestProject(t, client, ctx, "Clips")
+	other := createTestProject(t, client, ctx, "Other")
+
+	short := createTestVideoClip(t, client, ctx, proj, "short")
+	long := createTestVideoClip(t, client, ctx, proj, "long")
+	createTestVideoClip(t, client, ctx, other, "elsewhere")
+
+	_, err := short.Update().SetDuration(30).SetTranscriptionState(TranscriptionStateCompleted).Save(ctx)
+	require.NoError(t, err)
+	_, err = long.Update().
+		SetDuration(600).
+		SetHighlights([]schema.Highlight{{ID: "h1", Start: 0, End: 1}, {ID: "h2", Start: 1, End: 2}}).
+		Save(ctx)
+	require.NoError(t, err)
+
+	_, err = service.AddVideoClipTag(long.ID, "b-roll")
+	require.NoError(t, err)
+
+	clipNames := func(summaries []*VideoClipSummary) []string {
+		result := []string{}
+		for _, summary := range summaries {
+			result = append(result, summary.Clip.Name)
+		}
+		return result
+	}
+
+	t.Run("project and duration", func(t *testing.T) {
+		summaries, err := service.FilterVideoClips(VideoClipFilter{ProjectID: proj.ID, MinDuration: floatPtr(60)})
+		require.NoError(t, err)
+		assert.Equal(t, []string{"long"}, clipNames(summaries))
+		assert.Equal(t, proj.ID, summaries[0].Clip.ProjectID)
+		assert.Equal(t, 2, summaries[0].HighlightCount)
+	})
+
+	t.Run("transcription state", func(t *testing.T) {
+		summaries, err := service.FilterVideoClips(VideoClipFilter{TranscriptionState: TranscriptionStateCompleted})
+		require.NoError(t, err)
+		assert.Equal(t, []string{"short"}, clipNames(summaries))
+	})
+
+	t.Run("tags", func(t *testing.T) {
+		summaries, err := service.FilterVideoClips(VideoClipFilter{Tags: []string{"B-Roll"}})
+		require.NoError(t, err)
+		assert.Equal(t, []string{"long"}, clipNames(summaries))
+		require.Len(t, summaries[0].Tags, 1)
+	})
+
+	t.Run("sort by duration and highlight bounds", func(t *testing.T) {
+		summaries, err := service.FilterVideoClips(VideoClipFilter{ProjectID: proj.ID, SortBy: SortByDuration, SortDesc: true})
+		require.NoError(t, err)
+		assert.Equal(t, []string{"long", "short"}, clipNames(summaries))
+
+		summaries, err = service.FilterVideoClips(VideoClipFilter{ProjectID: proj.ID, MaxHighlights: intPtr(1)})
+		require.NoError(t, err)
+		assert.Equal(t, []string{"short"}, clipNames(summaries))
+	})
+}
@@ -0,0 +1,53 @@
+package projects
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"ramble-ai/ent/schema"
+)
+
+func TestAcceptedHighlightsKeepInsights(t *testing.T) {
+	client := setupTestClient(t)
+	defer client.Close()
+	ctx := context.Background()
+	service := NewProjectService(client, ctx)
+
+	proj := createTestProject(t, client, ctx, "Insights")
+	clip := createTestVideoClip(t, client, ctx, proj, "clip")
+
+	insight := &schema.HighlightInsight{Score: 0.9, Category: "hook", Rationale: "Bold opening claim", Model: "test/model"}
+	require.NoError(t, service.UpdateVideoClipSuggestedHighlights(clip.ID, []Highlight{
+		{ID: "suggestion_0_10", Start: 1.0, End: 5.0, ColorID: 1, Insight: insight},
+	}))
+
+	// Accepting a suggestion creates a new highlight over (almost) the same words
+	require.NoError(t, service.UpdateVideoClipHighlights(clip.ID, []Highlight{
+		{ID: "h1", Start: 1.1, End: 5.0, ColorID: 1},
+		{ID: "h2", Start: 10.0, End: 12.0, ColorID: 2},
+	}))
+
+	updated, err := client.VideoClip.Get(ctx, clip.ID)
+	require.NoError(t, err)
+	require.Len(t, updated.Highlights, 2)
+	assert.Equal(t, insight, updated.Highlights[0].Insight)
+	assert.Nil(t, updated.Highlights[1].Insight)
+
+	// Later edits of an accepted highlight keep its insight even without the suggestion
+	require.NoError(t, service.UpdateVideoClipSuggestedHighlights(clip.ID, nil))
+	require.NoError(t, service.UpdateVideoClipHighlights(clip.ID, []Highlight{{ID: "h1", Start: 0.5, End: 6.0, ColorID: 1}}))
+
+	updated, err = client.VideoClip.Get(ctx, clip.ID)
+	require.NoError(t, err)
+	require.Len(t, updated.Highlights, 1)
+	assert.Equal(t, insight, updated.Highlights[0].Insight)
+}
+
+func TestTimeOverlapRatio(t *testing.T) {
+	assert.Equal(t, 1.0, timeOverlapRatio(1, 5, 1, 5))
+	assert.InDelta(t, 0.5, timeOverlapRatio(0, 4, 2, 4), 0.001)
+	assert.Equal(t, 0.0, timeOverlapRatio(0, 2, 3, 4))
+}
@@ -0,0 +1,225 @@
+package projects
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"ramble-ai/ent/project"
+	"ramble-ai/ent/transcriptembedding"
+	"ramble-ai/ent/videoclip"
+	highlightsservice "ramble-ai/goapp/highlights"
+)
+
+func TestDuplicateProject(t *testing.T) {
+	client := setupTestClient(t)
+	defer client.Close()
+	ctx := context.Background()
+	service := NewProjectService(client, ctx)
+
+	source := createTestProject(t, client, ctx, "Episode 1")
+	clip := createTestVideoClip(t, client, ctx, source, "intro")
+	createTestTranscriptEmbedding(t, client, ctx, clip)
+
+	_, err := service.AddProjectTag(source.ID, "series")
+	require.NoError(t, err)
+	_, err = service.AddVideoClipTag(clip.ID, "intro")
+	require.NoError(t, err)
+
+	order := []interface{}{map[string]interface{}{"type": "N", "title": "Intro"}, "h1", "N"}
+	presets := []ExportPreset{{Name: "YouTube", Mode: ExportModeStitched, PaddingSeconds: 0.5}}
+	_, err = source.Update().
+		SetAiModel("test/model").
+		SetAiPrompt("reorder prompt").
+		SetHighlightOrder(order).
+		SetHiddenHighlights([]string{"h1"}).
+		SetExportPresets(presets).
+		Save(ctx)
+	require.NoError(t, err)
+
+	highlightService := highlightsservice.NewHighlightService(client, ctx)
+	require.NoError(t, highlightService.SaveProjectHighlightAISettings(source.ID, highlightsservice.ProjectHighlightAISettings{
+		AIModel:  "test/highlight-model",
+		AIPrompt: "highlight prompt",
+	}))
+
+	t.Run("with clips", func(t *testing.T) {
+		response, err := service.DuplicateProject(source.ID, "", true)
+		require.NoError(t, err)
+		assert.Equal(t, "Episode 1 (Copy)", response.Name)
+
+		copied, err := client.Project.Get(ctx, response.ID)
+		require.NoError(t, err)
+		assert.Equal(t, "test/model", copied.AiModel)
+		assert.Equal(t, "reorder prompt", copied.AiPrompt)
+		assert.Equal(t, []string{"h1"}, copied.HiddenHighlights)
+		assert.Equal(t, presets, copied.ExportPresets)
+		assert.Len(t, copied.HighlightOrder, 3)
+
+		clips, err := client.VideoClip.Query().Where(videoclip.HasProjectWith(project.ID(response.ID))).All(ctx)
+		require.NoError(t, err)
+		require.Len(t, clips, 1)
+		assert.NotEqual(t, clip.ID, clips[0].ID)
+		assert.Equal(t, clip.TranscriptionWords, clips[0].TranscriptionWords)
+		assert.Equal(t, clip.Highlights, clips[0].Highlights)
+
+		clipTags, err := service.GetVideoClipTags(clips[0].ID)
+		require.NoError(t, err)
+		require.Len(t, clipTags, 1)
+		assert.Equal(t, "intro", clipTags[0].Name)
+
+		projectTags, err := service.GetProjectTags(response.ID)
+		require.NoError(t, err)
+		require.Len(t, projectTags, 1)
+
+		embeddings, err := client.TranscriptEmbedding.Query().Where(transcriptembedding.VideoClipID(clips[0].ID)).Count(ctx)
+		require.NoError(t, err)
+		assert.Equal(t, 1, embeddings)
+
+		settings, err := highlightService.GetProjectHighlightAISettings(response.ID)
+		require.NoError(t, err)
+		assert.Equal(t, "test/highlight-model", settings.AIModel)
+		assert.Equal(t, "highlight prompt", settings.AIPrompt)
+
+		// The copy is independent of the source
+		require.NoError(t, service.DeleteProject(response.ID))
+		exists, err := client.VideoClip.Query().Where(videoclip.ID(clip.ID)).Exist(ctx)
+		require.NoError(t, err)
+		assert.True(t, exists)
+	})
+
+	t.Run("without clips keeps only the section skeleton", func(t *testing.T) {
+		response, err := service.DuplicateProject(source.ID, "Episode 2", false)
+		require.NoError(t, err)
+		assert.Equal(t, "Episode 2", response.Name)
+
+		copied, err := client.Project.Get(ctx, response.ID)
+		require.NoError(t, err)
+		assert.Equal(t, []interface{}{map[string]interface{}{"type": "N", "title": "Intro"}, "N"}, copied.HighlightOrder)
+		assert.Empty(t, copied.HiddenHighlights)
+
+		count, err := client.VideoClip.Query().Where(videoclip.HasProjectWith(project.ID(response.ID))).Count(ctx)
+		require.NoError(t, err)
+		assert.Equal(t, 0, count)
+	})
+
+	t.Run("missing project", func(t *testing.T) {
+		_, err := service.DuplicateProject(99999, "", true)
+		assert.Error(t, err)
+	})
+}
+
+func TestProjectTemplates(t *testing.T) {
+	client := setupTestClient(t)
+	defer client.Close()
+	ctx := context.Background()
+	service := NewProjectService(client, ctx)
+	highlightService := highlightsservice.NewHighlightService(client, ctx)
+
+	source := createTestProject(t, client, ctx, "Series Pilot")
+	_, err := source.Update().
+		SetAiModel("test/model").
+		SetAiPrompt("reorder prompt").
+		SetHighlightOrder([]interface{}{map[string]interface{}{"type": "N", "title": "Cold open"}, "h1", map[string]interface{}{"type": "N", "title": "Interview"}}).
+		SetExportPresets([]ExportPreset{{Name: "Shorts", Mode: ExportModeIndividual, PaddingSeconds: 0.2}}).
+		Save(ctx)
+	require.NoError(t, err)
+	require.NoError(t, highlightService.SaveProjectHighlightAISettings(source.ID, highlightsservice.ProjectHighlightAISettings{
+		AIModel:  "test/highlight-model",
+		AIPrompt: "highlight prompt",
+	}))
+
+	tmpl, err := service.CreateTemplateFromProject(source.ID, "Weekly show", "")
+	require.NoError(t, err)
+	assert.Equal(t, "test/model", tmpl.AIModel)
+	assert.Equal(t, "test/highlight-model", tmpl.HighlightAIModel)
+	assert.Equal(t, []string{"Cold open", "Interview"}, tmpl.SectionTitles)
+	require.Len(t, tmpl.ExportPresets, 1)
+
+	t.Run("create project from template", func(t *testing.T) {
+		response, err := service.CreateProjectFromTemplate("Episode 5", "desc", tmpl.ID)
+		require.NoError(t, err)
+
+		created, err := client.Project.Get(ctx, response.ID)
+		require.NoError(t, err)
+		assert.Equal(t, "test/model", created.AiModel)
+		assert.Equal(t, "reorder prompt", created.AiPrompt)
+		assert.Equal(t, tmpl.ExportPresets, created.ExportPresets)
+		assert.Equal(t, []interface{}{
+			map[string]interface{}{"type": "N", "title": "Cold open"},
+			map[string]interface{}{"type": "N", "title": "Interview"},
+		}, created.HighlightOrder)
+
+		settings, err := highlightService.GetProjectHighlightAISettings(response.ID)
+		require.NoError(t, err)
+		assert.Equal(t, "test/highlight-model", settings.AIModel)
+		assert.Equal(t, "highlight prompt", settings.AIPrompt)
+	})
+
+	t.Run("template ID 0 creates a blank project", func(t *testing.T) {
+		response, err := service.CreateProjectFromTemplate("Blank", "", 0)
+		require.NoError(t, err)
+
+		created, err := client.Project.Get(ctx, response.ID)
+		require.NoError(t, err)
+		assert.Empty(t, created.HighlightOrder)
+		assert.Empty(t, created.ExportPresets)
+	})
+
+	t.Run("update, list and delete", func(t *testing.T) {
+		tmpl.SectionTitles = []string{"Intro", ""}
+		updated, err := service.UpdateProjectTemplate(*tmpl)
+		require.NoError(t, err)
+		assert.Equal(t, []string{"Intro", ""}, updated.SectionTitles)
+
+		templates, err := service.GetProjectTemplates()
+		require.NoError(t, err)
+		require.Len(t, templates, 1)
+
+		require.NoError(t, service.DeleteProjectTemplate(tmpl.ID))
+		_, err = service.GetProjectTemplate(tmpl.ID)
+		assert.Error(t, err)
+	})
+
+	t.Run("validation", func(t *testing.T) {
+		_, err := service.CreateProjectTemplate(ProjectTemplate{Name: "  "})
+		assert.Error(t, err)
+
+		_, err = service.CreateProjectTemplate(ProjectTemplate{Name: "Bad", ExportPresets: []ExportPreset{{Name: "x", Mode: "gif"}}})
+		assert.Error(t, err)
+
+		err = service.SaveProjectExportPresets(source.ID, []ExportPreset{{Name: "x", Mode: ExportModeStitched, PaddingSeconds: -1}})
+		assert.Error(t, err)
+
+		_, err = service.CreateProjectFromTemplate("Missing", "", 99999)
+		assert.Error(t, err)
+	})
+}
+
+func TestProjectExportPresets(t *testing.T) {
+	client := setupTestClient(t)
+	defer client.Close()
+	ctx := context.Background()
+	service := NewProjectService(client, ctx)
+
+	proj := createTestProject(t, client, ctx, "Presets")
+
+	presets, err := service.GetProjectExportPresets(proj.ID)
+	require.NoError(t, err)
+	assert.Empty(t, presets)
+
+	saved := []ExportPreset{
+		{Name: "Full", Mode: ExportModeStitched, PaddingSeconds: 0.3},
+		{Name: "Clips", Mode: ExportModeIndividual},
+	}
+	require.NoError(t, service.SaveProjectExportPresets(proj.ID, saved))
+
+	presets, err = service.GetProjectExportPresets(proj.ID)
+	require.NoError(t, err)
+	assert.Equal(t, saved, presets)
+
+	err = service.SaveProjectExportPresets(proj.ID, []ExportPreset{{Name: "Dup", Mode: ExportModeStitched}, {Name: "Dup", Mode: ExportModeIndividual}})
+	assert.Error(t, err)
+}
@@ -11,6 +11,7 @@ import (
 	"ramble-ai/ent/chatmessage"
 	"ramble-ai/ent/enttest"
 	"ramble-ai/ent/schema"
+	"ramble-ai/ent/transcriptembedding"
 	_ "github.com/mattn/go-sqlite3"
 )
 
@@ -88,6 +89,24 @@ func createTestExportJob(t *testing.T, client *ent.Client, ctx context.Context,
 	return job
 }
 
+func createTestTranscriptEmbedding(t *testing.T, client *ent.Client, ctx context.Context, clip *ent.VideoClip) *ent.TranscriptEmbedding {
+	embedding, err := client.TranscriptEmbedding.
+		Create().
+		SetVideoClipID(clip.ID).
+		SetChunkIndex(0).
+		SetStartWord(0).
+		SetEndWord(1).
+		SetStartTime(0).
+		SetEndTime(1).
+		SetText("hello").
+		SetModel("test-model").
+		SetSourceHash("hash").
+		SetEmbedding([]float32{1, 0}).
+		Save(ctx)
+	require.NoError(t, err)
+	return embedding
+}
+
 func TestDeleteProject_CascadesDeletion(t *testing.T) {
 	client := setupTestClient(t)
 	defer client.Close()
@@ -641,12 +660,43 @@ func TestDeleteVideoClip(t *testing.T) {
 		assert.Error(t, err)
 	})
 
+	t.Run("removes transcript embeddings", func(t *testing.T) {
+		clip := createTestVideoClip(t, client, ctx, project, "Embedded Clip")
+		createTestTranscriptEmbedding(t, client, ctx, clip)
+
+		err := service.DeleteVideoClip(clip.ID)
+		require.NoError(t, err)
+
+		count, err := client.TranscriptEmbedding.Query().Where(transcriptembedding.VideoClipID(clip.ID)).Count(ctx)
+		require.NoError(t, err)
+		assert.Equal(t, 0, count)
+	})
+
 	t.Run("nonexistent clip", func(t *testing.T) {
 		err := service.DeleteVideoClip(99999)
 		assert.Error(t, err)
 	})
 }
 
+func TestDeleteProject_RemovesTranscriptEmbeddings(t *testing.T) {
+	client := setupTestClient(t)
+	defer client.Close()
+
+	ctx := context.Background()
+	service := NewProjectService(client, ctx)
+
+	project := createTestProject(t, client, ctx, "Embedded Project")
+	clip := createTestVideoClip(t, client, ctx, project, "Embedded Clip")
+	createTestTranscriptEmbedding(t, client, ctx, clip)
+
+	err := service.DeleteProject(project.ID)
+	require.NoError(t, err)
+
+	count, err := client.TranscriptEmbedding.Query().Where(transcriptembedding.VideoClipID(clip.ID)).Count(ctx)
+	require.NoError(t, err)
+	assert.Equal(t, 0, count)
+}
+
 // Test file handling utilities
 func TestIsVideoFile(t *testing.T) {
 	client := setupTestClient(t)
@@ -0,0 +1,153 @@
+package projects
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"ramble-ai/ent/chatmessage"
+	"ramble-ai/ent/chatsession"
+	"ramble-ai/ent/project"
+	"ramble-ai/ent/videoclip"
+	"ramble-ai/goapp/settings"
+)
+
+func TestTrashProject(t *testing.T) {
+	client := setupTestClient(t)
+	defer client.Close()
+	ctx := context.Background()
+	service := NewProjectService(client, ctx)
+
+	proj := createTestProject(t, client, ctx, "Trashable")
+	keptClip := createTestVideoClip(t, client, ctx, proj, "kept")
+	earlierClip := createTestVideoClip(t, client, ctx, proj, "trashed earlier")
+	session := createTestChatSession(t, client, ctx, proj, "highlight_ordering")
+	createTestChatMessage(t, client, ctx, session, "hello", chatmessage.RoleUser)
+
+	require.NoError(t, service.TrashVideoClip(earlierClip.ID))
+	require.NoError(t, service.TrashProject(proj.ID))
+
+	t.Run("hidden from normal queries", func(t *testing.T) {
+		projects, err := service.GetProjects()
+		require.NoError(t, err)
+		assert.Empty(t, projects)
+
+		_, err = service.GetProjectByID(proj.ID)
+		assert.Error(t, err)
+
+		clips, err := service.GetVideoClipsByProject(proj.ID)
+		require.NoError(t, err)
+		assert.Empty(t, clips)
+
+		assert.Error(t, service.TrashProject(proj.ID))
+	})
+
+	t.Run("listed in the trash", func(t *testing.T) {
+		trash, err := service.GetTrash()
+		require.NoError(t, err)
+		require.Len(t, trash.Projects, 1)
+		assert.Equal(t, "Trashable", trash.Projects[0].Name)
+		assert.Equal(t, 2, trash.Projects[0].ClipCount)
+		assert.NotEmpty(t, trash.Projects[0].PurgeAt)
+		assert.Equal(t, settings.DefaultTrashRetentionDays, trash.RetentionDays)
+
+		// Clips of a trashed project are listed under the project only
+		assert.Empty(t, trash.VideoClips)
+		assert.Error(t, service.RestoreVideoClip(keptClip.ID))
+	})
+
+	t.Run("restore brings back the project, its clips and its chat history", func(t *testing.T) {
+		require.NoError(t, service.RestoreProject(proj.ID))
+
+		clips, err := service.GetVideoClipsByProject(proj.ID)
+		require.NoError(t, err)
+		require.Len(t, clips, 1)
+		assert.Equal(t, keptClip.ID, clips[0].ID)
+
+		sessions, err := client.ChatSession.Query().Where(chatsession.HasProjectWith(project.ID(proj.ID))).Count(ctx)
+		require.NoError(t, err)
+		assert.Equal(t, 1, sessions)
+
+		// The clip trashed on its own is still in the trash
+		trash, err := service.GetTrash()
+		require.NoError(t, err)
+		require.Len(t, trash.VideoClips, 1)
+		assert.Equal(t, earlierClip.ID, trash.VideoClips[0].ID)
+		assert.Equal(t, "Trashable", trash.VideoClips[0].ProjectName)
+
+		require.NoError(t, service.RestoreVideoClip(earlierClip.ID))
+		clips, err = service.GetVideoClipsByProject(proj.ID)
+		require.NoError(t, err)
+		assert.Len(t, clips, 2)
+
+		assert.Error(t, service.RestoreProject(proj.ID))
+	})
+}
+
+func TestPurgeTrash(t *testing.T) {
+	client := setupTestClient(t)
+	defer client.Close()
+	ctx := context.Background()
+	service := NewProjectService(client, ctx)
+	settingsService := settings.NewSettingsService(client, ctx)
+
+	old := createTestProject(t, client, ctx, "Old")
+	createTestVideoClip(t, client, ctx, old, "old clip")
+	recent := createTestProject(t, client, ctx, "Recent")
+	active := createTestProject(t, client, ctx, "Active")
+	oldClip := createTestVideoClip(t, client, ctx, active, "old single clip")
+
+	require.NoError(t, service.TrashProject(old.ID))
+	require.NoError(t, service.TrashProject(recent.ID))
+	require.NoError(t, service.TrashVideoClip(oldClip.ID))
+
+	// Age the old items past the retention period
+	longAgo := time.Now().AddDate(0, 0, -10)
+	_, err := client.Project.UpdateOneID(old.ID).SetDeletedAt(longAgo).Save(ctx)
+	require.NoError(t, err)
+	_, err = client.VideoClip.Update().Where(videoclip.HasProjectWith(project.ID(old.ID))).SetDeletedAt(longAgo).Save(ctx)
+	require.NoError(t, err)
+	_, err = client.VideoClip.UpdateOneID(oldClip.ID).SetDeletedAt(longAgo).Save(ctx)
+	require.NoError(t, err)
+
+	t.Run("retention of zero keeps everything", func(t *testing.T) {
+		require.NoError(t, settingsService.SaveTrashRetentionDays(0))
+		purged, err := service.PurgeExpiredTrash()
+		require.NoError(t, err)
+		assert.Equal(t, 0, purged)
+	})
+
+	t.Run("expired items are purged", func(t *testing.T) {
+		require.NoError(t, settingsService.SaveTrashRetentionDays(7))
+		purged, err := service.PurgeExpiredTrash()
+		require.NoError(t, err)
+		assert.Equal(t, 2, purged)
+
+		exists, err := client.Project.Query().Where(project.ID(old.ID)).Exist(ctx)
+		require.NoError(t, err)
+		assert.False(t, exists)
+
+		exists, err = client.Project.Query().Where(project.ID(recent.ID)).Exist(ctx)
+		require.NoError(t, err)
+		assert.True(t, exists)
+	})
+
+	t.Run("empty trash", func(t *testing.T) {
+		purged, err := service.EmptyTrash()
+		require.NoError(t, err)
+		assert.Equal(t, 1, purged)
+
+		trash, err := service.GetTrash()
+		require.NoError(t, err)
+		assert.Empty(t, trash.Projects)
+		assert.Empty(t, trash.VideoClips)
+
+		projects, err := service.GetProjects()
+		require.NoError(t, err)
+		require.Len(t, projects, 1)
+		assert.Equal(t, "Active", projects[0].Name)
+	})
+}
@@ -0,0 +1,286 @@
+package projects
+
+import (
+	"fmt"
+	"strings"
+
+	"ramble-ai/ent"
+	"ramble-ai/ent/collection"
+	"ramble-ai/ent/project"
+	"ramble-ai/ent/tag"
+	"ramble-ai/ent/videoclip"
+)
+
+// TagResponse represents a tag for the frontend
+type TagResponse struct {
+	ID        int    `json:"id"`
+	Name      string `json:"name"`
+	Color     string `json:"color"`
+	CreatedAt string `json:"createdAt"`
+}
+
+// CollectionResponse represents a collection of projects for the frontend
+type CollectionResponse struct {
+	ID          int    `json:"id"`
+	Name        string `json:"name"`
+	Description string `json:"description"`
+	ProjectIDs  []int  `json:"projectIds"`
+	CreatedAt   string `json:"createdAt"`
+	UpdatedAt   string `json:"updatedAt"`
+}
+
+// Tag Methods
+
+// GetTags returns all tags
+func (s *ProjectService) GetTags() ([]*TagResponse, error) {
+	tags, err := s.client.Tag.Query().Order(ent.Asc(tag.FieldName)).All(s.ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get tags: %w", err)
+	}
+	return toTagResponses(tags), nil
+}
+
+// UpdateTag renames or recolors a tag
+func (s *ProjectService) UpdateTag(id int, name, color string) (*TagResponse, error) {
+	name = strings.TrimSpace(name)
+	if name == "" {
+		return nil, fmt.Errorf("tag name cannot be empty")
+	}
+
+	updated, err := s.client.Tag.
+		UpdateOneID(id).
+		SetName(name).
+		SetColor(color).
+		Save(s.ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to update tag: %w", err)
+	}
+
+	return toTagResponse(updated), nil
+}
+
+// DeleteTag deletes a tag and removes it from every project and clip
+func (s *ProjectService) DeleteTag(id int) error {
+	if err := s.client.Tag.DeleteOneID(id).Exec(s.ctx); err != nil {
+		return fmt.Errorf("failed to delete tag: %w", err)
+	}
+	return nil
+}
+
+// AddProjectTag tags a project, creating the tag if no tag with that name exists
+func (s *ProjectService) AddProjectTag(projectID int, tagName string) (*TagResponse, error) {
+	t, err := s.findOrCreateTag(tagName)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := s.client.Project.UpdateOneID(projectID).AddTagIDs(t.ID).Exec(s.ctx); err != nil {
+		return nil, fmt.Errorf("failed to tag project: %w", err)
+	}
+
+	return toTagResponse(t), nil
+}
+
+// RemoveProjectTag removes a tag from a project
+func (s *ProjectService) RemoveProjectTag(projectID, tagID int) error {
+	if err := s.client.Project.UpdateOneID(projectID).RemoveTagIDs(tagID).Exec(s.ctx); err != nil {
+		return fmt.Errorf("failed to remove project tag: %w", err)
+	}
+	return nil
+}
+
+// GetProjectTags returns the tags on a project
+func (s *ProjectService) GetProjectTags(projectID int) ([]*TagResponse, error) {
+	tags, err := s.client.Tag.Query().
+		Where(tag.HasProjectsWith(project.ID(projectID))).
+		Order(ent.Asc(tag.FieldName)).
+		All(s.ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get project tags: %w", err)
+	}
+	return toTagResponses(tags), nil
+}
+
+// AddVideoClipTag tags a video clip, creating the tag if no tag with that name exists
+func (s *ProjectService) AddVideoClipTag(clipID int, tagName string) (*TagResponse, error) {
+	t, err := s.findOrCreateTag(tagName)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := s.client.VideoClip.UpdateOneID(clipID).AddTagIDs(t.ID).Exec(s.ctx); err != nil {
+		return nil, fmt.Errorf("failed to tag video clip: %w", err)
+	}
+
+	return toTagResponse(t), nil
+}
+
+// RemoveVideoClipTag removes a tag from a video clip
+func (s *ProjectService) RemoveVideoClipTag(clipID, tagID int) error {
+	if err := s.client.VideoClip.UpdateOneID(clipID).RemoveTagIDs(tagID).Exec(s.ctx); err != nil {
+		return fmt.Errorf("failed to remove video clip tag: %w", err)
+	}
+	return nil
+}
+
+// GetVideoClipTags returns the tags on a video clip
+func (s *ProjectService) GetVideoClipTags(clipID int) ([]*TagResponse, error) {
+	tags, err := s.client.Tag.Query().
+		Where(tag.HasVideoClipsWith(videoclip.ID(clipID))).
+		Order(ent.Asc(tag.FieldName)).
+		All(s.ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get video clip tags: %w", err)
+	}
+	return toTagResponses(tags), nil
+}
+
+// findOrCreateTag returns the tag with the given name (case-insensitive), creating it if needed
+func (s *ProjectService) findOrCreateTag(name string) (*ent.Tag, error) {
+	name = strings.TrimSpace(name)
+	if name == "" {
+		return nil, fmt.Errorf("tag name cannot be empty")
+	}
+
+	existing, err := s.client.Tag.Query().Where(tag.NameEqualFold(name)).First(s.ctx)
+	if err == nil {
+		return existing, nil
+	}
+	if !ent.IsNotFound(err) {
+		return nil, fmt.Errorf("failed to query tag: %w", err)
+	}
+
+	created, err := s.client.Tag.Create().SetName(name).Save(s.ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create tag: %w", err)
+	}
+	return created, nil
+}
+
+// Collection Methods
+
+// CreateCollection creates a new, empty collection
+func (s *ProjectService) CreateCollection(name, description string) (*CollectionResponse, error) {
+	name = strings.TrimSpace(name)
+	if name == "" {
+		return nil, fmt.Errorf("collection name cannot be empty")
+	}
+
+	created, err := s.client.Collection.
+		Create().
+		SetName(name).
+		SetDescription(description).
+		Save(s.ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create collection: %w", err)
+	}
+
+	return toCollectionResponse(created), nil
+}
+
+// GetCollections returns all collections with the IDs of their projects
+func (s *ProjectService) GetCollections() ([]*CollectionResponse, error) {
+	collections, err := s.client.Collection.Query().
+		WithProjects(func(q *ent.ProjectQuery) {
+			q.Select(project.FieldID)
+		}).
+		Order(ent.Asc(collection.FieldName)).
+		All(s.ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get collections: %w", err)
+	}
+
+	responses := make([]*CollectionResponse, 0, len(collections))
+	for _, c := range collections {
+		responses = append(responses, toCollectionResponse(c))
+	}
+	return responses, nil
+}
+
+// UpdateCollection renames a collection or changes its description
+func (s *ProjectService) UpdateCollection(id int, name, description string) (*CollectionResponse, error) {
+	name = strings.TrimSpace(name)
+	if name == "" {
+		return nil, fmt.Errorf("collection name cannot be empty")
+	}
+
+	if err := s.client.Collection.UpdateOneID(id).SetName(name).SetDescription(description).Exec(s.ctx); err != nil {
+		return nil, fmt.Errorf("failed to update collection: %w", err)
+	}
+
+	return s.getCollection(id)
+}
+
+// DeleteCollection deletes a collection. The projects in it are not deleted.
+func (s *ProjectService) DeleteCollection(id int) error {
+	if err := s.client.Collection.DeleteOneID(id).Exec(s.ctx); err != nil {
+		return fmt.Errorf("failed to delete collection: %w", err)
+	}
+	return nil
+}
+
+// AddProjectToCollection adds a project to a collection
+func (s *ProjectService) AddProjectToCollection(collectionID, projectID int) (*CollectionResponse, error) {
+	if err := s.client.Collection.UpdateOneID(collectionID).AddProjectIDs(projectID).Exec(s.ctx); err != nil {
+		return nil, fmt.Errorf("failed to add project to collection: %w", err)
+	}
+	return s.getCollection(collectionID)
+}
+
+// RemoveProjectFromCollection removes a project from a collection
+func (s *ProjectService) RemoveProjectFromCollection(collectionID, projectID int) (*CollectionResponse, error) {
+	if err := s.client.Collection.UpdateOneID(collectionID).RemoveProjectIDs(projectID).Exec(s.ctx); err != nil {
+		return nil, fmt.Errorf("failed to remove project from collection: %w", err)
+	}
+	return s.getCollection(collectionID)
+}
+
+// getCollection loads a collection with its project IDs
+func (s *ProjectService) getCollection(id int) (*CollectionResponse, error) {
+	c, err := s.client.Collection.Query().
+		Where(collection.ID(id)).
+		WithProjects(func(q *ent.ProjectQuery) {
+			q.Select(project.FieldID)
+		}).
+		Only(s.ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get collection: %w", err)
+	}
+	return toCollectionResponse(c), nil
+}
+
+// toTagResponse converts a tag entity to its frontend form
+func toTagResponse(t *ent.Tag) *TagResponse {
+	return &TagResponse{
+		ID:        t.ID,
+		Name:      t.Name,
+		Color:     t.Color,
+		CreatedAt: t.CreatedAt.Format("2006-01-02 15:04:05"),
+	}
+}
+
+// toTagResponses converts tag entities to their frontend form
+func toTagResponses(tags []*ent.Tag) []*TagResponse {
+	responses := make([]*TagResponse, 0, len(tags))
+	for _, t := range tags {
+		responses = append(responses, toTagResponse(t))
+	}
+	return responses
+}
+
+// toCollectionResponse converts a collection entity (with loaded projects) to its frontend form
+func toCollectionResponse(c *ent.Collection) *CollectionResponse {
+	projectIDs := make([]int, 0, len(c.Edges.Projects))
+	for _, p := range c.Edges.Projects {
+		projectIDs = append(projectIDs, p.ID)
+	}
+
+	return &CollectionResponse{
+		ID:          c.ID,
+		Name:        c.Name,
+		Description: c.Description,
+		ProjectIDs:  projectIDs,
+		CreatedAt:   c.CreatedAt.Format("2006-01-02 15:04:05"),
+		UpdatedAt:   c.UpdatedAt.Format("2006-01-02 15:04:05"),
+	}
+}
@@ -0,0 +1,319 @@
+package projects
+
+import (
+	"fmt"
+	"log"
+	"strings"
+
+	"ramble-ai/ent"
+	"ramble-ai/ent/schema"
+	highlightsservice "ramble-ai/goapp/highlights"
+)
+
+// Export preset modes
+const (
+	ExportModeStitched   = "stitched"
+	ExportModeIndividual = "individual"
+)
+
+// ExportPreset represents a named set of export options
+type ExportPreset = schema.ExportPreset
+
+// ProjectTemplate represents reusable project settings for the frontend
+type ProjectTemplate struct {
+	ID                int            `json:"id"`
+	Name              string         `json:"name"`
+	Description       string         `json:"description"`
+	AIModel           string         `json:"aiModel"`
+	AIPrompt          string         `json:"aiPrompt"`
+	HighlightAIModel  string         `json:"highlightAiModel"`
+	HighlightAIPrompt string         `json:"highlightAiPrompt"`
+	ExportPresets     []ExportPreset `json:"exportPresets"`
+	SectionTitles     []string       `json:"sectionTitles"`
+	CreatedAt         string         `json:"createdAt"`
+	UpdatedAt         string         `json:"updatedAt"`
+}
+
+// CreateProjectTemplate creates a new project template
+func (s *ProjectService) CreateProjectTemplate(tmpl ProjectTemplate) (*ProjectTemplate, error) {
+	if err := validateProjectTemplate(tmpl); err != nil {
+		return nil, err
+	}
+
+	created, err := s.client.ProjectTemplate.
+		Create().
+		SetName(strings.TrimSpace(tmpl.Name)).
+		SetDescription(tmpl.Description).
+		SetAiModel(tmpl.AIModel).
+		SetAiPrompt(tmpl.AIPrompt).
+		SetHighlightAiModel(tmpl.HighlightAIModel).
+		SetHighlightAiPrompt(tmpl.HighlightAIPrompt).
+		SetExportPresets(tmpl.ExportPresets).
+		SetSectionTitles(tmpl.SectionTitles).
+		Save(s.ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create project template: %w", err)
+	}
+
+	return toProjectTemplate(created), nil
+}
+
+// UpdateProjectTemplate replaces the settings stored in an existing template
+func (s *ProjectService) UpdateProjectTemplate(tmpl ProjectTemplate) (*ProjectTemplate, error) {
+	if err := validateProjectTemplate(tmpl); err != nil {
+		return nil, err
+	}
+
+	updated, err := s.client.ProjectTemplate.
+		UpdateOneID(tmpl.ID).
+		SetName(strings.TrimSpace(tmpl.Name)).
+		SetDescription(tmpl.Description).
+		SetAiModel(tmpl.AIModel).
+		SetAiPrompt(tmpl.AIPrompt).
+		SetHighlightAiModel(tmpl.HighlightAIModel).
+		SetHighlightAiPrompt(tmpl.HighlightAIPrompt).
+		SetExportPresets(tmpl.ExportPresets).
+		SetSectionTitles(tmpl.SectionTitles).
+		Save(s.ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to update project template: %w", err)
+	}
+
+	return toProjectTemplate(updated), nil
+}
+
+// GetProjectTemplates returns all project templates
+func (s *ProjectService) GetProjectTemplates() ([]*ProjectTemplate, error) {
+	templates, err := s.client.ProjectTemplate.Query().All(s.ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get project templates: %w", err)
+	}
+
+	responses := make([]*ProjectTemplate, 0, len(templates))
+	for _, tmpl := range templates {
+		responses = append(responses, toProjectTemplate(tmpl))
+	}
+
+	return responses, nil
+}
+
+// GetProjectTemplate returns a project template by its ID
+func (s *ProjectService) GetProjectTemplate(id int) (*ProjectTemplate, error) {
+	tmpl, err := s.client.ProjectTemplate.Get(s.ctx, id)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get project template: %w", err)
+	}
+
+	return toProjectTemplate(tmpl), nil
+}
+
+// DeleteProjectTemplate deletes a project template. Projects created from it are unaffected.
+func (s *ProjectService) DeleteProjectTemplate(id int) error {
+	if err := s.client.ProjectTemplate.DeleteOneID(id).Exec(s.ctx); err != nil {
+		return fmt.Errorf("failed to delete project template: %w", err)
+	}
+	return nil
+}
+
+// CreateTemplateFromProject captures the AI settings, export presets and section structure of a project as a template
+func (s *ProjectService) CreateTemplateFromProject(projectID int, name, description string) (*ProjectTemplate, error) {
+	proj, err := s.client.Project.Get(s.ctx, projectID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get project: %w", err)
+	}
+
+	highlightSettings, err := highlightsservice.NewHighlightService(s.client, s.ctx).GetProjectHighlightAISettings(projectID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get highlight AI settings: %w", err)
+	}
+
+	return s.CreateProjectTemplate(ProjectTemplate{
+		Name:              name,
+		Description:       description,
+		AIModel:           proj.AiModel,
+		AIPrompt:          proj.AiPrompt,
+		HighlightAIModel:  highlightSettings.AIModel,
+		HighlightAIPrompt: highlightSettings.AIPrompt,
+		ExportPresets:     proj.ExportPresets,
+		SectionTitles:     sectionTitlesFromOrder(proj.HighlightOrder),
+	})
+}
+
+// CreateProjectFromTemplate creates a new project pre-filled from a template (templateID 0 creates a blank project)
+func (s *ProjectService) CreateProjectFromTemplate(name, description string, templateID int) (*ProjectResponse, error) {
+	if templateID == 0 {
+		return s.CreateProject(name, description)
+	}
+
+	tmpl, err := s.client.ProjectTemplate.Get(s.ctx, templateID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get project template: %w", err)
+	}
+
+	tx, err := s.client.Tx(s.ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to start transaction: %w", err)
+	}
+	defer func() {
+		if err != nil {
+			tx.Rollback()
+		}
+	}()
+
+	create := tx.Project.
+		Create().
+		SetName(name).
+		SetDescription(description).
+		SetPath(projectPath(name)).
+		SetAiPrompt(tmpl.AiPrompt).
+		SetExportPresets(tmpl.ExportPresets).
+		SetHighlightOrder(orderFromSectionTitles(tmpl.SectionTitles))
+	if tmpl.AiModel != "" {
+		create.SetAiModel(tmpl.AiModel)
+	}
+
+	proj, err := create.Save(s.ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create project: %w", err)
+	}
+
+	if tmpl.HighlightAiModel != "" || tmpl.HighlightAiPrompt != "" {
+		err = highlightsservice.NewHighlightService(tx.Client(), s.ctx).SaveProjectHighlightAISettings(proj.ID, highlightsservice.ProjectHighlightAISettings{
+			AIModel:  tmpl.HighlightAiModel,
+			AIPrompt: tmpl.HighlightAiPrompt,
+		})
+		if err != nil {
+			return nil, fmt.Errorf("failed to apply highlight AI settings: %w", err)
+		}
+	}
+
+	if err = tx.Commit(); err != nil {
+		return nil, fmt.Errorf("failed to commit transaction: %w", err)
+	}
+
+	log.Printf("[PROJECTS] Created project %d from template %q", proj.ID, tmpl.Name)
+	return toProjectResponse(proj), nil
+}
+
+// GetProjectExportPresets returns the export presets saved for a project
+func (s *ProjectService) GetProjectExportPresets(projectID int) ([]ExportPreset, error) {
+	proj, err := s.client.Project.Get(s.ctx, projectID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get project: %w", err)
+	}
+
+	if proj.ExportPresets == nil {
+		return []ExportPreset{}, nil
+	}
+	return proj.ExportPresets, nil
+}
+
+// SaveProjectExportPresets replaces the export presets saved for a project
+func (s *ProjectService) SaveProjectExportPresets(projectID int, presets []ExportPreset) error {
+	if err := validateExportPresets(presets); err != nil {
+		return err
+	}
+
+	_, err := s.client.Project.
+		UpdateOneID(projectID).
+		SetExportPresets(presets).
+		Save(s.ctx)
+	if err != nil {
+		return fmt.Errorf("failed to save export presets: %w", err)
+	}
+
+	return nil
+}
+
+// validateProjectTemplate checks the user-editable fields of a template
+func validateProjectTemplate(tmpl ProjectTemplate) error {
+	if strings.TrimSpace(tmpl.Name) == "" {
+		return fmt.Errorf("template name cannot be empty")
+	}
+	return validateExportPresets(tmpl.ExportPresets)
+}
+
+// validateExportPresets checks that every preset is named, uses a known mode and has non-negative padding
+func validateExportPresets(presets []ExportPreset) error {
+	seen := make(map[string]bool)
+	for _, preset := range presets {
+		name := strings.TrimSpace(preset.Name)
+		if name == "" {
+			return fmt.Errorf("export preset name cannot be empty")
+		}
+		if seen[name] {
+			return fmt.Errorf("duplicate export preset name: %s", name)
+		}
+		seen[name] = true
+
+		if preset.Mode != ExportModeStitched && preset.Mode != ExportModeIndividual {
+			return fmt.Errorf("invalid export mode for preset %s: %s", name, preset.Mode)
+		}
+		if preset.PaddingSeconds < 0 {
+			return fmt.Errorf("padding for preset %s cannot be negative", name)
+		}
+	}
+	return nil
+}
+
+// sectionTitlesFromOrder extracts the newline sections of a highlight order as a title skeleton
+func sectionTitlesFromOrder(order []interface{}) []string {
+	titles := []string{}
+	for _, item := range order {
+		switch v := item.(type) {
+		case string:
+			if v == "N" {
+				titles = append(titles, "")
+			}
+		case map[string]interface{}:
+			if typeVal, ok := v["type"].(string); ok && typeVal == "N" {
+				title, _ := v["title"].(string)
+				titles = append(titles, title)
+			}
+		case NewlineSection:
+			if v.Type == "N" {
+				titles = append(titles, v.Title)
+			}
+		}
+	}
+	return titles
+}
+
+// orderFromSectionTitles builds a highlight order containing only the skeleton sections
+func orderFromSectionTitles(titles []string) []interface{} {
+	order := make([]interface{}, 0, len(titles))
+	for _, title := range titles {
+		if title == "" {
+			order = append(order, "N")
+			continue
+		}
+		order = append(order, map[string]interface{}{"type": "N", "title": title})
+	}
+	return order
+}
+
+// toProjectTemplate converts a template entity to its frontend form
+func toProjectTemplate(tmpl *ent.ProjectTemplate) *ProjectTemplate {
+	exportPresets := tmpl.ExportPresets
+	if exportPresets == nil {
+		exportPresets = []ExportPreset{}
+	}
+	sectionTitles := tmpl.SectionTitles
+	if sectionTitles == nil {
+		sectionTitles = []string{}
+	}
+
+	return &ProjectTemplate{
+		ID:                tmpl.ID,
+		Name:              tmpl.Name,
+		Description:       tmpl.Description,
+		AIModel:           tmpl.AiModel,
+		AIPrompt:          tmpl.AiPrompt,
+		HighlightAIModel:  tmpl.HighlightAiModel,
+		HighlightAIPrompt: tmpl.HighlightAiPrompt,
+		ExportPresets:     exportPresets,
+		SectionTitles:     sectionTitles,
+		CreatedAt:         tmpl.CreatedAt.Format("2006-01-02 15:04:05"),
+		UpdatedAt:         tmpl.UpdatedAt.Format("2006-01-02 15:04:05"),
+	}
+}
@@ -0,0 +1,301 @@
+package projects
+
+import (
+	"fmt"
+	"log"
+	"time"
+
+	"ramble-ai/ent"
+	"ramble-ai/ent/project"
+	"ramble-ai/ent/videoclip"
+	"ramble-ai/goapp/settings"
+)
+
+// TrashedProject represents a project in the trash
+type TrashedProject struct {
+	ID          int    `json:"id"`
+	Name        string `json:"name"`
+	Description string `json:"description"`
+	ClipCount   int    `json:"clipCount"`
+	DeletedAt   string `json:"deletedAt"`
+	PurgeAt     string `json:"purgeAt"` // empty when trash is kept forever
+}
+
+// TrashedVideoClip represents a video clip trashed on its own (clips trashed with their project are listed under it)
+type TrashedVideoClip struct {
+	ID          int    `json:"id"`
+	Name        string `json:"name"`
+	FilePath    string `json:"filePath"`
+	ProjectID   int    `json:"projectId"`
+	ProjectName string `json:"projectName"`
+	DeletedAt   string `json:"deletedAt"`
+	PurgeAt     string `json:"purgeAt"` // empty when trash is kept forever
+}
+
+// TrashResponse lists everything in the trash
+type TrashResponse struct {
+	Projects      []*TrashedProject   `json:"projects"`
+	VideoClips    []*TrashedVideoClip `json:"videoClips"`
+	RetentionDays int                 `json:"retentionDays"`
+}
+
+// TrashProject moves a project and its active clips to the trash. Chat sessions and export
+// history are left untouched so they come back unchanged on restore.
+func (s *ProjectService) TrashProject(id int) error {
+	proj, err := s.client.Project.Get(s.ctx, id)
+	if err != nil {
+		return fmt.Errorf("failed to get project: %w", err)
+	}
+	if !proj.DeletedAt.IsZero() {
+		return fmt.Errorf("project is already in the trash")
+	}
+
+	tx, err := s.client.Tx(s.ctx)
+	if err != nil {
+		return fmt.Errorf("failed to start transaction: %w", err)
+	}
+	defer func() {
+		if err != nil {
+			tx.Rollback()
+		}
+	}()
+
+	// Clips share the project's timestamp so a restore brings back exactly these clips
+	now := time.Now()
+	_, err = tx.VideoClip.Update().
+		Where(
+			videoclip.HasProjectWith(project.ID(id)),
+			videoclip.DeletedAtIsNil(),
+		).
+		SetDeletedAt(now).
+		Save(s.ctx)
+	if err != nil {
+		return fmt.Errorf("failed to trash video clips: %w", err)
+	}
+
+	if err = tx.Project.UpdateOneID(id).SetDeletedAt(now).Exec(s.ctx); err != nil {
+		return fmt.Errorf("failed to trash project: %w", err)
+	}
+
+	if err = tx.Commit(); err != nil {
+		return fmt.Errorf("failed to commit transaction: %w", err)
+	}
+
+	log.Printf("[TRASH] Moved project %d to the trash", id)
+	return nil
+}
+
+// RestoreProject brings a project back from the trash together with the clips that were trashed with it
+func (s *ProjectService) RestoreProject(id int) error {
+	proj, err := s.client.Project.Get(s.ctx, id)
+	if err != nil {
+		return fmt.Errorf("failed to get project: %w", err)
+	}
+	if proj.DeletedAt.IsZero() {
+		return fmt.Errorf("project is not in the trash")
+	}
+
+	tx, err := s.client.Tx(s.ctx)
+	if err != nil {
+		return fmt.Errorf("failed to start transaction: %w", err)
+	}
+	defer func() {
+		if err != nil {
+			tx.Rollback()
+		}
+	}()
+
+	// Clips trashed individually before the project stay in the trash
+	_, err = tx.VideoClip.Update().
+		Where(
+			videoclip.HasProjectWith(project.ID(id)),
+			videoclip.DeletedAt(proj.DeletedAt),
+		).
+		ClearDeletedAt().
+		Save(s.ctx)
+	if err != nil {
+		return fmt.Errorf("failed to restore video clips: %w", err)
+	}
+
+	if err = tx.Project.UpdateOneID(id).ClearDeletedAt().Exec(s.ctx); err != nil {
+		return fmt.Errorf("failed to restore project: %w", err)
+	}
+
+	if err = tx.Commit(); err != nil {
+		return fmt.Errorf("failed to commit transaction: %w", err)
+	}
+
+	log.Printf("[TRASH] Restored project %d", id)
+	return nil
+}
+
+// TrashVideoClip moves a single video clip to the trash
+func (s *ProjectService) TrashVideoClip(id int) error {
+	clip, err := s.client.VideoClip.Get(s.ctx, id)
+	if err != nil {
+		return fmt.Errorf("failed to get video clip: %w", err)
+	}
+	if !clip.DeletedAt.IsZero() {
+		return fmt.Errorf("video clip is already in the trash")
+	}
+
+	if err := s.client.VideoClip.UpdateOneID(id).SetDeletedAt(time.Now()).Exec(s.ctx); err != nil {
+		return fmt.Errorf("failed to trash video clip: %w", err)
+	}
+
+	log.Printf("[TRASH] Moved video clip %d to the trash", id)
+	return nil
+}
+
+// RestoreVideoClip brings a video clip back from the trash. Clips of a trashed project are restored with the project.
+func (s *ProjectService) RestoreVideoClip(id int) error {
+	clip, err := s.client.VideoClip.Query().
+		Where(videoclip.ID(id)).
+		WithProject().
+		Only(s.ctx)
+	if err != nil {
+		return fmt.Errorf("failed to get video clip: %w", err)
+	}
+	if clip.DeletedAt.IsZero() {
+		return fmt.Errorf("video clip is not in the trash")
+	}
+	if clip.Edges.Project != nil && !clip.Edges.Project.DeletedAt.IsZero() {
+		return fmt.Errorf("video clip belongs to a project in the trash, restore the project instead")
+	}
+
+	if err := s.client.VideoClip.UpdateOneID(id).ClearDeletedAt().Exec(s.ctx); err != nil {
+		return fmt.Errorf("failed to restore video clip: %w", err)
+	}
+
+	log.Printf("[TRASH] Restored video clip %d", id)
+	return nil
+}
+
+// GetTrash lists the trashed projects and individually trashed video clips, most recent first
+func (s *ProjectService) GetTrash() (*TrashResponse, error) {
+	retentionDays, err := settings.NewSettingsService(s.client, s.ctx).GetTrashRetentionDays()
+	if err != nil {
+		log.Printf("[TRASH] Using default retention: %v", err)
+	}
+
+	projects, err := s.client.Project.Query().
+		Where(project.DeletedAtNotNil()).
+		WithVideoClips(func(q *ent.VideoClipQuery) {
+			q.Select(videoclip.FieldID)
+		}).
+		Order(ent.Desc(project.FieldDeletedAt)).
+		All(s.ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get trashed projects: %w", err)
+	}
+
+	clips, err := s.client.VideoClip.Query().
+		Where(
+			videoclip.DeletedAtNotNil(),
+			videoclip.HasProjectWith(project.DeletedAtIsNil()),
+		).
+		WithProject().
+		Order(ent.Desc(videoclip.FieldDeletedAt)).
+		All(s.ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get trashed video clips: %w", err)
+	}
+
+	response := &TrashResponse{
+		Projects:      make([]*TrashedProject, 0, len(projects)),
+		VideoClips:    make([]*TrashedVideoClip, 0, len(clips)),
+		RetentionDays: retentionDays,
+	}
+
+	for _, proj := range projects {
+		response.Projects = append(response.Projects, &TrashedProject{
+			ID:          proj.ID,
+			Name:        proj.Name,
+			Description: proj.Description,
+			ClipCount:   len(proj.Edges.VideoClips),
+			DeletedAt:   proj.DeletedAt.Format("2006-01-02 15:04:05"),
+			PurgeAt:     purgeTime(proj.DeletedAt, retentionDays),
+		})
+	}
+
+	for _, clip := range clips {
+		trashed := &TrashedVideoClip{
+			ID:        clip.ID,
+			Name:      clip.Name,
+			FilePath:  clip.FilePath,
+			DeletedAt: clip.DeletedAt.Format("2006-01-02 15:04:05"),
+			PurgeAt:   purgeTime(clip.DeletedAt, retentionDays),
+		}
+		if clip.Edges.Project != nil {
+			trashed.ProjectID = clip.Edges.Project.ID
+			trashed.ProjectName = clip.Edges.Project.Name
+		}
+		response.VideoClips = append(response.VideoClips, trashed)
+	}
+
+	return response, nil
+}
+
+// PurgeExpiredTrash permanently deletes trashed items older than the configured retention.
+// Returns the number of projects and clips removed.
+func (s *ProjectService) PurgeExpiredTrash() (int, error) {
+	retentionDays, err := settings.NewSettingsService(s.client, s.ctx).GetTrashRetentionDays()
+	if err != nil {
+		return 0, fmt.Errorf("failed to get trash retention: %w", err)
+	}
+	if retentionDays == 0 {
+		return 0, nil
+	}
+
+	return s.purgeTrash(time.Now().AddDate(0, 0, -retentionDays))
+}
+
+// EmptyTrash permanently deletes everything in the trash
+func (s *ProjectService) EmptyTrash() (int, error) {
+	return s.purgeTrash(time.Now().Add(time.Second))
+}
+
+// purgeTrash permanently deletes projects and clips trashed before the cutoff
+func (s *ProjectService) purgeTrash(cutoff time.Time) (int, error) {
+	projectIDs, err := s.client.Project.Query().
+		Where(project.DeletedAtLT(cutoff)).
+		IDs(s.ctx)
+	if err != nil {
+		return 0, fmt.Errorf("failed to query expired projects: %w", err)
+	}
+
+	purged := 0
+	for _, id := range projectIDs {
+		if err := s.DeleteProject(id); err != nil {
+			return purged, fmt.Errorf("failed to purge project %d: %w", id, err)
+		}
+		purged++
+	}
+
+	clipIDs, err := s.client.VideoClip.Query().
+		Where(videoclip.DeletedAtLT(cutoff)).
+		IDs(s.ctx)
+	if err != nil {
+		return purged, fmt.Errorf("failed to query expired video clips: %w", err)
+	}
+
+	for _, id := range clipIDs {
+		if err := s.DeleteVideoClip(id); err != nil {
+			return purged, fmt.Errorf("failed to purge video clip %d: %w", id, err)
+		}
+		purged++
+	}
+
+	if purged > 0 {
+		log.Printf("[TRASH] Purged %d items trashed before %s", purged, cutoff.Format("2006-01-02 15:04:05"))
+	}
+	return purged, nil
+}
+
+// purgeTime formats when a trashed item will be purged, or "" if trash is kept forever
+func purgeTime(deletedAt time.Time, retentionDays int) string {
+	if retentionDays == 0 {
+		return ""
+	}
+	return deletedAt.AddDate(0, 0, retentionDays).Format("2006-01-02 15:04:05")
+}
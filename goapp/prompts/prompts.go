@@ -0,0 +1,393 @@
+package prompts
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"regexp"
+	"sort"
+	"strings"
+
+	"ramble-ai/ent"
+	"ramble-ai/ent/predicate"
+	"ramble-ai/ent/prompt"
+	"ramble-ai/ent/schema"
+)
+
+// Task types a library prompt can be written for. Chat prompts are per endpoint, see ChatTaskType.
+const (
+	TaskSuggest = "suggest"
+	TaskReorder = "reorder"
+	TaskSilence = "silence"
+
+	chatTaskPrefix = "chat:"
+)
+
+// PromptVariable is a {{name}} placeholder declared by a library prompt
+type PromptVariable = schema.PromptVariable
+
+// placeholderPattern matches {{name}} placeholders, allowing spaces inside the braces
+var placeholderPattern = regexp.MustCompile(`\{\{\s*([A-Za-z_][A-Za-z0-9_]*)\s*\}\}`)
+
+// variableNamePattern is the form a declared variable name must take
+var variableNamePattern = regexp.MustCompile(`^[A-Za-z_][A-Za-z0-9_]*$`)
+
+// PromptService manages the prompt library
+type PromptService struct {
+	client *ent.Client
+	ctx    context.Context
+}
+
+// NewPromptService creates a new prompt service
+func NewPromptService(client *ent.Client, ctx context.Context) *PromptService {
+	return &PromptService{
+		client: client,
+		ctx:    ctx,
+	}
+}
+
+// Selection picks a library prompt version for an AI call and fills in its variables
+type Selection struct {
+	PromptID        int               `json:"promptId,omitempty"` // prompt version to use, 0 = none
+	PromptVariables map[string]string `json:"promptVariables,omitempty"`
+}
+
+// PromptInput is a new version of a library prompt
+type PromptInput struct {
+	Name        string           `json:"name"`
+	TaskType    string           `json:"taskType"`
+	Body        string           `json:"body"`
+	Description string           `json:"description"`
+	Variables   []PromptVariable `json:"variables"`
+	ProjectID   int              `json:"projectId"` // project the prompt belongs to, 0 = shared
+}
+
+// PromptResponse is one version of a library prompt for the frontend
+type PromptResponse struct {
+	ID          int              `json:"id"`
+	Name        string           `json:"name"`
+	Version     int              `json:"version"`
+	TaskType    string           `json:"taskType"`
+	Body        string           `json:"body"`
+	Description string           `json:"description"`
+	Variables   []PromptVariable `json:"variables"`
+	ProjectID   int              `json:"projectId"` // 0 = shared across all projects
+	CreatedAt   string           `json:"createdAt"`
+}
+
+// ChatTaskType returns the task type of prompts written for a chat endpoint
+func ChatTaskType(endpointID string) string {
+	return chatTaskPrefix + endpointID
+}
+
+// SavePrompt stores a new version of a prompt in the input's scope: its project, or the shared
+// library when ProjectID is 0. The first save of a name in a scope creates version 1; later saves
+// add the next version. A project prompt may reuse the name of a shared one, overriding it in that project.
+func (s *PromptService) SavePrompt(input PromptInput) (*PromptResponse, error) {
+	name := strings.TrimSpace(input.Name)
+	if name == "" {
+		return nil, fmt.Errorf("prompt name cannot be empty")
+	}
+	if err := validatePrompt(input); err != nil {
+		return nil, err
+	}
+
+	latest, err := s.latestVersion(input.ProjectID, name)
+	if err != nil {
+		return nil, err
+	}
+
+	version, projectID := 1, input.ProjectID
+	if latest != nil {
+		if latest.TaskType != input.TaskType {
+			return nil, fmt.Errorf("prompt %s is a %s prompt, not %s", name, latest.TaskType, input.TaskType)
+		}
+		version = latest.Version + 1
+	}
+
+	create := s.client.Prompt.
+		Create().
+		SetName(name).
+		SetVersion(version).
+		SetTaskType(input.TaskType).
+		SetBody(input.Body).
+		SetDescription(input.Description).
+		SetVariables(input.Variables)
+	if projectID != 0 {
+		create.SetProjectID(projectID)
+	}
+
+	saved, err := create.Save(s.ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to save prompt: %w", err)
+	}
+
+	log.Printf("[PROMPTS] Saved prompt %q version %d (%s)", name, version, input.TaskType)
+	return toPromptResponse(saved), nil
+}
+
+// GetPrompts returns the latest version of every prompt available to a project (its own and shared ones),
+// optionally limited to a task type. A project prompt hides a shared prompt of the same name.
+// projectID 0 returns the prompts of every scope.
+func (s *PromptService) GetPrompts(projectID int, taskType string) ([]*PromptResponse, error) {
+	var predicates []predicate.Prompt
+	if projectID != 0 {
+		predicates = append(predicates, prompt.Or(prompt.ProjectIDIsNil(), prompt.ProjectID(projectID)))
+	}
+	if taskType != "" {
+		predicates = append(predicates, prompt.TaskType(taskType))
+	}
+
+	versions, err := s.client.Prompt.
+		Query().
+		Where(predicates...).
+		Order(ent.Asc(prompt.FieldName), ent.Desc(prompt.FieldVersion)).
+		All(s.ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get prompts: %w", err)
+	}
+
+	type scopedName struct {
+		projectID int
+		name      string
+	}
+	latest := make(map[scopedName]*ent.Prompt)
+	for _, version := range versions {
+		key := scopedName{version.ProjectID, version.Name}
+		if _, seen := latest[key]; !seen {
+			latest[key] = version
+		}
+	}
+
+	responses := []*PromptResponse{}
+	for _, version := range versions {
+		if latest[scopedName{version.ProjectID, version.Name}] != version {
+			continue
+		}
+		if projectID != 0 && version.ProjectID == 0 && latest[scopedName{projectID, version.Name}] != nil {
+			continue // overridden by the project's own prompt
+		}
+		responses = append(responses, toPromptResponse(version))
+	}
+	return responses, nil
+}
+
+// GetPromptVersions returns every version of a prompt in a scope (projectID 0 = shared), newest first
+func (s *PromptService) GetPromptVersions(projectID int, name string) ([]*PromptResponse, error) {
+	versions, err := s.client.Prompt.
+		Query().
+		Where(prompt.Name(strings.TrimSpace(name)), promptScope(projectID)).
+		Order(ent.Desc(prompt.FieldVersion)).
+		All(s.ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get prompt versions: %w", err)
+	}
+	if len(versions) == 0 {
+		return nil, fmt.Errorf("prompt not found: %s", name)
+	}
+
+	responses := make([]*PromptResponse, 0, len(versions))
+	for _, version := range versions {
+		responses = append(responses, toPromptResponse(version))
+	}
+	return responses, nil
+}
+
+// GetPrompt returns a prompt version by its ID
+func (s *PromptService) GetPrompt(id int) (*PromptResponse, error) {
+	version, err := s.client.Prompt.Get(s.ctx, id)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get prompt: %w", err)
+	}
+	return toPromptResponse(version), nil
+}
+
+// SharePrompt makes every version of a project's prompt available to all projects. It fails if
+// the shared library already has a prompt of that name.
+func (s *PromptService) SharePrompt(projectID int, name string) error {
+	name = strings.TrimSpace(name)
+	if projectID == 0 {
+		return fmt.Errorf("prompt %s is already shared", name)
+	}
+
+	shared, err := s.latestVersion(0, name)
+	if err != nil {
+		return err
+	}
+	if shared != nil {
+		return fmt.Errorf("a shared prompt named %s already exists", name)
+	}
+
+	updated, err := s.client.Prompt.
+		Update().
+		Where(prompt.Name(name), prompt.ProjectID(projectID)).
+		ClearProjectID().
+		Save(s.ctx)
+	if err != nil {
+		return fmt.Errorf("failed to share prompt: %w", err)
+	}
+	if updated == 0 {
+		return fmt.Errorf("prompt not found: %s", name)
+	}
+	return nil
+}
+
+// DeletePrompt deletes every version of a prompt in a scope (projectID 0 = shared). Results already
+// produced by it keep the recorded version ID.
+func (s *PromptService) DeletePrompt(projectID int, name string) error {
+	deleted, err := s.client.Prompt.
+		Delete().
+		Where(prompt.Name(strings.TrimSpace(name)), promptScope(projectID)).
+		Exec(s.ctx)
+	if err != nil {
+		return fmt.Errorf("failed to delete prompt: %w", err)
+	}
+	if deleted == 0 {
+		return fmt.Errorf("prompt not found: %s", name)
+	}
+	return nil
+}
+
+// ResolvePrompt renders the selected prompt version for a task run in a project. It fails if the
+// prompt was written for another task or belongs to another project.
+func (s *PromptService) ResolvePrompt(selection Selection, taskType string, projectID int) (string, error) {
+	version, err := s.client.Prompt.Get(s.ctx, selection.PromptID)
+	if err != nil {
+		return "", fmt.Errorf("failed to get prompt: %w", err)
+	}
+	if version.TaskType != taskType {
+		return "", fmt.Errorf("prompt %s is a %s prompt, not %s", version.Name, version.TaskType, taskType)
+	}
+	if version.ProjectID != 0 && version.ProjectID != projectID {
+		return "", fmt.Errorf("prompt %s belongs to another project", version.Name)
+	}
+
+	rendered, err := RenderPrompt(version.Body, version.Variables, selection.PromptVariables)
+	if err != nil {
+		return "", err
+	}
+
+	log.Printf("[PROMPTS] Using prompt %q version %d for %s in project %d", version.Name, version.Version, taskType, projectID)
+	return rendered, nil
+}
+
+// RenderPrompt replaces the {{name}} placeholders of a prompt body with the given values,
+// falling back to each variable's default. Variables without a value or default are an error.
+func RenderPrompt(body string, variables []PromptVariable, values map[string]string) (string, error) {
+	defaults := make(map[string]string, len(variables))
+	for _, variable := range variables {
+		defaults[variable.Name] = variable.Default
+	}
+
+	var missing []string
+	rendered := placeholderPattern.ReplaceAllStringFunc(body, func(placeholder string) string {
+		name := placeholderPattern.FindStringSubmatch(placeholder)[1]
+		if value, ok := values[name]; ok {
+			return value
+		}
+		if value := defaults[name]; value != "" {
+			return value
+		}
+		missing = append(missing, name)
+		return placeholder
+	})
+
+	if len(missing) > 0 {
+		return "", fmt.Errorf("missing values for prompt variables: %s", strings.Join(uniqueSorted(missing), ", "))
+	}
+	return rendered, nil
+}
+
+// latestVersion returns the newest version of a prompt in a scope (projectID 0 = shared), or nil if there is none
+func (s *PromptService) latestVersion(projectID int, name string) (*ent.Prompt, error) {
+	latest, err := s.client.Prompt.
+		Query().
+		Where(prompt.Name(name), promptScope(projectID)).
+		Order(ent.Desc(prompt.FieldVersion)).
+		First(s.ctx)
+	if ent.IsNotFound(err) {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, fmt.Errorf("failed to get prompt: %w", err)
+	}
+	return latest, nil
+}
+
+// promptScope selects the prompts of a project, or the shared prompts when projectID is 0
+func promptScope(projectID int) predicate.Prompt {
+	if projectID == 0 {
+		return prompt.ProjectIDIsNil()
+	}
+	return prompt.ProjectID(projectID)
+}
+
+// validatePrompt checks the task type, body and variables of a prompt version
+func validatePrompt(input PromptInput) error {
+	switch {
+	case input.TaskType == TaskSuggest, input.TaskType == TaskReorder, input.TaskType == TaskSilence:
+	case strings.HasPrefix(input.TaskType, chatTaskPrefix) && len(input.TaskType) > len(chatTaskPrefix):
+	default:
+		return fmt.Errorf("invalid prompt task type: %s", input.TaskType)
+	}
+
+	if strings.TrimSpace(input.Body) == "" {
+		return fmt.Errorf("prompt body cannot be empty")
+	}
+
+	declared := make(map[string]bool, len(input.Variables))
+	for _, variable := range input.Variables {
+		if !variableNamePattern.MatchString(variable.Name) {
+			return fmt.Errorf("invalid prompt variable name: %q", variable.Name)
+		}
+		if declared[variable.Name] {
+			return fmt.Errorf("duplicate prompt variable: %s", variable.Name)
+		}
+		declared[variable.Name] = true
+	}
+
+	var undeclared []string
+	for _, match := range placeholderPattern.FindAllStringSubmatch(input.Body, -1) {
+		if !declared[match[1]] {
+			undeclared = append(undeclared, match[1])
+		}
+	}
+	if len(undeclared) > 0 {
+		return fmt.Errorf("prompt uses undeclared variables: %s", strings.Join(uniqueSorted(undeclared), ", "))
+	}
+	return nil
+}
+
+// uniqueSorted returns the distinct values of names in sorted order
+func uniqueSorted(names []string) []string {
+	seen := make(map[string]bool, len(names))
+	var result []string
+	for _, name := range names {
+		if !seen[name] {
+			seen[name] = true
+			result = append(result, name)
+		}
+	}
+	sort.Strings(result)
+	return result
+}
+
+// toPromptResponse converts a prompt entity to its frontend form
+func toPromptResponse(p *ent.Prompt) *PromptResponse {
+	variables := p.Variables
+	if variables == nil {
+		variables = []PromptVariable{}
+	}
+
+	return &PromptResponse{
+		ID:          p.ID,
+		Name:        p.Name,
+		Version:     p.Version,
+		TaskType:    p.TaskType,
+		Body:        p.Body,
+		Description: p.Description,
+		Variables:   variables,
+		ProjectID:   p.ProjectID,
+		CreatedAt:   p.CreatedAt.Format("2006-01-02 15:04:05"),
+	}
+}
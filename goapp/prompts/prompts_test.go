@@ -0,0 +1,209 @@
+package prompts
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"ramble-ai/goapp"
+)
+
+func setupPromptService(t *testing.T) (*goapp.TestHelper, *PromptService) {
+	helper := goapp.NewTestHelper(t)
+	return helper, NewPromptService(helper.Client, helper.Ctx)
+}
+
+func TestSavePromptVersions(t *testing.T) {
+	helper, service := setupPromptService(t)
+	project := helper.CreateTestProject("Prompt Owner")
+
+	first, err := service.SavePrompt(PromptInput{
+		Name:      " Punchy hooks ",
+		TaskType:  TaskSuggest,
+		Body:      "Find hooks for {{audience}}",
+		Variables: []PromptVariable{{Name: "audience", Default: "developers"}},
+		ProjectID: project.ID,
+	})
+	require.NoError(t, err)
+	assert.Equal(t, "Punchy hooks", first.Name)
+	assert.Equal(t, 1, first.Version)
+	assert.Equal(t, project.ID, first.ProjectID)
+
+	second, err := service.SavePrompt(PromptInput{Name: "Punchy hooks", TaskType: TaskSuggest, Body: "Find short hooks", ProjectID: project.ID})
+	require.NoError(t, err)
+	assert.Equal(t, 2, second.Version)
+	assert.Equal(t, project.ID, second.ProjectID)
+	assert.Empty(t, second.Variables)
+
+	_, err = service.SavePrompt(PromptInput{Name: "Punchy hooks", TaskType: TaskReorder, Body: "Reorder", ProjectID: project.ID})
+	assert.EqualError(t, err, "prompt Punchy hooks is a suggest prompt, not reorder")
+
+	versions, err := service.GetPromptVersions(project.ID, "Punchy hooks")
+	require.NoError(t, err)
+	require.Len(t, versions, 2)
+	assert.Equal(t, []int{2, 1}, []int{versions[0].Version, versions[1].Version})
+
+	_, err = service.GetPromptVersions(0, "Punchy hooks")
+	assert.EqualError(t, err, "prompt not found: Punchy hooks", "the project's prompt is not shared")
+	_, err = service.GetPromptVersions(project.ID, "missing")
+	assert.EqualError(t, err, "prompt not found: missing")
+}
+
+func TestSavePromptSameNameInTwoProjects(t *testing.T) {
+	helper, service := setupPromptService(t)
+	first := helper.CreateTestProject("First")
+	second := helper.CreateTestProject("Second")
+
+	_, err := service.SavePrompt(PromptInput{Name: "Recap", TaskType: TaskReorder, Body: "First recap", ProjectID: first.ID})
+	require.NoError(t, err)
+	other, err := service.SavePrompt(PromptInput{Name: "Recap", TaskType: TaskReorder, Body: "Second recap", ProjectID: second.ID})
+	require.NoError(t, err)
+	assert.Equal(t, 1, other.Version, "each project versions its own prompt")
+	assert.Equal(t, second.ID, other.ProjectID)
+
+	next, err := service.SavePrompt(PromptInput{Name: "Recap", TaskType: TaskReorder, Body: "First recap, tighter", ProjectID: first.ID})
+	require.NoError(t, err)
+	assert.Equal(t, 2, next.Version)
+	assert.Equal(t, first.ID, next.ProjectID)
+
+	versions, err := service.GetPromptVersions(second.ID, "Recap")
+	require.NoError(t, err)
+	require.Len(t, versions, 1)
+	assert.Equal(t, "Second recap", versions[0].Body)
+
+	prompts, err := service.GetPrompts(first.ID, "")
+	require.NoError(t, err)
+	require.Len(t, prompts, 1)
+	assert.Equal(t, "First recap, tighter", prompts[0].Body)
+
+	// Only one of them can become the shared Recap
+	require.NoError(t, service.SharePrompt(first.ID, "Recap"))
+	assert.EqualError(t, service.SharePrompt(second.ID, "Recap"), "a shared prompt named Recap already exists")
+}
+
+func TestProjectPromptOverridesSharedPrompt(t *testing.T) {
+	helper, service := setupPromptService(t)
+	project := helper.CreateTestProject("Project")
+	other := helper.CreateTestProject("Other")
+
+	shared, err := service.SavePrompt(PromptInput{Name: "Hooks", TaskType: TaskSuggest, Body: "Find hooks"})
+	require.NoError(t, err)
+	assert.Zero(t, shared.ProjectID)
+
+	override, err := service.SavePrompt(PromptInput{Name: "Hooks", TaskType: TaskSuggest, Body: "Find technical hooks", ProjectID: project.ID})
+	require.NoError(t, err)
+	assert.Equal(t, 1, override.Version)
+	assert.Equal(t, project.ID, override.ProjectID, "saving under a shared name creates a project prompt")
+
+	bodies := func(projectID int) []string {
+		list, err := service.GetPrompts(projectID, TaskSuggest)
+		require.NoError(t, err)
+		var result []string
+		for _, p := range list {
+			result = append(result, p.Body)
+		}
+		return result
+	}
+	assert.Equal(t, []string{"Find technical hooks"}, bodies(project.ID))
+	assert.Equal(t, []string{"Find hooks"}, bodies(other.ID), "other projects keep the shared prompt")
+	assert.ElementsMatch(t, []string{"Find hooks", "Find technical hooks"}, bodies(0))
+
+	// A shared save stays shared and doesn't touch the project's prompt
+	sharedNext, err := service.SavePrompt(PromptInput{Name: "Hooks", TaskType: TaskSuggest, Body: "Find any hooks"})
+	require.NoError(t, err)
+	assert.Equal(t, 2, sharedNext.Version)
+	assert.Zero(t, sharedNext.ProjectID)
+	versions, err := service.GetPromptVersions(project.ID, "Hooks")
+	require.NoError(t, err)
+	require.Len(t, versions, 1)
+
+	assert.EqualError(t, service.SharePrompt(project.ID, "Hooks"), "a shared prompt named Hooks already exists")
+	require.NoError(t, service.DeletePrompt(project.ID, "Hooks"))
+	assert.Equal(t, []string{"Find any hooks"}, bodies(project.ID))
+}
+
+func TestValidatePrompt(t *testing.T) {
+	tests := []struct {
+		name    string
+		input   PromptInput
+		wantErr string
+	}{
+		{"chat endpoint", PromptInput{TaskType: ChatTaskType("highlight_ordering"), Body: "Be brief"}, ""},
+		{"unknown task", PromptInput{TaskType: "summarize", Body: "x"}, "invalid prompt task type: summarize"},
+		{"chat without endpoint", PromptInput{TaskType: "chat:", Body: "x"}, "invalid prompt task type: chat:"},
+		{"empty body", PromptInput{TaskType: TaskSilence, Body: "  "}, "prompt body cannot be empty"},
+		{"undeclared variable", PromptInput{TaskType: TaskReorder, Body: "{{tone}} and {{ length }} and {{tone}}"}, "prompt uses undeclared variables: length, tone"},
+		{"invalid variable name", PromptInput{TaskType: TaskReorder, Body: "x", Variables: []PromptVariable{{Name: "two words"}}}, `invalid prompt variable name: "two words"`},
+		{"duplicate variable", PromptInput{TaskType: TaskReorder, Body: "{{a}}", Variables: []PromptVariable{{Name: "a"}, {Name: "a"}}}, "duplicate prompt variable: a"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := validatePrompt(tt.input)
+			if tt.wantErr == "" {
+				assert.NoError(t, err)
+				return
+			}
+			assert.EqualError(t, err, tt.wantErr)
+		})
+	}
+}
+
+func TestRenderPrompt(t *testing.T) {
+	variables := []PromptVariable{{Name: "audience", Default: "developers"}, {Name: "length"}}
+
+	rendered, err := RenderPrompt("Keep it under {{length}} for {{ audience }}.", variables, map[string]string{"length": "3 minutes"})
+	require.NoError(t, err)
+	assert.Equal(t, "Keep it under 3 minutes for developers.", rendered)
+
+	rendered, err = RenderPrompt("For {{audience}}", variables, map[string]string{"audience": "designers"})
+	require.NoError(t, err)
+	assert.Equal(t, "For designers", rendered)
+
+	_, err = RenderPrompt("Keep it under {{length}}", variables, nil)
+	assert.EqualError(t, err, "missing values for prompt variables: length")
+}
+
+func TestPromptSharingAndResolution(t *testing.T) {
+	helper, service := setupPromptService(t)
+	owner := helper.CreateTestProject("Owner")
+	other := helper.CreateTestProject("Other")
+
+	private, err := service.SavePrompt(PromptInput{Name: "Tight recap", TaskType: TaskReorder, Body: "Recap in {{minutes}} minutes", Variables: []PromptVariable{{Name: "minutes", Default: "3"}}, ProjectID: owner.ID})
+	require.NoError(t, err)
+	_, err = service.SavePrompt(PromptInput{Name: "Calm pacing", TaskType: TaskSilence, Body: "Leave generous pauses"})
+	require.NoError(t, err)
+
+	names := func(projectID int, taskType string) []string {
+		list, err := service.GetPrompts(projectID, taskType)
+		require.NoError(t, err)
+		var result []string
+		for _, p := range list {
+			result = append(result, p.Name)
+		}
+		return result
+	}
+	assert.Equal(t, []string{"Calm pacing", "Tight recap"}, names(owner.ID, ""))
+	assert.Equal(t, []string{"Calm pacing"}, names(other.ID, ""))
+	assert.Equal(t, []string{"Tight recap"}, names(0, TaskReorder))
+
+	rendered, err := service.ResolvePrompt(Selection{PromptID: private.ID, PromptVariables: map[string]string{"minutes": "5"}}, TaskReorder, owner.ID)
+	require.NoError(t, err)
+	assert.Equal(t, "Recap in 5 minutes", rendered)
+
+	_, err = service.ResolvePrompt(Selection{PromptID: private.ID}, TaskSuggest, owner.ID)
+	assert.EqualError(t, err, "prompt Tight recap is a reorder prompt, not suggest")
+	_, err = service.ResolvePrompt(Selection{PromptID: private.ID}, TaskReorder, other.ID)
+	assert.EqualError(t, err, "prompt Tight recap belongs to another project")
+
+	require.NoError(t, service.SharePrompt(owner.ID, "Tight recap"))
+	assert.Equal(t, []string{"Calm pacing", "Tight recap"}, names(other.ID, ""))
+	rendered, err = service.ResolvePrompt(Selection{PromptID: private.ID}, TaskReorder, other.ID)
+	require.NoError(t, err)
+	assert.Equal(t, "Recap in 3 minutes", rendered)
+
+	require.NoError(t, service.DeletePrompt(0, "Tight recap"))
+	assert.Equal(t, []string{"Calm pacing"}, names(owner.ID, ""))
+	assert.EqualError(t, service.DeletePrompt(0, "Tight recap"), "prompt not found: Tight recap")
+}
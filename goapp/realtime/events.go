@@ -22,6 +22,7 @@ const (
 	EventChatHistoryCleared EventType = "chat_history_cleared"
 	EventChatSessionUpdated EventType = "chat_session_updated"
 	EventChatProgress       EventType = "chat_progress"
+	EventChatDelta          EventType = "chat_delta"
 
 	// Connection events
 	EventConnected    EventType = "connected"
@@ -109,3 +110,11 @@ type ChatProgressData struct {
 	SessionID  string `json:"sessionId"`
 	Message    string `json:"message"`
 }
+
+// ChatDeltaData represents a piece of an assistant reply that is still being streamed
+type ChatDeltaData struct {
+	EndpointID string `json:"endpointId"`
+	SessionID  string `json:"sessionId"`
+	MessageID  string `json:"messageId"`
+	Delta      string `json:"delta"`
+}
@@ -74,6 +74,7 @@ func TestEventTypes(t *testing.T) {
 		EventChatHistoryCleared,
 		EventChatSessionUpdated,
 		EventChatProgress,
+		EventChatDelta,
 		EventConnected,
 		EventDisconnected,
 	}
@@ -257,6 +257,27 @@ func (m *Manager) BroadcastChatProgress(projectID string, endpointID string, ses
 	log.Printf("Broadcasted chat progress for project %s, endpoint %s: %s", projectID, endpointID, message)
 }
 
+// BroadcastChatDelta broadcasts a streamed piece of an assistant reply. The complete reply follows
+// as a chat message added event with the same message ID.
+func (m *Manager) BroadcastChatDelta(projectID string, endpointID string, sessionID string, messageID string, delta string) {
+	data := &ChatDeltaData{
+		EndpointID: endpointID,
+		SessionID:  sessionID,
+		MessageID:  messageID,
+		Delta:      delta,
+	}
+
+	event := NewEvent(EventChatDelta, projectID, data)
+
+	// Broadcast via SSE for browser connections
+	m.sseManager.BroadcastToProject(projectID, event)
+
+	// Broadcast via Wails events for desktop app
+	if m.ctx != nil {
+		runtime.EventsEmit(m.ctx, string(EventChatDelta), event)
+	}
+}
+
 // GetStats returns statistics about connected clients
 func (m *Manager) GetStats() map[string]interface{} {
 	return map[string]interface{}{
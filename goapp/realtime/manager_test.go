@@ -239,6 +239,18 @@ func TestManager_BroadcastChatProgress(t *testing.T) {
 	time.Sleep(10 * time.Millisecond)
 }
 
+func TestManager_BroadcastChatDelta(t *testing.T) {
+	// Reset global manager for testing
+	globalManager = nil
+	once = sync.Once{}
+
+	manager := GetManager()
+	defer manager.Shutdown()
+
+	manager.BroadcastChatDelta("123", "endpoint1", "session1", "msg_1", "Hel")
+	time.Sleep(10 * time.Millisecond)
+}
+
 func TestManager_GetStats(t *testing.T) {
 	// Reset global manager for testing
 	globalManager = nil
@@ -0,0 +1,345 @@
+package search
+
+import (
+	"sort"
+	"strings"
+	"sync"
+	"time"
+	"unicode"
+
+	"ramble-ai/ent"
+	"ramble-ai/ent/schema"
+)
+
+// posting records one occurrence of a token in a clip transcript
+type posting struct {
+	clipID    int
+	wordIndex int
+}
+
+// indexedClip holds the transcript of a clip as tokens aligned with its words
+type indexedClip struct {
+	id        int
+	projectID int
+	name      string
+	filePath  string
+	words     []schema.Word
+	tokens    []string
+	updatedAt time.Time
+}
+
+// TranscriptIndex is an in-process inverted index over clip transcripts
+type TranscriptIndex struct {
+	mu       sync.RWMutex
+	clips    map[int]*indexedClip
+	postings map[string][]posting
+}
+
+// match is a raw phrase occurrence found in the index
+type match struct {
+	clipID    int
+	startWord int
+	endWord   int // exclusive
+	clauseLen int
+}
+
+var (
+	// indexes holds one shared index per ent client so it survives across short-lived services
+	indexes   = make(map[*ent.Client]*TranscriptIndex)
+	indexesMu sync.Mutex
+)
+
+// indexFor returns the shared transcript index for a client
+func indexFor(client *ent.Client) *TranscriptIndex {
+	indexesMu.Lock()
+	defer indexesMu.Unlock()
+
+	index, exists := indexes[client]
+	if !exists {
+		index = NewTranscriptIndex()
+		indexes[client] = index
+	}
+	return index
+}
+
+// NewTranscriptIndex creates an empty transcript index
+func NewTranscriptIndex() *TranscriptIndex {
+	return &TranscriptIndex{
+		clips:    make(map[int]*indexedClip),
+		postings: make(map[string][]posting),
+	}
+}
+
+// normalizeToken lowercases a word and strips surrounding punctuation
+func normalizeToken(word string) string {
+	return strings.ToLower(strings.TrimFunc(word, func(r rune) bool {
+		return !unicode.IsLetter(r) && !unicode.IsNumber(r)
+	}))
+}
+
+// tokenize splits free text into normalized tokens
+func tokenize(text string) []string {
+	var tokens []string
+	for _, field := range strings.Fields(text) {
+		if token := normalizeToken(field); token != "" {
+			tokens = append(tokens, token)
+		}
+	}
+	return tokens
+}
+
+// parseQuery splits a query into clauses: quoted text becomes one phrase clause,
+// every other word becomes its own single-token clause
+func parseQuery(query string) [][]string {
+	var clauses [][]string
+	parts := strings.Split(query, `"`)
+	for i, part := range parts {
+		tokens := tokenize(part)
+		if len(tokens) == 0 {
+			continue
+		}
+		// Odd segments are inside quotes (an unterminated quote still counts as a phrase)
+		if i%2 == 1 {
+			clauses = append(clauses, tokens)
+			continue
+		}
+		for _, token := range tokens {
+			clauses = append(clauses, []string{token})
+		}
+	}
+	return clauses
+}
+
+// upsertClip replaces the indexed transcript of a clip
+func (ix *TranscriptIndex) upsertClip(clip *indexedClip) {
+	ix.mu.Lock()
+	defer ix.mu.Unlock()
+
+	ix.removeClipLocked(clip.id)
+
+	clip.tokens = make([]string, len(clip.words))
+	for i, word := range clip.words {
+		token := normalizeToken(word.Word)
+		clip.tokens[i] = token
+		if token == "" {
+			continue
+		}
+		ix.postings[token] = append(ix.postings[token], posting{clipID: clip.id, wordIndex: i})
+	}
+	ix.clips[clip.id] = clip
+}
+
+// removeClip drops a clip from the index
+func (ix *TranscriptIndex) removeClip(clipID int) {
+	ix.mu.Lock()
+	defer ix.mu.Unlock()
+	ix.removeClipLocked(clipID)
+}
+
+func (ix *TranscriptIndex) removeClipLocked(clipID int) {
+	clip, exists := ix.clips[clipID]
+	if !exists {
+		return
+	}
+
+	seen := make(map[string]bool)
+	for _, token := range clip.tokens {
+		if token == "" || seen[token] {
+			continue
+		}
+		seen[token] = true
+
+		kept := ix.postings[token][:0]
+		for _, p := range ix.postings[token] {
+			if p.clipID != clipID {
+				kept = append(kept, p)
+			}
+		}
+		if len(kept) == 0 {
+			delete(ix.postings, token)
+		} else {
+			ix.postings[token] = kept
+		}
+	}
+	delete(ix.clips, clipID)
+}
+
+// clipStamps returns the last indexed update time of every clip
+func (ix *TranscriptIndex) clipStamps() map[int]time.Time {
+	ix.mu.RLock()
+	defer ix.mu.RUnlock()
+
+	stamps := make(map[int]time.Time, len(ix.clips))
+	for id, clip := range ix.clips {
+		stamps[id] = clip.updatedAt
+	}
+	return stamps
+}
+
+// findPhrase returns every occurrence of a token sequence, optionally restricted to a project
+func (ix *TranscriptIndex) findPhrase(tokens []string, projectID int) []match {
+	if len(tokens) == 0 {
+		return nil
+	}
+
+	var matches []match
+	for _, p := range ix.postings[tokens[0]] {
+		clip := ix.clips[p.clipID]
+		if clip == nil || (projectID != 0 && clip.projectID != projectID) {
+			continue
+		}
+
+		// Phrase tokens must follow each other, skipping words that normalize to nothing
+		end := p.wordIndex + 1
+		matched := true
+		for _, want := range tokens[1:] {
+			for end < len(clip.tokens) && clip.tokens[end] == "" {
+				end++
+			}
+			if end >= len(clip.tokens) || clip.tokens[end] != want {
+				matched = false
+				break
+			}
+			end++
+		}
+		if matched {
+			matches = append(matches, match{clipID: p.clipID, startWord: p.wordIndex, endWord: end, clauseLen: len(tokens)})
+		}
+	}
+	return matches
+}
+
+// search runs a parsed query. A clip only matches if it contains every clause;
+// each occurrence of a clause in a matching clip is returned as a hit.
+func (ix *TranscriptIndex) search(clauses [][]string, projectID int, contextWords int) []SearchResult {
+	ix.mu.RLock()
+	defer ix.mu.RUnlock()
+
+	if len(clauses) == 0 {
+		return []SearchResult{}
+	}
+
+	// Collect matches per clause and keep only clips that satisfy all of them
+	clauseMatches := make([][]match, len(clauses))
+	var clipsWithAll map[int]bool
+	for i, clause := range clauses {
+		clauseMatches[i] = ix.findPhrase(clause, projectID)
+
+		clipsWithClause := make(map[int]bool)
+		for _, m := range clauseMatches[i] {
+			if clipsWithAll == nil || clipsWithAll[m.clipID] {
+				clipsWithClause[m.clipID] = true
+			}
+		}
+		clipsWithAll = clipsWithClause
+		if len(clipsWithAll) == 0 {
+			return []SearchResult{}
+		}
+	}
+
+	var results []SearchResult
+	for i, matches := range clauseMatches {
+		for _, m := range matches {
+			if !clipsWithAll[m.clipID] {
+				continue
+			}
+			clip := ix.clips[m.clipID]
+
+			// Score phrases above single words and reward other clauses appearing nearby
+			score := float64(m.clauseLen)
+			for j, others := range clauseMatches {
+				if j == i {
+					continue
+				}
+				for _, other := range others {
+					if other.clipID == m.clipID && abs(other.startWord-m.startWord) <= contextWords {
+						score += float64(other.clauseLen) / 2
+						break
+					}
+				}
+			}
+
+			results = append(results, buildResult(clip, m.startWord, m.endWord, contextWords, score, ModeText))
+		}
+	}
+
+	sort.SliceStable(results, func(i, j int) bool {
+		if results[i].Score != results[j].Score {
+			return results[i].Score > results[j].Score
+		}
+		if results[i].ClipID != results[j].ClipID {
+			return results[i].ClipID < results[j].ClipID
+		}
+		return results[i].StartWord < results[j].StartWord
+	})
+
+	return dedupeOverlapping(results)
+}
+
+// dedupeOverlapping drops hits that cover the same words as a better-scored hit in the same clip
+func dedupeOverlapping(results []SearchResult) []SearchResult {
+	kept := make([]SearchResult, 0, len(results))
+	for _, result := range results {
+		overlaps := false
+		for _, existing := range kept {
+			if existing.ClipID == result.ClipID && result.StartWord < existing.EndWord && existing.StartWord < result.EndWord {
+				overlaps = true
+				break
+			}
+		}
+		if !overlaps {
+			kept = append(kept, result)
+		}
+	}
+	return kept
+}
+
+// buildResult creates a search result for a word range with surrounding context
+func buildResult(clip *indexedClip, startWord, endWord, contextWords int, score float64, mode string) SearchResult {
+	if endWord > len(clip.words) {
+		endWord = len(clip.words)
+	}
+	contextStart := startWord - contextWords
+	if contextStart < 0 {
+		contextStart = 0
+	}
+	contextEnd := endWord + contextWords
+	if contextEnd > len(clip.words) {
+		contextEnd = len(clip.words)
+	}
+
+	result := SearchResult{
+		ProjectID:     clip.projectID,
+		ClipID:        clip.id,
+		ClipName:      clip.name,
+		FilePath:      clip.filePath,
+		StartWord:     startWord,
+		EndWord:       endWord,
+		MatchText:     joinWords(clip.words[startWord:endWord]),
+		ContextBefore: joinWords(clip.words[contextStart:startWord]),
+		ContextAfter:  joinWords(clip.words[endWord:contextEnd]),
+		Score:         score,
+		Mode:          mode,
+	}
+	if startWord < endWord {
+		result.Start = clip.words[startWord].Start
+		result.End = clip.words[endWord-1].End
+	}
+	return result
+}
+
+// joinWords joins transcript words into display text
+func joinWords(words []schema.Word) string {
+	parts := make([]string, len(words))
+	for i, word := range words {
+		parts[i] = strings.TrimSpace(word.Word)
+	}
+	return strings.Join(parts, " ")
+}
+
+func abs(x int) int {
+	if x < 0 {
+		return -x
+	}
+	return x
+}
@@ -0,0 +1,237 @@
+package search
+
+import (
+	"fmt"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"ramble-ai/ent"
+	"ramble-ai/ent/schema"
+	"ramble-ai/ent/videoclip"
+	"ramble-ai/goapp"
+	"ramble-ai/goapp/ai"
+)
+
+// wordsFromText builds transcript words with one second per word
+func wordsFromText(text string) []schema.Word {
+	var words []schema.Word
+	for i, w := range strings.Fields(text) {
+		words = append(words, schema.Word{Word: w, Start: float64(i), End: float64(i) + 0.9})
+	}
+	return words
+}
+
+func createTranscribedClip(t *testing.T, helper *goapp.TestHelper, project *ent.Project, name, text string) *ent.VideoClip {
+	clip := helper.CreateTestVideoClip(project, name)
+	clip, err := clip.Update().SetTranscriptionWords(wordsFromText(text)).Save(helper.Ctx)
+	require.NoError(t, err)
+	return clip
+}
+
+// fakeEmbedder embeds texts as keyword-count vectors so similarity is predictable
+type fakeEmbedder struct {
+	keywords []string
+	calls    int
+}
+
+func (f *fakeEmbedder) ProcessText(request *ai.TextProcessingRequest) (*ai.OpenRouterResponse, error) {
+	return nil, fmt.Errorf("not implemented")
+}
+
+func (f *fakeEmbedder) ProcessAudio(audioFile string) (*ai.AudioProcessingResult, error) {
+	return nil, fmt.Errorf("not implemented")
+}
+
+func (f *fakeEmbedder) ProcessEmbeddings(request *ai.EmbeddingRequest) (*ai.EmbeddingResult, error) {
+	f.calls++
+	result := &ai.EmbeddingResult{Model: request.Model}
+	for _, text := range request.Input {
+		vector := make([]float32, len(f.keywords)+1)
+		vector[len(f.keywords)] = 0.1 // Avoid zero vectors
+		for _, token := range tokenize(text) {
+			for i, keyword := range f.keywords {
+				if token == keyword {
+					vector[i]++
+				}
+			}
+		}
+		result.Embeddings = append(result.Embeddings, vector)
+	}
+	return result, nil
+}
+
+func TestParseQuery(t *testing.T) {
+	tests := []struct {
+		name     string
+		query    string
+		expected [][]string
+	}{
+		{"single word", "Pricing", [][]string{{"pricing"}}},
+		{"multiple words", "pricing, tiers!", [][]string{{"pricing"}, {"tiers"}}},
+		{"phrase", `"pricing model"`, [][]string{{"pricing", "model"}}},
+		{"phrase and word", `launch "pricing model"`, [][]string{{"launch"}, {"pricing", "model"}}},
+		{"unterminated quote", `"pricing model`, [][]string{{"pricing", "model"}}},
+		{"only punctuation", `"..."`, nil},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.expected, parseQuery(tt.query))
+		})
+	}
+}
+
+func TestChunkTranscript(t *testing.T) {
+	words := wordsFromText("a b c d e f g")
+
+	chunks := chunkTranscript(words, 4, 1)
+
+	require.Len(t, chunks, 2)
+	assert.Equal(t, 0, chunks[0].startWord)
+	assert.Equal(t, 4, chunks[0].endWord)
+	assert.Equal(t, "a b c d", chunks[0].text)
+	assert.Equal(t, 3, chunks[1].startWord)
+	assert.Equal(t, 7, chunks[1].endWord)
+
+	assert.Nil(t, chunkTranscript(words, 4, 4))
+}
+
+func TestCosineSimilarity(t *testing.T) {
+	assert.InDelta(t, 1.0, cosineSimilarity([]float32{1, 2}, []float32{2, 4}), 1e-9)
+	assert.InDelta(t, 0.0, cosineSimilarity([]float32{1, 0}, []float32{0, 1}), 1e-9)
+	assert.Equal(t, 0.0, cosineSimilarity([]float32{1}, []float32{1, 2}))
+	assert.Equal(t, 0.0, cosineSimilarity([]float32{0, 0}, []float32{1, 2}))
+}
+
+func TestSearchTranscripts_Text(t *testing.T) {
+	helper := goapp.NewTestHelper(t)
+	projectA := helper.CreateTestProject("Podcast")
+	projectB := helper.CreateTestProject("Webinar")
+
+	clipA := createTranscribedClip(t, helper, projectA, "episode1", "Today we talk about our pricing model and why the pricing changed.")
+	createTranscribedClip(t, helper, projectB, "session1", "The new Pricing Model launches next week.")
+	createTranscribedClip(t, helper, projectB, "session2", "Nothing relevant here at all.")
+
+	service := NewSearchService(helper.Client, helper.Ctx)
+
+	t.Run("phrase across all projects", func(t *testing.T) {
+		response, err := service.SearchTranscripts(SearchOptions{Query: `"pricing model"`})
+
+		require.NoError(t, err)
+		require.Equal(t, 2, response.Total)
+		names := []string{response.Results[0].ProjectName, response.Results[1].ProjectName}
+		assert.ElementsMatch(t, []string{"Podcast", "Webinar"}, names)
+	})
+
+	t.Run("result carries timestamps and context", func(t *testing.T) {
+		response, err := service.SearchTranscripts(SearchOptions{Query: `"pricing model"`, ProjectID: projectA.ID, ContextWords: 2})
+
+		require.NoError(t, err)
+		require.Len(t, response.Results, 1)
+		result := response.Results[0]
+		assert.Equal(t, clipA.ID, result.ClipID)
+		assert.Equal(t, "episode1", result.ClipName)
+		assert.Equal(t, 5, result.StartWord)
+		assert.Equal(t, 7, result.EndWord)
+		assert.Equal(t, 5.0, result.Start)
+		assert.Equal(t, 6.9, result.End)
+		assert.Equal(t, "pricing model", result.MatchText)
+		assert.Equal(t, "about our", result.ContextBefore)
+		assert.Equal(t, "and why", result.ContextAfter)
+		assert.Equal(t, ModeText, result.Mode)
+	})
+
+	t.Run("all words must appear in the clip", func(t *testing.T) {
+		response, err := service.SearchTranscripts(SearchOptions{Query: "pricing launches"})
+
+		require.NoError(t, err)
+		require.NotEmpty(t, response.Results)
+		for _, result := range response.Results {
+			assert.Equal(t, "session1", result.ClipName)
+		}
+	})
+
+	t.Run("limit", func(t *testing.T) {
+		response, err := service.SearchTranscripts(SearchOptions{Query: "pricing", Limit: 1})
+
+		require.NoError(t, err)
+		assert.Equal(t, 3, response.Total)
+		assert.Len(t, response.Results, 1)
+	})
+
+	t.Run("index picks up transcript changes and deletions", func(t *testing.T) {
+		_, err := clipA.Update().SetTranscriptionWords(wordsFromText("completely different words")).Save(helper.Ctx)
+		require.NoError(t, err)
+
+		response, err := service.SearchTranscripts(SearchOptions{Query: "different", ProjectID: projectA.ID})
+		require.NoError(t, err)
+		assert.Equal(t, 1, response.Total)
+
+		require.NoError(t, helper.Client.VideoClip.DeleteOneID(clipA.ID).Exec(helper.Ctx))
+
+		response, err = service.SearchTranscripts(SearchOptions{Query: "different"})
+		require.NoError(t, err)
+		assert.Equal(t, 0, response.Total)
+	})
+
+	t.Run("trashed clips are not searchable", func(t *testing.T) {
+		session, err := helper.Client.VideoClip.Query().Where(videoclip.Name("session1")).Only(helper.Ctx)
+		require.NoError(t, err)
+		_, err = session.Update().SetDeletedAt(time.Now()).Save(helper.Ctx)
+		require.NoError(t, err)
+
+		response, err := service.SearchTranscripts(SearchOptions{Query: "launches"})
+		require.NoError(t, err)
+		assert.Equal(t, 0, response.Total)
+
+		_, err = session.Update().ClearDeletedAt().Save(helper.Ctx)
+		require.NoError(t, err)
+
+		response, err = service.SearchTranscripts(SearchOptions{Query: "launches"})
+		require.NoError(t, err)
+		assert.Equal(t, 1, response.Total)
+	})
+
+	t.Run("validation", func(t *testing.T) {
+		_, err := service.SearchTranscripts(SearchOptions{Query: "   "})
+		assert.Error(t, err)
+
+		_, err = service.SearchTranscripts(SearchOptions{Query: "pricing", Mode: "fuzzy"})
+		assert.Error(t, err)
+
+		_, err = service.SearchTranscripts(SearchOptions{Query: "pricing", Mode: ModeSemantic})
+		assert.Error(t, err)
+	})
+}
+
+func TestSearchTranscripts_Semantic(t *testing.T) {
+	helper := goapp.NewTestHelper(t)
+	project := helper.CreateTestProject("Semantic")
+
+	createTranscribedClip(t, helper, project, "money", "we discuss cost and price and cost again")
+	createTranscribedClip(t, helper, project, "travel", "we discuss trains and planes")
+
+	service := NewSearchService(helper.Client, helper.Ctx)
+	embedder := &fakeEmbedder{keywords: []string{"cost", "price", "trains"}}
+
+	response, err := service.SearchTranscriptsWithAIService(SearchOptions{Query: "price cost", ProjectID: project.ID, Mode: ModeSemantic}, embedder)
+
+	require.NoError(t, err)
+	require.NotEmpty(t, response.Results)
+	assert.Equal(t, "money", response.Results[0].ClipName)
+	assert.Equal(t, ModeSemantic, response.Results[0].Mode)
+	assert.Equal(t, "Semantic", response.Results[0].ProjectName)
+
+	stored, err := helper.Client.TranscriptEmbedding.Query().Count(helper.Ctx)
+	require.NoError(t, err)
+	assert.Equal(t, 2, stored)
+
+	// Embeddings are reused until the transcript changes
+	embedded, err := service.BuildSemanticIndex(project.ID, embedder)
+	require.NoError(t, err)
+	assert.Equal(t, 0, embedded)
+}
@@ -0,0 +1,249 @@
+package search
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
+	"log"
+	"math"
+	"sort"
+
+	"ramble-ai/ent"
+	"ramble-ai/ent/project"
+	"ramble-ai/ent/schema"
+	"ramble-ai/ent/transcriptembedding"
+	"ramble-ai/ent/videoclip"
+	"ramble-ai/goapp/ai"
+)
+
+const (
+	// embeddingChunkWords is the number of transcript words per embedded chunk
+	embeddingChunkWords = 60
+	// embeddingChunkOverlap is the number of words shared by consecutive chunks
+	embeddingChunkOverlap = 15
+	// embeddingBatchSize is the number of chunks sent per embeddings request
+	embeddingBatchSize = 64
+)
+
+// transcriptChunk is a window of transcript words to be embedded
+type transcriptChunk struct {
+	startWord int
+	endWord   int // exclusive
+	text      string
+}
+
+// chunkTranscript splits transcript words into overlapping windows
+func chunkTranscript(words []schema.Word, size, overlap int) []transcriptChunk {
+	if size <= 0 || overlap >= size {
+		return nil
+	}
+
+	var chunks []transcriptChunk
+	for start := 0; start < len(words); start += size - overlap {
+		end := start + size
+		if end > len(words) {
+			end = len(words)
+		}
+		chunks = append(chunks, transcriptChunk{
+			startWord: start,
+			endWord:   end,
+			text:      joinWords(words[start:end]),
+		})
+		if end == len(words) {
+			break
+		}
+	}
+	return chunks
+}
+
+// transcriptHash identifies the transcript content an embedding was built from
+func transcriptHash(words []schema.Word) string {
+	hash := sha256.New()
+	for _, word := range words {
+		fmt.Fprintf(hash, "%s|%.3f|%.3f\n", word.Word, word.Start, word.End)
+	}
+	return hex.EncodeToString(hash.Sum(nil))
+}
+
+// cosineSimilarity returns the cosine similarity of two vectors (0 if either is empty or lengths differ)
+func cosineSimilarity(a, b []float32) float64 {
+	if len(a) == 0 || len(a) != len(b) {
+		return 0
+	}
+
+	var dot, normA, normB float64
+	for i := range a {
+		dot += float64(a[i]) * float64(b[i])
+		normA += float64(a[i]) * float64(a[i])
+		normB += float64(b[i]) * float64(b[i])
+	}
+	if normA == 0 || normB == 0 {
+		return 0
+	}
+	return dot / (math.Sqrt(normA) * math.Sqrt(normB))
+}
+
+// BuildSemanticIndex embeds the transcripts of every clip in a project (0 for all projects) that is missing
+// or has stale embeddings. Returns the number of clips that were (re)embedded.
+func (s *SearchService) BuildSemanticIndex(projectID int, aiService ai.AIService) (int, error) {
+	query := s.client.VideoClip.Query().Where(videoclip.TranscriptionWordsNotNil(), videoclip.DeletedAtIsNil())
+	if projectID != 0 {
+		query = query.Where(videoclip.HasProjectWith(project.ID(projectID)))
+	}
+
+	clips, err := query.All(s.ctx)
+	if err != nil {
+		return 0, fmt.Errorf("failed to query video clips: %w", err)
+	}
+
+	embedded := 0
+	for _, clip := range clips {
+		updated, err := s.ensureClipEmbeddings(clip, aiService)
+		if err != nil {
+			return embedded, fmt.Errorf("failed to embed clip %d: %w", clip.ID, err)
+		}
+		if updated {
+			embedded++
+		}
+	}
+
+	log.Printf("[SEARCH] Semantic index ready for project %d (%d clips embedded)", projectID, embedded)
+	return embedded, nil
+}
+
+// ensureClipEmbeddings (re)embeds a clip transcript if its stored embeddings are missing or stale
+func (s *SearchService) ensureClipEmbeddings(clip *ent.VideoClip, aiService ai.AIService) (bool, error) {
+	if len(clip.TranscriptionWords) == 0 {
+		return false, nil
+	}
+
+	sourceHash := transcriptHash(clip.TranscriptionWords)
+
+	upToDate, err := s.client.TranscriptEmbedding.Query().
+		Where(
+			transcriptembedding.VideoClipID(clip.ID),
+			transcriptembedding.Model(ai.DefaultEmbeddingModel),
+			transcriptembedding.SourceHash(sourceHash),
+		).
+		Exist(s.ctx)
+	if err != nil {
+		return false, fmt.Errorf("failed to check existing embeddings: %w", err)
+	}
+	if upToDate {
+		return false, nil
+	}
+
+	chunks := chunkTranscript(clip.TranscriptionWords, embeddingChunkWords, embeddingChunkOverlap)
+	vectors := make([][]float32, 0, len(chunks))
+	for start := 0; start < len(chunks); start += embeddingBatchSize {
+		end := start + embeddingBatchSize
+		if end > len(chunks) {
+			end = len(chunks)
+		}
+
+		input := make([]string, 0, end-start)
+		for _, chunk := range chunks[start:end] {
+			input = append(input, chunk.text)
+		}
+
+		result, err := aiService.ProcessEmbeddings(&ai.EmbeddingRequest{Input: input, Model: ai.DefaultEmbeddingModel})
+		if err != nil {
+			return false, err
+		}
+		if len(result.Embeddings) != len(input) {
+			return false, fmt.Errorf("expected %d embeddings, got %d", len(input), len(result.Embeddings))
+		}
+		vectors = append(vectors, result.Embeddings...)
+	}
+
+	// Replace the clip's embeddings atomically
+	tx, err := s.client.Tx(s.ctx)
+	if err != nil {
+		return false, fmt.Errorf("failed to start transaction: %w", err)
+	}
+
+	_, err = tx.TranscriptEmbedding.Delete().
+		Where(
+			transcriptembedding.VideoClipID(clip.ID),
+			transcriptembedding.Model(ai.DefaultEmbeddingModel),
+		).
+		Exec(s.ctx)
+	if err != nil {
+		tx.Rollback()
+		return false, fmt.Errorf("failed to delete stale embeddings: %w", err)
+	}
+
+	builders := make([]*ent.TranscriptEmbeddingCreate, len(chunks))
+	for i, chunk := range chunks {
+		builders[i] = tx.TranscriptEmbedding.Create().
+			SetVideoClipID(clip.ID).
+			SetChunkIndex(i).
+			SetStartWord(chunk.startWord).
+			SetEndWord(chunk.endWord).
+			SetStartTime(clip.TranscriptionWords[chunk.startWord].Start).
+			SetEndTime(clip.TranscriptionWords[chunk.endWord-1].End).
+			SetText(chunk.text).
+			SetModel(ai.DefaultEmbeddingModel).
+			SetSourceHash(sourceHash).
+			SetEmbedding(vectors[i])
+	}
+	if _, err := tx.TranscriptEmbedding.CreateBulk(builders...).Save(s.ctx); err != nil {
+		tx.Rollback()
+		return false, fmt.Errorf("failed to save embeddings: %w", err)
+	}
+
+	if err := tx.Commit(); err != nil {
+		return false, fmt.Errorf("failed to commit transaction: %w", err)
+	}
+
+	log.Printf("[SEARCH] Embedded clip %d (%d chunks)", clip.ID, len(chunks))
+	return true, nil
+}
+
+// semanticSearch ranks transcript chunks by similarity to the query
+func (s *SearchService) semanticSearch(opts SearchOptions, aiService ai.AIService) ([]SearchResult, error) {
+	if _, err := s.BuildSemanticIndex(opts.ProjectID, aiService); err != nil {
+		return nil, err
+	}
+	if err := s.RefreshIndex(); err != nil {
+		return nil, err
+	}
+
+	queryResult, err := aiService.ProcessEmbeddings(&ai.EmbeddingRequest{Input: []string{opts.Query}, Model: ai.DefaultEmbeddingModel})
+	if err != nil {
+		return nil, fmt.Errorf("failed to embed query: %w", err)
+	}
+	if len(queryResult.Embeddings) != 1 {
+		return nil, fmt.Errorf("expected 1 query embedding, got %d", len(queryResult.Embeddings))
+	}
+	queryVector := queryResult.Embeddings[0]
+
+	query := s.client.TranscriptEmbedding.Query().
+		Where(transcriptembedding.Model(ai.DefaultEmbeddingModel))
+	if opts.ProjectID != 0 {
+		query = query.Where(transcriptembedding.HasVideoClipWith(videoclip.HasProjectWith(project.ID(opts.ProjectID))))
+	}
+	chunks, err := query.All(s.ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to load embeddings: %w", err)
+	}
+
+	s.index.mu.RLock()
+	defer s.index.mu.RUnlock()
+
+	results := make([]SearchResult, 0, len(chunks))
+	for _, chunk := range chunks {
+		clip := s.index.clips[chunk.VideoClipID]
+		if clip == nil || chunk.EndWord > len(clip.words) {
+			continue
+		}
+		score := cosineSimilarity(queryVector, chunk.Embedding)
+		results = append(results, buildResult(clip, chunk.StartWord, chunk.EndWord, opts.ContextWords, score, ModeSemantic))
+	}
+
+	sort.SliceStable(results, func(i, j int) bool {
+		return results[i].Score > results[j].Score
+	})
+
+	return dedupeOverlapping(results), nil
+}
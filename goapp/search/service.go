@@ -0,0 +1,243 @@
+package search
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"strings"
+
+	"ramble-ai/ent"
+	"ramble-ai/ent/project"
+	"ramble-ai/ent/videoclip"
+	"ramble-ai/goapp/ai"
+)
+
+const (
+	// ModeText is exact word/phrase search over the inverted index
+	ModeText = "text"
+	// ModeSemantic is embeddings-based similarity search
+	ModeSemantic = "semantic"
+
+	// DefaultLimit is the maximum number of results returned when no limit is given
+	DefaultLimit = 50
+	// DefaultContextWords is the number of words shown on each side of a hit
+	DefaultContextWords = 12
+)
+
+// SearchOptions configures a transcript search
+type SearchOptions struct {
+	Query        string `json:"query"`
+	ProjectID    int    `json:"projectId,omitempty"` // 0 searches every project
+	Mode         string `json:"mode,omitempty"`      // "text" (default) or "semantic"
+	Limit        int    `json:"limit,omitempty"`
+	ContextWords int    `json:"contextWords,omitempty"`
+}
+
+// SearchResult is a single transcript hit
+type SearchResult struct {
+	ProjectID     int     `json:"projectId"`
+	ProjectName   string  `json:"projectName"`
+	ClipID        int     `json:"clipId"`
+	ClipName      string  `json:"clipName"`
+	FilePath      string  `json:"filePath"`
+	StartWord     int     `json:"startWord"`
+	EndWord       int     `json:"endWord"` // exclusive
+	Start         float64 `json:"start"`
+	End           float64 `json:"end"`
+	MatchText     string  `json:"matchText"`
+	ContextBefore string  `json:"contextBefore"`
+	ContextAfter  string  `json:"contextAfter"`
+	Score         float64 `json:"score"`
+	Mode          string  `json:"mode"`
+}
+
+// SearchResponse is the result of a transcript search
+type SearchResponse struct {
+	Query   string         `json:"query"`
+	Mode    string         `json:"mode"`
+	Total   int            `json:"total"`
+	Results []SearchResult `json:"results"`
+}
+
+// SearchService provides search across project transcripts
+type SearchService struct {
+	client *ent.Client
+	ctx    context.Context
+	index  *TranscriptIndex
+}
+
+// NewSearchService creates a new search service
+func NewSearchService(client *ent.Client, ctx context.Context) *SearchService {
+	return &SearchService{
+		client: client,
+		ctx:    ctx,
+		index:  indexFor(client),
+	}
+}
+
+// SearchTranscripts runs a text search. Semantic mode requires an AI service, see SearchTranscriptsWithAIService.
+func (s *SearchService) SearchTranscripts(opts SearchOptions) (*SearchResponse, error) {
+	if opts.Mode == ModeSemantic {
+		return nil, fmt.Errorf("semantic search requires an AI service")
+	}
+	return s.SearchTranscriptsWithAIService(opts, nil)
+}
+
+// SearchTranscriptsWithAIService runs a text or semantic search across transcripts
+func (s *SearchService) SearchTranscriptsWithAIService(opts SearchOptions, aiService ai.AIService) (*SearchResponse, error) {
+	opts.Query = strings.TrimSpace(opts.Query)
+	if opts.Query == "" {
+		return nil, fmt.Errorf("search query cannot be empty")
+	}
+	if opts.Mode == "" {
+		opts.Mode = ModeText
+	}
+	if opts.Limit <= 0 {
+		opts.Limit = DefaultLimit
+	}
+	if opts.ContextWords <= 0 {
+		opts.ContextWords = DefaultContextWords
+	}
+
+	var results []SearchResult
+	var err error
+
+	switch opts.Mode {
+	case ModeText:
+		if err = s.RefreshIndex(); err != nil {
+			return nil, err
+		}
+		results = s.index.search(parseQuery(opts.Query), opts.ProjectID, opts.ContextWords)
+	case ModeSemantic:
+		if aiService == nil {
+			return nil, fmt.Errorf("semantic search requires an AI service")
+		}
+		results, err = s.semanticSearch(opts, aiService)
+		if err != nil {
+			return nil, err
+		}
+	default:
+		return nil, fmt.Errorf("unknown search mode: %s", opts.Mode)
+	}
+
+	total := len(results)
+	if len(results) > opts.Limit {
+		results = results[:opts.Limit]
+	}
+
+	if err := s.attachProjectNames(results); err != nil {
+		return nil, err
+	}
+
+	log.Printf("[SEARCH] %s query %q (project %d): %d results", opts.Mode, opts.Query, opts.ProjectID, total)
+
+	return &SearchResponse{
+		Query:   opts.Query,
+		Mode:    opts.Mode,
+		Total:   total,
+		Results: results,
+	}, nil
+}
+
+// RefreshIndex brings the in-memory index up to date, reloading only clips that changed since they were indexed
+func (s *SearchService) RefreshIndex() error {
+	current, err := s.client.VideoClip.Query().
+		Where(videoclip.DeletedAtIsNil()).
+		Select(videoclip.FieldID, videoclip.FieldUpdatedAt).
+		All(s.ctx)
+	if err != nil {
+		return fmt.Errorf("failed to query video clips: %w", err)
+	}
+
+	indexed := s.index.clipStamps()
+
+	var changedIDs []int
+	for _, clip := range current {
+		if stamp, exists := indexed[clip.ID]; !exists || !stamp.Equal(clip.UpdatedAt) {
+			changedIDs = append(changedIDs, clip.ID)
+		}
+		delete(indexed, clip.ID)
+	}
+
+	// Anything left in indexed was deleted or moved to the trash
+	for clipID := range indexed {
+		s.index.removeClip(clipID)
+	}
+
+	if len(changedIDs) == 0 {
+		return nil
+	}
+
+	clips, err := s.client.VideoClip.Query().
+		Where(videoclip.IDIn(changedIDs...)).
+		WithProject().
+		All(s.ctx)
+	if err != nil {
+		return fmt.Errorf("failed to load video clips for indexing: %w", err)
+	}
+
+	for _, clip := range clips {
+		s.index.upsertClip(toIndexedClip(clip))
+	}
+
+	log.Printf("[SEARCH] Indexed %d changed clips", len(clips))
+	return nil
+}
+
+// RebuildIndex discards the in-memory index and indexes every clip again
+func (s *SearchService) RebuildIndex() error {
+	indexesMu.Lock()
+	s.index = NewTranscriptIndex()
+	indexes[s.client] = s.index
+	indexesMu.Unlock()
+
+	return s.RefreshIndex()
+}
+
+// toIndexedClip converts a clip entity into its indexed form
+func toIndexedClip(clip *ent.VideoClip) *indexedClip {
+	indexed := &indexedClip{
+		id:        clip.ID,
+		name:      clip.Name,
+		filePath:  clip.FilePath,
+		words:     clip.TranscriptionWords,
+		updatedAt: clip.UpdatedAt,
+	}
+	if clip.Edges.Project != nil {
+		indexed.projectID = clip.Edges.Project.ID
+	}
+	return indexed
+}
+
+// attachProjectNames fills in project names at query time so renamed projects never show stale names
+func (s *SearchService) attachProjectNames(results []SearchResult) error {
+	if len(results) == 0 {
+		return nil
+	}
+
+	idSet := make(map[int]bool)
+	var ids []int
+	for _, result := range results {
+		if !idSet[result.ProjectID] {
+			idSet[result.ProjectID] = true
+			ids = append(ids, result.ProjectID)
+		}
+	}
+
+	projects, err := s.client.Project.Query().
+		Where(project.IDIn(ids...)).
+		Select(project.FieldID, project.FieldName).
+		All(s.ctx)
+	if err != nil {
+		return fmt.Errorf("failed to load project names: %w", err)
+	}
+
+	names := make(map[int]string, len(projects))
+	for _, p := range projects {
+		names[p.ID] = p.Name
+	}
+	for i := range results {
+		results[i].ProjectName = names[results[i].ProjectID]
+	}
+	return nil
+}
@@ -3,6 +3,7 @@ package settings
 import (
 	"context"
 	"fmt"
+	"strconv"
 
 	"ramble-ai/ent"
 	"ramble-ai/ent/settings"
@@ -117,4 +118,32 @@ func (s *SettingsService) GetThemePreference() (string, error) {
 	return theme, nil
 }
 
+// DefaultTrashRetentionDays is how long trashed projects and clips are kept when no retention is configured
+const DefaultTrashRetentionDays = 30
+
+// SaveTrashRetentionDays sets how many days trashed items are kept before being purged (0 keeps them forever)
+func (s *SettingsService) SaveTrashRetentionDays(days int) error {
+	if days < 0 {
+		return fmt.Errorf("trash retention cannot be negative")
+	}
+	return s.SaveSetting("trash_retention_days", strconv.Itoa(days))
+}
+
+// GetTrashRetentionDays retrieves the trash retention in days, defaults to DefaultTrashRetentionDays
+func (s *SettingsService) GetTrashRetentionDays() (int, error) {
+	value, err := s.GetSetting("trash_retention_days")
+	if err != nil {
+		return DefaultTrashRetentionDays, err
+	}
+	if value == "" {
+		return DefaultTrashRetentionDays, nil
+	}
+
+	days, err := strconv.Atoi(value)
+	if err != nil || days < 0 {
+		return DefaultTrashRetentionDays, fmt.Errorf("invalid trash retention setting: %s", value)
+	}
+	return days, nil
+}
+
 // FFmpeg database settings removed - FFmpeg is now bundled in the app
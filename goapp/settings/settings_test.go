@@ -198,6 +198,27 @@ func TestGetThemePreference_NotSet(t *testing.T) {
 	assert.Equal(t, "light", theme) // Default theme per implementation
 }
 
+func TestTrashRetentionDays(t *testing.T) {
+	helper := goapp.NewTestHelper(t)
+	service := NewSettingsService(helper.Client, helper.Ctx)
+
+	days, err := service.GetTrashRetentionDays()
+	require.NoError(t, err)
+	assert.Equal(t, DefaultTrashRetentionDays, days)
+
+	require.NoError(t, service.SaveTrashRetentionDays(0))
+	days, err = service.GetTrashRetentionDays()
+	require.NoError(t, err)
+	assert.Equal(t, 0, days)
+
+	assert.Error(t, service.SaveTrashRetentionDays(-1))
+
+	require.NoError(t, service.SaveSetting("trash_retention_days", "soon"))
+	days, err = service.GetTrashRetentionDays()
+	assert.Error(t, err)
+	assert.Equal(t, DefaultTrashRetentionDays, days)
+}
+
 func TestSettingsIntegration(t *testing.T) {
 	helper := goapp.NewTestHelper(t)
 	service := NewSettingsService(helper.Client, helper.Ctx)
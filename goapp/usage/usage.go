@@ -0,0 +1,324 @@
+package usage
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log"
+	"strconv"
+	"strings"
+	"time"
+
+	"ramble-ai/ent"
+	"ramble-ai/ent/usagerecord"
+	"ramble-ai/goapp/settings"
+)
+
+// Providers whose calls are metered
+const (
+	ProviderOpenRouter       = "openrouter"
+	ProviderOpenAI           = "openai"
+	ProviderAnthropic        = "anthropic"
+	ProviderOpenAICompatible = "openai_compatible"
+)
+
+// TaskTranscription is the task type of Whisper transcription calls
+const TaskTranscription = "transcription"
+
+// monthlyBudgetKey is the setting holding the monthly AI budget cap in US dollars
+const monthlyBudgetKey = "ai_monthly_budget_usd"
+
+// monthFormat is the layout of month keys in summaries
+const monthFormat = "2006-01"
+
+// ErrBudgetExceeded is returned when the monthly AI budget cap has been reached
+var ErrBudgetExceeded = errors.New("AI budget cap reached")
+
+// UsageService records AI usage and enforces the monthly budget cap
+type UsageService struct {
+	client *ent.Client
+	ctx    context.Context
+}
+
+// NewUsageService creates a new usage service
+func NewUsageService(client *ent.Client, ctx context.Context) *UsageService {
+	return &UsageService{
+		client: client,
+		ctx:    ctx,
+	}
+}
+
+// Entry is one metered AI call
+type Entry struct {
+	ProjectID        int
+	Provider         string
+	TaskType         string
+	Model            string
+	PromptTokens     int
+	CompletionTokens int
+	TotalTokens      int
+	AudioSeconds     float64
+	CostUSD          *float64    // cost reported by the provider, nil = estimate from Price
+	Price            *ModelPrice // the model's list price, nil = defaultModelPrice
+}
+
+// UsageTotals adds up the usage of a group of calls
+type UsageTotals struct {
+	Calls            int     `json:"calls"`
+	PromptTokens     int     `json:"promptTokens"`
+	CompletionTokens int     `json:"completionTokens"`
+	TotalTokens      int     `json:"totalTokens"`
+	AudioMinutes     float64 `json:"audioMinutes"`
+	CostUSD          float64 `json:"costUsd"`
+}
+
+// UsageSummary is the usage of a project or a month, broken down by task type and model
+type UsageSummary struct {
+	UsageTotals
+	ProjectID     int                    `json:"projectId,omitempty"`
+	Month         string                 `json:"month,omitempty"` // YYYY-MM
+	CostEstimated bool                   `json:"costEstimated"`   // some costs are estimated from model prices
+	ByTaskType    map[string]UsageTotals `json:"byTaskType"`
+	ByModel       map[string]UsageTotals `json:"byModel"`
+}
+
+// BudgetStatus is the monthly budget cap and this month's spend against it
+type BudgetStatus struct {
+	MonthlyCapUSD float64 `json:"monthlyCapUsd"` // 0 = no cap
+	SpentUSD      float64 `json:"spentUsd"`
+	RemainingUSD  float64 `json:"remainingUsd"` // 0 when there is no cap
+	Month         string  `json:"month"`
+	Exceeded      bool    `json:"exceeded"`
+}
+
+// Record adds a call to the usage ledger, estimating its cost when the provider did not report one
+func (s *UsageService) Record(entry Entry) error {
+	totalTokens := entry.TotalTokens
+	if totalTokens == 0 {
+		totalTokens = entry.PromptTokens + entry.CompletionTokens
+	}
+
+	estimated := entry.CostUSD == nil
+	var cost float64
+	if estimated {
+		cost = EstimateCost(entry.Price, entry.PromptTokens, entry.CompletionTokens, entry.AudioSeconds)
+	} else {
+		cost = *entry.CostUSD
+	}
+
+	create := s.client.UsageRecord.
+		Create().
+		SetProvider(entry.Provider).
+		SetTaskType(entry.TaskType).
+		SetModel(entry.Model).
+		SetPromptTokens(entry.PromptTokens).
+		SetCompletionTokens(entry.CompletionTokens).
+		SetTotalTokens(totalTokens).
+		SetAudioSeconds(entry.AudioSeconds).
+		SetCostUsd(cost).
+		SetCostEstimated(estimated)
+	if entry.ProjectID != 0 {
+		create.SetProjectID(entry.ProjectID)
+	}
+
+	if _, err := create.Save(s.ctx); err != nil {
+		return fmt.Errorf("failed to record AI usage: %w", err)
+	}
+
+	log.Printf("[USAGE] %s %s (%s) project %d: %d tokens, %.1fs audio, $%.4f", entry.Provider, entry.TaskType, entry.Model, entry.ProjectID, totalTokens, entry.AudioSeconds, cost)
+	return nil
+}
+
+// CheckBudget returns an error wrapping ErrBudgetExceeded when this month's spend has reached the budget cap
+func (s *UsageService) CheckBudget() error {
+	status, err := s.GetBudgetStatus()
+	if err != nil {
+		return err
+	}
+	if status.Exceeded {
+		return fmt.Errorf("%w: spent $%.2f of the $%.2f monthly budget for %s; raise the budget in settings to continue",
+			ErrBudgetExceeded, status.SpentUSD, status.MonthlyCapUSD, status.Month)
+	}
+	return nil
+}
+
+// GetBudgetStatus returns the monthly budget cap and this month's spend
+func (s *UsageService) GetBudgetStatus() (*BudgetStatus, error) {
+	capUSD, err := s.GetMonthlyBudget()
+	if err != nil {
+		return nil, err
+	}
+
+	start := monthStart(time.Now())
+	records, err := s.client.UsageRecord.
+		Query().
+		Where(usagerecord.CreatedAtGTE(start)).
+		All(s.ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get AI usage: %w", err)
+	}
+
+	status := &BudgetStatus{
+		MonthlyCapUSD: capUSD,
+		Month:         start.Format(monthFormat),
+	}
+	for _, record := range records {
+		status.SpentUSD += record.CostUsd
+	}
+	if capUSD > 0 {
+		status.RemainingUSD = max(capUSD-status.SpentUSD, 0)
+		status.Exceeded = status.SpentUSD >= capUSD
+	}
+	return status, nil
+}
+
+// GetMonthlyBudget returns the monthly budget cap in US dollars, 0 when there is none
+func (s *UsageService) GetMonthlyBudget() (float64, error) {
+	value, err := settings.NewSettingsService(s.client, s.ctx).GetSetting(monthlyBudgetKey)
+	if err != nil {
+		return 0, err
+	}
+	if strings.TrimSpace(value) == "" {
+		return 0, nil
+	}
+
+	capUSD, err := strconv.ParseFloat(value, 64)
+	if err != nil {
+		return 0, fmt.Errorf("invalid monthly AI budget %q: %w", value, err)
+	}
+	return capUSD, nil
+}
+
+// SetMonthlyBudget sets the monthly budget cap in US dollars. 0 removes the cap.
+func (s *UsageService) SetMonthlyBudget(capUSD float64) error {
+	if capUSD < 0 {
+		return fmt.Errorf("monthly AI budget cannot be negative")
+	}
+
+	value := ""
+	if capUSD > 0 {
+		value = strconv.FormatFloat(capUSD, 'f', -1, 64)
+	}
+	return settings.NewSettingsService(s.client, s.ctx).SaveSetting(monthlyBudgetKey, value)
+}
+
+// GetProjectUsageSummary returns the usage of every call made for a project
+func (s *UsageService) GetProjectUsageSummary(projectID int) (*UsageSummary, error) {
+	records, err := s.client.UsageRecord.
+		Query().
+		Where(usagerecord.ProjectID(projectID)).
+		All(s.ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get project AI usage: %w", err)
+	}
+
+	summary := newUsageSummary()
+	summary.ProjectID = projectID
+	for _, record := range records {
+		summary.add(record)
+	}
+	return summary, nil
+}
+
+// GetMonthlyUsageSummaries returns the usage of the given number of months up to and including
+// the current one, newest first. Months without calls are included with zero usage.
+func (s *UsageService) GetMonthlyUsageSummaries(months int) ([]*UsageSummary, error) {
+	if months <= 0 {
+		return nil, fmt.Errorf("number of months must be positive")
+	}
+
+	current := monthStart(time.Now())
+	start := current.AddDate(0, -(months - 1), 0)
+	records, err := s.client.UsageRecord.
+		Query().
+		Where(usagerecord.CreatedAtGTE(start)).
+		All(s.ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get AI usage: %w", err)
+	}
+
+	byMonth := make(map[string]*UsageSummary, months)
+	summaries := make([]*UsageSummary, 0, months)
+	for month := current; !month.Before(start); month = month.AddDate(0, -1, 0) {
+		summary := newUsageSummary()
+		summary.Month = month.Format(monthFormat)
+		byMonth[summary.Month] = summary
+		summaries = append(summaries, summary)
+	}
+
+	for _, record := range records {
+		if summary, ok := byMonth[record.CreatedAt.Local().Format(monthFormat)]; ok {
+			summary.add(record)
+		}
+	}
+	return summaries, nil
+}
+
+// newUsageSummary creates an empty summary
+func newUsageSummary() *UsageSummary {
+	return &UsageSummary{
+		ByTaskType: map[string]UsageTotals{},
+		ByModel:    map[string]UsageTotals{},
+	}
+}
+
+// add counts a ledger record in the summary and its breakdowns
+func (s *UsageSummary) add(record *ent.UsageRecord) {
+	s.UsageTotals.add(record)
+	s.CostEstimated = s.CostEstimated || record.CostEstimated
+
+	taskTotals := s.ByTaskType[record.TaskType]
+	taskTotals.add(record)
+	s.ByTaskType[record.TaskType] = taskTotals
+
+	model := record.Model
+	if model == "" {
+		model = "unknown"
+	}
+	modelTotals := s.ByModel[model]
+	modelTotals.add(record)
+	s.ByModel[model] = modelTotals
+}
+
+// add counts a ledger record in the totals
+func (t *UsageTotals) add(record *ent.UsageRecord) {
+	t.Calls++
+	t.PromptTokens += record.PromptTokens
+	t.CompletionTokens += record.CompletionTokens
+	t.TotalTokens += record.TotalTokens
+	t.AudioMinutes += record.AudioSeconds / 60
+	t.CostUSD += record.CostUsd
+}
+
+// monthStart returns the first instant of the local month containing t
+func monthStart(t time.Time) time.Time {
+	t = t.Local()
+	return time.Date(t.Year(), t.Month(), 1, 0, 0, 0, 0, time.Local)
+}
+
+// ModelPrice is the price of a model in US dollars per million tokens
+type ModelPrice struct {
+	Input  float64
+	Output float64
+}
+
+// defaultModelPrice is used for models without a known price. It errs on the expensive side so
+// the budget cap is not overrun by unknown models.
+var defaultModelPrice = ModelPrice{Input: 3, Output: 15}
+
+// whisperPricePerMinute is the price of Whisper transcription in US dollars per audio minute
+const whisperPricePerMinute = 0.006
+
+// EstimateCost estimates the cost of a call in US dollars from its token counts and audio length.
+// Tokens are priced at price, or at defaultModelPrice when the model's price is unknown.
+func EstimateCost(price *ModelPrice, promptTokens, completionTokens int, audioSeconds float64) float64 {
+	cost := audioSeconds / 60 * whisperPricePerMinute
+	if promptTokens == 0 && completionTokens == 0 {
+		return cost
+	}
+
+	if price == nil {
+		price = &defaultModelPrice
+	}
+	return cost + (float64(promptTokens)*price.Input+float64(completionTokens)*price.Output)/1_000_000
+}
@@ -0,0 +1,129 @@
+package usage
+
+import (
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"ramble-ai/goapp"
+)
+
+func setupUsageService(t *testing.T) (*goapp.TestHelper, *UsageService) {
+	helper := goapp.NewTestHelper(t)
+	return helper, NewUsageService(helper.Client, helper.Ctx)
+}
+
+func cost(usd float64) *float64 {
+	return &usd
+}
+
+func TestEstimateCost(t *testing.T) {
+	tests := []struct {
+		name             string
+		price            *ModelPrice
+		promptTokens     int
+		completionTokens int
+		audioSeconds     float64
+		expected         float64
+	}{
+		{"model price", &ModelPrice{Input: 0.8, Output: 4}, 1_000_000, 100_000, 0, 1.2},
+		{"free model", &ModelPrice{}, 1_000_000, 1_000_000, 0, 0},
+		{"unknown price uses default", nil, 100_000, 10_000, 0, 0.45},
+		{"whisper minutes", nil, 0, 0, 600, 0.06},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.InDelta(t, tt.expected, EstimateCost(tt.price, tt.promptTokens, tt.completionTokens, tt.audioSeconds), 1e-9)
+		})
+	}
+}
+
+func TestProjectUsageSummary(t *testing.T) {
+	helper, service := setupUsageService(t)
+	project := helper.CreateTestProject("Metered")
+	other := helper.CreateTestProject("Other")
+
+	require.NoError(t, service.Record(Entry{ProjectID: project.ID, Provider: ProviderOpenRouter, TaskType: "reorder", Model: "anthropic/claude-sonnet-4", PromptTokens: 1000, CompletionTokens: 200, CostUSD: cost(0.01)}))
+	require.NoError(t, service.Record(Entry{ProjectID: project.ID, Provider: ProviderOpenRouter, TaskType: "reorder", Model: "anthropic/claude-sonnet-4", PromptTokens: 2000, CompletionTokens: 100, TotalTokens: 2100, CostUSD: cost(0.02)}))
+	require.NoError(t, service.Record(Entry{ProjectID: project.ID, Provider: ProviderOpenAI, TaskType: TaskTranscription, Model: "whisper-1", AudioSeconds: 90}))
+	require.NoError(t, service.Record(Entry{ProjectID: other.ID, Provider: ProviderOpenRouter, TaskType: "chat", Model: "openai/gpt-4o", TotalTokens: 50, CostUSD: cost(1)}))
+
+	summary, err := service.GetProjectUsageSummary(project.ID)
+	require.NoError(t, err)
+	assert.Equal(t, project.ID, summary.ProjectID)
+	assert.Equal(t, 3, summary.Calls)
+	assert.Equal(t, 3300, summary.TotalTokens, "missing totals are the sum of prompt and completion tokens")
+	assert.InDelta(t, 1.5, summary.AudioMinutes, 1e-9)
+	assert.InDelta(t, 0.039, summary.CostUSD, 1e-9)
+	assert.True(t, summary.CostEstimated)
+
+	require.Contains(t, summary.ByTaskType, "reorder")
+	assert.Equal(t, 2, summary.ByTaskType["reorder"].Calls)
+	assert.InDelta(t, 0.03, summary.ByTaskType["reorder"].CostUSD, 1e-9)
+	assert.InDelta(t, 0.009, summary.ByModel["whisper-1"].CostUSD, 1e-9)
+	assert.NotContains(t, summary.ByModel, "openai/gpt-4o")
+
+	empty, err := service.GetProjectUsageSummary(999)
+	require.NoError(t, err)
+	assert.Zero(t, empty.Calls)
+	assert.False(t, empty.CostEstimated)
+}
+
+func TestMonthlyUsageSummaries(t *testing.T) {
+	helper, service := setupUsageService(t)
+
+	require.NoError(t, service.Record(Entry{Provider: ProviderOpenRouter, TaskType: "chat", Model: "openai/gpt-4o", CostUSD: cost(0.5)}))
+	lastMonth := monthStart(time.Now()).AddDate(0, -1, 3)
+	_, err := helper.Client.UsageRecord.Create().
+		SetProvider(ProviderOpenRouter).
+		SetTaskType("suggest_highlights").
+		SetCostUsd(0.25).
+		SetCreatedAt(lastMonth).
+		Save(helper.Ctx)
+	require.NoError(t, err)
+
+	summaries, err := service.GetMonthlyUsageSummaries(3)
+	require.NoError(t, err)
+	require.Len(t, summaries, 3)
+	assert.Equal(t, time.Now().Format(monthFormat), summaries[0].Month)
+	assert.Equal(t, lastMonth.Format(monthFormat), summaries[1].Month)
+	assert.InDelta(t, 0.5, summaries[0].CostUSD, 1e-9)
+	assert.InDelta(t, 0.25, summaries[1].CostUSD, 1e-9)
+	assert.Equal(t, 1, summaries[1].ByModel["unknown"].Calls)
+	assert.Zero(t, summaries[2].Calls)
+
+	_, err = service.GetMonthlyUsageSummaries(0)
+	assert.EqualError(t, err, "number of months must be positive")
+}
+
+func TestBudgetCap(t *testing.T) {
+	_, service := setupUsageService(t)
+
+	status, err := service.GetBudgetStatus()
+	require.NoError(t, err)
+	assert.Zero(t, status.MonthlyCapUSD)
+	assert.NoError(t, service.CheckBudget(), "no cap by default")
+
+	require.NoError(t, service.SetMonthlyBudget(1))
+	require.NoError(t, service.Record(Entry{Provider: ProviderOpenRouter, TaskType: "chat", Model: "openai/gpt-4o", CostUSD: cost(0.75)}))
+	require.NoError(t, service.CheckBudget())
+
+	status, err = service.GetBudgetStatus()
+	require.NoError(t, err)
+	assert.InDelta(t, 0.25, status.RemainingUSD, 1e-9)
+	assert.False(t, status.Exceeded)
+
+	require.NoError(t, service.Record(Entry{Provider: ProviderOpenRouter, TaskType: "chat", Model: "openai/gpt-4o", CostUSD: cost(0.5)}))
+	err = service.CheckBudget()
+	require.Error(t, err)
+	assert.True(t, errors.Is(err, ErrBudgetExceeded))
+	assert.Contains(t, err.Error(), "spent $1.25 of the $1.00 monthly budget")
+
+	assert.EqualError(t, service.SetMonthlyBudget(-1), "monthly AI budget cannot be negative")
+	require.NoError(t, service.SetMonthlyBudget(0))
+	assert.NoError(t, service.CheckBudget(), "0 removes the cap")
+}
@@ -1,6 +1,8 @@
 package main
 
 import (
+	"os"
+
 	"github.com/wailsapp/wails/v2"
 	"github.com/wailsapp/wails/v2/pkg/options"
 	"github.com/wailsapp/wails/v2/pkg/options/assetserver"
@@ -18,6 +20,15 @@ func main() {
 	// Create an instance of the app structure
 	app := NewApp()
 
+	// "mcp" serves the Model Context Protocol on stdin/stdout for external agents instead of opening the UI
+	if len(os.Args) > 1 && os.Args[1] == "mcp" {
+		if err := app.serveMCPStdio(); err != nil {
+			println("Error:", err.Error())
+			os.Exit(1)
+		}
+		return
+	}
+
 	// Create application with options
 	err := wails.Run(&options.App{
 		Title:  "Video Editor",